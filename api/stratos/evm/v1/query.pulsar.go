@@ -7291,9 +7291,11 @@ func (x *fastReflection_QueryParamsResponse) ProtoMethods() *protoiface.Methods
 }
 
 var (
-	md_EthCallRequest         protoreflect.MessageDescriptor
-	fd_EthCallRequest_args    protoreflect.FieldDescriptor
-	fd_EthCallRequest_gas_cap protoreflect.FieldDescriptor
+	md_EthCallRequest                 protoreflect.MessageDescriptor
+	fd_EthCallRequest_args            protoreflect.FieldDescriptor
+	fd_EthCallRequest_gas_cap         protoreflect.FieldDescriptor
+	fd_EthCallRequest_overrides       protoreflect.FieldDescriptor
+	fd_EthCallRequest_block_overrides protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -7301,6 +7303,8 @@ func init() {
 	md_EthCallRequest = File_stratos_evm_v1_query_proto.Messages().ByName("EthCallRequest")
 	fd_EthCallRequest_args = md_EthCallRequest.Fields().ByName("args")
 	fd_EthCallRequest_gas_cap = md_EthCallRequest.Fields().ByName("gas_cap")
+	fd_EthCallRequest_overrides = md_EthCallRequest.Fields().ByName("overrides")
+	fd_EthCallRequest_block_overrides = md_EthCallRequest.Fields().ByName("block_overrides")
 }
 
 var _ protoreflect.Message = (*fastReflection_EthCallRequest)(nil)
@@ -7380,6 +7384,18 @@ func (x *fastReflection_EthCallRequest) Range(f func(protoreflect.FieldDescripto
 			return
 		}
 	}
+	if len(x.Overrides) != 0 {
+		value := protoreflect.ValueOfBytes(x.Overrides)
+		if !f(fd_EthCallRequest_overrides, value) {
+			return
+		}
+	}
+	if len(x.BlockOverrides) != 0 {
+		value := protoreflect.ValueOfBytes(x.BlockOverrides)
+		if !f(fd_EthCallRequest_block_overrides, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -7399,6 +7415,10 @@ func (x *fastReflection_EthCallRequest) Has(fd protoreflect.FieldDescriptor) boo
 		return len(x.Args) != 0
 	case "stratos.evm.v1.EthCallRequest.gas_cap":
 		return x.GasCap != uint64(0)
+	case "stratos.evm.v1.EthCallRequest.overrides":
+		return len(x.Overrides) != 0
+	case "stratos.evm.v1.EthCallRequest.block_overrides":
+		return len(x.BlockOverrides) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.evm.v1.EthCallRequest"))
@@ -7419,6 +7439,10 @@ func (x *fastReflection_EthCallRequest) Clear(fd protoreflect.FieldDescriptor) {
 		x.Args = nil
 	case "stratos.evm.v1.EthCallRequest.gas_cap":
 		x.GasCap = uint64(0)
+	case "stratos.evm.v1.EthCallRequest.overrides":
+		x.Overrides = nil
+	case "stratos.evm.v1.EthCallRequest.block_overrides":
+		x.BlockOverrides = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.evm.v1.EthCallRequest"))
@@ -7441,6 +7465,12 @@ func (x *fastReflection_EthCallRequest) Get(descriptor protoreflect.FieldDescrip
 	case "stratos.evm.v1.EthCallRequest.gas_cap":
 		value := x.GasCap
 		return protoreflect.ValueOfUint64(value)
+	case "stratos.evm.v1.EthCallRequest.overrides":
+		value := x.Overrides
+		return protoreflect.ValueOfBytes(value)
+	case "stratos.evm.v1.EthCallRequest.block_overrides":
+		value := x.BlockOverrides
+		return protoreflect.ValueOfBytes(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.evm.v1.EthCallRequest"))
@@ -7465,6 +7495,10 @@ func (x *fastReflection_EthCallRequest) Set(fd protoreflect.FieldDescriptor, val
 		x.Args = value.Bytes()
 	case "stratos.evm.v1.EthCallRequest.gas_cap":
 		x.GasCap = value.Uint()
+	case "stratos.evm.v1.EthCallRequest.overrides":
+		x.Overrides = value.Bytes()
+	case "stratos.evm.v1.EthCallRequest.block_overrides":
+		x.BlockOverrides = value.Bytes()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.evm.v1.EthCallRequest"))
@@ -7489,6 +7523,10 @@ func (x *fastReflection_EthCallRequest) Mutable(fd protoreflect.FieldDescriptor)
 		panic(fmt.Errorf("field args of message stratos.evm.v1.EthCallRequest is not mutable"))
 	case "stratos.evm.v1.EthCallRequest.gas_cap":
 		panic(fmt.Errorf("field gas_cap of message stratos.evm.v1.EthCallRequest is not mutable"))
+	case "stratos.evm.v1.EthCallRequest.overrides":
+		panic(fmt.Errorf("field overrides of message stratos.evm.v1.EthCallRequest is not mutable"))
+	case "stratos.evm.v1.EthCallRequest.block_overrides":
+		panic(fmt.Errorf("field block_overrides of message stratos.evm.v1.EthCallRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.evm.v1.EthCallRequest"))
@@ -7506,6 +7544,10 @@ func (x *fastReflection_EthCallRequest) NewField(fd protoreflect.FieldDescriptor
 		return protoreflect.ValueOfBytes(nil)
 	case "stratos.evm.v1.EthCallRequest.gas_cap":
 		return protoreflect.ValueOfUint64(uint64(0))
+	case "stratos.evm.v1.EthCallRequest.overrides":
+		return protoreflect.ValueOfBytes(nil)
+	case "stratos.evm.v1.EthCallRequest.block_overrides":
+		return protoreflect.ValueOfBytes(nil)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.evm.v1.EthCallRequest"))
@@ -7582,6 +7624,14 @@ func (x *fastReflection_EthCallRequest) ProtoMethods() *protoiface.Methods {
 		if x.GasCap != 0 {
 			n += 1 + runtime.Sov(uint64(x.GasCap))
 		}
+		l = len(x.Overrides)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.BlockOverrides)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -7611,6 +7661,20 @@ func (x *fastReflection_EthCallRequest) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.BlockOverrides) > 0 {
+			i -= len(x.BlockOverrides)
+			copy(dAtA[i:], x.BlockOverrides)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BlockOverrides)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Overrides) > 0 {
+			i -= len(x.Overrides)
+			copy(dAtA[i:], x.Overrides)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Overrides)))
+			i--
+			dAtA[i] = 0x1a
+		}
 		if x.GasCap != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.GasCap))
 			i--
@@ -7725,6 +7789,74 @@ func (x *fastReflection_EthCallRequest) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Overrides", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Overrides = append(x.Overrides[:0], dAtA[iNdEx:postIndex]...)
+				if x.Overrides == nil {
+					x.Overrides = []byte{}
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockOverrides", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BlockOverrides = append(x.BlockOverrides[:0], dAtA[iNdEx:postIndex]...)
+				if x.BlockOverrides == nil {
+					x.BlockOverrides = []byte{}
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -8114,7 +8246,592 @@ func (x *fastReflection_EstimateGasResponse) ProtoMethods() *protoiface.Methods
 				if wireType != 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Gas", wireType)
 				}
-				x.Gas = 0
+				x.Gas = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Gas |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_CreateAccessListResponse             protoreflect.MessageDescriptor
+	fd_CreateAccessListResponse_access_list protoreflect.FieldDescriptor
+	fd_CreateAccessListResponse_gas_used    protoreflect.FieldDescriptor
+	fd_CreateAccessListResponse_vm_error    protoreflect.FieldDescriptor
+	fd_CreateAccessListResponse_ret         protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_stratos_evm_v1_query_proto_init()
+	md_CreateAccessListResponse = File_stratos_evm_v1_query_proto.Messages().ByName("CreateAccessListResponse")
+	fd_CreateAccessListResponse_access_list = md_CreateAccessListResponse.Fields().ByName("access_list")
+	fd_CreateAccessListResponse_gas_used = md_CreateAccessListResponse.Fields().ByName("gas_used")
+	fd_CreateAccessListResponse_vm_error = md_CreateAccessListResponse.Fields().ByName("vm_error")
+	fd_CreateAccessListResponse_ret = md_CreateAccessListResponse.Fields().ByName("ret")
+}
+
+var _ protoreflect.Message = (*fastReflection_CreateAccessListResponse)(nil)
+
+type fastReflection_CreateAccessListResponse CreateAccessListResponse
+
+func (x *CreateAccessListResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_CreateAccessListResponse)(x)
+}
+
+func (x *CreateAccessListResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_stratos_evm_v1_query_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_CreateAccessListResponse_messageType fastReflection_CreateAccessListResponse_messageType
+var _ protoreflect.MessageType = fastReflection_CreateAccessListResponse_messageType{}
+
+type fastReflection_CreateAccessListResponse_messageType struct{}
+
+func (x fastReflection_CreateAccessListResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_CreateAccessListResponse)(nil)
+}
+func (x fastReflection_CreateAccessListResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_CreateAccessListResponse)
+}
+func (x fastReflection_CreateAccessListResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_CreateAccessListResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_CreateAccessListResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_CreateAccessListResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_CreateAccessListResponse) Type() protoreflect.MessageType {
+	return _fastReflection_CreateAccessListResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_CreateAccessListResponse) New() protoreflect.Message {
+	return new(fastReflection_CreateAccessListResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_CreateAccessListResponse) Interface() protoreflect.ProtoMessage {
+	return (*CreateAccessListResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_CreateAccessListResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.AccessList) != 0 {
+		value := protoreflect.ValueOfBytes(x.AccessList)
+		if !f(fd_CreateAccessListResponse_access_list, value) {
+			return
+		}
+	}
+	if x.GasUsed != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.GasUsed)
+		if !f(fd_CreateAccessListResponse_gas_used, value) {
+			return
+		}
+	}
+	if x.VmError != "" {
+		value := protoreflect.ValueOfString(x.VmError)
+		if !f(fd_CreateAccessListResponse_vm_error, value) {
+			return
+		}
+	}
+	if len(x.Ret) != 0 {
+		value := protoreflect.ValueOfBytes(x.Ret)
+		if !f(fd_CreateAccessListResponse_ret, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_CreateAccessListResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "stratos.evm.v1.CreateAccessListResponse.access_list":
+		return len(x.AccessList) != 0
+	case "stratos.evm.v1.CreateAccessListResponse.gas_used":
+		return x.GasUsed != uint64(0)
+	case "stratos.evm.v1.CreateAccessListResponse.vm_error":
+		return x.VmError != ""
+	case "stratos.evm.v1.CreateAccessListResponse.ret":
+		return len(x.Ret) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.evm.v1.CreateAccessListResponse"))
+		}
+		panic(fmt.Errorf("message stratos.evm.v1.CreateAccessListResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CreateAccessListResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "stratos.evm.v1.CreateAccessListResponse.access_list":
+		x.AccessList = nil
+	case "stratos.evm.v1.CreateAccessListResponse.gas_used":
+		x.GasUsed = uint64(0)
+	case "stratos.evm.v1.CreateAccessListResponse.vm_error":
+		x.VmError = ""
+	case "stratos.evm.v1.CreateAccessListResponse.ret":
+		x.Ret = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.evm.v1.CreateAccessListResponse"))
+		}
+		panic(fmt.Errorf("message stratos.evm.v1.CreateAccessListResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_CreateAccessListResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "stratos.evm.v1.CreateAccessListResponse.access_list":
+		value := x.AccessList
+		return protoreflect.ValueOfBytes(value)
+	case "stratos.evm.v1.CreateAccessListResponse.gas_used":
+		value := x.GasUsed
+		return protoreflect.ValueOfUint64(value)
+	case "stratos.evm.v1.CreateAccessListResponse.vm_error":
+		value := x.VmError
+		return protoreflect.ValueOfString(value)
+	case "stratos.evm.v1.CreateAccessListResponse.ret":
+		value := x.Ret
+		return protoreflect.ValueOfBytes(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.evm.v1.CreateAccessListResponse"))
+		}
+		panic(fmt.Errorf("message stratos.evm.v1.CreateAccessListResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CreateAccessListResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "stratos.evm.v1.CreateAccessListResponse.access_list":
+		x.AccessList = value.Bytes()
+	case "stratos.evm.v1.CreateAccessListResponse.gas_used":
+		x.GasUsed = value.Uint()
+	case "stratos.evm.v1.CreateAccessListResponse.vm_error":
+		x.VmError = value.Interface().(string)
+	case "stratos.evm.v1.CreateAccessListResponse.ret":
+		x.Ret = value.Bytes()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.evm.v1.CreateAccessListResponse"))
+		}
+		panic(fmt.Errorf("message stratos.evm.v1.CreateAccessListResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CreateAccessListResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "stratos.evm.v1.CreateAccessListResponse.access_list":
+		panic(fmt.Errorf("field access_list of message stratos.evm.v1.CreateAccessListResponse is not mutable"))
+	case "stratos.evm.v1.CreateAccessListResponse.gas_used":
+		panic(fmt.Errorf("field gas_used of message stratos.evm.v1.CreateAccessListResponse is not mutable"))
+	case "stratos.evm.v1.CreateAccessListResponse.vm_error":
+		panic(fmt.Errorf("field vm_error of message stratos.evm.v1.CreateAccessListResponse is not mutable"))
+	case "stratos.evm.v1.CreateAccessListResponse.ret":
+		panic(fmt.Errorf("field ret of message stratos.evm.v1.CreateAccessListResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.evm.v1.CreateAccessListResponse"))
+		}
+		panic(fmt.Errorf("message stratos.evm.v1.CreateAccessListResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_CreateAccessListResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "stratos.evm.v1.CreateAccessListResponse.access_list":
+		return protoreflect.ValueOfBytes(nil)
+	case "stratos.evm.v1.CreateAccessListResponse.gas_used":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "stratos.evm.v1.CreateAccessListResponse.vm_error":
+		return protoreflect.ValueOfString("")
+	case "stratos.evm.v1.CreateAccessListResponse.ret":
+		return protoreflect.ValueOfBytes(nil)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.evm.v1.CreateAccessListResponse"))
+		}
+		panic(fmt.Errorf("message stratos.evm.v1.CreateAccessListResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_CreateAccessListResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in stratos.evm.v1.CreateAccessListResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_CreateAccessListResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CreateAccessListResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_CreateAccessListResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_CreateAccessListResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*CreateAccessListResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.AccessList)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.GasUsed != 0 {
+			n += 1 + runtime.Sov(uint64(x.GasUsed))
+		}
+		l = len(x.VmError)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Ret)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*CreateAccessListResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Ret) > 0 {
+			i -= len(x.Ret)
+			copy(dAtA[i:], x.Ret)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Ret)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.VmError) > 0 {
+			i -= len(x.VmError)
+			copy(dAtA[i:], x.VmError)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VmError)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.GasUsed != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.GasUsed))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.AccessList) > 0 {
+			i -= len(x.AccessList)
+			copy(dAtA[i:], x.AccessList)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AccessList)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*CreateAccessListResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CreateAccessListResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CreateAccessListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AccessList", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AccessList = append(x.AccessList[:0], dAtA[iNdEx:postIndex]...)
+				if x.AccessList == nil {
+					x.AccessList = []byte{}
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+				}
+				x.GasUsed = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.GasUsed |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VmError", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.VmError = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Ret", wireType)
+				}
+				var byteLen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -8124,11 +8841,26 @@ func (x *fastReflection_EstimateGasResponse) ProtoMethods() *protoiface.Methods
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.Gas |= uint64(b&0x7F) << shift
+					byteLen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Ret = append(x.Ret[:0], dAtA[iNdEx:postIndex]...)
+				if x.Ret == nil {
+					x.Ret = []byte{}
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -8216,13 +8948,15 @@ func (x *_QueryTraceTxRequest_3_list) IsValid() bool {
 }
 
 var (
-	md_QueryTraceTxRequest              protoreflect.MessageDescriptor
-	fd_QueryTraceTxRequest_msg          protoreflect.FieldDescriptor
-	fd_QueryTraceTxRequest_trace_config protoreflect.FieldDescriptor
-	fd_QueryTraceTxRequest_predecessors protoreflect.FieldDescriptor
-	fd_QueryTraceTxRequest_block_number protoreflect.FieldDescriptor
-	fd_QueryTraceTxRequest_block_hash   protoreflect.FieldDescriptor
-	fd_QueryTraceTxRequest_block_time   protoreflect.FieldDescriptor
+	md_QueryTraceTxRequest                 protoreflect.MessageDescriptor
+	fd_QueryTraceTxRequest_msg             protoreflect.FieldDescriptor
+	fd_QueryTraceTxRequest_trace_config    protoreflect.FieldDescriptor
+	fd_QueryTraceTxRequest_predecessors    protoreflect.FieldDescriptor
+	fd_QueryTraceTxRequest_block_number    protoreflect.FieldDescriptor
+	fd_QueryTraceTxRequest_block_hash      protoreflect.FieldDescriptor
+	fd_QueryTraceTxRequest_block_time      protoreflect.FieldDescriptor
+	fd_QueryTraceTxRequest_state_overrides protoreflect.FieldDescriptor
+	fd_QueryTraceTxRequest_block_overrides protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -8234,6 +8968,8 @@ func init() {
 	fd_QueryTraceTxRequest_block_number = md_QueryTraceTxRequest.Fields().ByName("block_number")
 	fd_QueryTraceTxRequest_block_hash = md_QueryTraceTxRequest.Fields().ByName("block_hash")
 	fd_QueryTraceTxRequest_block_time = md_QueryTraceTxRequest.Fields().ByName("block_time")
+	fd_QueryTraceTxRequest_state_overrides = md_QueryTraceTxRequest.Fields().ByName("state_overrides")
+	fd_QueryTraceTxRequest_block_overrides = md_QueryTraceTxRequest.Fields().ByName("block_overrides")
 }
 
 var _ protoreflect.Message = (*fastReflection_QueryTraceTxRequest)(nil)
@@ -8245,7 +8981,7 @@ func (x *QueryTraceTxRequest) ProtoReflect() protoreflect.Message {
 }
 
 func (x *QueryTraceTxRequest) slowProtoReflect() protoreflect.Message {
-	mi := &file_stratos_evm_v1_query_proto_msgTypes[18]
+	mi := &file_stratos_evm_v1_query_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8337,6 +9073,18 @@ func (x *fastReflection_QueryTraceTxRequest) Range(f func(protoreflect.FieldDesc
 			return
 		}
 	}
+	if len(x.StateOverrides) != 0 {
+		value := protoreflect.ValueOfBytes(x.StateOverrides)
+		if !f(fd_QueryTraceTxRequest_state_overrides, value) {
+			return
+		}
+	}
+	if len(x.BlockOverrides) != 0 {
+		value := protoreflect.ValueOfBytes(x.BlockOverrides)
+		if !f(fd_QueryTraceTxRequest_block_overrides, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -8364,6 +9112,10 @@ func (x *fastReflection_QueryTraceTxRequest) Has(fd protoreflect.FieldDescriptor
 		return x.BlockHash != ""
 	case "stratos.evm.v1.QueryTraceTxRequest.block_time":
 		return x.BlockTime != nil
+	case "stratos.evm.v1.QueryTraceTxRequest.state_overrides":
+		return len(x.StateOverrides) != 0
+	case "stratos.evm.v1.QueryTraceTxRequest.block_overrides":
+		return len(x.BlockOverrides) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.evm.v1.QueryTraceTxRequest"))
@@ -8392,6 +9144,10 @@ func (x *fastReflection_QueryTraceTxRequest) Clear(fd protoreflect.FieldDescript
 		x.BlockHash = ""
 	case "stratos.evm.v1.QueryTraceTxRequest.block_time":
 		x.BlockTime = nil
+	case "stratos.evm.v1.QueryTraceTxRequest.state_overrides":
+		x.StateOverrides = nil
+	case "stratos.evm.v1.QueryTraceTxRequest.block_overrides":
+		x.BlockOverrides = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.evm.v1.QueryTraceTxRequest"))
@@ -8429,6 +9185,12 @@ func (x *fastReflection_QueryTraceTxRequest) Get(descriptor protoreflect.FieldDe
 	case "stratos.evm.v1.QueryTraceTxRequest.block_time":
 		value := x.BlockTime
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "stratos.evm.v1.QueryTraceTxRequest.state_overrides":
+		value := x.StateOverrides
+		return protoreflect.ValueOfBytes(value)
+	case "stratos.evm.v1.QueryTraceTxRequest.block_overrides":
+		value := x.BlockOverrides
+		return protoreflect.ValueOfBytes(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.evm.v1.QueryTraceTxRequest"))
@@ -8463,6 +9225,10 @@ func (x *fastReflection_QueryTraceTxRequest) Set(fd protoreflect.FieldDescriptor
 		x.BlockHash = value.Interface().(string)
 	case "stratos.evm.v1.QueryTraceTxRequest.block_time":
 		x.BlockTime = value.Message().Interface().(*timestamppb.Timestamp)
+	case "stratos.evm.v1.QueryTraceTxRequest.state_overrides":
+		x.StateOverrides = value.Bytes()
+	case "stratos.evm.v1.QueryTraceTxRequest.block_overrides":
+		x.BlockOverrides = value.Bytes()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.evm.v1.QueryTraceTxRequest"))
@@ -8508,6 +9274,10 @@ func (x *fastReflection_QueryTraceTxRequest) Mutable(fd protoreflect.FieldDescri
 		panic(fmt.Errorf("field block_number of message stratos.evm.v1.QueryTraceTxRequest is not mutable"))
 	case "stratos.evm.v1.QueryTraceTxRequest.block_hash":
 		panic(fmt.Errorf("field block_hash of message stratos.evm.v1.QueryTraceTxRequest is not mutable"))
+	case "stratos.evm.v1.QueryTraceTxRequest.state_overrides":
+		panic(fmt.Errorf("field state_overrides of message stratos.evm.v1.QueryTraceTxRequest is not mutable"))
+	case "stratos.evm.v1.QueryTraceTxRequest.block_overrides":
+		panic(fmt.Errorf("field block_overrides of message stratos.evm.v1.QueryTraceTxRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.evm.v1.QueryTraceTxRequest"))
@@ -8537,6 +9307,10 @@ func (x *fastReflection_QueryTraceTxRequest) NewField(fd protoreflect.FieldDescr
 	case "stratos.evm.v1.QueryTraceTxRequest.block_time":
 		m := new(timestamppb.Timestamp)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "stratos.evm.v1.QueryTraceTxRequest.state_overrides":
+		return protoreflect.ValueOfBytes(nil)
+	case "stratos.evm.v1.QueryTraceTxRequest.block_overrides":
+		return protoreflect.ValueOfBytes(nil)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.evm.v1.QueryTraceTxRequest"))
@@ -8631,6 +9405,14 @@ func (x *fastReflection_QueryTraceTxRequest) ProtoMethods() *protoiface.Methods
 			l = options.Size(x.BlockTime)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		l = len(x.StateOverrides)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.BlockOverrides)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -8660,6 +9442,20 @@ func (x *fastReflection_QueryTraceTxRequest) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.BlockOverrides) > 0 {
+			i -= len(x.BlockOverrides)
+			copy(dAtA[i:], x.BlockOverrides)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BlockOverrides)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if len(x.StateOverrides) > 0 {
+			i -= len(x.StateOverrides)
+			copy(dAtA[i:], x.StateOverrides)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.StateOverrides)))
+			i--
+			dAtA[i] = 0x3a
+		}
 		if x.BlockTime != nil {
 			encoded, err := options.Marshal(x.BlockTime)
 			if err != nil {
@@ -8972,6 +9768,74 @@ func (x *fastReflection_QueryTraceTxRequest) ProtoMethods() *protoiface.Methods
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StateOverrides", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.StateOverrides = append(x.StateOverrides[:0], dAtA[iNdEx:postIndex]...)
+				if x.StateOverrides == nil {
+					x.StateOverrides = []byte{}
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockOverrides", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BlockOverrides = append(x.BlockOverrides[:0], dAtA[iNdEx:postIndex]...)
+				if x.BlockOverrides == nil {
+					x.BlockOverrides = []byte{}
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -9027,7 +9891,7 @@ func (x *QueryTraceTxResponse) ProtoReflect() protoreflect.Message {
 }
 
 func (x *QueryTraceTxResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_stratos_evm_v1_query_proto_msgTypes[19]
+	mi := &file_stratos_evm_v1_query_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9508,7 +10372,7 @@ func (x *QueryTraceBlockRequest) ProtoReflect() protoreflect.Message {
 }
 
 func (x *QueryTraceBlockRequest) slowProtoReflect() protoreflect.Message {
-	mi := &file_stratos_evm_v1_query_proto_msgTypes[20]
+	mi := &file_stratos_evm_v1_query_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10213,7 +11077,7 @@ func (x *QueryTraceBlockResponse) ProtoReflect() protoreflect.Message {
 }
 
 func (x *QueryTraceBlockResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_stratos_evm_v1_query_proto_msgTypes[21]
+	mi := &file_stratos_evm_v1_query_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10633,7 +11497,7 @@ func (x *QueryBaseFeeRequest) ProtoReflect() protoreflect.Message {
 }
 
 func (x *QueryBaseFeeRequest) slowProtoReflect() protoreflect.Message {
-	mi := &file_stratos_evm_v1_query_proto_msgTypes[22]
+	mi := &file_stratos_evm_v1_query_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10991,7 +11855,7 @@ func (x *QueryBaseFeeResponse) ProtoReflect() protoreflect.Message {
 }
 
 func (x *QueryBaseFeeResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_stratos_evm_v1_query_proto_msgTypes[23]
+	mi := &file_stratos_evm_v1_query_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11409,7 +12273,7 @@ func (x *QueryBlockGasRequest) ProtoReflect() protoreflect.Message {
 }
 
 func (x *QueryBlockGasRequest) slowProtoReflect() protoreflect.Message {
-	mi := &file_stratos_evm_v1_query_proto_msgTypes[24]
+	mi := &file_stratos_evm_v1_query_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11767,7 +12631,7 @@ func (x *QueryBlockGasResponse) ProtoReflect() protoreflect.Message {
 }
 
 func (x *QueryBlockGasResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_stratos_evm_v1_query_proto_msgTypes[25]
+	mi := &file_stratos_evm_v1_query_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12171,7 +13035,7 @@ func (x *QueryAddressConvertRequest) ProtoReflect() protoreflect.Message {
 }
 
 func (x *QueryAddressConvertRequest) slowProtoReflect() protoreflect.Message {
-	mi := &file_stratos_evm_v1_query_proto_msgTypes[26]
+	mi := &file_stratos_evm_v1_query_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12593,7 +13457,7 @@ func (x *QueryAddressConvertResponse) ProtoReflect() protoreflect.Message {
 }
 
 func (x *QueryAddressConvertResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_stratos_evm_v1_query_proto_msgTypes[27]
+	mi := &file_stratos_evm_v1_query_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13428,7 +14292,7 @@ type QueryStorageRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// / address is the ethereum hex address to query the storage state for.
+	/// address is the ethereum hex address to query the storage state for.
 	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 	// key defines the key of the storage state
 	Key string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
@@ -13747,6 +14611,10 @@ type EthCallRequest struct {
 	Args []byte `protobuf:"bytes,1,opt,name=args,proto3" json:"args,omitempty"`
 	// the default gas cap to be used
 	GasCap uint64 `protobuf:"varint,2,opt,name=gas_cap,json=gasCap,proto3" json:"gas_cap,omitempty"`
+	// state overrides applied before the call, same json format as the json rpc api.
+	Overrides []byte `protobuf:"bytes,3,opt,name=overrides,proto3" json:"overrides,omitempty"`
+	// block overrides applied to the call context, same json format as the json rpc api.
+	BlockOverrides []byte `protobuf:"bytes,4,opt,name=block_overrides,json=blockOverrides,proto3" json:"block_overrides,omitempty"`
 }
 
 func (x *EthCallRequest) Reset() {
@@ -13783,6 +14651,20 @@ func (x *EthCallRequest) GetGasCap() uint64 {
 	return 0
 }
 
+func (x *EthCallRequest) GetOverrides() []byte {
+	if x != nil {
+		return x.Overrides
+	}
+	return nil
+}
+
+func (x *EthCallRequest) GetBlockOverrides() []byte {
+	if x != nil {
+		return x.BlockOverrides
+	}
+	return nil
+}
+
 // EstimateGasResponse defines EstimateGas response
 type EstimateGasResponse struct {
 	state         protoimpl.MessageState
@@ -13820,6 +14702,70 @@ func (x *EstimateGasResponse) GetGas() uint64 {
 	return 0
 }
 
+// CreateAccessListResponse defines CreateAccessList response
+type CreateAccessListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the access list in the same json format as the json rpc api
+	AccessList []byte `protobuf:"bytes,1,opt,name=access_list,json=accessList,proto3" json:"access_list,omitempty"`
+	// the gas used by the call with the access list applied
+	GasUsed uint64 `protobuf:"varint,2,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// vm error is the error returned by vm execution
+	VmError string `protobuf:"bytes,3,opt,name=vm_error,json=vmError,proto3" json:"vm_error,omitempty"`
+	// ret is the returned data from evm function (result or data supplied with revert opcode)
+	Ret []byte `protobuf:"bytes,4,opt,name=ret,proto3" json:"ret,omitempty"`
+}
+
+func (x *CreateAccessListResponse) Reset() {
+	*x = CreateAccessListResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stratos_evm_v1_query_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateAccessListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAccessListResponse) ProtoMessage() {}
+
+// Deprecated: Use CreateAccessListResponse.ProtoReflect.Descriptor instead.
+func (*CreateAccessListResponse) Descriptor() ([]byte, []int) {
+	return file_stratos_evm_v1_query_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CreateAccessListResponse) GetAccessList() []byte {
+	if x != nil {
+		return x.AccessList
+	}
+	return nil
+}
+
+func (x *CreateAccessListResponse) GetGasUsed() uint64 {
+	if x != nil {
+		return x.GasUsed
+	}
+	return 0
+}
+
+func (x *CreateAccessListResponse) GetVmError() string {
+	if x != nil {
+		return x.VmError
+	}
+	return ""
+}
+
+func (x *CreateAccessListResponse) GetRet() []byte {
+	if x != nil {
+		return x.Ret
+	}
+	return nil
+}
+
 // QueryTraceTxRequest defines TraceTx request
 type QueryTraceTxRequest struct {
 	state         protoimpl.MessageState
@@ -13839,12 +14785,16 @@ type QueryTraceTxRequest struct {
 	BlockHash string `protobuf:"bytes,5,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
 	// block time of requested transaction
 	BlockTime *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=block_time,json=blockTime,proto3" json:"block_time,omitempty"`
+	// state overrides applied to the traced transaction only, same json format as the json rpc api.
+	StateOverrides []byte `protobuf:"bytes,7,opt,name=state_overrides,json=stateOverrides,proto3" json:"state_overrides,omitempty"`
+	// block overrides applied to the traced transaction only, same json format as the json rpc api.
+	BlockOverrides []byte `protobuf:"bytes,8,opt,name=block_overrides,json=blockOverrides,proto3" json:"block_overrides,omitempty"`
 }
 
 func (x *QueryTraceTxRequest) Reset() {
 	*x = QueryTraceTxRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_stratos_evm_v1_query_proto_msgTypes[18]
+		mi := &file_stratos_evm_v1_query_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13858,7 +14808,7 @@ func (*QueryTraceTxRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryTraceTxRequest.ProtoReflect.Descriptor instead.
 func (*QueryTraceTxRequest) Descriptor() ([]byte, []int) {
-	return file_stratos_evm_v1_query_proto_rawDescGZIP(), []int{18}
+	return file_stratos_evm_v1_query_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *QueryTraceTxRequest) GetMsg() *MsgEthereumTx {
@@ -13903,6 +14853,20 @@ func (x *QueryTraceTxRequest) GetBlockTime() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *QueryTraceTxRequest) GetStateOverrides() []byte {
+	if x != nil {
+		return x.StateOverrides
+	}
+	return nil
+}
+
+func (x *QueryTraceTxRequest) GetBlockOverrides() []byte {
+	if x != nil {
+		return x.BlockOverrides
+	}
+	return nil
+}
+
 // QueryTraceTxResponse defines TraceTx response
 type QueryTraceTxResponse struct {
 	state         protoimpl.MessageState
@@ -13916,7 +14880,7 @@ type QueryTraceTxResponse struct {
 func (x *QueryTraceTxResponse) Reset() {
 	*x = QueryTraceTxResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_stratos_evm_v1_query_proto_msgTypes[19]
+		mi := &file_stratos_evm_v1_query_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13930,7 +14894,7 @@ func (*QueryTraceTxResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryTraceTxResponse.ProtoReflect.Descriptor instead.
 func (*QueryTraceTxResponse) Descriptor() ([]byte, []int) {
-	return file_stratos_evm_v1_query_proto_rawDescGZIP(), []int{19}
+	return file_stratos_evm_v1_query_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *QueryTraceTxResponse) GetData() []byte {
@@ -13961,7 +14925,7 @@ type QueryTraceBlockRequest struct {
 func (x *QueryTraceBlockRequest) Reset() {
 	*x = QueryTraceBlockRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_stratos_evm_v1_query_proto_msgTypes[20]
+		mi := &file_stratos_evm_v1_query_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -13975,7 +14939,7 @@ func (*QueryTraceBlockRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryTraceBlockRequest.ProtoReflect.Descriptor instead.
 func (*QueryTraceBlockRequest) Descriptor() ([]byte, []int) {
-	return file_stratos_evm_v1_query_proto_rawDescGZIP(), []int{20}
+	return file_stratos_evm_v1_query_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *QueryTraceBlockRequest) GetTxs() []*MsgEthereumTx {
@@ -14025,7 +14989,7 @@ type QueryTraceBlockResponse struct {
 func (x *QueryTraceBlockResponse) Reset() {
 	*x = QueryTraceBlockResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_stratos_evm_v1_query_proto_msgTypes[21]
+		mi := &file_stratos_evm_v1_query_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -14039,7 +15003,7 @@ func (*QueryTraceBlockResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryTraceBlockResponse.ProtoReflect.Descriptor instead.
 func (*QueryTraceBlockResponse) Descriptor() ([]byte, []int) {
-	return file_stratos_evm_v1_query_proto_rawDescGZIP(), []int{21}
+	return file_stratos_evm_v1_query_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *QueryTraceBlockResponse) GetData() []byte {
@@ -14060,7 +15024,7 @@ type QueryBaseFeeRequest struct {
 func (x *QueryBaseFeeRequest) Reset() {
 	*x = QueryBaseFeeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_stratos_evm_v1_query_proto_msgTypes[22]
+		mi := &file_stratos_evm_v1_query_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -14074,7 +15038,7 @@ func (*QueryBaseFeeRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryBaseFeeRequest.ProtoReflect.Descriptor instead.
 func (*QueryBaseFeeRequest) Descriptor() ([]byte, []int) {
-	return file_stratos_evm_v1_query_proto_rawDescGZIP(), []int{22}
+	return file_stratos_evm_v1_query_proto_rawDescGZIP(), []int{23}
 }
 
 // BaseFeeResponse returns the EIP1559 base fee.
@@ -14089,7 +15053,7 @@ type QueryBaseFeeResponse struct {
 func (x *QueryBaseFeeResponse) Reset() {
 	*x = QueryBaseFeeResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_stratos_evm_v1_query_proto_msgTypes[23]
+		mi := &file_stratos_evm_v1_query_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -14103,7 +15067,7 @@ func (*QueryBaseFeeResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryBaseFeeResponse.ProtoReflect.Descriptor instead.
 func (*QueryBaseFeeResponse) Descriptor() ([]byte, []int) {
-	return file_stratos_evm_v1_query_proto_rawDescGZIP(), []int{23}
+	return file_stratos_evm_v1_query_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *QueryBaseFeeResponse) GetBaseFee() string {
@@ -14124,7 +15088,7 @@ type QueryBlockGasRequest struct {
 func (x *QueryBlockGasRequest) Reset() {
 	*x = QueryBlockGasRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_stratos_evm_v1_query_proto_msgTypes[24]
+		mi := &file_stratos_evm_v1_query_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -14138,7 +15102,7 @@ func (*QueryBlockGasRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryBlockGasRequest.ProtoReflect.Descriptor instead.
 func (*QueryBlockGasRequest) Descriptor() ([]byte, []int) {
-	return file_stratos_evm_v1_query_proto_rawDescGZIP(), []int{24}
+	return file_stratos_evm_v1_query_proto_rawDescGZIP(), []int{25}
 }
 
 // QueryBlockGasResponse returns block gas used for a given height.
@@ -14153,7 +15117,7 @@ type QueryBlockGasResponse struct {
 func (x *QueryBlockGasResponse) Reset() {
 	*x = QueryBlockGasResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_stratos_evm_v1_query_proto_msgTypes[25]
+		mi := &file_stratos_evm_v1_query_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -14167,7 +15131,7 @@ func (*QueryBlockGasResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryBlockGasResponse.ProtoReflect.Descriptor instead.
 func (*QueryBlockGasResponse) Descriptor() ([]byte, []int) {
-	return file_stratos_evm_v1_query_proto_rawDescGZIP(), []int{25}
+	return file_stratos_evm_v1_query_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *QueryBlockGasResponse) GetGas() int64 {
@@ -14190,7 +15154,7 @@ type QueryAddressConvertRequest struct {
 func (x *QueryAddressConvertRequest) Reset() {
 	*x = QueryAddressConvertRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_stratos_evm_v1_query_proto_msgTypes[26]
+		mi := &file_stratos_evm_v1_query_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -14204,7 +15168,7 @@ func (*QueryAddressConvertRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryAddressConvertRequest.ProtoReflect.Descriptor instead.
 func (*QueryAddressConvertRequest) Descriptor() ([]byte, []int) {
-	return file_stratos_evm_v1_query_proto_rawDescGZIP(), []int{26}
+	return file_stratos_evm_v1_query_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *QueryAddressConvertRequest) GetAddress() string {
@@ -14227,7 +15191,7 @@ type QueryAddressConvertResponse struct {
 func (x *QueryAddressConvertResponse) Reset() {
 	*x = QueryAddressConvertResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_stratos_evm_v1_query_proto_msgTypes[27]
+		mi := &file_stratos_evm_v1_query_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -14241,7 +15205,7 @@ func (*QueryAddressConvertResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryAddressConvertResponse.ProtoReflect.Descriptor instead.
 func (*QueryAddressConvertResponse) Descriptor() ([]byte, []int) {
-	return file_stratos_evm_v1_query_proto_rawDescGZIP(), []int{27}
+	return file_stratos_evm_v1_query_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *QueryAddressConvertResponse) GetHexAddress() string {
@@ -14356,34 +15320,52 @@ var file_stratos_evm_v1_query_proto_rawDesc = []byte{
 	0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73,
 	0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61,
 	0x72, 0x61, 0x6d, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61,
-	0x6d, 0x73, 0x22, 0x3d, 0x0a, 0x0e, 0x45, 0x74, 0x68, 0x43, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x67, 0x73, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x73, 0x5f,
-	0x63, 0x61, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x67, 0x61, 0x73, 0x43, 0x61,
-	0x70, 0x22, 0x27, 0x0a, 0x13, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x47, 0x61, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x67, 0x61, 0x73, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x67, 0x61, 0x73, 0x22, 0xd0, 0x02, 0x0a, 0x13, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x54, 0x72, 0x61, 0x63, 0x65, 0x54, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x2f, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1d, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31,
-	0x2e, 0x4d, 0x73, 0x67, 0x45, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x54, 0x78, 0x52, 0x03,
-	0x6d, 0x73, 0x67, 0x12, 0x3e, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x74, 0x72, 0x61,
-	0x74, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x65,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x12, 0x41, 0x0a, 0x0c, 0x70, 0x72, 0x65, 0x64, 0x65, 0x63, 0x65, 0x73, 0x73,
-	0x6f, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x74, 0x72, 0x61,
-	0x74, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x74,
-	0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x54, 0x78, 0x52, 0x0c, 0x70, 0x72, 0x65, 0x64, 0x65, 0x63,
-	0x65, 0x73, 0x73, 0x6f, 0x72, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f,
-	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c,
-	0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f,
-	0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62,
-	0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x43, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63,
-	0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf,
-	0x1f, 0x01, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x2a, 0x0a,
+	0x6d, 0x73, 0x22, 0x84, 0x01, 0x0a, 0x0e, 0x45, 0x74, 0x68, 0x43, 0x61, 0x6c, 0x6c, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x67, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x73,
+	0x5f, 0x63, 0x61, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x67, 0x61, 0x73, 0x43,
+	0x61, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73,
+	0x12, 0x27, 0x0a, 0x0f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69,
+	0x64, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x22, 0x27, 0x0a, 0x13, 0x45, 0x73, 0x74,
+	0x69, 0x6d, 0x61, 0x74, 0x65, 0x47, 0x61, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x10, 0x0a, 0x03, 0x67, 0x61, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x67,
+	0x61, 0x73, 0x22, 0x83, 0x01, 0x0a, 0x18, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x1f, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x73, 0x74,
+	0x12, 0x19, 0x0a, 0x08, 0x67, 0x61, 0x73, 0x5f, 0x75, 0x73, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x07, 0x67, 0x61, 0x73, 0x55, 0x73, 0x65, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x76,
+	0x6d, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76,
+	0x6d, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x65, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x03, 0x72, 0x65, 0x74, 0x22, 0xa2, 0x03, 0x0a, 0x13, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x54, 0x72, 0x61, 0x63, 0x65, 0x54, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x2f, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
+	0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
+	0x73, 0x67, 0x45, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x54, 0x78, 0x52, 0x03, 0x6d, 0x73,
+	0x67, 0x12, 0x3e, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f,
+	0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x65, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x12, 0x41, 0x0a, 0x0c, 0x70, 0x72, 0x65, 0x64, 0x65, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f,
+	0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x74, 0x68, 0x65,
+	0x72, 0x65, 0x75, 0x6d, 0x54, 0x78, 0x52, 0x0c, 0x70, 0x72, 0x65, 0x64, 0x65, 0x63, 0x65, 0x73,
+	0x73, 0x6f, 0x72, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x43, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01,
+	0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x5f, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x73, 0x74, 0x61, 0x74, 0x65, 0x4f, 0x76, 0x65, 0x72, 0x72,
+	0x69, 0x64, 0x65, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6f, 0x76,
+	0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x22, 0x2a, 0x0a,
 	0x14, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x72, 0x61, 0x63, 0x65, 0x54, 0x78, 0x52, 0x65, 0x73,
 	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x90, 0x02, 0x0a, 0x16, 0x51, 0x75,
@@ -14429,7 +15411,7 @@ var file_stratos_evm_v1_query_proto_rawDesc = []byte{
 	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x68, 0x65, 0x78, 0x41, 0x64, 0x64,
 	0x72, 0x65, 0x73, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x62, 0x65, 0x63, 0x68, 0x33, 0x32, 0x5f, 0x61,
 	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x62, 0x65,
-	0x63, 0x68, 0x33, 0x32, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x32, 0xbb, 0x0f, 0x0a, 0x05,
+	0x63, 0x68, 0x33, 0x32, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x32, 0xc6, 0x10, 0x0a, 0x05,
 	0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x7f, 0x0a, 0x07, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
 	0x12, 0x23, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76,
 	0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65,
@@ -14505,66 +15487,74 @@ var file_stratos_evm_v1_query_proto_rawDesc = []byte{
 	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02,
 	0x1e, 0x12, 0x1c, 0x2f, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2f, 0x65, 0x76, 0x6d, 0x2f,
 	0x76, 0x31, 0x2f, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x5f, 0x67, 0x61, 0x73, 0x12,
-	0x76, 0x0a, 0x07, 0x54, 0x72, 0x61, 0x63, 0x65, 0x54, 0x78, 0x12, 0x23, 0x2e, 0x73, 0x74, 0x72,
+	0x88, 0x01, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x4c, 0x69, 0x73, 0x74, 0x12, 0x1e, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e, 0x65,
+	0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x74, 0x68, 0x43, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e, 0x65,
+	0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2a,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x12, 0x22, 0x2f, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73,
+	0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x61,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x76, 0x0a, 0x07, 0x54, 0x72,
+	0x61, 0x63, 0x65, 0x54, 0x78, 0x12, 0x23, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e,
+	0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x72, 0x61, 0x63,
+	0x65, 0x54, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x73, 0x74, 0x72,
 	0x61, 0x74, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x54, 0x72, 0x61, 0x63, 0x65, 0x54, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x24, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31,
-	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x72, 0x61, 0x63, 0x65, 0x54, 0x78, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x12, 0x18, 0x2f,
-	0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x74,
-	0x72, 0x61, 0x63, 0x65, 0x5f, 0x74, 0x78, 0x12, 0x82, 0x01, 0x0a, 0x0a, 0x54, 0x72, 0x61, 0x63,
-	0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x26, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73,
-	0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x72, 0x61,
-	0x63, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27,
-	0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x72, 0x61, 0x63, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x12,
-	0x1b, 0x2f, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x76, 0x31,
-	0x2f, 0x74, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x76, 0x0a, 0x07,
-	0x42, 0x61, 0x73, 0x65, 0x46, 0x65, 0x65, 0x12, 0x23, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f,
+	0x79, 0x54, 0x72, 0x61, 0x63, 0x65, 0x54, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x12, 0x18, 0x2f, 0x73, 0x74, 0x72, 0x61, 0x74,
+	0x6f, 0x73, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x72, 0x61, 0x63, 0x65, 0x5f,
+	0x74, 0x78, 0x12, 0x82, 0x01, 0x0a, 0x0a, 0x54, 0x72, 0x61, 0x63, 0x65, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x12, 0x26, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e,
+	0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x72, 0x61, 0x63, 0x65, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x73, 0x74, 0x72, 0x61,
+	0x74, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x54, 0x72, 0x61, 0x63, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x12, 0x1b, 0x2f, 0x73, 0x74, 0x72,
+	0x61, 0x74, 0x6f, 0x73, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x72, 0x61, 0x63,
+	0x65, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x76, 0x0a, 0x07, 0x42, 0x61, 0x73, 0x65, 0x46,
+	0x65, 0x65, 0x12, 0x23, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d,
+	0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x61, 0x73, 0x65, 0x46, 0x65, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f,
 	0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x61,
-	0x73, 0x65, 0x46, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x73,
-	0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x42, 0x61, 0x73, 0x65, 0x46, 0x65, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x12, 0x18, 0x2f, 0x73, 0x74, 0x72,
-	0x61, 0x74, 0x6f, 0x73, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x61, 0x73, 0x65,
-	0x5f, 0x66, 0x65, 0x65, 0x12, 0x81, 0x01, 0x0a, 0x0c, 0x42, 0x61, 0x73, 0x65, 0x46, 0x65, 0x65,
-	0x50, 0x61, 0x72, 0x61, 0x6d, 0x12, 0x23, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e,
+	0x73, 0x65, 0x46, 0x65, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x20, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x12, 0x18, 0x2f, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2f,
+	0x65, 0x76, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x12,
+	0x81, 0x01, 0x0a, 0x0c, 0x42, 0x61, 0x73, 0x65, 0x46, 0x65, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x12, 0x23, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76,
+	0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x61, 0x73, 0x65, 0x46, 0x65, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e,
 	0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x61, 0x73, 0x65,
-	0x46, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x73, 0x74, 0x72,
-	0x61, 0x74, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x42, 0x61, 0x73, 0x65, 0x46, 0x65, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x12, 0x1e, 0x2f, 0x73, 0x74, 0x72, 0x61, 0x74,
-	0x6f, 0x73, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x66,
-	0x65, 0x65, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x12, 0x7a, 0x0a, 0x08, 0x42, 0x6c, 0x6f, 0x63,
-	0x6b, 0x47, 0x61, 0x73, 0x12, 0x24, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e, 0x65,
-	0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
-	0x47, 0x61, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x73, 0x74, 0x72,
-	0x61, 0x74, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x47, 0x61, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x12, 0x19, 0x2f, 0x73, 0x74, 0x72, 0x61,
-	0x74, 0x6f, 0x73, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
-	0x5f, 0x67, 0x61, 0x73, 0x12, 0x92, 0x01, 0x0a, 0x0e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x12, 0x2a, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f,
-	0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e, 0x65, 0x76,
-	0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x12, 0x1f, 0x2f, 0x73, 0x74, 0x72, 0x61, 0x74,
-	0x6f, 0x73, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x5f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x42, 0xa1, 0x01, 0x0a, 0x12, 0x63, 0x6f,
-	0x6d, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31,
-	0x42, 0x0a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x25,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x76, 0x31, 0x3b,
-	0x65, 0x76, 0x6d, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x53, 0x45, 0x58, 0xaa, 0x02, 0x0e, 0x53, 0x74,
-	0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e, 0x45, 0x76, 0x6d, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0e, 0x53,
-	0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x5c, 0x45, 0x76, 0x6d, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1a,
-	0x53, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x5c, 0x45, 0x76, 0x6d, 0x5c, 0x56, 0x31, 0x5c, 0x47,
-	0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x10, 0x53, 0x74, 0x72,
-	0x61, 0x74, 0x6f, 0x73, 0x3a, 0x3a, 0x45, 0x76, 0x6d, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x46, 0x65, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x26, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x20, 0x12, 0x1e, 0x2f, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2f, 0x65, 0x76,
+	0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x70, 0x61,
+	0x72, 0x61, 0x6d, 0x12, 0x7a, 0x0a, 0x08, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x47, 0x61, 0x73, 0x12,
+	0x24, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x47, 0x61, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e,
+	0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x47, 0x61, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x1b, 0x12, 0x19, 0x2f, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2f, 0x65,
+	0x76, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x67, 0x61, 0x73, 0x12,
+	0x92, 0x01, 0x0a, 0x0e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e, 0x76, 0x65,
+	0x72, 0x74, 0x12, 0x2a, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d,
+	0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b,
+	0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x2e,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6e, 0x76,
+	0x65, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x27, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x21, 0x12, 0x1f, 0x2f, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2f, 0x65, 0x76,
+	0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x6f, 0x6e,
+	0x76, 0x65, 0x72, 0x74, 0x42, 0xa1, 0x01, 0x0a, 0x12, 0x63, 0x6f, 0x6d, 0x2e, 0x73, 0x74, 0x72,
+	0x61, 0x74, 0x6f, 0x73, 0x2e, 0x65, 0x76, 0x6d, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x25, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x73, 0x74, 0x72, 0x61,
+	0x74, 0x6f, 0x73, 0x2f, 0x65, 0x76, 0x6d, 0x2f, 0x76, 0x31, 0x3b, 0x65, 0x76, 0x6d, 0x76, 0x31,
+	0xa2, 0x02, 0x03, 0x53, 0x45, 0x58, 0xaa, 0x02, 0x0e, 0x53, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73,
+	0x2e, 0x45, 0x76, 0x6d, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0e, 0x53, 0x74, 0x72, 0x61, 0x74, 0x6f,
+	0x73, 0x5c, 0x45, 0x76, 0x6d, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1a, 0x53, 0x74, 0x72, 0x61, 0x74,
+	0x6f, 0x73, 0x5c, 0x45, 0x76, 0x6d, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x10, 0x53, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x3a,
+	0x3a, 0x45, 0x76, 0x6d, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -14579,7 +15569,7 @@ func file_stratos_evm_v1_query_proto_rawDescGZIP() []byte {
 	return file_stratos_evm_v1_query_proto_rawDescData
 }
 
-var file_stratos_evm_v1_query_proto_msgTypes = make([]protoimpl.MessageInfo, 28)
+var file_stratos_evm_v1_query_proto_msgTypes = make([]protoimpl.MessageInfo, 29)
 var file_stratos_evm_v1_query_proto_goTypes = []interface{}{
 	(*QueryAccountRequest)(nil),           // 0: stratos.evm.v1.QueryAccountRequest
 	(*QueryAccountResponse)(nil),          // 1: stratos.evm.v1.QueryAccountResponse
@@ -14599,37 +15589,38 @@ var file_stratos_evm_v1_query_proto_goTypes = []interface{}{
 	(*QueryParamsResponse)(nil),           // 15: stratos.evm.v1.QueryParamsResponse
 	(*EthCallRequest)(nil),                // 16: stratos.evm.v1.EthCallRequest
 	(*EstimateGasResponse)(nil),           // 17: stratos.evm.v1.EstimateGasResponse
-	(*QueryTraceTxRequest)(nil),           // 18: stratos.evm.v1.QueryTraceTxRequest
-	(*QueryTraceTxResponse)(nil),          // 19: stratos.evm.v1.QueryTraceTxResponse
-	(*QueryTraceBlockRequest)(nil),        // 20: stratos.evm.v1.QueryTraceBlockRequest
-	(*QueryTraceBlockResponse)(nil),       // 21: stratos.evm.v1.QueryTraceBlockResponse
-	(*QueryBaseFeeRequest)(nil),           // 22: stratos.evm.v1.QueryBaseFeeRequest
-	(*QueryBaseFeeResponse)(nil),          // 23: stratos.evm.v1.QueryBaseFeeResponse
-	(*QueryBlockGasRequest)(nil),          // 24: stratos.evm.v1.QueryBlockGasRequest
-	(*QueryBlockGasResponse)(nil),         // 25: stratos.evm.v1.QueryBlockGasResponse
-	(*QueryAddressConvertRequest)(nil),    // 26: stratos.evm.v1.QueryAddressConvertRequest
-	(*QueryAddressConvertResponse)(nil),   // 27: stratos.evm.v1.QueryAddressConvertResponse
-	(*v1beta1.PageRequest)(nil),           // 28: cosmos.base.query.v1beta1.PageRequest
-	(*Log)(nil),                           // 29: stratos.evm.v1.Log
-	(*v1beta1.PageResponse)(nil),          // 30: cosmos.base.query.v1beta1.PageResponse
-	(*Params)(nil),                        // 31: stratos.evm.v1.Params
-	(*MsgEthereumTx)(nil),                 // 32: stratos.evm.v1.MsgEthereumTx
-	(*TraceConfig)(nil),                   // 33: stratos.evm.v1.TraceConfig
-	(*timestamppb.Timestamp)(nil),         // 34: google.protobuf.Timestamp
-	(*MsgEthereumTxResponse)(nil),         // 35: stratos.evm.v1.MsgEthereumTxResponse
+	(*CreateAccessListResponse)(nil),      // 18: stratos.evm.v1.CreateAccessListResponse
+	(*QueryTraceTxRequest)(nil),           // 19: stratos.evm.v1.QueryTraceTxRequest
+	(*QueryTraceTxResponse)(nil),          // 20: stratos.evm.v1.QueryTraceTxResponse
+	(*QueryTraceBlockRequest)(nil),        // 21: stratos.evm.v1.QueryTraceBlockRequest
+	(*QueryTraceBlockResponse)(nil),       // 22: stratos.evm.v1.QueryTraceBlockResponse
+	(*QueryBaseFeeRequest)(nil),           // 23: stratos.evm.v1.QueryBaseFeeRequest
+	(*QueryBaseFeeResponse)(nil),          // 24: stratos.evm.v1.QueryBaseFeeResponse
+	(*QueryBlockGasRequest)(nil),          // 25: stratos.evm.v1.QueryBlockGasRequest
+	(*QueryBlockGasResponse)(nil),         // 26: stratos.evm.v1.QueryBlockGasResponse
+	(*QueryAddressConvertRequest)(nil),    // 27: stratos.evm.v1.QueryAddressConvertRequest
+	(*QueryAddressConvertResponse)(nil),   // 28: stratos.evm.v1.QueryAddressConvertResponse
+	(*v1beta1.PageRequest)(nil),           // 29: cosmos.base.query.v1beta1.PageRequest
+	(*Log)(nil),                           // 30: stratos.evm.v1.Log
+	(*v1beta1.PageResponse)(nil),          // 31: cosmos.base.query.v1beta1.PageResponse
+	(*Params)(nil),                        // 32: stratos.evm.v1.Params
+	(*MsgEthereumTx)(nil),                 // 33: stratos.evm.v1.MsgEthereumTx
+	(*TraceConfig)(nil),                   // 34: stratos.evm.v1.TraceConfig
+	(*timestamppb.Timestamp)(nil),         // 35: google.protobuf.Timestamp
+	(*MsgEthereumTxResponse)(nil),         // 36: stratos.evm.v1.MsgEthereumTxResponse
 }
 var file_stratos_evm_v1_query_proto_depIdxs = []int32{
-	28, // 0: stratos.evm.v1.QueryTxLogsRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
-	29, // 1: stratos.evm.v1.QueryTxLogsResponse.logs:type_name -> stratos.evm.v1.Log
-	30, // 2: stratos.evm.v1.QueryTxLogsResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
-	31, // 3: stratos.evm.v1.QueryParamsResponse.params:type_name -> stratos.evm.v1.Params
-	32, // 4: stratos.evm.v1.QueryTraceTxRequest.msg:type_name -> stratos.evm.v1.MsgEthereumTx
-	33, // 5: stratos.evm.v1.QueryTraceTxRequest.trace_config:type_name -> stratos.evm.v1.TraceConfig
-	32, // 6: stratos.evm.v1.QueryTraceTxRequest.predecessors:type_name -> stratos.evm.v1.MsgEthereumTx
-	34, // 7: stratos.evm.v1.QueryTraceTxRequest.block_time:type_name -> google.protobuf.Timestamp
-	32, // 8: stratos.evm.v1.QueryTraceBlockRequest.txs:type_name -> stratos.evm.v1.MsgEthereumTx
-	33, // 9: stratos.evm.v1.QueryTraceBlockRequest.trace_config:type_name -> stratos.evm.v1.TraceConfig
-	34, // 10: stratos.evm.v1.QueryTraceBlockRequest.block_time:type_name -> google.protobuf.Timestamp
+	29, // 0: stratos.evm.v1.QueryTxLogsRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	30, // 1: stratos.evm.v1.QueryTxLogsResponse.logs:type_name -> stratos.evm.v1.Log
+	31, // 2: stratos.evm.v1.QueryTxLogsResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	32, // 3: stratos.evm.v1.QueryParamsResponse.params:type_name -> stratos.evm.v1.Params
+	33, // 4: stratos.evm.v1.QueryTraceTxRequest.msg:type_name -> stratos.evm.v1.MsgEthereumTx
+	34, // 5: stratos.evm.v1.QueryTraceTxRequest.trace_config:type_name -> stratos.evm.v1.TraceConfig
+	33, // 6: stratos.evm.v1.QueryTraceTxRequest.predecessors:type_name -> stratos.evm.v1.MsgEthereumTx
+	35, // 7: stratos.evm.v1.QueryTraceTxRequest.block_time:type_name -> google.protobuf.Timestamp
+	33, // 8: stratos.evm.v1.QueryTraceBlockRequest.txs:type_name -> stratos.evm.v1.MsgEthereumTx
+	34, // 9: stratos.evm.v1.QueryTraceBlockRequest.trace_config:type_name -> stratos.evm.v1.TraceConfig
+	35, // 10: stratos.evm.v1.QueryTraceBlockRequest.block_time:type_name -> google.protobuf.Timestamp
 	0,  // 11: stratos.evm.v1.Query.Account:input_type -> stratos.evm.v1.QueryAccountRequest
 	2,  // 12: stratos.evm.v1.Query.CosmosAccount:input_type -> stratos.evm.v1.QueryCosmosAccountRequest
 	4,  // 13: stratos.evm.v1.Query.ValidatorAccount:input_type -> stratos.evm.v1.QueryValidatorAccountRequest
@@ -14639,29 +15630,31 @@ var file_stratos_evm_v1_query_proto_depIdxs = []int32{
 	14, // 17: stratos.evm.v1.Query.Params:input_type -> stratos.evm.v1.QueryParamsRequest
 	16, // 18: stratos.evm.v1.Query.EthCall:input_type -> stratos.evm.v1.EthCallRequest
 	16, // 19: stratos.evm.v1.Query.EstimateGas:input_type -> stratos.evm.v1.EthCallRequest
-	18, // 20: stratos.evm.v1.Query.TraceTx:input_type -> stratos.evm.v1.QueryTraceTxRequest
-	20, // 21: stratos.evm.v1.Query.TraceBlock:input_type -> stratos.evm.v1.QueryTraceBlockRequest
-	22, // 22: stratos.evm.v1.Query.BaseFee:input_type -> stratos.evm.v1.QueryBaseFeeRequest
-	22, // 23: stratos.evm.v1.Query.BaseFeeParam:input_type -> stratos.evm.v1.QueryBaseFeeRequest
-	24, // 24: stratos.evm.v1.Query.BlockGas:input_type -> stratos.evm.v1.QueryBlockGasRequest
-	26, // 25: stratos.evm.v1.Query.AddressConvert:input_type -> stratos.evm.v1.QueryAddressConvertRequest
-	1,  // 26: stratos.evm.v1.Query.Account:output_type -> stratos.evm.v1.QueryAccountResponse
-	3,  // 27: stratos.evm.v1.Query.CosmosAccount:output_type -> stratos.evm.v1.QueryCosmosAccountResponse
-	5,  // 28: stratos.evm.v1.Query.ValidatorAccount:output_type -> stratos.evm.v1.QueryValidatorAccountResponse
-	7,  // 29: stratos.evm.v1.Query.Balance:output_type -> stratos.evm.v1.QueryBalanceResponse
-	9,  // 30: stratos.evm.v1.Query.Storage:output_type -> stratos.evm.v1.QueryStorageResponse
-	11, // 31: stratos.evm.v1.Query.Code:output_type -> stratos.evm.v1.QueryCodeResponse
-	15, // 32: stratos.evm.v1.Query.Params:output_type -> stratos.evm.v1.QueryParamsResponse
-	35, // 33: stratos.evm.v1.Query.EthCall:output_type -> stratos.evm.v1.MsgEthereumTxResponse
-	17, // 34: stratos.evm.v1.Query.EstimateGas:output_type -> stratos.evm.v1.EstimateGasResponse
-	19, // 35: stratos.evm.v1.Query.TraceTx:output_type -> stratos.evm.v1.QueryTraceTxResponse
-	21, // 36: stratos.evm.v1.Query.TraceBlock:output_type -> stratos.evm.v1.QueryTraceBlockResponse
-	23, // 37: stratos.evm.v1.Query.BaseFee:output_type -> stratos.evm.v1.QueryBaseFeeResponse
-	23, // 38: stratos.evm.v1.Query.BaseFeeParam:output_type -> stratos.evm.v1.QueryBaseFeeResponse
-	25, // 39: stratos.evm.v1.Query.BlockGas:output_type -> stratos.evm.v1.QueryBlockGasResponse
-	27, // 40: stratos.evm.v1.Query.AddressConvert:output_type -> stratos.evm.v1.QueryAddressConvertResponse
-	26, // [26:41] is the sub-list for method output_type
-	11, // [11:26] is the sub-list for method input_type
+	16, // 20: stratos.evm.v1.Query.CreateAccessList:input_type -> stratos.evm.v1.EthCallRequest
+	19, // 21: stratos.evm.v1.Query.TraceTx:input_type -> stratos.evm.v1.QueryTraceTxRequest
+	21, // 22: stratos.evm.v1.Query.TraceBlock:input_type -> stratos.evm.v1.QueryTraceBlockRequest
+	23, // 23: stratos.evm.v1.Query.BaseFee:input_type -> stratos.evm.v1.QueryBaseFeeRequest
+	23, // 24: stratos.evm.v1.Query.BaseFeeParam:input_type -> stratos.evm.v1.QueryBaseFeeRequest
+	25, // 25: stratos.evm.v1.Query.BlockGas:input_type -> stratos.evm.v1.QueryBlockGasRequest
+	27, // 26: stratos.evm.v1.Query.AddressConvert:input_type -> stratos.evm.v1.QueryAddressConvertRequest
+	1,  // 27: stratos.evm.v1.Query.Account:output_type -> stratos.evm.v1.QueryAccountResponse
+	3,  // 28: stratos.evm.v1.Query.CosmosAccount:output_type -> stratos.evm.v1.QueryCosmosAccountResponse
+	5,  // 29: stratos.evm.v1.Query.ValidatorAccount:output_type -> stratos.evm.v1.QueryValidatorAccountResponse
+	7,  // 30: stratos.evm.v1.Query.Balance:output_type -> stratos.evm.v1.QueryBalanceResponse
+	9,  // 31: stratos.evm.v1.Query.Storage:output_type -> stratos.evm.v1.QueryStorageResponse
+	11, // 32: stratos.evm.v1.Query.Code:output_type -> stratos.evm.v1.QueryCodeResponse
+	15, // 33: stratos.evm.v1.Query.Params:output_type -> stratos.evm.v1.QueryParamsResponse
+	36, // 34: stratos.evm.v1.Query.EthCall:output_type -> stratos.evm.v1.MsgEthereumTxResponse
+	17, // 35: stratos.evm.v1.Query.EstimateGas:output_type -> stratos.evm.v1.EstimateGasResponse
+	18, // 36: stratos.evm.v1.Query.CreateAccessList:output_type -> stratos.evm.v1.CreateAccessListResponse
+	20, // 37: stratos.evm.v1.Query.TraceTx:output_type -> stratos.evm.v1.QueryTraceTxResponse
+	22, // 38: stratos.evm.v1.Query.TraceBlock:output_type -> stratos.evm.v1.QueryTraceBlockResponse
+	24, // 39: stratos.evm.v1.Query.BaseFee:output_type -> stratos.evm.v1.QueryBaseFeeResponse
+	24, // 40: stratos.evm.v1.Query.BaseFeeParam:output_type -> stratos.evm.v1.QueryBaseFeeResponse
+	26, // 41: stratos.evm.v1.Query.BlockGas:output_type -> stratos.evm.v1.QueryBlockGasResponse
+	28, // 42: stratos.evm.v1.Query.AddressConvert:output_type -> stratos.evm.v1.QueryAddressConvertResponse
+	27, // [27:43] is the sub-list for method output_type
+	11, // [11:27] is the sub-list for method input_type
 	11, // [11:11] is the sub-list for extension type_name
 	11, // [11:11] is the sub-list for extension extendee
 	0,  // [0:11] is the sub-list for field type_name
@@ -14892,7 +15885,7 @@ func file_stratos_evm_v1_query_proto_init() {
 			}
 		}
 		file_stratos_evm_v1_query_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryTraceTxRequest); i {
+			switch v := v.(*CreateAccessListResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14904,7 +15897,7 @@ func file_stratos_evm_v1_query_proto_init() {
 			}
 		}
 		file_stratos_evm_v1_query_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryTraceTxResponse); i {
+			switch v := v.(*QueryTraceTxRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14916,7 +15909,7 @@ func file_stratos_evm_v1_query_proto_init() {
 			}
 		}
 		file_stratos_evm_v1_query_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryTraceBlockRequest); i {
+			switch v := v.(*QueryTraceTxResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14928,7 +15921,7 @@ func file_stratos_evm_v1_query_proto_init() {
 			}
 		}
 		file_stratos_evm_v1_query_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryTraceBlockResponse); i {
+			switch v := v.(*QueryTraceBlockRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14940,7 +15933,7 @@ func file_stratos_evm_v1_query_proto_init() {
 			}
 		}
 		file_stratos_evm_v1_query_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryBaseFeeRequest); i {
+			switch v := v.(*QueryTraceBlockResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14952,7 +15945,7 @@ func file_stratos_evm_v1_query_proto_init() {
 			}
 		}
 		file_stratos_evm_v1_query_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryBaseFeeResponse); i {
+			switch v := v.(*QueryBaseFeeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14964,7 +15957,7 @@ func file_stratos_evm_v1_query_proto_init() {
 			}
 		}
 		file_stratos_evm_v1_query_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryBlockGasRequest); i {
+			switch v := v.(*QueryBaseFeeResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14976,7 +15969,7 @@ func file_stratos_evm_v1_query_proto_init() {
 			}
 		}
 		file_stratos_evm_v1_query_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryBlockGasResponse); i {
+			switch v := v.(*QueryBlockGasRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -14988,7 +15981,7 @@ func file_stratos_evm_v1_query_proto_init() {
 			}
 		}
 		file_stratos_evm_v1_query_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryAddressConvertRequest); i {
+			switch v := v.(*QueryBlockGasResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15000,6 +15993,18 @@ func file_stratos_evm_v1_query_proto_init() {
 			}
 		}
 		file_stratos_evm_v1_query_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryAddressConvertRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stratos_evm_v1_query_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*QueryAddressConvertResponse); i {
 			case 0:
 				return &v.state
@@ -15018,7 +16023,7 @@ func file_stratos_evm_v1_query_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_stratos_evm_v1_query_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   28,
+			NumMessages:   29,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
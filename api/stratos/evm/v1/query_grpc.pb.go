@@ -28,6 +28,7 @@ const (
 	Query_Params_FullMethodName           = "/stratos.evm.v1.Query/Params"
 	Query_EthCall_FullMethodName          = "/stratos.evm.v1.Query/EthCall"
 	Query_EstimateGas_FullMethodName      = "/stratos.evm.v1.Query/EstimateGas"
+	Query_CreateAccessList_FullMethodName = "/stratos.evm.v1.Query/CreateAccessList"
 	Query_TraceTx_FullMethodName          = "/stratos.evm.v1.Query/TraceTx"
 	Query_TraceBlock_FullMethodName       = "/stratos.evm.v1.Query/TraceBlock"
 	Query_BaseFee_FullMethodName          = "/stratos.evm.v1.Query/BaseFee"
@@ -60,6 +61,8 @@ type QueryClient interface {
 	EthCall(ctx context.Context, in *EthCallRequest, opts ...grpc.CallOption) (*MsgEthereumTxResponse, error)
 	// EstimateGas implements the `eth_estimateGas` rpc api
 	EstimateGas(ctx context.Context, in *EthCallRequest, opts ...grpc.CallOption) (*EstimateGasResponse, error)
+	// CreateAccessList implements the `eth_createAccessList` rpc api
+	CreateAccessList(ctx context.Context, in *EthCallRequest, opts ...grpc.CallOption) (*CreateAccessListResponse, error)
 	// TraceTx implements the `debug_traceTransaction` rpc api
 	TraceTx(ctx context.Context, in *QueryTraceTxRequest, opts ...grpc.CallOption) (*QueryTraceTxResponse, error)
 	// TraceBlock implements the `debug_traceBlockByNumber` and `debug_traceBlockByHash` rpc api
@@ -163,6 +166,15 @@ func (c *queryClient) EstimateGas(ctx context.Context, in *EthCallRequest, opts
 	return out, nil
 }
 
+func (c *queryClient) CreateAccessList(ctx context.Context, in *EthCallRequest, opts ...grpc.CallOption) (*CreateAccessListResponse, error) {
+	out := new(CreateAccessListResponse)
+	err := c.cc.Invoke(ctx, Query_CreateAccessList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) TraceTx(ctx context.Context, in *QueryTraceTxRequest, opts ...grpc.CallOption) (*QueryTraceTxResponse, error) {
 	out := new(QueryTraceTxResponse)
 	err := c.cc.Invoke(ctx, Query_TraceTx_FullMethodName, in, out, opts...)
@@ -241,6 +253,8 @@ type QueryServer interface {
 	EthCall(context.Context, *EthCallRequest) (*MsgEthereumTxResponse, error)
 	// EstimateGas implements the `eth_estimateGas` rpc api
 	EstimateGas(context.Context, *EthCallRequest) (*EstimateGasResponse, error)
+	// CreateAccessList implements the `eth_createAccessList` rpc api
+	CreateAccessList(context.Context, *EthCallRequest) (*CreateAccessListResponse, error)
 	// TraceTx implements the `debug_traceTransaction` rpc api
 	TraceTx(context.Context, *QueryTraceTxRequest) (*QueryTraceTxResponse, error)
 	// TraceBlock implements the `debug_traceBlockByNumber` and `debug_traceBlockByHash` rpc api
@@ -287,6 +301,9 @@ func (UnimplementedQueryServer) EthCall(context.Context, *EthCallRequest) (*MsgE
 func (UnimplementedQueryServer) EstimateGas(context.Context, *EthCallRequest) (*EstimateGasResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EstimateGas not implemented")
 }
+func (UnimplementedQueryServer) CreateAccessList(context.Context, *EthCallRequest) (*CreateAccessListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAccessList not implemented")
+}
 func (UnimplementedQueryServer) TraceTx(context.Context, *QueryTraceTxRequest) (*QueryTraceTxResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TraceTx not implemented")
 }
@@ -480,6 +497,24 @@ func _Query_EstimateGas_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_CreateAccessList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EthCallRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CreateAccessList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_CreateAccessList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CreateAccessList(ctx, req.(*EthCallRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_TraceTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryTraceTxRequest)
 	if err := dec(in); err != nil {
@@ -631,6 +666,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "EstimateGas",
 			Handler:    _Query_EstimateGas_Handler,
 		},
+		{
+			MethodName: "CreateAccessList",
+			Handler:    _Query_CreateAccessList_Handler,
+		},
 		{
 			MethodName: "TraceTx",
 			Handler:    _Query_TraceTx_Handler,
@@ -2140,215 +2140,5897 @@ func (x *fastReflection_EventSlashing) ProtoMethods() *protoiface.Methods {
 	}
 }
 
+var (
+	md_EventVolumeReportHeader                  protoreflect.MessageDescriptor
+	fd_EventVolumeReportHeader_report_reference protoreflect.FieldDescriptor
+	fd_EventVolumeReportHeader_epoch            protoreflect.FieldDescriptor
+	fd_EventVolumeReportHeader_total_count      protoreflect.FieldDescriptor
+	fd_EventVolumeReportHeader_deadline_height  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_stratos_pot_v1_event_proto_init()
+	md_EventVolumeReportHeader = File_stratos_pot_v1_event_proto.Messages().ByName("EventVolumeReportHeader")
+	fd_EventVolumeReportHeader_report_reference = md_EventVolumeReportHeader.Fields().ByName("report_reference")
+	fd_EventVolumeReportHeader_epoch = md_EventVolumeReportHeader.Fields().ByName("epoch")
+	fd_EventVolumeReportHeader_total_count = md_EventVolumeReportHeader.Fields().ByName("total_count")
+	fd_EventVolumeReportHeader_deadline_height = md_EventVolumeReportHeader.Fields().ByName("deadline_height")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventVolumeReportHeader)(nil)
+
+type fastReflection_EventVolumeReportHeader EventVolumeReportHeader
+
+func (x *EventVolumeReportHeader) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventVolumeReportHeader)(x)
+}
+
+func (x *EventVolumeReportHeader) slowProtoReflect() protoreflect.Message {
+	mi := &file_stratos_pot_v1_event_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventVolumeReportHeader_messageType fastReflection_EventVolumeReportHeader_messageType
+var _ protoreflect.MessageType = fastReflection_EventVolumeReportHeader_messageType{}
+
+type fastReflection_EventVolumeReportHeader_messageType struct{}
+
+func (x fastReflection_EventVolumeReportHeader_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventVolumeReportHeader)(nil)
+}
+func (x fastReflection_EventVolumeReportHeader_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventVolumeReportHeader)
+}
+func (x fastReflection_EventVolumeReportHeader_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventVolumeReportHeader
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventVolumeReportHeader) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventVolumeReportHeader
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventVolumeReportHeader) Type() protoreflect.MessageType {
+	return _fastReflection_EventVolumeReportHeader_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventVolumeReportHeader) New() protoreflect.Message {
+	return new(fastReflection_EventVolumeReportHeader)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventVolumeReportHeader) Interface() protoreflect.ProtoMessage {
+	return (*EventVolumeReportHeader)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventVolumeReportHeader) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ReportReference != "" {
+		value := protoreflect.ValueOfString(x.ReportReference)
+		if !f(fd_EventVolumeReportHeader_report_reference, value) {
+			return
+		}
+	}
+	if x.Epoch != "" {
+		value := protoreflect.ValueOfString(x.Epoch)
+		if !f(fd_EventVolumeReportHeader_epoch, value) {
+			return
+		}
+	}
+	if x.TotalCount != "" {
+		value := protoreflect.ValueOfString(x.TotalCount)
+		if !f(fd_EventVolumeReportHeader_total_count, value) {
+			return
+		}
+	}
+	if x.DeadlineHeight != "" {
+		value := protoreflect.ValueOfString(x.DeadlineHeight)
+		if !f(fd_EventVolumeReportHeader_deadline_height, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventVolumeReportHeader) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "stratos.pot.v1.EventVolumeReportHeader.report_reference":
+		return x.ReportReference != ""
+	case "stratos.pot.v1.EventVolumeReportHeader.epoch":
+		return x.Epoch != ""
+	case "stratos.pot.v1.EventVolumeReportHeader.total_count":
+		return x.TotalCount != ""
+	case "stratos.pot.v1.EventVolumeReportHeader.deadline_height":
+		return x.DeadlineHeight != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.EventVolumeReportHeader"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.EventVolumeReportHeader does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventVolumeReportHeader) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "stratos.pot.v1.EventVolumeReportHeader.report_reference":
+		x.ReportReference = ""
+	case "stratos.pot.v1.EventVolumeReportHeader.epoch":
+		x.Epoch = ""
+	case "stratos.pot.v1.EventVolumeReportHeader.total_count":
+		x.TotalCount = ""
+	case "stratos.pot.v1.EventVolumeReportHeader.deadline_height":
+		x.DeadlineHeight = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.EventVolumeReportHeader"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.EventVolumeReportHeader does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventVolumeReportHeader) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "stratos.pot.v1.EventVolumeReportHeader.report_reference":
+		value := x.ReportReference
+		return protoreflect.ValueOfString(value)
+	case "stratos.pot.v1.EventVolumeReportHeader.epoch":
+		value := x.Epoch
+		return protoreflect.ValueOfString(value)
+	case "stratos.pot.v1.EventVolumeReportHeader.total_count":
+		value := x.TotalCount
+		return protoreflect.ValueOfString(value)
+	case "stratos.pot.v1.EventVolumeReportHeader.deadline_height":
+		value := x.DeadlineHeight
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.EventVolumeReportHeader"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.EventVolumeReportHeader does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventVolumeReportHeader) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "stratos.pot.v1.EventVolumeReportHeader.report_reference":
+		x.ReportReference = value.Interface().(string)
+	case "stratos.pot.v1.EventVolumeReportHeader.epoch":
+		x.Epoch = value.Interface().(string)
+	case "stratos.pot.v1.EventVolumeReportHeader.total_count":
+		x.TotalCount = value.Interface().(string)
+	case "stratos.pot.v1.EventVolumeReportHeader.deadline_height":
+		x.DeadlineHeight = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.EventVolumeReportHeader"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.EventVolumeReportHeader does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventVolumeReportHeader) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "stratos.pot.v1.EventVolumeReportHeader.report_reference":
+		panic(fmt.Errorf("field report_reference of message stratos.pot.v1.EventVolumeReportHeader is not mutable"))
+	case "stratos.pot.v1.EventVolumeReportHeader.epoch":
+		panic(fmt.Errorf("field epoch of message stratos.pot.v1.EventVolumeReportHeader is not mutable"))
+	case "stratos.pot.v1.EventVolumeReportHeader.total_count":
+		panic(fmt.Errorf("field total_count of message stratos.pot.v1.EventVolumeReportHeader is not mutable"))
+	case "stratos.pot.v1.EventVolumeReportHeader.deadline_height":
+		panic(fmt.Errorf("field deadline_height of message stratos.pot.v1.EventVolumeReportHeader is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.EventVolumeReportHeader"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.EventVolumeReportHeader does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventVolumeReportHeader) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "stratos.pot.v1.EventVolumeReportHeader.report_reference":
+		return protoreflect.ValueOfString("")
+	case "stratos.pot.v1.EventVolumeReportHeader.epoch":
+		return protoreflect.ValueOfString("")
+	case "stratos.pot.v1.EventVolumeReportHeader.total_count":
+		return protoreflect.ValueOfString("")
+	case "stratos.pot.v1.EventVolumeReportHeader.deadline_height":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.EventVolumeReportHeader"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.EventVolumeReportHeader does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventVolumeReportHeader) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in stratos.pot.v1.EventVolumeReportHeader", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventVolumeReportHeader) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventVolumeReportHeader) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventVolumeReportHeader) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventVolumeReportHeader) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventVolumeReportHeader)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.ReportReference)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Epoch)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.TotalCount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.DeadlineHeight)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventVolumeReportHeader)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.DeadlineHeight) > 0 {
+			i -= len(x.DeadlineHeight)
+			copy(dAtA[i:], x.DeadlineHeight)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DeadlineHeight)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.TotalCount) > 0 {
+			i -= len(x.TotalCount)
+			copy(dAtA[i:], x.TotalCount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TotalCount)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Epoch) > 0 {
+			i -= len(x.Epoch)
+			copy(dAtA[i:], x.Epoch)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Epoch)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.ReportReference) > 0 {
+			i -= len(x.ReportReference)
+			copy(dAtA[i:], x.ReportReference)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ReportReference)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventVolumeReportHeader)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventVolumeReportHeader: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventVolumeReportHeader: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReportReference", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ReportReference = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Epoch = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalCount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TotalCount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DeadlineHeight", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DeadlineHeight = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EventVolumeReportChunk             protoreflect.MessageDescriptor
+	fd_EventVolumeReportChunk_epoch       protoreflect.FieldDescriptor
+	fd_EventVolumeReportChunk_start_index protoreflect.FieldDescriptor
+	fd_EventVolumeReportChunk_count       protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_stratos_pot_v1_event_proto_init()
+	md_EventVolumeReportChunk = File_stratos_pot_v1_event_proto.Messages().ByName("EventVolumeReportChunk")
+	fd_EventVolumeReportChunk_epoch = md_EventVolumeReportChunk.Fields().ByName("epoch")
+	fd_EventVolumeReportChunk_start_index = md_EventVolumeReportChunk.Fields().ByName("start_index")
+	fd_EventVolumeReportChunk_count = md_EventVolumeReportChunk.Fields().ByName("count")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventVolumeReportChunk)(nil)
+
+type fastReflection_EventVolumeReportChunk EventVolumeReportChunk
+
+func (x *EventVolumeReportChunk) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventVolumeReportChunk)(x)
+}
+
+func (x *EventVolumeReportChunk) slowProtoReflect() protoreflect.Message {
+	mi := &file_stratos_pot_v1_event_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventVolumeReportChunk_messageType fastReflection_EventVolumeReportChunk_messageType
+var _ protoreflect.MessageType = fastReflection_EventVolumeReportChunk_messageType{}
+
+type fastReflection_EventVolumeReportChunk_messageType struct{}
+
+func (x fastReflection_EventVolumeReportChunk_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventVolumeReportChunk)(nil)
+}
+func (x fastReflection_EventVolumeReportChunk_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventVolumeReportChunk)
+}
+func (x fastReflection_EventVolumeReportChunk_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventVolumeReportChunk
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventVolumeReportChunk) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventVolumeReportChunk
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventVolumeReportChunk) Type() protoreflect.MessageType {
+	return _fastReflection_EventVolumeReportChunk_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventVolumeReportChunk) New() protoreflect.Message {
+	return new(fastReflection_EventVolumeReportChunk)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventVolumeReportChunk) Interface() protoreflect.ProtoMessage {
+	return (*EventVolumeReportChunk)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventVolumeReportChunk) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Epoch != "" {
+		value := protoreflect.ValueOfString(x.Epoch)
+		if !f(fd_EventVolumeReportChunk_epoch, value) {
+			return
+		}
+	}
+	if x.StartIndex != "" {
+		value := protoreflect.ValueOfString(x.StartIndex)
+		if !f(fd_EventVolumeReportChunk_start_index, value) {
+			return
+		}
+	}
+	if x.Count != "" {
+		value := protoreflect.ValueOfString(x.Count)
+		if !f(fd_EventVolumeReportChunk_count, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventVolumeReportChunk) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "stratos.pot.v1.EventVolumeReportChunk.epoch":
+		return x.Epoch != ""
+	case "stratos.pot.v1.EventVolumeReportChunk.start_index":
+		return x.StartIndex != ""
+	case "stratos.pot.v1.EventVolumeReportChunk.count":
+		return x.Count != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.EventVolumeReportChunk"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.EventVolumeReportChunk does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventVolumeReportChunk) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "stratos.pot.v1.EventVolumeReportChunk.epoch":
+		x.Epoch = ""
+	case "stratos.pot.v1.EventVolumeReportChunk.start_index":
+		x.StartIndex = ""
+	case "stratos.pot.v1.EventVolumeReportChunk.count":
+		x.Count = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.EventVolumeReportChunk"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.EventVolumeReportChunk does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventVolumeReportChunk) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "stratos.pot.v1.EventVolumeReportChunk.epoch":
+		value := x.Epoch
+		return protoreflect.ValueOfString(value)
+	case "stratos.pot.v1.EventVolumeReportChunk.start_index":
+		value := x.StartIndex
+		return protoreflect.ValueOfString(value)
+	case "stratos.pot.v1.EventVolumeReportChunk.count":
+		value := x.Count
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.EventVolumeReportChunk"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.EventVolumeReportChunk does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventVolumeReportChunk) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "stratos.pot.v1.EventVolumeReportChunk.epoch":
+		x.Epoch = value.Interface().(string)
+	case "stratos.pot.v1.EventVolumeReportChunk.start_index":
+		x.StartIndex = value.Interface().(string)
+	case "stratos.pot.v1.EventVolumeReportChunk.count":
+		x.Count = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.EventVolumeReportChunk"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.EventVolumeReportChunk does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventVolumeReportChunk) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "stratos.pot.v1.EventVolumeReportChunk.epoch":
+		panic(fmt.Errorf("field epoch of message stratos.pot.v1.EventVolumeReportChunk is not mutable"))
+	case "stratos.pot.v1.EventVolumeReportChunk.start_index":
+		panic(fmt.Errorf("field start_index of message stratos.pot.v1.EventVolumeReportChunk is not mutable"))
+	case "stratos.pot.v1.EventVolumeReportChunk.count":
+		panic(fmt.Errorf("field count of message stratos.pot.v1.EventVolumeReportChunk is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.EventVolumeReportChunk"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.EventVolumeReportChunk does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventVolumeReportChunk) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "stratos.pot.v1.EventVolumeReportChunk.epoch":
+		return protoreflect.ValueOfString("")
+	case "stratos.pot.v1.EventVolumeReportChunk.start_index":
+		return protoreflect.ValueOfString("")
+	case "stratos.pot.v1.EventVolumeReportChunk.count":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.EventVolumeReportChunk"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.EventVolumeReportChunk does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventVolumeReportChunk) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in stratos.pot.v1.EventVolumeReportChunk", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventVolumeReportChunk) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventVolumeReportChunk) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventVolumeReportChunk) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventVolumeReportChunk) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventVolumeReportChunk)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Epoch)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.StartIndex)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Count)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventVolumeReportChunk)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Count) > 0 {
+			i -= len(x.Count)
+			copy(dAtA[i:], x.Count)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Count)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.StartIndex) > 0 {
+			i -= len(x.StartIndex)
+			copy(dAtA[i:], x.StartIndex)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.StartIndex)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Epoch) > 0 {
+			i -= len(x.Epoch)
+			copy(dAtA[i:], x.Epoch)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Epoch)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventVolumeReportChunk)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventVolumeReportChunk: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventVolumeReportChunk: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Epoch = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StartIndex", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.StartIndex = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Count = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EventVolumeReportExpired                  protoreflect.MessageDescriptor
+	fd_EventVolumeReportExpired_report_reference protoreflect.FieldDescriptor
+	fd_EventVolumeReportExpired_epoch            protoreflect.FieldDescriptor
+	fd_EventVolumeReportExpired_received_count   protoreflect.FieldDescriptor
+	fd_EventVolumeReportExpired_total_count      protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_stratos_pot_v1_event_proto_init()
+	md_EventVolumeReportExpired = File_stratos_pot_v1_event_proto.Messages().ByName("EventVolumeReportExpired")
+	fd_EventVolumeReportExpired_report_reference = md_EventVolumeReportExpired.Fields().ByName("report_reference")
+	fd_EventVolumeReportExpired_epoch = md_EventVolumeReportExpired.Fields().ByName("epoch")
+	fd_EventVolumeReportExpired_received_count = md_EventVolumeReportExpired.Fields().ByName("received_count")
+	fd_EventVolumeReportExpired_total_count = md_EventVolumeReportExpired.Fields().ByName("total_count")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventVolumeReportExpired)(nil)
+
+type fastReflection_EventVolumeReportExpired EventVolumeReportExpired
+
+func (x *EventVolumeReportExpired) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventVolumeReportExpired)(x)
+}
+
+func (x *EventVolumeReportExpired) slowProtoReflect() protoreflect.Message {
+	mi := &file_stratos_pot_v1_event_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventVolumeReportExpired_messageType fastReflection_EventVolumeReportExpired_messageType
+var _ protoreflect.MessageType = fastReflection_EventVolumeReportExpired_messageType{}
+
+type fastReflection_EventVolumeReportExpired_messageType struct{}
+
+func (x fastReflection_EventVolumeReportExpired_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventVolumeReportExpired)(nil)
+}
+func (x fastReflection_EventVolumeReportExpired_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventVolumeReportExpired)
+}
+func (x fastReflection_EventVolumeReportExpired_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventVolumeReportExpired
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventVolumeReportExpired) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventVolumeReportExpired
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventVolumeReportExpired) Type() protoreflect.MessageType {
+	return _fastReflection_EventVolumeReportExpired_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventVolumeReportExpired) New() protoreflect.Message {
+	return new(fastReflection_EventVolumeReportExpired)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventVolumeReportExpired) Interface() protoreflect.ProtoMessage {
+	return (*EventVolumeReportExpired)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventVolumeReportExpired) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ReportReference != "" {
+		value := protoreflect.ValueOfString(x.ReportReference)
+		if !f(fd_EventVolumeReportExpired_report_reference, value) {
+			return
+		}
+	}
+	if x.Epoch != "" {
+		value := protoreflect.ValueOfString(x.Epoch)
+		if !f(fd_EventVolumeReportExpired_epoch, value) {
+			return
+		}
+	}
+	if x.ReceivedCount != "" {
+		value := protoreflect.ValueOfString(x.ReceivedCount)
+		if !f(fd_EventVolumeReportExpired_received_count, value) {
+			return
+		}
+	}
+	if x.TotalCount != "" {
+		value := protoreflect.ValueOfString(x.TotalCount)
+		if !f(fd_EventVolumeReportExpired_total_count, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventVolumeReportExpired) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "stratos.pot.v1.EventVolumeReportExpired.report_reference":
+		return x.ReportReference != ""
+	case "stratos.pot.v1.EventVolumeReportExpired.epoch":
+		return x.Epoch != ""
+	case "stratos.pot.v1.EventVolumeReportExpired.received_count":
+		return x.ReceivedCount != ""
+	case "stratos.pot.v1.EventVolumeReportExpired.total_count":
+		return x.TotalCount != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.EventVolumeReportExpired"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.EventVolumeReportExpired does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventVolumeReportExpired) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "stratos.pot.v1.EventVolumeReportExpired.report_reference":
+		x.ReportReference = ""
+	case "stratos.pot.v1.EventVolumeReportExpired.epoch":
+		x.Epoch = ""
+	case "stratos.pot.v1.EventVolumeReportExpired.received_count":
+		x.ReceivedCount = ""
+	case "stratos.pot.v1.EventVolumeReportExpired.total_count":
+		x.TotalCount = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.EventVolumeReportExpired"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.EventVolumeReportExpired does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventVolumeReportExpired) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "stratos.pot.v1.EventVolumeReportExpired.report_reference":
+		value := x.ReportReference
+		return protoreflect.ValueOfString(value)
+	case "stratos.pot.v1.EventVolumeReportExpired.epoch":
+		value := x.Epoch
+		return protoreflect.ValueOfString(value)
+	case "stratos.pot.v1.EventVolumeReportExpired.received_count":
+		value := x.ReceivedCount
+		return protoreflect.ValueOfString(value)
+	case "stratos.pot.v1.EventVolumeReportExpired.total_count":
+		value := x.TotalCount
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.EventVolumeReportExpired"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.EventVolumeReportExpired does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventVolumeReportExpired) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "stratos.pot.v1.EventVolumeReportExpired.report_reference":
+		x.ReportReference = value.Interface().(string)
+	case "stratos.pot.v1.EventVolumeReportExpired.epoch":
+		x.Epoch = value.Interface().(string)
+	case "stratos.pot.v1.EventVolumeReportExpired.received_count":
+		x.ReceivedCount = value.Interface().(string)
+	case "stratos.pot.v1.EventVolumeReportExpired.total_count":
+		x.TotalCount = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.EventVolumeReportExpired"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.EventVolumeReportExpired does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventVolumeReportExpired) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "stratos.pot.v1.EventVolumeReportExpired.report_reference":
+		panic(fmt.Errorf("field report_reference of message stratos.pot.v1.EventVolumeReportExpired is not mutable"))
+	case "stratos.pot.v1.EventVolumeReportExpired.epoch":
+		panic(fmt.Errorf("field epoch of message stratos.pot.v1.EventVolumeReportExpired is not mutable"))
+	case "stratos.pot.v1.EventVolumeReportExpired.received_count":
+		panic(fmt.Errorf("field received_count of message stratos.pot.v1.EventVolumeReportExpired is not mutable"))
+	case "stratos.pot.v1.EventVolumeReportExpired.total_count":
+		panic(fmt.Errorf("field total_count of message stratos.pot.v1.EventVolumeReportExpired is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.EventVolumeReportExpired"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.EventVolumeReportExpired does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventVolumeReportExpired) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "stratos.pot.v1.EventVolumeReportExpired.report_reference":
+		return protoreflect.ValueOfString("")
+	case "stratos.pot.v1.EventVolumeReportExpired.epoch":
+		return protoreflect.ValueOfString("")
+	case "stratos.pot.v1.EventVolumeReportExpired.received_count":
+		return protoreflect.ValueOfString("")
+	case "stratos.pot.v1.EventVolumeReportExpired.total_count":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.EventVolumeReportExpired"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.EventVolumeReportExpired does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventVolumeReportExpired) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in stratos.pot.v1.EventVolumeReportExpired", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventVolumeReportExpired) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventVolumeReportExpired) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventVolumeReportExpired) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventVolumeReportExpired) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventVolumeReportExpired)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.ReportReference)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Epoch)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ReceivedCount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.TotalCount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventVolumeReportExpired)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.TotalCount) > 0 {
+			i -= len(x.TotalCount)
+			copy(dAtA[i:], x.TotalCount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TotalCount)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.ReceivedCount) > 0 {
+			i -= len(x.ReceivedCount)
+			copy(dAtA[i:], x.ReceivedCount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ReceivedCount)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Epoch) > 0 {
+			i -= len(x.Epoch)
+			copy(dAtA[i:], x.Epoch)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Epoch)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.ReportReference) > 0 {
+			i -= len(x.ReportReference)
+			copy(dAtA[i:], x.ReportReference)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ReportReference)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventVolumeReportExpired)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventVolumeReportExpired: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventVolumeReportExpired: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReportReference", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ReportReference = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Epoch = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReceivedCount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ReceivedCount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalCount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TotalCount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EventDisputeVolumeReport             protoreflect.MessageDescriptor
+	fd_EventDisputeVolumeReport_epoch       protoreflect.FieldDescriptor
+	fd_EventDisputeVolumeReport_disputer    protoreflect.FieldDescriptor
+	fd_EventDisputeVolumeReport_reason      protoreflect.FieldDescriptor
+	fd_EventDisputeVolumeReport_proposal_id protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_stratos_pot_v1_event_proto_init()
+	md_EventDisputeVolumeReport = File_stratos_pot_v1_event_proto.Messages().ByName("EventDisputeVolumeReport")
+	fd_EventDisputeVolumeReport_epoch = md_EventDisputeVolumeReport.Fields().ByName("epoch")
+	fd_EventDisputeVolumeReport_disputer = md_EventDisputeVolumeReport.Fields().ByName("disputer")
+	fd_EventDisputeVolumeReport_reason = md_EventDisputeVolumeReport.Fields().ByName("reason")
+	fd_EventDisputeVolumeReport_proposal_id = md_EventDisputeVolumeReport.Fields().ByName("proposal_id")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventDisputeVolumeReport)(nil)
+
+type fastReflection_EventDisputeVolumeReport EventDisputeVolumeReport
+
+func (x *EventDisputeVolumeReport) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventDisputeVolumeReport)(x)
+}
+
+func (x *EventDisputeVolumeReport) slowProtoReflect() protoreflect.Message {
+	mi := &file_stratos_pot_v1_event_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventDisputeVolumeReport_messageType fastReflection_EventDisputeVolumeReport_messageType
+var _ protoreflect.MessageType = fastReflection_EventDisputeVolumeReport_messageType{}
+
+type fastReflection_EventDisputeVolumeReport_messageType struct{}
+
+func (x fastReflection_EventDisputeVolumeReport_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventDisputeVolumeReport)(nil)
+}
+func (x fastReflection_EventDisputeVolumeReport_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventDisputeVolumeReport)
+}
+func (x fastReflection_EventDisputeVolumeReport_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventDisputeVolumeReport
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventDisputeVolumeReport) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventDisputeVolumeReport
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventDisputeVolumeReport) Type() protoreflect.MessageType {
+	return _fastReflection_EventDisputeVolumeReport_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventDisputeVolumeReport) New() protoreflect.Message {
+	return new(fastReflection_EventDisputeVolumeReport)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventDisputeVolumeReport) Interface() protoreflect.ProtoMessage {
+	return (*EventDisputeVolumeReport)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventDisputeVolumeReport) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Epoch != "" {
+		value := protoreflect.ValueOfString(x.Epoch)
+		if !f(fd_EventDisputeVolumeReport_epoch, value) {
+			return
+		}
+	}
+	if x.Disputer != "" {
+		value := protoreflect.ValueOfString(x.Disputer)
+		if !f(fd_EventDisputeVolumeReport_disputer, value) {
+			return
+		}
+	}
+	if x.Reason != "" {
+		value := protoreflect.ValueOfString(x.Reason)
+		if !f(fd_EventDisputeVolumeReport_reason, value) {
+			return
+		}
+	}
+	if x.ProposalId != "" {
+		value := protoreflect.ValueOfString(x.ProposalId)
+		if !f(fd_EventDisputeVolumeReport_proposal_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventDisputeVolumeReport) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "stratos.pot.v1.EventDisputeVolumeReport.epoch":
+		return x.Epoch != ""
+	case "stratos.pot.v1.EventDisputeVolumeReport.disputer":
+		return x.Disputer != ""
+	case "stratos.pot.v1.EventDisputeVolumeReport.reason":
+		return x.Reason != ""
+	case "stratos.pot.v1.EventDisputeVolumeReport.proposal_id":
+		return x.ProposalId != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.EventDisputeVolumeReport"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.EventDisputeVolumeReport does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventDisputeVolumeReport) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "stratos.pot.v1.EventDisputeVolumeReport.epoch":
+		x.Epoch = ""
+	case "stratos.pot.v1.EventDisputeVolumeReport.disputer":
+		x.Disputer = ""
+	case "stratos.pot.v1.EventDisputeVolumeReport.reason":
+		x.Reason = ""
+	case "stratos.pot.v1.EventDisputeVolumeReport.proposal_id":
+		x.ProposalId = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.EventDisputeVolumeReport"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.EventDisputeVolumeReport does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventDisputeVolumeReport) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "stratos.pot.v1.EventDisputeVolumeReport.epoch":
+		value := x.Epoch
+		return protoreflect.ValueOfString(value)
+	case "stratos.pot.v1.EventDisputeVolumeReport.disputer":
+		value := x.Disputer
+		return protoreflect.ValueOfString(value)
+	case "stratos.pot.v1.EventDisputeVolumeReport.reason":
+		value := x.Reason
+		return protoreflect.ValueOfString(value)
+	case "stratos.pot.v1.EventDisputeVolumeReport.proposal_id":
+		value := x.ProposalId
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.EventDisputeVolumeReport"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.EventDisputeVolumeReport does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventDisputeVolumeReport) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "stratos.pot.v1.EventDisputeVolumeReport.epoch":
+		x.Epoch = value.Interface().(string)
+	case "stratos.pot.v1.EventDisputeVolumeReport.disputer":
+		x.Disputer = value.Interface().(string)
+	case "stratos.pot.v1.EventDisputeVolumeReport.reason":
+		x.Reason = value.Interface().(string)
+	case "stratos.pot.v1.EventDisputeVolumeReport.proposal_id":
+		x.ProposalId = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.EventDisputeVolumeReport"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.EventDisputeVolumeReport does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventDisputeVolumeReport) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "stratos.pot.v1.EventDisputeVolumeReport.epoch":
+		panic(fmt.Errorf("field epoch of message stratos.pot.v1.EventDisputeVolumeReport is not mutable"))
+	case "stratos.pot.v1.EventDisputeVolumeReport.disputer":
+		panic(fmt.Errorf("field disputer of message stratos.pot.v1.EventDisputeVolumeReport is not mutable"))
+	case "stratos.pot.v1.EventDisputeVolumeReport.reason":
+		panic(fmt.Errorf("field reason of message stratos.pot.v1.EventDisputeVolumeReport is not mutable"))
+	case "stratos.pot.v1.EventDisputeVolumeReport.proposal_id":
+		panic(fmt.Errorf("field proposal_id of message stratos.pot.v1.EventDisputeVolumeReport is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.EventDisputeVolumeReport"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.EventDisputeVolumeReport does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventDisputeVolumeReport) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "stratos.pot.v1.EventDisputeVolumeReport.epoch":
+		return protoreflect.ValueOfString("")
+	case "stratos.pot.v1.EventDisputeVolumeReport.disputer":
+		return protoreflect.ValueOfString("")
+	case "stratos.pot.v1.EventDisputeVolumeReport.reason":
+		return protoreflect.ValueOfString("")
+	case "stratos.pot.v1.EventDisputeVolumeReport.proposal_id":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.EventDisputeVolumeReport"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.EventDisputeVolumeReport does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventDisputeVolumeReport) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in stratos.pot.v1.EventDisputeVolumeReport", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventDisputeVolumeReport) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventDisputeVolumeReport) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventDisputeVolumeReport) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventDisputeVolumeReport) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventDisputeVolumeReport)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Epoch)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Disputer)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Reason)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ProposalId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventDisputeVolumeReport)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.ProposalId) > 0 {
+			i -= len(x.ProposalId)
+			copy(dAtA[i:], x.ProposalId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ProposalId)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Reason) > 0 {
+			i -= len(x.Reason)
+			copy(dAtA[i:], x.Reason)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Reason)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Disputer) > 0 {
+			i -= len(x.Disputer)
+			copy(dAtA[i:], x.Disputer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Disputer)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Epoch) > 0 {
+			i -= len(x.Epoch)
+			copy(dAtA[i:], x.Epoch)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Epoch)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventDisputeVolumeReport)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventDisputeVolumeReport: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventDisputeVolumeReport: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Epoch = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Disputer", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Disputer = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Reason = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ProposalId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EventResolveVolumeReportDispute            protoreflect.MessageDescriptor
+	fd_EventResolveVolumeReportDispute_epoch      protoreflect.FieldDescriptor
+	fd_EventResolveVolumeReportDispute_resolution protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_stratos_pot_v1_event_proto_init()
+	md_EventResolveVolumeReportDispute = File_stratos_pot_v1_event_proto.Messages().ByName("EventResolveVolumeReportDispute")
+	fd_EventResolveVolumeReportDispute_epoch = md_EventResolveVolumeReportDispute.Fields().ByName("epoch")
+	fd_EventResolveVolumeReportDispute_resolution = md_EventResolveVolumeReportDispute.Fields().ByName("resolution")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventResolveVolumeReportDispute)(nil)
+
+type fastReflection_EventResolveVolumeReportDispute EventResolveVolumeReportDispute
+
+func (x *EventResolveVolumeReportDispute) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventResolveVolumeReportDispute)(x)
+}
+
+func (x *EventResolveVolumeReportDispute) slowProtoReflect() protoreflect.Message {
+	mi := &file_stratos_pot_v1_event_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventResolveVolumeReportDispute_messageType fastReflection_EventResolveVolumeReportDispute_messageType
+var _ protoreflect.MessageType = fastReflection_EventResolveVolumeReportDispute_messageType{}
+
+type fastReflection_EventResolveVolumeReportDispute_messageType struct{}
+
+func (x fastReflection_EventResolveVolumeReportDispute_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventResolveVolumeReportDispute)(nil)
+}
+func (x fastReflection_EventResolveVolumeReportDispute_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventResolveVolumeReportDispute)
+}
+func (x fastReflection_EventResolveVolumeReportDispute_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventResolveVolumeReportDispute
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventResolveVolumeReportDispute) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventResolveVolumeReportDispute
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventResolveVolumeReportDispute) Type() protoreflect.MessageType {
+	return _fastReflection_EventResolveVolumeReportDispute_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventResolveVolumeReportDispute) New() protoreflect.Message {
+	return new(fastReflection_EventResolveVolumeReportDispute)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventResolveVolumeReportDispute) Interface() protoreflect.ProtoMessage {
+	return (*EventResolveVolumeReportDispute)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventResolveVolumeReportDispute) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Epoch != "" {
+		value := protoreflect.ValueOfString(x.Epoch)
+		if !f(fd_EventResolveVolumeReportDispute_epoch, value) {
+			return
+		}
+	}
+	if x.Resolution != "" {
+		value := protoreflect.ValueOfString(x.Resolution)
+		if !f(fd_EventResolveVolumeReportDispute_resolution, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventResolveVolumeReportDispute) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "stratos.pot.v1.EventResolveVolumeReportDispute.epoch":
+		return x.Epoch != ""
+	case "stratos.pot.v1.EventResolveVolumeReportDispute.resolution":
+		return x.Resolution != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.EventResolveVolumeReportDispute"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.EventResolveVolumeReportDispute does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventResolveVolumeReportDispute) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "stratos.pot.v1.EventResolveVolumeReportDispute.epoch":
+		x.Epoch = ""
+	case "stratos.pot.v1.EventResolveVolumeReportDispute.resolution":
+		x.Resolution = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.EventResolveVolumeReportDispute"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.EventResolveVolumeReportDispute does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventResolveVolumeReportDispute) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "stratos.pot.v1.EventResolveVolumeReportDispute.epoch":
+		value := x.Epoch
+		return protoreflect.ValueOfString(value)
+	case "stratos.pot.v1.EventResolveVolumeReportDispute.resolution":
+		value := x.Resolution
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.EventResolveVolumeReportDispute"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.EventResolveVolumeReportDispute does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventResolveVolumeReportDispute) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "stratos.pot.v1.EventResolveVolumeReportDispute.epoch":
+		x.Epoch = value.Interface().(string)
+	case "stratos.pot.v1.EventResolveVolumeReportDispute.resolution":
+		x.Resolution = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.EventResolveVolumeReportDispute"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.EventResolveVolumeReportDispute does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventResolveVolumeReportDispute) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "stratos.pot.v1.EventResolveVolumeReportDispute.epoch":
+		panic(fmt.Errorf("field epoch of message stratos.pot.v1.EventResolveVolumeReportDispute is not mutable"))
+	case "stratos.pot.v1.EventResolveVolumeReportDispute.resolution":
+		panic(fmt.Errorf("field resolution of message stratos.pot.v1.EventResolveVolumeReportDispute is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.EventResolveVolumeReportDispute"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.EventResolveVolumeReportDispute does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventResolveVolumeReportDispute) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "stratos.pot.v1.EventResolveVolumeReportDispute.epoch":
+		return protoreflect.ValueOfString("")
+	case "stratos.pot.v1.EventResolveVolumeReportDispute.resolution":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.EventResolveVolumeReportDispute"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.EventResolveVolumeReportDispute does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventResolveVolumeReportDispute) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in stratos.pot.v1.EventResolveVolumeReportDispute", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventResolveVolumeReportDispute) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventResolveVolumeReportDispute) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventResolveVolumeReportDispute) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventResolveVolumeReportDispute) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventResolveVolumeReportDispute)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Epoch)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Resolution)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventResolveVolumeReportDispute)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Resolution) > 0 {
+			i -= len(x.Resolution)
+			copy(dAtA[i:], x.Resolution)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Resolution)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Epoch) > 0 {
+			i -= len(x.Epoch)
+			copy(dAtA[i:], x.Epoch)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Epoch)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventResolveVolumeReportDispute)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventResolveVolumeReportDispute: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventResolveVolumeReportDispute: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Epoch = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Resolution", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Resolution = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EventReportEquivocation             protoreflect.MessageDescriptor
+	fd_EventReportEquivocation_epoch       protoreflect.FieldDescriptor
+	fd_EventReportEquivocation_accused     protoreflect.FieldDescriptor
+	fd_EventReportEquivocation_reporter    protoreflect.FieldDescriptor
+	fd_EventReportEquivocation_slashed     protoreflect.FieldDescriptor
+	fd_EventReportEquivocation_kick_status protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_stratos_pot_v1_event_proto_init()
+	md_EventReportEquivocation = File_stratos_pot_v1_event_proto.Messages().ByName("EventReportEquivocation")
+	fd_EventReportEquivocation_epoch = md_EventReportEquivocation.Fields().ByName("epoch")
+	fd_EventReportEquivocation_accused = md_EventReportEquivocation.Fields().ByName("accused")
+	fd_EventReportEquivocation_reporter = md_EventReportEquivocation.Fields().ByName("reporter")
+	fd_EventReportEquivocation_slashed = md_EventReportEquivocation.Fields().ByName("slashed")
+	fd_EventReportEquivocation_kick_status = md_EventReportEquivocation.Fields().ByName("kick_status")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventReportEquivocation)(nil)
+
+type fastReflection_EventReportEquivocation EventReportEquivocation
+
+func (x *EventReportEquivocation) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventReportEquivocation)(x)
+}
+
+func (x *EventReportEquivocation) slowProtoReflect() protoreflect.Message {
+	mi := &file_stratos_pot_v1_event_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventReportEquivocation_messageType fastReflection_EventReportEquivocation_messageType
+var _ protoreflect.MessageType = fastReflection_EventReportEquivocation_messageType{}
+
+type fastReflection_EventReportEquivocation_messageType struct{}
+
+func (x fastReflection_EventReportEquivocation_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventReportEquivocation)(nil)
+}
+func (x fastReflection_EventReportEquivocation_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventReportEquivocation)
+}
+func (x fastReflection_EventReportEquivocation_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventReportEquivocation
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventReportEquivocation) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventReportEquivocation
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventReportEquivocation) Type() protoreflect.MessageType {
+	return _fastReflection_EventReportEquivocation_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventReportEquivocation) New() protoreflect.Message {
+	return new(fastReflection_EventReportEquivocation)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventReportEquivocation) Interface() protoreflect.ProtoMessage {
+	return (*EventReportEquivocation)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventReportEquivocation) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Epoch != "" {
+		value := protoreflect.ValueOfString(x.Epoch)
+		if !f(fd_EventReportEquivocation_epoch, value) {
+			return
+		}
+	}
+	if x.Accused != "" {
+		value := protoreflect.ValueOfString(x.Accused)
+		if !f(fd_EventReportEquivocation_accused, value) {
+			return
+		}
+	}
+	if x.Reporter != "" {
+		value := protoreflect.ValueOfString(x.Reporter)
+		if !f(fd_EventReportEquivocation_reporter, value) {
+			return
+		}
+	}
+	if x.Slashed != "" {
+		value := protoreflect.ValueOfString(x.Slashed)
+		if !f(fd_EventReportEquivocation_slashed, value) {
+			return
+		}
+	}
+	if x.KickStatus != "" {
+		value := protoreflect.ValueOfString(x.KickStatus)
+		if !f(fd_EventReportEquivocation_kick_status, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventReportEquivocation) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "stratos.pot.v1.EventReportEquivocation.epoch":
+		return x.Epoch != ""
+	case "stratos.pot.v1.EventReportEquivocation.accused":
+		return x.Accused != ""
+	case "stratos.pot.v1.EventReportEquivocation.reporter":
+		return x.Reporter != ""
+	case "stratos.pot.v1.EventReportEquivocation.slashed":
+		return x.Slashed != ""
+	case "stratos.pot.v1.EventReportEquivocation.kick_status":
+		return x.KickStatus != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.EventReportEquivocation"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.EventReportEquivocation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventReportEquivocation) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "stratos.pot.v1.EventReportEquivocation.epoch":
+		x.Epoch = ""
+	case "stratos.pot.v1.EventReportEquivocation.accused":
+		x.Accused = ""
+	case "stratos.pot.v1.EventReportEquivocation.reporter":
+		x.Reporter = ""
+	case "stratos.pot.v1.EventReportEquivocation.slashed":
+		x.Slashed = ""
+	case "stratos.pot.v1.EventReportEquivocation.kick_status":
+		x.KickStatus = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.EventReportEquivocation"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.EventReportEquivocation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventReportEquivocation) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "stratos.pot.v1.EventReportEquivocation.epoch":
+		value := x.Epoch
+		return protoreflect.ValueOfString(value)
+	case "stratos.pot.v1.EventReportEquivocation.accused":
+		value := x.Accused
+		return protoreflect.ValueOfString(value)
+	case "stratos.pot.v1.EventReportEquivocation.reporter":
+		value := x.Reporter
+		return protoreflect.ValueOfString(value)
+	case "stratos.pot.v1.EventReportEquivocation.slashed":
+		value := x.Slashed
+		return protoreflect.ValueOfString(value)
+	case "stratos.pot.v1.EventReportEquivocation.kick_status":
+		value := x.KickStatus
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.EventReportEquivocation"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.EventReportEquivocation does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventReportEquivocation) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "stratos.pot.v1.EventReportEquivocation.epoch":
+		x.Epoch = value.Interface().(string)
+	case "stratos.pot.v1.EventReportEquivocation.accused":
+		x.Accused = value.Interface().(string)
+	case "stratos.pot.v1.EventReportEquivocation.reporter":
+		x.Reporter = value.Interface().(string)
+	case "stratos.pot.v1.EventReportEquivocation.slashed":
+		x.Slashed = value.Interface().(string)
+	case "stratos.pot.v1.EventReportEquivocation.kick_status":
+		x.KickStatus = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.EventReportEquivocation"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.EventReportEquivocation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventReportEquivocation) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "stratos.pot.v1.EventReportEquivocation.epoch":
+		panic(fmt.Errorf("field epoch of message stratos.pot.v1.EventReportEquivocation is not mutable"))
+	case "stratos.pot.v1.EventReportEquivocation.accused":
+		panic(fmt.Errorf("field accused of message stratos.pot.v1.EventReportEquivocation is not mutable"))
+	case "stratos.pot.v1.EventReportEquivocation.reporter":
+		panic(fmt.Errorf("field reporter of message stratos.pot.v1.EventReportEquivocation is not mutable"))
+	case "stratos.pot.v1.EventReportEquivocation.slashed":
+		panic(fmt.Errorf("field slashed of message stratos.pot.v1.EventReportEquivocation is not mutable"))
+	case "stratos.pot.v1.EventReportEquivocation.kick_status":
+		panic(fmt.Errorf("field kick_status of message stratos.pot.v1.EventReportEquivocation is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.EventReportEquivocation"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.EventReportEquivocation does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventReportEquivocation) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "stratos.pot.v1.EventReportEquivocation.epoch":
+		return protoreflect.ValueOfString("")
+	case "stratos.pot.v1.EventReportEquivocation.accused":
+		return protoreflect.ValueOfString("")
+	case "stratos.pot.v1.EventReportEquivocation.reporter":
+		return protoreflect.ValueOfString("")
+	case "stratos.pot.v1.EventReportEquivocation.slashed":
+		return protoreflect.ValueOfString("")
+	case "stratos.pot.v1.EventReportEquivocation.kick_status":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.EventReportEquivocation"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.EventReportEquivocation does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventReportEquivocation) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in stratos.pot.v1.EventReportEquivocation", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventReportEquivocation) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventReportEquivocation) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventReportEquivocation) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventReportEquivocation) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventReportEquivocation)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Epoch)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Accused)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Reporter)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Slashed)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.KickStatus)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventReportEquivocation)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.KickStatus) > 0 {
+			i -= len(x.KickStatus)
+			copy(dAtA[i:], x.KickStatus)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.KickStatus)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.Slashed) > 0 {
+			i -= len(x.Slashed)
+			copy(dAtA[i:], x.Slashed)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Slashed)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Reporter) > 0 {
+			i -= len(x.Reporter)
+			copy(dAtA[i:], x.Reporter)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Reporter)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Accused) > 0 {
+			i -= len(x.Accused)
+			copy(dAtA[i:], x.Accused)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Accused)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Epoch) > 0 {
+			i -= len(x.Epoch)
+			copy(dAtA[i:], x.Epoch)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Epoch)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventReportEquivocation)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventReportEquivocation: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventReportEquivocation: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Epoch = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Accused", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Accused = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reporter", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Reporter = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Slashed", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Slashed = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field KickStatus", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.KickStatus = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EventRewardDistributed              protoreflect.MessageDescriptor
+	fd_EventRewardDistributed_epoch        protoreflect.FieldDescriptor
+	fd_EventRewardDistributed_wallet_count protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_stratos_pot_v1_event_proto_init()
+	md_EventRewardDistributed = File_stratos_pot_v1_event_proto.Messages().ByName("EventRewardDistributed")
+	fd_EventRewardDistributed_epoch = md_EventRewardDistributed.Fields().ByName("epoch")
+	fd_EventRewardDistributed_wallet_count = md_EventRewardDistributed.Fields().ByName("wallet_count")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventRewardDistributed)(nil)
+
+type fastReflection_EventRewardDistributed EventRewardDistributed
+
+func (x *EventRewardDistributed) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventRewardDistributed)(x)
+}
+
+func (x *EventRewardDistributed) slowProtoReflect() protoreflect.Message {
+	mi := &file_stratos_pot_v1_event_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventRewardDistributed_messageType fastReflection_EventRewardDistributed_messageType
+var _ protoreflect.MessageType = fastReflection_EventRewardDistributed_messageType{}
+
+type fastReflection_EventRewardDistributed_messageType struct{}
+
+func (x fastReflection_EventRewardDistributed_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventRewardDistributed)(nil)
+}
+func (x fastReflection_EventRewardDistributed_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventRewardDistributed)
+}
+func (x fastReflection_EventRewardDistributed_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventRewardDistributed
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventRewardDistributed) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventRewardDistributed
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventRewardDistributed) Type() protoreflect.MessageType {
+	return _fastReflection_EventRewardDistributed_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventRewardDistributed) New() protoreflect.Message {
+	return new(fastReflection_EventRewardDistributed)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventRewardDistributed) Interface() protoreflect.ProtoMessage {
+	return (*EventRewardDistributed)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventRewardDistributed) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Epoch != "" {
+		value := protoreflect.ValueOfString(x.Epoch)
+		if !f(fd_EventRewardDistributed_epoch, value) {
+			return
+		}
+	}
+	if x.WalletCount != "" {
+		value := protoreflect.ValueOfString(x.WalletCount)
+		if !f(fd_EventRewardDistributed_wallet_count, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventRewardDistributed) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "stratos.pot.v1.EventRewardDistributed.epoch":
+		return x.Epoch != ""
+	case "stratos.pot.v1.EventRewardDistributed.wallet_count":
+		return x.WalletCount != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.EventRewardDistributed"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.EventRewardDistributed does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventRewardDistributed) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "stratos.pot.v1.EventRewardDistributed.epoch":
+		x.Epoch = ""
+	case "stratos.pot.v1.EventRewardDistributed.wallet_count":
+		x.WalletCount = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.EventRewardDistributed"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.EventRewardDistributed does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventRewardDistributed) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "stratos.pot.v1.EventRewardDistributed.epoch":
+		value := x.Epoch
+		return protoreflect.ValueOfString(value)
+	case "stratos.pot.v1.EventRewardDistributed.wallet_count":
+		value := x.WalletCount
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.EventRewardDistributed"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.EventRewardDistributed does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventRewardDistributed) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "stratos.pot.v1.EventRewardDistributed.epoch":
+		x.Epoch = value.Interface().(string)
+	case "stratos.pot.v1.EventRewardDistributed.wallet_count":
+		x.WalletCount = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.EventRewardDistributed"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.EventRewardDistributed does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventRewardDistributed) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "stratos.pot.v1.EventRewardDistributed.epoch":
+		panic(fmt.Errorf("field epoch of message stratos.pot.v1.EventRewardDistributed is not mutable"))
+	case "stratos.pot.v1.EventRewardDistributed.wallet_count":
+		panic(fmt.Errorf("field wallet_count of message stratos.pot.v1.EventRewardDistributed is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.EventRewardDistributed"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.EventRewardDistributed does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventRewardDistributed) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "stratos.pot.v1.EventRewardDistributed.epoch":
+		return protoreflect.ValueOfString("")
+	case "stratos.pot.v1.EventRewardDistributed.wallet_count":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.EventRewardDistributed"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.EventRewardDistributed does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventRewardDistributed) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in stratos.pot.v1.EventRewardDistributed", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventRewardDistributed) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventRewardDistributed) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventRewardDistributed) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventRewardDistributed) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventRewardDistributed)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Epoch)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.WalletCount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventRewardDistributed)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.WalletCount) > 0 {
+			i -= len(x.WalletCount)
+			copy(dAtA[i:], x.WalletCount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.WalletCount)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Epoch) > 0 {
+			i -= len(x.Epoch)
+			copy(dAtA[i:], x.Epoch)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Epoch)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventRewardDistributed)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventRewardDistributed: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventRewardDistributed: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Epoch = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WalletCount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.WalletCount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EventSetAutoRestake                 protoreflect.MessageDescriptor
+	fd_EventSetAutoRestake_wallet_address  protoreflect.FieldDescriptor
+	fd_EventSetAutoRestake_network_address protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_stratos_pot_v1_event_proto_init()
+	md_EventSetAutoRestake = File_stratos_pot_v1_event_proto.Messages().ByName("EventSetAutoRestake")
+	fd_EventSetAutoRestake_wallet_address = md_EventSetAutoRestake.Fields().ByName("wallet_address")
+	fd_EventSetAutoRestake_network_address = md_EventSetAutoRestake.Fields().ByName("network_address")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventSetAutoRestake)(nil)
+
+type fastReflection_EventSetAutoRestake EventSetAutoRestake
+
+func (x *EventSetAutoRestake) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventSetAutoRestake)(x)
+}
+
+func (x *EventSetAutoRestake) slowProtoReflect() protoreflect.Message {
+	mi := &file_stratos_pot_v1_event_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventSetAutoRestake_messageType fastReflection_EventSetAutoRestake_messageType
+var _ protoreflect.MessageType = fastReflection_EventSetAutoRestake_messageType{}
+
+type fastReflection_EventSetAutoRestake_messageType struct{}
+
+func (x fastReflection_EventSetAutoRestake_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventSetAutoRestake)(nil)
+}
+func (x fastReflection_EventSetAutoRestake_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventSetAutoRestake)
+}
+func (x fastReflection_EventSetAutoRestake_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventSetAutoRestake
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventSetAutoRestake) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventSetAutoRestake
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventSetAutoRestake) Type() protoreflect.MessageType {
+	return _fastReflection_EventSetAutoRestake_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventSetAutoRestake) New() protoreflect.Message {
+	return new(fastReflection_EventSetAutoRestake)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventSetAutoRestake) Interface() protoreflect.ProtoMessage {
+	return (*EventSetAutoRestake)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventSetAutoRestake) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.WalletAddress != "" {
+		value := protoreflect.ValueOfString(x.WalletAddress)
+		if !f(fd_EventSetAutoRestake_wallet_address, value) {
+			return
+		}
+	}
+	if x.NetworkAddress != "" {
+		value := protoreflect.ValueOfString(x.NetworkAddress)
+		if !f(fd_EventSetAutoRestake_network_address, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventSetAutoRestake) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "stratos.pot.v1.EventSetAutoRestake.wallet_address":
+		return x.WalletAddress != ""
+	case "stratos.pot.v1.EventSetAutoRestake.network_address":
+		return x.NetworkAddress != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.EventSetAutoRestake"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.EventSetAutoRestake does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventSetAutoRestake) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "stratos.pot.v1.EventSetAutoRestake.wallet_address":
+		x.WalletAddress = ""
+	case "stratos.pot.v1.EventSetAutoRestake.network_address":
+		x.NetworkAddress = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.EventSetAutoRestake"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.EventSetAutoRestake does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventSetAutoRestake) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "stratos.pot.v1.EventSetAutoRestake.wallet_address":
+		value := x.WalletAddress
+		return protoreflect.ValueOfString(value)
+	case "stratos.pot.v1.EventSetAutoRestake.network_address":
+		value := x.NetworkAddress
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.EventSetAutoRestake"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.EventSetAutoRestake does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventSetAutoRestake) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "stratos.pot.v1.EventSetAutoRestake.wallet_address":
+		x.WalletAddress = value.Interface().(string)
+	case "stratos.pot.v1.EventSetAutoRestake.network_address":
+		x.NetworkAddress = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.EventSetAutoRestake"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.EventSetAutoRestake does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventSetAutoRestake) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "stratos.pot.v1.EventSetAutoRestake.wallet_address":
+		panic(fmt.Errorf("field wallet_address of message stratos.pot.v1.EventSetAutoRestake is not mutable"))
+	case "stratos.pot.v1.EventSetAutoRestake.network_address":
+		panic(fmt.Errorf("field network_address of message stratos.pot.v1.EventSetAutoRestake is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.EventSetAutoRestake"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.EventSetAutoRestake does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventSetAutoRestake) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "stratos.pot.v1.EventSetAutoRestake.wallet_address":
+		return protoreflect.ValueOfString("")
+	case "stratos.pot.v1.EventSetAutoRestake.network_address":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.EventSetAutoRestake"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.EventSetAutoRestake does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventSetAutoRestake) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in stratos.pot.v1.EventSetAutoRestake", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventSetAutoRestake) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventSetAutoRestake) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventSetAutoRestake) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventSetAutoRestake) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventSetAutoRestake)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.WalletAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.NetworkAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventSetAutoRestake)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.NetworkAddress) > 0 {
+			i -= len(x.NetworkAddress)
+			copy(dAtA[i:], x.NetworkAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NetworkAddress)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.WalletAddress) > 0 {
+			i -= len(x.WalletAddress)
+			copy(dAtA[i:], x.WalletAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.WalletAddress)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventSetAutoRestake)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventSetAutoRestake: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventSetAutoRestake: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WalletAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.WalletAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NetworkAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.NetworkAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EventAutoRestake                     protoreflect.MessageDescriptor
+	fd_EventAutoRestake_wallet_address      protoreflect.FieldDescriptor
+	fd_EventAutoRestake_network_address     protoreflect.FieldDescriptor
+	fd_EventAutoRestake_amount              protoreflect.FieldDescriptor
+	fd_EventAutoRestake_current_deposit     protoreflect.FieldDescriptor
+	fd_EventAutoRestake_ozone_limit_changes protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_stratos_pot_v1_event_proto_init()
+	md_EventAutoRestake = File_stratos_pot_v1_event_proto.Messages().ByName("EventAutoRestake")
+	fd_EventAutoRestake_wallet_address = md_EventAutoRestake.Fields().ByName("wallet_address")
+	fd_EventAutoRestake_network_address = md_EventAutoRestake.Fields().ByName("network_address")
+	fd_EventAutoRestake_amount = md_EventAutoRestake.Fields().ByName("amount")
+	fd_EventAutoRestake_current_deposit = md_EventAutoRestake.Fields().ByName("current_deposit")
+	fd_EventAutoRestake_ozone_limit_changes = md_EventAutoRestake.Fields().ByName("ozone_limit_changes")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventAutoRestake)(nil)
+
+type fastReflection_EventAutoRestake EventAutoRestake
+
+func (x *EventAutoRestake) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventAutoRestake)(x)
+}
+
+func (x *EventAutoRestake) slowProtoReflect() protoreflect.Message {
+	mi := &file_stratos_pot_v1_event_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventAutoRestake_messageType fastReflection_EventAutoRestake_messageType
+var _ protoreflect.MessageType = fastReflection_EventAutoRestake_messageType{}
+
+type fastReflection_EventAutoRestake_messageType struct{}
+
+func (x fastReflection_EventAutoRestake_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventAutoRestake)(nil)
+}
+func (x fastReflection_EventAutoRestake_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventAutoRestake)
+}
+func (x fastReflection_EventAutoRestake_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventAutoRestake
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventAutoRestake) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventAutoRestake
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventAutoRestake) Type() protoreflect.MessageType {
+	return _fastReflection_EventAutoRestake_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventAutoRestake) New() protoreflect.Message {
+	return new(fastReflection_EventAutoRestake)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventAutoRestake) Interface() protoreflect.ProtoMessage {
+	return (*EventAutoRestake)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventAutoRestake) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.WalletAddress != "" {
+		value := protoreflect.ValueOfString(x.WalletAddress)
+		if !f(fd_EventAutoRestake_wallet_address, value) {
+			return
+		}
+	}
+	if x.NetworkAddress != "" {
+		value := protoreflect.ValueOfString(x.NetworkAddress)
+		if !f(fd_EventAutoRestake_network_address, value) {
+			return
+		}
+	}
+	if x.Amount != "" {
+		value := protoreflect.ValueOfString(x.Amount)
+		if !f(fd_EventAutoRestake_amount, value) {
+			return
+		}
+	}
+	if x.CurrentDeposit != "" {
+		value := protoreflect.ValueOfString(x.CurrentDeposit)
+		if !f(fd_EventAutoRestake_current_deposit, value) {
+			return
+		}
+	}
+	if x.OzoneLimitChanges != "" {
+		value := protoreflect.ValueOfString(x.OzoneLimitChanges)
+		if !f(fd_EventAutoRestake_ozone_limit_changes, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventAutoRestake) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "stratos.pot.v1.EventAutoRestake.wallet_address":
+		return x.WalletAddress != ""
+	case "stratos.pot.v1.EventAutoRestake.network_address":
+		return x.NetworkAddress != ""
+	case "stratos.pot.v1.EventAutoRestake.amount":
+		return x.Amount != ""
+	case "stratos.pot.v1.EventAutoRestake.current_deposit":
+		return x.CurrentDeposit != ""
+	case "stratos.pot.v1.EventAutoRestake.ozone_limit_changes":
+		return x.OzoneLimitChanges != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.EventAutoRestake"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.EventAutoRestake does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventAutoRestake) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "stratos.pot.v1.EventAutoRestake.wallet_address":
+		x.WalletAddress = ""
+	case "stratos.pot.v1.EventAutoRestake.network_address":
+		x.NetworkAddress = ""
+	case "stratos.pot.v1.EventAutoRestake.amount":
+		x.Amount = ""
+	case "stratos.pot.v1.EventAutoRestake.current_deposit":
+		x.CurrentDeposit = ""
+	case "stratos.pot.v1.EventAutoRestake.ozone_limit_changes":
+		x.OzoneLimitChanges = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.EventAutoRestake"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.EventAutoRestake does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventAutoRestake) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "stratos.pot.v1.EventAutoRestake.wallet_address":
+		value := x.WalletAddress
+		return protoreflect.ValueOfString(value)
+	case "stratos.pot.v1.EventAutoRestake.network_address":
+		value := x.NetworkAddress
+		return protoreflect.ValueOfString(value)
+	case "stratos.pot.v1.EventAutoRestake.amount":
+		value := x.Amount
+		return protoreflect.ValueOfString(value)
+	case "stratos.pot.v1.EventAutoRestake.current_deposit":
+		value := x.CurrentDeposit
+		return protoreflect.ValueOfString(value)
+	case "stratos.pot.v1.EventAutoRestake.ozone_limit_changes":
+		value := x.OzoneLimitChanges
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.EventAutoRestake"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.EventAutoRestake does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventAutoRestake) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "stratos.pot.v1.EventAutoRestake.wallet_address":
+		x.WalletAddress = value.Interface().(string)
+	case "stratos.pot.v1.EventAutoRestake.network_address":
+		x.NetworkAddress = value.Interface().(string)
+	case "stratos.pot.v1.EventAutoRestake.amount":
+		x.Amount = value.Interface().(string)
+	case "stratos.pot.v1.EventAutoRestake.current_deposit":
+		x.CurrentDeposit = value.Interface().(string)
+	case "stratos.pot.v1.EventAutoRestake.ozone_limit_changes":
+		x.OzoneLimitChanges = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.EventAutoRestake"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.EventAutoRestake does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventAutoRestake) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "stratos.pot.v1.EventAutoRestake.wallet_address":
+		panic(fmt.Errorf("field wallet_address of message stratos.pot.v1.EventAutoRestake is not mutable"))
+	case "stratos.pot.v1.EventAutoRestake.network_address":
+		panic(fmt.Errorf("field network_address of message stratos.pot.v1.EventAutoRestake is not mutable"))
+	case "stratos.pot.v1.EventAutoRestake.amount":
+		panic(fmt.Errorf("field amount of message stratos.pot.v1.EventAutoRestake is not mutable"))
+	case "stratos.pot.v1.EventAutoRestake.current_deposit":
+		panic(fmt.Errorf("field current_deposit of message stratos.pot.v1.EventAutoRestake is not mutable"))
+	case "stratos.pot.v1.EventAutoRestake.ozone_limit_changes":
+		panic(fmt.Errorf("field ozone_limit_changes of message stratos.pot.v1.EventAutoRestake is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.EventAutoRestake"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.EventAutoRestake does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventAutoRestake) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "stratos.pot.v1.EventAutoRestake.wallet_address":
+		return protoreflect.ValueOfString("")
+	case "stratos.pot.v1.EventAutoRestake.network_address":
+		return protoreflect.ValueOfString("")
+	case "stratos.pot.v1.EventAutoRestake.amount":
+		return protoreflect.ValueOfString("")
+	case "stratos.pot.v1.EventAutoRestake.current_deposit":
+		return protoreflect.ValueOfString("")
+	case "stratos.pot.v1.EventAutoRestake.ozone_limit_changes":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.EventAutoRestake"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.EventAutoRestake does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventAutoRestake) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in stratos.pot.v1.EventAutoRestake", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventAutoRestake) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventAutoRestake) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventAutoRestake) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventAutoRestake) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventAutoRestake)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.WalletAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.NetworkAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Amount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.CurrentDeposit)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.OzoneLimitChanges)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventAutoRestake)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.OzoneLimitChanges) > 0 {
+			i -= len(x.OzoneLimitChanges)
+			copy(dAtA[i:], x.OzoneLimitChanges)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.OzoneLimitChanges)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.CurrentDeposit) > 0 {
+			i -= len(x.CurrentDeposit)
+			copy(dAtA[i:], x.CurrentDeposit)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CurrentDeposit)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Amount) > 0 {
+			i -= len(x.Amount)
+			copy(dAtA[i:], x.Amount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Amount)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.NetworkAddress) > 0 {
+			i -= len(x.NetworkAddress)
+			copy(dAtA[i:], x.NetworkAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NetworkAddress)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.WalletAddress) > 0 {
+			i -= len(x.WalletAddress)
+			copy(dAtA[i:], x.WalletAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.WalletAddress)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventAutoRestake)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventAutoRestake: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventAutoRestake: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WalletAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.WalletAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NetworkAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.NetworkAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Amount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CurrentDeposit", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CurrentDeposit = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OzoneLimitChanges", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.OzoneLimitChanges = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
 // 	protoc-gen-go v1.27.0
 // 	protoc        (unknown)
 // source: stratos/pot/v1/event.proto
 
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// EventVolumeReport is emitted on Msg/MsgVolumeReport
+type EventVolumeReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReportReference string `protobuf:"bytes,1,opt,name=report_reference,json=reportReference,proto3" json:"report_reference,omitempty"`
+	Epoch           string `protobuf:"bytes,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+}
+
+func (x *EventVolumeReport) Reset() {
+	*x = EventVolumeReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stratos_pot_v1_event_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventVolumeReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventVolumeReport) ProtoMessage() {}
+
+// Deprecated: Use EventVolumeReport.ProtoReflect.Descriptor instead.
+func (*EventVolumeReport) Descriptor() ([]byte, []int) {
+	return file_stratos_pot_v1_event_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *EventVolumeReport) GetReportReference() string {
+	if x != nil {
+		return x.ReportReference
+	}
+	return ""
+}
+
+func (x *EventVolumeReport) GetEpoch() string {
+	if x != nil {
+		return x.Epoch
+	}
+	return ""
+}
+
+// EventWithdraw is emitted on Msg/MsgWithdraw
+type EventWithdraw struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Amount        string `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	WalletAddress string `protobuf:"bytes,2,opt,name=wallet_address,json=walletAddress,proto3" json:"wallet_address,omitempty"`
+	TargetAddress string `protobuf:"bytes,3,opt,name=target_address,json=targetAddress,proto3" json:"target_address,omitempty"`
+}
+
+func (x *EventWithdraw) Reset() {
+	*x = EventWithdraw{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stratos_pot_v1_event_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventWithdraw) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventWithdraw) ProtoMessage() {}
+
+// Deprecated: Use EventWithdraw.ProtoReflect.Descriptor instead.
+func (*EventWithdraw) Descriptor() ([]byte, []int) {
+	return file_stratos_pot_v1_event_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *EventWithdraw) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *EventWithdraw) GetWalletAddress() string {
+	if x != nil {
+		return x.WalletAddress
+	}
+	return ""
+}
+
+func (x *EventWithdraw) GetTargetAddress() string {
+	if x != nil {
+		return x.TargetAddress
+	}
+	return ""
+}
+
+// EventFoundationDeposit is emitted on Msg/MsgFoundationDeposit
+type EventFoundationDeposit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Amount string `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *EventFoundationDeposit) Reset() {
+	*x = EventFoundationDeposit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stratos_pot_v1_event_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventFoundationDeposit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventFoundationDeposit) ProtoMessage() {}
+
+// Deprecated: Use EventFoundationDeposit.ProtoReflect.Descriptor instead.
+func (*EventFoundationDeposit) Descriptor() ([]byte, []int) {
+	return file_stratos_pot_v1_event_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *EventFoundationDeposit) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+// EventSlashing is emitted on Msg/MsgSlashingResourceNode
+type EventSlashing struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WalletAddress  string `protobuf:"bytes,1,opt,name=wallet_address,json=walletAddress,proto3" json:"wallet_address,omitempty"`
+	NetworkAddress string `protobuf:"bytes,2,opt,name=network_address,json=networkAddress,proto3" json:"network_address,omitempty"`
+	Amount         string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	SlashingType   string `protobuf:"bytes,4,opt,name=slashing_type,json=slashingType,proto3" json:"slashing_type,omitempty"`
+	Suspend        string `protobuf:"bytes,5,opt,name=suspend,proto3" json:"suspend,omitempty"`
+}
+
+func (x *EventSlashing) Reset() {
+	*x = EventSlashing{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stratos_pot_v1_event_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventSlashing) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventSlashing) ProtoMessage() {}
+
+// Deprecated: Use EventSlashing.ProtoReflect.Descriptor instead.
+func (*EventSlashing) Descriptor() ([]byte, []int) {
+	return file_stratos_pot_v1_event_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *EventSlashing) GetWalletAddress() string {
+	if x != nil {
+		return x.WalletAddress
+	}
+	return ""
+}
+
+func (x *EventSlashing) GetNetworkAddress() string {
+	if x != nil {
+		return x.NetworkAddress
+	}
+	return ""
+}
+
+func (x *EventSlashing) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *EventSlashing) GetSlashingType() string {
+	if x != nil {
+		return x.SlashingType
+	}
+	return ""
+}
+
+func (x *EventSlashing) GetSuspend() string {
+	if x != nil {
+		return x.Suspend
+	}
+	return ""
+}
+
+// EventVolumeReportHeader is emitted on Msg/MsgVolumeReportHeader
+type EventVolumeReportHeader struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReportReference string `protobuf:"bytes,1,opt,name=report_reference,json=reportReference,proto3" json:"report_reference,omitempty"`
+	Epoch           string `protobuf:"bytes,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	TotalCount      string `protobuf:"bytes,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	DeadlineHeight  string `protobuf:"bytes,4,opt,name=deadline_height,json=deadlineHeight,proto3" json:"deadline_height,omitempty"`
+}
+
+func (x *EventVolumeReportHeader) Reset() {
+	*x = EventVolumeReportHeader{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stratos_pot_v1_event_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventVolumeReportHeader) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventVolumeReportHeader) ProtoMessage() {}
+
+// Deprecated: Use EventVolumeReportHeader.ProtoReflect.Descriptor instead.
+func (*EventVolumeReportHeader) Descriptor() ([]byte, []int) {
+	return file_stratos_pot_v1_event_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *EventVolumeReportHeader) GetReportReference() string {
+	if x != nil {
+		return x.ReportReference
+	}
+	return ""
+}
+
+func (x *EventVolumeReportHeader) GetEpoch() string {
+	if x != nil {
+		return x.Epoch
+	}
+	return ""
+}
+
+func (x *EventVolumeReportHeader) GetTotalCount() string {
+	if x != nil {
+		return x.TotalCount
+	}
+	return ""
+}
+
+func (x *EventVolumeReportHeader) GetDeadlineHeight() string {
+	if x != nil {
+		return x.DeadlineHeight
+	}
+	return ""
+}
+
+// EventVolumeReportChunk is emitted on Msg/MsgVolumeReportChunk
+type EventVolumeReportChunk struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Epoch      string `protobuf:"bytes,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	StartIndex string `protobuf:"bytes,2,opt,name=start_index,json=startIndex,proto3" json:"start_index,omitempty"`
+	Count      string `protobuf:"bytes,3,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *EventVolumeReportChunk) Reset() {
+	*x = EventVolumeReportChunk{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stratos_pot_v1_event_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventVolumeReportChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventVolumeReportChunk) ProtoMessage() {}
+
+// Deprecated: Use EventVolumeReportChunk.ProtoReflect.Descriptor instead.
+func (*EventVolumeReportChunk) Descriptor() ([]byte, []int) {
+	return file_stratos_pot_v1_event_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *EventVolumeReportChunk) GetEpoch() string {
+	if x != nil {
+		return x.Epoch
+	}
+	return ""
+}
+
+func (x *EventVolumeReportChunk) GetStartIndex() string {
+	if x != nil {
+		return x.StartIndex
+	}
+	return ""
+}
+
+func (x *EventVolumeReportChunk) GetCount() string {
+	if x != nil {
+		return x.Count
+	}
+	return ""
+}
+
+// EventVolumeReportExpired is emitted when a chunked volume report is not completed before its deadline
+type EventVolumeReportExpired struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReportReference string `protobuf:"bytes,1,opt,name=report_reference,json=reportReference,proto3" json:"report_reference,omitempty"`
+	Epoch           string `protobuf:"bytes,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	ReceivedCount   string `protobuf:"bytes,3,opt,name=received_count,json=receivedCount,proto3" json:"received_count,omitempty"`
+	TotalCount      string `protobuf:"bytes,4,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+}
+
+func (x *EventVolumeReportExpired) Reset() {
+	*x = EventVolumeReportExpired{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stratos_pot_v1_event_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventVolumeReportExpired) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventVolumeReportExpired) ProtoMessage() {}
+
+// Deprecated: Use EventVolumeReportExpired.ProtoReflect.Descriptor instead.
+func (*EventVolumeReportExpired) Descriptor() ([]byte, []int) {
+	return file_stratos_pot_v1_event_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *EventVolumeReportExpired) GetReportReference() string {
+	if x != nil {
+		return x.ReportReference
+	}
+	return ""
+}
+
+func (x *EventVolumeReportExpired) GetEpoch() string {
+	if x != nil {
+		return x.Epoch
+	}
+	return ""
+}
+
+func (x *EventVolumeReportExpired) GetReceivedCount() string {
+	if x != nil {
+		return x.ReceivedCount
+	}
+	return ""
+}
+
+func (x *EventVolumeReportExpired) GetTotalCount() string {
+	if x != nil {
+		return x.TotalCount
+	}
+	return ""
+}
 
-// EventVolumeReport is emitted on Msg/MsgVolumeReport
-type EventVolumeReport struct {
+type EventDisputeVolumeReport struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ReportReference string `protobuf:"bytes,1,opt,name=report_reference,json=reportReference,proto3" json:"report_reference,omitempty"`
-	Epoch           string `protobuf:"bytes,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Epoch      string `protobuf:"bytes,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Disputer   string `protobuf:"bytes,2,opt,name=disputer,proto3" json:"disputer,omitempty"`
+	Reason     string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	ProposalId string `protobuf:"bytes,4,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
 }
 
-func (x *EventVolumeReport) Reset() {
-	*x = EventVolumeReport{}
+func (x *EventDisputeVolumeReport) Reset() {
+	*x = EventDisputeVolumeReport{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_stratos_pot_v1_event_proto_msgTypes[0]
+		mi := &file_stratos_pot_v1_event_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EventVolumeReport) String() string {
+func (x *EventDisputeVolumeReport) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EventVolumeReport) ProtoMessage() {}
+func (*EventDisputeVolumeReport) ProtoMessage() {}
 
-// Deprecated: Use EventVolumeReport.ProtoReflect.Descriptor instead.
-func (*EventVolumeReport) Descriptor() ([]byte, []int) {
-	return file_stratos_pot_v1_event_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use EventDisputeVolumeReport.ProtoReflect.Descriptor instead.
+func (*EventDisputeVolumeReport) Descriptor() ([]byte, []int) {
+	return file_stratos_pot_v1_event_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *EventVolumeReport) GetReportReference() string {
+func (x *EventDisputeVolumeReport) GetEpoch() string {
 	if x != nil {
-		return x.ReportReference
+		return x.Epoch
 	}
 	return ""
 }
 
-func (x *EventVolumeReport) GetEpoch() string {
+func (x *EventDisputeVolumeReport) GetDisputer() string {
+	if x != nil {
+		return x.Disputer
+	}
+	return ""
+}
+
+func (x *EventDisputeVolumeReport) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *EventDisputeVolumeReport) GetProposalId() string {
+	if x != nil {
+		return x.ProposalId
+	}
+	return ""
+}
+
+type EventResolveVolumeReportDispute struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Epoch      string `protobuf:"bytes,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Resolution string `protobuf:"bytes,2,opt,name=resolution,proto3" json:"resolution,omitempty"`
+}
+
+func (x *EventResolveVolumeReportDispute) Reset() {
+	*x = EventResolveVolumeReportDispute{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stratos_pot_v1_event_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventResolveVolumeReportDispute) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventResolveVolumeReportDispute) ProtoMessage() {}
+
+// Deprecated: Use EventResolveVolumeReportDispute.ProtoReflect.Descriptor instead.
+func (*EventResolveVolumeReportDispute) Descriptor() ([]byte, []int) {
+	return file_stratos_pot_v1_event_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *EventResolveVolumeReportDispute) GetEpoch() string {
 	if x != nil {
 		return x.Epoch
 	}
 	return ""
 }
 
-// EventWithdraw is emitted on Msg/MsgWithdraw
-type EventWithdraw struct {
+func (x *EventResolveVolumeReportDispute) GetResolution() string {
+	if x != nil {
+		return x.Resolution
+	}
+	return ""
+}
+
+type EventReportEquivocation struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Amount        string `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
-	WalletAddress string `protobuf:"bytes,2,opt,name=wallet_address,json=walletAddress,proto3" json:"wallet_address,omitempty"`
-	TargetAddress string `protobuf:"bytes,3,opt,name=target_address,json=targetAddress,proto3" json:"target_address,omitempty"`
+	Epoch      string `protobuf:"bytes,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Accused    string `protobuf:"bytes,2,opt,name=accused,proto3" json:"accused,omitempty"`
+	Reporter   string `protobuf:"bytes,3,opt,name=reporter,proto3" json:"reporter,omitempty"`
+	Slashed    string `protobuf:"bytes,4,opt,name=slashed,proto3" json:"slashed,omitempty"`
+	KickStatus string `protobuf:"bytes,5,opt,name=kick_status,json=kickStatus,proto3" json:"kick_status,omitempty"`
 }
 
-func (x *EventWithdraw) Reset() {
-	*x = EventWithdraw{}
+func (x *EventReportEquivocation) Reset() {
+	*x = EventReportEquivocation{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_stratos_pot_v1_event_proto_msgTypes[1]
+		mi := &file_stratos_pot_v1_event_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EventWithdraw) String() string {
+func (x *EventReportEquivocation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EventWithdraw) ProtoMessage() {}
+func (*EventReportEquivocation) ProtoMessage() {}
 
-// Deprecated: Use EventWithdraw.ProtoReflect.Descriptor instead.
-func (*EventWithdraw) Descriptor() ([]byte, []int) {
-	return file_stratos_pot_v1_event_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use EventReportEquivocation.ProtoReflect.Descriptor instead.
+func (*EventReportEquivocation) Descriptor() ([]byte, []int) {
+	return file_stratos_pot_v1_event_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *EventWithdraw) GetAmount() string {
+func (x *EventReportEquivocation) GetEpoch() string {
 	if x != nil {
-		return x.Amount
+		return x.Epoch
 	}
 	return ""
 }
 
-func (x *EventWithdraw) GetWalletAddress() string {
+func (x *EventReportEquivocation) GetAccused() string {
 	if x != nil {
-		return x.WalletAddress
+		return x.Accused
 	}
 	return ""
 }
 
-func (x *EventWithdraw) GetTargetAddress() string {
+func (x *EventReportEquivocation) GetReporter() string {
 	if x != nil {
-		return x.TargetAddress
+		return x.Reporter
 	}
 	return ""
 }
 
-// EventFoundationDeposit is emitted on Msg/MsgFoundationDeposit
-type EventFoundationDeposit struct {
+func (x *EventReportEquivocation) GetSlashed() string {
+	if x != nil {
+		return x.Slashed
+	}
+	return ""
+}
+
+func (x *EventReportEquivocation) GetKickStatus() string {
+	if x != nil {
+		return x.KickStatus
+	}
+	return ""
+}
+
+type EventRewardDistributed struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Amount string `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	Epoch       string `protobuf:"bytes,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	WalletCount string `protobuf:"bytes,2,opt,name=wallet_count,json=walletCount,proto3" json:"wallet_count,omitempty"`
 }
 
-func (x *EventFoundationDeposit) Reset() {
-	*x = EventFoundationDeposit{}
+func (x *EventRewardDistributed) Reset() {
+	*x = EventRewardDistributed{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_stratos_pot_v1_event_proto_msgTypes[2]
+		mi := &file_stratos_pot_v1_event_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EventFoundationDeposit) String() string {
+func (x *EventRewardDistributed) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EventFoundationDeposit) ProtoMessage() {}
+func (*EventRewardDistributed) ProtoMessage() {}
 
-// Deprecated: Use EventFoundationDeposit.ProtoReflect.Descriptor instead.
-func (*EventFoundationDeposit) Descriptor() ([]byte, []int) {
-	return file_stratos_pot_v1_event_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use EventRewardDistributed.ProtoReflect.Descriptor instead.
+func (*EventRewardDistributed) Descriptor() ([]byte, []int) {
+	return file_stratos_pot_v1_event_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *EventFoundationDeposit) GetAmount() string {
+func (x *EventRewardDistributed) GetEpoch() string {
 	if x != nil {
-		return x.Amount
+		return x.Epoch
 	}
 	return ""
 }
 
-// EventSlashing is emitted on Msg/MsgSlashingResourceNode
-type EventSlashing struct {
+func (x *EventRewardDistributed) GetWalletCount() string {
+	if x != nil {
+		return x.WalletCount
+	}
+	return ""
+}
+
+type EventSetAutoRestake struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	WalletAddress  string `protobuf:"bytes,1,opt,name=wallet_address,json=walletAddress,proto3" json:"wallet_address,omitempty"`
 	NetworkAddress string `protobuf:"bytes,2,opt,name=network_address,json=networkAddress,proto3" json:"network_address,omitempty"`
-	Amount         string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
-	SlashingType   string `protobuf:"bytes,4,opt,name=slashing_type,json=slashingType,proto3" json:"slashing_type,omitempty"`
-	Suspend        string `protobuf:"bytes,5,opt,name=suspend,proto3" json:"suspend,omitempty"`
 }
 
-func (x *EventSlashing) Reset() {
-	*x = EventSlashing{}
+func (x *EventSetAutoRestake) Reset() {
+	*x = EventSetAutoRestake{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_stratos_pot_v1_event_proto_msgTypes[3]
+		mi := &file_stratos_pot_v1_event_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EventSlashing) String() string {
+func (x *EventSetAutoRestake) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EventSlashing) ProtoMessage() {}
+func (*EventSetAutoRestake) ProtoMessage() {}
 
-// Deprecated: Use EventSlashing.ProtoReflect.Descriptor instead.
-func (*EventSlashing) Descriptor() ([]byte, []int) {
-	return file_stratos_pot_v1_event_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use EventSetAutoRestake.ProtoReflect.Descriptor instead.
+func (*EventSetAutoRestake) Descriptor() ([]byte, []int) {
+	return file_stratos_pot_v1_event_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *EventSlashing) GetWalletAddress() string {
+func (x *EventSetAutoRestake) GetWalletAddress() string {
 	if x != nil {
 		return x.WalletAddress
 	}
 	return ""
 }
 
-func (x *EventSlashing) GetNetworkAddress() string {
+func (x *EventSetAutoRestake) GetNetworkAddress() string {
 	if x != nil {
 		return x.NetworkAddress
 	}
 	return ""
 }
 
-func (x *EventSlashing) GetAmount() string {
+type EventAutoRestake struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WalletAddress     string `protobuf:"bytes,1,opt,name=wallet_address,json=walletAddress,proto3" json:"wallet_address,omitempty"`
+	NetworkAddress    string `protobuf:"bytes,2,opt,name=network_address,json=networkAddress,proto3" json:"network_address,omitempty"`
+	Amount            string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	CurrentDeposit    string `protobuf:"bytes,4,opt,name=current_deposit,json=currentDeposit,proto3" json:"current_deposit,omitempty"`
+	OzoneLimitChanges string `protobuf:"bytes,5,opt,name=ozone_limit_changes,json=ozoneLimitChanges,proto3" json:"ozone_limit_changes,omitempty"`
+}
+
+func (x *EventAutoRestake) Reset() {
+	*x = EventAutoRestake{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stratos_pot_v1_event_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventAutoRestake) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventAutoRestake) ProtoMessage() {}
+
+// Deprecated: Use EventAutoRestake.ProtoReflect.Descriptor instead.
+func (*EventAutoRestake) Descriptor() ([]byte, []int) {
+	return file_stratos_pot_v1_event_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *EventAutoRestake) GetWalletAddress() string {
+	if x != nil {
+		return x.WalletAddress
+	}
+	return ""
+}
+
+func (x *EventAutoRestake) GetNetworkAddress() string {
+	if x != nil {
+		return x.NetworkAddress
+	}
+	return ""
+}
+
+func (x *EventAutoRestake) GetAmount() string {
 	if x != nil {
 		return x.Amount
 	}
 	return ""
 }
 
-func (x *EventSlashing) GetSlashingType() string {
+func (x *EventAutoRestake) GetCurrentDeposit() string {
 	if x != nil {
-		return x.SlashingType
+		return x.CurrentDeposit
 	}
 	return ""
 }
 
-func (x *EventSlashing) GetSuspend() string {
+func (x *EventAutoRestake) GetOzoneLimitChanges() string {
 	if x != nil {
-		return x.Suspend
+		return x.OzoneLimitChanges
 	}
 	return ""
 }
@@ -2386,17 +8068,94 @@ var file_stratos_pot_v1_event_proto_rawDesc = []byte{
 	0x67, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x6c,
 	0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75,
 	0x73, 0x70, 0x65, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x73,
-	0x70, 0x65, 0x6e, 0x64, 0x42, 0xa1, 0x01, 0x0a, 0x12, 0x63, 0x6f, 0x6d, 0x2e, 0x73, 0x74, 0x72,
-	0x61, 0x74, 0x6f, 0x73, 0x2e, 0x70, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x25, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x73, 0x74, 0x72, 0x61,
-	0x74, 0x6f, 0x73, 0x2f, 0x70, 0x6f, 0x74, 0x2f, 0x76, 0x31, 0x3b, 0x70, 0x6f, 0x74, 0x76, 0x31,
-	0xa2, 0x02, 0x03, 0x53, 0x50, 0x58, 0xaa, 0x02, 0x0e, 0x53, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73,
-	0x2e, 0x50, 0x6f, 0x74, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0e, 0x53, 0x74, 0x72, 0x61, 0x74, 0x6f,
-	0x73, 0x5c, 0x50, 0x6f, 0x74, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1a, 0x53, 0x74, 0x72, 0x61, 0x74,
-	0x6f, 0x73, 0x5c, 0x50, 0x6f, 0x74, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x10, 0x53, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x3a,
-	0x3a, 0x50, 0x6f, 0x74, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x70, 0x65, 0x6e, 0x64, 0x22, 0xa4, 0x01, 0x0a, 0x17, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x56, 0x6f,
+	0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x12, 0x29, 0x0a, 0x10, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x72, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x70, 0x6f, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63,
+	0x68, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x64, 0x65, 0x61,
+	0x64, 0x6c, 0x69, 0x6e, 0x65, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x65, 0x0a, 0x16, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x43, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x1f, 0x0a, 0x0b, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x14, 0x0a, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x22, 0xa3, 0x01, 0x0a, 0x18, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x56, 0x6f, 0x6c, 0x75,
+	0x6d, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x12,
+	0x29, 0x0a, 0x10, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x72, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70,
+	0x6f, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68,
+	0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76,
+	0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x85, 0x01, 0x0a, 0x18, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x44, 0x69, 0x73, 0x70, 0x75, 0x74, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x64,
+	0x69, 0x73, 0x70, 0x75, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64,
+	0x69, 0x73, 0x70, 0x75, 0x74, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12,
+	0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x49, 0x64,
+	0x22, 0x57, 0x0a, 0x1f, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65,
+	0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x69, 0x73, 0x70,
+	0x75, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x73,
+	0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72,
+	0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xa0, 0x01, 0x0a, 0x17, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x71, 0x75, 0x69, 0x76, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x61,
+	0x63, 0x63, 0x75, 0x73, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63,
+	0x63, 0x75, 0x73, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65,
+	0x72, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6b,
+	0x69, 0x63, 0x6b, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x6b, 0x69, 0x63, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x51, 0x0a, 0x16,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x44, 0x69, 0x73, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x21, 0x0a, 0x0c,
+	0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22,
+	0x65, 0x0a, 0x13, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x74, 0x41, 0x75, 0x74, 0x6f, 0x52,
+	0x65, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x27, 0x0a,
+	0x0f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xd3, 0x01, 0x0a, 0x10, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x41, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x77,
+	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x64,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x74, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x2e, 0x0a, 0x13,
+	0x6f, 0x7a, 0x6f, 0x6e, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6f, 0x7a, 0x6f, 0x6e, 0x65,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x42, 0xa1, 0x01, 0x0a,
+	0x12, 0x63, 0x6f, 0x6d, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e, 0x70, 0x6f, 0x74,
+	0x2e, 0x76, 0x31, 0x42, 0x0a, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50,
+	0x01, 0x5a, 0x25, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2f, 0x70, 0x6f, 0x74, 0x2f,
+	0x76, 0x31, 0x3b, 0x70, 0x6f, 0x74, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x53, 0x50, 0x58, 0xaa, 0x02,
+	0x0e, 0x53, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e, 0x50, 0x6f, 0x74, 0x2e, 0x56, 0x31, 0xca,
+	0x02, 0x0e, 0x53, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x5c, 0x50, 0x6f, 0x74, 0x5c, 0x56, 0x31,
+	0xe2, 0x02, 0x1a, 0x53, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x5c, 0x50, 0x6f, 0x74, 0x5c, 0x56,
+	0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x10,
+	0x53, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x3a, 0x3a, 0x50, 0x6f, 0x74, 0x3a, 0x3a, 0x56, 0x31,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2411,12 +8170,21 @@ func file_stratos_pot_v1_event_proto_rawDescGZIP() []byte {
 	return file_stratos_pot_v1_event_proto_rawDescData
 }
 
-var file_stratos_pot_v1_event_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_stratos_pot_v1_event_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
 var file_stratos_pot_v1_event_proto_goTypes = []interface{}{
-	(*EventVolumeReport)(nil),      // 0: stratos.pot.v1.EventVolumeReport
-	(*EventWithdraw)(nil),          // 1: stratos.pot.v1.EventWithdraw
-	(*EventFoundationDeposit)(nil), // 2: stratos.pot.v1.EventFoundationDeposit
-	(*EventSlashing)(nil),          // 3: stratos.pot.v1.EventSlashing
+	(*EventVolumeReport)(nil),               // 0: stratos.pot.v1.EventVolumeReport
+	(*EventWithdraw)(nil),                   // 1: stratos.pot.v1.EventWithdraw
+	(*EventFoundationDeposit)(nil),          // 2: stratos.pot.v1.EventFoundationDeposit
+	(*EventSlashing)(nil),                   // 3: stratos.pot.v1.EventSlashing
+	(*EventVolumeReportHeader)(nil),         // 4: stratos.pot.v1.EventVolumeReportHeader
+	(*EventVolumeReportChunk)(nil),          // 5: stratos.pot.v1.EventVolumeReportChunk
+	(*EventVolumeReportExpired)(nil),        // 6: stratos.pot.v1.EventVolumeReportExpired
+	(*EventDisputeVolumeReport)(nil),        // 7: stratos.pot.v1.EventDisputeVolumeReport
+	(*EventResolveVolumeReportDispute)(nil), // 8: stratos.pot.v1.EventResolveVolumeReportDispute
+	(*EventReportEquivocation)(nil),         // 9: stratos.pot.v1.EventReportEquivocation
+	(*EventRewardDistributed)(nil),          // 10: stratos.pot.v1.EventRewardDistributed
+	(*EventSetAutoRestake)(nil),             // 11: stratos.pot.v1.EventSetAutoRestake
+	(*EventAutoRestake)(nil),                // 12: stratos.pot.v1.EventAutoRestake
 }
 var file_stratos_pot_v1_event_proto_depIdxs = []int32{
 	0, // [0:0] is the sub-list for method output_type
@@ -2480,6 +8248,114 @@ func file_stratos_pot_v1_event_proto_init() {
 				return nil
 			}
 		}
+		file_stratos_pot_v1_event_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventVolumeReportHeader); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stratos_pot_v1_event_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventVolumeReportChunk); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stratos_pot_v1_event_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventVolumeReportExpired); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stratos_pot_v1_event_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventDisputeVolumeReport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stratos_pot_v1_event_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventResolveVolumeReportDispute); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stratos_pot_v1_event_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventReportEquivocation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stratos_pot_v1_event_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventRewardDistributed); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stratos_pot_v1_event_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventSetAutoRestake); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stratos_pot_v1_event_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventAutoRestake); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -2487,7 +8363,7 @@ func file_stratos_pot_v1_event_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_stratos_pot_v1_event_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   4,
+			NumMessages:   13,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
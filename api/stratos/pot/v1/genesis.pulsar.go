@@ -220,6 +220,363 @@ func (x *_GenesisState_8_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_GenesisState_10_list)(nil)
+
+type _GenesisState_10_list struct {
+	list *[]*SingleWalletVolume
+}
+
+func (x *_GenesisState_10_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_10_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_10_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SingleWalletVolume)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_10_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SingleWalletVolume)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_10_list) AppendMutable() protoreflect.Value {
+	v := new(SingleWalletVolume)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_10_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_10_list) NewElement() protoreflect.Value {
+	v := new(SingleWalletVolume)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_10_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_11_list)(nil)
+
+type _GenesisState_11_list struct {
+	list *[]*PendingDistribution
+}
+
+func (x *_GenesisState_11_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_11_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_11_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PendingDistribution)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_11_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PendingDistribution)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_11_list) AppendMutable() protoreflect.Value {
+	v := new(PendingDistribution)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_11_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_11_list) NewElement() protoreflect.Value {
+	v := new(PendingDistribution)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_11_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_12_list)(nil)
+
+type _GenesisState_12_list struct {
+	list *[]*DistributionVolume
+}
+
+func (x *_GenesisState_12_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_12_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_12_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DistributionVolume)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_12_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DistributionVolume)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_12_list) AppendMutable() protoreflect.Value {
+	v := new(DistributionVolume)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_12_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_12_list) NewElement() protoreflect.Value {
+	v := new(DistributionVolume)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_12_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_13_list)(nil)
+
+type _GenesisState_13_list struct {
+	list *[]*VolumeReportDispute
+}
+
+func (x *_GenesisState_13_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_13_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_13_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*VolumeReportDispute)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_13_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*VolumeReportDispute)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_13_list) AppendMutable() protoreflect.Value {
+	v := new(VolumeReportDispute)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_13_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_13_list) NewElement() protoreflect.Value {
+	v := new(VolumeReportDispute)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_13_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_14_list)(nil)
+
+type _GenesisState_14_list struct {
+	list *[]*ReportEquivocation
+}
+
+func (x *_GenesisState_14_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_14_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_14_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ReportEquivocation)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_14_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ReportEquivocation)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_14_list) AppendMutable() protoreflect.Value {
+	v := new(ReportEquivocation)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_14_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_14_list) NewElement() protoreflect.Value {
+	v := new(ReportEquivocation)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_14_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_15_list)(nil)
+
+type _GenesisState_15_list struct {
+	list *[]*RewardHistory
+}
+
+func (x *_GenesisState_15_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_15_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_15_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*RewardHistory)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_15_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*RewardHistory)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_15_list) AppendMutable() protoreflect.Value {
+	v := new(RewardHistory)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_15_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_15_list) NewElement() protoreflect.Value {
+	v := new(RewardHistory)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_15_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_16_list)(nil)
+
+type _GenesisState_16_list struct {
+	list *[]*AutoRestake
+}
+
+func (x *_GenesisState_16_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_16_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_16_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*AutoRestake)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_16_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*AutoRestake)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_16_list) AppendMutable() protoreflect.Value {
+	v := new(AutoRestake)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_16_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_16_list) NewElement() protoreflect.Value {
+	v := new(AutoRestake)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_16_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
 	md_GenesisState                        protoreflect.MessageDescriptor
 	fd_GenesisState_params                 protoreflect.FieldDescriptor
@@ -230,6 +587,14 @@ var (
 	fd_GenesisState_individual_reward_info protoreflect.FieldDescriptor
 	fd_GenesisState_matured_epoch          protoreflect.FieldDescriptor
 	fd_GenesisState_reward_total_info      protoreflect.FieldDescriptor
+	fd_GenesisState_pending_volume_report  protoreflect.FieldDescriptor
+	fd_GenesisState_pending_wallet_volumes protoreflect.FieldDescriptor
+	fd_GenesisState_pending_distributions  protoreflect.FieldDescriptor
+	fd_GenesisState_distribution_volumes   protoreflect.FieldDescriptor
+	fd_GenesisState_volume_report_disputes protoreflect.FieldDescriptor
+	fd_GenesisState_report_equivocations   protoreflect.FieldDescriptor
+	fd_GenesisState_reward_histories       protoreflect.FieldDescriptor
+	fd_GenesisState_auto_restakes          protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -243,6 +608,14 @@ func init() {
 	fd_GenesisState_individual_reward_info = md_GenesisState.Fields().ByName("individual_reward_info")
 	fd_GenesisState_matured_epoch = md_GenesisState.Fields().ByName("matured_epoch")
 	fd_GenesisState_reward_total_info = md_GenesisState.Fields().ByName("reward_total_info")
+	fd_GenesisState_pending_volume_report = md_GenesisState.Fields().ByName("pending_volume_report")
+	fd_GenesisState_pending_wallet_volumes = md_GenesisState.Fields().ByName("pending_wallet_volumes")
+	fd_GenesisState_pending_distributions = md_GenesisState.Fields().ByName("pending_distributions")
+	fd_GenesisState_distribution_volumes = md_GenesisState.Fields().ByName("distribution_volumes")
+	fd_GenesisState_volume_report_disputes = md_GenesisState.Fields().ByName("volume_report_disputes")
+	fd_GenesisState_report_equivocations = md_GenesisState.Fields().ByName("report_equivocations")
+	fd_GenesisState_reward_histories = md_GenesisState.Fields().ByName("reward_histories")
+	fd_GenesisState_auto_restakes = md_GenesisState.Fields().ByName("auto_restakes")
 }
 
 var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
@@ -358,6 +731,54 @@ func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
+	if x.PendingVolumeReport != nil {
+		value := protoreflect.ValueOfMessage(x.PendingVolumeReport.ProtoReflect())
+		if !f(fd_GenesisState_pending_volume_report, value) {
+			return
+		}
+	}
+	if len(x.PendingWalletVolumes) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_10_list{list: &x.PendingWalletVolumes})
+		if !f(fd_GenesisState_pending_wallet_volumes, value) {
+			return
+		}
+	}
+	if len(x.PendingDistributions) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_11_list{list: &x.PendingDistributions})
+		if !f(fd_GenesisState_pending_distributions, value) {
+			return
+		}
+	}
+	if len(x.DistributionVolumes) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_12_list{list: &x.DistributionVolumes})
+		if !f(fd_GenesisState_distribution_volumes, value) {
+			return
+		}
+	}
+	if len(x.VolumeReportDisputes) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_13_list{list: &x.VolumeReportDisputes})
+		if !f(fd_GenesisState_volume_report_disputes, value) {
+			return
+		}
+	}
+	if len(x.ReportEquivocations) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_14_list{list: &x.ReportEquivocations})
+		if !f(fd_GenesisState_report_equivocations, value) {
+			return
+		}
+	}
+	if len(x.RewardHistories) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_15_list{list: &x.RewardHistories})
+		if !f(fd_GenesisState_reward_histories, value) {
+			return
+		}
+	}
+	if len(x.AutoRestakes) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_16_list{list: &x.AutoRestakes})
+		if !f(fd_GenesisState_auto_restakes, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -389,6 +810,22 @@ func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool
 		return x.MaturedEpoch != ""
 	case "stratos.pot.v1.GenesisState.reward_total_info":
 		return len(x.RewardTotalInfo) != 0
+	case "stratos.pot.v1.GenesisState.pending_volume_report":
+		return x.PendingVolumeReport != nil
+	case "stratos.pot.v1.GenesisState.pending_wallet_volumes":
+		return len(x.PendingWalletVolumes) != 0
+	case "stratos.pot.v1.GenesisState.pending_distributions":
+		return len(x.PendingDistributions) != 0
+	case "stratos.pot.v1.GenesisState.distribution_volumes":
+		return len(x.DistributionVolumes) != 0
+	case "stratos.pot.v1.GenesisState.volume_report_disputes":
+		return len(x.VolumeReportDisputes) != 0
+	case "stratos.pot.v1.GenesisState.report_equivocations":
+		return len(x.ReportEquivocations) != 0
+	case "stratos.pot.v1.GenesisState.reward_histories":
+		return len(x.RewardHistories) != 0
+	case "stratos.pot.v1.GenesisState.auto_restakes":
+		return len(x.AutoRestakes) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.GenesisState"))
@@ -421,6 +858,22 @@ func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
 		x.MaturedEpoch = ""
 	case "stratos.pot.v1.GenesisState.reward_total_info":
 		x.RewardTotalInfo = nil
+	case "stratos.pot.v1.GenesisState.pending_volume_report":
+		x.PendingVolumeReport = nil
+	case "stratos.pot.v1.GenesisState.pending_wallet_volumes":
+		x.PendingWalletVolumes = nil
+	case "stratos.pot.v1.GenesisState.pending_distributions":
+		x.PendingDistributions = nil
+	case "stratos.pot.v1.GenesisState.distribution_volumes":
+		x.DistributionVolumes = nil
+	case "stratos.pot.v1.GenesisState.volume_report_disputes":
+		x.VolumeReportDisputes = nil
+	case "stratos.pot.v1.GenesisState.report_equivocations":
+		x.ReportEquivocations = nil
+	case "stratos.pot.v1.GenesisState.reward_histories":
+		x.RewardHistories = nil
+	case "stratos.pot.v1.GenesisState.auto_restakes":
+		x.AutoRestakes = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.GenesisState"))
@@ -473,6 +926,51 @@ func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescripto
 		}
 		listValue := &_GenesisState_8_list{list: &x.RewardTotalInfo}
 		return protoreflect.ValueOfList(listValue)
+	case "stratos.pot.v1.GenesisState.pending_volume_report":
+		value := x.PendingVolumeReport
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "stratos.pot.v1.GenesisState.pending_wallet_volumes":
+		if len(x.PendingWalletVolumes) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_10_list{})
+		}
+		listValue := &_GenesisState_10_list{list: &x.PendingWalletVolumes}
+		return protoreflect.ValueOfList(listValue)
+	case "stratos.pot.v1.GenesisState.pending_distributions":
+		if len(x.PendingDistributions) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_11_list{})
+		}
+		listValue := &_GenesisState_11_list{list: &x.PendingDistributions}
+		return protoreflect.ValueOfList(listValue)
+	case "stratos.pot.v1.GenesisState.distribution_volumes":
+		if len(x.DistributionVolumes) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_12_list{})
+		}
+		listValue := &_GenesisState_12_list{list: &x.DistributionVolumes}
+		return protoreflect.ValueOfList(listValue)
+	case "stratos.pot.v1.GenesisState.volume_report_disputes":
+		if len(x.VolumeReportDisputes) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_13_list{})
+		}
+		listValue := &_GenesisState_13_list{list: &x.VolumeReportDisputes}
+		return protoreflect.ValueOfList(listValue)
+	case "stratos.pot.v1.GenesisState.report_equivocations":
+		if len(x.ReportEquivocations) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_14_list{})
+		}
+		listValue := &_GenesisState_14_list{list: &x.ReportEquivocations}
+		return protoreflect.ValueOfList(listValue)
+	case "stratos.pot.v1.GenesisState.reward_histories":
+		if len(x.RewardHistories) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_15_list{})
+		}
+		listValue := &_GenesisState_15_list{list: &x.RewardHistories}
+		return protoreflect.ValueOfList(listValue)
+	case "stratos.pot.v1.GenesisState.auto_restakes":
+		if len(x.AutoRestakes) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_16_list{})
+		}
+		listValue := &_GenesisState_16_list{list: &x.AutoRestakes}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.GenesisState"))
@@ -517,6 +1015,36 @@ func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value
 		lv := value.List()
 		clv := lv.(*_GenesisState_8_list)
 		x.RewardTotalInfo = *clv.list
+	case "stratos.pot.v1.GenesisState.pending_volume_report":
+		x.PendingVolumeReport = value.Message().Interface().(*PendingVolumeReport)
+	case "stratos.pot.v1.GenesisState.pending_wallet_volumes":
+		lv := value.List()
+		clv := lv.(*_GenesisState_10_list)
+		x.PendingWalletVolumes = *clv.list
+	case "stratos.pot.v1.GenesisState.pending_distributions":
+		lv := value.List()
+		clv := lv.(*_GenesisState_11_list)
+		x.PendingDistributions = *clv.list
+	case "stratos.pot.v1.GenesisState.distribution_volumes":
+		lv := value.List()
+		clv := lv.(*_GenesisState_12_list)
+		x.DistributionVolumes = *clv.list
+	case "stratos.pot.v1.GenesisState.volume_report_disputes":
+		lv := value.List()
+		clv := lv.(*_GenesisState_13_list)
+		x.VolumeReportDisputes = *clv.list
+	case "stratos.pot.v1.GenesisState.report_equivocations":
+		lv := value.List()
+		clv := lv.(*_GenesisState_14_list)
+		x.ReportEquivocations = *clv.list
+	case "stratos.pot.v1.GenesisState.reward_histories":
+		lv := value.List()
+		clv := lv.(*_GenesisState_15_list)
+		x.RewardHistories = *clv.list
+	case "stratos.pot.v1.GenesisState.auto_restakes":
+		lv := value.List()
+		clv := lv.(*_GenesisState_16_list)
+		x.AutoRestakes = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.GenesisState"))
@@ -571,6 +1099,53 @@ func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) p
 		}
 		value := &_GenesisState_8_list{list: &x.RewardTotalInfo}
 		return protoreflect.ValueOfList(value)
+	case "stratos.pot.v1.GenesisState.pending_volume_report":
+		if x.PendingVolumeReport == nil {
+			x.PendingVolumeReport = new(PendingVolumeReport)
+		}
+		return protoreflect.ValueOfMessage(x.PendingVolumeReport.ProtoReflect())
+	case "stratos.pot.v1.GenesisState.pending_wallet_volumes":
+		if x.PendingWalletVolumes == nil {
+			x.PendingWalletVolumes = []*SingleWalletVolume{}
+		}
+		value := &_GenesisState_10_list{list: &x.PendingWalletVolumes}
+		return protoreflect.ValueOfList(value)
+	case "stratos.pot.v1.GenesisState.pending_distributions":
+		if x.PendingDistributions == nil {
+			x.PendingDistributions = []*PendingDistribution{}
+		}
+		value := &_GenesisState_11_list{list: &x.PendingDistributions}
+		return protoreflect.ValueOfList(value)
+	case "stratos.pot.v1.GenesisState.distribution_volumes":
+		if x.DistributionVolumes == nil {
+			x.DistributionVolumes = []*DistributionVolume{}
+		}
+		value := &_GenesisState_12_list{list: &x.DistributionVolumes}
+		return protoreflect.ValueOfList(value)
+	case "stratos.pot.v1.GenesisState.volume_report_disputes":
+		if x.VolumeReportDisputes == nil {
+			x.VolumeReportDisputes = []*VolumeReportDispute{}
+		}
+		value := &_GenesisState_13_list{list: &x.VolumeReportDisputes}
+		return protoreflect.ValueOfList(value)
+	case "stratos.pot.v1.GenesisState.report_equivocations":
+		if x.ReportEquivocations == nil {
+			x.ReportEquivocations = []*ReportEquivocation{}
+		}
+		value := &_GenesisState_14_list{list: &x.ReportEquivocations}
+		return protoreflect.ValueOfList(value)
+	case "stratos.pot.v1.GenesisState.reward_histories":
+		if x.RewardHistories == nil {
+			x.RewardHistories = []*RewardHistory{}
+		}
+		value := &_GenesisState_15_list{list: &x.RewardHistories}
+		return protoreflect.ValueOfList(value)
+	case "stratos.pot.v1.GenesisState.auto_restakes":
+		if x.AutoRestakes == nil {
+			x.AutoRestakes = []*AutoRestake{}
+		}
+		value := &_GenesisState_16_list{list: &x.AutoRestakes}
+		return protoreflect.ValueOfList(value)
 	case "stratos.pot.v1.GenesisState.last_distributed_epoch":
 		panic(fmt.Errorf("field last_distributed_epoch of message stratos.pot.v1.GenesisState is not mutable"))
 	case "stratos.pot.v1.GenesisState.matured_epoch":
@@ -610,6 +1185,30 @@ func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor)
 	case "stratos.pot.v1.GenesisState.reward_total_info":
 		list := []*RewardTotal{}
 		return protoreflect.ValueOfList(&_GenesisState_8_list{list: &list})
+	case "stratos.pot.v1.GenesisState.pending_volume_report":
+		m := new(PendingVolumeReport)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "stratos.pot.v1.GenesisState.pending_wallet_volumes":
+		list := []*SingleWalletVolume{}
+		return protoreflect.ValueOfList(&_GenesisState_10_list{list: &list})
+	case "stratos.pot.v1.GenesisState.pending_distributions":
+		list := []*PendingDistribution{}
+		return protoreflect.ValueOfList(&_GenesisState_11_list{list: &list})
+	case "stratos.pot.v1.GenesisState.distribution_volumes":
+		list := []*DistributionVolume{}
+		return protoreflect.ValueOfList(&_GenesisState_12_list{list: &list})
+	case "stratos.pot.v1.GenesisState.volume_report_disputes":
+		list := []*VolumeReportDispute{}
+		return protoreflect.ValueOfList(&_GenesisState_13_list{list: &list})
+	case "stratos.pot.v1.GenesisState.report_equivocations":
+		list := []*ReportEquivocation{}
+		return protoreflect.ValueOfList(&_GenesisState_14_list{list: &list})
+	case "stratos.pot.v1.GenesisState.reward_histories":
+		list := []*RewardHistory{}
+		return protoreflect.ValueOfList(&_GenesisState_15_list{list: &list})
+	case "stratos.pot.v1.GenesisState.auto_restakes":
+		list := []*AutoRestake{}
+		return protoreflect.ValueOfList(&_GenesisState_16_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.GenesisState"))
@@ -683,42 +1282,88 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			l = options.Size(x.Params)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.TotalMinedToken != nil {
-			l = options.Size(x.TotalMinedToken)
+		if x.TotalMinedToken != nil {
+			l = options.Size(x.TotalMinedToken)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.LastDistributedEpoch)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.ImmatureTotalInfo) > 0 {
+			for _, e := range x.ImmatureTotalInfo {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.MatureTotalInfo) > 0 {
+			for _, e := range x.MatureTotalInfo {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.IndividualRewardInfo) > 0 {
+			for _, e := range x.IndividualRewardInfo {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.MaturedEpoch)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.RewardTotalInfo) > 0 {
+			for _, e := range x.RewardTotalInfo {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.PendingVolumeReport != nil {
+			l = options.Size(x.PendingVolumeReport)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.LastDistributedEpoch)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if len(x.PendingWalletVolumes) > 0 {
+			for _, e := range x.PendingWalletVolumes {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
-		if len(x.ImmatureTotalInfo) > 0 {
-			for _, e := range x.ImmatureTotalInfo {
+		if len(x.PendingDistributions) > 0 {
+			for _, e := range x.PendingDistributions {
 				l = options.Size(e)
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
-		if len(x.MatureTotalInfo) > 0 {
-			for _, e := range x.MatureTotalInfo {
+		if len(x.DistributionVolumes) > 0 {
+			for _, e := range x.DistributionVolumes {
 				l = options.Size(e)
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
-		if len(x.IndividualRewardInfo) > 0 {
-			for _, e := range x.IndividualRewardInfo {
+		if len(x.VolumeReportDisputes) > 0 {
+			for _, e := range x.VolumeReportDisputes {
 				l = options.Size(e)
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
-		l = len(x.MaturedEpoch)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if len(x.ReportEquivocations) > 0 {
+			for _, e := range x.ReportEquivocations {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
-		if len(x.RewardTotalInfo) > 0 {
-			for _, e := range x.RewardTotalInfo {
+		if len(x.RewardHistories) > 0 {
+			for _, e := range x.RewardHistories {
 				l = options.Size(e)
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
+		if len(x.AutoRestakes) > 0 {
+			for _, e := range x.AutoRestakes {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -748,6 +1393,134 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.AutoRestakes) > 0 {
+			for iNdEx := len(x.AutoRestakes) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.AutoRestakes[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0x82
+			}
+		}
+		if len(x.RewardHistories) > 0 {
+			for iNdEx := len(x.RewardHistories) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.RewardHistories[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x7a
+			}
+		}
+		if len(x.ReportEquivocations) > 0 {
+			for iNdEx := len(x.ReportEquivocations) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ReportEquivocations[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x72
+			}
+		}
+		if len(x.VolumeReportDisputes) > 0 {
+			for iNdEx := len(x.VolumeReportDisputes) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.VolumeReportDisputes[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x6a
+			}
+		}
+		if len(x.DistributionVolumes) > 0 {
+			for iNdEx := len(x.DistributionVolumes) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.DistributionVolumes[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x62
+			}
+		}
+		if len(x.PendingDistributions) > 0 {
+			for iNdEx := len(x.PendingDistributions) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.PendingDistributions[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x5a
+			}
+		}
+		if len(x.PendingWalletVolumes) > 0 {
+			for iNdEx := len(x.PendingWalletVolumes) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.PendingWalletVolumes[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x52
+			}
+		}
+		if x.PendingVolumeReport != nil {
+			encoded, err := options.Marshal(x.PendingVolumeReport)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x4a
+		}
 		if len(x.RewardTotalInfo) > 0 {
 			for iNdEx := len(x.RewardTotalInfo) - 1; iNdEx >= 0; iNdEx-- {
 				encoded, err := options.Marshal(x.RewardTotalInfo[iNdEx])
@@ -884,28 +1657,166 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if shift >= 64 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 				}
-				if iNdEx >= l {
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Params == nil {
+					x.Params = &Params{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Params); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalMinedToken", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.TotalMinedToken == nil {
+					x.TotalMinedToken = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TotalMinedToken); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastDistributedEpoch", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LastDistributedEpoch = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ImmatureTotalInfo", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				wire |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				x.ImmatureTotalInfo = append(x.ImmatureTotalInfo, &ImmatureTotal{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ImmatureTotalInfo[len(x.ImmatureTotalInfo)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-			}
-			fieldNum := int32(wire >> 3)
-			wireType := int(wire & 0x7)
-			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
-			}
-			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
-			}
-			switch fieldNum {
-			case 1:
+				iNdEx = postIndex
+			case 5:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MatureTotalInfo", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -932,16 +1843,14 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Params == nil {
-					x.Params = &Params{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Params); err != nil {
+				x.MatureTotalInfo = append(x.MatureTotalInfo, &MatureTotal{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MatureTotalInfo[len(x.MatureTotalInfo)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 2:
+			case 6:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalMinedToken", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IndividualRewardInfo", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -968,16 +1877,14 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.TotalMinedToken == nil {
-					x.TotalMinedToken = &v1beta1.Coin{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TotalMinedToken); err != nil {
+				x.IndividualRewardInfo = append(x.IndividualRewardInfo, &Reward{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.IndividualRewardInfo[len(x.IndividualRewardInfo)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 3:
+			case 7:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastDistributedEpoch", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaturedEpoch", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -1005,11 +1912,11 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.LastDistributedEpoch = string(dAtA[iNdEx:postIndex])
+				x.MaturedEpoch = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 4:
+			case 8:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ImmatureTotalInfo", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RewardTotalInfo", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -1036,14 +1943,14 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ImmatureTotalInfo = append(x.ImmatureTotalInfo, &ImmatureTotal{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ImmatureTotalInfo[len(x.ImmatureTotalInfo)-1]); err != nil {
+				x.RewardTotalInfo = append(x.RewardTotalInfo, &RewardTotal{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RewardTotalInfo[len(x.RewardTotalInfo)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 5:
+			case 9:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MatureTotalInfo", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PendingVolumeReport", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -1070,14 +1977,16 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.MatureTotalInfo = append(x.MatureTotalInfo, &MatureTotal{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MatureTotalInfo[len(x.MatureTotalInfo)-1]); err != nil {
+				if x.PendingVolumeReport == nil {
+					x.PendingVolumeReport = &PendingVolumeReport{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PendingVolumeReport); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 6:
+			case 10:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IndividualRewardInfo", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PendingWalletVolumes", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -1104,16 +2013,16 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.IndividualRewardInfo = append(x.IndividualRewardInfo, &Reward{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.IndividualRewardInfo[len(x.IndividualRewardInfo)-1]); err != nil {
+				x.PendingWalletVolumes = append(x.PendingWalletVolumes, &SingleWalletVolume{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PendingWalletVolumes[len(x.PendingWalletVolumes)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 7:
+			case 11:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaturedEpoch", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PendingDistributions", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1123,27 +2032,29 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.MaturedEpoch = string(dAtA[iNdEx:postIndex])
+				x.PendingDistributions = append(x.PendingDistributions, &PendingDistribution{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PendingDistributions[len(x.PendingDistributions)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
-			case 8:
+			case 12:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RewardTotalInfo", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DistributionVolumes", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -1170,8 +2081,144 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.RewardTotalInfo = append(x.RewardTotalInfo, &RewardTotal{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RewardTotalInfo[len(x.RewardTotalInfo)-1]); err != nil {
+				x.DistributionVolumes = append(x.DistributionVolumes, &DistributionVolume{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DistributionVolumes[len(x.DistributionVolumes)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 13:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VolumeReportDisputes", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.VolumeReportDisputes = append(x.VolumeReportDisputes, &VolumeReportDispute{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.VolumeReportDisputes[len(x.VolumeReportDisputes)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 14:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReportEquivocations", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ReportEquivocations = append(x.ReportEquivocations, &ReportEquivocation{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ReportEquivocations[len(x.ReportEquivocations)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 15:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RewardHistories", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RewardHistories = append(x.RewardHistories, &RewardHistory{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RewardHistories[len(x.RewardHistories)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 16:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AutoRestakes", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AutoRestakes = append(x.AutoRestakes, &AutoRestake{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.AutoRestakes[len(x.AutoRestakes)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -2844,14 +3891,23 @@ type GenesisState struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Params               *Params          `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
-	TotalMinedToken      *v1beta1.Coin    `protobuf:"bytes,2,opt,name=total_mined_token,json=totalMinedToken,proto3" json:"total_mined_token,omitempty"`
-	LastDistributedEpoch string           `protobuf:"bytes,3,opt,name=last_distributed_epoch,json=lastDistributedEpoch,proto3" json:"last_distributed_epoch,omitempty"`
-	ImmatureTotalInfo    []*ImmatureTotal `protobuf:"bytes,4,rep,name=immature_total_info,json=immatureTotalInfo,proto3" json:"immature_total_info,omitempty"`
-	MatureTotalInfo      []*MatureTotal   `protobuf:"bytes,5,rep,name=mature_total_info,json=matureTotalInfo,proto3" json:"mature_total_info,omitempty"`
-	IndividualRewardInfo []*Reward        `protobuf:"bytes,6,rep,name=individual_reward_info,json=individualRewardInfo,proto3" json:"individual_reward_info,omitempty"`
-	MaturedEpoch         string           `protobuf:"bytes,7,opt,name=matured_epoch,json=maturedEpoch,proto3" json:"matured_epoch,omitempty"`
-	RewardTotalInfo      []*RewardTotal   `protobuf:"bytes,8,rep,name=reward_total_info,json=rewardTotalInfo,proto3" json:"reward_total_info,omitempty"`
+	Params               *Params              `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	TotalMinedToken      *v1beta1.Coin        `protobuf:"bytes,2,opt,name=total_mined_token,json=totalMinedToken,proto3" json:"total_mined_token,omitempty"`
+	LastDistributedEpoch string               `protobuf:"bytes,3,opt,name=last_distributed_epoch,json=lastDistributedEpoch,proto3" json:"last_distributed_epoch,omitempty"`
+	ImmatureTotalInfo    []*ImmatureTotal     `protobuf:"bytes,4,rep,name=immature_total_info,json=immatureTotalInfo,proto3" json:"immature_total_info,omitempty"`
+	MatureTotalInfo      []*MatureTotal       `protobuf:"bytes,5,rep,name=mature_total_info,json=matureTotalInfo,proto3" json:"mature_total_info,omitempty"`
+	IndividualRewardInfo []*Reward            `protobuf:"bytes,6,rep,name=individual_reward_info,json=individualRewardInfo,proto3" json:"individual_reward_info,omitempty"`
+	MaturedEpoch         string               `protobuf:"bytes,7,opt,name=matured_epoch,json=maturedEpoch,proto3" json:"matured_epoch,omitempty"`
+	RewardTotalInfo      []*RewardTotal       `protobuf:"bytes,8,rep,name=reward_total_info,json=rewardTotalInfo,proto3" json:"reward_total_info,omitempty"`
+	PendingVolumeReport  *PendingVolumeReport `protobuf:"bytes,9,opt,name=pending_volume_report,json=pendingVolumeReport,proto3" json:"pending_volume_report,omitempty"`
+	// pending_wallet_volumes are the wallet volumes received for the pending volume report, in report order
+	PendingWalletVolumes []*SingleWalletVolume  `protobuf:"bytes,10,rep,name=pending_wallet_volumes,json=pendingWalletVolumes,proto3" json:"pending_wallet_volumes,omitempty"`
+	PendingDistributions []*PendingDistribution `protobuf:"bytes,11,rep,name=pending_distributions,json=pendingDistributions,proto3" json:"pending_distributions,omitempty"`
+	DistributionVolumes  []*DistributionVolume  `protobuf:"bytes,12,rep,name=distribution_volumes,json=distributionVolumes,proto3" json:"distribution_volumes,omitempty"`
+	VolumeReportDisputes []*VolumeReportDispute `protobuf:"bytes,13,rep,name=volume_report_disputes,json=volumeReportDisputes,proto3" json:"volume_report_disputes,omitempty"`
+	ReportEquivocations  []*ReportEquivocation  `protobuf:"bytes,14,rep,name=report_equivocations,json=reportEquivocations,proto3" json:"report_equivocations,omitempty"`
+	RewardHistories      []*RewardHistory       `protobuf:"bytes,15,rep,name=reward_histories,json=rewardHistories,proto3" json:"reward_histories,omitempty"`
+	AutoRestakes         []*AutoRestake         `protobuf:"bytes,16,rep,name=auto_restakes,json=autoRestakes,proto3" json:"auto_restakes,omitempty"`
 }
 
 func (x *GenesisState) Reset() {
@@ -2930,6 +3986,62 @@ func (x *GenesisState) GetRewardTotalInfo() []*RewardTotal {
 	return nil
 }
 
+func (x *GenesisState) GetPendingVolumeReport() *PendingVolumeReport {
+	if x != nil {
+		return x.PendingVolumeReport
+	}
+	return nil
+}
+
+func (x *GenesisState) GetPendingWalletVolumes() []*SingleWalletVolume {
+	if x != nil {
+		return x.PendingWalletVolumes
+	}
+	return nil
+}
+
+func (x *GenesisState) GetPendingDistributions() []*PendingDistribution {
+	if x != nil {
+		return x.PendingDistributions
+	}
+	return nil
+}
+
+func (x *GenesisState) GetDistributionVolumes() []*DistributionVolume {
+	if x != nil {
+		return x.DistributionVolumes
+	}
+	return nil
+}
+
+func (x *GenesisState) GetVolumeReportDisputes() []*VolumeReportDispute {
+	if x != nil {
+		return x.VolumeReportDisputes
+	}
+	return nil
+}
+
+func (x *GenesisState) GetReportEquivocations() []*ReportEquivocation {
+	if x != nil {
+		return x.ReportEquivocations
+	}
+	return nil
+}
+
+func (x *GenesisState) GetRewardHistories() []*RewardHistory {
+	if x != nil {
+		return x.RewardHistories
+	}
+	return nil
+}
+
+func (x *GenesisState) GetAutoRestakes() []*AutoRestake {
+	if x != nil {
+		return x.AutoRestakes
+	}
+	return nil
+}
+
 type ImmatureTotal struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -3072,7 +4184,7 @@ var file_stratos_pot_v1_genesis_proto_rawDesc = []byte{
 	0x74, 0x6f, 0x1a, 0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f,
 	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x1a, 0x18, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2f, 0x70, 0x6f, 0x74, 0x2f,
-	0x76, 0x31, 0x2f, 0x70, 0x6f, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xda, 0x08, 0x0a,
+	0x76, 0x31, 0x2f, 0x70, 0x6f, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xad, 0x12, 0x0a,
 	0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x54, 0x0a,
 	0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
 	0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e, 0x70, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50,
@@ -3142,63 +4254,140 @@ var file_stratos_pot_v1_genesis_proto_rawDesc = []byte{
 	0x64, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0xf2, 0xde, 0x1f, 0x18,
 	0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x74, 0x6f, 0x74,
 	0x61, 0x6c, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x22, 0x52, 0x0f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64,
-	0x54, 0x6f, 0x74, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0xf7, 0x01, 0x0a, 0x0d, 0x49, 0x6d,
-	0x6d, 0x61, 0x74, 0x75, 0x72, 0x65, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x6a, 0x0a, 0x0e, 0x77,
-	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x43, 0xea, 0xde, 0x1f, 0x0e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f,
-	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0xf2, 0xde, 0x1f, 0x15, 0x79, 0x61, 0x6d, 0x6c, 0x3a,
-	0x22, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22,
-	0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0d, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74,
-	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x7a, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
-	0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69,
-	0x6e, 0x42, 0x49, 0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0xf2, 0xde, 0x1f, 0x0c, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22,
-	0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b,
-	0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x22, 0xf5, 0x01, 0x0a, 0x0b, 0x4d, 0x61, 0x74, 0x75, 0x72, 0x65, 0x54, 0x6f,
-	0x74, 0x61, 0x6c, 0x12, 0x6a, 0x0a, 0x0e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x61, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x43, 0xea, 0xde, 0x1f,
-	0x0e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0xf2,
-	0xde, 0x1f, 0x15, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f,
-	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x52, 0x0d, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
-	0x7a, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19,
-	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62,
-	0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x49, 0xc8, 0xde, 0x1f, 0x00, 0xea,
-	0xde, 0x1f, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0xf2, 0xde, 0x1f, 0x0c, 0x79, 0x61, 0x6d, 0x6c,
-	0x3a, 0x22, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43,
-	0x6f, 0x69, 0x6e, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xd9, 0x01, 0x0a, 0x0b,
-	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x5d, 0x0a, 0x05, 0x65,
-	0x70, 0x6f, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x47, 0xc8, 0xde, 0x1f, 0x00,
-	0xda, 0xde, 0x1f, 0x26, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b,
-	0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xea, 0xde, 0x1f, 0x05, 0x65, 0x70,
-	0x6f, 0x63, 0x68, 0xf2, 0xde, 0x1f, 0x0c, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x65, 0x70, 0x6f,
-	0x63, 0x68, 0x22, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x6b, 0x0a, 0x0c, 0x74, 0x6f,
-	0x74, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1b, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e, 0x70, 0x6f, 0x74, 0x2e, 0x76,
-	0x31, 0x2e, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x42, 0x2b, 0xc8,
-	0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x77,
-	0x61, 0x72, 0x64, 0xf2, 0xde, 0x1f, 0x13, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x74, 0x6f, 0x74,
-	0x61, 0x6c, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x22, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61,
-	0x6c, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x42, 0xa7, 0x01, 0x0a, 0x12, 0x63, 0x6f, 0x6d, 0x2e,
-	0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e, 0x70, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x42, 0x0c,
-	0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x25,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2f, 0x70, 0x6f, 0x74, 0x2f, 0x76, 0x31, 0x3b,
-	0x70, 0x6f, 0x74, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x53, 0x50, 0x58, 0xaa, 0x02, 0x0e, 0x53, 0x74,
-	0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e, 0x50, 0x6f, 0x74, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0e, 0x53,
-	0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x5c, 0x50, 0x6f, 0x74, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1a,
-	0x53, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x5c, 0x50, 0x6f, 0x74, 0x5c, 0x56, 0x31, 0x5c, 0x47,
-	0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x10, 0x53, 0x74, 0x72,
-	0x61, 0x74, 0x6f, 0x73, 0x3a, 0x3a, 0x50, 0x6f, 0x74, 0x3a, 0x3a, 0x56, 0x31, 0xa8, 0xe2, 0x1e,
-	0x01, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x54, 0x6f, 0x74, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x9c, 0x01, 0x0a, 0x15, 0x70, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x72, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x73, 0x74, 0x72, 0x61,
+	0x74, 0x6f, 0x73, 0x2e, 0x70, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x43,
+	0xea, 0xde, 0x1f, 0x1f, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x76, 0x6f, 0x6c, 0x75,
+	0x6d, 0x65, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d,
+	0x70, 0x74, 0x79, 0xf2, 0xde, 0x1f, 0x1c, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x70, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x5f, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x72, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x22, 0x52, 0x13, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x56, 0x6f, 0x6c, 0x75,
+	0x6d, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0xa3, 0x01, 0x0a, 0x16, 0x70, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x5f, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x76, 0x6f, 0x6c, 0x75,
+	0x6d, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x73, 0x74, 0x72, 0x61,
+	0x74, 0x6f, 0x73, 0x2e, 0x70, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x6e, 0x67, 0x6c,
+	0x65, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x42, 0x49, 0xc8,
+	0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f, 0x20, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x77,
+	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x73, 0x2c, 0x6f, 0x6d,
+	0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0xf2, 0xde, 0x1f, 0x1d, 0x79, 0x61, 0x6d, 0x6c, 0x3a,
+	0x22, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f,
+	0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x73, 0x22, 0x52, 0x14, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x73, 0x12, 0xa1,
+	0x01, 0x0a, 0x15, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23,
+	0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e, 0x70, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x42, 0x47, 0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f, 0x1f, 0x70, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0xf2, 0xde, 0x1f, 0x1c,
+	0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x69,
+	0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x52, 0x14, 0x70, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x9c, 0x01, 0x0a, 0x14, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x22, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e, 0x70, 0x6f, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x56,
+	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x42, 0x45, 0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f, 0x1e, 0x64,
+	0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x76, 0x6f, 0x6c, 0x75,
+	0x6d, 0x65, 0x73, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0xf2, 0xde, 0x1f,
+	0x1b, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x73, 0x22, 0x52, 0x13, 0x64, 0x69,
+	0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
+	0x73, 0x12, 0xa4, 0x01, 0x0a, 0x16, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x72, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x5f, 0x64, 0x69, 0x73, 0x70, 0x75, 0x74, 0x65, 0x73, 0x18, 0x0d, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x23, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e, 0x70, 0x6f, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x44, 0x69, 0x73, 0x70, 0x75, 0x74, 0x65, 0x42, 0x49, 0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f,
+	0x20, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x64,
+	0x69, 0x73, 0x70, 0x75, 0x74, 0x65, 0x73, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74,
+	0x79, 0xf2, 0xde, 0x1f, 0x1d, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x76, 0x6f, 0x6c, 0x75, 0x6d,
+	0x65, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x64, 0x69, 0x73, 0x70, 0x75, 0x74, 0x65,
+	0x73, 0x22, 0x52, 0x14, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x44, 0x69, 0x73, 0x70, 0x75, 0x74, 0x65, 0x73, 0x12, 0x9c, 0x01, 0x0a, 0x14, 0x72, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x5f, 0x65, 0x71, 0x75, 0x69, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f,
+	0x73, 0x2e, 0x70, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x45,
+	0x71, 0x75, 0x69, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x45, 0xc8, 0xde, 0x1f,
+	0x00, 0xea, 0xde, 0x1f, 0x1e, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x65, 0x71, 0x75, 0x69,
+	0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d,
+	0x70, 0x74, 0x79, 0xf2, 0xde, 0x1f, 0x1b, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x72, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x5f, 0x65, 0x71, 0x75, 0x69, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x22, 0x52, 0x13, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x71, 0x75, 0x69, 0x76, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x87, 0x01, 0x0a, 0x10, 0x72, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x0f, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e, 0x70, 0x6f, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x42, 0x3d, 0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f, 0x1a, 0x72, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x2c, 0x6f, 0x6d, 0x69, 0x74,
+	0x65, 0x6d, 0x70, 0x74, 0x79, 0xf2, 0xde, 0x1f, 0x17, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x72,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x22,
+	0x52, 0x0f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x65,
+	0x73, 0x12, 0x79, 0x0a, 0x0d, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x72, 0x65, 0x73, 0x74, 0x61, 0x6b,
+	0x65, 0x73, 0x18, 0x10, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74,
+	0x6f, 0x73, 0x2e, 0x70, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x6f, 0x52, 0x65,
+	0x73, 0x74, 0x61, 0x6b, 0x65, 0x42, 0x37, 0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f, 0x17, 0x61,
+	0x75, 0x74, 0x6f, 0x5f, 0x72, 0x65, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x73, 0x2c, 0x6f, 0x6d, 0x69,
+	0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0xf2, 0xde, 0x1f, 0x14, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22,
+	0x61, 0x75, 0x74, 0x6f, 0x5f, 0x72, 0x65, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x73, 0x22, 0x52, 0x0c,
+	0x61, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x73, 0x22, 0xf7, 0x01, 0x0a,
+	0x0d, 0x49, 0x6d, 0x6d, 0x61, 0x74, 0x75, 0x72, 0x65, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x6a,
+	0x0a, 0x0e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x43, 0xea, 0xde, 0x1f, 0x0e, 0x77, 0x61, 0x6c, 0x6c,
+	0x65, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0xf2, 0xde, 0x1f, 0x15, 0x79, 0x61,
+	0x6d, 0x6c, 0x3a, 0x22, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x22, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0d, 0x77, 0x61, 0x6c,
+	0x6c, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x7a, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x43, 0x6f, 0x69, 0x6e, 0x42, 0x49, 0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0xf2, 0xde, 0x1f, 0x0c, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x22, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d,
+	0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xf5, 0x01, 0x0a, 0x0b, 0x4d, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x6a, 0x0a, 0x0e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x43,
+	0xea, 0xde, 0x1f, 0x0e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0xf2, 0xde, 0x1f, 0x15, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x77, 0x61, 0x6c, 0x6c,
+	0x65, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xd2, 0xb4, 0x2d, 0x14, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x52, 0x0d, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x7a, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x49, 0xc8, 0xde,
+	0x1f, 0x00, 0xea, 0xde, 0x1f, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0xf2, 0xde, 0x1f, 0x0c, 0x79,
+	0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xaa, 0xdf, 0x1f, 0x28, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xd9,
+	0x01, 0x0a, 0x0b, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x5d,
+	0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x47, 0xc8,
+	0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x26, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d,
+	0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xea, 0xde, 0x1f,
+	0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0xf2, 0xde, 0x1f, 0x0c, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22,
+	0x65, 0x70, 0x6f, 0x63, 0x68, 0x22, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x6b, 0x0a,
+	0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e, 0x70, 0x6f,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x42, 0x2b, 0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f,
+	0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0xf2, 0xde, 0x1f, 0x13, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x22, 0x52, 0x0b, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x42, 0xa7, 0x01, 0x0a, 0x12, 0x63,
+	0x6f, 0x6d, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e, 0x70, 0x6f, 0x74, 0x2e, 0x76,
+	0x31, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50,
+	0x01, 0x5a, 0x25, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2f, 0x70, 0x6f, 0x74, 0x2f,
+	0x76, 0x31, 0x3b, 0x70, 0x6f, 0x74, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x53, 0x50, 0x58, 0xaa, 0x02,
+	0x0e, 0x53, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e, 0x50, 0x6f, 0x74, 0x2e, 0x56, 0x31, 0xca,
+	0x02, 0x0e, 0x53, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x5c, 0x50, 0x6f, 0x74, 0x5c, 0x56, 0x31,
+	0xe2, 0x02, 0x1a, 0x53, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x5c, 0x50, 0x6f, 0x74, 0x5c, 0x56,
+	0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x10,
+	0x53, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x3a, 0x3a, 0x50, 0x6f, 0x74, 0x3a, 0x3a, 0x56, 0x31,
+	0xa8, 0xe2, 0x1e, 0x01, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -3215,30 +4404,46 @@ func file_stratos_pot_v1_genesis_proto_rawDescGZIP() []byte {
 
 var file_stratos_pot_v1_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_stratos_pot_v1_genesis_proto_goTypes = []interface{}{
-	(*GenesisState)(nil),  // 0: stratos.pot.v1.GenesisState
-	(*ImmatureTotal)(nil), // 1: stratos.pot.v1.ImmatureTotal
-	(*MatureTotal)(nil),   // 2: stratos.pot.v1.MatureTotal
-	(*RewardTotal)(nil),   // 3: stratos.pot.v1.RewardTotal
-	(*Params)(nil),        // 4: stratos.pot.v1.Params
-	(*v1beta1.Coin)(nil),  // 5: cosmos.base.v1beta1.Coin
-	(*Reward)(nil),        // 6: stratos.pot.v1.Reward
-	(*TotalReward)(nil),   // 7: stratos.pot.v1.TotalReward
+	(*GenesisState)(nil),        // 0: stratos.pot.v1.GenesisState
+	(*ImmatureTotal)(nil),       // 1: stratos.pot.v1.ImmatureTotal
+	(*MatureTotal)(nil),         // 2: stratos.pot.v1.MatureTotal
+	(*RewardTotal)(nil),         // 3: stratos.pot.v1.RewardTotal
+	(*Params)(nil),              // 4: stratos.pot.v1.Params
+	(*v1beta1.Coin)(nil),        // 5: cosmos.base.v1beta1.Coin
+	(*Reward)(nil),              // 6: stratos.pot.v1.Reward
+	(*PendingVolumeReport)(nil), // 7: stratos.pot.v1.PendingVolumeReport
+	(*SingleWalletVolume)(nil),  // 8: stratos.pot.v1.SingleWalletVolume
+	(*PendingDistribution)(nil), // 9: stratos.pot.v1.PendingDistribution
+	(*DistributionVolume)(nil),  // 10: stratos.pot.v1.DistributionVolume
+	(*VolumeReportDispute)(nil), // 11: stratos.pot.v1.VolumeReportDispute
+	(*ReportEquivocation)(nil),  // 12: stratos.pot.v1.ReportEquivocation
+	(*RewardHistory)(nil),       // 13: stratos.pot.v1.RewardHistory
+	(*AutoRestake)(nil),         // 14: stratos.pot.v1.AutoRestake
+	(*TotalReward)(nil),         // 15: stratos.pot.v1.TotalReward
 }
 var file_stratos_pot_v1_genesis_proto_depIdxs = []int32{
-	4, // 0: stratos.pot.v1.GenesisState.params:type_name -> stratos.pot.v1.Params
-	5, // 1: stratos.pot.v1.GenesisState.total_mined_token:type_name -> cosmos.base.v1beta1.Coin
-	1, // 2: stratos.pot.v1.GenesisState.immature_total_info:type_name -> stratos.pot.v1.ImmatureTotal
-	2, // 3: stratos.pot.v1.GenesisState.mature_total_info:type_name -> stratos.pot.v1.MatureTotal
-	6, // 4: stratos.pot.v1.GenesisState.individual_reward_info:type_name -> stratos.pot.v1.Reward
-	3, // 5: stratos.pot.v1.GenesisState.reward_total_info:type_name -> stratos.pot.v1.RewardTotal
-	5, // 6: stratos.pot.v1.ImmatureTotal.value:type_name -> cosmos.base.v1beta1.Coin
-	5, // 7: stratos.pot.v1.MatureTotal.value:type_name -> cosmos.base.v1beta1.Coin
-	7, // 8: stratos.pot.v1.RewardTotal.total_reward:type_name -> stratos.pot.v1.TotalReward
-	9, // [9:9] is the sub-list for method output_type
-	9, // [9:9] is the sub-list for method input_type
-	9, // [9:9] is the sub-list for extension type_name
-	9, // [9:9] is the sub-list for extension extendee
-	0, // [0:9] is the sub-list for field type_name
+	4,  // 0: stratos.pot.v1.GenesisState.params:type_name -> stratos.pot.v1.Params
+	5,  // 1: stratos.pot.v1.GenesisState.total_mined_token:type_name -> cosmos.base.v1beta1.Coin
+	1,  // 2: stratos.pot.v1.GenesisState.immature_total_info:type_name -> stratos.pot.v1.ImmatureTotal
+	2,  // 3: stratos.pot.v1.GenesisState.mature_total_info:type_name -> stratos.pot.v1.MatureTotal
+	6,  // 4: stratos.pot.v1.GenesisState.individual_reward_info:type_name -> stratos.pot.v1.Reward
+	3,  // 5: stratos.pot.v1.GenesisState.reward_total_info:type_name -> stratos.pot.v1.RewardTotal
+	7,  // 6: stratos.pot.v1.GenesisState.pending_volume_report:type_name -> stratos.pot.v1.PendingVolumeReport
+	8,  // 7: stratos.pot.v1.GenesisState.pending_wallet_volumes:type_name -> stratos.pot.v1.SingleWalletVolume
+	9,  // 8: stratos.pot.v1.GenesisState.pending_distributions:type_name -> stratos.pot.v1.PendingDistribution
+	10, // 9: stratos.pot.v1.GenesisState.distribution_volumes:type_name -> stratos.pot.v1.DistributionVolume
+	11, // 10: stratos.pot.v1.GenesisState.volume_report_disputes:type_name -> stratos.pot.v1.VolumeReportDispute
+	12, // 11: stratos.pot.v1.GenesisState.report_equivocations:type_name -> stratos.pot.v1.ReportEquivocation
+	13, // 12: stratos.pot.v1.GenesisState.reward_histories:type_name -> stratos.pot.v1.RewardHistory
+	14, // 13: stratos.pot.v1.GenesisState.auto_restakes:type_name -> stratos.pot.v1.AutoRestake
+	5,  // 14: stratos.pot.v1.ImmatureTotal.value:type_name -> cosmos.base.v1beta1.Coin
+	5,  // 15: stratos.pot.v1.MatureTotal.value:type_name -> cosmos.base.v1beta1.Coin
+	15, // 16: stratos.pot.v1.RewardTotal.total_reward:type_name -> stratos.pot.v1.TotalReward
+	17, // [17:17] is the sub-list for method output_type
+	17, // [17:17] is the sub-list for method input_type
+	17, // [17:17] is the sub-list for extension type_name
+	17, // [17:17] is the sub-list for extension extendee
+	0,  // [0:17] is the sub-list for field type_name
 }
 
 func init() { file_stratos_pot_v1_genesis_proto_init() }
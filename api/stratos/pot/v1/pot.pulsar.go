@@ -68,13 +68,20 @@ func (x *_Params_4_list) IsValid() bool {
 }
 
 var (
-	md_Params                      protoreflect.MessageDescriptor
-	fd_Params_bond_denom           protoreflect.FieldDescriptor
-	fd_Params_reward_denom         protoreflect.FieldDescriptor
-	fd_Params_mature_epoch         protoreflect.FieldDescriptor
-	fd_Params_mining_reward_params protoreflect.FieldDescriptor
-	fd_Params_community_tax        protoreflect.FieldDescriptor
-	fd_Params_initial_total_supply protoreflect.FieldDescriptor
+	md_Params                             protoreflect.MessageDescriptor
+	fd_Params_bond_denom                  protoreflect.FieldDescriptor
+	fd_Params_reward_denom                protoreflect.FieldDescriptor
+	fd_Params_mature_epoch                protoreflect.FieldDescriptor
+	fd_Params_mining_reward_params        protoreflect.FieldDescriptor
+	fd_Params_community_tax               protoreflect.FieldDescriptor
+	fd_Params_initial_total_supply        protoreflect.FieldDescriptor
+	fd_Params_volume_report_chunk_timeout protoreflect.FieldDescriptor
+	fd_Params_distribute_batch_size       protoreflect.FieldDescriptor
+	fd_Params_dispute_window              protoreflect.FieldDescriptor
+	fd_Params_equivocation_slash_fraction protoreflect.FieldDescriptor
+	fd_Params_vesting_mode                protoreflect.FieldDescriptor
+	fd_Params_vesting_epochs              protoreflect.FieldDescriptor
+	fd_Params_reward_history_retention    protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -86,6 +93,13 @@ func init() {
 	fd_Params_mining_reward_params = md_Params.Fields().ByName("mining_reward_params")
 	fd_Params_community_tax = md_Params.Fields().ByName("community_tax")
 	fd_Params_initial_total_supply = md_Params.Fields().ByName("initial_total_supply")
+	fd_Params_volume_report_chunk_timeout = md_Params.Fields().ByName("volume_report_chunk_timeout")
+	fd_Params_distribute_batch_size = md_Params.Fields().ByName("distribute_batch_size")
+	fd_Params_dispute_window = md_Params.Fields().ByName("dispute_window")
+	fd_Params_equivocation_slash_fraction = md_Params.Fields().ByName("equivocation_slash_fraction")
+	fd_Params_vesting_mode = md_Params.Fields().ByName("vesting_mode")
+	fd_Params_vesting_epochs = md_Params.Fields().ByName("vesting_epochs")
+	fd_Params_reward_history_retention = md_Params.Fields().ByName("reward_history_retention")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -189,6 +203,48 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.VolumeReportChunkTimeout != int64(0) {
+		value := protoreflect.ValueOfInt64(x.VolumeReportChunkTimeout)
+		if !f(fd_Params_volume_report_chunk_timeout, value) {
+			return
+		}
+	}
+	if x.DistributeBatchSize != int64(0) {
+		value := protoreflect.ValueOfInt64(x.DistributeBatchSize)
+		if !f(fd_Params_distribute_batch_size, value) {
+			return
+		}
+	}
+	if x.DisputeWindow != int64(0) {
+		value := protoreflect.ValueOfInt64(x.DisputeWindow)
+		if !f(fd_Params_dispute_window, value) {
+			return
+		}
+	}
+	if x.EquivocationSlashFraction != "" {
+		value := protoreflect.ValueOfString(x.EquivocationSlashFraction)
+		if !f(fd_Params_equivocation_slash_fraction, value) {
+			return
+		}
+	}
+	if x.VestingMode != "" {
+		value := protoreflect.ValueOfString(x.VestingMode)
+		if !f(fd_Params_vesting_mode, value) {
+			return
+		}
+	}
+	if x.VestingEpochs != int64(0) {
+		value := protoreflect.ValueOfInt64(x.VestingEpochs)
+		if !f(fd_Params_vesting_epochs, value) {
+			return
+		}
+	}
+	if x.RewardHistoryRetention != int64(0) {
+		value := protoreflect.ValueOfInt64(x.RewardHistoryRetention)
+		if !f(fd_Params_reward_history_retention, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -216,6 +272,20 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.CommunityTax != ""
 	case "stratos.pot.v1.Params.initial_total_supply":
 		return x.InitialTotalSupply != nil
+	case "stratos.pot.v1.Params.volume_report_chunk_timeout":
+		return x.VolumeReportChunkTimeout != int64(0)
+	case "stratos.pot.v1.Params.distribute_batch_size":
+		return x.DistributeBatchSize != int64(0)
+	case "stratos.pot.v1.Params.dispute_window":
+		return x.DisputeWindow != int64(0)
+	case "stratos.pot.v1.Params.equivocation_slash_fraction":
+		return x.EquivocationSlashFraction != ""
+	case "stratos.pot.v1.Params.vesting_mode":
+		return x.VestingMode != ""
+	case "stratos.pot.v1.Params.vesting_epochs":
+		return x.VestingEpochs != int64(0)
+	case "stratos.pot.v1.Params.reward_history_retention":
+		return x.RewardHistoryRetention != int64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.Params"))
@@ -244,6 +314,20 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 		x.CommunityTax = ""
 	case "stratos.pot.v1.Params.initial_total_supply":
 		x.InitialTotalSupply = nil
+	case "stratos.pot.v1.Params.volume_report_chunk_timeout":
+		x.VolumeReportChunkTimeout = int64(0)
+	case "stratos.pot.v1.Params.distribute_batch_size":
+		x.DistributeBatchSize = int64(0)
+	case "stratos.pot.v1.Params.dispute_window":
+		x.DisputeWindow = int64(0)
+	case "stratos.pot.v1.Params.equivocation_slash_fraction":
+		x.EquivocationSlashFraction = ""
+	case "stratos.pot.v1.Params.vesting_mode":
+		x.VestingMode = ""
+	case "stratos.pot.v1.Params.vesting_epochs":
+		x.VestingEpochs = int64(0)
+	case "stratos.pot.v1.Params.reward_history_retention":
+		x.RewardHistoryRetention = int64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.Params"))
@@ -281,6 +365,27 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "stratos.pot.v1.Params.initial_total_supply":
 		value := x.InitialTotalSupply
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "stratos.pot.v1.Params.volume_report_chunk_timeout":
+		value := x.VolumeReportChunkTimeout
+		return protoreflect.ValueOfInt64(value)
+	case "stratos.pot.v1.Params.distribute_batch_size":
+		value := x.DistributeBatchSize
+		return protoreflect.ValueOfInt64(value)
+	case "stratos.pot.v1.Params.dispute_window":
+		value := x.DisputeWindow
+		return protoreflect.ValueOfInt64(value)
+	case "stratos.pot.v1.Params.equivocation_slash_fraction":
+		value := x.EquivocationSlashFraction
+		return protoreflect.ValueOfString(value)
+	case "stratos.pot.v1.Params.vesting_mode":
+		value := x.VestingMode
+		return protoreflect.ValueOfString(value)
+	case "stratos.pot.v1.Params.vesting_epochs":
+		value := x.VestingEpochs
+		return protoreflect.ValueOfInt64(value)
+	case "stratos.pot.v1.Params.reward_history_retention":
+		value := x.RewardHistoryRetention
+		return protoreflect.ValueOfInt64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.Params"))
@@ -315,6 +420,20 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 		x.CommunityTax = value.Interface().(string)
 	case "stratos.pot.v1.Params.initial_total_supply":
 		x.InitialTotalSupply = value.Message().Interface().(*v1beta1.Coin)
+	case "stratos.pot.v1.Params.volume_report_chunk_timeout":
+		x.VolumeReportChunkTimeout = value.Int()
+	case "stratos.pot.v1.Params.distribute_batch_size":
+		x.DistributeBatchSize = value.Int()
+	case "stratos.pot.v1.Params.dispute_window":
+		x.DisputeWindow = value.Int()
+	case "stratos.pot.v1.Params.equivocation_slash_fraction":
+		x.EquivocationSlashFraction = value.Interface().(string)
+	case "stratos.pot.v1.Params.vesting_mode":
+		x.VestingMode = value.Interface().(string)
+	case "stratos.pot.v1.Params.vesting_epochs":
+		x.VestingEpochs = value.Int()
+	case "stratos.pot.v1.Params.reward_history_retention":
+		x.RewardHistoryRetention = value.Int()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.Params"))
@@ -354,6 +473,20 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 		panic(fmt.Errorf("field mature_epoch of message stratos.pot.v1.Params is not mutable"))
 	case "stratos.pot.v1.Params.community_tax":
 		panic(fmt.Errorf("field community_tax of message stratos.pot.v1.Params is not mutable"))
+	case "stratos.pot.v1.Params.volume_report_chunk_timeout":
+		panic(fmt.Errorf("field volume_report_chunk_timeout of message stratos.pot.v1.Params is not mutable"))
+	case "stratos.pot.v1.Params.distribute_batch_size":
+		panic(fmt.Errorf("field distribute_batch_size of message stratos.pot.v1.Params is not mutable"))
+	case "stratos.pot.v1.Params.dispute_window":
+		panic(fmt.Errorf("field dispute_window of message stratos.pot.v1.Params is not mutable"))
+	case "stratos.pot.v1.Params.equivocation_slash_fraction":
+		panic(fmt.Errorf("field equivocation_slash_fraction of message stratos.pot.v1.Params is not mutable"))
+	case "stratos.pot.v1.Params.vesting_mode":
+		panic(fmt.Errorf("field vesting_mode of message stratos.pot.v1.Params is not mutable"))
+	case "stratos.pot.v1.Params.vesting_epochs":
+		panic(fmt.Errorf("field vesting_epochs of message stratos.pot.v1.Params is not mutable"))
+	case "stratos.pot.v1.Params.reward_history_retention":
+		panic(fmt.Errorf("field reward_history_retention of message stratos.pot.v1.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.Params"))
@@ -381,6 +514,20 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 	case "stratos.pot.v1.Params.initial_total_supply":
 		m := new(v1beta1.Coin)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "stratos.pot.v1.Params.volume_report_chunk_timeout":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "stratos.pot.v1.Params.distribute_batch_size":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "stratos.pot.v1.Params.dispute_window":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "stratos.pot.v1.Params.equivocation_slash_fraction":
+		return protoreflect.ValueOfString("")
+	case "stratos.pot.v1.Params.vesting_mode":
+		return protoreflect.ValueOfString("")
+	case "stratos.pot.v1.Params.vesting_epochs":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "stratos.pot.v1.Params.reward_history_retention":
+		return protoreflect.ValueOfInt64(int64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.Params"))
@@ -475,6 +622,29 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			l = options.Size(x.InitialTotalSupply)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.VolumeReportChunkTimeout != 0 {
+			n += 1 + runtime.Sov(uint64(x.VolumeReportChunkTimeout))
+		}
+		if x.DistributeBatchSize != 0 {
+			n += 1 + runtime.Sov(uint64(x.DistributeBatchSize))
+		}
+		if x.DisputeWindow != 0 {
+			n += 1 + runtime.Sov(uint64(x.DisputeWindow))
+		}
+		l = len(x.EquivocationSlashFraction)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.VestingMode)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.VestingEpochs != 0 {
+			n += 1 + runtime.Sov(uint64(x.VestingEpochs))
+		}
+		if x.RewardHistoryRetention != 0 {
+			n += 1 + runtime.Sov(uint64(x.RewardHistoryRetention))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -504,6 +674,45 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.RewardHistoryRetention != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RewardHistoryRetention))
+			i--
+			dAtA[i] = 0x68
+		}
+		if x.VestingEpochs != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.VestingEpochs))
+			i--
+			dAtA[i] = 0x60
+		}
+		if len(x.VestingMode) > 0 {
+			i -= len(x.VestingMode)
+			copy(dAtA[i:], x.VestingMode)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VestingMode)))
+			i--
+			dAtA[i] = 0x5a
+		}
+		if len(x.EquivocationSlashFraction) > 0 {
+			i -= len(x.EquivocationSlashFraction)
+			copy(dAtA[i:], x.EquivocationSlashFraction)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.EquivocationSlashFraction)))
+			i--
+			dAtA[i] = 0x52
+		}
+		if x.DisputeWindow != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DisputeWindow))
+			i--
+			dAtA[i] = 0x48
+		}
+		if x.DistributeBatchSize != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DistributeBatchSize))
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.VolumeReportChunkTimeout != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.VolumeReportChunkTimeout))
+			i--
+			dAtA[i] = 0x38
+		}
 		if x.InitialTotalSupply != nil {
 			encoded, err := options.Marshal(x.InitialTotalSupply)
 			if err != nil {
@@ -794,6 +1003,165 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VolumeReportChunkTimeout", wireType)
+				}
+				x.VolumeReportChunkTimeout = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.VolumeReportChunkTimeout |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DistributeBatchSize", wireType)
+				}
+				x.DistributeBatchSize = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DistributeBatchSize |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DisputeWindow", wireType)
+				}
+				x.DisputeWindow = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DisputeWindow |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EquivocationSlashFraction", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.EquivocationSlashFraction = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VestingMode", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.VestingMode = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 12:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VestingEpochs", wireType)
+				}
+				x.VestingEpochs = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.VestingEpochs |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 13:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RewardHistoryRetention", wireType)
+				}
+				x.RewardHistoryRetention = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RewardHistoryRetention |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -5784,538 +6152,8368 @@ func (x *fastReflection_Metrics) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: stratos/pot/v1/pot.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
-
-// Params defines the PoT module parameters
-type Params struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+var _ protoreflect.List = (*_VolumeInclusionProof_1_list)(nil)
 
-	BondDenom          string               `protobuf:"bytes,1,opt,name=bond_denom,json=bondDenom,proto3" json:"bond_denom,omitempty"`
-	RewardDenom        string               `protobuf:"bytes,2,opt,name=reward_denom,json=rewardDenom,proto3" json:"reward_denom,omitempty"`
-	MatureEpoch        int64                `protobuf:"varint,3,opt,name=mature_epoch,json=matureEpoch,proto3" json:"mature_epoch,omitempty"`
-	MiningRewardParams []*MiningRewardParam `protobuf:"bytes,4,rep,name=mining_reward_params,json=miningRewardParams,proto3" json:"mining_reward_params,omitempty"`
-	CommunityTax       string               `protobuf:"bytes,5,opt,name=community_tax,json=communityTax,proto3" json:"community_tax,omitempty"`
-	InitialTotalSupply *v1beta1.Coin        `protobuf:"bytes,6,opt,name=initial_total_supply,json=initialTotalSupply,proto3" json:"initial_total_supply,omitempty"`
+type _VolumeInclusionProof_1_list struct {
+	list *[][]byte
 }
 
-func (x *Params) Reset() {
-	*x = Params{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_stratos_pot_v1_pot_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *_VolumeInclusionProof_1_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
+	return len(*x.list)
 }
 
-func (x *Params) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *_VolumeInclusionProof_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfBytes((*x.list)[i])
 }
 
-func (*Params) ProtoMessage() {}
+func (x *_VolumeInclusionProof_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Bytes()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
 
-// Deprecated: Use Params.ProtoReflect.Descriptor instead.
-func (*Params) Descriptor() ([]byte, []int) {
-	return file_stratos_pot_v1_pot_proto_rawDescGZIP(), []int{0}
+func (x *_VolumeInclusionProof_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Bytes()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *Params) GetBondDenom() string {
-	if x != nil {
-		return x.BondDenom
-	}
-	return ""
+func (x *_VolumeInclusionProof_1_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message VolumeInclusionProof at list field Hashes as it is not of Message kind"))
 }
 
-func (x *Params) GetRewardDenom() string {
-	if x != nil {
-		return x.RewardDenom
-	}
-	return ""
+func (x *_VolumeInclusionProof_1_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
 }
 
-func (x *Params) GetMatureEpoch() int64 {
+func (x *_VolumeInclusionProof_1_list) NewElement() protoreflect.Value {
+	var v []byte
+	return protoreflect.ValueOfBytes(v)
+}
+
+func (x *_VolumeInclusionProof_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_VolumeInclusionProof        protoreflect.MessageDescriptor
+	fd_VolumeInclusionProof_hashes protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_stratos_pot_v1_pot_proto_init()
+	md_VolumeInclusionProof = File_stratos_pot_v1_pot_proto.Messages().ByName("VolumeInclusionProof")
+	fd_VolumeInclusionProof_hashes = md_VolumeInclusionProof.Fields().ByName("hashes")
+}
+
+var _ protoreflect.Message = (*fastReflection_VolumeInclusionProof)(nil)
+
+type fastReflection_VolumeInclusionProof VolumeInclusionProof
+
+func (x *VolumeInclusionProof) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_VolumeInclusionProof)(x)
+}
+
+func (x *VolumeInclusionProof) slowProtoReflect() protoreflect.Message {
+	mi := &file_stratos_pot_v1_pot_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_VolumeInclusionProof_messageType fastReflection_VolumeInclusionProof_messageType
+var _ protoreflect.MessageType = fastReflection_VolumeInclusionProof_messageType{}
+
+type fastReflection_VolumeInclusionProof_messageType struct{}
+
+func (x fastReflection_VolumeInclusionProof_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_VolumeInclusionProof)(nil)
+}
+func (x fastReflection_VolumeInclusionProof_messageType) New() protoreflect.Message {
+	return new(fastReflection_VolumeInclusionProof)
+}
+func (x fastReflection_VolumeInclusionProof_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_VolumeInclusionProof
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_VolumeInclusionProof) Descriptor() protoreflect.MessageDescriptor {
+	return md_VolumeInclusionProof
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_VolumeInclusionProof) Type() protoreflect.MessageType {
+	return _fastReflection_VolumeInclusionProof_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_VolumeInclusionProof) New() protoreflect.Message {
+	return new(fastReflection_VolumeInclusionProof)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_VolumeInclusionProof) Interface() protoreflect.ProtoMessage {
+	return (*VolumeInclusionProof)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_VolumeInclusionProof) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Hashes) != 0 {
+		value := protoreflect.ValueOfList(&_VolumeInclusionProof_1_list{list: &x.Hashes})
+		if !f(fd_VolumeInclusionProof_hashes, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_VolumeInclusionProof) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "stratos.pot.v1.VolumeInclusionProof.hashes":
+		return len(x.Hashes) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.VolumeInclusionProof"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.VolumeInclusionProof does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VolumeInclusionProof) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "stratos.pot.v1.VolumeInclusionProof.hashes":
+		x.Hashes = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.VolumeInclusionProof"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.VolumeInclusionProof does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_VolumeInclusionProof) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "stratos.pot.v1.VolumeInclusionProof.hashes":
+		if len(x.Hashes) == 0 {
+			return protoreflect.ValueOfList(&_VolumeInclusionProof_1_list{})
+		}
+		listValue := &_VolumeInclusionProof_1_list{list: &x.Hashes}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.VolumeInclusionProof"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.VolumeInclusionProof does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VolumeInclusionProof) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "stratos.pot.v1.VolumeInclusionProof.hashes":
+		lv := value.List()
+		clv := lv.(*_VolumeInclusionProof_1_list)
+		x.Hashes = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.VolumeInclusionProof"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.VolumeInclusionProof does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VolumeInclusionProof) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "stratos.pot.v1.VolumeInclusionProof.hashes":
+		if x.Hashes == nil {
+			x.Hashes = [][]byte{}
+		}
+		value := &_VolumeInclusionProof_1_list{list: &x.Hashes}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.VolumeInclusionProof"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.VolumeInclusionProof does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_VolumeInclusionProof) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "stratos.pot.v1.VolumeInclusionProof.hashes":
+		list := [][]byte{}
+		return protoreflect.ValueOfList(&_VolumeInclusionProof_1_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.VolumeInclusionProof"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.VolumeInclusionProof does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_VolumeInclusionProof) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in stratos.pot.v1.VolumeInclusionProof", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_VolumeInclusionProof) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VolumeInclusionProof) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_VolumeInclusionProof) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_VolumeInclusionProof) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*VolumeInclusionProof)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Hashes) > 0 {
+			for _, b := range x.Hashes {
+				l = len(b)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*VolumeInclusionProof)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Hashes) > 0 {
+			for iNdEx := len(x.Hashes) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Hashes[iNdEx])
+				copy(dAtA[i:], x.Hashes[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Hashes[iNdEx])))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*VolumeInclusionProof)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: VolumeInclusionProof: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: VolumeInclusionProof: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Hashes", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Hashes = append(x.Hashes, make([]byte, postIndex-iNdEx))
+				copy(x.Hashes[len(x.Hashes)-1], dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_PendingVolumeReport                  protoreflect.MessageDescriptor
+	fd_PendingVolumeReport_reporter         protoreflect.FieldDescriptor
+	fd_PendingVolumeReport_epoch            protoreflect.FieldDescriptor
+	fd_PendingVolumeReport_report_reference protoreflect.FieldDescriptor
+	fd_PendingVolumeReport_total_count      protoreflect.FieldDescriptor
+	fd_PendingVolumeReport_volumes_root     protoreflect.FieldDescriptor
+	fd_PendingVolumeReport_received_count   protoreflect.FieldDescriptor
+	fd_PendingVolumeReport_deadline_height  protoreflect.FieldDescriptor
+	fd_PendingVolumeReport_tx_hash          protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_stratos_pot_v1_pot_proto_init()
+	md_PendingVolumeReport = File_stratos_pot_v1_pot_proto.Messages().ByName("PendingVolumeReport")
+	fd_PendingVolumeReport_reporter = md_PendingVolumeReport.Fields().ByName("reporter")
+	fd_PendingVolumeReport_epoch = md_PendingVolumeReport.Fields().ByName("epoch")
+	fd_PendingVolumeReport_report_reference = md_PendingVolumeReport.Fields().ByName("report_reference")
+	fd_PendingVolumeReport_total_count = md_PendingVolumeReport.Fields().ByName("total_count")
+	fd_PendingVolumeReport_volumes_root = md_PendingVolumeReport.Fields().ByName("volumes_root")
+	fd_PendingVolumeReport_received_count = md_PendingVolumeReport.Fields().ByName("received_count")
+	fd_PendingVolumeReport_deadline_height = md_PendingVolumeReport.Fields().ByName("deadline_height")
+	fd_PendingVolumeReport_tx_hash = md_PendingVolumeReport.Fields().ByName("tx_hash")
+}
+
+var _ protoreflect.Message = (*fastReflection_PendingVolumeReport)(nil)
+
+type fastReflection_PendingVolumeReport PendingVolumeReport
+
+func (x *PendingVolumeReport) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PendingVolumeReport)(x)
+}
+
+func (x *PendingVolumeReport) slowProtoReflect() protoreflect.Message {
+	mi := &file_stratos_pot_v1_pot_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_PendingVolumeReport_messageType fastReflection_PendingVolumeReport_messageType
+var _ protoreflect.MessageType = fastReflection_PendingVolumeReport_messageType{}
+
+type fastReflection_PendingVolumeReport_messageType struct{}
+
+func (x fastReflection_PendingVolumeReport_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PendingVolumeReport)(nil)
+}
+func (x fastReflection_PendingVolumeReport_messageType) New() protoreflect.Message {
+	return new(fastReflection_PendingVolumeReport)
+}
+func (x fastReflection_PendingVolumeReport_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PendingVolumeReport
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_PendingVolumeReport) Descriptor() protoreflect.MessageDescriptor {
+	return md_PendingVolumeReport
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_PendingVolumeReport) Type() protoreflect.MessageType {
+	return _fastReflection_PendingVolumeReport_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_PendingVolumeReport) New() protoreflect.Message {
+	return new(fastReflection_PendingVolumeReport)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_PendingVolumeReport) Interface() protoreflect.ProtoMessage {
+	return (*PendingVolumeReport)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_PendingVolumeReport) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Reporter != "" {
+		value := protoreflect.ValueOfString(x.Reporter)
+		if !f(fd_PendingVolumeReport_reporter, value) {
+			return
+		}
+	}
+	if x.Epoch != "" {
+		value := protoreflect.ValueOfString(x.Epoch)
+		if !f(fd_PendingVolumeReport_epoch, value) {
+			return
+		}
+	}
+	if x.ReportReference != "" {
+		value := protoreflect.ValueOfString(x.ReportReference)
+		if !f(fd_PendingVolumeReport_report_reference, value) {
+			return
+		}
+	}
+	if x.TotalCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TotalCount)
+		if !f(fd_PendingVolumeReport_total_count, value) {
+			return
+		}
+	}
+	if len(x.VolumesRoot) != 0 {
+		value := protoreflect.ValueOfBytes(x.VolumesRoot)
+		if !f(fd_PendingVolumeReport_volumes_root, value) {
+			return
+		}
+	}
+	if x.ReceivedCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ReceivedCount)
+		if !f(fd_PendingVolumeReport_received_count, value) {
+			return
+		}
+	}
+	if x.DeadlineHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.DeadlineHeight)
+		if !f(fd_PendingVolumeReport_deadline_height, value) {
+			return
+		}
+	}
+	if x.TxHash != "" {
+		value := protoreflect.ValueOfString(x.TxHash)
+		if !f(fd_PendingVolumeReport_tx_hash, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_PendingVolumeReport) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "stratos.pot.v1.PendingVolumeReport.reporter":
+		return x.Reporter != ""
+	case "stratos.pot.v1.PendingVolumeReport.epoch":
+		return x.Epoch != ""
+	case "stratos.pot.v1.PendingVolumeReport.report_reference":
+		return x.ReportReference != ""
+	case "stratos.pot.v1.PendingVolumeReport.total_count":
+		return x.TotalCount != uint64(0)
+	case "stratos.pot.v1.PendingVolumeReport.volumes_root":
+		return len(x.VolumesRoot) != 0
+	case "stratos.pot.v1.PendingVolumeReport.received_count":
+		return x.ReceivedCount != uint64(0)
+	case "stratos.pot.v1.PendingVolumeReport.deadline_height":
+		return x.DeadlineHeight != int64(0)
+	case "stratos.pot.v1.PendingVolumeReport.tx_hash":
+		return x.TxHash != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.PendingVolumeReport"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.PendingVolumeReport does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PendingVolumeReport) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "stratos.pot.v1.PendingVolumeReport.reporter":
+		x.Reporter = ""
+	case "stratos.pot.v1.PendingVolumeReport.epoch":
+		x.Epoch = ""
+	case "stratos.pot.v1.PendingVolumeReport.report_reference":
+		x.ReportReference = ""
+	case "stratos.pot.v1.PendingVolumeReport.total_count":
+		x.TotalCount = uint64(0)
+	case "stratos.pot.v1.PendingVolumeReport.volumes_root":
+		x.VolumesRoot = nil
+	case "stratos.pot.v1.PendingVolumeReport.received_count":
+		x.ReceivedCount = uint64(0)
+	case "stratos.pot.v1.PendingVolumeReport.deadline_height":
+		x.DeadlineHeight = int64(0)
+	case "stratos.pot.v1.PendingVolumeReport.tx_hash":
+		x.TxHash = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.PendingVolumeReport"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.PendingVolumeReport does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_PendingVolumeReport) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "stratos.pot.v1.PendingVolumeReport.reporter":
+		value := x.Reporter
+		return protoreflect.ValueOfString(value)
+	case "stratos.pot.v1.PendingVolumeReport.epoch":
+		value := x.Epoch
+		return protoreflect.ValueOfString(value)
+	case "stratos.pot.v1.PendingVolumeReport.report_reference":
+		value := x.ReportReference
+		return protoreflect.ValueOfString(value)
+	case "stratos.pot.v1.PendingVolumeReport.total_count":
+		value := x.TotalCount
+		return protoreflect.ValueOfUint64(value)
+	case "stratos.pot.v1.PendingVolumeReport.volumes_root":
+		value := x.VolumesRoot
+		return protoreflect.ValueOfBytes(value)
+	case "stratos.pot.v1.PendingVolumeReport.received_count":
+		value := x.ReceivedCount
+		return protoreflect.ValueOfUint64(value)
+	case "stratos.pot.v1.PendingVolumeReport.deadline_height":
+		value := x.DeadlineHeight
+		return protoreflect.ValueOfInt64(value)
+	case "stratos.pot.v1.PendingVolumeReport.tx_hash":
+		value := x.TxHash
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.PendingVolumeReport"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.PendingVolumeReport does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PendingVolumeReport) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "stratos.pot.v1.PendingVolumeReport.reporter":
+		x.Reporter = value.Interface().(string)
+	case "stratos.pot.v1.PendingVolumeReport.epoch":
+		x.Epoch = value.Interface().(string)
+	case "stratos.pot.v1.PendingVolumeReport.report_reference":
+		x.ReportReference = value.Interface().(string)
+	case "stratos.pot.v1.PendingVolumeReport.total_count":
+		x.TotalCount = value.Uint()
+	case "stratos.pot.v1.PendingVolumeReport.volumes_root":
+		x.VolumesRoot = value.Bytes()
+	case "stratos.pot.v1.PendingVolumeReport.received_count":
+		x.ReceivedCount = value.Uint()
+	case "stratos.pot.v1.PendingVolumeReport.deadline_height":
+		x.DeadlineHeight = value.Int()
+	case "stratos.pot.v1.PendingVolumeReport.tx_hash":
+		x.TxHash = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.PendingVolumeReport"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.PendingVolumeReport does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PendingVolumeReport) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "stratos.pot.v1.PendingVolumeReport.reporter":
+		panic(fmt.Errorf("field reporter of message stratos.pot.v1.PendingVolumeReport is not mutable"))
+	case "stratos.pot.v1.PendingVolumeReport.epoch":
+		panic(fmt.Errorf("field epoch of message stratos.pot.v1.PendingVolumeReport is not mutable"))
+	case "stratos.pot.v1.PendingVolumeReport.report_reference":
+		panic(fmt.Errorf("field report_reference of message stratos.pot.v1.PendingVolumeReport is not mutable"))
+	case "stratos.pot.v1.PendingVolumeReport.total_count":
+		panic(fmt.Errorf("field total_count of message stratos.pot.v1.PendingVolumeReport is not mutable"))
+	case "stratos.pot.v1.PendingVolumeReport.volumes_root":
+		panic(fmt.Errorf("field volumes_root of message stratos.pot.v1.PendingVolumeReport is not mutable"))
+	case "stratos.pot.v1.PendingVolumeReport.received_count":
+		panic(fmt.Errorf("field received_count of message stratos.pot.v1.PendingVolumeReport is not mutable"))
+	case "stratos.pot.v1.PendingVolumeReport.deadline_height":
+		panic(fmt.Errorf("field deadline_height of message stratos.pot.v1.PendingVolumeReport is not mutable"))
+	case "stratos.pot.v1.PendingVolumeReport.tx_hash":
+		panic(fmt.Errorf("field tx_hash of message stratos.pot.v1.PendingVolumeReport is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.PendingVolumeReport"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.PendingVolumeReport does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_PendingVolumeReport) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "stratos.pot.v1.PendingVolumeReport.reporter":
+		return protoreflect.ValueOfString("")
+	case "stratos.pot.v1.PendingVolumeReport.epoch":
+		return protoreflect.ValueOfString("")
+	case "stratos.pot.v1.PendingVolumeReport.report_reference":
+		return protoreflect.ValueOfString("")
+	case "stratos.pot.v1.PendingVolumeReport.total_count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "stratos.pot.v1.PendingVolumeReport.volumes_root":
+		return protoreflect.ValueOfBytes(nil)
+	case "stratos.pot.v1.PendingVolumeReport.received_count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "stratos.pot.v1.PendingVolumeReport.deadline_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "stratos.pot.v1.PendingVolumeReport.tx_hash":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.PendingVolumeReport"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.PendingVolumeReport does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_PendingVolumeReport) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in stratos.pot.v1.PendingVolumeReport", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_PendingVolumeReport) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PendingVolumeReport) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_PendingVolumeReport) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_PendingVolumeReport) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*PendingVolumeReport)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Reporter)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Epoch)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ReportReference)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.TotalCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.TotalCount))
+		}
+		l = len(x.VolumesRoot)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ReceivedCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.ReceivedCount))
+		}
+		if x.DeadlineHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.DeadlineHeight))
+		}
+		l = len(x.TxHash)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*PendingVolumeReport)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.TxHash) > 0 {
+			i -= len(x.TxHash)
+			copy(dAtA[i:], x.TxHash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TxHash)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if x.DeadlineHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DeadlineHeight))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.ReceivedCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ReceivedCount))
+			i--
+			dAtA[i] = 0x30
+		}
+		if len(x.VolumesRoot) > 0 {
+			i -= len(x.VolumesRoot)
+			copy(dAtA[i:], x.VolumesRoot)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VolumesRoot)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.TotalCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TotalCount))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.ReportReference) > 0 {
+			i -= len(x.ReportReference)
+			copy(dAtA[i:], x.ReportReference)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ReportReference)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Epoch) > 0 {
+			i -= len(x.Epoch)
+			copy(dAtA[i:], x.Epoch)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Epoch)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Reporter) > 0 {
+			i -= len(x.Reporter)
+			copy(dAtA[i:], x.Reporter)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Reporter)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*PendingVolumeReport)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PendingVolumeReport: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PendingVolumeReport: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reporter", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Reporter = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Epoch = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReportReference", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ReportReference = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalCount", wireType)
+				}
+				x.TotalCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TotalCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VolumesRoot", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.VolumesRoot = append(x.VolumesRoot[:0], dAtA[iNdEx:postIndex]...)
+				if x.VolumesRoot == nil {
+					x.VolumesRoot = []byte{}
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReceivedCount", wireType)
+				}
+				x.ReceivedCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ReceivedCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DeadlineHeight", wireType)
+				}
+				x.DeadlineHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DeadlineHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TxHash = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_PendingDistribution                                 protoreflect.MessageDescriptor
+	fd_PendingDistribution_epoch                           protoreflect.FieldDescriptor
+	fd_PendingDistribution_total_count                     protoreflect.FieldDescriptor
+	fd_PendingDistribution_processed_count                 protoreflect.FieldDescriptor
+	fd_PendingDistribution_total_consumed_noz              protoreflect.FieldDescriptor
+	fd_PendingDistribution_started                         protoreflect.FieldDescriptor
+	fd_PendingDistribution_mining_reward_to_resource_node  protoreflect.FieldDescriptor
+	fd_PendingDistribution_traffic_reward_to_resource_node protoreflect.FieldDescriptor
+	fd_PendingDistribution_mining_reward_to_meta_node      protoreflect.FieldDescriptor
+	fd_PendingDistribution_traffic_reward_to_meta_node     protoreflect.FieldDescriptor
+	fd_PendingDistribution_mined_reward                    protoreflect.FieldDescriptor
+	fd_PendingDistribution_traffic_reward                  protoreflect.FieldDescriptor
+	fd_PendingDistribution_dispute_deadline_height         protoreflect.FieldDescriptor
+	fd_PendingDistribution_disputed                        protoreflect.FieldDescriptor
+	fd_PendingDistribution_community_tax_remainder         protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_stratos_pot_v1_pot_proto_init()
+	md_PendingDistribution = File_stratos_pot_v1_pot_proto.Messages().ByName("PendingDistribution")
+	fd_PendingDistribution_epoch = md_PendingDistribution.Fields().ByName("epoch")
+	fd_PendingDistribution_total_count = md_PendingDistribution.Fields().ByName("total_count")
+	fd_PendingDistribution_processed_count = md_PendingDistribution.Fields().ByName("processed_count")
+	fd_PendingDistribution_total_consumed_noz = md_PendingDistribution.Fields().ByName("total_consumed_noz")
+	fd_PendingDistribution_started = md_PendingDistribution.Fields().ByName("started")
+	fd_PendingDistribution_mining_reward_to_resource_node = md_PendingDistribution.Fields().ByName("mining_reward_to_resource_node")
+	fd_PendingDistribution_traffic_reward_to_resource_node = md_PendingDistribution.Fields().ByName("traffic_reward_to_resource_node")
+	fd_PendingDistribution_mining_reward_to_meta_node = md_PendingDistribution.Fields().ByName("mining_reward_to_meta_node")
+	fd_PendingDistribution_traffic_reward_to_meta_node = md_PendingDistribution.Fields().ByName("traffic_reward_to_meta_node")
+	fd_PendingDistribution_mined_reward = md_PendingDistribution.Fields().ByName("mined_reward")
+	fd_PendingDistribution_traffic_reward = md_PendingDistribution.Fields().ByName("traffic_reward")
+	fd_PendingDistribution_dispute_deadline_height = md_PendingDistribution.Fields().ByName("dispute_deadline_height")
+	fd_PendingDistribution_disputed = md_PendingDistribution.Fields().ByName("disputed")
+	fd_PendingDistribution_community_tax_remainder = md_PendingDistribution.Fields().ByName("community_tax_remainder")
+}
+
+var _ protoreflect.Message = (*fastReflection_PendingDistribution)(nil)
+
+type fastReflection_PendingDistribution PendingDistribution
+
+func (x *PendingDistribution) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PendingDistribution)(x)
+}
+
+func (x *PendingDistribution) slowProtoReflect() protoreflect.Message {
+	mi := &file_stratos_pot_v1_pot_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_PendingDistribution_messageType fastReflection_PendingDistribution_messageType
+var _ protoreflect.MessageType = fastReflection_PendingDistribution_messageType{}
+
+type fastReflection_PendingDistribution_messageType struct{}
+
+func (x fastReflection_PendingDistribution_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PendingDistribution)(nil)
+}
+func (x fastReflection_PendingDistribution_messageType) New() protoreflect.Message {
+	return new(fastReflection_PendingDistribution)
+}
+func (x fastReflection_PendingDistribution_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PendingDistribution
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_PendingDistribution) Descriptor() protoreflect.MessageDescriptor {
+	return md_PendingDistribution
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_PendingDistribution) Type() protoreflect.MessageType {
+	return _fastReflection_PendingDistribution_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_PendingDistribution) New() protoreflect.Message {
+	return new(fastReflection_PendingDistribution)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_PendingDistribution) Interface() protoreflect.ProtoMessage {
+	return (*PendingDistribution)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_PendingDistribution) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Epoch != "" {
+		value := protoreflect.ValueOfString(x.Epoch)
+		if !f(fd_PendingDistribution_epoch, value) {
+			return
+		}
+	}
+	if x.TotalCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TotalCount)
+		if !f(fd_PendingDistribution_total_count, value) {
+			return
+		}
+	}
+	if x.ProcessedCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ProcessedCount)
+		if !f(fd_PendingDistribution_processed_count, value) {
+			return
+		}
+	}
+	if x.TotalConsumedNoz != "" {
+		value := protoreflect.ValueOfString(x.TotalConsumedNoz)
+		if !f(fd_PendingDistribution_total_consumed_noz, value) {
+			return
+		}
+	}
+	if x.Started != false {
+		value := protoreflect.ValueOfBool(x.Started)
+		if !f(fd_PendingDistribution_started, value) {
+			return
+		}
+	}
+	if x.MiningRewardToResourceNode != nil {
+		value := protoreflect.ValueOfMessage(x.MiningRewardToResourceNode.ProtoReflect())
+		if !f(fd_PendingDistribution_mining_reward_to_resource_node, value) {
+			return
+		}
+	}
+	if x.TrafficRewardToResourceNode != nil {
+		value := protoreflect.ValueOfMessage(x.TrafficRewardToResourceNode.ProtoReflect())
+		if !f(fd_PendingDistribution_traffic_reward_to_resource_node, value) {
+			return
+		}
+	}
+	if x.MiningRewardToMetaNode != nil {
+		value := protoreflect.ValueOfMessage(x.MiningRewardToMetaNode.ProtoReflect())
+		if !f(fd_PendingDistribution_mining_reward_to_meta_node, value) {
+			return
+		}
+	}
+	if x.TrafficRewardToMetaNode != nil {
+		value := protoreflect.ValueOfMessage(x.TrafficRewardToMetaNode.ProtoReflect())
+		if !f(fd_PendingDistribution_traffic_reward_to_meta_node, value) {
+			return
+		}
+	}
+	if x.MinedReward != nil {
+		value := protoreflect.ValueOfMessage(x.MinedReward.ProtoReflect())
+		if !f(fd_PendingDistribution_mined_reward, value) {
+			return
+		}
+	}
+	if x.TrafficReward != nil {
+		value := protoreflect.ValueOfMessage(x.TrafficReward.ProtoReflect())
+		if !f(fd_PendingDistribution_traffic_reward, value) {
+			return
+		}
+	}
+	if x.DisputeDeadlineHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.DisputeDeadlineHeight)
+		if !f(fd_PendingDistribution_dispute_deadline_height, value) {
+			return
+		}
+	}
+	if x.Disputed != false {
+		value := protoreflect.ValueOfBool(x.Disputed)
+		if !f(fd_PendingDistribution_disputed, value) {
+			return
+		}
+	}
+	if x.CommunityTaxRemainder != "" {
+		value := protoreflect.ValueOfString(x.CommunityTaxRemainder)
+		if !f(fd_PendingDistribution_community_tax_remainder, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_PendingDistribution) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "stratos.pot.v1.PendingDistribution.epoch":
+		return x.Epoch != ""
+	case "stratos.pot.v1.PendingDistribution.total_count":
+		return x.TotalCount != uint64(0)
+	case "stratos.pot.v1.PendingDistribution.processed_count":
+		return x.ProcessedCount != uint64(0)
+	case "stratos.pot.v1.PendingDistribution.total_consumed_noz":
+		return x.TotalConsumedNoz != ""
+	case "stratos.pot.v1.PendingDistribution.started":
+		return x.Started != false
+	case "stratos.pot.v1.PendingDistribution.mining_reward_to_resource_node":
+		return x.MiningRewardToResourceNode != nil
+	case "stratos.pot.v1.PendingDistribution.traffic_reward_to_resource_node":
+		return x.TrafficRewardToResourceNode != nil
+	case "stratos.pot.v1.PendingDistribution.mining_reward_to_meta_node":
+		return x.MiningRewardToMetaNode != nil
+	case "stratos.pot.v1.PendingDistribution.traffic_reward_to_meta_node":
+		return x.TrafficRewardToMetaNode != nil
+	case "stratos.pot.v1.PendingDistribution.mined_reward":
+		return x.MinedReward != nil
+	case "stratos.pot.v1.PendingDistribution.traffic_reward":
+		return x.TrafficReward != nil
+	case "stratos.pot.v1.PendingDistribution.dispute_deadline_height":
+		return x.DisputeDeadlineHeight != int64(0)
+	case "stratos.pot.v1.PendingDistribution.disputed":
+		return x.Disputed != false
+	case "stratos.pot.v1.PendingDistribution.community_tax_remainder":
+		return x.CommunityTaxRemainder != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.PendingDistribution"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.PendingDistribution does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PendingDistribution) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "stratos.pot.v1.PendingDistribution.epoch":
+		x.Epoch = ""
+	case "stratos.pot.v1.PendingDistribution.total_count":
+		x.TotalCount = uint64(0)
+	case "stratos.pot.v1.PendingDistribution.processed_count":
+		x.ProcessedCount = uint64(0)
+	case "stratos.pot.v1.PendingDistribution.total_consumed_noz":
+		x.TotalConsumedNoz = ""
+	case "stratos.pot.v1.PendingDistribution.started":
+		x.Started = false
+	case "stratos.pot.v1.PendingDistribution.mining_reward_to_resource_node":
+		x.MiningRewardToResourceNode = nil
+	case "stratos.pot.v1.PendingDistribution.traffic_reward_to_resource_node":
+		x.TrafficRewardToResourceNode = nil
+	case "stratos.pot.v1.PendingDistribution.mining_reward_to_meta_node":
+		x.MiningRewardToMetaNode = nil
+	case "stratos.pot.v1.PendingDistribution.traffic_reward_to_meta_node":
+		x.TrafficRewardToMetaNode = nil
+	case "stratos.pot.v1.PendingDistribution.mined_reward":
+		x.MinedReward = nil
+	case "stratos.pot.v1.PendingDistribution.traffic_reward":
+		x.TrafficReward = nil
+	case "stratos.pot.v1.PendingDistribution.dispute_deadline_height":
+		x.DisputeDeadlineHeight = int64(0)
+	case "stratos.pot.v1.PendingDistribution.disputed":
+		x.Disputed = false
+	case "stratos.pot.v1.PendingDistribution.community_tax_remainder":
+		x.CommunityTaxRemainder = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.PendingDistribution"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.PendingDistribution does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_PendingDistribution) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "stratos.pot.v1.PendingDistribution.epoch":
+		value := x.Epoch
+		return protoreflect.ValueOfString(value)
+	case "stratos.pot.v1.PendingDistribution.total_count":
+		value := x.TotalCount
+		return protoreflect.ValueOfUint64(value)
+	case "stratos.pot.v1.PendingDistribution.processed_count":
+		value := x.ProcessedCount
+		return protoreflect.ValueOfUint64(value)
+	case "stratos.pot.v1.PendingDistribution.total_consumed_noz":
+		value := x.TotalConsumedNoz
+		return protoreflect.ValueOfString(value)
+	case "stratos.pot.v1.PendingDistribution.started":
+		value := x.Started
+		return protoreflect.ValueOfBool(value)
+	case "stratos.pot.v1.PendingDistribution.mining_reward_to_resource_node":
+		value := x.MiningRewardToResourceNode
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "stratos.pot.v1.PendingDistribution.traffic_reward_to_resource_node":
+		value := x.TrafficRewardToResourceNode
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "stratos.pot.v1.PendingDistribution.mining_reward_to_meta_node":
+		value := x.MiningRewardToMetaNode
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "stratos.pot.v1.PendingDistribution.traffic_reward_to_meta_node":
+		value := x.TrafficRewardToMetaNode
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "stratos.pot.v1.PendingDistribution.mined_reward":
+		value := x.MinedReward
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "stratos.pot.v1.PendingDistribution.traffic_reward":
+		value := x.TrafficReward
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "stratos.pot.v1.PendingDistribution.dispute_deadline_height":
+		value := x.DisputeDeadlineHeight
+		return protoreflect.ValueOfInt64(value)
+	case "stratos.pot.v1.PendingDistribution.disputed":
+		value := x.Disputed
+		return protoreflect.ValueOfBool(value)
+	case "stratos.pot.v1.PendingDistribution.community_tax_remainder":
+		value := x.CommunityTaxRemainder
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.PendingDistribution"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.PendingDistribution does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PendingDistribution) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "stratos.pot.v1.PendingDistribution.epoch":
+		x.Epoch = value.Interface().(string)
+	case "stratos.pot.v1.PendingDistribution.total_count":
+		x.TotalCount = value.Uint()
+	case "stratos.pot.v1.PendingDistribution.processed_count":
+		x.ProcessedCount = value.Uint()
+	case "stratos.pot.v1.PendingDistribution.total_consumed_noz":
+		x.TotalConsumedNoz = value.Interface().(string)
+	case "stratos.pot.v1.PendingDistribution.started":
+		x.Started = value.Bool()
+	case "stratos.pot.v1.PendingDistribution.mining_reward_to_resource_node":
+		x.MiningRewardToResourceNode = value.Message().Interface().(*v1beta1.Coin)
+	case "stratos.pot.v1.PendingDistribution.traffic_reward_to_resource_node":
+		x.TrafficRewardToResourceNode = value.Message().Interface().(*v1beta1.Coin)
+	case "stratos.pot.v1.PendingDistribution.mining_reward_to_meta_node":
+		x.MiningRewardToMetaNode = value.Message().Interface().(*v1beta1.Coin)
+	case "stratos.pot.v1.PendingDistribution.traffic_reward_to_meta_node":
+		x.TrafficRewardToMetaNode = value.Message().Interface().(*v1beta1.Coin)
+	case "stratos.pot.v1.PendingDistribution.mined_reward":
+		x.MinedReward = value.Message().Interface().(*v1beta1.Coin)
+	case "stratos.pot.v1.PendingDistribution.traffic_reward":
+		x.TrafficReward = value.Message().Interface().(*v1beta1.Coin)
+	case "stratos.pot.v1.PendingDistribution.dispute_deadline_height":
+		x.DisputeDeadlineHeight = value.Int()
+	case "stratos.pot.v1.PendingDistribution.disputed":
+		x.Disputed = value.Bool()
+	case "stratos.pot.v1.PendingDistribution.community_tax_remainder":
+		x.CommunityTaxRemainder = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.PendingDistribution"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.PendingDistribution does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PendingDistribution) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "stratos.pot.v1.PendingDistribution.mining_reward_to_resource_node":
+		if x.MiningRewardToResourceNode == nil {
+			x.MiningRewardToResourceNode = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.MiningRewardToResourceNode.ProtoReflect())
+	case "stratos.pot.v1.PendingDistribution.traffic_reward_to_resource_node":
+		if x.TrafficRewardToResourceNode == nil {
+			x.TrafficRewardToResourceNode = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.TrafficRewardToResourceNode.ProtoReflect())
+	case "stratos.pot.v1.PendingDistribution.mining_reward_to_meta_node":
+		if x.MiningRewardToMetaNode == nil {
+			x.MiningRewardToMetaNode = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.MiningRewardToMetaNode.ProtoReflect())
+	case "stratos.pot.v1.PendingDistribution.traffic_reward_to_meta_node":
+		if x.TrafficRewardToMetaNode == nil {
+			x.TrafficRewardToMetaNode = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.TrafficRewardToMetaNode.ProtoReflect())
+	case "stratos.pot.v1.PendingDistribution.mined_reward":
+		if x.MinedReward == nil {
+			x.MinedReward = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.MinedReward.ProtoReflect())
+	case "stratos.pot.v1.PendingDistribution.traffic_reward":
+		if x.TrafficReward == nil {
+			x.TrafficReward = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.TrafficReward.ProtoReflect())
+	case "stratos.pot.v1.PendingDistribution.epoch":
+		panic(fmt.Errorf("field epoch of message stratos.pot.v1.PendingDistribution is not mutable"))
+	case "stratos.pot.v1.PendingDistribution.total_count":
+		panic(fmt.Errorf("field total_count of message stratos.pot.v1.PendingDistribution is not mutable"))
+	case "stratos.pot.v1.PendingDistribution.processed_count":
+		panic(fmt.Errorf("field processed_count of message stratos.pot.v1.PendingDistribution is not mutable"))
+	case "stratos.pot.v1.PendingDistribution.total_consumed_noz":
+		panic(fmt.Errorf("field total_consumed_noz of message stratos.pot.v1.PendingDistribution is not mutable"))
+	case "stratos.pot.v1.PendingDistribution.started":
+		panic(fmt.Errorf("field started of message stratos.pot.v1.PendingDistribution is not mutable"))
+	case "stratos.pot.v1.PendingDistribution.dispute_deadline_height":
+		panic(fmt.Errorf("field dispute_deadline_height of message stratos.pot.v1.PendingDistribution is not mutable"))
+	case "stratos.pot.v1.PendingDistribution.disputed":
+		panic(fmt.Errorf("field disputed of message stratos.pot.v1.PendingDistribution is not mutable"))
+	case "stratos.pot.v1.PendingDistribution.community_tax_remainder":
+		panic(fmt.Errorf("field community_tax_remainder of message stratos.pot.v1.PendingDistribution is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.PendingDistribution"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.PendingDistribution does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_PendingDistribution) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "stratos.pot.v1.PendingDistribution.epoch":
+		return protoreflect.ValueOfString("")
+	case "stratos.pot.v1.PendingDistribution.total_count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "stratos.pot.v1.PendingDistribution.processed_count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "stratos.pot.v1.PendingDistribution.total_consumed_noz":
+		return protoreflect.ValueOfString("")
+	case "stratos.pot.v1.PendingDistribution.started":
+		return protoreflect.ValueOfBool(false)
+	case "stratos.pot.v1.PendingDistribution.mining_reward_to_resource_node":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "stratos.pot.v1.PendingDistribution.traffic_reward_to_resource_node":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "stratos.pot.v1.PendingDistribution.mining_reward_to_meta_node":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "stratos.pot.v1.PendingDistribution.traffic_reward_to_meta_node":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "stratos.pot.v1.PendingDistribution.mined_reward":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "stratos.pot.v1.PendingDistribution.traffic_reward":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "stratos.pot.v1.PendingDistribution.dispute_deadline_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "stratos.pot.v1.PendingDistribution.disputed":
+		return protoreflect.ValueOfBool(false)
+	case "stratos.pot.v1.PendingDistribution.community_tax_remainder":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.PendingDistribution"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.PendingDistribution does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_PendingDistribution) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in stratos.pot.v1.PendingDistribution", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_PendingDistribution) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PendingDistribution) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_PendingDistribution) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_PendingDistribution) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*PendingDistribution)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Epoch)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.TotalCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.TotalCount))
+		}
+		if x.ProcessedCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.ProcessedCount))
+		}
+		l = len(x.TotalConsumedNoz)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Started {
+			n += 2
+		}
+		if x.MiningRewardToResourceNode != nil {
+			l = options.Size(x.MiningRewardToResourceNode)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.TrafficRewardToResourceNode != nil {
+			l = options.Size(x.TrafficRewardToResourceNode)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.MiningRewardToMetaNode != nil {
+			l = options.Size(x.MiningRewardToMetaNode)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.TrafficRewardToMetaNode != nil {
+			l = options.Size(x.TrafficRewardToMetaNode)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.MinedReward != nil {
+			l = options.Size(x.MinedReward)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.TrafficReward != nil {
+			l = options.Size(x.TrafficReward)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.DisputeDeadlineHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.DisputeDeadlineHeight))
+		}
+		if x.Disputed {
+			n += 2
+		}
+		l = len(x.CommunityTaxRemainder)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*PendingDistribution)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.CommunityTaxRemainder) > 0 {
+			i -= len(x.CommunityTaxRemainder)
+			copy(dAtA[i:], x.CommunityTaxRemainder)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CommunityTaxRemainder)))
+			i--
+			dAtA[i] = 0x72
+		}
+		if x.Disputed {
+			i--
+			if x.Disputed {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x68
+		}
+		if x.DisputeDeadlineHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DisputeDeadlineHeight))
+			i--
+			dAtA[i] = 0x60
+		}
+		if x.TrafficReward != nil {
+			encoded, err := options.Marshal(x.TrafficReward)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x5a
+		}
+		if x.MinedReward != nil {
+			encoded, err := options.Marshal(x.MinedReward)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x52
+		}
+		if x.TrafficRewardToMetaNode != nil {
+			encoded, err := options.Marshal(x.TrafficRewardToMetaNode)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if x.MiningRewardToMetaNode != nil {
+			encoded, err := options.Marshal(x.MiningRewardToMetaNode)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if x.TrafficRewardToResourceNode != nil {
+			encoded, err := options.Marshal(x.TrafficRewardToResourceNode)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if x.MiningRewardToResourceNode != nil {
+			encoded, err := options.Marshal(x.MiningRewardToResourceNode)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.Started {
+			i--
+			if x.Started {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.TotalConsumedNoz) > 0 {
+			i -= len(x.TotalConsumedNoz)
+			copy(dAtA[i:], x.TotalConsumedNoz)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TotalConsumedNoz)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.ProcessedCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ProcessedCount))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.TotalCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TotalCount))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Epoch) > 0 {
+			i -= len(x.Epoch)
+			copy(dAtA[i:], x.Epoch)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Epoch)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*PendingDistribution)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PendingDistribution: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PendingDistribution: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Epoch = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalCount", wireType)
+				}
+				x.TotalCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TotalCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProcessedCount", wireType)
+				}
+				x.ProcessedCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ProcessedCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalConsumedNoz", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TotalConsumedNoz = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Started", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Started = bool(v != 0)
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MiningRewardToResourceNode", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.MiningRewardToResourceNode == nil {
+					x.MiningRewardToResourceNode = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MiningRewardToResourceNode); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TrafficRewardToResourceNode", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.TrafficRewardToResourceNode == nil {
+					x.TrafficRewardToResourceNode = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TrafficRewardToResourceNode); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MiningRewardToMetaNode", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.MiningRewardToMetaNode == nil {
+					x.MiningRewardToMetaNode = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MiningRewardToMetaNode); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TrafficRewardToMetaNode", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.TrafficRewardToMetaNode == nil {
+					x.TrafficRewardToMetaNode = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TrafficRewardToMetaNode); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinedReward", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.MinedReward == nil {
+					x.MinedReward = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MinedReward); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TrafficReward", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.TrafficReward == nil {
+					x.TrafficReward = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TrafficReward); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 12:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DisputeDeadlineHeight", wireType)
+				}
+				x.DisputeDeadlineHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DisputeDeadlineHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 13:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Disputed", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Disputed = bool(v != 0)
+			case 14:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CommunityTaxRemainder", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CommunityTaxRemainder = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_DistributionVolume        protoreflect.MessageDescriptor
+	fd_DistributionVolume_epoch  protoreflect.FieldDescriptor
+	fd_DistributionVolume_index  protoreflect.FieldDescriptor
+	fd_DistributionVolume_volume protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_stratos_pot_v1_pot_proto_init()
+	md_DistributionVolume = File_stratos_pot_v1_pot_proto.Messages().ByName("DistributionVolume")
+	fd_DistributionVolume_epoch = md_DistributionVolume.Fields().ByName("epoch")
+	fd_DistributionVolume_index = md_DistributionVolume.Fields().ByName("index")
+	fd_DistributionVolume_volume = md_DistributionVolume.Fields().ByName("volume")
+}
+
+var _ protoreflect.Message = (*fastReflection_DistributionVolume)(nil)
+
+type fastReflection_DistributionVolume DistributionVolume
+
+func (x *DistributionVolume) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_DistributionVolume)(x)
+}
+
+func (x *DistributionVolume) slowProtoReflect() protoreflect.Message {
+	mi := &file_stratos_pot_v1_pot_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_DistributionVolume_messageType fastReflection_DistributionVolume_messageType
+var _ protoreflect.MessageType = fastReflection_DistributionVolume_messageType{}
+
+type fastReflection_DistributionVolume_messageType struct{}
+
+func (x fastReflection_DistributionVolume_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_DistributionVolume)(nil)
+}
+func (x fastReflection_DistributionVolume_messageType) New() protoreflect.Message {
+	return new(fastReflection_DistributionVolume)
+}
+func (x fastReflection_DistributionVolume_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_DistributionVolume
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_DistributionVolume) Descriptor() protoreflect.MessageDescriptor {
+	return md_DistributionVolume
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_DistributionVolume) Type() protoreflect.MessageType {
+	return _fastReflection_DistributionVolume_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_DistributionVolume) New() protoreflect.Message {
+	return new(fastReflection_DistributionVolume)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_DistributionVolume) Interface() protoreflect.ProtoMessage {
+	return (*DistributionVolume)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_DistributionVolume) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Epoch != "" {
+		value := protoreflect.ValueOfString(x.Epoch)
+		if !f(fd_DistributionVolume_epoch, value) {
+			return
+		}
+	}
+	if x.Index != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Index)
+		if !f(fd_DistributionVolume_index, value) {
+			return
+		}
+	}
+	if x.Volume != nil {
+		value := protoreflect.ValueOfMessage(x.Volume.ProtoReflect())
+		if !f(fd_DistributionVolume_volume, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_DistributionVolume) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "stratos.pot.v1.DistributionVolume.epoch":
+		return x.Epoch != ""
+	case "stratos.pot.v1.DistributionVolume.index":
+		return x.Index != uint64(0)
+	case "stratos.pot.v1.DistributionVolume.volume":
+		return x.Volume != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.DistributionVolume"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.DistributionVolume does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DistributionVolume) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "stratos.pot.v1.DistributionVolume.epoch":
+		x.Epoch = ""
+	case "stratos.pot.v1.DistributionVolume.index":
+		x.Index = uint64(0)
+	case "stratos.pot.v1.DistributionVolume.volume":
+		x.Volume = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.DistributionVolume"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.DistributionVolume does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_DistributionVolume) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "stratos.pot.v1.DistributionVolume.epoch":
+		value := x.Epoch
+		return protoreflect.ValueOfString(value)
+	case "stratos.pot.v1.DistributionVolume.index":
+		value := x.Index
+		return protoreflect.ValueOfUint64(value)
+	case "stratos.pot.v1.DistributionVolume.volume":
+		value := x.Volume
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.DistributionVolume"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.DistributionVolume does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DistributionVolume) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "stratos.pot.v1.DistributionVolume.epoch":
+		x.Epoch = value.Interface().(string)
+	case "stratos.pot.v1.DistributionVolume.index":
+		x.Index = value.Uint()
+	case "stratos.pot.v1.DistributionVolume.volume":
+		x.Volume = value.Message().Interface().(*SingleWalletVolume)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.DistributionVolume"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.DistributionVolume does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DistributionVolume) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "stratos.pot.v1.DistributionVolume.volume":
+		if x.Volume == nil {
+			x.Volume = new(SingleWalletVolume)
+		}
+		return protoreflect.ValueOfMessage(x.Volume.ProtoReflect())
+	case "stratos.pot.v1.DistributionVolume.epoch":
+		panic(fmt.Errorf("field epoch of message stratos.pot.v1.DistributionVolume is not mutable"))
+	case "stratos.pot.v1.DistributionVolume.index":
+		panic(fmt.Errorf("field index of message stratos.pot.v1.DistributionVolume is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.DistributionVolume"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.DistributionVolume does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_DistributionVolume) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "stratos.pot.v1.DistributionVolume.epoch":
+		return protoreflect.ValueOfString("")
+	case "stratos.pot.v1.DistributionVolume.index":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "stratos.pot.v1.DistributionVolume.volume":
+		m := new(SingleWalletVolume)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.DistributionVolume"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.DistributionVolume does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_DistributionVolume) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in stratos.pot.v1.DistributionVolume", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_DistributionVolume) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_DistributionVolume) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_DistributionVolume) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_DistributionVolume) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*DistributionVolume)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Epoch)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Index != 0 {
+			n += 1 + runtime.Sov(uint64(x.Index))
+		}
+		if x.Volume != nil {
+			l = options.Size(x.Volume)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*DistributionVolume)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Volume != nil {
+			encoded, err := options.Marshal(x.Volume)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.Index != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Index))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Epoch) > 0 {
+			i -= len(x.Epoch)
+			copy(dAtA[i:], x.Epoch)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Epoch)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*DistributionVolume)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DistributionVolume: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DistributionVolume: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Epoch = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				}
+				x.Index = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Index |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Volume == nil {
+					x.Volume = &SingleWalletVolume{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Volume); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_VolumeReportDispute_5_list)(nil)
+
+type _VolumeReportDispute_5_list struct {
+	list *[]*SingleWalletVolume
+}
+
+func (x *_VolumeReportDispute_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_VolumeReportDispute_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_VolumeReportDispute_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SingleWalletVolume)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_VolumeReportDispute_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SingleWalletVolume)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_VolumeReportDispute_5_list) AppendMutable() protoreflect.Value {
+	v := new(SingleWalletVolume)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_VolumeReportDispute_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_VolumeReportDispute_5_list) NewElement() protoreflect.Value {
+	v := new(SingleWalletVolume)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_VolumeReportDispute_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_VolumeReportDispute                protoreflect.MessageDescriptor
+	fd_VolumeReportDispute_epoch          protoreflect.FieldDescriptor
+	fd_VolumeReportDispute_disputer       protoreflect.FieldDescriptor
+	fd_VolumeReportDispute_reporter       protoreflect.FieldDescriptor
+	fd_VolumeReportDispute_reason         protoreflect.FieldDescriptor
+	fd_VolumeReportDispute_wallet_volumes protoreflect.FieldDescriptor
+	fd_VolumeReportDispute_height         protoreflect.FieldDescriptor
+	fd_VolumeReportDispute_proposal_id    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_stratos_pot_v1_pot_proto_init()
+	md_VolumeReportDispute = File_stratos_pot_v1_pot_proto.Messages().ByName("VolumeReportDispute")
+	fd_VolumeReportDispute_epoch = md_VolumeReportDispute.Fields().ByName("epoch")
+	fd_VolumeReportDispute_disputer = md_VolumeReportDispute.Fields().ByName("disputer")
+	fd_VolumeReportDispute_reporter = md_VolumeReportDispute.Fields().ByName("reporter")
+	fd_VolumeReportDispute_reason = md_VolumeReportDispute.Fields().ByName("reason")
+	fd_VolumeReportDispute_wallet_volumes = md_VolumeReportDispute.Fields().ByName("wallet_volumes")
+	fd_VolumeReportDispute_height = md_VolumeReportDispute.Fields().ByName("height")
+	fd_VolumeReportDispute_proposal_id = md_VolumeReportDispute.Fields().ByName("proposal_id")
+}
+
+var _ protoreflect.Message = (*fastReflection_VolumeReportDispute)(nil)
+
+type fastReflection_VolumeReportDispute VolumeReportDispute
+
+func (x *VolumeReportDispute) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_VolumeReportDispute)(x)
+}
+
+func (x *VolumeReportDispute) slowProtoReflect() protoreflect.Message {
+	mi := &file_stratos_pot_v1_pot_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_VolumeReportDispute_messageType fastReflection_VolumeReportDispute_messageType
+var _ protoreflect.MessageType = fastReflection_VolumeReportDispute_messageType{}
+
+type fastReflection_VolumeReportDispute_messageType struct{}
+
+func (x fastReflection_VolumeReportDispute_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_VolumeReportDispute)(nil)
+}
+func (x fastReflection_VolumeReportDispute_messageType) New() protoreflect.Message {
+	return new(fastReflection_VolumeReportDispute)
+}
+func (x fastReflection_VolumeReportDispute_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_VolumeReportDispute
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_VolumeReportDispute) Descriptor() protoreflect.MessageDescriptor {
+	return md_VolumeReportDispute
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_VolumeReportDispute) Type() protoreflect.MessageType {
+	return _fastReflection_VolumeReportDispute_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_VolumeReportDispute) New() protoreflect.Message {
+	return new(fastReflection_VolumeReportDispute)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_VolumeReportDispute) Interface() protoreflect.ProtoMessage {
+	return (*VolumeReportDispute)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_VolumeReportDispute) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Epoch != "" {
+		value := protoreflect.ValueOfString(x.Epoch)
+		if !f(fd_VolumeReportDispute_epoch, value) {
+			return
+		}
+	}
+	if x.Disputer != "" {
+		value := protoreflect.ValueOfString(x.Disputer)
+		if !f(fd_VolumeReportDispute_disputer, value) {
+			return
+		}
+	}
+	if x.Reporter != "" {
+		value := protoreflect.ValueOfString(x.Reporter)
+		if !f(fd_VolumeReportDispute_reporter, value) {
+			return
+		}
+	}
+	if x.Reason != "" {
+		value := protoreflect.ValueOfString(x.Reason)
+		if !f(fd_VolumeReportDispute_reason, value) {
+			return
+		}
+	}
+	if len(x.WalletVolumes) != 0 {
+		value := protoreflect.ValueOfList(&_VolumeReportDispute_5_list{list: &x.WalletVolumes})
+		if !f(fd_VolumeReportDispute_wallet_volumes, value) {
+			return
+		}
+	}
+	if x.Height != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Height)
+		if !f(fd_VolumeReportDispute_height, value) {
+			return
+		}
+	}
+	if x.ProposalId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ProposalId)
+		if !f(fd_VolumeReportDispute_proposal_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_VolumeReportDispute) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "stratos.pot.v1.VolumeReportDispute.epoch":
+		return x.Epoch != ""
+	case "stratos.pot.v1.VolumeReportDispute.disputer":
+		return x.Disputer != ""
+	case "stratos.pot.v1.VolumeReportDispute.reporter":
+		return x.Reporter != ""
+	case "stratos.pot.v1.VolumeReportDispute.reason":
+		return x.Reason != ""
+	case "stratos.pot.v1.VolumeReportDispute.wallet_volumes":
+		return len(x.WalletVolumes) != 0
+	case "stratos.pot.v1.VolumeReportDispute.height":
+		return x.Height != int64(0)
+	case "stratos.pot.v1.VolumeReportDispute.proposal_id":
+		return x.ProposalId != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.VolumeReportDispute"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.VolumeReportDispute does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VolumeReportDispute) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "stratos.pot.v1.VolumeReportDispute.epoch":
+		x.Epoch = ""
+	case "stratos.pot.v1.VolumeReportDispute.disputer":
+		x.Disputer = ""
+	case "stratos.pot.v1.VolumeReportDispute.reporter":
+		x.Reporter = ""
+	case "stratos.pot.v1.VolumeReportDispute.reason":
+		x.Reason = ""
+	case "stratos.pot.v1.VolumeReportDispute.wallet_volumes":
+		x.WalletVolumes = nil
+	case "stratos.pot.v1.VolumeReportDispute.height":
+		x.Height = int64(0)
+	case "stratos.pot.v1.VolumeReportDispute.proposal_id":
+		x.ProposalId = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.VolumeReportDispute"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.VolumeReportDispute does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_VolumeReportDispute) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "stratos.pot.v1.VolumeReportDispute.epoch":
+		value := x.Epoch
+		return protoreflect.ValueOfString(value)
+	case "stratos.pot.v1.VolumeReportDispute.disputer":
+		value := x.Disputer
+		return protoreflect.ValueOfString(value)
+	case "stratos.pot.v1.VolumeReportDispute.reporter":
+		value := x.Reporter
+		return protoreflect.ValueOfString(value)
+	case "stratos.pot.v1.VolumeReportDispute.reason":
+		value := x.Reason
+		return protoreflect.ValueOfString(value)
+	case "stratos.pot.v1.VolumeReportDispute.wallet_volumes":
+		if len(x.WalletVolumes) == 0 {
+			return protoreflect.ValueOfList(&_VolumeReportDispute_5_list{})
+		}
+		listValue := &_VolumeReportDispute_5_list{list: &x.WalletVolumes}
+		return protoreflect.ValueOfList(listValue)
+	case "stratos.pot.v1.VolumeReportDispute.height":
+		value := x.Height
+		return protoreflect.ValueOfInt64(value)
+	case "stratos.pot.v1.VolumeReportDispute.proposal_id":
+		value := x.ProposalId
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.VolumeReportDispute"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.VolumeReportDispute does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VolumeReportDispute) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "stratos.pot.v1.VolumeReportDispute.epoch":
+		x.Epoch = value.Interface().(string)
+	case "stratos.pot.v1.VolumeReportDispute.disputer":
+		x.Disputer = value.Interface().(string)
+	case "stratos.pot.v1.VolumeReportDispute.reporter":
+		x.Reporter = value.Interface().(string)
+	case "stratos.pot.v1.VolumeReportDispute.reason":
+		x.Reason = value.Interface().(string)
+	case "stratos.pot.v1.VolumeReportDispute.wallet_volumes":
+		lv := value.List()
+		clv := lv.(*_VolumeReportDispute_5_list)
+		x.WalletVolumes = *clv.list
+	case "stratos.pot.v1.VolumeReportDispute.height":
+		x.Height = value.Int()
+	case "stratos.pot.v1.VolumeReportDispute.proposal_id":
+		x.ProposalId = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.VolumeReportDispute"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.VolumeReportDispute does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VolumeReportDispute) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "stratos.pot.v1.VolumeReportDispute.wallet_volumes":
+		if x.WalletVolumes == nil {
+			x.WalletVolumes = []*SingleWalletVolume{}
+		}
+		value := &_VolumeReportDispute_5_list{list: &x.WalletVolumes}
+		return protoreflect.ValueOfList(value)
+	case "stratos.pot.v1.VolumeReportDispute.epoch":
+		panic(fmt.Errorf("field epoch of message stratos.pot.v1.VolumeReportDispute is not mutable"))
+	case "stratos.pot.v1.VolumeReportDispute.disputer":
+		panic(fmt.Errorf("field disputer of message stratos.pot.v1.VolumeReportDispute is not mutable"))
+	case "stratos.pot.v1.VolumeReportDispute.reporter":
+		panic(fmt.Errorf("field reporter of message stratos.pot.v1.VolumeReportDispute is not mutable"))
+	case "stratos.pot.v1.VolumeReportDispute.reason":
+		panic(fmt.Errorf("field reason of message stratos.pot.v1.VolumeReportDispute is not mutable"))
+	case "stratos.pot.v1.VolumeReportDispute.height":
+		panic(fmt.Errorf("field height of message stratos.pot.v1.VolumeReportDispute is not mutable"))
+	case "stratos.pot.v1.VolumeReportDispute.proposal_id":
+		panic(fmt.Errorf("field proposal_id of message stratos.pot.v1.VolumeReportDispute is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.VolumeReportDispute"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.VolumeReportDispute does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_VolumeReportDispute) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "stratos.pot.v1.VolumeReportDispute.epoch":
+		return protoreflect.ValueOfString("")
+	case "stratos.pot.v1.VolumeReportDispute.disputer":
+		return protoreflect.ValueOfString("")
+	case "stratos.pot.v1.VolumeReportDispute.reporter":
+		return protoreflect.ValueOfString("")
+	case "stratos.pot.v1.VolumeReportDispute.reason":
+		return protoreflect.ValueOfString("")
+	case "stratos.pot.v1.VolumeReportDispute.wallet_volumes":
+		list := []*SingleWalletVolume{}
+		return protoreflect.ValueOfList(&_VolumeReportDispute_5_list{list: &list})
+	case "stratos.pot.v1.VolumeReportDispute.height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "stratos.pot.v1.VolumeReportDispute.proposal_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.VolumeReportDispute"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.VolumeReportDispute does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_VolumeReportDispute) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in stratos.pot.v1.VolumeReportDispute", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_VolumeReportDispute) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VolumeReportDispute) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_VolumeReportDispute) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_VolumeReportDispute) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*VolumeReportDispute)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Epoch)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Disputer)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Reporter)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Reason)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.WalletVolumes) > 0 {
+			for _, e := range x.WalletVolumes {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
+		}
+		if x.ProposalId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ProposalId))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*VolumeReportDispute)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.ProposalId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ProposalId))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+			i--
+			dAtA[i] = 0x30
+		}
+		if len(x.WalletVolumes) > 0 {
+			for iNdEx := len(x.WalletVolumes) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.WalletVolumes[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if len(x.Reason) > 0 {
+			i -= len(x.Reason)
+			copy(dAtA[i:], x.Reason)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Reason)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Reporter) > 0 {
+			i -= len(x.Reporter)
+			copy(dAtA[i:], x.Reporter)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Reporter)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Disputer) > 0 {
+			i -= len(x.Disputer)
+			copy(dAtA[i:], x.Disputer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Disputer)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Epoch) > 0 {
+			i -= len(x.Epoch)
+			copy(dAtA[i:], x.Epoch)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Epoch)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*VolumeReportDispute)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: VolumeReportDispute: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: VolumeReportDispute: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Epoch = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Disputer", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Disputer = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reporter", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Reporter = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Reason = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WalletVolumes", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.WalletVolumes = append(x.WalletVolumes, &SingleWalletVolume{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.WalletVolumes[len(x.WalletVolumes)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				}
+				x.Height = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Height |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+				}
+				x.ProposalId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ProposalId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_ReportEquivocation          protoreflect.MessageDescriptor
+	fd_ReportEquivocation_epoch    protoreflect.FieldDescriptor
+	fd_ReportEquivocation_accused  protoreflect.FieldDescriptor
+	fd_ReportEquivocation_reporter protoreflect.FieldDescriptor
+	fd_ReportEquivocation_slashed  protoreflect.FieldDescriptor
+	fd_ReportEquivocation_height   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_stratos_pot_v1_pot_proto_init()
+	md_ReportEquivocation = File_stratos_pot_v1_pot_proto.Messages().ByName("ReportEquivocation")
+	fd_ReportEquivocation_epoch = md_ReportEquivocation.Fields().ByName("epoch")
+	fd_ReportEquivocation_accused = md_ReportEquivocation.Fields().ByName("accused")
+	fd_ReportEquivocation_reporter = md_ReportEquivocation.Fields().ByName("reporter")
+	fd_ReportEquivocation_slashed = md_ReportEquivocation.Fields().ByName("slashed")
+	fd_ReportEquivocation_height = md_ReportEquivocation.Fields().ByName("height")
+}
+
+var _ protoreflect.Message = (*fastReflection_ReportEquivocation)(nil)
+
+type fastReflection_ReportEquivocation ReportEquivocation
+
+func (x *ReportEquivocation) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ReportEquivocation)(x)
+}
+
+func (x *ReportEquivocation) slowProtoReflect() protoreflect.Message {
+	mi := &file_stratos_pot_v1_pot_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ReportEquivocation_messageType fastReflection_ReportEquivocation_messageType
+var _ protoreflect.MessageType = fastReflection_ReportEquivocation_messageType{}
+
+type fastReflection_ReportEquivocation_messageType struct{}
+
+func (x fastReflection_ReportEquivocation_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ReportEquivocation)(nil)
+}
+func (x fastReflection_ReportEquivocation_messageType) New() protoreflect.Message {
+	return new(fastReflection_ReportEquivocation)
+}
+func (x fastReflection_ReportEquivocation_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ReportEquivocation
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ReportEquivocation) Descriptor() protoreflect.MessageDescriptor {
+	return md_ReportEquivocation
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ReportEquivocation) Type() protoreflect.MessageType {
+	return _fastReflection_ReportEquivocation_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ReportEquivocation) New() protoreflect.Message {
+	return new(fastReflection_ReportEquivocation)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ReportEquivocation) Interface() protoreflect.ProtoMessage {
+	return (*ReportEquivocation)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ReportEquivocation) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Epoch != "" {
+		value := protoreflect.ValueOfString(x.Epoch)
+		if !f(fd_ReportEquivocation_epoch, value) {
+			return
+		}
+	}
+	if x.Accused != "" {
+		value := protoreflect.ValueOfString(x.Accused)
+		if !f(fd_ReportEquivocation_accused, value) {
+			return
+		}
+	}
+	if x.Reporter != "" {
+		value := protoreflect.ValueOfString(x.Reporter)
+		if !f(fd_ReportEquivocation_reporter, value) {
+			return
+		}
+	}
+	if x.Slashed != nil {
+		value := protoreflect.ValueOfMessage(x.Slashed.ProtoReflect())
+		if !f(fd_ReportEquivocation_slashed, value) {
+			return
+		}
+	}
+	if x.Height != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Height)
+		if !f(fd_ReportEquivocation_height, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ReportEquivocation) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "stratos.pot.v1.ReportEquivocation.epoch":
+		return x.Epoch != ""
+	case "stratos.pot.v1.ReportEquivocation.accused":
+		return x.Accused != ""
+	case "stratos.pot.v1.ReportEquivocation.reporter":
+		return x.Reporter != ""
+	case "stratos.pot.v1.ReportEquivocation.slashed":
+		return x.Slashed != nil
+	case "stratos.pot.v1.ReportEquivocation.height":
+		return x.Height != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.ReportEquivocation"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.ReportEquivocation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ReportEquivocation) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "stratos.pot.v1.ReportEquivocation.epoch":
+		x.Epoch = ""
+	case "stratos.pot.v1.ReportEquivocation.accused":
+		x.Accused = ""
+	case "stratos.pot.v1.ReportEquivocation.reporter":
+		x.Reporter = ""
+	case "stratos.pot.v1.ReportEquivocation.slashed":
+		x.Slashed = nil
+	case "stratos.pot.v1.ReportEquivocation.height":
+		x.Height = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.ReportEquivocation"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.ReportEquivocation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ReportEquivocation) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "stratos.pot.v1.ReportEquivocation.epoch":
+		value := x.Epoch
+		return protoreflect.ValueOfString(value)
+	case "stratos.pot.v1.ReportEquivocation.accused":
+		value := x.Accused
+		return protoreflect.ValueOfString(value)
+	case "stratos.pot.v1.ReportEquivocation.reporter":
+		value := x.Reporter
+		return protoreflect.ValueOfString(value)
+	case "stratos.pot.v1.ReportEquivocation.slashed":
+		value := x.Slashed
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "stratos.pot.v1.ReportEquivocation.height":
+		value := x.Height
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.ReportEquivocation"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.ReportEquivocation does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ReportEquivocation) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "stratos.pot.v1.ReportEquivocation.epoch":
+		x.Epoch = value.Interface().(string)
+	case "stratos.pot.v1.ReportEquivocation.accused":
+		x.Accused = value.Interface().(string)
+	case "stratos.pot.v1.ReportEquivocation.reporter":
+		x.Reporter = value.Interface().(string)
+	case "stratos.pot.v1.ReportEquivocation.slashed":
+		x.Slashed = value.Message().Interface().(*v1beta1.Coin)
+	case "stratos.pot.v1.ReportEquivocation.height":
+		x.Height = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.ReportEquivocation"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.ReportEquivocation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ReportEquivocation) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "stratos.pot.v1.ReportEquivocation.slashed":
+		if x.Slashed == nil {
+			x.Slashed = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.Slashed.ProtoReflect())
+	case "stratos.pot.v1.ReportEquivocation.epoch":
+		panic(fmt.Errorf("field epoch of message stratos.pot.v1.ReportEquivocation is not mutable"))
+	case "stratos.pot.v1.ReportEquivocation.accused":
+		panic(fmt.Errorf("field accused of message stratos.pot.v1.ReportEquivocation is not mutable"))
+	case "stratos.pot.v1.ReportEquivocation.reporter":
+		panic(fmt.Errorf("field reporter of message stratos.pot.v1.ReportEquivocation is not mutable"))
+	case "stratos.pot.v1.ReportEquivocation.height":
+		panic(fmt.Errorf("field height of message stratos.pot.v1.ReportEquivocation is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.ReportEquivocation"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.ReportEquivocation does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ReportEquivocation) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "stratos.pot.v1.ReportEquivocation.epoch":
+		return protoreflect.ValueOfString("")
+	case "stratos.pot.v1.ReportEquivocation.accused":
+		return protoreflect.ValueOfString("")
+	case "stratos.pot.v1.ReportEquivocation.reporter":
+		return protoreflect.ValueOfString("")
+	case "stratos.pot.v1.ReportEquivocation.slashed":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "stratos.pot.v1.ReportEquivocation.height":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.ReportEquivocation"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.ReportEquivocation does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ReportEquivocation) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in stratos.pot.v1.ReportEquivocation", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ReportEquivocation) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ReportEquivocation) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ReportEquivocation) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ReportEquivocation) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ReportEquivocation)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Epoch)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Accused)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Reporter)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Slashed != nil {
+			l = options.Size(x.Slashed)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ReportEquivocation)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.Slashed != nil {
+			encoded, err := options.Marshal(x.Slashed)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Reporter) > 0 {
+			i -= len(x.Reporter)
+			copy(dAtA[i:], x.Reporter)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Reporter)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Accused) > 0 {
+			i -= len(x.Accused)
+			copy(dAtA[i:], x.Accused)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Accused)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Epoch) > 0 {
+			i -= len(x.Epoch)
+			copy(dAtA[i:], x.Epoch)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Epoch)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ReportEquivocation)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ReportEquivocation: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ReportEquivocation: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Epoch = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Accused", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Accused = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reporter", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Reporter = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Slashed", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Slashed == nil {
+					x.Slashed = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Slashed); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				}
+				x.Height = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Height |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_RewardHistory_3_list)(nil)
+
+type _RewardHistory_3_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_RewardHistory_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_RewardHistory_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_RewardHistory_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_RewardHistory_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_RewardHistory_3_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_RewardHistory_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_RewardHistory_3_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_RewardHistory_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_RewardHistory_4_list)(nil)
+
+type _RewardHistory_4_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_RewardHistory_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_RewardHistory_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_RewardHistory_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_RewardHistory_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_RewardHistory_4_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_RewardHistory_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_RewardHistory_4_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_RewardHistory_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_RewardHistory_5_list)(nil)
+
+type _RewardHistory_5_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_RewardHistory_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_RewardHistory_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_RewardHistory_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_RewardHistory_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_RewardHistory_5_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_RewardHistory_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_RewardHistory_5_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_RewardHistory_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_RewardHistory                          protoreflect.MessageDescriptor
+	fd_RewardHistory_wallet_address           protoreflect.FieldDescriptor
+	fd_RewardHistory_epoch                    protoreflect.FieldDescriptor
+	fd_RewardHistory_reward_from_mining_pool  protoreflect.FieldDescriptor
+	fd_RewardHistory_reward_from_traffic_pool protoreflect.FieldDescriptor
+	fd_RewardHistory_slashing_deducted        protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_stratos_pot_v1_pot_proto_init()
+	md_RewardHistory = File_stratos_pot_v1_pot_proto.Messages().ByName("RewardHistory")
+	fd_RewardHistory_wallet_address = md_RewardHistory.Fields().ByName("wallet_address")
+	fd_RewardHistory_epoch = md_RewardHistory.Fields().ByName("epoch")
+	fd_RewardHistory_reward_from_mining_pool = md_RewardHistory.Fields().ByName("reward_from_mining_pool")
+	fd_RewardHistory_reward_from_traffic_pool = md_RewardHistory.Fields().ByName("reward_from_traffic_pool")
+	fd_RewardHistory_slashing_deducted = md_RewardHistory.Fields().ByName("slashing_deducted")
+}
+
+var _ protoreflect.Message = (*fastReflection_RewardHistory)(nil)
+
+type fastReflection_RewardHistory RewardHistory
+
+func (x *RewardHistory) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_RewardHistory)(x)
+}
+
+func (x *RewardHistory) slowProtoReflect() protoreflect.Message {
+	mi := &file_stratos_pot_v1_pot_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_RewardHistory_messageType fastReflection_RewardHistory_messageType
+var _ protoreflect.MessageType = fastReflection_RewardHistory_messageType{}
+
+type fastReflection_RewardHistory_messageType struct{}
+
+func (x fastReflection_RewardHistory_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_RewardHistory)(nil)
+}
+func (x fastReflection_RewardHistory_messageType) New() protoreflect.Message {
+	return new(fastReflection_RewardHistory)
+}
+func (x fastReflection_RewardHistory_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_RewardHistory
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_RewardHistory) Descriptor() protoreflect.MessageDescriptor {
+	return md_RewardHistory
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_RewardHistory) Type() protoreflect.MessageType {
+	return _fastReflection_RewardHistory_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_RewardHistory) New() protoreflect.Message {
+	return new(fastReflection_RewardHistory)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_RewardHistory) Interface() protoreflect.ProtoMessage {
+	return (*RewardHistory)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_RewardHistory) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.WalletAddress != "" {
+		value := protoreflect.ValueOfString(x.WalletAddress)
+		if !f(fd_RewardHistory_wallet_address, value) {
+			return
+		}
+	}
+	if x.Epoch != "" {
+		value := protoreflect.ValueOfString(x.Epoch)
+		if !f(fd_RewardHistory_epoch, value) {
+			return
+		}
+	}
+	if len(x.RewardFromMiningPool) != 0 {
+		value := protoreflect.ValueOfList(&_RewardHistory_3_list{list: &x.RewardFromMiningPool})
+		if !f(fd_RewardHistory_reward_from_mining_pool, value) {
+			return
+		}
+	}
+	if len(x.RewardFromTrafficPool) != 0 {
+		value := protoreflect.ValueOfList(&_RewardHistory_4_list{list: &x.RewardFromTrafficPool})
+		if !f(fd_RewardHistory_reward_from_traffic_pool, value) {
+			return
+		}
+	}
+	if len(x.SlashingDeducted) != 0 {
+		value := protoreflect.ValueOfList(&_RewardHistory_5_list{list: &x.SlashingDeducted})
+		if !f(fd_RewardHistory_slashing_deducted, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_RewardHistory) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "stratos.pot.v1.RewardHistory.wallet_address":
+		return x.WalletAddress != ""
+	case "stratos.pot.v1.RewardHistory.epoch":
+		return x.Epoch != ""
+	case "stratos.pot.v1.RewardHistory.reward_from_mining_pool":
+		return len(x.RewardFromMiningPool) != 0
+	case "stratos.pot.v1.RewardHistory.reward_from_traffic_pool":
+		return len(x.RewardFromTrafficPool) != 0
+	case "stratos.pot.v1.RewardHistory.slashing_deducted":
+		return len(x.SlashingDeducted) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.RewardHistory"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.RewardHistory does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RewardHistory) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "stratos.pot.v1.RewardHistory.wallet_address":
+		x.WalletAddress = ""
+	case "stratos.pot.v1.RewardHistory.epoch":
+		x.Epoch = ""
+	case "stratos.pot.v1.RewardHistory.reward_from_mining_pool":
+		x.RewardFromMiningPool = nil
+	case "stratos.pot.v1.RewardHistory.reward_from_traffic_pool":
+		x.RewardFromTrafficPool = nil
+	case "stratos.pot.v1.RewardHistory.slashing_deducted":
+		x.SlashingDeducted = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.RewardHistory"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.RewardHistory does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_RewardHistory) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "stratos.pot.v1.RewardHistory.wallet_address":
+		value := x.WalletAddress
+		return protoreflect.ValueOfString(value)
+	case "stratos.pot.v1.RewardHistory.epoch":
+		value := x.Epoch
+		return protoreflect.ValueOfString(value)
+	case "stratos.pot.v1.RewardHistory.reward_from_mining_pool":
+		if len(x.RewardFromMiningPool) == 0 {
+			return protoreflect.ValueOfList(&_RewardHistory_3_list{})
+		}
+		listValue := &_RewardHistory_3_list{list: &x.RewardFromMiningPool}
+		return protoreflect.ValueOfList(listValue)
+	case "stratos.pot.v1.RewardHistory.reward_from_traffic_pool":
+		if len(x.RewardFromTrafficPool) == 0 {
+			return protoreflect.ValueOfList(&_RewardHistory_4_list{})
+		}
+		listValue := &_RewardHistory_4_list{list: &x.RewardFromTrafficPool}
+		return protoreflect.ValueOfList(listValue)
+	case "stratos.pot.v1.RewardHistory.slashing_deducted":
+		if len(x.SlashingDeducted) == 0 {
+			return protoreflect.ValueOfList(&_RewardHistory_5_list{})
+		}
+		listValue := &_RewardHistory_5_list{list: &x.SlashingDeducted}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.RewardHistory"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.RewardHistory does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RewardHistory) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "stratos.pot.v1.RewardHistory.wallet_address":
+		x.WalletAddress = value.Interface().(string)
+	case "stratos.pot.v1.RewardHistory.epoch":
+		x.Epoch = value.Interface().(string)
+	case "stratos.pot.v1.RewardHistory.reward_from_mining_pool":
+		lv := value.List()
+		clv := lv.(*_RewardHistory_3_list)
+		x.RewardFromMiningPool = *clv.list
+	case "stratos.pot.v1.RewardHistory.reward_from_traffic_pool":
+		lv := value.List()
+		clv := lv.(*_RewardHistory_4_list)
+		x.RewardFromTrafficPool = *clv.list
+	case "stratos.pot.v1.RewardHistory.slashing_deducted":
+		lv := value.List()
+		clv := lv.(*_RewardHistory_5_list)
+		x.SlashingDeducted = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.RewardHistory"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.RewardHistory does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RewardHistory) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "stratos.pot.v1.RewardHistory.reward_from_mining_pool":
+		if x.RewardFromMiningPool == nil {
+			x.RewardFromMiningPool = []*v1beta1.Coin{}
+		}
+		value := &_RewardHistory_3_list{list: &x.RewardFromMiningPool}
+		return protoreflect.ValueOfList(value)
+	case "stratos.pot.v1.RewardHistory.reward_from_traffic_pool":
+		if x.RewardFromTrafficPool == nil {
+			x.RewardFromTrafficPool = []*v1beta1.Coin{}
+		}
+		value := &_RewardHistory_4_list{list: &x.RewardFromTrafficPool}
+		return protoreflect.ValueOfList(value)
+	case "stratos.pot.v1.RewardHistory.slashing_deducted":
+		if x.SlashingDeducted == nil {
+			x.SlashingDeducted = []*v1beta1.Coin{}
+		}
+		value := &_RewardHistory_5_list{list: &x.SlashingDeducted}
+		return protoreflect.ValueOfList(value)
+	case "stratos.pot.v1.RewardHistory.wallet_address":
+		panic(fmt.Errorf("field wallet_address of message stratos.pot.v1.RewardHistory is not mutable"))
+	case "stratos.pot.v1.RewardHistory.epoch":
+		panic(fmt.Errorf("field epoch of message stratos.pot.v1.RewardHistory is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.RewardHistory"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.RewardHistory does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_RewardHistory) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "stratos.pot.v1.RewardHistory.wallet_address":
+		return protoreflect.ValueOfString("")
+	case "stratos.pot.v1.RewardHistory.epoch":
+		return protoreflect.ValueOfString("")
+	case "stratos.pot.v1.RewardHistory.reward_from_mining_pool":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_RewardHistory_3_list{list: &list})
+	case "stratos.pot.v1.RewardHistory.reward_from_traffic_pool":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_RewardHistory_4_list{list: &list})
+	case "stratos.pot.v1.RewardHistory.slashing_deducted":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_RewardHistory_5_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.RewardHistory"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.RewardHistory does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_RewardHistory) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in stratos.pot.v1.RewardHistory", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_RewardHistory) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RewardHistory) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_RewardHistory) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_RewardHistory) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*RewardHistory)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.WalletAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Epoch)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.RewardFromMiningPool) > 0 {
+			for _, e := range x.RewardFromMiningPool {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.RewardFromTrafficPool) > 0 {
+			for _, e := range x.RewardFromTrafficPool {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.SlashingDeducted) > 0 {
+			for _, e := range x.SlashingDeducted {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*RewardHistory)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.SlashingDeducted) > 0 {
+			for iNdEx := len(x.SlashingDeducted) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.SlashingDeducted[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if len(x.RewardFromTrafficPool) > 0 {
+			for iNdEx := len(x.RewardFromTrafficPool) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.RewardFromTrafficPool[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if len(x.RewardFromMiningPool) > 0 {
+			for iNdEx := len(x.RewardFromMiningPool) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.RewardFromMiningPool[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.Epoch) > 0 {
+			i -= len(x.Epoch)
+			copy(dAtA[i:], x.Epoch)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Epoch)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.WalletAddress) > 0 {
+			i -= len(x.WalletAddress)
+			copy(dAtA[i:], x.WalletAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.WalletAddress)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*RewardHistory)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RewardHistory: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RewardHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WalletAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.WalletAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Epoch = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RewardFromMiningPool", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RewardFromMiningPool = append(x.RewardFromMiningPool, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RewardFromMiningPool[len(x.RewardFromMiningPool)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RewardFromTrafficPool", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RewardFromTrafficPool = append(x.RewardFromTrafficPool, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RewardFromTrafficPool[len(x.RewardFromTrafficPool)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SlashingDeducted", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SlashingDeducted = append(x.SlashingDeducted, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.SlashingDeducted[len(x.SlashingDeducted)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_AutoRestake                 protoreflect.MessageDescriptor
+	fd_AutoRestake_wallet_address  protoreflect.FieldDescriptor
+	fd_AutoRestake_network_address protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_stratos_pot_v1_pot_proto_init()
+	md_AutoRestake = File_stratos_pot_v1_pot_proto.Messages().ByName("AutoRestake")
+	fd_AutoRestake_wallet_address = md_AutoRestake.Fields().ByName("wallet_address")
+	fd_AutoRestake_network_address = md_AutoRestake.Fields().ByName("network_address")
+}
+
+var _ protoreflect.Message = (*fastReflection_AutoRestake)(nil)
+
+type fastReflection_AutoRestake AutoRestake
+
+func (x *AutoRestake) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_AutoRestake)(x)
+}
+
+func (x *AutoRestake) slowProtoReflect() protoreflect.Message {
+	mi := &file_stratos_pot_v1_pot_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_AutoRestake_messageType fastReflection_AutoRestake_messageType
+var _ protoreflect.MessageType = fastReflection_AutoRestake_messageType{}
+
+type fastReflection_AutoRestake_messageType struct{}
+
+func (x fastReflection_AutoRestake_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_AutoRestake)(nil)
+}
+func (x fastReflection_AutoRestake_messageType) New() protoreflect.Message {
+	return new(fastReflection_AutoRestake)
+}
+func (x fastReflection_AutoRestake_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_AutoRestake
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_AutoRestake) Descriptor() protoreflect.MessageDescriptor {
+	return md_AutoRestake
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_AutoRestake) Type() protoreflect.MessageType {
+	return _fastReflection_AutoRestake_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_AutoRestake) New() protoreflect.Message {
+	return new(fastReflection_AutoRestake)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_AutoRestake) Interface() protoreflect.ProtoMessage {
+	return (*AutoRestake)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_AutoRestake) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.WalletAddress != "" {
+		value := protoreflect.ValueOfString(x.WalletAddress)
+		if !f(fd_AutoRestake_wallet_address, value) {
+			return
+		}
+	}
+	if x.NetworkAddress != "" {
+		value := protoreflect.ValueOfString(x.NetworkAddress)
+		if !f(fd_AutoRestake_network_address, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_AutoRestake) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "stratos.pot.v1.AutoRestake.wallet_address":
+		return x.WalletAddress != ""
+	case "stratos.pot.v1.AutoRestake.network_address":
+		return x.NetworkAddress != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.AutoRestake"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.AutoRestake does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AutoRestake) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "stratos.pot.v1.AutoRestake.wallet_address":
+		x.WalletAddress = ""
+	case "stratos.pot.v1.AutoRestake.network_address":
+		x.NetworkAddress = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.AutoRestake"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.AutoRestake does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_AutoRestake) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "stratos.pot.v1.AutoRestake.wallet_address":
+		value := x.WalletAddress
+		return protoreflect.ValueOfString(value)
+	case "stratos.pot.v1.AutoRestake.network_address":
+		value := x.NetworkAddress
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.AutoRestake"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.AutoRestake does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AutoRestake) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "stratos.pot.v1.AutoRestake.wallet_address":
+		x.WalletAddress = value.Interface().(string)
+	case "stratos.pot.v1.AutoRestake.network_address":
+		x.NetworkAddress = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.AutoRestake"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.AutoRestake does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AutoRestake) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "stratos.pot.v1.AutoRestake.wallet_address":
+		panic(fmt.Errorf("field wallet_address of message stratos.pot.v1.AutoRestake is not mutable"))
+	case "stratos.pot.v1.AutoRestake.network_address":
+		panic(fmt.Errorf("field network_address of message stratos.pot.v1.AutoRestake is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.AutoRestake"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.AutoRestake does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_AutoRestake) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "stratos.pot.v1.AutoRestake.wallet_address":
+		return protoreflect.ValueOfString("")
+	case "stratos.pot.v1.AutoRestake.network_address":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.AutoRestake"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.AutoRestake does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_AutoRestake) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in stratos.pot.v1.AutoRestake", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_AutoRestake) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AutoRestake) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_AutoRestake) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_AutoRestake) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*AutoRestake)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.WalletAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.NetworkAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*AutoRestake)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.NetworkAddress) > 0 {
+			i -= len(x.NetworkAddress)
+			copy(dAtA[i:], x.NetworkAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NetworkAddress)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.WalletAddress) > 0 {
+			i -= len(x.WalletAddress)
+			copy(dAtA[i:], x.WalletAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.WalletAddress)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*AutoRestake)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AutoRestake: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AutoRestake: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WalletAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.WalletAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NetworkAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.NetworkAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_VestingSchedule_2_list)(nil)
+
+type _VestingSchedule_2_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_VestingSchedule_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_VestingSchedule_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_VestingSchedule_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_VestingSchedule_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_VestingSchedule_2_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_VestingSchedule_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_VestingSchedule_2_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_VestingSchedule_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_VestingSchedule_3_list)(nil)
+
+type _VestingSchedule_3_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_VestingSchedule_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_VestingSchedule_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_VestingSchedule_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_VestingSchedule_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_VestingSchedule_3_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_VestingSchedule_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_VestingSchedule_3_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_VestingSchedule_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_VestingSchedule_4_list)(nil)
+
+type _VestingSchedule_4_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_VestingSchedule_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_VestingSchedule_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_VestingSchedule_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_VestingSchedule_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_VestingSchedule_4_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_VestingSchedule_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_VestingSchedule_4_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_VestingSchedule_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_VestingSchedule_5_list)(nil)
+
+type _VestingSchedule_5_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_VestingSchedule_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_VestingSchedule_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_VestingSchedule_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_VestingSchedule_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_VestingSchedule_5_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_VestingSchedule_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_VestingSchedule_5_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_VestingSchedule_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_VestingSchedule                            protoreflect.MessageDescriptor
+	fd_VestingSchedule_wallet_address             protoreflect.FieldDescriptor
+	fd_VestingSchedule_total_from_mining_pool     protoreflect.FieldDescriptor
+	fd_VestingSchedule_total_from_traffic_pool    protoreflect.FieldDescriptor
+	fd_VestingSchedule_released_from_mining_pool  protoreflect.FieldDescriptor
+	fd_VestingSchedule_released_from_traffic_pool protoreflect.FieldDescriptor
+	fd_VestingSchedule_start_epoch                protoreflect.FieldDescriptor
+	fd_VestingSchedule_vesting_epochs             protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_stratos_pot_v1_pot_proto_init()
+	md_VestingSchedule = File_stratos_pot_v1_pot_proto.Messages().ByName("VestingSchedule")
+	fd_VestingSchedule_wallet_address = md_VestingSchedule.Fields().ByName("wallet_address")
+	fd_VestingSchedule_total_from_mining_pool = md_VestingSchedule.Fields().ByName("total_from_mining_pool")
+	fd_VestingSchedule_total_from_traffic_pool = md_VestingSchedule.Fields().ByName("total_from_traffic_pool")
+	fd_VestingSchedule_released_from_mining_pool = md_VestingSchedule.Fields().ByName("released_from_mining_pool")
+	fd_VestingSchedule_released_from_traffic_pool = md_VestingSchedule.Fields().ByName("released_from_traffic_pool")
+	fd_VestingSchedule_start_epoch = md_VestingSchedule.Fields().ByName("start_epoch")
+	fd_VestingSchedule_vesting_epochs = md_VestingSchedule.Fields().ByName("vesting_epochs")
+}
+
+var _ protoreflect.Message = (*fastReflection_VestingSchedule)(nil)
+
+type fastReflection_VestingSchedule VestingSchedule
+
+func (x *VestingSchedule) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_VestingSchedule)(x)
+}
+
+func (x *VestingSchedule) slowProtoReflect() protoreflect.Message {
+	mi := &file_stratos_pot_v1_pot_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_VestingSchedule_messageType fastReflection_VestingSchedule_messageType
+var _ protoreflect.MessageType = fastReflection_VestingSchedule_messageType{}
+
+type fastReflection_VestingSchedule_messageType struct{}
+
+func (x fastReflection_VestingSchedule_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_VestingSchedule)(nil)
+}
+func (x fastReflection_VestingSchedule_messageType) New() protoreflect.Message {
+	return new(fastReflection_VestingSchedule)
+}
+func (x fastReflection_VestingSchedule_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_VestingSchedule
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_VestingSchedule) Descriptor() protoreflect.MessageDescriptor {
+	return md_VestingSchedule
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_VestingSchedule) Type() protoreflect.MessageType {
+	return _fastReflection_VestingSchedule_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_VestingSchedule) New() protoreflect.Message {
+	return new(fastReflection_VestingSchedule)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_VestingSchedule) Interface() protoreflect.ProtoMessage {
+	return (*VestingSchedule)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_VestingSchedule) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.WalletAddress != "" {
+		value := protoreflect.ValueOfString(x.WalletAddress)
+		if !f(fd_VestingSchedule_wallet_address, value) {
+			return
+		}
+	}
+	if len(x.TotalFromMiningPool) != 0 {
+		value := protoreflect.ValueOfList(&_VestingSchedule_2_list{list: &x.TotalFromMiningPool})
+		if !f(fd_VestingSchedule_total_from_mining_pool, value) {
+			return
+		}
+	}
+	if len(x.TotalFromTrafficPool) != 0 {
+		value := protoreflect.ValueOfList(&_VestingSchedule_3_list{list: &x.TotalFromTrafficPool})
+		if !f(fd_VestingSchedule_total_from_traffic_pool, value) {
+			return
+		}
+	}
+	if len(x.ReleasedFromMiningPool) != 0 {
+		value := protoreflect.ValueOfList(&_VestingSchedule_4_list{list: &x.ReleasedFromMiningPool})
+		if !f(fd_VestingSchedule_released_from_mining_pool, value) {
+			return
+		}
+	}
+	if len(x.ReleasedFromTrafficPool) != 0 {
+		value := protoreflect.ValueOfList(&_VestingSchedule_5_list{list: &x.ReleasedFromTrafficPool})
+		if !f(fd_VestingSchedule_released_from_traffic_pool, value) {
+			return
+		}
+	}
+	if x.StartEpoch != int64(0) {
+		value := protoreflect.ValueOfInt64(x.StartEpoch)
+		if !f(fd_VestingSchedule_start_epoch, value) {
+			return
+		}
+	}
+	if x.VestingEpochs != int64(0) {
+		value := protoreflect.ValueOfInt64(x.VestingEpochs)
+		if !f(fd_VestingSchedule_vesting_epochs, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_VestingSchedule) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "stratos.pot.v1.VestingSchedule.wallet_address":
+		return x.WalletAddress != ""
+	case "stratos.pot.v1.VestingSchedule.total_from_mining_pool":
+		return len(x.TotalFromMiningPool) != 0
+	case "stratos.pot.v1.VestingSchedule.total_from_traffic_pool":
+		return len(x.TotalFromTrafficPool) != 0
+	case "stratos.pot.v1.VestingSchedule.released_from_mining_pool":
+		return len(x.ReleasedFromMiningPool) != 0
+	case "stratos.pot.v1.VestingSchedule.released_from_traffic_pool":
+		return len(x.ReleasedFromTrafficPool) != 0
+	case "stratos.pot.v1.VestingSchedule.start_epoch":
+		return x.StartEpoch != int64(0)
+	case "stratos.pot.v1.VestingSchedule.vesting_epochs":
+		return x.VestingEpochs != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.VestingSchedule"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.VestingSchedule does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VestingSchedule) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "stratos.pot.v1.VestingSchedule.wallet_address":
+		x.WalletAddress = ""
+	case "stratos.pot.v1.VestingSchedule.total_from_mining_pool":
+		x.TotalFromMiningPool = nil
+	case "stratos.pot.v1.VestingSchedule.total_from_traffic_pool":
+		x.TotalFromTrafficPool = nil
+	case "stratos.pot.v1.VestingSchedule.released_from_mining_pool":
+		x.ReleasedFromMiningPool = nil
+	case "stratos.pot.v1.VestingSchedule.released_from_traffic_pool":
+		x.ReleasedFromTrafficPool = nil
+	case "stratos.pot.v1.VestingSchedule.start_epoch":
+		x.StartEpoch = int64(0)
+	case "stratos.pot.v1.VestingSchedule.vesting_epochs":
+		x.VestingEpochs = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.VestingSchedule"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.VestingSchedule does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_VestingSchedule) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "stratos.pot.v1.VestingSchedule.wallet_address":
+		value := x.WalletAddress
+		return protoreflect.ValueOfString(value)
+	case "stratos.pot.v1.VestingSchedule.total_from_mining_pool":
+		if len(x.TotalFromMiningPool) == 0 {
+			return protoreflect.ValueOfList(&_VestingSchedule_2_list{})
+		}
+		listValue := &_VestingSchedule_2_list{list: &x.TotalFromMiningPool}
+		return protoreflect.ValueOfList(listValue)
+	case "stratos.pot.v1.VestingSchedule.total_from_traffic_pool":
+		if len(x.TotalFromTrafficPool) == 0 {
+			return protoreflect.ValueOfList(&_VestingSchedule_3_list{})
+		}
+		listValue := &_VestingSchedule_3_list{list: &x.TotalFromTrafficPool}
+		return protoreflect.ValueOfList(listValue)
+	case "stratos.pot.v1.VestingSchedule.released_from_mining_pool":
+		if len(x.ReleasedFromMiningPool) == 0 {
+			return protoreflect.ValueOfList(&_VestingSchedule_4_list{})
+		}
+		listValue := &_VestingSchedule_4_list{list: &x.ReleasedFromMiningPool}
+		return protoreflect.ValueOfList(listValue)
+	case "stratos.pot.v1.VestingSchedule.released_from_traffic_pool":
+		if len(x.ReleasedFromTrafficPool) == 0 {
+			return protoreflect.ValueOfList(&_VestingSchedule_5_list{})
+		}
+		listValue := &_VestingSchedule_5_list{list: &x.ReleasedFromTrafficPool}
+		return protoreflect.ValueOfList(listValue)
+	case "stratos.pot.v1.VestingSchedule.start_epoch":
+		value := x.StartEpoch
+		return protoreflect.ValueOfInt64(value)
+	case "stratos.pot.v1.VestingSchedule.vesting_epochs":
+		value := x.VestingEpochs
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.VestingSchedule"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.VestingSchedule does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VestingSchedule) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "stratos.pot.v1.VestingSchedule.wallet_address":
+		x.WalletAddress = value.Interface().(string)
+	case "stratos.pot.v1.VestingSchedule.total_from_mining_pool":
+		lv := value.List()
+		clv := lv.(*_VestingSchedule_2_list)
+		x.TotalFromMiningPool = *clv.list
+	case "stratos.pot.v1.VestingSchedule.total_from_traffic_pool":
+		lv := value.List()
+		clv := lv.(*_VestingSchedule_3_list)
+		x.TotalFromTrafficPool = *clv.list
+	case "stratos.pot.v1.VestingSchedule.released_from_mining_pool":
+		lv := value.List()
+		clv := lv.(*_VestingSchedule_4_list)
+		x.ReleasedFromMiningPool = *clv.list
+	case "stratos.pot.v1.VestingSchedule.released_from_traffic_pool":
+		lv := value.List()
+		clv := lv.(*_VestingSchedule_5_list)
+		x.ReleasedFromTrafficPool = *clv.list
+	case "stratos.pot.v1.VestingSchedule.start_epoch":
+		x.StartEpoch = value.Int()
+	case "stratos.pot.v1.VestingSchedule.vesting_epochs":
+		x.VestingEpochs = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.VestingSchedule"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.VestingSchedule does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VestingSchedule) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "stratos.pot.v1.VestingSchedule.total_from_mining_pool":
+		if x.TotalFromMiningPool == nil {
+			x.TotalFromMiningPool = []*v1beta1.Coin{}
+		}
+		value := &_VestingSchedule_2_list{list: &x.TotalFromMiningPool}
+		return protoreflect.ValueOfList(value)
+	case "stratos.pot.v1.VestingSchedule.total_from_traffic_pool":
+		if x.TotalFromTrafficPool == nil {
+			x.TotalFromTrafficPool = []*v1beta1.Coin{}
+		}
+		value := &_VestingSchedule_3_list{list: &x.TotalFromTrafficPool}
+		return protoreflect.ValueOfList(value)
+	case "stratos.pot.v1.VestingSchedule.released_from_mining_pool":
+		if x.ReleasedFromMiningPool == nil {
+			x.ReleasedFromMiningPool = []*v1beta1.Coin{}
+		}
+		value := &_VestingSchedule_4_list{list: &x.ReleasedFromMiningPool}
+		return protoreflect.ValueOfList(value)
+	case "stratos.pot.v1.VestingSchedule.released_from_traffic_pool":
+		if x.ReleasedFromTrafficPool == nil {
+			x.ReleasedFromTrafficPool = []*v1beta1.Coin{}
+		}
+		value := &_VestingSchedule_5_list{list: &x.ReleasedFromTrafficPool}
+		return protoreflect.ValueOfList(value)
+	case "stratos.pot.v1.VestingSchedule.wallet_address":
+		panic(fmt.Errorf("field wallet_address of message stratos.pot.v1.VestingSchedule is not mutable"))
+	case "stratos.pot.v1.VestingSchedule.start_epoch":
+		panic(fmt.Errorf("field start_epoch of message stratos.pot.v1.VestingSchedule is not mutable"))
+	case "stratos.pot.v1.VestingSchedule.vesting_epochs":
+		panic(fmt.Errorf("field vesting_epochs of message stratos.pot.v1.VestingSchedule is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.VestingSchedule"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.VestingSchedule does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_VestingSchedule) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "stratos.pot.v1.VestingSchedule.wallet_address":
+		return protoreflect.ValueOfString("")
+	case "stratos.pot.v1.VestingSchedule.total_from_mining_pool":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_VestingSchedule_2_list{list: &list})
+	case "stratos.pot.v1.VestingSchedule.total_from_traffic_pool":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_VestingSchedule_3_list{list: &list})
+	case "stratos.pot.v1.VestingSchedule.released_from_mining_pool":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_VestingSchedule_4_list{list: &list})
+	case "stratos.pot.v1.VestingSchedule.released_from_traffic_pool":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_VestingSchedule_5_list{list: &list})
+	case "stratos.pot.v1.VestingSchedule.start_epoch":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "stratos.pot.v1.VestingSchedule.vesting_epochs":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.VestingSchedule"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.VestingSchedule does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_VestingSchedule) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in stratos.pot.v1.VestingSchedule", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_VestingSchedule) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VestingSchedule) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_VestingSchedule) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_VestingSchedule) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*VestingSchedule)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.WalletAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.TotalFromMiningPool) > 0 {
+			for _, e := range x.TotalFromMiningPool {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.TotalFromTrafficPool) > 0 {
+			for _, e := range x.TotalFromTrafficPool {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.ReleasedFromMiningPool) > 0 {
+			for _, e := range x.ReleasedFromMiningPool {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.ReleasedFromTrafficPool) > 0 {
+			for _, e := range x.ReleasedFromTrafficPool {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.StartEpoch != 0 {
+			n += 1 + runtime.Sov(uint64(x.StartEpoch))
+		}
+		if x.VestingEpochs != 0 {
+			n += 1 + runtime.Sov(uint64(x.VestingEpochs))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*VestingSchedule)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.VestingEpochs != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.VestingEpochs))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.StartEpoch != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.StartEpoch))
+			i--
+			dAtA[i] = 0x30
+		}
+		if len(x.ReleasedFromTrafficPool) > 0 {
+			for iNdEx := len(x.ReleasedFromTrafficPool) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ReleasedFromTrafficPool[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if len(x.ReleasedFromMiningPool) > 0 {
+			for iNdEx := len(x.ReleasedFromMiningPool) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ReleasedFromMiningPool[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if len(x.TotalFromTrafficPool) > 0 {
+			for iNdEx := len(x.TotalFromTrafficPool) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.TotalFromTrafficPool[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.TotalFromMiningPool) > 0 {
+			for iNdEx := len(x.TotalFromMiningPool) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.TotalFromMiningPool[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if len(x.WalletAddress) > 0 {
+			i -= len(x.WalletAddress)
+			copy(dAtA[i:], x.WalletAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.WalletAddress)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*VestingSchedule)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: VestingSchedule: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: VestingSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WalletAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.WalletAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalFromMiningPool", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TotalFromMiningPool = append(x.TotalFromMiningPool, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TotalFromMiningPool[len(x.TotalFromMiningPool)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalFromTrafficPool", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TotalFromTrafficPool = append(x.TotalFromTrafficPool, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TotalFromTrafficPool[len(x.TotalFromTrafficPool)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReleasedFromMiningPool", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ReleasedFromMiningPool = append(x.ReleasedFromMiningPool, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ReleasedFromMiningPool[len(x.ReleasedFromMiningPool)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReleasedFromTrafficPool", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ReleasedFromTrafficPool = append(x.ReleasedFromTrafficPool, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ReleasedFromTrafficPool[len(x.ReleasedFromTrafficPool)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StartEpoch", wireType)
+				}
+				x.StartEpoch = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.StartEpoch |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VestingEpochs", wireType)
+				}
+				x.VestingEpochs = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.VestingEpochs |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: stratos/pot/v1/pot.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Params defines the PoT module parameters
+type Params struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BondDenom          string               `protobuf:"bytes,1,opt,name=bond_denom,json=bondDenom,proto3" json:"bond_denom,omitempty"`
+	RewardDenom        string               `protobuf:"bytes,2,opt,name=reward_denom,json=rewardDenom,proto3" json:"reward_denom,omitempty"`
+	MatureEpoch        int64                `protobuf:"varint,3,opt,name=mature_epoch,json=matureEpoch,proto3" json:"mature_epoch,omitempty"`
+	MiningRewardParams []*MiningRewardParam `protobuf:"bytes,4,rep,name=mining_reward_params,json=miningRewardParams,proto3" json:"mining_reward_params,omitempty"`
+	CommunityTax       string               `protobuf:"bytes,5,opt,name=community_tax,json=communityTax,proto3" json:"community_tax,omitempty"`
+	InitialTotalSupply *v1beta1.Coin        `protobuf:"bytes,6,opt,name=initial_total_supply,json=initialTotalSupply,proto3" json:"initial_total_supply,omitempty"`
+	// volume_report_chunk_timeout is the number of blocks in which all chunks of a volume report must be submitted
+	VolumeReportChunkTimeout int64 `protobuf:"varint,7,opt,name=volume_report_chunk_timeout,json=volumeReportChunkTimeout,proto3" json:"volume_report_chunk_timeout,omitempty"`
+	// distribute_batch_size is the maximum number of wallet volumes rewarded in a block
+	DistributeBatchSize int64 `protobuf:"varint,8,opt,name=distribute_batch_size,json=distributeBatchSize,proto3" json:"distribute_batch_size,omitempty"`
+	// dispute_window is the number of blocks in which an accepted volume report can be disputed
+	DisputeWindow int64 `protobuf:"varint,9,opt,name=dispute_window,json=disputeWindow,proto3" json:"dispute_window,omitempty"`
+	// equivocation_slash_fraction is the fraction of the deposit slashed from a meta node signing conflicting volume reports
+	EquivocationSlashFraction string `protobuf:"bytes,10,opt,name=equivocation_slash_fraction,json=equivocationSlashFraction,proto3" json:"equivocation_slash_fraction,omitempty"`
+	// vesting_mode selects how individual rewards mature, either "cliff" or "linear"
+	VestingMode string `protobuf:"bytes,11,opt,name=vesting_mode,json=vestingMode,proto3" json:"vesting_mode,omitempty"`
+	// vesting_epochs is the number of epochs over which an individual reward unlocks in linear vesting mode once it
+	// reaches its mature epoch. It is at most 4320.
+	VestingEpochs int64 `protobuf:"varint,12,opt,name=vesting_epochs,json=vestingEpochs,proto3" json:"vesting_epochs,omitempty"`
+	// reward_history_retention is the number of matured epochs the reward history is kept for, 0 keeps it forever
+	RewardHistoryRetention int64 `protobuf:"varint,13,opt,name=reward_history_retention,json=rewardHistoryRetention,proto3" json:"reward_history_retention,omitempty"`
+}
+
+func (x *Params) Reset() {
+	*x = Params{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stratos_pot_v1_pot_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Params) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Params) ProtoMessage() {}
+
+// Deprecated: Use Params.ProtoReflect.Descriptor instead.
+func (*Params) Descriptor() ([]byte, []int) {
+	return file_stratos_pot_v1_pot_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Params) GetBondDenom() string {
+	if x != nil {
+		return x.BondDenom
+	}
+	return ""
+}
+
+func (x *Params) GetRewardDenom() string {
+	if x != nil {
+		return x.RewardDenom
+	}
+	return ""
+}
+
+func (x *Params) GetMatureEpoch() int64 {
+	if x != nil {
+		return x.MatureEpoch
+	}
+	return 0
+}
+
+func (x *Params) GetMiningRewardParams() []*MiningRewardParam {
+	if x != nil {
+		return x.MiningRewardParams
+	}
+	return nil
+}
+
+func (x *Params) GetCommunityTax() string {
+	if x != nil {
+		return x.CommunityTax
+	}
+	return ""
+}
+
+func (x *Params) GetInitialTotalSupply() *v1beta1.Coin {
+	if x != nil {
+		return x.InitialTotalSupply
+	}
+	return nil
+}
+
+func (x *Params) GetVolumeReportChunkTimeout() int64 {
+	if x != nil {
+		return x.VolumeReportChunkTimeout
+	}
+	return 0
+}
+
+func (x *Params) GetDistributeBatchSize() int64 {
+	if x != nil {
+		return x.DistributeBatchSize
+	}
+	return 0
+}
+
+func (x *Params) GetDisputeWindow() int64 {
+	if x != nil {
+		return x.DisputeWindow
+	}
+	return 0
+}
+
+func (x *Params) GetEquivocationSlashFraction() string {
+	if x != nil {
+		return x.EquivocationSlashFraction
+	}
+	return ""
+}
+
+func (x *Params) GetVestingMode() string {
+	if x != nil {
+		return x.VestingMode
+	}
+	return ""
+}
+
+func (x *Params) GetVestingEpochs() int64 {
+	if x != nil {
+		return x.VestingEpochs
+	}
+	return 0
+}
+
+func (x *Params) GetRewardHistoryRetention() int64 {
+	if x != nil {
+		return x.RewardHistoryRetention
+	}
+	return 0
+}
+
+type MiningRewardParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TotalMinedValveStart       *v1beta1.Coin `protobuf:"bytes,1,opt,name=total_mined_valve_start,json=totalMinedValveStart,proto3" json:"total_mined_valve_start,omitempty"`
+	TotalMinedValveEnd         *v1beta1.Coin `protobuf:"bytes,2,opt,name=total_mined_valve_end,json=totalMinedValveEnd,proto3" json:"total_mined_valve_end,omitempty"`
+	MiningReward               *v1beta1.Coin `protobuf:"bytes,3,opt,name=mining_reward,json=miningReward,proto3" json:"mining_reward,omitempty"`
+	BlockChainPercentageInBp   string        `protobuf:"bytes,4,opt,name=block_chain_percentage_in_bp,json=blockChainPercentageInBp,proto3" json:"block_chain_percentage_in_bp,omitempty"`
+	ResourceNodePercentageInBp string        `protobuf:"bytes,5,opt,name=resource_node_percentage_in_bp,json=resourceNodePercentageInBp,proto3" json:"resource_node_percentage_in_bp,omitempty"`
+	MetaNodePercentageInBp     string        `protobuf:"bytes,6,opt,name=meta_node_percentage_in_bp,json=metaNodePercentageInBp,proto3" json:"meta_node_percentage_in_bp,omitempty"`
+}
+
+func (x *MiningRewardParam) Reset() {
+	*x = MiningRewardParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stratos_pot_v1_pot_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MiningRewardParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MiningRewardParam) ProtoMessage() {}
+
+// Deprecated: Use MiningRewardParam.ProtoReflect.Descriptor instead.
+func (*MiningRewardParam) Descriptor() ([]byte, []int) {
+	return file_stratos_pot_v1_pot_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *MiningRewardParam) GetTotalMinedValveStart() *v1beta1.Coin {
+	if x != nil {
+		return x.TotalMinedValveStart
+	}
+	return nil
+}
+
+func (x *MiningRewardParam) GetTotalMinedValveEnd() *v1beta1.Coin {
+	if x != nil {
+		return x.TotalMinedValveEnd
+	}
+	return nil
+}
+
+func (x *MiningRewardParam) GetMiningReward() *v1beta1.Coin {
+	if x != nil {
+		return x.MiningReward
+	}
+	return nil
+}
+
+func (x *MiningRewardParam) GetBlockChainPercentageInBp() string {
+	if x != nil {
+		return x.BlockChainPercentageInBp
+	}
+	return ""
+}
+
+func (x *MiningRewardParam) GetResourceNodePercentageInBp() string {
+	if x != nil {
+		return x.ResourceNodePercentageInBp
+	}
+	return ""
+}
+
+func (x *MiningRewardParam) GetMetaNodePercentageInBp() string {
+	if x != nil {
+		return x.MetaNodePercentageInBp
+	}
+	return ""
+}
+
+type Reward struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WalletAddress         string          `protobuf:"bytes,1,opt,name=wallet_address,json=walletAddress,proto3" json:"wallet_address,omitempty"`
+	RewardFromMiningPool  []*v1beta1.Coin `protobuf:"bytes,2,rep,name=reward_from_mining_pool,json=rewardFromMiningPool,proto3" json:"reward_from_mining_pool,omitempty"`
+	RewardFromTrafficPool []*v1beta1.Coin `protobuf:"bytes,3,rep,name=reward_from_traffic_pool,json=rewardFromTrafficPool,proto3" json:"reward_from_traffic_pool,omitempty"`
+}
+
+func (x *Reward) Reset() {
+	*x = Reward{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stratos_pot_v1_pot_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Reward) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Reward) ProtoMessage() {}
+
+// Deprecated: Use Reward.ProtoReflect.Descriptor instead.
+func (*Reward) Descriptor() ([]byte, []int) {
+	return file_stratos_pot_v1_pot_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Reward) GetWalletAddress() string {
+	if x != nil {
+		return x.WalletAddress
+	}
+	return ""
+}
+
+func (x *Reward) GetRewardFromMiningPool() []*v1beta1.Coin {
+	if x != nil {
+		return x.RewardFromMiningPool
+	}
+	return nil
+}
+
+func (x *Reward) GetRewardFromTrafficPool() []*v1beta1.Coin {
+	if x != nil {
+		return x.RewardFromTrafficPool
+	}
+	return nil
+}
+
+type SingleWalletVolume struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WalletAddress string `protobuf:"bytes,1,opt,name=wallet_address,json=walletAddress,proto3" json:"wallet_address,omitempty"`
+	Volume        string `protobuf:"bytes,2,opt,name=volume,proto3" json:"volume,omitempty"`
+}
+
+func (x *SingleWalletVolume) Reset() {
+	*x = SingleWalletVolume{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stratos_pot_v1_pot_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SingleWalletVolume) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SingleWalletVolume) ProtoMessage() {}
+
+// Deprecated: Use SingleWalletVolume.ProtoReflect.Descriptor instead.
+func (*SingleWalletVolume) Descriptor() ([]byte, []int) {
+	return file_stratos_pot_v1_pot_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SingleWalletVolume) GetWalletAddress() string {
+	if x != nil {
+		return x.WalletAddress
+	}
+	return ""
+}
+
+func (x *SingleWalletVolume) GetVolume() string {
+	if x != nil {
+		return x.Volume
+	}
+	return ""
+}
+
+type WalletVolumes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Volumes []*SingleWalletVolume `protobuf:"bytes,1,rep,name=volumes,proto3" json:"volumes,omitempty"`
+}
+
+func (x *WalletVolumes) Reset() {
+	*x = WalletVolumes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stratos_pot_v1_pot_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WalletVolumes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WalletVolumes) ProtoMessage() {}
+
+// Deprecated: Use WalletVolumes.ProtoReflect.Descriptor instead.
+func (*WalletVolumes) Descriptor() ([]byte, []int) {
+	return file_stratos_pot_v1_pot_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *WalletVolumes) GetVolumes() []*SingleWalletVolume {
+	if x != nil {
+		return x.Volumes
+	}
+	return nil
+}
+
+type VolumeReportRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reporter        string `protobuf:"bytes,1,opt,name=reporter,proto3" json:"reporter,omitempty"`
+	ReportReference string `protobuf:"bytes,2,opt,name=report_reference,json=reportReference,proto3" json:"report_reference,omitempty"`
+	TxHash          string `protobuf:"bytes,3,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+}
+
+func (x *VolumeReportRecord) Reset() {
+	*x = VolumeReportRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stratos_pot_v1_pot_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VolumeReportRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VolumeReportRecord) ProtoMessage() {}
+
+// Deprecated: Use VolumeReportRecord.ProtoReflect.Descriptor instead.
+func (*VolumeReportRecord) Descriptor() ([]byte, []int) {
+	return file_stratos_pot_v1_pot_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *VolumeReportRecord) GetReporter() string {
+	if x != nil {
+		return x.Reporter
+	}
+	return ""
+}
+
+func (x *VolumeReportRecord) GetReportReference() string {
+	if x != nil {
+		return x.ReportReference
+	}
+	return ""
+}
+
+func (x *VolumeReportRecord) GetTxHash() string {
+	if x != nil {
+		return x.TxHash
+	}
+	return ""
+}
+
+type TotalReward struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MiningReward  []*v1beta1.Coin `protobuf:"bytes,1,rep,name=mining_reward,json=miningReward,proto3" json:"mining_reward,omitempty"`
+	TrafficReward []*v1beta1.Coin `protobuf:"bytes,2,rep,name=traffic_reward,json=trafficReward,proto3" json:"traffic_reward,omitempty"`
+}
+
+func (x *TotalReward) Reset() {
+	*x = TotalReward{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stratos_pot_v1_pot_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TotalReward) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TotalReward) ProtoMessage() {}
+
+// Deprecated: Use TotalReward.ProtoReflect.Descriptor instead.
+func (*TotalReward) Descriptor() ([]byte, []int) {
+	return file_stratos_pot_v1_pot_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *TotalReward) GetMiningReward() []*v1beta1.Coin {
+	if x != nil {
+		return x.MiningReward
+	}
+	return nil
+}
+
+func (x *TotalReward) GetTrafficReward() []*v1beta1.Coin {
+	if x != nil {
+		return x.TrafficReward
+	}
+	return nil
+}
+
+type Metrics struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TotalSupply               string `protobuf:"bytes,1,opt,name=total_supply,json=totalSupply,proto3" json:"total_supply,omitempty"`
+	TotalMiningSupply         string `protobuf:"bytes,2,opt,name=total_mining_supply,json=totalMiningSupply,proto3" json:"total_mining_supply,omitempty"`
+	TotalMinedTokens          string `protobuf:"bytes,3,opt,name=total_mined_tokens,json=totalMinedTokens,proto3" json:"total_mined_tokens,omitempty"`
+	TotalResourceNodesDeposit string `protobuf:"bytes,4,opt,name=total_resource_nodes_deposit,json=totalResourceNodesDeposit,proto3" json:"total_resource_nodes_deposit,omitempty"`
+	TotalBondedDelegation     string `protobuf:"bytes,5,opt,name=total_bonded_delegation,json=totalBondedDelegation,proto3" json:"total_bonded_delegation,omitempty"`
+	TotalUnbondedDelegation   string `protobuf:"bytes,6,opt,name=total_unbonded_delegation,json=totalUnbondedDelegation,proto3" json:"total_unbonded_delegation,omitempty"`
+	TotalUnbondingDelegation  string `protobuf:"bytes,7,opt,name=total_unbonding_delegation,json=totalUnbondingDelegation,proto3" json:"total_unbonding_delegation,omitempty"`
+	CirculationSupply         string `protobuf:"bytes,8,opt,name=circulation_supply,json=circulationSupply,proto3" json:"circulation_supply,omitempty"`
+	TotalMiningReward         string `protobuf:"bytes,9,opt,name=total_mining_reward,json=totalMiningReward,proto3" json:"total_mining_reward,omitempty"`
+	ChainMiningReward         string `protobuf:"bytes,10,opt,name=chain_mining_reward,json=chainMiningReward,proto3" json:"chain_mining_reward,omitempty"`
+	ResourceMiningReward      string `protobuf:"bytes,11,opt,name=resource_mining_reward,json=resourceMiningReward,proto3" json:"resource_mining_reward,omitempty"`
+	MetaMiningReward          string `protobuf:"bytes,12,opt,name=meta_mining_reward,json=metaMiningReward,proto3" json:"meta_mining_reward,omitempty"`
+	ResourceNodeDeposit       string `protobuf:"bytes,13,opt,name=resource_node_deposit,json=resourceNodeDeposit,proto3" json:"resource_node_deposit,omitempty"`
+	MetaNodeDeposit           string `protobuf:"bytes,14,opt,name=meta_node_deposit,json=metaNodeDeposit,proto3" json:"meta_node_deposit,omitempty"`
+	UnissuedPrepay            string `protobuf:"bytes,15,opt,name=unissued_prepay,json=unissuedPrepay,proto3" json:"unissued_prepay,omitempty"`
+}
+
+func (x *Metrics) Reset() {
+	*x = Metrics{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stratos_pot_v1_pot_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Metrics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Metrics) ProtoMessage() {}
+
+// Deprecated: Use Metrics.ProtoReflect.Descriptor instead.
+func (*Metrics) Descriptor() ([]byte, []int) {
+	return file_stratos_pot_v1_pot_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Metrics) GetTotalSupply() string {
+	if x != nil {
+		return x.TotalSupply
+	}
+	return ""
+}
+
+func (x *Metrics) GetTotalMiningSupply() string {
+	if x != nil {
+		return x.TotalMiningSupply
+	}
+	return ""
+}
+
+func (x *Metrics) GetTotalMinedTokens() string {
+	if x != nil {
+		return x.TotalMinedTokens
+	}
+	return ""
+}
+
+func (x *Metrics) GetTotalResourceNodesDeposit() string {
+	if x != nil {
+		return x.TotalResourceNodesDeposit
+	}
+	return ""
+}
+
+func (x *Metrics) GetTotalBondedDelegation() string {
+	if x != nil {
+		return x.TotalBondedDelegation
+	}
+	return ""
+}
+
+func (x *Metrics) GetTotalUnbondedDelegation() string {
+	if x != nil {
+		return x.TotalUnbondedDelegation
+	}
+	return ""
+}
+
+func (x *Metrics) GetTotalUnbondingDelegation() string {
+	if x != nil {
+		return x.TotalUnbondingDelegation
+	}
+	return ""
+}
+
+func (x *Metrics) GetCirculationSupply() string {
+	if x != nil {
+		return x.CirculationSupply
+	}
+	return ""
+}
+
+func (x *Metrics) GetTotalMiningReward() string {
+	if x != nil {
+		return x.TotalMiningReward
+	}
+	return ""
+}
+
+func (x *Metrics) GetChainMiningReward() string {
+	if x != nil {
+		return x.ChainMiningReward
+	}
+	return ""
+}
+
+func (x *Metrics) GetResourceMiningReward() string {
+	if x != nil {
+		return x.ResourceMiningReward
+	}
+	return ""
+}
+
+func (x *Metrics) GetMetaMiningReward() string {
+	if x != nil {
+		return x.MetaMiningReward
+	}
+	return ""
+}
+
+func (x *Metrics) GetResourceNodeDeposit() string {
+	if x != nil {
+		return x.ResourceNodeDeposit
+	}
+	return ""
+}
+
+func (x *Metrics) GetMetaNodeDeposit() string {
+	if x != nil {
+		return x.MetaNodeDeposit
+	}
+	return ""
+}
+
+func (x *Metrics) GetUnissuedPrepay() string {
+	if x != nil {
+		return x.UnissuedPrepay
+	}
+	return ""
+}
+
+// VolumeInclusionProof is the merkle proof of a wallet volume against the volumes root of a chunked volume report
+type VolumeInclusionProof struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hashes [][]byte `protobuf:"bytes,1,rep,name=hashes,proto3" json:"hashes,omitempty"`
+}
+
+func (x *VolumeInclusionProof) Reset() {
+	*x = VolumeInclusionProof{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stratos_pot_v1_pot_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VolumeInclusionProof) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VolumeInclusionProof) ProtoMessage() {}
+
+// Deprecated: Use VolumeInclusionProof.ProtoReflect.Descriptor instead.
+func (*VolumeInclusionProof) Descriptor() ([]byte, []int) {
+	return file_stratos_pot_v1_pot_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *VolumeInclusionProof) GetHashes() [][]byte {
+	if x != nil {
+		return x.Hashes
+	}
+	return nil
+}
+
+// PendingVolumeReport is a chunked volume report whose header is accepted and whose chunks are being submitted
+type PendingVolumeReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reporter        string `protobuf:"bytes,1,opt,name=reporter,proto3" json:"reporter,omitempty"`
+	Epoch           string `protobuf:"bytes,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	ReportReference string `protobuf:"bytes,3,opt,name=report_reference,json=reportReference,proto3" json:"report_reference,omitempty"`
+	TotalCount      uint64 `protobuf:"varint,4,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	VolumesRoot     []byte `protobuf:"bytes,5,opt,name=volumes_root,json=volumesRoot,proto3" json:"volumes_root,omitempty"`
+	ReceivedCount   uint64 `protobuf:"varint,6,opt,name=received_count,json=receivedCount,proto3" json:"received_count,omitempty"`
+	DeadlineHeight  int64  `protobuf:"varint,7,opt,name=deadline_height,json=deadlineHeight,proto3" json:"deadline_height,omitempty"`
+	TxHash          string `protobuf:"bytes,8,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+}
+
+func (x *PendingVolumeReport) Reset() {
+	*x = PendingVolumeReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stratos_pot_v1_pot_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PendingVolumeReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PendingVolumeReport) ProtoMessage() {}
+
+// Deprecated: Use PendingVolumeReport.ProtoReflect.Descriptor instead.
+func (*PendingVolumeReport) Descriptor() ([]byte, []int) {
+	return file_stratos_pot_v1_pot_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *PendingVolumeReport) GetReporter() string {
+	if x != nil {
+		return x.Reporter
+	}
+	return ""
+}
+
+func (x *PendingVolumeReport) GetEpoch() string {
 	if x != nil {
-		return x.MatureEpoch
+		return x.Epoch
+	}
+	return ""
+}
+
+func (x *PendingVolumeReport) GetReportReference() string {
+	if x != nil {
+		return x.ReportReference
+	}
+	return ""
+}
+
+func (x *PendingVolumeReport) GetTotalCount() uint64 {
+	if x != nil {
+		return x.TotalCount
 	}
 	return 0
 }
 
-func (x *Params) GetMiningRewardParams() []*MiningRewardParam {
+func (x *PendingVolumeReport) GetVolumesRoot() []byte {
 	if x != nil {
-		return x.MiningRewardParams
+		return x.VolumesRoot
 	}
 	return nil
 }
 
-func (x *Params) GetCommunityTax() string {
+func (x *PendingVolumeReport) GetReceivedCount() uint64 {
 	if x != nil {
-		return x.CommunityTax
+		return x.ReceivedCount
 	}
-	return ""
+	return 0
 }
 
-func (x *Params) GetInitialTotalSupply() *v1beta1.Coin {
+func (x *PendingVolumeReport) GetDeadlineHeight() int64 {
 	if x != nil {
-		return x.InitialTotalSupply
+		return x.DeadlineHeight
 	}
-	return nil
+	return 0
 }
 
-type MiningRewardParam struct {
+func (x *PendingVolumeReport) GetTxHash() string {
+	if x != nil {
+		return x.TxHash
+	}
+	return ""
+}
+
+// PendingDistribution is an accepted volume report whose rewards are being distributed in the end blockers
+type PendingDistribution struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TotalMinedValveStart       *v1beta1.Coin `protobuf:"bytes,1,opt,name=total_mined_valve_start,json=totalMinedValveStart,proto3" json:"total_mined_valve_start,omitempty"`
-	TotalMinedValveEnd         *v1beta1.Coin `protobuf:"bytes,2,opt,name=total_mined_valve_end,json=totalMinedValveEnd,proto3" json:"total_mined_valve_end,omitempty"`
-	MiningReward               *v1beta1.Coin `protobuf:"bytes,3,opt,name=mining_reward,json=miningReward,proto3" json:"mining_reward,omitempty"`
-	BlockChainPercentageInBp   string        `protobuf:"bytes,4,opt,name=block_chain_percentage_in_bp,json=blockChainPercentageInBp,proto3" json:"block_chain_percentage_in_bp,omitempty"`
-	ResourceNodePercentageInBp string        `protobuf:"bytes,5,opt,name=resource_node_percentage_in_bp,json=resourceNodePercentageInBp,proto3" json:"resource_node_percentage_in_bp,omitempty"`
-	MetaNodePercentageInBp     string        `protobuf:"bytes,6,opt,name=meta_node_percentage_in_bp,json=metaNodePercentageInBp,proto3" json:"meta_node_percentage_in_bp,omitempty"`
-}
-
-func (x *MiningRewardParam) Reset() {
-	*x = MiningRewardParam{}
+	Epoch                       string        `protobuf:"bytes,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	TotalCount                  uint64        `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	ProcessedCount              uint64        `protobuf:"varint,3,opt,name=processed_count,json=processedCount,proto3" json:"processed_count,omitempty"`
+	TotalConsumedNoz            string        `protobuf:"bytes,4,opt,name=total_consumed_noz,json=totalConsumedNoz,proto3" json:"total_consumed_noz,omitempty"`
+	Started                     bool          `protobuf:"varint,5,opt,name=started,proto3" json:"started,omitempty"`
+	MiningRewardToResourceNode  *v1beta1.Coin `protobuf:"bytes,6,opt,name=mining_reward_to_resource_node,json=miningRewardToResourceNode,proto3" json:"mining_reward_to_resource_node,omitempty"`
+	TrafficRewardToResourceNode *v1beta1.Coin `protobuf:"bytes,7,opt,name=traffic_reward_to_resource_node,json=trafficRewardToResourceNode,proto3" json:"traffic_reward_to_resource_node,omitempty"`
+	MiningRewardToMetaNode      *v1beta1.Coin `protobuf:"bytes,8,opt,name=mining_reward_to_meta_node,json=miningRewardToMetaNode,proto3" json:"mining_reward_to_meta_node,omitempty"`
+	TrafficRewardToMetaNode     *v1beta1.Coin `protobuf:"bytes,9,opt,name=traffic_reward_to_meta_node,json=trafficRewardToMetaNode,proto3" json:"traffic_reward_to_meta_node,omitempty"`
+	MinedReward                 *v1beta1.Coin `protobuf:"bytes,10,opt,name=mined_reward,json=minedReward,proto3" json:"mined_reward,omitempty"`
+	TrafficReward               *v1beta1.Coin `protobuf:"bytes,11,opt,name=traffic_reward,json=trafficReward,proto3" json:"traffic_reward,omitempty"`
+	DisputeDeadlineHeight       int64         `protobuf:"varint,12,opt,name=dispute_deadline_height,json=disputeDeadlineHeight,proto3" json:"dispute_deadline_height,omitempty"`
+	Disputed                    bool          `protobuf:"varint,13,opt,name=disputed,proto3" json:"disputed,omitempty"`
+	// the fraction of the community tax not transferred yet, carried to the next batch
+	CommunityTaxRemainder string `protobuf:"bytes,14,opt,name=community_tax_remainder,json=communityTaxRemainder,proto3" json:"community_tax_remainder,omitempty"`
+}
+
+func (x *PendingDistribution) Reset() {
+	*x = PendingDistribution{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_stratos_pot_v1_pot_proto_msgTypes[1]
+		mi := &file_stratos_pot_v1_pot_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MiningRewardParam) String() string {
+func (x *PendingDistribution) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MiningRewardParam) ProtoMessage() {}
+func (*PendingDistribution) ProtoMessage() {}
 
-// Deprecated: Use MiningRewardParam.ProtoReflect.Descriptor instead.
-func (*MiningRewardParam) Descriptor() ([]byte, []int) {
-	return file_stratos_pot_v1_pot_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use PendingDistribution.ProtoReflect.Descriptor instead.
+func (*PendingDistribution) Descriptor() ([]byte, []int) {
+	return file_stratos_pot_v1_pot_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *MiningRewardParam) GetTotalMinedValveStart() *v1beta1.Coin {
+func (x *PendingDistribution) GetEpoch() string {
 	if x != nil {
-		return x.TotalMinedValveStart
+		return x.Epoch
+	}
+	return ""
+}
+
+func (x *PendingDistribution) GetTotalCount() uint64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *PendingDistribution) GetProcessedCount() uint64 {
+	if x != nil {
+		return x.ProcessedCount
+	}
+	return 0
+}
+
+func (x *PendingDistribution) GetTotalConsumedNoz() string {
+	if x != nil {
+		return x.TotalConsumedNoz
+	}
+	return ""
+}
+
+func (x *PendingDistribution) GetStarted() bool {
+	if x != nil {
+		return x.Started
+	}
+	return false
+}
+
+func (x *PendingDistribution) GetMiningRewardToResourceNode() *v1beta1.Coin {
+	if x != nil {
+		return x.MiningRewardToResourceNode
 	}
 	return nil
 }
 
-func (x *MiningRewardParam) GetTotalMinedValveEnd() *v1beta1.Coin {
+func (x *PendingDistribution) GetTrafficRewardToResourceNode() *v1beta1.Coin {
 	if x != nil {
-		return x.TotalMinedValveEnd
+		return x.TrafficRewardToResourceNode
 	}
 	return nil
 }
 
-func (x *MiningRewardParam) GetMiningReward() *v1beta1.Coin {
+func (x *PendingDistribution) GetMiningRewardToMetaNode() *v1beta1.Coin {
 	if x != nil {
-		return x.MiningReward
+		return x.MiningRewardToMetaNode
 	}
 	return nil
 }
 
-func (x *MiningRewardParam) GetBlockChainPercentageInBp() string {
+func (x *PendingDistribution) GetTrafficRewardToMetaNode() *v1beta1.Coin {
 	if x != nil {
-		return x.BlockChainPercentageInBp
+		return x.TrafficRewardToMetaNode
 	}
-	return ""
+	return nil
 }
 
-func (x *MiningRewardParam) GetResourceNodePercentageInBp() string {
+func (x *PendingDistribution) GetMinedReward() *v1beta1.Coin {
 	if x != nil {
-		return x.ResourceNodePercentageInBp
+		return x.MinedReward
 	}
-	return ""
+	return nil
 }
 
-func (x *MiningRewardParam) GetMetaNodePercentageInBp() string {
+func (x *PendingDistribution) GetTrafficReward() *v1beta1.Coin {
 	if x != nil {
-		return x.MetaNodePercentageInBp
+		return x.TrafficReward
+	}
+	return nil
+}
+
+func (x *PendingDistribution) GetDisputeDeadlineHeight() int64 {
+	if x != nil {
+		return x.DisputeDeadlineHeight
+	}
+	return 0
+}
+
+func (x *PendingDistribution) GetDisputed() bool {
+	if x != nil {
+		return x.Disputed
+	}
+	return false
+}
+
+func (x *PendingDistribution) GetCommunityTaxRemainder() string {
+	if x != nil {
+		return x.CommunityTaxRemainder
 	}
 	return ""
 }
 
-type Reward struct {
+// DistributionVolume is a wallet volume of a pending distribution not rewarded yet
+type DistributionVolume struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	WalletAddress         string          `protobuf:"bytes,1,opt,name=wallet_address,json=walletAddress,proto3" json:"wallet_address,omitempty"`
-	RewardFromMiningPool  []*v1beta1.Coin `protobuf:"bytes,2,rep,name=reward_from_mining_pool,json=rewardFromMiningPool,proto3" json:"reward_from_mining_pool,omitempty"`
-	RewardFromTrafficPool []*v1beta1.Coin `protobuf:"bytes,3,rep,name=reward_from_traffic_pool,json=rewardFromTrafficPool,proto3" json:"reward_from_traffic_pool,omitempty"`
+	Epoch  string              `protobuf:"bytes,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Index  uint64              `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	Volume *SingleWalletVolume `protobuf:"bytes,3,opt,name=volume,proto3" json:"volume,omitempty"`
 }
 
-func (x *Reward) Reset() {
-	*x = Reward{}
+func (x *DistributionVolume) Reset() {
+	*x = DistributionVolume{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_stratos_pot_v1_pot_proto_msgTypes[2]
+		mi := &file_stratos_pot_v1_pot_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Reward) String() string {
+func (x *DistributionVolume) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Reward) ProtoMessage() {}
+func (*DistributionVolume) ProtoMessage() {}
 
-// Deprecated: Use Reward.ProtoReflect.Descriptor instead.
-func (*Reward) Descriptor() ([]byte, []int) {
-	return file_stratos_pot_v1_pot_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use DistributionVolume.ProtoReflect.Descriptor instead.
+func (*DistributionVolume) Descriptor() ([]byte, []int) {
+	return file_stratos_pot_v1_pot_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *Reward) GetWalletAddress() string {
+func (x *DistributionVolume) GetEpoch() string {
 	if x != nil {
-		return x.WalletAddress
+		return x.Epoch
 	}
 	return ""
 }
 
-func (x *Reward) GetRewardFromMiningPool() []*v1beta1.Coin {
+func (x *DistributionVolume) GetIndex() uint64 {
 	if x != nil {
-		return x.RewardFromMiningPool
+		return x.Index
 	}
-	return nil
+	return 0
 }
 
-func (x *Reward) GetRewardFromTrafficPool() []*v1beta1.Coin {
+func (x *DistributionVolume) GetVolume() *SingleWalletVolume {
 	if x != nil {
-		return x.RewardFromTrafficPool
+		return x.Volume
 	}
 	return nil
 }
 
-type SingleWalletVolume struct {
+// VolumeReportDispute is the evidence filed against a volume report during its dispute window,
+// the disputed report waits for the governance proposal escalated from the dispute
+type VolumeReportDispute struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	WalletAddress string `protobuf:"bytes,1,opt,name=wallet_address,json=walletAddress,proto3" json:"wallet_address,omitempty"`
-	Volume        string `protobuf:"bytes,2,opt,name=volume,proto3" json:"volume,omitempty"`
+	Epoch         string                `protobuf:"bytes,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Disputer      string                `protobuf:"bytes,2,opt,name=disputer,proto3" json:"disputer,omitempty"`
+	Reporter      string                `protobuf:"bytes,3,opt,name=reporter,proto3" json:"reporter,omitempty"`
+	Reason        string                `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	WalletVolumes []*SingleWalletVolume `protobuf:"bytes,5,rep,name=wallet_volumes,json=walletVolumes,proto3" json:"wallet_volumes,omitempty"`
+	Height        int64                 `protobuf:"varint,6,opt,name=height,proto3" json:"height,omitempty"`
+	// proposal_id is the governance proposal amending the report with the counter-report
+	ProposalId uint64 `protobuf:"varint,7,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
 }
 
-func (x *SingleWalletVolume) Reset() {
-	*x = SingleWalletVolume{}
+func (x *VolumeReportDispute) Reset() {
+	*x = VolumeReportDispute{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_stratos_pot_v1_pot_proto_msgTypes[3]
+		mi := &file_stratos_pot_v1_pot_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SingleWalletVolume) String() string {
+func (x *VolumeReportDispute) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SingleWalletVolume) ProtoMessage() {}
+func (*VolumeReportDispute) ProtoMessage() {}
 
-// Deprecated: Use SingleWalletVolume.ProtoReflect.Descriptor instead.
-func (*SingleWalletVolume) Descriptor() ([]byte, []int) {
-	return file_stratos_pot_v1_pot_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use VolumeReportDispute.ProtoReflect.Descriptor instead.
+func (*VolumeReportDispute) Descriptor() ([]byte, []int) {
+	return file_stratos_pot_v1_pot_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *SingleWalletVolume) GetWalletAddress() string {
+func (x *VolumeReportDispute) GetEpoch() string {
 	if x != nil {
-		return x.WalletAddress
+		return x.Epoch
 	}
 	return ""
 }
 
-func (x *SingleWalletVolume) GetVolume() string {
+func (x *VolumeReportDispute) GetDisputer() string {
 	if x != nil {
-		return x.Volume
+		return x.Disputer
 	}
 	return ""
 }
 
-type WalletVolumes struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Volumes []*SingleWalletVolume `protobuf:"bytes,1,rep,name=volumes,proto3" json:"volumes,omitempty"`
+func (x *VolumeReportDispute) GetReporter() string {
+	if x != nil {
+		return x.Reporter
+	}
+	return ""
 }
 
-func (x *WalletVolumes) Reset() {
-	*x = WalletVolumes{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_stratos_pot_v1_pot_proto_msgTypes[4]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *VolumeReportDispute) GetReason() string {
+	if x != nil {
+		return x.Reason
 	}
+	return ""
 }
 
-func (x *WalletVolumes) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *VolumeReportDispute) GetWalletVolumes() []*SingleWalletVolume {
+	if x != nil {
+		return x.WalletVolumes
+	}
+	return nil
 }
 
-func (*WalletVolumes) ProtoMessage() {}
-
-// Deprecated: Use WalletVolumes.ProtoReflect.Descriptor instead.
-func (*WalletVolumes) Descriptor() ([]byte, []int) {
-	return file_stratos_pot_v1_pot_proto_rawDescGZIP(), []int{4}
+func (x *VolumeReportDispute) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
 }
 
-func (x *WalletVolumes) GetVolumes() []*SingleWalletVolume {
+func (x *VolumeReportDispute) GetProposalId() uint64 {
 	if x != nil {
-		return x.Volumes
+		return x.ProposalId
 	}
-	return nil
+	return 0
 }
 
-type VolumeReportRecord struct {
+// ReportEquivocation records a meta node slashed for signing conflicting volume reports of an epoch
+type ReportEquivocation struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Reporter        string `protobuf:"bytes,1,opt,name=reporter,proto3" json:"reporter,omitempty"`
-	ReportReference string `protobuf:"bytes,2,opt,name=report_reference,json=reportReference,proto3" json:"report_reference,omitempty"`
-	TxHash          string `protobuf:"bytes,3,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	Epoch    string        `protobuf:"bytes,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Accused  string        `protobuf:"bytes,2,opt,name=accused,proto3" json:"accused,omitempty"`
+	Reporter string        `protobuf:"bytes,3,opt,name=reporter,proto3" json:"reporter,omitempty"`
+	Slashed  *v1beta1.Coin `protobuf:"bytes,4,opt,name=slashed,proto3" json:"slashed,omitempty"`
+	Height   int64         `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
 }
 
-func (x *VolumeReportRecord) Reset() {
-	*x = VolumeReportRecord{}
+func (x *ReportEquivocation) Reset() {
+	*x = ReportEquivocation{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_stratos_pot_v1_pot_proto_msgTypes[5]
+		mi := &file_stratos_pot_v1_pot_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *VolumeReportRecord) String() string {
+func (x *ReportEquivocation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VolumeReportRecord) ProtoMessage() {}
+func (*ReportEquivocation) ProtoMessage() {}
 
-// Deprecated: Use VolumeReportRecord.ProtoReflect.Descriptor instead.
-func (*VolumeReportRecord) Descriptor() ([]byte, []int) {
-	return file_stratos_pot_v1_pot_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use ReportEquivocation.ProtoReflect.Descriptor instead.
+func (*ReportEquivocation) Descriptor() ([]byte, []int) {
+	return file_stratos_pot_v1_pot_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *VolumeReportRecord) GetReporter() string {
+func (x *ReportEquivocation) GetEpoch() string {
 	if x != nil {
-		return x.Reporter
+		return x.Epoch
 	}
 	return ""
 }
 
-func (x *VolumeReportRecord) GetReportReference() string {
+func (x *ReportEquivocation) GetAccused() string {
 	if x != nil {
-		return x.ReportReference
+		return x.Accused
 	}
 	return ""
 }
 
-func (x *VolumeReportRecord) GetTxHash() string {
+func (x *ReportEquivocation) GetReporter() string {
 	if x != nil {
-		return x.TxHash
+		return x.Reporter
 	}
 	return ""
 }
 
-type TotalReward struct {
+func (x *ReportEquivocation) GetSlashed() *v1beta1.Coin {
+	if x != nil {
+		return x.Slashed
+	}
+	return nil
+}
+
+func (x *ReportEquivocation) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+// RewardHistory is the reward of a wallet matured at an epoch
+type RewardHistory struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	MiningReward  []*v1beta1.Coin `protobuf:"bytes,1,rep,name=mining_reward,json=miningReward,proto3" json:"mining_reward,omitempty"`
-	TrafficReward []*v1beta1.Coin `protobuf:"bytes,2,rep,name=traffic_reward,json=trafficReward,proto3" json:"traffic_reward,omitempty"`
+	WalletAddress         string          `protobuf:"bytes,1,opt,name=wallet_address,json=walletAddress,proto3" json:"wallet_address,omitempty"`
+	Epoch                 string          `protobuf:"bytes,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	RewardFromMiningPool  []*v1beta1.Coin `protobuf:"bytes,3,rep,name=reward_from_mining_pool,json=rewardFromMiningPool,proto3" json:"reward_from_mining_pool,omitempty"`
+	RewardFromTrafficPool []*v1beta1.Coin `protobuf:"bytes,4,rep,name=reward_from_traffic_pool,json=rewardFromTrafficPool,proto3" json:"reward_from_traffic_pool,omitempty"`
+	SlashingDeducted      []*v1beta1.Coin `protobuf:"bytes,5,rep,name=slashing_deducted,json=slashingDeducted,proto3" json:"slashing_deducted,omitempty"`
 }
 
-func (x *TotalReward) Reset() {
-	*x = TotalReward{}
+func (x *RewardHistory) Reset() {
+	*x = RewardHistory{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_stratos_pot_v1_pot_proto_msgTypes[6]
+		mi := &file_stratos_pot_v1_pot_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TotalReward) String() string {
+func (x *RewardHistory) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TotalReward) ProtoMessage() {}
+func (*RewardHistory) ProtoMessage() {}
 
-// Deprecated: Use TotalReward.ProtoReflect.Descriptor instead.
-func (*TotalReward) Descriptor() ([]byte, []int) {
-	return file_stratos_pot_v1_pot_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use RewardHistory.ProtoReflect.Descriptor instead.
+func (*RewardHistory) Descriptor() ([]byte, []int) {
+	return file_stratos_pot_v1_pot_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *TotalReward) GetMiningReward() []*v1beta1.Coin {
+func (x *RewardHistory) GetWalletAddress() string {
 	if x != nil {
-		return x.MiningReward
+		return x.WalletAddress
+	}
+	return ""
+}
+
+func (x *RewardHistory) GetEpoch() string {
+	if x != nil {
+		return x.Epoch
+	}
+	return ""
+}
+
+func (x *RewardHistory) GetRewardFromMiningPool() []*v1beta1.Coin {
+	if x != nil {
+		return x.RewardFromMiningPool
 	}
 	return nil
 }
 
-func (x *TotalReward) GetTrafficReward() []*v1beta1.Coin {
+func (x *RewardHistory) GetRewardFromTrafficPool() []*v1beta1.Coin {
 	if x != nil {
-		return x.TrafficReward
+		return x.RewardFromTrafficPool
 	}
 	return nil
 }
 
-type Metrics struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *RewardHistory) GetSlashingDeducted() []*v1beta1.Coin {
+	if x != nil {
+		return x.SlashingDeducted
+	}
+	return nil
+}
 
-	TotalSupply               string `protobuf:"bytes,1,opt,name=total_supply,json=totalSupply,proto3" json:"total_supply,omitempty"`
-	TotalMiningSupply         string `protobuf:"bytes,2,opt,name=total_mining_supply,json=totalMiningSupply,proto3" json:"total_mining_supply,omitempty"`
-	TotalMinedTokens          string `protobuf:"bytes,3,opt,name=total_mined_tokens,json=totalMinedTokens,proto3" json:"total_mined_tokens,omitempty"`
-	TotalResourceNodesDeposit string `protobuf:"bytes,4,opt,name=total_resource_nodes_deposit,json=totalResourceNodesDeposit,proto3" json:"total_resource_nodes_deposit,omitempty"`
-	TotalBondedDelegation     string `protobuf:"bytes,5,opt,name=total_bonded_delegation,json=totalBondedDelegation,proto3" json:"total_bonded_delegation,omitempty"`
-	TotalUnbondedDelegation   string `protobuf:"bytes,6,opt,name=total_unbonded_delegation,json=totalUnbondedDelegation,proto3" json:"total_unbonded_delegation,omitempty"`
-	TotalUnbondingDelegation  string `protobuf:"bytes,7,opt,name=total_unbonding_delegation,json=totalUnbondingDelegation,proto3" json:"total_unbonding_delegation,omitempty"`
-	CirculationSupply         string `protobuf:"bytes,8,opt,name=circulation_supply,json=circulationSupply,proto3" json:"circulation_supply,omitempty"`
-	TotalMiningReward         string `protobuf:"bytes,9,opt,name=total_mining_reward,json=totalMiningReward,proto3" json:"total_mining_reward,omitempty"`
-	ChainMiningReward         string `protobuf:"bytes,10,opt,name=chain_mining_reward,json=chainMiningReward,proto3" json:"chain_mining_reward,omitempty"`
-	ResourceMiningReward      string `protobuf:"bytes,11,opt,name=resource_mining_reward,json=resourceMiningReward,proto3" json:"resource_mining_reward,omitempty"`
-	MetaMiningReward          string `protobuf:"bytes,12,opt,name=meta_mining_reward,json=metaMiningReward,proto3" json:"meta_mining_reward,omitempty"`
-	ResourceNodeDeposit       string `protobuf:"bytes,13,opt,name=resource_node_deposit,json=resourceNodeDeposit,proto3" json:"resource_node_deposit,omitempty"`
-	MetaNodeDeposit           string `protobuf:"bytes,14,opt,name=meta_node_deposit,json=metaNodeDeposit,proto3" json:"meta_node_deposit,omitempty"`
-	UnissuedPrepay            string `protobuf:"bytes,15,opt,name=unissued_prepay,json=unissuedPrepay,proto3" json:"unissued_prepay,omitempty"`
+// AutoRestake is the node the matured rewards of a wallet are automatically added to the deposit of
+type AutoRestake struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WalletAddress  string `protobuf:"bytes,1,opt,name=wallet_address,json=walletAddress,proto3" json:"wallet_address,omitempty"`
+	NetworkAddress string `protobuf:"bytes,2,opt,name=network_address,json=networkAddress,proto3" json:"network_address,omitempty"`
 }
 
-func (x *Metrics) Reset() {
-	*x = Metrics{}
+func (x *AutoRestake) Reset() {
+	*x = AutoRestake{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_stratos_pot_v1_pot_proto_msgTypes[7]
+		mi := &file_stratos_pot_v1_pot_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Metrics) String() string {
+func (x *AutoRestake) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Metrics) ProtoMessage() {}
+func (*AutoRestake) ProtoMessage() {}
 
-// Deprecated: Use Metrics.ProtoReflect.Descriptor instead.
-func (*Metrics) Descriptor() ([]byte, []int) {
-	return file_stratos_pot_v1_pot_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use AutoRestake.ProtoReflect.Descriptor instead.
+func (*AutoRestake) Descriptor() ([]byte, []int) {
+	return file_stratos_pot_v1_pot_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *Metrics) GetTotalSupply() string {
+func (x *AutoRestake) GetWalletAddress() string {
 	if x != nil {
-		return x.TotalSupply
+		return x.WalletAddress
 	}
 	return ""
 }
 
-func (x *Metrics) GetTotalMiningSupply() string {
+func (x *AutoRestake) GetNetworkAddress() string {
 	if x != nil {
-		return x.TotalMiningSupply
+		return x.NetworkAddress
 	}
 	return ""
 }
 
-func (x *Metrics) GetTotalMinedTokens() string {
-	if x != nil {
-		return x.TotalMinedTokens
-	}
-	return ""
-}
+// VestingSchedule is the matured reward of a wallet vesting linearly over vesting_epochs epochs from start_epoch on.
+// The rewards maturing while it vests restart the schedule with the part not released yet.
+type VestingSchedule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *Metrics) GetTotalResourceNodesDeposit() string {
-	if x != nil {
-		return x.TotalResourceNodesDeposit
-	}
-	return ""
+	WalletAddress           string          `protobuf:"bytes,1,opt,name=wallet_address,json=walletAddress,proto3" json:"wallet_address,omitempty"`
+	TotalFromMiningPool     []*v1beta1.Coin `protobuf:"bytes,2,rep,name=total_from_mining_pool,json=totalFromMiningPool,proto3" json:"total_from_mining_pool,omitempty"`
+	TotalFromTrafficPool    []*v1beta1.Coin `protobuf:"bytes,3,rep,name=total_from_traffic_pool,json=totalFromTrafficPool,proto3" json:"total_from_traffic_pool,omitempty"`
+	ReleasedFromMiningPool  []*v1beta1.Coin `protobuf:"bytes,4,rep,name=released_from_mining_pool,json=releasedFromMiningPool,proto3" json:"released_from_mining_pool,omitempty"`
+	ReleasedFromTrafficPool []*v1beta1.Coin `protobuf:"bytes,5,rep,name=released_from_traffic_pool,json=releasedFromTrafficPool,proto3" json:"released_from_traffic_pool,omitempty"`
+	StartEpoch              int64           `protobuf:"varint,6,opt,name=start_epoch,json=startEpoch,proto3" json:"start_epoch,omitempty"`
+	VestingEpochs           int64           `protobuf:"varint,7,opt,name=vesting_epochs,json=vestingEpochs,proto3" json:"vesting_epochs,omitempty"`
 }
 
-func (x *Metrics) GetTotalBondedDelegation() string {
-	if x != nil {
-		return x.TotalBondedDelegation
+func (x *VestingSchedule) Reset() {
+	*x = VestingSchedule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stratos_pot_v1_pot_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *Metrics) GetTotalUnbondedDelegation() string {
-	if x != nil {
-		return x.TotalUnbondedDelegation
-	}
-	return ""
+func (x *VestingSchedule) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Metrics) GetTotalUnbondingDelegation() string {
-	if x != nil {
-		return x.TotalUnbondingDelegation
-	}
-	return ""
-}
+func (*VestingSchedule) ProtoMessage() {}
 
-func (x *Metrics) GetCirculationSupply() string {
-	if x != nil {
-		return x.CirculationSupply
-	}
-	return ""
+// Deprecated: Use VestingSchedule.ProtoReflect.Descriptor instead.
+func (*VestingSchedule) Descriptor() ([]byte, []int) {
+	return file_stratos_pot_v1_pot_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *Metrics) GetTotalMiningReward() string {
+func (x *VestingSchedule) GetWalletAddress() string {
 	if x != nil {
-		return x.TotalMiningReward
+		return x.WalletAddress
 	}
 	return ""
 }
 
-func (x *Metrics) GetChainMiningReward() string {
+func (x *VestingSchedule) GetTotalFromMiningPool() []*v1beta1.Coin {
 	if x != nil {
-		return x.ChainMiningReward
+		return x.TotalFromMiningPool
 	}
-	return ""
+	return nil
 }
 
-func (x *Metrics) GetResourceMiningReward() string {
+func (x *VestingSchedule) GetTotalFromTrafficPool() []*v1beta1.Coin {
 	if x != nil {
-		return x.ResourceMiningReward
+		return x.TotalFromTrafficPool
 	}
-	return ""
+	return nil
 }
 
-func (x *Metrics) GetMetaMiningReward() string {
+func (x *VestingSchedule) GetReleasedFromMiningPool() []*v1beta1.Coin {
 	if x != nil {
-		return x.MetaMiningReward
+		return x.ReleasedFromMiningPool
 	}
-	return ""
+	return nil
 }
 
-func (x *Metrics) GetResourceNodeDeposit() string {
+func (x *VestingSchedule) GetReleasedFromTrafficPool() []*v1beta1.Coin {
 	if x != nil {
-		return x.ResourceNodeDeposit
+		return x.ReleasedFromTrafficPool
 	}
-	return ""
+	return nil
 }
 
-func (x *Metrics) GetMetaNodeDeposit() string {
+func (x *VestingSchedule) GetStartEpoch() int64 {
 	if x != nil {
-		return x.MetaNodeDeposit
+		return x.StartEpoch
 	}
-	return ""
+	return 0
 }
 
-func (x *Metrics) GetUnissuedPrepay() string {
+func (x *VestingSchedule) GetVestingEpochs() int64 {
 	if x != nil {
-		return x.UnissuedPrepay
+		return x.VestingEpochs
 	}
-	return ""
+	return 0
 }
 
 var File_stratos_pot_v1_pot_proto protoreflect.FileDescriptor
@@ -6329,8 +14527,8 @@ var file_stratos_pot_v1_pot_proto_rawDesc = []byte{
 	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x11, 0x61, 0x6d, 0x69,
 	0x6e, 0x6f, 0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e,
 	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x65,
-	0x74, 0x61, 0x31, 0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xbd,
-	0x05, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x42, 0x0a, 0x0a, 0x62, 0x6f, 0x6e,
+	0x74, 0x61, 0x31, 0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xec,
+	0x0b, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x42, 0x0a, 0x0a, 0x62, 0x6f, 0x6e,
 	0x64, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x23, 0xea,
 	0xde, 0x1f, 0x0a, 0x62, 0x6f, 0x6e, 0x64, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0xf2, 0xde, 0x1f,
 	0x11, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x62, 0x6f, 0x6e, 0x64, 0x5f, 0x64, 0x65, 0x6e, 0x6f,
@@ -6371,342 +14569,774 @@ var file_stratos_pot_v1_pot_proto_rawDesc = []byte{
 	0x5f, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0xf2, 0xde, 0x1f, 0x1b, 0x79, 0x61, 0x6d, 0x6c, 0x3a,
 	0x22, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73,
 	0x75, 0x70, 0x70, 0x6c, 0x79, 0x22, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x12, 0x69, 0x6e, 0x69,
-	0x74, 0x69, 0x61, 0x6c, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x3a,
-	0x1d, 0x98, 0xa0, 0x1f, 0x01, 0x8a, 0xe7, 0xb0, 0x2a, 0x14, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f,
-	0x73, 0x2f, 0x78, 0x2f, 0x70, 0x6f, 0x74, 0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0x99,
-	0x08, 0x0a, 0x11, 0x4d, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x50,
-	0x61, 0x72, 0x61, 0x6d, 0x12, 0x98, 0x01, 0x0a, 0x17, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6d,
-	0x69, 0x6e, 0x65, 0x64, 0x5f, 0x76, 0x61, 0x6c, 0x76, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
-	0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69,
-	0x6e, 0x42, 0x46, 0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f, 0x17, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x74, 0x69, 0x61, 0x6c, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x12,
+	0x84, 0x01, 0x0a, 0x1b, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x5f, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x03, 0x42, 0x45, 0xea, 0xde, 0x1f, 0x1b, 0x76, 0x6f, 0x6c, 0x75, 0x6d,
+	0x65, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0xf2, 0xde, 0x1f, 0x22, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22,
+	0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x63, 0x68,
+	0x75, 0x6e, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x22, 0x52, 0x18, 0x76, 0x6f,
+	0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x54,
+	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x6d, 0x0a, 0x15, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x65, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x03, 0x42, 0x39, 0xea, 0xde, 0x1f, 0x15, 0x64, 0x69, 0x73, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x65, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x73, 0x69, 0x7a, 0x65,
+	0xf2, 0xde, 0x1f, 0x1c, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x65, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x22,
+	0x52, 0x13, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x52, 0x0a, 0x0e, 0x64, 0x69, 0x73, 0x70, 0x75, 0x74, 0x65,
+	0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x42, 0x2b, 0xea,
+	0xde, 0x1f, 0x0e, 0x64, 0x69, 0x73, 0x70, 0x75, 0x74, 0x65, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f,
+	0x77, 0xf2, 0xde, 0x1f, 0x15, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x64, 0x69, 0x73, 0x70, 0x75,
+	0x74, 0x65, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x22, 0x52, 0x0d, 0x64, 0x69, 0x73, 0x70,
+	0x75, 0x74, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0xc7, 0x01, 0x0a, 0x1b, 0x65, 0x71,
+	0x75, 0x69, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x6c, 0x61, 0x73, 0x68,
+	0x5f, 0x66, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x86, 0x01, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x26, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x65, 0x63,
+	0xea, 0xde, 0x1f, 0x1b, 0x65, 0x71, 0x75, 0x69, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x5f, 0x66, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0xf2,
+	0xde, 0x1f, 0x22, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x65, 0x71, 0x75, 0x69, 0x76, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x5f, 0x66, 0x72, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x19, 0x65, 0x71, 0x75, 0x69, 0x76, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x46, 0x72, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x4a, 0x0a, 0x0c, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x6d,
+	0x6f, 0x64, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x42, 0x27, 0xea, 0xde, 0x1f, 0x0c, 0x76,
+	0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0xf2, 0xde, 0x1f, 0x13, 0x79,
+	0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x6f, 0x64,
+	0x65, 0x22, 0x52, 0x0b, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x4d, 0x6f, 0x64, 0x65, 0x12,
+	0x52, 0x0a, 0x0e, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68,
+	0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x42, 0x2b, 0xea, 0xde, 0x1f, 0x0e, 0x76, 0x65, 0x73,
+	0x74, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x73, 0xf2, 0xde, 0x1f, 0x15, 0x79,
+	0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x70, 0x6f,
+	0x63, 0x68, 0x73, 0x22, 0x52, 0x0d, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x45, 0x70, 0x6f,
+	0x63, 0x68, 0x73, 0x12, 0x79, 0x0a, 0x18, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x68, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x0d, 0x20, 0x01, 0x28, 0x03, 0x42, 0x3f, 0xea, 0xde, 0x1f, 0x18, 0x72, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74,
+	0x69, 0x6f, 0x6e, 0xf2, 0xde, 0x1f, 0x1f, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x72, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x72, 0x65, 0x74, 0x65,
+	0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x52, 0x16, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x48, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x1d,
+	0x98, 0xa0, 0x1f, 0x01, 0x8a, 0xe7, 0xb0, 0x2a, 0x14, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73,
+	0x2f, 0x78, 0x2f, 0x70, 0x6f, 0x74, 0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0x99, 0x08,
+	0x0a, 0x11, 0x4d, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x12, 0x98, 0x01, 0x0a, 0x17, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6d, 0x69,
+	0x6e, 0x65, 0x64, 0x5f, 0x76, 0x61, 0x6c, 0x76, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62,
+	0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e,
+	0x42, 0x46, 0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f, 0x17, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f,
+	0x6d, 0x69, 0x6e, 0x65, 0x64, 0x5f, 0x76, 0x61, 0x6c, 0x76, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0xf2, 0xde, 0x1f, 0x1e, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x74, 0x6f, 0x74, 0x61, 0x6c,
 	0x5f, 0x6d, 0x69, 0x6e, 0x65, 0x64, 0x5f, 0x76, 0x61, 0x6c, 0x76, 0x65, 0x5f, 0x73, 0x74, 0x61,
-	0x72, 0x74, 0xf2, 0xde, 0x1f, 0x1e, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x74, 0x6f, 0x74, 0x61,
-	0x6c, 0x5f, 0x6d, 0x69, 0x6e, 0x65, 0x64, 0x5f, 0x76, 0x61, 0x6c, 0x76, 0x65, 0x5f, 0x73, 0x74,
-	0x61, 0x72, 0x74, 0x22, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x14, 0x74, 0x6f, 0x74, 0x61, 0x6c,
-	0x4d, 0x69, 0x6e, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x76, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12,
-	0x90, 0x01, 0x0a, 0x15, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6d, 0x69, 0x6e, 0x65, 0x64, 0x5f,
-	0x76, 0x61, 0x6c, 0x76, 0x65, 0x5f, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31,
-	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x42, 0xc8, 0xde, 0x1f, 0x00,
-	0xea, 0xde, 0x1f, 0x15, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6d, 0x69, 0x6e, 0x65, 0x64, 0x5f,
-	0x76, 0x61, 0x6c, 0x76, 0x65, 0x5f, 0x65, 0x6e, 0x64, 0xf2, 0xde, 0x1f, 0x1c, 0x79, 0x61, 0x6d,
-	0x6c, 0x3a, 0x22, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6d, 0x69, 0x6e, 0x65, 0x64, 0x5f, 0x76,
-	0x61, 0x6c, 0x76, 0x65, 0x5f, 0x65, 0x6e, 0x64, 0x22, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x12,
-	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4d, 0x69, 0x6e, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x76, 0x65, 0x45,
-	0x6e, 0x64, 0x12, 0x72, 0x0a, 0x0d, 0x6d, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x77,
-	0x61, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
-	0x43, 0x6f, 0x69, 0x6e, 0x42, 0x32, 0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f, 0x0d, 0x6d, 0x69,
-	0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0xf2, 0xde, 0x1f, 0x14, 0x79,
-	0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6d, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x77, 0x61,
-	0x72, 0x64, 0x22, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0c, 0x6d, 0x69, 0x6e, 0x69, 0x6e, 0x67,
-	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0xc9, 0x01, 0x0a, 0x1c, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
-	0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67,
-	0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x62, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x88, 0x01,
-	0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x26, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
-	0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xea, 0xde,
-	0x1f, 0x1c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x70, 0x65,
-	0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x62, 0x70, 0xf2, 0xde,
-	0x1f, 0x23, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x63, 0x68,
-	0x61, 0x69, 0x6e, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x5f, 0x69,
-	0x6e, 0x5f, 0x62, 0x70, 0x22, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
-	0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x18, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x43,
-	0x68, 0x61, 0x69, 0x6e, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x49, 0x6e,
-	0x42, 0x70, 0x12, 0xd1, 0x01, 0x0a, 0x1e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f,
-	0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x5f,
-	0x69, 0x6e, 0x5f, 0x62, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x8c, 0x01, 0xc8, 0xde,
-	0x1f, 0x00, 0xda, 0xde, 0x1f, 0x26, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73,
-	0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xea, 0xde, 0x1f, 0x1e,
-	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x70, 0x65,
-	0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x62, 0x70, 0xf2, 0xde,
-	0x1f, 0x25, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65,
-	0x5f, 0x69, 0x6e, 0x5f, 0x62, 0x70, 0x22, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x1a, 0x72, 0x65, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61,
-	0x67, 0x65, 0x49, 0x6e, 0x42, 0x70, 0x12, 0xc1, 0x01, 0x0a, 0x1a, 0x6d, 0x65, 0x74, 0x61, 0x5f,
+	0x72, 0x74, 0x22, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x14, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4d,
+	0x69, 0x6e, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x76, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x90,
+	0x01, 0x0a, 0x15, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6d, 0x69, 0x6e, 0x65, 0x64, 0x5f, 0x76,
+	0x61, 0x6c, 0x76, 0x65, 0x5f, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x42, 0xc8, 0xde, 0x1f, 0x00, 0xea,
+	0xde, 0x1f, 0x15, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6d, 0x69, 0x6e, 0x65, 0x64, 0x5f, 0x76,
+	0x61, 0x6c, 0x76, 0x65, 0x5f, 0x65, 0x6e, 0x64, 0xf2, 0xde, 0x1f, 0x1c, 0x79, 0x61, 0x6d, 0x6c,
+	0x3a, 0x22, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6d, 0x69, 0x6e, 0x65, 0x64, 0x5f, 0x76, 0x61,
+	0x6c, 0x76, 0x65, 0x5f, 0x65, 0x6e, 0x64, 0x22, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x12, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x4d, 0x69, 0x6e, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x76, 0x65, 0x45, 0x6e,
+	0x64, 0x12, 0x72, 0x0a, 0x0d, 0x6d, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43,
+	0x6f, 0x69, 0x6e, 0x42, 0x32, 0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f, 0x0d, 0x6d, 0x69, 0x6e,
+	0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0xf2, 0xde, 0x1f, 0x14, 0x79, 0x61,
+	0x6d, 0x6c, 0x3a, 0x22, 0x6d, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x22, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0c, 0x6d, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x52,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0xc9, 0x01, 0x0a, 0x1c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65,
+	0x5f, 0x69, 0x6e, 0x5f, 0x62, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x88, 0x01, 0xc8,
+	0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x26, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d,
+	0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xea, 0xde, 0x1f,
+	0x1c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x70, 0x65, 0x72,
+	0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x62, 0x70, 0xf2, 0xde, 0x1f,
+	0x23, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x6e,
+	0x5f, 0x62, 0x70, 0x22, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49,
+	0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x18, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x68,
+	0x61, 0x69, 0x6e, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x42,
+	0x70, 0x12, 0xd1, 0x01, 0x0a, 0x1e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x6e,
+	0x6f, 0x64, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x5f, 0x69,
+	0x6e, 0x5f, 0x62, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x8c, 0x01, 0xc8, 0xde, 0x1f,
+	0x00, 0xda, 0xde, 0x1f, 0x26, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64,
+	0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xea, 0xde, 0x1f, 0x1e, 0x72,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x70, 0x65, 0x72,
+	0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x62, 0x70, 0xf2, 0xde, 0x1f,
+	0x25, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f,
 	0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x5f,
-	0x69, 0x6e, 0x5f, 0x62, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x84, 0x01, 0xc8, 0xde,
-	0x1f, 0x00, 0xda, 0xde, 0x1f, 0x26, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73,
-	0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xea, 0xde, 0x1f, 0x1a,
-	0x6d, 0x65, 0x74, 0x61, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e,
-	0x74, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x62, 0x70, 0xf2, 0xde, 0x1f, 0x21, 0x79, 0x61,
-	0x6d, 0x6c, 0x3a, 0x22, 0x6d, 0x65, 0x74, 0x61, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x70, 0x65,
-	0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x62, 0x70, 0x22, 0xd2,
-	0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0,
-	0x2a, 0x01, 0x52, 0x16, 0x6d, 0x65, 0x74, 0x61, 0x4e, 0x6f, 0x64, 0x65, 0x50, 0x65, 0x72, 0x63,
-	0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x42, 0x70, 0x22, 0x86, 0x04, 0x0a, 0x06, 0x52,
-	0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x6a, 0x0a, 0x0e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f,
-	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x43, 0xea,
-	0xde, 0x1f, 0x0e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0xf2, 0xde, 0x1f, 0x15, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x77, 0x61, 0x6c, 0x6c, 0x65,
-	0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x52, 0x0d, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x12, 0xc4, 0x01, 0x0a, 0x17, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x66, 0x72, 0x6f,
-	0x6d, 0x5f, 0x6d, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x18, 0x02, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73,
-	0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x72,
-	0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f, 0x17, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x66,
-	0x72, 0x6f, 0x6d, 0x5f, 0x6d, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0xf2,
-	0xde, 0x1f, 0x1e, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f,
-	0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x6d, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x6f, 0x6f, 0x6c,
-	0x22, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x69, 0x6e, 0x5f, 0x62, 0x70, 0x22, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x1a, 0x72, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67,
+	0x65, 0x49, 0x6e, 0x42, 0x70, 0x12, 0xc1, 0x01, 0x0a, 0x1a, 0x6d, 0x65, 0x74, 0x61, 0x5f, 0x6e,
+	0x6f, 0x64, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x5f, 0x69,
+	0x6e, 0x5f, 0x62, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x84, 0x01, 0xc8, 0xde, 0x1f,
+	0x00, 0xda, 0xde, 0x1f, 0x26, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
 	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64,
-	0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0,
-	0x2a, 0x01, 0x52, 0x14, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x4d, 0x69,
-	0x6e, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6f, 0x6c, 0x12, 0xc8, 0x01, 0x0a, 0x18, 0x72, 0x65, 0x77,
-	0x61, 0x72, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63,
-	0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
-	0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x74, 0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f, 0x18,
-	0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x74, 0x72, 0x61, 0x66,
-	0x66, 0x69, 0x63, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0xf2, 0xde, 0x1f, 0x1f, 0x79, 0x61, 0x6d, 0x6c,
-	0x3a, 0x22, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x74, 0x72,
-	0x61, 0x66, 0x66, 0x69, 0x63, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x22, 0xaa, 0xdf, 0x1f, 0x28, 0x67,
+	0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xea, 0xde, 0x1f, 0x1a, 0x6d,
+	0x65, 0x74, 0x61, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74,
+	0x61, 0x67, 0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x62, 0x70, 0xf2, 0xde, 0x1f, 0x21, 0x79, 0x61, 0x6d,
+	0x6c, 0x3a, 0x22, 0x6d, 0x65, 0x74, 0x61, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x70, 0x65, 0x72,
+	0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x62, 0x70, 0x22, 0xd2, 0xb4,
+	0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a,
+	0x01, 0x52, 0x16, 0x6d, 0x65, 0x74, 0x61, 0x4e, 0x6f, 0x64, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65,
+	0x6e, 0x74, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x42, 0x70, 0x22, 0x86, 0x04, 0x0a, 0x06, 0x52, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x12, 0x6a, 0x0a, 0x0e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x43, 0xea, 0xde,
+	0x1f, 0x0e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0xf2, 0xde, 0x1f, 0x15, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x52, 0x0d, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0xc4, 0x01, 0x0a, 0x17, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d,
+	0x5f, 0x6d, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x72, 0xc8,
+	0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f, 0x17, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x66, 0x72,
+	0x6f, 0x6d, 0x5f, 0x6d, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0xf2, 0xde,
+	0x1f, 0x1e, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x66,
+	0x72, 0x6f, 0x6d, 0x5f, 0x6d, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x22,
+	0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b,
+	0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a,
+	0x01, 0x52, 0x14, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x4d, 0x69, 0x6e,
+	0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6f, 0x6c, 0x12, 0xc8, 0x01, 0x0a, 0x18, 0x72, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x5f,
+	0x70, 0x6f, 0x6f, 0x6c, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x74, 0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f, 0x18, 0x72,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x74, 0x72, 0x61, 0x66, 0x66,
+	0x69, 0x63, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0xf2, 0xde, 0x1f, 0x1f, 0x79, 0x61, 0x6d, 0x6c, 0x3a,
+	0x22, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x74, 0x72, 0x61,
+	0x66, 0x66, 0x69, 0x63, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x22, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x15, 0x72, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x50, 0x6f,
+	0x6f, 0x6c, 0x22, 0xf6, 0x01, 0x0a, 0x12, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x57, 0x61, 0x6c,
+	0x6c, 0x65, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x6a, 0x0a, 0x0e, 0x77, 0x61, 0x6c,
+	0x6c, 0x65, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x43, 0xea, 0xde, 0x1f, 0x0e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0xf2, 0xde, 0x1f, 0x15, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x77,
+	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xd2, 0xb4,
+	0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0d, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x74, 0x0a, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x5c, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x26, 0x67,
 	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
 	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65,
-	0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x15, 0x72, 0x65,
-	0x77, 0x61, 0x72, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x50,
-	0x6f, 0x6f, 0x6c, 0x22, 0xf6, 0x01, 0x0a, 0x12, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x57, 0x61,
-	0x6c, 0x6c, 0x65, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x6a, 0x0a, 0x0e, 0x77, 0x61,
-	0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x43, 0xea, 0xde, 0x1f, 0x0e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0xf2, 0xde, 0x1f, 0x15, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22,
-	0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xd2,
-	0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0d, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x41,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x74, 0x0a, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x5c, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x26,
-	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70,
-	0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xea, 0xde, 0x1f, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
-	0xf2, 0xde, 0x1f, 0x0d, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
-	0x22, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8,
-	0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x22, 0x75, 0x0a, 0x0d,
-	0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x73, 0x12, 0x64, 0x0a,
-	0x07, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22,
-	0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e, 0x70, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e,
-	0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x56, 0x6f, 0x6c, 0x75,
-	0x6d, 0x65, 0x42, 0x26, 0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f, 0x07, 0x76, 0x6f, 0x6c, 0x75,
-	0x6d, 0x65, 0x73, 0xf2, 0xde, 0x1f, 0x0e, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x76, 0x6f, 0x6c,
-	0x75, 0x6d, 0x65, 0x73, 0x22, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x07, 0x76, 0x6f, 0x6c, 0x75,
-	0x6d, 0x65, 0x73, 0x22, 0xfd, 0x01, 0x0a, 0x12, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65,
-	0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x53, 0x0a, 0x08, 0x72, 0x65,
-	0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0xea, 0xde,
-	0x1f, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0xf2, 0xde, 0x1f, 0x0f, 0x79, 0x61,
-	0x6d, 0x6c, 0x3a, 0x22, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x22, 0xd2, 0xb4, 0x2d,
-	0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53,
-	0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x12,
-	0x5a, 0x0a, 0x10, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65,
-	0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2f, 0xea, 0xde, 0x1f, 0x10, 0x72,
-	0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0xf2,
-	0xde, 0x1f, 0x17, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f,
-	0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x22, 0x52, 0x0f, 0x72, 0x65, 0x70, 0x6f,
-	0x72, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x36, 0x0a, 0x07, 0x74,
-	0x78, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1d, 0xea, 0xde,
-	0x1f, 0x07, 0x74, 0x78, 0x5f, 0x68, 0x61, 0x73, 0x68, 0xf2, 0xde, 0x1f, 0x0e, 0x79, 0x61, 0x6d,
-	0x6c, 0x3a, 0x22, 0x74, 0x78, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x22, 0x52, 0x06, 0x74, 0x78, 0x48,
-	0x61, 0x73, 0x68, 0x22, 0xd3, 0x02, 0x0a, 0x0b, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x52, 0x65, 0x77,
-	0x61, 0x72, 0x64, 0x12, 0x9e, 0x01, 0x0a, 0x0d, 0x6d, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x72,
-	0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
-	0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x5e, 0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f, 0x0d,
-	0x6d, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0xf2, 0xde, 0x1f,
-	0x14, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6d, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65,
-	0x77, 0x61, 0x72, 0x64, 0x22, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x73, 0x2e, 0x49, 0x6e, 0x74, 0xea, 0xde, 0x1f, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0xf2,
+	0xde, 0x1f, 0x0d, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x22,
+	0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7,
+	0xb0, 0x2a, 0x01, 0x52, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x22, 0x75, 0x0a, 0x0d, 0x57,
+	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x73, 0x12, 0x64, 0x0a, 0x07,
+	0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e,
+	0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e, 0x70, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x69, 0x6e, 0x67, 0x6c, 0x65, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d,
+	0x65, 0x42, 0x26, 0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f, 0x07, 0x76, 0x6f, 0x6c, 0x75, 0x6d,
+	0x65, 0x73, 0xf2, 0xde, 0x1f, 0x0e, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x76, 0x6f, 0x6c, 0x75,
+	0x6d, 0x65, 0x73, 0x22, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x07, 0x76, 0x6f, 0x6c, 0x75, 0x6d,
+	0x65, 0x73, 0x22, 0xfd, 0x01, 0x0a, 0x12, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x53, 0x0a, 0x08, 0x72, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0xea, 0xde, 0x1f,
+	0x08, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0xf2, 0xde, 0x1f, 0x0f, 0x79, 0x61, 0x6d,
+	0x6c, 0x3a, 0x22, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x22, 0xd2, 0xb4, 0x2d, 0x14,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x12, 0x5a,
+	0x0a, 0x10, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2f, 0xea, 0xde, 0x1f, 0x10, 0x72, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0xf2, 0xde,
+	0x1f, 0x17, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x72,
+	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x22, 0x52, 0x0f, 0x72, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x36, 0x0a, 0x07, 0x74, 0x78,
+	0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1d, 0xea, 0xde, 0x1f,
+	0x07, 0x74, 0x78, 0x5f, 0x68, 0x61, 0x73, 0x68, 0xf2, 0xde, 0x1f, 0x0e, 0x79, 0x61, 0x6d, 0x6c,
+	0x3a, 0x22, 0x74, 0x78, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x22, 0x52, 0x06, 0x74, 0x78, 0x48, 0x61,
+	0x73, 0x68, 0x22, 0xd3, 0x02, 0x0a, 0x0b, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x52, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x12, 0x9e, 0x01, 0x0a, 0x0d, 0x6d, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x5e, 0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f, 0x0d, 0x6d,
+	0x69, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0xf2, 0xde, 0x1f, 0x14,
+	0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6d, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x22, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73,
+	0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0c, 0x6d, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x12, 0xa2, 0x01, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x5f,
+	0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x60, 0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f,
+	0x0e, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0xf2,
+	0xde, 0x1f, 0x15, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63,
+	0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x22, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43,
+	0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x66, 0x66,
+	0x69, 0x63, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x22, 0xb1, 0x14, 0x0a, 0x07, 0x4d, 0x65, 0x74,
+	0x72, 0x69, 0x63, 0x73, 0x12, 0x8b, 0x01, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73,
+	0x75, 0x70, 0x70, 0x6c, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x68, 0xc8, 0xde, 0x1f,
+	0x00, 0xda, 0xde, 0x1f, 0x26, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64,
+	0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xea, 0xde, 0x1f, 0x0c, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0xf2, 0xde, 0x1f, 0x13, 0x79,
+	0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6c,
+	0x79, 0x22, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74,
+	0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x75, 0x70, 0x70,
+	0x6c, 0x79, 0x12, 0xa6, 0x01, 0x0a, 0x13, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6d, 0x69, 0x6e,
+	0x69, 0x6e, 0x67, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x76, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x26, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74,
+	0xea, 0xde, 0x1f, 0x13, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6d, 0x69, 0x6e, 0x69, 0x6e, 0x67,
+	0x5f, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0xf2, 0xde, 0x1f, 0x1a, 0x79, 0x61, 0x6d, 0x6c, 0x3a,
+	0x22, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6d, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x75,
+	0x70, 0x70, 0x6c, 0x79, 0x22, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x11, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4d,
+	0x69, 0x6e, 0x69, 0x6e, 0x67, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x12, 0xa2, 0x01, 0x0a, 0x12,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6d, 0x69, 0x6e, 0x65, 0x64, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x74, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde,
+	0x1f, 0x26, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xea, 0xde, 0x1f, 0x12, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x5f, 0x6d, 0x69, 0x6e, 0x65, 0x64, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0xf2, 0xde,
+	0x1f, 0x19, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6d, 0x69,
+	0x6e, 0x65, 0x64, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x22, 0xd2, 0xb4, 0x2d, 0x0a, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x10,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4d, 0x69, 0x6e, 0x65, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73,
+	0x12, 0xca, 0x01, 0x0a, 0x1c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x88, 0x01, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde,
+	0x1f, 0x26, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xea, 0xde, 0x1f, 0x1c, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x73,
+	0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0xf2, 0xde, 0x1f, 0x23, 0x79, 0x61, 0x6d, 0x6c,
+	0x3a, 0x22, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x22, 0xd2,
+	0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0,
+	0x2a, 0x01, 0x52, 0x19, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0xb6, 0x01,
+	0x0a, 0x17, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x62, 0x6f, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x64,
+	0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x7e, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x26, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
 	0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e,
-	0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0c, 0x6d, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65,
-	0x77, 0x61, 0x72, 0x64, 0x12, 0xa2, 0x01, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63,
-	0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65,
-	0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x60, 0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde,
-	0x1f, 0x0e, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64,
-	0xf2, 0xde, 0x1f, 0x15, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69,
-	0x63, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x22, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
-	0x43, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x66,
-	0x66, 0x69, 0x63, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x22, 0xb1, 0x14, 0x0a, 0x07, 0x4d, 0x65,
-	0x74, 0x72, 0x69, 0x63, 0x73, 0x12, 0x8b, 0x01, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f,
-	0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x68, 0xc8, 0xde,
-	0x1f, 0x00, 0xda, 0xde, 0x1f, 0x26, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73,
-	0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xea, 0xde, 0x1f, 0x0c,
-	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0xf2, 0xde, 0x1f, 0x13,
-	0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x75, 0x70, 0x70,
-	0x6c, 0x79, 0x22, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e,
-	0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x75, 0x70,
-	0x70, 0x6c, 0x79, 0x12, 0xa6, 0x01, 0x0a, 0x13, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6d, 0x69,
-	0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x76, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x26, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x49, 0x6e,
-	0x74, 0xea, 0xde, 0x1f, 0x13, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6d, 0x69, 0x6e, 0x69, 0x6e,
-	0x67, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0xf2, 0xde, 0x1f, 0x1a, 0x79, 0x61, 0x6d, 0x6c,
-	0x3a, 0x22, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6d, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x73,
-	0x75, 0x70, 0x70, 0x6c, 0x79, 0x22, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x11, 0x74, 0x6f, 0x74, 0x61, 0x6c,
-	0x4d, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x12, 0xa2, 0x01, 0x0a,
-	0x12, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6d, 0x69, 0x6e, 0x65, 0x64, 0x5f, 0x74, 0x6f, 0x6b,
-	0x65, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x74, 0xc8, 0xde, 0x1f, 0x00, 0xda,
-	0xde, 0x1f, 0x26, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xea, 0xde, 0x1f, 0x12, 0x74, 0x6f, 0x74,
-	0x61, 0x6c, 0x5f, 0x6d, 0x69, 0x6e, 0x65, 0x64, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0xf2,
-	0xde, 0x1f, 0x19, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6d,
-	0x69, 0x6e, 0x65, 0x64, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x22, 0xd2, 0xb4, 0x2d, 0x0a,
+	0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xea,
+	0xde, 0x1f, 0x17, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x62, 0x6f, 0x6e, 0x64, 0x65, 0x64, 0x5f,
+	0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0xf2, 0xde, 0x1f, 0x1e, 0x79, 0x61,
+	0x6d, 0x6c, 0x3a, 0x22, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x62, 0x6f, 0x6e, 0x64, 0x65, 0x64,
+	0x5f, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xd2, 0xb4, 0x2d, 0x0a,
 	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52,
-	0x10, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4d, 0x69, 0x6e, 0x65, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x73, 0x12, 0xca, 0x01, 0x0a, 0x1c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73,
-	0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x88, 0x01, 0xc8, 0xde, 0x1f, 0x00, 0xda,
-	0xde, 0x1f, 0x26, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xea, 0xde, 0x1f, 0x1c, 0x74, 0x6f, 0x74,
-	0x61, 0x6c, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x6e, 0x6f, 0x64, 0x65,
-	0x73, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0xf2, 0xde, 0x1f, 0x23, 0x79, 0x61, 0x6d,
-	0x6c, 0x3a, 0x22, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x22,
+	0x15, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x6f, 0x6e, 0x64, 0x65, 0x64, 0x44, 0x65, 0x6c, 0x65,
+	0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0xbf, 0x01, 0x0a, 0x19, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x5f, 0x75, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x82, 0x01, 0xc8, 0xde, 0x1f,
+	0x00, 0xda, 0xde, 0x1f, 0x26, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64,
+	0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xea, 0xde, 0x1f, 0x19, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x75, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x64, 0x65,
+	0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0xf2, 0xde, 0x1f, 0x20, 0x79, 0x61, 0x6d, 0x6c,
+	0x3a, 0x22, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x75, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x65, 0x64,
+	0x5f, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xd2, 0xb4, 0x2d, 0x0a,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52,
+	0x17, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x55, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x65, 0x64, 0x44, 0x65,
+	0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0xc3, 0x01, 0x0a, 0x1a, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x5f, 0x75, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x65, 0x6c,
+	0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x84, 0x01,
+	0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x26, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xea, 0xde,
+	0x1f, 0x1a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x75, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0xf2, 0xde, 0x1f, 0x21,
+	0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x75, 0x6e, 0x62, 0x6f,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8,
+	0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x18, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x55, 0x6e, 0x62, 0x6f, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0xa3,
+	0x01, 0x0a, 0x12, 0x63, 0x69, 0x72, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73,
+	0x75, 0x70, 0x70, 0x6c, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x74, 0xc8, 0xde, 0x1f,
+	0x00, 0xda, 0xde, 0x1f, 0x26, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64,
+	0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xea, 0xde, 0x1f, 0x12, 0x63,
+	0x69, 0x72, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6c,
+	0x79, 0xf2, 0xde, 0x1f, 0x19, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x63, 0x69, 0x72, 0x63, 0x75,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x22, 0xd2, 0xb4,
+	0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a,
+	0x01, 0x52, 0x11, 0x63, 0x69, 0x72, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x75,
+	0x70, 0x70, 0x6c, 0x79, 0x12, 0xa6, 0x01, 0x0a, 0x13, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6d,
+	0x69, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x76, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x26, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x49,
+	0x6e, 0x74, 0xea, 0xde, 0x1f, 0x13, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6d, 0x69, 0x6e, 0x69,
+	0x6e, 0x67, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0xf2, 0xde, 0x1f, 0x1a, 0x79, 0x61, 0x6d,
+	0x6c, 0x3a, 0x22, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6d, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x5f,
+	0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x22, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x11, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x4d, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0xa6, 0x01,
+	0x0a, 0x13, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x6d, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x72,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x42, 0x76, 0xc8, 0xde, 0x1f,
+	0x00, 0xda, 0xde, 0x1f, 0x26, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64,
+	0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xea, 0xde, 0x1f, 0x13, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x5f, 0x6d, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0xf2, 0xde, 0x1f, 0x1a, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x5f, 0x6d, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x22,
 	0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7,
-	0xb0, 0x2a, 0x01, 0x52, 0x19, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0xb6,
-	0x01, 0x0a, 0x17, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x62, 0x6f, 0x6e, 0x64, 0x65, 0x64, 0x5f,
-	0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x7e, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x26, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0xb0, 0x2a, 0x01, 0x52, 0x11, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x4d, 0x69, 0x6e, 0x69, 0x6e, 0x67,
+	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0xb2, 0x01, 0x0a, 0x16, 0x72, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x5f, 0x6d, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x42, 0x7c, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f,
+	0x26, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xea, 0xde, 0x1f, 0x16, 0x72, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x5f, 0x6d, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0xf2, 0xde, 0x1f, 0x1d, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x72, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x5f, 0x6d, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x22, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74,
+	0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x14, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4d,
+	0x69, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0xa2, 0x01, 0x0a, 0x12,
+	0x6d, 0x65, 0x74, 0x61, 0x5f, 0x6d, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x42, 0x74, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde,
+	0x1f, 0x26, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xea, 0xde, 0x1f, 0x12, 0x6d, 0x65, 0x74, 0x61,
+	0x5f, 0x6d, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0xf2, 0xde,
+	0x1f, 0x19, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6d, 0x65, 0x74, 0x61, 0x5f, 0x6d, 0x69, 0x6e,
+	0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x22, 0xd2, 0xb4, 0x2d, 0x0a, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x10,
+	0x6d, 0x65, 0x74, 0x61, 0x4d, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x12, 0xae, 0x01, 0x0a, 0x15, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x6e, 0x6f,
+	0x64, 0x65, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x7a, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x26, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
 	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
 	0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74,
-	0xea, 0xde, 0x1f, 0x17, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x62, 0x6f, 0x6e, 0x64, 0x65, 0x64,
-	0x5f, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0xf2, 0xde, 0x1f, 0x1e, 0x79,
-	0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x62, 0x6f, 0x6e, 0x64, 0x65,
-	0x64, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xd2, 0xb4, 0x2d,
-	0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
-	0x52, 0x15, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x6f, 0x6e, 0x64, 0x65, 0x64, 0x44, 0x65, 0x6c,
-	0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0xbf, 0x01, 0x0a, 0x19, 0x74, 0x6f, 0x74, 0x61,
-	0x6c, 0x5f, 0x75, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x67,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x82, 0x01, 0xc8, 0xde,
+	0xea, 0xde, 0x1f, 0x15, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x6e, 0x6f, 0x64,
+	0x65, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0xf2, 0xde, 0x1f, 0x1c, 0x79, 0x61, 0x6d,
+	0x6c, 0x3a, 0x22, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x6e, 0x6f, 0x64, 0x65,
+	0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x22, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x13, 0x72, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x12, 0x9e, 0x01, 0x0a, 0x11, 0x6d, 0x65, 0x74, 0x61, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f,
+	0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x42, 0x72, 0xc8,
+	0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x26, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d,
+	0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xea, 0xde, 0x1f,
+	0x11, 0x6d, 0x65, 0x74, 0x61, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0xf2, 0xde, 0x1f, 0x18, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6d, 0x65, 0x74, 0x61,
+	0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x22, 0xd2, 0xb4,
+	0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a,
+	0x01, 0x52, 0x0f, 0x6d, 0x65, 0x74, 0x61, 0x4e, 0x6f, 0x64, 0x65, 0x44, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x12, 0x97, 0x01, 0x0a, 0x0f, 0x75, 0x6e, 0x69, 0x73, 0x73, 0x75, 0x65, 0x64, 0x5f,
+	0x70, 0x72, 0x65, 0x70, 0x61, 0x79, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x42, 0x6e, 0xc8, 0xde,
 	0x1f, 0x00, 0xda, 0xde, 0x1f, 0x26, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
 	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73,
-	0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xea, 0xde, 0x1f, 0x19,
-	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x75, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x64,
-	0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0xf2, 0xde, 0x1f, 0x20, 0x79, 0x61, 0x6d,
-	0x6c, 0x3a, 0x22, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x75, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x65,
-	0x64, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xd2, 0xb4, 0x2d,
-	0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
-	0x52, 0x17, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x55, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x65, 0x64, 0x44,
-	0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0xc3, 0x01, 0x0a, 0x1a, 0x74, 0x6f,
-	0x74, 0x61, 0x6c, 0x5f, 0x75, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x65,
-	0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x84,
-	0x01, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x26, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xea, 0xde, 0x1f, 0x0f,
+	0x75, 0x6e, 0x69, 0x73, 0x73, 0x75, 0x65, 0x64, 0x5f, 0x70, 0x72, 0x65, 0x70, 0x61, 0x79, 0xf2,
+	0xde, 0x1f, 0x16, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x75, 0x6e, 0x69, 0x73, 0x73, 0x75, 0x65,
+	0x64, 0x5f, 0x70, 0x72, 0x65, 0x70, 0x61, 0x79, 0x22, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0e, 0x75, 0x6e,
+	0x69, 0x73, 0x73, 0x75, 0x65, 0x64, 0x50, 0x72, 0x65, 0x70, 0x61, 0x79, 0x22, 0x4b, 0x0a, 0x14,
+	0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x49, 0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x50,
+	0x72, 0x6f, 0x6f, 0x66, 0x12, 0x33, 0x0a, 0x06, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0c, 0x42, 0x1b, 0xea, 0xde, 0x1f, 0x06, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73,
+	0xf2, 0xde, 0x1f, 0x0d, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73,
+	0x22, 0x52, 0x06, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x22, 0xb0, 0x05, 0x0a, 0x13, 0x50, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x12, 0x53, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x37, 0xea, 0xde, 0x1f, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65,
+	0x72, 0xf2, 0xde, 0x1f, 0x0f, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x72, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x65, 0x72, 0x22, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x72, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x12, 0x70, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x5a, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x26, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x49, 0x6e, 0x74, 0xea, 0xde, 0x1f, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0xf2, 0xde,
+	0x1f, 0x0c, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x22, 0xd2, 0xb4,
+	0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a,
+	0x01, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x5a, 0x0a, 0x10, 0x72, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x2f, 0xea, 0xde, 0x1f, 0x10, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x72,
+	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0xf2, 0xde, 0x1f, 0x17, 0x79, 0x61, 0x6d, 0x6c,
+	0x3a, 0x22, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x22, 0x52, 0x0f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x12, 0x46, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x42, 0x25, 0xea, 0xde, 0x1f, 0x0b, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0xf2, 0xde, 0x1f, 0x12, 0x79, 0x61,
+	0x6d, 0x6c, 0x3a, 0x22, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22,
+	0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x4a, 0x0a, 0x0c,
+	0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x73, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0c, 0x42, 0x27, 0xea, 0xde, 0x1f, 0x0c, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x73, 0x5f,
+	0x72, 0x6f, 0x6f, 0x74, 0xf2, 0xde, 0x1f, 0x13, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x76, 0x6f,
+	0x6c, 0x75, 0x6d, 0x65, 0x73, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x22, 0x52, 0x0b, 0x76, 0x6f, 0x6c,
+	0x75, 0x6d, 0x65, 0x73, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x52, 0x0a, 0x0e, 0x72, 0x65, 0x63, 0x65,
+	0x69, 0x76, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04,
+	0x42, 0x2b, 0xea, 0xde, 0x1f, 0x0e, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0xf2, 0xde, 0x1f, 0x15, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x72, 0x65,
+	0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x52, 0x0d, 0x72,
+	0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x56, 0x0a, 0x0f,
+	0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x03, 0x42, 0x2d, 0xea, 0xde, 0x1f, 0x0f, 0x64, 0x65, 0x61, 0x64, 0x6c,
+	0x69, 0x6e, 0x65, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0xf2, 0xde, 0x1f, 0x16, 0x79, 0x61,
+	0x6d, 0x6c, 0x3a, 0x22, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x68, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x22, 0x52, 0x0e, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x48, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x12, 0x36, 0x0a, 0x07, 0x74, 0x78, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1d, 0xea, 0xde, 0x1f, 0x07, 0x74, 0x78, 0x5f, 0x68, 0x61,
+	0x73, 0x68, 0xf2, 0xde, 0x1f, 0x0e, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x74, 0x78, 0x5f, 0x68,
+	0x61, 0x73, 0x68, 0x22, 0x52, 0x06, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x22, 0x9a, 0x0e, 0x0a,
+	0x13, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x70, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x5a, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x26, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x49, 0x6e, 0x74, 0xea, 0xde, 0x1f, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0xf2, 0xde, 0x1f, 0x0c,
+	0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x22, 0xd2, 0xb4, 0x2d, 0x0a,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52,
+	0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x46, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x25, 0xea, 0xde, 0x1f,
+	0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0xf2, 0xde, 0x1f, 0x12,
+	0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x22, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x56,
+	0x0a, 0x0f, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2d, 0xea, 0xde, 0x1f, 0x0f, 0x70, 0x72, 0x6f,
+	0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0xf2, 0xde, 0x1f, 0x16,
+	0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x5f,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x52, 0x0e, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65,
+	0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0xa2, 0x01, 0x0a, 0x12, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x64, 0x5f, 0x6e, 0x6f, 0x7a, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x74, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x26, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x49, 0x6e, 0x74, 0xea, 0xde, 0x1f, 0x12, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x6e,
+	0x73, 0x75, 0x6d, 0x65, 0x64, 0x5f, 0x6e, 0x6f, 0x7a, 0xf2, 0xde, 0x1f, 0x19, 0x79, 0x61, 0x6d,
+	0x6c, 0x3a, 0x22, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65,
+	0x64, 0x5f, 0x6e, 0x6f, 0x7a, 0x22, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x10, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x64, 0x4e, 0x6f, 0x7a, 0x12, 0x37, 0x0a, 0x07, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x42, 0x1d, 0xea, 0xde,
+	0x1f, 0x07, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0xf2, 0xde, 0x1f, 0x0e, 0x79, 0x61, 0x6d,
+	0x6c, 0x3a, 0x22, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x22, 0x52, 0x07, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x65, 0x64, 0x12, 0xb3, 0x01, 0x0a, 0x1e, 0x6d, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x5f,
+	0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x74, 0x6f, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x54, 0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde,
+	0x1f, 0x1e, 0x6d, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f,
+	0x74, 0x6f, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x6e, 0x6f, 0x64, 0x65,
+	0xf2, 0xde, 0x1f, 0x25, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6d, 0x69, 0x6e, 0x69, 0x6e, 0x67,
+	0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x74, 0x6f, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x22, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x1a,
+	0x6d, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x54, 0x6f, 0x52, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0xb7, 0x01, 0x0a, 0x1f, 0x74,
+	0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x74, 0x6f,
+	0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61,
+	0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42,
+	0x56, 0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f, 0x1f, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63,
+	0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x74, 0x6f, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0xf2, 0xde, 0x1f, 0x26, 0x79, 0x61, 0x6d, 0x6c,
+	0x3a, 0x22, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x5f, 0x74, 0x6f, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x6e, 0x6f, 0x64,
+	0x65, 0x22, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x1b, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63,
+	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x54, 0x6f, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x4e, 0x6f, 0x64, 0x65, 0x12, 0xa3, 0x01, 0x0a, 0x1a, 0x6d, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x5f,
+	0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x74, 0x6f, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x5f, 0x6e,
+	0x6f, 0x64, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x43, 0x6f, 0x69, 0x6e, 0x42, 0x4c, 0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f, 0x1a, 0x6d, 0x69,
+	0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x74, 0x6f, 0x5f, 0x6d,
+	0x65, 0x74, 0x61, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0xf2, 0xde, 0x1f, 0x21, 0x79, 0x61, 0x6d, 0x6c,
+	0x3a, 0x22, 0x6d, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f,
+	0x74, 0x6f, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x22, 0xa8, 0xe7, 0xb0,
+	0x2a, 0x01, 0x52, 0x16, 0x6d, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x54, 0x6f, 0x4d, 0x65, 0x74, 0x61, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0xa7, 0x01, 0x0a, 0x1b, 0x74,
+	0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x74, 0x6f,
+	0x5f, 0x6d, 0x65, 0x74, 0x61, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x4e, 0xc8, 0xde, 0x1f,
+	0x00, 0xea, 0xde, 0x1f, 0x1b, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x5f, 0x72, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x5f, 0x74, 0x6f, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x5f, 0x6e, 0x6f, 0x64, 0x65,
+	0xf2, 0xde, 0x1f, 0x22, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69,
+	0x63, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x74, 0x6f, 0x5f, 0x6d, 0x65, 0x74, 0x61,
+	0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x22, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x17, 0x74, 0x72, 0x61,
+	0x66, 0x66, 0x69, 0x63, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x54, 0x6f, 0x4d, 0x65, 0x74, 0x61,
+	0x4e, 0x6f, 0x64, 0x65, 0x12, 0x6e, 0x0a, 0x0c, 0x6d, 0x69, 0x6e, 0x65, 0x64, 0x5f, 0x72, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f, 0x0c, 0x6d,
+	0x69, 0x6e, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0xf2, 0xde, 0x1f, 0x13, 0x79,
+	0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6d, 0x69, 0x6e, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x22, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x65, 0x64, 0x52, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x12, 0x76, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x5f,
+	0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x34, 0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f,
+	0x0e, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0xf2,
+	0xde, 0x1f, 0x15, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63,
+	0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x22, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0d, 0x74,
+	0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x75, 0x0a, 0x17,
+	0x64, 0x69, 0x73, 0x70, 0x75, 0x74, 0x65, 0x5f, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65,
+	0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x42, 0x3d, 0xea,
+	0xde, 0x1f, 0x17, 0x64, 0x69, 0x73, 0x70, 0x75, 0x74, 0x65, 0x5f, 0x64, 0x65, 0x61, 0x64, 0x6c,
+	0x69, 0x6e, 0x65, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0xf2, 0xde, 0x1f, 0x1e, 0x79, 0x61,
+	0x6d, 0x6c, 0x3a, 0x22, 0x64, 0x69, 0x73, 0x70, 0x75, 0x74, 0x65, 0x5f, 0x64, 0x65, 0x61, 0x64,
+	0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x52, 0x15, 0x64, 0x69,
+	0x73, 0x70, 0x75, 0x74, 0x65, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x48, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x12, 0x3b, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x70, 0x75, 0x74, 0x65, 0x64, 0x18,
+	0x0d, 0x20, 0x01, 0x28, 0x08, 0x42, 0x1f, 0xea, 0xde, 0x1f, 0x08, 0x64, 0x69, 0x73, 0x70, 0x75,
+	0x74, 0x65, 0x64, 0xf2, 0xde, 0x1f, 0x0f, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x64, 0x69, 0x73,
+	0x70, 0x75, 0x74, 0x65, 0x64, 0x22, 0x52, 0x08, 0x64, 0x69, 0x73, 0x70, 0x75, 0x74, 0x65, 0x64,
+	0x12, 0xb6, 0x01, 0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x5f, 0x74,
+	0x61, 0x78, 0x5f, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x0e, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x7e, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x26, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44,
+	0x65, 0x63, 0xea, 0xde, 0x1f, 0x17, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x5f,
+	0x74, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x64, 0x65, 0x72, 0xf2, 0xde, 0x1f,
+	0x1e, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79,
+	0x5f, 0x74, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x22, 0xd2,
+	0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0,
+	0x2a, 0x01, 0x52, 0x15, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x54, 0x61, 0x78,
+	0x52, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x22, 0x99, 0x02, 0x0a, 0x12, 0x44, 0x69,
+	0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
+	0x12, 0x70, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x5a, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x26, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
 	0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
 	0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xea,
-	0xde, 0x1f, 0x1a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x75, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69,
-	0x6e, 0x67, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0xf2, 0xde, 0x1f,
-	0x21, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x75, 0x6e, 0x62,
-	0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x22, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74,
-	0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x18, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x55, 0x6e, 0x62, 0x6f,
-	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0xa3, 0x01, 0x0a, 0x12, 0x63, 0x69, 0x72, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x74, 0xc8, 0xde,
+	0xde, 0x1f, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0xf2, 0xde, 0x1f, 0x0c, 0x79, 0x61, 0x6d, 0x6c,
+	0x3a, 0x22, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x22, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x05, 0x65, 0x70, 0x6f,
+	0x63, 0x68, 0x12, 0x2f, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x42, 0x19, 0xea, 0xde, 0x1f, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0xf2, 0xde, 0x1f, 0x0c,
+	0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x52, 0x05, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x12, 0x60, 0x0a, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e, 0x70, 0x6f,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x57, 0x61, 0x6c, 0x6c, 0x65,
+	0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x42, 0x24, 0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f,
+	0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0xf2, 0xde, 0x1f, 0x0d, 0x79, 0x61, 0x6d, 0x6c, 0x3a,
+	0x22, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x22, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x76,
+	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x22, 0xe4, 0x04, 0x0a, 0x13, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x69, 0x73, 0x70, 0x75, 0x74, 0x65, 0x12, 0x70, 0x0a,
+	0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x5a, 0xc8, 0xde,
 	0x1f, 0x00, 0xda, 0xde, 0x1f, 0x26, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
 	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73,
-	0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xea, 0xde, 0x1f, 0x12,
-	0x63, 0x69, 0x72, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x75, 0x70, 0x70,
-	0x6c, 0x79, 0xf2, 0xde, 0x1f, 0x19, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x63, 0x69, 0x72, 0x63,
-	0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x22, 0xd2,
-	0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0,
-	0x2a, 0x01, 0x52, 0x11, 0x63, 0x69, 0x72, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
-	0x75, 0x70, 0x70, 0x6c, 0x79, 0x12, 0xa6, 0x01, 0x0a, 0x13, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f,
-	0x6d, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x09, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x76, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x26, 0x67, 0x69, 0x74,
+	0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xea, 0xde, 0x1f, 0x05,
+	0x65, 0x70, 0x6f, 0x63, 0x68, 0xf2, 0xde, 0x1f, 0x0c, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x65,
+	0x70, 0x6f, 0x63, 0x68, 0x22, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12,
+	0x53, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x70, 0x75, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x37, 0xea, 0xde, 0x1f, 0x08, 0x64, 0x69, 0x73, 0x70, 0x75, 0x74, 0x65, 0x72, 0xf2,
+	0xde, 0x1f, 0x0f, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x64, 0x69, 0x73, 0x70, 0x75, 0x74, 0x65,
+	0x72, 0x22, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x64, 0x69, 0x73, 0x70,
+	0x75, 0x74, 0x65, 0x72, 0x12, 0x53, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0xea, 0xde, 0x1f, 0x08, 0x72, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x65, 0x72, 0xf2, 0xde, 0x1f, 0x0f, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x72, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x22, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52,
+	0x08, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x12, 0x33, 0x0a, 0x06, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1b, 0xea, 0xde, 0x1f, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0xf2, 0xde, 0x1f, 0x0d, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x7f,
+	0x0a, 0x0e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x73,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73,
+	0x2e, 0x70, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x57, 0x61,
+	0x6c, 0x6c, 0x65, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x42, 0x34, 0xc8, 0xde, 0x1f, 0x00,
+	0xea, 0xde, 0x1f, 0x0e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x76, 0x6f, 0x6c, 0x75, 0x6d,
+	0x65, 0x73, 0xf2, 0xde, 0x1f, 0x15, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x77, 0x61, 0x6c, 0x6c,
+	0x65, 0x74, 0x5f, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x73, 0x22, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
+	0x52, 0x0d, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x73, 0x12,
+	0x33, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x42,
+	0x1b, 0xea, 0xde, 0x1f, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0xf2, 0xde, 0x1f, 0x0d, 0x79,
+	0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x52, 0x06, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x12, 0x46, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c,
+	0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x42, 0x25, 0xea, 0xde, 0x1f, 0x0b, 0x70,
+	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0xf2, 0xde, 0x1f, 0x12, 0x79, 0x61,
+	0x6d, 0x6c, 0x3a, 0x22, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x22,
+	0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x49, 0x64, 0x22, 0xbe, 0x03, 0x0a,
+	0x12, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x71, 0x75, 0x69, 0x76, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x70, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x5a, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x26, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x49,
+	0x6e, 0x74, 0xea, 0xde, 0x1f, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0xf2, 0xde, 0x1f, 0x0c, 0x79,
+	0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x22, 0xd2, 0xb4, 0x2d, 0x0a, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x05,
+	0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x4f, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x75, 0x73, 0x65, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x35, 0xea, 0xde, 0x1f, 0x07, 0x61, 0x63, 0x63, 0x75,
+	0x73, 0x65, 0x64, 0xf2, 0xde, 0x1f, 0x0e, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x61, 0x63, 0x63,
+	0x75, 0x73, 0x65, 0x64, 0x22, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x61,
+	0x63, 0x63, 0x75, 0x73, 0x65, 0x64, 0x12, 0x53, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0xea, 0xde, 0x1f, 0x08, 0x72, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0xf2, 0xde, 0x1f, 0x0f, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22,
+	0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x22, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x12, 0x5b, 0x0a, 0x07, 0x73,
+	0x6c, 0x61, 0x73, 0x68, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x26, 0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f,
+	0x07, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x64, 0xf2, 0xde, 0x1f, 0x0e, 0x79, 0x61, 0x6d, 0x6c,
+	0x3a, 0x22, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x64, 0x22, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52,
+	0x07, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x64, 0x12, 0x33, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x42, 0x1b, 0xea, 0xde, 0x1f, 0x06, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0xf2, 0xde, 0x1f, 0x0d, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x22, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0xb0, 0x06,
+	0x0a, 0x0d, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12,
+	0x6a, 0x0a, 0x0e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x43, 0xea, 0xde, 0x1f, 0x0e, 0x77, 0x61, 0x6c,
+	0x6c, 0x65, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0xf2, 0xde, 0x1f, 0x15, 0x79,
+	0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x22, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0d, 0x77, 0x61,
+	0x6c, 0x6c, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x70, 0x0a, 0x05, 0x65,
+	0x70, 0x6f, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x5a, 0xc8, 0xde, 0x1f, 0x00,
+	0xda, 0xde, 0x1f, 0x26, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b,
+	0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xea, 0xde, 0x1f, 0x05, 0x65, 0x70,
+	0x6f, 0x63, 0x68, 0xf2, 0xde, 0x1f, 0x0c, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x65, 0x70, 0x6f,
+	0x63, 0x68, 0x22, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e,
+	0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0xc4, 0x01,
+	0x0a, 0x17, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x6d, 0x69,
+	0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x72, 0xc8, 0xde, 0x1f, 0x00,
+	0xea, 0xde, 0x1f, 0x17, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f,
+	0x6d, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0xf2, 0xde, 0x1f, 0x1e, 0x79,
+	0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d,
+	0x5f, 0x6d, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x22, 0xaa, 0xdf, 0x1f,
+	0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x14,
+	0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x4d, 0x69, 0x6e, 0x69, 0x6e, 0x67,
+	0x50, 0x6f, 0x6f, 0x6c, 0x12, 0xc8, 0x01, 0x0a, 0x18, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f,
+	0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x5f, 0x70, 0x6f, 0x6f,
+	0x6c, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f,
+	0x69, 0x6e, 0x42, 0x74, 0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f, 0x18, 0x72, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x5f,
+	0x70, 0x6f, 0x6f, 0x6c, 0xf2, 0xde, 0x1f, 0x1f, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x72, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69,
+	0x63, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x22, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f,
+	0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x15, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x46, 0x72, 0x6f, 0x6d, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x50, 0x6f, 0x6f, 0x6c, 0x12,
+	0xae, 0x01, 0x0a, 0x11, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x65, 0x64,
+	0x75, 0x63, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x66, 0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f, 0x11,
+	0x73, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x65, 0x64, 0x75, 0x63, 0x74, 0x65,
+	0x64, 0xf2, 0xde, 0x1f, 0x18, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x73, 0x6c, 0x61, 0x73, 0x68,
+	0x69, 0x6e, 0x67, 0x5f, 0x64, 0x65, 0x64, 0x75, 0x63, 0x74, 0x65, 0x64, 0x22, 0xaa, 0xdf, 0x1f,
+	0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x10,
+	0x73, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x64, 0x75, 0x63, 0x74, 0x65, 0x64,
+	0x22, 0xe9, 0x01, 0x0a, 0x0b, 0x41, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x73, 0x74, 0x61, 0x6b, 0x65,
+	0x12, 0x6a, 0x0a, 0x0e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x43, 0xea, 0xde, 0x1f, 0x0e, 0x77, 0x61,
+	0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0xf2, 0xde, 0x1f, 0x15,
+	0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x22, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0d, 0x77,
+	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x6e, 0x0a, 0x0f,
+	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x45, 0xea, 0xde, 0x1f, 0x0f, 0x6e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0xf2, 0xde, 0x1f, 0x16, 0x79, 0x61,
+	0x6d, 0x6c, 0x3a, 0x22, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x22, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0e, 0x6e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xc5, 0x08, 0x0a,
+	0x0f, 0x56, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x12, 0x6a, 0x0a, 0x0e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x43, 0xea, 0xde, 0x1f, 0x0e, 0x77, 0x61,
+	0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0xf2, 0xde, 0x1f, 0x15,
+	0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x22, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0d, 0x77,
+	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0xc0, 0x01, 0x0a,
+	0x16, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x6d, 0x69, 0x6e, 0x69,
+	0x6e, 0x67, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x70, 0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde,
+	0x1f, 0x16, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x6d, 0x69, 0x6e,
+	0x69, 0x6e, 0x67, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0xf2, 0xde, 0x1f, 0x1d, 0x79, 0x61, 0x6d, 0x6c,
+	0x3a, 0x22, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x6d, 0x69, 0x6e,
+	0x69, 0x6e, 0x67, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x22, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74,
 	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63,
 	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
-	0x49, 0x6e, 0x74, 0xea, 0xde, 0x1f, 0x13, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6d, 0x69, 0x6e,
-	0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0xf2, 0xde, 0x1f, 0x1a, 0x79, 0x61,
-	0x6d, 0x6c, 0x3a, 0x22, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6d, 0x69, 0x6e, 0x69, 0x6e, 0x67,
-	0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x22, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x11, 0x74, 0x6f, 0x74,
-	0x61, 0x6c, 0x4d, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0xa6,
-	0x01, 0x0a, 0x13, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x6d, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x5f,
-	0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x42, 0x76, 0xc8, 0xde,
-	0x1f, 0x00, 0xda, 0xde, 0x1f, 0x26, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73,
-	0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xea, 0xde, 0x1f, 0x13,
-	0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x6d, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x77,
-	0x61, 0x72, 0x64, 0xf2, 0xde, 0x1f, 0x1a, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x63, 0x68, 0x61,
-	0x69, 0x6e, 0x5f, 0x6d, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64,
-	0x22, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8,
-	0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x11, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x4d, 0x69, 0x6e, 0x69, 0x6e,
-	0x67, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0xb2, 0x01, 0x0a, 0x16, 0x72, 0x65, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x5f, 0x6d, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x77, 0x61,
-	0x72, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x42, 0x7c, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde,
-	0x1f, 0x26, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74,
-	0x79, 0x70, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xea, 0xde, 0x1f, 0x16, 0x72, 0x65, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x5f, 0x6d, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x77, 0x61,
-	0x72, 0x64, 0xf2, 0xde, 0x1f, 0x1d, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x72, 0x65, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x5f, 0x6d, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x77, 0x61,
-	0x72, 0x64, 0x22, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e,
-	0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x14, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x4d, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0xa2, 0x01, 0x0a,
-	0x12, 0x6d, 0x65, 0x74, 0x61, 0x5f, 0x6d, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x77,
-	0x61, 0x72, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x42, 0x74, 0xc8, 0xde, 0x1f, 0x00, 0xda,
-	0xde, 0x1f, 0x26, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f,
+	0x43, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x13, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x46, 0x72, 0x6f, 0x6d, 0x4d, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6f, 0x6c, 0x12,
+	0xc4, 0x01, 0x0a, 0x17, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x74,
+	0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x72, 0xc8, 0xde,
+	0x1f, 0x00, 0xea, 0xde, 0x1f, 0x17, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x66, 0x72, 0x6f, 0x6d,
+	0x5f, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0xf2, 0xde, 0x1f,
+	0x1e, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x66, 0x72, 0x6f,
+	0x6d, 0x5f, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x22, 0xaa,
+	0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f,
 	0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xea, 0xde, 0x1f, 0x12, 0x6d, 0x65, 0x74,
-	0x61, 0x5f, 0x6d, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0xf2,
-	0xde, 0x1f, 0x19, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6d, 0x65, 0x74, 0x61, 0x5f, 0x6d, 0x69,
-	0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x22, 0xd2, 0xb4, 0x2d, 0x0a,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52,
-	0x10, 0x6d, 0x65, 0x74, 0x61, 0x4d, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x77, 0x61, 0x72,
-	0x64, 0x12, 0xae, 0x01, 0x0a, 0x15, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x6e,
-	0x6f, 0x64, 0x65, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x7a, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x26, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x49, 0x6e,
-	0x74, 0xea, 0xde, 0x1f, 0x15, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x6e, 0x6f,
-	0x64, 0x65, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0xf2, 0xde, 0x1f, 0x1c, 0x79, 0x61,
-	0x6d, 0x6c, 0x3a, 0x22, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x6e, 0x6f, 0x64,
-	0x65, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x22, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x13, 0x72,
-	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x44, 0x65, 0x70, 0x6f, 0x73,
-	0x69, 0x74, 0x12, 0x9e, 0x01, 0x0a, 0x11, 0x6d, 0x65, 0x74, 0x61, 0x5f, 0x6e, 0x6f, 0x64, 0x65,
-	0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x42, 0x72,
-	0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x26, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
-	0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xea, 0xde,
-	0x1f, 0x11, 0x6d, 0x65, 0x74, 0x61, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x64, 0x65, 0x70, 0x6f,
-	0x73, 0x69, 0x74, 0xf2, 0xde, 0x1f, 0x18, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6d, 0x65, 0x74,
-	0x61, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x22, 0xd2,
-	0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0,
-	0x2a, 0x01, 0x52, 0x0f, 0x6d, 0x65, 0x74, 0x61, 0x4e, 0x6f, 0x64, 0x65, 0x44, 0x65, 0x70, 0x6f,
-	0x73, 0x69, 0x74, 0x12, 0x97, 0x01, 0x0a, 0x0f, 0x75, 0x6e, 0x69, 0x73, 0x73, 0x75, 0x65, 0x64,
-	0x5f, 0x70, 0x72, 0x65, 0x70, 0x61, 0x79, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x42, 0x6e, 0xc8,
-	0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x26, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
-	0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d,
-	0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xea, 0xde, 0x1f,
-	0x0f, 0x75, 0x6e, 0x69, 0x73, 0x73, 0x75, 0x65, 0x64, 0x5f, 0x70, 0x72, 0x65, 0x70, 0x61, 0x79,
-	0xf2, 0xde, 0x1f, 0x16, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x75, 0x6e, 0x69, 0x73, 0x73, 0x75,
-	0x65, 0x64, 0x5f, 0x70, 0x72, 0x65, 0x70, 0x61, 0x79, 0x22, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0e, 0x75,
-	0x6e, 0x69, 0x73, 0x73, 0x75, 0x65, 0x64, 0x50, 0x72, 0x65, 0x70, 0x61, 0x79, 0x42, 0xa3, 0x01,
-	0x0a, 0x12, 0x63, 0x6f, 0x6d, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e, 0x70, 0x6f,
-	0x74, 0x2e, 0x76, 0x31, 0x42, 0x08, 0x50, 0x6f, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01,
-	0x5a, 0x25, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61,
-	0x70, 0x69, 0x2f, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2f, 0x70, 0x6f, 0x74, 0x2f, 0x76,
-	0x31, 0x3b, 0x70, 0x6f, 0x74, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x53, 0x50, 0x58, 0xaa, 0x02, 0x0e,
-	0x53, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e, 0x50, 0x6f, 0x74, 0x2e, 0x56, 0x31, 0xca, 0x02,
-	0x0e, 0x53, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x5c, 0x50, 0x6f, 0x74, 0x5c, 0x56, 0x31, 0xe2,
-	0x02, 0x1a, 0x53, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x5c, 0x50, 0x6f, 0x74, 0x5c, 0x56, 0x31,
-	0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x10, 0x53,
-	0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x3a, 0x3a, 0x50, 0x6f, 0x74, 0x3a, 0x3a, 0x56, 0x31, 0xa8,
-	0xe2, 0x1e, 0x01, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
+	0x52, 0x14, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x46, 0x72, 0x6f, 0x6d, 0x54, 0x72, 0x61, 0x66, 0x66,
+	0x69, 0x63, 0x50, 0x6f, 0x6f, 0x6c, 0x12, 0xcc, 0x01, 0x0a, 0x19, 0x72, 0x65, 0x6c, 0x65, 0x61,
+	0x73, 0x65, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x6d, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x5f,
+	0x70, 0x6f, 0x6f, 0x6c, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x76, 0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f, 0x19, 0x72,
+	0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x6d, 0x69, 0x6e,
+	0x69, 0x6e, 0x67, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0xf2, 0xde, 0x1f, 0x20, 0x79, 0x61, 0x6d, 0x6c,
+	0x3a, 0x22, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f,
+	0x6d, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x22, 0xaa, 0xdf, 0x1f, 0x28,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x16, 0x72,
+	0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x4d, 0x69, 0x6e, 0x69, 0x6e,
+	0x67, 0x50, 0x6f, 0x6f, 0x6c, 0x12, 0xd0, 0x01, 0x0a, 0x1a, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73,
+	0x65, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x5f,
+	0x70, 0x6f, 0x6f, 0x6c, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x78, 0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f, 0x1a, 0x72,
+	0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x74, 0x72, 0x61,
+	0x66, 0x66, 0x69, 0x63, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0xf2, 0xde, 0x1f, 0x21, 0x79, 0x61, 0x6d,
+	0x6c, 0x3a, 0x22, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d,
+	0x5f, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x22, 0xaa, 0xdf,
+	0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52,
+	0x17, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x54, 0x72, 0x61,
+	0x66, 0x66, 0x69, 0x63, 0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x46, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x42, 0x25, 0xea,
+	0xde, 0x1f, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0xf2, 0xde,
+	0x1f, 0x12, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x65, 0x70,
+	0x6f, 0x63, 0x68, 0x22, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68,
+	0x12, 0x52, 0x0a, 0x0e, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x70, 0x6f, 0x63,
+	0x68, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x42, 0x2b, 0xea, 0xde, 0x1f, 0x0e, 0x76, 0x65,
+	0x73, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x73, 0xf2, 0xde, 0x1f, 0x15,
+	0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x70,
+	0x6f, 0x63, 0x68, 0x73, 0x22, 0x52, 0x0d, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x45, 0x70,
+	0x6f, 0x63, 0x68, 0x73, 0x42, 0xa3, 0x01, 0x0a, 0x12, 0x63, 0x6f, 0x6d, 0x2e, 0x73, 0x74, 0x72,
+	0x61, 0x74, 0x6f, 0x73, 0x2e, 0x70, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x42, 0x08, 0x50, 0x6f, 0x74,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x25, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
+	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f,
+	0x73, 0x2f, 0x70, 0x6f, 0x74, 0x2f, 0x76, 0x31, 0x3b, 0x70, 0x6f, 0x74, 0x76, 0x31, 0xa2, 0x02,
+	0x03, 0x53, 0x50, 0x58, 0xaa, 0x02, 0x0e, 0x53, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e, 0x50,
+	0x6f, 0x74, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0e, 0x53, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x5c,
+	0x50, 0x6f, 0x74, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1a, 0x53, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73,
+	0x5c, 0x50, 0x6f, 0x74, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0xea, 0x02, 0x10, 0x53, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x3a, 0x3a, 0x50,
+	0x6f, 0x74, 0x3a, 0x3a, 0x56, 0x31, 0xa8, 0xe2, 0x1e, 0x01, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -6721,34 +15351,59 @@ func file_stratos_pot_v1_pot_proto_rawDescGZIP() []byte {
 	return file_stratos_pot_v1_pot_proto_rawDescData
 }
 
-var file_stratos_pot_v1_pot_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_stratos_pot_v1_pot_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
 var file_stratos_pot_v1_pot_proto_goTypes = []interface{}{
-	(*Params)(nil),             // 0: stratos.pot.v1.Params
-	(*MiningRewardParam)(nil),  // 1: stratos.pot.v1.MiningRewardParam
-	(*Reward)(nil),             // 2: stratos.pot.v1.Reward
-	(*SingleWalletVolume)(nil), // 3: stratos.pot.v1.SingleWalletVolume
-	(*WalletVolumes)(nil),      // 4: stratos.pot.v1.WalletVolumes
-	(*VolumeReportRecord)(nil), // 5: stratos.pot.v1.VolumeReportRecord
-	(*TotalReward)(nil),        // 6: stratos.pot.v1.TotalReward
-	(*Metrics)(nil),            // 7: stratos.pot.v1.Metrics
-	(*v1beta1.Coin)(nil),       // 8: cosmos.base.v1beta1.Coin
+	(*Params)(nil),               // 0: stratos.pot.v1.Params
+	(*MiningRewardParam)(nil),    // 1: stratos.pot.v1.MiningRewardParam
+	(*Reward)(nil),               // 2: stratos.pot.v1.Reward
+	(*SingleWalletVolume)(nil),   // 3: stratos.pot.v1.SingleWalletVolume
+	(*WalletVolumes)(nil),        // 4: stratos.pot.v1.WalletVolumes
+	(*VolumeReportRecord)(nil),   // 5: stratos.pot.v1.VolumeReportRecord
+	(*TotalReward)(nil),          // 6: stratos.pot.v1.TotalReward
+	(*Metrics)(nil),              // 7: stratos.pot.v1.Metrics
+	(*VolumeInclusionProof)(nil), // 8: stratos.pot.v1.VolumeInclusionProof
+	(*PendingVolumeReport)(nil),  // 9: stratos.pot.v1.PendingVolumeReport
+	(*PendingDistribution)(nil),  // 10: stratos.pot.v1.PendingDistribution
+	(*DistributionVolume)(nil),   // 11: stratos.pot.v1.DistributionVolume
+	(*VolumeReportDispute)(nil),  // 12: stratos.pot.v1.VolumeReportDispute
+	(*ReportEquivocation)(nil),   // 13: stratos.pot.v1.ReportEquivocation
+	(*RewardHistory)(nil),        // 14: stratos.pot.v1.RewardHistory
+	(*AutoRestake)(nil),          // 15: stratos.pot.v1.AutoRestake
+	(*VestingSchedule)(nil),      // 16: stratos.pot.v1.VestingSchedule
+	(*v1beta1.Coin)(nil),         // 17: cosmos.base.v1beta1.Coin
 }
 var file_stratos_pot_v1_pot_proto_depIdxs = []int32{
 	1,  // 0: stratos.pot.v1.Params.mining_reward_params:type_name -> stratos.pot.v1.MiningRewardParam
-	8,  // 1: stratos.pot.v1.Params.initial_total_supply:type_name -> cosmos.base.v1beta1.Coin
-	8,  // 2: stratos.pot.v1.MiningRewardParam.total_mined_valve_start:type_name -> cosmos.base.v1beta1.Coin
-	8,  // 3: stratos.pot.v1.MiningRewardParam.total_mined_valve_end:type_name -> cosmos.base.v1beta1.Coin
-	8,  // 4: stratos.pot.v1.MiningRewardParam.mining_reward:type_name -> cosmos.base.v1beta1.Coin
-	8,  // 5: stratos.pot.v1.Reward.reward_from_mining_pool:type_name -> cosmos.base.v1beta1.Coin
-	8,  // 6: stratos.pot.v1.Reward.reward_from_traffic_pool:type_name -> cosmos.base.v1beta1.Coin
+	17, // 1: stratos.pot.v1.Params.initial_total_supply:type_name -> cosmos.base.v1beta1.Coin
+	17, // 2: stratos.pot.v1.MiningRewardParam.total_mined_valve_start:type_name -> cosmos.base.v1beta1.Coin
+	17, // 3: stratos.pot.v1.MiningRewardParam.total_mined_valve_end:type_name -> cosmos.base.v1beta1.Coin
+	17, // 4: stratos.pot.v1.MiningRewardParam.mining_reward:type_name -> cosmos.base.v1beta1.Coin
+	17, // 5: stratos.pot.v1.Reward.reward_from_mining_pool:type_name -> cosmos.base.v1beta1.Coin
+	17, // 6: stratos.pot.v1.Reward.reward_from_traffic_pool:type_name -> cosmos.base.v1beta1.Coin
 	3,  // 7: stratos.pot.v1.WalletVolumes.volumes:type_name -> stratos.pot.v1.SingleWalletVolume
-	8,  // 8: stratos.pot.v1.TotalReward.mining_reward:type_name -> cosmos.base.v1beta1.Coin
-	8,  // 9: stratos.pot.v1.TotalReward.traffic_reward:type_name -> cosmos.base.v1beta1.Coin
-	10, // [10:10] is the sub-list for method output_type
-	10, // [10:10] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	17, // 8: stratos.pot.v1.TotalReward.mining_reward:type_name -> cosmos.base.v1beta1.Coin
+	17, // 9: stratos.pot.v1.TotalReward.traffic_reward:type_name -> cosmos.base.v1beta1.Coin
+	17, // 10: stratos.pot.v1.PendingDistribution.mining_reward_to_resource_node:type_name -> cosmos.base.v1beta1.Coin
+	17, // 11: stratos.pot.v1.PendingDistribution.traffic_reward_to_resource_node:type_name -> cosmos.base.v1beta1.Coin
+	17, // 12: stratos.pot.v1.PendingDistribution.mining_reward_to_meta_node:type_name -> cosmos.base.v1beta1.Coin
+	17, // 13: stratos.pot.v1.PendingDistribution.traffic_reward_to_meta_node:type_name -> cosmos.base.v1beta1.Coin
+	17, // 14: stratos.pot.v1.PendingDistribution.mined_reward:type_name -> cosmos.base.v1beta1.Coin
+	17, // 15: stratos.pot.v1.PendingDistribution.traffic_reward:type_name -> cosmos.base.v1beta1.Coin
+	3,  // 16: stratos.pot.v1.DistributionVolume.volume:type_name -> stratos.pot.v1.SingleWalletVolume
+	3,  // 17: stratos.pot.v1.VolumeReportDispute.wallet_volumes:type_name -> stratos.pot.v1.SingleWalletVolume
+	17, // 18: stratos.pot.v1.ReportEquivocation.slashed:type_name -> cosmos.base.v1beta1.Coin
+	17, // 19: stratos.pot.v1.RewardHistory.reward_from_mining_pool:type_name -> cosmos.base.v1beta1.Coin
+	17, // 20: stratos.pot.v1.RewardHistory.reward_from_traffic_pool:type_name -> cosmos.base.v1beta1.Coin
+	17, // 21: stratos.pot.v1.RewardHistory.slashing_deducted:type_name -> cosmos.base.v1beta1.Coin
+	17, // 22: stratos.pot.v1.VestingSchedule.total_from_mining_pool:type_name -> cosmos.base.v1beta1.Coin
+	17, // 23: stratos.pot.v1.VestingSchedule.total_from_traffic_pool:type_name -> cosmos.base.v1beta1.Coin
+	17, // 24: stratos.pot.v1.VestingSchedule.released_from_mining_pool:type_name -> cosmos.base.v1beta1.Coin
+	17, // 25: stratos.pot.v1.VestingSchedule.released_from_traffic_pool:type_name -> cosmos.base.v1beta1.Coin
+	26, // [26:26] is the sub-list for method output_type
+	26, // [26:26] is the sub-list for method input_type
+	26, // [26:26] is the sub-list for extension type_name
+	26, // [26:26] is the sub-list for extension extendee
+	0,  // [0:26] is the sub-list for field type_name
 }
 
 func init() { file_stratos_pot_v1_pot_proto_init() }
@@ -6853,6 +15508,114 @@ func file_stratos_pot_v1_pot_proto_init() {
 				return nil
 			}
 		}
+		file_stratos_pot_v1_pot_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VolumeInclusionProof); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stratos_pot_v1_pot_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PendingVolumeReport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stratos_pot_v1_pot_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PendingDistribution); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stratos_pot_v1_pot_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DistributionVolume); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stratos_pot_v1_pot_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VolumeReportDispute); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stratos_pot_v1_pot_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReportEquivocation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stratos_pot_v1_pot_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RewardHistory); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stratos_pot_v1_pot_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AutoRestake); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stratos_pot_v1_pot_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VestingSchedule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -6860,7 +15623,7 @@ func file_stratos_pot_v1_pot_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_stratos_pot_v1_pot_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   8,
+			NumMessages:   17,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
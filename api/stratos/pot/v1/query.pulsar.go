@@ -10134,850 +10134,8273 @@ func (x *fastReflection_QueryMetricsResponse) ProtoMethods() *protoiface.Methods
 	}
 }
 
+var (
+	md_QueryPendingVolumeReportRequest protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_stratos_pot_v1_query_proto_init()
+	md_QueryPendingVolumeReportRequest = File_stratos_pot_v1_query_proto.Messages().ByName("QueryPendingVolumeReportRequest")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryPendingVolumeReportRequest)(nil)
+
+type fastReflection_QueryPendingVolumeReportRequest QueryPendingVolumeReportRequest
+
+func (x *QueryPendingVolumeReportRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryPendingVolumeReportRequest)(x)
+}
+
+func (x *QueryPendingVolumeReportRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_stratos_pot_v1_query_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryPendingVolumeReportRequest_messageType fastReflection_QueryPendingVolumeReportRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryPendingVolumeReportRequest_messageType{}
+
+type fastReflection_QueryPendingVolumeReportRequest_messageType struct{}
+
+func (x fastReflection_QueryPendingVolumeReportRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryPendingVolumeReportRequest)(nil)
+}
+func (x fastReflection_QueryPendingVolumeReportRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryPendingVolumeReportRequest)
+}
+func (x fastReflection_QueryPendingVolumeReportRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPendingVolumeReportRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryPendingVolumeReportRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPendingVolumeReportRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryPendingVolumeReportRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryPendingVolumeReportRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryPendingVolumeReportRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryPendingVolumeReportRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryPendingVolumeReportRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryPendingVolumeReportRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryPendingVolumeReportRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryPendingVolumeReportRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.QueryPendingVolumeReportRequest"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.QueryPendingVolumeReportRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryPendingVolumeReportRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.QueryPendingVolumeReportRequest"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.QueryPendingVolumeReportRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryPendingVolumeReportRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.QueryPendingVolumeReportRequest"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.QueryPendingVolumeReportRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryPendingVolumeReportRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.QueryPendingVolumeReportRequest"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.QueryPendingVolumeReportRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryPendingVolumeReportRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.QueryPendingVolumeReportRequest"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.QueryPendingVolumeReportRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryPendingVolumeReportRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.QueryPendingVolumeReportRequest"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.QueryPendingVolumeReportRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryPendingVolumeReportRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in stratos.pot.v1.QueryPendingVolumeReportRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryPendingVolumeReportRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryPendingVolumeReportRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryPendingVolumeReportRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryPendingVolumeReportRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryPendingVolumeReportRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryPendingVolumeReportRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryPendingVolumeReportRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPendingVolumeReportRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPendingVolumeReportRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryPendingVolumeReportResponse        protoreflect.MessageDescriptor
+	fd_QueryPendingVolumeReportResponse_report protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_stratos_pot_v1_query_proto_init()
+	md_QueryPendingVolumeReportResponse = File_stratos_pot_v1_query_proto.Messages().ByName("QueryPendingVolumeReportResponse")
+	fd_QueryPendingVolumeReportResponse_report = md_QueryPendingVolumeReportResponse.Fields().ByName("report")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryPendingVolumeReportResponse)(nil)
+
+type fastReflection_QueryPendingVolumeReportResponse QueryPendingVolumeReportResponse
+
+func (x *QueryPendingVolumeReportResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryPendingVolumeReportResponse)(x)
+}
+
+func (x *QueryPendingVolumeReportResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_stratos_pot_v1_query_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryPendingVolumeReportResponse_messageType fastReflection_QueryPendingVolumeReportResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryPendingVolumeReportResponse_messageType{}
+
+type fastReflection_QueryPendingVolumeReportResponse_messageType struct{}
+
+func (x fastReflection_QueryPendingVolumeReportResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryPendingVolumeReportResponse)(nil)
+}
+func (x fastReflection_QueryPendingVolumeReportResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryPendingVolumeReportResponse)
+}
+func (x fastReflection_QueryPendingVolumeReportResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPendingVolumeReportResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryPendingVolumeReportResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPendingVolumeReportResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryPendingVolumeReportResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryPendingVolumeReportResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryPendingVolumeReportResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryPendingVolumeReportResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryPendingVolumeReportResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryPendingVolumeReportResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryPendingVolumeReportResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Report != nil {
+		value := protoreflect.ValueOfMessage(x.Report.ProtoReflect())
+		if !f(fd_QueryPendingVolumeReportResponse_report, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryPendingVolumeReportResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "stratos.pot.v1.QueryPendingVolumeReportResponse.report":
+		return x.Report != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.QueryPendingVolumeReportResponse"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.QueryPendingVolumeReportResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryPendingVolumeReportResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "stratos.pot.v1.QueryPendingVolumeReportResponse.report":
+		x.Report = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.QueryPendingVolumeReportResponse"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.QueryPendingVolumeReportResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryPendingVolumeReportResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "stratos.pot.v1.QueryPendingVolumeReportResponse.report":
+		value := x.Report
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.QueryPendingVolumeReportResponse"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.QueryPendingVolumeReportResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryPendingVolumeReportResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "stratos.pot.v1.QueryPendingVolumeReportResponse.report":
+		x.Report = value.Message().Interface().(*PendingVolumeReport)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.QueryPendingVolumeReportResponse"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.QueryPendingVolumeReportResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryPendingVolumeReportResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "stratos.pot.v1.QueryPendingVolumeReportResponse.report":
+		if x.Report == nil {
+			x.Report = new(PendingVolumeReport)
+		}
+		return protoreflect.ValueOfMessage(x.Report.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.QueryPendingVolumeReportResponse"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.QueryPendingVolumeReportResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryPendingVolumeReportResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "stratos.pot.v1.QueryPendingVolumeReportResponse.report":
+		m := new(PendingVolumeReport)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.QueryPendingVolumeReportResponse"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.QueryPendingVolumeReportResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryPendingVolumeReportResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in stratos.pot.v1.QueryPendingVolumeReportResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryPendingVolumeReportResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryPendingVolumeReportResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryPendingVolumeReportResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryPendingVolumeReportResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryPendingVolumeReportResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Report != nil {
+			l = options.Size(x.Report)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryPendingVolumeReportResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Report != nil {
+			encoded, err := options.Marshal(x.Report)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryPendingVolumeReportResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPendingVolumeReportResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPendingVolumeReportResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Report", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Report == nil {
+					x.Report = &PendingVolumeReport{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Report); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryVolumeReportDisputeRequest       protoreflect.MessageDescriptor
+	fd_QueryVolumeReportDisputeRequest_epoch protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_stratos_pot_v1_query_proto_init()
+	md_QueryVolumeReportDisputeRequest = File_stratos_pot_v1_query_proto.Messages().ByName("QueryVolumeReportDisputeRequest")
+	fd_QueryVolumeReportDisputeRequest_epoch = md_QueryVolumeReportDisputeRequest.Fields().ByName("epoch")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryVolumeReportDisputeRequest)(nil)
+
+type fastReflection_QueryVolumeReportDisputeRequest QueryVolumeReportDisputeRequest
+
+func (x *QueryVolumeReportDisputeRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryVolumeReportDisputeRequest)(x)
+}
+
+func (x *QueryVolumeReportDisputeRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_stratos_pot_v1_query_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryVolumeReportDisputeRequest_messageType fastReflection_QueryVolumeReportDisputeRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryVolumeReportDisputeRequest_messageType{}
+
+type fastReflection_QueryVolumeReportDisputeRequest_messageType struct{}
+
+func (x fastReflection_QueryVolumeReportDisputeRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryVolumeReportDisputeRequest)(nil)
+}
+func (x fastReflection_QueryVolumeReportDisputeRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryVolumeReportDisputeRequest)
+}
+func (x fastReflection_QueryVolumeReportDisputeRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryVolumeReportDisputeRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryVolumeReportDisputeRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryVolumeReportDisputeRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryVolumeReportDisputeRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryVolumeReportDisputeRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryVolumeReportDisputeRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryVolumeReportDisputeRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryVolumeReportDisputeRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryVolumeReportDisputeRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryVolumeReportDisputeRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Epoch != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Epoch)
+		if !f(fd_QueryVolumeReportDisputeRequest_epoch, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryVolumeReportDisputeRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "stratos.pot.v1.QueryVolumeReportDisputeRequest.epoch":
+		return x.Epoch != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.QueryVolumeReportDisputeRequest"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.QueryVolumeReportDisputeRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryVolumeReportDisputeRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "stratos.pot.v1.QueryVolumeReportDisputeRequest.epoch":
+		x.Epoch = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.QueryVolumeReportDisputeRequest"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.QueryVolumeReportDisputeRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryVolumeReportDisputeRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "stratos.pot.v1.QueryVolumeReportDisputeRequest.epoch":
+		value := x.Epoch
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.QueryVolumeReportDisputeRequest"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.QueryVolumeReportDisputeRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryVolumeReportDisputeRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "stratos.pot.v1.QueryVolumeReportDisputeRequest.epoch":
+		x.Epoch = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.QueryVolumeReportDisputeRequest"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.QueryVolumeReportDisputeRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryVolumeReportDisputeRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "stratos.pot.v1.QueryVolumeReportDisputeRequest.epoch":
+		panic(fmt.Errorf("field epoch of message stratos.pot.v1.QueryVolumeReportDisputeRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.QueryVolumeReportDisputeRequest"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.QueryVolumeReportDisputeRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryVolumeReportDisputeRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "stratos.pot.v1.QueryVolumeReportDisputeRequest.epoch":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.QueryVolumeReportDisputeRequest"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.QueryVolumeReportDisputeRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryVolumeReportDisputeRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in stratos.pot.v1.QueryVolumeReportDisputeRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryVolumeReportDisputeRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryVolumeReportDisputeRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryVolumeReportDisputeRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryVolumeReportDisputeRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryVolumeReportDisputeRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Epoch != 0 {
+			n += 1 + runtime.Sov(uint64(x.Epoch))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryVolumeReportDisputeRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Epoch != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Epoch))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryVolumeReportDisputeRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryVolumeReportDisputeRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryVolumeReportDisputeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+				}
+				x.Epoch = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Epoch |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryVolumeReportDisputeResponse         protoreflect.MessageDescriptor
+	fd_QueryVolumeReportDisputeResponse_dispute protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_stratos_pot_v1_query_proto_init()
+	md_QueryVolumeReportDisputeResponse = File_stratos_pot_v1_query_proto.Messages().ByName("QueryVolumeReportDisputeResponse")
+	fd_QueryVolumeReportDisputeResponse_dispute = md_QueryVolumeReportDisputeResponse.Fields().ByName("dispute")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryVolumeReportDisputeResponse)(nil)
+
+type fastReflection_QueryVolumeReportDisputeResponse QueryVolumeReportDisputeResponse
+
+func (x *QueryVolumeReportDisputeResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryVolumeReportDisputeResponse)(x)
+}
+
+func (x *QueryVolumeReportDisputeResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_stratos_pot_v1_query_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryVolumeReportDisputeResponse_messageType fastReflection_QueryVolumeReportDisputeResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryVolumeReportDisputeResponse_messageType{}
+
+type fastReflection_QueryVolumeReportDisputeResponse_messageType struct{}
+
+func (x fastReflection_QueryVolumeReportDisputeResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryVolumeReportDisputeResponse)(nil)
+}
+func (x fastReflection_QueryVolumeReportDisputeResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryVolumeReportDisputeResponse)
+}
+func (x fastReflection_QueryVolumeReportDisputeResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryVolumeReportDisputeResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryVolumeReportDisputeResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryVolumeReportDisputeResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryVolumeReportDisputeResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryVolumeReportDisputeResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryVolumeReportDisputeResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryVolumeReportDisputeResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryVolumeReportDisputeResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryVolumeReportDisputeResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryVolumeReportDisputeResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Dispute != nil {
+		value := protoreflect.ValueOfMessage(x.Dispute.ProtoReflect())
+		if !f(fd_QueryVolumeReportDisputeResponse_dispute, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryVolumeReportDisputeResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "stratos.pot.v1.QueryVolumeReportDisputeResponse.dispute":
+		return x.Dispute != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.QueryVolumeReportDisputeResponse"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.QueryVolumeReportDisputeResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryVolumeReportDisputeResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "stratos.pot.v1.QueryVolumeReportDisputeResponse.dispute":
+		x.Dispute = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.QueryVolumeReportDisputeResponse"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.QueryVolumeReportDisputeResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryVolumeReportDisputeResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "stratos.pot.v1.QueryVolumeReportDisputeResponse.dispute":
+		value := x.Dispute
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.QueryVolumeReportDisputeResponse"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.QueryVolumeReportDisputeResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryVolumeReportDisputeResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "stratos.pot.v1.QueryVolumeReportDisputeResponse.dispute":
+		x.Dispute = value.Message().Interface().(*VolumeReportDispute)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.QueryVolumeReportDisputeResponse"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.QueryVolumeReportDisputeResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryVolumeReportDisputeResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "stratos.pot.v1.QueryVolumeReportDisputeResponse.dispute":
+		if x.Dispute == nil {
+			x.Dispute = new(VolumeReportDispute)
+		}
+		return protoreflect.ValueOfMessage(x.Dispute.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.QueryVolumeReportDisputeResponse"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.QueryVolumeReportDisputeResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryVolumeReportDisputeResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "stratos.pot.v1.QueryVolumeReportDisputeResponse.dispute":
+		m := new(VolumeReportDispute)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.QueryVolumeReportDisputeResponse"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.QueryVolumeReportDisputeResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryVolumeReportDisputeResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in stratos.pot.v1.QueryVolumeReportDisputeResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryVolumeReportDisputeResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryVolumeReportDisputeResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryVolumeReportDisputeResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryVolumeReportDisputeResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryVolumeReportDisputeResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Dispute != nil {
+			l = options.Size(x.Dispute)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryVolumeReportDisputeResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Dispute != nil {
+			encoded, err := options.Marshal(x.Dispute)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryVolumeReportDisputeResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryVolumeReportDisputeResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryVolumeReportDisputeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Dispute", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Dispute == nil {
+					x.Dispute = &VolumeReportDispute{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Dispute); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryDistributionProgressRequest protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_stratos_pot_v1_query_proto_init()
+	md_QueryDistributionProgressRequest = File_stratos_pot_v1_query_proto.Messages().ByName("QueryDistributionProgressRequest")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryDistributionProgressRequest)(nil)
+
+type fastReflection_QueryDistributionProgressRequest QueryDistributionProgressRequest
+
+func (x *QueryDistributionProgressRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryDistributionProgressRequest)(x)
+}
+
+func (x *QueryDistributionProgressRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_stratos_pot_v1_query_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryDistributionProgressRequest_messageType fastReflection_QueryDistributionProgressRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryDistributionProgressRequest_messageType{}
+
+type fastReflection_QueryDistributionProgressRequest_messageType struct{}
+
+func (x fastReflection_QueryDistributionProgressRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryDistributionProgressRequest)(nil)
+}
+func (x fastReflection_QueryDistributionProgressRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryDistributionProgressRequest)
+}
+func (x fastReflection_QueryDistributionProgressRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryDistributionProgressRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryDistributionProgressRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryDistributionProgressRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryDistributionProgressRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryDistributionProgressRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryDistributionProgressRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryDistributionProgressRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryDistributionProgressRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryDistributionProgressRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryDistributionProgressRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryDistributionProgressRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.QueryDistributionProgressRequest"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.QueryDistributionProgressRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDistributionProgressRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.QueryDistributionProgressRequest"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.QueryDistributionProgressRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryDistributionProgressRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.QueryDistributionProgressRequest"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.QueryDistributionProgressRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDistributionProgressRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.QueryDistributionProgressRequest"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.QueryDistributionProgressRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDistributionProgressRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.QueryDistributionProgressRequest"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.QueryDistributionProgressRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryDistributionProgressRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.QueryDistributionProgressRequest"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.QueryDistributionProgressRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryDistributionProgressRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in stratos.pot.v1.QueryDistributionProgressRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryDistributionProgressRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDistributionProgressRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryDistributionProgressRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryDistributionProgressRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryDistributionProgressRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryDistributionProgressRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryDistributionProgressRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryDistributionProgressRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryDistributionProgressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryDistributionProgressResponse_2_list)(nil)
+
+type _QueryDistributionProgressResponse_2_list struct {
+	list *[]*PendingDistribution
+}
+
+func (x *_QueryDistributionProgressResponse_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryDistributionProgressResponse_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryDistributionProgressResponse_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PendingDistribution)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryDistributionProgressResponse_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PendingDistribution)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryDistributionProgressResponse_2_list) AppendMutable() protoreflect.Value {
+	v := new(PendingDistribution)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryDistributionProgressResponse_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryDistributionProgressResponse_2_list) NewElement() protoreflect.Value {
+	v := new(PendingDistribution)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryDistributionProgressResponse_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryDistributionProgressResponse                        protoreflect.MessageDescriptor
+	fd_QueryDistributionProgressResponse_last_distributed_epoch protoreflect.FieldDescriptor
+	fd_QueryDistributionProgressResponse_distributions          protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_stratos_pot_v1_query_proto_init()
+	md_QueryDistributionProgressResponse = File_stratos_pot_v1_query_proto.Messages().ByName("QueryDistributionProgressResponse")
+	fd_QueryDistributionProgressResponse_last_distributed_epoch = md_QueryDistributionProgressResponse.Fields().ByName("last_distributed_epoch")
+	fd_QueryDistributionProgressResponse_distributions = md_QueryDistributionProgressResponse.Fields().ByName("distributions")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryDistributionProgressResponse)(nil)
+
+type fastReflection_QueryDistributionProgressResponse QueryDistributionProgressResponse
+
+func (x *QueryDistributionProgressResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryDistributionProgressResponse)(x)
+}
+
+func (x *QueryDistributionProgressResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_stratos_pot_v1_query_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryDistributionProgressResponse_messageType fastReflection_QueryDistributionProgressResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryDistributionProgressResponse_messageType{}
+
+type fastReflection_QueryDistributionProgressResponse_messageType struct{}
+
+func (x fastReflection_QueryDistributionProgressResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryDistributionProgressResponse)(nil)
+}
+func (x fastReflection_QueryDistributionProgressResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryDistributionProgressResponse)
+}
+func (x fastReflection_QueryDistributionProgressResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryDistributionProgressResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryDistributionProgressResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryDistributionProgressResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryDistributionProgressResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryDistributionProgressResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryDistributionProgressResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryDistributionProgressResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryDistributionProgressResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryDistributionProgressResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryDistributionProgressResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.LastDistributedEpoch != "" {
+		value := protoreflect.ValueOfString(x.LastDistributedEpoch)
+		if !f(fd_QueryDistributionProgressResponse_last_distributed_epoch, value) {
+			return
+		}
+	}
+	if len(x.Distributions) != 0 {
+		value := protoreflect.ValueOfList(&_QueryDistributionProgressResponse_2_list{list: &x.Distributions})
+		if !f(fd_QueryDistributionProgressResponse_distributions, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryDistributionProgressResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "stratos.pot.v1.QueryDistributionProgressResponse.last_distributed_epoch":
+		return x.LastDistributedEpoch != ""
+	case "stratos.pot.v1.QueryDistributionProgressResponse.distributions":
+		return len(x.Distributions) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.QueryDistributionProgressResponse"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.QueryDistributionProgressResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDistributionProgressResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "stratos.pot.v1.QueryDistributionProgressResponse.last_distributed_epoch":
+		x.LastDistributedEpoch = ""
+	case "stratos.pot.v1.QueryDistributionProgressResponse.distributions":
+		x.Distributions = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.QueryDistributionProgressResponse"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.QueryDistributionProgressResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryDistributionProgressResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "stratos.pot.v1.QueryDistributionProgressResponse.last_distributed_epoch":
+		value := x.LastDistributedEpoch
+		return protoreflect.ValueOfString(value)
+	case "stratos.pot.v1.QueryDistributionProgressResponse.distributions":
+		if len(x.Distributions) == 0 {
+			return protoreflect.ValueOfList(&_QueryDistributionProgressResponse_2_list{})
+		}
+		listValue := &_QueryDistributionProgressResponse_2_list{list: &x.Distributions}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.QueryDistributionProgressResponse"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.QueryDistributionProgressResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDistributionProgressResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "stratos.pot.v1.QueryDistributionProgressResponse.last_distributed_epoch":
+		x.LastDistributedEpoch = value.Interface().(string)
+	case "stratos.pot.v1.QueryDistributionProgressResponse.distributions":
+		lv := value.List()
+		clv := lv.(*_QueryDistributionProgressResponse_2_list)
+		x.Distributions = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.QueryDistributionProgressResponse"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.QueryDistributionProgressResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDistributionProgressResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "stratos.pot.v1.QueryDistributionProgressResponse.distributions":
+		if x.Distributions == nil {
+			x.Distributions = []*PendingDistribution{}
+		}
+		value := &_QueryDistributionProgressResponse_2_list{list: &x.Distributions}
+		return protoreflect.ValueOfList(value)
+	case "stratos.pot.v1.QueryDistributionProgressResponse.last_distributed_epoch":
+		panic(fmt.Errorf("field last_distributed_epoch of message stratos.pot.v1.QueryDistributionProgressResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.QueryDistributionProgressResponse"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.QueryDistributionProgressResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryDistributionProgressResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "stratos.pot.v1.QueryDistributionProgressResponse.last_distributed_epoch":
+		return protoreflect.ValueOfString("")
+	case "stratos.pot.v1.QueryDistributionProgressResponse.distributions":
+		list := []*PendingDistribution{}
+		return protoreflect.ValueOfList(&_QueryDistributionProgressResponse_2_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.QueryDistributionProgressResponse"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.QueryDistributionProgressResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryDistributionProgressResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in stratos.pot.v1.QueryDistributionProgressResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryDistributionProgressResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryDistributionProgressResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryDistributionProgressResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryDistributionProgressResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryDistributionProgressResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.LastDistributedEpoch)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Distributions) > 0 {
+			for _, e := range x.Distributions {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryDistributionProgressResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Distributions) > 0 {
+			for iNdEx := len(x.Distributions) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Distributions[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if len(x.LastDistributedEpoch) > 0 {
+			i -= len(x.LastDistributedEpoch)
+			copy(dAtA[i:], x.LastDistributedEpoch)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LastDistributedEpoch)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryDistributionProgressResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryDistributionProgressResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryDistributionProgressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastDistributedEpoch", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LastDistributedEpoch = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Distributions", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Distributions = append(x.Distributions, &PendingDistribution{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Distributions[len(x.Distributions)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryVestingScheduleRequest                protoreflect.MessageDescriptor
+	fd_QueryVestingScheduleRequest_wallet_address protoreflect.FieldDescriptor
+	fd_QueryVestingScheduleRequest_pagination     protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_stratos_pot_v1_query_proto_init()
+	md_QueryVestingScheduleRequest = File_stratos_pot_v1_query_proto.Messages().ByName("QueryVestingScheduleRequest")
+	fd_QueryVestingScheduleRequest_wallet_address = md_QueryVestingScheduleRequest.Fields().ByName("wallet_address")
+	fd_QueryVestingScheduleRequest_pagination = md_QueryVestingScheduleRequest.Fields().ByName("pagination")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryVestingScheduleRequest)(nil)
+
+type fastReflection_QueryVestingScheduleRequest QueryVestingScheduleRequest
+
+func (x *QueryVestingScheduleRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryVestingScheduleRequest)(x)
+}
+
+func (x *QueryVestingScheduleRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_stratos_pot_v1_query_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryVestingScheduleRequest_messageType fastReflection_QueryVestingScheduleRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryVestingScheduleRequest_messageType{}
+
+type fastReflection_QueryVestingScheduleRequest_messageType struct{}
+
+func (x fastReflection_QueryVestingScheduleRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryVestingScheduleRequest)(nil)
+}
+func (x fastReflection_QueryVestingScheduleRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryVestingScheduleRequest)
+}
+func (x fastReflection_QueryVestingScheduleRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryVestingScheduleRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryVestingScheduleRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryVestingScheduleRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryVestingScheduleRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryVestingScheduleRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryVestingScheduleRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryVestingScheduleRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryVestingScheduleRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryVestingScheduleRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryVestingScheduleRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.WalletAddress != "" {
+		value := protoreflect.ValueOfString(x.WalletAddress)
+		if !f(fd_QueryVestingScheduleRequest_wallet_address, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryVestingScheduleRequest_pagination, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryVestingScheduleRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "stratos.pot.v1.QueryVestingScheduleRequest.wallet_address":
+		return x.WalletAddress != ""
+	case "stratos.pot.v1.QueryVestingScheduleRequest.pagination":
+		return x.Pagination != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.QueryVestingScheduleRequest"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.QueryVestingScheduleRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryVestingScheduleRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "stratos.pot.v1.QueryVestingScheduleRequest.wallet_address":
+		x.WalletAddress = ""
+	case "stratos.pot.v1.QueryVestingScheduleRequest.pagination":
+		x.Pagination = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.QueryVestingScheduleRequest"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.QueryVestingScheduleRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryVestingScheduleRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "stratos.pot.v1.QueryVestingScheduleRequest.wallet_address":
+		value := x.WalletAddress
+		return protoreflect.ValueOfString(value)
+	case "stratos.pot.v1.QueryVestingScheduleRequest.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.QueryVestingScheduleRequest"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.QueryVestingScheduleRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryVestingScheduleRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "stratos.pot.v1.QueryVestingScheduleRequest.wallet_address":
+		x.WalletAddress = value.Interface().(string)
+	case "stratos.pot.v1.QueryVestingScheduleRequest.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.QueryVestingScheduleRequest"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.QueryVestingScheduleRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryVestingScheduleRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "stratos.pot.v1.QueryVestingScheduleRequest.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageRequest)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	case "stratos.pot.v1.QueryVestingScheduleRequest.wallet_address":
+		panic(fmt.Errorf("field wallet_address of message stratos.pot.v1.QueryVestingScheduleRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.QueryVestingScheduleRequest"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.QueryVestingScheduleRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryVestingScheduleRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "stratos.pot.v1.QueryVestingScheduleRequest.wallet_address":
+		return protoreflect.ValueOfString("")
+	case "stratos.pot.v1.QueryVestingScheduleRequest.pagination":
+		m := new(v1beta1.PageRequest)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.QueryVestingScheduleRequest"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.QueryVestingScheduleRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryVestingScheduleRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in stratos.pot.v1.QueryVestingScheduleRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryVestingScheduleRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryVestingScheduleRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryVestingScheduleRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryVestingScheduleRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryVestingScheduleRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.WalletAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryVestingScheduleRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.WalletAddress) > 0 {
+			i -= len(x.WalletAddress)
+			copy(dAtA[i:], x.WalletAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.WalletAddress)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryVestingScheduleRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryVestingScheduleRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryVestingScheduleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WalletAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.WalletAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageRequest{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryVestingScheduleResponse_4_list)(nil)
+
+type _QueryVestingScheduleResponse_4_list struct {
+	list *[]*VestingScheduleEntry
+}
+
+func (x *_QueryVestingScheduleResponse_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryVestingScheduleResponse_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryVestingScheduleResponse_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*VestingScheduleEntry)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryVestingScheduleResponse_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*VestingScheduleEntry)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryVestingScheduleResponse_4_list) AppendMutable() protoreflect.Value {
+	v := new(VestingScheduleEntry)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryVestingScheduleResponse_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryVestingScheduleResponse_4_list) NewElement() protoreflect.Value {
+	v := new(VestingScheduleEntry)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryVestingScheduleResponse_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_QueryVestingScheduleResponse_5_list)(nil)
+
+type _QueryVestingScheduleResponse_5_list struct {
+	list *[]*v1beta11.Coin
+}
+
+func (x *_QueryVestingScheduleResponse_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryVestingScheduleResponse_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryVestingScheduleResponse_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta11.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryVestingScheduleResponse_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta11.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryVestingScheduleResponse_5_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta11.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryVestingScheduleResponse_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryVestingScheduleResponse_5_list) NewElement() protoreflect.Value {
+	v := new(v1beta11.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryVestingScheduleResponse_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_QueryVestingScheduleResponse_6_list)(nil)
+
+type _QueryVestingScheduleResponse_6_list struct {
+	list *[]*v1beta11.Coin
+}
+
+func (x *_QueryVestingScheduleResponse_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryVestingScheduleResponse_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryVestingScheduleResponse_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta11.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryVestingScheduleResponse_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta11.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryVestingScheduleResponse_6_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta11.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryVestingScheduleResponse_6_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryVestingScheduleResponse_6_list) NewElement() protoreflect.Value {
+	v := new(v1beta11.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryVestingScheduleResponse_6_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryVestingScheduleResponse                       protoreflect.MessageDescriptor
+	fd_QueryVestingScheduleResponse_wallet_address        protoreflect.FieldDescriptor
+	fd_QueryVestingScheduleResponse_vesting_mode          protoreflect.FieldDescriptor
+	fd_QueryVestingScheduleResponse_matured_epoch         protoreflect.FieldDescriptor
+	fd_QueryVestingScheduleResponse_entries               protoreflect.FieldDescriptor
+	fd_QueryVestingScheduleResponse_mature_total_reward   protoreflect.FieldDescriptor
+	fd_QueryVestingScheduleResponse_immature_total_reward protoreflect.FieldDescriptor
+	fd_QueryVestingScheduleResponse_pagination            protoreflect.FieldDescriptor
+	fd_QueryVestingScheduleResponse_linear_vesting        protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_stratos_pot_v1_query_proto_init()
+	md_QueryVestingScheduleResponse = File_stratos_pot_v1_query_proto.Messages().ByName("QueryVestingScheduleResponse")
+	fd_QueryVestingScheduleResponse_wallet_address = md_QueryVestingScheduleResponse.Fields().ByName("wallet_address")
+	fd_QueryVestingScheduleResponse_vesting_mode = md_QueryVestingScheduleResponse.Fields().ByName("vesting_mode")
+	fd_QueryVestingScheduleResponse_matured_epoch = md_QueryVestingScheduleResponse.Fields().ByName("matured_epoch")
+	fd_QueryVestingScheduleResponse_entries = md_QueryVestingScheduleResponse.Fields().ByName("entries")
+	fd_QueryVestingScheduleResponse_mature_total_reward = md_QueryVestingScheduleResponse.Fields().ByName("mature_total_reward")
+	fd_QueryVestingScheduleResponse_immature_total_reward = md_QueryVestingScheduleResponse.Fields().ByName("immature_total_reward")
+	fd_QueryVestingScheduleResponse_pagination = md_QueryVestingScheduleResponse.Fields().ByName("pagination")
+	fd_QueryVestingScheduleResponse_linear_vesting = md_QueryVestingScheduleResponse.Fields().ByName("linear_vesting")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryVestingScheduleResponse)(nil)
+
+type fastReflection_QueryVestingScheduleResponse QueryVestingScheduleResponse
+
+func (x *QueryVestingScheduleResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryVestingScheduleResponse)(x)
+}
+
+func (x *QueryVestingScheduleResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_stratos_pot_v1_query_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryVestingScheduleResponse_messageType fastReflection_QueryVestingScheduleResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryVestingScheduleResponse_messageType{}
+
+type fastReflection_QueryVestingScheduleResponse_messageType struct{}
+
+func (x fastReflection_QueryVestingScheduleResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryVestingScheduleResponse)(nil)
+}
+func (x fastReflection_QueryVestingScheduleResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryVestingScheduleResponse)
+}
+func (x fastReflection_QueryVestingScheduleResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryVestingScheduleResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryVestingScheduleResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryVestingScheduleResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryVestingScheduleResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryVestingScheduleResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryVestingScheduleResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryVestingScheduleResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryVestingScheduleResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryVestingScheduleResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryVestingScheduleResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.WalletAddress != "" {
+		value := protoreflect.ValueOfString(x.WalletAddress)
+		if !f(fd_QueryVestingScheduleResponse_wallet_address, value) {
+			return
+		}
+	}
+	if x.VestingMode != "" {
+		value := protoreflect.ValueOfString(x.VestingMode)
+		if !f(fd_QueryVestingScheduleResponse_vesting_mode, value) {
+			return
+		}
+	}
+	if x.MaturedEpoch != int64(0) {
+		value := protoreflect.ValueOfInt64(x.MaturedEpoch)
+		if !f(fd_QueryVestingScheduleResponse_matured_epoch, value) {
+			return
+		}
+	}
+	if len(x.Entries) != 0 {
+		value := protoreflect.ValueOfList(&_QueryVestingScheduleResponse_4_list{list: &x.Entries})
+		if !f(fd_QueryVestingScheduleResponse_entries, value) {
+			return
+		}
+	}
+	if len(x.MatureTotalReward) != 0 {
+		value := protoreflect.ValueOfList(&_QueryVestingScheduleResponse_5_list{list: &x.MatureTotalReward})
+		if !f(fd_QueryVestingScheduleResponse_mature_total_reward, value) {
+			return
+		}
+	}
+	if len(x.ImmatureTotalReward) != 0 {
+		value := protoreflect.ValueOfList(&_QueryVestingScheduleResponse_6_list{list: &x.ImmatureTotalReward})
+		if !f(fd_QueryVestingScheduleResponse_immature_total_reward, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryVestingScheduleResponse_pagination, value) {
+			return
+		}
+	}
+	if x.LinearVesting != nil {
+		value := protoreflect.ValueOfMessage(x.LinearVesting.ProtoReflect())
+		if !f(fd_QueryVestingScheduleResponse_linear_vesting, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryVestingScheduleResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "stratos.pot.v1.QueryVestingScheduleResponse.wallet_address":
+		return x.WalletAddress != ""
+	case "stratos.pot.v1.QueryVestingScheduleResponse.vesting_mode":
+		return x.VestingMode != ""
+	case "stratos.pot.v1.QueryVestingScheduleResponse.matured_epoch":
+		return x.MaturedEpoch != int64(0)
+	case "stratos.pot.v1.QueryVestingScheduleResponse.entries":
+		return len(x.Entries) != 0
+	case "stratos.pot.v1.QueryVestingScheduleResponse.mature_total_reward":
+		return len(x.MatureTotalReward) != 0
+	case "stratos.pot.v1.QueryVestingScheduleResponse.immature_total_reward":
+		return len(x.ImmatureTotalReward) != 0
+	case "stratos.pot.v1.QueryVestingScheduleResponse.pagination":
+		return x.Pagination != nil
+	case "stratos.pot.v1.QueryVestingScheduleResponse.linear_vesting":
+		return x.LinearVesting != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.QueryVestingScheduleResponse"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.QueryVestingScheduleResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryVestingScheduleResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "stratos.pot.v1.QueryVestingScheduleResponse.wallet_address":
+		x.WalletAddress = ""
+	case "stratos.pot.v1.QueryVestingScheduleResponse.vesting_mode":
+		x.VestingMode = ""
+	case "stratos.pot.v1.QueryVestingScheduleResponse.matured_epoch":
+		x.MaturedEpoch = int64(0)
+	case "stratos.pot.v1.QueryVestingScheduleResponse.entries":
+		x.Entries = nil
+	case "stratos.pot.v1.QueryVestingScheduleResponse.mature_total_reward":
+		x.MatureTotalReward = nil
+	case "stratos.pot.v1.QueryVestingScheduleResponse.immature_total_reward":
+		x.ImmatureTotalReward = nil
+	case "stratos.pot.v1.QueryVestingScheduleResponse.pagination":
+		x.Pagination = nil
+	case "stratos.pot.v1.QueryVestingScheduleResponse.linear_vesting":
+		x.LinearVesting = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.QueryVestingScheduleResponse"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.QueryVestingScheduleResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryVestingScheduleResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "stratos.pot.v1.QueryVestingScheduleResponse.wallet_address":
+		value := x.WalletAddress
+		return protoreflect.ValueOfString(value)
+	case "stratos.pot.v1.QueryVestingScheduleResponse.vesting_mode":
+		value := x.VestingMode
+		return protoreflect.ValueOfString(value)
+	case "stratos.pot.v1.QueryVestingScheduleResponse.matured_epoch":
+		value := x.MaturedEpoch
+		return protoreflect.ValueOfInt64(value)
+	case "stratos.pot.v1.QueryVestingScheduleResponse.entries":
+		if len(x.Entries) == 0 {
+			return protoreflect.ValueOfList(&_QueryVestingScheduleResponse_4_list{})
+		}
+		listValue := &_QueryVestingScheduleResponse_4_list{list: &x.Entries}
+		return protoreflect.ValueOfList(listValue)
+	case "stratos.pot.v1.QueryVestingScheduleResponse.mature_total_reward":
+		if len(x.MatureTotalReward) == 0 {
+			return protoreflect.ValueOfList(&_QueryVestingScheduleResponse_5_list{})
+		}
+		listValue := &_QueryVestingScheduleResponse_5_list{list: &x.MatureTotalReward}
+		return protoreflect.ValueOfList(listValue)
+	case "stratos.pot.v1.QueryVestingScheduleResponse.immature_total_reward":
+		if len(x.ImmatureTotalReward) == 0 {
+			return protoreflect.ValueOfList(&_QueryVestingScheduleResponse_6_list{})
+		}
+		listValue := &_QueryVestingScheduleResponse_6_list{list: &x.ImmatureTotalReward}
+		return protoreflect.ValueOfList(listValue)
+	case "stratos.pot.v1.QueryVestingScheduleResponse.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "stratos.pot.v1.QueryVestingScheduleResponse.linear_vesting":
+		value := x.LinearVesting
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.QueryVestingScheduleResponse"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.QueryVestingScheduleResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryVestingScheduleResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "stratos.pot.v1.QueryVestingScheduleResponse.wallet_address":
+		x.WalletAddress = value.Interface().(string)
+	case "stratos.pot.v1.QueryVestingScheduleResponse.vesting_mode":
+		x.VestingMode = value.Interface().(string)
+	case "stratos.pot.v1.QueryVestingScheduleResponse.matured_epoch":
+		x.MaturedEpoch = value.Int()
+	case "stratos.pot.v1.QueryVestingScheduleResponse.entries":
+		lv := value.List()
+		clv := lv.(*_QueryVestingScheduleResponse_4_list)
+		x.Entries = *clv.list
+	case "stratos.pot.v1.QueryVestingScheduleResponse.mature_total_reward":
+		lv := value.List()
+		clv := lv.(*_QueryVestingScheduleResponse_5_list)
+		x.MatureTotalReward = *clv.list
+	case "stratos.pot.v1.QueryVestingScheduleResponse.immature_total_reward":
+		lv := value.List()
+		clv := lv.(*_QueryVestingScheduleResponse_6_list)
+		x.ImmatureTotalReward = *clv.list
+	case "stratos.pot.v1.QueryVestingScheduleResponse.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
+	case "stratos.pot.v1.QueryVestingScheduleResponse.linear_vesting":
+		x.LinearVesting = value.Message().Interface().(*VestingSchedule)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.QueryVestingScheduleResponse"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.QueryVestingScheduleResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryVestingScheduleResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "stratos.pot.v1.QueryVestingScheduleResponse.entries":
+		if x.Entries == nil {
+			x.Entries = []*VestingScheduleEntry{}
+		}
+		value := &_QueryVestingScheduleResponse_4_list{list: &x.Entries}
+		return protoreflect.ValueOfList(value)
+	case "stratos.pot.v1.QueryVestingScheduleResponse.mature_total_reward":
+		if x.MatureTotalReward == nil {
+			x.MatureTotalReward = []*v1beta11.Coin{}
+		}
+		value := &_QueryVestingScheduleResponse_5_list{list: &x.MatureTotalReward}
+		return protoreflect.ValueOfList(value)
+	case "stratos.pot.v1.QueryVestingScheduleResponse.immature_total_reward":
+		if x.ImmatureTotalReward == nil {
+			x.ImmatureTotalReward = []*v1beta11.Coin{}
+		}
+		value := &_QueryVestingScheduleResponse_6_list{list: &x.ImmatureTotalReward}
+		return protoreflect.ValueOfList(value)
+	case "stratos.pot.v1.QueryVestingScheduleResponse.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageResponse)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	case "stratos.pot.v1.QueryVestingScheduleResponse.linear_vesting":
+		if x.LinearVesting == nil {
+			x.LinearVesting = new(VestingSchedule)
+		}
+		return protoreflect.ValueOfMessage(x.LinearVesting.ProtoReflect())
+	case "stratos.pot.v1.QueryVestingScheduleResponse.wallet_address":
+		panic(fmt.Errorf("field wallet_address of message stratos.pot.v1.QueryVestingScheduleResponse is not mutable"))
+	case "stratos.pot.v1.QueryVestingScheduleResponse.vesting_mode":
+		panic(fmt.Errorf("field vesting_mode of message stratos.pot.v1.QueryVestingScheduleResponse is not mutable"))
+	case "stratos.pot.v1.QueryVestingScheduleResponse.matured_epoch":
+		panic(fmt.Errorf("field matured_epoch of message stratos.pot.v1.QueryVestingScheduleResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.QueryVestingScheduleResponse"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.QueryVestingScheduleResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryVestingScheduleResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "stratos.pot.v1.QueryVestingScheduleResponse.wallet_address":
+		return protoreflect.ValueOfString("")
+	case "stratos.pot.v1.QueryVestingScheduleResponse.vesting_mode":
+		return protoreflect.ValueOfString("")
+	case "stratos.pot.v1.QueryVestingScheduleResponse.matured_epoch":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "stratos.pot.v1.QueryVestingScheduleResponse.entries":
+		list := []*VestingScheduleEntry{}
+		return protoreflect.ValueOfList(&_QueryVestingScheduleResponse_4_list{list: &list})
+	case "stratos.pot.v1.QueryVestingScheduleResponse.mature_total_reward":
+		list := []*v1beta11.Coin{}
+		return protoreflect.ValueOfList(&_QueryVestingScheduleResponse_5_list{list: &list})
+	case "stratos.pot.v1.QueryVestingScheduleResponse.immature_total_reward":
+		list := []*v1beta11.Coin{}
+		return protoreflect.ValueOfList(&_QueryVestingScheduleResponse_6_list{list: &list})
+	case "stratos.pot.v1.QueryVestingScheduleResponse.pagination":
+		m := new(v1beta1.PageResponse)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "stratos.pot.v1.QueryVestingScheduleResponse.linear_vesting":
+		m := new(VestingSchedule)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.QueryVestingScheduleResponse"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.QueryVestingScheduleResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryVestingScheduleResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in stratos.pot.v1.QueryVestingScheduleResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryVestingScheduleResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryVestingScheduleResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryVestingScheduleResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryVestingScheduleResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryVestingScheduleResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.WalletAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.VestingMode)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.MaturedEpoch != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaturedEpoch))
+		}
+		if len(x.Entries) > 0 {
+			for _, e := range x.Entries {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.MatureTotalReward) > 0 {
+			for _, e := range x.MatureTotalReward {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.ImmatureTotalReward) > 0 {
+			for _, e := range x.ImmatureTotalReward {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.LinearVesting != nil {
+			l = options.Size(x.LinearVesting)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryVestingScheduleResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.LinearVesting != nil {
+			encoded, err := options.Marshal(x.LinearVesting)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.ImmatureTotalReward) > 0 {
+			for iNdEx := len(x.ImmatureTotalReward) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ImmatureTotalReward[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if len(x.MatureTotalReward) > 0 {
+			for iNdEx := len(x.MatureTotalReward) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.MatureTotalReward[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if len(x.Entries) > 0 {
+			for iNdEx := len(x.Entries) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Entries[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if x.MaturedEpoch != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaturedEpoch))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.VestingMode) > 0 {
+			i -= len(x.VestingMode)
+			copy(dAtA[i:], x.VestingMode)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VestingMode)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.WalletAddress) > 0 {
+			i -= len(x.WalletAddress)
+			copy(dAtA[i:], x.WalletAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.WalletAddress)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryVestingScheduleResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryVestingScheduleResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryVestingScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WalletAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.WalletAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VestingMode", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.VestingMode = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaturedEpoch", wireType)
+				}
+				x.MaturedEpoch = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaturedEpoch |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Entries = append(x.Entries, &VestingScheduleEntry{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Entries[len(x.Entries)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MatureTotalReward", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MatureTotalReward = append(x.MatureTotalReward, &v1beta11.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MatureTotalReward[len(x.MatureTotalReward)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ImmatureTotalReward", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ImmatureTotalReward = append(x.ImmatureTotalReward, &v1beta11.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ImmatureTotalReward[len(x.ImmatureTotalReward)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageResponse{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LinearVesting", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.LinearVesting == nil {
+					x.LinearVesting = &VestingSchedule{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.LinearVesting); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_VestingScheduleEntry_2_list)(nil)
+
+type _VestingScheduleEntry_2_list struct {
+	list *[]*v1beta11.Coin
+}
+
+func (x *_VestingScheduleEntry_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_VestingScheduleEntry_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_VestingScheduleEntry_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta11.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_VestingScheduleEntry_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta11.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_VestingScheduleEntry_2_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta11.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_VestingScheduleEntry_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_VestingScheduleEntry_2_list) NewElement() protoreflect.Value {
+	v := new(v1beta11.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_VestingScheduleEntry_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_VestingScheduleEntry_3_list)(nil)
+
+type _VestingScheduleEntry_3_list struct {
+	list *[]*v1beta11.Coin
+}
+
+func (x *_VestingScheduleEntry_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_VestingScheduleEntry_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_VestingScheduleEntry_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta11.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_VestingScheduleEntry_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta11.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_VestingScheduleEntry_3_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta11.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_VestingScheduleEntry_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_VestingScheduleEntry_3_list) NewElement() protoreflect.Value {
+	v := new(v1beta11.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_VestingScheduleEntry_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_VestingScheduleEntry                          protoreflect.MessageDescriptor
+	fd_VestingScheduleEntry_mature_epoch             protoreflect.FieldDescriptor
+	fd_VestingScheduleEntry_reward_from_mining_pool  protoreflect.FieldDescriptor
+	fd_VestingScheduleEntry_reward_from_traffic_pool protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_stratos_pot_v1_query_proto_init()
+	md_VestingScheduleEntry = File_stratos_pot_v1_query_proto.Messages().ByName("VestingScheduleEntry")
+	fd_VestingScheduleEntry_mature_epoch = md_VestingScheduleEntry.Fields().ByName("mature_epoch")
+	fd_VestingScheduleEntry_reward_from_mining_pool = md_VestingScheduleEntry.Fields().ByName("reward_from_mining_pool")
+	fd_VestingScheduleEntry_reward_from_traffic_pool = md_VestingScheduleEntry.Fields().ByName("reward_from_traffic_pool")
+}
+
+var _ protoreflect.Message = (*fastReflection_VestingScheduleEntry)(nil)
+
+type fastReflection_VestingScheduleEntry VestingScheduleEntry
+
+func (x *VestingScheduleEntry) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_VestingScheduleEntry)(x)
+}
+
+func (x *VestingScheduleEntry) slowProtoReflect() protoreflect.Message {
+	mi := &file_stratos_pot_v1_query_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_VestingScheduleEntry_messageType fastReflection_VestingScheduleEntry_messageType
+var _ protoreflect.MessageType = fastReflection_VestingScheduleEntry_messageType{}
+
+type fastReflection_VestingScheduleEntry_messageType struct{}
+
+func (x fastReflection_VestingScheduleEntry_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_VestingScheduleEntry)(nil)
+}
+func (x fastReflection_VestingScheduleEntry_messageType) New() protoreflect.Message {
+	return new(fastReflection_VestingScheduleEntry)
+}
+func (x fastReflection_VestingScheduleEntry_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_VestingScheduleEntry
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_VestingScheduleEntry) Descriptor() protoreflect.MessageDescriptor {
+	return md_VestingScheduleEntry
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_VestingScheduleEntry) Type() protoreflect.MessageType {
+	return _fastReflection_VestingScheduleEntry_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_VestingScheduleEntry) New() protoreflect.Message {
+	return new(fastReflection_VestingScheduleEntry)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_VestingScheduleEntry) Interface() protoreflect.ProtoMessage {
+	return (*VestingScheduleEntry)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_VestingScheduleEntry) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.MatureEpoch != int64(0) {
+		value := protoreflect.ValueOfInt64(x.MatureEpoch)
+		if !f(fd_VestingScheduleEntry_mature_epoch, value) {
+			return
+		}
+	}
+	if len(x.RewardFromMiningPool) != 0 {
+		value := protoreflect.ValueOfList(&_VestingScheduleEntry_2_list{list: &x.RewardFromMiningPool})
+		if !f(fd_VestingScheduleEntry_reward_from_mining_pool, value) {
+			return
+		}
+	}
+	if len(x.RewardFromTrafficPool) != 0 {
+		value := protoreflect.ValueOfList(&_VestingScheduleEntry_3_list{list: &x.RewardFromTrafficPool})
+		if !f(fd_VestingScheduleEntry_reward_from_traffic_pool, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_VestingScheduleEntry) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "stratos.pot.v1.VestingScheduleEntry.mature_epoch":
+		return x.MatureEpoch != int64(0)
+	case "stratos.pot.v1.VestingScheduleEntry.reward_from_mining_pool":
+		return len(x.RewardFromMiningPool) != 0
+	case "stratos.pot.v1.VestingScheduleEntry.reward_from_traffic_pool":
+		return len(x.RewardFromTrafficPool) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.VestingScheduleEntry"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.VestingScheduleEntry does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VestingScheduleEntry) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "stratos.pot.v1.VestingScheduleEntry.mature_epoch":
+		x.MatureEpoch = int64(0)
+	case "stratos.pot.v1.VestingScheduleEntry.reward_from_mining_pool":
+		x.RewardFromMiningPool = nil
+	case "stratos.pot.v1.VestingScheduleEntry.reward_from_traffic_pool":
+		x.RewardFromTrafficPool = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.VestingScheduleEntry"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.VestingScheduleEntry does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_VestingScheduleEntry) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "stratos.pot.v1.VestingScheduleEntry.mature_epoch":
+		value := x.MatureEpoch
+		return protoreflect.ValueOfInt64(value)
+	case "stratos.pot.v1.VestingScheduleEntry.reward_from_mining_pool":
+		if len(x.RewardFromMiningPool) == 0 {
+			return protoreflect.ValueOfList(&_VestingScheduleEntry_2_list{})
+		}
+		listValue := &_VestingScheduleEntry_2_list{list: &x.RewardFromMiningPool}
+		return protoreflect.ValueOfList(listValue)
+	case "stratos.pot.v1.VestingScheduleEntry.reward_from_traffic_pool":
+		if len(x.RewardFromTrafficPool) == 0 {
+			return protoreflect.ValueOfList(&_VestingScheduleEntry_3_list{})
+		}
+		listValue := &_VestingScheduleEntry_3_list{list: &x.RewardFromTrafficPool}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.VestingScheduleEntry"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.VestingScheduleEntry does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VestingScheduleEntry) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "stratos.pot.v1.VestingScheduleEntry.mature_epoch":
+		x.MatureEpoch = value.Int()
+	case "stratos.pot.v1.VestingScheduleEntry.reward_from_mining_pool":
+		lv := value.List()
+		clv := lv.(*_VestingScheduleEntry_2_list)
+		x.RewardFromMiningPool = *clv.list
+	case "stratos.pot.v1.VestingScheduleEntry.reward_from_traffic_pool":
+		lv := value.List()
+		clv := lv.(*_VestingScheduleEntry_3_list)
+		x.RewardFromTrafficPool = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.VestingScheduleEntry"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.VestingScheduleEntry does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VestingScheduleEntry) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "stratos.pot.v1.VestingScheduleEntry.reward_from_mining_pool":
+		if x.RewardFromMiningPool == nil {
+			x.RewardFromMiningPool = []*v1beta11.Coin{}
+		}
+		value := &_VestingScheduleEntry_2_list{list: &x.RewardFromMiningPool}
+		return protoreflect.ValueOfList(value)
+	case "stratos.pot.v1.VestingScheduleEntry.reward_from_traffic_pool":
+		if x.RewardFromTrafficPool == nil {
+			x.RewardFromTrafficPool = []*v1beta11.Coin{}
+		}
+		value := &_VestingScheduleEntry_3_list{list: &x.RewardFromTrafficPool}
+		return protoreflect.ValueOfList(value)
+	case "stratos.pot.v1.VestingScheduleEntry.mature_epoch":
+		panic(fmt.Errorf("field mature_epoch of message stratos.pot.v1.VestingScheduleEntry is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.VestingScheduleEntry"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.VestingScheduleEntry does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_VestingScheduleEntry) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "stratos.pot.v1.VestingScheduleEntry.mature_epoch":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "stratos.pot.v1.VestingScheduleEntry.reward_from_mining_pool":
+		list := []*v1beta11.Coin{}
+		return protoreflect.ValueOfList(&_VestingScheduleEntry_2_list{list: &list})
+	case "stratos.pot.v1.VestingScheduleEntry.reward_from_traffic_pool":
+		list := []*v1beta11.Coin{}
+		return protoreflect.ValueOfList(&_VestingScheduleEntry_3_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.VestingScheduleEntry"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.VestingScheduleEntry does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_VestingScheduleEntry) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in stratos.pot.v1.VestingScheduleEntry", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_VestingScheduleEntry) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VestingScheduleEntry) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_VestingScheduleEntry) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_VestingScheduleEntry) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*VestingScheduleEntry)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.MatureEpoch != 0 {
+			n += 1 + runtime.Sov(uint64(x.MatureEpoch))
+		}
+		if len(x.RewardFromMiningPool) > 0 {
+			for _, e := range x.RewardFromMiningPool {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.RewardFromTrafficPool) > 0 {
+			for _, e := range x.RewardFromTrafficPool {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*VestingScheduleEntry)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.RewardFromTrafficPool) > 0 {
+			for iNdEx := len(x.RewardFromTrafficPool) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.RewardFromTrafficPool[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.RewardFromMiningPool) > 0 {
+			for iNdEx := len(x.RewardFromMiningPool) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.RewardFromMiningPool[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if x.MatureEpoch != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MatureEpoch))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*VestingScheduleEntry)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: VestingScheduleEntry: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: VestingScheduleEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MatureEpoch", wireType)
+				}
+				x.MatureEpoch = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MatureEpoch |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RewardFromMiningPool", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RewardFromMiningPool = append(x.RewardFromMiningPool, &v1beta11.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RewardFromMiningPool[len(x.RewardFromMiningPool)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RewardFromTrafficPool", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RewardFromTrafficPool = append(x.RewardFromTrafficPool, &v1beta11.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RewardFromTrafficPool[len(x.RewardFromTrafficPool)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryRewardHistoryRequest                protoreflect.MessageDescriptor
+	fd_QueryRewardHistoryRequest_wallet_address protoreflect.FieldDescriptor
+	fd_QueryRewardHistoryRequest_start_epoch    protoreflect.FieldDescriptor
+	fd_QueryRewardHistoryRequest_end_epoch      protoreflect.FieldDescriptor
+	fd_QueryRewardHistoryRequest_pagination     protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_stratos_pot_v1_query_proto_init()
+	md_QueryRewardHistoryRequest = File_stratos_pot_v1_query_proto.Messages().ByName("QueryRewardHistoryRequest")
+	fd_QueryRewardHistoryRequest_wallet_address = md_QueryRewardHistoryRequest.Fields().ByName("wallet_address")
+	fd_QueryRewardHistoryRequest_start_epoch = md_QueryRewardHistoryRequest.Fields().ByName("start_epoch")
+	fd_QueryRewardHistoryRequest_end_epoch = md_QueryRewardHistoryRequest.Fields().ByName("end_epoch")
+	fd_QueryRewardHistoryRequest_pagination = md_QueryRewardHistoryRequest.Fields().ByName("pagination")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryRewardHistoryRequest)(nil)
+
+type fastReflection_QueryRewardHistoryRequest QueryRewardHistoryRequest
+
+func (x *QueryRewardHistoryRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryRewardHistoryRequest)(x)
+}
+
+func (x *QueryRewardHistoryRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_stratos_pot_v1_query_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryRewardHistoryRequest_messageType fastReflection_QueryRewardHistoryRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryRewardHistoryRequest_messageType{}
+
+type fastReflection_QueryRewardHistoryRequest_messageType struct{}
+
+func (x fastReflection_QueryRewardHistoryRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryRewardHistoryRequest)(nil)
+}
+func (x fastReflection_QueryRewardHistoryRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryRewardHistoryRequest)
+}
+func (x fastReflection_QueryRewardHistoryRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryRewardHistoryRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryRewardHistoryRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryRewardHistoryRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryRewardHistoryRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryRewardHistoryRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryRewardHistoryRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryRewardHistoryRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryRewardHistoryRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryRewardHistoryRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryRewardHistoryRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.WalletAddress != "" {
+		value := protoreflect.ValueOfString(x.WalletAddress)
+		if !f(fd_QueryRewardHistoryRequest_wallet_address, value) {
+			return
+		}
+	}
+	if x.StartEpoch != int64(0) {
+		value := protoreflect.ValueOfInt64(x.StartEpoch)
+		if !f(fd_QueryRewardHistoryRequest_start_epoch, value) {
+			return
+		}
+	}
+	if x.EndEpoch != int64(0) {
+		value := protoreflect.ValueOfInt64(x.EndEpoch)
+		if !f(fd_QueryRewardHistoryRequest_end_epoch, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryRewardHistoryRequest_pagination, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryRewardHistoryRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "stratos.pot.v1.QueryRewardHistoryRequest.wallet_address":
+		return x.WalletAddress != ""
+	case "stratos.pot.v1.QueryRewardHistoryRequest.start_epoch":
+		return x.StartEpoch != int64(0)
+	case "stratos.pot.v1.QueryRewardHistoryRequest.end_epoch":
+		return x.EndEpoch != int64(0)
+	case "stratos.pot.v1.QueryRewardHistoryRequest.pagination":
+		return x.Pagination != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.QueryRewardHistoryRequest"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.QueryRewardHistoryRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryRewardHistoryRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "stratos.pot.v1.QueryRewardHistoryRequest.wallet_address":
+		x.WalletAddress = ""
+	case "stratos.pot.v1.QueryRewardHistoryRequest.start_epoch":
+		x.StartEpoch = int64(0)
+	case "stratos.pot.v1.QueryRewardHistoryRequest.end_epoch":
+		x.EndEpoch = int64(0)
+	case "stratos.pot.v1.QueryRewardHistoryRequest.pagination":
+		x.Pagination = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.QueryRewardHistoryRequest"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.QueryRewardHistoryRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryRewardHistoryRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "stratos.pot.v1.QueryRewardHistoryRequest.wallet_address":
+		value := x.WalletAddress
+		return protoreflect.ValueOfString(value)
+	case "stratos.pot.v1.QueryRewardHistoryRequest.start_epoch":
+		value := x.StartEpoch
+		return protoreflect.ValueOfInt64(value)
+	case "stratos.pot.v1.QueryRewardHistoryRequest.end_epoch":
+		value := x.EndEpoch
+		return protoreflect.ValueOfInt64(value)
+	case "stratos.pot.v1.QueryRewardHistoryRequest.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.QueryRewardHistoryRequest"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.QueryRewardHistoryRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryRewardHistoryRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "stratos.pot.v1.QueryRewardHistoryRequest.wallet_address":
+		x.WalletAddress = value.Interface().(string)
+	case "stratos.pot.v1.QueryRewardHistoryRequest.start_epoch":
+		x.StartEpoch = value.Int()
+	case "stratos.pot.v1.QueryRewardHistoryRequest.end_epoch":
+		x.EndEpoch = value.Int()
+	case "stratos.pot.v1.QueryRewardHistoryRequest.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.QueryRewardHistoryRequest"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.QueryRewardHistoryRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryRewardHistoryRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "stratos.pot.v1.QueryRewardHistoryRequest.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageRequest)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	case "stratos.pot.v1.QueryRewardHistoryRequest.wallet_address":
+		panic(fmt.Errorf("field wallet_address of message stratos.pot.v1.QueryRewardHistoryRequest is not mutable"))
+	case "stratos.pot.v1.QueryRewardHistoryRequest.start_epoch":
+		panic(fmt.Errorf("field start_epoch of message stratos.pot.v1.QueryRewardHistoryRequest is not mutable"))
+	case "stratos.pot.v1.QueryRewardHistoryRequest.end_epoch":
+		panic(fmt.Errorf("field end_epoch of message stratos.pot.v1.QueryRewardHistoryRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.QueryRewardHistoryRequest"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.QueryRewardHistoryRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryRewardHistoryRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "stratos.pot.v1.QueryRewardHistoryRequest.wallet_address":
+		return protoreflect.ValueOfString("")
+	case "stratos.pot.v1.QueryRewardHistoryRequest.start_epoch":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "stratos.pot.v1.QueryRewardHistoryRequest.end_epoch":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "stratos.pot.v1.QueryRewardHistoryRequest.pagination":
+		m := new(v1beta1.PageRequest)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.QueryRewardHistoryRequest"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.QueryRewardHistoryRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryRewardHistoryRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in stratos.pot.v1.QueryRewardHistoryRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryRewardHistoryRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryRewardHistoryRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryRewardHistoryRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryRewardHistoryRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryRewardHistoryRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.WalletAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.StartEpoch != 0 {
+			n += 1 + runtime.Sov(uint64(x.StartEpoch))
+		}
+		if x.EndEpoch != 0 {
+			n += 1 + runtime.Sov(uint64(x.EndEpoch))
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryRewardHistoryRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.EndEpoch != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.EndEpoch))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.StartEpoch != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.StartEpoch))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.WalletAddress) > 0 {
+			i -= len(x.WalletAddress)
+			copy(dAtA[i:], x.WalletAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.WalletAddress)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryRewardHistoryRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryRewardHistoryRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryRewardHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WalletAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.WalletAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StartEpoch", wireType)
+				}
+				x.StartEpoch = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.StartEpoch |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EndEpoch", wireType)
+				}
+				x.EndEpoch = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.EndEpoch |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageRequest{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryRewardHistoryResponse_1_list)(nil)
+
+type _QueryRewardHistoryResponse_1_list struct {
+	list *[]*RewardHistory
+}
+
+func (x *_QueryRewardHistoryResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryRewardHistoryResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryRewardHistoryResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*RewardHistory)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryRewardHistoryResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*RewardHistory)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryRewardHistoryResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(RewardHistory)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryRewardHistoryResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryRewardHistoryResponse_1_list) NewElement() protoreflect.Value {
+	v := new(RewardHistory)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryRewardHistoryResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryRewardHistoryResponse            protoreflect.MessageDescriptor
+	fd_QueryRewardHistoryResponse_histories  protoreflect.FieldDescriptor
+	fd_QueryRewardHistoryResponse_pagination protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_stratos_pot_v1_query_proto_init()
+	md_QueryRewardHistoryResponse = File_stratos_pot_v1_query_proto.Messages().ByName("QueryRewardHistoryResponse")
+	fd_QueryRewardHistoryResponse_histories = md_QueryRewardHistoryResponse.Fields().ByName("histories")
+	fd_QueryRewardHistoryResponse_pagination = md_QueryRewardHistoryResponse.Fields().ByName("pagination")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryRewardHistoryResponse)(nil)
+
+type fastReflection_QueryRewardHistoryResponse QueryRewardHistoryResponse
+
+func (x *QueryRewardHistoryResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryRewardHistoryResponse)(x)
+}
+
+func (x *QueryRewardHistoryResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_stratos_pot_v1_query_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryRewardHistoryResponse_messageType fastReflection_QueryRewardHistoryResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryRewardHistoryResponse_messageType{}
+
+type fastReflection_QueryRewardHistoryResponse_messageType struct{}
+
+func (x fastReflection_QueryRewardHistoryResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryRewardHistoryResponse)(nil)
+}
+func (x fastReflection_QueryRewardHistoryResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryRewardHistoryResponse)
+}
+func (x fastReflection_QueryRewardHistoryResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryRewardHistoryResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryRewardHistoryResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryRewardHistoryResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryRewardHistoryResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryRewardHistoryResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryRewardHistoryResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryRewardHistoryResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryRewardHistoryResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryRewardHistoryResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryRewardHistoryResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Histories) != 0 {
+		value := protoreflect.ValueOfList(&_QueryRewardHistoryResponse_1_list{list: &x.Histories})
+		if !f(fd_QueryRewardHistoryResponse_histories, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryRewardHistoryResponse_pagination, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryRewardHistoryResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "stratos.pot.v1.QueryRewardHistoryResponse.histories":
+		return len(x.Histories) != 0
+	case "stratos.pot.v1.QueryRewardHistoryResponse.pagination":
+		return x.Pagination != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.QueryRewardHistoryResponse"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.QueryRewardHistoryResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryRewardHistoryResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "stratos.pot.v1.QueryRewardHistoryResponse.histories":
+		x.Histories = nil
+	case "stratos.pot.v1.QueryRewardHistoryResponse.pagination":
+		x.Pagination = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.QueryRewardHistoryResponse"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.QueryRewardHistoryResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryRewardHistoryResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "stratos.pot.v1.QueryRewardHistoryResponse.histories":
+		if len(x.Histories) == 0 {
+			return protoreflect.ValueOfList(&_QueryRewardHistoryResponse_1_list{})
+		}
+		listValue := &_QueryRewardHistoryResponse_1_list{list: &x.Histories}
+		return protoreflect.ValueOfList(listValue)
+	case "stratos.pot.v1.QueryRewardHistoryResponse.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.QueryRewardHistoryResponse"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.QueryRewardHistoryResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryRewardHistoryResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "stratos.pot.v1.QueryRewardHistoryResponse.histories":
+		lv := value.List()
+		clv := lv.(*_QueryRewardHistoryResponse_1_list)
+		x.Histories = *clv.list
+	case "stratos.pot.v1.QueryRewardHistoryResponse.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.QueryRewardHistoryResponse"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.QueryRewardHistoryResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryRewardHistoryResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "stratos.pot.v1.QueryRewardHistoryResponse.histories":
+		if x.Histories == nil {
+			x.Histories = []*RewardHistory{}
+		}
+		value := &_QueryRewardHistoryResponse_1_list{list: &x.Histories}
+		return protoreflect.ValueOfList(value)
+	case "stratos.pot.v1.QueryRewardHistoryResponse.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageResponse)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.QueryRewardHistoryResponse"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.QueryRewardHistoryResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryRewardHistoryResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "stratos.pot.v1.QueryRewardHistoryResponse.histories":
+		list := []*RewardHistory{}
+		return protoreflect.ValueOfList(&_QueryRewardHistoryResponse_1_list{list: &list})
+	case "stratos.pot.v1.QueryRewardHistoryResponse.pagination":
+		m := new(v1beta1.PageResponse)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.QueryRewardHistoryResponse"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.QueryRewardHistoryResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryRewardHistoryResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in stratos.pot.v1.QueryRewardHistoryResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryRewardHistoryResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryRewardHistoryResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryRewardHistoryResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryRewardHistoryResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryRewardHistoryResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Histories) > 0 {
+			for _, e := range x.Histories {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryRewardHistoryResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Histories) > 0 {
+			for iNdEx := len(x.Histories) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Histories[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryRewardHistoryResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryRewardHistoryResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryRewardHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Histories", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Histories = append(x.Histories, &RewardHistory{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Histories[len(x.Histories)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageResponse{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryAutoRestakeRequest                protoreflect.MessageDescriptor
+	fd_QueryAutoRestakeRequest_wallet_address protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_stratos_pot_v1_query_proto_init()
+	md_QueryAutoRestakeRequest = File_stratos_pot_v1_query_proto.Messages().ByName("QueryAutoRestakeRequest")
+	fd_QueryAutoRestakeRequest_wallet_address = md_QueryAutoRestakeRequest.Fields().ByName("wallet_address")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryAutoRestakeRequest)(nil)
+
+type fastReflection_QueryAutoRestakeRequest QueryAutoRestakeRequest
+
+func (x *QueryAutoRestakeRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryAutoRestakeRequest)(x)
+}
+
+func (x *QueryAutoRestakeRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_stratos_pot_v1_query_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryAutoRestakeRequest_messageType fastReflection_QueryAutoRestakeRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryAutoRestakeRequest_messageType{}
+
+type fastReflection_QueryAutoRestakeRequest_messageType struct{}
+
+func (x fastReflection_QueryAutoRestakeRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryAutoRestakeRequest)(nil)
+}
+func (x fastReflection_QueryAutoRestakeRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryAutoRestakeRequest)
+}
+func (x fastReflection_QueryAutoRestakeRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryAutoRestakeRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryAutoRestakeRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryAutoRestakeRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryAutoRestakeRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryAutoRestakeRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryAutoRestakeRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryAutoRestakeRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryAutoRestakeRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryAutoRestakeRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryAutoRestakeRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.WalletAddress != "" {
+		value := protoreflect.ValueOfString(x.WalletAddress)
+		if !f(fd_QueryAutoRestakeRequest_wallet_address, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryAutoRestakeRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "stratos.pot.v1.QueryAutoRestakeRequest.wallet_address":
+		return x.WalletAddress != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.QueryAutoRestakeRequest"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.QueryAutoRestakeRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAutoRestakeRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "stratos.pot.v1.QueryAutoRestakeRequest.wallet_address":
+		x.WalletAddress = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.QueryAutoRestakeRequest"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.QueryAutoRestakeRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryAutoRestakeRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "stratos.pot.v1.QueryAutoRestakeRequest.wallet_address":
+		value := x.WalletAddress
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.QueryAutoRestakeRequest"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.QueryAutoRestakeRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAutoRestakeRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "stratos.pot.v1.QueryAutoRestakeRequest.wallet_address":
+		x.WalletAddress = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.QueryAutoRestakeRequest"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.QueryAutoRestakeRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAutoRestakeRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "stratos.pot.v1.QueryAutoRestakeRequest.wallet_address":
+		panic(fmt.Errorf("field wallet_address of message stratos.pot.v1.QueryAutoRestakeRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.QueryAutoRestakeRequest"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.QueryAutoRestakeRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryAutoRestakeRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "stratos.pot.v1.QueryAutoRestakeRequest.wallet_address":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.QueryAutoRestakeRequest"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.QueryAutoRestakeRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryAutoRestakeRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in stratos.pot.v1.QueryAutoRestakeRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryAutoRestakeRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAutoRestakeRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryAutoRestakeRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryAutoRestakeRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryAutoRestakeRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.WalletAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryAutoRestakeRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.WalletAddress) > 0 {
+			i -= len(x.WalletAddress)
+			copy(dAtA[i:], x.WalletAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.WalletAddress)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryAutoRestakeRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAutoRestakeRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAutoRestakeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WalletAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.WalletAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryAutoRestakeResponse              protoreflect.MessageDescriptor
+	fd_QueryAutoRestakeResponse_auto_restake protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_stratos_pot_v1_query_proto_init()
+	md_QueryAutoRestakeResponse = File_stratos_pot_v1_query_proto.Messages().ByName("QueryAutoRestakeResponse")
+	fd_QueryAutoRestakeResponse_auto_restake = md_QueryAutoRestakeResponse.Fields().ByName("auto_restake")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryAutoRestakeResponse)(nil)
+
+type fastReflection_QueryAutoRestakeResponse QueryAutoRestakeResponse
+
+func (x *QueryAutoRestakeResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryAutoRestakeResponse)(x)
+}
+
+func (x *QueryAutoRestakeResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_stratos_pot_v1_query_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryAutoRestakeResponse_messageType fastReflection_QueryAutoRestakeResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryAutoRestakeResponse_messageType{}
+
+type fastReflection_QueryAutoRestakeResponse_messageType struct{}
+
+func (x fastReflection_QueryAutoRestakeResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryAutoRestakeResponse)(nil)
+}
+func (x fastReflection_QueryAutoRestakeResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryAutoRestakeResponse)
+}
+func (x fastReflection_QueryAutoRestakeResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryAutoRestakeResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryAutoRestakeResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryAutoRestakeResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryAutoRestakeResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryAutoRestakeResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryAutoRestakeResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryAutoRestakeResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryAutoRestakeResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryAutoRestakeResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryAutoRestakeResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.AutoRestake != nil {
+		value := protoreflect.ValueOfMessage(x.AutoRestake.ProtoReflect())
+		if !f(fd_QueryAutoRestakeResponse_auto_restake, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryAutoRestakeResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "stratos.pot.v1.QueryAutoRestakeResponse.auto_restake":
+		return x.AutoRestake != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.QueryAutoRestakeResponse"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.QueryAutoRestakeResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAutoRestakeResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "stratos.pot.v1.QueryAutoRestakeResponse.auto_restake":
+		x.AutoRestake = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.QueryAutoRestakeResponse"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.QueryAutoRestakeResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryAutoRestakeResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "stratos.pot.v1.QueryAutoRestakeResponse.auto_restake":
+		value := x.AutoRestake
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.QueryAutoRestakeResponse"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.QueryAutoRestakeResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAutoRestakeResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "stratos.pot.v1.QueryAutoRestakeResponse.auto_restake":
+		x.AutoRestake = value.Message().Interface().(*AutoRestake)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.QueryAutoRestakeResponse"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.QueryAutoRestakeResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAutoRestakeResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "stratos.pot.v1.QueryAutoRestakeResponse.auto_restake":
+		if x.AutoRestake == nil {
+			x.AutoRestake = new(AutoRestake)
+		}
+		return protoreflect.ValueOfMessage(x.AutoRestake.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.QueryAutoRestakeResponse"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.QueryAutoRestakeResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryAutoRestakeResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "stratos.pot.v1.QueryAutoRestakeResponse.auto_restake":
+		m := new(AutoRestake)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.QueryAutoRestakeResponse"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.QueryAutoRestakeResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryAutoRestakeResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in stratos.pot.v1.QueryAutoRestakeResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryAutoRestakeResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAutoRestakeResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryAutoRestakeResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryAutoRestakeResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryAutoRestakeResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.AutoRestake != nil {
+			l = options.Size(x.AutoRestake)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryAutoRestakeResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.AutoRestake != nil {
+			encoded, err := options.Marshal(x.AutoRestake)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryAutoRestakeResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAutoRestakeResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAutoRestakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AutoRestake", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.AutoRestake == nil {
+					x.AutoRestake = &AutoRestake{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.AutoRestake); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
 // 	protoc-gen-go v1.27.0
 // 	protoc        (unknown)
 // source: stratos/pot/v1/query.proto
 
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// QueryVolumeReportRequest is request type for the Query/VolumeReport RPC method
+type QueryVolumeReportRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// epoch defines the epoch number to query for.
+	Epoch int64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+}
+
+func (x *QueryVolumeReportRequest) Reset() {
+	*x = QueryVolumeReportRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stratos_pot_v1_query_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryVolumeReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryVolumeReportRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryVolumeReportRequest.ProtoReflect.Descriptor instead.
+func (*QueryVolumeReportRequest) Descriptor() ([]byte, []int) {
+	return file_stratos_pot_v1_query_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *QueryVolumeReportRequest) GetEpoch() int64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+// QueryVolumeReportResponse is response type for the Query/ResourceNode RPC method
+type QueryVolumeReportResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// node defines the the volumeReport info.
+	ReportInfo *ReportInfo `protobuf:"bytes,1,opt,name=report_info,json=reportInfo,proto3" json:"report_info,omitempty"`
+}
+
+func (x *QueryVolumeReportResponse) Reset() {
+	*x = QueryVolumeReportResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stratos_pot_v1_query_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryVolumeReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryVolumeReportResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryVolumeReportResponse.ProtoReflect.Descriptor instead.
+func (*QueryVolumeReportResponse) Descriptor() ([]byte, []int) {
+	return file_stratos_pot_v1_query_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *QueryVolumeReportResponse) GetReportInfo() *ReportInfo {
+	if x != nil {
+		return x.ReportInfo
+	}
+	return nil
+}
+
+type ReportInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Epoch     int64  `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Reference string `protobuf:"bytes,2,opt,name=reference,proto3" json:"reference,omitempty"`
+	TxHash    string `protobuf:"bytes,3,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	Reporter  string `protobuf:"bytes,4,opt,name=reporter,proto3" json:"reporter,omitempty"`
+}
+
+func (x *ReportInfo) Reset() {
+	*x = ReportInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stratos_pot_v1_query_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReportInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportInfo) ProtoMessage() {}
+
+// Deprecated: Use ReportInfo.ProtoReflect.Descriptor instead.
+func (*ReportInfo) Descriptor() ([]byte, []int) {
+	return file_stratos_pot_v1_query_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ReportInfo) GetEpoch() int64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *ReportInfo) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *ReportInfo) GetTxHash() string {
+	if x != nil {
+		return x.TxHash
+	}
+	return ""
+}
+
+func (x *ReportInfo) GetReporter() string {
+	if x != nil {
+		return x.Reporter
+	}
+	return ""
+}
+
+// QueryParamsRequest is request type for the Query/Params RPC method.
+type QueryParamsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *QueryParamsRequest) Reset() {
+	*x = QueryParamsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stratos_pot_v1_query_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryParamsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryParamsRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryParamsRequest.ProtoReflect.Descriptor instead.
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return file_stratos_pot_v1_query_proto_rawDescGZIP(), []int{3}
+}
+
+// QueryParamsResponse is response type for the Query/Params RPC method.
+type QueryParamsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// params holds all the parameters of this module.
+	Params *Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+}
+
+func (x *QueryParamsResponse) Reset() {
+	*x = QueryParamsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stratos_pot_v1_query_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryParamsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryParamsResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryParamsResponse.ProtoReflect.Descriptor instead.
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return file_stratos_pot_v1_query_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *QueryParamsResponse) GetParams() *Params {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+// QueryRewardsByEpochRequest is request type for the Query/RewardsByEpoch by a given epoch RPC method
+type QueryRewardsByEpochRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Epoch      int64                `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Pagination *v1beta1.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *QueryRewardsByEpochRequest) Reset() {
+	*x = QueryRewardsByEpochRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stratos_pot_v1_query_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryRewardsByEpochRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryRewardsByEpochRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryRewardsByEpochRequest.ProtoReflect.Descriptor instead.
+func (*QueryRewardsByEpochRequest) Descriptor() ([]byte, []int) {
+	return file_stratos_pot_v1_query_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *QueryRewardsByEpochRequest) GetEpoch() int64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *QueryRewardsByEpochRequest) GetPagination() *v1beta1.PageRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// QueryRewardsByEpochResponse is response type for the Query/RewardsByEpoch RPC method
+type QueryRewardsByEpochResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rewards    []*Reward             `protobuf:"bytes,1,rep,name=rewards,proto3" json:"rewards,omitempty"`
+	Pagination *v1beta1.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *QueryRewardsByEpochResponse) Reset() {
+	*x = QueryRewardsByEpochResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stratos_pot_v1_query_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryRewardsByEpochResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryRewardsByEpochResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryRewardsByEpochResponse.ProtoReflect.Descriptor instead.
+func (*QueryRewardsByEpochResponse) Descriptor() ([]byte, []int) {
+	return file_stratos_pot_v1_query_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *QueryRewardsByEpochResponse) GetRewards() []*Reward {
+	if x != nil {
+		return x.Rewards
+	}
+	return nil
+}
+
+func (x *QueryRewardsByEpochResponse) GetPagination() *v1beta1.PageResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// QueryRewardsByOwnerRequest is request type for the Query/RewardsByOwner by a given owner RPC method
+type QueryRewardsByWalletRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WalletAddress string `protobuf:"bytes,1,opt,name=wallet_address,json=walletAddress,proto3" json:"wallet_address,omitempty"`
+}
+
+func (x *QueryRewardsByWalletRequest) Reset() {
+	*x = QueryRewardsByWalletRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stratos_pot_v1_query_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryRewardsByWalletRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryRewardsByWalletRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryRewardsByWalletRequest.ProtoReflect.Descriptor instead.
+func (*QueryRewardsByWalletRequest) Descriptor() ([]byte, []int) {
+	return file_stratos_pot_v1_query_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *QueryRewardsByWalletRequest) GetWalletAddress() string {
+	if x != nil {
+		return x.WalletAddress
+	}
+	return ""
+}
+
+// QueryRewardsByOwnerResponse is response type for the Query/RewardsByOwner RPC method
+type QueryRewardsByWalletResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rewards *RewardByWallet `protobuf:"bytes,1,opt,name=rewards,proto3" json:"rewards,omitempty"`
+}
+
+func (x *QueryRewardsByWalletResponse) Reset() {
+	*x = QueryRewardsByWalletResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stratos_pot_v1_query_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryRewardsByWalletResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryRewardsByWalletResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryRewardsByWalletResponse.ProtoReflect.Descriptor instead.
+func (*QueryRewardsByWalletResponse) Descriptor() ([]byte, []int) {
+	return file_stratos_pot_v1_query_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *QueryRewardsByWalletResponse) GetRewards() *RewardByWallet {
+	if x != nil {
+		return x.Rewards
+	}
+	return nil
+}
+
+type QueryRewardsByWalletAndEpochRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WalletAddress string               `protobuf:"bytes,1,opt,name=wallet_address,json=walletAddress,proto3" json:"wallet_address,omitempty"`
+	Epoch         int64                `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Pagination    *v1beta1.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *QueryRewardsByWalletAndEpochRequest) Reset() {
+	*x = QueryRewardsByWalletAndEpochRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stratos_pot_v1_query_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryRewardsByWalletAndEpochRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryRewardsByWalletAndEpochRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryRewardsByWalletAndEpochRequest.ProtoReflect.Descriptor instead.
+func (*QueryRewardsByWalletAndEpochRequest) Descriptor() ([]byte, []int) {
+	return file_stratos_pot_v1_query_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *QueryRewardsByWalletAndEpochRequest) GetWalletAddress() string {
+	if x != nil {
+		return x.WalletAddress
+	}
+	return ""
+}
+
+func (x *QueryRewardsByWalletAndEpochRequest) GetEpoch() int64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *QueryRewardsByWalletAndEpochRequest) GetPagination() *v1beta1.PageRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type QueryRewardsByWalletAndEpochResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rewards    []*Reward             `protobuf:"bytes,1,rep,name=rewards,proto3" json:"rewards,omitempty"`
+	Pagination *v1beta1.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *QueryRewardsByWalletAndEpochResponse) Reset() {
+	*x = QueryRewardsByWalletAndEpochResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stratos_pot_v1_query_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryRewardsByWalletAndEpochResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryRewardsByWalletAndEpochResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryRewardsByWalletAndEpochResponse.ProtoReflect.Descriptor instead.
+func (*QueryRewardsByWalletAndEpochResponse) Descriptor() ([]byte, []int) {
+	return file_stratos_pot_v1_query_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *QueryRewardsByWalletAndEpochResponse) GetRewards() []*Reward {
+	if x != nil {
+		return x.Rewards
+	}
+	return nil
+}
+
+func (x *QueryRewardsByWalletAndEpochResponse) GetPagination() *v1beta1.PageResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type RewardByWallet struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WalletAddress       string           `protobuf:"bytes,1,opt,name=wallet_address,json=walletAddress,proto3" json:"wallet_address,omitempty"`
+	MatureTotalReward   []*v1beta11.Coin `protobuf:"bytes,2,rep,name=mature_total_reward,json=matureTotalReward,proto3" json:"mature_total_reward,omitempty"`
+	ImmatureTotalReward []*v1beta11.Coin `protobuf:"bytes,3,rep,name=immature_total_reward,json=immatureTotalReward,proto3" json:"immature_total_reward,omitempty"`
+}
+
+func (x *RewardByWallet) Reset() {
+	*x = RewardByWallet{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stratos_pot_v1_query_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RewardByWallet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RewardByWallet) ProtoMessage() {}
+
+// Deprecated: Use RewardByWallet.ProtoReflect.Descriptor instead.
+func (*RewardByWallet) Descriptor() ([]byte, []int) {
+	return file_stratos_pot_v1_query_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RewardByWallet) GetWalletAddress() string {
+	if x != nil {
+		return x.WalletAddress
+	}
+	return ""
+}
+
+func (x *RewardByWallet) GetMatureTotalReward() []*v1beta11.Coin {
+	if x != nil {
+		return x.MatureTotalReward
+	}
+	return nil
+}
+
+func (x *RewardByWallet) GetImmatureTotalReward() []*v1beta11.Coin {
+	if x != nil {
+		return x.ImmatureTotalReward
+	}
+	return nil
+}
 
-// QueryVolumeReportRequest is request type for the Query/VolumeReport RPC method
-type QueryVolumeReportRequest struct {
+// QuerySlashingByOwnerRequest is request type for the Query/Slashing by a given owner RPC method
+type QuerySlashingByOwnerRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// epoch defines the epoch number to query for.
-	Epoch int64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	WalletAddress string `protobuf:"bytes,1,opt,name=wallet_address,json=walletAddress,proto3" json:"wallet_address,omitempty"`
 }
 
-func (x *QueryVolumeReportRequest) Reset() {
-	*x = QueryVolumeReportRequest{}
+func (x *QuerySlashingByOwnerRequest) Reset() {
+	*x = QuerySlashingByOwnerRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_stratos_pot_v1_query_proto_msgTypes[0]
+		mi := &file_stratos_pot_v1_query_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryVolumeReportRequest) String() string {
+func (x *QuerySlashingByOwnerRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryVolumeReportRequest) ProtoMessage() {}
+func (*QuerySlashingByOwnerRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryVolumeReportRequest.ProtoReflect.Descriptor instead.
-func (*QueryVolumeReportRequest) Descriptor() ([]byte, []int) {
-	return file_stratos_pot_v1_query_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use QuerySlashingByOwnerRequest.ProtoReflect.Descriptor instead.
+func (*QuerySlashingByOwnerRequest) Descriptor() ([]byte, []int) {
+	return file_stratos_pot_v1_query_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *QueryVolumeReportRequest) GetEpoch() int64 {
+func (x *QuerySlashingByOwnerRequest) GetWalletAddress() string {
 	if x != nil {
-		return x.Epoch
+		return x.WalletAddress
 	}
-	return 0
+	return ""
 }
 
-// QueryVolumeReportResponse is response type for the Query/ResourceNode RPC method
-type QueryVolumeReportResponse struct {
+// QuerySlashingByOwnerResponse is response type for the Query/Slashing RPC method
+type QuerySlashingByOwnerResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// node defines the the volumeReport info.
-	ReportInfo *ReportInfo `protobuf:"bytes,1,opt,name=report_info,json=reportInfo,proto3" json:"report_info,omitempty"`
+	Slashing string `protobuf:"bytes,1,opt,name=slashing,proto3" json:"slashing,omitempty"`
 }
 
-func (x *QueryVolumeReportResponse) Reset() {
-	*x = QueryVolumeReportResponse{}
+func (x *QuerySlashingByOwnerResponse) Reset() {
+	*x = QuerySlashingByOwnerResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_stratos_pot_v1_query_proto_msgTypes[1]
+		mi := &file_stratos_pot_v1_query_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryVolumeReportResponse) String() string {
+func (x *QuerySlashingByOwnerResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryVolumeReportResponse) ProtoMessage() {}
+func (*QuerySlashingByOwnerResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryVolumeReportResponse.ProtoReflect.Descriptor instead.
-func (*QueryVolumeReportResponse) Descriptor() ([]byte, []int) {
-	return file_stratos_pot_v1_query_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use QuerySlashingByOwnerResponse.ProtoReflect.Descriptor instead.
+func (*QuerySlashingByOwnerResponse) Descriptor() ([]byte, []int) {
+	return file_stratos_pot_v1_query_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *QueryVolumeReportResponse) GetReportInfo() *ReportInfo {
+func (x *QuerySlashingByOwnerResponse) GetSlashing() string {
 	if x != nil {
-		return x.ReportInfo
+		return x.Slashing
 	}
-	return nil
+	return ""
 }
 
-type ReportInfo struct {
+type QueryTotalMinedTokenRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Epoch     int64  `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
-	Reference string `protobuf:"bytes,2,opt,name=reference,proto3" json:"reference,omitempty"`
-	TxHash    string `protobuf:"bytes,3,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
-	Reporter  string `protobuf:"bytes,4,opt,name=reporter,proto3" json:"reporter,omitempty"`
 }
 
-func (x *ReportInfo) Reset() {
-	*x = ReportInfo{}
+func (x *QueryTotalMinedTokenRequest) Reset() {
+	*x = QueryTotalMinedTokenRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_stratos_pot_v1_query_proto_msgTypes[2]
+		mi := &file_stratos_pot_v1_query_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ReportInfo) String() string {
+func (x *QueryTotalMinedTokenRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReportInfo) ProtoMessage() {}
+func (*QueryTotalMinedTokenRequest) ProtoMessage() {}
 
-// Deprecated: Use ReportInfo.ProtoReflect.Descriptor instead.
-func (*ReportInfo) Descriptor() ([]byte, []int) {
-	return file_stratos_pot_v1_query_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use QueryTotalMinedTokenRequest.ProtoReflect.Descriptor instead.
+func (*QueryTotalMinedTokenRequest) Descriptor() ([]byte, []int) {
+	return file_stratos_pot_v1_query_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *ReportInfo) GetEpoch() int64 {
-	if x != nil {
-		return x.Epoch
-	}
-	return 0
+type QueryTotalMinedTokenResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TotalMinedToken *v1beta11.Coin `protobuf:"bytes,1,opt,name=total_mined_token,json=totalMinedToken,proto3" json:"total_mined_token,omitempty"`
 }
 
-func (x *ReportInfo) GetReference() string {
-	if x != nil {
-		return x.Reference
+func (x *QueryTotalMinedTokenResponse) Reset() {
+	*x = QueryTotalMinedTokenResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stratos_pot_v1_query_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *ReportInfo) GetTxHash() string {
-	if x != nil {
-		return x.TxHash
-	}
-	return ""
+func (x *QueryTotalMinedTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *ReportInfo) GetReporter() string {
+func (*QueryTotalMinedTokenResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryTotalMinedTokenResponse.ProtoReflect.Descriptor instead.
+func (*QueryTotalMinedTokenResponse) Descriptor() ([]byte, []int) {
+	return file_stratos_pot_v1_query_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *QueryTotalMinedTokenResponse) GetTotalMinedToken() *v1beta11.Coin {
 	if x != nil {
-		return x.Reporter
+		return x.TotalMinedToken
 	}
-	return ""
+	return nil
 }
 
-// QueryParamsRequest is request type for the Query/Params RPC method.
-type QueryParamsRequest struct {
+type QueryCirculationSupplyRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *QueryParamsRequest) Reset() {
-	*x = QueryParamsRequest{}
+func (x *QueryCirculationSupplyRequest) Reset() {
+	*x = QueryCirculationSupplyRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_stratos_pot_v1_query_proto_msgTypes[3]
+		mi := &file_stratos_pot_v1_query_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryParamsRequest) String() string {
+func (x *QueryCirculationSupplyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryParamsRequest) ProtoMessage() {}
+func (*QueryCirculationSupplyRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryParamsRequest.ProtoReflect.Descriptor instead.
-func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return file_stratos_pot_v1_query_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use QueryCirculationSupplyRequest.ProtoReflect.Descriptor instead.
+func (*QueryCirculationSupplyRequest) Descriptor() ([]byte, []int) {
+	return file_stratos_pot_v1_query_proto_rawDescGZIP(), []int{16}
 }
 
-// QueryParamsResponse is response type for the Query/Params RPC method.
-type QueryParamsResponse struct {
+type QueryCirculationSupplyResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// params holds all the parameters of this module.
-	Params *Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	CirculationSupply []*v1beta11.Coin `protobuf:"bytes,3,rep,name=circulation_supply,json=circulationSupply,proto3" json:"circulation_supply,omitempty"`
 }
 
-func (x *QueryParamsResponse) Reset() {
-	*x = QueryParamsResponse{}
+func (x *QueryCirculationSupplyResponse) Reset() {
+	*x = QueryCirculationSupplyResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_stratos_pot_v1_query_proto_msgTypes[4]
+		mi := &file_stratos_pot_v1_query_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryParamsResponse) String() string {
+func (x *QueryCirculationSupplyResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryParamsResponse) ProtoMessage() {}
+func (*QueryCirculationSupplyResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryParamsResponse.ProtoReflect.Descriptor instead.
-func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return file_stratos_pot_v1_query_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use QueryCirculationSupplyResponse.ProtoReflect.Descriptor instead.
+func (*QueryCirculationSupplyResponse) Descriptor() ([]byte, []int) {
+	return file_stratos_pot_v1_query_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *QueryParamsResponse) GetParams() *Params {
+func (x *QueryCirculationSupplyResponse) GetCirculationSupply() []*v1beta11.Coin {
 	if x != nil {
-		return x.Params
+		return x.CirculationSupply
 	}
 	return nil
 }
 
-// QueryRewardsByEpochRequest is request type for the Query/RewardsByEpoch by a given epoch RPC method
-type QueryRewardsByEpochRequest struct {
+type QueryTotalRewardByEpochRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Epoch      int64                `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
-	Pagination *v1beta1.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Epoch int64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
 }
 
-func (x *QueryRewardsByEpochRequest) Reset() {
-	*x = QueryRewardsByEpochRequest{}
+func (x *QueryTotalRewardByEpochRequest) Reset() {
+	*x = QueryTotalRewardByEpochRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_stratos_pot_v1_query_proto_msgTypes[5]
+		mi := &file_stratos_pot_v1_query_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryRewardsByEpochRequest) String() string {
+func (x *QueryTotalRewardByEpochRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryRewardsByEpochRequest) ProtoMessage() {}
+func (*QueryTotalRewardByEpochRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryRewardsByEpochRequest.ProtoReflect.Descriptor instead.
-func (*QueryRewardsByEpochRequest) Descriptor() ([]byte, []int) {
-	return file_stratos_pot_v1_query_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use QueryTotalRewardByEpochRequest.ProtoReflect.Descriptor instead.
+func (*QueryTotalRewardByEpochRequest) Descriptor() ([]byte, []int) {
+	return file_stratos_pot_v1_query_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *QueryRewardsByEpochRequest) GetEpoch() int64 {
+func (x *QueryTotalRewardByEpochRequest) GetEpoch() int64 {
 	if x != nil {
 		return x.Epoch
 	}
 	return 0
 }
 
-func (x *QueryRewardsByEpochRequest) GetPagination() *v1beta1.PageRequest {
-	if x != nil {
-		return x.Pagination
-	}
-	return nil
+type QueryTotalRewardByEpochResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TotalReward *TotalReward `protobuf:"bytes,1,opt,name=total_reward,json=totalReward,proto3" json:"total_reward,omitempty"`
+	IsLegacy    bool         `protobuf:"varint,2,opt,name=is_legacy,json=isLegacy,proto3" json:"is_legacy,omitempty"`
+}
+
+func (x *QueryTotalRewardByEpochResponse) Reset() {
+	*x = QueryTotalRewardByEpochResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stratos_pot_v1_query_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryTotalRewardByEpochResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryTotalRewardByEpochResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryTotalRewardByEpochResponse.ProtoReflect.Descriptor instead.
+func (*QueryTotalRewardByEpochResponse) Descriptor() ([]byte, []int) {
+	return file_stratos_pot_v1_query_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *QueryTotalRewardByEpochResponse) GetTotalReward() *TotalReward {
+	if x != nil {
+		return x.TotalReward
+	}
+	return nil
+}
+
+func (x *QueryTotalRewardByEpochResponse) GetIsLegacy() bool {
+	if x != nil {
+		return x.IsLegacy
+	}
+	return false
+}
+
+type QueryMetricsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *QueryMetricsRequest) Reset() {
+	*x = QueryMetricsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stratos_pot_v1_query_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryMetricsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryMetricsRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryMetricsRequest.ProtoReflect.Descriptor instead.
+func (*QueryMetricsRequest) Descriptor() ([]byte, []int) {
+	return file_stratos_pot_v1_query_proto_rawDescGZIP(), []int{20}
 }
 
-// QueryRewardsByEpochResponse is response type for the Query/RewardsByEpoch RPC method
-type QueryRewardsByEpochResponse struct {
+type QueryMetricsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Rewards    []*Reward             `protobuf:"bytes,1,rep,name=rewards,proto3" json:"rewards,omitempty"`
-	Pagination *v1beta1.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Metrics *Metrics `protobuf:"bytes,1,opt,name=metrics,proto3" json:"metrics,omitempty"`
 }
 
-func (x *QueryRewardsByEpochResponse) Reset() {
-	*x = QueryRewardsByEpochResponse{}
+func (x *QueryMetricsResponse) Reset() {
+	*x = QueryMetricsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_stratos_pot_v1_query_proto_msgTypes[6]
+		mi := &file_stratos_pot_v1_query_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryRewardsByEpochResponse) String() string {
+func (x *QueryMetricsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryRewardsByEpochResponse) ProtoMessage() {}
-
-// Deprecated: Use QueryRewardsByEpochResponse.ProtoReflect.Descriptor instead.
-func (*QueryRewardsByEpochResponse) Descriptor() ([]byte, []int) {
-	return file_stratos_pot_v1_query_proto_rawDescGZIP(), []int{6}
-}
+func (*QueryMetricsResponse) ProtoMessage() {}
 
-func (x *QueryRewardsByEpochResponse) GetRewards() []*Reward {
-	if x != nil {
-		return x.Rewards
-	}
-	return nil
+// Deprecated: Use QueryMetricsResponse.ProtoReflect.Descriptor instead.
+func (*QueryMetricsResponse) Descriptor() ([]byte, []int) {
+	return file_stratos_pot_v1_query_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *QueryRewardsByEpochResponse) GetPagination() *v1beta1.PageResponse {
+func (x *QueryMetricsResponse) GetMetrics() *Metrics {
 	if x != nil {
-		return x.Pagination
+		return x.Metrics
 	}
 	return nil
 }
 
-// QueryRewardsByOwnerRequest is request type for the Query/RewardsByOwner by a given owner RPC method
-type QueryRewardsByWalletRequest struct {
+// QueryPendingVolumeReportRequest is request type for the Query/PendingVolumeReport RPC method
+type QueryPendingVolumeReportRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	WalletAddress string `protobuf:"bytes,1,opt,name=wallet_address,json=walletAddress,proto3" json:"wallet_address,omitempty"`
 }
 
-func (x *QueryRewardsByWalletRequest) Reset() {
-	*x = QueryRewardsByWalletRequest{}
+func (x *QueryPendingVolumeReportRequest) Reset() {
+	*x = QueryPendingVolumeReportRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_stratos_pot_v1_query_proto_msgTypes[7]
+		mi := &file_stratos_pot_v1_query_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryRewardsByWalletRequest) String() string {
+func (x *QueryPendingVolumeReportRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryRewardsByWalletRequest) ProtoMessage() {}
-
-// Deprecated: Use QueryRewardsByWalletRequest.ProtoReflect.Descriptor instead.
-func (*QueryRewardsByWalletRequest) Descriptor() ([]byte, []int) {
-	return file_stratos_pot_v1_query_proto_rawDescGZIP(), []int{7}
-}
+func (*QueryPendingVolumeReportRequest) ProtoMessage() {}
 
-func (x *QueryRewardsByWalletRequest) GetWalletAddress() string {
-	if x != nil {
-		return x.WalletAddress
-	}
-	return ""
+// Deprecated: Use QueryPendingVolumeReportRequest.ProtoReflect.Descriptor instead.
+func (*QueryPendingVolumeReportRequest) Descriptor() ([]byte, []int) {
+	return file_stratos_pot_v1_query_proto_rawDescGZIP(), []int{22}
 }
 
-// QueryRewardsByOwnerResponse is response type for the Query/RewardsByOwner RPC method
-type QueryRewardsByWalletResponse struct {
+// QueryPendingVolumeReportResponse is response type for the Query/PendingVolumeReport RPC method
+type QueryPendingVolumeReportResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Rewards *RewardByWallet `protobuf:"bytes,1,opt,name=rewards,proto3" json:"rewards,omitempty"`
+	Report *PendingVolumeReport `protobuf:"bytes,1,opt,name=report,proto3" json:"report,omitempty"`
 }
 
-func (x *QueryRewardsByWalletResponse) Reset() {
-	*x = QueryRewardsByWalletResponse{}
+func (x *QueryPendingVolumeReportResponse) Reset() {
+	*x = QueryPendingVolumeReportResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_stratos_pot_v1_query_proto_msgTypes[8]
+		mi := &file_stratos_pot_v1_query_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryRewardsByWalletResponse) String() string {
+func (x *QueryPendingVolumeReportResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryRewardsByWalletResponse) ProtoMessage() {}
+func (*QueryPendingVolumeReportResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryRewardsByWalletResponse.ProtoReflect.Descriptor instead.
-func (*QueryRewardsByWalletResponse) Descriptor() ([]byte, []int) {
-	return file_stratos_pot_v1_query_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use QueryPendingVolumeReportResponse.ProtoReflect.Descriptor instead.
+func (*QueryPendingVolumeReportResponse) Descriptor() ([]byte, []int) {
+	return file_stratos_pot_v1_query_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *QueryRewardsByWalletResponse) GetRewards() *RewardByWallet {
+func (x *QueryPendingVolumeReportResponse) GetReport() *PendingVolumeReport {
 	if x != nil {
-		return x.Rewards
+		return x.Report
 	}
 	return nil
 }
 
-type QueryRewardsByWalletAndEpochRequest struct {
+// QueryVolumeReportDisputeRequest is request type for the Query/VolumeReportDispute RPC method
+type QueryVolumeReportDisputeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	WalletAddress string               `protobuf:"bytes,1,opt,name=wallet_address,json=walletAddress,proto3" json:"wallet_address,omitempty"`
-	Epoch         int64                `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
-	Pagination    *v1beta1.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Epoch int64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
 }
 
-func (x *QueryRewardsByWalletAndEpochRequest) Reset() {
-	*x = QueryRewardsByWalletAndEpochRequest{}
+func (x *QueryVolumeReportDisputeRequest) Reset() {
+	*x = QueryVolumeReportDisputeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_stratos_pot_v1_query_proto_msgTypes[9]
+		mi := &file_stratos_pot_v1_query_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryRewardsByWalletAndEpochRequest) String() string {
+func (x *QueryVolumeReportDisputeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryRewardsByWalletAndEpochRequest) ProtoMessage() {}
-
-// Deprecated: Use QueryRewardsByWalletAndEpochRequest.ProtoReflect.Descriptor instead.
-func (*QueryRewardsByWalletAndEpochRequest) Descriptor() ([]byte, []int) {
-	return file_stratos_pot_v1_query_proto_rawDescGZIP(), []int{9}
-}
+func (*QueryVolumeReportDisputeRequest) ProtoMessage() {}
 
-func (x *QueryRewardsByWalletAndEpochRequest) GetWalletAddress() string {
-	if x != nil {
-		return x.WalletAddress
-	}
-	return ""
+// Deprecated: Use QueryVolumeReportDisputeRequest.ProtoReflect.Descriptor instead.
+func (*QueryVolumeReportDisputeRequest) Descriptor() ([]byte, []int) {
+	return file_stratos_pot_v1_query_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *QueryRewardsByWalletAndEpochRequest) GetEpoch() int64 {
+func (x *QueryVolumeReportDisputeRequest) GetEpoch() int64 {
 	if x != nil {
 		return x.Epoch
 	}
 	return 0
 }
 
-func (x *QueryRewardsByWalletAndEpochRequest) GetPagination() *v1beta1.PageRequest {
-	if x != nil {
-		return x.Pagination
-	}
-	return nil
-}
-
-type QueryRewardsByWalletAndEpochResponse struct {
+// QueryVolumeReportDisputeResponse is response type for the Query/VolumeReportDispute RPC method
+type QueryVolumeReportDisputeResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Rewards    []*Reward             `protobuf:"bytes,1,rep,name=rewards,proto3" json:"rewards,omitempty"`
-	Pagination *v1beta1.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Dispute *VolumeReportDispute `protobuf:"bytes,1,opt,name=dispute,proto3" json:"dispute,omitempty"`
 }
 
-func (x *QueryRewardsByWalletAndEpochResponse) Reset() {
-	*x = QueryRewardsByWalletAndEpochResponse{}
+func (x *QueryVolumeReportDisputeResponse) Reset() {
+	*x = QueryVolumeReportDisputeResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_stratos_pot_v1_query_proto_msgTypes[10]
+		mi := &file_stratos_pot_v1_query_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryRewardsByWalletAndEpochResponse) String() string {
+func (x *QueryVolumeReportDisputeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryRewardsByWalletAndEpochResponse) ProtoMessage() {}
-
-// Deprecated: Use QueryRewardsByWalletAndEpochResponse.ProtoReflect.Descriptor instead.
-func (*QueryRewardsByWalletAndEpochResponse) Descriptor() ([]byte, []int) {
-	return file_stratos_pot_v1_query_proto_rawDescGZIP(), []int{10}
-}
+func (*QueryVolumeReportDisputeResponse) ProtoMessage() {}
 
-func (x *QueryRewardsByWalletAndEpochResponse) GetRewards() []*Reward {
-	if x != nil {
-		return x.Rewards
-	}
-	return nil
+// Deprecated: Use QueryVolumeReportDisputeResponse.ProtoReflect.Descriptor instead.
+func (*QueryVolumeReportDisputeResponse) Descriptor() ([]byte, []int) {
+	return file_stratos_pot_v1_query_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *QueryRewardsByWalletAndEpochResponse) GetPagination() *v1beta1.PageResponse {
+func (x *QueryVolumeReportDisputeResponse) GetDispute() *VolumeReportDispute {
 	if x != nil {
-		return x.Pagination
+		return x.Dispute
 	}
 	return nil
 }
 
-type RewardByWallet struct {
+// QueryDistributionProgressRequest is request type for the Query/DistributionProgress RPC method
+type QueryDistributionProgressRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	WalletAddress       string           `protobuf:"bytes,1,opt,name=wallet_address,json=walletAddress,proto3" json:"wallet_address,omitempty"`
-	MatureTotalReward   []*v1beta11.Coin `protobuf:"bytes,2,rep,name=mature_total_reward,json=matureTotalReward,proto3" json:"mature_total_reward,omitempty"`
-	ImmatureTotalReward []*v1beta11.Coin `protobuf:"bytes,3,rep,name=immature_total_reward,json=immatureTotalReward,proto3" json:"immature_total_reward,omitempty"`
 }
 
-func (x *RewardByWallet) Reset() {
-	*x = RewardByWallet{}
+func (x *QueryDistributionProgressRequest) Reset() {
+	*x = QueryDistributionProgressRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_stratos_pot_v1_query_proto_msgTypes[11]
+		mi := &file_stratos_pot_v1_query_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RewardByWallet) String() string {
+func (x *QueryDistributionProgressRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RewardByWallet) ProtoMessage() {}
-
-// Deprecated: Use RewardByWallet.ProtoReflect.Descriptor instead.
-func (*RewardByWallet) Descriptor() ([]byte, []int) {
-	return file_stratos_pot_v1_query_proto_rawDescGZIP(), []int{11}
-}
-
-func (x *RewardByWallet) GetWalletAddress() string {
-	if x != nil {
-		return x.WalletAddress
-	}
-	return ""
-}
-
-func (x *RewardByWallet) GetMatureTotalReward() []*v1beta11.Coin {
-	if x != nil {
-		return x.MatureTotalReward
-	}
-	return nil
-}
+func (*QueryDistributionProgressRequest) ProtoMessage() {}
 
-func (x *RewardByWallet) GetImmatureTotalReward() []*v1beta11.Coin {
-	if x != nil {
-		return x.ImmatureTotalReward
-	}
-	return nil
+// Deprecated: Use QueryDistributionProgressRequest.ProtoReflect.Descriptor instead.
+func (*QueryDistributionProgressRequest) Descriptor() ([]byte, []int) {
+	return file_stratos_pot_v1_query_proto_rawDescGZIP(), []int{26}
 }
 
-// QuerySlashingByOwnerRequest is request type for the Query/Slashing by a given owner RPC method
-type QuerySlashingByOwnerRequest struct {
+// QueryDistributionProgressResponse is response type for the Query/DistributionProgress RPC method
+type QueryDistributionProgressResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	WalletAddress string `protobuf:"bytes,1,opt,name=wallet_address,json=walletAddress,proto3" json:"wallet_address,omitempty"`
+	LastDistributedEpoch string                 `protobuf:"bytes,1,opt,name=last_distributed_epoch,json=lastDistributedEpoch,proto3" json:"last_distributed_epoch,omitempty"`
+	Distributions        []*PendingDistribution `protobuf:"bytes,2,rep,name=distributions,proto3" json:"distributions,omitempty"`
 }
 
-func (x *QuerySlashingByOwnerRequest) Reset() {
-	*x = QuerySlashingByOwnerRequest{}
+func (x *QueryDistributionProgressResponse) Reset() {
+	*x = QueryDistributionProgressResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_stratos_pot_v1_query_proto_msgTypes[12]
+		mi := &file_stratos_pot_v1_query_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QuerySlashingByOwnerRequest) String() string {
+func (x *QueryDistributionProgressResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QuerySlashingByOwnerRequest) ProtoMessage() {}
+func (*QueryDistributionProgressResponse) ProtoMessage() {}
 
-// Deprecated: Use QuerySlashingByOwnerRequest.ProtoReflect.Descriptor instead.
-func (*QuerySlashingByOwnerRequest) Descriptor() ([]byte, []int) {
-	return file_stratos_pot_v1_query_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use QueryDistributionProgressResponse.ProtoReflect.Descriptor instead.
+func (*QueryDistributionProgressResponse) Descriptor() ([]byte, []int) {
+	return file_stratos_pot_v1_query_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *QuerySlashingByOwnerRequest) GetWalletAddress() string {
+func (x *QueryDistributionProgressResponse) GetLastDistributedEpoch() string {
 	if x != nil {
-		return x.WalletAddress
+		return x.LastDistributedEpoch
 	}
 	return ""
 }
 
-// QuerySlashingByOwnerResponse is response type for the Query/Slashing RPC method
-type QuerySlashingByOwnerResponse struct {
+func (x *QueryDistributionProgressResponse) GetDistributions() []*PendingDistribution {
+	if x != nil {
+		return x.Distributions
+	}
+	return nil
+}
+
+// QueryVestingScheduleRequest is request type for the Query/VestingSchedule RPC method
+type QueryVestingScheduleRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Slashing string `protobuf:"bytes,1,opt,name=slashing,proto3" json:"slashing,omitempty"`
+	WalletAddress string               `protobuf:"bytes,1,opt,name=wallet_address,json=walletAddress,proto3" json:"wallet_address,omitempty"`
+	Pagination    *v1beta1.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *QuerySlashingByOwnerResponse) Reset() {
-	*x = QuerySlashingByOwnerResponse{}
+func (x *QueryVestingScheduleRequest) Reset() {
+	*x = QueryVestingScheduleRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_stratos_pot_v1_query_proto_msgTypes[13]
+		mi := &file_stratos_pot_v1_query_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QuerySlashingByOwnerResponse) String() string {
+func (x *QueryVestingScheduleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QuerySlashingByOwnerResponse) ProtoMessage() {}
+func (*QueryVestingScheduleRequest) ProtoMessage() {}
 
-// Deprecated: Use QuerySlashingByOwnerResponse.ProtoReflect.Descriptor instead.
-func (*QuerySlashingByOwnerResponse) Descriptor() ([]byte, []int) {
-	return file_stratos_pot_v1_query_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use QueryVestingScheduleRequest.ProtoReflect.Descriptor instead.
+func (*QueryVestingScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_stratos_pot_v1_query_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *QuerySlashingByOwnerResponse) GetSlashing() string {
+func (x *QueryVestingScheduleRequest) GetWalletAddress() string {
 	if x != nil {
-		return x.Slashing
+		return x.WalletAddress
 	}
 	return ""
 }
 
-type QueryTotalMinedTokenRequest struct {
+func (x *QueryVestingScheduleRequest) GetPagination() *v1beta1.PageRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// QueryVestingScheduleResponse is response type for the Query/VestingSchedule RPC method
+type QueryVestingScheduleResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	WalletAddress       string                  `protobuf:"bytes,1,opt,name=wallet_address,json=walletAddress,proto3" json:"wallet_address,omitempty"`
+	VestingMode         string                  `protobuf:"bytes,2,opt,name=vesting_mode,json=vestingMode,proto3" json:"vesting_mode,omitempty"`
+	MaturedEpoch        int64                   `protobuf:"varint,3,opt,name=matured_epoch,json=maturedEpoch,proto3" json:"matured_epoch,omitempty"`
+	Entries             []*VestingScheduleEntry `protobuf:"bytes,4,rep,name=entries,proto3" json:"entries,omitempty"`
+	MatureTotalReward   []*v1beta11.Coin        `protobuf:"bytes,5,rep,name=mature_total_reward,json=matureTotalReward,proto3" json:"mature_total_reward,omitempty"`
+	ImmatureTotalReward []*v1beta11.Coin        `protobuf:"bytes,6,rep,name=immature_total_reward,json=immatureTotalReward,proto3" json:"immature_total_reward,omitempty"`
+	Pagination          *v1beta1.PageResponse   `protobuf:"bytes,7,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// linear_vesting is the matured reward of the wallet vesting linearly, nil if none
+	LinearVesting *VestingSchedule `protobuf:"bytes,8,opt,name=linear_vesting,json=linearVesting,proto3" json:"linear_vesting,omitempty"`
 }
 
-func (x *QueryTotalMinedTokenRequest) Reset() {
-	*x = QueryTotalMinedTokenRequest{}
+func (x *QueryVestingScheduleResponse) Reset() {
+	*x = QueryVestingScheduleResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_stratos_pot_v1_query_proto_msgTypes[14]
+		mi := &file_stratos_pot_v1_query_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryTotalMinedTokenRequest) String() string {
+func (x *QueryVestingScheduleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryTotalMinedTokenRequest) ProtoMessage() {}
-
-// Deprecated: Use QueryTotalMinedTokenRequest.ProtoReflect.Descriptor instead.
-func (*QueryTotalMinedTokenRequest) Descriptor() ([]byte, []int) {
-	return file_stratos_pot_v1_query_proto_rawDescGZIP(), []int{14}
-}
-
-type QueryTotalMinedTokenResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (*QueryVestingScheduleResponse) ProtoMessage() {}
 
-	TotalMinedToken *v1beta11.Coin `protobuf:"bytes,1,opt,name=total_mined_token,json=totalMinedToken,proto3" json:"total_mined_token,omitempty"`
+// Deprecated: Use QueryVestingScheduleResponse.ProtoReflect.Descriptor instead.
+func (*QueryVestingScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_stratos_pot_v1_query_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *QueryTotalMinedTokenResponse) Reset() {
-	*x = QueryTotalMinedTokenResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_stratos_pot_v1_query_proto_msgTypes[15]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *QueryVestingScheduleResponse) GetWalletAddress() string {
+	if x != nil {
+		return x.WalletAddress
 	}
+	return ""
 }
 
-func (x *QueryTotalMinedTokenResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *QueryVestingScheduleResponse) GetVestingMode() string {
+	if x != nil {
+		return x.VestingMode
+	}
+	return ""
 }
 
-func (*QueryTotalMinedTokenResponse) ProtoMessage() {}
-
-// Deprecated: Use QueryTotalMinedTokenResponse.ProtoReflect.Descriptor instead.
-func (*QueryTotalMinedTokenResponse) Descriptor() ([]byte, []int) {
-	return file_stratos_pot_v1_query_proto_rawDescGZIP(), []int{15}
+func (x *QueryVestingScheduleResponse) GetMaturedEpoch() int64 {
+	if x != nil {
+		return x.MaturedEpoch
+	}
+	return 0
 }
 
-func (x *QueryTotalMinedTokenResponse) GetTotalMinedToken() *v1beta11.Coin {
+func (x *QueryVestingScheduleResponse) GetEntries() []*VestingScheduleEntry {
 	if x != nil {
-		return x.TotalMinedToken
+		return x.Entries
 	}
 	return nil
 }
 
-type QueryCirculationSupplyRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *QueryVestingScheduleResponse) GetMatureTotalReward() []*v1beta11.Coin {
+	if x != nil {
+		return x.MatureTotalReward
+	}
+	return nil
 }
 
-func (x *QueryCirculationSupplyRequest) Reset() {
-	*x = QueryCirculationSupplyRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_stratos_pot_v1_query_proto_msgTypes[16]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *QueryVestingScheduleResponse) GetImmatureTotalReward() []*v1beta11.Coin {
+	if x != nil {
+		return x.ImmatureTotalReward
 	}
+	return nil
 }
 
-func (x *QueryCirculationSupplyRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *QueryVestingScheduleResponse) GetPagination() *v1beta1.PageResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
 }
 
-func (*QueryCirculationSupplyRequest) ProtoMessage() {}
-
-// Deprecated: Use QueryCirculationSupplyRequest.ProtoReflect.Descriptor instead.
-func (*QueryCirculationSupplyRequest) Descriptor() ([]byte, []int) {
-	return file_stratos_pot_v1_query_proto_rawDescGZIP(), []int{16}
+func (x *QueryVestingScheduleResponse) GetLinearVesting() *VestingSchedule {
+	if x != nil {
+		return x.LinearVesting
+	}
+	return nil
 }
 
-type QueryCirculationSupplyResponse struct {
+// VestingScheduleEntry is the individual reward of a wallet maturing at an epoch
+type VestingScheduleEntry struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CirculationSupply []*v1beta11.Coin `protobuf:"bytes,3,rep,name=circulation_supply,json=circulationSupply,proto3" json:"circulation_supply,omitempty"`
+	MatureEpoch           int64            `protobuf:"varint,1,opt,name=mature_epoch,json=matureEpoch,proto3" json:"mature_epoch,omitempty"`
+	RewardFromMiningPool  []*v1beta11.Coin `protobuf:"bytes,2,rep,name=reward_from_mining_pool,json=rewardFromMiningPool,proto3" json:"reward_from_mining_pool,omitempty"`
+	RewardFromTrafficPool []*v1beta11.Coin `protobuf:"bytes,3,rep,name=reward_from_traffic_pool,json=rewardFromTrafficPool,proto3" json:"reward_from_traffic_pool,omitempty"`
 }
 
-func (x *QueryCirculationSupplyResponse) Reset() {
-	*x = QueryCirculationSupplyResponse{}
+func (x *VestingScheduleEntry) Reset() {
+	*x = VestingScheduleEntry{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_stratos_pot_v1_query_proto_msgTypes[17]
+		mi := &file_stratos_pot_v1_query_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryCirculationSupplyResponse) String() string {
+func (x *VestingScheduleEntry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryCirculationSupplyResponse) ProtoMessage() {}
+func (*VestingScheduleEntry) ProtoMessage() {}
 
-// Deprecated: Use QueryCirculationSupplyResponse.ProtoReflect.Descriptor instead.
-func (*QueryCirculationSupplyResponse) Descriptor() ([]byte, []int) {
-	return file_stratos_pot_v1_query_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use VestingScheduleEntry.ProtoReflect.Descriptor instead.
+func (*VestingScheduleEntry) Descriptor() ([]byte, []int) {
+	return file_stratos_pot_v1_query_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *QueryCirculationSupplyResponse) GetCirculationSupply() []*v1beta11.Coin {
+func (x *VestingScheduleEntry) GetMatureEpoch() int64 {
 	if x != nil {
-		return x.CirculationSupply
+		return x.MatureEpoch
+	}
+	return 0
+}
+
+func (x *VestingScheduleEntry) GetRewardFromMiningPool() []*v1beta11.Coin {
+	if x != nil {
+		return x.RewardFromMiningPool
 	}
 	return nil
 }
 
-type QueryTotalRewardByEpochRequest struct {
+func (x *VestingScheduleEntry) GetRewardFromTrafficPool() []*v1beta11.Coin {
+	if x != nil {
+		return x.RewardFromTrafficPool
+	}
+	return nil
+}
+
+// QueryRewardHistoryRequest is request type for the Query/RewardHistory RPC method
+type QueryRewardHistoryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Epoch int64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	WalletAddress string `protobuf:"bytes,1,opt,name=wallet_address,json=walletAddress,proto3" json:"wallet_address,omitempty"`
+	// start_epoch is the first epoch of the range, 0 for no lower bound
+	StartEpoch int64 `protobuf:"varint,2,opt,name=start_epoch,json=startEpoch,proto3" json:"start_epoch,omitempty"`
+	// end_epoch is the last epoch of the range, 0 for no upper bound
+	EndEpoch   int64                `protobuf:"varint,3,opt,name=end_epoch,json=endEpoch,proto3" json:"end_epoch,omitempty"`
+	Pagination *v1beta1.PageRequest `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *QueryTotalRewardByEpochRequest) Reset() {
-	*x = QueryTotalRewardByEpochRequest{}
+func (x *QueryRewardHistoryRequest) Reset() {
+	*x = QueryRewardHistoryRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_stratos_pot_v1_query_proto_msgTypes[18]
+		mi := &file_stratos_pot_v1_query_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryTotalRewardByEpochRequest) String() string {
+func (x *QueryRewardHistoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryTotalRewardByEpochRequest) ProtoMessage() {}
+func (*QueryRewardHistoryRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryTotalRewardByEpochRequest.ProtoReflect.Descriptor instead.
-func (*QueryTotalRewardByEpochRequest) Descriptor() ([]byte, []int) {
-	return file_stratos_pot_v1_query_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use QueryRewardHistoryRequest.ProtoReflect.Descriptor instead.
+func (*QueryRewardHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_stratos_pot_v1_query_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *QueryTotalRewardByEpochRequest) GetEpoch() int64 {
+func (x *QueryRewardHistoryRequest) GetWalletAddress() string {
 	if x != nil {
-		return x.Epoch
+		return x.WalletAddress
+	}
+	return ""
+}
+
+func (x *QueryRewardHistoryRequest) GetStartEpoch() int64 {
+	if x != nil {
+		return x.StartEpoch
 	}
 	return 0
 }
 
-type QueryTotalRewardByEpochResponse struct {
+func (x *QueryRewardHistoryRequest) GetEndEpoch() int64 {
+	if x != nil {
+		return x.EndEpoch
+	}
+	return 0
+}
+
+func (x *QueryRewardHistoryRequest) GetPagination() *v1beta1.PageRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// QueryRewardHistoryResponse is response type for the Query/RewardHistory RPC method
+type QueryRewardHistoryResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TotalReward *TotalReward `protobuf:"bytes,1,opt,name=total_reward,json=totalReward,proto3" json:"total_reward,omitempty"`
-	IsLegacy    bool         `protobuf:"varint,2,opt,name=is_legacy,json=isLegacy,proto3" json:"is_legacy,omitempty"`
+	Histories  []*RewardHistory      `protobuf:"bytes,1,rep,name=histories,proto3" json:"histories,omitempty"`
+	Pagination *v1beta1.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *QueryTotalRewardByEpochResponse) Reset() {
-	*x = QueryTotalRewardByEpochResponse{}
+func (x *QueryRewardHistoryResponse) Reset() {
+	*x = QueryRewardHistoryResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_stratos_pot_v1_query_proto_msgTypes[19]
+		mi := &file_stratos_pot_v1_query_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryTotalRewardByEpochResponse) String() string {
+func (x *QueryRewardHistoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryTotalRewardByEpochResponse) ProtoMessage() {}
+func (*QueryRewardHistoryResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryTotalRewardByEpochResponse.ProtoReflect.Descriptor instead.
-func (*QueryTotalRewardByEpochResponse) Descriptor() ([]byte, []int) {
-	return file_stratos_pot_v1_query_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use QueryRewardHistoryResponse.ProtoReflect.Descriptor instead.
+func (*QueryRewardHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_stratos_pot_v1_query_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *QueryTotalRewardByEpochResponse) GetTotalReward() *TotalReward {
+func (x *QueryRewardHistoryResponse) GetHistories() []*RewardHistory {
 	if x != nil {
-		return x.TotalReward
+		return x.Histories
 	}
 	return nil
 }
 
-func (x *QueryTotalRewardByEpochResponse) GetIsLegacy() bool {
+func (x *QueryRewardHistoryResponse) GetPagination() *v1beta1.PageResponse {
 	if x != nil {
-		return x.IsLegacy
+		return x.Pagination
 	}
-	return false
+	return nil
 }
 
-type QueryMetricsRequest struct {
+// QueryAutoRestakeRequest is request type for the Query/AutoRestake RPC method
+type QueryAutoRestakeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	WalletAddress string `protobuf:"bytes,1,opt,name=wallet_address,json=walletAddress,proto3" json:"wallet_address,omitempty"`
 }
 
-func (x *QueryMetricsRequest) Reset() {
-	*x = QueryMetricsRequest{}
+func (x *QueryAutoRestakeRequest) Reset() {
+	*x = QueryAutoRestakeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_stratos_pot_v1_query_proto_msgTypes[20]
+		mi := &file_stratos_pot_v1_query_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryMetricsRequest) String() string {
+func (x *QueryAutoRestakeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryMetricsRequest) ProtoMessage() {}
+func (*QueryAutoRestakeRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryMetricsRequest.ProtoReflect.Descriptor instead.
-func (*QueryMetricsRequest) Descriptor() ([]byte, []int) {
-	return file_stratos_pot_v1_query_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use QueryAutoRestakeRequest.ProtoReflect.Descriptor instead.
+func (*QueryAutoRestakeRequest) Descriptor() ([]byte, []int) {
+	return file_stratos_pot_v1_query_proto_rawDescGZIP(), []int{33}
 }
 
-type QueryMetricsResponse struct {
+func (x *QueryAutoRestakeRequest) GetWalletAddress() string {
+	if x != nil {
+		return x.WalletAddress
+	}
+	return ""
+}
+
+// QueryAutoRestakeResponse is response type for the Query/AutoRestake RPC method
+type QueryAutoRestakeResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Metrics *Metrics `protobuf:"bytes,1,opt,name=metrics,proto3" json:"metrics,omitempty"`
+	AutoRestake *AutoRestake `protobuf:"bytes,1,opt,name=auto_restake,json=autoRestake,proto3" json:"auto_restake,omitempty"`
 }
 
-func (x *QueryMetricsResponse) Reset() {
-	*x = QueryMetricsResponse{}
+func (x *QueryAutoRestakeResponse) Reset() {
+	*x = QueryAutoRestakeResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_stratos_pot_v1_query_proto_msgTypes[21]
+		mi := &file_stratos_pot_v1_query_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryMetricsResponse) String() string {
+func (x *QueryAutoRestakeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryMetricsResponse) ProtoMessage() {}
+func (*QueryAutoRestakeResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryMetricsResponse.ProtoReflect.Descriptor instead.
-func (*QueryMetricsResponse) Descriptor() ([]byte, []int) {
-	return file_stratos_pot_v1_query_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use QueryAutoRestakeResponse.ProtoReflect.Descriptor instead.
+func (*QueryAutoRestakeResponse) Descriptor() ([]byte, []int) {
+	return file_stratos_pot_v1_query_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *QueryMetricsResponse) GetMetrics() *Metrics {
+func (x *QueryAutoRestakeResponse) GetAutoRestake() *AutoRestake {
 	if x != nil {
-		return x.Metrics
+		return x.AutoRestake
 	}
 	return nil
 }
@@ -11142,50 +18565,248 @@ var file_stratos_pot_v1_query_proto_rawDesc = []byte{
 	0x0a, 0x07, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
 	0x17, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e, 0x70, 0x6f, 0x74, 0x2e, 0x76, 0x31,
 	0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x07,
-	0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x32, 0x9a, 0x0c, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x12, 0x92, 0x01, 0x0a, 0x0c, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x70, 0x6f,
-	0x72, 0x74, 0x12, 0x28, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e, 0x70, 0x6f, 0x74,
-	0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52,
-	0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x73,
-	0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e, 0x70, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27, 0x12,
-	0x25, 0x2f, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2f, 0x70, 0x6f, 0x74, 0x2f, 0x76, 0x31,
-	0x2f, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x2f, 0x7b,
-	0x65, 0x70, 0x6f, 0x63, 0x68, 0x7d, 0x12, 0x98, 0x01, 0x0a, 0x0e, 0x52, 0x65, 0x77, 0x61, 0x72,
-	0x64, 0x73, 0x42, 0x79, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x2a, 0x2e, 0x73, 0x74, 0x72, 0x61,
-	0x74, 0x6f, 0x73, 0x2e, 0x70, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x42, 0x79, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e,
-	0x70, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x77, 0x61,
-	0x72, 0x64, 0x73, 0x42, 0x79, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x22, 0x21, 0x0a, 0x1f, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x5f, 0x0a, 0x20, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b,
+	0x0a, 0x06, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23,
+	0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e, 0x70, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x52, 0x06, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x37, 0x0a, 0x1f, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x44, 0x69, 0x73, 0x70, 0x75, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x65,
+	0x70, 0x6f, 0x63, 0x68, 0x22, 0x61, 0x0a, 0x20, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x6f, 0x6c,
+	0x75, 0x6d, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x69, 0x73, 0x70, 0x75, 0x74, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x07, 0x64, 0x69, 0x73, 0x70,
+	0x75, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x73, 0x74, 0x72, 0x61,
+	0x74, 0x6f, 0x73, 0x2e, 0x70, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6f, 0x6c, 0x75, 0x6d,
+	0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x69, 0x73, 0x70, 0x75, 0x74, 0x65, 0x52, 0x07,
+	0x64, 0x69, 0x73, 0x70, 0x75, 0x74, 0x65, 0x22, 0x22, 0x0a, 0x20, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x67,
+	0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xda, 0x01, 0x0a, 0x21,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x64, 0x0a, 0x16, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x65, 0x64, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x2e, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x26, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x49, 0x6e,
+	0x74, 0x52, 0x14, 0x6c, 0x61, 0x73, 0x74, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x65, 0x64, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x4f, 0x0a, 0x0d, 0x64, 0x69, 0x73, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23,
+	0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e, 0x70, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0d, 0x64, 0x69, 0x73, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x8c, 0x01, 0x0a, 0x1b, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x56, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x77, 0x61, 0x6c, 0x6c,
+	0x65, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73,
+	0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xe2, 0x04, 0x0a, 0x1c, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x56, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x77, 0x61, 0x6c, 0x6c,
+	0x65, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x21, 0x0a, 0x0c, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x4d, 0x6f,
+	0x64, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x61, 0x74, 0x75, 0x72, 0x65, 0x64, 0x5f, 0x65, 0x70,
+	0x6f, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6d, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x64, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x44, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69,
+	0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74,
+	0x6f, 0x73, 0x2e, 0x70, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x65, 0x73, 0x74, 0x69, 0x6e,
+	0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x04,
+	0xc8, 0xde, 0x1f, 0x00, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x7b, 0x0a,
+	0x13, 0x6d, 0x61, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x72, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x11, 0x6d, 0x61, 0x74, 0x75, 0x72, 0x65, 0x54,
+	0x6f, 0x74, 0x61, 0x6c, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x7f, 0x0a, 0x15, 0x69, 0x6d,
+	0x6d, 0x61, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x43, 0x6f, 0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x13, 0x69, 0x6d, 0x6d, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x54, 0x6f, 0x74, 0x61, 0x6c, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x47, 0x0a, 0x0a, 0x70,
+	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75,
+	0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x46, 0x0a, 0x0e, 0x6c, 0x69, 0x6e, 0x65, 0x61, 0x72, 0x5f, 0x76,
+	0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x73,
+	0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e, 0x70, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x65,
+	0x73, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x0d, 0x6c,
+	0x69, 0x6e, 0x65, 0x61, 0x72, 0x56, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x22, 0xc5, 0x02, 0x0a,
+	0x14, 0x56, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x61, 0x74, 0x75, 0x72, 0x65, 0x5f,
+	0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x6d, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x82, 0x01, 0x0a, 0x17, 0x72, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x6d, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x5f,
+	0x70, 0x6f, 0x6f, 0x6c, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x14, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x46,
+	0x72, 0x6f, 0x6d, 0x4d, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x84, 0x01,
+	0x0a, 0x18, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x74, 0x72,
+	0x61, 0x66, 0x66, 0x69, 0x63, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f,
+	0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64,
+	0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x15, 0x72,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63,
+	0x50, 0x6f, 0x6f, 0x6c, 0x22, 0xc8, 0x01, 0x0a, 0x19, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x77, 0x61, 0x6c, 0x6c,
+	0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x6e,
+	0x64, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x65,
+	0x6e, 0x64, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0xa8, 0x01, 0x0a, 0x1a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41,
+	0x0a, 0x09, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e, 0x70, 0x6f, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x09, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x65,
+	0x73, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62,
+	0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a,
+	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x40, 0x0a, 0x17, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x41, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x77,
+	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x5a, 0x0a, 0x18,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x73, 0x74, 0x61, 0x6b, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0c, 0x61, 0x75, 0x74, 0x6f,
+	0x5f, 0x72, 0x65, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e, 0x70, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x41, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x52, 0x0b, 0x61, 0x75, 0x74,
+	0x6f, 0x52, 0x65, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x32, 0x89, 0x14, 0x0a, 0x05, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x12, 0x92, 0x01, 0x0a, 0x0c, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x12, 0x28, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e, 0x70, 0x6f,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e,
+	0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e, 0x70, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27,
+	0x12, 0x25, 0x2f, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2f, 0x70, 0x6f, 0x74, 0x2f, 0x76,
+	0x31, 0x2f, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x2f,
+	0x7b, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x7d, 0x12, 0xa7, 0x01, 0x0a, 0x13, 0x50, 0x65, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12,
+	0x2f, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e, 0x70, 0x6f, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x56, 0x6f, 0x6c,
+	0x75, 0x6d, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x30, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e, 0x70, 0x6f, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x56, 0x6f,
+	0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
 	0x73, 0x65, 0x22, 0x2d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27, 0x12, 0x25, 0x2f, 0x73, 0x74, 0x72,
-	0x61, 0x74, 0x6f, 0x73, 0x2f, 0x70, 0x6f, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x77, 0x61,
-	0x72, 0x64, 0x73, 0x2f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x2f, 0x7b, 0x65, 0x70, 0x6f, 0x63, 0x68,
-	0x7d, 0x12, 0xa5, 0x01, 0x0a, 0x0f, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x42, 0x79, 0x57,
-	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x12, 0x2b, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e,
-	0x70, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x77, 0x61,
-	0x72, 0x64, 0x73, 0x42, 0x79, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e, 0x70, 0x6f, 0x74,
-	0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73,
-	0x42, 0x79, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x37, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x31, 0x12, 0x2f, 0x2f, 0x73, 0x74, 0x72, 0x61, 0x74,
-	0x6f, 0x73, 0x2f, 0x70, 0x6f, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64,
-	0x73, 0x2f, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2f, 0x7b, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74,
-	0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x12, 0xcb, 0x01, 0x0a, 0x17, 0x52, 0x65,
-	0x77, 0x61, 0x72, 0x64, 0x73, 0x42, 0x79, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x41, 0x6e, 0x64,
-	0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x33, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e,
-	0x70, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x77, 0x61,
-	0x72, 0x64, 0x73, 0x42, 0x79, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x41, 0x6e, 0x64, 0x45, 0x70,
-	0x6f, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x73, 0x74, 0x72,
+	0x61, 0x74, 0x6f, 0x73, 0x2f, 0x70, 0x6f, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x65, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x5f, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x12, 0xaa, 0x01, 0x0a, 0x14, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x30, 0x2e, 0x73, 0x74, 0x72,
 	0x61, 0x74, 0x6f, 0x73, 0x2e, 0x70, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x42, 0x79, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74,
-	0x41, 0x6e, 0x64, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x45, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3f, 0x12, 0x3d, 0x2f, 0x73, 0x74, 0x72, 0x61, 0x74,
-	0x6f, 0x73, 0x2f, 0x70, 0x6f, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64,
-	0x73, 0x2f, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2f, 0x7b, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74,
-	0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x2f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x2f,
-	0x7b, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x7d, 0x12, 0x9f, 0x01, 0x0a, 0x0f, 0x53, 0x6c, 0x61, 0x73,
+	0x79, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f,
+	0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x73,
+	0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e, 0x70, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x50,
+	0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x2d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27, 0x12, 0x25, 0x2f, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f,
+	0x73, 0x2f, 0x70, 0x6f, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0xaf,
+	0x01, 0x0a, 0x13, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x44,
+	0x69, 0x73, 0x70, 0x75, 0x74, 0x65, 0x12, 0x2f, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73,
+	0x2e, 0x70, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x6f, 0x6c,
+	0x75, 0x6d, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x69, 0x73, 0x70, 0x75, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f,
+	0x73, 0x2e, 0x70, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x6f,
+	0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x69, 0x73, 0x70, 0x75, 0x74,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x35, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x2f, 0x12, 0x2d, 0x2f, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2f, 0x70, 0x6f, 0x74, 0x2f,
+	0x76, 0x31, 0x2f, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x5f, 0x64, 0x69, 0x73, 0x70, 0x75, 0x74, 0x65, 0x2f, 0x7b, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x7d,
+	0x12, 0x98, 0x01, 0x0a, 0x0e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x42, 0x79, 0x45, 0x70,
+	0x6f, 0x63, 0x68, 0x12, 0x2a, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e, 0x70, 0x6f,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x73, 0x42, 0x79, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x2b, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e, 0x70, 0x6f, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x42, 0x79, 0x45,
+	0x70, 0x6f, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2d, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x27, 0x12, 0x25, 0x2f, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2f, 0x70,
+	0x6f, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x2f, 0x65, 0x70,
+	0x6f, 0x63, 0x68, 0x2f, 0x7b, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x7d, 0x12, 0xa5, 0x01, 0x0a, 0x0f,
+	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x42, 0x79, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x12,
+	0x2b, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e, 0x70, 0x6f, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x42, 0x79, 0x57,
+	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x73,
+	0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e, 0x70, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x42, 0x79, 0x57, 0x61, 0x6c, 0x6c,
+	0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x37, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x31, 0x12, 0x2f, 0x2f, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2f, 0x70, 0x6f, 0x74,
+	0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x2f, 0x77, 0x61, 0x6c, 0x6c,
+	0x65, 0x74, 0x2f, 0x7b, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x7d, 0x12, 0xcb, 0x01, 0x0a, 0x17, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x42,
+	0x79, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x41, 0x6e, 0x64, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12,
+	0x33, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e, 0x70, 0x6f, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x42, 0x79, 0x57,
+	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x41, 0x6e, 0x64, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e, 0x70,
+	0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x73, 0x42, 0x79, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x41, 0x6e, 0x64, 0x45, 0x70, 0x6f,
+	0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x45, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x3f, 0x12, 0x3d, 0x2f, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2f, 0x70, 0x6f, 0x74,
+	0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x2f, 0x77, 0x61, 0x6c, 0x6c,
+	0x65, 0x74, 0x2f, 0x7b, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x7d, 0x2f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x2f, 0x7b, 0x65, 0x70, 0x6f, 0x63, 0x68,
+	0x7d, 0x12, 0xa7, 0x01, 0x0a, 0x0f, 0x56, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x2b, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e,
+	0x70, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x65, 0x73, 0x74,
+	0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e, 0x70, 0x6f, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x39, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x33, 0x12, 0x31, 0x2f, 0x73, 0x74, 0x72, 0x61, 0x74,
+	0x6f, 0x73, 0x2f, 0x70, 0x6f, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e,
+	0x67, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x2f, 0x7b, 0x77, 0x61, 0x6c, 0x6c,
+	0x65, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x12, 0x9f, 0x01, 0x0a, 0x0d,
+	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x29, 0x2e,
+	0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e, 0x70, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74,
+	0x6f, 0x73, 0x2e, 0x70, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x37, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x31, 0x12, 0x2f, 0x2f, 0x73,
+	0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2f, 0x70, 0x6f, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x2f, 0x7b, 0x77, 0x61,
+	0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x12, 0x97, 0x01,
+	0x0a, 0x0b, 0x41, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x12, 0x27, 0x2e,
+	0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e, 0x70, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x41, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73,
+	0x2e, 0x70, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x75, 0x74,
+	0x6f, 0x52, 0x65, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x35, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2f, 0x12, 0x2d, 0x2f, 0x73, 0x74, 0x72, 0x61, 0x74,
+	0x6f, 0x73, 0x2f, 0x70, 0x6f, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x72,
+	0x65, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x2f, 0x7b, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x12, 0x9f, 0x01, 0x0a, 0x0f, 0x53, 0x6c, 0x61, 0x73,
 	0x68, 0x69, 0x6e, 0x67, 0x42, 0x79, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x2b, 0x2e, 0x73, 0x74,
 	0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e, 0x70, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65,
 	0x72, 0x79, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x42, 0x79, 0x4f, 0x77, 0x6e, 0x65,
@@ -11265,7 +18886,7 @@ func file_stratos_pot_v1_query_proto_rawDescGZIP() []byte {
 	return file_stratos_pot_v1_query_proto_rawDescData
 }
 
-var file_stratos_pot_v1_query_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
+var file_stratos_pot_v1_query_proto_msgTypes = make([]protoimpl.MessageInfo, 35)
 var file_stratos_pot_v1_query_proto_goTypes = []interface{}{
 	(*QueryVolumeReportRequest)(nil),             // 0: stratos.pot.v1.QueryVolumeReportRequest
 	(*QueryVolumeReportResponse)(nil),            // 1: stratos.pot.v1.QueryVolumeReportResponse
@@ -11289,55 +18910,101 @@ var file_stratos_pot_v1_query_proto_goTypes = []interface{}{
 	(*QueryTotalRewardByEpochResponse)(nil),      // 19: stratos.pot.v1.QueryTotalRewardByEpochResponse
 	(*QueryMetricsRequest)(nil),                  // 20: stratos.pot.v1.QueryMetricsRequest
 	(*QueryMetricsResponse)(nil),                 // 21: stratos.pot.v1.QueryMetricsResponse
-	(*Params)(nil),                               // 22: stratos.pot.v1.Params
-	(*v1beta1.PageRequest)(nil),                  // 23: cosmos.base.query.v1beta1.PageRequest
-	(*Reward)(nil),                               // 24: stratos.pot.v1.Reward
-	(*v1beta1.PageResponse)(nil),                 // 25: cosmos.base.query.v1beta1.PageResponse
-	(*v1beta11.Coin)(nil),                        // 26: cosmos.base.v1beta1.Coin
-	(*TotalReward)(nil),                          // 27: stratos.pot.v1.TotalReward
-	(*Metrics)(nil),                              // 28: stratos.pot.v1.Metrics
+	(*QueryPendingVolumeReportRequest)(nil),      // 22: stratos.pot.v1.QueryPendingVolumeReportRequest
+	(*QueryPendingVolumeReportResponse)(nil),     // 23: stratos.pot.v1.QueryPendingVolumeReportResponse
+	(*QueryVolumeReportDisputeRequest)(nil),      // 24: stratos.pot.v1.QueryVolumeReportDisputeRequest
+	(*QueryVolumeReportDisputeResponse)(nil),     // 25: stratos.pot.v1.QueryVolumeReportDisputeResponse
+	(*QueryDistributionProgressRequest)(nil),     // 26: stratos.pot.v1.QueryDistributionProgressRequest
+	(*QueryDistributionProgressResponse)(nil),    // 27: stratos.pot.v1.QueryDistributionProgressResponse
+	(*QueryVestingScheduleRequest)(nil),          // 28: stratos.pot.v1.QueryVestingScheduleRequest
+	(*QueryVestingScheduleResponse)(nil),         // 29: stratos.pot.v1.QueryVestingScheduleResponse
+	(*VestingScheduleEntry)(nil),                 // 30: stratos.pot.v1.VestingScheduleEntry
+	(*QueryRewardHistoryRequest)(nil),            // 31: stratos.pot.v1.QueryRewardHistoryRequest
+	(*QueryRewardHistoryResponse)(nil),           // 32: stratos.pot.v1.QueryRewardHistoryResponse
+	(*QueryAutoRestakeRequest)(nil),              // 33: stratos.pot.v1.QueryAutoRestakeRequest
+	(*QueryAutoRestakeResponse)(nil),             // 34: stratos.pot.v1.QueryAutoRestakeResponse
+	(*Params)(nil),                               // 35: stratos.pot.v1.Params
+	(*v1beta1.PageRequest)(nil),                  // 36: cosmos.base.query.v1beta1.PageRequest
+	(*Reward)(nil),                               // 37: stratos.pot.v1.Reward
+	(*v1beta1.PageResponse)(nil),                 // 38: cosmos.base.query.v1beta1.PageResponse
+	(*v1beta11.Coin)(nil),                        // 39: cosmos.base.v1beta1.Coin
+	(*TotalReward)(nil),                          // 40: stratos.pot.v1.TotalReward
+	(*Metrics)(nil),                              // 41: stratos.pot.v1.Metrics
+	(*PendingVolumeReport)(nil),                  // 42: stratos.pot.v1.PendingVolumeReport
+	(*VolumeReportDispute)(nil),                  // 43: stratos.pot.v1.VolumeReportDispute
+	(*PendingDistribution)(nil),                  // 44: stratos.pot.v1.PendingDistribution
+	(*VestingSchedule)(nil),                      // 45: stratos.pot.v1.VestingSchedule
+	(*RewardHistory)(nil),                        // 46: stratos.pot.v1.RewardHistory
+	(*AutoRestake)(nil),                          // 47: stratos.pot.v1.AutoRestake
 }
 var file_stratos_pot_v1_query_proto_depIdxs = []int32{
 	2,  // 0: stratos.pot.v1.QueryVolumeReportResponse.report_info:type_name -> stratos.pot.v1.ReportInfo
-	22, // 1: stratos.pot.v1.QueryParamsResponse.params:type_name -> stratos.pot.v1.Params
-	23, // 2: stratos.pot.v1.QueryRewardsByEpochRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
-	24, // 3: stratos.pot.v1.QueryRewardsByEpochResponse.rewards:type_name -> stratos.pot.v1.Reward
-	25, // 4: stratos.pot.v1.QueryRewardsByEpochResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	35, // 1: stratos.pot.v1.QueryParamsResponse.params:type_name -> stratos.pot.v1.Params
+	36, // 2: stratos.pot.v1.QueryRewardsByEpochRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	37, // 3: stratos.pot.v1.QueryRewardsByEpochResponse.rewards:type_name -> stratos.pot.v1.Reward
+	38, // 4: stratos.pot.v1.QueryRewardsByEpochResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
 	11, // 5: stratos.pot.v1.QueryRewardsByWalletResponse.rewards:type_name -> stratos.pot.v1.RewardByWallet
-	23, // 6: stratos.pot.v1.QueryRewardsByWalletAndEpochRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
-	24, // 7: stratos.pot.v1.QueryRewardsByWalletAndEpochResponse.rewards:type_name -> stratos.pot.v1.Reward
-	25, // 8: stratos.pot.v1.QueryRewardsByWalletAndEpochResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
-	26, // 9: stratos.pot.v1.RewardByWallet.mature_total_reward:type_name -> cosmos.base.v1beta1.Coin
-	26, // 10: stratos.pot.v1.RewardByWallet.immature_total_reward:type_name -> cosmos.base.v1beta1.Coin
-	26, // 11: stratos.pot.v1.QueryTotalMinedTokenResponse.total_mined_token:type_name -> cosmos.base.v1beta1.Coin
-	26, // 12: stratos.pot.v1.QueryCirculationSupplyResponse.circulation_supply:type_name -> cosmos.base.v1beta1.Coin
-	27, // 13: stratos.pot.v1.QueryTotalRewardByEpochResponse.total_reward:type_name -> stratos.pot.v1.TotalReward
-	28, // 14: stratos.pot.v1.QueryMetricsResponse.metrics:type_name -> stratos.pot.v1.Metrics
-	0,  // 15: stratos.pot.v1.Query.VolumeReport:input_type -> stratos.pot.v1.QueryVolumeReportRequest
-	5,  // 16: stratos.pot.v1.Query.RewardsByEpoch:input_type -> stratos.pot.v1.QueryRewardsByEpochRequest
-	7,  // 17: stratos.pot.v1.Query.RewardsByWallet:input_type -> stratos.pot.v1.QueryRewardsByWalletRequest
-	9,  // 18: stratos.pot.v1.Query.RewardsByWalletAndEpoch:input_type -> stratos.pot.v1.QueryRewardsByWalletAndEpochRequest
-	12, // 19: stratos.pot.v1.Query.SlashingByOwner:input_type -> stratos.pot.v1.QuerySlashingByOwnerRequest
-	3,  // 20: stratos.pot.v1.Query.Params:input_type -> stratos.pot.v1.QueryParamsRequest
-	14, // 21: stratos.pot.v1.Query.TotalMinedToken:input_type -> stratos.pot.v1.QueryTotalMinedTokenRequest
-	16, // 22: stratos.pot.v1.Query.CirculationSupply:input_type -> stratos.pot.v1.QueryCirculationSupplyRequest
-	18, // 23: stratos.pot.v1.Query.TotalRewardByEpoch:input_type -> stratos.pot.v1.QueryTotalRewardByEpochRequest
-	20, // 24: stratos.pot.v1.Query.Metrics:input_type -> stratos.pot.v1.QueryMetricsRequest
-	1,  // 25: stratos.pot.v1.Query.VolumeReport:output_type -> stratos.pot.v1.QueryVolumeReportResponse
-	6,  // 26: stratos.pot.v1.Query.RewardsByEpoch:output_type -> stratos.pot.v1.QueryRewardsByEpochResponse
-	8,  // 27: stratos.pot.v1.Query.RewardsByWallet:output_type -> stratos.pot.v1.QueryRewardsByWalletResponse
-	10, // 28: stratos.pot.v1.Query.RewardsByWalletAndEpoch:output_type -> stratos.pot.v1.QueryRewardsByWalletAndEpochResponse
-	13, // 29: stratos.pot.v1.Query.SlashingByOwner:output_type -> stratos.pot.v1.QuerySlashingByOwnerResponse
-	4,  // 30: stratos.pot.v1.Query.Params:output_type -> stratos.pot.v1.QueryParamsResponse
-	15, // 31: stratos.pot.v1.Query.TotalMinedToken:output_type -> stratos.pot.v1.QueryTotalMinedTokenResponse
-	17, // 32: stratos.pot.v1.Query.CirculationSupply:output_type -> stratos.pot.v1.QueryCirculationSupplyResponse
-	19, // 33: stratos.pot.v1.Query.TotalRewardByEpoch:output_type -> stratos.pot.v1.QueryTotalRewardByEpochResponse
-	21, // 34: stratos.pot.v1.Query.Metrics:output_type -> stratos.pot.v1.QueryMetricsResponse
-	25, // [25:35] is the sub-list for method output_type
-	15, // [15:25] is the sub-list for method input_type
-	15, // [15:15] is the sub-list for extension type_name
-	15, // [15:15] is the sub-list for extension extendee
-	0,  // [0:15] is the sub-list for field type_name
+	36, // 6: stratos.pot.v1.QueryRewardsByWalletAndEpochRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	37, // 7: stratos.pot.v1.QueryRewardsByWalletAndEpochResponse.rewards:type_name -> stratos.pot.v1.Reward
+	38, // 8: stratos.pot.v1.QueryRewardsByWalletAndEpochResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	39, // 9: stratos.pot.v1.RewardByWallet.mature_total_reward:type_name -> cosmos.base.v1beta1.Coin
+	39, // 10: stratos.pot.v1.RewardByWallet.immature_total_reward:type_name -> cosmos.base.v1beta1.Coin
+	39, // 11: stratos.pot.v1.QueryTotalMinedTokenResponse.total_mined_token:type_name -> cosmos.base.v1beta1.Coin
+	39, // 12: stratos.pot.v1.QueryCirculationSupplyResponse.circulation_supply:type_name -> cosmos.base.v1beta1.Coin
+	40, // 13: stratos.pot.v1.QueryTotalRewardByEpochResponse.total_reward:type_name -> stratos.pot.v1.TotalReward
+	41, // 14: stratos.pot.v1.QueryMetricsResponse.metrics:type_name -> stratos.pot.v1.Metrics
+	42, // 15: stratos.pot.v1.QueryPendingVolumeReportResponse.report:type_name -> stratos.pot.v1.PendingVolumeReport
+	43, // 16: stratos.pot.v1.QueryVolumeReportDisputeResponse.dispute:type_name -> stratos.pot.v1.VolumeReportDispute
+	44, // 17: stratos.pot.v1.QueryDistributionProgressResponse.distributions:type_name -> stratos.pot.v1.PendingDistribution
+	36, // 18: stratos.pot.v1.QueryVestingScheduleRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	30, // 19: stratos.pot.v1.QueryVestingScheduleResponse.entries:type_name -> stratos.pot.v1.VestingScheduleEntry
+	39, // 20: stratos.pot.v1.QueryVestingScheduleResponse.mature_total_reward:type_name -> cosmos.base.v1beta1.Coin
+	39, // 21: stratos.pot.v1.QueryVestingScheduleResponse.immature_total_reward:type_name -> cosmos.base.v1beta1.Coin
+	38, // 22: stratos.pot.v1.QueryVestingScheduleResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	45, // 23: stratos.pot.v1.QueryVestingScheduleResponse.linear_vesting:type_name -> stratos.pot.v1.VestingSchedule
+	39, // 24: stratos.pot.v1.VestingScheduleEntry.reward_from_mining_pool:type_name -> cosmos.base.v1beta1.Coin
+	39, // 25: stratos.pot.v1.VestingScheduleEntry.reward_from_traffic_pool:type_name -> cosmos.base.v1beta1.Coin
+	36, // 26: stratos.pot.v1.QueryRewardHistoryRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	46, // 27: stratos.pot.v1.QueryRewardHistoryResponse.histories:type_name -> stratos.pot.v1.RewardHistory
+	38, // 28: stratos.pot.v1.QueryRewardHistoryResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	47, // 29: stratos.pot.v1.QueryAutoRestakeResponse.auto_restake:type_name -> stratos.pot.v1.AutoRestake
+	0,  // 30: stratos.pot.v1.Query.VolumeReport:input_type -> stratos.pot.v1.QueryVolumeReportRequest
+	22, // 31: stratos.pot.v1.Query.PendingVolumeReport:input_type -> stratos.pot.v1.QueryPendingVolumeReportRequest
+	26, // 32: stratos.pot.v1.Query.DistributionProgress:input_type -> stratos.pot.v1.QueryDistributionProgressRequest
+	24, // 33: stratos.pot.v1.Query.VolumeReportDispute:input_type -> stratos.pot.v1.QueryVolumeReportDisputeRequest
+	5,  // 34: stratos.pot.v1.Query.RewardsByEpoch:input_type -> stratos.pot.v1.QueryRewardsByEpochRequest
+	7,  // 35: stratos.pot.v1.Query.RewardsByWallet:input_type -> stratos.pot.v1.QueryRewardsByWalletRequest
+	9,  // 36: stratos.pot.v1.Query.RewardsByWalletAndEpoch:input_type -> stratos.pot.v1.QueryRewardsByWalletAndEpochRequest
+	28, // 37: stratos.pot.v1.Query.VestingSchedule:input_type -> stratos.pot.v1.QueryVestingScheduleRequest
+	31, // 38: stratos.pot.v1.Query.RewardHistory:input_type -> stratos.pot.v1.QueryRewardHistoryRequest
+	33, // 39: stratos.pot.v1.Query.AutoRestake:input_type -> stratos.pot.v1.QueryAutoRestakeRequest
+	12, // 40: stratos.pot.v1.Query.SlashingByOwner:input_type -> stratos.pot.v1.QuerySlashingByOwnerRequest
+	3,  // 41: stratos.pot.v1.Query.Params:input_type -> stratos.pot.v1.QueryParamsRequest
+	14, // 42: stratos.pot.v1.Query.TotalMinedToken:input_type -> stratos.pot.v1.QueryTotalMinedTokenRequest
+	16, // 43: stratos.pot.v1.Query.CirculationSupply:input_type -> stratos.pot.v1.QueryCirculationSupplyRequest
+	18, // 44: stratos.pot.v1.Query.TotalRewardByEpoch:input_type -> stratos.pot.v1.QueryTotalRewardByEpochRequest
+	20, // 45: stratos.pot.v1.Query.Metrics:input_type -> stratos.pot.v1.QueryMetricsRequest
+	1,  // 46: stratos.pot.v1.Query.VolumeReport:output_type -> stratos.pot.v1.QueryVolumeReportResponse
+	23, // 47: stratos.pot.v1.Query.PendingVolumeReport:output_type -> stratos.pot.v1.QueryPendingVolumeReportResponse
+	27, // 48: stratos.pot.v1.Query.DistributionProgress:output_type -> stratos.pot.v1.QueryDistributionProgressResponse
+	25, // 49: stratos.pot.v1.Query.VolumeReportDispute:output_type -> stratos.pot.v1.QueryVolumeReportDisputeResponse
+	6,  // 50: stratos.pot.v1.Query.RewardsByEpoch:output_type -> stratos.pot.v1.QueryRewardsByEpochResponse
+	8,  // 51: stratos.pot.v1.Query.RewardsByWallet:output_type -> stratos.pot.v1.QueryRewardsByWalletResponse
+	10, // 52: stratos.pot.v1.Query.RewardsByWalletAndEpoch:output_type -> stratos.pot.v1.QueryRewardsByWalletAndEpochResponse
+	29, // 53: stratos.pot.v1.Query.VestingSchedule:output_type -> stratos.pot.v1.QueryVestingScheduleResponse
+	32, // 54: stratos.pot.v1.Query.RewardHistory:output_type -> stratos.pot.v1.QueryRewardHistoryResponse
+	34, // 55: stratos.pot.v1.Query.AutoRestake:output_type -> stratos.pot.v1.QueryAutoRestakeResponse
+	13, // 56: stratos.pot.v1.Query.SlashingByOwner:output_type -> stratos.pot.v1.QuerySlashingByOwnerResponse
+	4,  // 57: stratos.pot.v1.Query.Params:output_type -> stratos.pot.v1.QueryParamsResponse
+	15, // 58: stratos.pot.v1.Query.TotalMinedToken:output_type -> stratos.pot.v1.QueryTotalMinedTokenResponse
+	17, // 59: stratos.pot.v1.Query.CirculationSupply:output_type -> stratos.pot.v1.QueryCirculationSupplyResponse
+	19, // 60: stratos.pot.v1.Query.TotalRewardByEpoch:output_type -> stratos.pot.v1.QueryTotalRewardByEpochResponse
+	21, // 61: stratos.pot.v1.Query.Metrics:output_type -> stratos.pot.v1.QueryMetricsResponse
+	46, // [46:62] is the sub-list for method output_type
+	30, // [30:46] is the sub-list for method input_type
+	30, // [30:30] is the sub-list for extension type_name
+	30, // [30:30] is the sub-list for extension extendee
+	0,  // [0:30] is the sub-list for field type_name
 }
 
 func init() { file_stratos_pot_v1_query_proto_init() }
@@ -11611,6 +19278,162 @@ func file_stratos_pot_v1_query_proto_init() {
 				return nil
 			}
 		}
+		file_stratos_pot_v1_query_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryPendingVolumeReportRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stratos_pot_v1_query_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryPendingVolumeReportResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stratos_pot_v1_query_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryVolumeReportDisputeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stratos_pot_v1_query_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryVolumeReportDisputeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stratos_pot_v1_query_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryDistributionProgressRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stratos_pot_v1_query_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryDistributionProgressResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stratos_pot_v1_query_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryVestingScheduleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stratos_pot_v1_query_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryVestingScheduleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stratos_pot_v1_query_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VestingScheduleEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stratos_pot_v1_query_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryRewardHistoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stratos_pot_v1_query_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryRewardHistoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stratos_pot_v1_query_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryAutoRestakeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stratos_pot_v1_query_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryAutoRestakeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -11618,7 +19441,7 @@ func file_stratos_pot_v1_query_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_stratos_pot_v1_query_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   22,
+			NumMessages:   35,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
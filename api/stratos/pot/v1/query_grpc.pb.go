@@ -20,9 +20,15 @@ const _ = grpc.SupportPackageIsVersion7
 
 const (
 	Query_VolumeReport_FullMethodName            = "/stratos.pot.v1.Query/VolumeReport"
+	Query_PendingVolumeReport_FullMethodName     = "/stratos.pot.v1.Query/PendingVolumeReport"
+	Query_DistributionProgress_FullMethodName    = "/stratos.pot.v1.Query/DistributionProgress"
+	Query_VolumeReportDispute_FullMethodName     = "/stratos.pot.v1.Query/VolumeReportDispute"
 	Query_RewardsByEpoch_FullMethodName          = "/stratos.pot.v1.Query/RewardsByEpoch"
 	Query_RewardsByWallet_FullMethodName         = "/stratos.pot.v1.Query/RewardsByWallet"
 	Query_RewardsByWalletAndEpoch_FullMethodName = "/stratos.pot.v1.Query/RewardsByWalletAndEpoch"
+	Query_VestingSchedule_FullMethodName         = "/stratos.pot.v1.Query/VestingSchedule"
+	Query_RewardHistory_FullMethodName           = "/stratos.pot.v1.Query/RewardHistory"
+	Query_AutoRestake_FullMethodName             = "/stratos.pot.v1.Query/AutoRestake"
 	Query_SlashingByOwner_FullMethodName         = "/stratos.pot.v1.Query/SlashingByOwner"
 	Query_Params_FullMethodName                  = "/stratos.pot.v1.Query/Params"
 	Query_TotalMinedToken_FullMethodName         = "/stratos.pot.v1.Query/TotalMinedToken"
@@ -37,6 +43,12 @@ const (
 type QueryClient interface {
 	// VolumeReport queries VolumeReport info for given epoch.
 	VolumeReport(ctx context.Context, in *QueryVolumeReportRequest, opts ...grpc.CallOption) (*QueryVolumeReportResponse, error)
+	// PendingVolumeReport queries the chunked volume report waiting for its chunks.
+	PendingVolumeReport(ctx context.Context, in *QueryPendingVolumeReportRequest, opts ...grpc.CallOption) (*QueryPendingVolumeReportResponse, error)
+	// DistributionProgress queries the volume reports whose rewards are being distributed.
+	DistributionProgress(ctx context.Context, in *QueryDistributionProgressRequest, opts ...grpc.CallOption) (*QueryDistributionProgressResponse, error)
+	// VolumeReportDispute queries the dispute filed against the volume report of an epoch.
+	VolumeReportDispute(ctx context.Context, in *QueryVolumeReportDisputeRequest, opts ...grpc.CallOption) (*QueryVolumeReportDisputeResponse, error)
 	// RewardsByEpoch queries Pot rewards by a given epoch.
 	RewardsByEpoch(ctx context.Context, in *QueryRewardsByEpochRequest, opts ...grpc.CallOption) (*QueryRewardsByEpochResponse, error)
 	// RewardsByOwner queries Pot rewards by a given beneficiary address.
@@ -44,6 +56,13 @@ type QueryClient interface {
 	// RewardsByWalletAndEpoch queries Pot rewards by a given beneficiary address at the specific epoch.
 	RewardsByWalletAndEpoch(ctx context.Context, in *QueryRewardsByWalletAndEpochRequest, opts ...grpc.CallOption) (*QueryRewardsByWalletAndEpochResponse, error)
 	// SlashingByOwner queries Pot slashing by owner wallet address.
+	// VestingSchedule queries the immature rewards of a wallet by the epoch they mature at, and its linearly vesting
+	// matured reward.
+	VestingSchedule(ctx context.Context, in *QueryVestingScheduleRequest, opts ...grpc.CallOption) (*QueryVestingScheduleResponse, error)
+	// RewardHistory queries the matured rewards of a wallet in an epoch range.
+	RewardHistory(ctx context.Context, in *QueryRewardHistoryRequest, opts ...grpc.CallOption) (*QueryRewardHistoryResponse, error)
+	// AutoRestake queries the node the matured rewards of a wallet are automatically added to the deposit of.
+	AutoRestake(ctx context.Context, in *QueryAutoRestakeRequest, opts ...grpc.CallOption) (*QueryAutoRestakeResponse, error)
 	SlashingByOwner(ctx context.Context, in *QuerySlashingByOwnerRequest, opts ...grpc.CallOption) (*QuerySlashingByOwnerResponse, error)
 	// Params queries POT module Params info.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
@@ -70,6 +89,33 @@ func (c *queryClient) VolumeReport(ctx context.Context, in *QueryVolumeReportReq
 	return out, nil
 }
 
+func (c *queryClient) PendingVolumeReport(ctx context.Context, in *QueryPendingVolumeReportRequest, opts ...grpc.CallOption) (*QueryPendingVolumeReportResponse, error) {
+	out := new(QueryPendingVolumeReportResponse)
+	err := c.cc.Invoke(ctx, Query_PendingVolumeReport_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DistributionProgress(ctx context.Context, in *QueryDistributionProgressRequest, opts ...grpc.CallOption) (*QueryDistributionProgressResponse, error) {
+	out := new(QueryDistributionProgressResponse)
+	err := c.cc.Invoke(ctx, Query_DistributionProgress_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VolumeReportDispute(ctx context.Context, in *QueryVolumeReportDisputeRequest, opts ...grpc.CallOption) (*QueryVolumeReportDisputeResponse, error) {
+	out := new(QueryVolumeReportDisputeResponse)
+	err := c.cc.Invoke(ctx, Query_VolumeReportDispute_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) RewardsByEpoch(ctx context.Context, in *QueryRewardsByEpochRequest, opts ...grpc.CallOption) (*QueryRewardsByEpochResponse, error) {
 	out := new(QueryRewardsByEpochResponse)
 	err := c.cc.Invoke(ctx, Query_RewardsByEpoch_FullMethodName, in, out, opts...)
@@ -97,6 +143,33 @@ func (c *queryClient) RewardsByWalletAndEpoch(ctx context.Context, in *QueryRewa
 	return out, nil
 }
 
+func (c *queryClient) VestingSchedule(ctx context.Context, in *QueryVestingScheduleRequest, opts ...grpc.CallOption) (*QueryVestingScheduleResponse, error) {
+	out := new(QueryVestingScheduleResponse)
+	err := c.cc.Invoke(ctx, Query_VestingSchedule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RewardHistory(ctx context.Context, in *QueryRewardHistoryRequest, opts ...grpc.CallOption) (*QueryRewardHistoryResponse, error) {
+	out := new(QueryRewardHistoryResponse)
+	err := c.cc.Invoke(ctx, Query_RewardHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AutoRestake(ctx context.Context, in *QueryAutoRestakeRequest, opts ...grpc.CallOption) (*QueryAutoRestakeResponse, error) {
+	out := new(QueryAutoRestakeResponse)
+	err := c.cc.Invoke(ctx, Query_AutoRestake_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) SlashingByOwner(ctx context.Context, in *QuerySlashingByOwnerRequest, opts ...grpc.CallOption) (*QuerySlashingByOwnerResponse, error) {
 	out := new(QuerySlashingByOwnerResponse)
 	err := c.cc.Invoke(ctx, Query_SlashingByOwner_FullMethodName, in, out, opts...)
@@ -157,6 +230,12 @@ func (c *queryClient) Metrics(ctx context.Context, in *QueryMetricsRequest, opts
 type QueryServer interface {
 	// VolumeReport queries VolumeReport info for given epoch.
 	VolumeReport(context.Context, *QueryVolumeReportRequest) (*QueryVolumeReportResponse, error)
+	// PendingVolumeReport queries the chunked volume report waiting for its chunks.
+	PendingVolumeReport(context.Context, *QueryPendingVolumeReportRequest) (*QueryPendingVolumeReportResponse, error)
+	// DistributionProgress queries the volume reports whose rewards are being distributed.
+	DistributionProgress(context.Context, *QueryDistributionProgressRequest) (*QueryDistributionProgressResponse, error)
+	// VolumeReportDispute queries the dispute filed against the volume report of an epoch.
+	VolumeReportDispute(context.Context, *QueryVolumeReportDisputeRequest) (*QueryVolumeReportDisputeResponse, error)
 	// RewardsByEpoch queries Pot rewards by a given epoch.
 	RewardsByEpoch(context.Context, *QueryRewardsByEpochRequest) (*QueryRewardsByEpochResponse, error)
 	// RewardsByOwner queries Pot rewards by a given beneficiary address.
@@ -164,6 +243,13 @@ type QueryServer interface {
 	// RewardsByWalletAndEpoch queries Pot rewards by a given beneficiary address at the specific epoch.
 	RewardsByWalletAndEpoch(context.Context, *QueryRewardsByWalletAndEpochRequest) (*QueryRewardsByWalletAndEpochResponse, error)
 	// SlashingByOwner queries Pot slashing by owner wallet address.
+	// VestingSchedule queries the immature rewards of a wallet by the epoch they mature at, and its linearly vesting
+	// matured reward.
+	VestingSchedule(context.Context, *QueryVestingScheduleRequest) (*QueryVestingScheduleResponse, error)
+	// RewardHistory queries the matured rewards of a wallet in an epoch range.
+	RewardHistory(context.Context, *QueryRewardHistoryRequest) (*QueryRewardHistoryResponse, error)
+	// AutoRestake queries the node the matured rewards of a wallet are automatically added to the deposit of.
+	AutoRestake(context.Context, *QueryAutoRestakeRequest) (*QueryAutoRestakeResponse, error)
 	SlashingByOwner(context.Context, *QuerySlashingByOwnerRequest) (*QuerySlashingByOwnerResponse, error)
 	// Params queries POT module Params info.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
@@ -181,6 +267,15 @@ type UnimplementedQueryServer struct {
 func (UnimplementedQueryServer) VolumeReport(context.Context, *QueryVolumeReportRequest) (*QueryVolumeReportResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method VolumeReport not implemented")
 }
+func (UnimplementedQueryServer) PendingVolumeReport(context.Context, *QueryPendingVolumeReportRequest) (*QueryPendingVolumeReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingVolumeReport not implemented")
+}
+func (UnimplementedQueryServer) DistributionProgress(context.Context, *QueryDistributionProgressRequest) (*QueryDistributionProgressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DistributionProgress not implemented")
+}
+func (UnimplementedQueryServer) VolumeReportDispute(context.Context, *QueryVolumeReportDisputeRequest) (*QueryVolumeReportDisputeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VolumeReportDispute not implemented")
+}
 func (UnimplementedQueryServer) RewardsByEpoch(context.Context, *QueryRewardsByEpochRequest) (*QueryRewardsByEpochResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RewardsByEpoch not implemented")
 }
@@ -190,6 +285,15 @@ func (UnimplementedQueryServer) RewardsByWallet(context.Context, *QueryRewardsBy
 func (UnimplementedQueryServer) RewardsByWalletAndEpoch(context.Context, *QueryRewardsByWalletAndEpochRequest) (*QueryRewardsByWalletAndEpochResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RewardsByWalletAndEpoch not implemented")
 }
+func (UnimplementedQueryServer) VestingSchedule(context.Context, *QueryVestingScheduleRequest) (*QueryVestingScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VestingSchedule not implemented")
+}
+func (UnimplementedQueryServer) RewardHistory(context.Context, *QueryRewardHistoryRequest) (*QueryRewardHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RewardHistory not implemented")
+}
+func (UnimplementedQueryServer) AutoRestake(context.Context, *QueryAutoRestakeRequest) (*QueryAutoRestakeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AutoRestake not implemented")
+}
 func (UnimplementedQueryServer) SlashingByOwner(context.Context, *QuerySlashingByOwnerRequest) (*QuerySlashingByOwnerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SlashingByOwner not implemented")
 }
@@ -239,6 +343,60 @@ func _Query_VolumeReport_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_PendingVolumeReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingVolumeReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingVolumeReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PendingVolumeReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingVolumeReport(ctx, req.(*QueryPendingVolumeReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DistributionProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDistributionProgressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DistributionProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DistributionProgress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DistributionProgress(ctx, req.(*QueryDistributionProgressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VolumeReportDispute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVolumeReportDisputeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VolumeReportDispute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_VolumeReportDispute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VolumeReportDispute(ctx, req.(*QueryVolumeReportDisputeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_RewardsByEpoch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryRewardsByEpochRequest)
 	if err := dec(in); err != nil {
@@ -293,6 +451,60 @@ func _Query_RewardsByWalletAndEpoch_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_VestingSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVestingScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VestingSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_VestingSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VestingSchedule(ctx, req.(*QueryVestingScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RewardHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRewardHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RewardHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_RewardHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RewardHistory(ctx, req.(*QueryRewardHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AutoRestake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAutoRestakeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AutoRestake(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AutoRestake_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AutoRestake(ctx, req.(*QueryAutoRestakeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_SlashingByOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QuerySlashingByOwnerRequest)
 	if err := dec(in); err != nil {
@@ -412,6 +624,18 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "VolumeReport",
 			Handler:    _Query_VolumeReport_Handler,
 		},
+		{
+			MethodName: "PendingVolumeReport",
+			Handler:    _Query_PendingVolumeReport_Handler,
+		},
+		{
+			MethodName: "DistributionProgress",
+			Handler:    _Query_DistributionProgress_Handler,
+		},
+		{
+			MethodName: "VolumeReportDispute",
+			Handler:    _Query_VolumeReportDispute_Handler,
+		},
 		{
 			MethodName: "RewardsByEpoch",
 			Handler:    _Query_RewardsByEpoch_Handler,
@@ -424,6 +648,18 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RewardsByWalletAndEpoch",
 			Handler:    _Query_RewardsByWalletAndEpoch_Handler,
 		},
+		{
+			MethodName: "VestingSchedule",
+			Handler:    _Query_VestingSchedule_Handler,
+		},
+		{
+			MethodName: "RewardHistory",
+			Handler:    _Query_RewardHistory_Handler,
+		},
+		{
+			MethodName: "AutoRestake",
+			Handler:    _Query_AutoRestake_Handler,
+		},
 		{
 			MethodName: "SlashingByOwner",
 			Handler:    _Query_SlashingByOwner_Handler,
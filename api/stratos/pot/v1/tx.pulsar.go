@@ -1202,81 +1202,38 @@ func (x *fastReflection_MsgVolumeReportResponse) ProtoMethods() *protoiface.Meth
 	}
 }
 
-var _ protoreflect.List = (*_MsgWithdraw_1_list)(nil)
-
-type _MsgWithdraw_1_list struct {
-	list *[]*v1beta1.Coin
-}
-
-func (x *_MsgWithdraw_1_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_MsgWithdraw_1_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_MsgWithdraw_1_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_MsgWithdraw_1_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_MsgWithdraw_1_list) AppendMutable() protoreflect.Value {
-	v := new(v1beta1.Coin)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_MsgWithdraw_1_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_MsgWithdraw_1_list) NewElement() protoreflect.Value {
-	v := new(v1beta1.Coin)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_MsgWithdraw_1_list) IsValid() bool {
-	return x.list != nil
-}
-
 var (
-	md_MsgWithdraw                protoreflect.MessageDescriptor
-	fd_MsgWithdraw_amount         protoreflect.FieldDescriptor
-	fd_MsgWithdraw_wallet_address protoreflect.FieldDescriptor
-	fd_MsgWithdraw_target_address protoreflect.FieldDescriptor
+	md_MsgVolumeReportHeader                  protoreflect.MessageDescriptor
+	fd_MsgVolumeReportHeader_reporter         protoreflect.FieldDescriptor
+	fd_MsgVolumeReportHeader_epoch            protoreflect.FieldDescriptor
+	fd_MsgVolumeReportHeader_report_reference protoreflect.FieldDescriptor
+	fd_MsgVolumeReportHeader_reporter_owner   protoreflect.FieldDescriptor
+	fd_MsgVolumeReportHeader_total_count      protoreflect.FieldDescriptor
+	fd_MsgVolumeReportHeader_volumes_root     protoreflect.FieldDescriptor
+	fd_MsgVolumeReportHeader_BLS_signature    protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_stratos_pot_v1_tx_proto_init()
-	md_MsgWithdraw = File_stratos_pot_v1_tx_proto.Messages().ByName("MsgWithdraw")
-	fd_MsgWithdraw_amount = md_MsgWithdraw.Fields().ByName("amount")
-	fd_MsgWithdraw_wallet_address = md_MsgWithdraw.Fields().ByName("wallet_address")
-	fd_MsgWithdraw_target_address = md_MsgWithdraw.Fields().ByName("target_address")
+	md_MsgVolumeReportHeader = File_stratos_pot_v1_tx_proto.Messages().ByName("MsgVolumeReportHeader")
+	fd_MsgVolumeReportHeader_reporter = md_MsgVolumeReportHeader.Fields().ByName("reporter")
+	fd_MsgVolumeReportHeader_epoch = md_MsgVolumeReportHeader.Fields().ByName("epoch")
+	fd_MsgVolumeReportHeader_report_reference = md_MsgVolumeReportHeader.Fields().ByName("report_reference")
+	fd_MsgVolumeReportHeader_reporter_owner = md_MsgVolumeReportHeader.Fields().ByName("reporter_owner")
+	fd_MsgVolumeReportHeader_total_count = md_MsgVolumeReportHeader.Fields().ByName("total_count")
+	fd_MsgVolumeReportHeader_volumes_root = md_MsgVolumeReportHeader.Fields().ByName("volumes_root")
+	fd_MsgVolumeReportHeader_BLS_signature = md_MsgVolumeReportHeader.Fields().ByName("BLS_signature")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgWithdraw)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgVolumeReportHeader)(nil)
 
-type fastReflection_MsgWithdraw MsgWithdraw
+type fastReflection_MsgVolumeReportHeader MsgVolumeReportHeader
 
-func (x *MsgWithdraw) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgWithdraw)(x)
+func (x *MsgVolumeReportHeader) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgVolumeReportHeader)(x)
 }
 
-func (x *MsgWithdraw) slowProtoReflect() protoreflect.Message {
+func (x *MsgVolumeReportHeader) slowProtoReflect() protoreflect.Message {
 	mi := &file_stratos_pot_v1_tx_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1288,43 +1245,43 @@ func (x *MsgWithdraw) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgWithdraw_messageType fastReflection_MsgWithdraw_messageType
-var _ protoreflect.MessageType = fastReflection_MsgWithdraw_messageType{}
+var _fastReflection_MsgVolumeReportHeader_messageType fastReflection_MsgVolumeReportHeader_messageType
+var _ protoreflect.MessageType = fastReflection_MsgVolumeReportHeader_messageType{}
 
-type fastReflection_MsgWithdraw_messageType struct{}
+type fastReflection_MsgVolumeReportHeader_messageType struct{}
 
-func (x fastReflection_MsgWithdraw_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgWithdraw)(nil)
+func (x fastReflection_MsgVolumeReportHeader_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgVolumeReportHeader)(nil)
 }
-func (x fastReflection_MsgWithdraw_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgWithdraw)
+func (x fastReflection_MsgVolumeReportHeader_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgVolumeReportHeader)
 }
-func (x fastReflection_MsgWithdraw_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgWithdraw
+func (x fastReflection_MsgVolumeReportHeader_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgVolumeReportHeader
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgWithdraw) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgWithdraw
+func (x *fastReflection_MsgVolumeReportHeader) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgVolumeReportHeader
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgWithdraw) Type() protoreflect.MessageType {
-	return _fastReflection_MsgWithdraw_messageType
+func (x *fastReflection_MsgVolumeReportHeader) Type() protoreflect.MessageType {
+	return _fastReflection_MsgVolumeReportHeader_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgWithdraw) New() protoreflect.Message {
-	return new(fastReflection_MsgWithdraw)
+func (x *fastReflection_MsgVolumeReportHeader) New() protoreflect.Message {
+	return new(fastReflection_MsgVolumeReportHeader)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgWithdraw) Interface() protoreflect.ProtoMessage {
-	return (*MsgWithdraw)(x)
+func (x *fastReflection_MsgVolumeReportHeader) Interface() protoreflect.ProtoMessage {
+	return (*MsgVolumeReportHeader)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1332,22 +1289,46 @@ func (x *fastReflection_MsgWithdraw) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgWithdraw) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.Amount) != 0 {
-		value := protoreflect.ValueOfList(&_MsgWithdraw_1_list{list: &x.Amount})
-		if !f(fd_MsgWithdraw_amount, value) {
+func (x *fastReflection_MsgVolumeReportHeader) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Reporter != "" {
+		value := protoreflect.ValueOfString(x.Reporter)
+		if !f(fd_MsgVolumeReportHeader_reporter, value) {
 			return
 		}
 	}
-	if x.WalletAddress != "" {
-		value := protoreflect.ValueOfString(x.WalletAddress)
-		if !f(fd_MsgWithdraw_wallet_address, value) {
+	if x.Epoch != "" {
+		value := protoreflect.ValueOfString(x.Epoch)
+		if !f(fd_MsgVolumeReportHeader_epoch, value) {
 			return
 		}
 	}
-	if x.TargetAddress != "" {
-		value := protoreflect.ValueOfString(x.TargetAddress)
-		if !f(fd_MsgWithdraw_target_address, value) {
+	if x.ReportReference != "" {
+		value := protoreflect.ValueOfString(x.ReportReference)
+		if !f(fd_MsgVolumeReportHeader_report_reference, value) {
+			return
+		}
+	}
+	if x.ReporterOwner != "" {
+		value := protoreflect.ValueOfString(x.ReporterOwner)
+		if !f(fd_MsgVolumeReportHeader_reporter_owner, value) {
+			return
+		}
+	}
+	if x.TotalCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TotalCount)
+		if !f(fd_MsgVolumeReportHeader_total_count, value) {
+			return
+		}
+	}
+	if len(x.VolumesRoot) != 0 {
+		value := protoreflect.ValueOfBytes(x.VolumesRoot)
+		if !f(fd_MsgVolumeReportHeader_volumes_root, value) {
+			return
+		}
+	}
+	if x.BLSSignature != nil {
+		value := protoreflect.ValueOfMessage(x.BLSSignature.ProtoReflect())
+		if !f(fd_MsgVolumeReportHeader_BLS_signature, value) {
 			return
 		}
 	}
@@ -1364,19 +1345,27 @@ func (x *fastReflection_MsgWithdraw) Range(f func(protoreflect.FieldDescriptor,
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgWithdraw) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgVolumeReportHeader) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "stratos.pot.v1.MsgWithdraw.amount":
-		return len(x.Amount) != 0
-	case "stratos.pot.v1.MsgWithdraw.wallet_address":
-		return x.WalletAddress != ""
-	case "stratos.pot.v1.MsgWithdraw.target_address":
-		return x.TargetAddress != ""
+	case "stratos.pot.v1.MsgVolumeReportHeader.reporter":
+		return x.Reporter != ""
+	case "stratos.pot.v1.MsgVolumeReportHeader.epoch":
+		return x.Epoch != ""
+	case "stratos.pot.v1.MsgVolumeReportHeader.report_reference":
+		return x.ReportReference != ""
+	case "stratos.pot.v1.MsgVolumeReportHeader.reporter_owner":
+		return x.ReporterOwner != ""
+	case "stratos.pot.v1.MsgVolumeReportHeader.total_count":
+		return x.TotalCount != uint64(0)
+	case "stratos.pot.v1.MsgVolumeReportHeader.volumes_root":
+		return len(x.VolumesRoot) != 0
+	case "stratos.pot.v1.MsgVolumeReportHeader.BLS_signature":
+		return x.BLSSignature != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgWithdraw"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgVolumeReportHeader"))
 		}
-		panic(fmt.Errorf("message stratos.pot.v1.MsgWithdraw does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message stratos.pot.v1.MsgVolumeReportHeader does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1386,19 +1375,27 @@ func (x *fastReflection_MsgWithdraw) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgWithdraw) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgVolumeReportHeader) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "stratos.pot.v1.MsgWithdraw.amount":
-		x.Amount = nil
-	case "stratos.pot.v1.MsgWithdraw.wallet_address":
-		x.WalletAddress = ""
-	case "stratos.pot.v1.MsgWithdraw.target_address":
-		x.TargetAddress = ""
+	case "stratos.pot.v1.MsgVolumeReportHeader.reporter":
+		x.Reporter = ""
+	case "stratos.pot.v1.MsgVolumeReportHeader.epoch":
+		x.Epoch = ""
+	case "stratos.pot.v1.MsgVolumeReportHeader.report_reference":
+		x.ReportReference = ""
+	case "stratos.pot.v1.MsgVolumeReportHeader.reporter_owner":
+		x.ReporterOwner = ""
+	case "stratos.pot.v1.MsgVolumeReportHeader.total_count":
+		x.TotalCount = uint64(0)
+	case "stratos.pot.v1.MsgVolumeReportHeader.volumes_root":
+		x.VolumesRoot = nil
+	case "stratos.pot.v1.MsgVolumeReportHeader.BLS_signature":
+		x.BLSSignature = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgWithdraw"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgVolumeReportHeader"))
 		}
-		panic(fmt.Errorf("message stratos.pot.v1.MsgWithdraw does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message stratos.pot.v1.MsgVolumeReportHeader does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1408,25 +1405,34 @@ func (x *fastReflection_MsgWithdraw) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgWithdraw) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgVolumeReportHeader) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "stratos.pot.v1.MsgWithdraw.amount":
-		if len(x.Amount) == 0 {
-			return protoreflect.ValueOfList(&_MsgWithdraw_1_list{})
-		}
-		listValue := &_MsgWithdraw_1_list{list: &x.Amount}
-		return protoreflect.ValueOfList(listValue)
-	case "stratos.pot.v1.MsgWithdraw.wallet_address":
-		value := x.WalletAddress
+	case "stratos.pot.v1.MsgVolumeReportHeader.reporter":
+		value := x.Reporter
 		return protoreflect.ValueOfString(value)
-	case "stratos.pot.v1.MsgWithdraw.target_address":
-		value := x.TargetAddress
+	case "stratos.pot.v1.MsgVolumeReportHeader.epoch":
+		value := x.Epoch
+		return protoreflect.ValueOfString(value)
+	case "stratos.pot.v1.MsgVolumeReportHeader.report_reference":
+		value := x.ReportReference
 		return protoreflect.ValueOfString(value)
+	case "stratos.pot.v1.MsgVolumeReportHeader.reporter_owner":
+		value := x.ReporterOwner
+		return protoreflect.ValueOfString(value)
+	case "stratos.pot.v1.MsgVolumeReportHeader.total_count":
+		value := x.TotalCount
+		return protoreflect.ValueOfUint64(value)
+	case "stratos.pot.v1.MsgVolumeReportHeader.volumes_root":
+		value := x.VolumesRoot
+		return protoreflect.ValueOfBytes(value)
+	case "stratos.pot.v1.MsgVolumeReportHeader.BLS_signature":
+		value := x.BLSSignature
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgWithdraw"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgVolumeReportHeader"))
 		}
-		panic(fmt.Errorf("message stratos.pot.v1.MsgWithdraw does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message stratos.pot.v1.MsgVolumeReportHeader does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1440,21 +1446,27 @@ func (x *fastReflection_MsgWithdraw) Get(descriptor protoreflect.FieldDescriptor
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgWithdraw) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgVolumeReportHeader) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "stratos.pot.v1.MsgWithdraw.amount":
-		lv := value.List()
-		clv := lv.(*_MsgWithdraw_1_list)
-		x.Amount = *clv.list
-	case "stratos.pot.v1.MsgWithdraw.wallet_address":
-		x.WalletAddress = value.Interface().(string)
-	case "stratos.pot.v1.MsgWithdraw.target_address":
-		x.TargetAddress = value.Interface().(string)
+	case "stratos.pot.v1.MsgVolumeReportHeader.reporter":
+		x.Reporter = value.Interface().(string)
+	case "stratos.pot.v1.MsgVolumeReportHeader.epoch":
+		x.Epoch = value.Interface().(string)
+	case "stratos.pot.v1.MsgVolumeReportHeader.report_reference":
+		x.ReportReference = value.Interface().(string)
+	case "stratos.pot.v1.MsgVolumeReportHeader.reporter_owner":
+		x.ReporterOwner = value.Interface().(string)
+	case "stratos.pot.v1.MsgVolumeReportHeader.total_count":
+		x.TotalCount = value.Uint()
+	case "stratos.pot.v1.MsgVolumeReportHeader.volumes_root":
+		x.VolumesRoot = value.Bytes()
+	case "stratos.pot.v1.MsgVolumeReportHeader.BLS_signature":
+		x.BLSSignature = value.Message().Interface().(*BLSSignatureInfo)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgWithdraw"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgVolumeReportHeader"))
 		}
-		panic(fmt.Errorf("message stratos.pot.v1.MsgWithdraw does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message stratos.pot.v1.MsgVolumeReportHeader does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1468,53 +1480,68 @@ func (x *fastReflection_MsgWithdraw) Set(fd protoreflect.FieldDescriptor, value
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgWithdraw) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgVolumeReportHeader) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "stratos.pot.v1.MsgWithdraw.amount":
-		if x.Amount == nil {
-			x.Amount = []*v1beta1.Coin{}
+	case "stratos.pot.v1.MsgVolumeReportHeader.BLS_signature":
+		if x.BLSSignature == nil {
+			x.BLSSignature = new(BLSSignatureInfo)
 		}
-		value := &_MsgWithdraw_1_list{list: &x.Amount}
-		return protoreflect.ValueOfList(value)
-	case "stratos.pot.v1.MsgWithdraw.wallet_address":
-		panic(fmt.Errorf("field wallet_address of message stratos.pot.v1.MsgWithdraw is not mutable"))
-	case "stratos.pot.v1.MsgWithdraw.target_address":
-		panic(fmt.Errorf("field target_address of message stratos.pot.v1.MsgWithdraw is not mutable"))
+		return protoreflect.ValueOfMessage(x.BLSSignature.ProtoReflect())
+	case "stratos.pot.v1.MsgVolumeReportHeader.reporter":
+		panic(fmt.Errorf("field reporter of message stratos.pot.v1.MsgVolumeReportHeader is not mutable"))
+	case "stratos.pot.v1.MsgVolumeReportHeader.epoch":
+		panic(fmt.Errorf("field epoch of message stratos.pot.v1.MsgVolumeReportHeader is not mutable"))
+	case "stratos.pot.v1.MsgVolumeReportHeader.report_reference":
+		panic(fmt.Errorf("field report_reference of message stratos.pot.v1.MsgVolumeReportHeader is not mutable"))
+	case "stratos.pot.v1.MsgVolumeReportHeader.reporter_owner":
+		panic(fmt.Errorf("field reporter_owner of message stratos.pot.v1.MsgVolumeReportHeader is not mutable"))
+	case "stratos.pot.v1.MsgVolumeReportHeader.total_count":
+		panic(fmt.Errorf("field total_count of message stratos.pot.v1.MsgVolumeReportHeader is not mutable"))
+	case "stratos.pot.v1.MsgVolumeReportHeader.volumes_root":
+		panic(fmt.Errorf("field volumes_root of message stratos.pot.v1.MsgVolumeReportHeader is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgWithdraw"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgVolumeReportHeader"))
 		}
-		panic(fmt.Errorf("message stratos.pot.v1.MsgWithdraw does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message stratos.pot.v1.MsgVolumeReportHeader does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgWithdraw) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgVolumeReportHeader) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "stratos.pot.v1.MsgWithdraw.amount":
-		list := []*v1beta1.Coin{}
-		return protoreflect.ValueOfList(&_MsgWithdraw_1_list{list: &list})
-	case "stratos.pot.v1.MsgWithdraw.wallet_address":
+	case "stratos.pot.v1.MsgVolumeReportHeader.reporter":
 		return protoreflect.ValueOfString("")
-	case "stratos.pot.v1.MsgWithdraw.target_address":
+	case "stratos.pot.v1.MsgVolumeReportHeader.epoch":
 		return protoreflect.ValueOfString("")
+	case "stratos.pot.v1.MsgVolumeReportHeader.report_reference":
+		return protoreflect.ValueOfString("")
+	case "stratos.pot.v1.MsgVolumeReportHeader.reporter_owner":
+		return protoreflect.ValueOfString("")
+	case "stratos.pot.v1.MsgVolumeReportHeader.total_count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "stratos.pot.v1.MsgVolumeReportHeader.volumes_root":
+		return protoreflect.ValueOfBytes(nil)
+	case "stratos.pot.v1.MsgVolumeReportHeader.BLS_signature":
+		m := new(BLSSignatureInfo)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgWithdraw"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgVolumeReportHeader"))
 		}
-		panic(fmt.Errorf("message stratos.pot.v1.MsgWithdraw does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message stratos.pot.v1.MsgVolumeReportHeader does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgWithdraw) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgVolumeReportHeader) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in stratos.pot.v1.MsgWithdraw", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in stratos.pot.v1.MsgVolumeReportHeader", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1522,7 +1549,7 @@ func (x *fastReflection_MsgWithdraw) WhichOneof(d protoreflect.OneofDescriptor)
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgWithdraw) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgVolumeReportHeader) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1533,7 +1560,7 @@ func (x *fastReflection_MsgWithdraw) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgWithdraw) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgVolumeReportHeader) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1545,7 +1572,7 @@ func (x *fastReflection_MsgWithdraw) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgWithdraw) IsValid() bool {
+func (x *fastReflection_MsgVolumeReportHeader) IsValid() bool {
 	return x != nil
 }
 
@@ -1555,9 +1582,9 @@ func (x *fastReflection_MsgWithdraw) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgWithdraw) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgVolumeReportHeader) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgWithdraw)
+		x := input.Message.Interface().(*MsgVolumeReportHeader)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1569,20 +1596,33 @@ func (x *fastReflection_MsgWithdraw) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if len(x.Amount) > 0 {
-			for _, e := range x.Amount {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+		l = len(x.Reporter)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.WalletAddress)
+		l = len(x.Epoch)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.TargetAddress)
+		l = len(x.ReportReference)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ReporterOwner)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.TotalCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.TotalCount))
+		}
+		l = len(x.VolumesRoot)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.BLSSignature != nil {
+			l = options.Size(x.BLSSignature)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1593,7 +1633,7 @@ func (x *fastReflection_MsgWithdraw) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgWithdraw)
+		x := input.Message.Interface().(*MsgVolumeReportHeader)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1612,35 +1652,59 @@ func (x *fastReflection_MsgWithdraw) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.TargetAddress) > 0 {
-			i -= len(x.TargetAddress)
-			copy(dAtA[i:], x.TargetAddress)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TargetAddress)))
+		if x.BLSSignature != nil {
+			encoded, err := options.Marshal(x.BLSSignature)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x1a
+			dAtA[i] = 0x3a
 		}
-		if len(x.WalletAddress) > 0 {
-			i -= len(x.WalletAddress)
-			copy(dAtA[i:], x.WalletAddress)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.WalletAddress)))
+		if len(x.VolumesRoot) > 0 {
+			i -= len(x.VolumesRoot)
+			copy(dAtA[i:], x.VolumesRoot)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VolumesRoot)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.TotalCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TotalCount))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.ReporterOwner) > 0 {
+			i -= len(x.ReporterOwner)
+			copy(dAtA[i:], x.ReporterOwner)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ReporterOwner)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.ReportReference) > 0 {
+			i -= len(x.ReportReference)
+			copy(dAtA[i:], x.ReportReference)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ReportReference)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Epoch) > 0 {
+			i -= len(x.Epoch)
+			copy(dAtA[i:], x.Epoch)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Epoch)))
 			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.Amount) > 0 {
-			for iNdEx := len(x.Amount) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Amount[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0xa
-			}
+		if len(x.Reporter) > 0 {
+			i -= len(x.Reporter)
+			copy(dAtA[i:], x.Reporter)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Reporter)))
+			i--
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -1653,7 +1717,7 @@ func (x *fastReflection_MsgWithdraw) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgWithdraw)
+		x := input.Message.Interface().(*MsgVolumeReportHeader)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1685,17 +1749,17 @@ func (x *fastReflection_MsgWithdraw) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgWithdraw: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgVolumeReportHeader: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgWithdraw: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgVolumeReportHeader: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reporter", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1705,29 +1769,27 @@ func (x *fastReflection_MsgWithdraw) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Amount = append(x.Amount, &v1beta1.Coin{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Amount[len(x.Amount)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.Reporter = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WalletAddress", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -1755,11 +1817,11 @@ func (x *fastReflection_MsgWithdraw) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.WalletAddress = string(dAtA[iNdEx:postIndex])
+				x.Epoch = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TargetAddress", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReportReference", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -1787,7 +1849,128 @@ func (x *fastReflection_MsgWithdraw) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.TargetAddress = string(dAtA[iNdEx:postIndex])
+				x.ReportReference = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReporterOwner", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ReporterOwner = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalCount", wireType)
+				}
+				x.TotalCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TotalCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VolumesRoot", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.VolumesRoot = append(x.VolumesRoot[:0], dAtA[iNdEx:postIndex]...)
+				if x.VolumesRoot == nil {
+					x.VolumesRoot = []byte{}
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BLSSignature", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.BLSSignature == nil {
+					x.BLSSignature = &BLSSignatureInfo{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BLSSignature); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -1825,23 +2008,23 @@ func (x *fastReflection_MsgWithdraw) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_MsgWithdrawResponse protoreflect.MessageDescriptor
+	md_MsgVolumeReportHeaderResponse protoreflect.MessageDescriptor
 )
 
 func init() {
 	file_stratos_pot_v1_tx_proto_init()
-	md_MsgWithdrawResponse = File_stratos_pot_v1_tx_proto.Messages().ByName("MsgWithdrawResponse")
+	md_MsgVolumeReportHeaderResponse = File_stratos_pot_v1_tx_proto.Messages().ByName("MsgVolumeReportHeaderResponse")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgWithdrawResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgVolumeReportHeaderResponse)(nil)
 
-type fastReflection_MsgWithdrawResponse MsgWithdrawResponse
+type fastReflection_MsgVolumeReportHeaderResponse MsgVolumeReportHeaderResponse
 
-func (x *MsgWithdrawResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgWithdrawResponse)(x)
+func (x *MsgVolumeReportHeaderResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgVolumeReportHeaderResponse)(x)
 }
 
-func (x *MsgWithdrawResponse) slowProtoReflect() protoreflect.Message {
+func (x *MsgVolumeReportHeaderResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_stratos_pot_v1_tx_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1853,43 +2036,43 @@ func (x *MsgWithdrawResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgWithdrawResponse_messageType fastReflection_MsgWithdrawResponse_messageType
-var _ protoreflect.MessageType = fastReflection_MsgWithdrawResponse_messageType{}
+var _fastReflection_MsgVolumeReportHeaderResponse_messageType fastReflection_MsgVolumeReportHeaderResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgVolumeReportHeaderResponse_messageType{}
 
-type fastReflection_MsgWithdrawResponse_messageType struct{}
+type fastReflection_MsgVolumeReportHeaderResponse_messageType struct{}
 
-func (x fastReflection_MsgWithdrawResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgWithdrawResponse)(nil)
+func (x fastReflection_MsgVolumeReportHeaderResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgVolumeReportHeaderResponse)(nil)
 }
-func (x fastReflection_MsgWithdrawResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgWithdrawResponse)
+func (x fastReflection_MsgVolumeReportHeaderResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgVolumeReportHeaderResponse)
 }
-func (x fastReflection_MsgWithdrawResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgWithdrawResponse
+func (x fastReflection_MsgVolumeReportHeaderResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgVolumeReportHeaderResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgWithdrawResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgWithdrawResponse
+func (x *fastReflection_MsgVolumeReportHeaderResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgVolumeReportHeaderResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgWithdrawResponse) Type() protoreflect.MessageType {
-	return _fastReflection_MsgWithdrawResponse_messageType
+func (x *fastReflection_MsgVolumeReportHeaderResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgVolumeReportHeaderResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgWithdrawResponse) New() protoreflect.Message {
-	return new(fastReflection_MsgWithdrawResponse)
+func (x *fastReflection_MsgVolumeReportHeaderResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgVolumeReportHeaderResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgWithdrawResponse) Interface() protoreflect.ProtoMessage {
-	return (*MsgWithdrawResponse)(x)
+func (x *fastReflection_MsgVolumeReportHeaderResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgVolumeReportHeaderResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1897,7 +2080,7 @@ func (x *fastReflection_MsgWithdrawResponse) Interface() protoreflect.ProtoMessa
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgWithdrawResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgVolumeReportHeaderResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 }
 
 // Has reports whether a field is populated.
@@ -1911,13 +2094,13 @@ func (x *fastReflection_MsgWithdrawResponse) Range(f func(protoreflect.FieldDesc
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgWithdrawResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgVolumeReportHeaderResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgWithdrawResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgVolumeReportHeaderResponse"))
 		}
-		panic(fmt.Errorf("message stratos.pot.v1.MsgWithdrawResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message stratos.pot.v1.MsgVolumeReportHeaderResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1927,13 +2110,13 @@ func (x *fastReflection_MsgWithdrawResponse) Has(fd protoreflect.FieldDescriptor
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgWithdrawResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgVolumeReportHeaderResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgWithdrawResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgVolumeReportHeaderResponse"))
 		}
-		panic(fmt.Errorf("message stratos.pot.v1.MsgWithdrawResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message stratos.pot.v1.MsgVolumeReportHeaderResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1943,13 +2126,13 @@ func (x *fastReflection_MsgWithdrawResponse) Clear(fd protoreflect.FieldDescript
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgWithdrawResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgVolumeReportHeaderResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgWithdrawResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgVolumeReportHeaderResponse"))
 		}
-		panic(fmt.Errorf("message stratos.pot.v1.MsgWithdrawResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message stratos.pot.v1.MsgVolumeReportHeaderResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1963,13 +2146,13 @@ func (x *fastReflection_MsgWithdrawResponse) Get(descriptor protoreflect.FieldDe
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgWithdrawResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgVolumeReportHeaderResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgWithdrawResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgVolumeReportHeaderResponse"))
 		}
-		panic(fmt.Errorf("message stratos.pot.v1.MsgWithdrawResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message stratos.pot.v1.MsgVolumeReportHeaderResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1983,36 +2166,36 @@ func (x *fastReflection_MsgWithdrawResponse) Set(fd protoreflect.FieldDescriptor
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgWithdrawResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgVolumeReportHeaderResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgWithdrawResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgVolumeReportHeaderResponse"))
 		}
-		panic(fmt.Errorf("message stratos.pot.v1.MsgWithdrawResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message stratos.pot.v1.MsgVolumeReportHeaderResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgWithdrawResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgVolumeReportHeaderResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgWithdrawResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgVolumeReportHeaderResponse"))
 		}
-		panic(fmt.Errorf("message stratos.pot.v1.MsgWithdrawResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message stratos.pot.v1.MsgVolumeReportHeaderResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgWithdrawResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgVolumeReportHeaderResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in stratos.pot.v1.MsgWithdrawResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in stratos.pot.v1.MsgVolumeReportHeaderResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2020,7 +2203,7 @@ func (x *fastReflection_MsgWithdrawResponse) WhichOneof(d protoreflect.OneofDesc
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgWithdrawResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgVolumeReportHeaderResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2031,7 +2214,7 @@ func (x *fastReflection_MsgWithdrawResponse) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgWithdrawResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgVolumeReportHeaderResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2043,7 +2226,7 @@ func (x *fastReflection_MsgWithdrawResponse) SetUnknown(fields protoreflect.RawF
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgWithdrawResponse) IsValid() bool {
+func (x *fastReflection_MsgVolumeReportHeaderResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -2053,9 +2236,9 @@ func (x *fastReflection_MsgWithdrawResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgWithdrawResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgVolumeReportHeaderResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgWithdrawResponse)
+		x := input.Message.Interface().(*MsgVolumeReportHeaderResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2077,7 +2260,7 @@ func (x *fastReflection_MsgWithdrawResponse) ProtoMethods() *protoiface.Methods
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgWithdrawResponse)
+		x := input.Message.Interface().(*MsgVolumeReportHeaderResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2107,7 +2290,7 @@ func (x *fastReflection_MsgWithdrawResponse) ProtoMethods() *protoiface.Methods
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgWithdrawResponse)
+		x := input.Message.Interface().(*MsgVolumeReportHeaderResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2139,10 +2322,10 @@ func (x *fastReflection_MsgWithdrawResponse) ProtoMethods() *protoiface.Methods
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgWithdrawResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgVolumeReportHeaderResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgWithdrawResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgVolumeReportHeaderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			default:
@@ -2180,79 +2363,138 @@ func (x *fastReflection_MsgWithdrawResponse) ProtoMethods() *protoiface.Methods
 	}
 }
 
-var _ protoreflect.List = (*_MsgFoundationDeposit_1_list)(nil)
+var _ protoreflect.List = (*_MsgVolumeReportChunk_5_list)(nil)
 
-type _MsgFoundationDeposit_1_list struct {
-	list *[]*v1beta1.Coin
+type _MsgVolumeReportChunk_5_list struct {
+	list *[]*SingleWalletVolume
 }
 
-func (x *_MsgFoundationDeposit_1_list) Len() int {
+func (x *_MsgVolumeReportChunk_5_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_MsgFoundationDeposit_1_list) Get(i int) protoreflect.Value {
+func (x *_MsgVolumeReportChunk_5_list) Get(i int) protoreflect.Value {
 	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_MsgFoundationDeposit_1_list) Set(i int, value protoreflect.Value) {
+func (x *_MsgVolumeReportChunk_5_list) Set(i int, value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	concreteValue := valueUnwrapped.Interface().(*SingleWalletVolume)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_MsgFoundationDeposit_1_list) Append(value protoreflect.Value) {
+func (x *_MsgVolumeReportChunk_5_list) Append(value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	concreteValue := valueUnwrapped.Interface().(*SingleWalletVolume)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_MsgFoundationDeposit_1_list) AppendMutable() protoreflect.Value {
-	v := new(v1beta1.Coin)
+func (x *_MsgVolumeReportChunk_5_list) AppendMutable() protoreflect.Value {
+	v := new(SingleWalletVolume)
 	*x.list = append(*x.list, v)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_MsgFoundationDeposit_1_list) Truncate(n int) {
+func (x *_MsgVolumeReportChunk_5_list) Truncate(n int) {
 	for i := n; i < len(*x.list); i++ {
 		(*x.list)[i] = nil
 	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_MsgFoundationDeposit_1_list) NewElement() protoreflect.Value {
-	v := new(v1beta1.Coin)
+func (x *_MsgVolumeReportChunk_5_list) NewElement() protoreflect.Value {
+	v := new(SingleWalletVolume)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_MsgFoundationDeposit_1_list) IsValid() bool {
+func (x *_MsgVolumeReportChunk_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_MsgVolumeReportChunk_6_list)(nil)
+
+type _MsgVolumeReportChunk_6_list struct {
+	list *[]*VolumeInclusionProof
+}
+
+func (x *_MsgVolumeReportChunk_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgVolumeReportChunk_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MsgVolumeReportChunk_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*VolumeInclusionProof)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgVolumeReportChunk_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*VolumeInclusionProof)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgVolumeReportChunk_6_list) AppendMutable() protoreflect.Value {
+	v := new(VolumeInclusionProof)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgVolumeReportChunk_6_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgVolumeReportChunk_6_list) NewElement() protoreflect.Value {
+	v := new(VolumeInclusionProof)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgVolumeReportChunk_6_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_MsgFoundationDeposit        protoreflect.MessageDescriptor
-	fd_MsgFoundationDeposit_amount protoreflect.FieldDescriptor
-	fd_MsgFoundationDeposit_from   protoreflect.FieldDescriptor
+	md_MsgVolumeReportChunk                protoreflect.MessageDescriptor
+	fd_MsgVolumeReportChunk_reporter       protoreflect.FieldDescriptor
+	fd_MsgVolumeReportChunk_reporter_owner protoreflect.FieldDescriptor
+	fd_MsgVolumeReportChunk_epoch          protoreflect.FieldDescriptor
+	fd_MsgVolumeReportChunk_start_index    protoreflect.FieldDescriptor
+	fd_MsgVolumeReportChunk_wallet_volumes protoreflect.FieldDescriptor
+	fd_MsgVolumeReportChunk_proofs         protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_stratos_pot_v1_tx_proto_init()
-	md_MsgFoundationDeposit = File_stratos_pot_v1_tx_proto.Messages().ByName("MsgFoundationDeposit")
-	fd_MsgFoundationDeposit_amount = md_MsgFoundationDeposit.Fields().ByName("amount")
-	fd_MsgFoundationDeposit_from = md_MsgFoundationDeposit.Fields().ByName("from")
+	md_MsgVolumeReportChunk = File_stratos_pot_v1_tx_proto.Messages().ByName("MsgVolumeReportChunk")
+	fd_MsgVolumeReportChunk_reporter = md_MsgVolumeReportChunk.Fields().ByName("reporter")
+	fd_MsgVolumeReportChunk_reporter_owner = md_MsgVolumeReportChunk.Fields().ByName("reporter_owner")
+	fd_MsgVolumeReportChunk_epoch = md_MsgVolumeReportChunk.Fields().ByName("epoch")
+	fd_MsgVolumeReportChunk_start_index = md_MsgVolumeReportChunk.Fields().ByName("start_index")
+	fd_MsgVolumeReportChunk_wallet_volumes = md_MsgVolumeReportChunk.Fields().ByName("wallet_volumes")
+	fd_MsgVolumeReportChunk_proofs = md_MsgVolumeReportChunk.Fields().ByName("proofs")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgFoundationDeposit)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgVolumeReportChunk)(nil)
 
-type fastReflection_MsgFoundationDeposit MsgFoundationDeposit
+type fastReflection_MsgVolumeReportChunk MsgVolumeReportChunk
 
-func (x *MsgFoundationDeposit) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgFoundationDeposit)(x)
+func (x *MsgVolumeReportChunk) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgVolumeReportChunk)(x)
 }
 
-func (x *MsgFoundationDeposit) slowProtoReflect() protoreflect.Message {
+func (x *MsgVolumeReportChunk) slowProtoReflect() protoreflect.Message {
 	mi := &file_stratos_pot_v1_tx_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2264,43 +2506,43 @@ func (x *MsgFoundationDeposit) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgFoundationDeposit_messageType fastReflection_MsgFoundationDeposit_messageType
-var _ protoreflect.MessageType = fastReflection_MsgFoundationDeposit_messageType{}
+var _fastReflection_MsgVolumeReportChunk_messageType fastReflection_MsgVolumeReportChunk_messageType
+var _ protoreflect.MessageType = fastReflection_MsgVolumeReportChunk_messageType{}
 
-type fastReflection_MsgFoundationDeposit_messageType struct{}
+type fastReflection_MsgVolumeReportChunk_messageType struct{}
 
-func (x fastReflection_MsgFoundationDeposit_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgFoundationDeposit)(nil)
+func (x fastReflection_MsgVolumeReportChunk_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgVolumeReportChunk)(nil)
 }
-func (x fastReflection_MsgFoundationDeposit_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgFoundationDeposit)
+func (x fastReflection_MsgVolumeReportChunk_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgVolumeReportChunk)
 }
-func (x fastReflection_MsgFoundationDeposit_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgFoundationDeposit
+func (x fastReflection_MsgVolumeReportChunk_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgVolumeReportChunk
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgFoundationDeposit) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgFoundationDeposit
+func (x *fastReflection_MsgVolumeReportChunk) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgVolumeReportChunk
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgFoundationDeposit) Type() protoreflect.MessageType {
-	return _fastReflection_MsgFoundationDeposit_messageType
+func (x *fastReflection_MsgVolumeReportChunk) Type() protoreflect.MessageType {
+	return _fastReflection_MsgVolumeReportChunk_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgFoundationDeposit) New() protoreflect.Message {
-	return new(fastReflection_MsgFoundationDeposit)
+func (x *fastReflection_MsgVolumeReportChunk) New() protoreflect.Message {
+	return new(fastReflection_MsgVolumeReportChunk)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgFoundationDeposit) Interface() protoreflect.ProtoMessage {
-	return (*MsgFoundationDeposit)(x)
+func (x *fastReflection_MsgVolumeReportChunk) Interface() protoreflect.ProtoMessage {
+	return (*MsgVolumeReportChunk)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2308,16 +2550,40 @@ func (x *fastReflection_MsgFoundationDeposit) Interface() protoreflect.ProtoMess
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgFoundationDeposit) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.Amount) != 0 {
-		value := protoreflect.ValueOfList(&_MsgFoundationDeposit_1_list{list: &x.Amount})
-		if !f(fd_MsgFoundationDeposit_amount, value) {
+func (x *fastReflection_MsgVolumeReportChunk) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Reporter != "" {
+		value := protoreflect.ValueOfString(x.Reporter)
+		if !f(fd_MsgVolumeReportChunk_reporter, value) {
 			return
 		}
 	}
-	if x.From != "" {
-		value := protoreflect.ValueOfString(x.From)
-		if !f(fd_MsgFoundationDeposit_from, value) {
+	if x.ReporterOwner != "" {
+		value := protoreflect.ValueOfString(x.ReporterOwner)
+		if !f(fd_MsgVolumeReportChunk_reporter_owner, value) {
+			return
+		}
+	}
+	if x.Epoch != "" {
+		value := protoreflect.ValueOfString(x.Epoch)
+		if !f(fd_MsgVolumeReportChunk_epoch, value) {
+			return
+		}
+	}
+	if x.StartIndex != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.StartIndex)
+		if !f(fd_MsgVolumeReportChunk_start_index, value) {
+			return
+		}
+	}
+	if len(x.WalletVolumes) != 0 {
+		value := protoreflect.ValueOfList(&_MsgVolumeReportChunk_5_list{list: &x.WalletVolumes})
+		if !f(fd_MsgVolumeReportChunk_wallet_volumes, value) {
+			return
+		}
+	}
+	if len(x.Proofs) != 0 {
+		value := protoreflect.ValueOfList(&_MsgVolumeReportChunk_6_list{list: &x.Proofs})
+		if !f(fd_MsgVolumeReportChunk_proofs, value) {
 			return
 		}
 	}
@@ -2334,17 +2600,25 @@ func (x *fastReflection_MsgFoundationDeposit) Range(f func(protoreflect.FieldDes
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgFoundationDeposit) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgVolumeReportChunk) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "stratos.pot.v1.MsgFoundationDeposit.amount":
-		return len(x.Amount) != 0
-	case "stratos.pot.v1.MsgFoundationDeposit.from":
-		return x.From != ""
+	case "stratos.pot.v1.MsgVolumeReportChunk.reporter":
+		return x.Reporter != ""
+	case "stratos.pot.v1.MsgVolumeReportChunk.reporter_owner":
+		return x.ReporterOwner != ""
+	case "stratos.pot.v1.MsgVolumeReportChunk.epoch":
+		return x.Epoch != ""
+	case "stratos.pot.v1.MsgVolumeReportChunk.start_index":
+		return x.StartIndex != uint64(0)
+	case "stratos.pot.v1.MsgVolumeReportChunk.wallet_volumes":
+		return len(x.WalletVolumes) != 0
+	case "stratos.pot.v1.MsgVolumeReportChunk.proofs":
+		return len(x.Proofs) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgFoundationDeposit"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgVolumeReportChunk"))
 		}
-		panic(fmt.Errorf("message stratos.pot.v1.MsgFoundationDeposit does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message stratos.pot.v1.MsgVolumeReportChunk does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2354,17 +2628,25 @@ func (x *fastReflection_MsgFoundationDeposit) Has(fd protoreflect.FieldDescripto
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgFoundationDeposit) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgVolumeReportChunk) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "stratos.pot.v1.MsgFoundationDeposit.amount":
-		x.Amount = nil
-	case "stratos.pot.v1.MsgFoundationDeposit.from":
-		x.From = ""
+	case "stratos.pot.v1.MsgVolumeReportChunk.reporter":
+		x.Reporter = ""
+	case "stratos.pot.v1.MsgVolumeReportChunk.reporter_owner":
+		x.ReporterOwner = ""
+	case "stratos.pot.v1.MsgVolumeReportChunk.epoch":
+		x.Epoch = ""
+	case "stratos.pot.v1.MsgVolumeReportChunk.start_index":
+		x.StartIndex = uint64(0)
+	case "stratos.pot.v1.MsgVolumeReportChunk.wallet_volumes":
+		x.WalletVolumes = nil
+	case "stratos.pot.v1.MsgVolumeReportChunk.proofs":
+		x.Proofs = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgFoundationDeposit"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgVolumeReportChunk"))
 		}
-		panic(fmt.Errorf("message stratos.pot.v1.MsgFoundationDeposit does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message stratos.pot.v1.MsgVolumeReportChunk does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2374,22 +2656,37 @@ func (x *fastReflection_MsgFoundationDeposit) Clear(fd protoreflect.FieldDescrip
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgFoundationDeposit) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgVolumeReportChunk) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "stratos.pot.v1.MsgFoundationDeposit.amount":
-		if len(x.Amount) == 0 {
-			return protoreflect.ValueOfList(&_MsgFoundationDeposit_1_list{})
+	case "stratos.pot.v1.MsgVolumeReportChunk.reporter":
+		value := x.Reporter
+		return protoreflect.ValueOfString(value)
+	case "stratos.pot.v1.MsgVolumeReportChunk.reporter_owner":
+		value := x.ReporterOwner
+		return protoreflect.ValueOfString(value)
+	case "stratos.pot.v1.MsgVolumeReportChunk.epoch":
+		value := x.Epoch
+		return protoreflect.ValueOfString(value)
+	case "stratos.pot.v1.MsgVolumeReportChunk.start_index":
+		value := x.StartIndex
+		return protoreflect.ValueOfUint64(value)
+	case "stratos.pot.v1.MsgVolumeReportChunk.wallet_volumes":
+		if len(x.WalletVolumes) == 0 {
+			return protoreflect.ValueOfList(&_MsgVolumeReportChunk_5_list{})
 		}
-		listValue := &_MsgFoundationDeposit_1_list{list: &x.Amount}
+		listValue := &_MsgVolumeReportChunk_5_list{list: &x.WalletVolumes}
+		return protoreflect.ValueOfList(listValue)
+	case "stratos.pot.v1.MsgVolumeReportChunk.proofs":
+		if len(x.Proofs) == 0 {
+			return protoreflect.ValueOfList(&_MsgVolumeReportChunk_6_list{})
+		}
+		listValue := &_MsgVolumeReportChunk_6_list{list: &x.Proofs}
 		return protoreflect.ValueOfList(listValue)
-	case "stratos.pot.v1.MsgFoundationDeposit.from":
-		value := x.From
-		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgFoundationDeposit"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgVolumeReportChunk"))
 		}
-		panic(fmt.Errorf("message stratos.pot.v1.MsgFoundationDeposit does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message stratos.pot.v1.MsgVolumeReportChunk does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2403,19 +2700,29 @@ func (x *fastReflection_MsgFoundationDeposit) Get(descriptor protoreflect.FieldD
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgFoundationDeposit) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgVolumeReportChunk) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "stratos.pot.v1.MsgFoundationDeposit.amount":
+	case "stratos.pot.v1.MsgVolumeReportChunk.reporter":
+		x.Reporter = value.Interface().(string)
+	case "stratos.pot.v1.MsgVolumeReportChunk.reporter_owner":
+		x.ReporterOwner = value.Interface().(string)
+	case "stratos.pot.v1.MsgVolumeReportChunk.epoch":
+		x.Epoch = value.Interface().(string)
+	case "stratos.pot.v1.MsgVolumeReportChunk.start_index":
+		x.StartIndex = value.Uint()
+	case "stratos.pot.v1.MsgVolumeReportChunk.wallet_volumes":
 		lv := value.List()
-		clv := lv.(*_MsgFoundationDeposit_1_list)
-		x.Amount = *clv.list
-	case "stratos.pot.v1.MsgFoundationDeposit.from":
-		x.From = value.Interface().(string)
+		clv := lv.(*_MsgVolumeReportChunk_5_list)
+		x.WalletVolumes = *clv.list
+	case "stratos.pot.v1.MsgVolumeReportChunk.proofs":
+		lv := value.List()
+		clv := lv.(*_MsgVolumeReportChunk_6_list)
+		x.Proofs = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgFoundationDeposit"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgVolumeReportChunk"))
 		}
-		panic(fmt.Errorf("message stratos.pot.v1.MsgFoundationDeposit does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message stratos.pot.v1.MsgVolumeReportChunk does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2429,49 +2736,70 @@ func (x *fastReflection_MsgFoundationDeposit) Set(fd protoreflect.FieldDescripto
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgFoundationDeposit) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgVolumeReportChunk) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "stratos.pot.v1.MsgFoundationDeposit.amount":
-		if x.Amount == nil {
-			x.Amount = []*v1beta1.Coin{}
+	case "stratos.pot.v1.MsgVolumeReportChunk.wallet_volumes":
+		if x.WalletVolumes == nil {
+			x.WalletVolumes = []*SingleWalletVolume{}
 		}
-		value := &_MsgFoundationDeposit_1_list{list: &x.Amount}
+		value := &_MsgVolumeReportChunk_5_list{list: &x.WalletVolumes}
 		return protoreflect.ValueOfList(value)
-	case "stratos.pot.v1.MsgFoundationDeposit.from":
-		panic(fmt.Errorf("field from of message stratos.pot.v1.MsgFoundationDeposit is not mutable"))
+	case "stratos.pot.v1.MsgVolumeReportChunk.proofs":
+		if x.Proofs == nil {
+			x.Proofs = []*VolumeInclusionProof{}
+		}
+		value := &_MsgVolumeReportChunk_6_list{list: &x.Proofs}
+		return protoreflect.ValueOfList(value)
+	case "stratos.pot.v1.MsgVolumeReportChunk.reporter":
+		panic(fmt.Errorf("field reporter of message stratos.pot.v1.MsgVolumeReportChunk is not mutable"))
+	case "stratos.pot.v1.MsgVolumeReportChunk.reporter_owner":
+		panic(fmt.Errorf("field reporter_owner of message stratos.pot.v1.MsgVolumeReportChunk is not mutable"))
+	case "stratos.pot.v1.MsgVolumeReportChunk.epoch":
+		panic(fmt.Errorf("field epoch of message stratos.pot.v1.MsgVolumeReportChunk is not mutable"))
+	case "stratos.pot.v1.MsgVolumeReportChunk.start_index":
+		panic(fmt.Errorf("field start_index of message stratos.pot.v1.MsgVolumeReportChunk is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgFoundationDeposit"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgVolumeReportChunk"))
 		}
-		panic(fmt.Errorf("message stratos.pot.v1.MsgFoundationDeposit does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message stratos.pot.v1.MsgVolumeReportChunk does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgFoundationDeposit) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgVolumeReportChunk) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "stratos.pot.v1.MsgFoundationDeposit.amount":
-		list := []*v1beta1.Coin{}
-		return protoreflect.ValueOfList(&_MsgFoundationDeposit_1_list{list: &list})
-	case "stratos.pot.v1.MsgFoundationDeposit.from":
+	case "stratos.pot.v1.MsgVolumeReportChunk.reporter":
+		return protoreflect.ValueOfString("")
+	case "stratos.pot.v1.MsgVolumeReportChunk.reporter_owner":
 		return protoreflect.ValueOfString("")
+	case "stratos.pot.v1.MsgVolumeReportChunk.epoch":
+		return protoreflect.ValueOfString("")
+	case "stratos.pot.v1.MsgVolumeReportChunk.start_index":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "stratos.pot.v1.MsgVolumeReportChunk.wallet_volumes":
+		list := []*SingleWalletVolume{}
+		return protoreflect.ValueOfList(&_MsgVolumeReportChunk_5_list{list: &list})
+	case "stratos.pot.v1.MsgVolumeReportChunk.proofs":
+		list := []*VolumeInclusionProof{}
+		return protoreflect.ValueOfList(&_MsgVolumeReportChunk_6_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgFoundationDeposit"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgVolumeReportChunk"))
 		}
-		panic(fmt.Errorf("message stratos.pot.v1.MsgFoundationDeposit does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message stratos.pot.v1.MsgVolumeReportChunk does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgFoundationDeposit) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgVolumeReportChunk) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in stratos.pot.v1.MsgFoundationDeposit", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in stratos.pot.v1.MsgVolumeReportChunk", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2479,7 +2807,7 @@ func (x *fastReflection_MsgFoundationDeposit) WhichOneof(d protoreflect.OneofDes
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgFoundationDeposit) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgVolumeReportChunk) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2490,7 +2818,7 @@ func (x *fastReflection_MsgFoundationDeposit) GetUnknown() protoreflect.RawField
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgFoundationDeposit) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgVolumeReportChunk) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2502,7 +2830,7 @@ func (x *fastReflection_MsgFoundationDeposit) SetUnknown(fields protoreflect.Raw
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgFoundationDeposit) IsValid() bool {
+func (x *fastReflection_MsgVolumeReportChunk) IsValid() bool {
 	return x != nil
 }
 
@@ -2512,9 +2840,9 @@ func (x *fastReflection_MsgFoundationDeposit) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgFoundationDeposit) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgVolumeReportChunk) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgFoundationDeposit)
+		x := input.Message.Interface().(*MsgVolumeReportChunk)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2526,15 +2854,32 @@ func (x *fastReflection_MsgFoundationDeposit) ProtoMethods() *protoiface.Methods
 		var n int
 		var l int
 		_ = l
-		if len(x.Amount) > 0 {
-			for _, e := range x.Amount {
+		l = len(x.Reporter)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ReporterOwner)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Epoch)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.StartIndex != 0 {
+			n += 1 + runtime.Sov(uint64(x.StartIndex))
+		}
+		if len(x.WalletVolumes) > 0 {
+			for _, e := range x.WalletVolumes {
 				l = options.Size(e)
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
-		l = len(x.From)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if len(x.Proofs) > 0 {
+			for _, e := range x.Proofs {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -2546,7 +2891,7 @@ func (x *fastReflection_MsgFoundationDeposit) ProtoMethods() *protoiface.Methods
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgFoundationDeposit)
+		x := input.Message.Interface().(*MsgVolumeReportChunk)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2565,16 +2910,25 @@ func (x *fastReflection_MsgFoundationDeposit) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.From) > 0 {
-			i -= len(x.From)
-			copy(dAtA[i:], x.From)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.From)))
-			i--
-			dAtA[i] = 0x12
+		if len(x.Proofs) > 0 {
+			for iNdEx := len(x.Proofs) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Proofs[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x32
+			}
 		}
-		if len(x.Amount) > 0 {
-			for iNdEx := len(x.Amount) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Amount[iNdEx])
+		if len(x.WalletVolumes) > 0 {
+			for iNdEx := len(x.WalletVolumes) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.WalletVolumes[iNdEx])
 				if err != nil {
 					return protoiface.MarshalOutput{
 						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2585,9 +2939,35 @@ func (x *fastReflection_MsgFoundationDeposit) ProtoMethods() *protoiface.Methods
 				copy(dAtA[i:], encoded)
 				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 				i--
-				dAtA[i] = 0xa
+				dAtA[i] = 0x2a
 			}
 		}
+		if x.StartIndex != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.StartIndex))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.Epoch) > 0 {
+			i -= len(x.Epoch)
+			copy(dAtA[i:], x.Epoch)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Epoch)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.ReporterOwner) > 0 {
+			i -= len(x.ReporterOwner)
+			copy(dAtA[i:], x.ReporterOwner)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ReporterOwner)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Reporter) > 0 {
+			i -= len(x.Reporter)
+			copy(dAtA[i:], x.Reporter)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Reporter)))
+			i--
+			dAtA[i] = 0xa
+		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -2599,7 +2979,7 @@ func (x *fastReflection_MsgFoundationDeposit) ProtoMethods() *protoiface.Methods
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgFoundationDeposit)
+		x := input.Message.Interface().(*MsgVolumeReportChunk)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2631,17 +3011,17 @@ func (x *fastReflection_MsgFoundationDeposit) ProtoMethods() *protoiface.Methods
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgFoundationDeposit: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgVolumeReportChunk: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgFoundationDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgVolumeReportChunk: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reporter", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -2651,29 +3031,27 @@ func (x *fastReflection_MsgFoundationDeposit) ProtoMethods() *protoiface.Methods
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Amount = append(x.Amount, &v1beta1.Coin{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Amount[len(x.Amount)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.Reporter = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReporterOwner", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -2701,7 +3079,126 @@ func (x *fastReflection_MsgFoundationDeposit) ProtoMethods() *protoiface.Methods
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.From = string(dAtA[iNdEx:postIndex])
+				x.ReporterOwner = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Epoch = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StartIndex", wireType)
+				}
+				x.StartIndex = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.StartIndex |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WalletVolumes", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.WalletVolumes = append(x.WalletVolumes, &SingleWalletVolume{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.WalletVolumes[len(x.WalletVolumes)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Proofs", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Proofs = append(x.Proofs, &VolumeInclusionProof{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Proofs[len(x.Proofs)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -2739,23 +3236,25 @@ func (x *fastReflection_MsgFoundationDeposit) ProtoMethods() *protoiface.Methods
 }
 
 var (
-	md_MsgFoundationDepositResponse protoreflect.MessageDescriptor
+	md_MsgVolumeReportChunkResponse           protoreflect.MessageDescriptor
+	fd_MsgVolumeReportChunkResponse_finalized protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_stratos_pot_v1_tx_proto_init()
-	md_MsgFoundationDepositResponse = File_stratos_pot_v1_tx_proto.Messages().ByName("MsgFoundationDepositResponse")
+	md_MsgVolumeReportChunkResponse = File_stratos_pot_v1_tx_proto.Messages().ByName("MsgVolumeReportChunkResponse")
+	fd_MsgVolumeReportChunkResponse_finalized = md_MsgVolumeReportChunkResponse.Fields().ByName("finalized")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgFoundationDepositResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgVolumeReportChunkResponse)(nil)
 
-type fastReflection_MsgFoundationDepositResponse MsgFoundationDepositResponse
+type fastReflection_MsgVolumeReportChunkResponse MsgVolumeReportChunkResponse
 
-func (x *MsgFoundationDepositResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgFoundationDepositResponse)(x)
+func (x *MsgVolumeReportChunkResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgVolumeReportChunkResponse)(x)
 }
 
-func (x *MsgFoundationDepositResponse) slowProtoReflect() protoreflect.Message {
+func (x *MsgVolumeReportChunkResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_stratos_pot_v1_tx_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2767,43 +3266,43 @@ func (x *MsgFoundationDepositResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgFoundationDepositResponse_messageType fastReflection_MsgFoundationDepositResponse_messageType
-var _ protoreflect.MessageType = fastReflection_MsgFoundationDepositResponse_messageType{}
+var _fastReflection_MsgVolumeReportChunkResponse_messageType fastReflection_MsgVolumeReportChunkResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgVolumeReportChunkResponse_messageType{}
 
-type fastReflection_MsgFoundationDepositResponse_messageType struct{}
+type fastReflection_MsgVolumeReportChunkResponse_messageType struct{}
 
-func (x fastReflection_MsgFoundationDepositResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgFoundationDepositResponse)(nil)
+func (x fastReflection_MsgVolumeReportChunkResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgVolumeReportChunkResponse)(nil)
 }
-func (x fastReflection_MsgFoundationDepositResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgFoundationDepositResponse)
+func (x fastReflection_MsgVolumeReportChunkResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgVolumeReportChunkResponse)
 }
-func (x fastReflection_MsgFoundationDepositResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgFoundationDepositResponse
+func (x fastReflection_MsgVolumeReportChunkResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgVolumeReportChunkResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgFoundationDepositResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgFoundationDepositResponse
+func (x *fastReflection_MsgVolumeReportChunkResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgVolumeReportChunkResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgFoundationDepositResponse) Type() protoreflect.MessageType {
-	return _fastReflection_MsgFoundationDepositResponse_messageType
+func (x *fastReflection_MsgVolumeReportChunkResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgVolumeReportChunkResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgFoundationDepositResponse) New() protoreflect.Message {
-	return new(fastReflection_MsgFoundationDepositResponse)
+func (x *fastReflection_MsgVolumeReportChunkResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgVolumeReportChunkResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgFoundationDepositResponse) Interface() protoreflect.ProtoMessage {
-	return (*MsgFoundationDepositResponse)(x)
+func (x *fastReflection_MsgVolumeReportChunkResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgVolumeReportChunkResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2811,7 +3310,13 @@ func (x *fastReflection_MsgFoundationDepositResponse) Interface() protoreflect.P
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgFoundationDepositResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgVolumeReportChunkResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Finalized != false {
+		value := protoreflect.ValueOfBool(x.Finalized)
+		if !f(fd_MsgVolumeReportChunkResponse_finalized, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -2825,13 +3330,15 @@ func (x *fastReflection_MsgFoundationDepositResponse) Range(f func(protoreflect.
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgFoundationDepositResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgVolumeReportChunkResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
+	case "stratos.pot.v1.MsgVolumeReportChunkResponse.finalized":
+		return x.Finalized != false
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgFoundationDepositResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgVolumeReportChunkResponse"))
 		}
-		panic(fmt.Errorf("message stratos.pot.v1.MsgFoundationDepositResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message stratos.pot.v1.MsgVolumeReportChunkResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2841,13 +3348,15 @@ func (x *fastReflection_MsgFoundationDepositResponse) Has(fd protoreflect.FieldD
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgFoundationDepositResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgVolumeReportChunkResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
+	case "stratos.pot.v1.MsgVolumeReportChunkResponse.finalized":
+		x.Finalized = false
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgFoundationDepositResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgVolumeReportChunkResponse"))
 		}
-		panic(fmt.Errorf("message stratos.pot.v1.MsgFoundationDepositResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message stratos.pot.v1.MsgVolumeReportChunkResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2857,13 +3366,16 @@ func (x *fastReflection_MsgFoundationDepositResponse) Clear(fd protoreflect.Fiel
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgFoundationDepositResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgVolumeReportChunkResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
+	case "stratos.pot.v1.MsgVolumeReportChunkResponse.finalized":
+		value := x.Finalized
+		return protoreflect.ValueOfBool(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgFoundationDepositResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgVolumeReportChunkResponse"))
 		}
-		panic(fmt.Errorf("message stratos.pot.v1.MsgFoundationDepositResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message stratos.pot.v1.MsgVolumeReportChunkResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2877,13 +3389,15 @@ func (x *fastReflection_MsgFoundationDepositResponse) Get(descriptor protoreflec
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgFoundationDepositResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgVolumeReportChunkResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
+	case "stratos.pot.v1.MsgVolumeReportChunkResponse.finalized":
+		x.Finalized = value.Bool()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgFoundationDepositResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgVolumeReportChunkResponse"))
 		}
-		panic(fmt.Errorf("message stratos.pot.v1.MsgFoundationDepositResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message stratos.pot.v1.MsgVolumeReportChunkResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2897,36 +3411,40 @@ func (x *fastReflection_MsgFoundationDepositResponse) Set(fd protoreflect.FieldD
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgFoundationDepositResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgVolumeReportChunkResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "stratos.pot.v1.MsgVolumeReportChunkResponse.finalized":
+		panic(fmt.Errorf("field finalized of message stratos.pot.v1.MsgVolumeReportChunkResponse is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgFoundationDepositResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgVolumeReportChunkResponse"))
 		}
-		panic(fmt.Errorf("message stratos.pot.v1.MsgFoundationDepositResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message stratos.pot.v1.MsgVolumeReportChunkResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgFoundationDepositResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgVolumeReportChunkResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "stratos.pot.v1.MsgVolumeReportChunkResponse.finalized":
+		return protoreflect.ValueOfBool(false)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgFoundationDepositResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgVolumeReportChunkResponse"))
 		}
-		panic(fmt.Errorf("message stratos.pot.v1.MsgFoundationDepositResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message stratos.pot.v1.MsgVolumeReportChunkResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgFoundationDepositResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgVolumeReportChunkResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in stratos.pot.v1.MsgFoundationDepositResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in stratos.pot.v1.MsgVolumeReportChunkResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2934,7 +3452,7 @@ func (x *fastReflection_MsgFoundationDepositResponse) WhichOneof(d protoreflect.
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgFoundationDepositResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgVolumeReportChunkResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2945,7 +3463,7 @@ func (x *fastReflection_MsgFoundationDepositResponse) GetUnknown() protoreflect.
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgFoundationDepositResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgVolumeReportChunkResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2957,7 +3475,7 @@ func (x *fastReflection_MsgFoundationDepositResponse) SetUnknown(fields protoref
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgFoundationDepositResponse) IsValid() bool {
+func (x *fastReflection_MsgVolumeReportChunkResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -2967,9 +3485,9 @@ func (x *fastReflection_MsgFoundationDepositResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgFoundationDepositResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgVolumeReportChunkResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgFoundationDepositResponse)
+		x := input.Message.Interface().(*MsgVolumeReportChunkResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2981,6 +3499,9 @@ func (x *fastReflection_MsgFoundationDepositResponse) ProtoMethods() *protoiface
 		var n int
 		var l int
 		_ = l
+		if x.Finalized {
+			n += 2
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -2991,7 +3512,7 @@ func (x *fastReflection_MsgFoundationDepositResponse) ProtoMethods() *protoiface
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgFoundationDepositResponse)
+		x := input.Message.Interface().(*MsgVolumeReportChunkResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3010,6 +3531,16 @@ func (x *fastReflection_MsgFoundationDepositResponse) ProtoMethods() *protoiface
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.Finalized {
+			i--
+			if x.Finalized {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x8
+		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -3021,7 +3552,7 @@ func (x *fastReflection_MsgFoundationDepositResponse) ProtoMethods() *protoiface
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgFoundationDepositResponse)
+		x := input.Message.Interface().(*MsgVolumeReportChunkResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3053,12 +3584,32 @@ func (x *fastReflection_MsgFoundationDepositResponse) ProtoMethods() *protoiface
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgFoundationDepositResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgVolumeReportChunkResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgFoundationDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgVolumeReportChunkResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Finalized", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Finalized = bool(v != 0)
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -3094,129 +3645,6752 @@ func (x *fastReflection_MsgFoundationDepositResponse) ProtoMethods() *protoiface
 	}
 }
 
-var _ protoreflect.List = (*_MsgSlashingResourceNode_1_list)(nil)
+var _ protoreflect.List = (*_MsgWithdraw_1_list)(nil)
 
-type _MsgSlashingResourceNode_1_list struct {
-	list *[]string
+type _MsgWithdraw_1_list struct {
+	list *[]*v1beta1.Coin
 }
 
-func (x *_MsgSlashingResourceNode_1_list) Len() int {
+func (x *_MsgWithdraw_1_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_MsgSlashingResourceNode_1_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfString((*x.list)[i])
+func (x *_MsgWithdraw_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_MsgSlashingResourceNode_1_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
+func (x *_MsgWithdraw_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_MsgSlashingResourceNode_1_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
+func (x *_MsgWithdraw_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_MsgSlashingResourceNode_1_list) AppendMutable() protoreflect.Value {
-	panic(fmt.Errorf("AppendMutable can not be called on message MsgSlashingResourceNode at list field Reporters as it is not of Message kind"))
+func (x *_MsgWithdraw_1_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_MsgSlashingResourceNode_1_list) Truncate(n int) {
+func (x *_MsgWithdraw_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_MsgSlashingResourceNode_1_list) NewElement() protoreflect.Value {
-	v := ""
-	return protoreflect.ValueOfString(v)
-}
-
-func (x *_MsgSlashingResourceNode_1_list) IsValid() bool {
+func (x *_MsgWithdraw_1_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgWithdraw_1_list) IsValid() bool {
 	return x.list != nil
 }
 
-var _ protoreflect.List = (*_MsgSlashingResourceNode_2_list)(nil)
+var (
+	md_MsgWithdraw                protoreflect.MessageDescriptor
+	fd_MsgWithdraw_amount         protoreflect.FieldDescriptor
+	fd_MsgWithdraw_wallet_address protoreflect.FieldDescriptor
+	fd_MsgWithdraw_target_address protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_stratos_pot_v1_tx_proto_init()
+	md_MsgWithdraw = File_stratos_pot_v1_tx_proto.Messages().ByName("MsgWithdraw")
+	fd_MsgWithdraw_amount = md_MsgWithdraw.Fields().ByName("amount")
+	fd_MsgWithdraw_wallet_address = md_MsgWithdraw.Fields().ByName("wallet_address")
+	fd_MsgWithdraw_target_address = md_MsgWithdraw.Fields().ByName("target_address")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgWithdraw)(nil)
+
+type fastReflection_MsgWithdraw MsgWithdraw
+
+func (x *MsgWithdraw) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgWithdraw)(x)
+}
+
+func (x *MsgWithdraw) slowProtoReflect() protoreflect.Message {
+	mi := &file_stratos_pot_v1_tx_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgWithdraw_messageType fastReflection_MsgWithdraw_messageType
+var _ protoreflect.MessageType = fastReflection_MsgWithdraw_messageType{}
+
+type fastReflection_MsgWithdraw_messageType struct{}
+
+func (x fastReflection_MsgWithdraw_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgWithdraw)(nil)
+}
+func (x fastReflection_MsgWithdraw_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgWithdraw)
+}
+func (x fastReflection_MsgWithdraw_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgWithdraw
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgWithdraw) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgWithdraw
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgWithdraw) Type() protoreflect.MessageType {
+	return _fastReflection_MsgWithdraw_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgWithdraw) New() protoreflect.Message {
+	return new(fastReflection_MsgWithdraw)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgWithdraw) Interface() protoreflect.ProtoMessage {
+	return (*MsgWithdraw)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgWithdraw) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Amount) != 0 {
+		value := protoreflect.ValueOfList(&_MsgWithdraw_1_list{list: &x.Amount})
+		if !f(fd_MsgWithdraw_amount, value) {
+			return
+		}
+	}
+	if x.WalletAddress != "" {
+		value := protoreflect.ValueOfString(x.WalletAddress)
+		if !f(fd_MsgWithdraw_wallet_address, value) {
+			return
+		}
+	}
+	if x.TargetAddress != "" {
+		value := protoreflect.ValueOfString(x.TargetAddress)
+		if !f(fd_MsgWithdraw_target_address, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgWithdraw) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "stratos.pot.v1.MsgWithdraw.amount":
+		return len(x.Amount) != 0
+	case "stratos.pot.v1.MsgWithdraw.wallet_address":
+		return x.WalletAddress != ""
+	case "stratos.pot.v1.MsgWithdraw.target_address":
+		return x.TargetAddress != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgWithdraw"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.MsgWithdraw does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgWithdraw) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "stratos.pot.v1.MsgWithdraw.amount":
+		x.Amount = nil
+	case "stratos.pot.v1.MsgWithdraw.wallet_address":
+		x.WalletAddress = ""
+	case "stratos.pot.v1.MsgWithdraw.target_address":
+		x.TargetAddress = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgWithdraw"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.MsgWithdraw does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgWithdraw) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "stratos.pot.v1.MsgWithdraw.amount":
+		if len(x.Amount) == 0 {
+			return protoreflect.ValueOfList(&_MsgWithdraw_1_list{})
+		}
+		listValue := &_MsgWithdraw_1_list{list: &x.Amount}
+		return protoreflect.ValueOfList(listValue)
+	case "stratos.pot.v1.MsgWithdraw.wallet_address":
+		value := x.WalletAddress
+		return protoreflect.ValueOfString(value)
+	case "stratos.pot.v1.MsgWithdraw.target_address":
+		value := x.TargetAddress
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgWithdraw"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.MsgWithdraw does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgWithdraw) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "stratos.pot.v1.MsgWithdraw.amount":
+		lv := value.List()
+		clv := lv.(*_MsgWithdraw_1_list)
+		x.Amount = *clv.list
+	case "stratos.pot.v1.MsgWithdraw.wallet_address":
+		x.WalletAddress = value.Interface().(string)
+	case "stratos.pot.v1.MsgWithdraw.target_address":
+		x.TargetAddress = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgWithdraw"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.MsgWithdraw does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgWithdraw) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "stratos.pot.v1.MsgWithdraw.amount":
+		if x.Amount == nil {
+			x.Amount = []*v1beta1.Coin{}
+		}
+		value := &_MsgWithdraw_1_list{list: &x.Amount}
+		return protoreflect.ValueOfList(value)
+	case "stratos.pot.v1.MsgWithdraw.wallet_address":
+		panic(fmt.Errorf("field wallet_address of message stratos.pot.v1.MsgWithdraw is not mutable"))
+	case "stratos.pot.v1.MsgWithdraw.target_address":
+		panic(fmt.Errorf("field target_address of message stratos.pot.v1.MsgWithdraw is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgWithdraw"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.MsgWithdraw does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgWithdraw) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "stratos.pot.v1.MsgWithdraw.amount":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_MsgWithdraw_1_list{list: &list})
+	case "stratos.pot.v1.MsgWithdraw.wallet_address":
+		return protoreflect.ValueOfString("")
+	case "stratos.pot.v1.MsgWithdraw.target_address":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgWithdraw"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.MsgWithdraw does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgWithdraw) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in stratos.pot.v1.MsgWithdraw", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgWithdraw) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgWithdraw) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgWithdraw) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgWithdraw) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgWithdraw)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Amount) > 0 {
+			for _, e := range x.Amount {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.WalletAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.TargetAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgWithdraw)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.TargetAddress) > 0 {
+			i -= len(x.TargetAddress)
+			copy(dAtA[i:], x.TargetAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TargetAddress)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.WalletAddress) > 0 {
+			i -= len(x.WalletAddress)
+			copy(dAtA[i:], x.WalletAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.WalletAddress)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Amount) > 0 {
+			for iNdEx := len(x.Amount) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Amount[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgWithdraw)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgWithdraw: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgWithdraw: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Amount = append(x.Amount, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Amount[len(x.Amount)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WalletAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.WalletAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TargetAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TargetAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgWithdrawResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_stratos_pot_v1_tx_proto_init()
+	md_MsgWithdrawResponse = File_stratos_pot_v1_tx_proto.Messages().ByName("MsgWithdrawResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgWithdrawResponse)(nil)
+
+type fastReflection_MsgWithdrawResponse MsgWithdrawResponse
+
+func (x *MsgWithdrawResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgWithdrawResponse)(x)
+}
+
+func (x *MsgWithdrawResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_stratos_pot_v1_tx_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgWithdrawResponse_messageType fastReflection_MsgWithdrawResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgWithdrawResponse_messageType{}
+
+type fastReflection_MsgWithdrawResponse_messageType struct{}
+
+func (x fastReflection_MsgWithdrawResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgWithdrawResponse)(nil)
+}
+func (x fastReflection_MsgWithdrawResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgWithdrawResponse)
+}
+func (x fastReflection_MsgWithdrawResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgWithdrawResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgWithdrawResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgWithdrawResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgWithdrawResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgWithdrawResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgWithdrawResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgWithdrawResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgWithdrawResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgWithdrawResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgWithdrawResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgWithdrawResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgWithdrawResponse"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.MsgWithdrawResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgWithdrawResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgWithdrawResponse"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.MsgWithdrawResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgWithdrawResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgWithdrawResponse"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.MsgWithdrawResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgWithdrawResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgWithdrawResponse"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.MsgWithdrawResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgWithdrawResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgWithdrawResponse"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.MsgWithdrawResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgWithdrawResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgWithdrawResponse"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.MsgWithdrawResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgWithdrawResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in stratos.pot.v1.MsgWithdrawResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgWithdrawResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgWithdrawResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgWithdrawResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgWithdrawResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgWithdrawResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgWithdrawResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgWithdrawResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgWithdrawResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgWithdrawResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_MsgFoundationDeposit_1_list)(nil)
+
+type _MsgFoundationDeposit_1_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_MsgFoundationDeposit_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgFoundationDeposit_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MsgFoundationDeposit_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgFoundationDeposit_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgFoundationDeposit_1_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgFoundationDeposit_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgFoundationDeposit_1_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgFoundationDeposit_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_MsgFoundationDeposit        protoreflect.MessageDescriptor
+	fd_MsgFoundationDeposit_amount protoreflect.FieldDescriptor
+	fd_MsgFoundationDeposit_from   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_stratos_pot_v1_tx_proto_init()
+	md_MsgFoundationDeposit = File_stratos_pot_v1_tx_proto.Messages().ByName("MsgFoundationDeposit")
+	fd_MsgFoundationDeposit_amount = md_MsgFoundationDeposit.Fields().ByName("amount")
+	fd_MsgFoundationDeposit_from = md_MsgFoundationDeposit.Fields().ByName("from")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgFoundationDeposit)(nil)
+
+type fastReflection_MsgFoundationDeposit MsgFoundationDeposit
+
+func (x *MsgFoundationDeposit) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgFoundationDeposit)(x)
+}
+
+func (x *MsgFoundationDeposit) slowProtoReflect() protoreflect.Message {
+	mi := &file_stratos_pot_v1_tx_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgFoundationDeposit_messageType fastReflection_MsgFoundationDeposit_messageType
+var _ protoreflect.MessageType = fastReflection_MsgFoundationDeposit_messageType{}
+
+type fastReflection_MsgFoundationDeposit_messageType struct{}
+
+func (x fastReflection_MsgFoundationDeposit_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgFoundationDeposit)(nil)
+}
+func (x fastReflection_MsgFoundationDeposit_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgFoundationDeposit)
+}
+func (x fastReflection_MsgFoundationDeposit_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgFoundationDeposit
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgFoundationDeposit) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgFoundationDeposit
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgFoundationDeposit) Type() protoreflect.MessageType {
+	return _fastReflection_MsgFoundationDeposit_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgFoundationDeposit) New() protoreflect.Message {
+	return new(fastReflection_MsgFoundationDeposit)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgFoundationDeposit) Interface() protoreflect.ProtoMessage {
+	return (*MsgFoundationDeposit)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgFoundationDeposit) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Amount) != 0 {
+		value := protoreflect.ValueOfList(&_MsgFoundationDeposit_1_list{list: &x.Amount})
+		if !f(fd_MsgFoundationDeposit_amount, value) {
+			return
+		}
+	}
+	if x.From != "" {
+		value := protoreflect.ValueOfString(x.From)
+		if !f(fd_MsgFoundationDeposit_from, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgFoundationDeposit) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "stratos.pot.v1.MsgFoundationDeposit.amount":
+		return len(x.Amount) != 0
+	case "stratos.pot.v1.MsgFoundationDeposit.from":
+		return x.From != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgFoundationDeposit"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.MsgFoundationDeposit does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgFoundationDeposit) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "stratos.pot.v1.MsgFoundationDeposit.amount":
+		x.Amount = nil
+	case "stratos.pot.v1.MsgFoundationDeposit.from":
+		x.From = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgFoundationDeposit"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.MsgFoundationDeposit does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgFoundationDeposit) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "stratos.pot.v1.MsgFoundationDeposit.amount":
+		if len(x.Amount) == 0 {
+			return protoreflect.ValueOfList(&_MsgFoundationDeposit_1_list{})
+		}
+		listValue := &_MsgFoundationDeposit_1_list{list: &x.Amount}
+		return protoreflect.ValueOfList(listValue)
+	case "stratos.pot.v1.MsgFoundationDeposit.from":
+		value := x.From
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgFoundationDeposit"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.MsgFoundationDeposit does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgFoundationDeposit) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "stratos.pot.v1.MsgFoundationDeposit.amount":
+		lv := value.List()
+		clv := lv.(*_MsgFoundationDeposit_1_list)
+		x.Amount = *clv.list
+	case "stratos.pot.v1.MsgFoundationDeposit.from":
+		x.From = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgFoundationDeposit"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.MsgFoundationDeposit does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgFoundationDeposit) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "stratos.pot.v1.MsgFoundationDeposit.amount":
+		if x.Amount == nil {
+			x.Amount = []*v1beta1.Coin{}
+		}
+		value := &_MsgFoundationDeposit_1_list{list: &x.Amount}
+		return protoreflect.ValueOfList(value)
+	case "stratos.pot.v1.MsgFoundationDeposit.from":
+		panic(fmt.Errorf("field from of message stratos.pot.v1.MsgFoundationDeposit is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgFoundationDeposit"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.MsgFoundationDeposit does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgFoundationDeposit) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "stratos.pot.v1.MsgFoundationDeposit.amount":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_MsgFoundationDeposit_1_list{list: &list})
+	case "stratos.pot.v1.MsgFoundationDeposit.from":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgFoundationDeposit"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.MsgFoundationDeposit does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgFoundationDeposit) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in stratos.pot.v1.MsgFoundationDeposit", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgFoundationDeposit) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgFoundationDeposit) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgFoundationDeposit) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgFoundationDeposit) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgFoundationDeposit)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Amount) > 0 {
+			for _, e := range x.Amount {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.From)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgFoundationDeposit)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.From) > 0 {
+			i -= len(x.From)
+			copy(dAtA[i:], x.From)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.From)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Amount) > 0 {
+			for iNdEx := len(x.Amount) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Amount[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgFoundationDeposit)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgFoundationDeposit: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgFoundationDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Amount = append(x.Amount, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Amount[len(x.Amount)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.From = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgFoundationDepositResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_stratos_pot_v1_tx_proto_init()
+	md_MsgFoundationDepositResponse = File_stratos_pot_v1_tx_proto.Messages().ByName("MsgFoundationDepositResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgFoundationDepositResponse)(nil)
+
+type fastReflection_MsgFoundationDepositResponse MsgFoundationDepositResponse
+
+func (x *MsgFoundationDepositResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgFoundationDepositResponse)(x)
+}
+
+func (x *MsgFoundationDepositResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_stratos_pot_v1_tx_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgFoundationDepositResponse_messageType fastReflection_MsgFoundationDepositResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgFoundationDepositResponse_messageType{}
+
+type fastReflection_MsgFoundationDepositResponse_messageType struct{}
+
+func (x fastReflection_MsgFoundationDepositResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgFoundationDepositResponse)(nil)
+}
+func (x fastReflection_MsgFoundationDepositResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgFoundationDepositResponse)
+}
+func (x fastReflection_MsgFoundationDepositResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgFoundationDepositResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgFoundationDepositResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgFoundationDepositResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgFoundationDepositResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgFoundationDepositResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgFoundationDepositResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgFoundationDepositResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgFoundationDepositResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgFoundationDepositResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgFoundationDepositResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgFoundationDepositResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgFoundationDepositResponse"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.MsgFoundationDepositResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgFoundationDepositResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgFoundationDepositResponse"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.MsgFoundationDepositResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgFoundationDepositResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgFoundationDepositResponse"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.MsgFoundationDepositResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgFoundationDepositResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgFoundationDepositResponse"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.MsgFoundationDepositResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgFoundationDepositResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgFoundationDepositResponse"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.MsgFoundationDepositResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgFoundationDepositResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgFoundationDepositResponse"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.MsgFoundationDepositResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgFoundationDepositResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in stratos.pot.v1.MsgFoundationDepositResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgFoundationDepositResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgFoundationDepositResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgFoundationDepositResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgFoundationDepositResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgFoundationDepositResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgFoundationDepositResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgFoundationDepositResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgFoundationDepositResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgFoundationDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_MsgSlashingResourceNode_1_list)(nil)
+
+type _MsgSlashingResourceNode_1_list struct {
+	list *[]string
+}
+
+func (x *_MsgSlashingResourceNode_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgSlashingResourceNode_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_MsgSlashingResourceNode_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgSlashingResourceNode_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgSlashingResourceNode_1_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message MsgSlashingResourceNode at list field Reporters as it is not of Message kind"))
+}
+
+func (x *_MsgSlashingResourceNode_1_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgSlashingResourceNode_1_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_MsgSlashingResourceNode_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_MsgSlashingResourceNode_2_list)(nil)
+
+type _MsgSlashingResourceNode_2_list struct {
+	list *[]string
+}
+
+func (x *_MsgSlashingResourceNode_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgSlashingResourceNode_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_MsgSlashingResourceNode_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgSlashingResourceNode_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgSlashingResourceNode_2_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message MsgSlashingResourceNode at list field ReporterOwner as it is not of Message kind"))
+}
+
+func (x *_MsgSlashingResourceNode_2_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgSlashingResourceNode_2_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_MsgSlashingResourceNode_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_MsgSlashingResourceNode                 protoreflect.MessageDescriptor
+	fd_MsgSlashingResourceNode_reporters       protoreflect.FieldDescriptor
+	fd_MsgSlashingResourceNode_reporter_owner  protoreflect.FieldDescriptor
+	fd_MsgSlashingResourceNode_network_address protoreflect.FieldDescriptor
+	fd_MsgSlashingResourceNode_wallet_address  protoreflect.FieldDescriptor
+	fd_MsgSlashingResourceNode_slashing        protoreflect.FieldDescriptor
+	fd_MsgSlashingResourceNode_suspend         protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_stratos_pot_v1_tx_proto_init()
+	md_MsgSlashingResourceNode = File_stratos_pot_v1_tx_proto.Messages().ByName("MsgSlashingResourceNode")
+	fd_MsgSlashingResourceNode_reporters = md_MsgSlashingResourceNode.Fields().ByName("reporters")
+	fd_MsgSlashingResourceNode_reporter_owner = md_MsgSlashingResourceNode.Fields().ByName("reporter_owner")
+	fd_MsgSlashingResourceNode_network_address = md_MsgSlashingResourceNode.Fields().ByName("network_address")
+	fd_MsgSlashingResourceNode_wallet_address = md_MsgSlashingResourceNode.Fields().ByName("wallet_address")
+	fd_MsgSlashingResourceNode_slashing = md_MsgSlashingResourceNode.Fields().ByName("slashing")
+	fd_MsgSlashingResourceNode_suspend = md_MsgSlashingResourceNode.Fields().ByName("suspend")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgSlashingResourceNode)(nil)
+
+type fastReflection_MsgSlashingResourceNode MsgSlashingResourceNode
+
+func (x *MsgSlashingResourceNode) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgSlashingResourceNode)(x)
+}
+
+func (x *MsgSlashingResourceNode) slowProtoReflect() protoreflect.Message {
+	mi := &file_stratos_pot_v1_tx_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgSlashingResourceNode_messageType fastReflection_MsgSlashingResourceNode_messageType
+var _ protoreflect.MessageType = fastReflection_MsgSlashingResourceNode_messageType{}
+
+type fastReflection_MsgSlashingResourceNode_messageType struct{}
+
+func (x fastReflection_MsgSlashingResourceNode_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgSlashingResourceNode)(nil)
+}
+func (x fastReflection_MsgSlashingResourceNode_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgSlashingResourceNode)
+}
+func (x fastReflection_MsgSlashingResourceNode_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSlashingResourceNode
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgSlashingResourceNode) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSlashingResourceNode
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgSlashingResourceNode) Type() protoreflect.MessageType {
+	return _fastReflection_MsgSlashingResourceNode_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgSlashingResourceNode) New() protoreflect.Message {
+	return new(fastReflection_MsgSlashingResourceNode)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgSlashingResourceNode) Interface() protoreflect.ProtoMessage {
+	return (*MsgSlashingResourceNode)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgSlashingResourceNode) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Reporters) != 0 {
+		value := protoreflect.ValueOfList(&_MsgSlashingResourceNode_1_list{list: &x.Reporters})
+		if !f(fd_MsgSlashingResourceNode_reporters, value) {
+			return
+		}
+	}
+	if len(x.ReporterOwner) != 0 {
+		value := protoreflect.ValueOfList(&_MsgSlashingResourceNode_2_list{list: &x.ReporterOwner})
+		if !f(fd_MsgSlashingResourceNode_reporter_owner, value) {
+			return
+		}
+	}
+	if x.NetworkAddress != "" {
+		value := protoreflect.ValueOfString(x.NetworkAddress)
+		if !f(fd_MsgSlashingResourceNode_network_address, value) {
+			return
+		}
+	}
+	if x.WalletAddress != "" {
+		value := protoreflect.ValueOfString(x.WalletAddress)
+		if !f(fd_MsgSlashingResourceNode_wallet_address, value) {
+			return
+		}
+	}
+	if x.Slashing != "" {
+		value := protoreflect.ValueOfString(x.Slashing)
+		if !f(fd_MsgSlashingResourceNode_slashing, value) {
+			return
+		}
+	}
+	if x.Suspend != false {
+		value := protoreflect.ValueOfBool(x.Suspend)
+		if !f(fd_MsgSlashingResourceNode_suspend, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgSlashingResourceNode) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "stratos.pot.v1.MsgSlashingResourceNode.reporters":
+		return len(x.Reporters) != 0
+	case "stratos.pot.v1.MsgSlashingResourceNode.reporter_owner":
+		return len(x.ReporterOwner) != 0
+	case "stratos.pot.v1.MsgSlashingResourceNode.network_address":
+		return x.NetworkAddress != ""
+	case "stratos.pot.v1.MsgSlashingResourceNode.wallet_address":
+		return x.WalletAddress != ""
+	case "stratos.pot.v1.MsgSlashingResourceNode.slashing":
+		return x.Slashing != ""
+	case "stratos.pot.v1.MsgSlashingResourceNode.suspend":
+		return x.Suspend != false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgSlashingResourceNode"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.MsgSlashingResourceNode does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSlashingResourceNode) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "stratos.pot.v1.MsgSlashingResourceNode.reporters":
+		x.Reporters = nil
+	case "stratos.pot.v1.MsgSlashingResourceNode.reporter_owner":
+		x.ReporterOwner = nil
+	case "stratos.pot.v1.MsgSlashingResourceNode.network_address":
+		x.NetworkAddress = ""
+	case "stratos.pot.v1.MsgSlashingResourceNode.wallet_address":
+		x.WalletAddress = ""
+	case "stratos.pot.v1.MsgSlashingResourceNode.slashing":
+		x.Slashing = ""
+	case "stratos.pot.v1.MsgSlashingResourceNode.suspend":
+		x.Suspend = false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgSlashingResourceNode"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.MsgSlashingResourceNode does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgSlashingResourceNode) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "stratos.pot.v1.MsgSlashingResourceNode.reporters":
+		if len(x.Reporters) == 0 {
+			return protoreflect.ValueOfList(&_MsgSlashingResourceNode_1_list{})
+		}
+		listValue := &_MsgSlashingResourceNode_1_list{list: &x.Reporters}
+		return protoreflect.ValueOfList(listValue)
+	case "stratos.pot.v1.MsgSlashingResourceNode.reporter_owner":
+		if len(x.ReporterOwner) == 0 {
+			return protoreflect.ValueOfList(&_MsgSlashingResourceNode_2_list{})
+		}
+		listValue := &_MsgSlashingResourceNode_2_list{list: &x.ReporterOwner}
+		return protoreflect.ValueOfList(listValue)
+	case "stratos.pot.v1.MsgSlashingResourceNode.network_address":
+		value := x.NetworkAddress
+		return protoreflect.ValueOfString(value)
+	case "stratos.pot.v1.MsgSlashingResourceNode.wallet_address":
+		value := x.WalletAddress
+		return protoreflect.ValueOfString(value)
+	case "stratos.pot.v1.MsgSlashingResourceNode.slashing":
+		value := x.Slashing
+		return protoreflect.ValueOfString(value)
+	case "stratos.pot.v1.MsgSlashingResourceNode.suspend":
+		value := x.Suspend
+		return protoreflect.ValueOfBool(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgSlashingResourceNode"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.MsgSlashingResourceNode does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSlashingResourceNode) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "stratos.pot.v1.MsgSlashingResourceNode.reporters":
+		lv := value.List()
+		clv := lv.(*_MsgSlashingResourceNode_1_list)
+		x.Reporters = *clv.list
+	case "stratos.pot.v1.MsgSlashingResourceNode.reporter_owner":
+		lv := value.List()
+		clv := lv.(*_MsgSlashingResourceNode_2_list)
+		x.ReporterOwner = *clv.list
+	case "stratos.pot.v1.MsgSlashingResourceNode.network_address":
+		x.NetworkAddress = value.Interface().(string)
+	case "stratos.pot.v1.MsgSlashingResourceNode.wallet_address":
+		x.WalletAddress = value.Interface().(string)
+	case "stratos.pot.v1.MsgSlashingResourceNode.slashing":
+		x.Slashing = value.Interface().(string)
+	case "stratos.pot.v1.MsgSlashingResourceNode.suspend":
+		x.Suspend = value.Bool()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgSlashingResourceNode"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.MsgSlashingResourceNode does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSlashingResourceNode) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "stratos.pot.v1.MsgSlashingResourceNode.reporters":
+		if x.Reporters == nil {
+			x.Reporters = []string{}
+		}
+		value := &_MsgSlashingResourceNode_1_list{list: &x.Reporters}
+		return protoreflect.ValueOfList(value)
+	case "stratos.pot.v1.MsgSlashingResourceNode.reporter_owner":
+		if x.ReporterOwner == nil {
+			x.ReporterOwner = []string{}
+		}
+		value := &_MsgSlashingResourceNode_2_list{list: &x.ReporterOwner}
+		return protoreflect.ValueOfList(value)
+	case "stratos.pot.v1.MsgSlashingResourceNode.network_address":
+		panic(fmt.Errorf("field network_address of message stratos.pot.v1.MsgSlashingResourceNode is not mutable"))
+	case "stratos.pot.v1.MsgSlashingResourceNode.wallet_address":
+		panic(fmt.Errorf("field wallet_address of message stratos.pot.v1.MsgSlashingResourceNode is not mutable"))
+	case "stratos.pot.v1.MsgSlashingResourceNode.slashing":
+		panic(fmt.Errorf("field slashing of message stratos.pot.v1.MsgSlashingResourceNode is not mutable"))
+	case "stratos.pot.v1.MsgSlashingResourceNode.suspend":
+		panic(fmt.Errorf("field suspend of message stratos.pot.v1.MsgSlashingResourceNode is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgSlashingResourceNode"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.MsgSlashingResourceNode does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgSlashingResourceNode) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "stratos.pot.v1.MsgSlashingResourceNode.reporters":
+		list := []string{}
+		return protoreflect.ValueOfList(&_MsgSlashingResourceNode_1_list{list: &list})
+	case "stratos.pot.v1.MsgSlashingResourceNode.reporter_owner":
+		list := []string{}
+		return protoreflect.ValueOfList(&_MsgSlashingResourceNode_2_list{list: &list})
+	case "stratos.pot.v1.MsgSlashingResourceNode.network_address":
+		return protoreflect.ValueOfString("")
+	case "stratos.pot.v1.MsgSlashingResourceNode.wallet_address":
+		return protoreflect.ValueOfString("")
+	case "stratos.pot.v1.MsgSlashingResourceNode.slashing":
+		return protoreflect.ValueOfString("")
+	case "stratos.pot.v1.MsgSlashingResourceNode.suspend":
+		return protoreflect.ValueOfBool(false)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgSlashingResourceNode"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.MsgSlashingResourceNode does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgSlashingResourceNode) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in stratos.pot.v1.MsgSlashingResourceNode", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgSlashingResourceNode) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSlashingResourceNode) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgSlashingResourceNode) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgSlashingResourceNode) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgSlashingResourceNode)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Reporters) > 0 {
+			for _, s := range x.Reporters {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.ReporterOwner) > 0 {
+			for _, s := range x.ReporterOwner {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.NetworkAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.WalletAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Slashing)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Suspend {
+			n += 2
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSlashingResourceNode)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Suspend {
+			i--
+			if x.Suspend {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x30
+		}
+		if len(x.Slashing) > 0 {
+			i -= len(x.Slashing)
+			copy(dAtA[i:], x.Slashing)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Slashing)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.WalletAddress) > 0 {
+			i -= len(x.WalletAddress)
+			copy(dAtA[i:], x.WalletAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.WalletAddress)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.NetworkAddress) > 0 {
+			i -= len(x.NetworkAddress)
+			copy(dAtA[i:], x.NetworkAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NetworkAddress)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.ReporterOwner) > 0 {
+			for iNdEx := len(x.ReporterOwner) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.ReporterOwner[iNdEx])
+				copy(dAtA[i:], x.ReporterOwner[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ReporterOwner[iNdEx])))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if len(x.Reporters) > 0 {
+			for iNdEx := len(x.Reporters) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Reporters[iNdEx])
+				copy(dAtA[i:], x.Reporters[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Reporters[iNdEx])))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSlashingResourceNode)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSlashingResourceNode: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSlashingResourceNode: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reporters", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Reporters = append(x.Reporters, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReporterOwner", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ReporterOwner = append(x.ReporterOwner, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NetworkAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.NetworkAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WalletAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.WalletAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Slashing", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Slashing = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Suspend", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Suspend = bool(v != 0)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgSlashingResourceNodeResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_stratos_pot_v1_tx_proto_init()
+	md_MsgSlashingResourceNodeResponse = File_stratos_pot_v1_tx_proto.Messages().ByName("MsgSlashingResourceNodeResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgSlashingResourceNodeResponse)(nil)
+
+type fastReflection_MsgSlashingResourceNodeResponse MsgSlashingResourceNodeResponse
+
+func (x *MsgSlashingResourceNodeResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgSlashingResourceNodeResponse)(x)
+}
+
+func (x *MsgSlashingResourceNodeResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_stratos_pot_v1_tx_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgSlashingResourceNodeResponse_messageType fastReflection_MsgSlashingResourceNodeResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgSlashingResourceNodeResponse_messageType{}
+
+type fastReflection_MsgSlashingResourceNodeResponse_messageType struct{}
+
+func (x fastReflection_MsgSlashingResourceNodeResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgSlashingResourceNodeResponse)(nil)
+}
+func (x fastReflection_MsgSlashingResourceNodeResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgSlashingResourceNodeResponse)
+}
+func (x fastReflection_MsgSlashingResourceNodeResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSlashingResourceNodeResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgSlashingResourceNodeResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSlashingResourceNodeResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgSlashingResourceNodeResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgSlashingResourceNodeResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgSlashingResourceNodeResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgSlashingResourceNodeResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgSlashingResourceNodeResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgSlashingResourceNodeResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgSlashingResourceNodeResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgSlashingResourceNodeResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgSlashingResourceNodeResponse"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.MsgSlashingResourceNodeResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSlashingResourceNodeResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgSlashingResourceNodeResponse"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.MsgSlashingResourceNodeResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgSlashingResourceNodeResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgSlashingResourceNodeResponse"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.MsgSlashingResourceNodeResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSlashingResourceNodeResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgSlashingResourceNodeResponse"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.MsgSlashingResourceNodeResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSlashingResourceNodeResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgSlashingResourceNodeResponse"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.MsgSlashingResourceNodeResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgSlashingResourceNodeResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgSlashingResourceNodeResponse"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.MsgSlashingResourceNodeResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgSlashingResourceNodeResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in stratos.pot.v1.MsgSlashingResourceNodeResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgSlashingResourceNodeResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSlashingResourceNodeResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgSlashingResourceNodeResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgSlashingResourceNodeResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgSlashingResourceNodeResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSlashingResourceNodeResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSlashingResourceNodeResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSlashingResourceNodeResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSlashingResourceNodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_MsgDisputeVolumeReport_4_list)(nil)
+
+type _MsgDisputeVolumeReport_4_list struct {
+	list *[]*SingleWalletVolume
+}
+
+func (x *_MsgDisputeVolumeReport_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgDisputeVolumeReport_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MsgDisputeVolumeReport_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SingleWalletVolume)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgDisputeVolumeReport_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SingleWalletVolume)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgDisputeVolumeReport_4_list) AppendMutable() protoreflect.Value {
+	v := new(SingleWalletVolume)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgDisputeVolumeReport_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgDisputeVolumeReport_4_list) NewElement() protoreflect.Value {
+	v := new(SingleWalletVolume)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgDisputeVolumeReport_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_MsgDisputeVolumeReport                protoreflect.MessageDescriptor
+	fd_MsgDisputeVolumeReport_disputer       protoreflect.FieldDescriptor
+	fd_MsgDisputeVolumeReport_reporter       protoreflect.FieldDescriptor
+	fd_MsgDisputeVolumeReport_epoch          protoreflect.FieldDescriptor
+	fd_MsgDisputeVolumeReport_wallet_volumes protoreflect.FieldDescriptor
+	fd_MsgDisputeVolumeReport_reason         protoreflect.FieldDescriptor
+	fd_MsgDisputeVolumeReport_BLS_signature  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_stratos_pot_v1_tx_proto_init()
+	md_MsgDisputeVolumeReport = File_stratos_pot_v1_tx_proto.Messages().ByName("MsgDisputeVolumeReport")
+	fd_MsgDisputeVolumeReport_disputer = md_MsgDisputeVolumeReport.Fields().ByName("disputer")
+	fd_MsgDisputeVolumeReport_reporter = md_MsgDisputeVolumeReport.Fields().ByName("reporter")
+	fd_MsgDisputeVolumeReport_epoch = md_MsgDisputeVolumeReport.Fields().ByName("epoch")
+	fd_MsgDisputeVolumeReport_wallet_volumes = md_MsgDisputeVolumeReport.Fields().ByName("wallet_volumes")
+	fd_MsgDisputeVolumeReport_reason = md_MsgDisputeVolumeReport.Fields().ByName("reason")
+	fd_MsgDisputeVolumeReport_BLS_signature = md_MsgDisputeVolumeReport.Fields().ByName("BLS_signature")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgDisputeVolumeReport)(nil)
+
+type fastReflection_MsgDisputeVolumeReport MsgDisputeVolumeReport
+
+func (x *MsgDisputeVolumeReport) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgDisputeVolumeReport)(x)
+}
+
+func (x *MsgDisputeVolumeReport) slowProtoReflect() protoreflect.Message {
+	mi := &file_stratos_pot_v1_tx_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgDisputeVolumeReport_messageType fastReflection_MsgDisputeVolumeReport_messageType
+var _ protoreflect.MessageType = fastReflection_MsgDisputeVolumeReport_messageType{}
+
+type fastReflection_MsgDisputeVolumeReport_messageType struct{}
+
+func (x fastReflection_MsgDisputeVolumeReport_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgDisputeVolumeReport)(nil)
+}
+func (x fastReflection_MsgDisputeVolumeReport_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgDisputeVolumeReport)
+}
+func (x fastReflection_MsgDisputeVolumeReport_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgDisputeVolumeReport
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgDisputeVolumeReport) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgDisputeVolumeReport
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgDisputeVolumeReport) Type() protoreflect.MessageType {
+	return _fastReflection_MsgDisputeVolumeReport_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgDisputeVolumeReport) New() protoreflect.Message {
+	return new(fastReflection_MsgDisputeVolumeReport)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgDisputeVolumeReport) Interface() protoreflect.ProtoMessage {
+	return (*MsgDisputeVolumeReport)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgDisputeVolumeReport) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Disputer != "" {
+		value := protoreflect.ValueOfString(x.Disputer)
+		if !f(fd_MsgDisputeVolumeReport_disputer, value) {
+			return
+		}
+	}
+	if x.Reporter != "" {
+		value := protoreflect.ValueOfString(x.Reporter)
+		if !f(fd_MsgDisputeVolumeReport_reporter, value) {
+			return
+		}
+	}
+	if x.Epoch != "" {
+		value := protoreflect.ValueOfString(x.Epoch)
+		if !f(fd_MsgDisputeVolumeReport_epoch, value) {
+			return
+		}
+	}
+	if len(x.WalletVolumes) != 0 {
+		value := protoreflect.ValueOfList(&_MsgDisputeVolumeReport_4_list{list: &x.WalletVolumes})
+		if !f(fd_MsgDisputeVolumeReport_wallet_volumes, value) {
+			return
+		}
+	}
+	if x.Reason != "" {
+		value := protoreflect.ValueOfString(x.Reason)
+		if !f(fd_MsgDisputeVolumeReport_reason, value) {
+			return
+		}
+	}
+	if x.BLSSignature != nil {
+		value := protoreflect.ValueOfMessage(x.BLSSignature.ProtoReflect())
+		if !f(fd_MsgDisputeVolumeReport_BLS_signature, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgDisputeVolumeReport) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "stratos.pot.v1.MsgDisputeVolumeReport.disputer":
+		return x.Disputer != ""
+	case "stratos.pot.v1.MsgDisputeVolumeReport.reporter":
+		return x.Reporter != ""
+	case "stratos.pot.v1.MsgDisputeVolumeReport.epoch":
+		return x.Epoch != ""
+	case "stratos.pot.v1.MsgDisputeVolumeReport.wallet_volumes":
+		return len(x.WalletVolumes) != 0
+	case "stratos.pot.v1.MsgDisputeVolumeReport.reason":
+		return x.Reason != ""
+	case "stratos.pot.v1.MsgDisputeVolumeReport.BLS_signature":
+		return x.BLSSignature != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgDisputeVolumeReport"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.MsgDisputeVolumeReport does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgDisputeVolumeReport) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "stratos.pot.v1.MsgDisputeVolumeReport.disputer":
+		x.Disputer = ""
+	case "stratos.pot.v1.MsgDisputeVolumeReport.reporter":
+		x.Reporter = ""
+	case "stratos.pot.v1.MsgDisputeVolumeReport.epoch":
+		x.Epoch = ""
+	case "stratos.pot.v1.MsgDisputeVolumeReport.wallet_volumes":
+		x.WalletVolumes = nil
+	case "stratos.pot.v1.MsgDisputeVolumeReport.reason":
+		x.Reason = ""
+	case "stratos.pot.v1.MsgDisputeVolumeReport.BLS_signature":
+		x.BLSSignature = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgDisputeVolumeReport"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.MsgDisputeVolumeReport does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgDisputeVolumeReport) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "stratos.pot.v1.MsgDisputeVolumeReport.disputer":
+		value := x.Disputer
+		return protoreflect.ValueOfString(value)
+	case "stratos.pot.v1.MsgDisputeVolumeReport.reporter":
+		value := x.Reporter
+		return protoreflect.ValueOfString(value)
+	case "stratos.pot.v1.MsgDisputeVolumeReport.epoch":
+		value := x.Epoch
+		return protoreflect.ValueOfString(value)
+	case "stratos.pot.v1.MsgDisputeVolumeReport.wallet_volumes":
+		if len(x.WalletVolumes) == 0 {
+			return protoreflect.ValueOfList(&_MsgDisputeVolumeReport_4_list{})
+		}
+		listValue := &_MsgDisputeVolumeReport_4_list{list: &x.WalletVolumes}
+		return protoreflect.ValueOfList(listValue)
+	case "stratos.pot.v1.MsgDisputeVolumeReport.reason":
+		value := x.Reason
+		return protoreflect.ValueOfString(value)
+	case "stratos.pot.v1.MsgDisputeVolumeReport.BLS_signature":
+		value := x.BLSSignature
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgDisputeVolumeReport"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.MsgDisputeVolumeReport does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgDisputeVolumeReport) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "stratos.pot.v1.MsgDisputeVolumeReport.disputer":
+		x.Disputer = value.Interface().(string)
+	case "stratos.pot.v1.MsgDisputeVolumeReport.reporter":
+		x.Reporter = value.Interface().(string)
+	case "stratos.pot.v1.MsgDisputeVolumeReport.epoch":
+		x.Epoch = value.Interface().(string)
+	case "stratos.pot.v1.MsgDisputeVolumeReport.wallet_volumes":
+		lv := value.List()
+		clv := lv.(*_MsgDisputeVolumeReport_4_list)
+		x.WalletVolumes = *clv.list
+	case "stratos.pot.v1.MsgDisputeVolumeReport.reason":
+		x.Reason = value.Interface().(string)
+	case "stratos.pot.v1.MsgDisputeVolumeReport.BLS_signature":
+		x.BLSSignature = value.Message().Interface().(*BLSSignatureInfo)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgDisputeVolumeReport"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.MsgDisputeVolumeReport does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgDisputeVolumeReport) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "stratos.pot.v1.MsgDisputeVolumeReport.wallet_volumes":
+		if x.WalletVolumes == nil {
+			x.WalletVolumes = []*SingleWalletVolume{}
+		}
+		value := &_MsgDisputeVolumeReport_4_list{list: &x.WalletVolumes}
+		return protoreflect.ValueOfList(value)
+	case "stratos.pot.v1.MsgDisputeVolumeReport.BLS_signature":
+		if x.BLSSignature == nil {
+			x.BLSSignature = new(BLSSignatureInfo)
+		}
+		return protoreflect.ValueOfMessage(x.BLSSignature.ProtoReflect())
+	case "stratos.pot.v1.MsgDisputeVolumeReport.disputer":
+		panic(fmt.Errorf("field disputer of message stratos.pot.v1.MsgDisputeVolumeReport is not mutable"))
+	case "stratos.pot.v1.MsgDisputeVolumeReport.reporter":
+		panic(fmt.Errorf("field reporter of message stratos.pot.v1.MsgDisputeVolumeReport is not mutable"))
+	case "stratos.pot.v1.MsgDisputeVolumeReport.epoch":
+		panic(fmt.Errorf("field epoch of message stratos.pot.v1.MsgDisputeVolumeReport is not mutable"))
+	case "stratos.pot.v1.MsgDisputeVolumeReport.reason":
+		panic(fmt.Errorf("field reason of message stratos.pot.v1.MsgDisputeVolumeReport is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgDisputeVolumeReport"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.MsgDisputeVolumeReport does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgDisputeVolumeReport) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "stratos.pot.v1.MsgDisputeVolumeReport.disputer":
+		return protoreflect.ValueOfString("")
+	case "stratos.pot.v1.MsgDisputeVolumeReport.reporter":
+		return protoreflect.ValueOfString("")
+	case "stratos.pot.v1.MsgDisputeVolumeReport.epoch":
+		return protoreflect.ValueOfString("")
+	case "stratos.pot.v1.MsgDisputeVolumeReport.wallet_volumes":
+		list := []*SingleWalletVolume{}
+		return protoreflect.ValueOfList(&_MsgDisputeVolumeReport_4_list{list: &list})
+	case "stratos.pot.v1.MsgDisputeVolumeReport.reason":
+		return protoreflect.ValueOfString("")
+	case "stratos.pot.v1.MsgDisputeVolumeReport.BLS_signature":
+		m := new(BLSSignatureInfo)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgDisputeVolumeReport"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.MsgDisputeVolumeReport does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgDisputeVolumeReport) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in stratos.pot.v1.MsgDisputeVolumeReport", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgDisputeVolumeReport) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgDisputeVolumeReport) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgDisputeVolumeReport) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgDisputeVolumeReport) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgDisputeVolumeReport)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Disputer)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Reporter)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Epoch)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.WalletVolumes) > 0 {
+			for _, e := range x.WalletVolumes {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.Reason)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.BLSSignature != nil {
+			l = options.Size(x.BLSSignature)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgDisputeVolumeReport)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.BLSSignature != nil {
+			encoded, err := options.Marshal(x.BLSSignature)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.Reason) > 0 {
+			i -= len(x.Reason)
+			copy(dAtA[i:], x.Reason)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Reason)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.WalletVolumes) > 0 {
+			for iNdEx := len(x.WalletVolumes) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.WalletVolumes[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if len(x.Epoch) > 0 {
+			i -= len(x.Epoch)
+			copy(dAtA[i:], x.Epoch)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Epoch)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Reporter) > 0 {
+			i -= len(x.Reporter)
+			copy(dAtA[i:], x.Reporter)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Reporter)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Disputer) > 0 {
+			i -= len(x.Disputer)
+			copy(dAtA[i:], x.Disputer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Disputer)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgDisputeVolumeReport)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgDisputeVolumeReport: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgDisputeVolumeReport: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Disputer", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Disputer = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reporter", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Reporter = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Epoch = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WalletVolumes", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.WalletVolumes = append(x.WalletVolumes, &SingleWalletVolume{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.WalletVolumes[len(x.WalletVolumes)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Reason = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BLSSignature", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.BLSSignature == nil {
+					x.BLSSignature = &BLSSignatureInfo{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BLSSignature); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgDisputeVolumeReportResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_stratos_pot_v1_tx_proto_init()
+	md_MsgDisputeVolumeReportResponse = File_stratos_pot_v1_tx_proto.Messages().ByName("MsgDisputeVolumeReportResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgDisputeVolumeReportResponse)(nil)
+
+type fastReflection_MsgDisputeVolumeReportResponse MsgDisputeVolumeReportResponse
+
+func (x *MsgDisputeVolumeReportResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgDisputeVolumeReportResponse)(x)
+}
+
+func (x *MsgDisputeVolumeReportResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_stratos_pot_v1_tx_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgDisputeVolumeReportResponse_messageType fastReflection_MsgDisputeVolumeReportResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgDisputeVolumeReportResponse_messageType{}
+
+type fastReflection_MsgDisputeVolumeReportResponse_messageType struct{}
+
+func (x fastReflection_MsgDisputeVolumeReportResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgDisputeVolumeReportResponse)(nil)
+}
+func (x fastReflection_MsgDisputeVolumeReportResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgDisputeVolumeReportResponse)
+}
+func (x fastReflection_MsgDisputeVolumeReportResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgDisputeVolumeReportResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgDisputeVolumeReportResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgDisputeVolumeReportResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgDisputeVolumeReportResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgDisputeVolumeReportResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgDisputeVolumeReportResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgDisputeVolumeReportResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgDisputeVolumeReportResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgDisputeVolumeReportResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgDisputeVolumeReportResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgDisputeVolumeReportResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgDisputeVolumeReportResponse"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.MsgDisputeVolumeReportResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgDisputeVolumeReportResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgDisputeVolumeReportResponse"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.MsgDisputeVolumeReportResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgDisputeVolumeReportResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgDisputeVolumeReportResponse"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.MsgDisputeVolumeReportResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgDisputeVolumeReportResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgDisputeVolumeReportResponse"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.MsgDisputeVolumeReportResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgDisputeVolumeReportResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgDisputeVolumeReportResponse"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.MsgDisputeVolumeReportResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgDisputeVolumeReportResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgDisputeVolumeReportResponse"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.MsgDisputeVolumeReportResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgDisputeVolumeReportResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in stratos.pot.v1.MsgDisputeVolumeReportResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgDisputeVolumeReportResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgDisputeVolumeReportResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgDisputeVolumeReportResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgDisputeVolumeReportResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgDisputeVolumeReportResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgDisputeVolumeReportResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgDisputeVolumeReportResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgDisputeVolumeReportResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgDisputeVolumeReportResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgSubmitReportEquivocation                   protoreflect.MessageDescriptor
+	fd_MsgSubmitReportEquivocation_reporter          protoreflect.FieldDescriptor
+	fd_MsgSubmitReportEquivocation_reporter_owner    protoreflect.FieldDescriptor
+	fd_MsgSubmitReportEquivocation_accused           protoreflect.FieldDescriptor
+	fd_MsgSubmitReportEquivocation_epoch             protoreflect.FieldDescriptor
+	fd_MsgSubmitReportEquivocation_first_report      protoreflect.FieldDescriptor
+	fd_MsgSubmitReportEquivocation_first_signature   protoreflect.FieldDescriptor
+	fd_MsgSubmitReportEquivocation_second_report     protoreflect.FieldDescriptor
+	fd_MsgSubmitReportEquivocation_second_signature  protoreflect.FieldDescriptor
+	fd_MsgSubmitReportEquivocation_accused_pub_key   protoreflect.FieldDescriptor
+	fd_MsgSubmitReportEquivocation_accused_signature protoreflect.FieldDescriptor
+	fd_MsgSubmitReportEquivocation_kick              protoreflect.FieldDescriptor
+	fd_MsgSubmitReportEquivocation_first_chunked     protoreflect.FieldDescriptor
+	fd_MsgSubmitReportEquivocation_second_chunked    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_stratos_pot_v1_tx_proto_init()
+	md_MsgSubmitReportEquivocation = File_stratos_pot_v1_tx_proto.Messages().ByName("MsgSubmitReportEquivocation")
+	fd_MsgSubmitReportEquivocation_reporter = md_MsgSubmitReportEquivocation.Fields().ByName("reporter")
+	fd_MsgSubmitReportEquivocation_reporter_owner = md_MsgSubmitReportEquivocation.Fields().ByName("reporter_owner")
+	fd_MsgSubmitReportEquivocation_accused = md_MsgSubmitReportEquivocation.Fields().ByName("accused")
+	fd_MsgSubmitReportEquivocation_epoch = md_MsgSubmitReportEquivocation.Fields().ByName("epoch")
+	fd_MsgSubmitReportEquivocation_first_report = md_MsgSubmitReportEquivocation.Fields().ByName("first_report")
+	fd_MsgSubmitReportEquivocation_first_signature = md_MsgSubmitReportEquivocation.Fields().ByName("first_signature")
+	fd_MsgSubmitReportEquivocation_second_report = md_MsgSubmitReportEquivocation.Fields().ByName("second_report")
+	fd_MsgSubmitReportEquivocation_second_signature = md_MsgSubmitReportEquivocation.Fields().ByName("second_signature")
+	fd_MsgSubmitReportEquivocation_accused_pub_key = md_MsgSubmitReportEquivocation.Fields().ByName("accused_pub_key")
+	fd_MsgSubmitReportEquivocation_accused_signature = md_MsgSubmitReportEquivocation.Fields().ByName("accused_signature")
+	fd_MsgSubmitReportEquivocation_kick = md_MsgSubmitReportEquivocation.Fields().ByName("kick")
+	fd_MsgSubmitReportEquivocation_first_chunked = md_MsgSubmitReportEquivocation.Fields().ByName("first_chunked")
+	fd_MsgSubmitReportEquivocation_second_chunked = md_MsgSubmitReportEquivocation.Fields().ByName("second_chunked")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgSubmitReportEquivocation)(nil)
+
+type fastReflection_MsgSubmitReportEquivocation MsgSubmitReportEquivocation
+
+func (x *MsgSubmitReportEquivocation) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgSubmitReportEquivocation)(x)
+}
+
+func (x *MsgSubmitReportEquivocation) slowProtoReflect() protoreflect.Message {
+	mi := &file_stratos_pot_v1_tx_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgSubmitReportEquivocation_messageType fastReflection_MsgSubmitReportEquivocation_messageType
+var _ protoreflect.MessageType = fastReflection_MsgSubmitReportEquivocation_messageType{}
+
+type fastReflection_MsgSubmitReportEquivocation_messageType struct{}
+
+func (x fastReflection_MsgSubmitReportEquivocation_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgSubmitReportEquivocation)(nil)
+}
+func (x fastReflection_MsgSubmitReportEquivocation_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgSubmitReportEquivocation)
+}
+func (x fastReflection_MsgSubmitReportEquivocation_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSubmitReportEquivocation
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgSubmitReportEquivocation) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSubmitReportEquivocation
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgSubmitReportEquivocation) Type() protoreflect.MessageType {
+	return _fastReflection_MsgSubmitReportEquivocation_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgSubmitReportEquivocation) New() protoreflect.Message {
+	return new(fastReflection_MsgSubmitReportEquivocation)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgSubmitReportEquivocation) Interface() protoreflect.ProtoMessage {
+	return (*MsgSubmitReportEquivocation)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgSubmitReportEquivocation) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Reporter != "" {
+		value := protoreflect.ValueOfString(x.Reporter)
+		if !f(fd_MsgSubmitReportEquivocation_reporter, value) {
+			return
+		}
+	}
+	if x.ReporterOwner != "" {
+		value := protoreflect.ValueOfString(x.ReporterOwner)
+		if !f(fd_MsgSubmitReportEquivocation_reporter_owner, value) {
+			return
+		}
+	}
+	if x.Accused != "" {
+		value := protoreflect.ValueOfString(x.Accused)
+		if !f(fd_MsgSubmitReportEquivocation_accused, value) {
+			return
+		}
+	}
+	if x.Epoch != "" {
+		value := protoreflect.ValueOfString(x.Epoch)
+		if !f(fd_MsgSubmitReportEquivocation_epoch, value) {
+			return
+		}
+	}
+	if len(x.FirstReport) != 0 {
+		value := protoreflect.ValueOfBytes(x.FirstReport)
+		if !f(fd_MsgSubmitReportEquivocation_first_report, value) {
+			return
+		}
+	}
+	if x.FirstSignature != nil {
+		value := protoreflect.ValueOfMessage(x.FirstSignature.ProtoReflect())
+		if !f(fd_MsgSubmitReportEquivocation_first_signature, value) {
+			return
+		}
+	}
+	if len(x.SecondReport) != 0 {
+		value := protoreflect.ValueOfBytes(x.SecondReport)
+		if !f(fd_MsgSubmitReportEquivocation_second_report, value) {
+			return
+		}
+	}
+	if x.SecondSignature != nil {
+		value := protoreflect.ValueOfMessage(x.SecondSignature.ProtoReflect())
+		if !f(fd_MsgSubmitReportEquivocation_second_signature, value) {
+			return
+		}
+	}
+	if len(x.AccusedPubKey) != 0 {
+		value := protoreflect.ValueOfBytes(x.AccusedPubKey)
+		if !f(fd_MsgSubmitReportEquivocation_accused_pub_key, value) {
+			return
+		}
+	}
+	if len(x.AccusedSignature) != 0 {
+		value := protoreflect.ValueOfBytes(x.AccusedSignature)
+		if !f(fd_MsgSubmitReportEquivocation_accused_signature, value) {
+			return
+		}
+	}
+	if x.Kick != false {
+		value := protoreflect.ValueOfBool(x.Kick)
+		if !f(fd_MsgSubmitReportEquivocation_kick, value) {
+			return
+		}
+	}
+	if x.FirstChunked != false {
+		value := protoreflect.ValueOfBool(x.FirstChunked)
+		if !f(fd_MsgSubmitReportEquivocation_first_chunked, value) {
+			return
+		}
+	}
+	if x.SecondChunked != false {
+		value := protoreflect.ValueOfBool(x.SecondChunked)
+		if !f(fd_MsgSubmitReportEquivocation_second_chunked, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgSubmitReportEquivocation) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "stratos.pot.v1.MsgSubmitReportEquivocation.reporter":
+		return x.Reporter != ""
+	case "stratos.pot.v1.MsgSubmitReportEquivocation.reporter_owner":
+		return x.ReporterOwner != ""
+	case "stratos.pot.v1.MsgSubmitReportEquivocation.accused":
+		return x.Accused != ""
+	case "stratos.pot.v1.MsgSubmitReportEquivocation.epoch":
+		return x.Epoch != ""
+	case "stratos.pot.v1.MsgSubmitReportEquivocation.first_report":
+		return len(x.FirstReport) != 0
+	case "stratos.pot.v1.MsgSubmitReportEquivocation.first_signature":
+		return x.FirstSignature != nil
+	case "stratos.pot.v1.MsgSubmitReportEquivocation.second_report":
+		return len(x.SecondReport) != 0
+	case "stratos.pot.v1.MsgSubmitReportEquivocation.second_signature":
+		return x.SecondSignature != nil
+	case "stratos.pot.v1.MsgSubmitReportEquivocation.accused_pub_key":
+		return len(x.AccusedPubKey) != 0
+	case "stratos.pot.v1.MsgSubmitReportEquivocation.accused_signature":
+		return len(x.AccusedSignature) != 0
+	case "stratos.pot.v1.MsgSubmitReportEquivocation.kick":
+		return x.Kick != false
+	case "stratos.pot.v1.MsgSubmitReportEquivocation.first_chunked":
+		return x.FirstChunked != false
+	case "stratos.pot.v1.MsgSubmitReportEquivocation.second_chunked":
+		return x.SecondChunked != false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgSubmitReportEquivocation"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.MsgSubmitReportEquivocation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSubmitReportEquivocation) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "stratos.pot.v1.MsgSubmitReportEquivocation.reporter":
+		x.Reporter = ""
+	case "stratos.pot.v1.MsgSubmitReportEquivocation.reporter_owner":
+		x.ReporterOwner = ""
+	case "stratos.pot.v1.MsgSubmitReportEquivocation.accused":
+		x.Accused = ""
+	case "stratos.pot.v1.MsgSubmitReportEquivocation.epoch":
+		x.Epoch = ""
+	case "stratos.pot.v1.MsgSubmitReportEquivocation.first_report":
+		x.FirstReport = nil
+	case "stratos.pot.v1.MsgSubmitReportEquivocation.first_signature":
+		x.FirstSignature = nil
+	case "stratos.pot.v1.MsgSubmitReportEquivocation.second_report":
+		x.SecondReport = nil
+	case "stratos.pot.v1.MsgSubmitReportEquivocation.second_signature":
+		x.SecondSignature = nil
+	case "stratos.pot.v1.MsgSubmitReportEquivocation.accused_pub_key":
+		x.AccusedPubKey = nil
+	case "stratos.pot.v1.MsgSubmitReportEquivocation.accused_signature":
+		x.AccusedSignature = nil
+	case "stratos.pot.v1.MsgSubmitReportEquivocation.kick":
+		x.Kick = false
+	case "stratos.pot.v1.MsgSubmitReportEquivocation.first_chunked":
+		x.FirstChunked = false
+	case "stratos.pot.v1.MsgSubmitReportEquivocation.second_chunked":
+		x.SecondChunked = false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgSubmitReportEquivocation"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.MsgSubmitReportEquivocation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgSubmitReportEquivocation) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "stratos.pot.v1.MsgSubmitReportEquivocation.reporter":
+		value := x.Reporter
+		return protoreflect.ValueOfString(value)
+	case "stratos.pot.v1.MsgSubmitReportEquivocation.reporter_owner":
+		value := x.ReporterOwner
+		return protoreflect.ValueOfString(value)
+	case "stratos.pot.v1.MsgSubmitReportEquivocation.accused":
+		value := x.Accused
+		return protoreflect.ValueOfString(value)
+	case "stratos.pot.v1.MsgSubmitReportEquivocation.epoch":
+		value := x.Epoch
+		return protoreflect.ValueOfString(value)
+	case "stratos.pot.v1.MsgSubmitReportEquivocation.first_report":
+		value := x.FirstReport
+		return protoreflect.ValueOfBytes(value)
+	case "stratos.pot.v1.MsgSubmitReportEquivocation.first_signature":
+		value := x.FirstSignature
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "stratos.pot.v1.MsgSubmitReportEquivocation.second_report":
+		value := x.SecondReport
+		return protoreflect.ValueOfBytes(value)
+	case "stratos.pot.v1.MsgSubmitReportEquivocation.second_signature":
+		value := x.SecondSignature
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "stratos.pot.v1.MsgSubmitReportEquivocation.accused_pub_key":
+		value := x.AccusedPubKey
+		return protoreflect.ValueOfBytes(value)
+	case "stratos.pot.v1.MsgSubmitReportEquivocation.accused_signature":
+		value := x.AccusedSignature
+		return protoreflect.ValueOfBytes(value)
+	case "stratos.pot.v1.MsgSubmitReportEquivocation.kick":
+		value := x.Kick
+		return protoreflect.ValueOfBool(value)
+	case "stratos.pot.v1.MsgSubmitReportEquivocation.first_chunked":
+		value := x.FirstChunked
+		return protoreflect.ValueOfBool(value)
+	case "stratos.pot.v1.MsgSubmitReportEquivocation.second_chunked":
+		value := x.SecondChunked
+		return protoreflect.ValueOfBool(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgSubmitReportEquivocation"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.MsgSubmitReportEquivocation does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSubmitReportEquivocation) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "stratos.pot.v1.MsgSubmitReportEquivocation.reporter":
+		x.Reporter = value.Interface().(string)
+	case "stratos.pot.v1.MsgSubmitReportEquivocation.reporter_owner":
+		x.ReporterOwner = value.Interface().(string)
+	case "stratos.pot.v1.MsgSubmitReportEquivocation.accused":
+		x.Accused = value.Interface().(string)
+	case "stratos.pot.v1.MsgSubmitReportEquivocation.epoch":
+		x.Epoch = value.Interface().(string)
+	case "stratos.pot.v1.MsgSubmitReportEquivocation.first_report":
+		x.FirstReport = value.Bytes()
+	case "stratos.pot.v1.MsgSubmitReportEquivocation.first_signature":
+		x.FirstSignature = value.Message().Interface().(*BLSSignatureInfo)
+	case "stratos.pot.v1.MsgSubmitReportEquivocation.second_report":
+		x.SecondReport = value.Bytes()
+	case "stratos.pot.v1.MsgSubmitReportEquivocation.second_signature":
+		x.SecondSignature = value.Message().Interface().(*BLSSignatureInfo)
+	case "stratos.pot.v1.MsgSubmitReportEquivocation.accused_pub_key":
+		x.AccusedPubKey = value.Bytes()
+	case "stratos.pot.v1.MsgSubmitReportEquivocation.accused_signature":
+		x.AccusedSignature = value.Bytes()
+	case "stratos.pot.v1.MsgSubmitReportEquivocation.kick":
+		x.Kick = value.Bool()
+	case "stratos.pot.v1.MsgSubmitReportEquivocation.first_chunked":
+		x.FirstChunked = value.Bool()
+	case "stratos.pot.v1.MsgSubmitReportEquivocation.second_chunked":
+		x.SecondChunked = value.Bool()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgSubmitReportEquivocation"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.MsgSubmitReportEquivocation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSubmitReportEquivocation) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "stratos.pot.v1.MsgSubmitReportEquivocation.first_signature":
+		if x.FirstSignature == nil {
+			x.FirstSignature = new(BLSSignatureInfo)
+		}
+		return protoreflect.ValueOfMessage(x.FirstSignature.ProtoReflect())
+	case "stratos.pot.v1.MsgSubmitReportEquivocation.second_signature":
+		if x.SecondSignature == nil {
+			x.SecondSignature = new(BLSSignatureInfo)
+		}
+		return protoreflect.ValueOfMessage(x.SecondSignature.ProtoReflect())
+	case "stratos.pot.v1.MsgSubmitReportEquivocation.reporter":
+		panic(fmt.Errorf("field reporter of message stratos.pot.v1.MsgSubmitReportEquivocation is not mutable"))
+	case "stratos.pot.v1.MsgSubmitReportEquivocation.reporter_owner":
+		panic(fmt.Errorf("field reporter_owner of message stratos.pot.v1.MsgSubmitReportEquivocation is not mutable"))
+	case "stratos.pot.v1.MsgSubmitReportEquivocation.accused":
+		panic(fmt.Errorf("field accused of message stratos.pot.v1.MsgSubmitReportEquivocation is not mutable"))
+	case "stratos.pot.v1.MsgSubmitReportEquivocation.epoch":
+		panic(fmt.Errorf("field epoch of message stratos.pot.v1.MsgSubmitReportEquivocation is not mutable"))
+	case "stratos.pot.v1.MsgSubmitReportEquivocation.first_report":
+		panic(fmt.Errorf("field first_report of message stratos.pot.v1.MsgSubmitReportEquivocation is not mutable"))
+	case "stratos.pot.v1.MsgSubmitReportEquivocation.second_report":
+		panic(fmt.Errorf("field second_report of message stratos.pot.v1.MsgSubmitReportEquivocation is not mutable"))
+	case "stratos.pot.v1.MsgSubmitReportEquivocation.accused_pub_key":
+		panic(fmt.Errorf("field accused_pub_key of message stratos.pot.v1.MsgSubmitReportEquivocation is not mutable"))
+	case "stratos.pot.v1.MsgSubmitReportEquivocation.accused_signature":
+		panic(fmt.Errorf("field accused_signature of message stratos.pot.v1.MsgSubmitReportEquivocation is not mutable"))
+	case "stratos.pot.v1.MsgSubmitReportEquivocation.kick":
+		panic(fmt.Errorf("field kick of message stratos.pot.v1.MsgSubmitReportEquivocation is not mutable"))
+	case "stratos.pot.v1.MsgSubmitReportEquivocation.first_chunked":
+		panic(fmt.Errorf("field first_chunked of message stratos.pot.v1.MsgSubmitReportEquivocation is not mutable"))
+	case "stratos.pot.v1.MsgSubmitReportEquivocation.second_chunked":
+		panic(fmt.Errorf("field second_chunked of message stratos.pot.v1.MsgSubmitReportEquivocation is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgSubmitReportEquivocation"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.MsgSubmitReportEquivocation does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgSubmitReportEquivocation) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "stratos.pot.v1.MsgSubmitReportEquivocation.reporter":
+		return protoreflect.ValueOfString("")
+	case "stratos.pot.v1.MsgSubmitReportEquivocation.reporter_owner":
+		return protoreflect.ValueOfString("")
+	case "stratos.pot.v1.MsgSubmitReportEquivocation.accused":
+		return protoreflect.ValueOfString("")
+	case "stratos.pot.v1.MsgSubmitReportEquivocation.epoch":
+		return protoreflect.ValueOfString("")
+	case "stratos.pot.v1.MsgSubmitReportEquivocation.first_report":
+		return protoreflect.ValueOfBytes(nil)
+	case "stratos.pot.v1.MsgSubmitReportEquivocation.first_signature":
+		m := new(BLSSignatureInfo)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "stratos.pot.v1.MsgSubmitReportEquivocation.second_report":
+		return protoreflect.ValueOfBytes(nil)
+	case "stratos.pot.v1.MsgSubmitReportEquivocation.second_signature":
+		m := new(BLSSignatureInfo)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "stratos.pot.v1.MsgSubmitReportEquivocation.accused_pub_key":
+		return protoreflect.ValueOfBytes(nil)
+	case "stratos.pot.v1.MsgSubmitReportEquivocation.accused_signature":
+		return protoreflect.ValueOfBytes(nil)
+	case "stratos.pot.v1.MsgSubmitReportEquivocation.kick":
+		return protoreflect.ValueOfBool(false)
+	case "stratos.pot.v1.MsgSubmitReportEquivocation.first_chunked":
+		return protoreflect.ValueOfBool(false)
+	case "stratos.pot.v1.MsgSubmitReportEquivocation.second_chunked":
+		return protoreflect.ValueOfBool(false)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgSubmitReportEquivocation"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.MsgSubmitReportEquivocation does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgSubmitReportEquivocation) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in stratos.pot.v1.MsgSubmitReportEquivocation", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgSubmitReportEquivocation) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSubmitReportEquivocation) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgSubmitReportEquivocation) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgSubmitReportEquivocation) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgSubmitReportEquivocation)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Reporter)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ReporterOwner)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Accused)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Epoch)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.FirstReport)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.FirstSignature != nil {
+			l = options.Size(x.FirstSignature)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.SecondReport)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.SecondSignature != nil {
+			l = options.Size(x.SecondSignature)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.AccusedPubKey)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.AccusedSignature)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Kick {
+			n += 2
+		}
+		if x.FirstChunked {
+			n += 2
+		}
+		if x.SecondChunked {
+			n += 2
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSubmitReportEquivocation)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.SecondChunked {
+			i--
+			if x.SecondChunked {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x68
+		}
+		if x.FirstChunked {
+			i--
+			if x.FirstChunked {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x60
+		}
+		if x.Kick {
+			i--
+			if x.Kick {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x58
+		}
+		if len(x.AccusedSignature) > 0 {
+			i -= len(x.AccusedSignature)
+			copy(dAtA[i:], x.AccusedSignature)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AccusedSignature)))
+			i--
+			dAtA[i] = 0x52
+		}
+		if len(x.AccusedPubKey) > 0 {
+			i -= len(x.AccusedPubKey)
+			copy(dAtA[i:], x.AccusedPubKey)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AccusedPubKey)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if x.SecondSignature != nil {
+			encoded, err := options.Marshal(x.SecondSignature)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if len(x.SecondReport) > 0 {
+			i -= len(x.SecondReport)
+			copy(dAtA[i:], x.SecondReport)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SecondReport)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if x.FirstSignature != nil {
+			encoded, err := options.Marshal(x.FirstSignature)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.FirstReport) > 0 {
+			i -= len(x.FirstReport)
+			copy(dAtA[i:], x.FirstReport)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.FirstReport)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.Epoch) > 0 {
+			i -= len(x.Epoch)
+			copy(dAtA[i:], x.Epoch)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Epoch)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Accused) > 0 {
+			i -= len(x.Accused)
+			copy(dAtA[i:], x.Accused)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Accused)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.ReporterOwner) > 0 {
+			i -= len(x.ReporterOwner)
+			copy(dAtA[i:], x.ReporterOwner)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ReporterOwner)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Reporter) > 0 {
+			i -= len(x.Reporter)
+			copy(dAtA[i:], x.Reporter)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Reporter)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSubmitReportEquivocation)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSubmitReportEquivocation: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSubmitReportEquivocation: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reporter", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Reporter = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReporterOwner", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ReporterOwner = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Accused", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Accused = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Epoch = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FirstReport", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FirstReport = append(x.FirstReport[:0], dAtA[iNdEx:postIndex]...)
+				if x.FirstReport == nil {
+					x.FirstReport = []byte{}
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FirstSignature", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.FirstSignature == nil {
+					x.FirstSignature = &BLSSignatureInfo{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FirstSignature); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SecondReport", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SecondReport = append(x.SecondReport[:0], dAtA[iNdEx:postIndex]...)
+				if x.SecondReport == nil {
+					x.SecondReport = []byte{}
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SecondSignature", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.SecondSignature == nil {
+					x.SecondSignature = &BLSSignatureInfo{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.SecondSignature); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AccusedPubKey", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AccusedPubKey = append(x.AccusedPubKey[:0], dAtA[iNdEx:postIndex]...)
+				if x.AccusedPubKey == nil {
+					x.AccusedPubKey = []byte{}
+				}
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AccusedSignature", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AccusedSignature = append(x.AccusedSignature[:0], dAtA[iNdEx:postIndex]...)
+				if x.AccusedSignature == nil {
+					x.AccusedSignature = []byte{}
+				}
+				iNdEx = postIndex
+			case 11:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Kick", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Kick = bool(v != 0)
+			case 12:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FirstChunked", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.FirstChunked = bool(v != 0)
+			case 13:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SecondChunked", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.SecondChunked = bool(v != 0)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgSubmitReportEquivocationResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_stratos_pot_v1_tx_proto_init()
+	md_MsgSubmitReportEquivocationResponse = File_stratos_pot_v1_tx_proto.Messages().ByName("MsgSubmitReportEquivocationResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgSubmitReportEquivocationResponse)(nil)
+
+type fastReflection_MsgSubmitReportEquivocationResponse MsgSubmitReportEquivocationResponse
+
+func (x *MsgSubmitReportEquivocationResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgSubmitReportEquivocationResponse)(x)
+}
+
+func (x *MsgSubmitReportEquivocationResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_stratos_pot_v1_tx_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgSubmitReportEquivocationResponse_messageType fastReflection_MsgSubmitReportEquivocationResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgSubmitReportEquivocationResponse_messageType{}
+
+type fastReflection_MsgSubmitReportEquivocationResponse_messageType struct{}
+
+func (x fastReflection_MsgSubmitReportEquivocationResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgSubmitReportEquivocationResponse)(nil)
+}
+func (x fastReflection_MsgSubmitReportEquivocationResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgSubmitReportEquivocationResponse)
+}
+func (x fastReflection_MsgSubmitReportEquivocationResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSubmitReportEquivocationResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgSubmitReportEquivocationResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSubmitReportEquivocationResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgSubmitReportEquivocationResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgSubmitReportEquivocationResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgSubmitReportEquivocationResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgSubmitReportEquivocationResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgSubmitReportEquivocationResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgSubmitReportEquivocationResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgSubmitReportEquivocationResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgSubmitReportEquivocationResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgSubmitReportEquivocationResponse"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.MsgSubmitReportEquivocationResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSubmitReportEquivocationResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgSubmitReportEquivocationResponse"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.MsgSubmitReportEquivocationResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgSubmitReportEquivocationResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgSubmitReportEquivocationResponse"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.MsgSubmitReportEquivocationResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSubmitReportEquivocationResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgSubmitReportEquivocationResponse"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.MsgSubmitReportEquivocationResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSubmitReportEquivocationResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgSubmitReportEquivocationResponse"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.MsgSubmitReportEquivocationResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgSubmitReportEquivocationResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgSubmitReportEquivocationResponse"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.MsgSubmitReportEquivocationResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgSubmitReportEquivocationResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in stratos.pot.v1.MsgSubmitReportEquivocationResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgSubmitReportEquivocationResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSubmitReportEquivocationResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgSubmitReportEquivocationResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgSubmitReportEquivocationResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgSubmitReportEquivocationResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSubmitReportEquivocationResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSubmitReportEquivocationResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSubmitReportEquivocationResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSubmitReportEquivocationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgSetAutoRestake                 protoreflect.MessageDescriptor
+	fd_MsgSetAutoRestake_wallet_address  protoreflect.FieldDescriptor
+	fd_MsgSetAutoRestake_network_address protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_stratos_pot_v1_tx_proto_init()
+	md_MsgSetAutoRestake = File_stratos_pot_v1_tx_proto.Messages().ByName("MsgSetAutoRestake")
+	fd_MsgSetAutoRestake_wallet_address = md_MsgSetAutoRestake.Fields().ByName("wallet_address")
+	fd_MsgSetAutoRestake_network_address = md_MsgSetAutoRestake.Fields().ByName("network_address")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgSetAutoRestake)(nil)
+
+type fastReflection_MsgSetAutoRestake MsgSetAutoRestake
+
+func (x *MsgSetAutoRestake) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgSetAutoRestake)(x)
+}
+
+func (x *MsgSetAutoRestake) slowProtoReflect() protoreflect.Message {
+	mi := &file_stratos_pot_v1_tx_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgSetAutoRestake_messageType fastReflection_MsgSetAutoRestake_messageType
+var _ protoreflect.MessageType = fastReflection_MsgSetAutoRestake_messageType{}
+
+type fastReflection_MsgSetAutoRestake_messageType struct{}
+
+func (x fastReflection_MsgSetAutoRestake_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgSetAutoRestake)(nil)
+}
+func (x fastReflection_MsgSetAutoRestake_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgSetAutoRestake)
+}
+func (x fastReflection_MsgSetAutoRestake_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSetAutoRestake
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgSetAutoRestake) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSetAutoRestake
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgSetAutoRestake) Type() protoreflect.MessageType {
+	return _fastReflection_MsgSetAutoRestake_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgSetAutoRestake) New() protoreflect.Message {
+	return new(fastReflection_MsgSetAutoRestake)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgSetAutoRestake) Interface() protoreflect.ProtoMessage {
+	return (*MsgSetAutoRestake)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgSetAutoRestake) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.WalletAddress != "" {
+		value := protoreflect.ValueOfString(x.WalletAddress)
+		if !f(fd_MsgSetAutoRestake_wallet_address, value) {
+			return
+		}
+	}
+	if x.NetworkAddress != "" {
+		value := protoreflect.ValueOfString(x.NetworkAddress)
+		if !f(fd_MsgSetAutoRestake_network_address, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgSetAutoRestake) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "stratos.pot.v1.MsgSetAutoRestake.wallet_address":
+		return x.WalletAddress != ""
+	case "stratos.pot.v1.MsgSetAutoRestake.network_address":
+		return x.NetworkAddress != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgSetAutoRestake"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.MsgSetAutoRestake does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetAutoRestake) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "stratos.pot.v1.MsgSetAutoRestake.wallet_address":
+		x.WalletAddress = ""
+	case "stratos.pot.v1.MsgSetAutoRestake.network_address":
+		x.NetworkAddress = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgSetAutoRestake"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.MsgSetAutoRestake does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgSetAutoRestake) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "stratos.pot.v1.MsgSetAutoRestake.wallet_address":
+		value := x.WalletAddress
+		return protoreflect.ValueOfString(value)
+	case "stratos.pot.v1.MsgSetAutoRestake.network_address":
+		value := x.NetworkAddress
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgSetAutoRestake"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.MsgSetAutoRestake does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetAutoRestake) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "stratos.pot.v1.MsgSetAutoRestake.wallet_address":
+		x.WalletAddress = value.Interface().(string)
+	case "stratos.pot.v1.MsgSetAutoRestake.network_address":
+		x.NetworkAddress = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgSetAutoRestake"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.MsgSetAutoRestake does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetAutoRestake) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "stratos.pot.v1.MsgSetAutoRestake.wallet_address":
+		panic(fmt.Errorf("field wallet_address of message stratos.pot.v1.MsgSetAutoRestake is not mutable"))
+	case "stratos.pot.v1.MsgSetAutoRestake.network_address":
+		panic(fmt.Errorf("field network_address of message stratos.pot.v1.MsgSetAutoRestake is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgSetAutoRestake"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.MsgSetAutoRestake does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgSetAutoRestake) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "stratos.pot.v1.MsgSetAutoRestake.wallet_address":
+		return protoreflect.ValueOfString("")
+	case "stratos.pot.v1.MsgSetAutoRestake.network_address":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgSetAutoRestake"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.MsgSetAutoRestake does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgSetAutoRestake) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in stratos.pot.v1.MsgSetAutoRestake", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgSetAutoRestake) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetAutoRestake) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgSetAutoRestake) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgSetAutoRestake) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgSetAutoRestake)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.WalletAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.NetworkAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSetAutoRestake)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.NetworkAddress) > 0 {
+			i -= len(x.NetworkAddress)
+			copy(dAtA[i:], x.NetworkAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NetworkAddress)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.WalletAddress) > 0 {
+			i -= len(x.WalletAddress)
+			copy(dAtA[i:], x.WalletAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.WalletAddress)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSetAutoRestake)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetAutoRestake: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetAutoRestake: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WalletAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.WalletAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NetworkAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.NetworkAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgSetAutoRestakeResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_stratos_pot_v1_tx_proto_init()
+	md_MsgSetAutoRestakeResponse = File_stratos_pot_v1_tx_proto.Messages().ByName("MsgSetAutoRestakeResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgSetAutoRestakeResponse)(nil)
+
+type fastReflection_MsgSetAutoRestakeResponse MsgSetAutoRestakeResponse
+
+func (x *MsgSetAutoRestakeResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgSetAutoRestakeResponse)(x)
+}
+
+func (x *MsgSetAutoRestakeResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_stratos_pot_v1_tx_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgSetAutoRestakeResponse_messageType fastReflection_MsgSetAutoRestakeResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgSetAutoRestakeResponse_messageType{}
+
+type fastReflection_MsgSetAutoRestakeResponse_messageType struct{}
+
+func (x fastReflection_MsgSetAutoRestakeResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgSetAutoRestakeResponse)(nil)
+}
+func (x fastReflection_MsgSetAutoRestakeResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgSetAutoRestakeResponse)
+}
+func (x fastReflection_MsgSetAutoRestakeResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSetAutoRestakeResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgSetAutoRestakeResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSetAutoRestakeResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgSetAutoRestakeResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgSetAutoRestakeResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgSetAutoRestakeResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgSetAutoRestakeResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgSetAutoRestakeResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgSetAutoRestakeResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgSetAutoRestakeResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgSetAutoRestakeResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgSetAutoRestakeResponse"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.MsgSetAutoRestakeResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetAutoRestakeResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgSetAutoRestakeResponse"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.MsgSetAutoRestakeResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgSetAutoRestakeResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgSetAutoRestakeResponse"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.MsgSetAutoRestakeResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetAutoRestakeResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgSetAutoRestakeResponse"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.MsgSetAutoRestakeResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetAutoRestakeResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgSetAutoRestakeResponse"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.MsgSetAutoRestakeResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgSetAutoRestakeResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgSetAutoRestakeResponse"))
+		}
+		panic(fmt.Errorf("message stratos.pot.v1.MsgSetAutoRestakeResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgSetAutoRestakeResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in stratos.pot.v1.MsgSetAutoRestakeResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgSetAutoRestakeResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetAutoRestakeResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgSetAutoRestakeResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgSetAutoRestakeResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgSetAutoRestakeResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSetAutoRestakeResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSetAutoRestakeResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetAutoRestakeResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetAutoRestakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_BLSSignatureInfo_1_list)(nil)
 
-type _MsgSlashingResourceNode_2_list struct {
-	list *[]string
+type _BLSSignatureInfo_1_list struct {
+	list *[][]byte
 }
 
-func (x *_MsgSlashingResourceNode_2_list) Len() int {
+func (x *_BLSSignatureInfo_1_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_MsgSlashingResourceNode_2_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfString((*x.list)[i])
+func (x *_BLSSignatureInfo_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfBytes((*x.list)[i])
 }
 
-func (x *_MsgSlashingResourceNode_2_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.String()
+func (x *_BLSSignatureInfo_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Bytes()
 	concreteValue := valueUnwrapped
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_MsgSlashingResourceNode_2_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.String()
+func (x *_BLSSignatureInfo_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Bytes()
 	concreteValue := valueUnwrapped
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_MsgSlashingResourceNode_2_list) AppendMutable() protoreflect.Value {
-	panic(fmt.Errorf("AppendMutable can not be called on message MsgSlashingResourceNode at list field ReporterOwner as it is not of Message kind"))
+func (x *_BLSSignatureInfo_1_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message BLSSignatureInfo at list field PubKeys as it is not of Message kind"))
 }
 
-func (x *_MsgSlashingResourceNode_2_list) Truncate(n int) {
+func (x *_BLSSignatureInfo_1_list) Truncate(n int) {
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_MsgSlashingResourceNode_2_list) NewElement() protoreflect.Value {
-	v := ""
-	return protoreflect.ValueOfString(v)
+func (x *_BLSSignatureInfo_1_list) NewElement() protoreflect.Value {
+	var v []byte
+	return protoreflect.ValueOfBytes(v)
 }
 
-func (x *_MsgSlashingResourceNode_2_list) IsValid() bool {
+func (x *_BLSSignatureInfo_1_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_MsgSlashingResourceNode                 protoreflect.MessageDescriptor
-	fd_MsgSlashingResourceNode_reporters       protoreflect.FieldDescriptor
-	fd_MsgSlashingResourceNode_reporter_owner  protoreflect.FieldDescriptor
-	fd_MsgSlashingResourceNode_network_address protoreflect.FieldDescriptor
-	fd_MsgSlashingResourceNode_wallet_address  protoreflect.FieldDescriptor
-	fd_MsgSlashingResourceNode_slashing        protoreflect.FieldDescriptor
-	fd_MsgSlashingResourceNode_suspend         protoreflect.FieldDescriptor
+	md_BLSSignatureInfo           protoreflect.MessageDescriptor
+	fd_BLSSignatureInfo_pub_keys  protoreflect.FieldDescriptor
+	fd_BLSSignatureInfo_signature protoreflect.FieldDescriptor
+	fd_BLSSignatureInfo_txData    protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_stratos_pot_v1_tx_proto_init()
-	md_MsgSlashingResourceNode = File_stratos_pot_v1_tx_proto.Messages().ByName("MsgSlashingResourceNode")
-	fd_MsgSlashingResourceNode_reporters = md_MsgSlashingResourceNode.Fields().ByName("reporters")
-	fd_MsgSlashingResourceNode_reporter_owner = md_MsgSlashingResourceNode.Fields().ByName("reporter_owner")
-	fd_MsgSlashingResourceNode_network_address = md_MsgSlashingResourceNode.Fields().ByName("network_address")
-	fd_MsgSlashingResourceNode_wallet_address = md_MsgSlashingResourceNode.Fields().ByName("wallet_address")
-	fd_MsgSlashingResourceNode_slashing = md_MsgSlashingResourceNode.Fields().ByName("slashing")
-	fd_MsgSlashingResourceNode_suspend = md_MsgSlashingResourceNode.Fields().ByName("suspend")
+	md_BLSSignatureInfo = File_stratos_pot_v1_tx_proto.Messages().ByName("BLSSignatureInfo")
+	fd_BLSSignatureInfo_pub_keys = md_BLSSignatureInfo.Fields().ByName("pub_keys")
+	fd_BLSSignatureInfo_signature = md_BLSSignatureInfo.Fields().ByName("signature")
+	fd_BLSSignatureInfo_txData = md_BLSSignatureInfo.Fields().ByName("txData")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgSlashingResourceNode)(nil)
+var _ protoreflect.Message = (*fastReflection_BLSSignatureInfo)(nil)
 
-type fastReflection_MsgSlashingResourceNode MsgSlashingResourceNode
+type fastReflection_BLSSignatureInfo BLSSignatureInfo
 
-func (x *MsgSlashingResourceNode) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgSlashingResourceNode)(x)
+func (x *BLSSignatureInfo) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_BLSSignatureInfo)(x)
 }
 
-func (x *MsgSlashingResourceNode) slowProtoReflect() protoreflect.Message {
-	mi := &file_stratos_pot_v1_tx_proto_msgTypes[6]
+func (x *BLSSignatureInfo) slowProtoReflect() protoreflect.Message {
+	mi := &file_stratos_pot_v1_tx_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3227,43 +10401,43 @@ func (x *MsgSlashingResourceNode) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgSlashingResourceNode_messageType fastReflection_MsgSlashingResourceNode_messageType
-var _ protoreflect.MessageType = fastReflection_MsgSlashingResourceNode_messageType{}
+var _fastReflection_BLSSignatureInfo_messageType fastReflection_BLSSignatureInfo_messageType
+var _ protoreflect.MessageType = fastReflection_BLSSignatureInfo_messageType{}
 
-type fastReflection_MsgSlashingResourceNode_messageType struct{}
+type fastReflection_BLSSignatureInfo_messageType struct{}
 
-func (x fastReflection_MsgSlashingResourceNode_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgSlashingResourceNode)(nil)
+func (x fastReflection_BLSSignatureInfo_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_BLSSignatureInfo)(nil)
 }
-func (x fastReflection_MsgSlashingResourceNode_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgSlashingResourceNode)
+func (x fastReflection_BLSSignatureInfo_messageType) New() protoreflect.Message {
+	return new(fastReflection_BLSSignatureInfo)
 }
-func (x fastReflection_MsgSlashingResourceNode_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgSlashingResourceNode
+func (x fastReflection_BLSSignatureInfo_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_BLSSignatureInfo
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgSlashingResourceNode) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgSlashingResourceNode
+func (x *fastReflection_BLSSignatureInfo) Descriptor() protoreflect.MessageDescriptor {
+	return md_BLSSignatureInfo
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgSlashingResourceNode) Type() protoreflect.MessageType {
-	return _fastReflection_MsgSlashingResourceNode_messageType
+func (x *fastReflection_BLSSignatureInfo) Type() protoreflect.MessageType {
+	return _fastReflection_BLSSignatureInfo_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgSlashingResourceNode) New() protoreflect.Message {
-	return new(fastReflection_MsgSlashingResourceNode)
+func (x *fastReflection_BLSSignatureInfo) New() protoreflect.Message {
+	return new(fastReflection_BLSSignatureInfo)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgSlashingResourceNode) Interface() protoreflect.ProtoMessage {
-	return (*MsgSlashingResourceNode)(x)
+func (x *fastReflection_BLSSignatureInfo) Interface() protoreflect.ProtoMessage {
+	return (*BLSSignatureInfo)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3271,40 +10445,22 @@ func (x *fastReflection_MsgSlashingResourceNode) Interface() protoreflect.ProtoM
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgSlashingResourceNode) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.Reporters) != 0 {
-		value := protoreflect.ValueOfList(&_MsgSlashingResourceNode_1_list{list: &x.Reporters})
-		if !f(fd_MsgSlashingResourceNode_reporters, value) {
-			return
-		}
-	}
-	if len(x.ReporterOwner) != 0 {
-		value := protoreflect.ValueOfList(&_MsgSlashingResourceNode_2_list{list: &x.ReporterOwner})
-		if !f(fd_MsgSlashingResourceNode_reporter_owner, value) {
-			return
-		}
-	}
-	if x.NetworkAddress != "" {
-		value := protoreflect.ValueOfString(x.NetworkAddress)
-		if !f(fd_MsgSlashingResourceNode_network_address, value) {
-			return
-		}
-	}
-	if x.WalletAddress != "" {
-		value := protoreflect.ValueOfString(x.WalletAddress)
-		if !f(fd_MsgSlashingResourceNode_wallet_address, value) {
+func (x *fastReflection_BLSSignatureInfo) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.PubKeys) != 0 {
+		value := protoreflect.ValueOfList(&_BLSSignatureInfo_1_list{list: &x.PubKeys})
+		if !f(fd_BLSSignatureInfo_pub_keys, value) {
 			return
 		}
 	}
-	if x.Slashing != "" {
-		value := protoreflect.ValueOfString(x.Slashing)
-		if !f(fd_MsgSlashingResourceNode_slashing, value) {
+	if len(x.Signature) != 0 {
+		value := protoreflect.ValueOfBytes(x.Signature)
+		if !f(fd_BLSSignatureInfo_signature, value) {
 			return
 		}
 	}
-	if x.Suspend != false {
-		value := protoreflect.ValueOfBool(x.Suspend)
-		if !f(fd_MsgSlashingResourceNode_suspend, value) {
+	if len(x.TxData) != 0 {
+		value := protoreflect.ValueOfBytes(x.TxData)
+		if !f(fd_BLSSignatureInfo_txData, value) {
 			return
 		}
 	}
@@ -3316,30 +10472,24 @@ func (x *fastReflection_MsgSlashingResourceNode) Range(f func(protoreflect.Field
 // distinguish between the default value of a field and whether the field
 // was explicitly populated with the default value. Singular message fields,
 // member fields of a oneof, and proto2 scalar fields are nullable. Such
-// fields are populated only if explicitly set.
-//
-// In other cases (aside from the nullable cases above),
-// a proto3 scalar field is populated if it contains a non-zero value, and
-// a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgSlashingResourceNode) Has(fd protoreflect.FieldDescriptor) bool {
-	switch fd.FullName() {
-	case "stratos.pot.v1.MsgSlashingResourceNode.reporters":
-		return len(x.Reporters) != 0
-	case "stratos.pot.v1.MsgSlashingResourceNode.reporter_owner":
-		return len(x.ReporterOwner) != 0
-	case "stratos.pot.v1.MsgSlashingResourceNode.network_address":
-		return x.NetworkAddress != ""
-	case "stratos.pot.v1.MsgSlashingResourceNode.wallet_address":
-		return x.WalletAddress != ""
-	case "stratos.pot.v1.MsgSlashingResourceNode.slashing":
-		return x.Slashing != ""
-	case "stratos.pot.v1.MsgSlashingResourceNode.suspend":
-		return x.Suspend != false
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_BLSSignatureInfo) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "stratos.pot.v1.BLSSignatureInfo.pub_keys":
+		return len(x.PubKeys) != 0
+	case "stratos.pot.v1.BLSSignatureInfo.signature":
+		return len(x.Signature) != 0
+	case "stratos.pot.v1.BLSSignatureInfo.txData":
+		return len(x.TxData) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgSlashingResourceNode"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.BLSSignatureInfo"))
 		}
-		panic(fmt.Errorf("message stratos.pot.v1.MsgSlashingResourceNode does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message stratos.pot.v1.BLSSignatureInfo does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3349,25 +10499,19 @@ func (x *fastReflection_MsgSlashingResourceNode) Has(fd protoreflect.FieldDescri
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSlashingResourceNode) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_BLSSignatureInfo) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "stratos.pot.v1.MsgSlashingResourceNode.reporters":
-		x.Reporters = nil
-	case "stratos.pot.v1.MsgSlashingResourceNode.reporter_owner":
-		x.ReporterOwner = nil
-	case "stratos.pot.v1.MsgSlashingResourceNode.network_address":
-		x.NetworkAddress = ""
-	case "stratos.pot.v1.MsgSlashingResourceNode.wallet_address":
-		x.WalletAddress = ""
-	case "stratos.pot.v1.MsgSlashingResourceNode.slashing":
-		x.Slashing = ""
-	case "stratos.pot.v1.MsgSlashingResourceNode.suspend":
-		x.Suspend = false
+	case "stratos.pot.v1.BLSSignatureInfo.pub_keys":
+		x.PubKeys = nil
+	case "stratos.pot.v1.BLSSignatureInfo.signature":
+		x.Signature = nil
+	case "stratos.pot.v1.BLSSignatureInfo.txData":
+		x.TxData = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgSlashingResourceNode"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.BLSSignatureInfo"))
 		}
-		panic(fmt.Errorf("message stratos.pot.v1.MsgSlashingResourceNode does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message stratos.pot.v1.BLSSignatureInfo does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3377,37 +10521,25 @@ func (x *fastReflection_MsgSlashingResourceNode) Clear(fd protoreflect.FieldDesc
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgSlashingResourceNode) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_BLSSignatureInfo) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "stratos.pot.v1.MsgSlashingResourceNode.reporters":
-		if len(x.Reporters) == 0 {
-			return protoreflect.ValueOfList(&_MsgSlashingResourceNode_1_list{})
-		}
-		listValue := &_MsgSlashingResourceNode_1_list{list: &x.Reporters}
-		return protoreflect.ValueOfList(listValue)
-	case "stratos.pot.v1.MsgSlashingResourceNode.reporter_owner":
-		if len(x.ReporterOwner) == 0 {
-			return protoreflect.ValueOfList(&_MsgSlashingResourceNode_2_list{})
+	case "stratos.pot.v1.BLSSignatureInfo.pub_keys":
+		if len(x.PubKeys) == 0 {
+			return protoreflect.ValueOfList(&_BLSSignatureInfo_1_list{})
 		}
-		listValue := &_MsgSlashingResourceNode_2_list{list: &x.ReporterOwner}
+		listValue := &_BLSSignatureInfo_1_list{list: &x.PubKeys}
 		return protoreflect.ValueOfList(listValue)
-	case "stratos.pot.v1.MsgSlashingResourceNode.network_address":
-		value := x.NetworkAddress
-		return protoreflect.ValueOfString(value)
-	case "stratos.pot.v1.MsgSlashingResourceNode.wallet_address":
-		value := x.WalletAddress
-		return protoreflect.ValueOfString(value)
-	case "stratos.pot.v1.MsgSlashingResourceNode.slashing":
-		value := x.Slashing
-		return protoreflect.ValueOfString(value)
-	case "stratos.pot.v1.MsgSlashingResourceNode.suspend":
-		value := x.Suspend
-		return protoreflect.ValueOfBool(value)
+	case "stratos.pot.v1.BLSSignatureInfo.signature":
+		value := x.Signature
+		return protoreflect.ValueOfBytes(value)
+	case "stratos.pot.v1.BLSSignatureInfo.txData":
+		value := x.TxData
+		return protoreflect.ValueOfBytes(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgSlashingResourceNode"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.BLSSignatureInfo"))
 		}
-		panic(fmt.Errorf("message stratos.pot.v1.MsgSlashingResourceNode does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message stratos.pot.v1.BLSSignatureInfo does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3421,29 +10553,21 @@ func (x *fastReflection_MsgSlashingResourceNode) Get(descriptor protoreflect.Fie
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSlashingResourceNode) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_BLSSignatureInfo) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "stratos.pot.v1.MsgSlashingResourceNode.reporters":
-		lv := value.List()
-		clv := lv.(*_MsgSlashingResourceNode_1_list)
-		x.Reporters = *clv.list
-	case "stratos.pot.v1.MsgSlashingResourceNode.reporter_owner":
+	case "stratos.pot.v1.BLSSignatureInfo.pub_keys":
 		lv := value.List()
-		clv := lv.(*_MsgSlashingResourceNode_2_list)
-		x.ReporterOwner = *clv.list
-	case "stratos.pot.v1.MsgSlashingResourceNode.network_address":
-		x.NetworkAddress = value.Interface().(string)
-	case "stratos.pot.v1.MsgSlashingResourceNode.wallet_address":
-		x.WalletAddress = value.Interface().(string)
-	case "stratos.pot.v1.MsgSlashingResourceNode.slashing":
-		x.Slashing = value.Interface().(string)
-	case "stratos.pot.v1.MsgSlashingResourceNode.suspend":
-		x.Suspend = value.Bool()
+		clv := lv.(*_BLSSignatureInfo_1_list)
+		x.PubKeys = *clv.list
+	case "stratos.pot.v1.BLSSignatureInfo.signature":
+		x.Signature = value.Bytes()
+	case "stratos.pot.v1.BLSSignatureInfo.txData":
+		x.TxData = value.Bytes()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgSlashingResourceNode"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.BLSSignatureInfo"))
 		}
-		panic(fmt.Errorf("message stratos.pot.v1.MsgSlashingResourceNode does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message stratos.pot.v1.BLSSignatureInfo does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3457,70 +10581,53 @@ func (x *fastReflection_MsgSlashingResourceNode) Set(fd protoreflect.FieldDescri
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSlashingResourceNode) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_BLSSignatureInfo) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "stratos.pot.v1.MsgSlashingResourceNode.reporters":
-		if x.Reporters == nil {
-			x.Reporters = []string{}
-		}
-		value := &_MsgSlashingResourceNode_1_list{list: &x.Reporters}
-		return protoreflect.ValueOfList(value)
-	case "stratos.pot.v1.MsgSlashingResourceNode.reporter_owner":
-		if x.ReporterOwner == nil {
-			x.ReporterOwner = []string{}
+	case "stratos.pot.v1.BLSSignatureInfo.pub_keys":
+		if x.PubKeys == nil {
+			x.PubKeys = [][]byte{}
 		}
-		value := &_MsgSlashingResourceNode_2_list{list: &x.ReporterOwner}
+		value := &_BLSSignatureInfo_1_list{list: &x.PubKeys}
 		return protoreflect.ValueOfList(value)
-	case "stratos.pot.v1.MsgSlashingResourceNode.network_address":
-		panic(fmt.Errorf("field network_address of message stratos.pot.v1.MsgSlashingResourceNode is not mutable"))
-	case "stratos.pot.v1.MsgSlashingResourceNode.wallet_address":
-		panic(fmt.Errorf("field wallet_address of message stratos.pot.v1.MsgSlashingResourceNode is not mutable"))
-	case "stratos.pot.v1.MsgSlashingResourceNode.slashing":
-		panic(fmt.Errorf("field slashing of message stratos.pot.v1.MsgSlashingResourceNode is not mutable"))
-	case "stratos.pot.v1.MsgSlashingResourceNode.suspend":
-		panic(fmt.Errorf("field suspend of message stratos.pot.v1.MsgSlashingResourceNode is not mutable"))
+	case "stratos.pot.v1.BLSSignatureInfo.signature":
+		panic(fmt.Errorf("field signature of message stratos.pot.v1.BLSSignatureInfo is not mutable"))
+	case "stratos.pot.v1.BLSSignatureInfo.txData":
+		panic(fmt.Errorf("field txData of message stratos.pot.v1.BLSSignatureInfo is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgSlashingResourceNode"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.BLSSignatureInfo"))
 		}
-		panic(fmt.Errorf("message stratos.pot.v1.MsgSlashingResourceNode does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message stratos.pot.v1.BLSSignatureInfo does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgSlashingResourceNode) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_BLSSignatureInfo) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "stratos.pot.v1.MsgSlashingResourceNode.reporters":
-		list := []string{}
-		return protoreflect.ValueOfList(&_MsgSlashingResourceNode_1_list{list: &list})
-	case "stratos.pot.v1.MsgSlashingResourceNode.reporter_owner":
-		list := []string{}
-		return protoreflect.ValueOfList(&_MsgSlashingResourceNode_2_list{list: &list})
-	case "stratos.pot.v1.MsgSlashingResourceNode.network_address":
-		return protoreflect.ValueOfString("")
-	case "stratos.pot.v1.MsgSlashingResourceNode.wallet_address":
-		return protoreflect.ValueOfString("")
-	case "stratos.pot.v1.MsgSlashingResourceNode.slashing":
-		return protoreflect.ValueOfString("")
-	case "stratos.pot.v1.MsgSlashingResourceNode.suspend":
-		return protoreflect.ValueOfBool(false)
+	case "stratos.pot.v1.BLSSignatureInfo.pub_keys":
+		list := [][]byte{}
+		return protoreflect.ValueOfList(&_BLSSignatureInfo_1_list{list: &list})
+	case "stratos.pot.v1.BLSSignatureInfo.signature":
+		return protoreflect.ValueOfBytes(nil)
+	case "stratos.pot.v1.BLSSignatureInfo.txData":
+		return protoreflect.ValueOfBytes(nil)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgSlashingResourceNode"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.BLSSignatureInfo"))
 		}
-		panic(fmt.Errorf("message stratos.pot.v1.MsgSlashingResourceNode does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message stratos.pot.v1.BLSSignatureInfo does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgSlashingResourceNode) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_BLSSignatureInfo) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in stratos.pot.v1.MsgSlashingResourceNode", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in stratos.pot.v1.BLSSignatureInfo", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3528,7 +10635,7 @@ func (x *fastReflection_MsgSlashingResourceNode) WhichOneof(d protoreflect.Oneof
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgSlashingResourceNode) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_BLSSignatureInfo) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3539,7 +10646,7 @@ func (x *fastReflection_MsgSlashingResourceNode) GetUnknown() protoreflect.RawFi
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSlashingResourceNode) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_BLSSignatureInfo) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3551,7 +10658,7 @@ func (x *fastReflection_MsgSlashingResourceNode) SetUnknown(fields protoreflect.
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgSlashingResourceNode) IsValid() bool {
+func (x *fastReflection_BLSSignatureInfo) IsValid() bool {
 	return x != nil
 }
 
@@ -3561,9 +10668,9 @@ func (x *fastReflection_MsgSlashingResourceNode) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgSlashingResourceNode) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_BLSSignatureInfo) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgSlashingResourceNode)
+		x := input.Message.Interface().(*BLSSignatureInfo)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3575,33 +10682,20 @@ func (x *fastReflection_MsgSlashingResourceNode) ProtoMethods() *protoiface.Meth
 		var n int
 		var l int
 		_ = l
-		if len(x.Reporters) > 0 {
-			for _, s := range x.Reporters {
-				l = len(s)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if len(x.ReporterOwner) > 0 {
-			for _, s := range x.ReporterOwner {
-				l = len(s)
+		if len(x.PubKeys) > 0 {
+			for _, b := range x.PubKeys {
+				l = len(b)
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
-		l = len(x.NetworkAddress)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.WalletAddress)
+		l = len(x.Signature)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.Slashing)
+		l = len(x.TxData)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.Suspend {
-			n += 2
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -3612,7 +10706,7 @@ func (x *fastReflection_MsgSlashingResourceNode) ProtoMethods() *protoiface.Meth
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgSlashingResourceNode)
+		x := input.Message.Interface().(*BLSSignatureInfo)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3631,51 +10725,25 @@ func (x *fastReflection_MsgSlashingResourceNode) ProtoMethods() *protoiface.Meth
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Suspend {
-			i--
-			if x.Suspend {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
-			}
-			i--
-			dAtA[i] = 0x30
-		}
-		if len(x.Slashing) > 0 {
-			i -= len(x.Slashing)
-			copy(dAtA[i:], x.Slashing)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Slashing)))
-			i--
-			dAtA[i] = 0x2a
-		}
-		if len(x.WalletAddress) > 0 {
-			i -= len(x.WalletAddress)
-			copy(dAtA[i:], x.WalletAddress)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.WalletAddress)))
-			i--
-			dAtA[i] = 0x22
-		}
-		if len(x.NetworkAddress) > 0 {
-			i -= len(x.NetworkAddress)
-			copy(dAtA[i:], x.NetworkAddress)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NetworkAddress)))
+		if len(x.TxData) > 0 {
+			i -= len(x.TxData)
+			copy(dAtA[i:], x.TxData)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TxData)))
 			i--
 			dAtA[i] = 0x1a
 		}
-		if len(x.ReporterOwner) > 0 {
-			for iNdEx := len(x.ReporterOwner) - 1; iNdEx >= 0; iNdEx-- {
-				i -= len(x.ReporterOwner[iNdEx])
-				copy(dAtA[i:], x.ReporterOwner[iNdEx])
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ReporterOwner[iNdEx])))
-				i--
-				dAtA[i] = 0x12
-			}
+		if len(x.Signature) > 0 {
+			i -= len(x.Signature)
+			copy(dAtA[i:], x.Signature)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Signature)))
+			i--
+			dAtA[i] = 0x12
 		}
-		if len(x.Reporters) > 0 {
-			for iNdEx := len(x.Reporters) - 1; iNdEx >= 0; iNdEx-- {
-				i -= len(x.Reporters[iNdEx])
-				copy(dAtA[i:], x.Reporters[iNdEx])
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Reporters[iNdEx])))
+		if len(x.PubKeys) > 0 {
+			for iNdEx := len(x.PubKeys) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.PubKeys[iNdEx])
+				copy(dAtA[i:], x.PubKeys[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PubKeys[iNdEx])))
 				i--
 				dAtA[i] = 0xa
 			}
@@ -3691,7 +10759,7 @@ func (x *fastReflection_MsgSlashingResourceNode) ProtoMethods() *protoiface.Meth
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgSlashingResourceNode)
+		x := input.Message.Interface().(*BLSSignatureInfo)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3723,17 +10791,17 @@ func (x *fastReflection_MsgSlashingResourceNode) ProtoMethods() *protoiface.Meth
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSlashingResourceNode: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BLSSignatureInfo: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSlashingResourceNode: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BLSSignatureInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reporters", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PubKeys", wireType)
 				}
-				var stringLen uint64
+				var byteLen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3743,29 +10811,29 @@ func (x *fastReflection_MsgSlashingResourceNode) ProtoMethods() *protoiface.Meth
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					byteLen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if byteLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + byteLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Reporters = append(x.Reporters, string(dAtA[iNdEx:postIndex]))
+				x.PubKeys = append(x.PubKeys, make([]byte, postIndex-iNdEx))
+				copy(x.PubKeys[len(x.PubKeys)-1], dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReporterOwner", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
 				}
-				var stringLen uint64
+				var byteLen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3775,61 +10843,31 @@ func (x *fastReflection_MsgSlashingResourceNode) ProtoMethods() *protoiface.Meth
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					byteLen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if byteLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + byteLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ReporterOwner = append(x.ReporterOwner, string(dAtA[iNdEx:postIndex]))
-				iNdEx = postIndex
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NetworkAddress", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				x.Signature = append(x.Signature[:0], dAtA[iNdEx:postIndex]...)
+				if x.Signature == nil {
+					x.Signature = []byte{}
 				}
-				x.NetworkAddress = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 4:
+			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WalletAddress", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TxData", wireType)
 				}
-				var stringLen uint64
+				var byteLen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3839,76 +10877,26 @@ func (x *fastReflection_MsgSlashingResourceNode) ProtoMethods() *protoiface.Meth
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					byteLen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if byteLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + byteLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.WalletAddress = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 5:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Slashing", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				x.TxData = append(x.TxData[:0], dAtA[iNdEx:postIndex]...)
+				if x.TxData == nil {
+					x.TxData = []byte{}
 				}
-				x.Slashing = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 6:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Suspend", wireType)
-				}
-				var v int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				x.Suspend = bool(v != 0)
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -3945,24 +10933,28 @@ func (x *fastReflection_MsgSlashingResourceNode) ProtoMethods() *protoiface.Meth
 }
 
 var (
-	md_MsgSlashingResourceNodeResponse protoreflect.MessageDescriptor
+	md_MsgUpdateParams           protoreflect.MessageDescriptor
+	fd_MsgUpdateParams_authority protoreflect.FieldDescriptor
+	fd_MsgUpdateParams_params    protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_stratos_pot_v1_tx_proto_init()
-	md_MsgSlashingResourceNodeResponse = File_stratos_pot_v1_tx_proto.Messages().ByName("MsgSlashingResourceNodeResponse")
+	md_MsgUpdateParams = File_stratos_pot_v1_tx_proto.Messages().ByName("MsgUpdateParams")
+	fd_MsgUpdateParams_authority = md_MsgUpdateParams.Fields().ByName("authority")
+	fd_MsgUpdateParams_params = md_MsgUpdateParams.Fields().ByName("params")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgSlashingResourceNodeResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgUpdateParams)(nil)
 
-type fastReflection_MsgSlashingResourceNodeResponse MsgSlashingResourceNodeResponse
+type fastReflection_MsgUpdateParams MsgUpdateParams
 
-func (x *MsgSlashingResourceNodeResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgSlashingResourceNodeResponse)(x)
+func (x *MsgUpdateParams) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUpdateParams)(x)
 }
 
-func (x *MsgSlashingResourceNodeResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_stratos_pot_v1_tx_proto_msgTypes[7]
+func (x *MsgUpdateParams) slowProtoReflect() protoreflect.Message {
+	mi := &file_stratos_pot_v1_tx_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3973,43 +10965,43 @@ func (x *MsgSlashingResourceNodeResponse) slowProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgSlashingResourceNodeResponse_messageType fastReflection_MsgSlashingResourceNodeResponse_messageType
-var _ protoreflect.MessageType = fastReflection_MsgSlashingResourceNodeResponse_messageType{}
+var _fastReflection_MsgUpdateParams_messageType fastReflection_MsgUpdateParams_messageType
+var _ protoreflect.MessageType = fastReflection_MsgUpdateParams_messageType{}
 
-type fastReflection_MsgSlashingResourceNodeResponse_messageType struct{}
+type fastReflection_MsgUpdateParams_messageType struct{}
 
-func (x fastReflection_MsgSlashingResourceNodeResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgSlashingResourceNodeResponse)(nil)
+func (x fastReflection_MsgUpdateParams_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgUpdateParams)(nil)
 }
-func (x fastReflection_MsgSlashingResourceNodeResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgSlashingResourceNodeResponse)
+func (x fastReflection_MsgUpdateParams_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateParams)
 }
-func (x fastReflection_MsgSlashingResourceNodeResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgSlashingResourceNodeResponse
+func (x fastReflection_MsgUpdateParams_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateParams
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgSlashingResourceNodeResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgSlashingResourceNodeResponse
+func (x *fastReflection_MsgUpdateParams) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateParams
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgSlashingResourceNodeResponse) Type() protoreflect.MessageType {
-	return _fastReflection_MsgSlashingResourceNodeResponse_messageType
+func (x *fastReflection_MsgUpdateParams) Type() protoreflect.MessageType {
+	return _fastReflection_MsgUpdateParams_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgSlashingResourceNodeResponse) New() protoreflect.Message {
-	return new(fastReflection_MsgSlashingResourceNodeResponse)
+func (x *fastReflection_MsgUpdateParams) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateParams)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgSlashingResourceNodeResponse) Interface() protoreflect.ProtoMessage {
-	return (*MsgSlashingResourceNodeResponse)(x)
+func (x *fastReflection_MsgUpdateParams) Interface() protoreflect.ProtoMessage {
+	return (*MsgUpdateParams)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -4017,7 +11009,19 @@ func (x *fastReflection_MsgSlashingResourceNodeResponse) Interface() protoreflec
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgSlashingResourceNodeResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgUpdateParams) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Authority != "" {
+		value := protoreflect.ValueOfString(x.Authority)
+		if !f(fd_MsgUpdateParams_authority, value) {
+			return
+		}
+	}
+	if x.Params != nil {
+		value := protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+		if !f(fd_MsgUpdateParams_params, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -4031,13 +11035,17 @@ func (x *fastReflection_MsgSlashingResourceNodeResponse) Range(f func(protorefle
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgSlashingResourceNodeResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgUpdateParams) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
+	case "stratos.pot.v1.MsgUpdateParams.authority":
+		return x.Authority != ""
+	case "stratos.pot.v1.MsgUpdateParams.params":
+		return x.Params != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgSlashingResourceNodeResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgUpdateParams"))
 		}
-		panic(fmt.Errorf("message stratos.pot.v1.MsgSlashingResourceNodeResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message stratos.pot.v1.MsgUpdateParams does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4047,13 +11055,17 @@ func (x *fastReflection_MsgSlashingResourceNodeResponse) Has(fd protoreflect.Fie
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSlashingResourceNodeResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgUpdateParams) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
+	case "stratos.pot.v1.MsgUpdateParams.authority":
+		x.Authority = ""
+	case "stratos.pot.v1.MsgUpdateParams.params":
+		x.Params = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgSlashingResourceNodeResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgUpdateParams"))
 		}
-		panic(fmt.Errorf("message stratos.pot.v1.MsgSlashingResourceNodeResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message stratos.pot.v1.MsgUpdateParams does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4063,13 +11075,19 @@ func (x *fastReflection_MsgSlashingResourceNodeResponse) Clear(fd protoreflect.F
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgSlashingResourceNodeResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateParams) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
+	case "stratos.pot.v1.MsgUpdateParams.authority":
+		value := x.Authority
+		return protoreflect.ValueOfString(value)
+	case "stratos.pot.v1.MsgUpdateParams.params":
+		value := x.Params
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgSlashingResourceNodeResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgUpdateParams"))
 		}
-		panic(fmt.Errorf("message stratos.pot.v1.MsgSlashingResourceNodeResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message stratos.pot.v1.MsgUpdateParams does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4083,13 +11101,17 @@ func (x *fastReflection_MsgSlashingResourceNodeResponse) Get(descriptor protoref
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSlashingResourceNodeResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgUpdateParams) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
+	case "stratos.pot.v1.MsgUpdateParams.authority":
+		x.Authority = value.Interface().(string)
+	case "stratos.pot.v1.MsgUpdateParams.params":
+		x.Params = value.Message().Interface().(*Params)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgSlashingResourceNodeResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgUpdateParams"))
 		}
-		panic(fmt.Errorf("message stratos.pot.v1.MsgSlashingResourceNodeResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message stratos.pot.v1.MsgUpdateParams does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4103,36 +11125,48 @@ func (x *fastReflection_MsgSlashingResourceNodeResponse) Set(fd protoreflect.Fie
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSlashingResourceNodeResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateParams) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "stratos.pot.v1.MsgUpdateParams.params":
+		if x.Params == nil {
+			x.Params = new(Params)
+		}
+		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+	case "stratos.pot.v1.MsgUpdateParams.authority":
+		panic(fmt.Errorf("field authority of message stratos.pot.v1.MsgUpdateParams is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgSlashingResourceNodeResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgUpdateParams"))
 		}
-		panic(fmt.Errorf("message stratos.pot.v1.MsgSlashingResourceNodeResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message stratos.pot.v1.MsgUpdateParams does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgSlashingResourceNodeResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateParams) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "stratos.pot.v1.MsgUpdateParams.authority":
+		return protoreflect.ValueOfString("")
+	case "stratos.pot.v1.MsgUpdateParams.params":
+		m := new(Params)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgSlashingResourceNodeResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgUpdateParams"))
 		}
-		panic(fmt.Errorf("message stratos.pot.v1.MsgSlashingResourceNodeResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message stratos.pot.v1.MsgUpdateParams does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgSlashingResourceNodeResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgUpdateParams) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in stratos.pot.v1.MsgSlashingResourceNodeResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in stratos.pot.v1.MsgUpdateParams", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4140,7 +11174,7 @@ func (x *fastReflection_MsgSlashingResourceNodeResponse) WhichOneof(d protorefle
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgSlashingResourceNodeResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgUpdateParams) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4151,7 +11185,7 @@ func (x *fastReflection_MsgSlashingResourceNodeResponse) GetUnknown() protorefle
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSlashingResourceNodeResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgUpdateParams) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4163,7 +11197,7 @@ func (x *fastReflection_MsgSlashingResourceNodeResponse) SetUnknown(fields proto
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgSlashingResourceNodeResponse) IsValid() bool {
+func (x *fastReflection_MsgUpdateParams) IsValid() bool {
 	return x != nil
 }
 
@@ -4173,9 +11207,9 @@ func (x *fastReflection_MsgSlashingResourceNodeResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgSlashingResourceNodeResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgUpdateParams) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgSlashingResourceNodeResponse)
+		x := input.Message.Interface().(*MsgUpdateParams)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4187,6 +11221,14 @@ func (x *fastReflection_MsgSlashingResourceNodeResponse) ProtoMethods() *protoif
 		var n int
 		var l int
 		_ = l
+		l = len(x.Authority)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Params != nil {
+			l = options.Size(x.Params)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -4197,7 +11239,7 @@ func (x *fastReflection_MsgSlashingResourceNodeResponse) ProtoMethods() *protoif
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgSlashingResourceNodeResponse)
+		x := input.Message.Interface().(*MsgUpdateParams)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4216,6 +11258,27 @@ func (x *fastReflection_MsgSlashingResourceNodeResponse) ProtoMethods() *protoif
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.Params != nil {
+			encoded, err := options.Marshal(x.Params)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Authority) > 0 {
+			i -= len(x.Authority)
+			copy(dAtA[i:], x.Authority)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Authority)))
+			i--
+			dAtA[i] = 0xa
+		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -4227,7 +11290,7 @@ func (x *fastReflection_MsgSlashingResourceNodeResponse) ProtoMethods() *protoif
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgSlashingResourceNodeResponse)
+		x := input.Message.Interface().(*MsgUpdateParams)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4259,12 +11322,80 @@ func (x *fastReflection_MsgSlashingResourceNodeResponse) ProtoMethods() *protoif
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSlashingResourceNodeResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSlashingResourceNodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Authority = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Params == nil {
+					x.Params = &Params{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Params); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -4300,77 +11431,25 @@ func (x *fastReflection_MsgSlashingResourceNodeResponse) ProtoMethods() *protoif
 	}
 }
 
-var _ protoreflect.List = (*_BLSSignatureInfo_1_list)(nil)
-
-type _BLSSignatureInfo_1_list struct {
-	list *[][]byte
-}
-
-func (x *_BLSSignatureInfo_1_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_BLSSignatureInfo_1_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfBytes((*x.list)[i])
-}
-
-func (x *_BLSSignatureInfo_1_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Bytes()
-	concreteValue := valueUnwrapped
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_BLSSignatureInfo_1_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Bytes()
-	concreteValue := valueUnwrapped
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_BLSSignatureInfo_1_list) AppendMutable() protoreflect.Value {
-	panic(fmt.Errorf("AppendMutable can not be called on message BLSSignatureInfo at list field PubKeys as it is not of Message kind"))
-}
-
-func (x *_BLSSignatureInfo_1_list) Truncate(n int) {
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_BLSSignatureInfo_1_list) NewElement() protoreflect.Value {
-	var v []byte
-	return protoreflect.ValueOfBytes(v)
-}
-
-func (x *_BLSSignatureInfo_1_list) IsValid() bool {
-	return x.list != nil
-}
-
 var (
-	md_BLSSignatureInfo           protoreflect.MessageDescriptor
-	fd_BLSSignatureInfo_pub_keys  protoreflect.FieldDescriptor
-	fd_BLSSignatureInfo_signature protoreflect.FieldDescriptor
-	fd_BLSSignatureInfo_txData    protoreflect.FieldDescriptor
+	md_MsgUpdateParamsResponse protoreflect.MessageDescriptor
 )
 
 func init() {
 	file_stratos_pot_v1_tx_proto_init()
-	md_BLSSignatureInfo = File_stratos_pot_v1_tx_proto.Messages().ByName("BLSSignatureInfo")
-	fd_BLSSignatureInfo_pub_keys = md_BLSSignatureInfo.Fields().ByName("pub_keys")
-	fd_BLSSignatureInfo_signature = md_BLSSignatureInfo.Fields().ByName("signature")
-	fd_BLSSignatureInfo_txData = md_BLSSignatureInfo.Fields().ByName("txData")
+	md_MsgUpdateParamsResponse = File_stratos_pot_v1_tx_proto.Messages().ByName("MsgUpdateParamsResponse")
 }
 
-var _ protoreflect.Message = (*fastReflection_BLSSignatureInfo)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgUpdateParamsResponse)(nil)
 
-type fastReflection_BLSSignatureInfo BLSSignatureInfo
+type fastReflection_MsgUpdateParamsResponse MsgUpdateParamsResponse
 
-func (x *BLSSignatureInfo) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_BLSSignatureInfo)(x)
+func (x *MsgUpdateParamsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUpdateParamsResponse)(x)
 }
 
-func (x *BLSSignatureInfo) slowProtoReflect() protoreflect.Message {
-	mi := &file_stratos_pot_v1_tx_proto_msgTypes[8]
+func (x *MsgUpdateParamsResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_stratos_pot_v1_tx_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4381,43 +11460,43 @@ func (x *BLSSignatureInfo) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_BLSSignatureInfo_messageType fastReflection_BLSSignatureInfo_messageType
-var _ protoreflect.MessageType = fastReflection_BLSSignatureInfo_messageType{}
+var _fastReflection_MsgUpdateParamsResponse_messageType fastReflection_MsgUpdateParamsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgUpdateParamsResponse_messageType{}
 
-type fastReflection_BLSSignatureInfo_messageType struct{}
+type fastReflection_MsgUpdateParamsResponse_messageType struct{}
 
-func (x fastReflection_BLSSignatureInfo_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_BLSSignatureInfo)(nil)
+func (x fastReflection_MsgUpdateParamsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgUpdateParamsResponse)(nil)
 }
-func (x fastReflection_BLSSignatureInfo_messageType) New() protoreflect.Message {
-	return new(fastReflection_BLSSignatureInfo)
+func (x fastReflection_MsgUpdateParamsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateParamsResponse)
 }
-func (x fastReflection_BLSSignatureInfo_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_BLSSignatureInfo
+func (x fastReflection_MsgUpdateParamsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateParamsResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_BLSSignatureInfo) Descriptor() protoreflect.MessageDescriptor {
-	return md_BLSSignatureInfo
+func (x *fastReflection_MsgUpdateParamsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateParamsResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_BLSSignatureInfo) Type() protoreflect.MessageType {
-	return _fastReflection_BLSSignatureInfo_messageType
+func (x *fastReflection_MsgUpdateParamsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgUpdateParamsResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_BLSSignatureInfo) New() protoreflect.Message {
-	return new(fastReflection_BLSSignatureInfo)
+func (x *fastReflection_MsgUpdateParamsResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateParamsResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_BLSSignatureInfo) Interface() protoreflect.ProtoMessage {
-	return (*BLSSignatureInfo)(x)
+func (x *fastReflection_MsgUpdateParamsResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgUpdateParamsResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -4425,25 +11504,7 @@ func (x *fastReflection_BLSSignatureInfo) Interface() protoreflect.ProtoMessage
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_BLSSignatureInfo) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.PubKeys) != 0 {
-		value := protoreflect.ValueOfList(&_BLSSignatureInfo_1_list{list: &x.PubKeys})
-		if !f(fd_BLSSignatureInfo_pub_keys, value) {
-			return
-		}
-	}
-	if len(x.Signature) != 0 {
-		value := protoreflect.ValueOfBytes(x.Signature)
-		if !f(fd_BLSSignatureInfo_signature, value) {
-			return
-		}
-	}
-	if len(x.TxData) != 0 {
-		value := protoreflect.ValueOfBytes(x.TxData)
-		if !f(fd_BLSSignatureInfo_txData, value) {
-			return
-		}
-	}
+func (x *fastReflection_MsgUpdateParamsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 }
 
 // Has reports whether a field is populated.
@@ -4457,19 +11518,13 @@ func (x *fastReflection_BLSSignatureInfo) Range(f func(protoreflect.FieldDescrip
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_BLSSignatureInfo) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgUpdateParamsResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "stratos.pot.v1.BLSSignatureInfo.pub_keys":
-		return len(x.PubKeys) != 0
-	case "stratos.pot.v1.BLSSignatureInfo.signature":
-		return len(x.Signature) != 0
-	case "stratos.pot.v1.BLSSignatureInfo.txData":
-		return len(x.TxData) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.BLSSignatureInfo"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgUpdateParamsResponse"))
 		}
-		panic(fmt.Errorf("message stratos.pot.v1.BLSSignatureInfo does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message stratos.pot.v1.MsgUpdateParamsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4479,19 +11534,13 @@ func (x *fastReflection_BLSSignatureInfo) Has(fd protoreflect.FieldDescriptor) b
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_BLSSignatureInfo) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgUpdateParamsResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "stratos.pot.v1.BLSSignatureInfo.pub_keys":
-		x.PubKeys = nil
-	case "stratos.pot.v1.BLSSignatureInfo.signature":
-		x.Signature = nil
-	case "stratos.pot.v1.BLSSignatureInfo.txData":
-		x.TxData = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.BLSSignatureInfo"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgUpdateParamsResponse"))
 		}
-		panic(fmt.Errorf("message stratos.pot.v1.BLSSignatureInfo does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message stratos.pot.v1.MsgUpdateParamsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4501,25 +11550,13 @@ func (x *fastReflection_BLSSignatureInfo) Clear(fd protoreflect.FieldDescriptor)
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_BLSSignatureInfo) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateParamsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "stratos.pot.v1.BLSSignatureInfo.pub_keys":
-		if len(x.PubKeys) == 0 {
-			return protoreflect.ValueOfList(&_BLSSignatureInfo_1_list{})
-		}
-		listValue := &_BLSSignatureInfo_1_list{list: &x.PubKeys}
-		return protoreflect.ValueOfList(listValue)
-	case "stratos.pot.v1.BLSSignatureInfo.signature":
-		value := x.Signature
-		return protoreflect.ValueOfBytes(value)
-	case "stratos.pot.v1.BLSSignatureInfo.txData":
-		value := x.TxData
-		return protoreflect.ValueOfBytes(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.BLSSignatureInfo"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgUpdateParamsResponse"))
 		}
-		panic(fmt.Errorf("message stratos.pot.v1.BLSSignatureInfo does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message stratos.pot.v1.MsgUpdateParamsResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4533,21 +11570,13 @@ func (x *fastReflection_BLSSignatureInfo) Get(descriptor protoreflect.FieldDescr
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_BLSSignatureInfo) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgUpdateParamsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "stratos.pot.v1.BLSSignatureInfo.pub_keys":
-		lv := value.List()
-		clv := lv.(*_BLSSignatureInfo_1_list)
-		x.PubKeys = *clv.list
-	case "stratos.pot.v1.BLSSignatureInfo.signature":
-		x.Signature = value.Bytes()
-	case "stratos.pot.v1.BLSSignatureInfo.txData":
-		x.TxData = value.Bytes()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.BLSSignatureInfo"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgUpdateParamsResponse"))
 		}
-		panic(fmt.Errorf("message stratos.pot.v1.BLSSignatureInfo does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message stratos.pot.v1.MsgUpdateParamsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4561,53 +11590,36 @@ func (x *fastReflection_BLSSignatureInfo) Set(fd protoreflect.FieldDescriptor, v
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_BLSSignatureInfo) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateParamsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "stratos.pot.v1.BLSSignatureInfo.pub_keys":
-		if x.PubKeys == nil {
-			x.PubKeys = [][]byte{}
-		}
-		value := &_BLSSignatureInfo_1_list{list: &x.PubKeys}
-		return protoreflect.ValueOfList(value)
-	case "stratos.pot.v1.BLSSignatureInfo.signature":
-		panic(fmt.Errorf("field signature of message stratos.pot.v1.BLSSignatureInfo is not mutable"))
-	case "stratos.pot.v1.BLSSignatureInfo.txData":
-		panic(fmt.Errorf("field txData of message stratos.pot.v1.BLSSignatureInfo is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.BLSSignatureInfo"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgUpdateParamsResponse"))
 		}
-		panic(fmt.Errorf("message stratos.pot.v1.BLSSignatureInfo does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message stratos.pot.v1.MsgUpdateParamsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_BLSSignatureInfo) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateParamsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "stratos.pot.v1.BLSSignatureInfo.pub_keys":
-		list := [][]byte{}
-		return protoreflect.ValueOfList(&_BLSSignatureInfo_1_list{list: &list})
-	case "stratos.pot.v1.BLSSignatureInfo.signature":
-		return protoreflect.ValueOfBytes(nil)
-	case "stratos.pot.v1.BLSSignatureInfo.txData":
-		return protoreflect.ValueOfBytes(nil)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.BLSSignatureInfo"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgUpdateParamsResponse"))
 		}
-		panic(fmt.Errorf("message stratos.pot.v1.BLSSignatureInfo does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message stratos.pot.v1.MsgUpdateParamsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_BLSSignatureInfo) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgUpdateParamsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in stratos.pot.v1.BLSSignatureInfo", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in stratos.pot.v1.MsgUpdateParamsResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4615,7 +11627,7 @@ func (x *fastReflection_BLSSignatureInfo) WhichOneof(d protoreflect.OneofDescrip
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_BLSSignatureInfo) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgUpdateParamsResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4626,7 +11638,7 @@ func (x *fastReflection_BLSSignatureInfo) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_BLSSignatureInfo) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgUpdateParamsResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4638,7 +11650,7 @@ func (x *fastReflection_BLSSignatureInfo) SetUnknown(fields protoreflect.RawFiel
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_BLSSignatureInfo) IsValid() bool {
+func (x *fastReflection_MsgUpdateParamsResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -4648,9 +11660,9 @@ func (x *fastReflection_BLSSignatureInfo) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_BLSSignatureInfo) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgUpdateParamsResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*BLSSignatureInfo)
+		x := input.Message.Interface().(*MsgUpdateParamsResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4662,20 +11674,6 @@ func (x *fastReflection_BLSSignatureInfo) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if len(x.PubKeys) > 0 {
-			for _, b := range x.PubKeys {
-				l = len(b)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		l = len(x.Signature)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.TxData)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -4686,7 +11684,7 @@ func (x *fastReflection_BLSSignatureInfo) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*BLSSignatureInfo)
+		x := input.Message.Interface().(*MsgUpdateParamsResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4705,29 +11703,6 @@ func (x *fastReflection_BLSSignatureInfo) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.TxData) > 0 {
-			i -= len(x.TxData)
-			copy(dAtA[i:], x.TxData)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TxData)))
-			i--
-			dAtA[i] = 0x1a
-		}
-		if len(x.Signature) > 0 {
-			i -= len(x.Signature)
-			copy(dAtA[i:], x.Signature)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Signature)))
-			i--
-			dAtA[i] = 0x12
-		}
-		if len(x.PubKeys) > 0 {
-			for iNdEx := len(x.PubKeys) - 1; iNdEx >= 0; iNdEx-- {
-				i -= len(x.PubKeys[iNdEx])
-				copy(dAtA[i:], x.PubKeys[iNdEx])
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PubKeys[iNdEx])))
-				i--
-				dAtA[i] = 0xa
-			}
-		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -4739,7 +11714,7 @@ func (x *fastReflection_BLSSignatureInfo) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*BLSSignatureInfo)
+		x := input.Message.Interface().(*MsgUpdateParamsResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4759,124 +11734,24 @@ func (x *fastReflection_BLSSignatureInfo) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 				}
 				if iNdEx >= l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				wire |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			fieldNum := int32(wire >> 3)
-			wireType := int(wire & 0x7)
-			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BLSSignatureInfo: wiretype end group for non-group")
-			}
-			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BLSSignatureInfo: illegal tag %d (wire type %d)", fieldNum, wire)
-			}
-			switch fieldNum {
-			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PubKeys", wireType)
-				}
-				var byteLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					byteLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if byteLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + byteLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.PubKeys = append(x.PubKeys, make([]byte, postIndex-iNdEx))
-				copy(x.PubKeys[len(x.PubKeys)-1], dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
-				}
-				var byteLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					byteLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if byteLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + byteLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Signature = append(x.Signature[:0], dAtA[iNdEx:postIndex]...)
-				if x.Signature == nil {
-					x.Signature = []byte{}
-				}
-				iNdEx = postIndex
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TxData", wireType)
-				}
-				var byteLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					byteLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if byteLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + byteLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.TxData = append(x.TxData[:0], dAtA[iNdEx:postIndex]...)
-				if x.TxData == nil {
-					x.TxData = []byte{}
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				iNdEx = postIndex
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -4912,29 +11787,84 @@ func (x *fastReflection_BLSSignatureInfo) ProtoMethods() *protoiface.Methods {
 	}
 }
 
+var _ protoreflect.List = (*_MsgResolveVolumeReportDispute_4_list)(nil)
+
+type _MsgResolveVolumeReportDispute_4_list struct {
+	list *[]*SingleWalletVolume
+}
+
+func (x *_MsgResolveVolumeReportDispute_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgResolveVolumeReportDispute_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MsgResolveVolumeReportDispute_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SingleWalletVolume)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgResolveVolumeReportDispute_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SingleWalletVolume)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgResolveVolumeReportDispute_4_list) AppendMutable() protoreflect.Value {
+	v := new(SingleWalletVolume)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgResolveVolumeReportDispute_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgResolveVolumeReportDispute_4_list) NewElement() protoreflect.Value {
+	v := new(SingleWalletVolume)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgResolveVolumeReportDispute_4_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_MsgUpdateParams           protoreflect.MessageDescriptor
-	fd_MsgUpdateParams_authority protoreflect.FieldDescriptor
-	fd_MsgUpdateParams_params    protoreflect.FieldDescriptor
+	md_MsgResolveVolumeReportDispute                protoreflect.MessageDescriptor
+	fd_MsgResolveVolumeReportDispute_authority      protoreflect.FieldDescriptor
+	fd_MsgResolveVolumeReportDispute_epoch          protoreflect.FieldDescriptor
+	fd_MsgResolveVolumeReportDispute_cancel         protoreflect.FieldDescriptor
+	fd_MsgResolveVolumeReportDispute_wallet_volumes protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_stratos_pot_v1_tx_proto_init()
-	md_MsgUpdateParams = File_stratos_pot_v1_tx_proto.Messages().ByName("MsgUpdateParams")
-	fd_MsgUpdateParams_authority = md_MsgUpdateParams.Fields().ByName("authority")
-	fd_MsgUpdateParams_params = md_MsgUpdateParams.Fields().ByName("params")
+	md_MsgResolveVolumeReportDispute = File_stratos_pot_v1_tx_proto.Messages().ByName("MsgResolveVolumeReportDispute")
+	fd_MsgResolveVolumeReportDispute_authority = md_MsgResolveVolumeReportDispute.Fields().ByName("authority")
+	fd_MsgResolveVolumeReportDispute_epoch = md_MsgResolveVolumeReportDispute.Fields().ByName("epoch")
+	fd_MsgResolveVolumeReportDispute_cancel = md_MsgResolveVolumeReportDispute.Fields().ByName("cancel")
+	fd_MsgResolveVolumeReportDispute_wallet_volumes = md_MsgResolveVolumeReportDispute.Fields().ByName("wallet_volumes")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgUpdateParams)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgResolveVolumeReportDispute)(nil)
 
-type fastReflection_MsgUpdateParams MsgUpdateParams
+type fastReflection_MsgResolveVolumeReportDispute MsgResolveVolumeReportDispute
 
-func (x *MsgUpdateParams) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgUpdateParams)(x)
+func (x *MsgResolveVolumeReportDispute) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgResolveVolumeReportDispute)(x)
 }
 
-func (x *MsgUpdateParams) slowProtoReflect() protoreflect.Message {
-	mi := &file_stratos_pot_v1_tx_proto_msgTypes[9]
+func (x *MsgResolveVolumeReportDispute) slowProtoReflect() protoreflect.Message {
+	mi := &file_stratos_pot_v1_tx_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4945,43 +11875,43 @@ func (x *MsgUpdateParams) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgUpdateParams_messageType fastReflection_MsgUpdateParams_messageType
-var _ protoreflect.MessageType = fastReflection_MsgUpdateParams_messageType{}
+var _fastReflection_MsgResolveVolumeReportDispute_messageType fastReflection_MsgResolveVolumeReportDispute_messageType
+var _ protoreflect.MessageType = fastReflection_MsgResolveVolumeReportDispute_messageType{}
 
-type fastReflection_MsgUpdateParams_messageType struct{}
+type fastReflection_MsgResolveVolumeReportDispute_messageType struct{}
 
-func (x fastReflection_MsgUpdateParams_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgUpdateParams)(nil)
+func (x fastReflection_MsgResolveVolumeReportDispute_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgResolveVolumeReportDispute)(nil)
 }
-func (x fastReflection_MsgUpdateParams_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgUpdateParams)
+func (x fastReflection_MsgResolveVolumeReportDispute_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgResolveVolumeReportDispute)
 }
-func (x fastReflection_MsgUpdateParams_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgUpdateParams
+func (x fastReflection_MsgResolveVolumeReportDispute_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgResolveVolumeReportDispute
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgUpdateParams) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgUpdateParams
+func (x *fastReflection_MsgResolveVolumeReportDispute) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgResolveVolumeReportDispute
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgUpdateParams) Type() protoreflect.MessageType {
-	return _fastReflection_MsgUpdateParams_messageType
+func (x *fastReflection_MsgResolveVolumeReportDispute) Type() protoreflect.MessageType {
+	return _fastReflection_MsgResolveVolumeReportDispute_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgUpdateParams) New() protoreflect.Message {
-	return new(fastReflection_MsgUpdateParams)
+func (x *fastReflection_MsgResolveVolumeReportDispute) New() protoreflect.Message {
+	return new(fastReflection_MsgResolveVolumeReportDispute)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgUpdateParams) Interface() protoreflect.ProtoMessage {
-	return (*MsgUpdateParams)(x)
+func (x *fastReflection_MsgResolveVolumeReportDispute) Interface() protoreflect.ProtoMessage {
+	return (*MsgResolveVolumeReportDispute)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -4989,16 +11919,28 @@ func (x *fastReflection_MsgUpdateParams) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgUpdateParams) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgResolveVolumeReportDispute) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.Authority != "" {
 		value := protoreflect.ValueOfString(x.Authority)
-		if !f(fd_MsgUpdateParams_authority, value) {
+		if !f(fd_MsgResolveVolumeReportDispute_authority, value) {
 			return
 		}
 	}
-	if x.Params != nil {
-		value := protoreflect.ValueOfMessage(x.Params.ProtoReflect())
-		if !f(fd_MsgUpdateParams_params, value) {
+	if x.Epoch != "" {
+		value := protoreflect.ValueOfString(x.Epoch)
+		if !f(fd_MsgResolveVolumeReportDispute_epoch, value) {
+			return
+		}
+	}
+	if x.Cancel != false {
+		value := protoreflect.ValueOfBool(x.Cancel)
+		if !f(fd_MsgResolveVolumeReportDispute_cancel, value) {
+			return
+		}
+	}
+	if len(x.WalletVolumes) != 0 {
+		value := protoreflect.ValueOfList(&_MsgResolveVolumeReportDispute_4_list{list: &x.WalletVolumes})
+		if !f(fd_MsgResolveVolumeReportDispute_wallet_volumes, value) {
 			return
 		}
 	}
@@ -5015,17 +11957,21 @@ func (x *fastReflection_MsgUpdateParams) Range(f func(protoreflect.FieldDescript
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgUpdateParams) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgResolveVolumeReportDispute) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "stratos.pot.v1.MsgUpdateParams.authority":
+	case "stratos.pot.v1.MsgResolveVolumeReportDispute.authority":
 		return x.Authority != ""
-	case "stratos.pot.v1.MsgUpdateParams.params":
-		return x.Params != nil
+	case "stratos.pot.v1.MsgResolveVolumeReportDispute.epoch":
+		return x.Epoch != ""
+	case "stratos.pot.v1.MsgResolveVolumeReportDispute.cancel":
+		return x.Cancel != false
+	case "stratos.pot.v1.MsgResolveVolumeReportDispute.wallet_volumes":
+		return len(x.WalletVolumes) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgUpdateParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgResolveVolumeReportDispute"))
 		}
-		panic(fmt.Errorf("message stratos.pot.v1.MsgUpdateParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message stratos.pot.v1.MsgResolveVolumeReportDispute does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5035,17 +11981,21 @@ func (x *fastReflection_MsgUpdateParams) Has(fd protoreflect.FieldDescriptor) bo
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateParams) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgResolveVolumeReportDispute) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "stratos.pot.v1.MsgUpdateParams.authority":
+	case "stratos.pot.v1.MsgResolveVolumeReportDispute.authority":
 		x.Authority = ""
-	case "stratos.pot.v1.MsgUpdateParams.params":
-		x.Params = nil
+	case "stratos.pot.v1.MsgResolveVolumeReportDispute.epoch":
+		x.Epoch = ""
+	case "stratos.pot.v1.MsgResolveVolumeReportDispute.cancel":
+		x.Cancel = false
+	case "stratos.pot.v1.MsgResolveVolumeReportDispute.wallet_volumes":
+		x.WalletVolumes = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgUpdateParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgResolveVolumeReportDispute"))
 		}
-		panic(fmt.Errorf("message stratos.pot.v1.MsgUpdateParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message stratos.pot.v1.MsgResolveVolumeReportDispute does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5055,19 +12005,28 @@ func (x *fastReflection_MsgUpdateParams) Clear(fd protoreflect.FieldDescriptor)
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgUpdateParams) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgResolveVolumeReportDispute) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "stratos.pot.v1.MsgUpdateParams.authority":
+	case "stratos.pot.v1.MsgResolveVolumeReportDispute.authority":
 		value := x.Authority
 		return protoreflect.ValueOfString(value)
-	case "stratos.pot.v1.MsgUpdateParams.params":
-		value := x.Params
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "stratos.pot.v1.MsgResolveVolumeReportDispute.epoch":
+		value := x.Epoch
+		return protoreflect.ValueOfString(value)
+	case "stratos.pot.v1.MsgResolveVolumeReportDispute.cancel":
+		value := x.Cancel
+		return protoreflect.ValueOfBool(value)
+	case "stratos.pot.v1.MsgResolveVolumeReportDispute.wallet_volumes":
+		if len(x.WalletVolumes) == 0 {
+			return protoreflect.ValueOfList(&_MsgResolveVolumeReportDispute_4_list{})
+		}
+		listValue := &_MsgResolveVolumeReportDispute_4_list{list: &x.WalletVolumes}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgUpdateParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgResolveVolumeReportDispute"))
 		}
-		panic(fmt.Errorf("message stratos.pot.v1.MsgUpdateParams does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message stratos.pot.v1.MsgResolveVolumeReportDispute does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -5081,17 +12040,23 @@ func (x *fastReflection_MsgUpdateParams) Get(descriptor protoreflect.FieldDescri
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateParams) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgResolveVolumeReportDispute) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "stratos.pot.v1.MsgUpdateParams.authority":
+	case "stratos.pot.v1.MsgResolveVolumeReportDispute.authority":
 		x.Authority = value.Interface().(string)
-	case "stratos.pot.v1.MsgUpdateParams.params":
-		x.Params = value.Message().Interface().(*Params)
+	case "stratos.pot.v1.MsgResolveVolumeReportDispute.epoch":
+		x.Epoch = value.Interface().(string)
+	case "stratos.pot.v1.MsgResolveVolumeReportDispute.cancel":
+		x.Cancel = value.Bool()
+	case "stratos.pot.v1.MsgResolveVolumeReportDispute.wallet_volumes":
+		lv := value.List()
+		clv := lv.(*_MsgResolveVolumeReportDispute_4_list)
+		x.WalletVolumes = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgUpdateParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgResolveVolumeReportDispute"))
 		}
-		panic(fmt.Errorf("message stratos.pot.v1.MsgUpdateParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message stratos.pot.v1.MsgResolveVolumeReportDispute does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5105,48 +12070,57 @@ func (x *fastReflection_MsgUpdateParams) Set(fd protoreflect.FieldDescriptor, va
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateParams) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgResolveVolumeReportDispute) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "stratos.pot.v1.MsgUpdateParams.params":
-		if x.Params == nil {
-			x.Params = new(Params)
+	case "stratos.pot.v1.MsgResolveVolumeReportDispute.wallet_volumes":
+		if x.WalletVolumes == nil {
+			x.WalletVolumes = []*SingleWalletVolume{}
 		}
-		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
-	case "stratos.pot.v1.MsgUpdateParams.authority":
-		panic(fmt.Errorf("field authority of message stratos.pot.v1.MsgUpdateParams is not mutable"))
+		value := &_MsgResolveVolumeReportDispute_4_list{list: &x.WalletVolumes}
+		return protoreflect.ValueOfList(value)
+	case "stratos.pot.v1.MsgResolveVolumeReportDispute.authority":
+		panic(fmt.Errorf("field authority of message stratos.pot.v1.MsgResolveVolumeReportDispute is not mutable"))
+	case "stratos.pot.v1.MsgResolveVolumeReportDispute.epoch":
+		panic(fmt.Errorf("field epoch of message stratos.pot.v1.MsgResolveVolumeReportDispute is not mutable"))
+	case "stratos.pot.v1.MsgResolveVolumeReportDispute.cancel":
+		panic(fmt.Errorf("field cancel of message stratos.pot.v1.MsgResolveVolumeReportDispute is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgUpdateParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgResolveVolumeReportDispute"))
 		}
-		panic(fmt.Errorf("message stratos.pot.v1.MsgUpdateParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message stratos.pot.v1.MsgResolveVolumeReportDispute does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgUpdateParams) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgResolveVolumeReportDispute) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "stratos.pot.v1.MsgUpdateParams.authority":
+	case "stratos.pot.v1.MsgResolveVolumeReportDispute.authority":
 		return protoreflect.ValueOfString("")
-	case "stratos.pot.v1.MsgUpdateParams.params":
-		m := new(Params)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "stratos.pot.v1.MsgResolveVolumeReportDispute.epoch":
+		return protoreflect.ValueOfString("")
+	case "stratos.pot.v1.MsgResolveVolumeReportDispute.cancel":
+		return protoreflect.ValueOfBool(false)
+	case "stratos.pot.v1.MsgResolveVolumeReportDispute.wallet_volumes":
+		list := []*SingleWalletVolume{}
+		return protoreflect.ValueOfList(&_MsgResolveVolumeReportDispute_4_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgUpdateParams"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgResolveVolumeReportDispute"))
 		}
-		panic(fmt.Errorf("message stratos.pot.v1.MsgUpdateParams does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message stratos.pot.v1.MsgResolveVolumeReportDispute does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgUpdateParams) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgResolveVolumeReportDispute) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in stratos.pot.v1.MsgUpdateParams", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in stratos.pot.v1.MsgResolveVolumeReportDispute", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -5154,7 +12128,7 @@ func (x *fastReflection_MsgUpdateParams) WhichOneof(d protoreflect.OneofDescript
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgUpdateParams) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgResolveVolumeReportDispute) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -5165,7 +12139,7 @@ func (x *fastReflection_MsgUpdateParams) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateParams) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgResolveVolumeReportDispute) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -5177,7 +12151,7 @@ func (x *fastReflection_MsgUpdateParams) SetUnknown(fields protoreflect.RawField
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgUpdateParams) IsValid() bool {
+func (x *fastReflection_MsgResolveVolumeReportDispute) IsValid() bool {
 	return x != nil
 }
 
@@ -5187,9 +12161,9 @@ func (x *fastReflection_MsgUpdateParams) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgUpdateParams) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgResolveVolumeReportDispute) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgUpdateParams)
+		x := input.Message.Interface().(*MsgResolveVolumeReportDispute)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5205,10 +12179,19 @@ func (x *fastReflection_MsgUpdateParams) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.Params != nil {
-			l = options.Size(x.Params)
+		l = len(x.Epoch)
+		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.Cancel {
+			n += 2
+		}
+		if len(x.WalletVolumes) > 0 {
+			for _, e := range x.WalletVolumes {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -5219,7 +12202,7 @@ func (x *fastReflection_MsgUpdateParams) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgUpdateParams)
+		x := input.Message.Interface().(*MsgResolveVolumeReportDispute)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5238,17 +12221,36 @@ func (x *fastReflection_MsgUpdateParams) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Params != nil {
-			encoded, err := options.Marshal(x.Params)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
+		if len(x.WalletVolumes) > 0 {
+			for iNdEx := len(x.WalletVolumes) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.WalletVolumes[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
 			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		}
+		if x.Cancel {
+			i--
+			if x.Cancel {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Epoch) > 0 {
+			i -= len(x.Epoch)
+			copy(dAtA[i:], x.Epoch)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Epoch)))
 			i--
 			dAtA[i] = 0x12
 		}
@@ -5270,7 +12272,7 @@ func (x *fastReflection_MsgUpdateParams) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgUpdateParams)
+		x := input.Message.Interface().(*MsgResolveVolumeReportDispute)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5298,19 +12300,51 @@ func (x *fastReflection_MsgUpdateParams) ProtoMethods() *protoiface.Methods {
 				if b < 0x80 {
 					break
 				}
-			}
-			fieldNum := int32(wire >> 3)
-			wireType := int(wire & 0x7)
-			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
-			}
-			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
-			}
-			switch fieldNum {
-			case 1:
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgResolveVolumeReportDispute: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgResolveVolumeReportDispute: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Authority = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -5338,11 +12372,31 @@ func (x *fastReflection_MsgUpdateParams) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Authority = string(dAtA[iNdEx:postIndex])
+				x.Epoch = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 2:
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Cancel", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Cancel = bool(v != 0)
+			case 4:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WalletVolumes", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -5369,10 +12423,8 @@ func (x *fastReflection_MsgUpdateParams) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Params == nil {
-					x.Params = &Params{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Params); err != nil {
+				x.WalletVolumes = append(x.WalletVolumes, &SingleWalletVolume{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.WalletVolumes[len(x.WalletVolumes)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -5412,24 +12464,24 @@ func (x *fastReflection_MsgUpdateParams) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_MsgUpdateParamsResponse protoreflect.MessageDescriptor
+	md_MsgResolveVolumeReportDisputeResponse protoreflect.MessageDescriptor
 )
 
 func init() {
 	file_stratos_pot_v1_tx_proto_init()
-	md_MsgUpdateParamsResponse = File_stratos_pot_v1_tx_proto.Messages().ByName("MsgUpdateParamsResponse")
+	md_MsgResolveVolumeReportDisputeResponse = File_stratos_pot_v1_tx_proto.Messages().ByName("MsgResolveVolumeReportDisputeResponse")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgUpdateParamsResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgResolveVolumeReportDisputeResponse)(nil)
 
-type fastReflection_MsgUpdateParamsResponse MsgUpdateParamsResponse
+type fastReflection_MsgResolveVolumeReportDisputeResponse MsgResolveVolumeReportDisputeResponse
 
-func (x *MsgUpdateParamsResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgUpdateParamsResponse)(x)
+func (x *MsgResolveVolumeReportDisputeResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgResolveVolumeReportDisputeResponse)(x)
 }
 
-func (x *MsgUpdateParamsResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_stratos_pot_v1_tx_proto_msgTypes[10]
+func (x *MsgResolveVolumeReportDisputeResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_stratos_pot_v1_tx_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5440,43 +12492,43 @@ func (x *MsgUpdateParamsResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgUpdateParamsResponse_messageType fastReflection_MsgUpdateParamsResponse_messageType
-var _ protoreflect.MessageType = fastReflection_MsgUpdateParamsResponse_messageType{}
+var _fastReflection_MsgResolveVolumeReportDisputeResponse_messageType fastReflection_MsgResolveVolumeReportDisputeResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgResolveVolumeReportDisputeResponse_messageType{}
 
-type fastReflection_MsgUpdateParamsResponse_messageType struct{}
+type fastReflection_MsgResolveVolumeReportDisputeResponse_messageType struct{}
 
-func (x fastReflection_MsgUpdateParamsResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgUpdateParamsResponse)(nil)
+func (x fastReflection_MsgResolveVolumeReportDisputeResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgResolveVolumeReportDisputeResponse)(nil)
 }
-func (x fastReflection_MsgUpdateParamsResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgUpdateParamsResponse)
+func (x fastReflection_MsgResolveVolumeReportDisputeResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgResolveVolumeReportDisputeResponse)
 }
-func (x fastReflection_MsgUpdateParamsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgUpdateParamsResponse
+func (x fastReflection_MsgResolveVolumeReportDisputeResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgResolveVolumeReportDisputeResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgUpdateParamsResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgUpdateParamsResponse
+func (x *fastReflection_MsgResolveVolumeReportDisputeResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgResolveVolumeReportDisputeResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgUpdateParamsResponse) Type() protoreflect.MessageType {
-	return _fastReflection_MsgUpdateParamsResponse_messageType
+func (x *fastReflection_MsgResolveVolumeReportDisputeResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgResolveVolumeReportDisputeResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgUpdateParamsResponse) New() protoreflect.Message {
-	return new(fastReflection_MsgUpdateParamsResponse)
+func (x *fastReflection_MsgResolveVolumeReportDisputeResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgResolveVolumeReportDisputeResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgUpdateParamsResponse) Interface() protoreflect.ProtoMessage {
-	return (*MsgUpdateParamsResponse)(x)
+func (x *fastReflection_MsgResolveVolumeReportDisputeResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgResolveVolumeReportDisputeResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -5484,7 +12536,7 @@ func (x *fastReflection_MsgUpdateParamsResponse) Interface() protoreflect.ProtoM
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgUpdateParamsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgResolveVolumeReportDisputeResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 }
 
 // Has reports whether a field is populated.
@@ -5498,13 +12550,13 @@ func (x *fastReflection_MsgUpdateParamsResponse) Range(f func(protoreflect.Field
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgUpdateParamsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgResolveVolumeReportDisputeResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgUpdateParamsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgResolveVolumeReportDisputeResponse"))
 		}
-		panic(fmt.Errorf("message stratos.pot.v1.MsgUpdateParamsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message stratos.pot.v1.MsgResolveVolumeReportDisputeResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5514,13 +12566,13 @@ func (x *fastReflection_MsgUpdateParamsResponse) Has(fd protoreflect.FieldDescri
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateParamsResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgResolveVolumeReportDisputeResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgUpdateParamsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgResolveVolumeReportDisputeResponse"))
 		}
-		panic(fmt.Errorf("message stratos.pot.v1.MsgUpdateParamsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message stratos.pot.v1.MsgResolveVolumeReportDisputeResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5530,13 +12582,13 @@ func (x *fastReflection_MsgUpdateParamsResponse) Clear(fd protoreflect.FieldDesc
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgUpdateParamsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgResolveVolumeReportDisputeResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgUpdateParamsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgResolveVolumeReportDisputeResponse"))
 		}
-		panic(fmt.Errorf("message stratos.pot.v1.MsgUpdateParamsResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message stratos.pot.v1.MsgResolveVolumeReportDisputeResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -5550,13 +12602,13 @@ func (x *fastReflection_MsgUpdateParamsResponse) Get(descriptor protoreflect.Fie
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateParamsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgResolveVolumeReportDisputeResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgUpdateParamsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgResolveVolumeReportDisputeResponse"))
 		}
-		panic(fmt.Errorf("message stratos.pot.v1.MsgUpdateParamsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message stratos.pot.v1.MsgResolveVolumeReportDisputeResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5570,36 +12622,36 @@ func (x *fastReflection_MsgUpdateParamsResponse) Set(fd protoreflect.FieldDescri
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateParamsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgResolveVolumeReportDisputeResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgUpdateParamsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgResolveVolumeReportDisputeResponse"))
 		}
-		panic(fmt.Errorf("message stratos.pot.v1.MsgUpdateParamsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message stratos.pot.v1.MsgResolveVolumeReportDisputeResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgUpdateParamsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgResolveVolumeReportDisputeResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgUpdateParamsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.pot.v1.MsgResolveVolumeReportDisputeResponse"))
 		}
-		panic(fmt.Errorf("message stratos.pot.v1.MsgUpdateParamsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message stratos.pot.v1.MsgResolveVolumeReportDisputeResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgUpdateParamsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgResolveVolumeReportDisputeResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in stratos.pot.v1.MsgUpdateParamsResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in stratos.pot.v1.MsgResolveVolumeReportDisputeResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -5607,7 +12659,7 @@ func (x *fastReflection_MsgUpdateParamsResponse) WhichOneof(d protoreflect.Oneof
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgUpdateParamsResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgResolveVolumeReportDisputeResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -5618,7 +12670,7 @@ func (x *fastReflection_MsgUpdateParamsResponse) GetUnknown() protoreflect.RawFi
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateParamsResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgResolveVolumeReportDisputeResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -5630,7 +12682,7 @@ func (x *fastReflection_MsgUpdateParamsResponse) SetUnknown(fields protoreflect.
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgUpdateParamsResponse) IsValid() bool {
+func (x *fastReflection_MsgResolveVolumeReportDisputeResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -5640,9 +12692,9 @@ func (x *fastReflection_MsgUpdateParamsResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgUpdateParamsResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgResolveVolumeReportDisputeResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgUpdateParamsResponse)
+		x := input.Message.Interface().(*MsgResolveVolumeReportDisputeResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5664,7 +12716,7 @@ func (x *fastReflection_MsgUpdateParamsResponse) ProtoMethods() *protoiface.Meth
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgUpdateParamsResponse)
+		x := input.Message.Interface().(*MsgResolveVolumeReportDisputeResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5694,7 +12746,7 @@ func (x *fastReflection_MsgUpdateParamsResponse) ProtoMethods() *protoiface.Meth
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgUpdateParamsResponse)
+		x := input.Message.Interface().(*MsgResolveVolumeReportDisputeResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5726,10 +12778,10 @@ func (x *fastReflection_MsgUpdateParamsResponse) ProtoMethods() *protoiface.Meth
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgResolveVolumeReportDisputeResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgResolveVolumeReportDisputeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			default:
@@ -5872,15 +12924,243 @@ func (x *MsgVolumeReportResponse) Reset() {
 	}
 }
 
-func (x *MsgVolumeReportResponse) String() string {
+func (x *MsgVolumeReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgVolumeReportResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgVolumeReportResponse.ProtoReflect.Descriptor instead.
+func (*MsgVolumeReportResponse) Descriptor() ([]byte, []int) {
+	return file_stratos_pot_v1_tx_proto_rawDescGZIP(), []int{1}
+}
+
+// MsgVolumeReportHeader starts a volume report submitted in chunks. It is BLS-signed once by the meta nodes
+// and commits to all the wallet volumes of the epoch with their merkle root.
+type MsgVolumeReportHeader struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reporter        string `protobuf:"bytes,1,opt,name=reporter,proto3" json:"reporter,omitempty"`
+	Epoch           string `protobuf:"bytes,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	ReportReference string `protobuf:"bytes,3,opt,name=report_reference,json=reportReference,proto3" json:"report_reference,omitempty"`
+	ReporterOwner   string `protobuf:"bytes,4,opt,name=reporter_owner,json=reporterOwner,proto3" json:"reporter_owner,omitempty"`
+	// total_count is the number of wallet volumes in the report
+	TotalCount uint64 `protobuf:"varint,5,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	// volumes_root is the merkle root of the wallet volumes
+	VolumesRoot  []byte            `protobuf:"bytes,6,opt,name=volumes_root,json=volumesRoot,proto3" json:"volumes_root,omitempty"`
+	BLSSignature *BLSSignatureInfo `protobuf:"bytes,7,opt,name=BLS_signature,json=BLSSignature,proto3" json:"BLS_signature,omitempty"`
+}
+
+func (x *MsgVolumeReportHeader) Reset() {
+	*x = MsgVolumeReportHeader{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stratos_pot_v1_tx_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgVolumeReportHeader) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgVolumeReportHeader) ProtoMessage() {}
+
+// Deprecated: Use MsgVolumeReportHeader.ProtoReflect.Descriptor instead.
+func (*MsgVolumeReportHeader) Descriptor() ([]byte, []int) {
+	return file_stratos_pot_v1_tx_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *MsgVolumeReportHeader) GetReporter() string {
+	if x != nil {
+		return x.Reporter
+	}
+	return ""
+}
+
+func (x *MsgVolumeReportHeader) GetEpoch() string {
+	if x != nil {
+		return x.Epoch
+	}
+	return ""
+}
+
+func (x *MsgVolumeReportHeader) GetReportReference() string {
+	if x != nil {
+		return x.ReportReference
+	}
+	return ""
+}
+
+func (x *MsgVolumeReportHeader) GetReporterOwner() string {
+	if x != nil {
+		return x.ReporterOwner
+	}
+	return ""
+}
+
+func (x *MsgVolumeReportHeader) GetTotalCount() uint64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *MsgVolumeReportHeader) GetVolumesRoot() []byte {
+	if x != nil {
+		return x.VolumesRoot
+	}
+	return nil
+}
+
+func (x *MsgVolumeReportHeader) GetBLSSignature() *BLSSignatureInfo {
+	if x != nil {
+		return x.BLSSignature
+	}
+	return nil
+}
+
+// MsgVolumeReportHeaderResponse defines the MsgVolumeReportHeader response type
+type MsgVolumeReportHeaderResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgVolumeReportHeaderResponse) Reset() {
+	*x = MsgVolumeReportHeaderResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stratos_pot_v1_tx_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgVolumeReportHeaderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgVolumeReportHeaderResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgVolumeReportHeaderResponse.ProtoReflect.Descriptor instead.
+func (*MsgVolumeReportHeaderResponse) Descriptor() ([]byte, []int) {
+	return file_stratos_pot_v1_tx_proto_rawDescGZIP(), []int{3}
+}
+
+// MsgVolumeReportChunk carries a slice of the wallet volumes of a chunked volume report, each with its inclusion proof
+type MsgVolumeReportChunk struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reporter      string `protobuf:"bytes,1,opt,name=reporter,proto3" json:"reporter,omitempty"`
+	ReporterOwner string `protobuf:"bytes,2,opt,name=reporter_owner,json=reporterOwner,proto3" json:"reporter_owner,omitempty"`
+	Epoch         string `protobuf:"bytes,3,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// start_index is the index of the first wallet volume of the chunk in the report
+	StartIndex    uint64                  `protobuf:"varint,4,opt,name=start_index,json=startIndex,proto3" json:"start_index,omitempty"`
+	WalletVolumes []*SingleWalletVolume   `protobuf:"bytes,5,rep,name=wallet_volumes,json=walletVolumes,proto3" json:"wallet_volumes,omitempty"`
+	Proofs        []*VolumeInclusionProof `protobuf:"bytes,6,rep,name=proofs,proto3" json:"proofs,omitempty"`
+}
+
+func (x *MsgVolumeReportChunk) Reset() {
+	*x = MsgVolumeReportChunk{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stratos_pot_v1_tx_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgVolumeReportChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgVolumeReportChunk) ProtoMessage() {}
+
+// Deprecated: Use MsgVolumeReportChunk.ProtoReflect.Descriptor instead.
+func (*MsgVolumeReportChunk) Descriptor() ([]byte, []int) {
+	return file_stratos_pot_v1_tx_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *MsgVolumeReportChunk) GetReporter() string {
+	if x != nil {
+		return x.Reporter
+	}
+	return ""
+}
+
+func (x *MsgVolumeReportChunk) GetReporterOwner() string {
+	if x != nil {
+		return x.ReporterOwner
+	}
+	return ""
+}
+
+func (x *MsgVolumeReportChunk) GetEpoch() string {
+	if x != nil {
+		return x.Epoch
+	}
+	return ""
+}
+
+func (x *MsgVolumeReportChunk) GetStartIndex() uint64 {
+	if x != nil {
+		return x.StartIndex
+	}
+	return 0
+}
+
+func (x *MsgVolumeReportChunk) GetWalletVolumes() []*SingleWalletVolume {
+	if x != nil {
+		return x.WalletVolumes
+	}
+	return nil
+}
+
+func (x *MsgVolumeReportChunk) GetProofs() []*VolumeInclusionProof {
+	if x != nil {
+		return x.Proofs
+	}
+	return nil
+}
+
+// MsgVolumeReportChunkResponse defines the MsgVolumeReportChunk response type
+type MsgVolumeReportChunkResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// finalized is true when the chunk completes the report and the rewards are distributed
+	Finalized bool `protobuf:"varint,1,opt,name=finalized,proto3" json:"finalized,omitempty"`
+}
+
+func (x *MsgVolumeReportChunkResponse) Reset() {
+	*x = MsgVolumeReportChunkResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stratos_pot_v1_tx_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgVolumeReportChunkResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgVolumeReportResponse) ProtoMessage() {}
+func (*MsgVolumeReportChunkResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgVolumeReportResponse.ProtoReflect.Descriptor instead.
-func (*MsgVolumeReportResponse) Descriptor() ([]byte, []int) {
-	return file_stratos_pot_v1_tx_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use MsgVolumeReportChunkResponse.ProtoReflect.Descriptor instead.
+func (*MsgVolumeReportChunkResponse) Descriptor() ([]byte, []int) {
+	return file_stratos_pot_v1_tx_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *MsgVolumeReportChunkResponse) GetFinalized() bool {
+	if x != nil {
+		return x.Finalized
+	}
+	return false
 }
 
 // MsgWithdraw encapsulates an withdraw transaction as an SDK message.
@@ -5897,7 +13177,7 @@ type MsgWithdraw struct {
 func (x *MsgWithdraw) Reset() {
 	*x = MsgWithdraw{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_stratos_pot_v1_tx_proto_msgTypes[2]
+		mi := &file_stratos_pot_v1_tx_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5911,7 +13191,7 @@ func (*MsgWithdraw) ProtoMessage() {}
 
 // Deprecated: Use MsgWithdraw.ProtoReflect.Descriptor instead.
 func (*MsgWithdraw) Descriptor() ([]byte, []int) {
-	return file_stratos_pot_v1_tx_proto_rawDescGZIP(), []int{2}
+	return file_stratos_pot_v1_tx_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *MsgWithdraw) GetAmount() []*v1beta1.Coin {
@@ -5945,7 +13225,7 @@ type MsgWithdrawResponse struct {
 func (x *MsgWithdrawResponse) Reset() {
 	*x = MsgWithdrawResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_stratos_pot_v1_tx_proto_msgTypes[3]
+		mi := &file_stratos_pot_v1_tx_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5959,7 +13239,7 @@ func (*MsgWithdrawResponse) ProtoMessage() {}
 
 // Deprecated: Use MsgWithdrawResponse.ProtoReflect.Descriptor instead.
 func (*MsgWithdrawResponse) Descriptor() ([]byte, []int) {
-	return file_stratos_pot_v1_tx_proto_rawDescGZIP(), []int{3}
+	return file_stratos_pot_v1_tx_proto_rawDescGZIP(), []int{7}
 }
 
 // MsgFoundationDeposit - encapsulates an FoundationDeposit transaction as an SDK message
@@ -5975,7 +13255,7 @@ type MsgFoundationDeposit struct {
 func (x *MsgFoundationDeposit) Reset() {
 	*x = MsgFoundationDeposit{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_stratos_pot_v1_tx_proto_msgTypes[4]
+		mi := &file_stratos_pot_v1_tx_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5989,7 +13269,7 @@ func (*MsgFoundationDeposit) ProtoMessage() {}
 
 // Deprecated: Use MsgFoundationDeposit.ProtoReflect.Descriptor instead.
 func (*MsgFoundationDeposit) Descriptor() ([]byte, []int) {
-	return file_stratos_pot_v1_tx_proto_rawDescGZIP(), []int{4}
+	return file_stratos_pot_v1_tx_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *MsgFoundationDeposit) GetAmount() []*v1beta1.Coin {
@@ -6016,7 +13296,7 @@ type MsgFoundationDepositResponse struct {
 func (x *MsgFoundationDepositResponse) Reset() {
 	*x = MsgFoundationDepositResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_stratos_pot_v1_tx_proto_msgTypes[5]
+		mi := &file_stratos_pot_v1_tx_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6030,7 +13310,7 @@ func (*MsgFoundationDepositResponse) ProtoMessage() {}
 
 // Deprecated: Use MsgFoundationDepositResponse.ProtoReflect.Descriptor instead.
 func (*MsgFoundationDepositResponse) Descriptor() ([]byte, []int) {
-	return file_stratos_pot_v1_tx_proto_rawDescGZIP(), []int{5}
+	return file_stratos_pot_v1_tx_proto_rawDescGZIP(), []int{9}
 }
 
 // MsgRemoveMetaNode - encapsulates an MsgRemoveMetaNode transaction as an SDK message
@@ -6050,7 +13330,7 @@ type MsgSlashingResourceNode struct {
 func (x *MsgSlashingResourceNode) Reset() {
 	*x = MsgSlashingResourceNode{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_stratos_pot_v1_tx_proto_msgTypes[6]
+		mi := &file_stratos_pot_v1_tx_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6064,7 +13344,7 @@ func (*MsgSlashingResourceNode) ProtoMessage() {}
 
 // Deprecated: Use MsgSlashingResourceNode.ProtoReflect.Descriptor instead.
 func (*MsgSlashingResourceNode) Descriptor() ([]byte, []int) {
-	return file_stratos_pot_v1_tx_proto_rawDescGZIP(), []int{6}
+	return file_stratos_pot_v1_tx_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *MsgSlashingResourceNode) GetReporters() []string {
@@ -6095,45 +13375,388 @@ func (x *MsgSlashingResourceNode) GetWalletAddress() string {
 	return ""
 }
 
-func (x *MsgSlashingResourceNode) GetSlashing() string {
+func (x *MsgSlashingResourceNode) GetSlashing() string {
+	if x != nil {
+		return x.Slashing
+	}
+	return ""
+}
+
+func (x *MsgSlashingResourceNode) GetSuspend() bool {
+	if x != nil {
+		return x.Suspend
+	}
+	return false
+}
+
+// MsgSlashingResourceNodeResponse defines the Msg/MsgSlashingResourceNode response type.
+type MsgSlashingResourceNodeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgSlashingResourceNodeResponse) Reset() {
+	*x = MsgSlashingResourceNodeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stratos_pot_v1_tx_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgSlashingResourceNodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgSlashingResourceNodeResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgSlashingResourceNodeResponse.ProtoReflect.Descriptor instead.
+func (*MsgSlashingResourceNodeResponse) Descriptor() ([]byte, []int) {
+	return file_stratos_pot_v1_tx_proto_rawDescGZIP(), []int{11}
+}
+
+// MsgDisputeVolumeReport disputes a volume report during its dispute window with a counter-report, signed by a
+// threshold of the bonded meta nodes when filed by a meta node. The dispute escalates to a governance proposal amending
+// the report with the counter-report, the report is distributed unchanged if the proposal does not pass.
+type MsgDisputeVolumeReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Disputer      string                `protobuf:"bytes,1,opt,name=disputer,proto3" json:"disputer,omitempty"`
+	Reporter      string                `protobuf:"bytes,2,opt,name=reporter,proto3" json:"reporter,omitempty"`
+	Epoch         string                `protobuf:"bytes,3,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	WalletVolumes []*SingleWalletVolume `protobuf:"bytes,4,rep,name=wallet_volumes,json=walletVolumes,proto3" json:"wallet_volumes,omitempty"`
+	Reason        string                `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	BLSSignature  *BLSSignatureInfo     `protobuf:"bytes,6,opt,name=BLS_signature,json=BLSSignature,proto3" json:"BLS_signature,omitempty"`
+}
+
+func (x *MsgDisputeVolumeReport) Reset() {
+	*x = MsgDisputeVolumeReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stratos_pot_v1_tx_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgDisputeVolumeReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgDisputeVolumeReport) ProtoMessage() {}
+
+// Deprecated: Use MsgDisputeVolumeReport.ProtoReflect.Descriptor instead.
+func (*MsgDisputeVolumeReport) Descriptor() ([]byte, []int) {
+	return file_stratos_pot_v1_tx_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *MsgDisputeVolumeReport) GetDisputer() string {
+	if x != nil {
+		return x.Disputer
+	}
+	return ""
+}
+
+func (x *MsgDisputeVolumeReport) GetReporter() string {
+	if x != nil {
+		return x.Reporter
+	}
+	return ""
+}
+
+func (x *MsgDisputeVolumeReport) GetEpoch() string {
+	if x != nil {
+		return x.Epoch
+	}
+	return ""
+}
+
+func (x *MsgDisputeVolumeReport) GetWalletVolumes() []*SingleWalletVolume {
+	if x != nil {
+		return x.WalletVolumes
+	}
+	return nil
+}
+
+func (x *MsgDisputeVolumeReport) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *MsgDisputeVolumeReport) GetBLSSignature() *BLSSignatureInfo {
+	if x != nil {
+		return x.BLSSignature
+	}
+	return nil
+}
+
+// MsgDisputeVolumeReportResponse defines the MsgDisputeVolumeReport response type
+type MsgDisputeVolumeReportResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgDisputeVolumeReportResponse) Reset() {
+	*x = MsgDisputeVolumeReportResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stratos_pot_v1_tx_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgDisputeVolumeReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgDisputeVolumeReportResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgDisputeVolumeReportResponse.ProtoReflect.Descriptor instead.
+func (*MsgDisputeVolumeReportResponse) Descriptor() ([]byte, []int) {
+	return file_stratos_pot_v1_tx_proto_rawDescGZIP(), []int{13}
+}
+
+// MsgSubmitReportEquivocation submits two volume reports of the same epoch with different wallet volumes, both
+// BLS-signed by the accused meta node. The accused BLS public key is bound to its network address by accused_signature.
+type MsgSubmitReportEquivocation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reporter      string `protobuf:"bytes,1,opt,name=reporter,proto3" json:"reporter,omitempty"`
+	ReporterOwner string `protobuf:"bytes,2,opt,name=reporter_owner,json=reporterOwner,proto3" json:"reporter_owner,omitempty"`
+	Accused       string `protobuf:"bytes,3,opt,name=accused,proto3" json:"accused,omitempty"`
+	Epoch         string `protobuf:"bytes,4,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// first_report and second_report are the BLS sign bytes of two volume reports of the epoch, each a MsgVolumeReport
+	// or the MsgVolumeReportHeader of a chunked report
+	FirstReport     []byte            `protobuf:"bytes,5,opt,name=first_report,json=firstReport,proto3" json:"first_report,omitempty"`
+	FirstSignature  *BLSSignatureInfo `protobuf:"bytes,6,opt,name=first_signature,json=firstSignature,proto3" json:"first_signature,omitempty"`
+	SecondReport    []byte            `protobuf:"bytes,7,opt,name=second_report,json=secondReport,proto3" json:"second_report,omitempty"`
+	SecondSignature *BLSSignatureInfo `protobuf:"bytes,8,opt,name=second_signature,json=secondSignature,proto3" json:"second_signature,omitempty"`
+	AccusedPubKey   []byte            `protobuf:"bytes,9,opt,name=accused_pub_key,json=accusedPubKey,proto3" json:"accused_pub_key,omitempty"`
+	// accused_signature is the BLS signature of the accused meta node over the keccak hash of its network address
+	AccusedSignature []byte `protobuf:"bytes,10,opt,name=accused_signature,json=accusedSignature,proto3" json:"accused_signature,omitempty"`
+	// kick votes for kicking the accused meta node on behalf of the reporter
+	Kick bool `protobuf:"varint,11,opt,name=kick,proto3" json:"kick,omitempty"`
+	// first_chunked is true if first_report are the BLS sign bytes of a MsgVolumeReportHeader
+	FirstChunked bool `protobuf:"varint,12,opt,name=first_chunked,json=firstChunked,proto3" json:"first_chunked,omitempty"`
+	// second_chunked is true if second_report are the BLS sign bytes of a MsgVolumeReportHeader
+	SecondChunked bool `protobuf:"varint,13,opt,name=second_chunked,json=secondChunked,proto3" json:"second_chunked,omitempty"`
+}
+
+func (x *MsgSubmitReportEquivocation) Reset() {
+	*x = MsgSubmitReportEquivocation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stratos_pot_v1_tx_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgSubmitReportEquivocation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgSubmitReportEquivocation) ProtoMessage() {}
+
+// Deprecated: Use MsgSubmitReportEquivocation.ProtoReflect.Descriptor instead.
+func (*MsgSubmitReportEquivocation) Descriptor() ([]byte, []int) {
+	return file_stratos_pot_v1_tx_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *MsgSubmitReportEquivocation) GetReporter() string {
+	if x != nil {
+		return x.Reporter
+	}
+	return ""
+}
+
+func (x *MsgSubmitReportEquivocation) GetReporterOwner() string {
+	if x != nil {
+		return x.ReporterOwner
+	}
+	return ""
+}
+
+func (x *MsgSubmitReportEquivocation) GetAccused() string {
+	if x != nil {
+		return x.Accused
+	}
+	return ""
+}
+
+func (x *MsgSubmitReportEquivocation) GetEpoch() string {
+	if x != nil {
+		return x.Epoch
+	}
+	return ""
+}
+
+func (x *MsgSubmitReportEquivocation) GetFirstReport() []byte {
+	if x != nil {
+		return x.FirstReport
+	}
+	return nil
+}
+
+func (x *MsgSubmitReportEquivocation) GetFirstSignature() *BLSSignatureInfo {
+	if x != nil {
+		return x.FirstSignature
+	}
+	return nil
+}
+
+func (x *MsgSubmitReportEquivocation) GetSecondReport() []byte {
+	if x != nil {
+		return x.SecondReport
+	}
+	return nil
+}
+
+func (x *MsgSubmitReportEquivocation) GetSecondSignature() *BLSSignatureInfo {
+	if x != nil {
+		return x.SecondSignature
+	}
+	return nil
+}
+
+func (x *MsgSubmitReportEquivocation) GetAccusedPubKey() []byte {
+	if x != nil {
+		return x.AccusedPubKey
+	}
+	return nil
+}
+
+func (x *MsgSubmitReportEquivocation) GetAccusedSignature() []byte {
+	if x != nil {
+		return x.AccusedSignature
+	}
+	return nil
+}
+
+func (x *MsgSubmitReportEquivocation) GetKick() bool {
+	if x != nil {
+		return x.Kick
+	}
+	return false
+}
+
+func (x *MsgSubmitReportEquivocation) GetFirstChunked() bool {
+	if x != nil {
+		return x.FirstChunked
+	}
+	return false
+}
+
+func (x *MsgSubmitReportEquivocation) GetSecondChunked() bool {
+	if x != nil {
+		return x.SecondChunked
+	}
+	return false
+}
+
+// MsgSubmitReportEquivocationResponse defines the MsgSubmitReportEquivocation response type
+type MsgSubmitReportEquivocationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgSubmitReportEquivocationResponse) Reset() {
+	*x = MsgSubmitReportEquivocationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stratos_pot_v1_tx_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgSubmitReportEquivocationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgSubmitReportEquivocationResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgSubmitReportEquivocationResponse.ProtoReflect.Descriptor instead.
+func (*MsgSubmitReportEquivocationResponse) Descriptor() ([]byte, []int) {
+	return file_stratos_pot_v1_tx_proto_rawDescGZIP(), []int{15}
+}
+
+// MsgSetAutoRestake - encapsulates a SetAutoRestake transaction as an SDK message
+type MsgSetAutoRestake struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WalletAddress string `protobuf:"bytes,1,opt,name=wallet_address,json=walletAddress,proto3" json:"wallet_address,omitempty"`
+	// network_address is the resource or meta node the matured rewards are added to the deposit of, empty to disable
+	NetworkAddress string `protobuf:"bytes,2,opt,name=network_address,json=networkAddress,proto3" json:"network_address,omitempty"`
+}
+
+func (x *MsgSetAutoRestake) Reset() {
+	*x = MsgSetAutoRestake{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stratos_pot_v1_tx_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgSetAutoRestake) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgSetAutoRestake) ProtoMessage() {}
+
+// Deprecated: Use MsgSetAutoRestake.ProtoReflect.Descriptor instead.
+func (*MsgSetAutoRestake) Descriptor() ([]byte, []int) {
+	return file_stratos_pot_v1_tx_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *MsgSetAutoRestake) GetWalletAddress() string {
 	if x != nil {
-		return x.Slashing
+		return x.WalletAddress
 	}
 	return ""
 }
 
-func (x *MsgSlashingResourceNode) GetSuspend() bool {
+func (x *MsgSetAutoRestake) GetNetworkAddress() string {
 	if x != nil {
-		return x.Suspend
+		return x.NetworkAddress
 	}
-	return false
+	return ""
 }
 
-// MsgSlashingResourceNodeResponse defines the Msg/MsgSlashingResourceNode response type.
-type MsgSlashingResourceNodeResponse struct {
+// MsgSetAutoRestakeResponse defines the Msg/MsgSetAutoRestake response type.
+type MsgSetAutoRestakeResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *MsgSlashingResourceNodeResponse) Reset() {
-	*x = MsgSlashingResourceNodeResponse{}
+func (x *MsgSetAutoRestakeResponse) Reset() {
+	*x = MsgSetAutoRestakeResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_stratos_pot_v1_tx_proto_msgTypes[7]
+		mi := &file_stratos_pot_v1_tx_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgSlashingResourceNodeResponse) String() string {
+func (x *MsgSetAutoRestakeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgSlashingResourceNodeResponse) ProtoMessage() {}
+func (*MsgSetAutoRestakeResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgSlashingResourceNodeResponse.ProtoReflect.Descriptor instead.
-func (*MsgSlashingResourceNodeResponse) Descriptor() ([]byte, []int) {
-	return file_stratos_pot_v1_tx_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use MsgSetAutoRestakeResponse.ProtoReflect.Descriptor instead.
+func (*MsgSetAutoRestakeResponse) Descriptor() ([]byte, []int) {
+	return file_stratos_pot_v1_tx_proto_rawDescGZIP(), []int{17}
 }
 
 type BLSSignatureInfo struct {
@@ -6149,7 +13772,7 @@ type BLSSignatureInfo struct {
 func (x *BLSSignatureInfo) Reset() {
 	*x = BLSSignatureInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_stratos_pot_v1_tx_proto_msgTypes[8]
+		mi := &file_stratos_pot_v1_tx_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6163,7 +13786,7 @@ func (*BLSSignatureInfo) ProtoMessage() {}
 
 // Deprecated: Use BLSSignatureInfo.ProtoReflect.Descriptor instead.
 func (*BLSSignatureInfo) Descriptor() ([]byte, []int) {
-	return file_stratos_pot_v1_tx_proto_rawDescGZIP(), []int{8}
+	return file_stratos_pot_v1_tx_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *BLSSignatureInfo) GetPubKeys() [][]byte {
@@ -6203,7 +13826,7 @@ type MsgUpdateParams struct {
 func (x *MsgUpdateParams) Reset() {
 	*x = MsgUpdateParams{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_stratos_pot_v1_tx_proto_msgTypes[9]
+		mi := &file_stratos_pot_v1_tx_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6217,7 +13840,7 @@ func (*MsgUpdateParams) ProtoMessage() {}
 
 // Deprecated: Use MsgUpdateParams.ProtoReflect.Descriptor instead.
 func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return file_stratos_pot_v1_tx_proto_rawDescGZIP(), []int{9}
+	return file_stratos_pot_v1_tx_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *MsgUpdateParams) GetAuthority() string {
@@ -6245,7 +13868,7 @@ type MsgUpdateParamsResponse struct {
 func (x *MsgUpdateParamsResponse) Reset() {
 	*x = MsgUpdateParamsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_stratos_pot_v1_tx_proto_msgTypes[10]
+		mi := &file_stratos_pot_v1_tx_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6259,7 +13882,96 @@ func (*MsgUpdateParamsResponse) ProtoMessage() {}
 
 // Deprecated: Use MsgUpdateParamsResponse.ProtoReflect.Descriptor instead.
 func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return file_stratos_pot_v1_tx_proto_rawDescGZIP(), []int{10}
+	return file_stratos_pot_v1_tx_proto_rawDescGZIP(), []int{20}
+}
+
+// MsgResolveVolumeReportDispute resolves a disputed volume report. The report is cancelled when cancel is set,
+// amended with wallet_volumes when they are provided, and distributed unchanged otherwise.
+type MsgResolveVolumeReportDispute struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// authority is the address of the governance account.
+	Authority     string                `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Epoch         string                `protobuf:"bytes,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Cancel        bool                  `protobuf:"varint,3,opt,name=cancel,proto3" json:"cancel,omitempty"`
+	WalletVolumes []*SingleWalletVolume `protobuf:"bytes,4,rep,name=wallet_volumes,json=walletVolumes,proto3" json:"wallet_volumes,omitempty"`
+}
+
+func (x *MsgResolveVolumeReportDispute) Reset() {
+	*x = MsgResolveVolumeReportDispute{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stratos_pot_v1_tx_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgResolveVolumeReportDispute) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgResolveVolumeReportDispute) ProtoMessage() {}
+
+// Deprecated: Use MsgResolveVolumeReportDispute.ProtoReflect.Descriptor instead.
+func (*MsgResolveVolumeReportDispute) Descriptor() ([]byte, []int) {
+	return file_stratos_pot_v1_tx_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *MsgResolveVolumeReportDispute) GetAuthority() string {
+	if x != nil {
+		return x.Authority
+	}
+	return ""
+}
+
+func (x *MsgResolveVolumeReportDispute) GetEpoch() string {
+	if x != nil {
+		return x.Epoch
+	}
+	return ""
+}
+
+func (x *MsgResolveVolumeReportDispute) GetCancel() bool {
+	if x != nil {
+		return x.Cancel
+	}
+	return false
+}
+
+func (x *MsgResolveVolumeReportDispute) GetWalletVolumes() []*SingleWalletVolume {
+	if x != nil {
+		return x.WalletVolumes
+	}
+	return nil
+}
+
+// MsgResolveVolumeReportDisputeResponse defines the MsgResolveVolumeReportDispute response type
+type MsgResolveVolumeReportDisputeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgResolveVolumeReportDisputeResponse) Reset() {
+	*x = MsgResolveVolumeReportDisputeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stratos_pot_v1_tx_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgResolveVolumeReportDisputeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgResolveVolumeReportDisputeResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgResolveVolumeReportDisputeResponse.ProtoReflect.Descriptor instead.
+func (*MsgResolveVolumeReportDisputeResponse) Descriptor() ([]byte, []int) {
+	return file_stratos_pot_v1_tx_proto_rawDescGZIP(), []int{22}
 }
 
 var File_stratos_pot_v1_tx_proto protoreflect.FileDescriptor
@@ -6323,168 +14035,481 @@ var file_stratos_pot_v1_tx_proto_rawDesc = []byte{
 	0x77, 0x6e, 0x65, 0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x17, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73,
 	0x2f, 0x4d, 0x73, 0x67, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
 	0x22, 0x19, 0x0a, 0x17, 0x4d, 0x73, 0x67, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x70,
-	0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x98, 0x03, 0x0a, 0x0b,
-	0x4d, 0x73, 0x67, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x12, 0x83, 0x01, 0x0a, 0x06,
-	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
-	0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x50, 0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f,
-	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0xf2, 0xde, 0x1f, 0x0d, 0x79, 0x61, 0x6d, 0x6c, 0x3a,
-	0x22, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43,
-	0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
-	0x74, 0x12, 0x6a, 0x0a, 0x0e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x43, 0xea, 0xde, 0x1f, 0x0e, 0x77,
-	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0xf2, 0xde, 0x1f,
-	0x15, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x61, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0d,
-	0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x6a, 0x0a,
-	0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x43, 0xea, 0xde, 0x1f, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65,
-	0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0xf2, 0xde, 0x1f, 0x15, 0x79, 0x61, 0x6d,
-	0x6c, 0x3a, 0x22, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x22, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0d, 0x74, 0x61, 0x72, 0x67,
-	0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x3a, 0x2b, 0x82, 0xe7, 0xb0, 0x2a, 0x0e,
-	0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x8a, 0xe7,
-	0xb0, 0x2a, 0x13, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2f, 0x4d, 0x73, 0x67, 0x57, 0x69,
-	0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x22, 0x15, 0x0a, 0x13, 0x4d, 0x73, 0x67, 0x57, 0x69, 0x74,
-	0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x8d, 0x02,
-	0x0a, 0x14, 0x4d, 0x73, 0x67, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44,
-	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x83, 0x01, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
-	0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f,
-	0x69, 0x6e, 0x42, 0x50, 0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f, 0x06, 0x61, 0x6d, 0x6f, 0x75,
-	0x6e, 0x74, 0xf2, 0xde, 0x1f, 0x0d, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x61, 0x6d, 0x6f, 0x75,
-	0x6e, 0x74, 0x22, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
-	0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d,
-	0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0xa8,
-	0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x43, 0x0a, 0x04,
-	0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2f, 0xea, 0xde, 0x1f, 0x04,
-	0x66, 0x72, 0x6f, 0x6d, 0xf2, 0xde, 0x1f, 0x0b, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x66, 0x72,
-	0x6f, 0x6d, 0x22, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x04, 0x66, 0x72, 0x6f,
-	0x6d, 0x3a, 0x2a, 0x82, 0xe7, 0xb0, 0x2a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x8a, 0xe7, 0xb0, 0x2a,
-	0x1c, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2f, 0x4d, 0x73, 0x67, 0x46, 0x6f, 0x75, 0x6e,
-	0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x22, 0x1e, 0x0a,
-	0x1c, 0x4d, 0x73, 0x67, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65,
-	0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xaa, 0x05,
-	0x0a, 0x17, 0x4d, 0x73, 0x67, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x57, 0x0a, 0x09, 0x72, 0x65, 0x70,
-	0x6f, 0x72, 0x74, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x42, 0x39, 0xea, 0xde,
-	0x1f, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x73, 0xf2, 0xde, 0x1f, 0x10, 0x79,
-	0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x73, 0x22, 0xd2,
-	0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65,
-	0x72, 0x73, 0x12, 0x6a, 0x0a, 0x0e, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x5f, 0x6f,
-	0x77, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x42, 0x43, 0xea, 0xde, 0x1f, 0x0e,
-	0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0xf2, 0xde,
-	0x1f, 0x15, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72,
-	0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x22, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xec, 0x05, 0x0a, 0x15,
+	0x4d, 0x73, 0x67, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x53, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0xea, 0xde, 0x1f, 0x08, 0x72, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x65, 0x72, 0xf2, 0xde, 0x1f, 0x0f, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x72,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x22, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x12, 0x70, 0x0a, 0x05, 0x65, 0x70,
+	0x6f, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x5a, 0xc8, 0xde, 0x1f, 0x00, 0xda,
+	0xde, 0x1f, 0x26, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xea, 0xde, 0x1f, 0x05, 0x65, 0x70, 0x6f,
+	0x63, 0x68, 0xf2, 0xde, 0x1f, 0x0c, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x65, 0x70, 0x6f, 0x63,
+	0x68, 0x22, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74,
+	0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x5a, 0x0a, 0x10,
+	0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2f, 0xea, 0xde, 0x1f, 0x10, 0x72, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0xf2, 0xde, 0x1f, 0x17,
+	0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x72, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x22, 0x52, 0x0f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52,
+	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x6a, 0x0a, 0x0e, 0x72, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x65, 0x72, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x43, 0xea, 0xde, 0x1f, 0x0e, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x5f, 0x6f,
+	0x77, 0x6e, 0x65, 0x72, 0xf2, 0xde, 0x1f, 0x15, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x72, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x22, 0xd2, 0xb4, 0x2d,
+	0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0d, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x4f,
+	0x77, 0x6e, 0x65, 0x72, 0x12, 0x46, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x42, 0x25, 0xea, 0xde, 0x1f, 0x0b, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0xf2, 0xde, 0x1f, 0x12, 0x79, 0x61,
+	0x6d, 0x6c, 0x3a, 0x22, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22,
+	0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x4a, 0x0a, 0x0c,
+	0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x73, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0c, 0x42, 0x27, 0xea, 0xde, 0x1f, 0x0c, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x73, 0x5f,
+	0x72, 0x6f, 0x6f, 0x74, 0xf2, 0xde, 0x1f, 0x13, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x76, 0x6f,
+	0x6c, 0x75, 0x6d, 0x65, 0x73, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x22, 0x52, 0x0b, 0x76, 0x6f, 0x6c,
+	0x75, 0x6d, 0x65, 0x73, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x79, 0x0a, 0x0d, 0x42, 0x4c, 0x53, 0x5f,
+	0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x20, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e, 0x70, 0x6f, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x42, 0x4c, 0x53, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x49, 0x6e, 0x66,
+	0x6f, 0x42, 0x32, 0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f, 0x0d, 0x62, 0x6c, 0x73, 0x5f, 0x73,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0xf2, 0xde, 0x1f, 0x14, 0x79, 0x61, 0x6d, 0x6c,
+	0x3a, 0x22, 0x62, 0x6c, 0x73, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22,
+	0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0c, 0x42, 0x4c, 0x53, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x3a, 0x35, 0x82, 0xe7, 0xb0, 0x2a, 0x0e, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x65, 0x72, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x1d, 0x73, 0x74, 0x72,
+	0x61, 0x74, 0x6f, 0x73, 0x2f, 0x4d, 0x73, 0x67, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x22, 0x1f, 0x0a, 0x1d, 0x4d, 0x73,
+	0x67, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xac, 0x05, 0x0a, 0x14,
+	0x4d, 0x73, 0x67, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x43,
+	0x68, 0x75, 0x6e, 0x6b, 0x12, 0x53, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0xea, 0xde, 0x1f, 0x08, 0x72, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x65, 0x72, 0xf2, 0xde, 0x1f, 0x0f, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x72, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x22, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
 	0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52,
-	0x0d, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x6e,
-	0x0a, 0x0f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x45, 0xea, 0xde, 0x1f, 0x0f, 0x6e, 0x65, 0x74,
-	0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0xf2, 0xde, 0x1f, 0x16,
-	0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x61, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0e,
-	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x6a,
+	0x08, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x12, 0x6a, 0x0a, 0x0e, 0x72, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x65, 0x72, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x43, 0xea, 0xde, 0x1f, 0x0e, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x5f,
+	0x6f, 0x77, 0x6e, 0x65, 0x72, 0xf2, 0xde, 0x1f, 0x15, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x72,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x22, 0xd2, 0xb4,
+	0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0d, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72,
+	0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x70, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x5a, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x26, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x49, 0x6e, 0x74, 0xea, 0xde, 0x1f, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0xf2, 0xde, 0x1f,
+	0x0c, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x22, 0xd2, 0xb4, 0x2d,
+	0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
+	0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x46, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x42, 0x25, 0xea, 0xde,
+	0x1f, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0xf2, 0xde, 0x1f,
+	0x12, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x22, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12,
+	0x7f, 0x0a, 0x0e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
+	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f,
+	0x73, 0x2e, 0x70, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x57,
+	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x42, 0x34, 0xc8, 0xde, 0x1f,
+	0x00, 0xea, 0xde, 0x1f, 0x0e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x76, 0x6f, 0x6c, 0x75,
+	0x6d, 0x65, 0x73, 0xf2, 0xde, 0x1f, 0x15, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x77, 0x61, 0x6c,
+	0x6c, 0x65, 0x74, 0x5f, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x73, 0x22, 0xa8, 0xe7, 0xb0, 0x2a,
+	0x01, 0x52, 0x0d, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x73,
+	0x12, 0x62, 0x0a, 0x06, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x24, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e, 0x70, 0x6f, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x49, 0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f,
+	0x6e, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x42, 0x24, 0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f, 0x06,
+	0x70, 0x72, 0x6f, 0x6f, 0x66, 0x73, 0xf2, 0xde, 0x1f, 0x0d, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22,
+	0x70, 0x72, 0x6f, 0x6f, 0x66, 0x73, 0x22, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x70, 0x72,
+	0x6f, 0x6f, 0x66, 0x73, 0x3a, 0x34, 0x82, 0xe7, 0xb0, 0x2a, 0x0e, 0x72, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x65, 0x72, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x1c, 0x73, 0x74,
+	0x72, 0x61, 0x74, 0x6f, 0x73, 0x2f, 0x4d, 0x73, 0x67, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x22, 0x3c, 0x0a, 0x1c, 0x4d, 0x73,
+	0x67, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x68, 0x75,
+	0x6e, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x69,
+	0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x66,
+	0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x22, 0x98, 0x03, 0x0a, 0x0b, 0x4d, 0x73, 0x67,
+	0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x12, 0x83, 0x01, 0x0a, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x43, 0x6f, 0x69, 0x6e, 0x42, 0x50, 0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0xf2, 0xde, 0x1f, 0x0d, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x22, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e,
+	0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x6a,
 	0x0a, 0x0e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x43, 0xea, 0xde, 0x1f, 0x0e, 0x77, 0x61, 0x6c, 0x6c,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x43, 0xea, 0xde, 0x1f, 0x0e, 0x77, 0x61, 0x6c, 0x6c,
 	0x65, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0xf2, 0xde, 0x1f, 0x15, 0x79, 0x61,
 	0x6d, 0x6c, 0x3a, 0x22, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
 	0x73, 0x73, 0x22, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64,
 	0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0d, 0x77, 0x61, 0x6c,
-	0x6c, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x7c, 0x0a, 0x08, 0x73, 0x6c,
-	0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x60, 0xc8, 0xde,
-	0x1f, 0x00, 0xda, 0xde, 0x1f, 0x26, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73,
-	0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xea, 0xde, 0x1f, 0x08,
-	0x73, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0xf2, 0xde, 0x1f, 0x0f, 0x79, 0x61, 0x6d, 0x6c,
-	0x3a, 0x22, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x22, 0xd2, 0xb4, 0x2d, 0x0a, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x08,
-	0x73, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x12, 0x37, 0x0a, 0x07, 0x73, 0x75, 0x73, 0x70,
-	0x65, 0x6e, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x42, 0x1d, 0xea, 0xde, 0x1f, 0x07, 0x73,
-	0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0xf2, 0xde, 0x1f, 0x0e, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22,
-	0x73, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x22, 0x52, 0x07, 0x73, 0x75, 0x73, 0x70, 0x65, 0x6e,
-	0x64, 0x3a, 0x37, 0x82, 0xe7, 0xb0, 0x2a, 0x0e, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72,
-	0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x1f, 0x73, 0x74, 0x72, 0x61, 0x74,
-	0x6f, 0x73, 0x2f, 0x4d, 0x73, 0x67, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x52, 0x65,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x22, 0x21, 0x0a, 0x1f, 0x4d, 0x73,
-	0x67, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xc6, 0x01,
-	0x0a, 0x10, 0x42, 0x4c, 0x53, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x49, 0x6e,
-	0x66, 0x6f, 0x12, 0x3a, 0x0a, 0x08, 0x70, 0x75, 0x62, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0c, 0x42, 0x1f, 0xea, 0xde, 0x1f, 0x08, 0x70, 0x75, 0x62, 0x5f, 0x6b, 0x65,
-	0x79, 0x73, 0xf2, 0xde, 0x1f, 0x0f, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x70, 0x75, 0x62, 0x5f,
-	0x6b, 0x65, 0x79, 0x73, 0x22, 0x52, 0x07, 0x70, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x3f,
-	0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0c, 0x42, 0x21, 0xea, 0xde, 0x1f, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
-	0xf2, 0xde, 0x1f, 0x10, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74,
-	0x75, 0x72, 0x65, 0x22, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12,
-	0x35, 0x0a, 0x06, 0x74, 0x78, 0x44, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x42,
-	0x1d, 0xea, 0xde, 0x1f, 0x07, 0x74, 0x78, 0x5f, 0x64, 0x61, 0x74, 0x61, 0xf2, 0xde, 0x1f, 0x0e,
-	0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x74, 0x78, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x22, 0x52, 0x06,
-	0x74, 0x78, 0x44, 0x61, 0x74, 0x61, 0x22, 0xb6, 0x01, 0x0a, 0x0f, 0x4d, 0x73, 0x67, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x36, 0x0a, 0x09, 0x61, 0x75,
-	0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2,
+	0x6c, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x6a, 0x0a, 0x0e, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x43, 0xea, 0xde, 0x1f, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0xf2, 0xde, 0x1f, 0x15, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xd2,
 	0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69,
-	0x74, 0x79, 0x12, 0x39, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e, 0x70, 0x6f, 0x74,
-	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00,
-	0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x3a, 0x30, 0x82,
-	0xe7, 0xb0, 0x2a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x8a, 0xe7, 0xb0,
-	0x2a, 0x1d, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2f, 0x78, 0x2f, 0x70, 0x6f, 0x74, 0x2f,
-	0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22,
-	0x19, 0x0a, 0x17, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61,
-	0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x8d, 0x04, 0x0a, 0x03, 0x4d,
-	0x73, 0x67, 0x12, 0x61, 0x0a, 0x15, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x4d, 0x73, 0x67, 0x56,
-	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1f, 0x2e, 0x73, 0x74,
+	0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0d, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x3a, 0x2b, 0x82, 0xe7, 0xb0, 0x2a, 0x0e, 0x77, 0x61, 0x6c,
+	0x6c, 0x65, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x8a, 0xe7, 0xb0, 0x2a, 0x13,
+	0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2f, 0x4d, 0x73, 0x67, 0x57, 0x69, 0x74, 0x68, 0x64,
+	0x72, 0x61, 0x77, 0x22, 0x15, 0x0a, 0x13, 0x4d, 0x73, 0x67, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72,
+	0x61, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x8d, 0x02, 0x0a, 0x14, 0x4d,
+	0x73, 0x67, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x12, 0x83, 0x01, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61,
+	0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42,
+	0x50, 0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0xf2,
+	0xde, 0x1f, 0x0d, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22,
+	0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b,
+	0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a,
+	0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x43, 0x0a, 0x04, 0x66, 0x72, 0x6f,
+	0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2f, 0xea, 0xde, 0x1f, 0x04, 0x66, 0x72, 0x6f,
+	0x6d, 0xf2, 0xde, 0x1f, 0x0b, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x66, 0x72, 0x6f, 0x6d, 0x22,
+	0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x3a, 0x2a,
+	0x82, 0xe7, 0xb0, 0x2a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x8a, 0xe7, 0xb0, 0x2a, 0x1c, 0x73, 0x74,
+	0x72, 0x61, 0x74, 0x6f, 0x73, 0x2f, 0x4d, 0x73, 0x67, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x22, 0x1e, 0x0a, 0x1c, 0x4d, 0x73,
+	0x67, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xaa, 0x05, 0x0a, 0x17, 0x4d,
+	0x73, 0x67, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x57, 0x0a, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x42, 0x39, 0xea, 0xde, 0x1f, 0x09, 0x72,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x73, 0xf2, 0xde, 0x1f, 0x10, 0x79, 0x61, 0x6d, 0x6c,
+	0x3a, 0x22, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x73, 0x22, 0xd2, 0xb4, 0x2d, 0x14,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x73, 0x12,
+	0x6a, 0x0a, 0x0e, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x5f, 0x6f, 0x77, 0x6e, 0x65,
+	0x72, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x42, 0x43, 0xea, 0xde, 0x1f, 0x0e, 0x72, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x65, 0x72, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0xf2, 0xde, 0x1f, 0x15, 0x79,
+	0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x5f, 0x6f, 0x77,
+	0x6e, 0x65, 0x72, 0x22, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0d, 0x72, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x6e, 0x0a, 0x0f, 0x6e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x45, 0xea, 0xde, 0x1f, 0x0f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0xf2, 0xde, 0x1f, 0x16, 0x79, 0x61, 0x6d,
+	0x6c, 0x3a, 0x22, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x22, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0e, 0x6e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x6a, 0x0a, 0x0e, 0x77,
+	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x43, 0xea, 0xde, 0x1f, 0x0e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0xf2, 0xde, 0x1f, 0x15, 0x79, 0x61, 0x6d, 0x6c, 0x3a,
+	0x22, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22,
+	0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0d, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x7c, 0x0a, 0x08, 0x73, 0x6c, 0x61, 0x73, 0x68,
+	0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x60, 0xc8, 0xde, 0x1f, 0x00, 0xda,
+	0xde, 0x1f, 0x26, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xea, 0xde, 0x1f, 0x08, 0x73, 0x6c, 0x61,
+	0x73, 0x68, 0x69, 0x6e, 0x67, 0xf2, 0xde, 0x1f, 0x0f, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x73,
+	0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x22, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x08, 0x73, 0x6c, 0x61,
+	0x73, 0x68, 0x69, 0x6e, 0x67, 0x12, 0x37, 0x0a, 0x07, 0x73, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x42, 0x1d, 0xea, 0xde, 0x1f, 0x07, 0x73, 0x75, 0x73, 0x70,
+	0x65, 0x6e, 0x64, 0xf2, 0xde, 0x1f, 0x0e, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x73, 0x75, 0x73,
+	0x70, 0x65, 0x6e, 0x64, 0x22, 0x52, 0x07, 0x73, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x3a, 0x37,
+	0x82, 0xe7, 0xb0, 0x2a, 0x0e, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x5f, 0x6f, 0x77,
+	0x6e, 0x65, 0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x1f, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2f,
+	0x4d, 0x73, 0x67, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x22, 0x21, 0x0a, 0x1f, 0x4d, 0x73, 0x67, 0x53, 0x6c,
+	0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e, 0x6f,
+	0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x97, 0x05, 0x0a, 0x16, 0x4d,
+	0x73, 0x67, 0x44, 0x69, 0x73, 0x70, 0x75, 0x74, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x53, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x70, 0x75, 0x74, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0xea, 0xde, 0x1f, 0x08, 0x64, 0x69, 0x73,
+	0x70, 0x75, 0x74, 0x65, 0x72, 0xf2, 0xde, 0x1f, 0x0f, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x64,
+	0x69, 0x73, 0x70, 0x75, 0x74, 0x65, 0x72, 0x22, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x52, 0x08, 0x64, 0x69, 0x73, 0x70, 0x75, 0x74, 0x65, 0x72, 0x12, 0x53, 0x0a, 0x08, 0x72, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0xea, 0xde,
+	0x1f, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0xf2, 0xde, 0x1f, 0x0f, 0x79, 0x61,
+	0x6d, 0x6c, 0x3a, 0x22, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x22, 0xd2, 0xb4, 0x2d,
+	0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x12,
+	0x70, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x5a,
+	0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x26, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xea, 0xde,
+	0x1f, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0xf2, 0xde, 0x1f, 0x0c, 0x79, 0x61, 0x6d, 0x6c, 0x3a,
+	0x22, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x22, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63,
+	0x68, 0x12, 0x7f, 0x0a, 0x0e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x76, 0x6f, 0x6c, 0x75,
+	0x6d, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x73, 0x74, 0x72, 0x61,
+	0x74, 0x6f, 0x73, 0x2e, 0x70, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x6e, 0x67, 0x6c,
+	0x65, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x42, 0x34, 0xc8,
+	0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f, 0x0e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x76, 0x6f,
+	0x6c, 0x75, 0x6d, 0x65, 0x73, 0xf2, 0xde, 0x1f, 0x15, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x77,
+	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x73, 0x22, 0xa8, 0xe7,
+	0xb0, 0x2a, 0x01, 0x52, 0x0d, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d,
+	0x65, 0x73, 0x12, 0x33, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x1b, 0xea, 0xde, 0x1f, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0xf2, 0xde,
+	0x1f, 0x0d, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x52,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x79, 0x0a, 0x0d, 0x42, 0x4c, 0x53, 0x5f, 0x73,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20,
+	0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e, 0x70, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x42, 0x4c, 0x53, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x49, 0x6e, 0x66, 0x6f,
+	0x42, 0x32, 0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f, 0x0d, 0x62, 0x6c, 0x73, 0x5f, 0x73, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0xf2, 0xde, 0x1f, 0x14, 0x79, 0x61, 0x6d, 0x6c, 0x3a,
+	0x22, 0x62, 0x6c, 0x73, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0xa8,
+	0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0c, 0x42, 0x4c, 0x53, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x3a, 0x30, 0x82, 0xe7, 0xb0, 0x2a, 0x08, 0x64, 0x69, 0x73, 0x70, 0x75, 0x74, 0x65,
+	0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x1e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2f, 0x4d, 0x73,
+	0x67, 0x44, 0x69, 0x73, 0x70, 0x75, 0x74, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x22, 0x20, 0x0a, 0x1e, 0x4d, 0x73, 0x67, 0x44, 0x69, 0x73, 0x70, 0x75,
+	0x74, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x8e, 0x0a, 0x0a, 0x1b, 0x4d, 0x73, 0x67, 0x53, 0x75,
+	0x62, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x71, 0x75, 0x69, 0x76, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x53, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0xea, 0xde, 0x1f, 0x08, 0x72, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0xf2, 0xde, 0x1f, 0x0f, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22,
+	0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x22, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x12, 0x6a, 0x0a, 0x0e, 0x72,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x43, 0xea, 0xde, 0x1f, 0x0e, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65,
+	0x72, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0xf2, 0xde, 0x1f, 0x15, 0x79, 0x61, 0x6d, 0x6c, 0x3a,
+	0x22, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x22,
+	0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0d, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x65, 0x72, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x4f, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x75, 0x73,
+	0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x35, 0xea, 0xde, 0x1f, 0x07, 0x61, 0x63,
+	0x63, 0x75, 0x73, 0x65, 0x64, 0xf2, 0xde, 0x1f, 0x0e, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x61,
+	0x63, 0x63, 0x75, 0x73, 0x65, 0x64, 0x22, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52,
+	0x07, 0x61, 0x63, 0x63, 0x75, 0x73, 0x65, 0x64, 0x12, 0x70, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63,
+	0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x5a, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f,
+	0x26, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xea, 0xde, 0x1f, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68,
+	0xf2, 0xde, 0x1f, 0x0c, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x22,
+	0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7,
+	0xb0, 0x2a, 0x01, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x4a, 0x0a, 0x0c, 0x66, 0x69,
+	0x72, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c,
+	0x42, 0x27, 0xea, 0xde, 0x1f, 0x0c, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0xf2, 0xde, 0x1f, 0x13, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x66, 0x69, 0x72, 0x73,
+	0x74, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x52, 0x0b, 0x66, 0x69, 0x72, 0x73, 0x74,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x81, 0x01, 0x0a, 0x0f, 0x66, 0x69, 0x72, 0x73, 0x74,
+	0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x20, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e, 0x70, 0x6f, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x42, 0x4c, 0x53, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x49, 0x6e,
+	0x66, 0x6f, 0x42, 0x36, 0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f, 0x0f, 0x66, 0x69, 0x72, 0x73,
+	0x74, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0xf2, 0xde, 0x1f, 0x16, 0x79,
+	0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x22, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0e, 0x66, 0x69, 0x72, 0x73,
+	0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x4e, 0x0a, 0x0d, 0x73, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0c, 0x42, 0x29, 0xea, 0xde, 0x1f, 0x0d, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x5f, 0x72, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0xf2, 0xde, 0x1f, 0x14, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x73, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x52, 0x0c, 0x73, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x85, 0x01, 0x0a, 0x10, 0x73,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e,
+	0x70, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x4c, 0x53, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x38, 0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f,
+	0x10, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0xf2, 0xde, 0x1f, 0x17, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x73, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0xa8, 0xe7, 0xb0, 0x2a,
+	0x01, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x12, 0x55, 0x0a, 0x0f, 0x61, 0x63, 0x63, 0x75, 0x73, 0x65, 0x64, 0x5f, 0x70, 0x75,
+	0x62, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x2d, 0xea, 0xde, 0x1f,
+	0x0f, 0x61, 0x63, 0x63, 0x75, 0x73, 0x65, 0x64, 0x5f, 0x70, 0x75, 0x62, 0x5f, 0x6b, 0x65, 0x79,
+	0xf2, 0xde, 0x1f, 0x16, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x61, 0x63, 0x63, 0x75, 0x73, 0x65,
+	0x64, 0x5f, 0x70, 0x75, 0x62, 0x5f, 0x6b, 0x65, 0x79, 0x22, 0x52, 0x0d, 0x61, 0x63, 0x63, 0x75,
+	0x73, 0x65, 0x64, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x12, 0x5e, 0x0a, 0x11, 0x61, 0x63, 0x63,
+	0x75, 0x73, 0x65, 0x64, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x0c, 0x42, 0x31, 0xea, 0xde, 0x1f, 0x11, 0x61, 0x63, 0x63, 0x75, 0x73, 0x65,
+	0x64, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0xf2, 0xde, 0x1f, 0x18, 0x79,
+	0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x61, 0x63, 0x63, 0x75, 0x73, 0x65, 0x64, 0x5f, 0x73, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x52, 0x10, 0x61, 0x63, 0x63, 0x75, 0x73, 0x65, 0x64,
+	0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x2b, 0x0a, 0x04, 0x6b, 0x69, 0x63,
+	0x6b, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x42, 0x17, 0xea, 0xde, 0x1f, 0x04, 0x6b, 0x69, 0x63,
+	0x6b, 0xf2, 0xde, 0x1f, 0x0b, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6b, 0x69, 0x63, 0x6b, 0x22,
+	0x52, 0x04, 0x6b, 0x69, 0x63, 0x6b, 0x12, 0x4e, 0x0a, 0x0d, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f,
+	0x63, 0x68, 0x75, 0x6e, 0x6b, 0x65, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x42, 0x29, 0xea,
+	0xde, 0x1f, 0x0d, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x65, 0x64,
+	0xf2, 0xde, 0x1f, 0x14, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f,
+	0x63, 0x68, 0x75, 0x6e, 0x6b, 0x65, 0x64, 0x22, 0x52, 0x0c, 0x66, 0x69, 0x72, 0x73, 0x74, 0x43,
+	0x68, 0x75, 0x6e, 0x6b, 0x65, 0x64, 0x12, 0x52, 0x0a, 0x0e, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x5f, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x65, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x42, 0x2b,
+	0xea, 0xde, 0x1f, 0x0e, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x5f, 0x63, 0x68, 0x75, 0x6e, 0x6b,
+	0x65, 0x64, 0xf2, 0xde, 0x1f, 0x15, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x73, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x5f, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x65, 0x64, 0x22, 0x52, 0x0d, 0x73, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x65, 0x64, 0x3a, 0x3b, 0x82, 0xe7, 0xb0, 0x2a,
+	0x0e, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x8a,
+	0xe7, 0xb0, 0x2a, 0x23, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2f, 0x4d, 0x73, 0x67, 0x53,
+	0x75, 0x62, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x71, 0x75, 0x69, 0x76,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x25, 0x0a, 0x23, 0x4d, 0x73, 0x67, 0x53, 0x75,
+	0x62, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x71, 0x75, 0x69, 0x76, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xa2,
+	0x02, 0x0a, 0x11, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x74, 0x41, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x73,
+	0x74, 0x61, 0x6b, 0x65, 0x12, 0x6a, 0x0a, 0x0e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x43, 0xea, 0xde,
+	0x1f, 0x0e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0xf2, 0xde, 0x1f, 0x15, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x52, 0x0d, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x6e, 0x0a, 0x0f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x45, 0xea, 0xde, 0x1f, 0x0f, 0x6e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0xf2, 0xde,
+	0x1f, 0x16, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x52, 0x0e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x3a, 0x31, 0x82, 0xe7, 0xb0, 0x2a, 0x0e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x8a, 0xe7, 0xb0, 0x2a, 0x19, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f,
+	0x73, 0x2f, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x74, 0x41, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x73, 0x74,
+	0x61, 0x6b, 0x65, 0x22, 0x1b, 0x0a, 0x19, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x74, 0x41, 0x75, 0x74,
+	0x6f, 0x52, 0x65, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0xc6, 0x01, 0x0a, 0x10, 0x42, 0x4c, 0x53, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x3a, 0x0a, 0x08, 0x70, 0x75, 0x62, 0x5f, 0x6b, 0x65, 0x79,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x42, 0x1f, 0xea, 0xde, 0x1f, 0x08, 0x70, 0x75, 0x62,
+	0x5f, 0x6b, 0x65, 0x79, 0x73, 0xf2, 0xde, 0x1f, 0x0f, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x70,
+	0x75, 0x62, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x22, 0x52, 0x07, 0x70, 0x75, 0x62, 0x4b, 0x65, 0x79,
+	0x73, 0x12, 0x3f, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0c, 0x42, 0x21, 0xea, 0xde, 0x1f, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0xf2, 0xde, 0x1f, 0x10, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x73, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x12, 0x35, 0x0a, 0x06, 0x74, 0x78, 0x44, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0c, 0x42, 0x1d, 0xea, 0xde, 0x1f, 0x07, 0x74, 0x78, 0x5f, 0x64, 0x61, 0x74, 0x61, 0xf2,
+	0xde, 0x1f, 0x0e, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x74, 0x78, 0x5f, 0x64, 0x61, 0x74, 0x61,
+	0x22, 0x52, 0x06, 0x74, 0x78, 0x44, 0x61, 0x74, 0x61, 0x22, 0xb6, 0x01, 0x0a, 0x0f, 0x4d, 0x73,
+	0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x36, 0x0a,
+	0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x39, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e,
+	0x70, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x09, 0xc8,
+	0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x3a, 0x30, 0x82, 0xe7, 0xb0, 0x2a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79,
+	0x8a, 0xe7, 0xb0, 0x2a, 0x1d, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2f, 0x78, 0x2f, 0x70,
+	0x6f, 0x74, 0x2f, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x22, 0x19, 0x0a, 0x17, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xde, 0x02,
+	0x0a, 0x1d, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x56, 0x6f, 0x6c, 0x75,
+	0x6d, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x69, 0x73, 0x70, 0x75, 0x74, 0x65, 0x12,
+	0x36, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x61, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x57, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x41, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x26,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x49, 0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68,
+	0x12, 0x16, 0x0a, 0x06, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x06, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x12, 0x54, 0x0a, 0x0e, 0x77, 0x61, 0x6c, 0x6c,
+	0x65, 0x74, 0x5f, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x22, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e, 0x70, 0x6f, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x56, 0x6f,
+	0x6c, 0x75, 0x6d, 0x65, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52,
+	0x0d, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x73, 0x3a, 0x3e,
+	0x82, 0xe7, 0xb0, 0x2a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x8a, 0xe7,
+	0xb0, 0x2a, 0x2b, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2f, 0x78, 0x2f, 0x70, 0x6f, 0x74,
+	0x2f, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d,
+	0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x69, 0x73, 0x70, 0x75, 0x74, 0x65, 0x22, 0x27,
+	0x0a, 0x25, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x56, 0x6f, 0x6c, 0x75,
+	0x6d, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x69, 0x73, 0x70, 0x75, 0x74, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xe2, 0x09, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x12,
+	0x61, 0x0a, 0x15, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x4d, 0x73, 0x67, 0x56, 0x6f, 0x6c, 0x75,
+	0x6d, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1f, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74,
+	0x6f, 0x73, 0x2e, 0x70, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x56, 0x6f, 0x6c,
+	0x75, 0x6d, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x1a, 0x27, 0x2e, 0x73, 0x74, 0x72, 0x61,
+	0x74, 0x6f, 0x73, 0x2e, 0x70, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x56, 0x6f,
+	0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x73, 0x0a, 0x1b, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x4d, 0x73, 0x67, 0x56,
+	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x48, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x12, 0x25, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e, 0x70, 0x6f, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x1a, 0x2d, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74,
+	0x6f, 0x73, 0x2e, 0x70, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x56, 0x6f, 0x6c,
+	0x75, 0x6d, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x70, 0x0a, 0x1a, 0x48, 0x61, 0x6e, 0x64, 0x6c,
+	0x65, 0x4d, 0x73, 0x67, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x43, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x24, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e,
+	0x70, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x1a, 0x2c, 0x2e, 0x73, 0x74,
+	0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e, 0x70, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67,
+	0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x68, 0x75, 0x6e,
+	0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x11, 0x48, 0x61, 0x6e,
+	0x64, 0x6c, 0x65, 0x4d, 0x73, 0x67, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x12, 0x1b,
+	0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e, 0x70, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x73, 0x67, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x1a, 0x23, 0x2e, 0x73, 0x74,
+	0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e, 0x70, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67,
+	0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x70, 0x0a, 0x1a, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x4d, 0x73, 0x67, 0x46, 0x6f, 0x75,
+	0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x24,
+	0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e, 0x70, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x73, 0x67, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x1a, 0x2c, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e, 0x70,
+	0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x79, 0x0a, 0x1d, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x4d, 0x73, 0x67, 0x53,
+	0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e,
+	0x6f, 0x64, 0x65, 0x12, 0x27, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e, 0x70, 0x6f,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67,
+	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x1a, 0x2f, 0x2e, 0x73,
+	0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e, 0x70, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73,
+	0x67, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x76, 0x0a,
+	0x1c, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x4d, 0x73, 0x67, 0x44, 0x69, 0x73, 0x70, 0x75, 0x74,
+	0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x26, 0x2e,
+	0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e, 0x70, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
+	0x73, 0x67, 0x44, 0x69, 0x73, 0x70, 0x75, 0x74, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x1a, 0x2e, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e,
+	0x70, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x44, 0x69, 0x73, 0x70, 0x75, 0x74,
+	0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x85, 0x01, 0x0a, 0x21, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65,
+	0x4d, 0x73, 0x67, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x45,
+	0x71, 0x75, 0x69, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x2e, 0x73, 0x74,
+	0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e, 0x70, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67,
+	0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x71, 0x75, 0x69,
+	0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x33, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74,
+	0x6f, 0x73, 0x2e, 0x70, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x62,
+	0x6d, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x71, 0x75, 0x69, 0x76, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x67, 0x0a,
+	0x17, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x74, 0x41, 0x75, 0x74,
+	0x6f, 0x52, 0x65, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x12, 0x21, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74,
+	0x6f, 0x73, 0x2e, 0x70, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x74,
+	0x41, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x1a, 0x29, 0x2e, 0x73, 0x74,
+	0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e, 0x70, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67,
+	0x53, 0x65, 0x74, 0x41, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x82, 0x01, 0x0a, 0x1a, 0x52, 0x65, 0x73, 0x6f, 0x6c,
+	0x76, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x69,
+	0x73, 0x70, 0x75, 0x74, 0x65, 0x12, 0x2d, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e,
+	0x70, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76,
+	0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x69, 0x73,
+	0x70, 0x75, 0x74, 0x65, 0x1a, 0x35, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e, 0x70,
+	0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65,
+	0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x69, 0x73, 0x70,
+	0x75, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a, 0x0c, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x1f, 0x2e, 0x73, 0x74,
 	0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e, 0x70, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67,
-	0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x1a, 0x27, 0x2e, 0x73,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x27, 0x2e, 0x73,
 	0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e, 0x70, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73,
-	0x67, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x11, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x4d,
-	0x73, 0x67, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x12, 0x1b, 0x2e, 0x73, 0x74, 0x72,
-	0x61, 0x74, 0x6f, 0x73, 0x2e, 0x70, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x57,
-	0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x1a, 0x23, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f,
-	0x73, 0x2e, 0x70, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x57, 0x69, 0x74, 0x68,
-	0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x70, 0x0a, 0x1a,
-	0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x4d, 0x73, 0x67, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x24, 0x2e, 0x73, 0x74, 0x72,
-	0x61, 0x74, 0x6f, 0x73, 0x2e, 0x70, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x46,
-	0x6f, 0x75, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
-	0x1a, 0x2c, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e, 0x70, 0x6f, 0x74, 0x2e, 0x76,
-	0x31, 0x2e, 0x4d, 0x73, 0x67, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44,
-	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x79,
-	0x0a, 0x1d, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x4d, 0x73, 0x67, 0x53, 0x6c, 0x61, 0x73, 0x68,
-	0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x12,
-	0x27, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e, 0x70, 0x6f, 0x74, 0x2e, 0x76, 0x31,
-	0x2e, 0x4d, 0x73, 0x67, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x1a, 0x2f, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74,
-	0x6f, 0x73, 0x2e, 0x70, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x6c, 0x61,
-	0x73, 0x68, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e, 0x6f, 0x64,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a, 0x0c, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x1f, 0x2e, 0x73, 0x74, 0x72, 0x61,
-	0x74, 0x6f, 0x73, 0x2e, 0x70, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x27, 0x2e, 0x73, 0x74, 0x72,
-	0x61, 0x74, 0x6f, 0x73, 0x2e, 0x70, 0x6f, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x1a, 0x05, 0x80, 0xe7, 0xb0, 0x2a, 0x01, 0x42, 0xa2, 0x01, 0x0a, 0x12, 0x63,
-	0x6f, 0x6d, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e, 0x70, 0x6f, 0x74, 0x2e, 0x76,
-	0x31, 0x42, 0x07, 0x54, 0x78, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x25, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x73,
-	0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2f, 0x70, 0x6f, 0x74, 0x2f, 0x76, 0x31, 0x3b, 0x70, 0x6f,
-	0x74, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x53, 0x50, 0x58, 0xaa, 0x02, 0x0e, 0x53, 0x74, 0x72, 0x61,
-	0x74, 0x6f, 0x73, 0x2e, 0x50, 0x6f, 0x74, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0e, 0x53, 0x74, 0x72,
-	0x61, 0x74, 0x6f, 0x73, 0x5c, 0x50, 0x6f, 0x74, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1a, 0x53, 0x74,
-	0x72, 0x61, 0x74, 0x6f, 0x73, 0x5c, 0x50, 0x6f, 0x74, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42,
-	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x10, 0x53, 0x74, 0x72, 0x61, 0x74,
-	0x6f, 0x73, 0x3a, 0x3a, 0x50, 0x6f, 0x74, 0x3a, 0x3a, 0x56, 0x31, 0xa8, 0xe2, 0x1e, 0x01, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x1a, 0x05, 0x80, 0xe7, 0xb0, 0x2a, 0x01, 0x42, 0xa2, 0x01, 0x0a,
+	0x12, 0x63, 0x6f, 0x6d, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e, 0x70, 0x6f, 0x74,
+	0x2e, 0x76, 0x31, 0x42, 0x07, 0x54, 0x78, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x25,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2f, 0x70, 0x6f, 0x74, 0x2f, 0x76, 0x31, 0x3b,
+	0x70, 0x6f, 0x74, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x53, 0x50, 0x58, 0xaa, 0x02, 0x0e, 0x53, 0x74,
+	0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e, 0x50, 0x6f, 0x74, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0e, 0x53,
+	0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x5c, 0x50, 0x6f, 0x74, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1a,
+	0x53, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x5c, 0x50, 0x6f, 0x74, 0x5c, 0x56, 0x31, 0x5c, 0x47,
+	0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x10, 0x53, 0x74, 0x72,
+	0x61, 0x74, 0x6f, 0x73, 0x3a, 0x3a, 0x50, 0x6f, 0x74, 0x3a, 0x3a, 0x56, 0x31, 0xa8, 0xe2, 0x1e,
+	0x01, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -6499,44 +14524,77 @@ func file_stratos_pot_v1_tx_proto_rawDescGZIP() []byte {
 	return file_stratos_pot_v1_tx_proto_rawDescData
 }
 
-var file_stratos_pot_v1_tx_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_stratos_pot_v1_tx_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
 var file_stratos_pot_v1_tx_proto_goTypes = []interface{}{
-	(*MsgVolumeReport)(nil),                 // 0: stratos.pot.v1.MsgVolumeReport
-	(*MsgVolumeReportResponse)(nil),         // 1: stratos.pot.v1.MsgVolumeReportResponse
-	(*MsgWithdraw)(nil),                     // 2: stratos.pot.v1.MsgWithdraw
-	(*MsgWithdrawResponse)(nil),             // 3: stratos.pot.v1.MsgWithdrawResponse
-	(*MsgFoundationDeposit)(nil),            // 4: stratos.pot.v1.MsgFoundationDeposit
-	(*MsgFoundationDepositResponse)(nil),    // 5: stratos.pot.v1.MsgFoundationDepositResponse
-	(*MsgSlashingResourceNode)(nil),         // 6: stratos.pot.v1.MsgSlashingResourceNode
-	(*MsgSlashingResourceNodeResponse)(nil), // 7: stratos.pot.v1.MsgSlashingResourceNodeResponse
-	(*BLSSignatureInfo)(nil),                // 8: stratos.pot.v1.BLSSignatureInfo
-	(*MsgUpdateParams)(nil),                 // 9: stratos.pot.v1.MsgUpdateParams
-	(*MsgUpdateParamsResponse)(nil),         // 10: stratos.pot.v1.MsgUpdateParamsResponse
-	(*SingleWalletVolume)(nil),              // 11: stratos.pot.v1.SingleWalletVolume
-	(*v1beta1.Coin)(nil),                    // 12: cosmos.base.v1beta1.Coin
-	(*Params)(nil),                          // 13: stratos.pot.v1.Params
+	(*MsgVolumeReport)(nil),                       // 0: stratos.pot.v1.MsgVolumeReport
+	(*MsgVolumeReportResponse)(nil),               // 1: stratos.pot.v1.MsgVolumeReportResponse
+	(*MsgVolumeReportHeader)(nil),                 // 2: stratos.pot.v1.MsgVolumeReportHeader
+	(*MsgVolumeReportHeaderResponse)(nil),         // 3: stratos.pot.v1.MsgVolumeReportHeaderResponse
+	(*MsgVolumeReportChunk)(nil),                  // 4: stratos.pot.v1.MsgVolumeReportChunk
+	(*MsgVolumeReportChunkResponse)(nil),          // 5: stratos.pot.v1.MsgVolumeReportChunkResponse
+	(*MsgWithdraw)(nil),                           // 6: stratos.pot.v1.MsgWithdraw
+	(*MsgWithdrawResponse)(nil),                   // 7: stratos.pot.v1.MsgWithdrawResponse
+	(*MsgFoundationDeposit)(nil),                  // 8: stratos.pot.v1.MsgFoundationDeposit
+	(*MsgFoundationDepositResponse)(nil),          // 9: stratos.pot.v1.MsgFoundationDepositResponse
+	(*MsgSlashingResourceNode)(nil),               // 10: stratos.pot.v1.MsgSlashingResourceNode
+	(*MsgSlashingResourceNodeResponse)(nil),       // 11: stratos.pot.v1.MsgSlashingResourceNodeResponse
+	(*MsgDisputeVolumeReport)(nil),                // 12: stratos.pot.v1.MsgDisputeVolumeReport
+	(*MsgDisputeVolumeReportResponse)(nil),        // 13: stratos.pot.v1.MsgDisputeVolumeReportResponse
+	(*MsgSubmitReportEquivocation)(nil),           // 14: stratos.pot.v1.MsgSubmitReportEquivocation
+	(*MsgSubmitReportEquivocationResponse)(nil),   // 15: stratos.pot.v1.MsgSubmitReportEquivocationResponse
+	(*MsgSetAutoRestake)(nil),                     // 16: stratos.pot.v1.MsgSetAutoRestake
+	(*MsgSetAutoRestakeResponse)(nil),             // 17: stratos.pot.v1.MsgSetAutoRestakeResponse
+	(*BLSSignatureInfo)(nil),                      // 18: stratos.pot.v1.BLSSignatureInfo
+	(*MsgUpdateParams)(nil),                       // 19: stratos.pot.v1.MsgUpdateParams
+	(*MsgUpdateParamsResponse)(nil),               // 20: stratos.pot.v1.MsgUpdateParamsResponse
+	(*MsgResolveVolumeReportDispute)(nil),         // 21: stratos.pot.v1.MsgResolveVolumeReportDispute
+	(*MsgResolveVolumeReportDisputeResponse)(nil), // 22: stratos.pot.v1.MsgResolveVolumeReportDisputeResponse
+	(*SingleWalletVolume)(nil),                    // 23: stratos.pot.v1.SingleWalletVolume
+	(*VolumeInclusionProof)(nil),                  // 24: stratos.pot.v1.VolumeInclusionProof
+	(*v1beta1.Coin)(nil),                          // 25: cosmos.base.v1beta1.Coin
+	(*Params)(nil),                                // 26: stratos.pot.v1.Params
 }
 var file_stratos_pot_v1_tx_proto_depIdxs = []int32{
-	11, // 0: stratos.pot.v1.MsgVolumeReport.wallet_volumes:type_name -> stratos.pot.v1.SingleWalletVolume
-	8,  // 1: stratos.pot.v1.MsgVolumeReport.BLS_signature:type_name -> stratos.pot.v1.BLSSignatureInfo
-	12, // 2: stratos.pot.v1.MsgWithdraw.amount:type_name -> cosmos.base.v1beta1.Coin
-	12, // 3: stratos.pot.v1.MsgFoundationDeposit.amount:type_name -> cosmos.base.v1beta1.Coin
-	13, // 4: stratos.pot.v1.MsgUpdateParams.params:type_name -> stratos.pot.v1.Params
-	0,  // 5: stratos.pot.v1.Msg.HandleMsgVolumeReport:input_type -> stratos.pot.v1.MsgVolumeReport
-	2,  // 6: stratos.pot.v1.Msg.HandleMsgWithdraw:input_type -> stratos.pot.v1.MsgWithdraw
-	4,  // 7: stratos.pot.v1.Msg.HandleMsgFoundationDeposit:input_type -> stratos.pot.v1.MsgFoundationDeposit
-	6,  // 8: stratos.pot.v1.Msg.HandleMsgSlashingResourceNode:input_type -> stratos.pot.v1.MsgSlashingResourceNode
-	9,  // 9: stratos.pot.v1.Msg.UpdateParams:input_type -> stratos.pot.v1.MsgUpdateParams
-	1,  // 10: stratos.pot.v1.Msg.HandleMsgVolumeReport:output_type -> stratos.pot.v1.MsgVolumeReportResponse
-	3,  // 11: stratos.pot.v1.Msg.HandleMsgWithdraw:output_type -> stratos.pot.v1.MsgWithdrawResponse
-	5,  // 12: stratos.pot.v1.Msg.HandleMsgFoundationDeposit:output_type -> stratos.pot.v1.MsgFoundationDepositResponse
-	7,  // 13: stratos.pot.v1.Msg.HandleMsgSlashingResourceNode:output_type -> stratos.pot.v1.MsgSlashingResourceNodeResponse
-	10, // 14: stratos.pot.v1.Msg.UpdateParams:output_type -> stratos.pot.v1.MsgUpdateParamsResponse
-	10, // [10:15] is the sub-list for method output_type
-	5,  // [5:10] is the sub-list for method input_type
-	5,  // [5:5] is the sub-list for extension type_name
-	5,  // [5:5] is the sub-list for extension extendee
-	0,  // [0:5] is the sub-list for field type_name
+	23, // 0: stratos.pot.v1.MsgVolumeReport.wallet_volumes:type_name -> stratos.pot.v1.SingleWalletVolume
+	18, // 1: stratos.pot.v1.MsgVolumeReport.BLS_signature:type_name -> stratos.pot.v1.BLSSignatureInfo
+	18, // 2: stratos.pot.v1.MsgVolumeReportHeader.BLS_signature:type_name -> stratos.pot.v1.BLSSignatureInfo
+	23, // 3: stratos.pot.v1.MsgVolumeReportChunk.wallet_volumes:type_name -> stratos.pot.v1.SingleWalletVolume
+	24, // 4: stratos.pot.v1.MsgVolumeReportChunk.proofs:type_name -> stratos.pot.v1.VolumeInclusionProof
+	25, // 5: stratos.pot.v1.MsgWithdraw.amount:type_name -> cosmos.base.v1beta1.Coin
+	25, // 6: stratos.pot.v1.MsgFoundationDeposit.amount:type_name -> cosmos.base.v1beta1.Coin
+	23, // 7: stratos.pot.v1.MsgDisputeVolumeReport.wallet_volumes:type_name -> stratos.pot.v1.SingleWalletVolume
+	18, // 8: stratos.pot.v1.MsgDisputeVolumeReport.BLS_signature:type_name -> stratos.pot.v1.BLSSignatureInfo
+	18, // 9: stratos.pot.v1.MsgSubmitReportEquivocation.first_signature:type_name -> stratos.pot.v1.BLSSignatureInfo
+	18, // 10: stratos.pot.v1.MsgSubmitReportEquivocation.second_signature:type_name -> stratos.pot.v1.BLSSignatureInfo
+	26, // 11: stratos.pot.v1.MsgUpdateParams.params:type_name -> stratos.pot.v1.Params
+	23, // 12: stratos.pot.v1.MsgResolveVolumeReportDispute.wallet_volumes:type_name -> stratos.pot.v1.SingleWalletVolume
+	0,  // 13: stratos.pot.v1.Msg.HandleMsgVolumeReport:input_type -> stratos.pot.v1.MsgVolumeReport
+	2,  // 14: stratos.pot.v1.Msg.HandleMsgVolumeReportHeader:input_type -> stratos.pot.v1.MsgVolumeReportHeader
+	4,  // 15: stratos.pot.v1.Msg.HandleMsgVolumeReportChunk:input_type -> stratos.pot.v1.MsgVolumeReportChunk
+	6,  // 16: stratos.pot.v1.Msg.HandleMsgWithdraw:input_type -> stratos.pot.v1.MsgWithdraw
+	8,  // 17: stratos.pot.v1.Msg.HandleMsgFoundationDeposit:input_type -> stratos.pot.v1.MsgFoundationDeposit
+	10, // 18: stratos.pot.v1.Msg.HandleMsgSlashingResourceNode:input_type -> stratos.pot.v1.MsgSlashingResourceNode
+	12, // 19: stratos.pot.v1.Msg.HandleMsgDisputeVolumeReport:input_type -> stratos.pot.v1.MsgDisputeVolumeReport
+	14, // 20: stratos.pot.v1.Msg.HandleMsgSubmitReportEquivocation:input_type -> stratos.pot.v1.MsgSubmitReportEquivocation
+	16, // 21: stratos.pot.v1.Msg.HandleMsgSetAutoRestake:input_type -> stratos.pot.v1.MsgSetAutoRestake
+	21, // 22: stratos.pot.v1.Msg.ResolveVolumeReportDispute:input_type -> stratos.pot.v1.MsgResolveVolumeReportDispute
+	19, // 23: stratos.pot.v1.Msg.UpdateParams:input_type -> stratos.pot.v1.MsgUpdateParams
+	1,  // 24: stratos.pot.v1.Msg.HandleMsgVolumeReport:output_type -> stratos.pot.v1.MsgVolumeReportResponse
+	3,  // 25: stratos.pot.v1.Msg.HandleMsgVolumeReportHeader:output_type -> stratos.pot.v1.MsgVolumeReportHeaderResponse
+	5,  // 26: stratos.pot.v1.Msg.HandleMsgVolumeReportChunk:output_type -> stratos.pot.v1.MsgVolumeReportChunkResponse
+	7,  // 27: stratos.pot.v1.Msg.HandleMsgWithdraw:output_type -> stratos.pot.v1.MsgWithdrawResponse
+	9,  // 28: stratos.pot.v1.Msg.HandleMsgFoundationDeposit:output_type -> stratos.pot.v1.MsgFoundationDepositResponse
+	11, // 29: stratos.pot.v1.Msg.HandleMsgSlashingResourceNode:output_type -> stratos.pot.v1.MsgSlashingResourceNodeResponse
+	13, // 30: stratos.pot.v1.Msg.HandleMsgDisputeVolumeReport:output_type -> stratos.pot.v1.MsgDisputeVolumeReportResponse
+	15, // 31: stratos.pot.v1.Msg.HandleMsgSubmitReportEquivocation:output_type -> stratos.pot.v1.MsgSubmitReportEquivocationResponse
+	17, // 32: stratos.pot.v1.Msg.HandleMsgSetAutoRestake:output_type -> stratos.pot.v1.MsgSetAutoRestakeResponse
+	22, // 33: stratos.pot.v1.Msg.ResolveVolumeReportDispute:output_type -> stratos.pot.v1.MsgResolveVolumeReportDisputeResponse
+	20, // 34: stratos.pot.v1.Msg.UpdateParams:output_type -> stratos.pot.v1.MsgUpdateParamsResponse
+	24, // [24:35] is the sub-list for method output_type
+	13, // [13:24] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
 }
 
 func init() { file_stratos_pot_v1_tx_proto_init() }
@@ -6571,7 +14629,7 @@ func file_stratos_pot_v1_tx_proto_init() {
 			}
 		}
 		file_stratos_pot_v1_tx_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgWithdraw); i {
+			switch v := v.(*MsgVolumeReportHeader); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6583,7 +14641,7 @@ func file_stratos_pot_v1_tx_proto_init() {
 			}
 		}
 		file_stratos_pot_v1_tx_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgWithdrawResponse); i {
+			switch v := v.(*MsgVolumeReportHeaderResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6595,7 +14653,7 @@ func file_stratos_pot_v1_tx_proto_init() {
 			}
 		}
 		file_stratos_pot_v1_tx_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgFoundationDeposit); i {
+			switch v := v.(*MsgVolumeReportChunk); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6607,7 +14665,7 @@ func file_stratos_pot_v1_tx_proto_init() {
 			}
 		}
 		file_stratos_pot_v1_tx_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgFoundationDepositResponse); i {
+			switch v := v.(*MsgVolumeReportChunkResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6619,7 +14677,7 @@ func file_stratos_pot_v1_tx_proto_init() {
 			}
 		}
 		file_stratos_pot_v1_tx_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgSlashingResourceNode); i {
+			switch v := v.(*MsgWithdraw); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6631,7 +14689,7 @@ func file_stratos_pot_v1_tx_proto_init() {
 			}
 		}
 		file_stratos_pot_v1_tx_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgSlashingResourceNodeResponse); i {
+			switch v := v.(*MsgWithdrawResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6643,7 +14701,7 @@ func file_stratos_pot_v1_tx_proto_init() {
 			}
 		}
 		file_stratos_pot_v1_tx_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BLSSignatureInfo); i {
+			switch v := v.(*MsgFoundationDeposit); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6655,7 +14713,7 @@ func file_stratos_pot_v1_tx_proto_init() {
 			}
 		}
 		file_stratos_pot_v1_tx_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgUpdateParams); i {
+			switch v := v.(*MsgFoundationDepositResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6667,6 +14725,126 @@ func file_stratos_pot_v1_tx_proto_init() {
 			}
 		}
 		file_stratos_pot_v1_tx_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgSlashingResourceNode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stratos_pot_v1_tx_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgSlashingResourceNodeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stratos_pot_v1_tx_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgDisputeVolumeReport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stratos_pot_v1_tx_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgDisputeVolumeReportResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stratos_pot_v1_tx_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgSubmitReportEquivocation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stratos_pot_v1_tx_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgSubmitReportEquivocationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stratos_pot_v1_tx_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgSetAutoRestake); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stratos_pot_v1_tx_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgSetAutoRestakeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stratos_pot_v1_tx_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BLSSignatureInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stratos_pot_v1_tx_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgUpdateParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stratos_pot_v1_tx_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MsgUpdateParamsResponse); i {
 			case 0:
 				return &v.state
@@ -6678,6 +14856,30 @@ func file_stratos_pot_v1_tx_proto_init() {
 				return nil
 			}
 		}
+		file_stratos_pot_v1_tx_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgResolveVolumeReportDispute); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stratos_pot_v1_tx_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgResolveVolumeReportDisputeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -6685,7 +14887,7 @@ func file_stratos_pot_v1_tx_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_stratos_pot_v1_tx_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   11,
+			NumMessages:   23,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,11 +19,17 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_HandleMsgVolumeReport_FullMethodName         = "/stratos.pot.v1.Msg/HandleMsgVolumeReport"
-	Msg_HandleMsgWithdraw_FullMethodName             = "/stratos.pot.v1.Msg/HandleMsgWithdraw"
-	Msg_HandleMsgFoundationDeposit_FullMethodName    = "/stratos.pot.v1.Msg/HandleMsgFoundationDeposit"
-	Msg_HandleMsgSlashingResourceNode_FullMethodName = "/stratos.pot.v1.Msg/HandleMsgSlashingResourceNode"
-	Msg_UpdateParams_FullMethodName                  = "/stratos.pot.v1.Msg/UpdateParams"
+	Msg_HandleMsgVolumeReport_FullMethodName             = "/stratos.pot.v1.Msg/HandleMsgVolumeReport"
+	Msg_HandleMsgVolumeReportHeader_FullMethodName       = "/stratos.pot.v1.Msg/HandleMsgVolumeReportHeader"
+	Msg_HandleMsgVolumeReportChunk_FullMethodName        = "/stratos.pot.v1.Msg/HandleMsgVolumeReportChunk"
+	Msg_HandleMsgWithdraw_FullMethodName                 = "/stratos.pot.v1.Msg/HandleMsgWithdraw"
+	Msg_HandleMsgFoundationDeposit_FullMethodName        = "/stratos.pot.v1.Msg/HandleMsgFoundationDeposit"
+	Msg_HandleMsgSlashingResourceNode_FullMethodName     = "/stratos.pot.v1.Msg/HandleMsgSlashingResourceNode"
+	Msg_HandleMsgDisputeVolumeReport_FullMethodName      = "/stratos.pot.v1.Msg/HandleMsgDisputeVolumeReport"
+	Msg_HandleMsgSubmitReportEquivocation_FullMethodName = "/stratos.pot.v1.Msg/HandleMsgSubmitReportEquivocation"
+	Msg_HandleMsgSetAutoRestake_FullMethodName           = "/stratos.pot.v1.Msg/HandleMsgSetAutoRestake"
+	Msg_ResolveVolumeReportDispute_FullMethodName        = "/stratos.pot.v1.Msg/ResolveVolumeReportDispute"
+	Msg_UpdateParams_FullMethodName                      = "/stratos.pot.v1.Msg/UpdateParams"
 )
 
 // MsgClient is the client API for Msg service.
@@ -31,9 +37,17 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type MsgClient interface {
 	HandleMsgVolumeReport(ctx context.Context, in *MsgVolumeReport, opts ...grpc.CallOption) (*MsgVolumeReportResponse, error)
+	HandleMsgVolumeReportHeader(ctx context.Context, in *MsgVolumeReportHeader, opts ...grpc.CallOption) (*MsgVolumeReportHeaderResponse, error)
+	HandleMsgVolumeReportChunk(ctx context.Context, in *MsgVolumeReportChunk, opts ...grpc.CallOption) (*MsgVolumeReportChunkResponse, error)
 	HandleMsgWithdraw(ctx context.Context, in *MsgWithdraw, opts ...grpc.CallOption) (*MsgWithdrawResponse, error)
 	HandleMsgFoundationDeposit(ctx context.Context, in *MsgFoundationDeposit, opts ...grpc.CallOption) (*MsgFoundationDepositResponse, error)
 	HandleMsgSlashingResourceNode(ctx context.Context, in *MsgSlashingResourceNode, opts ...grpc.CallOption) (*MsgSlashingResourceNodeResponse, error)
+	HandleMsgDisputeVolumeReport(ctx context.Context, in *MsgDisputeVolumeReport, opts ...grpc.CallOption) (*MsgDisputeVolumeReportResponse, error)
+	HandleMsgSubmitReportEquivocation(ctx context.Context, in *MsgSubmitReportEquivocation, opts ...grpc.CallOption) (*MsgSubmitReportEquivocationResponse, error)
+	HandleMsgSetAutoRestake(ctx context.Context, in *MsgSetAutoRestake, opts ...grpc.CallOption) (*MsgSetAutoRestakeResponse, error)
+	// ResolveVolumeReportDispute defines a governance operation for resolving a disputed volume report.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	ResolveVolumeReportDispute(ctx context.Context, in *MsgResolveVolumeReportDispute, opts ...grpc.CallOption) (*MsgResolveVolumeReportDisputeResponse, error)
 	// UpdateParams defined a governance operation for updating the x/pot module parameters.
 	// The authority is hard-coded to the Cosmos SDK x/gov module account
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
@@ -56,6 +70,24 @@ func (c *msgClient) HandleMsgVolumeReport(ctx context.Context, in *MsgVolumeRepo
 	return out, nil
 }
 
+func (c *msgClient) HandleMsgVolumeReportHeader(ctx context.Context, in *MsgVolumeReportHeader, opts ...grpc.CallOption) (*MsgVolumeReportHeaderResponse, error) {
+	out := new(MsgVolumeReportHeaderResponse)
+	err := c.cc.Invoke(ctx, Msg_HandleMsgVolumeReportHeader_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) HandleMsgVolumeReportChunk(ctx context.Context, in *MsgVolumeReportChunk, opts ...grpc.CallOption) (*MsgVolumeReportChunkResponse, error) {
+	out := new(MsgVolumeReportChunkResponse)
+	err := c.cc.Invoke(ctx, Msg_HandleMsgVolumeReportChunk_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) HandleMsgWithdraw(ctx context.Context, in *MsgWithdraw, opts ...grpc.CallOption) (*MsgWithdrawResponse, error) {
 	out := new(MsgWithdrawResponse)
 	err := c.cc.Invoke(ctx, Msg_HandleMsgWithdraw_FullMethodName, in, out, opts...)
@@ -83,6 +115,42 @@ func (c *msgClient) HandleMsgSlashingResourceNode(ctx context.Context, in *MsgSl
 	return out, nil
 }
 
+func (c *msgClient) HandleMsgDisputeVolumeReport(ctx context.Context, in *MsgDisputeVolumeReport, opts ...grpc.CallOption) (*MsgDisputeVolumeReportResponse, error) {
+	out := new(MsgDisputeVolumeReportResponse)
+	err := c.cc.Invoke(ctx, Msg_HandleMsgDisputeVolumeReport_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) HandleMsgSubmitReportEquivocation(ctx context.Context, in *MsgSubmitReportEquivocation, opts ...grpc.CallOption) (*MsgSubmitReportEquivocationResponse, error) {
+	out := new(MsgSubmitReportEquivocationResponse)
+	err := c.cc.Invoke(ctx, Msg_HandleMsgSubmitReportEquivocation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) HandleMsgSetAutoRestake(ctx context.Context, in *MsgSetAutoRestake, opts ...grpc.CallOption) (*MsgSetAutoRestakeResponse, error) {
+	out := new(MsgSetAutoRestakeResponse)
+	err := c.cc.Invoke(ctx, Msg_HandleMsgSetAutoRestake_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ResolveVolumeReportDispute(ctx context.Context, in *MsgResolveVolumeReportDispute, opts ...grpc.CallOption) (*MsgResolveVolumeReportDisputeResponse, error) {
+	out := new(MsgResolveVolumeReportDisputeResponse)
+	err := c.cc.Invoke(ctx, Msg_ResolveVolumeReportDispute_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
 	out := new(MsgUpdateParamsResponse)
 	err := c.cc.Invoke(ctx, Msg_UpdateParams_FullMethodName, in, out, opts...)
@@ -97,9 +165,17 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 // for forward compatibility
 type MsgServer interface {
 	HandleMsgVolumeReport(context.Context, *MsgVolumeReport) (*MsgVolumeReportResponse, error)
+	HandleMsgVolumeReportHeader(context.Context, *MsgVolumeReportHeader) (*MsgVolumeReportHeaderResponse, error)
+	HandleMsgVolumeReportChunk(context.Context, *MsgVolumeReportChunk) (*MsgVolumeReportChunkResponse, error)
 	HandleMsgWithdraw(context.Context, *MsgWithdraw) (*MsgWithdrawResponse, error)
 	HandleMsgFoundationDeposit(context.Context, *MsgFoundationDeposit) (*MsgFoundationDepositResponse, error)
 	HandleMsgSlashingResourceNode(context.Context, *MsgSlashingResourceNode) (*MsgSlashingResourceNodeResponse, error)
+	HandleMsgDisputeVolumeReport(context.Context, *MsgDisputeVolumeReport) (*MsgDisputeVolumeReportResponse, error)
+	HandleMsgSubmitReportEquivocation(context.Context, *MsgSubmitReportEquivocation) (*MsgSubmitReportEquivocationResponse, error)
+	HandleMsgSetAutoRestake(context.Context, *MsgSetAutoRestake) (*MsgSetAutoRestakeResponse, error)
+	// ResolveVolumeReportDispute defines a governance operation for resolving a disputed volume report.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	ResolveVolumeReportDispute(context.Context, *MsgResolveVolumeReportDispute) (*MsgResolveVolumeReportDisputeResponse, error)
 	// UpdateParams defined a governance operation for updating the x/pot module parameters.
 	// The authority is hard-coded to the Cosmos SDK x/gov module account
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
@@ -113,6 +189,12 @@ type UnimplementedMsgServer struct {
 func (UnimplementedMsgServer) HandleMsgVolumeReport(context.Context, *MsgVolumeReport) (*MsgVolumeReportResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method HandleMsgVolumeReport not implemented")
 }
+func (UnimplementedMsgServer) HandleMsgVolumeReportHeader(context.Context, *MsgVolumeReportHeader) (*MsgVolumeReportHeaderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HandleMsgVolumeReportHeader not implemented")
+}
+func (UnimplementedMsgServer) HandleMsgVolumeReportChunk(context.Context, *MsgVolumeReportChunk) (*MsgVolumeReportChunkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HandleMsgVolumeReportChunk not implemented")
+}
 func (UnimplementedMsgServer) HandleMsgWithdraw(context.Context, *MsgWithdraw) (*MsgWithdrawResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method HandleMsgWithdraw not implemented")
 }
@@ -122,6 +204,18 @@ func (UnimplementedMsgServer) HandleMsgFoundationDeposit(context.Context, *MsgFo
 func (UnimplementedMsgServer) HandleMsgSlashingResourceNode(context.Context, *MsgSlashingResourceNode) (*MsgSlashingResourceNodeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method HandleMsgSlashingResourceNode not implemented")
 }
+func (UnimplementedMsgServer) HandleMsgDisputeVolumeReport(context.Context, *MsgDisputeVolumeReport) (*MsgDisputeVolumeReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HandleMsgDisputeVolumeReport not implemented")
+}
+func (UnimplementedMsgServer) HandleMsgSubmitReportEquivocation(context.Context, *MsgSubmitReportEquivocation) (*MsgSubmitReportEquivocationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HandleMsgSubmitReportEquivocation not implemented")
+}
+func (UnimplementedMsgServer) HandleMsgSetAutoRestake(context.Context, *MsgSetAutoRestake) (*MsgSetAutoRestakeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HandleMsgSetAutoRestake not implemented")
+}
+func (UnimplementedMsgServer) ResolveVolumeReportDispute(context.Context, *MsgResolveVolumeReportDispute) (*MsgResolveVolumeReportDisputeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveVolumeReportDispute not implemented")
+}
 func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
@@ -156,6 +250,42 @@ func _Msg_HandleMsgVolumeReport_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_HandleMsgVolumeReportHeader_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgVolumeReportHeader)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).HandleMsgVolumeReportHeader(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_HandleMsgVolumeReportHeader_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).HandleMsgVolumeReportHeader(ctx, req.(*MsgVolumeReportHeader))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_HandleMsgVolumeReportChunk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgVolumeReportChunk)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).HandleMsgVolumeReportChunk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_HandleMsgVolumeReportChunk_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).HandleMsgVolumeReportChunk(ctx, req.(*MsgVolumeReportChunk))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_HandleMsgWithdraw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgWithdraw)
 	if err := dec(in); err != nil {
@@ -210,6 +340,78 @@ func _Msg_HandleMsgSlashingResourceNode_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_HandleMsgDisputeVolumeReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDisputeVolumeReport)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).HandleMsgDisputeVolumeReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_HandleMsgDisputeVolumeReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).HandleMsgDisputeVolumeReport(ctx, req.(*MsgDisputeVolumeReport))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_HandleMsgSubmitReportEquivocation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitReportEquivocation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).HandleMsgSubmitReportEquivocation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_HandleMsgSubmitReportEquivocation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).HandleMsgSubmitReportEquivocation(ctx, req.(*MsgSubmitReportEquivocation))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_HandleMsgSetAutoRestake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAutoRestake)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).HandleMsgSetAutoRestake(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_HandleMsgSetAutoRestake_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).HandleMsgSetAutoRestake(ctx, req.(*MsgSetAutoRestake))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ResolveVolumeReportDispute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgResolveVolumeReportDispute)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ResolveVolumeReportDispute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ResolveVolumeReportDispute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ResolveVolumeReportDispute(ctx, req.(*MsgResolveVolumeReportDispute))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgUpdateParams)
 	if err := dec(in); err != nil {
@@ -239,6 +441,14 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "HandleMsgVolumeReport",
 			Handler:    _Msg_HandleMsgVolumeReport_Handler,
 		},
+		{
+			MethodName: "HandleMsgVolumeReportHeader",
+			Handler:    _Msg_HandleMsgVolumeReportHeader_Handler,
+		},
+		{
+			MethodName: "HandleMsgVolumeReportChunk",
+			Handler:    _Msg_HandleMsgVolumeReportChunk_Handler,
+		},
 		{
 			MethodName: "HandleMsgWithdraw",
 			Handler:    _Msg_HandleMsgWithdraw_Handler,
@@ -251,6 +461,22 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "HandleMsgSlashingResourceNode",
 			Handler:    _Msg_HandleMsgSlashingResourceNode_Handler,
 		},
+		{
+			MethodName: "HandleMsgDisputeVolumeReport",
+			Handler:    _Msg_HandleMsgDisputeVolumeReport_Handler,
+		},
+		{
+			MethodName: "HandleMsgSubmitReportEquivocation",
+			Handler:    _Msg_HandleMsgSubmitReportEquivocation_Handler,
+		},
+		{
+			MethodName: "HandleMsgSetAutoRestake",
+			Handler:    _Msg_HandleMsgSetAutoRestake_Handler,
+		},
+		{
+			MethodName: "ResolveVolumeReportDispute",
+			Handler:    _Msg_ResolveVolumeReportDispute_Handler,
+		},
 		{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
@@ -6196,6 +6196,554 @@ func (x *fastReflection_EventUpdateMetaNode) ProtoMethods() *protoiface.Methods
 	}
 }
 
+var (
+	md_EventRegisterMetaNodeBLSPubKey                 protoreflect.MessageDescriptor
+	fd_EventRegisterMetaNodeBLSPubKey_sender          protoreflect.FieldDescriptor
+	fd_EventRegisterMetaNodeBLSPubKey_network_address protoreflect.FieldDescriptor
+	fd_EventRegisterMetaNodeBLSPubKey_bls_pub_key     protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_stratos_register_v1_event_proto_init()
+	md_EventRegisterMetaNodeBLSPubKey = File_stratos_register_v1_event_proto.Messages().ByName("EventRegisterMetaNodeBLSPubKey")
+	fd_EventRegisterMetaNodeBLSPubKey_sender = md_EventRegisterMetaNodeBLSPubKey.Fields().ByName("sender")
+	fd_EventRegisterMetaNodeBLSPubKey_network_address = md_EventRegisterMetaNodeBLSPubKey.Fields().ByName("network_address")
+	fd_EventRegisterMetaNodeBLSPubKey_bls_pub_key = md_EventRegisterMetaNodeBLSPubKey.Fields().ByName("bls_pub_key")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventRegisterMetaNodeBLSPubKey)(nil)
+
+type fastReflection_EventRegisterMetaNodeBLSPubKey EventRegisterMetaNodeBLSPubKey
+
+func (x *EventRegisterMetaNodeBLSPubKey) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventRegisterMetaNodeBLSPubKey)(x)
+}
+
+func (x *EventRegisterMetaNodeBLSPubKey) slowProtoReflect() protoreflect.Message {
+	mi := &file_stratos_register_v1_event_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventRegisterMetaNodeBLSPubKey_messageType fastReflection_EventRegisterMetaNodeBLSPubKey_messageType
+var _ protoreflect.MessageType = fastReflection_EventRegisterMetaNodeBLSPubKey_messageType{}
+
+type fastReflection_EventRegisterMetaNodeBLSPubKey_messageType struct{}
+
+func (x fastReflection_EventRegisterMetaNodeBLSPubKey_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventRegisterMetaNodeBLSPubKey)(nil)
+}
+func (x fastReflection_EventRegisterMetaNodeBLSPubKey_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventRegisterMetaNodeBLSPubKey)
+}
+func (x fastReflection_EventRegisterMetaNodeBLSPubKey_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventRegisterMetaNodeBLSPubKey
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventRegisterMetaNodeBLSPubKey) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventRegisterMetaNodeBLSPubKey
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventRegisterMetaNodeBLSPubKey) Type() protoreflect.MessageType {
+	return _fastReflection_EventRegisterMetaNodeBLSPubKey_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventRegisterMetaNodeBLSPubKey) New() protoreflect.Message {
+	return new(fastReflection_EventRegisterMetaNodeBLSPubKey)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventRegisterMetaNodeBLSPubKey) Interface() protoreflect.ProtoMessage {
+	return (*EventRegisterMetaNodeBLSPubKey)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventRegisterMetaNodeBLSPubKey) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Sender != "" {
+		value := protoreflect.ValueOfString(x.Sender)
+		if !f(fd_EventRegisterMetaNodeBLSPubKey_sender, value) {
+			return
+		}
+	}
+	if x.NetworkAddress != "" {
+		value := protoreflect.ValueOfString(x.NetworkAddress)
+		if !f(fd_EventRegisterMetaNodeBLSPubKey_network_address, value) {
+			return
+		}
+	}
+	if x.BlsPubKey != "" {
+		value := protoreflect.ValueOfString(x.BlsPubKey)
+		if !f(fd_EventRegisterMetaNodeBLSPubKey_bls_pub_key, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventRegisterMetaNodeBLSPubKey) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "stratos.register.v1.EventRegisterMetaNodeBLSPubKey.sender":
+		return x.Sender != ""
+	case "stratos.register.v1.EventRegisterMetaNodeBLSPubKey.network_address":
+		return x.NetworkAddress != ""
+	case "stratos.register.v1.EventRegisterMetaNodeBLSPubKey.bls_pub_key":
+		return x.BlsPubKey != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.register.v1.EventRegisterMetaNodeBLSPubKey"))
+		}
+		panic(fmt.Errorf("message stratos.register.v1.EventRegisterMetaNodeBLSPubKey does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventRegisterMetaNodeBLSPubKey) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "stratos.register.v1.EventRegisterMetaNodeBLSPubKey.sender":
+		x.Sender = ""
+	case "stratos.register.v1.EventRegisterMetaNodeBLSPubKey.network_address":
+		x.NetworkAddress = ""
+	case "stratos.register.v1.EventRegisterMetaNodeBLSPubKey.bls_pub_key":
+		x.BlsPubKey = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.register.v1.EventRegisterMetaNodeBLSPubKey"))
+		}
+		panic(fmt.Errorf("message stratos.register.v1.EventRegisterMetaNodeBLSPubKey does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventRegisterMetaNodeBLSPubKey) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "stratos.register.v1.EventRegisterMetaNodeBLSPubKey.sender":
+		value := x.Sender
+		return protoreflect.ValueOfString(value)
+	case "stratos.register.v1.EventRegisterMetaNodeBLSPubKey.network_address":
+		value := x.NetworkAddress
+		return protoreflect.ValueOfString(value)
+	case "stratos.register.v1.EventRegisterMetaNodeBLSPubKey.bls_pub_key":
+		value := x.BlsPubKey
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.register.v1.EventRegisterMetaNodeBLSPubKey"))
+		}
+		panic(fmt.Errorf("message stratos.register.v1.EventRegisterMetaNodeBLSPubKey does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventRegisterMetaNodeBLSPubKey) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "stratos.register.v1.EventRegisterMetaNodeBLSPubKey.sender":
+		x.Sender = value.Interface().(string)
+	case "stratos.register.v1.EventRegisterMetaNodeBLSPubKey.network_address":
+		x.NetworkAddress = value.Interface().(string)
+	case "stratos.register.v1.EventRegisterMetaNodeBLSPubKey.bls_pub_key":
+		x.BlsPubKey = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.register.v1.EventRegisterMetaNodeBLSPubKey"))
+		}
+		panic(fmt.Errorf("message stratos.register.v1.EventRegisterMetaNodeBLSPubKey does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventRegisterMetaNodeBLSPubKey) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "stratos.register.v1.EventRegisterMetaNodeBLSPubKey.sender":
+		panic(fmt.Errorf("field sender of message stratos.register.v1.EventRegisterMetaNodeBLSPubKey is not mutable"))
+	case "stratos.register.v1.EventRegisterMetaNodeBLSPubKey.network_address":
+		panic(fmt.Errorf("field network_address of message stratos.register.v1.EventRegisterMetaNodeBLSPubKey is not mutable"))
+	case "stratos.register.v1.EventRegisterMetaNodeBLSPubKey.bls_pub_key":
+		panic(fmt.Errorf("field bls_pub_key of message stratos.register.v1.EventRegisterMetaNodeBLSPubKey is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.register.v1.EventRegisterMetaNodeBLSPubKey"))
+		}
+		panic(fmt.Errorf("message stratos.register.v1.EventRegisterMetaNodeBLSPubKey does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventRegisterMetaNodeBLSPubKey) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "stratos.register.v1.EventRegisterMetaNodeBLSPubKey.sender":
+		return protoreflect.ValueOfString("")
+	case "stratos.register.v1.EventRegisterMetaNodeBLSPubKey.network_address":
+		return protoreflect.ValueOfString("")
+	case "stratos.register.v1.EventRegisterMetaNodeBLSPubKey.bls_pub_key":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.register.v1.EventRegisterMetaNodeBLSPubKey"))
+		}
+		panic(fmt.Errorf("message stratos.register.v1.EventRegisterMetaNodeBLSPubKey does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventRegisterMetaNodeBLSPubKey) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in stratos.register.v1.EventRegisterMetaNodeBLSPubKey", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventRegisterMetaNodeBLSPubKey) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventRegisterMetaNodeBLSPubKey) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventRegisterMetaNodeBLSPubKey) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventRegisterMetaNodeBLSPubKey) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventRegisterMetaNodeBLSPubKey)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Sender)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.NetworkAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.BlsPubKey)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventRegisterMetaNodeBLSPubKey)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.BlsPubKey) > 0 {
+			i -= len(x.BlsPubKey)
+			copy(dAtA[i:], x.BlsPubKey)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BlsPubKey)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.NetworkAddress) > 0 {
+			i -= len(x.NetworkAddress)
+			copy(dAtA[i:], x.NetworkAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NetworkAddress)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Sender) > 0 {
+			i -= len(x.Sender)
+			copy(dAtA[i:], x.Sender)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Sender)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventRegisterMetaNodeBLSPubKey)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventRegisterMetaNodeBLSPubKey: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventRegisterMetaNodeBLSPubKey: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Sender = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NetworkAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.NetworkAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlsPubKey", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BlsPubKey = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
 var (
 	md_EventUpdateMetaNodeDeposit                        protoreflect.MessageDescriptor
 	fd_EventUpdateMetaNodeDeposit_sender                 protoreflect.FieldDescriptor
@@ -6230,7 +6778,7 @@ func (x *EventUpdateMetaNodeDeposit) ProtoReflect() protoreflect.Message {
 }
 
 func (x *EventUpdateMetaNodeDeposit) slowProtoReflect() protoreflect.Message {
-	mi := &file_stratos_register_v1_event_proto_msgTypes[10]
+	mi := &file_stratos_register_v1_event_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7086,7 +7634,7 @@ func (x *EventCompleteUnBondingResourceNode) ProtoReflect() protoreflect.Message
 }
 
 func (x *EventCompleteUnBondingResourceNode) slowProtoReflect() protoreflect.Message {
-	mi := &file_stratos_register_v1_event_proto_msgTypes[11]
+	mi := &file_stratos_register_v1_event_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7570,7 +8118,7 @@ func (x *EventCompleteUnBondingMetaNode) ProtoReflect() protoreflect.Message {
 }
 
 func (x *EventCompleteUnBondingMetaNode) slowProtoReflect() protoreflect.Message {
-	mi := &file_stratos_register_v1_event_proto_msgTypes[12]
+	mi := &file_stratos_register_v1_event_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8652,6 +9200,58 @@ func (x *EventUpdateMetaNode) GetNetworkAddress() string {
 	return ""
 }
 
+// EventRegisterMetaNodeBLSPubKey is emitted on Msg/MsgRegisterMetaNodeBLSPubKey
+type EventRegisterMetaNodeBLSPubKey struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sender         string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	NetworkAddress string `protobuf:"bytes,2,opt,name=network_address,json=networkAddress,proto3" json:"network_address,omitempty"`
+	BlsPubKey      string `protobuf:"bytes,3,opt,name=bls_pub_key,json=blsPubKey,proto3" json:"bls_pub_key,omitempty"`
+}
+
+func (x *EventRegisterMetaNodeBLSPubKey) Reset() {
+	*x = EventRegisterMetaNodeBLSPubKey{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stratos_register_v1_event_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventRegisterMetaNodeBLSPubKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventRegisterMetaNodeBLSPubKey) ProtoMessage() {}
+
+// Deprecated: Use EventRegisterMetaNodeBLSPubKey.ProtoReflect.Descriptor instead.
+func (*EventRegisterMetaNodeBLSPubKey) Descriptor() ([]byte, []int) {
+	return file_stratos_register_v1_event_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *EventRegisterMetaNodeBLSPubKey) GetSender() string {
+	if x != nil {
+		return x.Sender
+	}
+	return ""
+}
+
+func (x *EventRegisterMetaNodeBLSPubKey) GetNetworkAddress() string {
+	if x != nil {
+		return x.NetworkAddress
+	}
+	return ""
+}
+
+func (x *EventRegisterMetaNodeBLSPubKey) GetBlsPubKey() string {
+	if x != nil {
+		return x.BlsPubKey
+	}
+	return ""
+}
+
 // EventUpdateMetaNodeDeposit is emitted on Msg/MsgUpdateMetaNodeDeposit
 type EventUpdateMetaNodeDeposit struct {
 	state         protoimpl.MessageState
@@ -8671,7 +9271,7 @@ type EventUpdateMetaNodeDeposit struct {
 func (x *EventUpdateMetaNodeDeposit) Reset() {
 	*x = EventUpdateMetaNodeDeposit{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_stratos_register_v1_event_proto_msgTypes[10]
+		mi := &file_stratos_register_v1_event_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8685,7 +9285,7 @@ func (*EventUpdateMetaNodeDeposit) ProtoMessage() {}
 
 // Deprecated: Use EventUpdateMetaNodeDeposit.ProtoReflect.Descriptor instead.
 func (*EventUpdateMetaNodeDeposit) Descriptor() ([]byte, []int) {
-	return file_stratos_register_v1_event_proto_rawDescGZIP(), []int{10}
+	return file_stratos_register_v1_event_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *EventUpdateMetaNodeDeposit) GetSender() string {
@@ -8757,7 +9357,7 @@ type EventCompleteUnBondingResourceNode struct {
 func (x *EventCompleteUnBondingResourceNode) Reset() {
 	*x = EventCompleteUnBondingResourceNode{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_stratos_register_v1_event_proto_msgTypes[11]
+		mi := &file_stratos_register_v1_event_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8771,7 +9371,7 @@ func (*EventCompleteUnBondingResourceNode) ProtoMessage() {}
 
 // Deprecated: Use EventCompleteUnBondingResourceNode.ProtoReflect.Descriptor instead.
 func (*EventCompleteUnBondingResourceNode) Descriptor() ([]byte, []int) {
-	return file_stratos_register_v1_event_proto_rawDescGZIP(), []int{11}
+	return file_stratos_register_v1_event_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *EventCompleteUnBondingResourceNode) GetAmount() string {
@@ -8801,7 +9401,7 @@ type EventCompleteUnBondingMetaNode struct {
 func (x *EventCompleteUnBondingMetaNode) Reset() {
 	*x = EventCompleteUnBondingMetaNode{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_stratos_register_v1_event_proto_msgTypes[12]
+		mi := &file_stratos_register_v1_event_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8815,7 +9415,7 @@ func (*EventCompleteUnBondingMetaNode) ProtoMessage() {}
 
 // Deprecated: Use EventCompleteUnBondingMetaNode.ProtoReflect.Descriptor instead.
 func (*EventCompleteUnBondingMetaNode) Descriptor() ([]byte, []int) {
-	return file_stratos_register_v1_event_proto_rawDescGZIP(), []int{12}
+	return file_stratos_register_v1_event_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *EventCompleteUnBondingMetaNode) GetAmount() string {
@@ -8959,57 +9559,65 @@ var file_stratos_register_v1_event_proto_rawDesc = []byte{
 	0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e,
 	0x64, 0x65, 0x72, 0x12, 0x27, 0x0a, 0x0f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x61,
 	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6e, 0x65,
-	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xf9, 0x02, 0x0a,
-	0x1a, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x74, 0x61,
-	0x4e, 0x6f, 0x64, 0x65, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73,
-	0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e,
-	0x64, 0x65, 0x72, 0x12, 0x27, 0x0a, 0x0f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6e, 0x65,
-	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x23, 0x0a, 0x0d,
-	0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x44, 0x65, 0x6c, 0x74,
-	0x61, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x64, 0x65, 0x70,
-	0x6f, 0x73, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x75, 0x72, 0x72,
-	0x65, 0x6e, 0x74, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x34, 0x0a, 0x16, 0x61, 0x76,
-	0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x62, 0x65,
-	0x66, 0x6f, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x61, 0x76, 0x61, 0x69,
-	0x6c, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65,
-	0x12, 0x32, 0x0a, 0x15, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x74, 0x6f,
-	0x6b, 0x65, 0x6e, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x13, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x41,
-	0x66, 0x74, 0x65, 0x72, 0x12, 0x2e, 0x0a, 0x13, 0x6f, 0x7a, 0x6f, 0x6e, 0x65, 0x5f, 0x6c, 0x69,
-	0x6d, 0x69, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x11, 0x6f, 0x7a, 0x6f, 0x6e, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x43, 0x68, 0x61,
-	0x6e, 0x67, 0x65, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x75, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e,
-	0x67, 0x5f, 0x6d, 0x61, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x13, 0x75, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4d, 0x61,
-	0x74, 0x75, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x65, 0x0a, 0x22, 0x45, 0x76, 0x65, 0x6e,
-	0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x6e, 0x42, 0x6f, 0x6e, 0x64, 0x69,
-	0x6e, 0x67, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x16,
-	0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
-	0x6b, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22,
-	0x61, 0x0a, 0x1e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65,
-	0x55, 0x6e, 0x42, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4d, 0x65, 0x74, 0x61, 0x4e, 0x6f, 0x64,
-	0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x6e, 0x65, 0x74,
-	0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x41, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x42, 0xc4, 0x01, 0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74,
-	0x6f, 0x73, 0x2e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x42, 0x0a,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2f, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x73,
-	0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2f, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x2f,
-	0x76, 0x31, 0x3b, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x76, 0x31, 0xa2, 0x02, 0x03,
-	0x53, 0x52, 0x58, 0xaa, 0x02, 0x13, 0x53, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e, 0x52, 0x65,
-	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x13, 0x53, 0x74, 0x72, 0x61,
-	0x74, 0x6f, 0x73, 0x5c, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x5c, 0x56, 0x31, 0xe2,
-	0x02, 0x1f, 0x53, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x5c, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
-	0x65, 0x72, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0xea, 0x02, 0x15, 0x53, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x3a, 0x3a, 0x52, 0x65, 0x67,
-	0x69, 0x73, 0x74, 0x65, 0x72, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x81, 0x01, 0x0a,
+	0x1e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x4d, 0x65,
+	0x74, 0x61, 0x4e, 0x6f, 0x64, 0x65, 0x42, 0x4c, 0x53, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x27, 0x0a, 0x0f, 0x6e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x1e, 0x0a, 0x0b, 0x62, 0x6c, 0x73, 0x5f, 0x70, 0x75, 0x62, 0x5f, 0x6b, 0x65, 0x79, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x6c, 0x73, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79,
+	0x22, 0xf9, 0x02, 0x0a, 0x1a, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x4d, 0x65, 0x74, 0x61, 0x4e, 0x6f, 0x64, 0x65, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x27, 0x0a, 0x0f, 0x6e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x23, 0x0a, 0x0d, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x64, 0x65, 0x6c, 0x74,
+	0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x44, 0x65, 0x6c, 0x74, 0x61, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
+	0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x34,
+	0x0a, 0x16, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14,
+	0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x65,
+	0x66, 0x6f, 0x72, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c,
+	0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x13, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x41, 0x66, 0x74, 0x65, 0x72, 0x12, 0x2e, 0x0a, 0x13, 0x6f, 0x7a, 0x6f, 0x6e,
+	0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6f, 0x7a, 0x6f, 0x6e, 0x65, 0x4c, 0x69, 0x6d, 0x69,
+	0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x75, 0x6e, 0x62, 0x6f,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x61, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x75, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x4d, 0x61, 0x74, 0x75, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x65, 0x0a, 0x22,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x6e, 0x42,
+	0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e, 0x6f,
+	0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x6e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x22, 0x61, 0x0a, 0x1e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x70,
+	0x6c, 0x65, 0x74, 0x65, 0x55, 0x6e, 0x42, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4d, 0x65, 0x74,
+	0x61, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x27, 0x0a,
+	0x0f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x42, 0xc4, 0x01, 0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x2e, 0x73,
+	0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x42, 0x0a, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01,
+	0x5a, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2f, 0x72, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x3b, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x76,
+	0x31, 0xa2, 0x02, 0x03, 0x53, 0x52, 0x58, 0xaa, 0x02, 0x13, 0x53, 0x74, 0x72, 0x61, 0x74, 0x6f,
+	0x73, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x13,
+	0x53, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x5c, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72,
+	0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1f, 0x53, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x5c, 0x52, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x15, 0x53, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x3a,
+	0x3a, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -9024,7 +9632,7 @@ func file_stratos_register_v1_event_proto_rawDescGZIP() []byte {
 	return file_stratos_register_v1_event_proto_rawDescData
 }
 
-var file_stratos_register_v1_event_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_stratos_register_v1_event_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
 var file_stratos_register_v1_event_proto_goTypes = []interface{}{
 	(*EventCreateResourceNode)(nil),            // 0: stratos.register.v1.EventCreateResourceNode
 	(*EventCreateMetaNode)(nil),                // 1: stratos.register.v1.EventCreateMetaNode
@@ -9036,9 +9644,10 @@ var file_stratos_register_v1_event_proto_goTypes = []interface{}{
 	(*EventUpdateResourceNodeDeposit)(nil),     // 7: stratos.register.v1.EventUpdateResourceNodeDeposit
 	(*EventUpdateEffectiveDeposit)(nil),        // 8: stratos.register.v1.EventUpdateEffectiveDeposit
 	(*EventUpdateMetaNode)(nil),                // 9: stratos.register.v1.EventUpdateMetaNode
-	(*EventUpdateMetaNodeDeposit)(nil),         // 10: stratos.register.v1.EventUpdateMetaNodeDeposit
-	(*EventCompleteUnBondingResourceNode)(nil), // 11: stratos.register.v1.EventCompleteUnBondingResourceNode
-	(*EventCompleteUnBondingMetaNode)(nil),     // 12: stratos.register.v1.EventCompleteUnBondingMetaNode
+	(*EventRegisterMetaNodeBLSPubKey)(nil),     // 10: stratos.register.v1.EventRegisterMetaNodeBLSPubKey
+	(*EventUpdateMetaNodeDeposit)(nil),         // 11: stratos.register.v1.EventUpdateMetaNodeDeposit
+	(*EventCompleteUnBondingResourceNode)(nil), // 12: stratos.register.v1.EventCompleteUnBondingResourceNode
+	(*EventCompleteUnBondingMetaNode)(nil),     // 13: stratos.register.v1.EventCompleteUnBondingMetaNode
 }
 var file_stratos_register_v1_event_proto_depIdxs = []int32{
 	0, // [0:0] is the sub-list for method output_type
@@ -9175,7 +9784,7 @@ func file_stratos_register_v1_event_proto_init() {
 			}
 		}
 		file_stratos_register_v1_event_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EventUpdateMetaNodeDeposit); i {
+			switch v := v.(*EventRegisterMetaNodeBLSPubKey); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9187,7 +9796,7 @@ func file_stratos_register_v1_event_proto_init() {
 			}
 		}
 		file_stratos_register_v1_event_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EventCompleteUnBondingResourceNode); i {
+			switch v := v.(*EventUpdateMetaNodeDeposit); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9199,6 +9808,18 @@ func file_stratos_register_v1_event_proto_init() {
 			}
 		}
 		file_stratos_register_v1_event_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventCompleteUnBondingResourceNode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stratos_register_v1_event_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*EventCompleteUnBondingMetaNode); i {
 			case 0:
 				return &v.state
@@ -9217,7 +9838,7 @@ func file_stratos_register_v1_event_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_stratos_register_v1_event_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   13,
+			NumMessages:   14,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
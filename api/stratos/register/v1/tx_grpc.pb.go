@@ -30,6 +30,7 @@ const (
 	Msg_HandleMsgUpdateMetaNodeDeposit_FullMethodName     = "/stratos.register.v1.Msg/HandleMsgUpdateMetaNodeDeposit"
 	Msg_HandleMsgMetaNodeRegistrationVote_FullMethodName  = "/stratos.register.v1.Msg/HandleMsgMetaNodeRegistrationVote"
 	Msg_HandleMsgKickMetaNodeVote_FullMethodName          = "/stratos.register.v1.Msg/HandleMsgKickMetaNodeVote"
+	Msg_HandleMsgRegisterMetaNodeBLSPubKey_FullMethodName = "/stratos.register.v1.Msg/HandleMsgRegisterMetaNodeBLSPubKey"
 	Msg_UpdateParams_FullMethodName                       = "/stratos.register.v1.Msg/UpdateParams"
 )
 
@@ -49,6 +50,7 @@ type MsgClient interface {
 	HandleMsgUpdateMetaNodeDeposit(ctx context.Context, in *MsgUpdateMetaNodeDeposit, opts ...grpc.CallOption) (*MsgUpdateMetaNodeDepositResponse, error)
 	HandleMsgMetaNodeRegistrationVote(ctx context.Context, in *MsgMetaNodeRegistrationVote, opts ...grpc.CallOption) (*MsgMetaNodeRegistrationVoteResponse, error)
 	HandleMsgKickMetaNodeVote(ctx context.Context, in *MsgKickMetaNodeVote, opts ...grpc.CallOption) (*MsgKickMetaNodeVoteResponse, error)
+	HandleMsgRegisterMetaNodeBLSPubKey(ctx context.Context, in *MsgRegisterMetaNodeBLSPubKey, opts ...grpc.CallOption) (*MsgRegisterMetaNodeBLSPubKeyResponse, error)
 	// UpdateParams defined a governance operation for updating the x/register module parameters.
 	// The authority is hard-coded to the Cosmos SDK x/gov module account
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
@@ -161,6 +163,15 @@ func (c *msgClient) HandleMsgKickMetaNodeVote(ctx context.Context, in *MsgKickMe
 	return out, nil
 }
 
+func (c *msgClient) HandleMsgRegisterMetaNodeBLSPubKey(ctx context.Context, in *MsgRegisterMetaNodeBLSPubKey, opts ...grpc.CallOption) (*MsgRegisterMetaNodeBLSPubKeyResponse, error) {
+	out := new(MsgRegisterMetaNodeBLSPubKeyResponse)
+	err := c.cc.Invoke(ctx, Msg_HandleMsgRegisterMetaNodeBLSPubKey_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
 	out := new(MsgUpdateParamsResponse)
 	err := c.cc.Invoke(ctx, Msg_UpdateParams_FullMethodName, in, out, opts...)
@@ -186,6 +197,7 @@ type MsgServer interface {
 	HandleMsgUpdateMetaNodeDeposit(context.Context, *MsgUpdateMetaNodeDeposit) (*MsgUpdateMetaNodeDepositResponse, error)
 	HandleMsgMetaNodeRegistrationVote(context.Context, *MsgMetaNodeRegistrationVote) (*MsgMetaNodeRegistrationVoteResponse, error)
 	HandleMsgKickMetaNodeVote(context.Context, *MsgKickMetaNodeVote) (*MsgKickMetaNodeVoteResponse, error)
+	HandleMsgRegisterMetaNodeBLSPubKey(context.Context, *MsgRegisterMetaNodeBLSPubKey) (*MsgRegisterMetaNodeBLSPubKeyResponse, error)
 	// UpdateParams defined a governance operation for updating the x/register module parameters.
 	// The authority is hard-coded to the Cosmos SDK x/gov module account
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
@@ -229,6 +241,9 @@ func (UnimplementedMsgServer) HandleMsgMetaNodeRegistrationVote(context.Context,
 func (UnimplementedMsgServer) HandleMsgKickMetaNodeVote(context.Context, *MsgKickMetaNodeVote) (*MsgKickMetaNodeVoteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method HandleMsgKickMetaNodeVote not implemented")
 }
+func (UnimplementedMsgServer) HandleMsgRegisterMetaNodeBLSPubKey(context.Context, *MsgRegisterMetaNodeBLSPubKey) (*MsgRegisterMetaNodeBLSPubKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HandleMsgRegisterMetaNodeBLSPubKey not implemented")
+}
 func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
@@ -443,6 +458,24 @@ func _Msg_HandleMsgKickMetaNodeVote_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_HandleMsgRegisterMetaNodeBLSPubKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterMetaNodeBLSPubKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).HandleMsgRegisterMetaNodeBLSPubKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_HandleMsgRegisterMetaNodeBLSPubKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).HandleMsgRegisterMetaNodeBLSPubKey(ctx, req.(*MsgRegisterMetaNodeBLSPubKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgUpdateParams)
 	if err := dec(in); err != nil {
@@ -512,6 +545,10 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "HandleMsgKickMetaNodeVote",
 			Handler:    _Msg_HandleMsgKickMetaNodeVote_Handler,
 		},
+		{
+			MethodName: "HandleMsgRegisterMetaNodeBLSPubKey",
+			Handler:    _Msg_HandleMsgRegisterMetaNodeBLSPubKey_Handler,
+		},
 		{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
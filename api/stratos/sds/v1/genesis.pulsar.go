@@ -65,263 +65,1043 @@ func (x *_GenesisState_2_list) IsValid() bool {
 	return x.list != nil
 }
 
-var (
-	md_GenesisState        protoreflect.MessageDescriptor
-	fd_GenesisState_params protoreflect.FieldDescriptor
-	fd_GenesisState_files  protoreflect.FieldDescriptor
-)
+var _ protoreflect.List = (*_GenesisState_3_list)(nil)
 
-func init() {
-	file_stratos_sds_v1_genesis_proto_init()
-	md_GenesisState = File_stratos_sds_v1_genesis_proto.Messages().ByName("GenesisState")
-	fd_GenesisState_params = md_GenesisState.Fields().ByName("params")
-	fd_GenesisState_files = md_GenesisState.Fields().ByName("files")
+type _GenesisState_3_list struct {
+	list *[]*PrepaySubscription
 }
 
-var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
-
-type fastReflection_GenesisState GenesisState
-
-func (x *GenesisState) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_GenesisState)(x)
+func (x *_GenesisState_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
 }
 
-func (x *GenesisState) slowProtoReflect() protoreflect.Message {
-	mi := &file_stratos_sds_v1_genesis_proto_msgTypes[0]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (x *_GenesisState_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-var _fastReflection_GenesisState_messageType fastReflection_GenesisState_messageType
-var _ protoreflect.MessageType = fastReflection_GenesisState_messageType{}
+func (x *_GenesisState_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PrepaySubscription)
+	(*x.list)[i] = concreteValue
+}
 
-type fastReflection_GenesisState_messageType struct{}
+func (x *_GenesisState_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PrepaySubscription)
+	*x.list = append(*x.list, concreteValue)
+}
 
-func (x fastReflection_GenesisState_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_GenesisState)(nil)
+func (x *_GenesisState_3_list) AppendMutable() protoreflect.Value {
+	v := new(PrepaySubscription)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
-func (x fastReflection_GenesisState_messageType) New() protoreflect.Message {
-	return new(fastReflection_GenesisState)
+
+func (x *_GenesisState_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
 }
-func (x fastReflection_GenesisState_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_GenesisState
+
+func (x *_GenesisState_3_list) NewElement() protoreflect.Value {
+	v := new(PrepaySubscription)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-// Descriptor returns message descriptor, which contains only the protobuf
-// type information for the message.
-func (x *fastReflection_GenesisState) Descriptor() protoreflect.MessageDescriptor {
-	return md_GenesisState
+func (x *_GenesisState_3_list) IsValid() bool {
+	return x.list != nil
 }
 
-// Type returns the message type, which encapsulates both Go and protobuf
-// type information. If the Go type information is not needed,
-// it is recommended that the message descriptor be used instead.
-func (x *fastReflection_GenesisState) Type() protoreflect.MessageType {
-	return _fastReflection_GenesisState_messageType
+var _ protoreflect.List = (*_GenesisState_5_list)(nil)
+
+type _GenesisState_5_list struct {
+	list *[]*NozPriceSnapshot
 }
 
-// New returns a newly allocated and mutable empty message.
-func (x *fastReflection_GenesisState) New() protoreflect.Message {
-	return new(fastReflection_GenesisState)
+func (x *_GenesisState_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
 }
 
-// Interface unwraps the message reflection interface and
-// returns the underlying ProtoMessage interface.
-func (x *fastReflection_GenesisState) Interface() protoreflect.ProtoMessage {
-	return (*GenesisState)(x)
+func (x *_GenesisState_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-// Range iterates over every populated field in an undefined order,
-// calling f for each field descriptor and value encountered.
-// Range returns immediately if f returns false.
-// While iterating, mutating operations may only be performed
-// on the current field descriptor.
-func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Params != nil {
-		value := protoreflect.ValueOfMessage(x.Params.ProtoReflect())
-		if !f(fd_GenesisState_params, value) {
-			return
-		}
-	}
-	if len(x.Files) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_2_list{list: &x.Files})
-		if !f(fd_GenesisState_files, value) {
-			return
-		}
-	}
+func (x *_GenesisState_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*NozPriceSnapshot)
+	(*x.list)[i] = concreteValue
 }
 
-// Has reports whether a field is populated.
-//
-// Some fields have the property of nullability where it is possible to
-// distinguish between the default value of a field and whether the field
-// was explicitly populated with the default value. Singular message fields,
-// member fields of a oneof, and proto2 scalar fields are nullable. Such
-// fields are populated only if explicitly set.
-//
-// In other cases (aside from the nullable cases above),
-// a proto3 scalar field is populated if it contains a non-zero value, and
-// a repeated field is populated if it is non-empty.
-func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool {
-	switch fd.FullName() {
-	case "stratos.sds.v1.GenesisState.params":
-		return x.Params != nil
-	case "stratos.sds.v1.GenesisState.files":
-		return len(x.Files) != 0
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.sds.v1.GenesisState"))
-		}
-		panic(fmt.Errorf("message stratos.sds.v1.GenesisState does not contain field %s", fd.FullName()))
-	}
+func (x *_GenesisState_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*NozPriceSnapshot)
+	*x.list = append(*x.list, concreteValue)
 }
 
-// Clear clears the field such that a subsequent Has call reports false.
-//
-// Clearing an extension field clears both the extension type and value
-// associated with the given field number.
-//
-// Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
-	switch fd.FullName() {
-	case "stratos.sds.v1.GenesisState.params":
-		x.Params = nil
-	case "stratos.sds.v1.GenesisState.files":
-		x.Files = nil
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.sds.v1.GenesisState"))
-		}
-		panic(fmt.Errorf("message stratos.sds.v1.GenesisState does not contain field %s", fd.FullName()))
-	}
+func (x *_GenesisState_5_list) AppendMutable() protoreflect.Value {
+	v := new(NozPriceSnapshot)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-// Get retrieves the value for a field.
-//
-// For unpopulated scalars, it returns the default value, where
-// the default value of a bytes scalar is guaranteed to be a copy.
-// For unpopulated composite types, it returns an empty, read-only view
-// of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
-	switch descriptor.FullName() {
-	case "stratos.sds.v1.GenesisState.params":
-		value := x.Params
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "stratos.sds.v1.GenesisState.files":
-		if len(x.Files) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_2_list{})
-		}
-		listValue := &_GenesisState_2_list{list: &x.Files}
-		return protoreflect.ValueOfList(listValue)
-	default:
-		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.sds.v1.GenesisState"))
-		}
-		panic(fmt.Errorf("message stratos.sds.v1.GenesisState does not contain field %s", descriptor.FullName()))
+func (x *_GenesisState_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
 	}
+	*x.list = (*x.list)[:n]
 }
 
-// Set stores the value for a field.
-//
-// For a field belonging to a oneof, it implicitly clears any other field
-// that may be currently set within the same oneof.
-// For extension fields, it implicitly stores the provided ExtensionType.
-// When setting a composite type, it is unspecified whether the stored value
-// aliases the source's memory in any way. If the composite value is an
-// empty, read-only value, then it panics.
-//
-// Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
-	switch fd.FullName() {
-	case "stratos.sds.v1.GenesisState.params":
-		x.Params = value.Message().Interface().(*Params)
-	case "stratos.sds.v1.GenesisState.files":
-		lv := value.List()
-		clv := lv.(*_GenesisState_2_list)
-		x.Files = *clv.list
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.sds.v1.GenesisState"))
-		}
-		panic(fmt.Errorf("message stratos.sds.v1.GenesisState does not contain field %s", fd.FullName()))
-	}
+func (x *_GenesisState_5_list) NewElement() protoreflect.Value {
+	v := new(NozPriceSnapshot)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-// Mutable returns a mutable reference to a composite type.
-//
-// If the field is unpopulated, it may allocate a composite value.
-// For a field belonging to a oneof, it implicitly clears any other field
-// that may be currently set within the same oneof.
-// For extension fields, it implicitly stores the provided ExtensionType
-// if not already stored.
-// It panics if the field does not contain a composite type.
-//
-// Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	case "stratos.sds.v1.GenesisState.params":
-		if x.Params == nil {
-			x.Params = new(Params)
-		}
-		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
-	case "stratos.sds.v1.GenesisState.files":
-		if x.Files == nil {
-			x.Files = []*GenesisFileInfo{}
-		}
-		value := &_GenesisState_2_list{list: &x.Files}
-		return protoreflect.ValueOfList(value)
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.sds.v1.GenesisState"))
-		}
-		panic(fmt.Errorf("message stratos.sds.v1.GenesisState does not contain field %s", fd.FullName()))
-	}
+func (x *_GenesisState_5_list) IsValid() bool {
+	return x.list != nil
 }
 
-// NewField returns a new value that is assignable to the field
-// for the given descriptor. For scalars, this returns the default value.
-// For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	case "stratos.sds.v1.GenesisState.params":
-		m := new(Params)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "stratos.sds.v1.GenesisState.files":
-		list := []*GenesisFileInfo{}
-		return protoreflect.ValueOfList(&_GenesisState_2_list{list: &list})
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.sds.v1.GenesisState"))
-		}
-		panic(fmt.Errorf("message stratos.sds.v1.GenesisState does not contain field %s", fd.FullName()))
-	}
+var _ protoreflect.List = (*_GenesisState_6_list)(nil)
+
+type _GenesisState_6_list struct {
+	list *[]*NozBalance
 }
 
-// WhichOneof reports which field within the oneof is populated,
-// returning nil if none are populated.
-// It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_GenesisState) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
-	switch d.FullName() {
-	default:
-		panic(fmt.Errorf("%s is not a oneof field in stratos.sds.v1.GenesisState", d.FullName()))
+func (x *_GenesisState_6_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
-	panic("unreachable")
+	return len(*x.list)
 }
 
-// GetUnknown retrieves the entire list of unknown fields.
-// The caller may only mutate the contents of the RawFields
-// if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_GenesisState) GetUnknown() protoreflect.RawFields {
-	return x.unknownFields
+func (x *_GenesisState_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-// SetUnknown stores an entire list of unknown fields.
+func (x *_GenesisState_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*NozBalance)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*NozBalance)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_6_list) AppendMutable() protoreflect.Value {
+	v := new(NozBalance)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_6_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_6_list) NewElement() protoreflect.Value {
+	v := new(NozBalance)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_6_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_7_list)(nil)
+
+type _GenesisState_7_list struct {
+	list *[]*NozAllowance
+}
+
+func (x *_GenesisState_7_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_7_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_7_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*NozAllowance)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_7_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*NozAllowance)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_7_list) AppendMutable() protoreflect.Value {
+	v := new(NozAllowance)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_7_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_7_list) NewElement() protoreflect.Value {
+	v := new(NozAllowance)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_7_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_8_list)(nil)
+
+type _GenesisState_8_list struct {
+	list *[]*FileAccessGrant
+}
+
+func (x *_GenesisState_8_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_8_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_8_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*FileAccessGrant)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_8_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*FileAccessGrant)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_8_list) AppendMutable() protoreflect.Value {
+	v := new(FileAccessGrant)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_8_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_8_list) NewElement() protoreflect.Value {
+	v := new(FileAccessGrant)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_8_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_9_list)(nil)
+
+type _GenesisState_9_list struct {
+	list *[]*StorageChallenge
+}
+
+func (x *_GenesisState_9_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_9_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_9_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*StorageChallenge)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_9_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*StorageChallenge)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_9_list) AppendMutable() protoreflect.Value {
+	v := new(StorageChallenge)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_9_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_9_list) NewElement() protoreflect.Value {
+	v := new(StorageChallenge)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_9_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_11_list)(nil)
+
+type _GenesisState_11_list struct {
+	list *[]*StorageChallengeFailure
+}
+
+func (x *_GenesisState_11_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_11_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_11_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*StorageChallengeFailure)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_11_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*StorageChallengeFailure)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_11_list) AppendMutable() protoreflect.Value {
+	v := new(StorageChallengeFailure)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_11_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_11_list) NewElement() protoreflect.Value {
+	v := new(StorageChallengeFailure)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_11_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_12_list)(nil)
+
+type _GenesisState_12_list struct {
+	list *[]*StorageRent
+}
+
+func (x *_GenesisState_12_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_12_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_12_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*StorageRent)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_12_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*StorageRent)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_12_list) AppendMutable() protoreflect.Value {
+	v := new(StorageRent)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_12_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_12_list) NewElement() protoreflect.Value {
+	v := new(StorageRent)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_12_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_13_list)(nil)
+
+type _GenesisState_13_list struct {
+	list *[]*RefundNonce
+}
+
+func (x *_GenesisState_13_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_13_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_13_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*RefundNonce)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_13_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*RefundNonce)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_13_list) AppendMutable() protoreflect.Value {
+	v := new(RefundNonce)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_13_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_13_list) NewElement() protoreflect.Value {
+	v := new(RefundNonce)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_13_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_GenesisState                             protoreflect.MessageDescriptor
+	fd_GenesisState_params                      protoreflect.FieldDescriptor
+	fd_GenesisState_files                       protoreflect.FieldDescriptor
+	fd_GenesisState_prepay_subscriptions        protoreflect.FieldDescriptor
+	fd_GenesisState_next_prepay_subscription_id protoreflect.FieldDescriptor
+	fd_GenesisState_noz_price_history           protoreflect.FieldDescriptor
+	fd_GenesisState_noz_balances                protoreflect.FieldDescriptor
+	fd_GenesisState_noz_allowances              protoreflect.FieldDescriptor
+	fd_GenesisState_file_access_grants          protoreflect.FieldDescriptor
+	fd_GenesisState_storage_challenges          protoreflect.FieldDescriptor
+	fd_GenesisState_next_storage_challenge_id   protoreflect.FieldDescriptor
+	fd_GenesisState_storage_challenge_failures  protoreflect.FieldDescriptor
+	fd_GenesisState_storage_rents               protoreflect.FieldDescriptor
+	fd_GenesisState_refund_nonces               protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_stratos_sds_v1_genesis_proto_init()
+	md_GenesisState = File_stratos_sds_v1_genesis_proto.Messages().ByName("GenesisState")
+	fd_GenesisState_params = md_GenesisState.Fields().ByName("params")
+	fd_GenesisState_files = md_GenesisState.Fields().ByName("files")
+	fd_GenesisState_prepay_subscriptions = md_GenesisState.Fields().ByName("prepay_subscriptions")
+	fd_GenesisState_next_prepay_subscription_id = md_GenesisState.Fields().ByName("next_prepay_subscription_id")
+	fd_GenesisState_noz_price_history = md_GenesisState.Fields().ByName("noz_price_history")
+	fd_GenesisState_noz_balances = md_GenesisState.Fields().ByName("noz_balances")
+	fd_GenesisState_noz_allowances = md_GenesisState.Fields().ByName("noz_allowances")
+	fd_GenesisState_file_access_grants = md_GenesisState.Fields().ByName("file_access_grants")
+	fd_GenesisState_storage_challenges = md_GenesisState.Fields().ByName("storage_challenges")
+	fd_GenesisState_next_storage_challenge_id = md_GenesisState.Fields().ByName("next_storage_challenge_id")
+	fd_GenesisState_storage_challenge_failures = md_GenesisState.Fields().ByName("storage_challenge_failures")
+	fd_GenesisState_storage_rents = md_GenesisState.Fields().ByName("storage_rents")
+	fd_GenesisState_refund_nonces = md_GenesisState.Fields().ByName("refund_nonces")
+}
+
+var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
+
+type fastReflection_GenesisState GenesisState
+
+func (x *GenesisState) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_GenesisState)(x)
+}
+
+func (x *GenesisState) slowProtoReflect() protoreflect.Message {
+	mi := &file_stratos_sds_v1_genesis_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_GenesisState_messageType fastReflection_GenesisState_messageType
+var _ protoreflect.MessageType = fastReflection_GenesisState_messageType{}
+
+type fastReflection_GenesisState_messageType struct{}
+
+func (x fastReflection_GenesisState_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_GenesisState)(nil)
+}
+func (x fastReflection_GenesisState_messageType) New() protoreflect.Message {
+	return new(fastReflection_GenesisState)
+}
+func (x fastReflection_GenesisState_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_GenesisState
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_GenesisState) Descriptor() protoreflect.MessageDescriptor {
+	return md_GenesisState
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_GenesisState) Type() protoreflect.MessageType {
+	return _fastReflection_GenesisState_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_GenesisState) New() protoreflect.Message {
+	return new(fastReflection_GenesisState)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_GenesisState) Interface() protoreflect.ProtoMessage {
+	return (*GenesisState)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Params != nil {
+		value := protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+		if !f(fd_GenesisState_params, value) {
+			return
+		}
+	}
+	if len(x.Files) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_2_list{list: &x.Files})
+		if !f(fd_GenesisState_files, value) {
+			return
+		}
+	}
+	if len(x.PrepaySubscriptions) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_3_list{list: &x.PrepaySubscriptions})
+		if !f(fd_GenesisState_prepay_subscriptions, value) {
+			return
+		}
+	}
+	if x.NextPrepaySubscriptionId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.NextPrepaySubscriptionId)
+		if !f(fd_GenesisState_next_prepay_subscription_id, value) {
+			return
+		}
+	}
+	if len(x.NozPriceHistory) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_5_list{list: &x.NozPriceHistory})
+		if !f(fd_GenesisState_noz_price_history, value) {
+			return
+		}
+	}
+	if len(x.NozBalances) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_6_list{list: &x.NozBalances})
+		if !f(fd_GenesisState_noz_balances, value) {
+			return
+		}
+	}
+	if len(x.NozAllowances) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_7_list{list: &x.NozAllowances})
+		if !f(fd_GenesisState_noz_allowances, value) {
+			return
+		}
+	}
+	if len(x.FileAccessGrants) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_8_list{list: &x.FileAccessGrants})
+		if !f(fd_GenesisState_file_access_grants, value) {
+			return
+		}
+	}
+	if len(x.StorageChallenges) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_9_list{list: &x.StorageChallenges})
+		if !f(fd_GenesisState_storage_challenges, value) {
+			return
+		}
+	}
+	if x.NextStorageChallengeId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.NextStorageChallengeId)
+		if !f(fd_GenesisState_next_storage_challenge_id, value) {
+			return
+		}
+	}
+	if len(x.StorageChallengeFailures) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_11_list{list: &x.StorageChallengeFailures})
+		if !f(fd_GenesisState_storage_challenge_failures, value) {
+			return
+		}
+	}
+	if len(x.StorageRents) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_12_list{list: &x.StorageRents})
+		if !f(fd_GenesisState_storage_rents, value) {
+			return
+		}
+	}
+	if len(x.RefundNonces) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_13_list{list: &x.RefundNonces})
+		if !f(fd_GenesisState_refund_nonces, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "stratos.sds.v1.GenesisState.params":
+		return x.Params != nil
+	case "stratos.sds.v1.GenesisState.files":
+		return len(x.Files) != 0
+	case "stratos.sds.v1.GenesisState.prepay_subscriptions":
+		return len(x.PrepaySubscriptions) != 0
+	case "stratos.sds.v1.GenesisState.next_prepay_subscription_id":
+		return x.NextPrepaySubscriptionId != uint64(0)
+	case "stratos.sds.v1.GenesisState.noz_price_history":
+		return len(x.NozPriceHistory) != 0
+	case "stratos.sds.v1.GenesisState.noz_balances":
+		return len(x.NozBalances) != 0
+	case "stratos.sds.v1.GenesisState.noz_allowances":
+		return len(x.NozAllowances) != 0
+	case "stratos.sds.v1.GenesisState.file_access_grants":
+		return len(x.FileAccessGrants) != 0
+	case "stratos.sds.v1.GenesisState.storage_challenges":
+		return len(x.StorageChallenges) != 0
+	case "stratos.sds.v1.GenesisState.next_storage_challenge_id":
+		return x.NextStorageChallengeId != uint64(0)
+	case "stratos.sds.v1.GenesisState.storage_challenge_failures":
+		return len(x.StorageChallengeFailures) != 0
+	case "stratos.sds.v1.GenesisState.storage_rents":
+		return len(x.StorageRents) != 0
+	case "stratos.sds.v1.GenesisState.refund_nonces":
+		return len(x.RefundNonces) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.sds.v1.GenesisState"))
+		}
+		panic(fmt.Errorf("message stratos.sds.v1.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "stratos.sds.v1.GenesisState.params":
+		x.Params = nil
+	case "stratos.sds.v1.GenesisState.files":
+		x.Files = nil
+	case "stratos.sds.v1.GenesisState.prepay_subscriptions":
+		x.PrepaySubscriptions = nil
+	case "stratos.sds.v1.GenesisState.next_prepay_subscription_id":
+		x.NextPrepaySubscriptionId = uint64(0)
+	case "stratos.sds.v1.GenesisState.noz_price_history":
+		x.NozPriceHistory = nil
+	case "stratos.sds.v1.GenesisState.noz_balances":
+		x.NozBalances = nil
+	case "stratos.sds.v1.GenesisState.noz_allowances":
+		x.NozAllowances = nil
+	case "stratos.sds.v1.GenesisState.file_access_grants":
+		x.FileAccessGrants = nil
+	case "stratos.sds.v1.GenesisState.storage_challenges":
+		x.StorageChallenges = nil
+	case "stratos.sds.v1.GenesisState.next_storage_challenge_id":
+		x.NextStorageChallengeId = uint64(0)
+	case "stratos.sds.v1.GenesisState.storage_challenge_failures":
+		x.StorageChallengeFailures = nil
+	case "stratos.sds.v1.GenesisState.storage_rents":
+		x.StorageRents = nil
+	case "stratos.sds.v1.GenesisState.refund_nonces":
+		x.RefundNonces = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.sds.v1.GenesisState"))
+		}
+		panic(fmt.Errorf("message stratos.sds.v1.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "stratos.sds.v1.GenesisState.params":
+		value := x.Params
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "stratos.sds.v1.GenesisState.files":
+		if len(x.Files) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_2_list{})
+		}
+		listValue := &_GenesisState_2_list{list: &x.Files}
+		return protoreflect.ValueOfList(listValue)
+	case "stratos.sds.v1.GenesisState.prepay_subscriptions":
+		if len(x.PrepaySubscriptions) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_3_list{})
+		}
+		listValue := &_GenesisState_3_list{list: &x.PrepaySubscriptions}
+		return protoreflect.ValueOfList(listValue)
+	case "stratos.sds.v1.GenesisState.next_prepay_subscription_id":
+		value := x.NextPrepaySubscriptionId
+		return protoreflect.ValueOfUint64(value)
+	case "stratos.sds.v1.GenesisState.noz_price_history":
+		if len(x.NozPriceHistory) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_5_list{})
+		}
+		listValue := &_GenesisState_5_list{list: &x.NozPriceHistory}
+		return protoreflect.ValueOfList(listValue)
+	case "stratos.sds.v1.GenesisState.noz_balances":
+		if len(x.NozBalances) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_6_list{})
+		}
+		listValue := &_GenesisState_6_list{list: &x.NozBalances}
+		return protoreflect.ValueOfList(listValue)
+	case "stratos.sds.v1.GenesisState.noz_allowances":
+		if len(x.NozAllowances) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_7_list{})
+		}
+		listValue := &_GenesisState_7_list{list: &x.NozAllowances}
+		return protoreflect.ValueOfList(listValue)
+	case "stratos.sds.v1.GenesisState.file_access_grants":
+		if len(x.FileAccessGrants) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_8_list{})
+		}
+		listValue := &_GenesisState_8_list{list: &x.FileAccessGrants}
+		return protoreflect.ValueOfList(listValue)
+	case "stratos.sds.v1.GenesisState.storage_challenges":
+		if len(x.StorageChallenges) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_9_list{})
+		}
+		listValue := &_GenesisState_9_list{list: &x.StorageChallenges}
+		return protoreflect.ValueOfList(listValue)
+	case "stratos.sds.v1.GenesisState.next_storage_challenge_id":
+		value := x.NextStorageChallengeId
+		return protoreflect.ValueOfUint64(value)
+	case "stratos.sds.v1.GenesisState.storage_challenge_failures":
+		if len(x.StorageChallengeFailures) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_11_list{})
+		}
+		listValue := &_GenesisState_11_list{list: &x.StorageChallengeFailures}
+		return protoreflect.ValueOfList(listValue)
+	case "stratos.sds.v1.GenesisState.storage_rents":
+		if len(x.StorageRents) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_12_list{})
+		}
+		listValue := &_GenesisState_12_list{list: &x.StorageRents}
+		return protoreflect.ValueOfList(listValue)
+	case "stratos.sds.v1.GenesisState.refund_nonces":
+		if len(x.RefundNonces) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_13_list{})
+		}
+		listValue := &_GenesisState_13_list{list: &x.RefundNonces}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.sds.v1.GenesisState"))
+		}
+		panic(fmt.Errorf("message stratos.sds.v1.GenesisState does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "stratos.sds.v1.GenesisState.params":
+		x.Params = value.Message().Interface().(*Params)
+	case "stratos.sds.v1.GenesisState.files":
+		lv := value.List()
+		clv := lv.(*_GenesisState_2_list)
+		x.Files = *clv.list
+	case "stratos.sds.v1.GenesisState.prepay_subscriptions":
+		lv := value.List()
+		clv := lv.(*_GenesisState_3_list)
+		x.PrepaySubscriptions = *clv.list
+	case "stratos.sds.v1.GenesisState.next_prepay_subscription_id":
+		x.NextPrepaySubscriptionId = value.Uint()
+	case "stratos.sds.v1.GenesisState.noz_price_history":
+		lv := value.List()
+		clv := lv.(*_GenesisState_5_list)
+		x.NozPriceHistory = *clv.list
+	case "stratos.sds.v1.GenesisState.noz_balances":
+		lv := value.List()
+		clv := lv.(*_GenesisState_6_list)
+		x.NozBalances = *clv.list
+	case "stratos.sds.v1.GenesisState.noz_allowances":
+		lv := value.List()
+		clv := lv.(*_GenesisState_7_list)
+		x.NozAllowances = *clv.list
+	case "stratos.sds.v1.GenesisState.file_access_grants":
+		lv := value.List()
+		clv := lv.(*_GenesisState_8_list)
+		x.FileAccessGrants = *clv.list
+	case "stratos.sds.v1.GenesisState.storage_challenges":
+		lv := value.List()
+		clv := lv.(*_GenesisState_9_list)
+		x.StorageChallenges = *clv.list
+	case "stratos.sds.v1.GenesisState.next_storage_challenge_id":
+		x.NextStorageChallengeId = value.Uint()
+	case "stratos.sds.v1.GenesisState.storage_challenge_failures":
+		lv := value.List()
+		clv := lv.(*_GenesisState_11_list)
+		x.StorageChallengeFailures = *clv.list
+	case "stratos.sds.v1.GenesisState.storage_rents":
+		lv := value.List()
+		clv := lv.(*_GenesisState_12_list)
+		x.StorageRents = *clv.list
+	case "stratos.sds.v1.GenesisState.refund_nonces":
+		lv := value.List()
+		clv := lv.(*_GenesisState_13_list)
+		x.RefundNonces = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.sds.v1.GenesisState"))
+		}
+		panic(fmt.Errorf("message stratos.sds.v1.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "stratos.sds.v1.GenesisState.params":
+		if x.Params == nil {
+			x.Params = new(Params)
+		}
+		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+	case "stratos.sds.v1.GenesisState.files":
+		if x.Files == nil {
+			x.Files = []*GenesisFileInfo{}
+		}
+		value := &_GenesisState_2_list{list: &x.Files}
+		return protoreflect.ValueOfList(value)
+	case "stratos.sds.v1.GenesisState.prepay_subscriptions":
+		if x.PrepaySubscriptions == nil {
+			x.PrepaySubscriptions = []*PrepaySubscription{}
+		}
+		value := &_GenesisState_3_list{list: &x.PrepaySubscriptions}
+		return protoreflect.ValueOfList(value)
+	case "stratos.sds.v1.GenesisState.noz_price_history":
+		if x.NozPriceHistory == nil {
+			x.NozPriceHistory = []*NozPriceSnapshot{}
+		}
+		value := &_GenesisState_5_list{list: &x.NozPriceHistory}
+		return protoreflect.ValueOfList(value)
+	case "stratos.sds.v1.GenesisState.noz_balances":
+		if x.NozBalances == nil {
+			x.NozBalances = []*NozBalance{}
+		}
+		value := &_GenesisState_6_list{list: &x.NozBalances}
+		return protoreflect.ValueOfList(value)
+	case "stratos.sds.v1.GenesisState.noz_allowances":
+		if x.NozAllowances == nil {
+			x.NozAllowances = []*NozAllowance{}
+		}
+		value := &_GenesisState_7_list{list: &x.NozAllowances}
+		return protoreflect.ValueOfList(value)
+	case "stratos.sds.v1.GenesisState.file_access_grants":
+		if x.FileAccessGrants == nil {
+			x.FileAccessGrants = []*FileAccessGrant{}
+		}
+		value := &_GenesisState_8_list{list: &x.FileAccessGrants}
+		return protoreflect.ValueOfList(value)
+	case "stratos.sds.v1.GenesisState.storage_challenges":
+		if x.StorageChallenges == nil {
+			x.StorageChallenges = []*StorageChallenge{}
+		}
+		value := &_GenesisState_9_list{list: &x.StorageChallenges}
+		return protoreflect.ValueOfList(value)
+	case "stratos.sds.v1.GenesisState.storage_challenge_failures":
+		if x.StorageChallengeFailures == nil {
+			x.StorageChallengeFailures = []*StorageChallengeFailure{}
+		}
+		value := &_GenesisState_11_list{list: &x.StorageChallengeFailures}
+		return protoreflect.ValueOfList(value)
+	case "stratos.sds.v1.GenesisState.storage_rents":
+		if x.StorageRents == nil {
+			x.StorageRents = []*StorageRent{}
+		}
+		value := &_GenesisState_12_list{list: &x.StorageRents}
+		return protoreflect.ValueOfList(value)
+	case "stratos.sds.v1.GenesisState.refund_nonces":
+		if x.RefundNonces == nil {
+			x.RefundNonces = []*RefundNonce{}
+		}
+		value := &_GenesisState_13_list{list: &x.RefundNonces}
+		return protoreflect.ValueOfList(value)
+	case "stratos.sds.v1.GenesisState.next_prepay_subscription_id":
+		panic(fmt.Errorf("field next_prepay_subscription_id of message stratos.sds.v1.GenesisState is not mutable"))
+	case "stratos.sds.v1.GenesisState.next_storage_challenge_id":
+		panic(fmt.Errorf("field next_storage_challenge_id of message stratos.sds.v1.GenesisState is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.sds.v1.GenesisState"))
+		}
+		panic(fmt.Errorf("message stratos.sds.v1.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "stratos.sds.v1.GenesisState.params":
+		m := new(Params)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "stratos.sds.v1.GenesisState.files":
+		list := []*GenesisFileInfo{}
+		return protoreflect.ValueOfList(&_GenesisState_2_list{list: &list})
+	case "stratos.sds.v1.GenesisState.prepay_subscriptions":
+		list := []*PrepaySubscription{}
+		return protoreflect.ValueOfList(&_GenesisState_3_list{list: &list})
+	case "stratos.sds.v1.GenesisState.next_prepay_subscription_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "stratos.sds.v1.GenesisState.noz_price_history":
+		list := []*NozPriceSnapshot{}
+		return protoreflect.ValueOfList(&_GenesisState_5_list{list: &list})
+	case "stratos.sds.v1.GenesisState.noz_balances":
+		list := []*NozBalance{}
+		return protoreflect.ValueOfList(&_GenesisState_6_list{list: &list})
+	case "stratos.sds.v1.GenesisState.noz_allowances":
+		list := []*NozAllowance{}
+		return protoreflect.ValueOfList(&_GenesisState_7_list{list: &list})
+	case "stratos.sds.v1.GenesisState.file_access_grants":
+		list := []*FileAccessGrant{}
+		return protoreflect.ValueOfList(&_GenesisState_8_list{list: &list})
+	case "stratos.sds.v1.GenesisState.storage_challenges":
+		list := []*StorageChallenge{}
+		return protoreflect.ValueOfList(&_GenesisState_9_list{list: &list})
+	case "stratos.sds.v1.GenesisState.next_storage_challenge_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "stratos.sds.v1.GenesisState.storage_challenge_failures":
+		list := []*StorageChallengeFailure{}
+		return protoreflect.ValueOfList(&_GenesisState_11_list{list: &list})
+	case "stratos.sds.v1.GenesisState.storage_rents":
+		list := []*StorageRent{}
+		return protoreflect.ValueOfList(&_GenesisState_12_list{list: &list})
+	case "stratos.sds.v1.GenesisState.refund_nonces":
+		list := []*RefundNonce{}
+		return protoreflect.ValueOfList(&_GenesisState_13_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: stratos.sds.v1.GenesisState"))
+		}
+		panic(fmt.Errorf("message stratos.sds.v1.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_GenesisState) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in stratos.sds.v1.GenesisState", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_GenesisState) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
 // The raw fields must be syntactically valid according to the wire format.
 // An implementation may panic if this is not the case.
 // Once stored, the caller must not mutate the content of the RawFields.
@@ -374,117 +1154,556 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
-		if x.unknownFields != nil {
-			n += len(x.unknownFields)
+		if len(x.PrepaySubscriptions) > 0 {
+			for _, e := range x.PrepaySubscriptions {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.NextPrepaySubscriptionId != 0 {
+			n += 1 + runtime.Sov(uint64(x.NextPrepaySubscriptionId))
+		}
+		if len(x.NozPriceHistory) > 0 {
+			for _, e := range x.NozPriceHistory {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.NozBalances) > 0 {
+			for _, e := range x.NozBalances {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.NozAllowances) > 0 {
+			for _, e := range x.NozAllowances {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.FileAccessGrants) > 0 {
+			for _, e := range x.FileAccessGrants {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.StorageChallenges) > 0 {
+			for _, e := range x.StorageChallenges {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.NextStorageChallengeId != 0 {
+			n += 1 + runtime.Sov(uint64(x.NextStorageChallengeId))
+		}
+		if len(x.StorageChallengeFailures) > 0 {
+			for _, e := range x.StorageChallengeFailures {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.StorageRents) > 0 {
+			for _, e := range x.StorageRents {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.RefundNonces) > 0 {
+			for _, e := range x.RefundNonces {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*GenesisState)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.RefundNonces) > 0 {
+			for iNdEx := len(x.RefundNonces) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.RefundNonces[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x6a
+			}
+		}
+		if len(x.StorageRents) > 0 {
+			for iNdEx := len(x.StorageRents) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.StorageRents[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x62
+			}
+		}
+		if len(x.StorageChallengeFailures) > 0 {
+			for iNdEx := len(x.StorageChallengeFailures) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.StorageChallengeFailures[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x5a
+			}
+		}
+		if x.NextStorageChallengeId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NextStorageChallengeId))
+			i--
+			dAtA[i] = 0x50
+		}
+		if len(x.StorageChallenges) > 0 {
+			for iNdEx := len(x.StorageChallenges) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.StorageChallenges[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x4a
+			}
+		}
+		if len(x.FileAccessGrants) > 0 {
+			for iNdEx := len(x.FileAccessGrants) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.FileAccessGrants[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x42
+			}
+		}
+		if len(x.NozAllowances) > 0 {
+			for iNdEx := len(x.NozAllowances) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.NozAllowances[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x3a
+			}
+		}
+		if len(x.NozBalances) > 0 {
+			for iNdEx := len(x.NozBalances) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.NozBalances[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if len(x.NozPriceHistory) > 0 {
+			for iNdEx := len(x.NozPriceHistory) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.NozPriceHistory[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if x.NextPrepaySubscriptionId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NextPrepaySubscriptionId))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.PrepaySubscriptions) > 0 {
+			for iNdEx := len(x.PrepaySubscriptions) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.PrepaySubscriptions[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.Files) > 0 {
+			for iNdEx := len(x.Files) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Files[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if x.Params != nil {
+			encoded, err := options.Marshal(x.Params)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
 		}
-		return protoiface.SizeOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Size:              n,
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
 		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
 	}
-
-	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
 		x := input.Message.Interface().(*GenesisState)
 		if x == nil {
-			return protoiface.MarshalOutput{
+			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Buf:               input.Buf,
+				Flags:             input.Flags,
 			}, nil
 		}
-		options := runtime.MarshalInputToOptions(input)
+		options := runtime.UnmarshalInputToOptions(input)
 		_ = options
-		size := options.Size(x)
-		dAtA := make([]byte, size)
-		i := len(dAtA)
-		_ = i
-		var l int
-		_ = l
-		if x.unknownFields != nil {
-			i -= len(x.unknownFields)
-			copy(dAtA[i:], x.unknownFields)
-		}
-		if len(x.Files) > 0 {
-			for iNdEx := len(x.Files) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Files[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Params == nil {
+					x.Params = &Params{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Params); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Files", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Files = append(x.Files, &GenesisFileInfo{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Files[len(x.Files)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PrepaySubscriptions", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PrepaySubscriptions = append(x.PrepaySubscriptions, &PrepaySubscription{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PrepaySubscriptions[len(x.PrepaySubscriptions)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NextPrepaySubscriptionId", wireType)
+				}
+				x.NextPrepaySubscriptionId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NextPrepaySubscriptionId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NozPriceHistory", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.NozPriceHistory = append(x.NozPriceHistory, &NozPriceSnapshot{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.NozPriceHistory[len(x.NozPriceHistory)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NozBalances", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.NozBalances = append(x.NozBalances, &NozBalance{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.NozBalances[len(x.NozBalances)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NozAllowances", wireType)
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x12
-			}
-		}
-		if x.Params != nil {
-			encoded, err := options.Marshal(x.Params)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0xa
-		}
-		if input.Buf != nil {
-			input.Buf = append(input.Buf, dAtA...)
-		} else {
-			input.Buf = dAtA
-		}
-		return protoiface.MarshalOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Buf:               input.Buf,
-		}, nil
-	}
-	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*GenesisState)
-		if x == nil {
-			return protoiface.UnmarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Flags:             input.Flags,
-			}, nil
-		}
-		options := runtime.UnmarshalInputToOptions(input)
-		_ = options
-		dAtA := input.Buf
-		l := len(dAtA)
-		iNdEx := 0
-		for iNdEx < l {
-			preIndex := iNdEx
-			var wire uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				wire |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				x.NozAllowances = append(x.NozAllowances, &NozAllowance{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.NozAllowances[len(x.NozAllowances)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-			}
-			fieldNum := int32(wire >> 3)
-			wireType := int(wire & 0x7)
-			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
-			}
-			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
-			}
-			switch fieldNum {
-			case 1:
+				iNdEx = postIndex
+			case 8:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FileAccessGrants", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -511,16 +1730,67 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Params == nil {
-					x.Params = &Params{}
+				x.FileAccessGrants = append(x.FileAccessGrants, &FileAccessGrant{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FileAccessGrants[len(x.FileAccessGrants)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Params); err != nil {
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StorageChallenges", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.StorageChallenges = append(x.StorageChallenges, &StorageChallenge{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.StorageChallenges[len(x.StorageChallenges)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 2:
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NextStorageChallengeId", wireType)
+				}
+				x.NextStorageChallengeId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NextStorageChallengeId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 11:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Files", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StorageChallengeFailures", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -547,8 +1817,76 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Files = append(x.Files, &GenesisFileInfo{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Files[len(x.Files)-1]); err != nil {
+				x.StorageChallengeFailures = append(x.StorageChallengeFailures, &StorageChallengeFailure{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.StorageChallengeFailures[len(x.StorageChallengeFailures)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StorageRents", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.StorageRents = append(x.StorageRents, &StorageRent{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.StorageRents[len(x.StorageRents)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 13:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RefundNonces", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RefundNonces = append(x.RefundNonces, &RefundNonce{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RefundNonces[len(x.RefundNonces)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -1105,8 +2443,19 @@ type GenesisState struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Params *Params            `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
-	Files  []*GenesisFileInfo `protobuf:"bytes,2,rep,name=files,proto3" json:"files,omitempty"`
+	Params                   *Params                    `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	Files                    []*GenesisFileInfo         `protobuf:"bytes,2,rep,name=files,proto3" json:"files,omitempty"`
+	PrepaySubscriptions      []*PrepaySubscription      `protobuf:"bytes,3,rep,name=prepay_subscriptions,json=prepaySubscriptions,proto3" json:"prepay_subscriptions,omitempty"`
+	NextPrepaySubscriptionId uint64                     `protobuf:"varint,4,opt,name=next_prepay_subscription_id,json=nextPrepaySubscriptionId,proto3" json:"next_prepay_subscription_id,omitempty"`
+	NozPriceHistory          []*NozPriceSnapshot        `protobuf:"bytes,5,rep,name=noz_price_history,json=nozPriceHistory,proto3" json:"noz_price_history,omitempty"`
+	NozBalances              []*NozBalance              `protobuf:"bytes,6,rep,name=noz_balances,json=nozBalances,proto3" json:"noz_balances,omitempty"`
+	NozAllowances            []*NozAllowance            `protobuf:"bytes,7,rep,name=noz_allowances,json=nozAllowances,proto3" json:"noz_allowances,omitempty"`
+	FileAccessGrants         []*FileAccessGrant         `protobuf:"bytes,8,rep,name=file_access_grants,json=fileAccessGrants,proto3" json:"file_access_grants,omitempty"`
+	StorageChallenges        []*StorageChallenge        `protobuf:"bytes,9,rep,name=storage_challenges,json=storageChallenges,proto3" json:"storage_challenges,omitempty"`
+	NextStorageChallengeId   uint64                     `protobuf:"varint,10,opt,name=next_storage_challenge_id,json=nextStorageChallengeId,proto3" json:"next_storage_challenge_id,omitempty"`
+	StorageChallengeFailures []*StorageChallengeFailure `protobuf:"bytes,11,rep,name=storage_challenge_failures,json=storageChallengeFailures,proto3" json:"storage_challenge_failures,omitempty"`
+	StorageRents             []*StorageRent             `protobuf:"bytes,12,rep,name=storage_rents,json=storageRents,proto3" json:"storage_rents,omitempty"`
+	RefundNonces             []*RefundNonce             `protobuf:"bytes,13,rep,name=refund_nonces,json=refundNonces,proto3" json:"refund_nonces,omitempty"`
 }
 
 func (x *GenesisState) Reset() {
@@ -1143,6 +2492,83 @@ func (x *GenesisState) GetFiles() []*GenesisFileInfo {
 	return nil
 }
 
+func (x *GenesisState) GetPrepaySubscriptions() []*PrepaySubscription {
+	if x != nil {
+		return x.PrepaySubscriptions
+	}
+	return nil
+}
+
+func (x *GenesisState) GetNextPrepaySubscriptionId() uint64 {
+	if x != nil {
+		return x.NextPrepaySubscriptionId
+	}
+	return 0
+}
+
+func (x *GenesisState) GetNozPriceHistory() []*NozPriceSnapshot {
+	if x != nil {
+		return x.NozPriceHistory
+	}
+	return nil
+}
+
+func (x *GenesisState) GetNozBalances() []*NozBalance {
+	if x != nil {
+		return x.NozBalances
+	}
+	return nil
+}
+
+func (x *GenesisState) GetNozAllowances() []*NozAllowance {
+	if x != nil {
+		return x.NozAllowances
+	}
+	return nil
+}
+
+func (x *GenesisState) GetFileAccessGrants() []*FileAccessGrant {
+	if x != nil {
+		return x.FileAccessGrants
+	}
+	return nil
+}
+
+func (x *GenesisState) GetStorageChallenges() []*StorageChallenge {
+	if x != nil {
+		return x.StorageChallenges
+	}
+	return nil
+}
+
+func (x *GenesisState) GetNextStorageChallengeId() uint64 {
+	if x != nil {
+		return x.NextStorageChallengeId
+	}
+	return 0
+}
+
+func (x *GenesisState) GetStorageChallengeFailures() []*StorageChallengeFailure {
+	if x != nil {
+		return x.StorageChallengeFailures
+	}
+	return nil
+}
+
+func (x *GenesisState) GetStorageRents() []*StorageRent {
+	if x != nil {
+		return x.StorageRents
+	}
+	return nil
+}
+
+func (x *GenesisState) GetRefundNonces() []*RefundNonce {
+	if x != nil {
+		return x.RefundNonces
+	}
+	return nil
+}
+
 type GenesisFileInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1196,7 +2622,7 @@ var file_stratos_sds_v1_genesis_proto_rawDesc = []byte{
 	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x11, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x6d, 0x69, 0x6e,
 	0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x18, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73,
 	0x2f, 0x73, 0x64, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x64, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x22, 0xbf, 0x01, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61,
+	0x6f, 0x22, 0xe8, 0x0c, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61,
 	0x74, 0x65, 0x12, 0x54, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e, 0x73, 0x64, 0x73,
 	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x24, 0xc8, 0xde, 0x1f, 0x00,
@@ -1208,29 +2634,119 @@ var file_stratos_sds_v1_genesis_proto_rawDesc = []byte{
 	0x46, 0x69, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x22, 0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde,
 	0x1f, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0xf2, 0xde, 0x1f, 0x0c, 0x79, 0x61, 0x6d, 0x6c, 0x3a,
 	0x22, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x22, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x05, 0x66, 0x69,
-	0x6c, 0x65, 0x73, 0x22, 0xaf, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x46,
-	0x69, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x3e, 0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x5f,
-	0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x21, 0xea, 0xde, 0x1f, 0x09,
-	0x66, 0x69, 0x6c, 0x65, 0x5f, 0x68, 0x61, 0x73, 0x68, 0xf2, 0xde, 0x1f, 0x10, 0x79, 0x61, 0x6d,
-	0x6c, 0x3a, 0x22, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x22, 0x52, 0x08, 0x66,
-	0x69, 0x6c, 0x65, 0x48, 0x61, 0x73, 0x68, 0x12, 0x5c, 0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x5f,
-	0x69, 0x6e, 0x66, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x74, 0x72,
-	0x61, 0x74, 0x6f, 0x73, 0x2e, 0x73, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6c, 0x65,
-	0x49, 0x6e, 0x66, 0x6f, 0x42, 0x25, 0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f, 0x09, 0x66, 0x69,
-	0x6c, 0x65, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0xf2, 0xde, 0x1f, 0x10, 0x79, 0x61, 0x6d, 0x6c, 0x3a,
-	0x22, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x22, 0x52, 0x08, 0x66, 0x69, 0x6c,
-	0x65, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0xa7, 0x01, 0x0a, 0x12, 0x63, 0x6f, 0x6d, 0x2e, 0x73, 0x74,
-	0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e, 0x73, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x47, 0x65,
-	0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x25, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x73,
-	0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2f, 0x73, 0x64, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x73, 0x64,
-	0x73, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x53, 0x53, 0x58, 0xaa, 0x02, 0x0e, 0x53, 0x74, 0x72, 0x61,
-	0x74, 0x6f, 0x73, 0x2e, 0x53, 0x64, 0x73, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0e, 0x53, 0x74, 0x72,
-	0x61, 0x74, 0x6f, 0x73, 0x5c, 0x53, 0x64, 0x73, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1a, 0x53, 0x74,
-	0x72, 0x61, 0x74, 0x6f, 0x73, 0x5c, 0x53, 0x64, 0x73, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42,
-	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x10, 0x53, 0x74, 0x72, 0x61, 0x74,
-	0x6f, 0x73, 0x3a, 0x3a, 0x53, 0x64, 0x73, 0x3a, 0x3a, 0x56, 0x31, 0xa8, 0xe2, 0x1e, 0x01, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6c, 0x65, 0x73, 0x12, 0x92, 0x01, 0x0a, 0x14, 0x70, 0x72, 0x65, 0x70, 0x61, 0x79, 0x5f, 0x73,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e, 0x73, 0x64, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x65, 0x70, 0x61, 0x79, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x3b, 0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f, 0x14,
+	0x70, 0x72, 0x65, 0x70, 0x61, 0x79, 0x5f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0xf2, 0xde, 0x1f, 0x1b, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x70, 0x72,
+	0x65, 0x70, 0x61, 0x79, 0x5f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x22, 0x52, 0x13, 0x70, 0x72, 0x65, 0x70, 0x61, 0x79, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x84, 0x01, 0x0a, 0x1b, 0x6e, 0x65, 0x78,
+	0x74, 0x5f, 0x70, 0x72, 0x65, 0x70, 0x61, 0x79, 0x5f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x42, 0x45,
+	0xea, 0xde, 0x1f, 0x1b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x72, 0x65, 0x70, 0x61, 0x79, 0x5f,
+	0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0xf2,
+	0xde, 0x1f, 0x22, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x72,
+	0x65, 0x70, 0x61, 0x79, 0x5f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0x22, 0x52, 0x18, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x72, 0x65, 0x70, 0x61,
+	0x79, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12,
+	0x83, 0x01, 0x0a, 0x11, 0x6e, 0x6f, 0x7a, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x68, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x74,
+	0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e, 0x73, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x7a,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x42, 0x35, 0xc8,
+	0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f, 0x11, 0x6e, 0x6f, 0x7a, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0xf2, 0xde, 0x1f, 0x18, 0x79, 0x61, 0x6d, 0x6c,
+	0x3a, 0x22, 0x6e, 0x6f, 0x7a, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x68, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x22, 0x52, 0x0f, 0x6e, 0x6f, 0x7a, 0x50, 0x72, 0x69, 0x63, 0x65, 0x48, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x6a, 0x0a, 0x0c, 0x6e, 0x6f, 0x7a, 0x5f, 0x62, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x74,
+	0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e, 0x73, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x7a,
+	0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x42, 0x2b, 0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f,
+	0x0c, 0x6e, 0x6f, 0x7a, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0xf2, 0xde, 0x1f,
+	0x13, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6e, 0x6f, 0x7a, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0x73, 0x22, 0x52, 0x0b, 0x6e, 0x6f, 0x7a, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x73, 0x12, 0x74, 0x0a, 0x0e, 0x6e, 0x6f, 0x7a, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e,
+	0x63, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x74, 0x72, 0x61,
+	0x74, 0x6f, 0x73, 0x2e, 0x73, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x7a, 0x41, 0x6c,
+	0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x42, 0x2f, 0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f,
+	0x0e, 0x6e, 0x6f, 0x7a, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x73, 0xf2,
+	0xde, 0x1f, 0x15, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6e, 0x6f, 0x7a, 0x5f, 0x61, 0x6c, 0x6c,
+	0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x22, 0x52, 0x0d, 0x6e, 0x6f, 0x7a, 0x41, 0x6c, 0x6c,
+	0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x86, 0x01, 0x0a, 0x12, 0x66, 0x69, 0x6c, 0x65,
+	0x5f, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x08,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e, 0x73,
+	0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x47, 0x72, 0x61, 0x6e, 0x74, 0x42, 0x37, 0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f, 0x12, 0x66,
+	0x69, 0x6c, 0x65, 0x5f, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x67, 0x72, 0x61, 0x6e, 0x74,
+	0x73, 0xf2, 0xde, 0x1f, 0x19, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x66, 0x69, 0x6c, 0x65, 0x5f,
+	0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x22, 0x52, 0x10,
+	0x66, 0x69, 0x6c, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x73,
+	0x12, 0x88, 0x01, 0x0a, 0x12, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x63, 0x68, 0x61,
+	0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e,
+	0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e, 0x73, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x42,
+	0x37, 0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f, 0x12, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x5f, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x73, 0xf2, 0xde, 0x1f, 0x19, 0x79,
+	0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x63, 0x68, 0x61,
+	0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x73, 0x22, 0x52, 0x11, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x7c, 0x0a, 0x19, 0x6e,
+	0x65, 0x78, 0x74, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6c,
+	0x6c, 0x65, 0x6e, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x42, 0x41,
+	0xea, 0xde, 0x1f, 0x19, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x5f, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x5f, 0x69, 0x64, 0xf2, 0xde, 0x1f,
+	0x20, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x73, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x5f, 0x69, 0x64,
+	0x22, 0x52, 0x16, 0x6e, 0x65, 0x78, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x68,
+	0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x49, 0x64, 0x12, 0xae, 0x01, 0x0a, 0x1a, 0x73, 0x74,
+	0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x5f,
+	0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27,
+	0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e, 0x73, 0x64, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65,
+	0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x42, 0x47, 0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f,
+	0x1a, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e,
+	0x67, 0x65, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0xf2, 0xde, 0x1f, 0x21, 0x79,
+	0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x63, 0x68, 0x61,
+	0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x22,
+	0x52, 0x18, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e,
+	0x67, 0x65, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x12, 0x6f, 0x0a, 0x0d, 0x73, 0x74,
+	0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e, 0x73, 0x64, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52, 0x65, 0x6e, 0x74, 0x42, 0x2d,
+	0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f, 0x0d, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f,
+	0x72, 0x65, 0x6e, 0x74, 0x73, 0xf2, 0xde, 0x1f, 0x14, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x73,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x52, 0x0c, 0x73,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x6f, 0x0a, 0x0d, 0x72,
+	0x65, 0x66, 0x75, 0x6e, 0x64, 0x5f, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x0d, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e, 0x73, 0x64, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x42,
+	0x2d, 0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f, 0x0d, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x5f,
+	0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x73, 0xf2, 0xde, 0x1f, 0x14, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22,
+	0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x5f, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x73, 0x22, 0x52, 0x0c,
+	0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x73, 0x22, 0xaf, 0x01, 0x0a,
+	0x0f, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f,
+	0x12, 0x3e, 0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x21, 0xea, 0xde, 0x1f, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x68, 0x61,
+	0x73, 0x68, 0xf2, 0xde, 0x1f, 0x10, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x66, 0x69, 0x6c, 0x65,
+	0x5f, 0x68, 0x61, 0x73, 0x68, 0x22, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x48, 0x61, 0x73, 0x68,
+	0x12, 0x5c, 0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e, 0x73, 0x64,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x25, 0xc8,
+	0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x69, 0x6e, 0x66, 0x6f,
+	0xf2, 0xde, 0x1f, 0x10, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x69,
+	0x6e, 0x66, 0x6f, 0x22, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0xa7,
+	0x01, 0x0a, 0x12, 0x63, 0x6f, 0x6d, 0x2e, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e, 0x73,
+	0x64, 0x73, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x25, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b,
+	0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x73, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2f,
+	0x73, 0x64, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x73, 0x64, 0x73, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x53,
+	0x53, 0x58, 0xaa, 0x02, 0x0e, 0x53, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e, 0x53, 0x64, 0x73,
+	0x2e, 0x56, 0x31, 0xca, 0x02, 0x0e, 0x53, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x5c, 0x53, 0x64,
+	0x73, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1a, 0x53, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x5c, 0x53,
+	0x64, 0x73, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0xea, 0x02, 0x10, 0x53, 0x74, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x3a, 0x3a, 0x53, 0x64, 0x73,
+	0x3a, 0x3a, 0x56, 0x31, 0xa8, 0xe2, 0x1e, 0x01, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1247,20 +2763,38 @@ func file_stratos_sds_v1_genesis_proto_rawDescGZIP() []byte {
 
 var file_stratos_sds_v1_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
 var file_stratos_sds_v1_genesis_proto_goTypes = []interface{}{
-	(*GenesisState)(nil),    // 0: stratos.sds.v1.GenesisState
-	(*GenesisFileInfo)(nil), // 1: stratos.sds.v1.GenesisFileInfo
-	(*Params)(nil),          // 2: stratos.sds.v1.Params
-	(*FileInfo)(nil),        // 3: stratos.sds.v1.FileInfo
+	(*GenesisState)(nil),            // 0: stratos.sds.v1.GenesisState
+	(*GenesisFileInfo)(nil),         // 1: stratos.sds.v1.GenesisFileInfo
+	(*Params)(nil),                  // 2: stratos.sds.v1.Params
+	(*PrepaySubscription)(nil),      // 3: stratos.sds.v1.PrepaySubscription
+	(*NozPriceSnapshot)(nil),        // 4: stratos.sds.v1.NozPriceSnapshot
+	(*NozBalance)(nil),              // 5: stratos.sds.v1.NozBalance
+	(*NozAllowance)(nil),            // 6: stratos.sds.v1.NozAllowance
+	(*FileAccessGrant)(nil),         // 7: stratos.sds.v1.FileAccessGrant
+	(*StorageChallenge)(nil),        // 8: stratos.sds.v1.StorageChallenge
+	(*StorageChallengeFailure)(nil), // 9: stratos.sds.v1.StorageChallengeFailure
+	(*StorageRent)(nil),             // 10: stratos.sds.v1.StorageRent
+	(*RefundNonce)(nil),             // 11: stratos.sds.v1.RefundNonce
+	(*FileInfo)(nil),                // 12: stratos.sds.v1.FileInfo
 }
 var file_stratos_sds_v1_genesis_proto_depIdxs = []int32{
-	2, // 0: stratos.sds.v1.GenesisState.params:type_name -> stratos.sds.v1.Params
-	1, // 1: stratos.sds.v1.GenesisState.files:type_name -> stratos.sds.v1.GenesisFileInfo
-	3, // 2: stratos.sds.v1.GenesisFileInfo.file_info:type_name -> stratos.sds.v1.FileInfo
-	3, // [3:3] is the sub-list for method output_type
-	3, // [3:3] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	2,  // 0: stratos.sds.v1.GenesisState.params:type_name -> stratos.sds.v1.Params
+	1,  // 1: stratos.sds.v1.GenesisState.files:type_name -> stratos.sds.v1.GenesisFileInfo
+	3,  // 2: stratos.sds.v1.GenesisState.prepay_subscriptions:type_name -> stratos.sds.v1.PrepaySubscription
+	4,  // 3: stratos.sds.v1.GenesisState.noz_price_history:type_name -> stratos.sds.v1.NozPriceSnapshot
+	5,  // 4: stratos.sds.v1.GenesisState.noz_balances:type_name -> stratos.sds.v1.NozBalance
+	6,  // 5: stratos.sds.v1.GenesisState.noz_allowances:type_name -> stratos.sds.v1.NozAllowance
+	7,  // 6: stratos.sds.v1.GenesisState.file_access_grants:type_name -> stratos.sds.v1.FileAccessGrant
+	8,  // 7: stratos.sds.v1.GenesisState.storage_challenges:type_name -> stratos.sds.v1.StorageChallenge
+	9,  // 8: stratos.sds.v1.GenesisState.storage_challenge_failures:type_name -> stratos.sds.v1.StorageChallengeFailure
+	10, // 9: stratos.sds.v1.GenesisState.storage_rents:type_name -> stratos.sds.v1.StorageRent
+	11, // 10: stratos.sds.v1.GenesisState.refund_nonces:type_name -> stratos.sds.v1.RefundNonce
+	12, // 11: stratos.sds.v1.GenesisFileInfo.file_info:type_name -> stratos.sds.v1.FileInfo
+	12, // [12:12] is the sub-list for method output_type
+	12, // [12:12] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_stratos_sds_v1_genesis_proto_init() }
@@ -19,11 +19,20 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Fileupload_FullMethodName = "/stratos.sds.v1.Query/Fileupload"
-	Query_SimPrepay_FullMethodName  = "/stratos.sds.v1.Query/SimPrepay"
-	Query_NozPrice_FullMethodName   = "/stratos.sds.v1.Query/NozPrice"
-	Query_NozSupply_FullMethodName  = "/stratos.sds.v1.Query/NozSupply"
-	Query_Params_FullMethodName     = "/stratos.sds.v1.Query/Params"
+	Query_Fileupload_FullMethodName          = "/stratos.sds.v1.Query/Fileupload"
+	Query_SimPrepay_FullMethodName           = "/stratos.sds.v1.Query/SimPrepay"
+	Query_SimRefund_FullMethodName           = "/stratos.sds.v1.Query/SimRefund"
+	Query_NozPrice_FullMethodName            = "/stratos.sds.v1.Query/NozPrice"
+	Query_NozSupply_FullMethodName           = "/stratos.sds.v1.Query/NozSupply"
+	Query_Params_FullMethodName              = "/stratos.sds.v1.Query/Params"
+	Query_PrepaySubscriptions_FullMethodName = "/stratos.sds.v1.Query/PrepaySubscriptions"
+	Query_NozPriceHistory_FullMethodName     = "/stratos.sds.v1.Query/NozPriceHistory"
+	Query_NozPriceTWAP_FullMethodName        = "/stratos.sds.v1.Query/NozPriceTWAP"
+	Query_NozBalance_FullMethodName          = "/stratos.sds.v1.Query/NozBalance"
+	Query_NozAllowance_FullMethodName        = "/stratos.sds.v1.Query/NozAllowance"
+	Query_FileAccess_FullMethodName          = "/stratos.sds.v1.Query/FileAccess"
+	Query_StorageRent_FullMethodName         = "/stratos.sds.v1.Query/StorageRent"
+	Query_StorageChallenges_FullMethodName   = "/stratos.sds.v1.Query/StorageChallenges"
 )
 
 // QueryClient is the client API for Query service.
@@ -33,10 +42,28 @@ type QueryClient interface {
 	// Query uploaded file info by hash
 	Fileupload(ctx context.Context, in *QueryFileUploadRequest, opts ...grpc.CallOption) (*QueryFileUploadResponse, error)
 	SimPrepay(ctx context.Context, in *QuerySimPrepayRequest, opts ...grpc.CallOption) (*QuerySimPrepayResponse, error)
+	// SimRefund simulates the STOS refunded for burning an amount of noz.
+	SimRefund(ctx context.Context, in *QuerySimRefundRequest, opts ...grpc.CallOption) (*QuerySimRefundResponse, error)
 	NozPrice(ctx context.Context, in *QueryNozPriceRequest, opts ...grpc.CallOption) (*QueryNozPriceResponse, error)
 	NozSupply(ctx context.Context, in *QueryNozSupplyRequest, opts ...grpc.CallOption) (*QueryNozSupplyResponse, error)
 	// Params queries SDS module Params info.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// PrepaySubscriptions queries all prepay subscriptions created by the given sender.
+	PrepaySubscriptions(ctx context.Context, in *QueryPrepaySubscriptionsRequest, opts ...grpc.CallOption) (*QueryPrepaySubscriptionsResponse, error)
+	// NozPriceHistory queries the recorded noz price snapshots within a height range.
+	NozPriceHistory(ctx context.Context, in *QueryNozPriceHistoryRequest, opts ...grpc.CallOption) (*QueryNozPriceHistoryResponse, error)
+	// NozPriceTWAP queries the time weighted average noz price over the latest window of blocks.
+	NozPriceTWAP(ctx context.Context, in *QueryNozPriceTWAPRequest, opts ...grpc.CallOption) (*QueryNozPriceTWAPResponse, error)
+	// NozBalance queries the noz ledger balance of a wallet.
+	NozBalance(ctx context.Context, in *QueryNozBalanceRequest, opts ...grpc.CallOption) (*QueryNozBalanceResponse, error)
+	// NozAllowance queries the amount of noz a spender is allowed to transfer on behalf of the owner.
+	NozAllowance(ctx context.Context, in *QueryNozAllowanceRequest, opts ...grpc.CallOption) (*QueryNozAllowanceResponse, error)
+	// FileAccess queries the read access to a file granted to a wallet.
+	FileAccess(ctx context.Context, in *QueryFileAccessRequest, opts ...grpc.CallOption) (*QueryFileAccessResponse, error)
+	// StorageRent queries the storage rent escrow of a file.
+	StorageRent(ctx context.Context, in *QueryStorageRentRequest, opts ...grpc.CallOption) (*QueryStorageRentResponse, error)
+	// StorageChallenges queries the pending storage challenges of a resource node.
+	StorageChallenges(ctx context.Context, in *QueryStorageChallengesRequest, opts ...grpc.CallOption) (*QueryStorageChallengesResponse, error)
 }
 
 type queryClient struct {
@@ -65,6 +92,15 @@ func (c *queryClient) SimPrepay(ctx context.Context, in *QuerySimPrepayRequest,
 	return out, nil
 }
 
+func (c *queryClient) SimRefund(ctx context.Context, in *QuerySimRefundRequest, opts ...grpc.CallOption) (*QuerySimRefundResponse, error) {
+	out := new(QuerySimRefundResponse)
+	err := c.cc.Invoke(ctx, Query_SimRefund_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) NozPrice(ctx context.Context, in *QueryNozPriceRequest, opts ...grpc.CallOption) (*QueryNozPriceResponse, error) {
 	out := new(QueryNozPriceResponse)
 	err := c.cc.Invoke(ctx, Query_NozPrice_FullMethodName, in, out, opts...)
@@ -92,6 +128,78 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) PrepaySubscriptions(ctx context.Context, in *QueryPrepaySubscriptionsRequest, opts ...grpc.CallOption) (*QueryPrepaySubscriptionsResponse, error) {
+	out := new(QueryPrepaySubscriptionsResponse)
+	err := c.cc.Invoke(ctx, Query_PrepaySubscriptions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) NozPriceHistory(ctx context.Context, in *QueryNozPriceHistoryRequest, opts ...grpc.CallOption) (*QueryNozPriceHistoryResponse, error) {
+	out := new(QueryNozPriceHistoryResponse)
+	err := c.cc.Invoke(ctx, Query_NozPriceHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) NozPriceTWAP(ctx context.Context, in *QueryNozPriceTWAPRequest, opts ...grpc.CallOption) (*QueryNozPriceTWAPResponse, error) {
+	out := new(QueryNozPriceTWAPResponse)
+	err := c.cc.Invoke(ctx, Query_NozPriceTWAP_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) NozBalance(ctx context.Context, in *QueryNozBalanceRequest, opts ...grpc.CallOption) (*QueryNozBalanceResponse, error) {
+	out := new(QueryNozBalanceResponse)
+	err := c.cc.Invoke(ctx, Query_NozBalance_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) NozAllowance(ctx context.Context, in *QueryNozAllowanceRequest, opts ...grpc.CallOption) (*QueryNozAllowanceResponse, error) {
+	out := new(QueryNozAllowanceResponse)
+	err := c.cc.Invoke(ctx, Query_NozAllowance_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FileAccess(ctx context.Context, in *QueryFileAccessRequest, opts ...grpc.CallOption) (*QueryFileAccessResponse, error) {
+	out := new(QueryFileAccessResponse)
+	err := c.cc.Invoke(ctx, Query_FileAccess_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) StorageRent(ctx context.Context, in *QueryStorageRentRequest, opts ...grpc.CallOption) (*QueryStorageRentResponse, error) {
+	out := new(QueryStorageRentResponse)
+	err := c.cc.Invoke(ctx, Query_StorageRent_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) StorageChallenges(ctx context.Context, in *QueryStorageChallengesRequest, opts ...grpc.CallOption) (*QueryStorageChallengesResponse, error) {
+	out := new(QueryStorageChallengesResponse)
+	err := c.cc.Invoke(ctx, Query_StorageChallenges_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -99,10 +207,28 @@ type QueryServer interface {
 	// Query uploaded file info by hash
 	Fileupload(context.Context, *QueryFileUploadRequest) (*QueryFileUploadResponse, error)
 	SimPrepay(context.Context, *QuerySimPrepayRequest) (*QuerySimPrepayResponse, error)
+	// SimRefund simulates the STOS refunded for burning an amount of noz.
+	SimRefund(context.Context, *QuerySimRefundRequest) (*QuerySimRefundResponse, error)
 	NozPrice(context.Context, *QueryNozPriceRequest) (*QueryNozPriceResponse, error)
 	NozSupply(context.Context, *QueryNozSupplyRequest) (*QueryNozSupplyResponse, error)
 	// Params queries SDS module Params info.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// PrepaySubscriptions queries all prepay subscriptions created by the given sender.
+	PrepaySubscriptions(context.Context, *QueryPrepaySubscriptionsRequest) (*QueryPrepaySubscriptionsResponse, error)
+	// NozPriceHistory queries the recorded noz price snapshots within a height range.
+	NozPriceHistory(context.Context, *QueryNozPriceHistoryRequest) (*QueryNozPriceHistoryResponse, error)
+	// NozPriceTWAP queries the time weighted average noz price over the latest window of blocks.
+	NozPriceTWAP(context.Context, *QueryNozPriceTWAPRequest) (*QueryNozPriceTWAPResponse, error)
+	// NozBalance queries the noz ledger balance of a wallet.
+	NozBalance(context.Context, *QueryNozBalanceRequest) (*QueryNozBalanceResponse, error)
+	// NozAllowance queries the amount of noz a spender is allowed to transfer on behalf of the owner.
+	NozAllowance(context.Context, *QueryNozAllowanceRequest) (*QueryNozAllowanceResponse, error)
+	// FileAccess queries the read access to a file granted to a wallet.
+	FileAccess(context.Context, *QueryFileAccessRequest) (*QueryFileAccessResponse, error)
+	// StorageRent queries the storage rent escrow of a file.
+	StorageRent(context.Context, *QueryStorageRentRequest) (*QueryStorageRentResponse, error)
+	// StorageChallenges queries the pending storage challenges of a resource node.
+	StorageChallenges(context.Context, *QueryStorageChallengesRequest) (*QueryStorageChallengesResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -116,6 +242,9 @@ func (UnimplementedQueryServer) Fileupload(context.Context, *QueryFileUploadRequ
 func (UnimplementedQueryServer) SimPrepay(context.Context, *QuerySimPrepayRequest) (*QuerySimPrepayResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SimPrepay not implemented")
 }
+func (UnimplementedQueryServer) SimRefund(context.Context, *QuerySimRefundRequest) (*QuerySimRefundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimRefund not implemented")
+}
 func (UnimplementedQueryServer) NozPrice(context.Context, *QueryNozPriceRequest) (*QueryNozPriceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method NozPrice not implemented")
 }
@@ -125,6 +254,30 @@ func (UnimplementedQueryServer) NozSupply(context.Context, *QueryNozSupplyReques
 func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (UnimplementedQueryServer) PrepaySubscriptions(context.Context, *QueryPrepaySubscriptionsRequest) (*QueryPrepaySubscriptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PrepaySubscriptions not implemented")
+}
+func (UnimplementedQueryServer) NozPriceHistory(context.Context, *QueryNozPriceHistoryRequest) (*QueryNozPriceHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NozPriceHistory not implemented")
+}
+func (UnimplementedQueryServer) NozPriceTWAP(context.Context, *QueryNozPriceTWAPRequest) (*QueryNozPriceTWAPResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NozPriceTWAP not implemented")
+}
+func (UnimplementedQueryServer) NozBalance(context.Context, *QueryNozBalanceRequest) (*QueryNozBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NozBalance not implemented")
+}
+func (UnimplementedQueryServer) NozAllowance(context.Context, *QueryNozAllowanceRequest) (*QueryNozAllowanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NozAllowance not implemented")
+}
+func (UnimplementedQueryServer) FileAccess(context.Context, *QueryFileAccessRequest) (*QueryFileAccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FileAccess not implemented")
+}
+func (UnimplementedQueryServer) StorageRent(context.Context, *QueryStorageRentRequest) (*QueryStorageRentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StorageRent not implemented")
+}
+func (UnimplementedQueryServer) StorageChallenges(context.Context, *QueryStorageChallengesRequest) (*QueryStorageChallengesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StorageChallenges not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -174,6 +327,24 @@ func _Query_SimPrepay_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_SimRefund_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimRefundRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimRefund(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SimRefund_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimRefund(ctx, req.(*QuerySimRefundRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_NozPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryNozPriceRequest)
 	if err := dec(in); err != nil {
@@ -228,6 +399,150 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_PrepaySubscriptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPrepaySubscriptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PrepaySubscriptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PrepaySubscriptions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PrepaySubscriptions(ctx, req.(*QueryPrepaySubscriptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_NozPriceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNozPriceHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NozPriceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_NozPriceHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NozPriceHistory(ctx, req.(*QueryNozPriceHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_NozPriceTWAP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNozPriceTWAPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NozPriceTWAP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_NozPriceTWAP_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NozPriceTWAP(ctx, req.(*QueryNozPriceTWAPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_NozBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNozBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NozBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_NozBalance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NozBalance(ctx, req.(*QueryNozBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_NozAllowance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNozAllowanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NozAllowance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_NozAllowance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NozAllowance(ctx, req.(*QueryNozAllowanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FileAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFileAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FileAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_FileAccess_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FileAccess(ctx, req.(*QueryFileAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StorageRent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStorageRentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StorageRent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_StorageRent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StorageRent(ctx, req.(*QueryStorageRentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StorageChallenges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStorageChallengesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StorageChallenges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_StorageChallenges_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StorageChallenges(ctx, req.(*QueryStorageChallengesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -243,6 +558,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SimPrepay",
 			Handler:    _Query_SimPrepay_Handler,
 		},
+		{
+			MethodName: "SimRefund",
+			Handler:    _Query_SimRefund_Handler,
+		},
 		{
 			MethodName: "NozPrice",
 			Handler:    _Query_NozPrice_Handler,
@@ -255,6 +574,38 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "PrepaySubscriptions",
+			Handler:    _Query_PrepaySubscriptions_Handler,
+		},
+		{
+			MethodName: "NozPriceHistory",
+			Handler:    _Query_NozPriceHistory_Handler,
+		},
+		{
+			MethodName: "NozPriceTWAP",
+			Handler:    _Query_NozPriceTWAP_Handler,
+		},
+		{
+			MethodName: "NozBalance",
+			Handler:    _Query_NozBalance_Handler,
+		},
+		{
+			MethodName: "NozAllowance",
+			Handler:    _Query_NozAllowance_Handler,
+		},
+		{
+			MethodName: "FileAccess",
+			Handler:    _Query_FileAccess_Handler,
+		},
+		{
+			MethodName: "StorageRent",
+			Handler:    _Query_StorageRent_Handler,
+		},
+		{
+			MethodName: "StorageChallenges",
+			Handler:    _Query_StorageChallenges_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "stratos/sds/v1/query.proto",
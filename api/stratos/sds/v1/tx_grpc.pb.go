@@ -19,9 +19,19 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_HandleMsgFileUpload_FullMethodName = "/stratos.sds.v1.Msg/HandleMsgFileUpload"
-	Msg_HandleMsgPrepay_FullMethodName     = "/stratos.sds.v1.Msg/HandleMsgPrepay"
-	Msg_UpdateParams_FullMethodName        = "/stratos.sds.v1.Msg/UpdateParams"
+	Msg_HandleMsgFileUpload_FullMethodName               = "/stratos.sds.v1.Msg/HandleMsgFileUpload"
+	Msg_HandleMsgPrepay_FullMethodName                   = "/stratos.sds.v1.Msg/HandleMsgPrepay"
+	Msg_HandleMsgCreatePrepaySubscription_FullMethodName = "/stratos.sds.v1.Msg/HandleMsgCreatePrepaySubscription"
+	Msg_HandleMsgCancelPrepaySubscription_FullMethodName = "/stratos.sds.v1.Msg/HandleMsgCancelPrepaySubscription"
+	Msg_HandleMsgTransferNoz_FullMethodName              = "/stratos.sds.v1.Msg/HandleMsgTransferNoz"
+	Msg_HandleMsgApproveNoz_FullMethodName               = "/stratos.sds.v1.Msg/HandleMsgApproveNoz"
+	Msg_HandleMsgTransferNozFrom_FullMethodName          = "/stratos.sds.v1.Msg/HandleMsgTransferNozFrom"
+	Msg_HandleMsgGrantFileAccess_FullMethodName          = "/stratos.sds.v1.Msg/HandleMsgGrantFileAccess"
+	Msg_HandleMsgRevokeFileAccess_FullMethodName         = "/stratos.sds.v1.Msg/HandleMsgRevokeFileAccess"
+	Msg_HandleMsgSubmitStorageProof_FullMethodName       = "/stratos.sds.v1.Msg/HandleMsgSubmitStorageProof"
+	Msg_HandleMsgRefundNoz_FullMethodName                = "/stratos.sds.v1.Msg/HandleMsgRefundNoz"
+	Msg_HandleMsgDeleteFile_FullMethodName               = "/stratos.sds.v1.Msg/HandleMsgDeleteFile"
+	Msg_UpdateParams_FullMethodName                      = "/stratos.sds.v1.Msg/UpdateParams"
 )
 
 // MsgClient is the client API for Msg service.
@@ -32,6 +42,26 @@ type MsgClient interface {
 	HandleMsgFileUpload(ctx context.Context, in *MsgFileUpload, opts ...grpc.CallOption) (*MsgFileUploadResponse, error)
 	// HandleMsgPrepay defines a method for prepay
 	HandleMsgPrepay(ctx context.Context, in *MsgPrepay, opts ...grpc.CallOption) (*MsgPrepayResponse, error)
+	// HandleMsgCreatePrepaySubscription defines a method for creating a recurring prepay
+	HandleMsgCreatePrepaySubscription(ctx context.Context, in *MsgCreatePrepaySubscription, opts ...grpc.CallOption) (*MsgCreatePrepaySubscriptionResponse, error)
+	// HandleMsgCancelPrepaySubscription defines a method for cancelling a recurring prepay
+	HandleMsgCancelPrepaySubscription(ctx context.Context, in *MsgCancelPrepaySubscription, opts ...grpc.CallOption) (*MsgCancelPrepaySubscriptionResponse, error)
+	// HandleMsgTransferNoz defines a method for transferring noz to another wallet
+	HandleMsgTransferNoz(ctx context.Context, in *MsgTransferNoz, opts ...grpc.CallOption) (*MsgTransferNozResponse, error)
+	// HandleMsgApproveNoz defines a method for allowing a spender to transfer noz on behalf of the owner
+	HandleMsgApproveNoz(ctx context.Context, in *MsgApproveNoz, opts ...grpc.CallOption) (*MsgApproveNozResponse, error)
+	// HandleMsgTransferNozFrom defines a method for transferring noz on behalf of the owner
+	HandleMsgTransferNozFrom(ctx context.Context, in *MsgTransferNozFrom, opts ...grpc.CallOption) (*MsgTransferNozFromResponse, error)
+	// HandleMsgGrantFileAccess defines a method for granting read access to an uploaded file
+	HandleMsgGrantFileAccess(ctx context.Context, in *MsgGrantFileAccess, opts ...grpc.CallOption) (*MsgGrantFileAccessResponse, error)
+	// HandleMsgRevokeFileAccess defines a method for revoking read access to an uploaded file
+	HandleMsgRevokeFileAccess(ctx context.Context, in *MsgRevokeFileAccess, opts ...grpc.CallOption) (*MsgRevokeFileAccessResponse, error)
+	// HandleMsgSubmitStorageProof defines a method for a resource node to answer a storage challenge
+	HandleMsgSubmitStorageProof(ctx context.Context, in *MsgSubmitStorageProof, opts ...grpc.CallOption) (*MsgSubmitStorageProofResponse, error)
+	// HandleMsgRefundNoz defines a method for burning unused noz in exchange of the prepaid STOS
+	HandleMsgRefundNoz(ctx context.Context, in *MsgRefundNoz, opts ...grpc.CallOption) (*MsgRefundNozResponse, error)
+	// HandleMsgDeleteFile defines a method for the uploader to delete a file and get back its unspent storage rent
+	HandleMsgDeleteFile(ctx context.Context, in *MsgDeleteFile, opts ...grpc.CallOption) (*MsgDeleteFileResponse, error)
 	// UpdateParams defined a governance operation for updating the x/sds module parameters.
 	// The authority is hard-coded to the Cosmos SDK x/gov module account
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
@@ -63,6 +93,96 @@ func (c *msgClient) HandleMsgPrepay(ctx context.Context, in *MsgPrepay, opts ...
 	return out, nil
 }
 
+func (c *msgClient) HandleMsgCreatePrepaySubscription(ctx context.Context, in *MsgCreatePrepaySubscription, opts ...grpc.CallOption) (*MsgCreatePrepaySubscriptionResponse, error) {
+	out := new(MsgCreatePrepaySubscriptionResponse)
+	err := c.cc.Invoke(ctx, Msg_HandleMsgCreatePrepaySubscription_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) HandleMsgCancelPrepaySubscription(ctx context.Context, in *MsgCancelPrepaySubscription, opts ...grpc.CallOption) (*MsgCancelPrepaySubscriptionResponse, error) {
+	out := new(MsgCancelPrepaySubscriptionResponse)
+	err := c.cc.Invoke(ctx, Msg_HandleMsgCancelPrepaySubscription_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) HandleMsgTransferNoz(ctx context.Context, in *MsgTransferNoz, opts ...grpc.CallOption) (*MsgTransferNozResponse, error) {
+	out := new(MsgTransferNozResponse)
+	err := c.cc.Invoke(ctx, Msg_HandleMsgTransferNoz_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) HandleMsgApproveNoz(ctx context.Context, in *MsgApproveNoz, opts ...grpc.CallOption) (*MsgApproveNozResponse, error) {
+	out := new(MsgApproveNozResponse)
+	err := c.cc.Invoke(ctx, Msg_HandleMsgApproveNoz_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) HandleMsgTransferNozFrom(ctx context.Context, in *MsgTransferNozFrom, opts ...grpc.CallOption) (*MsgTransferNozFromResponse, error) {
+	out := new(MsgTransferNozFromResponse)
+	err := c.cc.Invoke(ctx, Msg_HandleMsgTransferNozFrom_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) HandleMsgGrantFileAccess(ctx context.Context, in *MsgGrantFileAccess, opts ...grpc.CallOption) (*MsgGrantFileAccessResponse, error) {
+	out := new(MsgGrantFileAccessResponse)
+	err := c.cc.Invoke(ctx, Msg_HandleMsgGrantFileAccess_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) HandleMsgRevokeFileAccess(ctx context.Context, in *MsgRevokeFileAccess, opts ...grpc.CallOption) (*MsgRevokeFileAccessResponse, error) {
+	out := new(MsgRevokeFileAccessResponse)
+	err := c.cc.Invoke(ctx, Msg_HandleMsgRevokeFileAccess_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) HandleMsgSubmitStorageProof(ctx context.Context, in *MsgSubmitStorageProof, opts ...grpc.CallOption) (*MsgSubmitStorageProofResponse, error) {
+	out := new(MsgSubmitStorageProofResponse)
+	err := c.cc.Invoke(ctx, Msg_HandleMsgSubmitStorageProof_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) HandleMsgRefundNoz(ctx context.Context, in *MsgRefundNoz, opts ...grpc.CallOption) (*MsgRefundNozResponse, error) {
+	out := new(MsgRefundNozResponse)
+	err := c.cc.Invoke(ctx, Msg_HandleMsgRefundNoz_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) HandleMsgDeleteFile(ctx context.Context, in *MsgDeleteFile, opts ...grpc.CallOption) (*MsgDeleteFileResponse, error) {
+	out := new(MsgDeleteFileResponse)
+	err := c.cc.Invoke(ctx, Msg_HandleMsgDeleteFile_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
 	out := new(MsgUpdateParamsResponse)
 	err := c.cc.Invoke(ctx, Msg_UpdateParams_FullMethodName, in, out, opts...)
@@ -80,6 +200,26 @@ type MsgServer interface {
 	HandleMsgFileUpload(context.Context, *MsgFileUpload) (*MsgFileUploadResponse, error)
 	// HandleMsgPrepay defines a method for prepay
 	HandleMsgPrepay(context.Context, *MsgPrepay) (*MsgPrepayResponse, error)
+	// HandleMsgCreatePrepaySubscription defines a method for creating a recurring prepay
+	HandleMsgCreatePrepaySubscription(context.Context, *MsgCreatePrepaySubscription) (*MsgCreatePrepaySubscriptionResponse, error)
+	// HandleMsgCancelPrepaySubscription defines a method for cancelling a recurring prepay
+	HandleMsgCancelPrepaySubscription(context.Context, *MsgCancelPrepaySubscription) (*MsgCancelPrepaySubscriptionResponse, error)
+	// HandleMsgTransferNoz defines a method for transferring noz to another wallet
+	HandleMsgTransferNoz(context.Context, *MsgTransferNoz) (*MsgTransferNozResponse, error)
+	// HandleMsgApproveNoz defines a method for allowing a spender to transfer noz on behalf of the owner
+	HandleMsgApproveNoz(context.Context, *MsgApproveNoz) (*MsgApproveNozResponse, error)
+	// HandleMsgTransferNozFrom defines a method for transferring noz on behalf of the owner
+	HandleMsgTransferNozFrom(context.Context, *MsgTransferNozFrom) (*MsgTransferNozFromResponse, error)
+	// HandleMsgGrantFileAccess defines a method for granting read access to an uploaded file
+	HandleMsgGrantFileAccess(context.Context, *MsgGrantFileAccess) (*MsgGrantFileAccessResponse, error)
+	// HandleMsgRevokeFileAccess defines a method for revoking read access to an uploaded file
+	HandleMsgRevokeFileAccess(context.Context, *MsgRevokeFileAccess) (*MsgRevokeFileAccessResponse, error)
+	// HandleMsgSubmitStorageProof defines a method for a resource node to answer a storage challenge
+	HandleMsgSubmitStorageProof(context.Context, *MsgSubmitStorageProof) (*MsgSubmitStorageProofResponse, error)
+	// HandleMsgRefundNoz defines a method for burning unused noz in exchange of the prepaid STOS
+	HandleMsgRefundNoz(context.Context, *MsgRefundNoz) (*MsgRefundNozResponse, error)
+	// HandleMsgDeleteFile defines a method for the uploader to delete a file and get back its unspent storage rent
+	HandleMsgDeleteFile(context.Context, *MsgDeleteFile) (*MsgDeleteFileResponse, error)
 	// UpdateParams defined a governance operation for updating the x/sds module parameters.
 	// The authority is hard-coded to the Cosmos SDK x/gov module account
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
@@ -96,6 +236,36 @@ func (UnimplementedMsgServer) HandleMsgFileUpload(context.Context, *MsgFileUploa
 func (UnimplementedMsgServer) HandleMsgPrepay(context.Context, *MsgPrepay) (*MsgPrepayResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method HandleMsgPrepay not implemented")
 }
+func (UnimplementedMsgServer) HandleMsgCreatePrepaySubscription(context.Context, *MsgCreatePrepaySubscription) (*MsgCreatePrepaySubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HandleMsgCreatePrepaySubscription not implemented")
+}
+func (UnimplementedMsgServer) HandleMsgCancelPrepaySubscription(context.Context, *MsgCancelPrepaySubscription) (*MsgCancelPrepaySubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HandleMsgCancelPrepaySubscription not implemented")
+}
+func (UnimplementedMsgServer) HandleMsgTransferNoz(context.Context, *MsgTransferNoz) (*MsgTransferNozResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HandleMsgTransferNoz not implemented")
+}
+func (UnimplementedMsgServer) HandleMsgApproveNoz(context.Context, *MsgApproveNoz) (*MsgApproveNozResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HandleMsgApproveNoz not implemented")
+}
+func (UnimplementedMsgServer) HandleMsgTransferNozFrom(context.Context, *MsgTransferNozFrom) (*MsgTransferNozFromResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HandleMsgTransferNozFrom not implemented")
+}
+func (UnimplementedMsgServer) HandleMsgGrantFileAccess(context.Context, *MsgGrantFileAccess) (*MsgGrantFileAccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HandleMsgGrantFileAccess not implemented")
+}
+func (UnimplementedMsgServer) HandleMsgRevokeFileAccess(context.Context, *MsgRevokeFileAccess) (*MsgRevokeFileAccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HandleMsgRevokeFileAccess not implemented")
+}
+func (UnimplementedMsgServer) HandleMsgSubmitStorageProof(context.Context, *MsgSubmitStorageProof) (*MsgSubmitStorageProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HandleMsgSubmitStorageProof not implemented")
+}
+func (UnimplementedMsgServer) HandleMsgRefundNoz(context.Context, *MsgRefundNoz) (*MsgRefundNozResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HandleMsgRefundNoz not implemented")
+}
+func (UnimplementedMsgServer) HandleMsgDeleteFile(context.Context, *MsgDeleteFile) (*MsgDeleteFileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HandleMsgDeleteFile not implemented")
+}
 func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
@@ -148,6 +318,186 @@ func _Msg_HandleMsgPrepay_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_HandleMsgCreatePrepaySubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreatePrepaySubscription)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).HandleMsgCreatePrepaySubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_HandleMsgCreatePrepaySubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).HandleMsgCreatePrepaySubscription(ctx, req.(*MsgCreatePrepaySubscription))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_HandleMsgCancelPrepaySubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelPrepaySubscription)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).HandleMsgCancelPrepaySubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_HandleMsgCancelPrepaySubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).HandleMsgCancelPrepaySubscription(ctx, req.(*MsgCancelPrepaySubscription))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_HandleMsgTransferNoz_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferNoz)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).HandleMsgTransferNoz(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_HandleMsgTransferNoz_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).HandleMsgTransferNoz(ctx, req.(*MsgTransferNoz))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_HandleMsgApproveNoz_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgApproveNoz)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).HandleMsgApproveNoz(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_HandleMsgApproveNoz_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).HandleMsgApproveNoz(ctx, req.(*MsgApproveNoz))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_HandleMsgTransferNozFrom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferNozFrom)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).HandleMsgTransferNozFrom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_HandleMsgTransferNozFrom_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).HandleMsgTransferNozFrom(ctx, req.(*MsgTransferNozFrom))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_HandleMsgGrantFileAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGrantFileAccess)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).HandleMsgGrantFileAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_HandleMsgGrantFileAccess_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).HandleMsgGrantFileAccess(ctx, req.(*MsgGrantFileAccess))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_HandleMsgRevokeFileAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeFileAccess)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).HandleMsgRevokeFileAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_HandleMsgRevokeFileAccess_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).HandleMsgRevokeFileAccess(ctx, req.(*MsgRevokeFileAccess))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_HandleMsgSubmitStorageProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitStorageProof)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).HandleMsgSubmitStorageProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_HandleMsgSubmitStorageProof_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).HandleMsgSubmitStorageProof(ctx, req.(*MsgSubmitStorageProof))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_HandleMsgRefundNoz_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRefundNoz)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).HandleMsgRefundNoz(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_HandleMsgRefundNoz_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).HandleMsgRefundNoz(ctx, req.(*MsgRefundNoz))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_HandleMsgDeleteFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteFile)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).HandleMsgDeleteFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_HandleMsgDeleteFile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).HandleMsgDeleteFile(ctx, req.(*MsgDeleteFile))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgUpdateParams)
 	if err := dec(in); err != nil {
@@ -181,6 +531,46 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "HandleMsgPrepay",
 			Handler:    _Msg_HandleMsgPrepay_Handler,
 		},
+		{
+			MethodName: "HandleMsgCreatePrepaySubscription",
+			Handler:    _Msg_HandleMsgCreatePrepaySubscription_Handler,
+		},
+		{
+			MethodName: "HandleMsgCancelPrepaySubscription",
+			Handler:    _Msg_HandleMsgCancelPrepaySubscription_Handler,
+		},
+		{
+			MethodName: "HandleMsgTransferNoz",
+			Handler:    _Msg_HandleMsgTransferNoz_Handler,
+		},
+		{
+			MethodName: "HandleMsgApproveNoz",
+			Handler:    _Msg_HandleMsgApproveNoz_Handler,
+		},
+		{
+			MethodName: "HandleMsgTransferNozFrom",
+			Handler:    _Msg_HandleMsgTransferNozFrom_Handler,
+		},
+		{
+			MethodName: "HandleMsgGrantFileAccess",
+			Handler:    _Msg_HandleMsgGrantFileAccess_Handler,
+		},
+		{
+			MethodName: "HandleMsgRevokeFileAccess",
+			Handler:    _Msg_HandleMsgRevokeFileAccess_Handler,
+		},
+		{
+			MethodName: "HandleMsgSubmitStorageProof",
+			Handler:    _Msg_HandleMsgSubmitStorageProof_Handler,
+		},
+		{
+			MethodName: "HandleMsgRefundNoz",
+			Handler:    _Msg_HandleMsgRefundNoz_Handler,
+		},
+		{
+			MethodName: "HandleMsgDeleteFile",
+			Handler:    _Msg_HandleMsgDeleteFile_Handler,
+		},
 		{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
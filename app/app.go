@@ -239,6 +239,7 @@ func NewStratosApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLates
 	app.App = appBuilder.Build(logger, db, traceStore, baseAppOptions...)
 
 	app.evmKeeper.SetMsgServiceRouter(app.App.BaseApp.MsgServiceRouter())
+	app.evmKeeper.SetSdsKeeper(app.sdsKeeper)
 
 	evmTracer := cast.ToString(appOpts.Get(srvflags.EVMTracer))
 	app.evmKeeper.SetTracer(evmTracer)
@@ -557,6 +558,10 @@ func (app *StratosApp) GetStakingKeeper() *stakingkeeper.Keeper {
 	return app.stakingKeeper
 }
 
+func (app *StratosApp) GetGovKeeper() *govkeeper.Keeper {
+	return app.govKeeper
+}
+
 func (app *StratosApp) GetRegisterKeeper() registerkeeper.Keeper {
 	return app.registerKeeper
 }
@@ -565,6 +570,10 @@ func (app *StratosApp) GetPotKeeper() potkeeper.Keeper {
 	return app.potKeeper
 }
 
+func (app *StratosApp) GetSdsKeeper() sdskeeper.Keeper {
+	return app.sdsKeeper
+}
+
 func (app *StratosApp) GetDistrKeeper() distrkeeper.Keeper {
 	return app.distrKeeper
 }
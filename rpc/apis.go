@@ -18,8 +18,10 @@ import (
 	"github.com/stratosnet/stratos-chain/rpc/namespaces/ethereum/miner"
 	"github.com/stratosnet/stratos-chain/rpc/namespaces/ethereum/net"
 	"github.com/stratosnet/stratos-chain/rpc/namespaces/ethereum/personal"
+	"github.com/stratosnet/stratos-chain/rpc/namespaces/ethereum/trace"
 	"github.com/stratosnet/stratos-chain/rpc/namespaces/ethereum/txpool"
 	"github.com/stratosnet/stratos-chain/rpc/namespaces/ethereum/web3"
+	"github.com/stratosnet/stratos-chain/rpc/namespaces/stratos"
 	"github.com/stratosnet/stratos-chain/rpc/types"
 
 	evmkeeper "github.com/stratosnet/stratos-chain/x/evm/keeper"
@@ -40,11 +42,16 @@ const (
 	TxPoolNamespace   = "txpool"
 	DebugNamespace    = "debug"
 	MinerNamespace    = "miner"
+	TraceNamespace    = "trace"
+
+	// Stratos namespaces
+
+	StratosNamespace = "stratos"
 
 	apiVersion = "1.0"
 )
 
-// GetRPCAPIs returns the list of all APIs
+// GetRPCAPIs returns the list of APIs of the selected namespaces
 func GetRPCAPIs(ctx *server.Context, tmNode *node.Node, evmKeeper *evmkeeper.Keeper, ms storetypes.MultiStore, clientCtx client.Context, selectedAPIs []string) ([]rpc.API, error) {
 	nonceLock := new(types.AddrLocker)
 	evmBackend, err := backend.NewBackend(ctx, tmNode, evmKeeper, ms, ctx.Logger, clientCtx)
@@ -53,7 +60,7 @@ func GetRPCAPIs(ctx *server.Context, tmNode *node.Node, evmKeeper *evmkeeper.Kee
 		return []rpc.API{}, err
 	}
 
-	return []rpc.API{
+	apis := []rpc.API{
 		{
 			Namespace: EthNamespace,
 			Version:   apiVersion,
@@ -96,11 +103,36 @@ func GetRPCAPIs(ctx *server.Context, tmNode *node.Node, evmKeeper *evmkeeper.Kee
 			Service:   debug.NewAPI(ctx, evmBackend, clientCtx),
 			Public:    true,
 		},
+		{
+			Namespace: TraceNamespace,
+			Version:   apiVersion,
+			Service:   trace.NewAPI(ctx.Logger, clientCtx, evmBackend),
+			Public:    true,
+		},
 		{
 			Namespace: MinerNamespace,
 			Version:   apiVersion,
 			Service:   miner.NewPrivateAPI(ctx, clientCtx, evmBackend),
 			Public:    false,
 		},
-	}, nil
+		{
+			Namespace: StratosNamespace,
+			Version:   apiVersion,
+			Service:   stratos.NewAPI(ctx.Logger, clientCtx, tmNode.EventBus(), evmBackend),
+			Public:    true,
+		},
+	}
+
+	selected := make(map[string]bool, len(selectedAPIs))
+	for _, namespace := range selectedAPIs {
+		selected[namespace] = true
+	}
+
+	enabled := make([]rpc.API, 0, len(apis))
+	for _, api := range apis {
+		if selected[api.Namespace] {
+			enabled = append(enabled, api)
+		}
+	}
+	return enabled, nil
 }
@@ -3,6 +3,7 @@ package backend
 import (
 	"context"
 	"math/big"
+	"path/filepath"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -71,6 +72,7 @@ type EVMBackend interface {
 	BlockNumber() (hexutil.Uint64, error)
 	GetTendermintBlockByNumber(blockNum types.BlockNumber) (*tmrpctypes.ResultBlock, error)
 	GetTendermintBlockByHash(blockHash common.Hash) (*tmrpctypes.ResultBlock, error)
+	GetTendermintBlockByNumberOrHash(blockNrOrHash types.BlockNumberOrHash) (*tmrpctypes.ResultBlock, error)
 	GetBlockByNumber(blockNum types.BlockNumber, fullTx bool) (*types.Block, error)
 	GetBlockByHash(hash common.Hash, fullTx bool) (*types.Block, error)
 	CurrentHeader() *types.Header
@@ -81,8 +83,11 @@ type EVMBackend interface {
 	SendTransaction(args evmtypes.TransactionArgs) (common.Hash, error)
 	GetCoinbase() (sdk.AccAddress, error)
 	GetTransactionByHash(txHash common.Hash) (*types.Transaction, error)
+	GetTransactionReceipt(hash common.Hash) (*types.TransactionReceipt, error)
+	GetBlockReceipts(resBlock *tmrpctypes.ResultBlock) ([]*types.TransactionReceipt, error)
 	GetTxByTxIndex(height int64, txIndex uint) (*tmrpctypes.ResultTx, error)
-	EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *types.BlockNumber) (hexutil.Uint64, error)
+	EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *types.BlockNumber, overrides *types.StateOverride) (hexutil.Uint64, error)
+	CreateAccessList(args evmtypes.TransactionArgs, blockNr types.BlockNumber) (*types.AccessListResult, error)
 	BaseFee() (*big.Int, error)
 	GetLogsByNumber(blockNum types.BlockNumber) ([][]*ethtypes.Log, error)
 	BlockBloom(height *int64) (ethtypes.Bloom, error)
@@ -133,7 +138,25 @@ func NewBackend(ctx *server.Context, tmNode *node.Node, evmkeeper *evmkeeper.Kee
 	}
 
 	evmCtx := evm.NewContext(logger, ms, tmNode.BlockStore())
-	txPool, err := pool.NewTxPool(core.DefaultTxPoolConfig, appConf, clientCtx, tmNode.Mempool(), evmkeeper, evmCtx)
+	txPoolCfg := pool.Config{
+		TxPoolConfig:           core.DefaultTxPoolConfig,
+		EvictionInterval:       appConf.EVM.TxPool.EvictionInterval,
+		ProcessQueueInterval:   appConf.EVM.TxPool.ProcessQueueInterval,
+		ProcessPendingInterval: appConf.EVM.TxPool.ProcessPendingInterval,
+	}
+	txPoolCfg.Journal = appConf.JSONRPC.TxPoolJournal
+	if txPoolCfg.Journal != "" && !filepath.IsAbs(txPoolCfg.Journal) {
+		txPoolCfg.Journal = filepath.Join(ctx.Config.DBDir(), txPoolCfg.Journal)
+	}
+	txPoolCfg.Rejournal = appConf.JSONRPC.TxPoolRejournal
+	txPoolCfg.PriceBump = appConf.EVM.TxPool.PriceBump
+	txPoolCfg.AccountSlots = appConf.EVM.TxPool.AccountSlots
+	txPoolCfg.GlobalSlots = appConf.EVM.TxPool.GlobalSlots
+	txPoolCfg.AccountQueue = appConf.EVM.TxPool.AccountQueue
+	txPoolCfg.GlobalQueue = appConf.EVM.TxPool.GlobalQueue
+	txPoolCfg.Lifetime = appConf.EVM.TxPool.Lifetime
+
+	txPool, err := pool.NewTxPool(txPoolCfg, appConf, clientCtx, tmNode.Mempool(), evmkeeper, evmCtx)
 	if err != nil {
 		return nil, err
 	}
@@ -12,12 +12,15 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/params"
 	"github.com/ethereum/go-ethereum/rpc"
 
 	tmrpccore "github.com/cometbft/cometbft/rpc/core"
 	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
 	tmjsonrpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
+	tmtypes "github.com/cometbft/cometbft/types"
 
 	"cosmossdk.io/errors"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -169,6 +172,18 @@ func (b *Backend) GetTendermintBlockByNumber(blockNum types.BlockNumber) (*tmrpc
 	return resBlock, nil
 }
 
+// GetTendermintBlockByNumberOrHash returns a Tendermint format block by block number or hash
+func (b *Backend) GetTendermintBlockByNumberOrHash(blockNrOrHash types.BlockNumberOrHash) (*tmrpctypes.ResultBlock, error) {
+	switch {
+	case blockNrOrHash.BlockHash != nil:
+		return b.GetTendermintBlockByHash(*blockNrOrHash.BlockHash)
+	case blockNrOrHash.BlockNumber != nil:
+		return b.GetTendermintBlockByNumber(*blockNrOrHash.BlockNumber)
+	default:
+		return nil, fmt.Errorf("types BlockHash and BlockNumber cannot be both nil")
+	}
+}
+
 // GetTendermintBlockByHash returns a Tendermint format block by block number
 func (b *Backend) GetTendermintBlockByHash(blockHash common.Hash) (*tmrpctypes.ResultBlock, error) {
 	resBlock, err := tmrpccore.BlockByHash(nil, blockHash.Bytes())
@@ -405,6 +420,156 @@ func (b *Backend) GetTransactionByHash(txHash common.Hash) (*types.Transaction,
 	)
 }
 
+// GetTransactionReceipt returns the transaction receipt identified by hash.
+func (b *Backend) GetTransactionReceipt(hash common.Hash) (*types.TransactionReceipt, error) {
+	res, err := evmtypes.GetTmTxByHash(hash)
+	if err != nil {
+		return nil, nil
+	}
+
+	block := b.GetBlockStore().LoadBlock(res.Height)
+	if block == nil {
+		b.logger.Debug("GetTransactionReceipt", "hash", hash, "block not found")
+		return nil, nil
+	}
+
+	blockResults, err := tmrpccore.BlockResults(nil, &block.Height)
+	if err != nil {
+		b.logger.Debug("GetTransactionReceipt", "hash", hash, "block not found")
+		return nil, nil
+	}
+
+	blockHash := common.BytesToHash(block.Hash())
+	cumulativeGasUsed := uint64(res.TxResult.GasUsed)
+	if res.Index != 0 {
+		cumulativeGasUsed += types.GetBlockCumulativeGas(blockResults, int(res.Index))
+	}
+
+	return b.buildReceipt(res.Tx, &res.TxResult, blockHash, uint64(res.Height), uint64(res.Index), cumulativeGasUsed)
+}
+
+// GetBlockReceipts returns the receipts of all the transactions of the given block, built from a single block results query.
+func (b *Backend) GetBlockReceipts(resBlock *tmrpctypes.ResultBlock) ([]*types.TransactionReceipt, error) {
+	block := resBlock.Block
+
+	blockResults, err := tmrpccore.BlockResults(nil, &block.Height)
+	if err != nil {
+		b.logger.Debug("GetBlockReceipts", "height", block.Height, "block results not found")
+		return nil, nil
+	}
+	if len(blockResults.TxsResults) != len(block.Txs) {
+		return nil, fmt.Errorf("block %d has %d txs but %d results", block.Height, len(block.Txs), len(blockResults.TxsResults))
+	}
+
+	blockHash := common.BytesToHash(block.Hash())
+	receipts := make([]*types.TransactionReceipt, 0, len(block.Txs))
+	cumulativeGasUsed := uint64(0)
+	for i, tmTx := range block.Txs {
+		txResult := blockResults.TxsResults[i]
+		cumulativeGasUsed += uint64(txResult.GasUsed)
+
+		receipt, err := b.buildReceipt(tmTx, txResult, blockHash, uint64(block.Height), uint64(i), cumulativeGasUsed)
+		if err != nil {
+			return nil, err
+		}
+		receipts = append(receipts, receipt)
+	}
+
+	return receipts, nil
+}
+
+// buildReceipt builds the receipt of the tx at the given position of a block from its delivery result.
+func (b *Backend) buildReceipt(
+	tmTx tmtypes.Tx,
+	txResult *abci.ResponseDeliverTx,
+	blockHash common.Hash,
+	blockHeight, txIndex, cumulativeGasUsed uint64,
+) (*types.TransactionReceipt, error) {
+	rpcTx, err := types.TmTxToEthTx(
+		b.clientCtx.TxConfig.TxDecoder(),
+		tmTx,
+		&blockHash,
+		&blockHeight,
+		&txIndex,
+	)
+	if err != nil {
+		return nil, err
+	}
+	hash := rpcTx.Hash
+
+	_, evtEthTx := types.FindEthereumTxEvent(txResult.Events, hash.Hex())
+
+	var (
+		contractAddress *common.Address
+		bloom           = ethtypes.BytesToBloom(make([]byte, 6))
+		logs            = make([]*ethtypes.Log, 0)
+	)
+	// Set status codes based on tx result
+	receiptStatus := ethtypes.ReceiptStatusSuccessful
+	if txResult.GetCode() != abci.CodeTypeOK {
+		receiptStatus = ethtypes.ReceiptStatusFailed
+	} else {
+		// Get the transaction result from the log
+		if evtEthTx.EthTxFailed != "" {
+			receiptStatus = ethtypes.ReceiptStatusFailed
+		}
+
+		if receiptStatus == ethtypes.ReceiptStatusSuccessful {
+			// parse tx logs from events
+			logs, err = TxLogsFromEvents(txResult.Events, 0)
+			if err != nil {
+				b.logger.Debug("logs not found", "hash", hash, "error", err.Error())
+			}
+			if logs == nil {
+				logs = make([]*ethtypes.Log, 0)
+			}
+			if rpcTx.To == nil {
+				// TODO: Rewrite on more optimal way in order to get a contract address
+				tx, err := b.clientCtx.TxConfig.TxDecoder()(tmTx)
+				if err != nil {
+					b.logger.Debug("decoding failed", "error", err.Error())
+					return nil, fmt.Errorf("failed to decode tx: %w", err)
+				}
+
+				// the `msgIndex` is inferred from tx events, should be within the bound.
+				msg := tx.GetMsgs()[0]
+				ethMsg, ok := msg.(*evmtypes.MsgEthereumTx)
+				if !ok {
+					b.logger.Debug(fmt.Sprintf("invalid tx type: %T", msg))
+					return nil, fmt.Errorf("invalid tx type: %T", msg)
+				}
+
+				txData, err := evmtypes.UnpackTxData(ethMsg.Data)
+				if err != nil {
+					b.logger.Error("failed to unpack tx data", "error", err.Error())
+					return nil, err
+				}
+				contractAddress = new(common.Address)
+				*contractAddress = crypto.CreateAddress(rpcTx.From, txData.GetNonce())
+			}
+			bloom = ethtypes.BytesToBloom(ethtypes.LogsBloom(logs))
+		}
+	}
+
+	receipt := &types.TransactionReceipt{
+		Type:              rpcTx.Type,
+		Status:            hexutil.Uint64(receiptStatus),
+		CumulativeGasUsed: hexutil.Uint64(cumulativeGasUsed),
+		LogsBloom:         bloom,
+		Logs:              logs,
+		TransactionHash:   rpcTx.Hash,
+		ContractAddress:   contractAddress,
+		GasUsed:           hexutil.Uint64(txResult.GasUsed),
+		BlockHash:         *rpcTx.BlockHash,
+		BlockNumber:       *rpcTx.BlockNumber,
+		TransactionIndex:  *rpcTx.TransactionIndex,
+		From:              rpcTx.From,
+		To:                rpcTx.To,
+	}
+
+	return receipt, nil
+}
+
 // GetTxByTxIndex uses `/tx_query` to find transaction by tx index of valid ethereum txs
 func (b *Backend) GetTxByTxIndex(height int64, index uint) (*tmrpctypes.ResultTx, error) {
 	query := fmt.Sprintf("tx.height=%d AND %s.%s=%d",
@@ -502,7 +667,7 @@ func (b *Backend) SendTransaction(args evmtypes.TransactionArgs) (common.Hash, e
 }
 
 // EstimateGas returns an estimate of gas usage for the given smart contract call.
-func (b *Backend) EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *types.BlockNumber) (hexutil.Uint64, error) {
+func (b *Backend) EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *types.BlockNumber, overrides *types.StateOverride) (hexutil.Uint64, error) {
 	blockNr := types.EthPendingBlockNumber
 	if blockNrOptional != nil {
 		blockNr = *blockNrOptional
@@ -517,6 +682,11 @@ func (b *Backend) EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *ty
 		Args:   bz,
 		GasCap: b.RPCGasCap(),
 	}
+	if overrides != nil {
+		if req.Overrides, err = json.Marshal(overrides); err != nil {
+			return 0, err
+		}
+	}
 
 	resBlock, err := b.GetTendermintBlockByNumber(blockNr)
 	if err != nil {
@@ -541,6 +711,55 @@ func (b *Backend) EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *ty
 	return hexutil.Uint64(res.Gas), nil
 }
 
+// CreateAccessList creates an EIP-2930 access list for the given call on top of the given block.
+func (b *Backend) CreateAccessList(args evmtypes.TransactionArgs, blockNr types.BlockNumber) (*types.AccessListResult, error) {
+	bz, err := json.Marshal(&args)
+	if err != nil {
+		return nil, err
+	}
+
+	req := evmtypes.EthCallRequest{
+		Args:   bz,
+		GasCap: b.RPCGasCap(),
+	}
+
+	resBlock, err := b.GetTendermintBlockByNumber(blockNr)
+	if err != nil {
+		return nil, err
+	}
+
+	// return if requested block height is greater than the current one or chain not synced
+	if resBlock == nil || resBlock.Block == nil {
+		return nil, fmt.Errorf("block not found '%d'", blockNr.Int64())
+	}
+
+	sdkCtx, _, err := b.GetEVMContext().GetSdkContextWithHeader(&resBlock.Block.Header)
+	if err != nil {
+		return nil, err
+	}
+	res, err := b.GetEVMKeeper().CreateAccessList(sdk.WrapSDKContext(sdkCtx), &req)
+	if err != nil {
+		return nil, err
+	}
+
+	var accessList ethtypes.AccessList
+	if err := json.Unmarshal(res.AccessList, &accessList); err != nil {
+		return nil, err
+	}
+	result := &types.AccessListResult{
+		AccessList: &accessList,
+		GasUsed:    hexutil.Uint64(res.GasUsed),
+	}
+	if res.VmError != "" {
+		if res.VmError == vm.ErrExecutionReverted.Error() {
+			result.Error = evmtypes.NewExecErrorWithReason(res.Ret).Error()
+		} else {
+			result.Error = res.VmError
+		}
+	}
+	return result, nil
+}
+
 // GetTransactionCount returns the number of transactions at the given address up to the given block number.
 func (b *Backend) GetTransactionCount(address common.Address, blockNum types.BlockNumber) (hexutil.Uint64, error) {
 	// Get nonce (sequence) from account
@@ -20,6 +20,7 @@ import (
 
 	"github.com/cometbft/cometbft/libs/log"
 	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
+	tmtypes "github.com/cometbft/cometbft/types"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/server"
@@ -34,6 +35,7 @@ import (
 	tmrpccore "github.com/cometbft/cometbft/rpc/core"
 	"github.com/stratosnet/stratos-chain/rpc/backend"
 	rpctypes "github.com/stratosnet/stratos-chain/rpc/types"
+	"github.com/stratosnet/stratos-chain/x/evm/statedb"
 	"github.com/stratosnet/stratos-chain/x/evm/tracers"
 	jstracers "github.com/stratosnet/stratos-chain/x/evm/tracers/js"
 	"github.com/stratosnet/stratos-chain/x/evm/tracers/logger"
@@ -98,12 +100,6 @@ func NewAPI(
 // TraceTransaction returns the structured logs created during the execution of EVM
 // and returns them as a JSON object.
 func (a *API) TraceTransaction(ctx context.Context, hash common.Hash, config *tracers.TraceConfig) (interface{}, error) {
-	// Assemble the structured logger or the JavaScript tracer
-	var (
-		tracer  tracers.Tracer
-		err     error
-		timeout = defaultTraceTimeout
-	)
 	a.logger.Debug("debug_traceTransaction", "hash", hash)
 
 	// Get transaction by hash
@@ -147,18 +143,127 @@ func (a *API) TraceTransaction(ctx context.Context, hash common.Hash, config *tr
 		TxHash:    ethMsg.AsTransaction().Hash(),
 	}
 
+	tracer, cancel, err := newTracer(ctx, txctx, config)
+	if err != nil {
+		return nil, err
+	}
+	defer cancel()
+
+	sdkCtx, _, err := a.backend.GetEVMContext().GetSdkContextWithHeader(&parentBlock.Block.Header)
+	if err != nil {
+		return nil, err
+	}
+
+	keeper := a.backend.GetEVMKeeper()
+
+	cfg, err := keeper.EVMConfig(sdkCtx)
+	if err != nil {
+		return nil, err
+	}
+	signer := ethtypes.MakeSigner(cfg.ChainConfig, big.NewInt(parentBlock.Block.Height))
+
+	msg, err := ethMsg.AsMessage(signer, cfg.BaseFee)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := keeper.ApplyMessage(sdkCtx, msg, tracer, false); err != nil {
+		return nil, fmt.Errorf("tracing failed: %w", err)
+	}
+	return tracer.GetResult()
+}
+
+// TraceCallConfig is the config for traceCall API. It holds one more
+// field to override the state for tracing.
+type TraceCallConfig struct {
+	tracers.TraceConfig
+	StateOverrides *rpctypes.StateOverride
+	BlockOverrides *rpctypes.BlockOverrides
+}
+
+// TraceCall lets you trace a given eth_call. It collects the structured logs
+// created during the execution of EVM if the given transaction was added on
+// top of the provided block and returns them as a JSON object.
+func (a *API) TraceCall(ctx context.Context, args evmtypes.TransactionArgs, blockNrOrHash rpctypes.BlockNumberOrHash, config *TraceCallConfig) (interface{}, error) {
+	a.logger.Debug("debug_traceCall", "args", args.String(), "block number or hash", blockNrOrHash)
+
+	resBlock, err := a.backend.GetTendermintBlockByNumberOrHash(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+	if resBlock == nil || resBlock.Block == nil {
+		return nil, errors.New("block not found")
+	}
+
+	if config == nil {
+		config = &TraceCallConfig{}
+	}
+
+	txctx := &tracers.Context{
+		BlockHash: common.BytesToHash(resBlock.Block.Hash()),
+	}
+	tracer, cancel, err := newTracer(ctx, txctx, &config.TraceConfig)
+	if err != nil {
+		return nil, err
+	}
+	defer cancel()
+
+	sdkCtx, _, err := a.backend.GetEVMContext().GetSdkContextWithHeader(&resBlock.Block.Header)
+	if err != nil {
+		return nil, err
+	}
+
+	keeper := a.backend.GetEVMKeeper()
+
+	cfg, err := keeper.EVMConfig(sdkCtx)
+	if err != nil {
+		return nil, err
+	}
+	cfg.StateOverrides = config.StateOverrides
+	cfg.BlockOverrides = config.BlockOverrides
+	if config.BlockOverrides != nil && config.BlockOverrides.BaseFee != nil {
+		cfg.BaseFee = config.BlockOverrides.BaseFee.ToInt()
+	}
+
+	// ApplyMessageWithConfig expect correct nonce set in msg
+	nonce, overridden := cfg.StateOverrides.GetNonce(args.GetFrom())
+	if !overridden {
+		nonce = keeper.GetNonce(sdkCtx, args.GetFrom())
+	}
+	args.Nonce = (*hexutil.Uint64)(&nonce)
+
+	msg, err := args.ToMessage(a.backend.RPCGasCap(), cfg.BaseFee)
+	if err != nil {
+		return nil, err
+	}
+
+	txConfig := statedb.NewEmptyTxConfig(common.BytesToHash(sdkCtx.HeaderHash()))
+	if _, err := keeper.ApplyMessageWithConfig(sdkCtx, msg, tracer, false, cfg, txConfig); err != nil {
+		return nil, fmt.Errorf("tracing failed: %w", err)
+	}
+	return tracer.GetResult()
+}
+
+// newTracer assembles the structured logger or the JavaScript tracer from the given config and
+// stops it once the configured timeout expires. The returned cancel func must be called when tracing is done.
+func newTracer(ctx context.Context, txctx *tracers.Context, config *tracers.TraceConfig) (tracers.Tracer, context.CancelFunc, error) {
+	var (
+		tracer  tracers.Tracer
+		err     error
+		timeout = defaultTraceTimeout
+	)
+
 	// Default tracer is the struct logger
 	tracer = logger.NewStructLogger(config.Config)
 	if config.Tracer != nil {
 		tracer, err = tracers.New(*config.Tracer, txctx, config.TracerConfig)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 	}
 	// Define a meaningful timeout of a single transaction trace
 	if config.Timeout != nil {
 		if timeout, err = time.ParseDuration(*config.Timeout); err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 	}
 
@@ -169,34 +274,35 @@ func (a *API) TraceTransaction(ctx context.Context, hash common.Hash, config *tr
 			tracer.Stop(errors.New("execution timeout"))
 		}
 	}()
-	defer cancel()
-
-	sdkCtx, _, err := a.backend.GetEVMContext().GetSdkContextWithHeader(&parentBlock.Block.Header)
-	if err != nil {
-		return nil, err
-	}
+	return tracer, cancel, nil
+}
 
-	keeper := a.backend.GetEVMKeeper()
+// TraceBlockConfig is the config for the block tracing APIs. Besides the
+// fields of TraceConfig it accepts the geth style tracerConfig object.
+type TraceBlockConfig struct {
+	*evmtypes.TraceConfig
+	TracerConfig json.RawMessage `json:"tracerConfig"`
+}
 
-	cfg, err := keeper.EVMConfig(sdkCtx)
-	if err != nil {
-		return nil, err
+// traceConfig returns the TraceConfig to be sent to the evm query, carrying
+// the tracerConfig object as its json encoded tracer config.
+func (c *TraceBlockConfig) traceConfig() *evmtypes.TraceConfig {
+	if c == nil {
+		return nil
 	}
-	signer := ethtypes.MakeSigner(cfg.ChainConfig, big.NewInt(parentBlock.Block.Height))
-
-	msg, err := ethMsg.AsMessage(signer, cfg.BaseFee)
-	if err != nil {
-		return nil, err
+	config := c.TraceConfig
+	if config == nil {
+		config = &evmtypes.TraceConfig{}
 	}
-	if _, err := keeper.ApplyMessage(sdkCtx, msg, tracer, false); err != nil {
-		return nil, fmt.Errorf("tracing failed: %w", err)
+	if len(c.TracerConfig) > 0 {
+		config.TracerJsonConfig = string(c.TracerConfig)
 	}
-	return tracer.GetResult()
+	return config
 }
 
 // TraceBlockByNumber returns the structured logs created during the execution of
 // EVM and returns them as a JSON object.
-func (a *API) TraceBlockByNumber(height rpctypes.BlockNumber, config *evmtypes.TraceConfig) ([]*tracers.TxTraceResult, error) {
+func (a *API) TraceBlockByNumber(height rpctypes.BlockNumber, config *TraceBlockConfig) ([]*tracers.TxTraceResult, error) {
 	a.logger.Debug("debug_traceBlockByNumber", "height", height)
 	if height == 0 {
 		return nil, errors.New("genesis is not traceable")
@@ -208,12 +314,12 @@ func (a *API) TraceBlockByNumber(height rpctypes.BlockNumber, config *evmtypes.T
 		return nil, err
 	}
 
-	return a.traceBlock(height, config, resBlock)
+	return a.traceBlock(height, config.traceConfig(), resBlock)
 }
 
 // TraceBlockByHash returns the structured logs created during the execution of
 // EVM and returns them as a JSON object.
-func (a *API) TraceBlockByHash(hash common.Hash, config *evmtypes.TraceConfig) ([]*tracers.TxTraceResult, error) {
+func (a *API) TraceBlockByHash(hash common.Hash, config *TraceBlockConfig) ([]*tracers.TxTraceResult, error) {
 	a.logger.Debug("debug_traceBlockByHash", "hash", hash)
 	// Get Tendermint Block
 	resBlock, err := a.backend.GetTendermintBlockByHash(hash)
@@ -227,7 +333,7 @@ func (a *API) TraceBlockByHash(hash common.Hash, config *evmtypes.TraceConfig) (
 		return nil, errors.New("block not found")
 	}
 
-	return a.traceBlock(rpctypes.BlockNumber(resBlock.Block.Height), config, resBlock)
+	return a.traceBlock(rpctypes.BlockNumber(resBlock.Block.Height), config.traceConfig(), resBlock)
 }
 
 // traceBlock configures a new tracer according to the provided configuration, and
@@ -499,6 +605,131 @@ func (a *API) GetBlockRlp(number uint64) (hexutil.Bytes, error) {
 	return rlp.EncodeToBytes(block)
 }
 
+// GetRawBlock retrieves the RLP encoded for of a single block identified by number or hash,
+// made of its header, one transaction per tendermint tx as listed by GetRawReceipts and an empty uncle list.
+func (a *API) GetRawBlock(blockNrOrHash rpctypes.BlockNumberOrHash) (hexutil.Bytes, error) {
+	resBlock, err := a.backend.GetTendermintBlockByNumberOrHash(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+	if resBlock == nil || resBlock.Block == nil {
+		return nil, errors.New("block not found")
+	}
+
+	header, err := a.backend.HeaderByNumber(rpctypes.BlockNumber(resBlock.Block.Height))
+	if err != nil {
+		return nil, err
+	}
+
+	txDecoder := a.clientCtx.TxConfig.TxDecoder()
+	txs := make(ethtypes.Transactions, 0, len(resBlock.Block.Txs))
+	for _, tmTx := range resBlock.Block.Txs {
+		tx, err := rawBlockTransaction(txDecoder, tmTx)
+		if err != nil {
+			return nil, err
+		}
+		txs = append(txs, tx)
+	}
+
+	return encodeRawBlock(header.ToEthHeader(), txs)
+}
+
+// encodeRawBlock RLP encodes the block of the given header and transactions, with an empty uncle list
+func encodeRawBlock(header *ethtypes.Header, txs ethtypes.Transactions) ([]byte, error) {
+	return rlp.EncodeToBytes([]interface{}{header, txs, []*ethtypes.Header{}})
+}
+
+// rawBlockTransaction returns the ethereum transaction of a tendermint tx. A cosmos tx is represented by the legacy
+// transaction built from the fields it is listed with in blocks and receipts.
+func rawBlockTransaction(txDecoder sdk.TxDecoder, tmTx tmtypes.Tx) (*ethtypes.Transaction, error) {
+	tx, err := txDecoder(tmTx)
+	if err != nil {
+		return nil, err
+	}
+	if len(tx.GetMsgs()) == 0 {
+		return nil, fmt.Errorf("empty msg")
+	}
+	if ethMsg, ok := tx.GetMsgs()[0].(*evmtypes.MsgEthereumTx); ok {
+		return ethMsg.AsTransaction(), nil
+	}
+
+	rpcTx, err := rpctypes.TmTxToEthTx(txDecoder, tmTx, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return ethtypes.NewTx(&ethtypes.LegacyTx{
+		Nonce:    uint64(rpcTx.Nonce),
+		GasPrice: rpcTx.GasPrice.ToInt(),
+		Gas:      uint64(rpcTx.Gas),
+		To:       rpcTx.To,
+		Value:    rpcTx.Value.ToInt(),
+		Data:     rpcTx.Input,
+		V:        rpcTx.V.ToInt(),
+		R:        rpcTx.R.ToInt(),
+		S:        rpcTx.S.ToInt(),
+	}), nil
+}
+
+// GetRawReceipts retrieves the binary-encoded receipts of a single block identified by number or hash.
+func (a *API) GetRawReceipts(blockNrOrHash rpctypes.BlockNumberOrHash) ([]hexutil.Bytes, error) {
+	resBlock, err := a.backend.GetTendermintBlockByNumberOrHash(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+	if resBlock == nil || resBlock.Block == nil {
+		return nil, errors.New("block not found")
+	}
+
+	receipts, err := a.backend.GetBlockReceipts(resBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	return encodeRawReceipts(receipts)
+}
+
+// encodeRawReceipts returns the consensus encoding of the given receipts, typed receipts are prefixed with their type
+func encodeRawReceipts(receipts []*rpctypes.TransactionReceipt) ([]hexutil.Bytes, error) {
+	result := make([]hexutil.Bytes, len(receipts))
+	for i, receipt := range receipts {
+		ethReceipt := &ethtypes.Receipt{
+			Type:              uint8(receipt.Type),
+			Status:            uint64(receipt.Status),
+			CumulativeGasUsed: uint64(receipt.CumulativeGasUsed),
+			Bloom:             receipt.LogsBloom,
+			Logs:              receipt.Logs,
+		}
+		var err error
+		if result[i], err = ethReceipt.MarshalBinary(); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// GetRawTransaction returns the bytes of the ethereum transaction for the given hash.
+func (a *API) GetRawTransaction(hash common.Hash) (hexutil.Bytes, error) {
+	resTx, err := evmtypes.GetTmTxByHash(hash)
+	if err != nil {
+		a.logger.Debug("debug_getRawTransaction", "tx not found", "hash", hash)
+		return nil, nil
+	}
+
+	tx, err := a.clientCtx.TxConfig.TxDecoder()(resTx.Tx)
+	if err != nil {
+		return nil, err
+	}
+	if len(tx.GetMsgs()) == 0 {
+		return nil, fmt.Errorf("empty msg")
+	}
+
+	ethMsg, ok := tx.GetMsgs()[0].(*evmtypes.MsgEthereumTx)
+	if !ok {
+		return nil, fmt.Errorf("invalid transaction type %T", tx.GetMsgs()[0])
+	}
+	return ethMsg.AsTransaction().MarshalBinary()
+}
+
 // PrintBlock retrieves a block and returns its pretty printed form.
 func (a *API) PrintBlock(number uint64) (string, error) {
 	block, err := a.backend.GetBlockByNumber(rpctypes.BlockNumber(number), true)
@@ -0,0 +1,165 @@
+package debug
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+
+	tmtypes "github.com/cometbft/cometbft/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	rpctypes "github.com/stratosnet/stratos-chain/rpc/types"
+	stratostestutil "github.com/stratosnet/stratos-chain/testutil"
+	stratos "github.com/stratosnet/stratos-chain/types"
+	evmtypes "github.com/stratosnet/stratos-chain/x/evm/types"
+)
+
+var testChainID = big.NewInt(2048)
+
+func encodeTx(t *testing.T, txConfig client.TxConfig, tx sdk.Tx) tmtypes.Tx {
+	bz, err := txConfig.TxEncoder()(tx)
+	require.NoError(t, err)
+	return bz
+}
+
+// newEthereumTmTx returns a signed dynamic fee transaction and the cosmos tx wrapping it
+func newEthereumTmTx(t *testing.T, txConfig client.TxConfig) (*ethtypes.Transaction, tmtypes.Tx) {
+	privKey, err := ethcrypto.GenerateKey()
+	require.NoError(t, err)
+	to := common.HexToAddress("0x00000000000000000000000000000000000000c0")
+	ethTx, err := ethtypes.SignNewTx(privKey, ethtypes.LatestSignerForChainID(testChainID), &ethtypes.DynamicFeeTx{
+		ChainID:   testChainID,
+		Nonce:     3,
+		GasTipCap: big.NewInt(1),
+		GasFeeCap: big.NewInt(1e9),
+		Gas:       21000,
+		To:        &to,
+		Value:     big.NewInt(100),
+	})
+	require.NoError(t, err)
+
+	msg := &evmtypes.MsgEthereumTx{}
+	require.NoError(t, msg.FromEthereumTx(ethTx))
+	tx, err := msg.BuildTx(txConfig.NewTxBuilder(), stratos.Wei)
+	require.NoError(t, err)
+	return ethTx, encodeTx(t, txConfig, tx)
+}
+
+// newBankSendTmTx returns an unsigned cosmos tx sending wei
+func newBankSendTmTx(t *testing.T, txConfig client.TxConfig, from, to sdk.AccAddress, amount int64) tmtypes.Tx {
+	builder := txConfig.NewTxBuilder()
+	require.NoError(t, builder.SetMsgs(banktypes.NewMsgSend(from, to, sdk.NewCoins(sdk.NewInt64Coin(stratos.Wei, amount)))))
+	builder.SetGasLimit(200000)
+	builder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin(stratos.Wei, 200000*10)))
+	return encodeTx(t, txConfig, builder.GetTx())
+}
+
+func TestEncodeRawBlock(t *testing.T) {
+	txConfig := stratostestutil.MakeTestEncodingConfig().TxConfig
+	txDecoder := txConfig.TxDecoder()
+
+	ethTx, ethTmTx := newEthereumTmTx(t, txConfig)
+	from, to := sdk.AccAddress("from________________"), sdk.AccAddress("to__________________")
+	bankTmTx := newBankSendTmTx(t, txConfig, from, to, 500)
+
+	txs := make(ethtypes.Transactions, 0, 2)
+	for _, tmTx := range []tmtypes.Tx{ethTmTx, bankTmTx} {
+		tx, err := rawBlockTransaction(txDecoder, tmTx)
+		require.NoError(t, err)
+		txs = append(txs, tx)
+	}
+
+	header := &rpctypes.Header{
+		Hash:       common.HexToHash("0x01"),
+		ParentHash: common.HexToHash("0x02"),
+		Coinbase:   common.HexToAddress("0x03"),
+		Difficulty: big.NewInt(1),
+		Number:     big.NewInt(42),
+		GasLimit:   10_000_000,
+		GasUsed:    21000,
+		Time:       1_700_000_000,
+		Extra:      []byte{},
+		Size:       1234,
+	}
+	raw, err := encodeRawBlock(header.ToEthHeader(), txs)
+	require.NoError(t, err)
+
+	// the raw block decodes as an ethereum block of the header fields and the transactions, with no uncles
+	var block ethtypes.Block
+	require.NoError(t, rlp.DecodeBytes(raw, &block))
+	require.Equal(t, header.ParentHash, block.ParentHash())
+	require.Equal(t, header.Coinbase, block.Coinbase())
+	require.Equal(t, header.Number, block.Number())
+	require.Equal(t, header.GasLimit, block.GasLimit())
+	require.Equal(t, header.Time, block.Time())
+	require.Empty(t, block.Uncles())
+	require.Len(t, block.Transactions(), 2)
+
+	// the ethereum transaction is kept as signed
+	require.Equal(t, ethTx.Hash(), block.Transactions()[0].Hash())
+	require.Equal(t, uint8(ethtypes.DynamicFeeTxType), block.Transactions()[0].Type())
+
+	// the cosmos transaction is the legacy transaction it is listed with
+	bankTx := block.Transactions()[1]
+	require.Equal(t, uint8(ethtypes.LegacyTxType), bankTx.Type())
+	require.Equal(t, common.BytesToAddress(to), *bankTx.To())
+	require.Equal(t, big.NewInt(500), bankTx.Value())
+	require.Equal(t, uint64(200000), bankTx.Gas())
+	require.Equal(t, big.NewInt(10), bankTx.GasPrice())
+}
+
+func TestEncodeRawReceipts(t *testing.T) {
+	log := &ethtypes.Log{
+		Address: common.HexToAddress("0x00000000000000000000000000000000000000c0"),
+		Topics:  []common.Hash{common.HexToHash("0x0a"), common.HexToHash("0x0b")},
+		Data:    []byte{1, 2, 3},
+	}
+	receipts := []*rpctypes.TransactionReceipt{
+		{
+			Type:              ethtypes.LegacyTxType,
+			Status:            hexutil.Uint64(ethtypes.ReceiptStatusSuccessful),
+			CumulativeGasUsed: 21000,
+			LogsBloom:         ethtypes.BytesToBloom(ethtypes.LogsBloom([]*ethtypes.Log{log})),
+			Logs:              []*ethtypes.Log{log},
+		},
+		{
+			Type:              ethtypes.DynamicFeeTxType,
+			Status:            hexutil.Uint64(ethtypes.ReceiptStatusFailed),
+			CumulativeGasUsed: 50000,
+			Logs:              []*ethtypes.Log{},
+		},
+	}
+
+	raw, err := encodeRawReceipts(receipts)
+	require.NoError(t, err)
+	require.Len(t, raw, 2)
+
+	// a typed receipt is prefixed with its type, a legacy receipt is a plain RLP list
+	require.Equal(t, byte(ethtypes.DynamicFeeTxType), raw[1][0])
+	require.GreaterOrEqual(t, raw[0][0], byte(0xc0))
+
+	for i, receipt := range receipts {
+		var decoded ethtypes.Receipt
+		require.NoError(t, decoded.UnmarshalBinary(raw[i]))
+		require.Equal(t, uint8(receipt.Type), decoded.Type)
+		require.Equal(t, uint64(receipt.Status), decoded.Status)
+		require.Equal(t, uint64(receipt.CumulativeGasUsed), decoded.CumulativeGasUsed)
+		require.Equal(t, receipt.LogsBloom, decoded.Bloom)
+		require.Len(t, decoded.Logs, len(receipt.Logs))
+		for j, decodedLog := range decoded.Logs {
+			require.Equal(t, receipt.Logs[j].Address, decodedLog.Address)
+			require.Equal(t, receipt.Logs[j].Topics, decodedLog.Topics)
+			require.Equal(t, receipt.Logs[j].Data, decodedLog.Data)
+		}
+	}
+}
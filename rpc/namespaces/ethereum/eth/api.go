@@ -12,7 +12,6 @@ import (
 
 	"github.com/spf13/viper"
 
-	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cometbft/cometbft/libs/log"
 	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
 
@@ -605,15 +604,20 @@ func (e *PublicAPI) Resend(ctx context.Context, args evmtypes.TransactionArgs, g
 	return common.Hash{}, fmt.Errorf("transaction %#x not found", matchTx.Hash())
 }
 
-// Call performs a raw contract call.
-func (e *PublicAPI) Call(args evmtypes.TransactionArgs, blockNrOrHash rpctypes.BlockNumberOrHash, _ *rpctypes.StateOverride) (hexutil.Bytes, error) {
+// Call performs a raw contract call, optionally on top of the given state and block overrides.
+func (e *PublicAPI) Call(
+	args evmtypes.TransactionArgs,
+	blockNrOrHash rpctypes.BlockNumberOrHash,
+	overrides *rpctypes.StateOverride,
+	blockOverrides *rpctypes.BlockOverrides,
+) (hexutil.Bytes, error) {
 	e.logger.Debug("eth_call", "args", args.String(), "block number or hash", blockNrOrHash)
 
 	blockNum, err := e.getBlockNumber(blockNrOrHash)
 	if err != nil {
 		return nil, err
 	}
-	data, err := e.doCall(args, blockNum)
+	data, err := e.doCall(args, blockNum, overrides, blockOverrides)
 	if err != nil {
 		return []byte{}, err
 	}
@@ -625,6 +629,7 @@ func (e *PublicAPI) Call(args evmtypes.TransactionArgs, blockNrOrHash rpctypes.B
 // estimated gas used on the operation or an error if fails.
 func (e *PublicAPI) doCall(
 	args evmtypes.TransactionArgs, blockNr rpctypes.BlockNumber,
+	overrides *rpctypes.StateOverride, blockOverrides *rpctypes.BlockOverrides,
 ) (*evmtypes.MsgEthereumTxResponse, error) {
 	bz, err := json.Marshal(&args)
 	if err != nil {
@@ -635,6 +640,16 @@ func (e *PublicAPI) doCall(
 		Args:   bz,
 		GasCap: e.backend.RPCGasCap(),
 	}
+	if overrides != nil {
+		if req.Overrides, err = json.Marshal(overrides); err != nil {
+			return nil, err
+		}
+	}
+	if blockOverrides != nil {
+		if req.BlockOverrides, err = json.Marshal(blockOverrides); err != nil {
+			return nil, err
+		}
+	}
 
 	resBlock, err := e.backend.GetTendermintBlockByNumber(blockNr)
 	if err != nil {
@@ -686,9 +701,24 @@ func (e *PublicAPI) doCall(
 }
 
 // EstimateGas returns an estimate of gas usage for the given smart contract call.
-func (e *PublicAPI) EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rpctypes.BlockNumber) (hexutil.Uint64, error) {
+func (e *PublicAPI) EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rpctypes.BlockNumber, overrides *rpctypes.StateOverride) (hexutil.Uint64, error) {
 	e.logger.Debug("eth_estimateGas")
-	return e.backend.EstimateGas(args, blockNrOptional)
+	return e.backend.EstimateGas(args, blockNrOptional, overrides)
+}
+
+// CreateAccessList creates an EIP-2930 type AccessList for the given transaction.
+// BlockNrOrHash can be specified to create the accessList on top of a certain state.
+func (e *PublicAPI) CreateAccessList(args evmtypes.TransactionArgs, blockNrOrHash *rpctypes.BlockNumberOrHash) (*rpctypes.AccessListResult, error) {
+	e.logger.Debug("eth_createAccessList", "args", args.String(), "block number or hash", blockNrOrHash)
+
+	blockNum := rpctypes.EthPendingBlockNumber
+	if blockNrOrHash != nil {
+		var err error
+		if blockNum, err = e.getBlockNumber(*blockNrOrHash); err != nil {
+			return nil, err
+		}
+	}
+	return e.backend.CreateAccessList(args, blockNum)
 }
 
 // GetBlockByHash returns the block identified by hash.
@@ -770,113 +800,18 @@ func (e *PublicAPI) GetTransactionByBlockNumberAndIndex(blockNum rpctypes.BlockN
 // GetTransactionReceipt returns the transaction receipt identified by hash.
 func (e *PublicAPI) GetTransactionReceipt(hash common.Hash) (*rpctypes.TransactionReceipt, error) {
 	e.logger.Debug("eth_getTransactionReceipt", "hash", hash)
-	res, err := evmtypes.GetTmTxByHash(hash)
-	if err != nil {
-		return nil, nil
-	}
-
-	block := e.backend.GetBlockStore().LoadBlock(res.Height)
-	if block == nil {
-		e.logger.Debug("eth_getTransactionReceipt", "hash", hash, "block not found")
-		return nil, nil
-	}
-
-	blockResults, err := tmrpccore.BlockResults(nil, &block.Height)
-	if err != nil {
-		e.logger.Debug("eth_getTransactionReceipt", "hash", hash, "block not found")
-		return nil, nil
-	}
+	return e.backend.GetTransactionReceipt(hash)
+}
 
-	blockHash := common.BytesToHash(block.Hash())
-	blockHeight := uint64(res.Height)
-	txIndex := uint64(res.Index)
+// GetBlockReceipts returns the receipts of all the transactions of the block identified by number or hash.
+func (e *PublicAPI) GetBlockReceipts(blockNrOrHash rpctypes.BlockNumberOrHash) ([]*rpctypes.TransactionReceipt, error) {
+	e.logger.Debug("eth_getBlockReceipts", "block number or hash", blockNrOrHash)
 
-	rpcTx, err := rpctypes.TmTxToEthTx(
-		e.clientCtx.TxConfig.TxDecoder(),
-		res.Tx,
-		&blockHash,
-		&blockHeight,
-		&txIndex,
-	)
-	if err != nil {
+	resBlock, err := e.backend.GetTendermintBlockByNumberOrHash(blockNrOrHash)
+	if err != nil || resBlock == nil {
 		return nil, err
 	}
-
-	cumulativeGasUsed := uint64(res.TxResult.GasUsed)
-	if *rpcTx.TransactionIndex != 0 {
-		cumulativeGasUsed += rpctypes.GetBlockCumulativeGas(blockResults, int(*rpcTx.TransactionIndex))
-	}
-
-	_, evtEthTx := rpctypes.FindEthereumTxEvent(res.TxResult.Events, hash.Hex())
-
-	var (
-		contractAddress *common.Address
-		bloom           = ethtypes.BytesToBloom(make([]byte, 6))
-		logs            = make([]*ethtypes.Log, 0)
-	)
-	// Set status codes based on tx result
-	receiptStatus := ethtypes.ReceiptStatusSuccessful
-	if res.TxResult.GetCode() != abci.CodeTypeOK {
-		receiptStatus = ethtypes.ReceiptStatusFailed
-	} else {
-		// Get the transaction result from the log
-		if evtEthTx.EthTxFailed != "" {
-			receiptStatus = ethtypes.ReceiptStatusFailed
-		}
-
-		if receiptStatus == ethtypes.ReceiptStatusSuccessful {
-			// parse tx logs from events
-			logs, err = backend.TxLogsFromEvents(res.TxResult.Events, 0)
-			if err != nil {
-				e.logger.Debug("logs not found", "hash", hash, "error", err.Error())
-			}
-			if logs == nil {
-				logs = make([]*ethtypes.Log, 0)
-			}
-			if rpcTx.To == nil {
-				// TODO: Rewrite on more optimal way in order to get a contract address
-				tx, err := e.clientCtx.TxConfig.TxDecoder()(res.Tx)
-				if err != nil {
-					e.logger.Debug("decoding failed", "error", err.Error())
-					return nil, fmt.Errorf("failed to decode tx: %w", err)
-				}
-
-				// the `msgIndex` is inferred from tx events, should be within the bound.
-				msg := tx.GetMsgs()[0]
-				ethMsg, ok := msg.(*evmtypes.MsgEthereumTx)
-				if !ok {
-					e.logger.Debug(fmt.Sprintf("invalid tx type: %T", msg))
-					return nil, fmt.Errorf("invalid tx type: %T", msg)
-				}
-
-				txData, err := evmtypes.UnpackTxData(ethMsg.Data)
-				if err != nil {
-					e.logger.Error("failed to unpack tx data", "error", err.Error())
-					return nil, err
-				}
-				contractAddress = new(common.Address)
-				*contractAddress = crypto.CreateAddress(rpcTx.From, txData.GetNonce())
-			}
-			bloom = ethtypes.BytesToBloom(ethtypes.LogsBloom(logs))
-		}
-	}
-
-	receipt := &rpctypes.TransactionReceipt{
-		Status:            hexutil.Uint64(receiptStatus),
-		CumulativeGasUsed: hexutil.Uint64(cumulativeGasUsed),
-		LogsBloom:         bloom,
-		Logs:              logs,
-		TransactionHash:   rpcTx.Hash,
-		ContractAddress:   contractAddress,
-		GasUsed:           hexutil.Uint64(res.TxResult.GasUsed),
-		BlockHash:         *rpcTx.BlockHash,
-		BlockNumber:       *rpcTx.BlockNumber,
-		TransactionIndex:  *rpcTx.TransactionIndex,
-		From:              rpcTx.From,
-		To:                rpcTx.To,
-	}
-
-	return receipt, nil
+	return e.backend.GetBlockReceipts(resBlock)
 }
 
 // GetPendingTransactions returns the transactions that are in the transaction pool
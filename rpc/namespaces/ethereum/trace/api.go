@@ -0,0 +1,432 @@
+package trace
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/cometbft/cometbft/libs/log"
+	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/stratosnet/stratos-chain/rpc/backend"
+	rpctypes "github.com/stratosnet/stratos-chain/rpc/types"
+	evmtypes "github.com/stratosnet/stratos-chain/x/evm/types"
+)
+
+const (
+	// flatCallTracer is the native tracer producing the parity trace format
+	flatCallTracer = "flatCallTracer"
+	// flatCallTracerConfig makes the tracer report errors the way parity does
+	flatCallTracerConfig = `{"convertParityErrors":true}`
+
+	// TraceTypeTrace is the only trace type supported by trace_replayBlockTransactions
+	TraceTypeTrace = "trace"
+)
+
+// API offers the parity style trace methods, built on top of the native flat call tracer.
+type API struct {
+	logger    log.Logger
+	clientCtx client.Context
+	backend   backend.BackendI
+}
+
+// NewAPI creates a new API definition for the parity style trace methods.
+func NewAPI(logger log.Logger, clientCtx client.Context, backend backend.BackendI) *API {
+	return &API{
+		logger:    logger.With("module", "trace"),
+		clientCtx: clientCtx,
+		backend:   backend,
+	}
+}
+
+// FilterArgs are the arguments of trace_filter
+type FilterArgs struct {
+	FromBlock   *rpctypes.BlockNumber `json:"fromBlock"`
+	ToBlock     *rpctypes.BlockNumber `json:"toBlock"`
+	FromAddress []common.Address      `json:"fromAddress"`
+	ToAddress   []common.Address      `json:"toAddress"`
+	After       *uint64               `json:"after"`
+	Count       *uint64               `json:"count"`
+}
+
+// ReplayResult is the result of trace_replayBlockTransactions for a single transaction
+type ReplayResult struct {
+	Output          hexutil.Bytes     `json:"output"`
+	StateDiff       interface{}       `json:"stateDiff"`
+	Trace           []json.RawMessage `json:"trace"`
+	VMTrace         interface{}       `json:"vmTrace"`
+	TransactionHash *common.Hash      `json:"transactionHash"`
+}
+
+// flatTrace holds the fields of a flat call trace needed to filter and replay it
+type flatTrace struct {
+	Action struct {
+		From          *common.Address `json:"from"`
+		To            *common.Address `json:"to"`
+		Address       *common.Address `json:"address"`
+		RefundAddress *common.Address `json:"refundAddress"`
+	} `json:"action"`
+	Result *struct {
+		Address *common.Address `json:"address"`
+		Output  hexutil.Bytes   `json:"output"`
+	} `json:"result"`
+	TransactionHash *common.Hash `json:"transactionHash"`
+}
+
+// errorTrace takes the place of the traces of a transaction that could not be traced
+type errorTrace struct {
+	BlockHash           common.Hash `json:"blockHash"`
+	BlockNumber         int64       `json:"blockNumber"`
+	TransactionHash     common.Hash `json:"transactionHash"`
+	TransactionPosition int         `json:"transactionPosition"`
+	Error               string      `json:"error"`
+}
+
+// from returns the sender of the call, or the destructed contract of a suicide
+func (t *flatTrace) from() *common.Address {
+	if t.Action.From != nil {
+		return t.Action.From
+	}
+	return t.Action.Address
+}
+
+// to returns the recipient of the call, the created contract or the refund address of a suicide
+func (t *flatTrace) to() *common.Address {
+	switch {
+	case t.Action.To != nil:
+		return t.Action.To
+	case t.Result != nil && t.Result.Address != nil:
+		return t.Result.Address
+	default:
+		return t.Action.RefundAddress
+	}
+}
+
+// Block returns the flat call traces of all the transactions of the given block.
+func (api *API) Block(blockNr rpctypes.BlockNumber) ([]json.RawMessage, error) {
+	api.logger.Debug("trace_block", "number", blockNr)
+
+	resBlock, err := api.backend.GetTendermintBlockByNumber(blockNr)
+	if err != nil {
+		return nil, err
+	}
+	if resBlock == nil || resBlock.Block == nil {
+		return nil, nil
+	}
+
+	txsTraces, err := api.traceBlock(resBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	traces := make([]json.RawMessage, 0, len(txsTraces))
+	for _, txTraces := range txsTraces {
+		traces = append(traces, txTraces...)
+	}
+	return traces, nil
+}
+
+// Transaction returns the flat call traces of the given transaction.
+func (api *API) Transaction(hash common.Hash) ([]json.RawMessage, error) {
+	api.logger.Debug("trace_transaction", "hash", hash)
+
+	resTx, err := evmtypes.GetTmTxByHash(hash)
+	if err != nil {
+		api.logger.Debug("trace_transaction", "tx not found", "hash", hash)
+		return nil, nil
+	}
+
+	resBlock, err := api.backend.GetTendermintBlockByNumber(rpctypes.BlockNumber(resTx.Height))
+	if err != nil {
+		return nil, err
+	}
+	if resBlock == nil || resBlock.Block == nil {
+		return nil, errors.New("block not found")
+	}
+
+	var (
+		ethMsg       *evmtypes.MsgEthereumTx
+		predecessors []*evmtypes.MsgEthereumTx
+	)
+	for _, msg := range api.ethereumMsgs(resBlock) {
+		if msg.AsTransaction().Hash() == hash {
+			ethMsg = msg
+			break
+		}
+		predecessors = append(predecessors, msg)
+	}
+	if ethMsg == nil {
+		return nil, fmt.Errorf("transaction %s is not an ethereum transaction", hash.Hex())
+	}
+
+	traceTxRequest := &evmtypes.QueryTraceTxRequest{
+		Msg:          ethMsg,
+		TraceConfig:  newTraceConfig(),
+		Predecessors: predecessors,
+		BlockNumber:  resBlock.Block.Height,
+		BlockTime:    resBlock.Block.Time,
+		BlockHash:    common.Bytes2Hex(resBlock.BlockID.Hash),
+	}
+
+	sdkCtx, _, err := api.backend.GetEVMContext().GetSdkContextWithHeader(&resBlock.Block.Header)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := api.backend.GetEVMKeeper().TraceTx(sdk.WrapSDKContext(sdkCtx), traceTxRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	var traces []json.RawMessage
+	if err := json.Unmarshal(res.Data, &traces); err != nil {
+		return nil, err
+	}
+	return traces, nil
+}
+
+// ReplayBlockTransactions replays all the transactions of the given block and returns their traces.
+// Only the "trace" trace type is supported.
+func (api *API) ReplayBlockTransactions(blockNr rpctypes.BlockNumber, traceTypes []string) ([]*ReplayResult, error) {
+	api.logger.Debug("trace_replayBlockTransactions", "number", blockNr, "trace types", traceTypes)
+
+	withTrace := false
+	for _, traceType := range traceTypes {
+		if traceType != TraceTypeTrace {
+			return nil, fmt.Errorf("trace type %s is not supported", traceType)
+		}
+		withTrace = true
+	}
+
+	resBlock, err := api.backend.GetTendermintBlockByNumber(blockNr)
+	if err != nil {
+		return nil, err
+	}
+	if resBlock == nil || resBlock.Block == nil {
+		return nil, nil
+	}
+
+	txsTraces, err := api.traceBlock(resBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*ReplayResult, 0, len(txsTraces))
+	for _, txTraces := range txsTraces {
+		result := &ReplayResult{
+			Output: hexutil.Bytes{},
+			Trace:  []json.RawMessage{},
+		}
+		if len(txTraces) > 0 {
+			var top flatTrace
+			if err := json.Unmarshal(txTraces[0], &top); err != nil {
+				return nil, err
+			}
+			result.TransactionHash = top.TransactionHash
+			if top.Result != nil && top.Result.Output != nil {
+				result.Output = top.Result.Output
+			}
+		}
+		if withTrace {
+			result.Trace = txTraces
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+// Filter returns the flat call traces matching the given from and to addresses over a block range,
+// the range is bounded by the block range cap of the node.
+func (api *API) Filter(args FilterArgs) ([]json.RawMessage, error) {
+	api.logger.Debug("trace_filter", "args", args)
+
+	currentBlock, err := api.backend.BlockNumber()
+	if err != nil {
+		return nil, err
+	}
+	fromBlock := resolveBlockNumber(args.FromBlock, int64(currentBlock))
+	toBlock := resolveBlockNumber(args.ToBlock, int64(currentBlock))
+	if fromBlock > toBlock {
+		return nil, fmt.Errorf("invalid block range: from %d is after to %d", fromBlock, toBlock)
+	}
+	if blockLimit := int64(api.backend.RPCBlockRangeCap()); toBlock-fromBlock > blockLimit {
+		return nil, fmt.Errorf("maximum [from, to] blocks distance: %d", blockLimit)
+	}
+
+	fromAddresses := make(map[common.Address]struct{}, len(args.FromAddress))
+	for _, addr := range args.FromAddress {
+		fromAddresses[addr] = struct{}{}
+	}
+	toAddresses := make(map[common.Address]struct{}, len(args.ToAddress))
+	for _, addr := range args.ToAddress {
+		toAddresses[addr] = struct{}{}
+	}
+
+	var skip, count uint64
+	if args.After != nil {
+		skip = *args.After
+	}
+	traces := make([]json.RawMessage, 0)
+	for height := fromBlock; height <= toBlock; height++ {
+		resBlock, err := api.backend.GetTendermintBlockByNumber(rpctypes.BlockNumber(height))
+		if err != nil {
+			return nil, err
+		}
+		if resBlock == nil || resBlock.Block == nil {
+			continue
+		}
+
+		txsTraces, err := api.traceBlock(resBlock)
+		if err != nil {
+			return nil, err
+		}
+		for _, txTraces := range txsTraces {
+			for _, rawTrace := range txTraces {
+				var trace flatTrace
+				if err := json.Unmarshal(rawTrace, &trace); err != nil {
+					return nil, err
+				}
+				// the error trace of a transaction that could not be traced has no call to match
+				if trace.from() == nil {
+					continue
+				}
+				if !matchAddress(trace.from(), fromAddresses) || !matchAddress(trace.to(), toAddresses) {
+					continue
+				}
+				if skip > 0 {
+					skip--
+					continue
+				}
+				traces = append(traces, rawTrace)
+				count++
+				if args.Count != nil && count >= *args.Count {
+					return traces, nil
+				}
+			}
+		}
+	}
+	return traces, nil
+}
+
+// traceBlock replays all the ethereum transactions of the block with the flat call tracer,
+// it returns the flat call traces of each transaction in block order. A transaction that could not be traced
+// gets a single error trace at its position.
+func (api *API) traceBlock(resBlock *tmrpctypes.ResultBlock) ([][]json.RawMessage, error) {
+	if resBlock.Block.Height == 0 {
+		return nil, errors.New("genesis is not traceable")
+	}
+
+	txsMessages := api.ethereumMsgs(resBlock)
+	if len(txsMessages) == 0 {
+		return [][]json.RawMessage{}, nil
+	}
+
+	traceBlockRequest := &evmtypes.QueryTraceBlockRequest{
+		Txs:         txsMessages,
+		TraceConfig: newTraceConfig(),
+		BlockNumber: resBlock.Block.Height,
+		BlockTime:   resBlock.Block.Time,
+		BlockHash:   common.Bytes2Hex(resBlock.BlockID.Hash),
+	}
+
+	sdkCtx, _, err := api.backend.GetEVMContext().GetSdkContextWithHeader(&resBlock.Block.Header)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := api.backend.GetEVMKeeper().TraceBlock(sdk.WrapSDKContext(sdkCtx), traceBlockRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	var txResults []struct {
+		Result []json.RawMessage `json:"result"`
+		Error  string            `json:"error"`
+	}
+	if err := json.Unmarshal(res.Data, &txResults); err != nil {
+		return nil, err
+	}
+
+	if len(txResults) != len(txsMessages) {
+		return nil, fmt.Errorf("got %d trace results for %d transactions", len(txResults), len(txsMessages))
+	}
+
+	txsTraces := make([][]json.RawMessage, 0, len(txResults))
+	for i, txResult := range txResults {
+		if txResult.Error != "" {
+			api.logger.Debug("failed to trace transaction", "height", resBlock.Block.Height, "error", txResult.Error)
+			rawTrace, err := json.Marshal(errorTrace{
+				BlockHash:           common.BytesToHash(resBlock.BlockID.Hash),
+				BlockNumber:         resBlock.Block.Height,
+				TransactionHash:     txsMessages[i].AsTransaction().Hash(),
+				TransactionPosition: i,
+				Error:               txResult.Error,
+			})
+			if err != nil {
+				return nil, err
+			}
+			txsTraces = append(txsTraces, []json.RawMessage{rawTrace})
+			continue
+		}
+		txsTraces = append(txsTraces, txResult.Result)
+	}
+	return txsTraces, nil
+}
+
+// ethereumMsgs returns the ethereum transactions of the block in block order
+func (api *API) ethereumMsgs(resBlock *tmrpctypes.ResultBlock) []*evmtypes.MsgEthereumTx {
+	txDecoder := api.clientCtx.TxConfig.TxDecoder()
+
+	var msgs []*evmtypes.MsgEthereumTx
+	for _, tmTx := range resBlock.Block.Txs {
+		tx, err := txDecoder(tmTx)
+		if err != nil {
+			api.logger.Debug("failed to decode transaction", "hash", tmTx.Hash(), "error", err.Error())
+			continue
+		}
+		for _, msg := range tx.GetMsgs() {
+			if ethMsg, ok := msg.(*evmtypes.MsgEthereumTx); ok {
+				msgs = append(msgs, ethMsg)
+			}
+		}
+	}
+	return msgs
+}
+
+func newTraceConfig() *evmtypes.TraceConfig {
+	return &evmtypes.TraceConfig{
+		Tracer:           flatCallTracer,
+		TracerJsonConfig: flatCallTracerConfig,
+	}
+}
+
+// resolveBlockNumber converts the block number tags to a height, missing block numbers default to the latest
+func resolveBlockNumber(blockNr *rpctypes.BlockNumber, currentBlock int64) int64 {
+	if blockNr == nil {
+		return currentBlock
+	}
+	switch *blockNr {
+	case rpctypes.EthLatestBlockNumber, rpctypes.EthPendingBlockNumber:
+		return currentBlock
+	case rpctypes.EthEarliestBlockNumber:
+		return 1
+	default:
+		return blockNr.Int64()
+	}
+}
+
+func matchAddress(addr *common.Address, addresses map[common.Address]struct{}) bool {
+	if len(addresses) == 0 {
+		return true
+	}
+	if addr == nil {
+		return false
+	}
+	_, ok := addresses[*addr]
+	return ok
+}
@@ -0,0 +1,106 @@
+package trace
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/cometbft/cometbft/libs/log"
+	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+
+	"github.com/stratosnet/stratos-chain/rpc/backend"
+	rpctypes "github.com/stratosnet/stratos-chain/rpc/types"
+)
+
+// rangeBackend is a chain of empty blocks recording the blocks it is queried for
+type rangeBackend struct {
+	backend.BackendI
+	currentBlock  uint64
+	blockRangeCap int32
+	queried       []int64
+}
+
+func (b *rangeBackend) BlockNumber() (hexutil.Uint64, error) {
+	return hexutil.Uint64(b.currentBlock), nil
+}
+
+func (b *rangeBackend) RPCBlockRangeCap() int32 {
+	return b.blockRangeCap
+}
+
+func (b *rangeBackend) GetTendermintBlockByNumber(blockNum rpctypes.BlockNumber) (*tmrpctypes.ResultBlock, error) {
+	b.queried = append(b.queried, blockNum.Int64())
+	return nil, nil
+}
+
+func blockNumber(height int64) *rpctypes.BlockNumber {
+	blockNr := rpctypes.BlockNumber(height)
+	return &blockNr
+}
+
+func TestFilterBlockRange(t *testing.T) {
+	rb := &rangeBackend{currentBlock: 100, blockRangeCap: 10}
+	api := NewAPI(log.NewNopLogger(), client.Context{}, rb)
+
+	// the range may span the block range cap
+	traces, err := api.Filter(FilterArgs{FromBlock: blockNumber(50), ToBlock: blockNumber(60)})
+	require.NoError(t, err)
+	require.Empty(t, traces)
+	require.Len(t, rb.queried, 11)
+	require.Equal(t, int64(50), rb.queried[0])
+	require.Equal(t, int64(60), rb.queried[10])
+
+	// a wider range is rejected before any block is traced
+	rb.queried = nil
+	_, err = api.Filter(FilterArgs{FromBlock: blockNumber(50), ToBlock: blockNumber(61)})
+	require.ErrorContains(t, err, "maximum [from, to] blocks distance: 10")
+	_, err = api.Filter(FilterArgs{FromBlock: blockNumber(int64(rpctypes.EthEarliestBlockNumber))})
+	require.Error(t, err)
+	require.Empty(t, rb.queried)
+
+	// the range must be ordered
+	_, err = api.Filter(FilterArgs{FromBlock: blockNumber(60), ToBlock: blockNumber(50)})
+	require.ErrorContains(t, err, "invalid block range")
+
+	// missing bounds default to the latest block
+	_, err = api.Filter(FilterArgs{FromBlock: blockNumber(95)})
+	require.NoError(t, err)
+	require.Equal(t, []int64{95, 96, 97, 98, 99, 100}, rb.queried)
+	rb.queried = nil
+	_, err = api.Filter(FilterArgs{FromBlock: blockNumber(int64(rpctypes.EthLatestBlockNumber))})
+	require.NoError(t, err)
+	require.Equal(t, []int64{100}, rb.queried)
+}
+
+func TestFlatTraceAddresses(t *testing.T) {
+	addr := func(i byte) common.Address { return common.BytesToAddress([]byte{i}) }
+	decode := func(rawTrace string) (trace flatTrace) {
+		require.NoError(t, json.Unmarshal([]byte(rawTrace), &trace))
+		return trace
+	}
+	call := decode(fmt.Sprintf(`{"action":{"from":"%s","to":"%s"},"type":"call"}`, addr(1), addr(2)))
+	create := decode(fmt.Sprintf(`{"action":{"from":"%s"},"result":{"address":"%s"},"type":"create"}`, addr(1), addr(3)))
+	suicide := decode(fmt.Sprintf(`{"action":{"address":"%s","refundAddress":"%s"},"type":"suicide"}`, addr(3), addr(4)))
+
+	// the created contract is the recipient of a create, and the refund address of a suicide
+	require.Equal(t, addr(1), *call.from())
+	require.Equal(t, addr(2), *call.to())
+	require.Equal(t, addr(1), *create.from())
+	require.Equal(t, addr(3), *create.to())
+	require.Equal(t, addr(3), *suicide.from())
+	require.Equal(t, addr(4), *suicide.to())
+
+	// an empty address filter matches every address
+	filter := map[common.Address]struct{}{addr(2): {}}
+	require.True(t, matchAddress(call.to(), nil))
+	require.True(t, matchAddress(call.to(), filter))
+	require.False(t, matchAddress(create.to(), filter))
+	require.False(t, matchAddress(nil, filter))
+}
@@ -1,6 +1,7 @@
 package txpool
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/cometbft/cometbft/libs/log"
@@ -8,9 +9,12 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
 
 	"github.com/stratosnet/stratos-chain/rpc/backend"
 	"github.com/stratosnet/stratos-chain/rpc/types"
+	"github.com/stratosnet/stratos-chain/x/evm/pool"
 )
 
 // PublicAPI offers and API for the transaction pool. It only operates on data that is non-confidential.
@@ -43,16 +47,130 @@ func (api *PublicAPI) getPendingList() map[common.Address]map[string]*types.Tran
 	return pending
 }
 
+// addPoolTxs adds the given transactions of the EVM tx pool to a nonce map.
+func addPoolTxs(dst map[string]*types.Transaction, txs ethtypes.Transactions) {
+	for _, tx := range txs {
+		rpcTx, err := types.NewRPCTransaction(tx, nil, nil, nil)
+		if err != nil {
+			continue
+		}
+		dst[fmt.Sprintf("%d", tx.Nonce())] = rpcTx
+	}
+}
+
+// addPoolContent adds the given transactions of the EVM tx pool to an address and nonce map.
+func addPoolContent(dst map[common.Address]map[string]*types.Transaction, content map[common.Address]ethtypes.Transactions) {
+	for addr, txs := range content {
+		addrMap, ok := dst[addr]
+		if !ok {
+			addrMap = make(map[string]*types.Transaction)
+			dst[addr] = addrMap
+		}
+		addPoolTxs(addrMap, txs)
+	}
+}
+
+// getContent returns the pending transactions of the Tendermint mempool along with
+// the pending and queued transactions of the EVM tx pool.
+func (api *PublicAPI) getContent() (map[common.Address]map[string]*types.Transaction, map[common.Address]map[string]*types.Transaction) {
+	pendingTxs, queuedTxs := api.backend.GetTxPool().Content()
+
+	pending := api.getPendingList()
+	addPoolContent(pending, pendingTxs)
+	queued := make(map[common.Address]map[string]*types.Transaction)
+	addPoolContent(queued, queuedTxs)
+	return pending, queued
+}
+
 // Content returns the transactions contained within the transaction pool
 func (api *PublicAPI) Content() map[string]map[common.Address]map[string]*types.Transaction {
 	api.logger.Debug("txpool_content")
+	pending, queued := api.getContent()
 	content := map[string]map[common.Address]map[string]*types.Transaction{
-		"pending": api.getPendingList(),
-		"queued":  make(map[common.Address]map[string]*types.Transaction),
+		"pending": pending,
+		"queued":  queued,
 	}
 	return content
 }
 
+// ContentFrom returns the transactions contained within the transaction pool
+// for the given address.
+func (api *PublicAPI) ContentFrom(addr common.Address) map[string]map[string]*types.Transaction {
+	api.logger.Debug("txpool_contentFrom", "address", addr.Hex())
+	content := map[string]map[string]*types.Transaction{
+		"pending": make(map[string]*types.Transaction),
+		"queued":  make(map[string]*types.Transaction),
+	}
+	for _, pTx := range types.GetPendingTxs(api.clientCtx.TxConfig.TxDecoder(), api.backend.GetMempool()) {
+		if pTx.From == addr {
+			content["pending"][fmt.Sprintf("%d", pTx.Nonce)] = pTx
+		}
+	}
+
+	pendingTxs, queuedTxs := api.backend.GetTxPool().ContentFrom(addr)
+	addPoolTxs(content["pending"], pendingTxs)
+	addPoolTxs(content["queued"], queuedTxs)
+	return content
+}
+
+// PendingBySender creates a subscription that is triggered each time a transaction
+// of the given sender is added to the pool with a nonce gap, or replaces one of
+// its pooled transactions.
+func (api *PublicAPI) PendingBySender(ctx context.Context, sender common.Address) (*rpc.Subscription, error) {
+	api.logger.Debug("txpool_pendingBySender", "sender", sender.Hex())
+
+	notifier, supported := rpc.NotifierFromContext(ctx)
+	if !supported {
+		return &rpc.Subscription{}, rpc.ErrNotificationsUnsupported
+	}
+
+	rpcSub := notifier.CreateSubscription()
+
+	go func() {
+		events := make(chan pool.SenderEvent, 128)
+		eventsSub := api.backend.GetTxPool().SubscribeSenderEvents(events)
+
+		defer eventsSub.Unsubscribe()
+
+		for {
+			select {
+			case ev := <-events:
+				if ev.Sender != sender {
+					continue
+				}
+				notifier.Notify(rpcSub.ID, newSenderEvent(ev))
+			case <-rpcSub.Err():
+				return
+			case <-eventsSub.Err():
+				return
+			}
+		}
+	}()
+
+	return rpcSub, nil
+}
+
+// newSenderEvent returns the RPC representation of a tx pool sender event.
+func newSenderEvent(ev pool.SenderEvent) *types.TxPoolSenderEvent {
+	res := &types.TxPoolSenderEvent{
+		Type:   string(ev.Type),
+		Sender: ev.Sender,
+		Hash:   ev.Tx.Hash(),
+		Nonce:  hexutil.Uint64(ev.Tx.Nonce()),
+	}
+	switch ev.Type {
+	case pool.SenderEventReplaced:
+		if ev.Replaced != nil {
+			hash := ev.Replaced.Hash()
+			res.ReplacedHash = &hash
+		}
+	case pool.SenderEventNonceGap:
+		expected := hexutil.Uint64(ev.Expected)
+		res.ExpectedNonce = &expected
+	}
+	return res
+}
+
 // Inspect returns the content of the transaction pool and flattens it into an
 func (api *PublicAPI) Inspect() map[string]map[string]map[string]string {
 	api.logger.Debug("txpool_inspect")
@@ -60,7 +178,7 @@ func (api *PublicAPI) Inspect() map[string]map[string]map[string]string {
 		"pending": make(map[string]map[string]string),
 		"queued":  make(map[string]map[string]string),
 	}
-	pending := api.getPendingList()
+	pending, queued := api.getContent()
 	// Define a formatter to flatten a transaction into a string
 	var format = func(tx *types.Transaction) string {
 		if to := tx.To; to != nil {
@@ -76,15 +194,45 @@ func (api *PublicAPI) Inspect() map[string]map[string]map[string]string {
 		}
 		content["pending"][account.Hex()] = dump
 	}
+	// Flatten the queued transactions
+	for account, txs := range queued {
+		dump := make(map[string]string)
+		for _, tx := range txs {
+			dump[fmt.Sprintf("%d", tx.Nonce)] = format(tx)
+		}
+		content["queued"][account.Hex()] = dump
+	}
 
 	return content
 }
 
-// Status returns the number of pending and queued transaction in the pool.
-func (api *PublicAPI) Status() map[string]hexutil.Uint {
+// Status returns the number of pending and queued transaction in the pool,
+// along with the effective configuration of the pool.
+func (api *PublicAPI) Status() map[string]interface{} {
 	api.logger.Debug("txpool_status")
-	return map[string]hexutil.Uint{
+	txPool := api.backend.GetTxPool()
+	_, queued := txPool.Stats()
+
+	cfg := txPool.Config()
+	config := types.TxPoolConfig{
+		PriceBump:              hexutil.Uint64(cfg.PriceBump),
+		AccountSlots:           hexutil.Uint64(cfg.AccountSlots),
+		GlobalSlots:            hexutil.Uint64(cfg.GlobalSlots),
+		AccountQueue:           hexutil.Uint64(cfg.AccountQueue),
+		GlobalQueue:            hexutil.Uint64(cfg.GlobalQueue),
+		Lifetime:               cfg.Lifetime.String(),
+		EvictionInterval:       cfg.EvictionInterval.String(),
+		ProcessQueueInterval:   cfg.ProcessQueueInterval.String(),
+		ProcessPendingInterval: cfg.ProcessPendingInterval.String(),
+	}
+	if cfg.Journal != "" {
+		config.Journal = cfg.Journal
+		config.Rejournal = cfg.Rejournal.String()
+	}
+
+	return map[string]interface{}{
 		"pending": hexutil.Uint(types.GetPendingTxsLen(api.backend.GetMempool())),
-		"queued":  hexutil.Uint(0),
+		"queued":  hexutil.Uint(queued),
+		"config":  config,
 	}
 }
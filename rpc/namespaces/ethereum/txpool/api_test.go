@@ -0,0 +1,74 @@
+package txpool
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/stratosnet/stratos-chain/rpc/types"
+	"github.com/stratosnet/stratos-chain/x/evm/pool"
+)
+
+func newSignedTx(t *testing.T, nonce uint64, gasPrice int64) *ethtypes.Transaction {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	to := common.HexToAddress("0x0000000000000000000000000000000000000001")
+	tx, err := ethtypes.SignNewTx(key, ethtypes.LatestSignerForChainID(big.NewInt(2048)), &ethtypes.LegacyTx{
+		Nonce:    nonce,
+		GasPrice: big.NewInt(gasPrice),
+		Gas:      21000,
+		To:       &to,
+	})
+	require.NoError(t, err)
+	return tx
+}
+
+func TestNewSenderEvent(t *testing.T) {
+	sender := common.HexToAddress("0x00000000000000000000000000000000000000aa")
+	tx := newSignedTx(t, 5, 2e9)
+
+	res := newSenderEvent(pool.SenderEvent{Type: pool.SenderEventNonceGap, Sender: sender, Tx: tx, Expected: 3})
+	expected := hexutil.Uint64(3)
+	require.Equal(t, &types.TxPoolSenderEvent{
+		Type:          "nonceGap",
+		Sender:        sender,
+		Hash:          tx.Hash(),
+		Nonce:         5,
+		ExpectedNonce: &expected,
+	}, res)
+
+	old := newSignedTx(t, 5, 1e9)
+	res = newSenderEvent(pool.SenderEvent{Type: pool.SenderEventReplaced, Sender: sender, Tx: tx, Replaced: old})
+	replacedHash := old.Hash()
+	require.Equal(t, &types.TxPoolSenderEvent{
+		Type:         "replaced",
+		Sender:       sender,
+		Hash:         tx.Hash(),
+		Nonce:        5,
+		ReplacedHash: &replacedHash,
+	}, res)
+}
+
+func TestAddPoolContent(t *testing.T) {
+	addr := common.HexToAddress("0x00000000000000000000000000000000000000aa")
+	pendingTx := newSignedTx(t, 0, 1e9)
+	queuedTxs := ethtypes.Transactions{newSignedTx(t, 2, 1e9), newSignedTx(t, 3, 1e9)}
+
+	// Transactions of the EVM tx pool are merged with the ones already listed for the address
+	content := map[common.Address]map[string]*types.Transaction{
+		addr: {"0": {Hash: pendingTx.Hash()}},
+	}
+	addPoolContent(content, map[common.Address]ethtypes.Transactions{addr: queuedTxs})
+	require.Len(t, content[addr], 3)
+	require.Equal(t, pendingTx.Hash(), content[addr]["0"].Hash)
+	require.Equal(t, queuedTxs[0].Hash(), content[addr]["2"].Hash)
+	require.Equal(t, queuedTxs[1].Hash(), content[addr]["3"].Hash)
+	require.Equal(t, hexutil.Uint64(3), content[addr]["3"].Nonce)
+}
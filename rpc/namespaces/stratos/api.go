@@ -0,0 +1,166 @@
+package stratos
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/cometbft/cometbft/libs/log"
+	tmtypes "github.com/cometbft/cometbft/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/stratosnet/stratos-chain/rpc/backend"
+	stratos "github.com/stratosnet/stratos-chain/types"
+	pottypes "github.com/stratosnet/stratos-chain/x/pot/types"
+	registertypes "github.com/stratosnet/stratos-chain/x/register/types"
+	sdstypes "github.com/stratosnet/stratos-chain/x/sds/types"
+)
+
+// API offers the sds, pot and register module data over JSON-RPC, along with
+// subscriptions to their events. Module query results are returned in the same
+// JSON format as the Cosmos REST endpoints.
+type API struct {
+	ctx            context.Context
+	logger         log.Logger
+	clientCtx      client.Context
+	eventBus       *tmtypes.EventBus
+	backend        backend.BackendI
+	registerClient registertypes.QueryClient
+	potClient      pottypes.QueryClient
+	sdsClient      sdstypes.QueryClient
+}
+
+// NewAPI creates a new API definition for the stratos namespace.
+func NewAPI(logger log.Logger, clientCtx client.Context, eventBus *tmtypes.EventBus, backend backend.BackendI) *API {
+	return &API{
+		ctx:            context.Background(),
+		logger:         logger.With("module", "stratos"),
+		clientCtx:      clientCtx,
+		eventBus:       eventBus,
+		backend:        backend,
+		registerClient: registertypes.NewQueryClient(clientCtx),
+		potClient:      pottypes.NewQueryClient(clientCtx),
+		sdsClient:      sdstypes.NewQueryClient(clientCtx),
+	}
+}
+
+// Addresses holds the representations of an address, shared by accounts and sds nodes.
+type Addresses struct {
+	Hex        common.Address `json:"hex"`
+	Bech32     string         `json:"bech32"`
+	SdsAddress string         `json:"sdsAddress"`
+}
+
+// marshal encodes a module query response the way the Cosmos REST endpoints do.
+func (api *API) marshal(res proto.Message, err error) (json.RawMessage, error) {
+	if err != nil {
+		return nil, err
+	}
+	return api.clientCtx.Codec.MarshalJSON(res)
+}
+
+// GetResourceNode returns the resource node registered with the given sds network address.
+func (api *API) GetResourceNode(networkAddr string) (json.RawMessage, error) {
+	api.logger.Debug("stratos_getResourceNode", "network address", networkAddr)
+	return api.marshal(api.registerClient.ResourceNode(api.ctx, &registertypes.QueryResourceNodeRequest{
+		NetworkAddr: networkAddr,
+	}))
+}
+
+// GetMetaNode returns the meta node registered with the given sds network address.
+func (api *API) GetMetaNode(networkAddr string) (json.RawMessage, error) {
+	api.logger.Debug("stratos_getMetaNode", "network address", networkAddr)
+	return api.marshal(api.registerClient.MetaNode(api.ctx, &registertypes.QueryMetaNodeRequest{
+		NetworkAddr: networkAddr,
+	}))
+}
+
+// GetNozPrice returns the current noz price.
+func (api *API) GetNozPrice() (json.RawMessage, error) {
+	api.logger.Debug("stratos_getNozPrice")
+	return api.marshal(api.sdsClient.NozPrice(api.ctx, &sdstypes.QueryNozPriceRequest{}))
+}
+
+// SimPrepay returns the amount of noz a prepay of the given amount of wei would purchase.
+func (api *API) SimPrepay(amount hexutil.Big) (json.RawMessage, error) {
+	api.logger.Debug("stratos_simPrepay", "amount", amount.String())
+	return api.marshal(api.sdsClient.SimPrepay(api.ctx, &sdstypes.QuerySimPrepayRequest{
+		Amount: (*big.Int)(&amount).String() + stratos.Wei,
+	}))
+}
+
+// GetRewards returns the rewards of the given wallet, hex or bech32, distributed at the given epoch.
+func (api *API) GetRewards(wallet string, epoch rpc.DecimalOrHex) (json.RawMessage, error) {
+	api.logger.Debug("stratos_getRewards", "wallet", wallet, "epoch", uint64(epoch))
+	walletAddr, err := parseAccAddress(wallet)
+	if err != nil {
+		return nil, err
+	}
+	return api.marshal(api.potClient.RewardsByWalletAndEpoch(api.ctx, &pottypes.QueryRewardsByWalletAndEpochRequest{
+		WalletAddress: walletAddr.String(),
+		Epoch:         int64(epoch),
+	}))
+}
+
+// GetFileInfo returns the upload info of the file with the given hash.
+func (api *API) GetFileInfo(fileHash string) (json.RawMessage, error) {
+	api.logger.Debug("stratos_getFileInfo", "file hash", fileHash)
+	return api.marshal(api.sdsClient.Fileupload(api.ctx, &sdstypes.QueryFileUploadRequest{
+		FileHash: fileHash,
+	}))
+}
+
+// GetVolumeReport returns the volume report of the given epoch.
+func (api *API) GetVolumeReport(epoch rpc.DecimalOrHex) (json.RawMessage, error) {
+	api.logger.Debug("stratos_getVolumeReport", "epoch", uint64(epoch))
+	return api.marshal(api.potClient.VolumeReport(api.ctx, &pottypes.QueryVolumeReportRequest{
+		Epoch: int64(epoch),
+	}))
+}
+
+// ConvertAddress returns the hex, bech32 and sds network representations of the
+// given address, which may be passed in any of them.
+func (api *API) ConvertAddress(address string) (*Addresses, error) {
+	api.logger.Debug("stratos_convertAddress", "address", address)
+	var bz []byte
+	switch {
+	case common.IsHexAddress(address):
+		bz = common.HexToAddress(address).Bytes()
+	case strings.HasPrefix(address, stratos.SdsNodeP2PAddressPrefix):
+		sdsAddr, err := stratos.SdsAddressFromBech32(address)
+		if err != nil {
+			return nil, err
+		}
+		bz = sdsAddr.Bytes()
+	default:
+		accAddr, err := sdk.AccAddressFromBech32(address)
+		if err != nil {
+			return nil, err
+		}
+		bz = accAddr.Bytes()
+	}
+	if len(bz) != common.AddressLength {
+		return nil, fmt.Errorf("invalid address length %d, expected %d", len(bz), common.AddressLength)
+	}
+	return &Addresses{
+		Hex:        common.BytesToAddress(bz),
+		Bech32:     sdk.AccAddress(bz).String(),
+		SdsAddress: stratos.SdsAddress(bz).String(),
+	}, nil
+}
+
+// parseAccAddress parses an account address given either in hex or in bech32.
+func parseAccAddress(address string) (sdk.AccAddress, error) {
+	if common.IsHexAddress(address) {
+		return common.HexToAddress(address).Bytes(), nil
+	}
+	return sdk.AccAddressFromBech32(address)
+}
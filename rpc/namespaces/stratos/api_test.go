@@ -0,0 +1,84 @@
+package stratos
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/cometbft/cometbft/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+
+	stratos "github.com/stratosnet/stratos-chain/types"
+)
+
+func TestMain(m *testing.M) {
+	config := stratos.GetConfig()
+	config.Seal()
+	exitVal := m.Run()
+	os.Exit(exitVal)
+}
+
+func TestConvertAddress(t *testing.T) {
+	api := NewAPI(log.NewNopLogger(), client.Context{}, nil, nil)
+
+	hexAddr := common.HexToAddress("0x2f6e2F7EF4B8b4c8A7cD2c2FeD9c8B6a3A1E8D21")
+	bech32Addr, err := bech32.ConvertAndEncode(stratos.AccountAddressPrefix, hexAddr.Bytes())
+	require.NoError(t, err)
+	sdsAddr, err := bech32.ConvertAndEncode(stratos.SdsNodeP2PAddressPrefix, hexAddr.Bytes())
+	require.NoError(t, err)
+
+	expected := &Addresses{Hex: hexAddr, Bech32: bech32Addr, SdsAddress: sdsAddr}
+	// Any representation converts to all of them, hex addresses whatever their case
+	for _, address := range []string{hexAddr.Hex(), hexAddr.Hex()[2:], "0x" + common.Bytes2Hex(hexAddr.Bytes()), bech32Addr, sdsAddr} {
+		res, err := api.ConvertAddress(address)
+		require.NoError(t, err, address)
+		require.Equal(t, expected, res, address)
+	}
+}
+
+func TestConvertAddressInvalid(t *testing.T) {
+	api := NewAPI(log.NewNopLogger(), client.Context{}, nil, nil)
+
+	addr := common.HexToAddress("0x2f6e2F7EF4B8b4c8A7cD2c2FeD9c8B6a3A1E8D21").Bytes()
+	otherPrefix, err := bech32.ConvertAndEncode("cosmos", addr)
+	require.NoError(t, err)
+	longAddr, err := bech32.ConvertAndEncode(stratos.AccountAddressPrefix, common.LeftPadBytes(addr, 32))
+	require.NoError(t, err)
+	sdsAddr, err := bech32.ConvertAndEncode(stratos.SdsNodeP2PAddressPrefix, addr)
+	require.NoError(t, err)
+	badChecksum := sdsAddr[:len(sdsAddr)-1] + "q"
+	if badChecksum == sdsAddr {
+		badChecksum = sdsAddr[:len(sdsAddr)-1] + "p"
+	}
+
+	for _, address := range []string{"", "0x1234", "not an address", otherPrefix, badChecksum} {
+		_, err := api.ConvertAddress(address)
+		require.Error(t, err, address)
+	}
+
+	_, err = api.ConvertAddress(longAddr)
+	require.EqualError(t, err, "invalid address length 32, expected 20")
+}
+
+func TestParseAccAddress(t *testing.T) {
+	hexAddr := common.HexToAddress("0x2f6e2F7EF4B8b4c8A7cD2c2FeD9c8B6a3A1E8D21")
+	bech32Addr, err := bech32.ConvertAndEncode(stratos.AccountAddressPrefix, hexAddr.Bytes())
+	require.NoError(t, err)
+
+	for _, address := range []string{hexAddr.Hex(), bech32Addr} {
+		accAddr, err := parseAccAddress(address)
+		require.NoError(t, err, address)
+		require.Equal(t, bech32Addr, accAddr.String())
+	}
+
+	// sds network addresses are not account addresses
+	sdsAddr, err := bech32.ConvertAndEncode(stratos.SdsNodeP2PAddressPrefix, hexAddr.Bytes())
+	require.NoError(t, err)
+	_, err = parseAccAddress(sdsAddr)
+	require.Error(t, err)
+}
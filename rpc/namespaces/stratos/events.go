@@ -0,0 +1,268 @@
+package stratos
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmpubsub "github.com/cometbft/cometbft/libs/pubsub"
+	tmquery "github.com/cometbft/cometbft/libs/pubsub/query"
+	tmtypes "github.com/cometbft/cometbft/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	pottypes "github.com/stratosnet/stratos-chain/x/pot/types"
+	registertypes "github.com/stratosnet/stratos-chain/x/register/types"
+	sdstypes "github.com/stratosnet/stratos-chain/x/sds/types"
+)
+
+// for tendermint subscribe channel capacity to make it buffered
+const tmChannelCapacity = 100
+
+// EventFilter narrows a subscription down to the events involving a wallet
+// and/or a sds network address. Empty fields match every event.
+type EventFilter struct {
+	WalletAddress  string `json:"walletAddress"`
+	NetworkAddress string `json:"networkAddress"`
+}
+
+// Event is the notification payload of the stratos subscriptions. Data holds
+// the typed event decoded from the block or transaction events.
+type Event struct {
+	Type        string          `json:"type"`
+	BlockNumber hexutil.Uint64  `json:"blockNumber"`
+	TxHash      *common.Hash    `json:"txHash,omitempty"`
+	Data        json.RawMessage `json:"data"`
+}
+
+// eventSource describes a typed event and the attributes a filter is matched against.
+type eventSource struct {
+	event      proto.Message
+	key        string   // attribute always present, used to select the event
+	endBlock   bool     // emitted on EndBlocker rather than by a transaction
+	walletKeys []string // attributes holding wallet addresses
+	nodeKeys   []string // attributes holding sds network addresses
+}
+
+func (s eventSource) eventType() string {
+	return proto.MessageName(s.event)
+}
+
+// query returns the tendermint event bus query matching the event.
+func (s eventSource) query() string {
+	tmEvent := tmtypes.EventTx
+	if s.endBlock {
+		tmEvent = tmtypes.EventNewBlock
+	}
+	return fmt.Sprintf("%s='%s' AND %s.%s EXISTS", tmtypes.EventTypeKey, tmEvent, s.eventType(), s.key)
+}
+
+// matches reports whether the attributes of the event satisfy the filter.
+func (s eventSource) matches(event abci.Event, wallet, node string) bool {
+	return matchAttributes(event, s.walletKeys, wallet) && matchAttributes(event, s.nodeKeys, node)
+}
+
+func matchAttributes(event abci.Event, keys []string, value string) bool {
+	if value == "" {
+		return true
+	}
+	for _, attr := range event.Attributes {
+		for _, key := range keys {
+			if attr.Key != key {
+				continue
+			}
+			// typed event attributes are json encoded
+			var v string
+			if err := json.Unmarshal([]byte(attr.Value), &v); err != nil {
+				v = attr.Value
+			}
+			if v == value {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+var (
+	volumeReportSources = []eventSource{
+		{event: &pottypes.EventVolumeReport{}, key: "epoch"},
+	}
+	withdrawSources = []eventSource{
+		{event: &pottypes.EventWithdraw{}, key: "wallet_address", walletKeys: []string{"wallet_address", "target_address"}},
+	}
+	slashingSources = []eventSource{
+		{event: &pottypes.EventSlashing{}, key: "network_address", walletKeys: []string{"wallet_address"}, nodeKeys: []string{"network_address"}},
+	}
+	prepaySources = []eventSource{
+		{event: &sdstypes.EventPrePay{}, key: "sender", walletKeys: []string{"sender", "beneficiary"}},
+		// recurring prepays of the prepay subscriptions are executed on EndBlocker
+		{event: &sdstypes.EventPrepaySubscriptionExecuted{}, key: "subscription_id", endBlock: true, walletKeys: []string{"sender", "beneficiary"}},
+	}
+	fileUploadSources = []eventSource{
+		{event: &sdstypes.EventFileUpload{}, key: "file_hash", walletKeys: []string{"uploader"}, nodeKeys: []string{"reporter"}},
+	}
+	nodeStatusSources = []eventSource{
+		{event: &registertypes.EventCompleteUnBondingResourceNode{}, key: "network_address", endBlock: true, nodeKeys: []string{"network_address"}},
+		{event: &registertypes.EventCompleteUnBondingMetaNode{}, key: "network_address", endBlock: true, nodeKeys: []string{"network_address"}},
+		{
+			event:      &registertypes.EventMetaNodeRegistrationVote{},
+			key:        "candidate_network_address",
+			walletKeys: []string{"sender"},
+			nodeKeys:   []string{"voter_network_address", "candidate_network_address"},
+		},
+		{
+			event:      &registertypes.EventKickMetaNodeVote{},
+			key:        "target_network_address",
+			walletKeys: []string{"sender"},
+			nodeKeys:   []string{"voter_network_address", "target_network_address"},
+		},
+	}
+)
+
+// VolumeReport sends a notification for every volume report.
+func (api *API) VolumeReport(ctx context.Context) (*rpc.Subscription, error) {
+	return api.subscribe(ctx, volumeReportSources, nil)
+}
+
+// Withdraw sends a notification for every reward withdrawal. The wallet
+// filter matches the withdrawing wallet or the target address.
+func (api *API) Withdraw(ctx context.Context, crit *EventFilter) (*rpc.Subscription, error) {
+	return api.subscribe(ctx, withdrawSources, crit)
+}
+
+// Slashing sends a notification for every slashing of a resource node.
+func (api *API) Slashing(ctx context.Context, crit *EventFilter) (*rpc.Subscription, error) {
+	return api.subscribe(ctx, slashingSources, crit)
+}
+
+// Prepay sends a notification for every prepay, including the recurring prepays
+// executed for the prepay subscriptions. The wallet filter matches the sender or the beneficiary.
+func (api *API) Prepay(ctx context.Context, crit *EventFilter) (*rpc.Subscription, error) {
+	return api.subscribe(ctx, prepaySources, crit)
+}
+
+// FileUpload sends a notification for every file upload. The wallet filter
+// matches the uploader and the network address filter matches the reporter.
+func (api *API) FileUpload(ctx context.Context, crit *EventFilter) (*rpc.Subscription, error) {
+	return api.subscribe(ctx, fileUploadSources, crit)
+}
+
+// NodeStatus sends a notification for every node state change: completed
+// unbondings of resource and meta nodes, and meta node registration and kick votes.
+func (api *API) NodeStatus(ctx context.Context, crit *EventFilter) (*rpc.Subscription, error) {
+	return api.subscribe(ctx, nodeStatusSources, crit)
+}
+
+// subscribe creates a subscription notifying the events of the given sources
+// that match the filter.
+func (api *API) subscribe(ctx context.Context, sources []eventSource, crit *EventFilter) (*rpc.Subscription, error) {
+	notifier, supported := rpc.NotifierFromContext(ctx)
+	if !supported {
+		return &rpc.Subscription{}, rpc.ErrNotificationsUnsupported
+	}
+
+	var wallet, node string
+	if crit != nil {
+		if crit.WalletAddress != "" {
+			walletAddr, err := parseAccAddress(crit.WalletAddress)
+			if err != nil {
+				return nil, err
+			}
+			wallet = walletAddr.String()
+		}
+		node = crit.NetworkAddress
+	}
+
+	rpcSub := notifier.CreateSubscription()
+	subscriber := "stratos_" + string(rpcSub.ID)
+
+	for _, source := range sources {
+		q, err := tmquery.New(source.query())
+		if err != nil {
+			_ = api.eventBus.UnsubscribeAll(api.ctx, subscriber)
+			return nil, err
+		}
+		tmSub, err := api.eventBus.Subscribe(api.ctx, subscriber, q, tmChannelCapacity)
+		if err != nil {
+			_ = api.eventBus.UnsubscribeAll(api.ctx, subscriber)
+			return nil, err
+		}
+
+		go func(source eventSource, tmSub tmtypes.Subscription) {
+			for {
+				select {
+				case msg := <-tmSub.Out():
+					for _, event := range api.decodeEvents(source, msg.Data(), wallet, node) {
+						if err := notifier.Notify(rpcSub.ID, event); err != nil {
+							api.logger.Debug("failed to notify stratos event", "type", event.Type, "error", err.Error())
+						}
+					}
+				case <-tmSub.Cancelled():
+					if err := tmSub.Err(); err != nil && err != tmpubsub.ErrUnsubscribed {
+						api.logger.Error("stratos event subscription was cancelled", "type", source.eventType(), "error", err.Error())
+					}
+					return
+				}
+			}
+		}(source, tmSub)
+	}
+
+	go func() {
+		select {
+		case <-rpcSub.Err():
+		case <-notifier.Closed():
+		case <-api.eventBus.Quit():
+			return
+		}
+		_ = api.eventBus.UnsubscribeAll(api.ctx, subscriber)
+	}()
+
+	return rpcSub, nil
+}
+
+// decodeEvents returns the typed events of the source carried by a transaction
+// or a block that match the filter.
+func (api *API) decodeEvents(source eventSource, data tmtypes.TMEventData, wallet, node string) []*Event {
+	var (
+		height int64
+		txHash *common.Hash
+		events []abci.Event
+	)
+	switch data := data.(type) {
+	case tmtypes.EventDataTx:
+		hash := common.BytesToHash(tmtypes.Tx(data.Tx).Hash())
+		height, txHash, events = data.Height, &hash, data.Result.Events
+	case tmtypes.EventDataNewBlock:
+		height, events = data.Block.Height, data.ResultEndBlock.Events
+	default:
+		return nil
+	}
+
+	var result []*Event
+	for _, event := range events {
+		if event.Type != source.eventType() || !source.matches(event, wallet, node) {
+			continue
+		}
+		typedEvent, err := sdk.ParseTypedEvent(event)
+		if err != nil {
+			api.logger.Debug("failed to parse stratos event", "type", event.Type, "error", err.Error())
+			continue
+		}
+		bz, err := api.clientCtx.Codec.MarshalJSON(typedEvent)
+		if err != nil {
+			continue
+		}
+		result = append(result, &Event{
+			Type:        event.Type,
+			BlockNumber: hexutil.Uint64(height),
+			TxHash:      txHash,
+			Data:        bz,
+		})
+	}
+	return result
+}
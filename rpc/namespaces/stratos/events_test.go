@@ -0,0 +1,132 @@
+package stratos
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/libs/log"
+	tmquery "github.com/cometbft/cometbft/libs/pubsub/query"
+	tmtypes "github.com/cometbft/cometbft/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	pottypes "github.com/stratosnet/stratos-chain/x/pot/types"
+	sdstypes "github.com/stratosnet/stratos-chain/x/sds/types"
+)
+
+const (
+	wallet1 = "st1w4yw7p7jyv3ew9f8zvkxtxzp5qpy6jmdmmm73m"
+	wallet2 = "st1lqgqpqc9vcrqwqrthdzxrvj6r0qdp2wdtssz5d"
+	node1   = "stsds1cw8qhgsxddak8hh8gs7veqmy5ku8f8za6qlq64"
+	node2   = "stsds1ftcvm2h9rjtzlwauxmr67hd5r4hpxqucjawpz6"
+)
+
+func typedEvent(t *testing.T, event proto.Message) abci.Event {
+	abciEvent, err := sdk.TypedEventToEvent(event)
+	require.NoError(t, err)
+	return abci.Event(abciEvent)
+}
+
+func TestEventSourceQuery(t *testing.T) {
+	for _, tc := range []struct {
+		source   eventSource
+		expected string
+	}{
+		{
+			source:   withdrawSources[0],
+			expected: "tm.event='Tx' AND stratos.pot.v1.EventWithdraw.wallet_address EXISTS",
+		},
+		{
+			source:   prepaySources[1],
+			expected: "tm.event='NewBlock' AND stratos.sds.v1.EventPrepaySubscriptionExecuted.subscription_id EXISTS",
+		},
+	} {
+		require.Equal(t, tc.expected, tc.source.query())
+		_, err := tmquery.New(tc.source.query())
+		require.NoError(t, err)
+	}
+}
+
+func TestEventSourceMatches(t *testing.T) {
+	withdraw := withdrawSources[0]
+	event := typedEvent(t, &pottypes.EventWithdraw{Amount: "1", WalletAddress: wallet1, TargetAddress: wallet2})
+
+	// An empty filter matches every event, the wallet filter matches any of the wallet attributes
+	require.True(t, withdraw.matches(event, "", ""))
+	require.True(t, withdraw.matches(event, wallet1, ""))
+	require.True(t, withdraw.matches(event, wallet2, ""))
+	require.False(t, withdraw.matches(event, "st1", ""))
+	// The source has no network address attribute, so a network address filter never matches
+	require.False(t, withdraw.matches(event, "", node1))
+
+	slashing := slashingSources[0]
+	event = typedEvent(t, &pottypes.EventSlashing{WalletAddress: wallet1, NetworkAddress: node1, Amount: "1"})
+
+	// Both filters have to match
+	require.True(t, slashing.matches(event, wallet1, node1))
+	require.True(t, slashing.matches(event, "", node1))
+	require.False(t, slashing.matches(event, wallet1, node2))
+	require.False(t, slashing.matches(event, wallet2, node1))
+
+	// Attributes that are not json encoded are compared as is
+	event = abci.Event{
+		Type:       proto.MessageName(&pottypes.EventSlashing{}),
+		Attributes: []abci.EventAttribute{{Key: "wallet_address", Value: wallet1}, {Key: "network_address", Value: node1}},
+	}
+	require.True(t, slashing.matches(event, wallet1, node1))
+	require.False(t, slashing.matches(event, wallet2, node1))
+}
+
+func TestDecodeEvents(t *testing.T) {
+	api := NewAPI(log.NewNopLogger(), client.Context{Codec: codec.NewProtoCodec(codectypes.NewInterfaceRegistry())}, nil, nil)
+
+	prepay1 := &sdstypes.EventPrePay{Sender: wallet1, Beneficiary: wallet1, Amount: "1", PurchasedNoz: "10"}
+	prepay2 := &sdstypes.EventPrePay{Sender: wallet2, Beneficiary: wallet1, Amount: "2", PurchasedNoz: "20"}
+	tx := tmtypes.Tx("tx")
+	data := tmtypes.EventDataTx{TxResult: abci.TxResult{
+		Height: 10,
+		Tx:     tx,
+		Result: abci.ResponseDeliverTx{Events: []abci.Event{
+			typedEvent(t, prepay1),
+			typedEvent(t, &pottypes.EventWithdraw{Amount: "1", WalletAddress: wallet2, TargetAddress: wallet2}),
+			typedEvent(t, prepay2),
+		}},
+	}}
+
+	// Only the events of the source type matching the filter are decoded
+	events := api.decodeEvents(prepaySources[0], data, wallet2, "")
+	require.Len(t, events, 1)
+	txHash := common.BytesToHash(tx.Hash())
+	require.Equal(t, "stratos.sds.v1.EventPrePay", events[0].Type)
+	require.Equal(t, hexutil.Uint64(10), events[0].BlockNumber)
+	require.Equal(t, &txHash, events[0].TxHash)
+	var decoded sdstypes.EventPrePay
+	require.NoError(t, json.Unmarshal(events[0].Data, &decoded))
+	require.Equal(t, *prepay2, decoded)
+
+	require.Len(t, api.decodeEvents(prepaySources[0], data, wallet1, ""), 2)
+	require.Empty(t, api.decodeEvents(prepaySources[0], data, "st1", ""))
+
+	// The events of a block are taken from its end block events, without a transaction hash
+	executed := &sdstypes.EventPrepaySubscriptionExecuted{Sender: wallet1, Beneficiary: wallet2, SubscriptionId: "1", Amount: "1", PurchasedNoz: "10"}
+	block := tmtypes.EventDataNewBlock{
+		Block:          &tmtypes.Block{Header: tmtypes.Header{Height: 11}},
+		ResultEndBlock: abci.ResponseEndBlock{Events: []abci.Event{typedEvent(t, executed)}},
+	}
+	events = api.decodeEvents(prepaySources[1], block, wallet2, "")
+	require.Len(t, events, 1)
+	require.Equal(t, hexutil.Uint64(11), events[0].BlockNumber)
+	require.Nil(t, events[0].TxHash)
+
+	require.Nil(t, api.decodeEvents(prepaySources[0], tmtypes.EventDataRoundState{}, "", ""))
+}
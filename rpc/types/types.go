@@ -6,6 +6,8 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	evmtypes "github.com/stratosnet/stratos-chain/x/evm/types"
 )
 
 // Copied the Account and StorageResult types since they are registered under an
@@ -53,20 +55,48 @@ type Transaction struct {
 }
 
 // StateOverride is the collection of overridden accounts.
-type StateOverride map[common.Address]OverrideAccount
+type StateOverride = evmtypes.StateOverride
 
 // OverrideAccount indicates the overriding fields of account during the execution of
 // a message call.
-// Note, state and stateDiff can't be specified at the same time. If state is
-// set, message execution will only use the data in the given state. Otherwise
-// if statDiff is set, all diff will be applied first and then execute the call
-// message.
-type OverrideAccount struct {
-	Nonce     *hexutil.Uint64              `json:"nonce"`
-	Code      *hexutil.Bytes               `json:"code"`
-	Balance   **hexutil.Big                `json:"balance"`
-	State     *map[common.Hash]common.Hash `json:"state"`
-	StateDiff *map[common.Hash]common.Hash `json:"stateDiff"`
+type OverrideAccount = evmtypes.OverrideAccount
+
+// BlockOverrides is a set of header fields to override.
+type BlockOverrides = evmtypes.BlockOverrides
+
+// AccessListResult returns an optional accesslist
+// It's the result of the `eth_createAccessList` RPC call.
+// It contains an error if the transaction itself failed.
+type AccessListResult struct {
+	AccessList *ethtypes.AccessList `json:"accessList"`
+	Error      string               `json:"error,omitempty"`
+	GasUsed    hexutil.Uint64       `json:"gasUsed"`
+}
+
+// TxPoolConfig is the effective configuration of the EVM transaction pool.
+// It's reported by the `txpool_status` RPC call.
+type TxPoolConfig struct {
+	PriceBump              hexutil.Uint64 `json:"priceBump"`
+	AccountSlots           hexutil.Uint64 `json:"accountSlots"`
+	GlobalSlots            hexutil.Uint64 `json:"globalSlots"`
+	AccountQueue           hexutil.Uint64 `json:"accountQueue"`
+	GlobalQueue            hexutil.Uint64 `json:"globalQueue"`
+	Lifetime               string         `json:"lifetime"`
+	EvictionInterval       string         `json:"evictionInterval"`
+	ProcessQueueInterval   string         `json:"processQueueInterval"`
+	ProcessPendingInterval string         `json:"processPendingInterval"`
+	Journal                string         `json:"journal,omitempty"`
+	Rejournal              string         `json:"rejournal,omitempty"`
+}
+
+// TxPoolSenderEvent is the notification sent by the `pendingBySender` txpool subscription.
+type TxPoolSenderEvent struct {
+	Type          string          `json:"type"`
+	Sender        common.Address  `json:"sender"`
+	Hash          common.Hash     `json:"hash"`
+	Nonce         hexutil.Uint64  `json:"nonce"`
+	ReplacedHash  *common.Hash    `json:"replacedHash,omitempty"`
+	ExpectedNonce *hexutil.Uint64 `json:"expectedNonce,omitempty"`
 }
 
 type FeeHistoryResult struct {
@@ -112,6 +142,29 @@ type Header struct {
 	BaseFee     *big.Int            `json:"baseFeePerGas"`
 }
 
+// ToEthHeader returns the ethereum header of the header fields, without the hash and the size of the block, which
+// are not part of the header encoding
+func (h *Header) ToEthHeader() *ethtypes.Header {
+	return &ethtypes.Header{
+		ParentHash:  h.ParentHash,
+		UncleHash:   h.UncleHash,
+		Coinbase:    h.Coinbase,
+		Root:        h.Root,
+		TxHash:      h.TxHash,
+		ReceiptHash: h.ReceiptHash,
+		Bloom:       h.Bloom,
+		Difficulty:  h.Difficulty,
+		Number:      h.Number,
+		GasLimit:    h.GasLimit,
+		GasUsed:     h.GasUsed,
+		Time:        h.Time,
+		Extra:       h.Extra,
+		MixDigest:   h.MixDigest,
+		Nonce:       h.Nonce,
+		BaseFee:     h.BaseFee,
+	}
+}
+
 // Block represents a block returned to RPC clients.
 type Block struct {
 	Number           hexutil.Uint64      `json:"number"`
@@ -141,6 +194,7 @@ type Block struct {
 // TransactionReceipt represents a mined transaction returned to RPC clients.
 type TransactionReceipt struct {
 	// Consensus fields: These fields are defined by the Yellow Paper
+	Type              hexutil.Uint64  `json:"type"`
 	Status            hexutil.Uint64  `json:"status"`
 	CumulativeGasUsed hexutil.Uint64  `json:"cumulativeGasUsed"`
 	LogsBloom         ethtypes.Bloom  `json:"logsBloom"`
@@ -32,6 +32,24 @@ const (
 
 	DefaultMaxTxGasWanted = 500000
 
+	DefaultTxPoolPriceBump uint64 = 10
+
+	DefaultTxPoolAccountSlots uint64 = 16
+
+	DefaultTxPoolGlobalSlots uint64 = 4096 + 1024
+
+	DefaultTxPoolAccountQueue uint64 = 64
+
+	DefaultTxPoolGlobalQueue uint64 = 1024
+
+	DefaultTxPoolLifetime = 3 * time.Hour
+
+	DefaultTxPoolEvictionInterval = time.Minute
+
+	DefaultTxPoolProcessQueueInterval = 5 * time.Second
+
+	DefaultTxPoolProcessPendingInterval = 5 * time.Second
+
 	DefaultGasCap uint64 = 25000000
 
 	DefaultFilterCap int32 = 200
@@ -50,6 +68,12 @@ const (
 
 	DefaultHTTPIdleTimeout = 120 * time.Second
 
+	// DefaultTxPoolJournal is the default file, relative to the node data directory,
+	// locally submitted transactions are journaled to.
+	DefaultTxPoolJournal = "transactions.rlp"
+
+	DefaultTxPoolRejournal = time.Hour
+
 	DefaultMinGasPrices uint64 = 1e9 // default 1000000000wei = 1gwei
 
 	MinimalMinGasPrices uint64 = 1e7 // 1000000wei = 0.01gwei
@@ -74,6 +98,30 @@ type EVMConfig struct {
 	Tracer string `mapstructure:"tracer"`
 	// MaxTxGasWanted defines the gas wanted for each eth tx returned in ante handler in check tx mode.
 	MaxTxGasWanted uint64 `mapstructure:"max-tx-gas-wanted"`
+	// TxPool defines the configuration of the EVM transaction pool.
+	TxPool TxPoolConfig `mapstructure:"txpool"`
+}
+
+// TxPoolConfig defines the configuration values of the EVM transaction pool.
+type TxPoolConfig struct {
+	// PriceBump is the minimum price bump percentage to replace an already existing transaction (nonce).
+	PriceBump uint64 `mapstructure:"price-bump"`
+	// AccountSlots is the number of executable transaction slots guaranteed per account.
+	AccountSlots uint64 `mapstructure:"account-slots"`
+	// GlobalSlots is the maximum number of executable transaction slots for all accounts.
+	GlobalSlots uint64 `mapstructure:"global-slots"`
+	// AccountQueue is the maximum number of non-executable transaction slots permitted per account.
+	AccountQueue uint64 `mapstructure:"account-queue"`
+	// GlobalQueue is the maximum number of non-executable transaction slots for all accounts.
+	GlobalQueue uint64 `mapstructure:"global-queue"`
+	// Lifetime is the maximum amount of time non-executable transactions are queued.
+	Lifetime time.Duration `mapstructure:"lifetime"`
+	// EvictionInterval is the time interval to check for evictable transactions.
+	EvictionInterval time.Duration `mapstructure:"eviction-interval"`
+	// ProcessQueueInterval is the time interval to promote executable queued transactions to pending.
+	ProcessQueueInterval time.Duration `mapstructure:"process-queue-interval"`
+	// ProcessPendingInterval is the time interval to broadcast pending transactions to the Tendermint mempool.
+	ProcessPendingInterval time.Duration `mapstructure:"process-pending-interval"`
 }
 
 // JSONRPCConfig defines configuration for the EVM RPC server.
@@ -98,12 +146,17 @@ type JSONRPCConfig struct {
 	Enable bool `mapstructure:"enable"`
 	// LogsCap defines the max number of results can be returned from single `eth_getLogs` query.
 	LogsCap int32 `mapstructure:"logs-cap"`
-	// BlockRangeCap defines the max block range allowed for `eth_getLogs` query.
+	// BlockRangeCap defines the max block range allowed for `eth_getLogs` and `trace_filter` queries.
 	BlockRangeCap int32 `mapstructure:"block-range-cap"`
 	// HTTPTimeout is the read/write timeout of http json-rpc server.
 	HTTPTimeout time.Duration `mapstructure:"http-timeout"`
 	// HTTPIdleTimeout is the idle timeout of http json-rpc server.
 	HTTPIdleTimeout time.Duration `mapstructure:"http-idle-timeout"`
+	// TxPoolJournal is the disk journal for locally submitted transactions to survive node restarts.
+	// A relative path is resolved against the node data directory, an empty value disables the journal.
+	TxPoolJournal string `mapstructure:"txpool-journal"`
+	// TxPoolRejournal is the time interval to regenerate the local transaction journal.
+	TxPoolRejournal time.Duration `mapstructure:"txpool-rejournal"`
 }
 
 // TLSConfig defines the certificate and matching private key for the server.
@@ -164,6 +217,7 @@ func DefaultEVMConfig() *EVMConfig {
 	return &EVMConfig{
 		Tracer:         DefaultEVMTracer,
 		MaxTxGasWanted: DefaultMaxTxGasWanted,
+		TxPool:         *DefaultTxPoolConfig(),
 	}
 }
 
@@ -173,6 +227,54 @@ func (c EVMConfig) Validate() error {
 		return fmt.Errorf("invalid tracer type %s, available types: %v", c.Tracer, evmTracers)
 	}
 
+	return c.TxPool.Validate()
+}
+
+// DefaultTxPoolConfig returns the default EVM transaction pool configuration
+func DefaultTxPoolConfig() *TxPoolConfig {
+	return &TxPoolConfig{
+		PriceBump:              DefaultTxPoolPriceBump,
+		AccountSlots:           DefaultTxPoolAccountSlots,
+		GlobalSlots:            DefaultTxPoolGlobalSlots,
+		AccountQueue:           DefaultTxPoolAccountQueue,
+		GlobalQueue:            DefaultTxPoolGlobalQueue,
+		Lifetime:               DefaultTxPoolLifetime,
+		EvictionInterval:       DefaultTxPoolEvictionInterval,
+		ProcessQueueInterval:   DefaultTxPoolProcessQueueInterval,
+		ProcessPendingInterval: DefaultTxPoolProcessPendingInterval,
+	}
+}
+
+// Validate returns an error if the transaction pool limits or intervals are invalid.
+func (c TxPoolConfig) Validate() error {
+	if c.PriceBump == 0 {
+		return fmt.Errorf("EVM txpool price-bump must be positive")
+	}
+
+	if c.AccountSlots == 0 || c.GlobalSlots == 0 {
+		return fmt.Errorf("EVM txpool account-slots and global-slots must be positive")
+	}
+
+	if c.AccountSlots > c.GlobalSlots {
+		return fmt.Errorf("EVM txpool account-slots cannot exceed global-slots")
+	}
+
+	if c.AccountQueue == 0 || c.GlobalQueue == 0 {
+		return fmt.Errorf("EVM txpool account-queue and global-queue must be positive")
+	}
+
+	if c.AccountQueue > c.GlobalQueue {
+		return fmt.Errorf("EVM txpool account-queue cannot exceed global-queue")
+	}
+
+	if c.Lifetime <= 0 {
+		return fmt.Errorf("EVM txpool lifetime must be positive")
+	}
+
+	if c.EvictionInterval <= 0 || c.ProcessQueueInterval <= 0 || c.ProcessPendingInterval <= 0 {
+		return fmt.Errorf("EVM txpool eviction and process intervals must be positive")
+	}
+
 	return nil
 }
 
@@ -183,7 +285,7 @@ func GetDefaultAPINamespaces() []string {
 
 // GetAPINamespaces returns the all the available JSON-RPC API namespaces.
 func GetAPINamespaces() []string {
-	return []string{"web3", "eth", "personal", "net", "txpool", "debug", "miner"}
+	return []string{"web3", "eth", "personal", "net", "txpool", "debug", "miner", "trace", "stratos"}
 }
 
 // DefaultJSONRPCConfig returns an EVM config with the JSON-RPC API enabled by default
@@ -202,6 +304,8 @@ func DefaultJSONRPCConfig() *JSONRPCConfig {
 		LogsCap:         DefaultLogsCap,
 		HTTPTimeout:     DefaultHTTPTimeout,
 		HTTPIdleTimeout: DefaultHTTPIdleTimeout,
+		TxPoolJournal:   DefaultTxPoolJournal,
+		TxPoolRejournal: DefaultTxPoolRejournal,
 	}
 }
 
@@ -243,6 +347,10 @@ func (c JSONRPCConfig) Validate() error {
 		return fmt.Errorf("JSON-RPC HTTP idle timeout duration cannot be negative")
 	}
 
+	if c.TxPoolJournal != "" && c.TxPoolRejournal < time.Second {
+		return fmt.Errorf("JSON-RPC txpool rejournal interval cannot be less than 1s")
+	}
+
 	// check for duplicates
 	seenAPIs := make(map[string]bool)
 	for _, api := range c.API {
@@ -293,6 +401,17 @@ func GetConfig(v *viper.Viper) (Config, error) {
 		EVM: EVMConfig{
 			Tracer:         v.GetString("evm.tracer"),
 			MaxTxGasWanted: v.GetUint64("evm.max-tx-gas-wanted"),
+			TxPool: TxPoolConfig{
+				PriceBump:              v.GetUint64("evm.txpool.price-bump"),
+				AccountSlots:           v.GetUint64("evm.txpool.account-slots"),
+				GlobalSlots:            v.GetUint64("evm.txpool.global-slots"),
+				AccountQueue:           v.GetUint64("evm.txpool.account-queue"),
+				GlobalQueue:            v.GetUint64("evm.txpool.global-queue"),
+				Lifetime:               v.GetDuration("evm.txpool.lifetime"),
+				EvictionInterval:       v.GetDuration("evm.txpool.eviction-interval"),
+				ProcessQueueInterval:   v.GetDuration("evm.txpool.process-queue-interval"),
+				ProcessPendingInterval: v.GetDuration("evm.txpool.process-pending-interval"),
+			},
 		},
 		JSONRPC: JSONRPCConfig{
 			Enable:          v.GetBool("json-rpc.enable"),
@@ -308,6 +427,8 @@ func GetConfig(v *viper.Viper) (Config, error) {
 			BlockRangeCap:   v.GetInt32("json-rpc.block-range-cap"),
 			HTTPTimeout:     v.GetDuration("json-rpc.http-timeout"),
 			HTTPIdleTimeout: v.GetDuration("json-rpc.http-idle-timeout"),
+			TxPoolJournal:   v.GetString("json-rpc.txpool-journal"),
+			TxPoolRejournal: v.GetDuration("json-rpc.txpool-rejournal"),
 		},
 		TLS: TLSConfig{
 			CertificatePath: v.GetString("tls.certificate-path"),
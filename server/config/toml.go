@@ -16,6 +16,35 @@ tracer = "{{ .EVM.Tracer }}"
 # MaxTxGasWanted defines the gas wanted for each eth tx returned in ante handler in check tx mode.
 max-tx-gas-wanted = {{ .EVM.MaxTxGasWanted }}
 
+[evm.txpool]
+
+# PriceBump is the minimum price bump percentage to replace an already existing transaction (nonce).
+price-bump = {{ .EVM.TxPool.PriceBump }}
+
+# AccountSlots is the number of executable transaction slots guaranteed per account.
+account-slots = {{ .EVM.TxPool.AccountSlots }}
+
+# GlobalSlots is the maximum number of executable transaction slots for all accounts.
+global-slots = {{ .EVM.TxPool.GlobalSlots }}
+
+# AccountQueue is the maximum number of non-executable transaction slots permitted per account.
+account-queue = {{ .EVM.TxPool.AccountQueue }}
+
+# GlobalQueue is the maximum number of non-executable transaction slots for all accounts.
+global-queue = {{ .EVM.TxPool.GlobalQueue }}
+
+# Lifetime is the maximum amount of time non-executable transactions are queued.
+lifetime = "{{ .EVM.TxPool.Lifetime }}"
+
+# EvictionInterval is the time interval to check for evictable transactions.
+eviction-interval = "{{ .EVM.TxPool.EvictionInterval }}"
+
+# ProcessQueueInterval is the time interval to promote executable queued transactions to pending.
+process-queue-interval = "{{ .EVM.TxPool.ProcessQueueInterval }}"
+
+# ProcessPendingInterval is the time interval to broadcast pending transactions to the Tendermint mempool.
+process-pending-interval = "{{ .EVM.TxPool.ProcessPendingInterval }}"
+
 ###############################################################################
 ###                           JSON RPC Configuration                        ###
 ###############################################################################
@@ -32,7 +61,7 @@ address = "{{ .JSONRPC.Address }}"
 ws-address = "{{ .JSONRPC.WsAddress }}"
 
 # API defines a list of JSON-RPC namespaces that should be enabled
-# Example: "eth,txpool,personal,net,debug,web3"
+# Example: "eth,txpool,personal,net,debug,web3,stratos"
 api = "{{range $index, $elmt := .JSONRPC.API}}{{if $index}},{{$elmt}}{{else}}{{$elmt}}{{end}}{{end}}"
 
 # GasCap sets a cap on gas that can be used in eth_call/estimateGas (0=infinite). Default: 25,000,000.
@@ -53,7 +82,7 @@ feehistory-cap = {{ .JSONRPC.FeeHistoryCap }}
 # LogsCap defines the max number of results can be returned from single 'eth_getLogs' query.
 logs-cap = {{ .JSONRPC.LogsCap }}
 
-# BlockRangeCap defines the max block range allowed for 'eth_getLogs' query.
+# BlockRangeCap defines the max block range allowed for 'eth_getLogs' and 'trace_filter' queries.
 block-range-cap = {{ .JSONRPC.BlockRangeCap }}
 
 # HTTPTimeout is the read/write timeout of http json-rpc server.
@@ -62,6 +91,13 @@ http-timeout = "{{ .JSONRPC.HTTPTimeout }}"
 # HTTPIdleTimeout is the idle timeout of http json-rpc server.
 http-idle-timeout = "{{ .JSONRPC.HTTPIdleTimeout }}"
 
+# TxPoolJournal is the disk journal for locally submitted transactions to survive node restarts.
+# A relative path is resolved against the node data directory, an empty value disables the journal.
+txpool-journal = "{{ .JSONRPC.TxPoolJournal }}"
+
+# TxPoolRejournal is the time interval to regenerate the local transaction journal.
+txpool-rejournal = "{{ .JSONRPC.TxPoolRejournal }}"
+
 ###############################################################################
 ###                             TLS Configuration                           ###
 ###############################################################################
@@ -44,12 +44,24 @@ const (
 	JSONRPCBlockRangeCap   = "json-rpc.block-range-cap"
 	JSONRPCHTTPTimeout     = "json-rpc.http-timeout"
 	JSONRPCHTTPIdleTimeout = "json-rpc.http-idle-timeout"
+	JSONRPCTxPoolJournal   = "json-rpc.txpool-journal"
+	JSONRPCTxPoolRejournal = "json-rpc.txpool-rejournal"
 )
 
 // EVM flags
 const (
 	EVMTracer         = "evm.tracer"
 	EVMMaxTxGasWanted = "evm.max-tx-gas-wanted"
+
+	EVMTxPoolPriceBump              = "evm.txpool.price-bump"
+	EVMTxPoolAccountSlots           = "evm.txpool.account-slots"
+	EVMTxPoolGlobalSlots            = "evm.txpool.global-slots"
+	EVMTxPoolAccountQueue           = "evm.txpool.account-queue"
+	EVMTxPoolGlobalQueue            = "evm.txpool.global-queue"
+	EVMTxPoolLifetime               = "evm.txpool.lifetime"
+	EVMTxPoolEvictionInterval       = "evm.txpool.eviction-interval"
+	EVMTxPoolProcessQueueInterval   = "evm.txpool.process-queue-interval"
+	EVMTxPoolProcessPendingInterval = "evm.txpool.process-pending-interval"
 )
 
 // TLS flags
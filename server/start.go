@@ -160,9 +160,20 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().Duration(srvflags.JSONRPCHTTPIdleTimeout, config.DefaultHTTPIdleTimeout, "Sets a idle timeout for json-rpc http server (0=infinite)")
 	cmd.Flags().Int32(srvflags.JSONRPCLogsCap, config.DefaultLogsCap, "Sets the max number of results can be returned from single `eth_getLogs` query")
 	cmd.Flags().Int32(srvflags.JSONRPCBlockRangeCap, config.DefaultBlockRangeCap, "Sets the max block range allowed for `eth_getLogs` query")
+	cmd.Flags().String(srvflags.JSONRPCTxPoolJournal, config.DefaultTxPoolJournal, "Disk journal for local transactions to survive node restarts, relative to the node data directory (empty=disabled)")
+	cmd.Flags().Duration(srvflags.JSONRPCTxPoolRejournal, config.DefaultTxPoolRejournal, "Time interval to regenerate the local transaction journal")
 
 	cmd.Flags().String(srvflags.EVMTracer, config.DefaultEVMTracer, "the EVM tracer type to collect execution traces from the EVM transaction execution (json|struct|access_list|markdown)")
 	cmd.Flags().Uint64(srvflags.EVMMaxTxGasWanted, config.DefaultMaxTxGasWanted, "the gas wanted for each eth tx returned in ante handler in check tx mode")
+	cmd.Flags().Uint64(srvflags.EVMTxPoolPriceBump, config.DefaultTxPoolPriceBump, "Minimum price bump percentage to replace an already existing EVM transaction (nonce)")
+	cmd.Flags().Uint64(srvflags.EVMTxPoolAccountSlots, config.DefaultTxPoolAccountSlots, "Number of executable EVM transaction slots guaranteed per account")
+	cmd.Flags().Uint64(srvflags.EVMTxPoolGlobalSlots, config.DefaultTxPoolGlobalSlots, "Maximum number of executable EVM transaction slots for all accounts")
+	cmd.Flags().Uint64(srvflags.EVMTxPoolAccountQueue, config.DefaultTxPoolAccountQueue, "Maximum number of non-executable EVM transaction slots permitted per account")
+	cmd.Flags().Uint64(srvflags.EVMTxPoolGlobalQueue, config.DefaultTxPoolGlobalQueue, "Maximum number of non-executable EVM transaction slots for all accounts")
+	cmd.Flags().Duration(srvflags.EVMTxPoolLifetime, config.DefaultTxPoolLifetime, "Maximum amount of time non-executable EVM transactions are queued")
+	cmd.Flags().Duration(srvflags.EVMTxPoolEvictionInterval, config.DefaultTxPoolEvictionInterval, "Time interval to check for evictable EVM transactions")
+	cmd.Flags().Duration(srvflags.EVMTxPoolProcessQueueInterval, config.DefaultTxPoolProcessQueueInterval, "Time interval to promote executable queued EVM transactions to pending")
+	cmd.Flags().Duration(srvflags.EVMTxPoolProcessPendingInterval, config.DefaultTxPoolProcessPendingInterval, "Time interval to broadcast pending EVM transactions to the Tendermint mempool")
 
 	cmd.Flags().String(srvflags.TLSCertPath, "", "the cert.pem file path for the server TLS configuration")
 	cmd.Flags().String(srvflags.TLSKeyPath, "", "the key.pem file path for the server TLS configuration")
@@ -70,7 +70,9 @@ func SetupWithGenesisNodeSet(t *testing.T,
 	validators := make([]stakingtypes.Validator, 0, len(valSet.Validators))
 	delegations := make([]stakingtypes.Delegation, 0, len(valSet.Validators))
 
-	validatorBondAmt := sdkmath.NewInt(1000000)
+	// the app raises sdk.DefaultPowerReduction to 1e15, a smaller bond gives the validators no voting power and the
+	// staking InitGenesis fails with an empty validator set
+	validatorBondAmt := sdk.TokensFromConsensusPower(1, sdk.DefaultPowerReduction)
 
 	for _, val := range valSet.Validators {
 		pk, err := cryptocodec.FromTmPubKeyInterface(val.PubKey)
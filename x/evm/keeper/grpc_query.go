@@ -16,6 +16,7 @@ import (
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 
 	ethparams "github.com/ethereum/go-ethereum/params"
 	"github.com/stratosnet/stratos-chain/x/evm/tracers"
@@ -230,9 +231,15 @@ func (k Keeper) EthCall(c context.Context, req *types.EthCallRequest) (*types.Ms
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
+	if err = cfg.SetOverrides(req.Overrides, req.BlockOverrides); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 
 	// ApplyMessageWithConfig expect correct nonce set in msg
-	nonce := k.GetNonce(ctx, args.GetFrom())
+	nonce, overridden := cfg.StateOverrides.GetNonce(args.GetFrom())
+	if !overridden {
+		nonce = k.GetNonce(ctx, args.GetFrom())
+	}
 	args.Nonce = (*hexutil.Uint64)(&nonce)
 
 	msg, err := args.ToMessage(req.GasCap, cfg.BaseFee)
@@ -302,9 +309,15 @@ func (k Keeper) EstimateGas(c context.Context, req *types.EthCallRequest) (*type
 	if err != nil {
 		return nil, status.Error(codes.Internal, "failed to load evm config")
 	}
+	if err = cfg.SetOverrides(req.Overrides, req.BlockOverrides); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 
 	// ApplyMessageWithConfig expect correct nonce set in msg
-	nonce := k.GetNonce(ctx, args.GetFrom())
+	nonce, overridden := cfg.StateOverrides.GetNonce(args.GetFrom())
+	if !overridden {
+		nonce = k.GetNonce(ctx, args.GetFrom())
+	}
 	args.Nonce = (*hexutil.Uint64)(&nonce)
 
 	txConfig := statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash().Bytes()))
@@ -370,6 +383,86 @@ func (k Keeper) EstimateGas(c context.Context, req *types.EthCallRequest) (*type
 	return &types.EstimateGasResponse{Gas: hi}, nil
 }
 
+// CreateAccessList implements eth_createAccessList rpc api.
+// The call is executed with an access list tracer until the access list stops growing.
+func (k Keeper) CreateAccessList(c context.Context, req *types.EthCallRequest) (*types.CreateAccessListResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var args types.TransactionArgs
+	err := json.Unmarshal(req.Args, &args)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	cfg, err := k.EVMConfig(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if err = cfg.SetOverrides(req.Overrides, req.BlockOverrides); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// ApplyMessageWithConfig expect correct nonce set in msg
+	from := args.GetFrom()
+	nonce, overridden := cfg.StateOverrides.GetNonce(from)
+	if !overridden {
+		nonce = k.GetNonce(ctx, from)
+	}
+	args.Nonce = (*hexutil.Uint64)(&nonce)
+
+	// the sender, the recipient and the precompiles are always warm, so they are excluded from the list
+	var to common.Address
+	if args.To != nil {
+		to = *args.To
+	} else {
+		to = crypto.CreateAddress(from, nonce)
+	}
+	rules := cfg.ChainConfig.Rules(big.NewInt(ctx.BlockHeight()), cfg.ChainConfig.MergeNetsplitBlock != nil)
+	precompiles := vm.ActivePrecompiles(rules)
+
+	var initialList ethtypes.AccessList
+	if args.AccessList != nil {
+		initialList = *args.AccessList
+	}
+	prevTracer := logger.NewAccessListTracer(initialList, from, to, precompiles)
+
+	txConfig := statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash().Bytes()))
+	for {
+		accessList := prevTracer.AccessList()
+		args.AccessList = &accessList
+
+		msg, err := args.ToMessage(req.GasCap, cfg.BaseFee)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		tracer := logger.NewAccessListTracer(accessList, from, to, precompiles)
+		// pass false to not commit
+		res, err := k.ApplyAutoMessageWithConfig(ctx, msg, tracer, false, cfg, txConfig)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		if tracer.Equal(prevTracer) {
+			accessListBz, err := json.Marshal(accessList)
+			if err != nil {
+				return nil, status.Error(codes.Internal, err.Error())
+			}
+			return &types.CreateAccessListResponse{
+				AccessList: accessListBz,
+				GasUsed:    res.GasUsed,
+				VmError:    res.VmError,
+				Ret:        res.Ret,
+			}, nil
+		}
+		prevTracer = tracer
+	}
+}
+
 // TraceTx configures a new tracer according to the provided configuration, and
 // executes the given message in the provided environment. The return value will
 // be tracer dependent.
@@ -419,10 +512,17 @@ func (k Keeper) TraceTx(c context.Context, req *types.QueryTraceTxRequest) (*typ
 		txConfig.LogIndex += uint(len(res.Logs))
 	}
 
+	// overrides only apply to the traced transaction, predecessors are replayed as they were
+	traceCfg := *cfg
+	if err = traceCfg.SetOverrides(req.StateOverrides, req.BlockOverrides); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
 	tx := req.Msg.AsTransaction()
 	txConfig.TxHash = tx.Hash()
-	txConfig.TxIndex++
-	result, _, err := k.traceTx(ctx, cfg, txConfig, signer, tx, req.TraceConfig, false)
+	// the traced transaction follows its predecessors in the block, without predecessors it is the first one
+	txConfig.TxIndex = uint(len(req.Predecessors))
+	result, _, err := k.traceTx(ctx, &traceCfg, txConfig, req.BlockNumber, signer, tx, req.TraceConfig, false)
 	if err != nil {
 		// error will be returned with detail status from traceTx
 		return nil, err
@@ -476,7 +576,7 @@ func (k Keeper) TraceBlock(c context.Context, req *types.QueryTraceBlockRequest)
 		ethTx := tx.AsTransaction()
 		txConfig.TxHash = ethTx.Hash()
 		txConfig.TxIndex = uint(i)
-		traceResult, logIndex, err := k.traceTx(ctx, cfg, txConfig, signer, ethTx, req.TraceConfig, true)
+		traceResult, logIndex, err := k.traceTx(ctx, cfg, txConfig, req.BlockNumber, signer, ethTx, req.TraceConfig, true)
 		if err != nil {
 			result.Error = err.Error()
 			continue
@@ -501,6 +601,7 @@ func (k *Keeper) traceTx(
 	ctx sdk.Context,
 	cfg *types.EVMConfig,
 	txConfig statedb.TxConfig,
+	blockNumber int64,
 	signer ethtypes.Signer,
 	tx *ethtypes.Transaction,
 	traceConfig *types.TraceConfig,
@@ -535,13 +636,18 @@ func (k *Keeper) traceTx(
 		}
 
 		tCtx := &tracers.Context{
-			BlockHash: txConfig.BlockHash,
-			TxIndex:   int(txConfig.TxIndex),
-			TxHash:    txConfig.TxHash,
+			BlockHash:   txConfig.BlockHash,
+			BlockNumber: big.NewInt(blockNumber),
+			TxIndex:     int(txConfig.TxIndex),
+			TxHash:      txConfig.TxHash,
 		}
 
 		// Construct the JavaScript tracer to execute with
-		if tracer, err = tracers.New(traceConfig.Tracer, tCtx, nil); err != nil {
+		var tracerConfig json.RawMessage
+		if traceConfig.TracerJsonConfig != "" {
+			tracerConfig = json.RawMessage(traceConfig.TracerJsonConfig)
+		}
+		if tracer, err = tracers.New(traceConfig.Tracer, tCtx, tracerConfig); err != nil {
 			return nil, 0, status.Error(codes.Internal, err.Error())
 		}
 
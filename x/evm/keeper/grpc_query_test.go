@@ -0,0 +1,349 @@
+package keeper_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"math/big"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	stratosapp "github.com/stratosnet/stratos-chain/app"
+	"github.com/stratosnet/stratos-chain/x/evm/types"
+)
+
+var (
+	// contract runtime codes returning a single word
+	sloadCode     = hexutil.Bytes(common.FromHex("0x60005460005260206000f3")) // SLOAD(0)
+	numberCode    = hexutil.Bytes(common.FromHex("0x4360005260206000f3"))     // NUMBER
+	timestampCode = hexutil.Bytes(common.FromHex("0x4260005260206000f3"))     // TIMESTAMP
+	balanceCode   = hexutil.Bytes(common.FromHex("0x333160005260206000f3"))   // BALANCE(CALLER)
+
+	contractAddr = common.HexToAddress("0x00000000000000000000000000000000000000c0")
+)
+
+// ethCall calls the contract from the sender with the json encoded state and block overrides
+func ethCall(t *testing.T, stApp *stratosapp.StratosApp, ctx sdk.Context, overrides types.StateOverride,
+	blockOverrides *types.BlockOverrides,
+) (*types.MsgEthereumTxResponse, error) {
+
+	args, err := json.Marshal(&types.TransactionArgs{From: &sender, To: &contractAddr})
+	require.NoError(t, err)
+	req := &types.EthCallRequest{Args: args, GasCap: testGasCap}
+	if overrides != nil {
+		req.Overrides, err = json.Marshal(overrides)
+		require.NoError(t, err)
+	}
+	if blockOverrides != nil {
+		req.BlockOverrides, err = json.Marshal(blockOverrides)
+		require.NoError(t, err)
+	}
+	return stApp.GetEVMKeeper().EthCall(sdk.WrapSDKContext(ctx), req)
+}
+
+// setCode deploys the runtime code at addr
+func setCode(t *testing.T, stApp *stratosapp.StratosApp, ctx sdk.Context, addr common.Address, code []byte) {
+	evmKeeper := stApp.GetEVMKeeper()
+	codeHash := ethcrypto.Keccak256(code)
+	evmKeeper.SetCode(ctx, codeHash, code)
+	account := evmKeeper.GetAccountOrEmpty(ctx, addr)
+	account.CodeHash = codeHash
+	require.NoError(t, evmKeeper.SetAccount(ctx, addr, account))
+}
+
+// sortAccessList sorts the access list by address, the access list tracer lists the addresses in no particular order
+func sortAccessList(accessList ethtypes.AccessList) ethtypes.AccessList {
+	sort.Slice(accessList, func(i, j int) bool {
+		return bytes.Compare(accessList[i].Address.Bytes(), accessList[j].Address.Bytes()) < 0
+	})
+	return accessList
+}
+
+func hashOf(i int64) common.Hash {
+	return common.BigToHash(big.NewInt(i))
+}
+
+func TestEthCallStateOverrides(t *testing.T) {
+	stApp, ctx := setupApp(t)
+	slot := hashOf(0)
+
+	// the overridden code runs on the overridden storage
+	storage := map[common.Hash]common.Hash{slot: hashOf(42)}
+	res, err := ethCall(t, stApp, ctx, types.StateOverride{
+		contractAddr: {Code: &sloadCode, State: &storage},
+	}, nil)
+	require.NoError(t, err)
+	require.False(t, res.Failed(), res.VmError)
+	require.Equal(t, hashOf(42).Bytes(), res.Ret)
+
+	// a state diff overrides single slots
+	res, err = ethCall(t, stApp, ctx, types.StateOverride{
+		contractAddr: {Code: &sloadCode, StateDiff: &map[common.Hash]common.Hash{slot: hashOf(7)}},
+	}, nil)
+	require.NoError(t, err)
+	require.Equal(t, hashOf(7).Bytes(), res.Ret)
+
+	// the overridden balance is seen by the call
+	balance := (*hexutil.Big)(big.NewInt(12345))
+	res, err = ethCall(t, stApp, ctx, types.StateOverride{
+		contractAddr: {Code: &balanceCode},
+		sender:       {Balance: &balance},
+	}, nil)
+	require.NoError(t, err)
+	require.Equal(t, hashOf(12345).Bytes(), res.Ret)
+
+	// the state and the state diff of an account cannot both be overridden
+	_, err = ethCall(t, stApp, ctx, types.StateOverride{
+		contractAddr: {Code: &sloadCode, State: &storage, StateDiff: &storage},
+	}, nil)
+	require.ErrorContains(t, err, types.ErrInvalidStateOverride.Error())
+
+	// the overrides are never committed
+	evmKeeper := stApp.GetEVMKeeper()
+	require.Empty(t, evmKeeper.GetCode(ctx, common.BytesToHash(evmKeeper.GetAccountOrEmpty(ctx, contractAddr).CodeHash)))
+	require.Equal(t, common.Hash{}, evmKeeper.GetState(ctx, contractAddr, slot))
+	require.Equal(t, initBalance.BigInt(), evmKeeper.GetBalance(ctx, sender))
+}
+
+func TestEthCallBlockOverrides(t *testing.T) {
+	stApp, ctx := setupApp(t)
+
+	number := (*hexutil.Big)(big.NewInt(1_000_000))
+	res, err := ethCall(t, stApp, ctx, types.StateOverride{contractAddr: {Code: &numberCode}},
+		&types.BlockOverrides{Number: number})
+	require.NoError(t, err)
+	require.Equal(t, hashOf(1_000_000).Bytes(), res.Ret)
+
+	timestamp := hexutil.Uint64(1_700_000_000)
+	res, err = ethCall(t, stApp, ctx, types.StateOverride{contractAddr: {Code: &timestampCode}},
+		&types.BlockOverrides{Time: &timestamp})
+	require.NoError(t, err)
+	require.Equal(t, hashOf(1_700_000_000).Bytes(), res.Ret)
+
+	// the block context is not overridden without block overrides
+	res, err = ethCall(t, stApp, ctx, types.StateOverride{contractAddr: {Code: &numberCode}}, nil)
+	require.NoError(t, err)
+	require.Equal(t, hashOf(ctx.BlockHeight()).Bytes(), res.Ret)
+}
+
+func TestTraceTxIndex(t *testing.T) {
+	stApp, ctx := setupApp(t)
+	evmKeeper := stApp.GetEVMKeeper()
+
+	// the tracer returns the index of the traced transaction in its block
+	traceConfig := &types.TraceConfig{
+		Tracer: "{result: function(ctx, db) { return ctx.txIndex }, fault: function(log, db) {}, step: function(log, db) {}}",
+	}
+	traceTx := func(predecessors ...*types.MsgEthereumTx) int {
+		cacheCtx, _ := ctx.CacheContext()
+		res, err := evmKeeper.TraceTx(sdk.WrapSDKContext(cacheCtx), &types.QueryTraceTxRequest{
+			Msg:          newSignedTx(t, stApp, ctx, uint64(len(predecessors)), contractAddr),
+			TraceConfig:  traceConfig,
+			Predecessors: predecessors,
+			BlockNumber:  ctx.BlockHeight(),
+			BlockHash:    common.Bytes2Hex(hashOf(1).Bytes()),
+			BlockTime:    ctx.BlockTime(),
+		})
+		require.NoError(t, err)
+		var txIndex int
+		require.NoError(t, json.Unmarshal(res.Data, &txIndex))
+		return txIndex
+	}
+
+	// the first transaction of a block has index 0
+	require.Equal(t, 0, traceTx())
+	require.Equal(t, 2, traceTx(newSignedTx(t, stApp, ctx, 0, contractAddr), newSignedTx(t, stApp, ctx, 1, contractAddr)))
+}
+
+func TestCreateAccessList(t *testing.T) {
+	stApp, ctx := setupApp(t)
+	evmKeeper := stApp.GetEVMKeeper()
+
+	// the contract reads its slot 1, the balance of another account and the identity precompile
+	otherAddr := common.HexToAddress("0x00000000000000000000000000000000000000dd")
+	setCode(t, stApp, ctx, contractAddr, common.FromHex("0x6001545073"+common.Bytes2Hex(otherAddr.Bytes())+"3150"+
+		"60006000600060006004615000fa5000"))
+
+	createAccessList := func(accessList *ethtypes.AccessList) *types.CreateAccessListResponse {
+		args, err := json.Marshal(&types.TransactionArgs{From: &sender, To: &contractAddr, AccessList: accessList})
+		require.NoError(t, err)
+		res, err := evmKeeper.CreateAccessList(sdk.WrapSDKContext(ctx), &types.EthCallRequest{Args: args, GasCap: testGasCap})
+		require.NoError(t, err)
+		require.Empty(t, res.VmError)
+		return res
+	}
+
+	// the sender, the recipient and the precompiles are warm, only the accessed slots of the recipient are listed
+	res := createAccessList(nil)
+	var accessList ethtypes.AccessList
+	require.NoError(t, json.Unmarshal(res.AccessList, &accessList))
+	require.Equal(t, ethtypes.AccessList{
+		{Address: contractAddr, StorageKeys: []common.Hash{hashOf(1)}},
+		{Address: otherAddr, StorageKeys: []common.Hash{}},
+	}, sortAccessList(accessList))
+
+	// the gas used is the gas of the call with the created access list, which creates the same list again
+	resWithList := createAccessList(&accessList)
+	require.Equal(t, res.GasUsed, resWithList.GasUsed)
+	var accessListAgain ethtypes.AccessList
+	require.NoError(t, json.Unmarshal(resWithList.AccessList, &accessListAgain))
+	require.Equal(t, sortAccessList(accessList), sortAccessList(accessListAgain))
+
+	// the entries of an initial access list are kept
+	unusedAddr := common.HexToAddress("0x00000000000000000000000000000000000000ee")
+	res = createAccessList(&ethtypes.AccessList{{Address: unusedAddr, StorageKeys: []common.Hash{hashOf(9)}}})
+	require.NoError(t, json.Unmarshal(res.AccessList, &accessList))
+	require.Contains(t, accessList, ethtypes.AccessTuple{Address: unusedAddr, StorageKeys: []common.Hash{hashOf(9)}})
+}
+
+func TestTraceTxFlatCallTracer(t *testing.T) {
+	stApp, ctx := setupApp(t)
+
+	// the contract calls a contract reverting
+	revertingAddr := common.HexToAddress("0x00000000000000000000000000000000000000c1")
+	setCode(t, stApp, ctx, revertingAddr, common.FromHex("0x60006000fd"))
+	setCode(t, stApp, ctx, contractAddr, common.FromHex("0x60006000600060006000"+
+		"73"+common.Bytes2Hex(revertingAddr.Bytes())+"615000f15000"))
+
+	msg := newSignedTx(t, stApp, ctx, 0, contractAddr)
+	res, err := stApp.GetEVMKeeper().TraceTx(sdk.WrapSDKContext(ctx), &types.QueryTraceTxRequest{
+		Msg: msg,
+		TraceConfig: &types.TraceConfig{
+			Tracer:           "flatCallTracer",
+			TracerJsonConfig: `{"convertParityErrors":true}`,
+		},
+		BlockNumber: ctx.BlockHeight(),
+		BlockHash:   common.Bytes2Hex(hashOf(1).Bytes()),
+		BlockTime:   ctx.BlockTime(),
+	})
+	require.NoError(t, err)
+
+	var traces []struct {
+		Action struct {
+			CallType string          `json:"callType"`
+			From     *common.Address `json:"from"`
+			To       *common.Address `json:"to"`
+		} `json:"action"`
+		BlockNumber         int64        `json:"blockNumber"`
+		Error               string       `json:"error"`
+		Subtraces           int          `json:"subtraces"`
+		TraceAddress        []int        `json:"traceAddress"`
+		TransactionHash     *common.Hash `json:"transactionHash"`
+		TransactionPosition int          `json:"transactionPosition"`
+		Type                string       `json:"type"`
+	}
+	require.NoError(t, json.Unmarshal(res.Data, &traces))
+	require.Len(t, traces, 2)
+
+	// the call of the transaction is followed by the nested call it made
+	require.Equal(t, "call", traces[0].Type)
+	require.Equal(t, "call", traces[0].Action.CallType)
+	require.Equal(t, sender, *traces[0].Action.From)
+	require.Equal(t, contractAddr, *traces[0].Action.To)
+	require.Empty(t, traces[0].TraceAddress)
+	require.Equal(t, 1, traces[0].Subtraces)
+	require.Empty(t, traces[0].Error)
+
+	require.Equal(t, contractAddr, *traces[1].Action.From)
+	require.Equal(t, revertingAddr, *traces[1].Action.To)
+	require.Equal(t, []int{0}, traces[1].TraceAddress)
+	require.Equal(t, 0, traces[1].Subtraces)
+	// the revert is reported as parity does
+	require.Equal(t, "Reverted", traces[1].Error)
+
+	for _, trace := range traces {
+		require.Equal(t, ctx.BlockHeight(), trace.BlockNumber)
+		require.Equal(t, msg.AsTransaction().Hash(), *trace.TransactionHash)
+		require.Equal(t, 0, trace.TransactionPosition)
+	}
+}
+
+// traceWithNativeTracer traces a transaction of the sender calling the contract with a native tracer
+func traceWithNativeTracer(t *testing.T, stApp *stratosapp.StratosApp, ctx sdk.Context, tracer, tracerConfig string,
+) (json.RawMessage, error) {
+
+	res, err := stApp.GetEVMKeeper().TraceTx(sdk.WrapSDKContext(ctx), &types.QueryTraceTxRequest{
+		Msg:         newSignedTx(t, stApp, ctx, 0, contractAddr),
+		TraceConfig: &types.TraceConfig{Tracer: tracer, TracerJsonConfig: tracerConfig},
+		BlockNumber: ctx.BlockHeight(),
+		BlockHash:   common.Bytes2Hex(hashOf(1).Bytes()),
+		BlockTime:   ctx.BlockTime(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.Data, nil
+}
+
+// prestateAccount holds the fields of an account of the prestate tracer result
+type prestateAccount struct {
+	Nonce   uint64                      `json:"nonce"`
+	Storage map[common.Hash]common.Hash `json:"storage"`
+}
+
+func TestTraceTxPrestateTracerDiffMode(t *testing.T) {
+	stApp, ctx := setupApp(t)
+
+	// the contract stores 5 in its slot 0 holding 1
+	setCode(t, stApp, ctx, contractAddr, common.FromHex("0x6005600055"))
+	stApp.GetEVMKeeper().SetState(ctx, contractAddr, hashOf(0), hashOf(1).Bytes())
+
+	// the prestate holds the accessed state before the transaction
+	data, err := traceWithNativeTracer(t, stApp, ctx, "prestateTracer", "")
+	require.NoError(t, err)
+	var prestate map[common.Address]prestateAccount
+	require.NoError(t, json.Unmarshal(data, &prestate))
+	require.Equal(t, hashOf(1), prestate[contractAddr].Storage[hashOf(0)])
+	require.Contains(t, prestate, sender)
+
+	// the diff mode holds the modified state only, before and after the transaction
+	data, err = traceWithNativeTracer(t, stApp, ctx, "prestateTracer", `{"diffMode":true}`)
+	require.NoError(t, err)
+	var diff struct {
+		Pre  map[common.Address]prestateAccount `json:"pre"`
+		Post map[common.Address]prestateAccount `json:"post"`
+	}
+	require.NoError(t, json.Unmarshal(data, &diff))
+	require.Equal(t, map[common.Hash]common.Hash{hashOf(0): hashOf(1)}, diff.Pre[contractAddr].Storage)
+	require.Equal(t, map[common.Hash]common.Hash{hashOf(0): hashOf(5)}, diff.Post[contractAddr].Storage)
+	require.Equal(t, uint64(0), diff.Pre[sender].Nonce)
+	require.Equal(t, uint64(1), diff.Post[sender].Nonce)
+}
+
+func TestTraceTxMuxTracer(t *testing.T) {
+	stApp, ctx := setupApp(t)
+	setCode(t, stApp, ctx, contractAddr, common.FromHex("0x6005600055"))
+
+	// every tracer of the mux tracer reports under its name
+	data, err := traceWithNativeTracer(t, stApp, ctx, "muxTracer", `{"callTracer":{},"prestateTracer":{"diffMode":true}}`)
+	require.NoError(t, err)
+	var results struct {
+		CallTracer struct {
+			Type string          `json:"type"`
+			From common.Address  `json:"from"`
+			To   *common.Address `json:"to"`
+		} `json:"callTracer"`
+		PrestateTracer struct {
+			Post map[common.Address]prestateAccount `json:"post"`
+		} `json:"prestateTracer"`
+	}
+	require.NoError(t, json.Unmarshal(data, &results))
+	require.Equal(t, "CALL", results.CallTracer.Type)
+	require.Equal(t, sender, results.CallTracer.From)
+	require.Equal(t, contractAddr, *results.CallTracer.To)
+	require.Equal(t, hashOf(5), results.PrestateTracer.Post[contractAddr].Storage[hashOf(0)])
+
+	// the mux tracer cannot be nested, and fails on unknown tracers
+	_, err = traceWithNativeTracer(t, stApp, ctx, "muxTracer", `{"muxTracer":{}}`)
+	require.Error(t, err)
+	_, err = traceWithNativeTracer(t, stApp, ctx, "muxTracer", `{"unknownTracer":{}}`)
+	require.Error(t, err)
+}
@@ -64,6 +64,9 @@ type Keeper struct {
 
 	msgServiceRouter *baseapp.MsgServiceRouter
 
+	// access to noz price history for the NOZPRICETWAP opcode
+	sdsKeeper types.SdsKeeper
+
 	// the address capable of executing a MsgUpdateParams message. Typically, this
 	// should be the x/gov module account.
 	authority string
@@ -121,6 +124,10 @@ func (k *Keeper) SetMsgServiceRouter(msgServiceRouter *baseapp.MsgServiceRouter)
 	k.msgServiceRouter = msgServiceRouter
 }
 
+func (k *Keeper) SetSdsKeeper(sdsKeeper types.SdsKeeper) {
+	k.sdsKeeper = sdsKeeper
+}
+
 func (k *Keeper) SetTracer(tracer string) {
 	k.tracer = tracer
 }
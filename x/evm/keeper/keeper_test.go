@@ -0,0 +1,105 @@
+package keeper_test
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	tmtypes "github.com/cometbft/cometbft/types"
+
+	sdkmath "cosmossdk.io/math"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	stratosapp "github.com/stratosnet/stratos-chain/app"
+	stratostestutil "github.com/stratosnet/stratos-chain/testutil"
+	stratos "github.com/stratosnet/stratos-chain/types"
+	jstracers "github.com/stratosnet/stratos-chain/x/evm/tracers/js"
+	nativetracers "github.com/stratosnet/stratos-chain/x/evm/tracers/native"
+	"github.com/stratosnet/stratos-chain/x/evm/types"
+)
+
+const (
+	chainID = "testchain_1-1"
+
+	testGasCap = uint64(25_000_000)
+)
+
+var (
+	senderPrivKey = mustGenerateKey()
+	sender        = ethcrypto.PubkeyToAddress(senderPrivKey.PublicKey)
+
+	initBalance = sdkmath.NewInt(100).MulRaw(stratos.StosToWei)
+)
+
+func TestMain(m *testing.M) {
+	config := stratos.GetConfig()
+	config.Seal()
+	jstracers.InitTracer()
+	nativetracers.InitTracer()
+	exitVal := m.Run()
+	os.Exit(exitVal)
+}
+
+func mustGenerateKey() *ecdsa.PrivateKey {
+	privKey, err := ethcrypto.GenerateKey()
+	if err != nil {
+		panic(err)
+	}
+	return privKey
+}
+
+// setupApp starts a chain with a funded sender account, and returns the context of the next block proposed by the
+// genesis validator
+func setupApp(t *testing.T) (*stratosapp.StratosApp, sdk.Context) {
+	valConsPubKey := ed25519.GenPrivKey().PubKey()
+	consPubKey, err := cryptocodec.ToTmPubKeyInterface(valConsPubKey)
+	require.NoError(t, err)
+	validator := tmtypes.NewValidator(consPubKey, 1)
+	valSet := tmtypes.NewValidatorSet([]*tmtypes.Validator{validator})
+
+	senderAddr := sdk.AccAddress(sender.Bytes())
+	accs := []authtypes.GenesisAccount{&authtypes.BaseAccount{Address: senderAddr.String()}}
+	balances := []banktypes.Balance{{Address: senderAddr.String(), Coins: sdk.Coins{stratos.NewCoin(initBalance)}}}
+	stApp := stratostestutil.SetupWithGenesisNodeSet(t, valSet, nil, nil, accs, chainID, false, balances...)
+
+	header := tmproto.Header{
+		Height:          stApp.LastBlockHeight() + 1,
+		ChainID:         chainID,
+		ProposerAddress: validator.Address,
+	}
+	stApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+	return stApp, stApp.BaseApp.NewContext(false, header)
+}
+
+// newSignedTx returns a legacy transaction of the sender calling to, signed for the chain of ctx
+func newSignedTx(t *testing.T, stApp *stratosapp.StratosApp, ctx sdk.Context, nonce uint64, to common.Address,
+) *types.MsgEthereumTx {
+
+	cfg, err := stApp.GetEVMKeeper().EVMConfig(ctx)
+	require.NoError(t, err)
+	signer := ethtypes.MakeSigner(cfg.ChainConfig, big.NewInt(ctx.BlockHeight()))
+	tx, err := ethtypes.SignNewTx(senderPrivKey, signer, &ethtypes.LegacyTx{
+		Nonce:    nonce,
+		To:       &to,
+		Gas:      100_000,
+		GasPrice: cfg.BaseFee,
+	})
+	require.NoError(t, err)
+
+	msg := &types.MsgEthereumTx{}
+	require.NoError(t, msg.FromEthereumTx(tx))
+	return msg
+}
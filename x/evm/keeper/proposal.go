@@ -60,6 +60,7 @@ func NewProposalCounsil(k *Keeper, ctx sdk.Context) (*ProposalCounsil, error) {
 		Transfer:           vm.Transfer,
 		GetHash:            k.GetHashFn(ctx),
 		ParseProtoFromData: k.ParseProtoFromDataFn(ctx),
+		NozPriceTWAP:       k.NozPriceTWAPFn(ctx),
 		Coinbase:           cfg.CoinBase,
 		GasLimit:           stratos.BlockGasLimit(ctx),
 		BlockNumber:        big.NewInt(ctx.BlockHeight()),
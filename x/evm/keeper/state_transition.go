@@ -86,6 +86,7 @@ func (k *Keeper) NewEVM(
 		GetHash:            k.GetHashFn(ctx),
 		RunSdkMsg:          k.RunSdkMsgFn(ctx, true),
 		ParseProtoFromData: k.ParseProtoFromDataFn(ctx),
+		NozPriceTWAP:       k.NozPriceTWAPFn(ctx),
 		Coinbase:           cfg.CoinBase,
 		GasLimit:           stratos.BlockGasLimit(ctx),
 		BlockNumber:        big.NewInt(ctx.BlockHeight()),
@@ -93,6 +94,7 @@ func (k *Keeper) NewEVM(
 		Difficulty:         big.NewInt(0), // unused. Only required in PoW context
 		BaseFee:            cfg.BaseFee,
 	}
+	cfg.BlockOverrides.Apply(&blockCtx)
 
 	txCtx := vm.NewEVMTxContext(msg)
 	if tracer == nil {
@@ -237,6 +239,48 @@ func (k *Keeper) ParseProtoFromDataFn(ctx sdk.Context) vm.ParseProtoFromDataFunc
 	}
 }
 
+// NozPriceTWAPFn get time weighted average noz price for the abi encoded window
+func (k *Keeper) NozPriceTWAPFn(ctx sdk.Context) vm.NozPriceTWAPFunc {
+	return func(data []byte, gas uint64) ([]byte, uint64, error) {
+		if k.sdsKeeper == nil {
+			return []byte{}, gas, errors.Wrapf(sdkerrors.ErrUnknownRequest, "sds keeper not set")
+		}
+
+		uintTy, _ := abi.NewType("uint256", "", nil)
+		arguments := abi.Arguments{
+			{
+				Type: uintTy,
+			},
+		}
+
+		results, err := arguments.Unpack(data)
+		if err != nil {
+			return []byte{}, gas, err
+		}
+		window, ok := results[0].(*big.Int)
+		if !ok || !window.IsInt64() {
+			return []byte{}, gas, errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid window")
+		}
+
+		gasBefore := ctx.GasMeter().GasConsumed()
+		price, err := k.sdsKeeper.GetNozPriceTWAP(ctx, window.Int64())
+		gasUsed := types.Max(ctx.GasMeter().GasConsumed()-gasBefore, 2500)
+		if gas < gasUsed {
+			return []byte{}, 0, errors.Wrap(types.ErrGasOverflow, "noz price twap")
+		}
+		if err != nil {
+			return []byte{}, gas - gasUsed, err
+		}
+
+		res, err := arguments.Pack(price.BigInt())
+		if err != nil {
+			return []byte{}, gas - gasUsed, err
+		}
+
+		return res, gas - gasUsed, nil
+	}
+}
+
 // RunSdkMsg execute cosmos msg from payload (NOTE: simulate=true always in smart contracts!!!)
 func (k *Keeper) RunSdkMsgFn(ctx sdk.Context, simulate bool) vm.RunSdkMsgFunc {
 	return func(from common.Address, data []byte, gas uint64) ([]byte, uint64, error) {
@@ -501,6 +545,9 @@ func (k *Keeper) ApplyMessageWithConfig(ctx sdk.Context, msg core.Message, trace
 	}
 
 	stateDB := statedb.New(ctx, k, txConfig)
+	if err := cfg.StateOverrides.Apply(stateDB); err != nil {
+		return nil, errors.Wrap(types.ErrInvalidStateOverride, err.Error())
+	}
 	evm := k.NewEVM(ctx, msg, cfg, tracer, stateDB)
 
 	defer func() { evm.Restore() }()
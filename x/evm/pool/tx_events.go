@@ -0,0 +1,28 @@
+package pool
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// SenderEventType is the kind of change reported by a SenderEvent.
+type SenderEventType string
+
+const (
+	// SenderEventNonceGap is posted when a transaction is added with a nonce above
+	// the next nonce the pool expects from its sender.
+	SenderEventNonceGap SenderEventType = "nonceGap"
+	// SenderEventReplaced is posted when a transaction replaces a pooled
+	// transaction with the same sender and nonce.
+	SenderEventReplaced SenderEventType = "replaced"
+)
+
+// SenderEvent is posted by the pool when a transaction of a sender can't be
+// executed right away, or replaces one of the sender transactions.
+type SenderEvent struct {
+	Type     SenderEventType
+	Sender   common.Address
+	Tx       *types.Transaction // Transaction added to the pool
+	Replaced *types.Transaction // Transaction dropped in favor of Tx, only set for SenderEventReplaced
+	Expected uint64             // Next nonce expected from the sender, only set for SenderEventNonceGap
+}
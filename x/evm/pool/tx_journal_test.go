@@ -0,0 +1,141 @@
+package pool
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+var testSigner = types.LatestSignerForChainID(big.NewInt(2048))
+
+func newSignedTx(t *testing.T, key *ecdsa.PrivateKey, nonce uint64) *types.Transaction {
+	to := common.HexToAddress("0x0000000000000000000000000000000000000001")
+	tx, err := types.SignNewTx(key, testSigner, &types.DynamicFeeTx{
+		ChainID:   big.NewInt(2048),
+		Nonce:     nonce,
+		GasTipCap: big.NewInt(1),
+		GasFeeCap: big.NewInt(1e9),
+		Gas:       21000,
+		To:        &to,
+		Value:     big.NewInt(1),
+	})
+	require.NoError(t, err)
+	return tx
+}
+
+// loadJournal simulates a node start by replaying the journal at the given path,
+// re-journaling every transaction like TxPool.Add does.
+func loadJournal(t *testing.T, path string) (*txJournal, []*types.Transaction) {
+	journal := newTxJournal(path)
+
+	var loaded []*types.Transaction
+	err := journal.load(func(txs []*types.Transaction) []error {
+		for _, tx := range txs {
+			loaded = append(loaded, tx)
+			require.NoError(t, journal.insert(tx))
+		}
+		return make([]error, len(txs))
+	})
+	require.NoError(t, err)
+	return journal, loaded
+}
+
+func txHashes(txs []*types.Transaction) []common.Hash {
+	hashes := make([]common.Hash, len(txs))
+	for i, tx := range txs {
+		hashes[i] = tx.Hash()
+	}
+	return hashes
+}
+
+func TestJournalRestartRecovery(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	path := filepath.Join(t.TempDir(), "transactions.rlp")
+
+	// First start: no journal on disk yet, nothing is replayed
+	journal, loaded := loadJournal(t, path)
+	require.Empty(t, loaded)
+	require.NoError(t, journal.rotate(nil))
+
+	// A pending tx and a nonce-gapped queued tx are submitted
+	txs := []*types.Transaction{newSignedTx(t, key, 0), newSignedTx(t, key, 5)}
+	for _, tx := range txs {
+		require.NoError(t, journal.insert(tx))
+	}
+
+	// The node goes down without a chance to close or rotate the journal
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+
+	// Restart: every submitted tx is replayed, and replaying them doesn't grow the journal
+	journal, loaded = loadJournal(t, path)
+	require.Equal(t, txHashes(txs), txHashes(loaded))
+
+	after, err := os.Stat(path)
+	require.NoError(t, err)
+	require.Equal(t, info.Size(), after.Size())
+	require.ErrorIs(t, journal.insert(txs[0]), errNoActiveJournal)
+}
+
+func TestJournalRotate(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	path := filepath.Join(t.TempDir(), "transactions.rlp")
+
+	journal := newTxJournal(path)
+	require.NoError(t, journal.rotate(nil))
+	for nonce := uint64(0); nonce < 3; nonce++ {
+		require.NoError(t, journal.insert(newSignedTx(t, key, nonce)))
+	}
+
+	// Only the transactions still known by the pool survive the rotation
+	remaining := types.Transactions{newSignedTx(t, key, 2)}
+	require.NoError(t, journal.rotate(map[common.Address]types.Transactions{
+		crypto.PubkeyToAddress(key.PublicKey): remaining,
+	}))
+
+	// Transactions added after the rotation are appended to the new journal
+	added := newSignedTx(t, key, 3)
+	require.NoError(t, journal.insert(added))
+	require.NoError(t, journal.close())
+
+	_, loaded := loadJournal(t, path)
+	require.Equal(t, []common.Hash{remaining[0].Hash(), added.Hash()}, txHashes(loaded))
+}
+
+func TestJournalCorruptedTail(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	path := filepath.Join(t.TempDir(), "transactions.rlp")
+
+	journal := newTxJournal(path)
+	require.NoError(t, journal.rotate(nil))
+	tx := newSignedTx(t, key, 0)
+	require.NoError(t, journal.insert(tx))
+	require.NoError(t, journal.close())
+
+	// Simulate a write interrupted by a crash
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0644)
+	require.NoError(t, err)
+	_, err = f.Write([]byte{0xf8, 0x6b, 0x02})
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	// The intact transactions are still recovered
+	var loaded []*types.Transaction
+	err = newTxJournal(path).load(func(txs []*types.Transaction) []error {
+		loaded = append(loaded, txs...)
+		return make([]error, len(txs))
+	})
+	require.Error(t, err)
+	require.Equal(t, []common.Hash{tx.Hash()}, txHashes(loaded))
+}
@@ -193,13 +193,18 @@ func (m *txSortedMap) Remove(nonce uint64) bool {
 // prevent getting into and invalid state. This is not something that should ever
 // happen but better to be self correcting than failing!
 func (m *txSortedMap) Ready(start uint64) types.Transactions {
+	return m.ReadyN(start, math.MaxInt)
+}
+
+// ReadyN works like Ready, but retrieves at most limit transactions.
+func (m *txSortedMap) ReadyN(start uint64, limit int) types.Transactions {
 	// Short circuit if no transactions are available
-	if m.index.Len() == 0 || (*m.index)[0] > start {
+	if m.index.Len() == 0 || (*m.index)[0] > start || limit <= 0 {
 		return nil
 	}
 	// Otherwise start accumulating incremental transactions
 	var ready types.Transactions
-	for next := (*m.index)[0]; m.index.Len() > 0 && (*m.index)[0] == next; next++ {
+	for next := (*m.index)[0]; m.index.Len() > 0 && (*m.index)[0] == next && len(ready) < limit; next++ {
 		ready = append(ready, m.items[next])
 		delete(m.items, next)
 		heap.Pop(m.index)
@@ -392,6 +397,11 @@ func (l *txList) Ready(start uint64) types.Transactions {
 	return l.txs.Ready(start)
 }
 
+// ReadyN works like Ready, but retrieves at most limit transactions.
+func (l *txList) ReadyN(start uint64, limit int) types.Transactions {
+	return l.txs.ReadyN(start, limit)
+}
+
 // Len returns the length of the transaction list.
 func (l *txList) Len() int {
 	return l.txs.Len()
@@ -12,6 +12,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
 	"github.com/ethereum/go-ethereum/log"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -24,17 +25,29 @@ import (
 )
 
 var (
-	evictionInterval       = time.Minute     // Time interval to check for evictable transactions
-	statsReportInterval    = 8 * time.Second // Time interval to report transaction pool stats
-	processQueueInterval   = 5 * time.Second // Time interval to process queue transactions in case if their ready and move to pending stage
-	processPendingInterval = 5 * time.Second // Time interval of pending tx broadcating to a tm mempool
+	statsReportInterval = 8 * time.Second // Time interval to report transaction pool stats
 )
 
+var (
+	// ErrAccountLimitExceeded is returned if a transaction would exceed the number
+	// of queued transactions allowed for its sender.
+	ErrAccountLimitExceeded = errors.New("account limit exceeded")
+)
+
+// Config are the configuration parameters of the transaction pool.
+type Config struct {
+	core.TxPoolConfig
+
+	EvictionInterval       time.Duration // Time interval to check for evictable transactions
+	ProcessQueueInterval   time.Duration // Time interval to process queue transactions in case if their ready and move to pending stage
+	ProcessPendingInterval time.Duration // Time interval of pending tx broadcating to a tm mempool
+}
+
 // TxPool contains all currently known transactions. Transactions
 // enter the pool when they are received from the local network or submitted
 // locally. They exit the pool when they are included in the blockchain.
 type TxPool struct {
-	config      core.TxPoolConfig
+	config      Config
 	srvCfg      config.Config
 	logger      log.Logger
 	clientCtx   client.Context
@@ -48,7 +61,11 @@ type TxPool struct {
 	pendingNonces    *txNoncer         // Pending state tracking virtual nonces
 	maxGasLimitCache uint64            // Current gas limit for transaction caps, only for cache
 
-	beats map[common.Address]time.Time // Last heartbeat from each known account
+	beats   map[common.Address]time.Time // Last heartbeat from each known account
+	journal *txJournal                   // Journal of local transaction to back up to disk
+
+	senderFeed event.Feed              // Feed of SenderEvent notifications
+	scope      event.SubscriptionScope // Subscription scope to unsubscribe all on shutdown
 
 	pending map[common.Address]*txList // All currently processable transactions
 	queue   map[common.Address]*txList // Queued but non-processable transactions
@@ -66,7 +83,7 @@ func catchInitPanic(err *error) {
 
 // NewTxPool creates a new transaction pool to gather, sort and filter inbound
 // transactions from the network.
-func NewTxPool(config core.TxPoolConfig, srvCfg config.Config, clientCtx client.Context, mempool mempl.Mempool, evmkeeper *evmkeeper.Keeper, evmCtx *evm.Context) (*TxPool, error) {
+func NewTxPool(config Config, srvCfg config.Config, clientCtx client.Context, mempool mempl.Mempool, evmkeeper *evmkeeper.Keeper, evmCtx *evm.Context) (*TxPool, error) {
 	pool := &TxPool{
 		config:           config,
 		srvCfg:           srvCfg,
@@ -83,6 +100,24 @@ func NewTxPool(config core.TxPoolConfig, srvCfg config.Config, clientCtx client.
 	}
 	pool.pendingNonces = newTxNoncer(pool.evmCtx, pool.evmkeeper)
 
+	// If journaling is enabled, load from disk and rotate the journal
+	if config.Journal != "" {
+		if pool.config.Rejournal < time.Second {
+			log.Warn("Sanitizing invalid txpool journal time", "provided", pool.config.Rejournal, "updated", time.Second)
+			pool.config.Rejournal = time.Second
+		}
+		pool.journal = newTxJournal(config.Journal)
+
+		if err := pool.journal.load(pool.addJournaled); err != nil {
+			log.Warn("Failed to load transaction journal", "err", err)
+		}
+		pool.mu.Lock()
+		if err := pool.journal.rotate(pool.local()); err != nil {
+			log.Warn("Failed to rotate transaction journal", "err", err)
+		}
+		pool.mu.Unlock()
+	}
+
 	go pool.eventLoop()
 
 	return pool, nil
@@ -94,15 +129,23 @@ func (pool *TxPool) eventLoop() {
 		prevPending, prevQueued int
 		// Start the stats reporting and transaction eviction tickers
 		report         = time.NewTicker(statsReportInterval)
-		evict          = time.NewTicker(evictionInterval)
-		processQueue   = time.NewTicker(processQueueInterval)
-		processPending = time.NewTicker(processPendingInterval)
+		evict          = time.NewTicker(pool.config.EvictionInterval)
+		processQueue   = time.NewTicker(pool.config.ProcessQueueInterval)
+		processPending = time.NewTicker(pool.config.ProcessPendingInterval)
+		journal        *time.Ticker
+		journalC       <-chan time.Time
 	)
 	defer report.Stop()
 	defer evict.Stop()
 	defer processQueue.Stop()
 	defer processPending.Stop()
 
+	if pool.journal != nil {
+		journal = time.NewTicker(pool.config.Rejournal)
+		journalC = journal.C
+		defer journal.Stop()
+	}
+
 	for {
 		select {
 		// Handle stats reporting ticks
@@ -145,6 +188,14 @@ func (pool *TxPool) eventLoop() {
 			pool.mu.Lock()
 			pool.processPending()
 			pool.mu.Unlock()
+
+		// Handle local transaction journal rotation
+		case <-journalC:
+			pool.mu.Lock()
+			if err := pool.journal.rotate(pool.local()); err != nil {
+				log.Warn("Failed to rotate local tx journal", "err", err)
+			}
+			pool.mu.Unlock()
 		}
 	}
 }
@@ -224,6 +275,20 @@ func (pool *TxPool) Nonce(addr common.Address) uint64 {
 	return pool.pendingNonces.get(addr)
 }
 
+// Config returns the effective configuration of the pool.
+func (pool *TxPool) Config() Config {
+	return pool.config
+}
+
+// Stats retrieves the current pool stats, namely the number of pending and the
+// number of queued (non-executable) transactions.
+func (pool *TxPool) Stats() (int, int) {
+	pool.mu.RLock()
+	defer pool.mu.RUnlock()
+
+	return pool.stats()
+}
+
 // stats retrieves the current pool stats, namely the number of pending and the
 // number of queued (non-executable) transactions.
 func (pool *TxPool) stats() (int, int) {
@@ -349,17 +414,29 @@ func (pool *TxPool) broadcastTx(tx *types.Transaction) error {
 // pending or queued one, it overwrites the previous transaction if its price is higher.
 func (pool *TxPool) Add(tx *types.Transaction) (replaced bool, err error) {
 	pool.mu.Lock()
-	defer pool.mu.Unlock()
+	replaced, ev, err := pool.add(tx)
+	pool.mu.Unlock()
 
+	// Notify the subscribers outside of the lock, they may call back into the pool
+	if ev != nil {
+		pool.senderFeed.Send(*ev)
+	}
+	return replaced, err
+}
+
+// add inserts a transaction into the pool, returning the event to be posted to the
+// sender subscribers if any.
+//
+// Note, this method assumes the pool lock is held!
+func (pool *TxPool) add(tx *types.Transaction) (bool, *SenderEvent, error) {
 	hash := tx.Hash()
 
 	if pool.Has(hash) {
 		log.Trace("Discarding already known transaction", "hash", hash)
-		return false, core.ErrAlreadyKnown
+		return false, nil, core.ErrAlreadyKnown
 	}
-	err = pool.validateTx(tx)
-	if err != nil {
-		return false, err
+	if err := pool.validateTx(tx); err != nil {
+		return false, nil, err
 	}
 
 	signer, _ := pool.getSigner()
@@ -370,19 +447,120 @@ func (pool *TxPool) Add(tx *types.Transaction) (replaced bool, err error) {
 		// Nonce already pending, check if required price bump is met
 		inserted, old := list.Add(tx, pool.config.PriceBump)
 		if !inserted {
-			return false, core.ErrReplaceUnderpriced
+			return false, nil, core.ErrReplaceUnderpriced
 		}
 		// New transaction is better, replace old one
+		var ev *SenderEvent
 		if old != nil {
 			pool.all.Remove(old.Hash())
+			ev = &SenderEvent{Type: SenderEventReplaced, Sender: from, Tx: tx, Replaced: old}
 		}
 		pool.all.Add(tx)
 		// Successful promotion, bump the heartbeat
 		pool.beats[from] = time.Now()
-		return old != nil, nil
+		pool.journalTx(tx)
+		return old != nil, ev, nil
+	}
+
+	// Look up what the transaction is about to replace or skip before it's queued
+	expected := pool.pendingNonces.get(from)
+	var old *types.Transaction
+	if list := pool.queue[from]; list != nil {
+		old = list.txs.Get(tx.Nonce())
+	}
+
+	replaced, err := pool.enqueueTx(tx, true)
+	if err != nil {
+		return false, nil, err
 	}
+	pool.journalTx(tx)
 
-	return pool.enqueueTx(tx, true)
+	var ev *SenderEvent
+	switch {
+	case replaced:
+		ev = &SenderEvent{Type: SenderEventReplaced, Sender: from, Tx: tx, Replaced: old}
+	case tx.Nonce() > expected:
+		ev = &SenderEvent{Type: SenderEventNonceGap, Sender: from, Tx: tx, Expected: expected}
+	}
+	return replaced, ev, nil
+}
+
+// SubscribeSenderEvents registers a subscription of SenderEvent and
+// starts sending event to the given channel.
+func (pool *TxPool) SubscribeSenderEvents(ch chan<- SenderEvent) event.Subscription {
+	return pool.scope.Track(pool.senderFeed.Subscribe(ch))
+}
+
+// Content retrieves the data content of the transaction pool, returning all the
+// pending as well as queued transactions, grouped by account and sorted by nonce.
+func (pool *TxPool) Content() (map[common.Address]types.Transactions, map[common.Address]types.Transactions) {
+	pool.mu.RLock()
+	defer pool.mu.RUnlock()
+
+	pending := make(map[common.Address]types.Transactions, len(pool.pending))
+	for addr, list := range pool.pending {
+		pending[addr] = list.Flatten()
+	}
+	queued := make(map[common.Address]types.Transactions, len(pool.queue))
+	for addr, list := range pool.queue {
+		queued[addr] = list.Flatten()
+	}
+	return pending, queued
+}
+
+// ContentFrom retrieves the data content of the transaction pool, returning the
+// pending as well as queued transactions of this address, sorted by nonce.
+func (pool *TxPool) ContentFrom(addr common.Address) (types.Transactions, types.Transactions) {
+	pool.mu.RLock()
+	defer pool.mu.RUnlock()
+
+	var pending types.Transactions
+	if list, ok := pool.pending[addr]; ok {
+		pending = list.Flatten()
+	}
+	var queued types.Transactions
+	if list, ok := pool.queue[addr]; ok {
+		queued = list.Flatten()
+	}
+	return pending, queued
+}
+
+// addJournaled replays the transactions loaded from the journal through Add.
+func (pool *TxPool) addJournaled(txs []*types.Transaction) []error {
+	errs := make([]error, len(txs))
+	for i, tx := range txs {
+		_, errs[i] = pool.Add(tx)
+	}
+	return errs
+}
+
+// journalTx adds the specified transaction to the local disk journal.
+//
+// Note, this method assumes the pool lock is held!
+func (pool *TxPool) journalTx(tx *types.Transaction) {
+	// Only journal if it's enabled
+	if pool.journal == nil {
+		return
+	}
+	if err := pool.journal.insert(tx); err != nil {
+		log.Warn("Failed to journal local transaction", "err", err)
+	}
+}
+
+// local retrieves all currently known transactions, grouped by origin account
+// and sorted by nonce. The returned transaction set is a copy and can be freely
+// modified by calling code.
+//
+// Note, this method assumes the pool lock is held!
+func (pool *TxPool) local() map[common.Address]types.Transactions {
+	txs := make(map[common.Address]types.Transactions)
+	for addr, list := range pool.pending {
+		txs[addr] = append(txs[addr], list.Flatten()...)
+	}
+	for addr, list := range pool.queue {
+		txs[addr] = append(txs[addr], list.Flatten()...)
+	}
+	return txs
 }
 
 // enqueueTx inserts a new transaction into the non-executable transaction queue.
@@ -396,11 +574,25 @@ func (pool *TxPool) enqueueTx(tx *types.Transaction, addAll bool) (bool, error)
 	if pool.queue[from] == nil {
 		pool.queue[from] = newTxList(false)
 	}
+	// Enforce the queue limits on new transactions, internal shuffles and
+	// replacements don't take any extra slot
+	if addAll && !pool.queue[from].Overlaps(tx) {
+		if uint64(pool.queue[from].Len()) >= pool.config.AccountQueue {
+			return false, ErrAccountLimitExceeded
+		}
+		if _, queued := pool.stats(); uint64(queued) >= pool.config.GlobalQueue {
+			return false, core.ErrTxPoolOverflow
+		}
+	}
 	inserted, old := pool.queue[from].Add(tx, pool.config.PriceBump)
 	if !inserted {
 		// An older transaction was better, discard this
 		return false, core.ErrReplaceUnderpriced
 	}
+	// Discard any previous transaction
+	if old != nil {
+		pool.all.Remove(old.Hash())
+	}
 	if addAll {
 		pool.all.Add(tx)
 	}
@@ -517,6 +709,7 @@ func (pool *TxPool) promoteExecutables(accounts []common.Address) []*types.Trans
 
 	sdkCtx := pool.evmCtx.GetSdkContext()
 	currentMaxGas, _ := pool.getMaxGasLimit()
+	pending, _ := pool.stats()
 
 	// Iterate over all accounts and promote any executable transactions
 	for _, addr := range accounts {
@@ -539,11 +732,14 @@ func (pool *TxPool) promoteExecutables(accounts []common.Address) []*types.Trans
 		}
 		log.Trace("Removed unpayable queued transactions", "count", len(drops))
 
-		// Gather all executable transactions and promote them
-		readies := list.Ready(pool.pendingNonces.get(addr))
+		// Gather all executable transactions and promote them, as long as the
+		// pending slots of the account and of the pool are not used up. The rest
+		// of them stays in the queue until some slots are released.
+		readies := list.ReadyN(pool.pendingNonces.get(addr), pool.pendingSlots(addr, pending))
 		for _, tx := range readies {
 			if pool.promoteTx(addr, tx) {
 				promoted = append(promoted, tx)
+				pending++
 			}
 		}
 		log.Trace("Promoted queued transactions", "count", len(promoted))
@@ -557,6 +753,28 @@ func (pool *TxPool) promoteExecutables(accounts []common.Address) []*types.Trans
 	return promoted
 }
 
+// pendingSlots returns the number of transactions of the given account that can
+// still be promoted to pending, given the total number of pending transactions.
+//
+// Note, this method assumes the pool lock is held!
+func (pool *TxPool) pendingSlots(addr common.Address, pending int) int {
+	if uint64(pending) >= pool.config.GlobalSlots {
+		return 0
+	}
+	slots := pool.config.GlobalSlots - uint64(pending)
+	if list := pool.pending[addr]; list != nil {
+		if uint64(list.Len()) >= pool.config.AccountSlots {
+			return 0
+		}
+		if free := pool.config.AccountSlots - uint64(list.Len()); free < slots {
+			slots = free
+		}
+	} else if pool.config.AccountSlots < slots {
+		slots = pool.config.AccountSlots
+	}
+	return int(slots)
+}
+
 // demoteUnexecutables removes invalid and processed transactions from the pools
 // executable/pending queue and any subsequent transactions that become unexecutable
 // are moved back into the future queue.
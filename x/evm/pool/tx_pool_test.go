@@ -0,0 +1,284 @@
+package pool
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/cometbft/cometbft/libs/log"
+	tmrpccore "github.com/cometbft/cometbft/rpc/core"
+	"github.com/cometbft/cometbft/state/txindex/null"
+	tmtypes "github.com/cometbft/cometbft/types"
+
+	sdkmath "cosmossdk.io/math"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	stratostestutil "github.com/stratosnet/stratos-chain/testutil"
+	stratos "github.com/stratosnet/stratos-chain/types"
+	"github.com/stratosnet/stratos-chain/x/evm"
+)
+
+func TestMain(m *testing.M) {
+	config := stratos.GetConfig()
+	config.Seal()
+	exitVal := m.Run()
+	os.Exit(exitVal)
+}
+
+// newTestPool returns a pool that only holds the in-memory state used by the queue
+// and pending bookkeeping, so it can run without a keeper
+func newTestPool(txPoolConfig core.TxPoolConfig) *TxPool {
+	return &TxPool{
+		config:        Config{TxPoolConfig: txPoolConfig},
+		signerCache:   testSigner,
+		pendingNonces: newTxNoncer(nil, nil),
+		beats:         make(map[common.Address]time.Time),
+		pending:       make(map[common.Address]*txList),
+		queue:         make(map[common.Address]*txList),
+		all:           newTxLookup(),
+	}
+}
+
+// newAppPool returns a pool backed by the EVM keeper of a new chain, along with the key of an account funded at
+// genesis
+func newAppPool(t *testing.T, txPoolConfig core.TxPoolConfig) (*TxPool, *ecdsa.PrivateKey) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	valConsPubKey := ed25519.GenPrivKey().PubKey()
+	consPubKey, err := cryptocodec.ToTmPubKeyInterface(valConsPubKey)
+	require.NoError(t, err)
+	valSet := tmtypes.NewValidatorSet([]*tmtypes.Validator{tmtypes.NewValidator(consPubKey, 1)})
+
+	senderAddr := sdk.AccAddress(crypto.PubkeyToAddress(key.PublicKey).Bytes())
+	accs := []authtypes.GenesisAccount{&authtypes.BaseAccount{Address: senderAddr.String()}}
+	balances := []banktypes.Balance{{
+		Address: senderAddr.String(),
+		Coins:   sdk.Coins{stratos.NewCoin(sdkmath.NewInt(100).MulRaw(stratos.StosToWei))},
+	}}
+	stApp := stratostestutil.SetupWithGenesisNodeSet(t, valSet, nil, nil, accs, "testchain_1-1", false, balances...)
+
+	// Has looks up the transactions already included in blocks through the tx indexer
+	tmrpccore.SetEnvironment(&tmrpccore.Environment{TxIndexer: &null.TxIndex{}})
+
+	evmCtx := evm.NewContext(log.NewNopLogger(), stApp.CommitMultiStore(), nil)
+	pool := newTestPool(txPoolConfig)
+	pool.signerCache = nil
+	pool.maxGasLimitCache = 25_000_000
+	pool.evmkeeper = stApp.GetEVMKeeper()
+	pool.evmCtx = evmCtx
+	pool.pendingNonces = newTxNoncer(evmCtx, stApp.GetEVMKeeper())
+	return pool, key
+}
+
+func newPricedTx(t *testing.T, key *ecdsa.PrivateKey, signer types.Signer, nonce uint64, tipCap, feeCap int64,
+) *types.Transaction {
+
+	to := common.HexToAddress("0x0000000000000000000000000000000000000001")
+	tx, err := types.SignNewTx(key, signer, &types.DynamicFeeTx{
+		ChainID:   signer.ChainID(),
+		Nonce:     nonce,
+		GasTipCap: big.NewInt(tipCap),
+		GasFeeCap: big.NewInt(feeCap),
+		Gas:       21000,
+		To:        &to,
+		Value:     big.NewInt(1),
+	})
+	require.NoError(t, err)
+	return tx
+}
+
+func TestEnqueueTxAccountLimit(t *testing.T) {
+	pool := newTestPool(core.TxPoolConfig{AccountQueue: 2, GlobalQueue: 10, PriceBump: 10})
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	for nonce := uint64(0); nonce < 2; nonce++ {
+		replaced, err := pool.enqueueTx(newSignedTx(t, key, nonce), true)
+		require.NoError(t, err)
+		require.False(t, replaced)
+	}
+
+	rejected := newSignedTx(t, key, 2)
+	_, err = pool.enqueueTx(rejected, true)
+	require.ErrorIs(t, err, ErrAccountLimitExceeded)
+	require.Nil(t, pool.Get(rejected.Hash()))
+
+	// A replacement reuses the slot of the transaction it overwrites
+	replacement := newPricedTx(t, key, testSigner, 1, 2, 2e9)
+	replaced, err := pool.enqueueTx(replacement, true)
+	require.NoError(t, err)
+	require.True(t, replaced)
+	require.NotNil(t, pool.Get(replacement.Hash()))
+
+	// Internal shuffles are not subject to the limit
+	_, err = pool.enqueueTx(rejected, false)
+	require.NoError(t, err)
+
+	_, queued := pool.stats()
+	require.Equal(t, 3, queued)
+}
+
+func TestEnqueueTxGlobalLimit(t *testing.T) {
+	pool := newTestPool(core.TxPoolConfig{AccountQueue: 10, GlobalQueue: 3, PriceBump: 10})
+
+	key1, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	key2, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	for _, tx := range []*types.Transaction{newSignedTx(t, key1, 0), newSignedTx(t, key1, 1), newSignedTx(t, key2, 0)} {
+		_, err := pool.enqueueTx(tx, true)
+		require.NoError(t, err)
+	}
+
+	rejected := newSignedTx(t, key2, 1)
+	_, err = pool.enqueueTx(rejected, true)
+	require.ErrorIs(t, err, core.ErrTxPoolOverflow)
+	require.Nil(t, pool.Get(rejected.Hash()))
+
+	replacement := newPricedTx(t, key2, testSigner, 0, 2, 2e9)
+	replaced, err := pool.enqueueTx(replacement, true)
+	require.NoError(t, err)
+	require.True(t, replaced)
+
+	_, queued := pool.stats()
+	require.Equal(t, 3, queued)
+}
+
+func TestEnqueueTxUnderpricedReplacement(t *testing.T) {
+	pool := newTestPool(core.TxPoolConfig{AccountQueue: 10, GlobalQueue: 10, PriceBump: 10})
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	old := newPricedTx(t, key, testSigner, 0, 10, 1e9)
+	_, err = pool.enqueueTx(old, true)
+	require.NoError(t, err)
+
+	// Both the fee cap and the tip have to be bumped by the price bump
+	_, err = pool.enqueueTx(newPricedTx(t, key, testSigner, 0, 10, 2e9), true)
+	require.ErrorIs(t, err, core.ErrReplaceUnderpriced)
+	_, err = pool.enqueueTx(newPricedTx(t, key, testSigner, 0, 10, 1e9+1), true)
+	require.ErrorIs(t, err, core.ErrReplaceUnderpriced)
+	require.NotNil(t, pool.Get(old.Hash()))
+
+	replacement := newPricedTx(t, key, testSigner, 0, 11, 1.1e9)
+	replaced, err := pool.enqueueTx(replacement, true)
+	require.NoError(t, err)
+	require.True(t, replaced)
+	require.Nil(t, pool.Get(old.Hash()))
+	require.NotNil(t, pool.Get(replacement.Hash()))
+}
+
+func TestPendingSlots(t *testing.T) {
+	pool := newTestPool(core.TxPoolConfig{AccountSlots: 2, GlobalSlots: 4, PriceBump: 10})
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	addr := crypto.PubkeyToAddress(key.PublicKey)
+
+	// Without pending transactions the account limit applies, until the global limit is lower
+	require.Equal(t, 2, pool.pendingSlots(addr, 0))
+	require.Equal(t, 2, pool.pendingSlots(addr, 2))
+	require.Equal(t, 1, pool.pendingSlots(addr, 3))
+	require.Equal(t, 0, pool.pendingSlots(addr, 4))
+	require.Equal(t, 0, pool.pendingSlots(addr, 5))
+
+	pool.pending[addr] = newTxList(true)
+	pool.pending[addr].Add(newSignedTx(t, key, 0), pool.config.PriceBump)
+	require.Equal(t, 1, pool.pendingSlots(addr, 1))
+	require.Equal(t, 0, pool.pendingSlots(addr, 4))
+
+	pool.pending[addr].Add(newSignedTx(t, key, 1), pool.config.PriceBump)
+	require.Equal(t, 0, pool.pendingSlots(addr, 2))
+}
+
+func TestAddSenderEvents(t *testing.T) {
+	pool, key := newAppPool(t, core.TxPoolConfig{AccountQueue: 10, GlobalQueue: 10, PriceBump: 10})
+	sender := crypto.PubkeyToAddress(key.PublicKey)
+	signer, err := pool.getSigner()
+	require.NoError(t, err)
+
+	events := make(chan SenderEvent, 10)
+	sub := pool.SubscribeSenderEvents(events)
+	defer sub.Unsubscribe()
+
+	// The next nonce of the sender doesn't post any event
+	replaced, err := pool.Add(newPricedTx(t, key, signer, 0, 1, 1e9))
+	require.NoError(t, err)
+	require.False(t, replaced)
+
+	gapTx := newPricedTx(t, key, signer, 2, 1, 1e9)
+	replaced, err = pool.Add(gapTx)
+	require.NoError(t, err)
+	require.False(t, replaced)
+
+	ev := <-events
+	require.Equal(t, SenderEventNonceGap, ev.Type)
+	require.Equal(t, sender, ev.Sender)
+	require.Equal(t, gapTx.Hash(), ev.Tx.Hash())
+	require.Equal(t, uint64(1), ev.Expected)
+	require.Nil(t, ev.Replaced)
+
+	replacement := newPricedTx(t, key, signer, 2, 2, 2e9)
+	replaced, err = pool.Add(replacement)
+	require.NoError(t, err)
+	require.True(t, replaced)
+
+	ev = <-events
+	require.Equal(t, SenderEventReplaced, ev.Type)
+	require.Equal(t, sender, ev.Sender)
+	require.Equal(t, replacement.Hash(), ev.Tx.Hash())
+	require.Equal(t, gapTx.Hash(), ev.Replaced.Hash())
+
+	// Rejected transactions don't post any event
+	_, err = pool.Add(newPricedTx(t, key, signer, 2, 2, 2e9+1))
+	require.ErrorIs(t, err, core.ErrReplaceUnderpriced)
+	require.Empty(t, events)
+}
+
+func TestContentFrom(t *testing.T) {
+	pool := newTestPool(core.TxPoolConfig{AccountQueue: 10, GlobalQueue: 10, PriceBump: 10})
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	addr := crypto.PubkeyToAddress(key.PublicKey)
+	other, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	pending, queued := pool.ContentFrom(addr)
+	require.Empty(t, pending)
+	require.Empty(t, queued)
+
+	pendingTx := newSignedTx(t, key, 0)
+	pool.pending[addr] = newTxList(true)
+	pool.pending[addr].Add(pendingTx, pool.config.PriceBump)
+
+	// Queued transactions are sorted by nonce
+	queuedTxs := []*types.Transaction{newSignedTx(t, key, 3), newSignedTx(t, key, 2)}
+	for _, tx := range queuedTxs {
+		_, err := pool.enqueueTx(tx, true)
+		require.NoError(t, err)
+	}
+	_, err = pool.enqueueTx(newSignedTx(t, other, 0), true)
+	require.NoError(t, err)
+
+	pending, queued = pool.ContentFrom(addr)
+	require.Equal(t, []common.Hash{pendingTx.Hash()}, txHashes(pending))
+	require.Equal(t, []common.Hash{queuedTxs[1].Hash(), queuedTxs[0].Hash()}, txHashes(queued))
+}
@@ -65,6 +65,9 @@ type stateObject struct {
 	// flags
 	dirtyCode bool
 	suicided  bool
+	// fakeStorage is set when the whole storage was replaced by a state override,
+	// committed state is then served from originStorage only
+	fakeStorage bool
 }
 
 // newObject creates a state object.
@@ -202,6 +205,9 @@ func (s *stateObject) GetCommittedState(key common.Hash) common.Hash {
 	if value, cached := s.originStorage[key]; cached {
 		return value
 	}
+	if s.fakeStorage {
+		return common.Hash{}
+	}
 	// If no live objects are available, load it from keeper
 	value := s.db.keeper.GetState(s.db.ctx, s.Address(), key)
 	s.originStorage[key] = value
@@ -235,3 +241,19 @@ func (s *stateObject) SetState(key common.Hash, value common.Hash) {
 func (s *stateObject) setState(key, value common.Hash) {
 	s.dirtyStorage[key] = value
 }
+
+// SetStorage replaces the entire storage of the object with the given one,
+// used by state overrides only and never committed
+func (s *stateObject) SetStorage(storage map[common.Hash]common.Hash) {
+	s.fakeStorage = true
+	s.originStorage = make(Storage, len(storage))
+	for key, value := range storage {
+		s.originStorage[key] = value
+	}
+	s.dirtyStorage = make(Storage)
+}
+
+// SetCommittedState overrides a single committed storage slot, used by state overrides only
+func (s *stateObject) SetCommittedState(key, value common.Hash) {
+	s.originStorage[key] = value
+}
@@ -268,6 +268,17 @@ func (s *StateDB) ForEachStorage(addr common.Address, cb func(key, value common.
 	if so == nil {
 		return nil
 	}
+	if so.fakeStorage {
+		for key, value := range so.originStorage {
+			if dirty, ok := so.dirtyStorage[key]; ok {
+				value = dirty
+			}
+			if !cb(key, value) {
+				return nil
+			}
+		}
+		return nil
+	}
 	s.keeper.ForEachStorage(s.ctx, addr, func(key, value common.Hash) bool {
 		if value, dirty := so.dirtyStorage[key]; dirty {
 			return cb(key, value)
@@ -304,6 +315,14 @@ func (s *StateDB) SubBalance(addr common.Address, amount *big.Int) {
 	}
 }
 
+// SetBalance sets the balance of account.
+func (s *StateDB) SetBalance(addr common.Address, amount *big.Int) {
+	stateObject := s.getOrNewStateObject(addr)
+	if stateObject != nil {
+		stateObject.SetBalance(amount)
+	}
+}
+
 // SetNonce sets the nonce of account.
 func (s *StateDB) SetNonce(addr common.Address, nonce uint64) {
 	stateObject := s.getOrNewStateObject(addr)
@@ -328,6 +347,23 @@ func (s *StateDB) SetState(addr common.Address, key, value common.Hash) {
 	}
 }
 
+// SetStorage replaces the entire storage of the account, used by state overrides only.
+func (s *StateDB) SetStorage(addr common.Address, storage map[common.Hash]common.Hash) {
+	stateObject := s.getOrNewStateObject(addr)
+	if stateObject != nil {
+		stateObject.SetStorage(storage)
+	}
+}
+
+// SetCommittedState overrides a single committed storage slot of the account,
+// used by state overrides only.
+func (s *StateDB) SetCommittedState(addr common.Address, key, value common.Hash) {
+	stateObject := s.getOrNewStateObject(addr)
+	if stateObject != nil {
+		stateObject.SetCommittedState(key, value)
+	}
+}
+
 // Suicide marks the given account as suicided.
 // This clears the account balance.
 //
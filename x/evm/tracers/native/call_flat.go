@@ -0,0 +1,347 @@
+// Copyright 2022 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package native
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stratosnet/stratos-chain/x/evm/tracers"
+	"github.com/stratosnet/stratos-chain/x/evm/vm"
+)
+
+func init() {
+	register("flatCallTracer", newFlatCallTracer)
+}
+
+var parityErrorMapping = map[string]string{
+	"contract creation code storage out of gas": "Out of gas",
+	"out of gas":                      "Out of gas",
+	"gas uint64 overflow":             "Out of gas",
+	"max code size exceeded":          "Out of gas",
+	"invalid jump destination":        "Bad jump destination",
+	"execution reverted":              "Reverted",
+	"return data out of bounds":       "Out of bounds",
+	"stack limit reached 1024 (1023)": "Out of stack",
+	"precompiled failed":              "Built-in failed",
+	"invalid input length":            "Built-in failed",
+}
+
+var parityErrorMappingStartingWith = map[string]string{
+	"invalid opcode:": "Bad instruction",
+	"stack underflow": "Stack underflow",
+}
+
+// flatCallFrame is a standalone call trace of the parity trace format.
+type flatCallFrame struct {
+	Action              flatCallAction  `json:"action"`
+	BlockHash           *common.Hash    `json:"blockHash"`
+	BlockNumber         uint64          `json:"blockNumber"`
+	Error               string          `json:"error,omitempty"`
+	Result              *flatCallResult `json:"result,omitempty"`
+	Subtraces           int             `json:"subtraces"`
+	TraceAddress        []int           `json:"traceAddress"`
+	TransactionHash     *common.Hash    `json:"transactionHash"`
+	TransactionPosition uint64          `json:"transactionPosition"`
+	Type                string          `json:"type"`
+}
+
+type flatCallAction struct {
+	SelfDestructed string `json:"address,omitempty"`
+	Balance        string `json:"balance,omitempty"`
+	CallType       string `json:"callType,omitempty"`
+	CreationMethod string `json:"creationMethod,omitempty"`
+	From           string `json:"from,omitempty"`
+	Gas            string `json:"gas,omitempty"`
+	Init           string `json:"init,omitempty"`
+	Input          string `json:"input,omitempty"`
+	RefundAddress  string `json:"refundAddress,omitempty"`
+	To             string `json:"to,omitempty"`
+	Value          string `json:"value,omitempty"`
+}
+
+type flatCallResult struct {
+	Address string `json:"address,omitempty"`
+	Code    string `json:"code,omitempty"`
+	GasUsed string `json:"gasUsed,omitempty"`
+	Output  string `json:"output,omitempty"`
+}
+
+// flatCallTracer reports call frame information of a tx in a flat format, i.e.
+// as opposed to the nested format of `callTracer`.
+type flatCallTracer struct {
+	tracer            *callTracer
+	config            flatCallTracerConfig
+	ctx               *tracers.Context // Holds tracer context data
+	blockNumber       uint64
+	activePrecompiles []common.Address // Updated on CaptureStart based on given rules
+}
+
+type flatCallTracerConfig struct {
+	ConvertParityErrors bool `json:"convertParityErrors"` // If true, call tracer converts errors to parity format
+	IncludePrecompiles  bool `json:"includePrecompiles"`  // If true, call tracer includes calls to precompiled contracts
+}
+
+// newFlatCallTracer returns a new flatCallTracer.
+func newFlatCallTracer(ctx *tracers.Context, cfg json.RawMessage) (tracers.Tracer, error) {
+	var config flatCallTracerConfig
+	if cfg != nil {
+		if err := json.Unmarshal(cfg, &config); err != nil {
+			return nil, err
+		}
+	}
+
+	tracer, err := newCallTracer(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	t, ok := tracer.(*callTracer)
+	if !ok {
+		return nil, errors.New("internal error: embedded tracer has wrong type")
+	}
+
+	return &flatCallTracer{tracer: t, ctx: ctx, config: config}, nil
+}
+
+// CaptureStart implements the EVMLogger interface to initialize the tracing operation.
+func (t *flatCallTracer) CaptureStart(env *vm.EVM, from common.Address, to common.Address, create bool, input []byte, gas uint64, value *big.Int) {
+	t.tracer.CaptureStart(env, from, to, create, input, gas, value)
+
+	blockNumber := env.Context.BlockNumber
+	if t.ctx != nil && t.ctx.BlockNumber != nil {
+		blockNumber = t.ctx.BlockNumber
+	}
+	t.blockNumber = blockNumber.Uint64()
+
+	// Update list of precompiles based on current block
+	rules := env.ChainConfig().Rules(env.Context.BlockNumber, env.Context.Random != nil)
+	t.activePrecompiles = vm.ActivePrecompiles(rules)
+}
+
+// CaptureEnd is called after the call finishes to finalize the tracing.
+func (t *flatCallTracer) CaptureEnd(output []byte, gasUsed uint64, d time.Duration, err error) {
+	t.tracer.CaptureEnd(output, gasUsed, d, err)
+}
+
+// CaptureState implements the EVMLogger interface to trace a single step of VM execution.
+func (t *flatCallTracer) CaptureState(pc uint64, op vm.OpCode, gas, cost uint64, scope *vm.ScopeContext, rData []byte, depth int, err error) {
+	t.tracer.CaptureState(pc, op, gas, cost, scope, rData, depth, err)
+}
+
+// CaptureFault implements the EVMLogger interface to trace an execution fault.
+func (t *flatCallTracer) CaptureFault(pc uint64, op vm.OpCode, gas, cost uint64, scope *vm.ScopeContext, depth int, err error) {
+	t.tracer.CaptureFault(pc, op, gas, cost, scope, depth, err)
+}
+
+// CaptureEnter is called when EVM enters a new scope (via call, create or selfdestruct).
+func (t *flatCallTracer) CaptureEnter(typ vm.OpCode, from common.Address, to common.Address, input []byte, gas uint64, value *big.Int) {
+	t.tracer.CaptureEnter(typ, from, to, input, gas, value)
+}
+
+// CaptureExit is called when EVM exits a scope, even if the scope didn't
+// execute any code.
+func (t *flatCallTracer) CaptureExit(output []byte, gasUsed uint64, err error) {
+	t.tracer.CaptureExit(output, gasUsed, err)
+
+	// Parity traces don't include CALL/STATICCALLs to precompiles.
+	// By default we remove them from the callstack.
+	if t.config.IncludePrecompiles || len(t.tracer.callstack) == 0 {
+		return
+	}
+	parent := &t.tracer.callstack[len(t.tracer.callstack)-1]
+	if len(parent.Calls) == 0 {
+		return
+	}
+	call := parent.Calls[len(parent.Calls)-1]
+	if call.Type == vm.CALL.String() || call.Type == vm.STATICCALL.String() {
+		if t.isPrecompiled(call.To) {
+			parent.Calls = parent.Calls[:len(parent.Calls)-1]
+		}
+	}
+}
+
+func (t *flatCallTracer) CaptureTxStart(gasLimit uint64) {
+	t.tracer.CaptureTxStart(gasLimit)
+}
+
+func (t *flatCallTracer) CaptureTxEnd(restGas uint64) {
+	t.tracer.CaptureTxEnd(restGas)
+}
+
+// GetResult returns an empty json object.
+func (t *flatCallTracer) GetResult() (json.RawMessage, error) {
+	if len(t.tracer.callstack) < 1 {
+		return nil, errors.New("invalid number of calls")
+	}
+
+	flat, err := t.flatFromNested(&t.tracer.callstack[0], []int{}, t.config.ConvertParityErrors)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := json.Marshal(flat)
+	if err != nil {
+		return nil, err
+	}
+	return res, t.tracer.reason
+}
+
+// Stop terminates execution of the tracer at the first opportune moment.
+func (t *flatCallTracer) Stop(err error) {
+	t.tracer.Stop(err)
+}
+
+// isPrecompiled returns whether the addr is a precompile.
+func (t *flatCallTracer) isPrecompiled(addr string) bool {
+	for _, p := range t.activePrecompiles {
+		if addrToHex(p) == addr {
+			return true
+		}
+	}
+	return false
+}
+
+func (t *flatCallTracer) flatFromNested(input *callFrame, traceAddress []int, convertErrs bool) (output []flatCallFrame, err error) {
+	var frame *flatCallFrame
+	switch input.Type {
+	case vm.CREATE.String(), vm.CREATE2.String():
+		frame = newFlatCreate(input)
+	case vm.SELFDESTRUCT.String():
+		frame = newFlatSuicide(input)
+	case vm.CALL.String(), vm.STATICCALL.String(), vm.CALLCODE.String(), vm.DELEGATECALL.String():
+		frame = newFlatCall(input)
+	default:
+		return nil, errors.New("unrecognized call frame type: " + input.Type)
+	}
+
+	frame.Error = input.Error
+	if convertErrs {
+		convertErrorToParity(frame)
+	}
+
+	// Revert output contains useful information (revert reason).
+	// Otherwise discard result.
+	if input.Error != "" && input.Error != vm.ErrExecutionReverted.Error() {
+		frame.Result = nil
+	}
+
+	frame.Subtraces = len(input.Calls)
+	fillCallFrameFromContext(frame, t.ctx, t.blockNumber)
+	frame.TraceAddress = traceAddress
+
+	output = append(output, *frame)
+
+	for i, childCall := range input.Calls {
+		childAddr := childTraceAddress(traceAddress, i)
+		childCallCopy := childCall
+		flat, err := t.flatFromNested(&childCallCopy, childAddr, convertErrs)
+		if err != nil {
+			return nil, err
+		}
+		output = append(output, flat...)
+	}
+
+	return output, nil
+}
+
+func newFlatCreate(input *callFrame) *flatCallFrame {
+	return &flatCallFrame{
+		Type: strings.ToLower(vm.CREATE.String()),
+		Action: flatCallAction{
+			From:           input.From,
+			Gas:            input.Gas,
+			Value:          input.Value,
+			Init:           input.Input,
+			CreationMethod: strings.ToLower(input.Type),
+		},
+		Result: &flatCallResult{
+			GasUsed: input.GasUsed,
+			Address: input.To,
+			Code:    input.Output,
+		},
+	}
+}
+
+func newFlatCall(input *callFrame) *flatCallFrame {
+	return &flatCallFrame{
+		Type: strings.ToLower(vm.CALL.String()),
+		Action: flatCallAction{
+			From:     input.From,
+			To:       input.To,
+			Gas:      input.Gas,
+			Value:    input.Value,
+			CallType: strings.ToLower(input.Type),
+			Input:    input.Input,
+		},
+		Result: &flatCallResult{
+			Output:  input.Output,
+			GasUsed: input.GasUsed,
+		},
+	}
+}
+
+func newFlatSuicide(input *callFrame) *flatCallFrame {
+	return &flatCallFrame{
+		Type: "suicide",
+		Action: flatCallAction{
+			SelfDestructed: input.From,
+			Balance:        input.Value,
+			RefundAddress:  input.To,
+		},
+	}
+}
+
+func fillCallFrameFromContext(callFrame *flatCallFrame, ctx *tracers.Context, blockNumber uint64) {
+	callFrame.BlockNumber = blockNumber
+	if ctx == nil {
+		return
+	}
+	if ctx.BlockHash != (common.Hash{}) {
+		callFrame.BlockHash = &ctx.BlockHash
+	}
+	if ctx.TxHash != (common.Hash{}) {
+		callFrame.TransactionHash = &ctx.TxHash
+	}
+	callFrame.TransactionPosition = uint64(ctx.TxIndex)
+}
+
+func convertErrorToParity(call *flatCallFrame) {
+	if call.Error == "" {
+		return
+	}
+
+	if parityError, ok := parityErrorMapping[call.Error]; ok {
+		call.Error = parityError
+	} else {
+		for gethError, parityError := range parityErrorMappingStartingWith {
+			if strings.HasPrefix(call.Error, gethError) {
+				call.Error = parityError
+			}
+		}
+	}
+}
+
+func childTraceAddress(a []int, i int) []int {
+	child := make([]int, 0, len(a)+1)
+	child = append(child, a...)
+	child = append(child, i)
+	return child
+}
@@ -0,0 +1,21 @@
+package native
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewMuxTracer(t *testing.T) {
+	tracer, err := newMuxTracer(nil, json.RawMessage(`{"prestateTracer":{"diffMode":true},"callTracer":{}}`))
+	require.NoError(t, err)
+	// the sub-tracers run in the order of their names
+	require.Equal(t, []string{"callTracer", "prestateTracer"}, tracer.(*muxTracer).names)
+
+	_, err = newMuxTracer(nil, json.RawMessage(`{"muxTracer":{}}`))
+	require.ErrorContains(t, err, "muxTracer can not be nested")
+
+	_, err = newMuxTracer(nil, json.RawMessage(`{"unknownTracer":{}}`))
+	require.ErrorContains(t, err, "unknownTracer")
+}
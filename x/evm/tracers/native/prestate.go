@@ -33,28 +33,51 @@ func init() {
 	register("prestateTracer", newPrestateTracer)
 }
 
-type prestate = map[common.Address]*account
+type state = map[common.Address]*account
 type account struct {
-	Balance string                      `json:"balance"`
-	Nonce   uint64                      `json:"nonce"`
-	Code    string                      `json:"code"`
-	Storage map[common.Hash]common.Hash `json:"storage"`
+	Balance string                      `json:"balance,omitempty"`
+	Nonce   uint64                      `json:"nonce,omitempty"`
+	Code    string                      `json:"code,omitempty"`
+	Storage map[common.Hash]common.Hash `json:"storage,omitempty"`
+}
+
+// exists reports whether the account held any state before the transaction.
+func (a *account) exists() bool {
+	return a.Nonce > 0 || len(a.Code) > 0 || len(a.Storage) > 0 || (a.Balance != "" && a.Balance != "0x0")
 }
 
 type prestateTracer struct {
 	env       *vm.EVM
-	prestate  prestate
+	pre       state
+	post      state
 	create    bool
 	to        common.Address
 	gasLimit  uint64 // Amount of gas bought for the whole tx
+	config    prestateTracerConfig
 	interrupt uint32 // Atomic flag to signal execution interruption
 	reason    error  // Textual reason for the interruption
+	created   map[common.Address]bool
+	deleted   map[common.Address]bool
 }
 
-func newPrestateTracer(ctx *tracers.Context, _ json.RawMessage) (tracers.Tracer, error) {
-	// First callframe contains tx context info
-	// and is populated on start and end.
-	return &prestateTracer{prestate: prestate{}}, nil
+type prestateTracerConfig struct {
+	DiffMode bool `json:"diffMode"` // If true, this tracer will return state modifications
+}
+
+func newPrestateTracer(ctx *tracers.Context, cfg json.RawMessage) (tracers.Tracer, error) {
+	var config prestateTracerConfig
+	if cfg != nil {
+		if err := json.Unmarshal(cfg, &config); err != nil {
+			return nil, err
+		}
+	}
+	return &prestateTracer{
+		pre:     state{},
+		post:    state{},
+		config:  config,
+		created: make(map[common.Address]bool),
+		deleted: make(map[common.Address]bool),
+	}, nil
 }
 
 // CaptureStart implements the EVMLogger interface to initialize the tracing operation.
@@ -67,54 +90,82 @@ func (t *prestateTracer) CaptureStart(env *vm.EVM, from common.Address, to commo
 	t.lookupAccount(to)
 
 	// The recipient balance includes the value transferred.
-	toBal := hexutil.MustDecodeBig(t.prestate[to].Balance)
+	toBal := hexutil.MustDecodeBig(t.pre[to].Balance)
 	toBal = new(big.Int).Sub(toBal, value)
-	t.prestate[to].Balance = hexutil.EncodeBig(toBal)
+	t.pre[to].Balance = hexutil.EncodeBig(toBal)
 
 	// The sender balance is after reducing: value and gasLimit.
 	// We need to re-add them to get the pre-tx balance.
-	fromBal := hexutil.MustDecodeBig(t.prestate[from].Balance)
+	fromBal := hexutil.MustDecodeBig(t.pre[from].Balance)
 	gasPrice := env.TxContext.GasPrice
 	consumedGas := new(big.Int).Mul(gasPrice, new(big.Int).SetUint64(t.gasLimit))
 	fromBal.Add(fromBal, new(big.Int).Add(value, consumedGas))
-	t.prestate[from].Balance = hexutil.EncodeBig(fromBal)
-	t.prestate[from].Nonce--
+	t.pre[from].Balance = hexutil.EncodeBig(fromBal)
+	t.pre[from].Nonce--
+
+	if create && t.config.DiffMode {
+		t.created[to] = true
+	}
 }
 
 // CaptureEnd is called after the call finishes to finalize the tracing.
+// The top level frame has been reverted or committed at this point, so
+// in diff mode this is where the post state is collected.
 func (t *prestateTracer) CaptureEnd(output []byte, gasUsed uint64, _ time.Duration, err error) {
+	if t.config.DiffMode {
+		t.processDiffState()
+		return
+	}
+
 	if t.create {
-		// Exclude created contract.
-		delete(t.prestate, t.to)
+		// Keep existing account prior to contract creation at that address
+		if s := t.pre[t.to]; s != nil && !s.exists() {
+			// Exclude newly created contract.
+			delete(t.pre, t.to)
+		}
 	}
 }
 
 // CaptureState implements the EVMLogger interface to trace a single step of VM execution.
 func (t *prestateTracer) CaptureState(pc uint64, op vm.OpCode, gas, cost uint64, scope *vm.ScopeContext, rData []byte, depth int, err error) {
+	if err != nil {
+		return
+	}
+	// Skip if tracing was interrupted
+	if atomic.LoadUint32(&t.interrupt) > 0 {
+		return
+	}
 	stack := scope.Stack
 	stackData := stack.Data()
 	stackLen := len(stackData)
+	caller := scope.Contract.Address()
 	switch {
 	case stackLen >= 1 && (op == vm.SLOAD || op == vm.SSTORE):
 		slot := common.Hash(stackData[stackLen-1].Bytes32())
-		t.lookupStorage(scope.Contract.Address(), slot)
+		t.lookupStorage(caller, slot)
 	case stackLen >= 1 && (op == vm.EXTCODECOPY || op == vm.EXTCODEHASH || op == vm.EXTCODESIZE || op == vm.BALANCE || op == vm.SELFDESTRUCT):
 		addr := common.Address(stackData[stackLen-1].Bytes20())
 		t.lookupAccount(addr)
+		if op == vm.SELFDESTRUCT {
+			t.deleted[caller] = true
+		}
 	case stackLen >= 5 && (op == vm.DELEGATECALL || op == vm.CALL || op == vm.STATICCALL || op == vm.CALLCODE):
 		addr := common.Address(stackData[stackLen-2].Bytes20())
 		t.lookupAccount(addr)
 	case op == vm.CREATE:
-		addr := scope.Contract.Address()
-		nonce := t.env.StateDB.GetNonce(addr)
-		t.lookupAccount(crypto.CreateAddress(addr, nonce))
+		nonce := t.env.StateDB.GetNonce(caller)
+		addr := crypto.CreateAddress(caller, nonce)
+		t.lookupAccount(addr)
+		t.created[addr] = true
 	case stackLen >= 4 && op == vm.CREATE2:
 		offset := stackData[stackLen-2]
 		size := stackData[stackLen-3]
 		init := scope.Memory.GetCopy(int64(offset.Uint64()), int64(size.Uint64()))
 		inithash := crypto.Keccak256(init)
 		salt := stackData[stackLen-4]
-		t.lookupAccount(crypto.CreateAddress2(scope.Contract.Address(), salt.Bytes32(), inithash))
+		addr := crypto.CreateAddress2(caller, salt.Bytes32(), inithash)
+		t.lookupAccount(addr)
+		t.created[addr] = true
 	}
 }
 
@@ -137,10 +188,85 @@ func (t *prestateTracer) CaptureTxStart(gasLimit uint64) {
 
 func (t *prestateTracer) CaptureTxEnd(restGas uint64) {}
 
+// processDiffState compares the collected pre state of every touched account
+// with its current state, keeping only the modified fields on both sides.
+func (t *prestateTracer) processDiffState() {
+	for addr, prestate := range t.pre {
+		// The deleted account's state is pruned from `post` but kept in `pre`
+		if _, ok := t.deleted[addr]; ok {
+			continue
+		}
+		modified := false
+		postAccount := &account{Storage: make(map[common.Hash]common.Hash)}
+		newBalance := bigToHex(t.env.StateDB.GetBalance(addr))
+		newNonce := t.env.StateDB.GetNonce(addr)
+		var newCode string
+		if code := t.env.StateDB.GetCode(addr); len(code) > 0 {
+			newCode = bytesToHex(code)
+		}
+
+		if newBalance != prestate.Balance {
+			modified = true
+			postAccount.Balance = newBalance
+		}
+		if newNonce != prestate.Nonce {
+			modified = true
+			postAccount.Nonce = newNonce
+		}
+		if newCode != prestate.Code {
+			modified = true
+			postAccount.Code = newCode
+		}
+
+		for key, val := range prestate.Storage {
+			// don't include the empty slot
+			if val == (common.Hash{}) {
+				delete(prestate.Storage, key)
+			}
+
+			newVal := t.env.StateDB.GetState(addr, key)
+			if val == newVal {
+				// Omit unchanged slots
+				delete(prestate.Storage, key)
+			} else {
+				modified = true
+				if newVal != (common.Hash{}) {
+					postAccount.Storage[key] = newVal
+				}
+			}
+		}
+
+		if modified {
+			t.post[addr] = postAccount
+		} else {
+			// if state is not modified, then no need to include into the pre state
+			delete(t.pre, addr)
+		}
+	}
+	// the new created contracts' prestate were empty, so delete them
+	for addr := range t.created {
+		// the created contract maybe exists in statedb before the creating tx
+		if s := t.pre[addr]; s != nil && !s.exists() {
+			delete(t.pre, addr)
+		}
+	}
+}
+
 // GetResult returns the json-encoded nested list of call traces, and any
 // error arising from the encoding or forceful termination (via `Stop`).
 func (t *prestateTracer) GetResult() (json.RawMessage, error) {
-	res, err := json.Marshal(t.prestate)
+	var (
+		res []byte
+		err error
+	)
+	if t.config.DiffMode {
+		res, err = json.Marshal(struct {
+			Post state `json:"post"`
+			Pre  state `json:"pre"`
+		}{t.post, t.pre})
+	} else {
+		res, err = json.Marshal(t.pre)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -156,23 +282,26 @@ func (t *prestateTracer) Stop(err error) {
 // lookupAccount fetches details of an account and adds it to the prestate
 // if it doesn't exist there.
 func (t *prestateTracer) lookupAccount(addr common.Address) {
-	if _, ok := t.prestate[addr]; ok {
+	if _, ok := t.pre[addr]; ok {
 		return
 	}
-	t.prestate[addr] = &account{
+	acc := &account{
 		Balance: bigToHex(t.env.StateDB.GetBalance(addr)),
 		Nonce:   t.env.StateDB.GetNonce(addr),
-		Code:    bytesToHex(t.env.StateDB.GetCode(addr)),
 		Storage: make(map[common.Hash]common.Hash),
 	}
+	if code := t.env.StateDB.GetCode(addr); len(code) > 0 {
+		acc.Code = bytesToHex(code)
+	}
+	t.pre[addr] = acc
 }
 
 // lookupStorage fetches the requested storage slot and adds
 // it to the prestate of the given contract. It assumes `lookupAccount`
 // has been performed on the contract before.
 func (t *prestateTracer) lookupStorage(addr common.Address, key common.Hash) {
-	if _, ok := t.prestate[addr].Storage[key]; ok {
+	if _, ok := t.pre[addr].Storage[key]; ok {
 		return
 	}
-	t.prestate[addr].Storage[key] = t.env.StateDB.GetState(addr, key)
+	t.pre[addr].Storage[key] = t.env.StateDB.GetState(addr, key)
 }
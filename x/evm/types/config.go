@@ -1,6 +1,7 @@
 package types
 
 import (
+	"encoding/json"
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -14,4 +15,29 @@ type EVMConfig struct {
 	ChainConfig *params.ChainConfig
 	CoinBase    common.Address
 	BaseFee     *big.Int
+	// StateOverrides and BlockOverrides are only set by the simulation queries
+	StateOverrides *StateOverride
+	BlockOverrides *BlockOverrides
+}
+
+// SetOverrides decodes the json encoded state and block overrides of a simulation query into the config
+func (cfg *EVMConfig) SetOverrides(stateOverrides, blockOverrides []byte) error {
+	if len(stateOverrides) > 0 {
+		var overrides StateOverride
+		if err := json.Unmarshal(stateOverrides, &overrides); err != nil {
+			return err
+		}
+		cfg.StateOverrides = &overrides
+	}
+	if len(blockOverrides) > 0 {
+		var overrides BlockOverrides
+		if err := json.Unmarshal(blockOverrides, &overrides); err != nil {
+			return err
+		}
+		cfg.BlockOverrides = &overrides
+		if overrides.BaseFee != nil {
+			cfg.BaseFee = overrides.BaseFee.ToInt()
+		}
+	}
+	return nil
 }
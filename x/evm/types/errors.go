@@ -34,6 +34,7 @@ const (
 	codeErrInvalidAccount
 	codeErrEthTxNotFound
 	codeErrUnSupportedBuilder
+	codeErrInvalidStateOverride
 )
 
 var ErrPostTxProcessing = fmt.Errorf("failed to execute post processing")
@@ -102,6 +103,9 @@ var (
 	ErrEthTxNotFound = errors.Register(ModuleName, codeErrEthTxNotFound, "ethereum tx not found")
 
 	ErrUnSupportedBuilder = errors.Register(ModuleName, codeErrUnSupportedBuilder, "unsupported builder")
+
+	// ErrInvalidStateOverride returns an error if the state overrides of a simulated call are invalid
+	ErrInvalidStateOverride = errors.Register(ModuleName, codeErrInvalidStateOverride, "invalid state override")
 )
 
 // NewExecErrorWithReason unpacks the revert return bytes and returns a wrapped error
@@ -551,6 +551,8 @@ type TraceConfig struct {
 	EnableMemory bool `protobuf:"varint,11,opt,name=enable_memory,json=enableMemory,proto3" json:"enableMemory"`
 	// enable return data capture
 	EnableReturnData bool `protobuf:"varint,12,opt,name=enable_return_data,json=enableReturnData,proto3" json:"enableReturnData"`
+	// tracer config specific to the given native tracer, json encoded
+	TracerJsonConfig string `protobuf:"bytes,13,opt,name=tracer_json_config,json=tracerJsonConfig,proto3" json:"tracerJsonConfig"`
 }
 
 func (m *TraceConfig) Reset()         { *m = TraceConfig{} }
@@ -656,6 +658,13 @@ func (m *TraceConfig) GetEnableReturnData() bool {
 	return false
 }
 
+func (m *TraceConfig) GetTracerJsonConfig() string {
+	if m != nil {
+		return m.TracerJsonConfig
+	}
+	return ""
+}
+
 // Params defines the EVM module parameters
 type FeeMarketParams struct {
 	// no base fee forces the EIP-1559 base fee to 0 (needed for 0 price calls)
@@ -748,119 +757,120 @@ func init() {
 func init() { proto.RegisterFile("stratos/evm/v1/evm.proto", fileDescriptor_6ee18d4714e9d670) }
 
 var fileDescriptor_6ee18d4714e9d670 = []byte{
-	// 1779 bytes of a gzipped FileDescriptorProto
+	// 1799 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x58, 0x4f, 0x6f, 0x23, 0x49,
-	0x15, 0x8f, 0x63, 0x27, 0x69, 0x97, 0x1d, 0xbb, 0x53, 0xf1, 0x0e, 0x9e, 0x89, 0x48, 0x47, 0x46,
-	0x40, 0xb4, 0xda, 0x49, 0x36, 0x33, 0x8a, 0x18, 0x06, 0xed, 0x21, 0x9e, 0xcc, 0xb0, 0x09, 0x33,
-	0xbb, 0x43, 0xcd, 0x0c, 0x2b, 0x21, 0x44, 0x53, 0xee, 0xae, 0x74, 0x5a, 0xe9, 0xee, 0x32, 0x55,
-	0x65, 0xaf, 0x0d, 0x02, 0x09, 0x21, 0x01, 0xda, 0x03, 0xe2, 0xc8, 0x71, 0x8f, 0x7b, 0xdc, 0x03,
-	0x1f, 0x80, 0xe3, 0x8a, 0xd3, 0x8a, 0x13, 0xe2, 0xd0, 0x42, 0x99, 0xc3, 0x4a, 0xe1, 0x80, 0xe4,
-	0x4f, 0x80, 0xfa, 0x55, 0xf9, 0x5f, 0x27, 0x1a, 0x6d, 0x72, 0x89, 0xeb, 0xd5, 0xef, 0xbd, 0xf7,
-	0x7b, 0xef, 0xd5, 0xab, 0xae, 0xaa, 0xa0, 0xa6, 0x54, 0x82, 0x2a, 0x2e, 0x77, 0x59, 0x3f, 0xde,
-	0xed, 0xef, 0x65, 0x3f, 0x3b, 0x5d, 0xc1, 0x15, 0xc7, 0x35, 0x83, 0xec, 0x64, 0x53, 0xfd, 0xbd,
-	0x3b, 0x8d, 0x80, 0x07, 0x1c, 0xa0, 0xdd, 0x6c, 0xa4, 0xb5, 0xee, 0xdc, 0xf6, 0xb8, 0x8c, 0xb9,
-	0x74, 0x35, 0xa0, 0x05, 0x03, 0xad, 0xd1, 0x38, 0x4c, 0xf8, 0x2e, 0xfc, 0xd5, 0x53, 0xad, 0xff,
-	0x16, 0xd1, 0xf2, 0x73, 0x2a, 0x68, 0x2c, 0xf1, 0x1e, 0x2a, 0xb3, 0x7e, 0xec, 0xfa, 0x2c, 0xe1,
-	0x71, 0xb3, 0xb0, 0x55, 0xd8, 0x2e, 0xb7, 0x1b, 0xa3, 0xd4, 0xb1, 0x87, 0x34, 0x8e, 0x1e, 0xb6,
-	0x26, 0x50, 0x8b, 0x58, 0xac, 0x1f, 0x1f, 0x66, 0x43, 0xfc, 0x1e, 0x5a, 0x65, 0x09, 0xed, 0x44,
-	0xcc, 0xf5, 0x04, 0xa3, 0x8a, 0x35, 0x17, 0xb7, 0x0a, 0xdb, 0x56, 0xbb, 0x39, 0x4a, 0x9d, 0x86,
-	0x31, 0x9b, 0x85, 0x5b, 0xa4, 0xaa, 0xe5, 0x47, 0x20, 0xe2, 0xef, 0xa1, 0xca, 0x18, 0xa7, 0x51,
-	0xd4, 0x2c, 0x82, 0xf1, 0xad, 0x51, 0xea, 0xe0, 0x79, 0x63, 0x1a, 0x45, 0x2d, 0x82, 0x8c, 0x29,
-	0x8d, 0x22, 0x7c, 0x80, 0x10, 0x1b, 0x28, 0x41, 0x5d, 0x16, 0x76, 0x65, 0xb3, 0xb4, 0x55, 0xdc,
-	0x2e, 0xb6, 0x5b, 0xe7, 0xa9, 0x53, 0x7e, 0x9c, 0xcd, 0x3e, 0x3e, 0x7a, 0x2e, 0x47, 0xa9, 0xb3,
-	0x66, 0x9c, 0x4c, 0x14, 0x5b, 0xa4, 0x0c, 0xc2, 0xe3, 0xb0, 0x2b, 0xf1, 0x2f, 0x50, 0xd5, 0x3b,
-	0xa5, 0x61, 0xe2, 0x7a, 0x3c, 0x39, 0x09, 0x83, 0xe6, 0xd2, 0x56, 0x61, 0xbb, 0x72, 0x6f, 0x63,
-	0x67, 0xbe, 0xc6, 0x3b, 0x8f, 0x32, 0x9d, 0x47, 0xa0, 0xd2, 0xde, 0xfa, 0x22, 0x75, 0x16, 0x46,
-	0xa9, 0xb3, 0xae, 0x1d, 0xcf, 0x9a, 0xb7, 0x3e, 0xfb, 0xea, 0xf3, 0xb7, 0x0b, 0xa4, 0xe2, 0x4d,
-	0xd5, 0xb1, 0x40, 0x6b, 0x27, 0x8c, 0xb9, 0x31, 0x15, 0x67, 0x4c, 0xb9, 0x5d, 0x28, 0x72, 0x73,
-	0x19, 0x68, 0x9c, 0x3c, 0xcd, 0x13, 0xc6, 0x9e, 0x81, 0x9e, 0x5e, 0x8b, 0xf6, 0xb7, 0x0d, 0x55,
-	0x53, 0x53, 0x5d, 0xf2, 0x63, 0xf8, 0xea, 0x27, 0xf3, 0x76, 0x0f, 0xbf, 0xf9, 0xd7, 0x4f, 0x9d,
-	0xc2, 0x27, 0x5f, 0x7d, 0xfe, 0x76, 0x63, 0xdc, 0x45, 0x03, 0xe8, 0x23, 0x0d, 0xb7, 0xfe, 0x67,
-	0xa3, 0xca, 0x4c, 0x46, 0xf8, 0x97, 0xc8, 0xd2, 0x59, 0x84, 0xbe, 0x59, 0xf1, 0x9f, 0xfc, 0x3b,
-	0x75, 0xbe, 0x13, 0x84, 0xea, 0xb4, 0xd7, 0xd9, 0xf1, 0x78, 0x6c, 0xfa, 0xc7, 0xfc, 0xdc, 0x95,
-	0xfe, 0xd9, 0xae, 0x1a, 0x76, 0x99, 0xdc, 0x39, 0x4a, 0xd4, 0x79, 0xea, 0xac, 0x80, 0xb3, 0xa3,
-	0xc3, 0x51, 0xea, 0xd4, 0x67, 0x8b, 0x12, 0xfa, 0xad, 0x7f, 0xfe, 0xed, 0x2e, 0x32, 0xcd, 0x77,
-	0x94, 0x28, 0xb2, 0x02, 0xc0, 0x91, 0x8f, 0x7f, 0x8d, 0xea, 0xa7, 0x3c, 0x66, 0x52, 0x31, 0xea,
-	0xbb, 0x9d, 0x88, 0x7b, 0x67, 0xd0, 0x34, 0xe5, 0x36, 0xf9, 0xfa, 0xcc, 0xa3, 0xd4, 0xb9, 0xa5,
-	0xe9, 0x72, 0xae, 0xf2, 0xac, 0xb5, 0x09, 0xde, 0xce, 0x60, 0xfc, 0xc7, 0x02, 0xaa, 0xf9, 0x94,
-	0xbb, 0x27, 0x5c, 0x9c, 0x19, 0xf2, 0x22, 0x90, 0xd3, 0x6b, 0xa5, 0x5d, 0x3d, 0x3c, 0xf8, 0xf0,
-	0x09, 0x17, 0x67, 0xe0, 0x74, 0x94, 0x3a, 0x6f, 0xe9, 0x60, 0xe6, 0x3d, 0xe7, 0x63, 0xa9, 0xfa,
-	0x94, 0x4f, 0x8c, 0xf0, 0x47, 0xc8, 0x9e, 0xa8, 0xcb, 0x5e, 0xb7, 0xcb, 0x85, 0x6a, 0x96, 0xa0,
-	0xff, 0xef, 0x9e, 0xa7, 0x4e, 0xcd, 0x10, 0xbc, 0xd0, 0xc8, 0x28, 0x75, 0xbe, 0x91, 0xa3, 0x30,
-	0x36, 0x2d, 0x52, 0x33, 0x6e, 0x8d, 0x2a, 0xfe, 0x5d, 0x01, 0x55, 0x59, 0xd8, 0xdd, 0xdb, 0x7f,
-	0xd7, 0x24, 0xb8, 0x04, 0x09, 0xfe, 0xfc, 0x5a, 0x09, 0x56, 0x1e, 0x1f, 0x3d, 0xdf, 0xdb, 0x7f,
-	0x77, 0x9c, 0x9f, 0x69, 0xf8, 0x59, 0xb7, 0xf9, 0xec, 0x2a, 0x1a, 0xd4, 0xc9, 0x1d, 0x21, 0x23,
-	0xba, 0xa7, 0x54, 0x9e, 0x42, 0xcf, 0x97, 0xdb, 0xdb, 0xe7, 0xa9, 0x83, 0xb4, 0xdf, 0xf7, 0xa9,
-	0x3c, 0x9d, 0xae, 0x61, 0x67, 0xf8, 0x2b, 0x9a, 0xa8, 0xb0, 0x17, 0x1b, 0xcf, 0x04, 0x69, 0xe3,
-	0x4c, 0x6b, 0x9a, 0xce, 0xbe, 0x49, 0x67, 0xe5, 0xc6, 0xe9, 0xec, 0x5f, 0x95, 0xce, 0xfe, 0x9b,
-	0xd2, 0xd1, 0x16, 0xd3, 0x18, 0x1e, 0x98, 0x18, 0xac, 0x1b, 0xc7, 0xf0, 0xe0, 0xaa, 0x18, 0x1e,
-	0xbc, 0x29, 0x06, 0x6d, 0x91, 0x6d, 0x9b, 0x5c, 0x9d, 0x9a, 0xe5, 0x9b, 0x6f, 0x9b, 0x7c, 0xc9,
-	0xf3, 0xdb, 0x66, 0x82, 0x6b, 0xf2, 0x4f, 0x0a, 0xa8, 0xe1, 0xf1, 0x44, 0xaa, 0x6c, 0x32, 0xe1,
-	0xdd, 0x88, 0x99, 0x10, 0x10, 0x84, 0xf0, 0xd1, 0xb5, 0x42, 0xd8, 0x30, 0x1f, 0x8a, 0x2b, 0xfc,
-	0xe5, 0xe3, 0x58, 0x9f, 0x57, 0xd2, 0xc1, 0xfc, 0x16, 0xd9, 0x5d, 0xa6, 0x98, 0x90, 0x9d, 0x9e,
-	0x08, 0x4c, 0x1c, 0x15, 0x88, 0xe3, 0xc5, 0xb5, 0xe2, 0x30, 0x3b, 0x2a, 0xef, 0x2b, 0x1f, 0x43,
-	0x7d, 0xaa, 0xa0, 0xf9, 0x07, 0xa8, 0x16, 0x66, 0x41, 0x75, 0x7a, 0x91, 0x61, 0xaf, 0x02, 0xfb,
-	0x8f, 0xaf, 0xc5, 0x6e, 0x3e, 0x19, 0xf3, 0x9e, 0xf2, 0xdc, 0xab, 0x63, 0x58, 0x33, 0xff, 0xbe,
-	0x80, 0x70, 0xdc, 0x0b, 0x85, 0x1b, 0x44, 0xd4, 0x0b, 0x99, 0x30, 0xf4, 0xab, 0x40, 0xff, 0xea,
-	0x5a, 0xf4, 0xb7, 0x35, 0xfd, 0x65, 0x6f, 0xf9, 0x10, 0xec, 0x4c, 0xe5, 0x87, 0x5a, 0x43, 0x47,
-	0x21, 0x50, 0xb5, 0xc3, 0x44, 0x14, 0x26, 0x86, 0xbe, 0x06, 0xf4, 0x1f, 0x5e, 0x8b, 0xde, 0x74,
-	0xff, 0xac, 0x9f, 0x4b, 0xdd, 0xaf, 0xc1, 0x09, 0x67, 0xc4, 0x13, 0x9f, 0x8f, 0x39, 0xf1, 0xcd,
-	0x39, 0x67, 0xfd, 0x5c, 0xe2, 0xd4, 0xa0, 0xe6, 0xfc, 0x43, 0x01, 0xad, 0x53, 0x21, 0xf8, 0xc7,
-	0xb9, 0x72, 0xaf, 0x5f, 0xf7, 0x9c, 0x1c, 0xa5, 0xce, 0x1d, 0xcd, 0x7d, 0x85, 0xbb, 0x7c, 0x08,
-	0x6b, 0xa0, 0x33, 0x57, 0xf0, 0xdf, 0x20, 0x3b, 0x66, 0x22, 0x60, 0xb3, 0xa7, 0x56, 0xe3, 0xe6,
-	0x0d, 0x9f, 0xf7, 0x75, 0x69, 0xf3, 0x83, 0xc2, 0xe4, 0xa4, 0x3a, 0x2e, 0x59, 0x75, 0xdb, 0x3e,
-	0x2e, 0x59, 0xb6, 0xbd, 0x76, 0x5c, 0xb2, 0xd6, 0x6c, 0x4c, 0x56, 0x87, 0x3c, 0xe2, 0x6e, 0xff,
-	0xbe, 0xb6, 0x27, 0x15, 0xf6, 0x31, 0x95, 0xe6, 0x4b, 0x42, 0x6a, 0x1e, 0x55, 0x34, 0x1a, 0x4a,
-	0x65, 0x9c, 0xef, 0xa2, 0xa5, 0x17, 0x2a, 0xbb, 0xeb, 0xd9, 0xa8, 0x78, 0xc6, 0x86, 0xfa, 0x96,
-	0x41, 0xb2, 0x21, 0x6e, 0xa0, 0xa5, 0x3e, 0x8d, 0x7a, 0xfa, 0xd2, 0x58, 0x26, 0x5a, 0x68, 0x7d,
-	0x80, 0xea, 0x2f, 0x05, 0x4d, 0x24, 0xf5, 0x54, 0xc8, 0x93, 0xa7, 0x3c, 0x90, 0x18, 0xa3, 0x12,
-	0x9c, 0x23, 0xda, 0x16, 0xc6, 0xf8, 0xbb, 0xa8, 0x14, 0xf1, 0x40, 0x36, 0x17, 0xb7, 0x8a, 0xdb,
-	0x95, 0x7b, 0xeb, 0xf9, 0xfb, 0xd4, 0x53, 0x1e, 0x10, 0x50, 0x68, 0xfd, 0x63, 0x11, 0x15, 0x9f,
-	0xf2, 0x00, 0x37, 0xd1, 0x0a, 0xf5, 0x7d, 0xc1, 0xa4, 0x34, 0x7e, 0xc6, 0x22, 0xbe, 0x85, 0x96,
-	0x15, 0xef, 0x86, 0x9e, 0x76, 0x56, 0x26, 0x46, 0xca, 0x68, 0x7d, 0xaa, 0x28, 0xdc, 0x10, 0xaa,
-	0x04, 0xc6, 0xf8, 0x1e, 0xaa, 0x42, 0x5e, 0x6e, 0xd2, 0x8b, 0x3b, 0x4c, 0xc0, 0x91, 0x5d, 0x6a,
-	0xd7, 0x2f, 0x52, 0xa7, 0x02, 0xf3, 0x1f, 0xc0, 0x34, 0x99, 0x15, 0xf0, 0x3b, 0x68, 0x45, 0x0d,
-	0xf4, 0x49, 0xa8, 0xcf, 0xe2, 0xf5, 0x8b, 0xd4, 0xa9, 0xab, 0x69, 0x92, 0xd9, 0x41, 0x47, 0x96,
-	0xd5, 0x00, 0x0e, 0xbc, 0x5d, 0x64, 0xa9, 0x81, 0x1b, 0x26, 0x3e, 0x1b, 0xc0, 0xc1, 0x59, 0x6a,
-	0x37, 0x2e, 0x52, 0xc7, 0x9e, 0x51, 0x3f, 0xca, 0x30, 0xb2, 0xa2, 0x06, 0x30, 0xc0, 0xef, 0x20,
-	0xa4, 0x43, 0x02, 0x06, 0x7d, 0x3c, 0xae, 0x5e, 0xa4, 0x4e, 0x19, 0x66, 0xc1, 0xf7, 0x74, 0x88,
-	0x5b, 0x68, 0x49, 0xfb, 0xb6, 0xc0, 0x77, 0xf5, 0x22, 0x75, 0xac, 0x88, 0x07, 0xda, 0xa7, 0x86,
-	0xb2, 0x52, 0x09, 0x16, 0xf3, 0x3e, 0xf3, 0xe1, 0x8c, 0xb1, 0xc8, 0x58, 0x6c, 0xfd, 0x79, 0x11,
-	0x59, 0x2f, 0x07, 0x84, 0xc9, 0x5e, 0xa4, 0xf0, 0x13, 0x64, 0x7b, 0x3c, 0x51, 0x82, 0x7a, 0xca,
-	0x9d, 0x2b, 0x6d, 0x7b, 0x63, 0xda, 0x6d, 0x79, 0x8d, 0x16, 0xa9, 0x8f, 0xa7, 0x0e, 0x4c, 0xfd,
-	0x1b, 0x68, 0xa9, 0x13, 0x71, 0x1e, 0x43, 0x1f, 0x54, 0x89, 0x16, 0xf0, 0x2b, 0xa8, 0x1a, 0xac,
-	0x71, 0xf1, 0xea, 0x3b, 0x73, 0xae, 0x4d, 0xda, 0x1b, 0xe6, 0xce, 0x5c, 0xd3, 0xcc, 0xc6, 0xda,
-	0xdc, 0x94, 0x97, 0xd5, 0x00, 0x7a, 0xc9, 0x46, 0x45, 0xc1, 0xf4, 0x55, 0xab, 0x4a, 0xb2, 0x21,
-	0xbe, 0x83, 0x2c, 0xc1, 0xfa, 0x4c, 0x28, 0xe6, 0xc3, 0xfa, 0x58, 0x64, 0x22, 0xe3, 0xdb, 0xc8,
-	0x0a, 0xa8, 0x74, 0x7b, 0x92, 0xf9, 0x7a, 0x31, 0xc8, 0x4a, 0x40, 0xe5, 0x2b, 0xc9, 0xfc, 0x87,
-	0xa5, 0x3f, 0x7d, 0xea, 0x2c, 0xb4, 0x28, 0xaa, 0x1c, 0x78, 0x1e, 0x93, 0xf2, 0x65, 0xaf, 0x1b,
-	0xb1, 0x37, 0x34, 0xd9, 0x3d, 0x54, 0x95, 0x8a, 0x0b, 0x1a, 0x30, 0xf7, 0x8c, 0x0d, 0x4d, 0xab,
-	0xe9, 0xc6, 0x31, 0xf3, 0x3f, 0x62, 0x43, 0x49, 0x66, 0x05, 0x43, 0x91, 0x16, 0x51, 0xe5, 0xa5,
-	0xa0, 0x1e, 0x33, 0x77, 0xf6, 0xac, 0x5d, 0x33, 0x51, 0x18, 0x0a, 0x23, 0x65, 0xdc, 0x2a, 0x8c,
-	0x19, 0xef, 0x29, 0xb3, 0xa1, 0xc6, 0x62, 0x66, 0x21, 0x18, 0x1b, 0x30, 0x0f, 0x2a, 0x59, 0x22,
-	0x46, 0xc2, 0xfb, 0x68, 0xd5, 0x0f, 0x25, 0x3c, 0xb1, 0xa4, 0xa2, 0xe6, 0xaa, 0x68, 0xb5, 0xed,
-	0x8b, 0xd4, 0xa9, 0x1a, 0xe0, 0x45, 0x36, 0x4f, 0xe6, 0x24, 0xfc, 0x03, 0x54, 0x9f, 0x9a, 0x41,
-	0xb4, 0x50, 0x1b, 0xab, 0x8d, 0x2f, 0x52, 0xa7, 0x36, 0x51, 0x05, 0x84, 0xe4, 0xe4, 0x6c, 0xb1,
-	0x7d, 0xd6, 0xe9, 0x05, 0xd0, 0x7f, 0x16, 0xd1, 0x42, 0x36, 0x1b, 0x85, 0x71, 0xa8, 0xa0, 0xdf,
-	0x96, 0x88, 0x16, 0xf0, 0xf7, 0x51, 0x99, 0xf7, 0x99, 0x10, 0xa1, 0xcf, 0x24, 0x5c, 0x35, 0xde,
-	0xfc, 0x3e, 0x23, 0x53, 0xed, 0x2c, 0x35, 0xf3, 0x78, 0x8c, 0x59, 0xcc, 0xc5, 0x10, 0x6e, 0x08,
-	0x26, 0x35, 0x0d, 0x3c, 0x83, 0x79, 0x32, 0x27, 0xe1, 0x36, 0xc2, 0xc6, 0x4c, 0x30, 0xd5, 0x13,
-	0x89, 0x0b, 0x1f, 0x80, 0x2a, 0xd8, 0xc2, 0x36, 0xd4, 0x28, 0x01, 0xf0, 0x90, 0x2a, 0x4a, 0x2e,
-	0xcd, 0x1c, 0x97, 0xac, 0x92, 0xbd, 0x74, 0x5c, 0xb2, 0x56, 0x6c, 0x6b, 0x92, 0xbd, 0x89, 0x82,
-	0xac, 0x8f, 0xe5, 0x19, 0xf7, 0xad, 0xbf, 0x2f, 0xa2, 0x7a, 0xee, 0xfd, 0x87, 0x37, 0x51, 0x25,
-	0xe1, 0x6e, 0x87, 0x4a, 0xe6, 0x9e, 0x30, 0x06, 0x2b, 0x6d, 0x91, 0x72, 0xc2, 0xdb, 0x54, 0xb2,
-	0x27, 0x8c, 0xe1, 0xf7, 0xd0, 0xc6, 0x18, 0x74, 0xbd, 0x53, 0x9a, 0x04, 0x4c, 0x3f, 0xce, 0xc3,
-	0x84, 0x2a, 0x2e, 0xa0, 0x01, 0x56, 0x49, 0xb3, 0xa3, 0xb5, 0x1f, 0x81, 0xc2, 0xe1, 0x14, 0xc7,
-	0xf7, 0xd1, 0x5b, 0x2c, 0xa2, 0x52, 0x85, 0x5e, 0xa8, 0x86, 0x6e, 0xdc, 0x8b, 0x54, 0xd8, 0x8d,
-	0x42, 0x26, 0xa0, 0x41, 0x56, 0x49, 0x63, 0x0a, 0x3e, 0x9b, 0x60, 0xf8, 0x5b, 0x93, 0x9a, 0x9e,
-	0xb2, 0x30, 0x38, 0x55, 0xd0, 0x2e, 0xc5, 0x71, 0x05, 0xdf, 0x87, 0x39, 0xfc, 0x33, 0x64, 0x4d,
-	0xa2, 0xd6, 0xf7, 0xfe, 0x83, 0x6c, 0x5b, 0x7e, 0xfd, 0x83, 0x6a, 0xfe, 0x34, 0xd2, 0x9b, 0x77,
-	0xc5, 0x24, 0xa2, 0x6b, 0x4b, 0xec, 0x30, 0x09, 0x55, 0x48, 0xa3, 0x49, 0x7d, 0xda, 0xcf, 0x3e,
-	0x3b, 0xdf, 0x2c, 0x7c, 0x71, 0xbe, 0x59, 0xf8, 0xf2, 0x7c, 0xb3, 0xf0, 0x9f, 0xf3, 0xcd, 0xc2,
-	0x5f, 0x5e, 0x6f, 0x2e, 0x7c, 0xf9, 0x7a, 0x73, 0xe1, 0x5f, 0xaf, 0x37, 0x17, 0x7e, 0xba, 0x3b,
-	0xc3, 0x6c, 0xda, 0x27, 0x61, 0x6a, 0x3c, 0xbc, 0x0b, 0x2f, 0x53, 0xf3, 0x4e, 0x86, 0x30, 0x3a,
-	0xcb, 0xf0, 0xbf, 0x91, 0xfb, 0xff, 0x0f, 0x00, 0x00, 0xff, 0xff, 0x92, 0x43, 0x73, 0x79, 0x8b,
-	0x11, 0x00, 0x00,
+	0x15, 0x8f, 0x63, 0x27, 0x6e, 0x97, 0x1d, 0xbb, 0x53, 0xf1, 0x0e, 0x9e, 0x89, 0x48, 0x47, 0x8d,
+	0x80, 0x68, 0xb5, 0x93, 0x6c, 0x66, 0x14, 0x31, 0x0c, 0xda, 0x43, 0x7a, 0x32, 0xc3, 0x26, 0xcc,
+	0xec, 0x0e, 0x35, 0x19, 0x56, 0x42, 0x88, 0xa6, 0xdc, 0x5d, 0x69, 0x37, 0xe9, 0xee, 0x32, 0x55,
+	0x65, 0xaf, 0x0d, 0x02, 0x09, 0x21, 0x01, 0xda, 0x03, 0xe2, 0xc8, 0x71, 0xc5, 0x69, 0x8f, 0x7b,
+	0xe0, 0x03, 0x70, 0x5c, 0x71, 0x5a, 0x71, 0x42, 0x1c, 0x5a, 0x28, 0x73, 0x58, 0x29, 0x1c, 0x90,
+	0xfc, 0x09, 0x50, 0x57, 0x95, 0xff, 0x75, 0xa2, 0xd9, 0x75, 0x2e, 0x71, 0xbd, 0xbf, 0xbf, 0xf7,
+	0x5e, 0xbd, 0xea, 0x57, 0x15, 0xd0, 0xe2, 0x82, 0x61, 0x41, 0xf9, 0x1e, 0xe9, 0xc7, 0x7b, 0xfd,
+	0xfd, 0xec, 0x67, 0xb7, 0xcb, 0xa8, 0xa0, 0xb0, 0xae, 0x25, 0xbb, 0x19, 0xab, 0xbf, 0x7f, 0xa7,
+	0x19, 0xd0, 0x80, 0x4a, 0xd1, 0x5e, 0xb6, 0x52, 0x5a, 0x77, 0x6e, 0x7b, 0x94, 0xc7, 0x94, 0xbb,
+	0x4a, 0xa0, 0x08, 0x2d, 0x5a, 0xc7, 0x71, 0x98, 0xd0, 0x3d, 0xf9, 0x57, 0xb1, 0xec, 0xff, 0x16,
+	0xc1, 0xea, 0x73, 0xcc, 0x70, 0xcc, 0xe1, 0x3e, 0xa8, 0x90, 0x7e, 0xec, 0xfa, 0x24, 0xa1, 0x71,
+	0xab, 0xb0, 0x5d, 0xd8, 0xa9, 0x38, 0xcd, 0x51, 0x6a, 0x99, 0x43, 0x1c, 0x47, 0x0f, 0xed, 0x89,
+	0xc8, 0x46, 0x06, 0xe9, 0xc7, 0x47, 0xd9, 0x12, 0xbe, 0x03, 0xd6, 0x48, 0x82, 0xdb, 0x11, 0x71,
+	0x3d, 0x46, 0xb0, 0x20, 0xad, 0xe5, 0xed, 0xc2, 0x8e, 0xe1, 0xb4, 0x46, 0xa9, 0xd5, 0xd4, 0x66,
+	0xb3, 0x62, 0x1b, 0xd5, 0x14, 0xfd, 0x48, 0x92, 0xf0, 0x3b, 0xa0, 0x3a, 0x96, 0xe3, 0x28, 0x6a,
+	0x15, 0xa5, 0xf1, 0xad, 0x51, 0x6a, 0xc1, 0x79, 0x63, 0x1c, 0x45, 0x36, 0x02, 0xda, 0x14, 0x47,
+	0x11, 0x3c, 0x04, 0x80, 0x0c, 0x04, 0xc3, 0x2e, 0x09, 0xbb, 0xbc, 0x55, 0xda, 0x2e, 0xee, 0x14,
+	0x1d, 0xfb, 0x22, 0xb5, 0x2a, 0x8f, 0x33, 0xee, 0xe3, 0xe3, 0xe7, 0x7c, 0x94, 0x5a, 0xeb, 0xda,
+	0xc9, 0x44, 0xd1, 0x46, 0x15, 0x49, 0x3c, 0x0e, 0xbb, 0x1c, 0xfe, 0x0c, 0xd4, 0xbc, 0x0e, 0x0e,
+	0x13, 0xd7, 0xa3, 0xc9, 0x59, 0x18, 0xb4, 0x56, 0xb6, 0x0b, 0x3b, 0xd5, 0x7b, 0x9b, 0xbb, 0xf3,
+	0x35, 0xde, 0x7d, 0x94, 0xe9, 0x3c, 0x92, 0x2a, 0xce, 0xf6, 0x67, 0xa9, 0xb5, 0x34, 0x4a, 0xad,
+	0x0d, 0xe5, 0x78, 0xd6, 0xdc, 0xfe, 0xe4, 0x8b, 0x4f, 0xdf, 0x2c, 0xa0, 0xaa, 0x37, 0x55, 0x87,
+	0x0c, 0xac, 0x9f, 0x11, 0xe2, 0xc6, 0x98, 0x9d, 0x13, 0xe1, 0x76, 0x65, 0x91, 0x5b, 0xab, 0x12,
+	0xc6, 0xca, 0xc3, 0x3c, 0x21, 0xe4, 0x99, 0xd4, 0x53, 0x7b, 0xe1, 0x7c, 0x53, 0x43, 0xb5, 0x14,
+	0xd4, 0x15, 0x3f, 0x1a, 0xaf, 0x71, 0x36, 0x6f, 0xf7, 0xf0, 0xeb, 0x7f, 0xf9, 0xd8, 0x2a, 0x7c,
+	0xf4, 0xc5, 0xa7, 0x6f, 0x36, 0xc7, 0x5d, 0x34, 0x90, 0x7d, 0xa4, 0xc4, 0xf6, 0xff, 0x4c, 0x50,
+	0x9d, 0xc9, 0x08, 0xfe, 0x02, 0x18, 0x2a, 0x8b, 0xd0, 0xd7, 0x3b, 0xfe, 0xa3, 0x7f, 0xa7, 0xd6,
+	0xb7, 0x82, 0x50, 0x74, 0x7a, 0xed, 0x5d, 0x8f, 0xc6, 0xba, 0x7f, 0xf4, 0xcf, 0x5d, 0xee, 0x9f,
+	0xef, 0x89, 0x61, 0x97, 0xf0, 0xdd, 0xe3, 0x44, 0x5c, 0xa4, 0x56, 0x59, 0x3a, 0x3b, 0x3e, 0x1a,
+	0xa5, 0x56, 0x63, 0xb6, 0x28, 0xa1, 0x6f, 0xff, 0xf3, 0x6f, 0x77, 0x81, 0x6e, 0xbe, 0xe3, 0x44,
+	0xa0, 0xb2, 0x14, 0x1c, 0xfb, 0xf0, 0x57, 0xa0, 0xd1, 0xa1, 0x31, 0xe1, 0x82, 0x60, 0xdf, 0x6d,
+	0x47, 0xd4, 0x3b, 0x97, 0x4d, 0x53, 0x71, 0xd0, 0x57, 0x47, 0x1e, 0xa5, 0xd6, 0x2d, 0x05, 0x97,
+	0x73, 0x95, 0x47, 0xad, 0x4f, 0xe4, 0x4e, 0x26, 0x86, 0x7f, 0x28, 0x80, 0xba, 0x8f, 0xa9, 0x7b,
+	0x46, 0xd9, 0xb9, 0x06, 0x2f, 0x4a, 0x70, 0xbc, 0x50, 0xda, 0xb5, 0xa3, 0xc3, 0xf7, 0x9f, 0x50,
+	0x76, 0x2e, 0x9d, 0x8e, 0x52, 0xeb, 0x0d, 0x15, 0xcc, 0xbc, 0xe7, 0x7c, 0x2c, 0x35, 0x1f, 0xd3,
+	0x89, 0x11, 0xfc, 0x00, 0x98, 0x13, 0x75, 0xde, 0xeb, 0x76, 0x29, 0x13, 0xad, 0x92, 0xec, 0xff,
+	0xbb, 0x17, 0xa9, 0x55, 0xd7, 0x00, 0x2f, 0x94, 0x64, 0x94, 0x5a, 0x5f, 0xcb, 0x41, 0x68, 0x1b,
+	0x1b, 0xd5, 0xb5, 0x5b, 0xad, 0x0a, 0x7f, 0x5b, 0x00, 0x35, 0x12, 0x76, 0xf7, 0x0f, 0xde, 0xd6,
+	0x09, 0xae, 0xc8, 0x04, 0x7f, 0xba, 0x50, 0x82, 0xd5, 0xc7, 0xc7, 0xcf, 0xf7, 0x0f, 0xde, 0x1e,
+	0xe7, 0xa7, 0x1b, 0x7e, 0xd6, 0x6d, 0x3e, 0xbb, 0xaa, 0x12, 0xaa, 0xe4, 0x8e, 0x81, 0x26, 0xdd,
+	0x0e, 0xe6, 0x1d, 0xd9, 0xf3, 0x15, 0x67, 0xe7, 0x22, 0xb5, 0x80, 0xf2, 0xfb, 0x2e, 0xe6, 0x9d,
+	0xe9, 0x1e, 0xb6, 0x87, 0xbf, 0xc4, 0x89, 0x08, 0x7b, 0xb1, 0xf6, 0x8c, 0x80, 0x32, 0xce, 0xb4,
+	0xa6, 0xe9, 0x1c, 0xe8, 0x74, 0xca, 0x37, 0x4e, 0xe7, 0xe0, 0xba, 0x74, 0x0e, 0x5e, 0x97, 0x8e,
+	0xb2, 0x98, 0xc6, 0xf0, 0x40, 0xc7, 0x60, 0xdc, 0x38, 0x86, 0x07, 0xd7, 0xc5, 0xf0, 0xe0, 0x75,
+	0x31, 0x28, 0x8b, 0xec, 0xd8, 0xe4, 0xea, 0xd4, 0xaa, 0xdc, 0xfc, 0xd8, 0xe4, 0x4b, 0x9e, 0x3f,
+	0x36, 0x13, 0xb9, 0x02, 0xff, 0xa8, 0x00, 0x9a, 0x1e, 0x4d, 0xb8, 0xc8, 0x98, 0x09, 0xed, 0x46,
+	0x44, 0x87, 0x00, 0x64, 0x08, 0x1f, 0x2c, 0x14, 0xc2, 0xa6, 0xfe, 0x50, 0x5c, 0xe3, 0x2f, 0x1f,
+	0xc7, 0xc6, 0xbc, 0x92, 0x0a, 0xe6, 0x37, 0xc0, 0xec, 0x12, 0x41, 0x18, 0x6f, 0xf7, 0x58, 0xa0,
+	0xe3, 0xa8, 0xca, 0x38, 0x5e, 0x2c, 0x14, 0x87, 0x3e, 0x51, 0x79, 0x5f, 0xf9, 0x18, 0x1a, 0x53,
+	0x05, 0x85, 0x3f, 0x00, 0xf5, 0x30, 0x0b, 0xaa, 0xdd, 0x8b, 0x34, 0x7a, 0x4d, 0xa2, 0xff, 0x70,
+	0x21, 0x74, 0xfd, 0xc9, 0x98, 0xf7, 0x94, 0xc7, 0x5e, 0x1b, 0x8b, 0x15, 0xf2, 0xef, 0x0a, 0x00,
+	0xc6, 0xbd, 0x90, 0xb9, 0x41, 0x84, 0xbd, 0x90, 0x30, 0x0d, 0xbf, 0x26, 0xe1, 0x5f, 0x2e, 0x04,
+	0x7f, 0x5b, 0xc1, 0x5f, 0xf5, 0x96, 0x0f, 0xc1, 0xcc, 0x54, 0xbe, 0xaf, 0x34, 0x54, 0x14, 0x0c,
+	0xd4, 0xda, 0x84, 0x45, 0x61, 0xa2, 0xe1, 0xeb, 0x12, 0xfe, 0xfd, 0x85, 0xe0, 0x75, 0xf7, 0xcf,
+	0xfa, 0xb9, 0xd2, 0xfd, 0x4a, 0x38, 0xc1, 0x8c, 0x68, 0xe2, 0xd3, 0x31, 0x26, 0xbc, 0x39, 0xe6,
+	0xac, 0x9f, 0x2b, 0x98, 0x4a, 0xa8, 0x30, 0x7f, 0x5f, 0x00, 0x1b, 0x98, 0x31, 0xfa, 0x61, 0xae,
+	0xdc, 0x1b, 0x8b, 0xce, 0xc9, 0x51, 0x6a, 0xdd, 0x51, 0xd8, 0xd7, 0xb8, 0xcb, 0x87, 0xb0, 0x2e,
+	0x75, 0xe6, 0x0a, 0xfe, 0x6b, 0x60, 0xc6, 0x84, 0x05, 0x64, 0x76, 0x6a, 0x35, 0x6f, 0xde, 0xf0,
+	0x79, 0x5f, 0x57, 0x0e, 0xbf, 0x54, 0x98, 0x4c, 0xaa, 0x93, 0x92, 0xd1, 0x30, 0xcd, 0x93, 0x92,
+	0x61, 0x9a, 0xeb, 0x27, 0x25, 0x63, 0xdd, 0x84, 0x68, 0x6d, 0x48, 0x23, 0xea, 0xf6, 0xef, 0x2b,
+	0x7b, 0x54, 0x25, 0x1f, 0x62, 0xae, 0xbf, 0x24, 0xa8, 0xee, 0x61, 0x81, 0xa3, 0x21, 0x17, 0xda,
+	0xf9, 0x1e, 0x58, 0x79, 0x21, 0xb2, 0xbb, 0x9e, 0x09, 0x8a, 0xe7, 0x64, 0xa8, 0x6e, 0x19, 0x28,
+	0x5b, 0xc2, 0x26, 0x58, 0xe9, 0xe3, 0xa8, 0xa7, 0x2e, 0x8d, 0x15, 0xa4, 0x08, 0xfb, 0x3d, 0xd0,
+	0x38, 0x65, 0x38, 0xe1, 0xd8, 0x13, 0x21, 0x4d, 0x9e, 0xd2, 0x80, 0x43, 0x08, 0x4a, 0x72, 0x8e,
+	0x28, 0x5b, 0xb9, 0x86, 0xdf, 0x06, 0xa5, 0x88, 0x06, 0xbc, 0xb5, 0xbc, 0x5d, 0xdc, 0xa9, 0xde,
+	0xdb, 0xc8, 0xdf, 0xa7, 0x9e, 0xd2, 0x00, 0x49, 0x05, 0xfb, 0x1f, 0xcb, 0xa0, 0xf8, 0x94, 0x06,
+	0xb0, 0x05, 0xca, 0xd8, 0xf7, 0x19, 0xe1, 0x5c, 0xfb, 0x19, 0x93, 0xf0, 0x16, 0x58, 0x15, 0xb4,
+	0x1b, 0x7a, 0xca, 0x59, 0x05, 0x69, 0x2a, 0x83, 0xf5, 0xb1, 0xc0, 0xf2, 0x86, 0x50, 0x43, 0x72,
+	0x0d, 0xef, 0x81, 0x9a, 0xcc, 0xcb, 0x4d, 0x7a, 0x71, 0x9b, 0x30, 0x39, 0xb2, 0x4b, 0x4e, 0xe3,
+	0x32, 0xb5, 0xaa, 0x92, 0xff, 0x9e, 0x64, 0xa3, 0x59, 0x02, 0xbe, 0x05, 0xca, 0x62, 0xa0, 0x26,
+	0xa1, 0x9a, 0xc5, 0x1b, 0x97, 0xa9, 0xd5, 0x10, 0xd3, 0x24, 0xb3, 0x41, 0x87, 0x56, 0xc5, 0x20,
+	0xfb, 0x85, 0x7b, 0xc0, 0x10, 0x03, 0x37, 0x4c, 0x7c, 0x32, 0x90, 0x83, 0xb3, 0xe4, 0x34, 0x2f,
+	0x53, 0xcb, 0x9c, 0x51, 0x3f, 0xce, 0x64, 0xa8, 0x2c, 0x06, 0x72, 0x01, 0xdf, 0x02, 0x40, 0x85,
+	0x24, 0x11, 0xd4, 0x78, 0x5c, 0xbb, 0x4c, 0xad, 0x8a, 0xe4, 0x4a, 0xdf, 0xd3, 0x25, 0xb4, 0xc1,
+	0x8a, 0xf2, 0x6d, 0x48, 0xdf, 0xb5, 0xcb, 0xd4, 0x32, 0x22, 0x1a, 0x28, 0x9f, 0x4a, 0x94, 0x95,
+	0x8a, 0x91, 0x98, 0xf6, 0x89, 0x2f, 0x67, 0x8c, 0x81, 0xc6, 0xa4, 0xfd, 0xa7, 0x65, 0x60, 0x9c,
+	0x0e, 0x10, 0xe1, 0xbd, 0x48, 0xc0, 0x27, 0xc0, 0xf4, 0x68, 0x22, 0x18, 0xf6, 0x84, 0x3b, 0x57,
+	0x5a, 0x67, 0x73, 0xda, 0x6d, 0x79, 0x0d, 0x1b, 0x35, 0xc6, 0xac, 0x43, 0x5d, 0xff, 0x26, 0x58,
+	0x69, 0x47, 0x94, 0xc6, 0xb2, 0x0f, 0x6a, 0x48, 0x11, 0xf0, 0xa5, 0xac, 0x9a, 0xdc, 0xe3, 0xe2,
+	0xf5, 0x77, 0xe6, 0x5c, 0x9b, 0x38, 0x9b, 0xfa, 0xce, 0x5c, 0x57, 0xc8, 0xda, 0x5a, 0xdf, 0x94,
+	0x57, 0xc5, 0x40, 0xf6, 0x92, 0x09, 0x8a, 0x8c, 0xa8, 0xab, 0x56, 0x0d, 0x65, 0x4b, 0x78, 0x07,
+	0x18, 0x8c, 0xf4, 0x09, 0x13, 0xc4, 0x97, 0xfb, 0x63, 0xa0, 0x09, 0x0d, 0x6f, 0x03, 0x23, 0xc0,
+	0xdc, 0xed, 0x71, 0xe2, 0xab, 0xcd, 0x40, 0xe5, 0x00, 0xf3, 0x97, 0x9c, 0xf8, 0x0f, 0x4b, 0x7f,
+	0xfc, 0xd8, 0x5a, 0xb2, 0x31, 0xa8, 0x1e, 0x7a, 0x1e, 0xe1, 0xfc, 0xb4, 0xd7, 0x8d, 0xc8, 0x6b,
+	0x9a, 0xec, 0x1e, 0xa8, 0x71, 0x41, 0x19, 0x0e, 0x88, 0x7b, 0x4e, 0x86, 0xba, 0xd5, 0x54, 0xe3,
+	0x68, 0xfe, 0x0f, 0xc8, 0x90, 0xa3, 0x59, 0x42, 0x43, 0xfc, 0xb5, 0x04, 0xaa, 0xa7, 0x0c, 0x7b,
+	0x44, 0xdf, 0xd9, 0xb3, 0x76, 0xcd, 0x48, 0xa6, 0x21, 0x34, 0x95, 0x61, 0x8b, 0x30, 0x26, 0xb4,
+	0x27, 0xf4, 0x81, 0x1a, 0x93, 0x99, 0x05, 0x23, 0x64, 0x40, 0x3c, 0x59, 0xc9, 0x12, 0xd2, 0x14,
+	0x3c, 0x00, 0x6b, 0x7e, 0xc8, 0xe5, 0x13, 0x8b, 0x0b, 0xac, 0xaf, 0x8a, 0x86, 0x63, 0x5e, 0xa6,
+	0x56, 0x4d, 0x0b, 0x5e, 0x64, 0x7c, 0x34, 0x47, 0xc1, 0xef, 0x81, 0xc6, 0xd4, 0x4c, 0x46, 0x2b,
+	0x6b, 0x63, 0x38, 0xf0, 0x32, 0xb5, 0xea, 0x13, 0x55, 0x29, 0x41, 0x39, 0x3a, 0xdb, 0x6c, 0x9f,
+	0xb4, 0x7b, 0x81, 0xec, 0x3f, 0x03, 0x29, 0x22, 0xe3, 0x46, 0x61, 0x1c, 0x0a, 0xd9, 0x6f, 0x2b,
+	0x48, 0x11, 0xf0, 0xbb, 0xa0, 0x42, 0xfb, 0x84, 0xb1, 0xd0, 0x27, 0xbc, 0x05, 0xbe, 0xf4, 0x7d,
+	0x86, 0xa6, 0xda, 0x59, 0x6a, 0xfa, 0xf1, 0x18, 0x93, 0x98, 0xb2, 0x61, 0xab, 0x3a, 0x4d, 0x4d,
+	0x09, 0x9e, 0x49, 0x3e, 0x9a, 0xa3, 0xa0, 0x03, 0xa0, 0x36, 0x63, 0x44, 0xf4, 0x58, 0xe2, 0xca,
+	0x0f, 0x40, 0x4d, 0xda, 0xca, 0x63, 0xa8, 0xa4, 0x48, 0x0a, 0x8f, 0xb0, 0xc0, 0xe8, 0x0a, 0x27,
+	0xf3, 0xa1, 0x76, 0xc4, 0xfd, 0x39, 0xa7, 0x93, 0xe7, 0xa5, 0x1a, 0xd2, 0xe3, 0xa3, 0xec, 0x11,
+	0x76, 0xc2, 0xe9, 0x38, 0xee, 0x2b, 0x9c, 0x93, 0x92, 0x51, 0x32, 0x57, 0x4e, 0x4a, 0x46, 0xd9,
+	0x34, 0x26, 0x15, 0xd4, 0x99, 0xa0, 0x8d, 0x31, 0x3d, 0x13, 0xa2, 0xfd, 0xf7, 0x65, 0xd0, 0xc8,
+	0xbd, 0x21, 0xe1, 0x16, 0xa8, 0x26, 0xd4, 0x6d, 0x63, 0x4e, 0xdc, 0x33, 0x42, 0x64, 0xb7, 0x18,
+	0xa8, 0x92, 0x50, 0x07, 0x73, 0xf2, 0x84, 0x10, 0xf8, 0x0e, 0xd8, 0x1c, 0x0b, 0x5d, 0xaf, 0x83,
+	0x93, 0x80, 0xa8, 0x07, 0x7e, 0x98, 0x60, 0x41, 0x99, 0x6c, 0xa2, 0x35, 0xd4, 0x6a, 0x2b, 0xed,
+	0x47, 0x52, 0xe1, 0x68, 0x2a, 0x87, 0xf7, 0xc1, 0x1b, 0x24, 0xc2, 0x5c, 0x84, 0x5e, 0x28, 0x86,
+	0x6e, 0xdc, 0x8b, 0x44, 0xd8, 0x8d, 0x42, 0xc2, 0x64, 0x93, 0xad, 0xa1, 0xe6, 0x54, 0xf8, 0x6c,
+	0x22, 0x83, 0xdf, 0x98, 0xec, 0x4b, 0x87, 0x84, 0x41, 0x47, 0xc8, 0x96, 0x2b, 0x8e, 0x77, 0xe1,
+	0x5d, 0xc9, 0x83, 0x3f, 0x01, 0xc6, 0x24, 0x6a, 0xf5, 0x76, 0x38, 0xcc, 0x8e, 0xf6, 0x57, 0x1f,
+	0x76, 0xf3, 0x13, 0x4d, 0x7d, 0x00, 0xca, 0x3a, 0x11, 0x55, 0x5b, 0x64, 0x86, 0x49, 0x28, 0x42,
+	0x1c, 0x4d, 0xea, 0xe3, 0x3c, 0xfb, 0xe4, 0x62, 0xab, 0xf0, 0xd9, 0xc5, 0x56, 0xe1, 0xf3, 0x8b,
+	0xad, 0xc2, 0x7f, 0x2e, 0xb6, 0x0a, 0x7f, 0x7e, 0xb5, 0xb5, 0xf4, 0xf9, 0xab, 0xad, 0xa5, 0x7f,
+	0xbd, 0xda, 0x5a, 0xfa, 0xf1, 0xde, 0x0c, 0xb2, 0x6e, 0xc1, 0x84, 0x88, 0xf1, 0xf2, 0xae, 0x7c,
+	0xdd, 0xea, 0xb7, 0xb6, 0x0c, 0xa3, 0xbd, 0x2a, 0xff, 0xbf, 0x72, 0xff, 0xff, 0x03, 0x00, 0xcc,
+	0xd9, 0x98, 0x6e, 0xcf, 0x11, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -1271,6 +1281,9 @@ func (this *TraceConfig) Equal(that interface{}) bool {
 	if this.EnableReturnData != that1.EnableReturnData {
 		return false
 	}
+	if this.TracerJsonConfig != that1.TracerJsonConfig {
+		return false
+	}
 	return true
 }
 func (this *FeeMarketParams) Equal(that interface{}) bool {
@@ -1918,6 +1931,13 @@ func (m *TraceConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.TracerJsonConfig) > 0 {
+		i -= len(m.TracerJsonConfig)
+		copy(dAtA[i:], m.TracerJsonConfig)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.TracerJsonConfig)))
+		i--
+		dAtA[i] = 0x6a
+	}
 	if m.EnableReturnData {
 		i--
 		if m.EnableReturnData {
@@ -2348,6 +2368,10 @@ func (m *TraceConfig) Size() (n int) {
 	if m.EnableReturnData {
 		n += 2
 	}
+	l = len(m.TracerJsonConfig)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
 	return n
 }
 
@@ -4408,6 +4432,38 @@ func (m *TraceConfig) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.EnableReturnData = bool(v != 0)
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TracerJsonConfig", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TracerJsonConfig = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvm(dAtA[iNdEx:])
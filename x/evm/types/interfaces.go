@@ -1,6 +1,7 @@
 package types
 
 import (
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -46,6 +47,11 @@ type PotKeeper interface {
 	SafeMintCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
 }
 
+// SdsKeeper defines the expected sds keeper interface used by the NOZPRICETWAP opcode
+type SdsKeeper interface {
+	GetNozPriceTWAP(ctx sdk.Context, window int64) (sdkmath.LegacyDec, error)
+}
+
 // Event Hooks
 // These can be utilized to customize evm transaction processing.
 
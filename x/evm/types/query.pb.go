@@ -793,6 +793,10 @@ type EthCallRequest struct {
 	Args []byte `protobuf:"bytes,1,opt,name=args,proto3" json:"args,omitempty"`
 	// the default gas cap to be used
 	GasCap uint64 `protobuf:"varint,2,opt,name=gas_cap,json=gasCap,proto3" json:"gas_cap,omitempty"`
+	// state overrides applied before the call, same json format as the json rpc api.
+	Overrides []byte `protobuf:"bytes,3,opt,name=overrides,proto3" json:"overrides,omitempty"`
+	// block overrides applied to the call context, same json format as the json rpc api.
+	BlockOverrides []byte `protobuf:"bytes,4,opt,name=block_overrides,json=blockOverrides,proto3" json:"block_overrides,omitempty"`
 }
 
 func (m *EthCallRequest) Reset()         { *m = EthCallRequest{} }
@@ -842,6 +846,20 @@ func (m *EthCallRequest) GetGasCap() uint64 {
 	return 0
 }
 
+func (m *EthCallRequest) GetOverrides() []byte {
+	if m != nil {
+		return m.Overrides
+	}
+	return nil
+}
+
+func (m *EthCallRequest) GetBlockOverrides() []byte {
+	if m != nil {
+		return m.BlockOverrides
+	}
+	return nil
+}
+
 // EstimateGasResponse defines EstimateGas response
 type EstimateGasResponse struct {
 	// the estimated gas
@@ -888,6 +906,79 @@ func (m *EstimateGasResponse) GetGas() uint64 {
 	return 0
 }
 
+// CreateAccessListResponse defines CreateAccessList response
+type CreateAccessListResponse struct {
+	// the access list in the same json format as the json rpc api
+	AccessList []byte `protobuf:"bytes,1,opt,name=access_list,json=accessList,proto3" json:"access_list,omitempty"`
+	// the gas used by the call with the access list applied
+	GasUsed uint64 `protobuf:"varint,2,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// vm error is the error returned by vm execution
+	VmError string `protobuf:"bytes,3,opt,name=vm_error,json=vmError,proto3" json:"vm_error,omitempty"`
+	// ret is the returned data from evm function (result or data supplied with revert opcode)
+	Ret []byte `protobuf:"bytes,4,opt,name=ret,proto3" json:"ret,omitempty"`
+}
+
+func (m *CreateAccessListResponse) Reset()         { *m = CreateAccessListResponse{} }
+func (m *CreateAccessListResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateAccessListResponse) ProtoMessage()    {}
+func (*CreateAccessListResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f35039d5386d306b, []int{18}
+}
+func (m *CreateAccessListResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreateAccessListResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreateAccessListResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CreateAccessListResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateAccessListResponse.Merge(m, src)
+}
+func (m *CreateAccessListResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreateAccessListResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateAccessListResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateAccessListResponse proto.InternalMessageInfo
+
+func (m *CreateAccessListResponse) GetAccessList() []byte {
+	if m != nil {
+		return m.AccessList
+	}
+	return nil
+}
+
+func (m *CreateAccessListResponse) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *CreateAccessListResponse) GetVmError() string {
+	if m != nil {
+		return m.VmError
+	}
+	return ""
+}
+
+func (m *CreateAccessListResponse) GetRet() []byte {
+	if m != nil {
+		return m.Ret
+	}
+	return nil
+}
+
 // QueryTraceTxRequest defines TraceTx request
 type QueryTraceTxRequest struct {
 	// msgEthereumTx for the requested transaction
@@ -903,13 +994,17 @@ type QueryTraceTxRequest struct {
 	BlockHash string `protobuf:"bytes,5,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
 	// block time of requested transaction
 	BlockTime time.Time `protobuf:"bytes,6,opt,name=block_time,json=blockTime,proto3,stdtime" json:"block_time"`
+	// state overrides applied to the traced transaction only, same json format as the json rpc api.
+	StateOverrides []byte `protobuf:"bytes,7,opt,name=state_overrides,json=stateOverrides,proto3" json:"state_overrides,omitempty"`
+	// block overrides applied to the traced transaction only, same json format as the json rpc api.
+	BlockOverrides []byte `protobuf:"bytes,8,opt,name=block_overrides,json=blockOverrides,proto3" json:"block_overrides,omitempty"`
 }
 
 func (m *QueryTraceTxRequest) Reset()         { *m = QueryTraceTxRequest{} }
 func (m *QueryTraceTxRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTraceTxRequest) ProtoMessage()    {}
 func (*QueryTraceTxRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f35039d5386d306b, []int{18}
+	return fileDescriptor_f35039d5386d306b, []int{19}
 }
 func (m *QueryTraceTxRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -980,6 +1075,20 @@ func (m *QueryTraceTxRequest) GetBlockTime() time.Time {
 	return time.Time{}
 }
 
+func (m *QueryTraceTxRequest) GetStateOverrides() []byte {
+	if m != nil {
+		return m.StateOverrides
+	}
+	return nil
+}
+
+func (m *QueryTraceTxRequest) GetBlockOverrides() []byte {
+	if m != nil {
+		return m.BlockOverrides
+	}
+	return nil
+}
+
 // QueryTraceTxResponse defines TraceTx response
 type QueryTraceTxResponse struct {
 	// response serialized in bytes
@@ -990,7 +1099,7 @@ func (m *QueryTraceTxResponse) Reset()         { *m = QueryTraceTxResponse{} }
 func (m *QueryTraceTxResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTraceTxResponse) ProtoMessage()    {}
 func (*QueryTraceTxResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f35039d5386d306b, []int{19}
+	return fileDescriptor_f35039d5386d306b, []int{20}
 }
 func (m *QueryTraceTxResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1044,7 +1153,7 @@ func (m *QueryTraceBlockRequest) Reset()         { *m = QueryTraceBlockRequest{}
 func (m *QueryTraceBlockRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTraceBlockRequest) ProtoMessage()    {}
 func (*QueryTraceBlockRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f35039d5386d306b, []int{20}
+	return fileDescriptor_f35039d5386d306b, []int{21}
 }
 func (m *QueryTraceBlockRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1117,7 +1226,7 @@ func (m *QueryTraceBlockResponse) Reset()         { *m = QueryTraceBlockResponse
 func (m *QueryTraceBlockResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTraceBlockResponse) ProtoMessage()    {}
 func (*QueryTraceBlockResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f35039d5386d306b, []int{21}
+	return fileDescriptor_f35039d5386d306b, []int{22}
 }
 func (m *QueryTraceBlockResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1162,7 +1271,7 @@ func (m *QueryBaseFeeRequest) Reset()         { *m = QueryBaseFeeRequest{} }
 func (m *QueryBaseFeeRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryBaseFeeRequest) ProtoMessage()    {}
 func (*QueryBaseFeeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f35039d5386d306b, []int{22}
+	return fileDescriptor_f35039d5386d306b, []int{23}
 }
 func (m *QueryBaseFeeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1200,7 +1309,7 @@ func (m *QueryBaseFeeResponse) Reset()         { *m = QueryBaseFeeResponse{} }
 func (m *QueryBaseFeeResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryBaseFeeResponse) ProtoMessage()    {}
 func (*QueryBaseFeeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f35039d5386d306b, []int{23}
+	return fileDescriptor_f35039d5386d306b, []int{24}
 }
 func (m *QueryBaseFeeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1238,7 +1347,7 @@ func (m *QueryBlockGasRequest) Reset()         { *m = QueryBlockGasRequest{} }
 func (m *QueryBlockGasRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryBlockGasRequest) ProtoMessage()    {}
 func (*QueryBlockGasRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f35039d5386d306b, []int{24}
+	return fileDescriptor_f35039d5386d306b, []int{25}
 }
 func (m *QueryBlockGasRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1276,7 +1385,7 @@ func (m *QueryBlockGasResponse) Reset()         { *m = QueryBlockGasResponse{} }
 func (m *QueryBlockGasResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryBlockGasResponse) ProtoMessage()    {}
 func (*QueryBlockGasResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f35039d5386d306b, []int{25}
+	return fileDescriptor_f35039d5386d306b, []int{26}
 }
 func (m *QueryBlockGasResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1322,7 +1431,7 @@ func (m *QueryAddressConvertRequest) Reset()         { *m = QueryAddressConvertR
 func (m *QueryAddressConvertRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAddressConvertRequest) ProtoMessage()    {}
 func (*QueryAddressConvertRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f35039d5386d306b, []int{26}
+	return fileDescriptor_f35039d5386d306b, []int{27}
 }
 func (m *QueryAddressConvertRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1361,7 +1470,7 @@ func (m *QueryAddressConvertResponse) Reset()         { *m = QueryAddressConvert
 func (m *QueryAddressConvertResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAddressConvertResponse) ProtoMessage()    {}
 func (*QueryAddressConvertResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f35039d5386d306b, []int{27}
+	return fileDescriptor_f35039d5386d306b, []int{28}
 }
 func (m *QueryAddressConvertResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1423,6 +1532,7 @@ func init() {
 	proto.RegisterType((*QueryParamsResponse)(nil), "stratos.evm.v1.QueryParamsResponse")
 	proto.RegisterType((*EthCallRequest)(nil), "stratos.evm.v1.EthCallRequest")
 	proto.RegisterType((*EstimateGasResponse)(nil), "stratos.evm.v1.EstimateGasResponse")
+	proto.RegisterType((*CreateAccessListResponse)(nil), "stratos.evm.v1.CreateAccessListResponse")
 	proto.RegisterType((*QueryTraceTxRequest)(nil), "stratos.evm.v1.QueryTraceTxRequest")
 	proto.RegisterType((*QueryTraceTxResponse)(nil), "stratos.evm.v1.QueryTraceTxResponse")
 	proto.RegisterType((*QueryTraceBlockRequest)(nil), "stratos.evm.v1.QueryTraceBlockRequest")
@@ -1438,103 +1548,111 @@ func init() {
 func init() { proto.RegisterFile("stratos/evm/v1/query.proto", fileDescriptor_f35039d5386d306b) }
 
 var fileDescriptor_f35039d5386d306b = []byte{
-	// 1523 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcf, 0x6f, 0x13, 0xc7,
-	0x17, 0xcf, 0xc6, 0x4e, 0x1c, 0x9e, 0x93, 0x90, 0xef, 0x10, 0x82, 0xd9, 0x10, 0x3b, 0xd9, 0x40,
-	0x7e, 0x7d, 0x89, 0x97, 0x84, 0xaa, 0xa2, 0x95, 0xfa, 0x23, 0x89, 0x80, 0x22, 0x68, 0x45, 0x4d,
-	0xd4, 0x43, 0x2f, 0xd6, 0x78, 0x3d, 0xac, 0xad, 0xd8, 0xbb, 0x66, 0x67, 0x6c, 0x39, 0x20, 0xd4,
-	0x16, 0xa9, 0x3f, 0xa4, 0x5e, 0x50, 0x7b, 0xe9, 0x91, 0x6b, 0xef, 0xbd, 0xf5, 0x1f, 0xe0, 0x88,
-	0xda, 0x4b, 0xd5, 0x03, 0xad, 0xa0, 0x87, 0xfe, 0x19, 0xd5, 0xfc, 0xb2, 0xbd, 0x6b, 0x6f, 0x4c,
-	0x10, 0xa7, 0xdd, 0x99, 0x79, 0xf3, 0x3e, 0x9f, 0x79, 0xf3, 0xe6, 0xbd, 0x0f, 0x98, 0x94, 0x05,
-	0x98, 0xf9, 0xd4, 0x26, 0xad, 0xba, 0xdd, 0xda, 0xb2, 0xef, 0x35, 0x49, 0x70, 0x98, 0x6f, 0x04,
-	0x3e, 0xf3, 0xd1, 0xb4, 0x5a, 0xcb, 0x93, 0x56, 0x3d, 0xdf, 0xda, 0x32, 0x67, 0x5d, 0xdf, 0xf5,
-	0xc5, 0x92, 0xcd, 0xff, 0xa4, 0x95, 0x79, 0xd6, 0xf1, 0x69, 0xdd, 0xa7, 0x45, 0xb9, 0x20, 0x07,
-	0x6a, 0x69, 0x43, 0x8e, 0xec, 0x12, 0xa6, 0x44, 0x7a, 0xb6, 0x5b, 0x5b, 0x25, 0xc2, 0xf0, 0x96,
-	0xdd, 0xc0, 0x6e, 0xd5, 0xc3, 0xac, 0xea, 0x7b, 0xca, 0xf6, 0x9c, 0xeb, 0xfb, 0x6e, 0x8d, 0xd8,
-	0xb8, 0x51, 0xb5, 0xb1, 0xe7, 0xf9, 0x4c, 0x2c, 0x6a, 0x4f, 0x99, 0x08, 0x4d, 0xce, 0x48, 0xae,
-	0x9c, 0x89, 0xac, 0xb0, 0xb6, 0x5a, 0xc8, 0x29, 0x87, 0x62, 0x54, 0x6a, 0xde, 0xb5, 0x59, 0xb5,
-	0x4e, 0x28, 0xc3, 0xf5, 0x86, 0x34, 0xb0, 0xde, 0x81, 0x53, 0x9f, 0x72, 0x4e, 0x3b, 0x8e, 0xe3,
-	0x37, 0x3d, 0x56, 0x20, 0xf7, 0x9a, 0x84, 0x32, 0x94, 0x81, 0x14, 0x2e, 0x97, 0x03, 0x42, 0x69,
-	0xc6, 0x58, 0x34, 0xd6, 0x4e, 0x14, 0xf4, 0xf0, 0xdd, 0x89, 0xef, 0x9e, 0xe4, 0x46, 0xfe, 0x7d,
-	0x92, 0x1b, 0xb1, 0x1c, 0x98, 0x0d, 0x6f, 0xa5, 0x0d, 0xdf, 0xa3, 0x84, 0xef, 0x2d, 0xe1, 0x1a,
-	0xf6, 0x1c, 0xa2, 0xf7, 0xaa, 0x21, 0x9a, 0x87, 0x13, 0x8e, 0x5f, 0x26, 0xc5, 0x0a, 0xa6, 0x95,
-	0xcc, 0xa8, 0x58, 0x9b, 0xe0, 0x13, 0x1f, 0x61, 0x5a, 0x41, 0xb3, 0x30, 0xe6, 0xf9, 0x7c, 0x53,
-	0x62, 0xd1, 0x58, 0x4b, 0x16, 0xe4, 0xc0, 0xfa, 0x00, 0xce, 0x0a, 0x90, 0x3d, 0x11, 0xc4, 0xd7,
-	0x60, 0xf9, 0x8d, 0x01, 0xe6, 0x20, 0x0f, 0x8a, 0xec, 0x05, 0x98, 0x56, 0x57, 0x17, 0xf6, 0x34,
-	0x25, 0x67, 0x77, 0xe4, 0x24, 0x32, 0x61, 0x82, 0x72, 0x50, 0xce, 0x6f, 0x54, 0xf0, 0xeb, 0x8c,
-	0xb9, 0x0b, 0x2c, 0xbd, 0x16, 0xbd, 0x66, 0xbd, 0x44, 0x02, 0x75, 0x82, 0x29, 0x35, 0xfb, 0x89,
-	0x98, 0xb4, 0x6e, 0xc2, 0x39, 0xc1, 0xe3, 0x33, 0x5c, 0xab, 0x96, 0x31, 0xf3, 0x83, 0xc8, 0x61,
-	0x96, 0x60, 0xd2, 0xf1, 0xbd, 0x28, 0x8f, 0x34, 0x9f, 0xdb, 0xe9, 0x3b, 0xd5, 0xf7, 0x06, 0x2c,
-	0xc4, 0x78, 0x53, 0x07, 0x5b, 0x85, 0x93, 0x9a, 0x55, 0xd8, 0xa3, 0x26, 0xfb, 0x06, 0x8f, 0xa6,
-	0x93, 0x68, 0x57, 0xde, 0xf3, 0x71, 0xae, 0xe7, 0x92, 0x4a, 0xa2, 0xce, 0xd6, 0x61, 0x49, 0x64,
-	0xdd, 0x54, 0x60, 0x77, 0x98, 0x1f, 0x60, 0x77, 0x38, 0x18, 0x9a, 0x81, 0xc4, 0x01, 0x39, 0x54,
-	0xf9, 0xc6, 0x7f, 0x7b, 0xe0, 0x2f, 0x2a, 0xf8, 0x8e, 0x33, 0x05, 0x3f, 0x0b, 0x63, 0x2d, 0x5c,
-	0x6b, 0x6a, 0x70, 0x39, 0xb0, 0xde, 0x86, 0x19, 0x95, 0x4a, 0xe5, 0x63, 0x1d, 0x72, 0x15, 0xfe,
-	0xd7, 0xb3, 0x4f, 0x41, 0x20, 0x48, 0xf2, 0xdc, 0x17, 0xbb, 0x26, 0x0b, 0xe2, 0xdf, 0xba, 0x0f,
-	0x48, 0x18, 0xee, 0xb7, 0x6f, 0xf9, 0x2e, 0xd5, 0x10, 0x08, 0x92, 0xe2, 0xc5, 0x48, 0xff, 0xe2,
-	0x1f, 0x5d, 0x03, 0xe8, 0x56, 0x0f, 0x71, 0xb6, 0xf4, 0xf6, 0x4a, 0x5e, 0x15, 0x1e, 0x5e, 0x6a,
-	0xf2, 0xb2, 0x88, 0xa9, 0x52, 0x93, 0xbf, 0xdd, 0x0d, 0x55, 0xa1, 0x67, 0x67, 0x0f, 0xc9, 0x6f,
-	0x0d, 0x15, 0x58, 0x0d, 0xde, 0x49, 0xa4, 0x64, 0xcd, 0x77, 0xf9, 0xe9, 0x12, 0x6b, 0xe9, 0xed,
-	0x53, 0xf9, 0x70, 0x3d, 0xcc, 0xdf, 0xf2, 0xdd, 0x82, 0x30, 0x40, 0xd7, 0x07, 0x50, 0x5a, 0x1d,
-	0x4a, 0x49, 0xa2, 0xf4, 0x72, 0xb2, 0x66, 0x55, 0x14, 0x6e, 0xe3, 0x00, 0xd7, 0x75, 0x14, 0x3a,
-	0xf7, 0xae, 0x67, 0x15, 0xbd, 0xb7, 0x60, 0xbc, 0x21, 0x66, 0x44, 0x78, 0xd2, 0xdb, 0x73, 0x51,
-	0x82, 0xd2, 0x7e, 0x37, 0xf9, 0xf4, 0x79, 0x6e, 0xa4, 0xa0, 0x6c, 0xad, 0xf7, 0x60, 0xfa, 0x2a,
-	0xab, 0xec, 0xe1, 0x5a, 0xad, 0x27, 0xc8, 0x38, 0x70, 0xa9, 0xbe, 0x0e, 0xfe, 0x8f, 0xce, 0x40,
-	0xca, 0xc5, 0xb4, 0xe8, 0xe0, 0x86, 0x7a, 0x19, 0xe3, 0x2e, 0xa6, 0x7b, 0xb8, 0x61, 0xad, 0xc2,
-	0xa9, 0xab, 0x94, 0x55, 0xeb, 0x98, 0x91, 0xeb, 0xb8, 0xcb, 0x65, 0x06, 0x12, 0x2e, 0x96, 0x2e,
-	0x92, 0x05, 0xfe, 0x6b, 0x3d, 0x1b, 0xd5, 0x41, 0x0d, 0xb0, 0x43, 0xf6, 0xdb, 0x1a, 0xcd, 0x86,
-	0x44, 0x9d, 0xba, 0x8a, 0xf2, 0x42, 0x94, 0xf2, 0xc7, 0xd4, 0xbd, 0xca, 0x2a, 0x24, 0x20, 0xcd,
-	0xfa, 0x7e, 0xbb, 0xc0, 0x2d, 0xd1, 0xfb, 0x30, 0xc9, 0xb8, 0x8b, 0xa2, 0xe3, 0x7b, 0x77, 0xab,
-	0xae, 0x0a, 0xef, 0x7c, 0x74, 0xa7, 0x80, 0xd9, 0x13, 0x26, 0x85, 0x34, 0xeb, 0x0e, 0xd0, 0x0e,
-	0x4c, 0x36, 0x02, 0x52, 0x26, 0x0e, 0xa1, 0xd4, 0x0f, 0x68, 0x26, 0x21, 0x6e, 0x73, 0x08, 0x72,
-	0x68, 0x0b, 0x2f, 0x50, 0xa5, 0x9a, 0xef, 0x1c, 0xe8, 0x52, 0x90, 0x5c, 0x34, 0xd6, 0x12, 0x85,
-	0xb4, 0x98, 0x93, 0x85, 0x00, 0x2d, 0x00, 0x48, 0x13, 0x91, 0xaf, 0x63, 0x22, 0x5f, 0x4f, 0x88,
-	0x19, 0x51, 0xe2, 0xf7, 0xf4, 0x32, 0xef, 0x42, 0x99, 0x71, 0x71, 0x04, 0x33, 0x2f, 0x5b, 0x54,
-	0x5e, 0xb7, 0xa8, 0xfc, 0xbe, 0x6e, 0x51, 0xbb, 0x13, 0xfc, 0xce, 0x1e, 0xff, 0x95, 0x33, 0x94,
-	0x13, 0xbe, 0x62, 0x6d, 0xa8, 0x27, 0xdb, 0x89, 0x68, 0xf7, 0x3d, 0x95, 0x31, 0xc3, 0xfa, 0x02,
-	0xf9, 0xbf, 0xf5, 0x78, 0x14, 0xe6, 0xba, 0xc6, 0xbb, 0xdc, 0x47, 0xcf, 0x0d, 0xb0, 0xb6, 0xce,
-	0xea, 0x61, 0x37, 0xc0, 0xda, 0xb4, 0xef, 0x06, 0x12, 0xc7, 0xbc, 0x81, 0x68, 0xf8, 0xc6, 0x86,
-	0x85, 0x6f, 0xfc, 0xe8, 0xf0, 0xa5, 0x5e, 0x2f, 0x7c, 0x9b, 0x70, 0xa6, 0x2f, 0x22, 0x47, 0x44,
-	0xf0, 0x74, 0xa7, 0xb4, 0x53, 0x72, 0x8d, 0xe8, 0x12, 0x62, 0x1d, 0x74, 0xca, 0xb6, 0x9a, 0x56,
-	0x2e, 0xee, 0xc0, 0x04, 0x7f, 0xe9, 0xc5, 0xbb, 0x44, 0x95, 0xce, 0xdd, 0x2b, 0x7f, 0x3e, 0xcf,
-	0xad, 0xb8, 0x55, 0x56, 0x69, 0x96, 0xf2, 0x8e, 0x5f, 0x57, 0xda, 0x48, 0x7d, 0x36, 0x69, 0xf9,
-	0xc0, 0x66, 0x87, 0x0d, 0x42, 0xf3, 0x37, 0x3c, 0xf6, 0xdb, 0x2f, 0x9b, 0xa0, 0xca, 0xc5, 0x0d,
-	0x8f, 0xf1, 0x8a, 0x2f, 0x9c, 0x5b, 0x73, 0x1a, 0x8c, 0xb3, 0x15, 0xef, 0x4d, 0x92, 0x58, 0x87,
-	0xd3, 0x91, 0xf9, 0xfe, 0x77, 0x98, 0x90, 0xef, 0xf0, 0x43, 0x25, 0x02, 0x54, 0xd3, 0xdb, 0xf3,
-	0xbd, 0x16, 0x09, 0x8e, 0xa5, 0x23, 0x08, 0xcc, 0x0f, 0xf4, 0xa0, 0x20, 0x73, 0x90, 0xae, 0x90,
-	0x76, 0xa4, 0xd5, 0x42, 0x85, 0xb4, 0x75, 0x9b, 0xbd, 0x00, 0xd3, 0x25, 0xe2, 0x54, 0x2e, 0x6f,
-	0x77, 0x6c, 0x64, 0x43, 0x9a, 0x92, 0xb3, 0xca, 0x6c, 0xfb, 0xd7, 0x93, 0x30, 0x26, 0x70, 0xd0,
-	0x17, 0x90, 0x52, 0x3d, 0x1d, 0x2d, 0x47, 0x93, 0x6c, 0x80, 0x64, 0x33, 0xcf, 0x1f, 0x6d, 0x24,
-	0x79, 0x5a, 0xeb, 0x8f, 0x7e, 0xff, 0xe7, 0xc7, 0xd1, 0x65, 0xb4, 0x64, 0x47, 0x24, 0xa3, 0xea,
-	0xe8, 0xf6, 0x03, 0x45, 0xef, 0x21, 0xfa, 0xc9, 0x80, 0xa9, 0x90, 0x68, 0x42, 0xeb, 0x03, 0x21,
-	0x06, 0x49, 0x33, 0x73, 0xe3, 0x55, 0x4c, 0x15, 0xa7, 0x4b, 0x82, 0xd3, 0x06, 0x5a, 0x8b, 0x72,
-	0xd2, 0xca, 0xac, 0x8f, 0xda, 0xcf, 0x06, 0xcc, 0x44, 0x95, 0x0f, 0xba, 0x38, 0x10, 0x32, 0x46,
-	0x6e, 0x99, 0x9b, 0xaf, 0x68, 0xad, 0x38, 0x5e, 0x11, 0x1c, 0xb7, 0xd1, 0xa5, 0x28, 0xc7, 0x96,
-	0xde, 0xd1, 0xa5, 0xd9, 0x2b, 0xe3, 0x1e, 0xa2, 0x2f, 0x0d, 0x48, 0x29, 0x75, 0x13, 0x73, 0x91,
-	0x61, 0xd9, 0x14, 0x73, 0x91, 0x11, 0x81, 0x64, 0x6d, 0x08, 0x42, 0xe7, 0x91, 0x15, 0x25, 0xa4,
-	0x74, 0x12, 0xed, 0x09, 0xd7, 0xd7, 0x06, 0xa4, 0x94, 0xc2, 0x89, 0xa1, 0x10, 0x16, 0x53, 0x31,
-	0x14, 0x22, 0x22, 0xc9, 0xb2, 0x05, 0x85, 0x75, 0xb4, 0x1a, 0xa5, 0x40, 0xa5, 0x61, 0x97, 0x81,
-	0xfd, 0xe0, 0x80, 0x1c, 0x3e, 0x44, 0x0c, 0x92, 0x5c, 0x02, 0xa1, 0xc5, 0x98, 0xe4, 0xe8, 0xa8,
-	0x2a, 0x73, 0xe9, 0x08, 0x0b, 0x85, 0xbe, 0x2a, 0xd0, 0x97, 0x50, 0xae, 0x3f, 0x6b, 0xca, 0xa1,
-	0xd3, 0xdf, 0x83, 0x71, 0xa9, 0x01, 0x90, 0x35, 0xd0, 0x6b, 0x48, 0x66, 0x98, 0xcb, 0x47, 0xda,
-	0x28, 0xec, 0xac, 0xc0, 0xce, 0xa0, 0xb9, 0x28, 0xb6, 0x94, 0x17, 0x28, 0x80, 0x94, 0x92, 0x17,
-	0x28, 0x1b, 0xf5, 0x17, 0xd6, 0x1d, 0xe6, 0x85, 0xa3, 0x5b, 0x8f, 0x46, 0x5c, 0x14, 0x88, 0x26,
-	0xca, 0x44, 0x11, 0x09, 0xab, 0x14, 0x1d, 0x0e, 0xd4, 0x86, 0x74, 0x8f, 0x26, 0x19, 0x8a, 0xdb,
-	0x77, 0xce, 0x01, 0x82, 0xc6, 0x3a, 0x2f, 0x50, 0xb3, 0xe8, 0x5c, 0x1f, 0xaa, 0x32, 0x2e, 0xba,
-	0x98, 0xa2, 0x16, 0xa4, 0x54, 0x33, 0x8e, 0xc9, 0xae, 0xb0, 0xf8, 0x89, 0xc9, 0xae, 0x48, 0x3f,
-	0x8f, 0x3f, 0xb1, 0xec, 0xc2, 0xac, 0x8d, 0x1e, 0x19, 0x00, 0xdd, 0x36, 0x86, 0x56, 0xe2, 0xdd,
-	0xf6, 0x76, 0x7e, 0x73, 0x75, 0xa8, 0x9d, 0x62, 0xb0, 0x2c, 0x18, 0x2c, 0xa0, 0xf9, 0xc1, 0x0c,
-	0x44, 0x4f, 0xe5, 0x87, 0x57, 0x4d, 0x30, 0xf6, 0x75, 0xf7, 0x76, 0xce, 0xd8, 0xd7, 0x1d, 0xea,
-	0xa3, 0xf1, 0x87, 0xd7, 0xdd, 0x15, 0x7d, 0x65, 0xc0, 0xa4, 0xda, 0x25, 0x92, 0xf3, 0x4d, 0xa2,
-	0xaf, 0x08, 0xf4, 0x45, 0x94, 0x8d, 0x43, 0x2f, 0x8a, 0x3c, 0x47, 0xf7, 0x61, 0x42, 0xf7, 0x5e,
-	0x14, 0xe3, 0x39, 0xdc, 0xb2, 0xfb, 0xb3, 0x7d, 0x60, 0x03, 0xb7, 0x96, 0x04, 0x81, 0x79, 0x74,
-	0xb6, 0x8f, 0x80, 0xd0, 0x3f, 0x3c, 0xe9, 0x7e, 0x30, 0x60, 0x3a, 0xdc, 0x8b, 0xd1, 0xe0, 0x9e,
-	0x33, 0xb0, 0xe5, 0x9b, 0xff, 0x7f, 0x25, 0xdb, 0x61, 0xa5, 0x46, 0xd5, 0x18, 0x2e, 0x09, 0xf9,
-	0x86, 0xdd, 0x1b, 0x4f, 0x5f, 0x64, 0x8d, 0x67, 0x2f, 0xb2, 0xc6, 0xdf, 0x2f, 0xb2, 0xc6, 0xe3,
-	0x97, 0xd9, 0x91, 0x67, 0x2f, 0xb3, 0x23, 0x7f, 0xbc, 0xcc, 0x8e, 0x7c, 0x6e, 0xf7, 0x48, 0x20,
-	0xe5, 0xc4, 0x23, 0x4c, 0xff, 0x6e, 0x3a, 0x15, 0x5c, 0xf5, 0xec, 0xb6, 0xf0, 0x2b, 0xf4, 0x50,
-	0x69, 0x5c, 0x08, 0xba, 0xcb, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0x20, 0x64, 0xaf, 0xf0, 0x9c,
-	0x12, 0x00, 0x00,
+	// 1656 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4b, 0x6f, 0x1b, 0x47,
+	0x12, 0xd6, 0x88, 0x94, 0x48, 0x15, 0x25, 0xad, 0xb6, 0x45, 0xcb, 0xd4, 0x48, 0x22, 0xa5, 0x91,
+	0xac, 0xd7, 0x5a, 0x1c, 0x4b, 0x5e, 0x2c, 0xbc, 0x7b, 0xd8, 0x5d, 0x49, 0x90, 0xbd, 0x86, 0xbd,
+	0xb1, 0x43, 0x2b, 0x39, 0xe4, 0x42, 0x34, 0x87, 0xed, 0x21, 0x21, 0x92, 0x43, 0x4f, 0x37, 0x09,
+	0xca, 0x86, 0x91, 0xc4, 0x79, 0x19, 0xc8, 0xc5, 0x48, 0x2e, 0x39, 0x1a, 0xb9, 0xe5, 0x9e, 0xdf,
+	0x10, 0xf8, 0x68, 0x24, 0x97, 0x20, 0x07, 0x27, 0xb0, 0x73, 0xc8, 0xcf, 0x08, 0xfa, 0x31, 0xe4,
+	0xcc, 0xf0, 0x65, 0x19, 0x3e, 0xcd, 0x74, 0x75, 0x75, 0xd5, 0x57, 0x5d, 0xd5, 0x55, 0x1f, 0xe8,
+	0x94, 0xb9, 0x98, 0x39, 0xd4, 0x24, 0xcd, 0xaa, 0xd9, 0xdc, 0x35, 0xef, 0x35, 0x88, 0x7b, 0x9a,
+	0xad, 0xbb, 0x0e, 0x73, 0xd0, 0xb4, 0xda, 0xcb, 0x92, 0x66, 0x35, 0xdb, 0xdc, 0xd5, 0x93, 0xb6,
+	0x63, 0x3b, 0x62, 0xcb, 0xe4, 0x7f, 0x52, 0x4b, 0x9f, 0xb7, 0x1c, 0x5a, 0x75, 0x68, 0x5e, 0x6e,
+	0xc8, 0x85, 0xda, 0xda, 0x96, 0x2b, 0xb3, 0x80, 0x29, 0x91, 0x96, 0xcd, 0xe6, 0x6e, 0x81, 0x30,
+	0xbc, 0x6b, 0xd6, 0xb1, 0x5d, 0xae, 0x61, 0x56, 0x76, 0x6a, 0x4a, 0x77, 0xd1, 0x76, 0x1c, 0xbb,
+	0x42, 0x4c, 0x5c, 0x2f, 0x9b, 0xb8, 0x56, 0x73, 0x98, 0xd8, 0xf4, 0x2c, 0xa5, 0x42, 0x30, 0x39,
+	0x22, 0xb9, 0x73, 0x3e, 0xb4, 0xc3, 0x5a, 0x6a, 0x23, 0xa3, 0x0c, 0x8a, 0x55, 0xa1, 0x71, 0xd7,
+	0x64, 0xe5, 0x2a, 0xa1, 0x0c, 0x57, 0xeb, 0x52, 0xc1, 0xf8, 0x27, 0xcc, 0xbe, 0xcb, 0x31, 0xed,
+	0x5b, 0x96, 0xd3, 0xa8, 0xb1, 0x1c, 0xb9, 0xd7, 0x20, 0x94, 0xa1, 0x14, 0xc4, 0x70, 0xb1, 0xe8,
+	0x12, 0x4a, 0x53, 0xda, 0xb2, 0xb6, 0x39, 0x91, 0xf3, 0x96, 0xff, 0x8a, 0x3f, 0x7e, 0x9a, 0x19,
+	0xf9, 0xe3, 0x69, 0x66, 0xc4, 0xb0, 0x20, 0x19, 0x3c, 0x4a, 0xeb, 0x4e, 0x8d, 0x12, 0x7e, 0xb6,
+	0x80, 0x2b, 0xb8, 0x66, 0x11, 0xef, 0xac, 0x5a, 0xa2, 0x05, 0x98, 0xb0, 0x9c, 0x22, 0xc9, 0x97,
+	0x30, 0x2d, 0xa5, 0x46, 0xc5, 0x5e, 0x9c, 0x0b, 0xfe, 0x87, 0x69, 0x09, 0x25, 0x61, 0xac, 0xe6,
+	0xf0, 0x43, 0x91, 0x65, 0x6d, 0x33, 0x9a, 0x93, 0x0b, 0xe3, 0x3f, 0x30, 0x2f, 0x9c, 0x1c, 0x8a,
+	0x4b, 0x7c, 0x03, 0x94, 0x9f, 0x6b, 0xa0, 0xf7, 0xb2, 0xa0, 0xc0, 0x5e, 0x80, 0x69, 0x95, 0xba,
+	0xa0, 0xa5, 0x29, 0x29, 0xdd, 0x97, 0x42, 0xa4, 0x43, 0x9c, 0x72, 0xa7, 0x1c, 0xdf, 0xa8, 0xc0,
+	0xd7, 0x5e, 0x73, 0x13, 0x58, 0x5a, 0xcd, 0xd7, 0x1a, 0xd5, 0x02, 0x71, 0x55, 0x04, 0x53, 0x4a,
+	0xfa, 0x8e, 0x10, 0x1a, 0x37, 0x60, 0x51, 0xe0, 0x78, 0x1f, 0x57, 0xca, 0x45, 0xcc, 0x1c, 0x37,
+	0x14, 0xcc, 0x0a, 0x4c, 0x5a, 0x4e, 0x2d, 0x8c, 0x23, 0xc1, 0x65, 0xfb, 0x5d, 0x51, 0x7d, 0xa9,
+	0xc1, 0x52, 0x1f, 0x6b, 0x2a, 0xb0, 0x0d, 0xf8, 0x8b, 0x87, 0x2a, 0x68, 0xd1, 0x03, 0xfb, 0x16,
+	0x43, 0xf3, 0x8a, 0xe8, 0x40, 0xe6, 0xf9, 0x2c, 0xe9, 0xb9, 0x04, 0xc9, 0xe0, 0xd1, 0x61, 0x45,
+	0x64, 0xdc, 0x50, 0xce, 0xee, 0x30, 0xc7, 0xc5, 0xf6, 0x70, 0x67, 0x68, 0x06, 0x22, 0x27, 0xe4,
+	0x54, 0xd5, 0x1b, 0xff, 0xf5, 0xb9, 0xbf, 0x08, 0xc9, 0xa0, 0x31, 0xe5, 0x3e, 0x09, 0x63, 0x4d,
+	0x5c, 0x69, 0x78, 0xce, 0xe5, 0xc2, 0xf8, 0x07, 0xcc, 0xa8, 0x52, 0x2a, 0x9e, 0x29, 0xc8, 0x0d,
+	0xf8, 0xab, 0xef, 0x9c, 0x72, 0x81, 0x20, 0xca, 0x6b, 0x5f, 0x9c, 0x9a, 0xcc, 0x89, 0x7f, 0xe3,
+	0x3e, 0x20, 0xa1, 0x78, 0xdc, 0xba, 0xe9, 0xd8, 0xd4, 0x73, 0x81, 0x20, 0x2a, 0x5e, 0x8c, 0xb4,
+	0x2f, 0xfe, 0xd1, 0x55, 0x80, 0x4e, 0xf7, 0x10, 0xb1, 0x25, 0xf6, 0xd6, 0xb3, 0xaa, 0xf1, 0xf0,
+	0x56, 0x93, 0x95, 0x4d, 0x4c, 0xb5, 0x9a, 0xec, 0xed, 0xce, 0x55, 0xe5, 0x7c, 0x27, 0x7d, 0x20,
+	0xbf, 0xd0, 0x60, 0x36, 0xe0, 0xbc, 0x5d, 0x48, 0xd1, 0x8a, 0x63, 0xf3, 0xe8, 0x22, 0x9b, 0x89,
+	0xbd, 0xd9, 0x6c, 0xb0, 0x1f, 0x66, 0x6f, 0x3a, 0x76, 0x4e, 0x28, 0xa0, 0x6b, 0x3d, 0x20, 0x6d,
+	0x0c, 0x85, 0x24, 0xbd, 0xf8, 0x31, 0x19, 0x49, 0x75, 0x0b, 0xb7, 0xb1, 0x8b, 0xab, 0xde, 0x2d,
+	0xb4, 0xf3, 0xee, 0x49, 0x15, 0xbc, 0xbf, 0xc3, 0x78, 0x5d, 0x48, 0xc4, 0xf5, 0x24, 0xf6, 0xe6,
+	0xc2, 0x00, 0xa5, 0xfe, 0x41, 0xf4, 0xd9, 0x8b, 0xcc, 0x48, 0x4e, 0xe9, 0x1a, 0x9f, 0x6a, 0x30,
+	0x7d, 0xc4, 0x4a, 0x87, 0xb8, 0x52, 0xf1, 0xdd, 0x32, 0x76, 0x6d, 0xea, 0xe5, 0x83, 0xff, 0xa3,
+	0xf3, 0x10, 0xb3, 0x31, 0xcd, 0x5b, 0xb8, 0xae, 0x9e, 0xc6, 0xb8, 0x8d, 0xe9, 0x21, 0xae, 0xa3,
+	0x45, 0x98, 0x70, 0x9a, 0xc4, 0x75, 0xcb, 0x45, 0x42, 0xc5, 0x9b, 0x98, 0xcc, 0x75, 0x04, 0xfc,
+	0xed, 0x15, 0x2a, 0x8e, 0x75, 0x92, 0xef, 0xe8, 0x44, 0x85, 0xce, 0xb4, 0x10, 0xdf, 0xf2, 0xa4,
+	0xc6, 0x06, 0xcc, 0x1e, 0x51, 0x56, 0xae, 0x62, 0x46, 0xae, 0xe1, 0x4e, 0x4c, 0x33, 0x10, 0xb1,
+	0xb1, 0x44, 0x12, 0xcd, 0xf1, 0x5f, 0xe3, 0x13, 0x0d, 0x52, 0x87, 0x2e, 0xc1, 0x8c, 0xec, 0x5b,
+	0x16, 0xa1, 0xf4, 0x66, 0x99, 0x76, 0x9e, 0x7a, 0x06, 0x12, 0x58, 0x48, 0xf3, 0x95, 0x32, 0x65,
+	0x2a, 0x00, 0xc0, 0x6d, 0x45, 0x34, 0x0f, 0x71, 0x1e, 0x46, 0x83, 0x92, 0xa2, 0x8a, 0x83, 0x87,
+	0xf5, 0x1e, 0x25, 0x45, 0xbe, 0xd5, 0xac, 0xe6, 0x89, 0xeb, 0x3a, 0xf2, 0x6d, 0x4f, 0xe4, 0x62,
+	0xcd, 0xea, 0x11, 0x5f, 0x72, 0x14, 0x2e, 0x61, 0x0a, 0x39, 0xff, 0x35, 0xbe, 0x8d, 0x78, 0x25,
+	0xe2, 0x62, 0x8b, 0x1c, 0xb7, 0xbc, 0xab, 0x33, 0x21, 0x52, 0xa5, 0xb6, 0x4a, 0xc0, 0x52, 0x38,
+	0x01, 0xff, 0xa7, 0xf6, 0x11, 0x2b, 0x11, 0x97, 0x34, 0xaa, 0xc7, 0xad, 0x1c, 0xd7, 0x44, 0xff,
+	0x86, 0x49, 0xc6, 0x4d, 0xe4, 0x2d, 0xa7, 0x76, 0xb7, 0x6c, 0xab, 0x62, 0x59, 0x08, 0x9f, 0x14,
+	0x6e, 0x0e, 0x85, 0x4a, 0x2e, 0xc1, 0x3a, 0x0b, 0xb4, 0x0f, 0x93, 0x75, 0x97, 0x14, 0x09, 0x8f,
+	0xd0, 0x71, 0x79, 0x06, 0x22, 0xc3, 0x3d, 0x07, 0x8e, 0xf0, 0x76, 0x2b, 0x73, 0xa4, 0x1a, 0x1b,
+	0x0f, 0x33, 0x92, 0x4b, 0x08, 0x99, 0x6c, 0x6b, 0x68, 0x09, 0x40, 0xaa, 0x88, 0xd7, 0x37, 0x26,
+	0x6e, 0x67, 0x42, 0x48, 0xc4, 0xc0, 0x3a, 0xf4, 0xb6, 0xf9, 0x4c, 0x4d, 0x8d, 0x8b, 0x10, 0xf4,
+	0xac, 0x1c, 0xb8, 0x59, 0x6f, 0xe0, 0x66, 0x8f, 0xbd, 0x81, 0x7b, 0x10, 0xe7, 0x15, 0xf8, 0xe4,
+	0xd7, 0x8c, 0xa6, 0x8c, 0xf0, 0x1d, 0x5e, 0x2a, 0x94, 0x61, 0x46, 0x7c, 0xa5, 0x12, 0x93, 0xa5,
+	0x22, 0xc4, 0xb7, 0x06, 0xd5, 0x54, 0xbc, 0x67, 0x4d, 0x6d, 0xab, 0x96, 0xd6, 0xce, 0x51, 0xa7,
+	0xdf, 0x14, 0x31, 0xc3, 0x5e, 0x7d, 0xf3, 0x7f, 0xe3, 0xc9, 0x28, 0xcc, 0x75, 0x94, 0x0f, 0xb8,
+	0x21, 0x5f, 0x4e, 0x59, 0xcb, 0x7b, 0xf5, 0xc3, 0x72, 0xca, 0x5a, 0xb4, 0x2b, 0xa7, 0x91, 0x33,
+	0xe6, 0x34, 0x9c, 0x90, 0xb1, 0x61, 0x09, 0x19, 0x1f, 0x9c, 0x90, 0xd8, 0x1b, 0x25, 0xc4, 0xd8,
+	0x81, 0xf3, 0x5d, 0x37, 0x32, 0xe0, 0x06, 0xcf, 0xb5, 0x47, 0x1f, 0x25, 0x57, 0x89, 0xd7, 0x62,
+	0x8d, 0x13, 0x48, 0x06, 0xc5, 0xca, 0xc4, 0x1d, 0x88, 0xf3, 0x4e, 0x98, 0xbf, 0x4b, 0xd4, 0x68,
+	0x39, 0xb8, 0xf2, 0xcb, 0x8b, 0xcc, 0xba, 0x5d, 0x66, 0xa5, 0x46, 0x21, 0x6b, 0x39, 0x55, 0xc5,
+	0x1d, 0xd5, 0x67, 0x87, 0x16, 0x4f, 0x4c, 0x76, 0x5a, 0x27, 0x34, 0x7b, 0xbd, 0xc6, 0x7e, 0xfc,
+	0x7e, 0x07, 0xa4, 0x9c, 0xaf, 0xf8, 0x44, 0x14, 0xc6, 0x8d, 0x39, 0xcf, 0x19, 0x47, 0x2b, 0xfa,
+	0x88, 0x04, 0xb1, 0x05, 0xe7, 0x42, 0xf2, 0xee, 0xfe, 0x12, 0x91, 0xfd, 0xe5, 0xbf, 0x8a, 0x24,
+	0x29, 0x52, 0x70, 0xe8, 0xd4, 0x9a, 0xc4, 0x3d, 0x13, 0xcf, 0x22, 0xb0, 0xd0, 0xd3, 0x42, 0xa7,
+	0x47, 0x95, 0x48, 0x2b, 0x44, 0x45, 0xa0, 0x44, 0x5a, 0x4a, 0x9f, 0x53, 0x8d, 0x02, 0xb1, 0x4a,
+	0x97, 0xf7, 0xda, 0x3a, 0x72, 0x60, 0x4f, 0x49, 0xa9, 0x52, 0xdb, 0xfb, 0x61, 0x06, 0xc6, 0x84,
+	0x1f, 0xf4, 0x21, 0xc4, 0x14, 0xe7, 0x41, 0xab, 0xe1, 0x22, 0xeb, 0x41, 0x69, 0xf5, 0xb5, 0xc1,
+	0x4a, 0x12, 0xa7, 0xb1, 0xf5, 0xe8, 0xa7, 0xdf, 0xbf, 0x1e, 0x5d, 0x45, 0x2b, 0x66, 0x88, 0x52,
+	0x2b, 0xc6, 0x63, 0x3e, 0x50, 0xf0, 0x1e, 0xa2, 0x6f, 0x34, 0x98, 0x0a, 0x90, 0x4a, 0xb4, 0xd5,
+	0xd3, 0x45, 0x2f, 0xea, 0xaa, 0x6f, 0xbf, 0x8e, 0xaa, 0xc2, 0x74, 0x49, 0x60, 0xda, 0x46, 0x9b,
+	0x61, 0x4c, 0x1e, 0x73, 0xed, 0x82, 0xf6, 0x9d, 0x06, 0x33, 0x61, 0x66, 0x88, 0x2e, 0xf6, 0x74,
+	0xd9, 0x87, 0x8e, 0xea, 0x3b, 0xaf, 0xa9, 0xad, 0x30, 0x5e, 0x11, 0x18, 0xf7, 0xd0, 0xa5, 0x30,
+	0xc6, 0xa6, 0x77, 0xa2, 0x03, 0xd3, 0x4f, 0x73, 0x1f, 0xa2, 0x8f, 0x34, 0x88, 0x29, 0xf6, 0xd7,
+	0x27, 0x91, 0x41, 0x5a, 0xa9, 0xaf, 0x0d, 0x56, 0x52, 0x80, 0xb6, 0x05, 0xa0, 0x35, 0x64, 0x84,
+	0x01, 0x29, 0x1e, 0x49, 0x7d, 0xd7, 0xf5, 0x99, 0x06, 0x31, 0xc5, 0x00, 0xfb, 0x40, 0x08, 0x92,
+	0x4d, 0x7d, 0x6d, 0xb0, 0x92, 0x82, 0x60, 0x0a, 0x08, 0x5b, 0x68, 0x23, 0x0c, 0x81, 0x4a, 0xc5,
+	0x0e, 0x02, 0xf3, 0xc1, 0x09, 0x39, 0x7d, 0x88, 0x18, 0x44, 0x39, 0x45, 0x44, 0xcb, 0x7d, 0x8a,
+	0xa3, 0xcd, 0x3a, 0xf5, 0x95, 0x01, 0x1a, 0xca, 0xfb, 0x86, 0xf0, 0xbe, 0x82, 0x32, 0xdd, 0x55,
+	0x53, 0x0c, 0x44, 0x7f, 0x0f, 0xc6, 0x25, 0x47, 0x42, 0x46, 0x4f, 0xab, 0x01, 0x1a, 0xa6, 0xaf,
+	0x0e, 0xd4, 0x51, 0xbe, 0xd3, 0xc2, 0x77, 0x0a, 0xcd, 0x85, 0x7d, 0x4b, 0xfa, 0x85, 0x5c, 0x88,
+	0x29, 0xf6, 0x85, 0xd2, 0x61, 0x7b, 0x41, 0x5a, 0xa6, 0x5f, 0x18, 0x3c, 0x7a, 0x3c, 0x8f, 0xcb,
+	0xc2, 0xa3, 0x8e, 0x52, 0x61, 0x8f, 0x84, 0x95, 0xf2, 0x16, 0x77, 0xd4, 0x82, 0x84, 0x8f, 0x6b,
+	0x0d, 0xf5, 0xdb, 0x15, 0x67, 0x0f, 0xa2, 0x66, 0xac, 0x09, 0xaf, 0x69, 0xb4, 0xd8, 0xe5, 0x55,
+	0x29, 0xe7, 0x6d, 0x4c, 0xd1, 0x63, 0x0d, 0x66, 0xc2, 0xe4, 0x6d, 0xa8, 0xff, 0xcd, 0xf0, 0x7e,
+	0x3f, 0xfa, 0xd7, 0xbf, 0xd2, 0x2d, 0x71, 0x22, 0xef, 0xe3, 0x86, 0xa8, 0x09, 0x31, 0xc5, 0x0b,
+	0xfa, 0x14, 0x7a, 0x90, 0xd9, 0xe9, 0x6b, 0x83, 0x95, 0x86, 0x5d, 0xbe, 0x24, 0x04, 0xac, 0x85,
+	0x1e, 0x69, 0x00, 0x9d, 0x89, 0x8a, 0xd6, 0xfb, 0x9b, 0xf5, 0x93, 0x10, 0x7d, 0x63, 0xa8, 0x9e,
+	0x42, 0xb0, 0x2a, 0x10, 0x2c, 0xa1, 0x85, 0xde, 0x08, 0xc4, 0x78, 0xe7, 0xc1, 0xab, 0x79, 0xdc,
+	0xb7, 0xd1, 0xf8, 0x87, 0xb8, 0xbe, 0x36, 0x58, 0x69, 0x58, 0xf0, 0xde, 0xa0, 0x47, 0x1f, 0x6b,
+	0x30, 0xa9, 0x4e, 0x89, 0x77, 0xf2, 0x36, 0xbd, 0xaf, 0x0b, 0xef, 0xcb, 0x28, 0xdd, 0xcf, 0x7b,
+	0x5e, 0x3c, 0x39, 0x74, 0x1f, 0xe2, 0x1e, 0x0d, 0x40, 0x7d, 0x2c, 0x07, 0xd9, 0x83, 0x7e, 0x61,
+	0x88, 0x96, 0x02, 0xb0, 0x22, 0x00, 0x2c, 0xa0, 0xf9, 0x2e, 0x00, 0x82, 0x8a, 0xf1, 0xfa, 0xff,
+	0x4a, 0x83, 0xe9, 0x20, 0x2d, 0x40, 0xbd, 0xc7, 0x5f, 0x4f, 0xf6, 0xa1, 0xff, 0xed, 0xb5, 0x74,
+	0x87, 0x75, 0x3d, 0xd5, 0xee, 0xf2, 0x96, 0x3c, 0x70, 0x70, 0xfd, 0xd9, 0xcb, 0xb4, 0xf6, 0xfc,
+	0x65, 0x5a, 0xfb, 0xed, 0x65, 0x5a, 0x7b, 0xf2, 0x2a, 0x3d, 0xf2, 0xfc, 0x55, 0x7a, 0xe4, 0xe7,
+	0x57, 0xe9, 0x91, 0x0f, 0x4c, 0x1f, 0x1b, 0x53, 0x46, 0x6a, 0x84, 0x79, 0xbf, 0x3b, 0x56, 0x09,
+	0x97, 0x6b, 0x66, 0x4b, 0xd8, 0x15, 0xd4, 0xac, 0x30, 0x2e, 0xb8, 0xe5, 0xe5, 0x3f, 0x07, 0x00,
+	0xaf, 0x08, 0xa8, 0x28, 0x47, 0x14, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1569,6 +1687,8 @@ type QueryClient interface {
 	EthCall(ctx context.Context, in *EthCallRequest, opts ...grpc.CallOption) (*MsgEthereumTxResponse, error)
 	// EstimateGas implements the `eth_estimateGas` rpc api
 	EstimateGas(ctx context.Context, in *EthCallRequest, opts ...grpc.CallOption) (*EstimateGasResponse, error)
+	// CreateAccessList implements the `eth_createAccessList` rpc api
+	CreateAccessList(ctx context.Context, in *EthCallRequest, opts ...grpc.CallOption) (*CreateAccessListResponse, error)
 	// TraceTx implements the `debug_traceTransaction` rpc api
 	TraceTx(ctx context.Context, in *QueryTraceTxRequest, opts ...grpc.CallOption) (*QueryTraceTxResponse, error)
 	// TraceBlock implements the `debug_traceBlockByNumber` and `debug_traceBlockByHash` rpc api
@@ -1672,6 +1792,15 @@ func (c *queryClient) EstimateGas(ctx context.Context, in *EthCallRequest, opts
 	return out, nil
 }
 
+func (c *queryClient) CreateAccessList(ctx context.Context, in *EthCallRequest, opts ...grpc.CallOption) (*CreateAccessListResponse, error) {
+	out := new(CreateAccessListResponse)
+	err := c.cc.Invoke(ctx, "/stratos.evm.v1.Query/CreateAccessList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) TraceTx(ctx context.Context, in *QueryTraceTxRequest, opts ...grpc.CallOption) (*QueryTraceTxResponse, error) {
 	out := new(QueryTraceTxResponse)
 	err := c.cc.Invoke(ctx, "/stratos.evm.v1.Query/TraceTx", in, out, opts...)
@@ -1748,6 +1877,8 @@ type QueryServer interface {
 	EthCall(context.Context, *EthCallRequest) (*MsgEthereumTxResponse, error)
 	// EstimateGas implements the `eth_estimateGas` rpc api
 	EstimateGas(context.Context, *EthCallRequest) (*EstimateGasResponse, error)
+	// CreateAccessList implements the `eth_createAccessList` rpc api
+	CreateAccessList(context.Context, *EthCallRequest) (*CreateAccessListResponse, error)
 	// TraceTx implements the `debug_traceTransaction` rpc api
 	TraceTx(context.Context, *QueryTraceTxRequest) (*QueryTraceTxResponse, error)
 	// TraceBlock implements the `debug_traceBlockByNumber` and `debug_traceBlockByHash` rpc api
@@ -1793,6 +1924,9 @@ func (*UnimplementedQueryServer) EthCall(ctx context.Context, req *EthCallReques
 func (*UnimplementedQueryServer) EstimateGas(ctx context.Context, req *EthCallRequest) (*EstimateGasResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EstimateGas not implemented")
 }
+func (*UnimplementedQueryServer) CreateAccessList(ctx context.Context, req *EthCallRequest) (*CreateAccessListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAccessList not implemented")
+}
 func (*UnimplementedQueryServer) TraceTx(ctx context.Context, req *QueryTraceTxRequest) (*QueryTraceTxResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TraceTx not implemented")
 }
@@ -1978,6 +2112,24 @@ func _Query_EstimateGas_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_CreateAccessList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EthCallRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CreateAccessList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stratos.evm.v1.Query/CreateAccessList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CreateAccessList(ctx, req.(*EthCallRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_TraceTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryTraceTxRequest)
 	if err := dec(in); err != nil {
@@ -2126,6 +2278,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "EstimateGas",
 			Handler:    _Query_EstimateGas_Handler,
 		},
+		{
+			MethodName: "CreateAccessList",
+			Handler:    _Query_CreateAccessList_Handler,
+		},
 		{
 			MethodName: "TraceTx",
 			Handler:    _Query_TraceTx_Handler,
@@ -2721,6 +2877,20 @@ func (m *EthCallRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.BlockOverrides) > 0 {
+		i -= len(m.BlockOverrides)
+		copy(dAtA[i:], m.BlockOverrides)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlockOverrides)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Overrides) > 0 {
+		i -= len(m.Overrides)
+		copy(dAtA[i:], m.Overrides)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Overrides)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.GasCap != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.GasCap))
 		i--
@@ -2764,6 +2934,55 @@ func (m *EstimateGasResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *CreateAccessListResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateAccessListResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreateAccessListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Ret) > 0 {
+		i -= len(m.Ret)
+		copy(dAtA[i:], m.Ret)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Ret)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.VmError) > 0 {
+		i -= len(m.VmError)
+		copy(dAtA[i:], m.VmError)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.VmError)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.GasUsed != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.AccessList) > 0 {
+		i -= len(m.AccessList)
+		copy(dAtA[i:], m.AccessList)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AccessList)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryTraceTxRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2784,6 +3003,20 @@ func (m *QueryTraceTxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.BlockOverrides) > 0 {
+		i -= len(m.BlockOverrides)
+		copy(dAtA[i:], m.BlockOverrides)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlockOverrides)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.StateOverrides) > 0 {
+		i -= len(m.StateOverrides)
+		copy(dAtA[i:], m.StateOverrides)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StateOverrides)))
+		i--
+		dAtA[i] = 0x3a
+	}
 	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.BlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime):])
 	if err4 != nil {
 		return 0, err4
@@ -3409,6 +3642,14 @@ func (m *EthCallRequest) Size() (n int) {
 	if m.GasCap != 0 {
 		n += 1 + sovQuery(uint64(m.GasCap))
 	}
+	l = len(m.Overrides)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.BlockOverrides)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -3424,6 +3665,30 @@ func (m *EstimateGasResponse) Size() (n int) {
 	return n
 }
 
+func (m *CreateAccessListResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AccessList)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovQuery(uint64(m.GasUsed))
+	}
+	l = len(m.VmError)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Ret)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func (m *QueryTraceTxRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3453,6 +3718,14 @@ func (m *QueryTraceTxRequest) Size() (n int) {
 	}
 	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime)
 	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.StateOverrides)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.BlockOverrides)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -5187,6 +5460,74 @@ func (m *EthCallRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Overrides", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Overrides = append(m.Overrides[:0], dAtA[iNdEx:postIndex]...)
+			if m.Overrides == nil {
+				m.Overrides = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockOverrides", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockOverrides = append(m.BlockOverrides[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockOverrides == nil {
+				m.BlockOverrides = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5277,6 +5618,175 @@ func (m *EstimateGasResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *CreateAccessListResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateAccessListResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateAccessListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessList", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccessList = append(m.AccessList[:0], dAtA[iNdEx:postIndex]...)
+			if m.AccessList == nil {
+				m.AccessList = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VmError", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VmError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ret", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ret = append(m.Ret[:0], dAtA[iNdEx:postIndex]...)
+			if m.Ret == nil {
+				m.Ret = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -5496,6 +6006,74 @@ func (m *QueryTraceTxRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateOverrides", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StateOverrides = append(m.StateOverrides[:0], dAtA[iNdEx:postIndex]...)
+			if m.StateOverrides == nil {
+				m.StateOverrides = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockOverrides", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockOverrides = append(m.BlockOverrides[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockOverrides == nil {
+				m.BlockOverrides = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
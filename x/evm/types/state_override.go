@@ -0,0 +1,115 @@
+package types
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/stratosnet/stratos-chain/x/evm/vm"
+)
+
+// OverrideStateDB is the state database the state overrides are applied to
+type OverrideStateDB interface {
+	SetNonce(addr common.Address, nonce uint64)
+	SetCode(addr common.Address, code []byte)
+	SetBalance(addr common.Address, amount *big.Int)
+	SetStorage(addr common.Address, storage map[common.Hash]common.Hash)
+	SetCommittedState(addr common.Address, key, value common.Hash)
+}
+
+// StateOverride is the collection of overridden accounts.
+type StateOverride map[common.Address]OverrideAccount
+
+// OverrideAccount indicates the overriding fields of account during the execution of
+// a message call.
+// Note, state and stateDiff can't be specified at the same time. If state is
+// set, message execution will only use the data in the given state. Otherwise
+// if statDiff is set, all diff will be applied first and then execute the call
+// message.
+type OverrideAccount struct {
+	Nonce     *hexutil.Uint64              `json:"nonce"`
+	Code      *hexutil.Bytes               `json:"code"`
+	Balance   **hexutil.Big                `json:"balance"`
+	State     *map[common.Hash]common.Hash `json:"state"`
+	StateDiff *map[common.Hash]common.Hash `json:"stateDiff"`
+}
+
+// Apply overrides the fields of specified accounts into the given state.
+func (diff *StateOverride) Apply(state OverrideStateDB) error {
+	if diff == nil {
+		return nil
+	}
+	for addr, account := range *diff {
+		// Override account nonce.
+		if account.Nonce != nil {
+			state.SetNonce(addr, uint64(*account.Nonce))
+		}
+		// Override account(contract) code.
+		if account.Code != nil {
+			state.SetCode(addr, *account.Code)
+		}
+		// Override account balance.
+		if account.Balance != nil {
+			state.SetBalance(addr, (*big.Int)(*account.Balance))
+		}
+		if account.State != nil && account.StateDiff != nil {
+			return fmt.Errorf("account %s has both 'state' and 'stateDiff'", addr.Hex())
+		}
+		// Replace entire state if caller requires.
+		if account.State != nil {
+			state.SetStorage(addr, *account.State)
+		}
+		// Apply state diff into specified accounts.
+		if account.StateDiff != nil {
+			for key, value := range *account.StateDiff {
+				state.SetCommittedState(addr, key, value)
+			}
+		}
+	}
+	return nil
+}
+
+// GetNonce returns the overridden nonce of the given account, if any.
+func (diff *StateOverride) GetNonce(addr common.Address) (uint64, bool) {
+	if diff == nil {
+		return 0, false
+	}
+	account, ok := (*diff)[addr]
+	if !ok || account.Nonce == nil {
+		return 0, false
+	}
+	return uint64(*account.Nonce), true
+}
+
+// BlockOverrides is a set of header fields to override.
+type BlockOverrides struct {
+	Number   *hexutil.Big    `json:"number"`
+	Time     *hexutil.Uint64 `json:"time"`
+	GasLimit *hexutil.Uint64 `json:"gasLimit"`
+	Coinbase *common.Address `json:"coinbase"`
+	BaseFee  *hexutil.Big    `json:"baseFee"`
+}
+
+// Apply overrides the given header fields into the given block context.
+func (diff *BlockOverrides) Apply(blockCtx *vm.BlockContext) {
+	if diff == nil {
+		return
+	}
+	if diff.Number != nil {
+		blockCtx.BlockNumber = diff.Number.ToInt()
+	}
+	if diff.Time != nil {
+		blockCtx.Time = new(big.Int).SetUint64(uint64(*diff.Time))
+	}
+	if diff.GasLimit != nil {
+		blockCtx.GasLimit = uint64(*diff.GasLimit)
+	}
+	if diff.Coinbase != nil {
+		blockCtx.Coinbase = *diff.Coinbase
+	}
+	if diff.BaseFee != nil {
+		blockCtx.BaseFee = diff.BaseFee.ToInt()
+	}
+}
@@ -48,6 +48,8 @@ type (
 	ParseProtoFromDataFunc func(data []byte, gas uint64) ([]byte, uint64, error)
 	// RunSdkMsgFunc execute any cosmos msg with provided data in RUNSDKMSG OpCode
 	RunSdkMsgFunc func(from common.Address, data []byte, gas uint64) ([]byte, uint64, error)
+	// NozPriceTWAPFunc get time weighted average noz price in NOZPRICETWAP OpCode
+	NozPriceTWAPFunc func(data []byte, gas uint64) ([]byte, uint64, error)
 )
 
 func (evm *EVM) precompile(addr common.Address) (PrecompiledContract, bool) {
@@ -80,6 +82,8 @@ type BlockContext struct {
 	RunSdkMsg RunSdkMsgFunc
 	// ParseProtoFromData get proto info
 	ParseProtoFromData ParseProtoFromDataFunc
+	// NozPriceTWAP get time weighted average noz price
+	NozPriceTWAP NozPriceTWAPFunc
 
 	// Block information
 	Coinbase    common.Address // Provides information for COINBASE
@@ -913,6 +913,40 @@ func opPpfd(pc *uint64, interpreter *EVMInterpreter, scope *ScopeContext) ([]byt
 	return ret, nil
 }
 
+// opNozPriceTwap returns the time weighted average noz price (18 decimals) for abi encoded window in blocks,
+// no caller verification is required as it is read only
+func opNozPriceTwap(pc *uint64, interpreter *EVMInterpreter, scope *ScopeContext) ([]byte, error) {
+	stack := scope.Stack
+	// Pop gas. The actual gas in interpreter.evm.callGasTemp.
+	// We can use this as a temporary value
+	temp := stack.pop()
+	gas := interpreter.evm.callGasTemp
+
+	if interpreter.evm.Context.NozPriceTWAP == nil {
+		return nil, fmt.Errorf("noz price twap is not supported")
+	}
+	inOffset, inSize, retOffset, retSize := stack.pop(), stack.pop(), stack.pop(), stack.pop()
+
+	data := scope.Memory.GetCopy(int64(inOffset.Uint64()), int64(inSize.Uint64()))
+
+	ret, returnGas, err := interpreter.evm.Context.NozPriceTWAP(data, gas)
+	if err != nil {
+		temp.Clear()
+	} else {
+		temp.SetOne()
+	}
+	stack.push(&temp)
+
+	if err == nil {
+		ret = common.CopyBytes(ret)
+		scope.Memory.Set(retOffset.Uint64(), retSize.Uint64(), ret)
+	}
+	scope.Contract.Gas += returnGas
+
+	interpreter.returnData = ret
+	return ret, nil
+}
+
 func opRunSdkMsg(pc *uint64, interpreter *EVMInterpreter, scope *ScopeContext) (ret []byte, err error) {
 	stack := scope.Stack
 	contract := scope.Contract
@@ -0,0 +1,78 @@
+package vm
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/require"
+)
+
+// nozPriceTwapCode stores the window at memory 0, calls NOZPRICETWAP through STATICCALL
+// and returns the 32 bytes result followed by the success flag of the call
+func nozPriceTwapCode(window byte) []byte {
+	return []byte{
+		byte(PUSH1), window, byte(PUSH1), 0x00, byte(MSTORE),
+		// retSize, retOffset, inSize, inOffset, address, gas
+		byte(PUSH1), 0x20, byte(PUSH1), 0x20, byte(PUSH1), 0x20, byte(PUSH1), 0x00,
+		byte(PUSH1), byte(NOZPRICETWAP), byte(GAS), byte(STATICCALL),
+		byte(PUSH1), 0x40, byte(MSTORE),
+		byte(PUSH1), 0x40, byte(PUSH1), 0x20, byte(RETURN),
+	}
+}
+
+// runNozPriceTwap runs the code against a twap function using usedGas and returns the output and the gas left
+func runNozPriceTwap(t *testing.T, usedGas uint64, twapErr error) (ret []byte, window []byte, gasLeft uint64) {
+	nozPriceTWAP := func(data []byte, gas uint64) ([]byte, uint64, error) {
+		window = data
+		if twapErr != nil {
+			return nil, gas - usedGas, twapErr
+		}
+		return common.LeftPadBytes([]byte{0x2a}, 32), gas - usedGas, nil
+	}
+
+	evm := NewEVM(BlockContext{BlockNumber: big.NewInt(1), NozPriceTWAP: nozPriceTWAP}, TxContext{}, nil,
+		params.AllEthashProtocolChanges, Config{}, nil)
+	contract := NewContract(AccountRef(common.Address{0x1}), AccountRef(common.Address{0x2}), new(big.Int), 1000000)
+	contract.Code = nozPriceTwapCode(0x64)
+
+	ret, err := evm.interpreter.Run(contract, nil, true)
+	require.NoError(t, err)
+	return ret, window, contract.Gas
+}
+
+func TestOpNozPriceTwap(t *testing.T) {
+	ret, window, gasLeft := runNozPriceTwap(t, 3000, nil)
+
+	// the abi encoded window is passed to the keeper and its result is copied to the return memory
+	require.Equal(t, common.LeftPadBytes([]byte{0x64}, 32), window)
+	require.Equal(t, common.LeftPadBytes([]byte{0x2a}, 32), ret[:32])
+	require.Equal(t, common.LeftPadBytes([]byte{0x1}, 32), ret[32:])
+
+	// only the gas reported as used by the keeper is charged on top of the call
+	_, _, gasLeftCostlier := runNozPriceTwap(t, 5000, nil)
+	require.Equal(t, uint64(2000), gasLeft-gasLeftCostlier)
+}
+
+func TestOpNozPriceTwapFailure(t *testing.T) {
+	ret, _, gasLeft := runNozPriceTwap(t, 3000, errors.New("no noz price history recorded"))
+
+	// the failure is reported as a zero success flag and the return memory is left untouched
+	require.Equal(t, make([]byte, 32), ret[:32])
+	require.Equal(t, make([]byte, 32), ret[32:])
+
+	// the used gas is charged as well
+	_, _, gasLeftSuccess := runNozPriceTwap(t, 3000, nil)
+	require.Equal(t, gasLeftSuccess, gasLeft)
+}
+
+func TestOpNozPriceTwapNotSupported(t *testing.T) {
+	evm := NewEVM(BlockContext{BlockNumber: big.NewInt(1)}, TxContext{}, nil, params.AllEthashProtocolChanges, Config{}, nil)
+	contract := NewContract(AccountRef(common.Address{0x1}), AccountRef(common.Address{0x2}), new(big.Int), 1000000)
+	contract.Code = nozPriceTwapCode(0x64)
+
+	_, err := evm.interpreter.Run(contract, nil, true)
+	require.EqualError(t, err, "noz price twap is not supported")
+}
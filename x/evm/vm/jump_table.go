@@ -1057,6 +1057,14 @@ func newKeeperInstructionSet(instructionSet JumpTable) JumpTable {
 		maxStack:    maxStack(5, 1),
 		memorySize:  memoryStStaticCallOpCode,
 	}
+	instructionSet[NOZPRICETWAP] = &operation{
+		execute:     opNozPriceTwap,
+		constantGas: GasExtStep,
+		dynamicGas:  gasStOpCode,
+		minStack:    minStack(5, 1),
+		maxStack:    maxStack(5, 1),
+		memorySize:  memoryStStaticCallOpCode,
+	}
 	instructionSet[RUNSDKMSG] = &operation{
 		execute:     opRunSdkMsg,
 		constantGas: GasExtStep,
@@ -10,7 +10,8 @@ var handlerNumToOpcode = map[OpCode]map[OpCode]bool{
 		RUNSDKMSG: true,
 	},
 	STATICCALL: {
-		PPFD: true,
+		PPFD:         true,
+		NOZPRICETWAP: true,
 	},
 }
 
@@ -211,6 +211,7 @@ const (
 const (
 	PPFD OpCode = 0xdc + iota
 	RUNSDKMSG
+	NOZPRICETWAP
 )
 
 // 0xf0 range - closures.
@@ -383,8 +384,9 @@ var opCodeToString = map[OpCode]string{
 	LOG4:   "LOG4",
 
 	// 0xdc range.
-	PPFD:      "PPFD",
-	RUNSDKMSG: "RUNSDKMSG",
+	PPFD:         "PPFD",
+	RUNSDKMSG:    "RUNSDKMSG",
+	NOZPRICETWAP: "NOZPRICETWAP",
 
 	// 0xf0 range.
 	CREATE:       "CREATE",
@@ -554,8 +556,9 @@ var stringToOp = map[string]OpCode{
 	"INVALID":        INVALID,
 	"SELFDESTRUCT":   SELFDESTRUCT,
 	// new
-	"PPFD":      PPFD,
-	"RUNSDKMSG": RUNSDKMSG,
+	"PPFD":         PPFD,
+	"RUNSDKMSG":    RUNSDKMSG,
+	"NOZPRICETWAP": NOZPRICETWAP,
 }
 
 // StringToOp finds the opcode whose name is stored in `str`.
@@ -27,6 +27,15 @@ func EndBlocker(ctx sdk.Context, req abci.RequestEndBlock, k keeper.Keeper) []ab
 		logger.Error("An error occurred while distributing the reward. ", "ErrMsg", err.Error())
 	}
 
+	// distribute the rewards of the accepted volume reports
+	err = k.DistributePendingRewards(ctx)
+	if err != nil {
+		logger.Error("An error occurred while distributing the pot reward. ", "ErrMsg", err.Error())
+	}
+
+	// drop the chunked volume report not completed in time
+	k.ExpirePendingVolumeReport(ctx)
+
 	// reset total supply to 100M stos every 10k blocks
 	if ctx.BlockHeight()%CHECK_TOTAL_SUPPLY_INTERVAL == 1 {
 		logger.Info("start RestoreTotalSupply")
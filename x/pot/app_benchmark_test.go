@@ -115,7 +115,7 @@ func TestVolumeReportBenchmark(t *testing.T) {
 	header = tmproto.Header{Height: stApp.LastBlockHeight() + 1, ChainID: testchainID}
 	stApp.BeginBlock(abci.RequestBeginBlock{Header: header})
 	ctx = stApp.BaseApp.NewContext(false, header)
-	prepayMsg := sdstypes.NewMsgPrepay(resourceNodes[0].OwnerAddress, resourceNodes[0].OwnerAddress, prepayAmt)
+	prepayMsg := sdstypes.NewMsgPrepay(resourceNodes[0].OwnerAddress, resourceNodes[0].OwnerAddress, prepayAmt, sdkmath.ZeroInt(), 0)
 	senderAcc = accountKeeper.GetAccount(ctx, keysMap[resourceNodes[0].NetworkAddress].OwnerAddress())
 
 	accNum = senderAcc.GetAccountNumber()
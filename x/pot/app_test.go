@@ -210,7 +210,7 @@ func TestPotVolumeReportMsgs(t *testing.T) {
 	header = tmproto.Header{Height: stApp.LastBlockHeight() + 1, ChainID: chainID}
 	stApp.BeginBlock(abci.RequestBeginBlock{Header: header})
 	ctx = stApp.BaseApp.NewContext(false, header)
-	prepayMsg := sdstypes.NewMsgPrepay(resOwner1.String(), resOwner1.String(), prepayAmount)
+	prepayMsg := sdstypes.NewMsgPrepay(resOwner1.String(), resOwner1.String(), prepayAmount, sdkmath.ZeroInt(), 0)
 	senderAcc = accountKeeper.GetAccount(ctx, resOwner1)
 	accNum = senderAcc.GetAccountNumber()
 	accSeq = senderAcc.GetSequence()
@@ -18,8 +18,14 @@ const (
 	FlagSlashing        = "slashing"
 	FlagSuspend         = "suspend"
 	FlagBLSSignature    = "bls-signature"
+	FlagReason          = "reason"
+	FlagStartEpoch      = "start-epoch"
+	FlagEndEpoch        = "end-epoch"
 )
 
+// OutputFormatCSV prints query results as csv rows, for the queries supporting it
+const OutputFormatCSV = "csv"
+
 // FlagSetAmount Returns the FlagSet for amount related operations.
 func flagSetAmount() *flag.FlagSet {
 	fs := flag.NewFlagSet("", flag.ContinueOnError)
@@ -90,3 +96,18 @@ func flagSetSuspend() *flag.FlagSet {
 	fs.Bool(FlagSuspend, false, "if the resource node is suspend")
 	return fs
 }
+
+// flagSetReason Returns the FlagSet for the reason of a dispute.
+func flagSetReason() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.String(FlagReason, "", "the reason why the volume report is disputed")
+	return fs
+}
+
+// flagSetEpochRange Returns the FlagSet for an epoch range.
+func flagSetEpochRange() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.Int64(FlagStartEpoch, 0, "the first epoch of the range, 0 for no lower bound")
+	fs.Int64(FlagEndEpoch, 0, "the last epoch of the range, 0 for no upper bound")
+	return fs
+}
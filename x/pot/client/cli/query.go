@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"encoding/csv"
 	"fmt"
 	"strconv"
 	"strings"
@@ -33,6 +34,12 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryTotalMinedTokens(),
 		GetCmdQueryCirculationSupply(),
 		GetCmdQueryTotalRewardByEpoch(),
+		GetCmdQueryPendingVolumeReport(),
+		GetCmdQueryDistributionProgress(),
+		GetCmdQueryVolumeReportDispute(),
+		GetCmdQueryVestingSchedule(),
+		GetCmdQueryRewardHistory(),
+		GetCmdQueryAutoRestake(),
 	)
 
 	return potQueryCmd
@@ -66,6 +73,249 @@ func GetCmdQueryCirculationSupply() *cobra.Command {
 	return cmd
 }
 
+func GetCmdQueryPendingVolumeReport() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-volume-report",
+		Args:  cobra.NoArgs,
+		Short: "Query the chunked volume report waiting for its chunks",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the chunked volume report waiting for its chunks.`),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.PendingVolumeReport(cmd.Context(), &types.QueryPendingVolumeReportRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdQueryDistributionProgress() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "distribution-progress",
+		Args:  cobra.NoArgs,
+		Short: "Query the progress of the reward distributions",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the last distributed epoch and the volume reports whose rewards are being distributed.`),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.DistributionProgress(cmd.Context(), &types.QueryDistributionProgressRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdQueryVolumeReportDispute() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dispute [epoch]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the dispute filed against the volume report of an epoch",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the dispute filed against the volume report of an epoch.
+
+Example:
+$ %s query pot dispute 100
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			epoch, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.VolumeReportDispute(cmd.Context(), &types.QueryVolumeReportDisputeRequest{Epoch: epoch})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdQueryVestingSchedule() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vesting-schedule [wallet_address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the epochs at which the immature rewards of a wallet mature",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the epochs at which the immature rewards of a wallet mature.
+
+Example:
+$ %s query pot vesting-schedule st1yx3kkx9jnqwehgmkelmq0cp3r6wxnhdxvqrm2u
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.VestingSchedule(cmd.Context(), &types.QueryVestingScheduleRequest{
+				WalletAddress: args[0],
+				Pagination:    pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "vesting schedule")
+	return cmd
+}
+
+func GetCmdQueryRewardHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reward-history [wallet_address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the matured rewards of a wallet by epoch",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the matured rewards of a wallet by epoch, with the slashing deducted from them.
+Use --output csv to print the history as csv rows.
+
+Example:
+$ %s query pot reward-history st1yx3kkx9jnqwehgmkelmq0cp3r6wxnhdxvqrm2u --start-epoch 100 --end-epoch 200 --output csv
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			startEpoch, err := cmd.Flags().GetInt64(FlagStartEpoch)
+			if err != nil {
+				return err
+			}
+			endEpoch, err := cmd.Flags().GetInt64(FlagEndEpoch)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.RewardHistory(cmd.Context(), &types.QueryRewardHistoryRequest{
+				WalletAddress: args[0],
+				StartEpoch:    startEpoch,
+				EndEpoch:      endEpoch,
+				Pagination:    pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			if clientCtx.OutputFormat == OutputFormatCSV {
+				return printRewardHistoryCSV(cmd, res.GetHistories())
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(flagSetEpochRange())
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "reward history")
+	return cmd
+}
+
+func printRewardHistoryCSV(cmd *cobra.Command, histories []types.RewardHistory) error {
+	w := csv.NewWriter(cmd.OutOrStdout())
+	err := w.Write([]string{"wallet_address", "epoch", "reward_from_mining_pool", "reward_from_traffic_pool", "slashing_deducted"})
+	if err != nil {
+		return err
+	}
+	for _, history := range histories {
+		err = w.Write([]string{
+			history.GetWalletAddress(),
+			history.Epoch.String(),
+			history.RewardFromMiningPool.String(),
+			history.RewardFromTrafficPool.String(),
+			history.SlashingDeducted.String(),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	w.Flush()
+	return w.Error()
+}
+
+func GetCmdQueryAutoRestake() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "auto-restake [wallet_address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the node the matured rewards of a wallet are automatically added to the deposit of",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.AutoRestake(cmd.Context(), &types.QueryAutoRestakeRequest{WalletAddress: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 func GetCmdQueryTotalMinedTokens() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "total-mined-token",
@@ -0,0 +1,41 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/csv"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/stratosnet/stratos-chain/x/pot/types"
+)
+
+func TestPrintRewardHistoryCSV(t *testing.T) {
+	walletAddr := sdk.AccAddress("wallet______________")
+	histories := []types.RewardHistory{
+		{
+			WalletAddress:         walletAddr.String(),
+			Epoch:                 sdkmath.NewInt(7),
+			RewardFromMiningPool:  sdk.NewCoins(sdk.NewInt64Coin("wei", 100), sdk.NewInt64Coin("utros", 2)),
+			RewardFromTrafficPool: sdk.NewCoins(sdk.NewInt64Coin("wei", 50)),
+			SlashingDeducted:      sdk.Coins{},
+		},
+	}
+
+	var out bytes.Buffer
+	cmd := &cobra.Command{}
+	cmd.SetOut(&out)
+	require.NoError(t, printRewardHistoryCSV(cmd, histories))
+
+	// the coins lists are quoted, so each history is a single record of five fields
+	records, err := csv.NewReader(&out).ReadAll()
+	require.NoError(t, err)
+	require.Equal(t, [][]string{
+		{"wallet_address", "epoch", "reward_from_mining_pool", "reward_from_traffic_pool", "slashing_deducted"},
+		{walletAddr.String(), "7", "2utros,100wei", "50wei", ""},
+	}, records)
+}
@@ -38,6 +38,8 @@ func NewTxCmd() *cobra.Command {
 		WithdrawCmd(),
 		FoundationDepositCmd(),
 		SlashingResourceNodeCmd(),
+		DisputeVolumeReportCmd(),
+		AutoRestakeCmd(),
 	)
 	return potTxCmd
 }
@@ -102,6 +104,34 @@ func buildWithdrawMsg(clientCtx client.Context, fs *flag.FlagSet) (*types.MsgWit
 }
 
 // VolumeReportCmd will report wallets volume and sign it with the given key.
+func AutoRestakeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "auto-restake",
+		Short: "add matured POT reward to the deposit of an owned node automatically, without --network-address to disable",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			networkAddress, err := cmd.Flags().GetString(FlagNetworkAddress)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetAutoRestake(clientCtx.GetFromAddress(), networkAddress)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(flagSetNetworkAddress())
+	flags.AddTxFlagsToCmd(cmd)
+
+	_ = cmd.MarkFlagRequired(flags.FlagFrom)
+
+	return cmd
+}
+
 func VolumeReportCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "report [flags]",
@@ -158,6 +188,32 @@ func createVolumeReportMsg(clientCtx client.Context, fs *flag.FlagSet) (*types.M
 	}
 	epoch := sdkmath.NewInt(value)
 
+	walletVolumes, err := parseWalletVolumes(fs)
+	if err != nil {
+		return nil, err
+	}
+
+	reporterOwner := clientCtx.GetFromAddress()
+
+	signature, err := parseBLSSignature(fs)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := types.NewMsgVolumeReport(
+		walletVolumes,
+		reporter,
+		epoch,
+		reportReference,
+		reporterOwner,
+	)
+
+	msg.BLSSignature = signature
+
+	return msg, nil
+}
+
+func parseWalletVolumes(fs *flag.FlagSet) ([]types.SingleWalletVolume, error) {
 	flagWalletVolumes, err := fs.GetString(FlagWalletVolumes)
 	if err != nil {
 		return nil, err
@@ -181,18 +237,19 @@ func createVolumeReportMsg(clientCtx client.Context, fs *flag.FlagSet) (*types.M
 		volume := sdkmath.NewInt(volumeInt64)
 		walletVolumes = append(walletVolumes, types.NewSingleWalletVolume(walletAcc, volume))
 	}
+	return walletVolumes, nil
+}
 
-	reporterOwner := clientCtx.GetFromAddress()
-
+func parseBLSSignature(fs *flag.FlagSet) (types.BLSSignatureInfo, error) {
 	blsSigture, err := fs.GetString(FlagBLSSignature)
 	if err != nil {
-		return nil, err
+		return types.BLSSignatureInfo{}, err
 	}
 
 	var sig types.BaseBLSSignatureInfo
 	err = json.Unmarshal([]byte(blsSigture), &sig)
 	if err != nil {
-		return nil, err
+		return types.BLSSignatureInfo{}, err
 	}
 
 	// TODO: change pubkey
@@ -201,16 +258,78 @@ func createVolumeReportMsg(clientCtx client.Context, fs *flag.FlagSet) (*types.M
 		pubKeys[i] = []byte(v)
 	}
 
-	signature := types.NewBLSSignatureInfo(pubKeys, []byte(sig.Signature), []byte(sig.TxData))
+	return types.NewBLSSignatureInfo(pubKeys, []byte(sig.Signature), []byte(sig.TxData)), nil
+}
 
-	msg := types.NewMsgVolumeReport(
-		walletVolumes,
-		reporter,
-		epoch,
-		reportReference,
-		reporterOwner,
-	)
+// DisputeVolumeReportCmd will dispute a volume report with a counter-report signed by a threshold of the meta nodes.
+func DisputeVolumeReportCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dispute [flags]",
+		Short: "Dispute a volume report during its dispute window",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg, err := buildDisputeVolumeReportMsg(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().AddFlagSet(flagSetReportVolumes())
+	cmd.Flags().AddFlagSet(flagSetReason())
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	_ = cmd.MarkFlagRequired(FlagReporterAddr)
+	_ = cmd.MarkFlagRequired(FlagEpoch)
+	_ = cmd.MarkFlagRequired(FlagWalletVolumes)
+	_ = cmd.MarkFlagRequired(FlagReason)
+	_ = cmd.MarkFlagRequired(flags.FlagFrom)
+
+	return cmd
+}
+
+func buildDisputeVolumeReportMsg(clientCtx client.Context, fs *flag.FlagSet) (*types.MsgDisputeVolumeReport, error) {
+	reporterStr, err := fs.GetString(FlagReporterAddr)
+	if err != nil {
+		return nil, err
+	}
+	reporter, err := stratos.SdsAddressFromBech32(reporterStr)
+	if err != nil {
+		return nil, err
+	}
+
+	flagEpochStr, err := fs.GetString(FlagEpoch)
+	if err != nil {
+		return nil, err
+	}
+	value, err := strconv.ParseInt(flagEpochStr, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	epoch := sdkmath.NewInt(value)
+
+	walletVolumes, err := parseWalletVolumes(fs)
+	if err != nil {
+		return nil, err
+	}
+
+	reason, err := fs.GetString(FlagReason)
+	if err != nil {
+		return nil, err
+	}
+
+	signature, err := parseBLSSignature(fs)
+	if err != nil {
+		return nil, err
+	}
 
+	msg := types.NewMsgDisputeVolumeReport(clientCtx.GetFromAddress(), reporter, epoch, walletVolumes, reason)
 	msg.BLSSignature = signature
 
 	return msg, nil
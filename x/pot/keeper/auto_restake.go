@@ -0,0 +1,144 @@
+package keeper
+
+import (
+	"cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	stratos "github.com/stratosnet/stratos-chain/types"
+	"github.com/stratosnet/stratos-chain/x/pot/types"
+)
+
+func (k Keeper) SetAutoRestake(ctx sdk.Context, autoRestake types.AutoRestake) {
+	walletAddr, err := sdk.AccAddressFromBech32(autoRestake.GetWalletAddress())
+	if err != nil {
+		panic(err)
+	}
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalLengthPrefixed(&autoRestake)
+	store.Set(types.GetAutoRestakeKey(walletAddr), bz)
+}
+
+func (k Keeper) GetAutoRestake(ctx sdk.Context, walletAddr sdk.AccAddress) (autoRestake types.AutoRestake, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetAutoRestakeKey(walletAddr))
+	if bz == nil {
+		return autoRestake, false
+	}
+	k.cdc.MustUnmarshalLengthPrefixed(bz, &autoRestake)
+	return autoRestake, true
+}
+
+func (k Keeper) RemoveAutoRestake(ctx sdk.Context, walletAddr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetAutoRestakeKey(walletAddr))
+}
+
+func (k Keeper) IterateAutoRestakes(ctx sdk.Context, handler func(autoRestake types.AutoRestake) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.AutoRestakeKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var autoRestake types.AutoRestake
+		k.cdc.MustUnmarshalLengthPrefixed(iter.Value(), &autoRestake)
+		if handler(autoRestake) {
+			break
+		}
+	}
+}
+
+// UpdateAutoRestake opts a wallet in auto-restake to the deposit of a node it owns, an empty network address opts it out
+func (k Keeper) UpdateAutoRestake(ctx sdk.Context, walletAddr sdk.AccAddress, networkAddress string) error {
+	if len(networkAddress) == 0 {
+		k.RemoveAutoRestake(ctx, walletAddr)
+		return nil
+	}
+
+	networkAddr, err := stratos.SdsAddressFromBech32(networkAddress)
+	if err != nil {
+		return errors.Wrap(types.ErrInvalidAddress, err.Error())
+	}
+
+	var ownerAddress string
+	var status stakingtypes.BondStatus
+	if metaNode, found := k.registerKeeper.GetMetaNode(ctx, networkAddr); found {
+		ownerAddress, status = metaNode.GetOwnerAddress(), metaNode.GetStatus()
+	} else if resourceNode, found := k.registerKeeper.GetResourceNode(ctx, networkAddr); found {
+		ownerAddress, status = resourceNode.GetOwnerAddress(), resourceNode.GetStatus()
+	} else {
+		return errors.Wrapf(types.ErrAutoRestakeNode, "node %s not found", networkAddress)
+	}
+	if ownerAddress != walletAddr.String() {
+		return errors.Wrapf(types.ErrAutoRestakeNode, "node %s is not owned by %s", networkAddress, walletAddr.String())
+	}
+	if status == stakingtypes.Unbonding {
+		return errors.Wrapf(types.ErrAutoRestakeNode, "node %s is unbonding", networkAddress)
+	}
+
+	k.SetAutoRestake(ctx, types.AutoRestake{
+		WalletAddress:  walletAddr.String(),
+		NetworkAddress: networkAddress,
+	})
+	return nil
+}
+
+// autoRestakeMaturedReward adds the newly matured reward of a wallet opted in auto-restake to the deposit of its node.
+// The matured reward stays withdrawable when the node cannot accept the deposit.
+func (k Keeper) autoRestakeMaturedReward(ctx sdk.Context, walletAddr sdk.AccAddress, matured sdk.Coins) {
+	autoRestake, found := k.GetAutoRestake(ctx, walletAddr)
+	if !found {
+		return
+	}
+	amount := sdk.NewCoin(k.BondDenom(ctx), matured.AmountOf(k.BondDenom(ctx)))
+	if !amount.IsPositive() {
+		return
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+	err := k.restake(cacheCtx, walletAddr, autoRestake.GetNetworkAddress(), amount)
+	if err != nil {
+		ctx.Logger().Info("auto-restake skipped", "wallet", walletAddr.String(),
+			"node", autoRestake.GetNetworkAddress(), "err", err.Error())
+		return
+	}
+	write()
+}
+
+func (k Keeper) restake(ctx sdk.Context, walletAddr sdk.AccAddress, networkAddress string, amount sdk.Coin) error {
+	networkAddr, err := stratos.SdsAddressFromBech32(networkAddress)
+	if err != nil {
+		return err
+	}
+
+	// the deposit is added from the owner wallet, the same as a withdrawal followed by a deposit update
+	err = k.Withdraw(ctx, sdk.NewCoins(amount), walletAddr, walletAddr)
+	if err != nil {
+		return err
+	}
+
+	var ozoneLimitChange, currentDeposit sdkmath.Int
+	if _, found := k.registerKeeper.GetMetaNode(ctx, networkAddr); found {
+		ozoneLimitChange, _, _, _, _, err = k.registerKeeper.UpdateMetaNodeDeposit(ctx, networkAddr, walletAddr, amount)
+		if err != nil {
+			return err
+		}
+		metaNode, _ := k.registerKeeper.GetMetaNode(ctx, networkAddr)
+		currentDeposit = metaNode.Tokens
+	} else {
+		ozoneLimitChange, _, _, _, _, err = k.registerKeeper.UpdateResourceNodeDeposit(ctx, networkAddr, walletAddr, amount)
+		if err != nil {
+			return err
+		}
+		resourceNode, _ := k.registerKeeper.GetResourceNode(ctx, networkAddr)
+		currentDeposit = resourceNode.Tokens
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventAutoRestake{
+		WalletAddress:     walletAddr.String(),
+		NetworkAddress:    networkAddress,
+		Amount:            amount.String(),
+		CurrentDeposit:    sdk.NewCoin(k.BondDenom(ctx), currentDeposit).String(),
+		OzoneLimitChanges: ozoneLimitChange.String(),
+	})
+}
@@ -0,0 +1,139 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	stratosapp "github.com/stratosnet/stratos-chain/app"
+	"github.com/stratosnet/stratos-chain/x/pot/keeper"
+	"github.com/stratosnet/stratos-chain/x/pot/types"
+)
+
+// setupMaturingEpoch distributes the wallet volumes of the first epoch with the rewards maturing at the next epoch
+func setupMaturingEpoch(t *testing.T) (*stratosapp.StratosApp, sdk.Context, sdkmath.Int) {
+	stApp, ctx := setupApp(t)
+	k := stApp.GetPotKeeper()
+
+	params := k.GetParams(ctx)
+	params.DisputeWindow = 0
+	params.MatureEpoch = 1
+	require.NoError(t, k.SetParams(ctx, params))
+
+	epoch := sdkmath.NewInt(1)
+	require.NoError(t, k.QueueRewardDistribution(ctx, walletVolumes(), epoch))
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	require.NoError(t, k.DistributePendingRewards(ctx))
+	require.True(t, k.GetLastDistributedEpoch(ctx).Equal(epoch))
+	return stApp, ctx, epoch.AddRaw(params.MatureEpoch)
+}
+
+// distributeAndMature distributes the wallet volumes of epoch and matures the rewards maturing at epoch
+func distributeAndMature(t *testing.T, k keeper.Keeper, ctx sdk.Context, epoch sdkmath.Int) sdk.Context {
+	require.NoError(t, k.QueueRewardDistribution(ctx, walletVolumes(), epoch))
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	require.NoError(t, k.DistributePendingRewards(ctx))
+	require.NoError(t, k.RewardMatureAndSubSlashing(ctx))
+	require.True(t, k.GetMaturedEpoch(ctx).Equal(epoch))
+	return ctx
+}
+
+func setAutoRestake(t *testing.T, stApp *stratosapp.StratosApp, ctx sdk.Context, walletAddr sdk.AccAddress, networkAddress string) error {
+	msg := types.NewMsgSetAutoRestake(walletAddr, networkAddress)
+	require.NoError(t, msg.ValidateBasic())
+	_, err := keeper.NewMsgServerImpl(stApp.GetPotKeeper()).HandleMsgSetAutoRestake(sdk.WrapSDKContext(ctx), msg)
+	return err
+}
+
+// maturedBondAmount returns the part of the reward of the wallet maturing at matureEpoch that can be restaked
+func maturedBondAmount(t *testing.T, k keeper.Keeper, ctx sdk.Context, walletAddr sdk.AccAddress, matureEpoch sdkmath.Int) sdkmath.Int {
+	reward, found := k.GetIndividualReward(ctx, walletAddr, matureEpoch)
+	require.True(t, found)
+	amount := reward.RewardFromMiningPool.Add(reward.RewardFromTrafficPool...).AmountOf(k.BondDenom(ctx))
+	require.True(t, amount.IsPositive())
+	return amount
+}
+
+func TestAutoRestakeMaturedReward(t *testing.T) {
+	stApp, ctx, maturingEpoch := setupMaturingEpoch(t)
+	k := stApp.GetPotKeeper()
+	registerKeeper := stApp.GetRegisterKeeper()
+
+	require.NoError(t, setAutoRestake(t, stApp, ctx, resOwner(0), resNodeP2PAddr(0).String()))
+	require.NoError(t, setAutoRestake(t, stApp, ctx, metaOwner(0), metaNodeP2PAddr(0).String()))
+	resourceRestaked := maturedBondAmount(t, k, ctx, resOwner(0), maturingEpoch)
+	metaRestaked := maturedBondAmount(t, k, ctx, metaOwner(0), maturingEpoch)
+
+	ctx = distributeAndMature(t, k, ctx, maturingEpoch)
+
+	// the matured reward is withdrawn into the deposit of the resource node
+	resourceNode, found := registerKeeper.GetResourceNode(ctx, resNodeP2PAddr(0))
+	require.True(t, found)
+	require.True(t, nodeInitialDeposit.Add(resourceRestaked).Equal(resourceNode.Tokens))
+	require.True(t, k.GetMatureTotalReward(ctx, resOwner(0)).AmountOf(k.BondDenom(ctx)).IsZero())
+
+	// and into the deposit of the meta node
+	metaNode, found := registerKeeper.GetMetaNode(ctx, metaNodeP2PAddr(0))
+	require.True(t, found)
+	require.True(t, nodeInitialDeposit.Add(metaRestaked).Equal(metaNode.Tokens))
+	require.True(t, k.GetMatureTotalReward(ctx, metaOwner(0)).AmountOf(k.BondDenom(ctx)).IsZero())
+
+	// the reward of the wallets not opted in stays withdrawable
+	require.True(t, k.GetMatureTotalReward(ctx, resOwner(1)).AmountOf(k.BondDenom(ctx)).IsPositive())
+}
+
+func TestAutoRestakeFailureKeepsMaturedReward(t *testing.T) {
+	stApp, ctx, maturingEpoch := setupMaturingEpoch(t)
+	k := stApp.GetPotKeeper()
+	registerKeeper := stApp.GetRegisterKeeper()
+
+	require.NoError(t, setAutoRestake(t, stApp, ctx, resOwner(0), resNodeP2PAddr(0).String()))
+	matured := maturedBondAmount(t, k, ctx, resOwner(0), maturingEpoch)
+
+	// the node changes owner after the opt-in, so the deposit update fails after the reward is withdrawn
+	resourceNode, _ := registerKeeper.GetResourceNode(ctx, resNodeP2PAddr(0))
+	resourceNode.OwnerAddress = resOwner(1).String()
+	registerKeeper.SetResourceNode(ctx, resourceNode)
+	ownerBalanceBefore := stApp.GetBankKeeper().GetBalance(ctx, resOwner(0), k.BondDenom(ctx))
+
+	ctx = distributeAndMature(t, k, ctx, maturingEpoch)
+
+	// the withdrawal is rolled back with the failed deposit update, the reward stays withdrawable
+	require.True(t, matured.Equal(k.GetMatureTotalReward(ctx, resOwner(0)).AmountOf(k.BondDenom(ctx))))
+	require.Equal(t, ownerBalanceBefore, stApp.GetBankKeeper().GetBalance(ctx, resOwner(0), k.BondDenom(ctx)))
+	resourceNode, _ = registerKeeper.GetResourceNode(ctx, resNodeP2PAddr(0))
+	require.True(t, nodeInitialDeposit.Equal(resourceNode.Tokens))
+}
+
+func TestSetAutoRestake(t *testing.T) {
+	stApp, ctx := setupApp(t)
+	k := stApp.GetPotKeeper()
+
+	// the node must be owned by the wallet
+	err := setAutoRestake(t, stApp, ctx, resOwner(0), resNodeP2PAddr(1).String())
+	require.ErrorIs(t, err, types.ErrAutoRestakeNode)
+	err = setAutoRestake(t, stApp, ctx, resOwner(0), metaNodeP2PAddr(0).String())
+	require.ErrorIs(t, err, types.ErrAutoRestakeNode)
+
+	// the node must not be unbonding
+	registerKeeper := stApp.GetRegisterKeeper()
+	resourceNode, _ := registerKeeper.GetResourceNode(ctx, resNodeP2PAddr(1))
+	resourceNode.Status = stakingtypes.Unbonding
+	registerKeeper.SetResourceNode(ctx, resourceNode)
+	err = setAutoRestake(t, stApp, ctx, resOwner(1), resNodeP2PAddr(1).String())
+	require.ErrorIs(t, err, types.ErrAutoRestakeNode)
+
+	require.NoError(t, setAutoRestake(t, stApp, ctx, resOwner(0), resNodeP2PAddr(0).String()))
+	autoRestake, found := k.GetAutoRestake(ctx, resOwner(0))
+	require.True(t, found)
+	require.Equal(t, resNodeP2PAddr(0).String(), autoRestake.GetNetworkAddress())
+
+	// an empty network address opts the wallet out
+	require.NoError(t, setAutoRestake(t, stApp, ctx, resOwner(0), ""))
+	_, found = k.GetAutoRestake(ctx, resOwner(0))
+	require.False(t, found)
+}
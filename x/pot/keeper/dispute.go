@@ -0,0 +1,204 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+
+	"cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/stratosnet/stratos-chain/crypto"
+	"github.com/stratosnet/stratos-chain/x/pot/types"
+)
+
+const (
+	DisputeResolutionAccept = "accept"
+	DisputeResolutionCancel = "cancel"
+	DisputeResolutionAmend  = "amend"
+)
+
+func (k Keeper) GetVolumeReportDispute(ctx sdk.Context, epoch sdkmath.Int) (dispute types.VolumeReportDispute, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetVolumeReportDisputeKey(epoch))
+	if bz == nil {
+		return dispute, false
+	}
+	k.cdc.MustUnmarshalLengthPrefixed(bz, &dispute)
+	return dispute, true
+}
+
+func (k Keeper) SetVolumeReportDispute(ctx sdk.Context, dispute types.VolumeReportDispute) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalLengthPrefixed(&dispute)
+	store.Set(types.GetVolumeReportDisputeKey(dispute.Epoch), bz)
+}
+
+func (k Keeper) RemoveVolumeReportDispute(ctx sdk.Context, epoch sdkmath.Int) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetVolumeReportDisputeKey(epoch))
+}
+
+func (k Keeper) IterateVolumeReportDisputes(ctx sdk.Context, handler func(dispute types.VolumeReportDispute) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.VolumeReportDisputeKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var dispute types.VolumeReportDispute
+		k.cdc.MustUnmarshalLengthPrefixed(iter.Value(), &dispute)
+		if handler(dispute) {
+			break
+		}
+	}
+}
+
+func (k Keeper) GetPendingDistribution(ctx sdk.Context, epoch sdkmath.Int) (distribution types.PendingDistribution, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetPendingDistributionKey(epoch))
+	if bz == nil {
+		return distribution, false
+	}
+	k.cdc.MustUnmarshalLengthPrefixed(bz, &distribution)
+	return distribution, true
+}
+
+// verifyDisputeSignature verifies that the counter-report is signed by a threshold of the bonded meta nodes, so that
+// a single meta node cannot hold the distribution of the following epochs
+func (k Keeper) verifyDisputeSignature(ctx sdk.Context, signBytes []byte, blsSignature types.BLSSignatureInfo) error {
+	txDataHash := crypto.Keccak256(signBytes)
+	if !bytes.Equal(txDataHash, blsSignature.GetTxData()) {
+		return types.ErrBLSTxDataInvalid
+	}
+	return k.registerKeeper.VerifyMetaNodeThresholdSignature(ctx, blsSignature.GetTxData(), blsSignature.GetSignature(),
+		blsSignature.GetPubKeys())
+}
+
+// DisputeVolumeReport holds the distribution of a volume report still in its dispute window and escalates the dispute
+// to a governance proposal amending the report with the counter-report of the dispute
+func (k Keeper) DisputeVolumeReport(ctx sdk.Context, dispute types.VolumeReportDispute) (proposalID uint64, err error) {
+	distribution, found := k.GetPendingDistribution(ctx, dispute.Epoch)
+	if !found {
+		return 0, errors.Wrapf(types.ErrNoPendingDistribution, "epoch %s", dispute.Epoch.String())
+	}
+	if distribution.GetDisputed() {
+		return 0, types.ErrVolumeReportDisputed
+	}
+	if distribution.GetStarted() || ctx.BlockHeight() >= distribution.GetDisputeDeadlineHeight() {
+		return 0, types.ErrDisputeWindowClosed
+	}
+	if !k.isVolumeReportChanged(ctx, distribution, dispute.GetWalletVolumes()) {
+		return 0, types.ErrDisputeUnchangedReport
+	}
+
+	disputer, err := sdk.AccAddressFromBech32(dispute.GetDisputer())
+	if err != nil {
+		return 0, errors.Wrap(types.ErrInvalidAddress, err.Error())
+	}
+	amendment := types.NewMsgResolveVolumeReportDispute(k.authority, dispute.Epoch, false, dispute.GetWalletVolumes())
+	proposal, err := k.govKeeper.SubmitProposal(ctx, []sdk.Msg{amendment}, "",
+		fmt.Sprintf("Amend the volume report of epoch %s", dispute.Epoch.String()),
+		fmt.Sprintf("The volume report of epoch %s is disputed by %s with a counter-report of %d wallet volumes",
+			dispute.Epoch.String(), dispute.GetDisputer(), len(dispute.GetWalletVolumes())),
+		disputer)
+	if err != nil {
+		return 0, err
+	}
+	// the dispute is voted on at once, the distribution of the following epochs waits for it
+	k.govKeeper.ActivateVotingPeriod(ctx, proposal)
+
+	distribution.Disputed = true
+	k.SetPendingDistribution(ctx, distribution)
+
+	dispute.Height = ctx.BlockHeight()
+	dispute.ProposalId = proposal.Id
+	k.SetVolumeReportDispute(ctx, dispute)
+	return proposal.Id, nil
+}
+
+// isVolumeReportChanged returns true if walletVolumes differ from the wallet volumes queued for the distribution
+func (k Keeper) isVolumeReportChanged(ctx sdk.Context, distribution types.PendingDistribution, walletVolumes []types.SingleWalletVolume) bool {
+	if uint64(len(walletVolumes)) != distribution.GetTotalCount() {
+		return true
+	}
+	volumes, _ := k.GetDistributionVolumes(ctx, distribution.Epoch, distribution.GetTotalCount())
+	for i, volume := range volumes {
+		if volume.WalletAddress != walletVolumes[i].WalletAddress || !volume.Volume.Equal(walletVolumes[i].Volume) {
+			return true
+		}
+	}
+	return false
+}
+
+// GetVolumeReportDisputeByProposal returns the dispute escalated to the governance proposal
+func (k Keeper) GetVolumeReportDisputeByProposal(ctx sdk.Context, proposalID uint64) (dispute types.VolumeReportDispute, found bool) {
+	k.IterateVolumeReportDisputes(ctx, func(d types.VolumeReportDispute) (stop bool) {
+		if d.GetProposalId() == proposalID {
+			dispute, found = d, true
+			return true
+		}
+		return false
+	})
+	return dispute, found
+}
+
+// ResolveVolumeReportDispute applies the governance resolution of a disputed volume report. A cancelled report is
+// dropped, an amended one is distributed with the wallet volumes approved by governance.
+func (k Keeper) ResolveVolumeReportDispute(ctx sdk.Context, epoch sdkmath.Int, cancel bool, walletVolumes []types.SingleWalletVolume,
+) (resolution string, err error) {
+
+	distribution, found := k.GetPendingDistribution(ctx, epoch)
+	if !found {
+		return "", errors.Wrapf(types.ErrNoPendingDistribution, "epoch %s", epoch.String())
+	}
+	if !distribution.GetDisputed() {
+		return "", types.ErrVolumeReportNotDisputed
+	}
+	k.RemoveVolumeReportDispute(ctx, epoch)
+
+	switch {
+	case cancel:
+		k.removeDistributionVolumes(ctx, epoch)
+		k.RemovePendingDistribution(ctx, epoch)
+		k.RemoveVolumeReport(ctx, epoch)
+		return DisputeResolutionCancel, nil
+
+	case len(walletVolumes) > 0:
+		totalConsumedNoz := k.GetTotalConsumedNoz(walletVolumes)
+		pendingConsumedNoz := k.getPendingConsumedNoz(ctx).Sub(distribution.TotalConsumedNoz)
+		err = k.checkConsumedNoz(ctx, totalConsumedNoz, pendingConsumedNoz)
+		if err != nil {
+			return "", err
+		}
+
+		k.removeDistributionVolumes(ctx, epoch)
+		for i, walletVolume := range walletVolumes {
+			k.SetDistributionVolume(ctx, epoch, uint64(i), walletVolume)
+		}
+		distribution.TotalCount = uint64(len(walletVolumes))
+		distribution.TotalConsumedNoz = totalConsumedNoz
+		resolution = DisputeResolutionAmend
+
+	default:
+		resolution = DisputeResolutionAccept
+	}
+
+	// the resolved report is distributed without a new dispute window
+	distribution.Disputed = false
+	distribution.DisputeDeadlineHeight = ctx.BlockHeight()
+	k.SetPendingDistribution(ctx, distribution)
+	return resolution, nil
+}
+
+func (k Keeper) removeDistributionVolumes(ctx sdk.Context, epoch sdkmath.Int) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetDistributionVolumeIteratorKey(epoch))
+	keys := make([][]byte, 0)
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
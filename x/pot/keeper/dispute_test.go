@@ -0,0 +1,191 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+
+	stratosapp "github.com/stratosnet/stratos-chain/app"
+	"github.com/stratosnet/stratos-chain/crypto"
+	"github.com/stratosnet/stratos-chain/crypto/bls"
+	"github.com/stratosnet/stratos-chain/x/pot/keeper"
+	"github.com/stratosnet/stratos-chain/x/pot/types"
+)
+
+const testDisputeWindow = int64(10)
+
+// setupDisputedEpoch queues the wallet volumes of an epoch with a dispute window of testDisputeWindow blocks
+func setupDisputedEpoch(t *testing.T) (*stratosapp.StratosApp, sdk.Context, sdkmath.Int) {
+	stApp, ctx := setupApp(t)
+	k := stApp.GetPotKeeper()
+
+	params := k.GetParams(ctx)
+	params.DisputeWindow = testDisputeWindow
+	require.NoError(t, k.SetParams(ctx, params))
+
+	epoch := sdkmath.NewInt(1)
+	require.NoError(t, k.QueueRewardDistribution(ctx, walletVolumes(), epoch))
+	return stApp, ctx, epoch
+}
+
+// counterReport returns the wallet volumes of the epoch with the volume of the first wallet halved
+func counterReport() []types.SingleWalletVolume {
+	volumes := walletVolumes()
+	volumes[0].Volume = volumes[0].Volume.QuoRaw(2)
+	return volumes
+}
+
+// newMsgDisputeVolumeReport creates the dispute of a meta node with a counter-report signed by the meta nodes of signers
+func newMsgDisputeVolumeReport(t *testing.T, epoch sdkmath.Int, volumes []types.SingleWalletVolume, signers ...int,
+) *types.MsgDisputeVolumeReport {
+
+	msg := types.NewMsgDisputeVolumeReport(metaOwner(0), metaNodeP2PAddr(0), epoch, volumes, "wrong volumes")
+	txData := crypto.Keccak256(msg.GetBLSSignBytes())
+
+	var signatures, pubKeys [][]byte
+	for _, i := range signers {
+		signature, err := bls.Sign(txData, metaNodeBLSPrivKeys[i])
+		require.NoError(t, err)
+		signatures = append(signatures, signature)
+		pubKeys = append(pubKeys, metaNodeBLSPubKeys[i])
+	}
+	signature, err := bls.AggregateSignatures(signatures...)
+	require.NoError(t, err)
+
+	msg.BLSSignature = types.BLSSignatureInfo{PubKeys: pubKeys, Signature: signature, TxData: txData}
+	require.NoError(t, msg.ValidateBasic())
+	return msg
+}
+
+func TestDisputeWindow(t *testing.T) {
+	stApp, ctx, epoch := setupDisputedEpoch(t)
+	k := stApp.GetPotKeeper()
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	// the report is not distributed before the end of its dispute window
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + testDisputeWindow - 1)
+	require.NoError(t, k.DistributePendingRewards(ctx))
+	distribution, found := k.GetPendingDistribution(ctx, epoch)
+	require.True(t, found)
+	require.False(t, distribution.GetStarted())
+
+	// the report cannot be disputed once its dispute window is closed
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	_, err := msgServer.HandleMsgDisputeVolumeReport(sdk.WrapSDKContext(ctx),
+		newMsgDisputeVolumeReport(t, epoch, counterReport(), 0, 1))
+	require.ErrorIs(t, err, types.ErrDisputeWindowClosed)
+
+	require.NoError(t, k.DistributePendingRewards(ctx))
+	require.True(t, k.GetLastDistributedEpoch(ctx).Equal(epoch))
+}
+
+func TestDisputeVolumeReportEvidence(t *testing.T) {
+	stApp, ctx, epoch := setupDisputedEpoch(t)
+	msgServer := keeper.NewMsgServerImpl(stApp.GetPotKeeper())
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	// every dispute carries a counter-report, including the disputes filed by governance
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+	msg := types.NewMsgDisputeVolumeReport(authority, nil, epoch, nil, "wrong volumes")
+	require.ErrorIs(t, msg.ValidateBasic(), types.ErrEmptyWalletVolumes)
+
+	// the counter-report of a meta node must be signed by a threshold of the meta nodes with registered BLS keys
+	_, err := msgServer.HandleMsgDisputeVolumeReport(goCtx, newMsgDisputeVolumeReport(t, epoch, counterReport(), 0))
+	require.Error(t, err)
+
+	// the counter-report must change the report
+	_, err = msgServer.HandleMsgDisputeVolumeReport(goCtx, newMsgDisputeVolumeReport(t, epoch, walletVolumes(), 0, 1))
+	require.ErrorIs(t, err, types.ErrDisputeUnchangedReport)
+}
+
+func TestDisputeVolumeReportWithholdsRewards(t *testing.T) {
+	stApp, ctx, epoch := setupDisputedEpoch(t)
+	k := stApp.GetPotKeeper()
+	msgServer := keeper.NewMsgServerImpl(k)
+	rewardPool := stApp.GetAccountKeeper().GetModuleAddress(types.TotalRewardPool)
+	poolBefore := stApp.GetBankKeeper().GetAllBalances(ctx, rewardPool)
+
+	_, err := msgServer.HandleMsgDisputeVolumeReport(sdk.WrapSDKContext(ctx),
+		newMsgDisputeVolumeReport(t, epoch, counterReport(), 0, 1))
+	require.NoError(t, err)
+
+	// the disputed report is not distributed after its dispute window until the dispute is resolved
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + testDisputeWindow)
+	require.NoError(t, k.DistributePendingRewards(ctx))
+	distribution, found := k.GetPendingDistribution(ctx, epoch)
+	require.True(t, found)
+	require.True(t, distribution.GetDisputed())
+	require.False(t, distribution.GetStarted())
+	require.True(t, k.GetLastDistributedEpoch(ctx).IsZero())
+	require.Equal(t, poolBefore, stApp.GetBankKeeper().GetAllBalances(ctx, rewardPool))
+	_, found = k.GetIndividualReward(ctx, resOwner(0), epoch.AddRaw(k.MatureEpoch(ctx)))
+	require.False(t, found)
+}
+
+func TestDisputeVolumeReportProposalAmendsReport(t *testing.T) {
+	stApp, ctx, epoch := setupDisputedEpoch(t)
+	k := stApp.GetPotKeeper()
+	msgServer := keeper.NewMsgServerImpl(k)
+	volumes := counterReport()
+
+	_, err := msgServer.HandleMsgDisputeVolumeReport(sdk.WrapSDKContext(ctx), newMsgDisputeVolumeReport(t, epoch, volumes, 0, 1))
+	require.NoError(t, err)
+
+	// the dispute is escalated to a proposal in its voting period amending the report with the counter-report
+	dispute, found := k.GetVolumeReportDispute(ctx, epoch)
+	require.True(t, found)
+	proposal, found := stApp.GetGovKeeper().GetProposal(ctx, dispute.GetProposalId())
+	require.True(t, found)
+	require.Equal(t, govv1.StatusVotingPeriod, proposal.Status)
+	msgs, err := proposal.GetMsgs()
+	require.NoError(t, err)
+	require.Len(t, msgs, 1)
+	amendment, ok := msgs[0].(*types.MsgResolveVolumeReportDispute)
+	require.True(t, ok)
+	require.False(t, amendment.GetCancel())
+	require.Equal(t, volumes, amendment.GetWalletVolumes())
+
+	// the passed proposal distributes the counter-report
+	_, err = msgServer.ResolveVolumeReportDispute(sdk.WrapSDKContext(ctx), amendment)
+	require.NoError(t, err)
+	_, found = k.GetVolumeReportDispute(ctx, epoch)
+	require.False(t, found)
+	distributionVolumes, _ := k.GetDistributionVolumes(ctx, epoch, uint64(len(volumes)))
+	require.Equal(t, volumes, distributionVolumes)
+
+	require.NoError(t, k.DistributePendingRewards(ctx))
+	require.True(t, k.GetLastDistributedEpoch(ctx).Equal(epoch))
+}
+
+func TestDisputeVolumeReportRejectedProposal(t *testing.T) {
+	stApp, ctx, epoch := setupDisputedEpoch(t)
+	k := stApp.GetPotKeeper()
+	govKeeper := stApp.GetGovKeeper()
+
+	_, err := keeper.NewMsgServerImpl(k).HandleMsgDisputeVolumeReport(sdk.WrapSDKContext(ctx),
+		newMsgDisputeVolumeReport(t, epoch, counterReport(), 0, 1))
+	require.NoError(t, err)
+	dispute, _ := k.GetVolumeReportDispute(ctx, epoch)
+	proposal, _ := govKeeper.GetProposal(ctx, dispute.GetProposalId())
+
+	// a proposal ending without quorum is rejected, and the report is distributed unchanged
+	ctx = ctx.WithBlockTime(*proposal.VotingEndTime).WithBlockHeight(ctx.BlockHeight() + testDisputeWindow)
+	gov.EndBlocker(ctx, govKeeper)
+	proposal, _ = govKeeper.GetProposal(ctx, dispute.GetProposalId())
+	require.Equal(t, govv1.StatusRejected, proposal.Status)
+
+	_, found := k.GetVolumeReportDispute(ctx, epoch)
+	require.False(t, found)
+	distributionVolumes, _ := k.GetDistributionVolumes(ctx, epoch, resourceNodeCount)
+	require.Equal(t, walletVolumes(), distributionVolumes)
+
+	require.NoError(t, k.DistributePendingRewards(ctx))
+	require.True(t, k.GetLastDistributedEpoch(ctx).Equal(epoch))
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	"errors"
 	"sort"
+	"strconv"
 	"strings"
 
 	sdkmath "cosmossdk.io/math"
@@ -24,56 +25,214 @@ var (
 	rewardDetailMap               map[string]types.Reward
 )
 
-func (k Keeper) DistributePotReward(ctx sdk.Context, trafficList []types.SingleWalletVolume, epoch sdkmath.Int) (err error) {
+// QueueRewardDistribution queues the rewards of an accepted volume report, they are distributed
+// in batches of at most DistributeBatchSize wallet volumes by the end blockers
+func (k Keeper) QueueRewardDistribution(ctx sdk.Context, trafficList []types.SingleWalletVolume, epoch sdkmath.Int) (err error) {
+	totalConsumedNoz := k.GetTotalConsumedNoz(trafficList)
+	err = k.checkConsumedNoz(ctx, totalConsumedNoz, k.getPendingConsumedNoz(ctx))
+	if err != nil {
+		return err
+	}
 
-	k.InitVariable(ctx)
+	for i, walletVolume := range trafficList {
+		k.SetDistributionVolume(ctx, epoch, uint64(i), walletVolume)
+	}
+	k.SetPendingDistribution(ctx, types.PendingDistribution{
+		Epoch:                       epoch,
+		TotalCount:                  uint64(len(trafficList)),
+		ProcessedCount:              0,
+		TotalConsumedNoz:            totalConsumedNoz,
+		Started:                     false,
+		MiningRewardToResourceNode:  sdk.NewCoin(k.RewardDenom(ctx), sdkmath.ZeroInt()),
+		TrafficRewardToResourceNode: sdk.NewCoin(k.BondDenom(ctx), sdkmath.ZeroInt()),
+		MiningRewardToMetaNode:      sdk.NewCoin(k.RewardDenom(ctx), sdkmath.ZeroInt()),
+		TrafficRewardToMetaNode:     sdk.NewCoin(k.BondDenom(ctx), sdkmath.ZeroInt()),
+		MinedReward:                 sdk.NewCoin(k.RewardDenom(ctx), sdkmath.ZeroInt()),
+		TrafficReward:               sdk.NewCoin(k.BondDenom(ctx), sdkmath.ZeroInt()),
+		DisputeDeadlineHeight:       ctx.BlockHeight() + k.DisputeWindow(ctx),
+		Disputed:                    false,
+		CommunityTaxRemainder:       sdkmath.LegacyZeroDec(),
+	})
+	return nil
+}
 
-	//1, calc traffic reward in total
-	totalConsumedNoz := k.GetTotalConsumedNoz(trafficList).ToLegacyDec()
+// getPendingConsumedNoz returns the noz consumed by the queued volume reports not distributed yet
+func (k Keeper) getPendingConsumedNoz(ctx sdk.Context) (pendingConsumedNoz sdkmath.Int) {
+	pendingConsumedNoz = sdkmath.ZeroInt()
+	k.IteratePendingDistributions(ctx, func(distribution types.PendingDistribution) (stop bool) {
+		if !distribution.GetStarted() {
+			pendingConsumedNoz = pendingConsumedNoz.Add(distribution.TotalConsumedNoz)
+		}
+		return false
+	})
+	return pendingConsumedNoz
+}
+
+func (k Keeper) checkConsumedNoz(ctx sdk.Context, totalConsumedNoz, pendingConsumedNoz sdkmath.Int) error {
 	remaining, total := k.NozSupply(ctx)
-	if totalConsumedNoz.Add(remaining.ToLegacyDec()).GT(total.ToLegacyDec()) {
+	if totalConsumedNoz.Add(remaining).Add(pendingConsumedNoz).GT(total) {
 		return errors.New("remaining+consumed Noz exceeds total Noz supply")
 	}
+	return nil
+}
 
-	distributeGoal, err = k.CalcTrafficRewardInTotal(ctx, distributeGoal, totalConsumedNoz)
-	if err != nil {
-		return err
+// DistributePendingRewards distributes the queued volume reports in epoch order, rewarding at most
+// DistributeBatchSize wallet volumes per block. The meta nodes are rewarded with the last batch of an epoch,
+// which then becomes the last distributed epoch.
+func (k Keeper) DistributePendingRewards(ctx sdk.Context) error {
+	budget := uint64(k.DistributeBatchSize(ctx))
+	for {
+		distribution, found := k.GetNextPendingDistribution(ctx)
+		if !found {
+			return nil
+		}
+		// the distribution waits for the end of its dispute window, or for the governance resolution once disputed
+		if distribution.GetDisputed() || (!distribution.GetStarted() && ctx.BlockHeight() < distribution.GetDisputeDeadlineHeight()) {
+			return nil
+		}
+
+		if budget == 0 {
+			return nil
+		}
+		batchSize := distribution.GetTotalCount() - distribution.GetProcessedCount()
+		if batchSize > budget {
+			batchSize = budget
+		}
+
+		// each batch is committed as a whole, a failed batch is retried in the next block
+		cacheCtx, write := ctx.CacheContext()
+		completed, err := k.distributeBatch(cacheCtx, distribution, batchSize)
+		if err != nil {
+			return err
+		}
+		write()
+		budget -= batchSize
+
+		if !completed {
+			return nil
+		}
+		err = ctx.EventManager().EmitTypedEvent(&types.EventRewardDistributed{
+			Epoch:       distribution.Epoch.String(),
+			WalletCount: strconv.FormatUint(distribution.GetTotalCount(), 10),
+		})
+		if err != nil {
+			return err
+		}
 	}
-	unissuedPrepayToFeeCollector = distributeGoal.StakeTrafficRewardToValidator
+}
 
-	//2, calc mining reward in total
-	distributeGoal, err = k.CalcMiningRewardInTotal(ctx, distributeGoal)
-	if err != nil && err != types.ErrOutOfIssuance {
-		return err
+func (k Keeper) distributeBatch(ctx sdk.Context, distribution types.PendingDistribution, batchSize uint64) (completed bool, err error) {
+	if !distribution.GetStarted() {
+		distribution, err = k.startDistribution(ctx, distribution)
+		if err != nil {
+			return false, err
+		}
 	}
-	foundationToFeeCollector = distributeGoal.StakeMiningRewardToValidator
 
-	//3, calc reward for resource node, store to rewardDetailMap by wallet address(owner address)
-	rewardDetailMap = k.CalcRewardForResourceNode(ctx, totalConsumedNoz, trafficList, distributeGoal, rewardDetailMap)
+	k.InitVariable(ctx)
+	distributeGoal = types.InitDistributeGoal().
+		AddMiningRewardToResourceNode(distribution.MiningRewardToResourceNode).
+		AddTrafficRewardToResourceNode(distribution.TrafficRewardToResourceNode).
+		AddMiningRewardToMetaNode(distribution.MiningRewardToMetaNode).
+		AddTrafficRewardToMetaNode(distribution.TrafficRewardToMetaNode)
+	// the fraction of the tax truncated by the previous batches is transferred once it adds up to a whole token
+	unissuedPrepayToCommunityPool = distribution.CommunityTaxRemainder
+
+	//1, calc reward for the batch of resource nodes, store to rewardDetailMap by wallet address(owner address)
+	trafficList, keys := k.GetDistributionVolumes(ctx, distribution.Epoch, batchSize)
+	if len(trafficList) > 0 {
+		rewardDetailMap = k.CalcRewardForResourceNode(ctx, distribution.TotalConsumedNoz.ToLegacyDec(), trafficList, distributeGoal, rewardDetailMap)
+	}
+	distribution.ProcessedCount += uint64(len(trafficList))
+	completed = distribution.GetProcessedCount() >= distribution.GetTotalCount()
 
-	//4, calc reward from meta node, store to rewardDetailMap by wallet address(owner address)
-	rewardDetailMap = k.CalcRewardForMetaNode(ctx, distributeGoal, rewardDetailMap)
+	//2, calc reward from meta node with the last batch, store to rewardDetailMap by wallet address(owner address)
+	if completed {
+		rewardDetailMap = k.CalcRewardForMetaNode(ctx, distributeGoal, rewardDetailMap)
+	}
 
-	//5, IMPORTANT: sort map and convert to slice to keep the order
+	//3, IMPORTANT: sort map and convert to slice to keep the order
 	rewardDetailList := sortDetailMapToSlice(rewardDetailMap)
 
-	//6, record all rewards to resource & meta nodes
-	err = k.saveRewardInfo(ctx, rewardDetailList, epoch)
+	//4, record the rewards of the batch
+	k.saveRewardInfo(ctx, rewardDetailList, distribution.Epoch)
+	distribution = k.addDistributedTotal(ctx, distribution)
+	distribution.CommunityTaxRemainder = unissuedPrepayToCommunityPool.Sub(unissuedPrepayToCommunityPool.TruncateDec())
+	for _, key := range keys {
+		k.RemoveDistributionVolume(ctx, key)
+	}
+
+	//5, [TLC] transfer balance of miningReward&trafficReward pools to totalReward&totalSlashed pool, utilized for future Withdraw Tx
+	err = k.transferTokensForDistribution(ctx)
 	if err != nil {
-		return err
+		return false, err
+	}
+
+	if !completed {
+		k.SetPendingDistribution(ctx, distribution)
+		return false, nil
+	}
+
+	//6, the epoch is completed
+	k.SetTotalMinedTokens(ctx, k.GetTotalMinedTokens(ctx).Add(distribution.MinedReward))
+	k.SetLastDistributedEpoch(ctx, distribution.Epoch)
+	k.SetTotalReward(ctx, distribution.Epoch, types.TotalReward{
+		MiningReward:  sdk.NewCoins(distribution.MinedReward),
+		TrafficReward: sdk.NewCoins(distribution.TrafficReward),
+	})
+	k.RemovePendingDistribution(ctx, distribution.Epoch)
+	return true, nil
+}
+
+// startDistribution calculates the distribute goal of the epoch and pays the validators' part of it
+func (k Keeper) startDistribution(ctx sdk.Context, distribution types.PendingDistribution) (types.PendingDistribution, error) {
+	k.InitVariable(ctx)
+	totalConsumedNoz := distribution.TotalConsumedNoz.ToLegacyDec()
+
+	//1, calc traffic reward in total
+	goal, err := k.CalcTrafficRewardInTotal(ctx, types.InitDistributeGoal(), totalConsumedNoz)
+	if err != nil {
+		return distribution, err
+	}
+	unissuedPrepayToFeeCollector = goal.StakeTrafficRewardToValidator
+	distribution.TrafficRewardToResourceNode = goal.TrafficRewardToResourceNode
+	distribution.TrafficRewardToMetaNode = goal.TrafficRewardToMetaNode
+
+	//2, calc mining reward in total, nothing is mined once the issuance limit is reached
+	goal, err = k.CalcMiningRewardInTotal(ctx, goal)
+	if err != nil && err != types.ErrOutOfIssuance {
+		return distribution, err
+	}
+	if err == nil {
+		foundationToFeeCollector = goal.StakeMiningRewardToValidator
+		distribution.MiningRewardToResourceNode = goal.MiningRewardToResourceNode
+		distribution.MiningRewardToMetaNode = goal.MiningRewardToMetaNode
 	}
+	distribution.Started = true
 
-	//7, update remaining ozone limit
+	//3, update remaining ozone limit
 	remainingNozLimit := k.registerKeeper.GetRemainingOzoneLimit(ctx)
-	k.registerKeeper.SetRemainingOzoneLimit(ctx, remainingNozLimit.Add(totalConsumedNoz.TruncateInt()))
+	k.registerKeeper.SetRemainingOzoneLimit(ctx, remainingNozLimit.Add(distribution.TotalConsumedNoz))
 
-	//8, [TLC] transfer balance of miningReward&trafficReward pools to totalReward&totalSlashed pool, utilized for future Withdraw Tx
+	//4, [TLC] transfer the stake reward to the fee collector
+	distribution = k.addDistributedTotal(ctx, distribution)
 	err = k.transferTokensForDistribution(ctx)
 	if err != nil {
-		return err
+		return distribution, err
 	}
+	return distribution, nil
+}
 
-	return nil
+// addDistributedTotal adds the amounts prepared for transfer to the totals distributed for the epoch
+func (k Keeper) addDistributedTotal(ctx sdk.Context, distribution types.PendingDistribution) types.PendingDistribution {
+	distribution.MinedReward = distribution.MinedReward.
+		Add(foundationToFeeCollector).
+		Add(foundationToReward)
+	distribution.TrafficReward = distribution.TrafficReward.
+		Add(unissuedPrepayToFeeCollector).
+		Add(unissuedPrepayToReward).
+		Add(sdk.NewCoin(k.BondDenom(ctx), unissuedPrepayToCommunityPool.TruncateInt()))
+	return distribution
 }
 
 func (k Keeper) CalcTrafficRewardInTotal(
@@ -131,7 +290,7 @@ func (k Keeper) CalcMiningRewardInTotal(ctx sdk.Context, distributeGoal types.Di
 }
 
 // Iteration for each rewarded SDS node
-func (k Keeper) saveRewardInfo(ctx sdk.Context, rewardDetailList []types.Reward, currentEpoch sdkmath.Int) (err error) {
+func (k Keeper) saveRewardInfo(ctx sdk.Context, rewardDetailList []types.Reward, currentEpoch sdkmath.Int) {
 	matureEpoch := k.getMatureEpochByCurrentEpoch(ctx, currentEpoch)
 
 	for _, reward := range rewardDetailList {
@@ -141,23 +300,6 @@ func (k Keeper) saveRewardInfo(ctx sdk.Context, rewardDetailList []types.Reward,
 		}
 		k.addNewIndividualAndUpdateImmatureTotal(ctx, walletAddr, matureEpoch, reward)
 	}
-
-	newMinedTotal := foundationToFeeCollector.Add(foundationToReward)
-	oldTotalMinedToken := k.GetTotalMinedTokens(ctx)
-	newTotalMinedToken := oldTotalMinedToken.Add(newMinedTotal)
-	k.SetTotalMinedTokens(ctx, newTotalMinedToken)
-	k.SetLastDistributedEpoch(ctx, currentEpoch)
-
-	newTrafficRewardTotal := unissuedPrepayToFeeCollector.
-		Add(unissuedPrepayToReward).
-		Add(sdk.NewCoin(k.BondDenom(ctx), unissuedPrepayToCommunityPool.TruncateInt()))
-	totalReward := types.TotalReward{
-		MiningReward:  sdk.NewCoins(newMinedTotal),
-		TrafficReward: sdk.NewCoins(newTrafficRewardTotal),
-	}
-	k.SetTotalReward(ctx, currentEpoch, totalReward)
-
-	return nil
 }
 
 func (k Keeper) addNewIndividualAndUpdateImmatureTotal(ctx sdk.Context, account sdk.AccAddress, matureEpoch sdkmath.Int, newReward types.Reward) {
@@ -165,6 +307,12 @@ func (k Keeper) addNewIndividualAndUpdateImmatureTotal(ctx sdk.Context, account
 	oldImmatureTotal := k.GetImmatureTotalReward(ctx, account)
 	newImmatureTotal := oldImmatureTotal.Add(newIndividualTotal...)
 
+	// the wallet may already be rewarded at matureEpoch by a previous batch
+	if oldReward, found := k.GetIndividualReward(ctx, account, matureEpoch); found {
+		newReward.RewardFromMiningPool = oldReward.RewardFromMiningPool.Add(newReward.RewardFromMiningPool...)
+		newReward.RewardFromTrafficPool = oldReward.RewardFromTrafficPool.Add(newReward.RewardFromTrafficPool...)
+	}
+
 	k.SetIndividualReward(ctx, account, matureEpoch, newReward)
 	k.SetImmatureTotalReward(ctx, account, newImmatureTotal)
 }
@@ -0,0 +1,209 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/stratosnet/stratos-chain/x/pot/keeper"
+	"github.com/stratosnet/stratos-chain/x/pot/types"
+)
+
+type distributionResult struct {
+	blocks      int
+	rewards     map[string]types.Reward
+	totalReward types.TotalReward
+}
+
+// distributeEpoch queues the wallet volumes of an epoch and runs the end blocker distribution until the epoch is completed
+func distributeEpoch(t *testing.T, batchSize int64) distributionResult {
+	stApp, ctx := setupApp(t)
+	k := stApp.GetPotKeeper()
+
+	params := k.GetParams(ctx)
+	params.DistributeBatchSize = batchSize
+	params.DisputeWindow = 0
+	require.NoError(t, k.SetParams(ctx, params))
+
+	epoch := sdkmath.NewInt(1)
+	matureEpoch := epoch.AddRaw(k.MatureEpoch(ctx))
+	require.NoError(t, k.QueueRewardDistribution(ctx, walletVolumes(), epoch))
+
+	result := distributionResult{rewards: make(map[string]types.Reward)}
+	for !k.GetLastDistributedEpoch(ctx).Equal(epoch) {
+		require.Less(t, result.blocks, resourceNodeCount+1, "distribution did not complete")
+
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+		require.NoError(t, k.DistributePendingRewards(ctx))
+		result.blocks++
+
+		if !k.GetLastDistributedEpoch(ctx).Equal(epoch) {
+			// the meta nodes are rewarded with the last batch only
+			for i := 0; i < metaNodeCount; i++ {
+				_, found := k.GetIndividualReward(ctx, metaOwner(i), matureEpoch)
+				require.False(t, found, "meta node rewarded before the last batch")
+			}
+		}
+	}
+
+	_, found := k.GetPendingDistribution(ctx, epoch)
+	require.False(t, found)
+
+	for i := 0; i < resourceNodeCount; i++ {
+		reward, found := k.GetIndividualReward(ctx, resOwner(i), matureEpoch)
+		require.True(t, found)
+		result.rewards[resOwner(i).String()] = reward
+	}
+	for i := 0; i < metaNodeCount; i++ {
+		reward, found := k.GetIndividualReward(ctx, metaOwner(i), matureEpoch)
+		require.True(t, found)
+		result.rewards[metaOwner(i).String()] = reward
+	}
+	result.totalReward = k.GetTotalReward(ctx, epoch)
+	return result
+}
+
+func sumRewards(rewards map[string]types.Reward) (mining, traffic sdk.Coins) {
+	for _, reward := range rewards {
+		mining = mining.Add(reward.RewardFromMiningPool...)
+		traffic = traffic.Add(reward.RewardFromTrafficPool...)
+	}
+	return mining, traffic
+}
+
+func TestDistributePendingRewardsInBatches(t *testing.T) {
+	singleShot := distributeEpoch(t, types.DefaultDistributeBatchSize)
+	require.Equal(t, 1, singleShot.blocks)
+
+	batched := distributeEpoch(t, 2)
+	require.Equal(t, 3, batched.blocks)
+
+	require.Equal(t, len(singleShot.rewards), len(batched.rewards))
+	for wallet, reward := range singleShot.rewards {
+		batchedReward := batched.rewards[wallet]
+		require.Equal(t, reward.String(), batchedReward.String(), "reward of %s", wallet)
+	}
+
+	singleShotMining, singleShotTraffic := sumRewards(singleShot.rewards)
+	batchedMining, batchedTraffic := sumRewards(batched.rewards)
+	require.True(t, singleShotMining.IsEqual(batchedMining))
+	require.True(t, singleShotTraffic.IsEqual(batchedTraffic))
+	require.Equal(t, singleShot.totalReward.String(), batched.totalReward.String())
+}
+
+func TestDistributePendingRewardsMetaNodesRewardedOnce(t *testing.T) {
+	batched := distributeEpoch(t, 1)
+	require.Equal(t, resourceNodeCount, batched.blocks)
+
+	// every meta node gets an equal share of the meta node rewards of the epoch, paid once
+	first := batched.rewards[metaOwner(0).String()]
+	require.False(t, first.RewardFromMiningPool.IsZero())
+	for i := 1; i < metaNodeCount; i++ {
+		reward := batched.rewards[metaOwner(i).String()]
+		require.True(t, first.RewardFromMiningPool.IsEqual(reward.RewardFromMiningPool))
+		require.True(t, first.RewardFromTrafficPool.IsEqual(reward.RewardFromTrafficPool))
+	}
+}
+
+func TestLinearVestingMaturesFromMatureEpoch(t *testing.T) {
+	stApp, ctx := setupApp(t)
+	k := stApp.GetPotKeeper()
+
+	params := k.GetParams(ctx)
+	params.DisputeWindow = 0
+	params.MatureEpoch = 3
+	params.VestingMode = types.VestingModeLinear
+	params.VestingEpochs = 4
+	require.NoError(t, k.SetParams(ctx, params))
+
+	distribute := func(epoch sdkmath.Int, volumes []types.SingleWalletVolume) {
+		require.NoError(t, k.QueueRewardDistribution(ctx, volumes, epoch))
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+		require.NoError(t, k.DistributePendingRewards(ctx))
+		require.True(t, k.GetLastDistributedEpoch(ctx).Equal(epoch))
+		require.NoError(t, k.RewardMatureAndSubSlashing(ctx))
+	}
+
+	// the wallet is rewarded in the first epochs only, each reward matures at its mature epoch
+	wallet := resOwner(0)
+	otherVolumes := walletVolumes()[1:]
+	rewards := make(map[int64]sdk.Coins)
+	total := sdk.Coins{}
+	for epoch := int64(1); epoch <= 3; epoch++ {
+		distribute(sdkmath.NewInt(epoch), walletVolumes())
+		matureEpoch := epoch + params.MatureEpoch
+		reward, found := k.GetIndividualReward(ctx, wallet, sdkmath.NewInt(matureEpoch))
+		require.True(t, found)
+		rewards[matureEpoch] = reward.RewardFromMiningPool.Add(reward.RewardFromTrafficPool...)
+		total = total.Add(rewards[matureEpoch]...)
+	}
+	require.True(t, total.IsEqual(k.GetImmatureTotalReward(ctx, wallet)))
+
+	// the vesting schedule is paginated in epoch order
+	querier := keeper.Querier{Keeper: k}
+	var scheduled []int64
+	pageReq := &query.PageRequest{Limit: 2}
+	for {
+		res, err := querier.VestingSchedule(sdk.WrapSDKContext(ctx), &types.QueryVestingScheduleRequest{
+			WalletAddress: wallet.String(),
+			Pagination:    pageReq,
+		})
+		require.NoError(t, err)
+		require.LessOrEqual(t, len(res.Entries), 2)
+		require.Nil(t, res.LinearVesting)
+		for _, entry := range res.Entries {
+			scheduled = append(scheduled, entry.MatureEpoch)
+		}
+		if len(res.Pagination.NextKey) == 0 {
+			break
+		}
+		pageReq = &query.PageRequest{Key: res.Pagination.NextKey, Limit: 2}
+	}
+	require.Equal(t, []int64{4, 5, 6}, scheduled)
+
+	// a reward reaching its mature epoch starts vesting in a single schedule of the wallet
+	distribute(sdkmath.NewInt(4), otherVolumes)
+	_, found := k.GetIndividualReward(ctx, wallet, sdkmath.NewInt(4))
+	require.False(t, found)
+	schedule, found := k.GetVestingSchedule(ctx, wallet)
+	require.True(t, found)
+	require.Equal(t, int64(4), schedule.GetStartEpoch())
+	require.Equal(t, params.VestingEpochs, schedule.GetVestingEpochs())
+	require.True(t, rewards[4].IsEqual(schedule.TotalFromMiningPool.Add(schedule.TotalFromTrafficPool...)))
+	require.True(t, k.GetMatureTotalReward(ctx, wallet).IsZero())
+
+	res, err := querier.VestingSchedule(sdk.WrapSDKContext(ctx), &types.QueryVestingScheduleRequest{WalletAddress: wallet.String()})
+	require.NoError(t, err)
+	require.Equal(t, &schedule, res.LinearVesting)
+
+	// the part vested at the next epoch matures, the rest vests again over vesting_epochs with the next reward
+	distribute(sdkmath.NewInt(5), otherVolumes)
+	vested := sdk.Coins{}
+	for _, coin := range rewards[4] {
+		vested = vested.Add(sdk.NewCoin(coin.Denom, coin.Amount.QuoRaw(params.VestingEpochs)))
+	}
+	require.True(t, vested.IsEqual(k.GetMatureTotalReward(ctx, wallet)))
+	schedule, found = k.GetVestingSchedule(ctx, wallet)
+	require.True(t, found)
+	require.Equal(t, int64(5), schedule.GetStartEpoch())
+	require.True(t, rewards[4].Sub(vested...).Add(rewards[5]...).IsEqual(schedule.TotalFromMiningPool.Add(schedule.TotalFromTrafficPool...)))
+	history, found := k.GetRewardHistory(ctx, wallet, sdkmath.NewInt(5))
+	require.True(t, found)
+	require.True(t, vested.IsEqual(history.RewardFromMiningPool.Add(history.RewardFromTrafficPool...)))
+
+	// the schedule is released in full vesting_epochs epochs after the last reward started vesting
+	for epoch := int64(6); epoch < 6+params.VestingEpochs; epoch++ {
+		distribute(sdkmath.NewInt(epoch), otherVolumes)
+		_, found = k.GetVestingSchedule(ctx, wallet)
+		require.True(t, found)
+	}
+	distribute(sdkmath.NewInt(6+params.VestingEpochs), otherVolumes)
+	_, found = k.GetVestingSchedule(ctx, wallet)
+	require.False(t, found)
+	require.True(t, total.IsEqual(k.GetMatureTotalReward(ctx, wallet)))
+	require.True(t, k.GetImmatureTotalReward(ctx, wallet).IsZero())
+}
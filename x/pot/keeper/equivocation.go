@@ -0,0 +1,153 @@
+package keeper
+
+import (
+	"bytes"
+
+	"cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/stratosnet/stratos-chain/crypto"
+	"github.com/stratosnet/stratos-chain/crypto/bls"
+	stratos "github.com/stratosnet/stratos-chain/types"
+	"github.com/stratosnet/stratos-chain/x/pot/types"
+)
+
+func (k Keeper) HasReportEquivocation(ctx sdk.Context, epoch sdkmath.Int, accused stratos.SdsAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetReportEquivocationKey(epoch, accused))
+}
+
+func (k Keeper) SetReportEquivocation(ctx sdk.Context, equivocation types.ReportEquivocation) {
+	accused, err := stratos.SdsAddressFromBech32(equivocation.GetAccused())
+	if err != nil {
+		panic(err)
+	}
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalLengthPrefixed(&equivocation)
+	store.Set(types.GetReportEquivocationKey(equivocation.Epoch, accused), bz)
+}
+
+func (k Keeper) IterateReportEquivocations(ctx sdk.Context, handler func(equivocation types.ReportEquivocation) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.ReportEquivocationKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var equivocation types.ReportEquivocation
+		k.cdc.MustUnmarshalLengthPrefixed(iter.Value(), &equivocation)
+		if handler(equivocation) {
+			break
+		}
+	}
+}
+
+// decodeSignedVolumeReport returns the epoch of the BLS sign bytes of a volume report together with the number and the
+// merkle root of its wallet volumes, a chunked report being signed through its MsgVolumeReportHeader
+func decodeSignedVolumeReport(report []byte, chunked bool) (epoch sdkmath.Int, totalCount uint64, volumesRoot []byte, err error) {
+	if chunked {
+		var header types.MsgVolumeReportHeader
+		err = proto.Unmarshal(report, &header)
+		if err != nil {
+			return epoch, 0, nil, errors.Wrap(types.ErrInvalidEquivocation, err.Error())
+		}
+		return header.Epoch, header.GetTotalCount(), header.GetVolumesRoot(), nil
+	}
+
+	var volumeReport types.MsgVolumeReport
+	err = proto.Unmarshal(report, &volumeReport)
+	if err != nil {
+		return epoch, 0, nil, errors.Wrap(types.ErrInvalidEquivocation, err.Error())
+	}
+	walletVolumes := volumeReport.GetWalletVolumes()
+	return volumeReport.Epoch, uint64(len(walletVolumes)), types.GetWalletVolumesRoot(walletVolumes), nil
+}
+
+// verifyReportEquivocation verifies that the accused meta node BLS-signed two volume reports of the epoch with
+// different wallet volumes
+func (k Keeper) verifyReportEquivocation(ctx sdk.Context, msg *types.MsgSubmitReportEquivocation, accused stratos.SdsAddress) error {
+	// only the BLS public key registered by the accused meta node in x/register can incriminate it
+	registeredPubKey, found := k.registerKeeper.GetMetaNodeBLSPubKey(ctx, accused)
+	if !found {
+		return errors.Wrap(types.ErrInvalidEquivocation, "the accused meta node has not registered a BLS public key")
+	}
+	if !bytes.Equal(registeredPubKey, msg.GetAccusedPubKey()) {
+		return errors.Wrap(types.ErrInvalidEquivocation, "accused public key is not the one registered by the accused meta node")
+	}
+
+	// the accused signature binds its BLS public key to its network address
+	verified, err := bls.Verify(crypto.Keccak256(accused.Bytes()), msg.GetAccusedSignature(), msg.GetAccusedPubKey())
+	if err != nil {
+		return errors.Wrap(types.ErrBLSVerifyFailed, err.Error())
+	}
+	if !verified {
+		return errors.Wrap(types.ErrBLSVerifyFailed, "accused signature")
+	}
+
+	reports := [][]byte{msg.GetFirstReport(), msg.GetSecondReport()}
+	chunked := []bool{msg.GetFirstChunked(), msg.GetSecondChunked()}
+	signatures := []types.BLSSignatureInfo{msg.GetFirstSignature(), msg.GetSecondSignature()}
+	totalCounts := make([]uint64, len(reports))
+	volumesRoots := make([][]byte, len(reports))
+	for i, report := range reports {
+		var epoch sdkmath.Int
+		epoch, totalCounts[i], volumesRoots[i], err = decodeSignedVolumeReport(report, chunked[i])
+		if err != nil {
+			return err
+		}
+		if epoch.IsNil() || !epoch.Equal(msg.Epoch) {
+			return errors.Wrapf(types.ErrInvalidEquivocation, "volume report of epoch %s", epoch)
+		}
+
+		signedByAccused := false
+		for _, pubKey := range signatures[i].GetPubKeys() {
+			if bytes.Equal(pubKey, msg.GetAccusedPubKey()) {
+				signedByAccused = true
+				break
+			}
+		}
+		if !signedByAccused {
+			return errors.Wrap(types.ErrInvalidEquivocation, "volume report not signed by the accused meta node")
+		}
+		err = verifyBLSSignature(report, signatures[i])
+		if err != nil {
+			return err
+		}
+	}
+
+	// reports differing only by their reporter or reference are not conflicting
+	if totalCounts[0] == totalCounts[1] && bytes.Equal(volumesRoots[0], volumesRoots[1]) {
+		return errors.Wrap(types.ErrInvalidEquivocation, "the volume reports have the same wallet volumes")
+	}
+	return nil
+}
+
+// SubmitReportEquivocation slashes the accused meta node by the equivocation slash fraction of its deposit
+func (k Keeper) SubmitReportEquivocation(ctx sdk.Context, msg *types.MsgSubmitReportEquivocation) (slashed sdk.Coin, err error) {
+	accused, err := stratos.SdsAddressFromBech32(msg.GetAccused())
+	if err != nil {
+		return slashed, errors.Wrap(types.ErrInvalidAddress, err.Error())
+	}
+	if k.HasReportEquivocation(ctx, msg.Epoch, accused) {
+		return slashed, types.ErrEquivocationReported
+	}
+
+	err = k.verifyReportEquivocation(ctx, msg, accused)
+	if err != nil {
+		return slashed, err
+	}
+
+	slashed, err = k.registerKeeper.SlashMetaNode(ctx, accused, k.EquivocationSlashFraction(ctx))
+	if err != nil {
+		return slashed, err
+	}
+
+	k.SetReportEquivocation(ctx, types.ReportEquivocation{
+		Epoch:    msg.Epoch,
+		Accused:  msg.GetAccused(),
+		Reporter: msg.GetReporter(),
+		Slashed:  slashed,
+		Height:   ctx.BlockHeight(),
+	})
+	return slashed, nil
+}
@@ -0,0 +1,135 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	stratosapp "github.com/stratosnet/stratos-chain/app"
+	"github.com/stratosnet/stratos-chain/crypto"
+	"github.com/stratosnet/stratos-chain/crypto/bls"
+	"github.com/stratosnet/stratos-chain/x/pot/keeper"
+	"github.com/stratosnet/stratos-chain/x/pot/types"
+)
+
+// signedReport is the BLS sign bytes of a volume report signed by the accused meta node
+type signedReport struct {
+	signBytes []byte
+	chunked   bool
+	signature types.BLSSignatureInfo
+}
+
+func signReport(t *testing.T, signBytes []byte, chunked bool) signedReport {
+	txData := crypto.Keccak256(signBytes)
+	signature, err := bls.Sign(txData, metaNodeBLSPrivKeys[1])
+	require.NoError(t, err)
+	return signedReport{
+		signBytes: signBytes,
+		chunked:   chunked,
+		signature: types.BLSSignatureInfo{PubKeys: [][]byte{metaNodeBLSPubKeys[1]}, Signature: signature, TxData: txData},
+	}
+}
+
+// signFullReport signs a volume report of the wallet volumes by the accused meta node
+func signFullReport(t *testing.T, epoch sdkmath.Int, reference string, volumes []types.SingleWalletVolume) signedReport {
+	report := types.NewMsgVolumeReport(volumes, metaNodeP2PAddr(1), epoch, reference, metaOwner(1))
+	return signReport(t, report.GetBLSSignBytes(), false)
+}
+
+// signChunkedReport signs the header of a chunked volume report of the wallet volumes by the accused meta node
+func signChunkedReport(t *testing.T, epoch sdkmath.Int, reference string, volumes []types.SingleWalletVolume) signedReport {
+	header := types.NewMsgVolumeReportHeader(metaNodeP2PAddr(1), epoch, reference, metaOwner(1), uint64(len(volumes)),
+		types.GetWalletVolumesRoot(volumes))
+	return signReport(t, header.GetBLSSignBytes(), true)
+}
+
+// newMsgSubmitReportEquivocation accuses the second meta node of signing both reports, on behalf of the first one
+func newMsgSubmitReportEquivocation(t *testing.T, first, second signedReport) *types.MsgSubmitReportEquivocation {
+	accusedSignature, err := bls.Sign(crypto.Keccak256(metaNodeP2PAddr(1).Bytes()), metaNodeBLSPrivKeys[1])
+	require.NoError(t, err)
+	msg := types.NewMsgSubmitReportEquivocation(metaNodeP2PAddr(0), metaOwner(0), metaNodeP2PAddr(1), reportEpoch,
+		first.signBytes, first.chunked, first.signature, second.signBytes, second.chunked, second.signature,
+		metaNodeBLSPubKeys[1], accusedSignature, false)
+	require.NoError(t, msg.ValidateBasic())
+	return msg
+}
+
+func submitReportEquivocation(stApp *stratosapp.StratosApp, ctx sdk.Context, msg *types.MsgSubmitReportEquivocation) error {
+	_, err := keeper.NewMsgServerImpl(stApp.GetPotKeeper()).HandleMsgSubmitReportEquivocation(sdk.WrapSDKContext(ctx), msg)
+	return err
+}
+
+func TestSubmitReportEquivocationSlashesAccused(t *testing.T) {
+	stApp, ctx := setupApp(t)
+	registerKeeper := stApp.GetRegisterKeeper()
+	accusedBefore, found := registerKeeper.GetMetaNode(ctx, metaNodeP2PAddr(1))
+	require.True(t, found)
+	communityPoolBefore := stApp.GetDistrKeeper().GetFeePoolCommunityCoins(ctx)
+
+	msg := newMsgSubmitReportEquivocation(t,
+		signFullReport(t, reportEpoch, reportReference, walletVolumes()),
+		signFullReport(t, reportEpoch, reportReference, counterReport()))
+	require.NoError(t, submitReportEquivocation(stApp, ctx, msg))
+
+	// the accused deposit is slashed by the equivocation slash fraction into the community pool
+	slashed := accusedBefore.Tokens.ToLegacyDec().Mul(stApp.GetPotKeeper().EquivocationSlashFraction(ctx)).TruncateInt()
+	require.True(t, slashed.IsPositive())
+	accused, found := registerKeeper.GetMetaNode(ctx, metaNodeP2PAddr(1))
+	require.True(t, found)
+	require.True(t, accusedBefore.Tokens.Sub(slashed).Equal(accused.Tokens))
+	funded := stApp.GetDistrKeeper().GetFeePoolCommunityCoins(ctx).Sub(communityPoolBefore)
+	require.True(t, slashed.Equal(funded.AmountOf(stApp.GetPotKeeper().BondDenom(ctx)).TruncateInt()))
+	require.True(t, stApp.GetPotKeeper().HasReportEquivocation(ctx, reportEpoch, metaNodeP2PAddr(1)))
+
+	// the equivocation of an epoch is slashed once
+	require.ErrorIs(t, submitReportEquivocation(stApp, ctx, msg), types.ErrEquivocationReported)
+}
+
+func TestSubmitReportEquivocationChunkedReport(t *testing.T) {
+	stApp, ctx := setupApp(t)
+
+	// a chunked report conflicts with a report of other wallet volumes
+	cacheCtx, _ := ctx.CacheContext()
+	require.NoError(t, submitReportEquivocation(stApp, cacheCtx, newMsgSubmitReportEquivocation(t,
+		signChunkedReport(t, reportEpoch, reportReference, walletVolumes()),
+		signFullReport(t, reportEpoch, reportReference, counterReport()))))
+
+	cacheCtx, _ = ctx.CacheContext()
+	require.NoError(t, submitReportEquivocation(stApp, cacheCtx, newMsgSubmitReportEquivocation(t,
+		signChunkedReport(t, reportEpoch, reportReference, walletVolumes()),
+		signChunkedReport(t, reportEpoch, reportReference, walletVolumes()[1:]))))
+
+	// a chunked report does not conflict with a report of the same wallet volumes
+	err := submitReportEquivocation(stApp, ctx, newMsgSubmitReportEquivocation(t,
+		signChunkedReport(t, reportEpoch, reportReference, walletVolumes()),
+		signFullReport(t, reportEpoch, "other-reference", walletVolumes())))
+	require.ErrorIs(t, err, types.ErrInvalidEquivocation)
+	require.False(t, stApp.GetPotKeeper().HasReportEquivocation(ctx, reportEpoch, metaNodeP2PAddr(1)))
+}
+
+func TestSubmitReportEquivocationRejectsConsistentReports(t *testing.T) {
+	stApp, ctx := setupApp(t)
+
+	// the sign bytes differ by the report reference only
+	err := submitReportEquivocation(stApp, ctx, newMsgSubmitReportEquivocation(t,
+		signFullReport(t, reportEpoch, reportReference, walletVolumes()),
+		signFullReport(t, reportEpoch, "other-reference", walletVolumes())))
+	require.ErrorIs(t, err, types.ErrInvalidEquivocation)
+
+	// the reports of different epochs do not conflict
+	err = submitReportEquivocation(stApp, ctx, newMsgSubmitReportEquivocation(t,
+		signFullReport(t, reportEpoch, reportReference, walletVolumes()),
+		signFullReport(t, reportEpoch.AddRaw(1), reportReference, counterReport())))
+	require.ErrorIs(t, err, types.ErrInvalidEquivocation)
+
+	// a chunked report submitted as a full report is rejected
+	err = submitReportEquivocation(stApp, ctx, newMsgSubmitReportEquivocation(t,
+		signFullReport(t, reportEpoch, reportReference, walletVolumes()),
+		signReport(t, signChunkedReport(t, reportEpoch, reportReference, counterReport()).signBytes, false)))
+	require.Error(t, err)
+
+	require.False(t, stApp.GetPotKeeper().HasReportEquivocation(ctx, reportEpoch, metaNodeP2PAddr(1)))
+}
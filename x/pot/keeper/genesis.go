@@ -54,6 +54,32 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) {
 		reward := rewardTotal.TotalReward
 		k.SetTotalReward(ctx, epoch, reward)
 	}
+
+	if data.PendingVolumeReport != nil {
+		k.SetPendingVolumeReport(ctx, *data.PendingVolumeReport)
+		for i, volume := range data.PendingWalletVolumes {
+			k.SetPendingWalletVolume(ctx, uint64(i), volume)
+		}
+	}
+
+	for _, distribution := range data.PendingDistributions {
+		k.SetPendingDistribution(ctx, distribution)
+	}
+	for _, distributionVolume := range data.DistributionVolumes {
+		k.SetDistributionVolume(ctx, distributionVolume.Epoch, distributionVolume.Index, distributionVolume.Volume)
+	}
+	for _, dispute := range data.VolumeReportDisputes {
+		k.SetVolumeReportDispute(ctx, dispute)
+	}
+	for _, equivocation := range data.ReportEquivocations {
+		k.SetReportEquivocation(ctx, equivocation)
+	}
+	for _, history := range data.RewardHistories {
+		k.SetRewardHistory(ctx, history)
+	}
+	for _, autoRestake := range data.AutoRestakes {
+		k.SetAutoRestake(ctx, autoRestake)
+	}
 }
 
 // ExportGenesis writes the current store values
@@ -100,6 +126,48 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) (data *types.GenesisState) {
 		return false
 	})
 
+	var pendingVolumeReport *types.PendingVolumeReport
+	var pendingWalletVolumes []types.SingleWalletVolume
+	if report, found := k.GetPendingVolumeReport(ctx); found {
+		pendingVolumeReport = &report
+		pendingWalletVolumes = k.GetPendingWalletVolumes(ctx)
+	}
+
+	var pendingDistributions []types.PendingDistribution
+	k.IteratePendingDistributions(ctx, func(distribution types.PendingDistribution) (stop bool) {
+		pendingDistributions = append(pendingDistributions, distribution)
+		return false
+	})
+	var distributionVolumes []types.DistributionVolume
+	k.IterateDistributionVolumes(ctx, func(distributionVolume types.DistributionVolume) (stop bool) {
+		distributionVolumes = append(distributionVolumes, distributionVolume)
+		return false
+	})
+
+	var volumeReportDisputes []types.VolumeReportDispute
+	k.IterateVolumeReportDisputes(ctx, func(dispute types.VolumeReportDispute) (stop bool) {
+		volumeReportDisputes = append(volumeReportDisputes, dispute)
+		return false
+	})
+
+	var reportEquivocations []types.ReportEquivocation
+	k.IterateReportEquivocations(ctx, func(equivocation types.ReportEquivocation) (stop bool) {
+		reportEquivocations = append(reportEquivocations, equivocation)
+		return false
+	})
+
+	var rewardHistories []types.RewardHistory
+	k.IterateRewardHistories(ctx, func(history types.RewardHistory) (stop bool) {
+		rewardHistories = append(rewardHistories, history)
+		return false
+	})
+
+	var autoRestakes []types.AutoRestake
+	k.IterateAutoRestakes(ctx, func(autoRestake types.AutoRestake) (stop bool) {
+		autoRestakes = append(autoRestakes, autoRestake)
+		return false
+	})
+
 	return types.NewGenesisState(
 		params,
 		totalMinedToken,
@@ -108,5 +176,13 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) (data *types.GenesisState) {
 		matureTotalInfo,
 		individualRewardInfo,
 		maturedEpoch,
-		rewardTotalInfo)
+		rewardTotalInfo,
+		pendingVolumeReport,
+		pendingWalletVolumes,
+		pendingDistributions,
+		distributionVolumes,
+		volumeReportDisputes,
+		reportEquivocations,
+		rewardHistories,
+		autoRestakes)
 }
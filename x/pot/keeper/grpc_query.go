@@ -60,6 +60,47 @@ func (q Querier) VolumeReport(c context.Context, req *types.QueryVolumeReportReq
 	}, nil
 }
 
+func (q Querier) PendingVolumeReport(c context.Context, _ *types.QueryPendingVolumeReportRequest) (*types.QueryPendingVolumeReportResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	report, found := q.GetPendingVolumeReport(ctx)
+	if !found {
+		return &types.QueryPendingVolumeReportResponse{}, nil
+	}
+
+	return &types.QueryPendingVolumeReportResponse{Report: &report}, nil
+}
+
+func (q Querier) DistributionProgress(c context.Context, _ *types.QueryDistributionProgressRequest) (*types.QueryDistributionProgressResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	distributions := make([]types.PendingDistribution, 0)
+	q.IteratePendingDistributions(ctx, func(distribution types.PendingDistribution) (stop bool) {
+		distributions = append(distributions, distribution)
+		return false
+	})
+
+	return &types.QueryDistributionProgressResponse{
+		LastDistributedEpoch: q.GetLastDistributedEpoch(ctx),
+		Distributions:        distributions,
+	}, nil
+}
+
+func (q Querier) VolumeReportDispute(c context.Context, req *types.QueryVolumeReportDisputeRequest) (*types.QueryVolumeReportDisputeResponse, error) {
+	if req == nil {
+		return &types.QueryVolumeReportDisputeResponse{}, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.GetEpoch() <= 0 {
+		return &types.QueryVolumeReportDisputeResponse{}, status.Error(codes.InvalidArgument, "epoch should be positive value")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	dispute, found := q.GetVolumeReportDispute(ctx, sdkmath.NewInt(req.GetEpoch()))
+	if !found {
+		return &types.QueryVolumeReportDisputeResponse{}, status.Errorf(codes.NotFound, "no dispute found for epoch %d", req.GetEpoch())
+	}
+
+	return &types.QueryVolumeReportDisputeResponse{Dispute: &dispute}, nil
+}
+
 func (q Querier) RewardsByEpoch(c context.Context, req *types.QueryRewardsByEpochRequest) (*types.QueryRewardsByEpochResponse, error) {
 	if req == nil {
 		return &types.QueryRewardsByEpochResponse{}, status.Errorf(codes.InvalidArgument, "empty request")
@@ -289,6 +330,136 @@ func (q Querier) RewardsByWalletAndEpoch(c context.Context, req *types.QueryRewa
 	return &types.QueryRewardsByWalletAndEpochResponse{Rewards: res, Pagination: rewardsPageRes}, nil
 }
 
+func (q Querier) VestingSchedule(c context.Context, req *types.QueryVestingScheduleRequest) (*types.QueryVestingScheduleResponse, error) {
+	if req == nil {
+		return &types.QueryVestingScheduleResponse{}, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.GetWalletAddress() == "" {
+		return &types.QueryVestingScheduleResponse{}, status.Error(codes.InvalidArgument, "wallet address cannot be empty")
+	}
+
+	walletAddr, err := sdk.AccAddressFromBech32(req.GetWalletAddress())
+	if err != nil {
+		return &types.QueryVestingScheduleResponse{}, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	entries := make([]types.VestingScheduleEntry, 0)
+
+	store := ctx.KVStore(q.storeKey)
+	indexStore := prefix.NewStore(store, types.GetIndividualRewardByWalletIteratorKey(walletAddr))
+
+	entriesPageRes, err := pagiquery.Paginate(indexStore, req.Pagination, func(key []byte, _ []byte) error {
+		// key: {epoch}
+		matureEpoch := sdkmath.NewIntFromUint64(sdk.BigEndianToUint64(key))
+		individualReward, found := q.GetIndividualReward(ctx, walletAddr, matureEpoch)
+		if !found {
+			return nil
+		}
+		entries = append(entries, types.VestingScheduleEntry{
+			MatureEpoch:           matureEpoch.Int64(),
+			RewardFromMiningPool:  individualReward.RewardFromMiningPool,
+			RewardFromTrafficPool: individualReward.RewardFromTrafficPool,
+		})
+		return nil
+	})
+	if err != nil {
+		return &types.QueryVestingScheduleResponse{}, status.Error(codes.Internal, err.Error())
+	}
+
+	var linearVesting *types.VestingSchedule
+	if schedule, found := q.GetVestingSchedule(ctx, walletAddr); found {
+		linearVesting = &schedule
+	}
+
+	return &types.QueryVestingScheduleResponse{
+		WalletAddress:       walletAddr.String(),
+		VestingMode:         q.VestingMode(ctx),
+		MaturedEpoch:        q.GetMaturedEpoch(ctx).Int64(),
+		Entries:             entries,
+		MatureTotalReward:   q.GetMatureTotalReward(ctx, walletAddr),
+		ImmatureTotalReward: q.GetImmatureTotalReward(ctx, walletAddr),
+		Pagination:          entriesPageRes,
+		LinearVesting:       linearVesting,
+	}, nil
+}
+
+func (q Querier) RewardHistory(c context.Context, req *types.QueryRewardHistoryRequest) (*types.QueryRewardHistoryResponse, error) {
+	if req == nil {
+		return &types.QueryRewardHistoryResponse{}, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.GetWalletAddress() == "" {
+		return &types.QueryRewardHistoryResponse{}, status.Error(codes.InvalidArgument, "wallet address cannot be empty")
+	}
+	if req.GetStartEpoch() < 0 || req.GetEndEpoch() < 0 {
+		return &types.QueryRewardHistoryResponse{}, status.Error(codes.InvalidArgument, "epoch cannot be lower than 0")
+	}
+	if req.GetEndEpoch() > 0 && req.GetEndEpoch() < req.GetStartEpoch() {
+		return &types.QueryRewardHistoryResponse{}, status.Error(codes.InvalidArgument, "end epoch cannot be lower than start epoch")
+	}
+
+	walletAddr, err := sdk.AccAddressFromBech32(req.GetWalletAddress())
+	if err != nil {
+		return &types.QueryRewardHistoryResponse{}, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	histories := make([]types.RewardHistory, 0)
+
+	store := ctx.KVStore(q.storeKey)
+	historyStore := prefix.NewStore(store, types.GetRewardHistoryIteratorKey(walletAddr))
+
+	historyPageRes, err := FilteredPaginate(q.cdc, historyStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		// key: {epoch}
+		epoch := int64(sdk.BigEndianToUint64(key))
+		if epoch < req.GetStartEpoch() || (req.GetEndEpoch() > 0 && epoch > req.GetEndEpoch()) {
+			return false, nil
+		}
+
+		if accumulate {
+			var history types.RewardHistory
+			err := q.cdc.UnmarshalLengthPrefixed(value, &history)
+			if err != nil {
+				return false, err
+			}
+			histories = append(histories, history)
+		}
+
+		return true, nil
+	})
+	if err != nil {
+		return &types.QueryRewardHistoryResponse{}, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryRewardHistoryResponse{Histories: histories, Pagination: historyPageRes}, nil
+}
+
+func (q Querier) AutoRestake(c context.Context, req *types.QueryAutoRestakeRequest) (*types.QueryAutoRestakeResponse, error) {
+	if req == nil {
+		return &types.QueryAutoRestakeResponse{}, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.GetWalletAddress() == "" {
+		return &types.QueryAutoRestakeResponse{}, status.Error(codes.InvalidArgument, "wallet address cannot be empty")
+	}
+
+	walletAddr, err := sdk.AccAddressFromBech32(req.GetWalletAddress())
+	if err != nil {
+		return &types.QueryAutoRestakeResponse{}, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	autoRestake, found := q.GetAutoRestake(ctx, walletAddr)
+	if !found {
+		return &types.QueryAutoRestakeResponse{}, nil
+	}
+	return &types.QueryAutoRestakeResponse{AutoRestake: &autoRestake}, nil
+}
+
 func (q Querier) SlashingByOwner(c context.Context, req *types.QuerySlashingByOwnerRequest) (*types.QuerySlashingByOwnerResponse, error) {
 	if req == nil {
 		return &types.QuerySlashingByOwnerResponse{}, status.Errorf(codes.InvalidArgument, "empty request")
@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/stratosnet/stratos-chain/x/pot/types"
+)
+
+// Hooks wrapper struct for the pot keeper
+type Hooks struct {
+	k Keeper
+}
+
+var _ govtypes.GovHooks = Hooks{}
+
+// Hooks returns the gov hooks of the pot keeper
+func (k Keeper) Hooks() Hooks {
+	return Hooks{k}
+}
+
+func (h Hooks) AfterProposalSubmission(_ sdk.Context, _ uint64) {}
+
+func (h Hooks) AfterProposalDeposit(_ sdk.Context, _ uint64, _ sdk.AccAddress) {}
+
+func (h Hooks) AfterProposalVote(_ sdk.Context, _ uint64, _ sdk.AccAddress) {}
+
+func (h Hooks) AfterProposalFailedMinDeposit(_ sdk.Context, _ uint64) {}
+
+// AfterProposalVotingPeriodEnded distributes the disputed volume report unchanged when the proposal escalated
+// from its dispute did not amend it
+func (h Hooks) AfterProposalVotingPeriodEnded(ctx sdk.Context, proposalID uint64) {
+	dispute, found := h.k.GetVolumeReportDisputeByProposal(ctx, proposalID)
+	if !found {
+		return
+	}
+
+	resolution, err := h.k.ResolveVolumeReportDispute(ctx, dispute.Epoch, false, nil)
+	if err != nil {
+		h.k.Logger(ctx).Error("failed to resolve volume report dispute", "epoch", dispute.Epoch.String(),
+			"proposal_id", proposalID, "ErrMsg", err.Error())
+		return
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventResolveVolumeReportDispute{
+		Epoch:      dispute.Epoch.String(),
+		Resolution: resolution,
+	})
+	if err != nil {
+		h.k.Logger(ctx).Error("failed to emit event", "ErrMsg", err.Error())
+	}
+}
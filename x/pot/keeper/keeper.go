@@ -33,6 +33,7 @@ type Keeper struct {
 	distrKeeper    types.DistrKeeper
 	registerKeeper types.RegisterKeeper
 	stakingKeeper  types.StakingKeeper
+	govKeeper      types.GovKeeper
 
 	// the address capable of executing a MsgUpdateParams message. Typically, this
 	// should be the x/gov module account.
@@ -50,6 +51,7 @@ func NewKeeper(
 	distrKeeper types.DistrKeeper,
 	registerKeeper types.RegisterKeeper,
 	stakingKeeper types.StakingKeeper,
+	govKeeper types.GovKeeper,
 	authority string,
 ) Keeper {
 	keeper := Keeper{
@@ -60,6 +62,7 @@ func NewKeeper(
 		distrKeeper:    distrKeeper,
 		registerKeeper: registerKeeper,
 		stakingKeeper:  stakingKeeper,
+		govKeeper:      govKeeper,
 		authority:      authority,
 		cache:          NewKeeperMCache(5 * time.Minute),
 	}
@@ -78,7 +81,7 @@ func (k Keeper) VolumeReport(ctx sdk.Context, walletVolumes types.WalletVolumes,
 	reportRecord := types.NewReportRecord(reporter, reportReference, txHash)
 	k.SetVolumeReport(ctx, epoch, reportRecord)
 
-	err = k.DistributePotReward(ctx, walletVolumes.GetVolumes(), epoch)
+	err = k.QueueRewardDistribution(ctx, walletVolumes.GetVolumes(), epoch)
 	if err != nil {
 		return err
 	}
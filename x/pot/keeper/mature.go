@@ -25,43 +25,44 @@ func (k Keeper) RewardMatureAndSubSlashing(ctx sdk.Context) error {
 		return nil
 	}
 
-	maturedIndividualKeys := make([][]byte, 0)
+	maturedWallets := make([]sdk.AccAddress, 0)
+	maturedEpochs := make([]sdkmath.Int, 0)
 
 	matureStartEpochOffset := int64(1)
 	matureEndEpochOffset := lastDistributedEpoch.Sub(maturedEpoch).Int64()
 
+	// the vesting schedules are released up to the last epoch whose individual rewards are all matured
+	releaseEpoch := maturedEpoch
 	processCount := 1
 	for i := matureStartEpochOffset; i <= matureEndEpochOffset; i++ {
 		processingEpoch := sdkmath.NewInt(i).Add(maturedEpoch)
 		totalSlashed := sdk.Coins{}
 
 		isBreak := true
+		isStopped := false
 		k.IteratorIndividualReward(ctx, processingEpoch, func(walletAddress sdk.AccAddress, individualReward types.Reward) (stop bool) {
 
 			// Stop iteration when executed wallet reaches MatureCountPerBlock && no new volume report is received
 			if processCount > MatureCountPerBlock &&
 				lastDistributedEpoch.Equal(processingEpoch) {
 				isBreak = true
+				isStopped = true
 				return true
 			}
 
-			// Mature reward
-			oldMatureTotal := k.GetMatureTotalReward(ctx, walletAddress)
-			oldImmatureTotal := k.GetImmatureTotalReward(ctx, walletAddress)
-			immatureToMature := individualReward.RewardFromMiningPool.Add(individualReward.RewardFromTrafficPool...)
-
-			// Deduct slashing amount from upcoming mature reward, don't need to deduct slashing from immatureTotal & individual
-			remaining, deducted := k.registerKeeper.DeductSlashing(ctx, walletAddress, immatureToMature, k.RewardDenom(ctx))
+			// Mature reward, a linearly vesting reward matures through the vesting schedule of the wallet
+			var deducted sdk.Coins
+			if k.VestingMode(ctx) == types.VestingModeLinear {
+				deducted = k.addToVestingSchedule(ctx, walletAddress, individualReward, processingEpoch)
+			} else {
+				deducted = k.matureIndividualReward(ctx, walletAddress, processingEpoch, individualReward)
+			}
 			totalSlashed = totalSlashed.Add(deducted...)
 
-			matureTotal := oldMatureTotal.Add(remaining...)
-			immatureTotal := oldImmatureTotal.Sub(immatureToMature...)
-
 			processCount++
 
-			k.SetMatureTotalReward(ctx, walletAddress, matureTotal)
-			k.SetImmatureTotalReward(ctx, walletAddress, immatureTotal)
-			maturedIndividualKeys = append(maturedIndividualKeys, types.GetIndividualRewardKey(walletAddress, processingEpoch))
+			maturedWallets = append(maturedWallets, walletAddress)
+			maturedEpochs = append(maturedEpochs, processingEpoch)
 			isBreak = false
 			return false
 		})
@@ -76,16 +77,59 @@ func (k Keeper) RewardMatureAndSubSlashing(ctx sdk.Context) error {
 		if !isBreak {
 			k.SetMaturedEpoch(ctx, processingEpoch)
 		}
+		if !isStopped {
+			releaseEpoch = processingEpoch
+		}
 	}
 
 	// remove matured individual reward records
-	for _, key := range maturedIndividualKeys {
-		k.RemoveIndividualReward(ctx, key)
+	for i, walletAddress := range maturedWallets {
+		k.RemoveIndividualReward(ctx, walletAddress, maturedEpochs[i])
 	}
 
+	// release the vested parts of the linearly vesting rewards
+	err := k.transferTokensForMatureReward(ctx, k.releaseVestingSchedules(ctx, releaseEpoch))
+	if err != nil {
+		return err
+	}
+
+	k.PruneRewardHistory(ctx, k.GetMaturedEpoch(ctx))
+
 	return nil
 }
 
+// matureIndividualReward moves the reward from the immature to the mature total reward of the wallet, deducting the
+// slashing of the wallet from it, and returns the deducted slashing
+func (k Keeper) matureIndividualReward(ctx sdk.Context, walletAddress sdk.AccAddress, epoch sdkmath.Int, reward types.Reward,
+) (deducted sdk.Coins) {
+
+	oldMatureTotal := k.GetMatureTotalReward(ctx, walletAddress)
+	oldImmatureTotal := k.GetImmatureTotalReward(ctx, walletAddress)
+	immatureToMature := reward.RewardFromMiningPool.Add(reward.RewardFromTrafficPool...)
+
+	// Deduct slashing amount from upcoming mature reward, don't need to deduct slashing from immatureTotal & individual
+	remaining, deducted := k.registerKeeper.DeductSlashing(ctx, walletAddress, immatureToMature, k.RewardDenom(ctx))
+
+	matureTotal := oldMatureTotal.Add(remaining...)
+	immatureTotal := oldImmatureTotal.Sub(immatureToMature...)
+
+	k.SetMatureTotalReward(ctx, walletAddress, matureTotal)
+	k.SetImmatureTotalReward(ctx, walletAddress, immatureTotal)
+
+	// the reward matured at the epoch and the vested part of the vesting schedule share the history of the epoch
+	history, found := k.GetRewardHistory(ctx, walletAddress, epoch)
+	if !found {
+		history = types.RewardHistory{WalletAddress: walletAddress.String(), Epoch: epoch}
+	}
+	history.RewardFromMiningPool = history.RewardFromMiningPool.Add(reward.RewardFromMiningPool...)
+	history.RewardFromTrafficPool = history.RewardFromTrafficPool.Add(reward.RewardFromTrafficPool...)
+	history.SlashingDeducted = history.SlashingDeducted.Add(deducted...)
+	k.SetRewardHistory(ctx, history)
+
+	k.autoRestakeMaturedReward(ctx, walletAddress, remaining)
+	return deducted
+}
+
 func (k Keeper) transferTokensForMatureReward(ctx sdk.Context, totalSlashed sdk.Coins) error {
 
 	// [TLC] [TotalRewardPool -> Distribution] Transfer slashed reward to FeePool.CommunityPool
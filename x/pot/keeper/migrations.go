@@ -3,6 +3,7 @@ package keeper
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	v011 "github.com/stratosnet/stratos-chain/x/pot/legacy/v011"
+	v012 "github.com/stratosnet/stratos-chain/x/pot/legacy/v012"
 	"github.com/stratosnet/stratos-chain/x/pot/types"
 )
 
@@ -21,3 +22,33 @@ func NewMigrator(keeper Keeper, legacySubspace types.ParamsSubspace) Migrator {
 func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 	return v011.MigrateStore(ctx, m.keeper.storeKey, m.legacySubspace, m.keeper.cdc)
 }
+
+// Migrate2to3 migrates from version 2 to 3.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	return v012.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc)
+}
+
+// Migrate3to4 migrates from version 3 to 4.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	return v012.MigrateDistributeBatchParams(ctx, m.keeper.storeKey, m.keeper.cdc)
+}
+
+// Migrate4to5 migrates from version 4 to 5.
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	return v012.MigrateDisputeParams(ctx, m.keeper.storeKey, m.keeper.cdc)
+}
+
+// Migrate5to6 migrates from version 5 to 6.
+func (m Migrator) Migrate5to6(ctx sdk.Context) error {
+	return v012.MigrateEquivocationParams(ctx, m.keeper.storeKey, m.keeper.cdc)
+}
+
+// Migrate6to7 migrates from version 6 to 7.
+func (m Migrator) Migrate6to7(ctx sdk.Context) error {
+	return v012.MigrateVestingParams(ctx, m.keeper.storeKey, m.keeper.cdc)
+}
+
+// Migrate7to8 migrates from version 7 to 8.
+func (m Migrator) Migrate7to8(ctx sdk.Context) error {
+	return v012.MigrateRewardHistoryParams(ctx, m.keeper.storeKey, m.keeper.cdc)
+}
@@ -1,7 +1,6 @@
 package keeper
 
 import (
-	"bytes"
 	"context"
 	"fmt"
 	"strconv"
@@ -12,11 +11,11 @@ import (
 	"cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
 
-	"github.com/stratosnet/stratos-chain/crypto"
-	"github.com/stratosnet/stratos-chain/crypto/bls"
 	stratos "github.com/stratosnet/stratos-chain/types"
 	"github.com/stratosnet/stratos-chain/x/pot/types"
+	registertypes "github.com/stratosnet/stratos-chain/x/register/types"
 )
 
 type msgServer struct {
@@ -51,33 +50,19 @@ func (k msgServer) HandleMsgVolumeReport(goCtx context.Context, msg *types.MsgVo
 	if !ok {
 		return &types.MsgVolumeReportResponse{}, types.ErrInvalid
 	}
-	lastDistributedEpoch := k.GetLastDistributedEpoch(ctx)
-	if msg.Epoch.LTE(lastDistributedEpoch) {
+	lastReportedEpoch := k.GetLastReportedEpoch(ctx)
+	if msg.Epoch.LTE(lastReportedEpoch) {
 		e := errors.Wrapf(types.ErrMatureEpoch, "expected epoch should be greater than %s, got %s",
-			lastDistributedEpoch.String(), msg.Epoch.String())
+			lastReportedEpoch.String(), msg.Epoch.String())
 		return &types.MsgVolumeReportResponse{}, e
 	}
-
-	blsSignature := msg.GetBLSSignature()
-
-	// verify txDataHash
-	signBytes := msg.GetBLSSignBytes()
-	txDataHash := crypto.Keccak256(signBytes)
-	if !bytes.Equal(txDataHash, blsSignature.GetTxData()) {
-		return &types.MsgVolumeReportResponse{}, types.ErrBLSTxDataInvalid
+	if _, found := k.GetPendingVolumeReport(ctx); found {
+		return &types.MsgVolumeReportResponse{}, types.ErrVolumeReportPending
 	}
 
-	// verify blsSignature
-	verified, err := bls.Verify(blsSignature.GetTxData(), blsSignature.GetSignature(), blsSignature.GetPubKeys()...)
+	err = k.verifyVolumeReportSignature(ctx, msg.GetBLSSignBytes(), msg.GetBLSSignature())
 	if err != nil {
-		return &types.MsgVolumeReportResponse{}, errors.Wrap(types.ErrBLSVerifyFailed, err.Error())
-	}
-	if !verified {
-		return &types.MsgVolumeReportResponse{}, types.ErrBLSVerifyFailed
-	}
-
-	if !k.HasReachedThreshold(ctx, blsSignature.GetPubKeys()) {
-		return &types.MsgVolumeReportResponse{}, types.ErrBLSNotReachThreshold
+		return &types.MsgVolumeReportResponse{}, err
 	}
 
 	txBytes := ctx.TxBytes()
@@ -103,6 +88,98 @@ func (k msgServer) HandleMsgVolumeReport(goCtx context.Context, msg *types.MsgVo
 	return &types.MsgVolumeReportResponse{}, nil
 }
 
+func (k msgServer) HandleMsgVolumeReportHeader(goCtx context.Context, msg *types.MsgVolumeReportHeader) (*types.MsgVolumeReportHeaderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	reporter, err := stratos.SdsAddressFromBech32(msg.Reporter)
+	if err != nil {
+		return &types.MsgVolumeReportHeaderResponse{}, errors.Wrap(types.ErrReporterAddress, err.Error())
+	}
+	reporterOwner, err := sdk.AccAddressFromBech32(msg.ReporterOwner)
+	if err != nil {
+		return &types.MsgVolumeReportHeaderResponse{}, errors.Wrap(types.ErrReporterOwnerAddr, err.Error())
+	}
+
+	if !(k.registerKeeper.OwnMetaNode(ctx, reporterOwner, reporter)) {
+		return &types.MsgVolumeReportHeaderResponse{}, types.ErrReporterAddressOrOwner
+	}
+
+	// ensure epoch increment
+	lastReportedEpoch := k.GetLastReportedEpoch(ctx)
+	if msg.Epoch.LTE(lastReportedEpoch) {
+		e := errors.Wrapf(types.ErrMatureEpoch, "expected epoch should be greater than %s, got %s",
+			lastReportedEpoch.String(), msg.Epoch.String())
+		return &types.MsgVolumeReportHeaderResponse{}, e
+	}
+
+	err = k.verifyVolumeReportSignature(ctx, msg.GetBLSSignBytes(), msg.GetBLSSignature())
+	if err != nil {
+		return &types.MsgVolumeReportHeaderResponse{}, err
+	}
+
+	txBytes := ctx.TxBytes()
+	txhash := fmt.Sprintf("%X", tmhash.Sum(txBytes))
+
+	deadlineHeight, err := k.VolumeReportHeader(ctx, msg, txhash)
+	if err != nil {
+		return nil, errors.Wrap(types.ErrVolumeReport, err.Error())
+	}
+
+	err = ctx.EventManager().EmitTypedEvents(
+		&types.EventVolumeReportHeader{
+			ReportReference: msg.GetReportReference(),
+			Epoch:           msg.Epoch.String(),
+			TotalCount:      strconv.FormatUint(msg.GetTotalCount(), 10),
+			DeadlineHeight:  strconv.FormatInt(deadlineHeight, 10),
+		},
+	)
+	if err != nil {
+		return nil, errors.Wrap(types.ErrEmitEvent, err.Error())
+	}
+
+	return &types.MsgVolumeReportHeaderResponse{}, nil
+}
+
+func (k msgServer) HandleMsgVolumeReportChunk(goCtx context.Context, msg *types.MsgVolumeReportChunk) (*types.MsgVolumeReportChunkResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	reporter, err := stratos.SdsAddressFromBech32(msg.Reporter)
+	if err != nil {
+		return &types.MsgVolumeReportChunkResponse{}, errors.Wrap(types.ErrReporterAddress, err.Error())
+	}
+	reporterOwner, err := sdk.AccAddressFromBech32(msg.ReporterOwner)
+	if err != nil {
+		return &types.MsgVolumeReportChunkResponse{}, errors.Wrap(types.ErrReporterOwnerAddr, err.Error())
+	}
+
+	if !(k.registerKeeper.OwnMetaNode(ctx, reporterOwner, reporter)) {
+		return &types.MsgVolumeReportChunkResponse{}, types.ErrReporterAddressOrOwner
+	}
+
+	report, finalized, err := k.VolumeReportChunk(ctx, msg)
+	if err != nil {
+		return nil, errors.Wrap(types.ErrVolumeReport, err.Error())
+	}
+
+	events := []proto.Message{
+		&types.EventVolumeReportChunk{
+			Epoch:      msg.Epoch.String(),
+			StartIndex: strconv.FormatUint(msg.GetStartIndex(), 10),
+			Count:      strconv.Itoa(len(msg.GetWalletVolumes())),
+		},
+	}
+	if finalized {
+		events = append(events, &types.EventVolumeReport{
+			ReportReference: report.GetReportReference(),
+			Epoch:           report.Epoch.String(),
+		})
+	}
+	err = ctx.EventManager().EmitTypedEvents(events...)
+	if err != nil {
+		return nil, errors.Wrap(types.ErrEmitEvent, err.Error())
+	}
+
+	return &types.MsgVolumeReportChunkResponse{Finalized: finalized}, nil
+}
+
 func (k msgServer) HandleMsgWithdraw(goCtx context.Context, msg *types.MsgWithdraw) (*types.MsgWithdrawResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	walletAddress, err := sdk.AccAddressFromBech32(msg.WalletAddress)
@@ -210,6 +287,126 @@ func (k msgServer) HandleMsgSlashingResourceNode(goCtx context.Context, msg *typ
 	return &types.MsgSlashingResourceNodeResponse{}, nil
 }
 
+func (k msgServer) HandleMsgDisputeVolumeReport(goCtx context.Context, msg *types.MsgDisputeVolumeReport) (*types.MsgDisputeVolumeReportResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	disputer, err := sdk.AccAddressFromBech32(msg.Disputer)
+	if err != nil {
+		return &types.MsgDisputeVolumeReportResponse{}, errors.Wrap(types.ErrInvalidAddress, err.Error())
+	}
+
+	// the counter-report of a meta node is signed by a threshold of the meta nodes, governance files it through a proposal
+	if msg.Disputer != k.authority {
+		reporter, err := stratos.SdsAddressFromBech32(msg.Reporter)
+		if err != nil {
+			return &types.MsgDisputeVolumeReportResponse{}, errors.Wrap(types.ErrReporterAddress, err.Error())
+		}
+		if !(k.registerKeeper.OwnMetaNode(ctx, disputer, reporter)) {
+			return &types.MsgDisputeVolumeReportResponse{}, types.ErrReporterAddressOrOwner
+		}
+		err = k.verifyDisputeSignature(ctx, msg.GetBLSSignBytes(), msg.GetBLSSignature())
+		if err != nil {
+			return &types.MsgDisputeVolumeReportResponse{}, err
+		}
+	}
+
+	proposalID, err := k.DisputeVolumeReport(ctx, types.VolumeReportDispute{
+		Epoch:         msg.Epoch,
+		Disputer:      msg.GetDisputer(),
+		Reporter:      msg.GetReporter(),
+		Reason:        msg.GetReason(),
+		WalletVolumes: msg.GetWalletVolumes(),
+	})
+	if err != nil {
+		return &types.MsgDisputeVolumeReportResponse{}, err
+	}
+
+	err = ctx.EventManager().EmitTypedEvents(
+		&types.EventDisputeVolumeReport{
+			Epoch:      msg.Epoch.String(),
+			Disputer:   msg.GetDisputer(),
+			Reason:     msg.GetReason(),
+			ProposalId: strconv.FormatUint(proposalID, 10),
+		},
+	)
+	if err != nil {
+		return nil, errors.Wrap(types.ErrEmitEvent, err.Error())
+	}
+
+	return &types.MsgDisputeVolumeReportResponse{}, nil
+}
+
+func (k msgServer) HandleMsgSubmitReportEquivocation(goCtx context.Context, msg *types.MsgSubmitReportEquivocation) (*types.MsgSubmitReportEquivocationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	reporter, err := stratos.SdsAddressFromBech32(msg.Reporter)
+	if err != nil {
+		return &types.MsgSubmitReportEquivocationResponse{}, errors.Wrap(types.ErrReporterAddress, err.Error())
+	}
+	reporterOwner, err := sdk.AccAddressFromBech32(msg.ReporterOwner)
+	if err != nil {
+		return &types.MsgSubmitReportEquivocationResponse{}, errors.Wrap(types.ErrReporterOwnerAddr, err.Error())
+	}
+
+	if !(k.registerKeeper.OwnMetaNode(ctx, reporterOwner, reporter)) {
+		return &types.MsgSubmitReportEquivocationResponse{}, types.ErrReporterAddressOrOwner
+	}
+
+	slashed, err := k.SubmitReportEquivocation(ctx, msg)
+	if err != nil {
+		return &types.MsgSubmitReportEquivocationResponse{}, err
+	}
+
+	// optionally vote for kicking the accused meta node through the kick vote of the reporter
+	kickStatus := ""
+	if msg.GetKick() {
+		accused, _ := stratos.SdsAddressFromBech32(msg.GetAccused())
+		nodeStatus, _, _, _, err := k.registerKeeper.HandleVoteForKickMetaNode(ctx, accused, registertypes.Approve, reporter, reporterOwner)
+		if err != nil {
+			return &types.MsgSubmitReportEquivocationResponse{}, err
+		}
+		kickStatus = nodeStatus.String()
+	}
+
+	err = ctx.EventManager().EmitTypedEvents(
+		&types.EventReportEquivocation{
+			Epoch:      msg.Epoch.String(),
+			Accused:    msg.GetAccused(),
+			Reporter:   msg.GetReporter(),
+			Slashed:    slashed.String(),
+			KickStatus: kickStatus,
+		},
+	)
+	if err != nil {
+		return nil, errors.Wrap(types.ErrEmitEvent, err.Error())
+	}
+
+	return &types.MsgSubmitReportEquivocationResponse{}, nil
+}
+
+func (k msgServer) HandleMsgSetAutoRestake(goCtx context.Context, msg *types.MsgSetAutoRestake) (*types.MsgSetAutoRestakeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	walletAddress, err := sdk.AccAddressFromBech32(msg.GetWalletAddress())
+	if err != nil {
+		return &types.MsgSetAutoRestakeResponse{}, errors.Wrap(types.ErrInvalidAddress, err.Error())
+	}
+
+	err = k.UpdateAutoRestake(ctx, walletAddress, msg.GetNetworkAddress())
+	if err != nil {
+		return &types.MsgSetAutoRestakeResponse{}, err
+	}
+
+	err = ctx.EventManager().EmitTypedEvents(
+		&types.EventSetAutoRestake{
+			WalletAddress:  msg.GetWalletAddress(),
+			NetworkAddress: msg.GetNetworkAddress(),
+		},
+	)
+	if err != nil {
+		return nil, errors.Wrap(types.ErrEmitEvent, err.Error())
+	}
+
+	return &types.MsgSetAutoRestakeResponse{}, nil
+}
+
 // UpdateParams updates the module parameters
 func (k msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
 	if k.authority != msg.Authority {
@@ -224,3 +421,28 @@ func (k msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParam
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+// ResolveVolumeReportDispute cancels, amends or accepts a disputed volume report
+func (k msgServer) ResolveVolumeReportDispute(goCtx context.Context, msg *types.MsgResolveVolumeReportDispute) (*types.MsgResolveVolumeReportDisputeResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	resolution, err := k.Keeper.ResolveVolumeReportDispute(ctx, msg.Epoch, msg.GetCancel(), msg.GetWalletVolumes())
+	if err != nil {
+		return nil, err
+	}
+
+	err = ctx.EventManager().EmitTypedEvents(
+		&types.EventResolveVolumeReportDispute{
+			Epoch:      msg.Epoch.String(),
+			Resolution: resolution,
+		},
+	)
+	if err != nil {
+		return nil, errors.Wrap(types.ErrEmitEvent, err.Error())
+	}
+
+	return &types.MsgResolveVolumeReportDisputeResponse{}, nil
+}
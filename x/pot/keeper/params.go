@@ -82,3 +82,39 @@ func (k Keeper) InitialTotalSupply(ctx sdk.Context) (res sdk.Coin) {
 	res = params.GetInitialTotalSupply()
 	return
 }
+
+func (k Keeper) DistributeBatchSize(ctx sdk.Context) (res int64) {
+	params := k.GetParams(ctx)
+	res = params.GetDistributeBatchSize()
+	return
+}
+
+func (k Keeper) DisputeWindow(ctx sdk.Context) (res int64) {
+	params := k.GetParams(ctx)
+	res = params.GetDisputeWindow()
+	return
+}
+
+func (k Keeper) EquivocationSlashFraction(ctx sdk.Context) (res sdkmath.LegacyDec) {
+	params := k.GetParams(ctx)
+	res = params.EquivocationSlashFraction
+	return
+}
+
+func (k Keeper) VestingMode(ctx sdk.Context) (res string) {
+	params := k.GetParams(ctx)
+	res = params.VestingMode
+	return
+}
+
+func (k Keeper) VestingEpochs(ctx sdk.Context) (res int64) {
+	params := k.GetParams(ctx)
+	res = params.VestingEpochs
+	return
+}
+
+func (k Keeper) RewardHistoryRetention(ctx sdk.Context) (res int64) {
+	params := k.GetParams(ctx)
+	res = params.RewardHistoryRetention
+	return
+}
@@ -0,0 +1,102 @@
+package keeper
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/stratosnet/stratos-chain/x/pot/types"
+)
+
+func (k Keeper) SetPendingDistribution(ctx sdk.Context, distribution types.PendingDistribution) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalLengthPrefixed(&distribution)
+	store.Set(types.GetPendingDistributionKey(distribution.Epoch), bz)
+}
+
+func (k Keeper) RemovePendingDistribution(ctx sdk.Context, epoch sdkmath.Int) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetPendingDistributionKey(epoch))
+}
+
+// GetNextPendingDistribution returns the queued distribution with the lowest epoch
+func (k Keeper) GetNextPendingDistribution(ctx sdk.Context) (distribution types.PendingDistribution, found bool) {
+	k.IteratePendingDistributions(ctx, func(d types.PendingDistribution) (stop bool) {
+		distribution, found = d, true
+		return true
+	})
+	return distribution, found
+}
+
+// IteratePendingDistributions iterates over the queued distributions in epoch order
+func (k Keeper) IteratePendingDistributions(ctx sdk.Context, handler func(distribution types.PendingDistribution) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.PendingDistributionKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var distribution types.PendingDistribution
+		k.cdc.MustUnmarshalLengthPrefixed(iter.Value(), &distribution)
+		if handler(distribution) {
+			break
+		}
+	}
+}
+
+// GetLastReportedEpoch returns the epoch of the last accepted volume report, distributed or not
+func (k Keeper) GetLastReportedEpoch(ctx sdk.Context) (epoch sdkmath.Int) {
+	epoch = k.GetLastDistributedEpoch(ctx)
+	k.IteratePendingDistributions(ctx, func(distribution types.PendingDistribution) (stop bool) {
+		if distribution.Epoch.GT(epoch) {
+			epoch = distribution.Epoch
+		}
+		return false
+	})
+	return epoch
+}
+
+func (k Keeper) SetDistributionVolume(ctx sdk.Context, epoch sdkmath.Int, index uint64, volume types.SingleWalletVolume) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalLengthPrefixed(&volume)
+	store.Set(types.GetDistributionVolumeKey(epoch, index), bz)
+}
+
+func (k Keeper) RemoveDistributionVolume(ctx sdk.Context, key []byte) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(key)
+}
+
+// GetDistributionVolumes returns up to limit wallet volumes of the epoch not rewarded yet, together with their keys
+func (k Keeper) GetDistributionVolumes(ctx sdk.Context, epoch sdkmath.Int, limit uint64) (volumes []types.SingleWalletVolume, keys [][]byte) {
+	if limit == 0 {
+		return volumes, keys
+	}
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetDistributionVolumeIteratorKey(epoch))
+	defer iter.Close()
+	for ; iter.Valid() && uint64(len(volumes)) < limit; iter.Next() {
+		var volume types.SingleWalletVolume
+		k.cdc.MustUnmarshalLengthPrefixed(iter.Value(), &volume)
+		volumes = append(volumes, volume)
+		keys = append(keys, iter.Key())
+	}
+	return volumes, keys
+}
+
+// IterateDistributionVolumes iterates over the wallet volumes not rewarded yet of all the queued distributions
+func (k Keeper) IterateDistributionVolumes(ctx sdk.Context, handler func(distributionVolume types.DistributionVolume) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.DistributionVolumeKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		key := iter.Key()[len(types.DistributionVolumeKeyPrefix):]
+		var volume types.SingleWalletVolume
+		k.cdc.MustUnmarshalLengthPrefixed(iter.Value(), &volume)
+		distributionVolume := types.DistributionVolume{
+			Epoch:  sdkmath.NewIntFromUint64(sdk.BigEndianToUint64(key[:8])),
+			Index:  sdk.BigEndianToUint64(key[8:]),
+			Volume: volume,
+		}
+		if handler(distributionVolume) {
+			break
+		}
+	}
+}
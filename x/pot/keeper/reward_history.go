@@ -0,0 +1,67 @@
+package keeper
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/stratosnet/stratos-chain/x/pot/types"
+)
+
+func (k Keeper) SetRewardHistory(ctx sdk.Context, history types.RewardHistory) {
+	walletAddr, err := sdk.AccAddressFromBech32(history.GetWalletAddress())
+	if err != nil {
+		panic(err)
+	}
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalLengthPrefixed(&history)
+	store.Set(types.GetRewardHistoryKey(walletAddr, history.Epoch), bz)
+	store.Set(types.GetRewardHistoryByEpochKey(walletAddr, history.Epoch), []byte{})
+}
+
+func (k Keeper) GetRewardHistory(ctx sdk.Context, walletAddr sdk.AccAddress, epoch sdkmath.Int) (history types.RewardHistory, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetRewardHistoryKey(walletAddr, epoch))
+	if bz == nil {
+		return history, false
+	}
+	k.cdc.MustUnmarshalLengthPrefixed(bz, &history)
+	return history, true
+}
+
+func (k Keeper) IterateRewardHistories(ctx sdk.Context, handler func(history types.RewardHistory) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.RewardHistoryKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var history types.RewardHistory
+		k.cdc.MustUnmarshalLengthPrefixed(iter.Value(), &history)
+		if handler(history) {
+			break
+		}
+	}
+}
+
+// PruneRewardHistory removes the reward history matured at or before maturedEpoch - reward_history_retention
+func (k Keeper) PruneRewardHistory(ctx sdk.Context, maturedEpoch sdkmath.Int) {
+	retention := k.RewardHistoryRetention(ctx)
+	if retention == 0 || maturedEpoch.LTE(sdkmath.NewInt(retention)) {
+		return
+	}
+	pruneEndEpoch := maturedEpoch.SubRaw(retention)
+
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(types.RewardHistoryByEpochKeyPrefix, types.GetRewardHistoryByEpochIteratorKey(pruneEndEpoch.AddRaw(1)))
+	defer iter.Close()
+
+	prunedKeys := make([][]byte, 0)
+	for ; iter.Valid(); iter.Next() {
+		// key: prefix{epoch}{address}
+		epochAndAddr := iter.Key()[len(types.RewardHistoryByEpochKeyPrefix):]
+		epoch := sdkmath.NewIntFromUint64(sdk.BigEndianToUint64(epochAndAddr[:8]))
+		walletAddr := sdk.AccAddress(epochAndAddr[8:])
+		prunedKeys = append(prunedKeys, iter.Key(), types.GetRewardHistoryKey(walletAddr, epoch))
+	}
+	for _, key := range prunedKeys {
+		store.Delete(key)
+	}
+}
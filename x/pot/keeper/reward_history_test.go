@@ -0,0 +1,71 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/stratosnet/stratos-chain/x/pot/types"
+)
+
+func newRewardHistory(walletAddr sdk.AccAddress, epoch int64) types.RewardHistory {
+	return types.RewardHistory{
+		WalletAddress:         walletAddr.String(),
+		Epoch:                 sdkmath.NewInt(epoch),
+		RewardFromMiningPool:  sdk.NewCoins(sdk.NewInt64Coin("wei", epoch)),
+		RewardFromTrafficPool: sdk.Coins{},
+		SlashingDeducted:      sdk.Coins{},
+	}
+}
+
+func TestPruneRewardHistory(t *testing.T) {
+	stApp, ctx := setupApp(t)
+	k := stApp.GetPotKeeper()
+
+	params := k.GetParams(ctx)
+	params.RewardHistoryRetention = 3
+	require.NoError(t, k.SetParams(ctx, params))
+
+	for epoch := int64(1); epoch <= 5; epoch++ {
+		k.SetRewardHistory(ctx, newRewardHistory(resOwner(0), epoch))
+		k.SetRewardHistory(ctx, newRewardHistory(resOwner(1), epoch))
+	}
+
+	// nothing is pruned until the matured epoch exceeds the retention
+	k.PruneRewardHistory(ctx, sdkmath.NewInt(3))
+	_, found := k.GetRewardHistory(ctx, resOwner(0), sdkmath.NewInt(1))
+	require.True(t, found)
+
+	// the history matured at or before matured epoch - retention is removed for every wallet
+	k.PruneRewardHistory(ctx, sdkmath.NewInt(5))
+	for _, walletAddr := range []sdk.AccAddress{resOwner(0), resOwner(1)} {
+		for epoch := int64(1); epoch <= 5; epoch++ {
+			_, found = k.GetRewardHistory(ctx, walletAddr, sdkmath.NewInt(epoch))
+			require.Equal(t, epoch > 2, found, "epoch %d", epoch)
+		}
+	}
+	count := 0
+	k.IterateRewardHistories(ctx, func(history types.RewardHistory) (stop bool) {
+		count++
+		return false
+	})
+	require.Equal(t, 6, count)
+}
+
+func TestPruneRewardHistoryZeroRetention(t *testing.T) {
+	stApp, ctx := setupApp(t)
+	k := stApp.GetPotKeeper()
+
+	params := k.GetParams(ctx)
+	params.RewardHistoryRetention = 0
+	require.NoError(t, k.SetParams(ctx, params))
+
+	// a retention of 0 keeps the full history
+	k.SetRewardHistory(ctx, newRewardHistory(resOwner(0), 1))
+	k.PruneRewardHistory(ctx, sdkmath.NewInt(100))
+	_, found := k.GetRewardHistory(ctx, resOwner(0), sdkmath.NewInt(1))
+	require.True(t, found)
+}
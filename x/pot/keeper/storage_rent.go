@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/stratosnet/stratos-chain/x/pot/types"
+	regtypes "github.com/stratosnet/stratos-chain/x/register/types"
+)
+
+// RewardStorageRent records the storage rent earned by a resource node at epoch as a traffic reward.
+// The rent noz is priced like consumed traffic, the reward is paid from the unissued prepay and matures
+// like the rewards distributed at epoch, following the vesting mode.
+func (k Keeper) RewardStorageRent(ctx sdk.Context, walletAddr sdk.AccAddress, nozAmt sdkmath.Int, epoch sdkmath.Int) (reward sdk.Coin, err error) {
+	if !nozAmt.IsPositive() {
+		return sdk.NewCoin(k.BondDenom(ctx), sdkmath.ZeroInt()), nil
+	}
+
+	rewardBeforeTax := k.GetTrafficReward(ctx, nozAmt.ToLegacyDec())
+	reward, tax := k.CalcCommunityTax(ctx, rewardBeforeTax)
+
+	// [TLC] [TotalUnissuedPrepay -> TotalRewardPool] Transfer storage rent reward to TotalRewardPool
+	err = k.bankKeeper.SendCoinsFromModuleToModule(ctx, regtypes.TotalUnissuedPrepay, types.TotalRewardPool, sdk.NewCoins(reward))
+	if err != nil {
+		return reward, err
+	}
+
+	// [TLC] [TotalUnissuedPrepay -> Distribution] Transfer tax to FeePool.CommunityPool
+	taxCoins := sdk.NewCoins(sdk.NewCoin(k.BondDenom(ctx), tax.TruncateInt()))
+	prepayAccAddr := k.accountKeeper.GetModuleAddress(regtypes.TotalUnissuedPrepay)
+	err = k.distrKeeper.FundCommunityPool(ctx, taxCoins, prepayAccAddr)
+	if err != nil {
+		return reward, err
+	}
+
+	k.saveRewardInfo(ctx, []types.Reward{types.NewReward(walletAddr, sdk.Coins{}, sdk.NewCoins(reward))}, epoch)
+
+	// the rent noz is consumed from the noz ledger, return it to the remaining ozone limit
+	k.registerKeeper.ReturnPrepaidOzone(ctx, nozAmt)
+
+	return reward, nil
+}
@@ -49,6 +49,7 @@ func (k Keeper) SetIndividualReward(ctx sdk.Context, walletAddress sdk.AccAddres
 	store := ctx.KVStore(k.storeKey)
 	b := k.cdc.MustMarshalLengthPrefixed(&value)
 	store.Set(types.GetIndividualRewardKey(walletAddress, matureEpoch), b)
+	store.Set(types.GetIndividualRewardByWalletKey(walletAddress, matureEpoch), []byte{})
 }
 
 func (k Keeper) GetIndividualReward(ctx sdk.Context, walletAddress sdk.AccAddress, matureEpoch sdkmath.Int) (value types.Reward, found bool) {
@@ -61,9 +62,10 @@ func (k Keeper) GetIndividualReward(ctx sdk.Context, walletAddress sdk.AccAddres
 	return value, true
 }
 
-func (k Keeper) RemoveIndividualReward(ctx sdk.Context, individualRewardKey []byte) {
+func (k Keeper) RemoveIndividualReward(ctx sdk.Context, walletAddress sdk.AccAddress, matureEpoch sdkmath.Int) {
 	store := ctx.KVStore(k.storeKey)
-	store.Delete(individualRewardKey)
+	store.Delete(types.GetIndividualRewardKey(walletAddress, matureEpoch))
+	store.Delete(types.GetIndividualRewardByWalletKey(walletAddress, matureEpoch))
 }
 
 // IteratorIndividualReward Iteration for getting individual reward of each owner at a specific epoch
@@ -82,6 +84,26 @@ func (k Keeper) IteratorIndividualReward(ctx sdk.Context, epoch sdkmath.Int, han
 	}
 }
 
+// IteratorIndividualRewardByWallet Iteration for getting individual rewards of a wallet at all epochs, in epoch order
+func (k Keeper) IteratorIndividualRewardByWallet(ctx sdk.Context, walletAddress sdk.AccAddress, handler func(matureEpoch sdkmath.Int, individualReward types.Reward) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iteratorKey := types.GetIndividualRewardByWalletIteratorKey(walletAddress)
+	iter := sdk.KVStorePrefixIterator(store, iteratorKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		// key: prefix{len(address)}{address}{epoch}
+		matureEpoch := sdkmath.NewIntFromUint64(sdk.BigEndianToUint64(iter.Key()[len(iteratorKey):]))
+
+		individualReward, found := k.GetIndividualReward(ctx, walletAddress, matureEpoch)
+		if !found {
+			continue
+		}
+		if handler(matureEpoch, individualReward) {
+			break
+		}
+	}
+}
+
 func (k Keeper) SetMatureTotalReward(ctx sdk.Context, walletAddress sdk.AccAddress, value sdk.Coins) {
 	store := ctx.KVStore(k.storeKey)
 	b := k.cdc.MustMarshalLengthPrefixed(&stratos.Coins{Value: value})
@@ -167,6 +189,11 @@ func (k Keeper) SetVolumeReport(ctx sdk.Context, epoch sdkmath.Int, reportRecord
 	store.Set(storeKey, bz)
 }
 
+func (k Keeper) RemoveVolumeReport(ctx sdk.Context, epoch sdkmath.Int) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.VolumeReportStoreKey(epoch))
+}
+
 func (k Keeper) GetMaturedEpoch(ctx sdk.Context) (epoch sdkmath.Int) {
 	store := ctx.KVStore(k.storeKey)
 	bz := store.Get(types.MaturedEpochKeyPrefix)
@@ -0,0 +1,144 @@
+package keeper
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/stratosnet/stratos-chain/x/pot/types"
+)
+
+func (k Keeper) GetVestingSchedule(ctx sdk.Context, walletAddr sdk.AccAddress) (schedule types.VestingSchedule, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetVestingScheduleKey(walletAddr))
+	if bz == nil {
+		return schedule, false
+	}
+	k.cdc.MustUnmarshalLengthPrefixed(bz, &schedule)
+	return schedule, true
+}
+
+func (k Keeper) SetVestingSchedule(ctx sdk.Context, schedule types.VestingSchedule) {
+	walletAddr, err := sdk.AccAddressFromBech32(schedule.GetWalletAddress())
+	if err != nil {
+		panic(err)
+	}
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalLengthPrefixed(&schedule)
+	store.Set(types.GetVestingScheduleKey(walletAddr), bz)
+}
+
+func (k Keeper) RemoveVestingSchedule(ctx sdk.Context, walletAddr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetVestingScheduleKey(walletAddr))
+}
+
+func (k Keeper) IterateVestingSchedules(ctx sdk.Context, handler func(schedule types.VestingSchedule) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.VestingScheduleKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var schedule types.VestingSchedule
+		k.cdc.MustUnmarshalLengthPrefixed(iter.Value(), &schedule)
+		if handler(schedule) {
+			break
+		}
+	}
+}
+
+// getVestedCoins returns the part of total vested elapsedEpochs epochs after the start of a vesting over vestingEpochs
+func getVestedCoins(total sdk.Coins, elapsedEpochs int64, vestingEpochs int64) sdk.Coins {
+	if elapsedEpochs >= vestingEpochs {
+		return total
+	}
+	vested := sdk.Coins{}
+	if elapsedEpochs <= 0 {
+		return vested
+	}
+	for _, coin := range total {
+		vested = vested.Add(sdk.NewCoin(coin.Denom, coin.Amount.MulRaw(elapsedEpochs).QuoRaw(vestingEpochs)))
+	}
+	return vested
+}
+
+// releaseVestingSchedule matures the part of the schedule vested at epoch and not released yet, and returns the
+// updated schedule with the slashing deducted from the released part
+func (k Keeper) releaseVestingSchedule(ctx sdk.Context, schedule types.VestingSchedule, epoch sdkmath.Int,
+) (types.VestingSchedule, sdk.Coins) {
+
+	walletAddr, err := sdk.AccAddressFromBech32(schedule.GetWalletAddress())
+	if err != nil {
+		panic(err)
+	}
+	elapsedEpochs := epoch.Int64() - schedule.GetStartEpoch()
+	vestedFromMiningPool := getVestedCoins(schedule.TotalFromMiningPool, elapsedEpochs, schedule.GetVestingEpochs())
+	vestedFromTrafficPool := getVestedCoins(schedule.TotalFromTrafficPool, elapsedEpochs, schedule.GetVestingEpochs())
+
+	released := types.NewReward(
+		walletAddr,
+		vestedFromMiningPool.Sub(schedule.ReleasedFromMiningPool...),
+		vestedFromTrafficPool.Sub(schedule.ReleasedFromTrafficPool...),
+	)
+	if released.RewardFromMiningPool.IsZero() && released.RewardFromTrafficPool.IsZero() {
+		return schedule, sdk.Coins{}
+	}
+
+	schedule.ReleasedFromMiningPool = vestedFromMiningPool
+	schedule.ReleasedFromTrafficPool = vestedFromTrafficPool
+	return schedule, k.matureIndividualReward(ctx, walletAddr, epoch, released)
+}
+
+// addToVestingSchedule starts the linear vesting of an individual reward reaching its mature epoch. The part of the
+// vesting schedule of the wallet not released at epoch vests again over vesting_epochs epochs with the reward.
+func (k Keeper) addToVestingSchedule(ctx sdk.Context, walletAddr sdk.AccAddress, reward types.Reward, epoch sdkmath.Int,
+) (deducted sdk.Coins) {
+
+	totalFromMiningPool := reward.RewardFromMiningPool
+	totalFromTrafficPool := reward.RewardFromTrafficPool
+
+	schedule, found := k.GetVestingSchedule(ctx, walletAddr)
+	if found {
+		schedule, deducted = k.releaseVestingSchedule(ctx, schedule, epoch)
+		totalFromMiningPool = totalFromMiningPool.Add(schedule.TotalFromMiningPool.Sub(schedule.ReleasedFromMiningPool...)...)
+		totalFromTrafficPool = totalFromTrafficPool.Add(schedule.TotalFromTrafficPool.Sub(schedule.ReleasedFromTrafficPool...)...)
+	}
+
+	k.SetVestingSchedule(ctx, types.VestingSchedule{
+		WalletAddress:           walletAddr.String(),
+		TotalFromMiningPool:     totalFromMiningPool,
+		TotalFromTrafficPool:    totalFromTrafficPool,
+		ReleasedFromMiningPool:  sdk.Coins{},
+		ReleasedFromTrafficPool: sdk.Coins{},
+		StartEpoch:              epoch.Int64(),
+		VestingEpochs:           k.VestingEpochs(ctx),
+	})
+	return deducted
+}
+
+// releaseVestingSchedules matures the parts of all the vesting schedules vested at epoch, and removes the schedules
+// released in full
+func (k Keeper) releaseVestingSchedules(ctx sdk.Context, epoch sdkmath.Int) (totalDeducted sdk.Coins) {
+	schedules := make([]types.VestingSchedule, 0)
+	k.IterateVestingSchedules(ctx, func(schedule types.VestingSchedule) (stop bool) {
+		schedules = append(schedules, schedule)
+		return false
+	})
+
+	totalDeducted = sdk.Coins{}
+	for _, schedule := range schedules {
+		released, deducted := k.releaseVestingSchedule(ctx, schedule, epoch)
+		if released.ReleasedFromMiningPool.IsEqual(schedule.ReleasedFromMiningPool) &&
+			released.ReleasedFromTrafficPool.IsEqual(schedule.ReleasedFromTrafficPool) {
+			continue
+		}
+		totalDeducted = totalDeducted.Add(deducted...)
+
+		if released.ReleasedFromMiningPool.IsEqual(released.TotalFromMiningPool) &&
+			released.ReleasedFromTrafficPool.IsEqual(released.TotalFromTrafficPool) {
+			walletAddr, _ := sdk.AccAddressFromBech32(released.GetWalletAddress())
+			k.RemoveVestingSchedule(ctx, walletAddr)
+			continue
+		}
+		k.SetVestingSchedule(ctx, released)
+	}
+	return totalDeducted
+}
@@ -0,0 +1,187 @@
+package keeper
+
+import (
+	"bytes"
+	"strconv"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/stratosnet/stratos-chain/crypto"
+	"github.com/stratosnet/stratos-chain/crypto/bls"
+	stratos "github.com/stratosnet/stratos-chain/types"
+	"github.com/stratosnet/stratos-chain/x/pot/types"
+)
+
+func (k Keeper) GetPendingVolumeReport(ctx sdk.Context) (report types.PendingVolumeReport, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.PendingVolumeReportKey)
+	if bz == nil {
+		return report, false
+	}
+	k.cdc.MustUnmarshalLengthPrefixed(bz, &report)
+	return report, true
+}
+
+func (k Keeper) SetPendingVolumeReport(ctx sdk.Context, report types.PendingVolumeReport) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalLengthPrefixed(&report)
+	store.Set(types.PendingVolumeReportKey, bz)
+}
+
+// RemovePendingVolumeReport removes the pending report together with the wallet volumes received for it
+func (k Keeper) RemovePendingVolumeReport(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.PendingVolumeReportKey)
+
+	iter := sdk.KVStorePrefixIterator(store, types.PendingWalletVolumeKeyPrefix)
+	keys := make([][]byte, 0)
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+func (k Keeper) SetPendingWalletVolume(ctx sdk.Context, index uint64, volume types.SingleWalletVolume) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalLengthPrefixed(&volume)
+	store.Set(types.GetPendingWalletVolumeKey(index), bz)
+}
+
+// GetPendingWalletVolumes returns the wallet volumes received for the pending report, in report order
+func (k Keeper) GetPendingWalletVolumes(ctx sdk.Context) (volumes []types.SingleWalletVolume) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.PendingWalletVolumeKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var volume types.SingleWalletVolume
+		k.cdc.MustUnmarshalLengthPrefixed(iter.Value(), &volume)
+		volumes = append(volumes, volume)
+	}
+	return volumes
+}
+
+// verifyVolumeReportSignature verifies the BLS signature of the meta nodes over the sign bytes of a volume report
+func (k Keeper) verifyVolumeReportSignature(ctx sdk.Context, signBytes []byte, blsSignature types.BLSSignatureInfo) error {
+	err := verifyBLSSignature(signBytes, blsSignature)
+	if err != nil {
+		return err
+	}
+
+	if !k.HasReachedThreshold(ctx, blsSignature.GetPubKeys()) {
+		return types.ErrBLSNotReachThreshold
+	}
+	return nil
+}
+
+func verifyBLSSignature(signBytes []byte, blsSignature types.BLSSignatureInfo) error {
+	// verify txDataHash
+	txDataHash := crypto.Keccak256(signBytes)
+	if !bytes.Equal(txDataHash, blsSignature.GetTxData()) {
+		return types.ErrBLSTxDataInvalid
+	}
+
+	// verify blsSignature
+	verified, err := bls.Verify(blsSignature.GetTxData(), blsSignature.GetSignature(), blsSignature.GetPubKeys()...)
+	if err != nil {
+		return errors.Wrap(types.ErrBLSVerifyFailed, err.Error())
+	}
+	if !verified {
+		return types.ErrBLSVerifyFailed
+	}
+	return nil
+}
+
+// VolumeReportHeader starts a chunked volume report, the rewards are distributed once all the wallet volumes
+// committed by the header are received or the report is dropped at its deadline height
+func (k Keeper) VolumeReportHeader(ctx sdk.Context, msg *types.MsgVolumeReportHeader, txHash string) (deadlineHeight int64, err error) {
+	if _, found := k.GetPendingVolumeReport(ctx); found {
+		return 0, types.ErrVolumeReportPending
+	}
+
+	deadlineHeight = ctx.BlockHeight() + k.GetParams(ctx).VolumeReportChunkTimeout
+	k.SetPendingVolumeReport(ctx, types.PendingVolumeReport{
+		Reporter:        msg.GetReporter(),
+		Epoch:           msg.Epoch,
+		ReportReference: msg.GetReportReference(),
+		TotalCount:      msg.GetTotalCount(),
+		VolumesRoot:     msg.GetVolumesRoot(),
+		ReceivedCount:   0,
+		DeadlineHeight:  deadlineHeight,
+		TxHash:          txHash,
+	})
+	return deadlineHeight, nil
+}
+
+// VolumeReportChunk records a chunk of the pending volume report after verifying the inclusion proof of each
+// wallet volume. The chunks must be submitted in report order, the last one distributes the rewards of the epoch.
+func (k Keeper) VolumeReportChunk(ctx sdk.Context, msg *types.MsgVolumeReportChunk) (report types.PendingVolumeReport, finalized bool, err error) {
+	report, found := k.GetPendingVolumeReport(ctx)
+	if !found || !report.Epoch.Equal(msg.Epoch) {
+		return report, false, errors.Wrapf(types.ErrNoPendingVolumeReport, "epoch %s", msg.Epoch.String())
+	}
+
+	count := uint64(len(msg.GetWalletVolumes()))
+	if msg.GetStartIndex() != report.GetReceivedCount() || msg.GetStartIndex()+count > report.GetTotalCount() {
+		return report, false, errors.Wrapf(types.ErrInvalidVolumeChunk, "expected chunk starting at %d within %d wallet volumes",
+			report.GetReceivedCount(), report.GetTotalCount())
+	}
+
+	if len(msg.GetProofs()) != len(msg.GetWalletVolumes()) {
+		return report, false, errors.Wrapf(types.ErrInvalidVolumeChunk, "got %d proofs for %d wallet volumes",
+			len(msg.GetProofs()), len(msg.GetWalletVolumes()))
+	}
+	// the whole chunk is verified before any wallet volume is recorded
+	for i, volume := range msg.GetWalletVolumes() {
+		index := msg.GetStartIndex() + uint64(i)
+		if !types.VerifyWalletVolumeProof(report.GetVolumesRoot(), report.GetTotalCount(), index, volume, msg.GetProofs()[i]) {
+			return report, false, errors.Wrapf(types.ErrInvalidVolumeProof, "wallet volume %d", index)
+		}
+	}
+	for i, volume := range msg.GetWalletVolumes() {
+		k.SetPendingWalletVolume(ctx, msg.GetStartIndex()+uint64(i), volume)
+	}
+
+	report.ReceivedCount += count
+	if report.GetReceivedCount() < report.GetTotalCount() {
+		k.SetPendingVolumeReport(ctx, report)
+		return report, false, nil
+	}
+
+	walletVolumes := types.WalletVolumes{Volumes: k.GetPendingWalletVolumes(ctx)}
+	k.RemovePendingVolumeReport(ctx)
+
+	reporter, err := stratos.SdsAddressFromBech32(report.GetReporter())
+	if err != nil {
+		return report, false, errors.Wrap(types.ErrReporterAddress, err.Error())
+	}
+	err = k.VolumeReport(ctx, walletVolumes, reporter, report.Epoch, report.GetReportReference(), report.GetTxHash())
+	if err != nil {
+		return report, false, err
+	}
+	return report, true, nil
+}
+
+// ExpirePendingVolumeReport drops the pending volume report whose chunks are not all received at its deadline height
+func (k Keeper) ExpirePendingVolumeReport(ctx sdk.Context) {
+	report, found := k.GetPendingVolumeReport(ctx)
+	if !found || ctx.BlockHeight() < report.GetDeadlineHeight() {
+		return
+	}
+
+	k.RemovePendingVolumeReport(ctx)
+
+	err := ctx.EventManager().EmitTypedEvent(&types.EventVolumeReportExpired{
+		ReportReference: report.GetReportReference(),
+		Epoch:           report.Epoch.String(),
+		ReceivedCount:   strconv.FormatUint(report.GetReceivedCount(), 10),
+		TotalCount:      strconv.FormatUint(report.GetTotalCount(), 10),
+	})
+	if err != nil {
+		k.Logger(ctx).Error("failed to emit event", "ErrMsg", err.Error())
+	}
+}
@@ -0,0 +1,191 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/stratosnet/stratos-chain/x/pot/keeper"
+	"github.com/stratosnet/stratos-chain/x/pot/types"
+)
+
+const (
+	reportReference = "report-reference"
+	reportTxHash    = "report-tx-hash"
+)
+
+var reportEpoch = sdkmath.NewInt(1)
+
+// startChunkedReport submits the header of a chunked volume report committing to the wallet volumes
+func startChunkedReport(t *testing.T, k keeper.Keeper, ctx sdk.Context, volumes []types.SingleWalletVolume) int64 {
+	header := types.NewMsgVolumeReportHeader(metaNodeP2PAddr(0), reportEpoch, reportReference, metaOwner(0),
+		uint64(len(volumes)), types.GetWalletVolumesRoot(volumes))
+	deadlineHeight, err := k.VolumeReportHeader(ctx, header, reportTxHash)
+	require.NoError(t, err)
+	return deadlineHeight
+}
+
+// newChunk returns the chunk of the wallet volumes in [start, end) with their inclusion proofs
+func newChunk(volumes []types.SingleWalletVolume, start, end uint64) *types.MsgVolumeReportChunk {
+	proofs := make([]types.VolumeInclusionProof, 0, end-start)
+	for i := start; i < end; i++ {
+		proofs = append(proofs, types.GetWalletVolumeProof(volumes, i))
+	}
+	return types.NewMsgVolumeReportChunk(metaNodeP2PAddr(0), metaOwner(0), reportEpoch, start, volumes[start:end], proofs)
+}
+
+func TestVolumeReportChunkInclusionProof(t *testing.T) {
+	stApp, ctx := setupApp(t)
+	k := stApp.GetPotKeeper()
+	volumes := walletVolumes()
+	startChunkedReport(t, k, ctx, volumes)
+
+	// a volume not committed by the header
+	tampered := newChunk(volumes, 0, 2)
+	tampered.WalletVolumes = []types.SingleWalletVolume{volumes[0], volumes[1]}
+	tampered.WalletVolumes[1].Volume = tampered.WalletVolumes[1].Volume.AddRaw(1)
+	_, _, err := k.VolumeReportChunk(ctx, tampered)
+	require.ErrorIs(t, err, types.ErrInvalidVolumeProof)
+
+	// the proof of another index
+	swapped := newChunk(volumes, 0, 2)
+	swapped.Proofs[0], swapped.Proofs[1] = swapped.Proofs[1], swapped.Proofs[0]
+	_, _, err = k.VolumeReportChunk(ctx, swapped)
+	require.ErrorIs(t, err, types.ErrInvalidVolumeProof)
+
+	// a rejected chunk records nothing
+	report, found := k.GetPendingVolumeReport(ctx)
+	require.True(t, found)
+	require.Zero(t, report.GetReceivedCount())
+	require.Empty(t, k.GetPendingWalletVolumes(ctx))
+
+	report, finalized, err := k.VolumeReportChunk(ctx, newChunk(volumes, 0, 2))
+	require.NoError(t, err)
+	require.False(t, finalized)
+	require.Equal(t, uint64(2), report.GetReceivedCount())
+	require.Equal(t, volumes[:2], k.GetPendingWalletVolumes(ctx))
+}
+
+func TestVolumeReportChunkOrdering(t *testing.T) {
+	stApp, ctx := setupApp(t)
+	k := stApp.GetPotKeeper()
+	volumes := walletVolumes()
+	startChunkedReport(t, k, ctx, volumes)
+
+	// a single report is pending at a time
+	_, err := k.VolumeReportHeader(ctx, types.NewMsgVolumeReportHeader(metaNodeP2PAddr(1), reportEpoch.AddRaw(1),
+		reportReference, metaOwner(1), uint64(len(volumes)), types.GetWalletVolumesRoot(volumes)), reportTxHash)
+	require.ErrorIs(t, err, types.ErrVolumeReportPending)
+
+	// the chunk of another epoch
+	otherEpoch := newChunk(volumes, 0, 2)
+	otherEpoch.Epoch = reportEpoch.AddRaw(1)
+	_, _, err = k.VolumeReportChunk(ctx, otherEpoch)
+	require.ErrorIs(t, err, types.ErrNoPendingVolumeReport)
+
+	// a chunk out of order
+	_, _, err = k.VolumeReportChunk(ctx, newChunk(volumes, 2, 4))
+	require.ErrorIs(t, err, types.ErrInvalidVolumeChunk)
+
+	_, _, err = k.VolumeReportChunk(ctx, newChunk(volumes, 0, 2))
+	require.NoError(t, err)
+
+	// a duplicated chunk
+	_, _, err = k.VolumeReportChunk(ctx, newChunk(volumes, 0, 2))
+	require.ErrorIs(t, err, types.ErrInvalidVolumeChunk)
+
+	// a chunk beyond the wallet volumes committed by the header
+	overrun := newChunk(volumes, 2, 5)
+	overrun.WalletVolumes = append(overrun.WalletVolumes, volumes[0])
+	overrun.Proofs = append(overrun.Proofs, types.GetWalletVolumeProof(volumes, 0))
+	_, _, err = k.VolumeReportChunk(ctx, overrun)
+	require.ErrorIs(t, err, types.ErrInvalidVolumeChunk)
+
+	report, found := k.GetPendingVolumeReport(ctx)
+	require.True(t, found)
+	require.Equal(t, uint64(2), report.GetReceivedCount())
+}
+
+func TestExpirePendingVolumeReport(t *testing.T) {
+	stApp, ctx := setupApp(t)
+	k := stApp.GetPotKeeper()
+	volumes := walletVolumes()
+	deadlineHeight := startChunkedReport(t, k, ctx, volumes)
+	require.Equal(t, ctx.BlockHeight()+k.GetParams(ctx).VolumeReportChunkTimeout, deadlineHeight)
+
+	_, _, err := k.VolumeReportChunk(ctx, newChunk(volumes, 0, 2))
+	require.NoError(t, err)
+
+	// the report is kept until its deadline height
+	ctx = ctx.WithBlockHeight(deadlineHeight - 1)
+	k.ExpirePendingVolumeReport(ctx)
+	_, found := k.GetPendingVolumeReport(ctx)
+	require.True(t, found)
+
+	ctx = ctx.WithBlockHeight(deadlineHeight).WithEventManager(sdk.NewEventManager())
+	k.ExpirePendingVolumeReport(ctx)
+	_, found = k.GetPendingVolumeReport(ctx)
+	require.False(t, found)
+	require.Empty(t, k.GetPendingWalletVolumes(ctx))
+
+	expired := false
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == proto.MessageName(&types.EventVolumeReportExpired{}) {
+			expired = true
+		}
+	}
+	require.True(t, expired)
+
+	// the late chunks are rejected and nothing is distributed for the epoch
+	_, _, err = k.VolumeReportChunk(ctx, newChunk(volumes, 2, 5))
+	require.ErrorIs(t, err, types.ErrNoPendingVolumeReport)
+	_, found = k.GetPendingDistribution(ctx, reportEpoch)
+	require.False(t, found)
+
+	// the epoch can be reported again
+	startChunkedReport(t, k, ctx, volumes)
+}
+
+func TestVolumeReportChunkFinalization(t *testing.T) {
+	stApp, ctx := setupApp(t)
+	k := stApp.GetPotKeeper()
+	volumes := walletVolumes()
+	startChunkedReport(t, k, ctx, volumes)
+
+	_, finalized, err := k.VolumeReportChunk(ctx, newChunk(volumes, 0, 2))
+	require.NoError(t, err)
+	require.False(t, finalized)
+	_, finalized, err = k.VolumeReportChunk(ctx, newChunk(volumes, 2, 4))
+	require.NoError(t, err)
+	require.False(t, finalized)
+	_, found := k.GetPendingDistribution(ctx, reportEpoch)
+	require.False(t, found)
+
+	report, finalized, err := k.VolumeReportChunk(ctx, newChunk(volumes, 4, 5))
+	require.NoError(t, err)
+	require.True(t, finalized)
+	require.Equal(t, uint64(len(volumes)), report.GetReceivedCount())
+
+	// the pending report is cleared
+	_, found = k.GetPendingVolumeReport(ctx)
+	require.False(t, found)
+	require.Empty(t, k.GetPendingWalletVolumes(ctx))
+
+	// the report is recorded and its wallet volumes are queued for distribution
+	record := k.GetVolumeReport(ctx, reportEpoch)
+	require.Equal(t, metaNodeP2PAddr(0).String(), record.Reporter)
+	require.Equal(t, reportReference, record.ReportReference)
+	require.Equal(t, reportTxHash, record.TxHash)
+
+	distribution, found := k.GetPendingDistribution(ctx, reportEpoch)
+	require.True(t, found)
+	require.Equal(t, uint64(len(volumes)), distribution.GetTotalCount())
+	require.True(t, k.GetTotalConsumedNoz(volumes).Equal(distribution.TotalConsumedNoz))
+
+	queued, _ := k.GetDistributionVolumes(ctx, reportEpoch, uint64(len(volumes)))
+	require.Equal(t, volumes, queued)
+}
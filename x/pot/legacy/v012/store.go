@@ -0,0 +1,128 @@
+package v012
+
+import (
+	"bytes"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stratosnet/stratos-chain/x/pot/types"
+)
+
+// MigrateStore migrates from version 2 to 3. The params added after v0.11 are not part of the params moved out of the
+// legacy subspace by v011, they are set to their defaults by the migration steps of this package.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.Codec) error {
+	store := ctx.KVStore(storeKey)
+
+	// migrate params
+	migrateVolumeReportChunkParams(store, cdc)
+
+	return nil
+}
+
+// MigrateDistributeBatchParams will set the distribute batch size to its default value and keep the existing params
+func MigrateDistributeBatchParams(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.Codec) error {
+	store := ctx.KVStore(storeKey)
+	params := getParams(store, cdc)
+
+	params.DistributeBatchSize = types.DefaultParams().DistributeBatchSize
+
+	setParams(store, cdc, params)
+	return nil
+}
+
+// MigrateDisputeParams will set the dispute window to its default value and keep the existing params
+func MigrateDisputeParams(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.Codec) error {
+	store := ctx.KVStore(storeKey)
+	params := getParams(store, cdc)
+
+	params.DisputeWindow = types.DefaultParams().DisputeWindow
+
+	setParams(store, cdc, params)
+	return nil
+}
+
+// MigrateEquivocationParams will set the equivocation slash fraction to its default value and keep the existing params
+func MigrateEquivocationParams(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.Codec) error {
+	store := ctx.KVStore(storeKey)
+	params := getParams(store, cdc)
+
+	params.EquivocationSlashFraction = types.DefaultParams().EquivocationSlashFraction
+
+	setParams(store, cdc, params)
+	return nil
+}
+
+// MigrateVestingParams will set the vesting params to their default values and keep the existing params, and index
+// the individual rewards by wallet for the vesting schedule query
+func MigrateVestingParams(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.Codec) error {
+	store := ctx.KVStore(storeKey)
+	params := getParams(store, cdc)
+
+	defaultParams := types.DefaultParams()
+	params.VestingMode = defaultParams.VestingMode
+	params.VestingEpochs = defaultParams.VestingEpochs
+
+	setParams(store, cdc, params)
+
+	// index the individual rewards by wallet
+	migrateIndividualRewardIndex(store)
+	return nil
+}
+
+// MigrateRewardHistoryParams will set the reward history retention to its default value and keep the existing params
+func MigrateRewardHistoryParams(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.Codec) error {
+	store := ctx.KVStore(storeKey)
+	params := getParams(store, cdc)
+
+	params.RewardHistoryRetention = types.DefaultParams().RewardHistoryRetention
+
+	setParams(store, cdc, params)
+	return nil
+}
+
+// migrateVolumeReportChunkParams will set the volume report chunk timeout to its default value and keep the existing params
+func migrateVolumeReportChunkParams(store storetypes.KVStore, cdc codec.Codec) {
+	params := getParams(store, cdc)
+
+	params.VolumeReportChunkTimeout = types.DefaultParams().VolumeReportChunkTimeout
+
+	setParams(store, cdc, params)
+}
+
+func getParams(store storetypes.KVStore, cdc codec.Codec) (params types.Params) {
+	if bz := store.Get(types.ParamsKey); bz != nil {
+		cdc.MustUnmarshal(bz, &params)
+	}
+	return params
+}
+
+func setParams(store storetypes.KVStore, cdc codec.Codec, params types.Params) {
+	bz := cdc.MustMarshal(&params)
+	store.Set(types.ParamsKey, bz)
+}
+
+// migrateIndividualRewardIndex adds the wallet index entry of every individual reward stored before it was introduced
+func migrateIndividualRewardIndex(store storetypes.KVStore) {
+	iter := sdk.KVStorePrefixIterator(store, types.IndividualRewardKeyPrefix)
+	defer iter.Close()
+
+	indexKeys := make([][]byte, 0)
+	for ; iter.Valid(); iter.Next() {
+		// key: prefix{epoch}_{account}
+		epochAndAddr := iter.Key()[len(types.IndividualRewardKeyPrefix):]
+		sep := bytes.IndexByte(epochAndAddr, '_')
+		if sep < 0 {
+			continue
+		}
+		matureEpoch, ok := sdkmath.NewIntFromString(string(epochAndAddr[:sep]))
+		if !ok {
+			continue
+		}
+		indexKeys = append(indexKeys, types.GetIndividualRewardByWalletKey(epochAndAddr[sep+1:], matureEpoch))
+	}
+	for _, key := range indexKeys {
+		store.Set(key, []byte{})
+	}
+}
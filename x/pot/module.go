@@ -12,6 +12,7 @@ import (
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/spf13/cobra"
@@ -34,7 +35,7 @@ import (
 )
 
 const (
-	consensusVersion = 2
+	consensusVersion = 8
 )
 
 // Type check to ensure the interface is properly implemented
@@ -141,6 +142,24 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	if err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
 		panic(fmt.Sprintf("failed to migrate x/%s from version 1 to 2: %v", types.ModuleName, err))
 	}
+	if err := cfg.RegisterMigration(types.ModuleName, 2, m.Migrate2to3); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 2 to 3: %v", types.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 3, m.Migrate3to4); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 3 to 4: %v", types.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 4, m.Migrate4to5); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 4 to 5: %v", types.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 5, m.Migrate5to6); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 5 to 6: %v", types.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 6, m.Migrate6to7); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 6 to 7: %v", types.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 7, m.Migrate7to8); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 7 to 8: %v", types.ModuleName, err))
+	}
 }
 
 // RegisterInvariants registers the pot module invariants.
@@ -233,6 +252,7 @@ type ModuleInputs struct {
 	DistrKeeper    distrkeeper.Keeper
 	RegisterKeeper registerkeeper.Keeper
 	StakingKeeper  *stakingkeeper.Keeper
+	GovKeeper      *govkeeper.Keeper
 
 	// LegacySubspace is used solely for migration of x/params managed parameters
 	LegacySubspace types.ParamsSubspace `optional:"true"`
@@ -243,6 +263,7 @@ type ModuleOutputs struct {
 
 	PotKeeper keeper.Keeper
 	Module    appmodule.AppModule
+	GovHooks  govtypes.GovHooksWrapper
 }
 
 func ProvideModule(in ModuleInputs) ModuleOutputs {
@@ -260,6 +281,7 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		in.DistrKeeper,
 		in.RegisterKeeper,
 		in.StakingKeeper,
+		in.GovKeeper,
 		authority.String(),
 	)
 
@@ -274,5 +296,5 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		in.LegacySubspace,
 	)
 
-	return ModuleOutputs{PotKeeper: k, Module: m}
+	return ModuleOutputs{PotKeeper: k, Module: m, GovHooks: govtypes.GovHooksWrapper{GovHooks: k.Hooks()}}
 }
@@ -16,20 +16,32 @@ import (
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	// this line is used by starport scaffolding # 1
 	cdc.RegisterConcrete(MsgVolumeReport{}, "pot/VolumeReportTx", nil)
+	cdc.RegisterConcrete(MsgVolumeReportHeader{}, "pot/VolumeReportHeaderTx", nil)
+	cdc.RegisterConcrete(MsgVolumeReportChunk{}, "pot/VolumeReportChunkTx", nil)
 	cdc.RegisterConcrete(MsgWithdraw{}, "pot/WithdrawTx", nil)
 	cdc.RegisterConcrete(MsgFoundationDeposit{}, "pot/FoundationDepositTx", nil)
 	cdc.RegisterConcrete(MsgSlashingResourceNode{}, "pot/SlashingResourceNodeTx", nil)
+	cdc.RegisterConcrete(MsgDisputeVolumeReport{}, "pot/DisputeVolumeReportTx", nil)
+	cdc.RegisterConcrete(MsgSubmitReportEquivocation{}, "pot/SubmitReportEquivocationTx", nil)
+	cdc.RegisterConcrete(MsgSetAutoRestake{}, "pot/SetAutoRestakeTx", nil)
 	cdc.RegisterConcrete(MsgUpdateParams{}, "pot/UpdateParamsTx", nil)
+	cdc.RegisterConcrete(MsgResolveVolumeReportDispute{}, "pot/ResolveVolumeReportDisputeTx", nil)
 }
 
 // RegisterInterfaces registers the x/register interfaces types with the interface registry
 func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgVolumeReport{},
+		&MsgVolumeReportHeader{},
+		&MsgVolumeReportChunk{},
 		&MsgWithdraw{},
 		&MsgFoundationDeposit{},
 		&MsgSlashingResourceNode{},
+		&MsgDisputeVolumeReport{},
+		&MsgSubmitReportEquivocation{},
+		&MsgSetAutoRestake{},
 		&MsgUpdateParams{},
+		&MsgResolveVolumeReportDispute{},
 	)
 	registry.RegisterImplementations(
 		(*authz.Authorization)(nil),
@@ -40,6 +40,20 @@ const (
 	codeErrTotalSupplyCapHit
 	codeErrInsufficientCommunityPool
 	codeErrEmitEvent
+	codeErrVolumeReportPending
+	codeErrNoPendingVolumeReport
+	codeErrInvalidVolumesRoot
+	codeErrInvalidVolumeChunk
+	codeErrInvalidVolumeProof
+	codeErrEmptyDisputeReason
+	codeErrNoPendingDistribution
+	codeErrDisputeWindowClosed
+	codeErrVolumeReportDisputed
+	codeErrVolumeReportNotDisputed
+	codeErrInvalidEquivocation
+	codeErrEquivocationReported
+	codeErrAutoRestakeNode
+	codeErrDisputeUnchangedReport
 )
 
 var (
@@ -78,4 +92,18 @@ var (
 	ErrTotalSupplyCapHit              = errors.Register(ModuleName, codeErrTotalSupplyCapHit, "minting not completed because total supply cap is hit")
 	ErrInsufficientCommunityPool      = errors.Register(ModuleName, codeErrInsufficientCommunityPool, "burning not completed as a result of insufficient balance in community pool")
 	ErrEmitEvent                      = errors.Register(ModuleName, codeErrEmitEvent, "failed to emit event")
+	ErrVolumeReportPending            = errors.Register(ModuleName, codeErrVolumeReportPending, "a chunked volume report is pending")
+	ErrNoPendingVolumeReport          = errors.Register(ModuleName, codeErrNoPendingVolumeReport, "no pending chunked volume report")
+	ErrInvalidVolumesRoot             = errors.Register(ModuleName, codeErrInvalidVolumesRoot, "invalid wallet volumes merkle root")
+	ErrInvalidVolumeChunk             = errors.Register(ModuleName, codeErrInvalidVolumeChunk, "invalid volume report chunk")
+	ErrInvalidVolumeProof             = errors.Register(ModuleName, codeErrInvalidVolumeProof, "invalid wallet volume inclusion proof")
+	ErrEmptyDisputeReason             = errors.Register(ModuleName, codeErrEmptyDisputeReason, "missing dispute reason")
+	ErrNoPendingDistribution          = errors.Register(ModuleName, codeErrNoPendingDistribution, "no volume report pending distribution for the epoch")
+	ErrDisputeWindowClosed            = errors.Register(ModuleName, codeErrDisputeWindowClosed, "the dispute window of the volume report is closed")
+	ErrVolumeReportDisputed           = errors.Register(ModuleName, codeErrVolumeReportDisputed, "the volume report is already disputed")
+	ErrVolumeReportNotDisputed        = errors.Register(ModuleName, codeErrVolumeReportNotDisputed, "the volume report is not disputed")
+	ErrInvalidEquivocation            = errors.Register(ModuleName, codeErrInvalidEquivocation, "invalid volume report equivocation evidence")
+	ErrEquivocationReported           = errors.Register(ModuleName, codeErrEquivocationReported, "the equivocation of the meta node is already reported for the epoch")
+	ErrAutoRestakeNode                = errors.Register(ModuleName, codeErrAutoRestakeNode, "the auto-restake node is not owned by the wallet or cannot accept deposit")
+	ErrDisputeUnchangedReport         = errors.Register(ModuleName, codeErrDisputeUnchangedReport, "the counter-report does not change the disputed volume report")
 )
@@ -258,308 +258,2776 @@ func (m *EventSlashing) GetSuspend() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*EventVolumeReport)(nil), "stratos.pot.v1.EventVolumeReport")
-	proto.RegisterType((*EventWithdraw)(nil), "stratos.pot.v1.EventWithdraw")
-	proto.RegisterType((*EventFoundationDeposit)(nil), "stratos.pot.v1.EventFoundationDeposit")
-	proto.RegisterType((*EventSlashing)(nil), "stratos.pot.v1.EventSlashing")
+// EventVolumeReportHeader is emitted on Msg/MsgVolumeReportHeader
+type EventVolumeReportHeader struct {
+	ReportReference string `protobuf:"bytes,1,opt,name=report_reference,json=reportReference,proto3" json:"report_reference,omitempty"`
+	Epoch           string `protobuf:"bytes,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	TotalCount      string `protobuf:"bytes,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	DeadlineHeight  string `protobuf:"bytes,4,opt,name=deadline_height,json=deadlineHeight,proto3" json:"deadline_height,omitempty"`
 }
 
-func init() { proto.RegisterFile("stratos/pot/v1/event.proto", fileDescriptor_4848852d1aafb62a) }
-
-var fileDescriptor_4848852d1aafb62a = []byte{
-	// 350 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xcd, 0x4a, 0xc3, 0x40,
-	0x14, 0x85, 0x3b, 0xd6, 0x56, 0x1c, 0x6c, 0xab, 0x41, 0x4a, 0x70, 0x11, 0x24, 0x22, 0xea, 0xc2,
-	0xc4, 0xe2, 0x13, 0x28, 0x2a, 0xb8, 0xad, 0x45, 0xc1, 0x4d, 0x99, 0x26, 0xd7, 0x26, 0x98, 0xce,
-	0x0c, 0x33, 0x37, 0xad, 0x7d, 0x0b, 0x9f, 0xc6, 0x67, 0x70, 0xd9, 0xa5, 0x4b, 0x69, 0x5f, 0x44,
-	0x3a, 0x99, 0xe0, 0x0f, 0x75, 0x37, 0xf7, 0x3b, 0x87, 0x7b, 0x4e, 0xc8, 0xa5, 0x7b, 0x1a, 0x15,
-	0x43, 0xa1, 0x43, 0x29, 0x30, 0x1c, 0x77, 0x42, 0x18, 0x03, 0xc7, 0x40, 0x2a, 0x81, 0xc2, 0x69,
-	0x5a, 0x2d, 0x90, 0x02, 0x83, 0x71, 0xc7, 0xef, 0xd1, 0x9d, 0xeb, 0xa5, 0x7c, 0x2f, 0xb2, 0x7c,
-	0x04, 0x5d, 0x90, 0x42, 0xa1, 0x73, 0x42, 0xb7, 0x95, 0x79, 0xf5, 0x15, 0x3c, 0x81, 0x02, 0x1e,
-	0x81, 0x4b, 0xf6, 0xc9, 0xf1, 0x66, 0xb7, 0x55, 0xf0, 0x6e, 0x89, 0x9d, 0x5d, 0x5a, 0x03, 0x29,
-	0xa2, 0xc4, 0x5d, 0x33, 0x7a, 0x31, 0xf8, 0x39, 0x6d, 0x98, 0xad, 0x0f, 0x29, 0x26, 0xb1, 0x62,
-	0x13, 0xa7, 0x4d, 0xeb, 0x6c, 0x24, 0x72, 0x8e, 0x76, 0x8f, 0x9d, 0x9c, 0x43, 0xda, 0x9c, 0xb0,
-	0x2c, 0x03, 0xec, 0xb3, 0x38, 0x56, 0xa0, 0xb5, 0xdd, 0xd3, 0x28, 0xe8, 0x45, 0x01, 0x97, 0x36,
-	0x64, 0x6a, 0xf8, 0xc3, 0x56, 0x2d, 0x6c, 0x05, 0xb5, 0x36, 0xff, 0x8c, 0xb6, 0x4d, 0xec, 0x8d,
-	0xc8, 0x79, 0xcc, 0x30, 0x15, 0xfc, 0x0a, 0xa4, 0xd0, 0x29, 0xfe, 0x97, 0xef, 0xbf, 0x11, 0xdb,
-	0xf4, 0x2e, 0x63, 0x3a, 0x49, 0xf9, 0x70, 0x45, 0x23, 0xb2, 0xaa, 0xd1, 0x11, 0x6d, 0x71, 0xc0,
-	0x89, 0x50, 0xcf, 0x7f, 0x9a, 0x37, 0x2d, 0x2e, 0x8d, 0xdf, 0xc9, 0xd5, 0x5f, 0x5f, 0x7e, 0x40,
-	0x1b, 0xda, 0x66, 0xf6, 0x71, 0x2a, 0xc1, 0x5d, 0x37, 0xf2, 0x56, 0x09, 0x7b, 0x53, 0x09, 0x8e,
-	0x4b, 0x37, 0x74, 0xae, 0x25, 0xf0, 0xd8, 0xad, 0x19, 0xb9, 0x1c, 0x2f, 0x6f, 0xdf, 0xe7, 0x1e,
-	0x99, 0xcd, 0x3d, 0xf2, 0x39, 0xf7, 0xc8, 0xeb, 0xc2, 0xab, 0xcc, 0x16, 0x5e, 0xe5, 0x63, 0xe1,
-	0x55, 0x1e, 0xc3, 0x61, 0x8a, 0x49, 0x3e, 0x08, 0x22, 0x31, 0x0a, 0xed, 0xcf, 0xe6, 0x80, 0xe5,
-	0xf3, 0x34, 0x4a, 0x58, 0xca, 0xc3, 0x17, 0x73, 0x1b, 0xcb, 0x5c, 0x3d, 0xa8, 0x9b, 0xcb, 0x38,
-	0xff, 0x0a, 0x00, 0x00, 0xff, 0xff, 0xe5, 0x34, 0x7c, 0x3d, 0x37, 0x02, 0x00, 0x00,
+func (m *EventVolumeReportHeader) Reset()         { *m = EventVolumeReportHeader{} }
+func (m *EventVolumeReportHeader) String() string { return proto.CompactTextString(m) }
+func (*EventVolumeReportHeader) ProtoMessage()    {}
+func (*EventVolumeReportHeader) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4848852d1aafb62a, []int{4}
 }
-
-func (m *EventVolumeReport) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventVolumeReportHeader) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventVolumeReportHeader) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventVolumeReportHeader.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *EventVolumeReportHeader) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventVolumeReportHeader.Merge(m, src)
+}
+func (m *EventVolumeReportHeader) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventVolumeReportHeader) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventVolumeReportHeader.DiscardUnknown(m)
 }
 
-func (m *EventVolumeReport) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_EventVolumeReportHeader proto.InternalMessageInfo
+
+func (m *EventVolumeReportHeader) GetReportReference() string {
+	if m != nil {
+		return m.ReportReference
+	}
+	return ""
 }
 
-func (m *EventVolumeReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Epoch) > 0 {
-		i -= len(m.Epoch)
-		copy(dAtA[i:], m.Epoch)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Epoch)))
-		i--
-		dAtA[i] = 0x12
+func (m *EventVolumeReportHeader) GetEpoch() string {
+	if m != nil {
+		return m.Epoch
 	}
-	if len(m.ReportReference) > 0 {
-		i -= len(m.ReportReference)
-		copy(dAtA[i:], m.ReportReference)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.ReportReference)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *EventVolumeReportHeader) GetTotalCount() string {
+	if m != nil {
+		return m.TotalCount
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventWithdraw) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventVolumeReportHeader) GetDeadlineHeight() string {
+	if m != nil {
+		return m.DeadlineHeight
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventWithdraw) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventVolumeReportChunk is emitted on Msg/MsgVolumeReportChunk
+type EventVolumeReportChunk struct {
+	Epoch      string `protobuf:"bytes,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	StartIndex string `protobuf:"bytes,2,opt,name=start_index,json=startIndex,proto3" json:"start_index,omitempty"`
+	Count      string `protobuf:"bytes,3,opt,name=count,proto3" json:"count,omitempty"`
 }
 
-func (m *EventWithdraw) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TargetAddress) > 0 {
-		i -= len(m.TargetAddress)
-		copy(dAtA[i:], m.TargetAddress)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.TargetAddress)))
-		i--
-		dAtA[i] = 0x1a
+func (m *EventVolumeReportChunk) Reset()         { *m = EventVolumeReportChunk{} }
+func (m *EventVolumeReportChunk) String() string { return proto.CompactTextString(m) }
+func (*EventVolumeReportChunk) ProtoMessage()    {}
+func (*EventVolumeReportChunk) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4848852d1aafb62a, []int{5}
+}
+func (m *EventVolumeReportChunk) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventVolumeReportChunk) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventVolumeReportChunk.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.WalletAddress) > 0 {
-		i -= len(m.WalletAddress)
-		copy(dAtA[i:], m.WalletAddress)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.WalletAddress)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *EventVolumeReportChunk) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventVolumeReportChunk.Merge(m, src)
+}
+func (m *EventVolumeReportChunk) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventVolumeReportChunk) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventVolumeReportChunk.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventVolumeReportChunk proto.InternalMessageInfo
+
+func (m *EventVolumeReportChunk) GetEpoch() string {
+	if m != nil {
+		return m.Epoch
 	}
-	if len(m.Amount) > 0 {
-		i -= len(m.Amount)
-		copy(dAtA[i:], m.Amount)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Amount)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *EventVolumeReportChunk) GetStartIndex() string {
+	if m != nil {
+		return m.StartIndex
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventFoundationDeposit) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventVolumeReportChunk) GetCount() string {
+	if m != nil {
+		return m.Count
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventFoundationDeposit) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventVolumeReportExpired is emitted when a chunked volume report is not completed before its deadline
+type EventVolumeReportExpired struct {
+	ReportReference string `protobuf:"bytes,1,opt,name=report_reference,json=reportReference,proto3" json:"report_reference,omitempty"`
+	Epoch           string `protobuf:"bytes,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	ReceivedCount   string `protobuf:"bytes,3,opt,name=received_count,json=receivedCount,proto3" json:"received_count,omitempty"`
+	TotalCount      string `protobuf:"bytes,4,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
 }
 
-func (m *EventFoundationDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Amount) > 0 {
-		i -= len(m.Amount)
-		copy(dAtA[i:], m.Amount)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Amount)))
-		i--
-		dAtA[i] = 0xa
+func (m *EventVolumeReportExpired) Reset()         { *m = EventVolumeReportExpired{} }
+func (m *EventVolumeReportExpired) String() string { return proto.CompactTextString(m) }
+func (*EventVolumeReportExpired) ProtoMessage()    {}
+func (*EventVolumeReportExpired) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4848852d1aafb62a, []int{6}
+}
+func (m *EventVolumeReportExpired) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventVolumeReportExpired) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventVolumeReportExpired.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *EventVolumeReportExpired) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventVolumeReportExpired.Merge(m, src)
+}
+func (m *EventVolumeReportExpired) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventVolumeReportExpired) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventVolumeReportExpired.DiscardUnknown(m)
 }
 
-func (m *EventSlashing) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventVolumeReportExpired proto.InternalMessageInfo
+
+func (m *EventVolumeReportExpired) GetReportReference() string {
+	if m != nil {
+		return m.ReportReference
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventSlashing) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventVolumeReportExpired) GetEpoch() string {
+	if m != nil {
+		return m.Epoch
+	}
+	return ""
 }
 
-func (m *EventSlashing) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Suspend) > 0 {
-		i -= len(m.Suspend)
-		copy(dAtA[i:], m.Suspend)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Suspend)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.SlashingType) > 0 {
-		i -= len(m.SlashingType)
-		copy(dAtA[i:], m.SlashingType)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.SlashingType)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Amount) > 0 {
-		i -= len(m.Amount)
-		copy(dAtA[i:], m.Amount)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Amount)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.NetworkAddress) > 0 {
-		i -= len(m.NetworkAddress)
-		copy(dAtA[i:], m.NetworkAddress)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.NetworkAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.WalletAddress) > 0 {
-		i -= len(m.WalletAddress)
-		copy(dAtA[i:], m.WalletAddress)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.WalletAddress)))
-		i--
-		dAtA[i] = 0xa
+func (m *EventVolumeReportExpired) GetReceivedCount() string {
+	if m != nil {
+		return m.ReceivedCount
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvent(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *EventVolumeReportExpired) GetTotalCount() string {
+	if m != nil {
+		return m.TotalCount
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *EventVolumeReport) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ReportReference)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	l = len(m.Epoch)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	return n
+
+type EventDisputeVolumeReport struct {
+	Epoch      string `protobuf:"bytes,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Disputer   string `protobuf:"bytes,2,opt,name=disputer,proto3" json:"disputer,omitempty"`
+	Reason     string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	ProposalId string `protobuf:"bytes,4,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
 }
 
-func (m *EventWithdraw) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventDisputeVolumeReport) Reset()         { *m = EventDisputeVolumeReport{} }
+func (m *EventDisputeVolumeReport) String() string { return proto.CompactTextString(m) }
+func (*EventDisputeVolumeReport) ProtoMessage()    {}
+func (*EventDisputeVolumeReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4848852d1aafb62a, []int{7}
+}
+func (m *EventDisputeVolumeReport) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventDisputeVolumeReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventDisputeVolumeReport.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.Amount)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+}
+func (m *EventDisputeVolumeReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventDisputeVolumeReport.Merge(m, src)
+}
+func (m *EventDisputeVolumeReport) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventDisputeVolumeReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventDisputeVolumeReport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventDisputeVolumeReport proto.InternalMessageInfo
+
+func (m *EventDisputeVolumeReport) GetEpoch() string {
+	if m != nil {
+		return m.Epoch
 	}
-	l = len(m.WalletAddress)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	return ""
+}
+
+func (m *EventDisputeVolumeReport) GetDisputer() string {
+	if m != nil {
+		return m.Disputer
 	}
-	l = len(m.TargetAddress)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	return ""
+}
+
+func (m *EventDisputeVolumeReport) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	return n
+	return ""
 }
 
-func (m *EventFoundationDeposit) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventDisputeVolumeReport) GetProposalId() string {
+	if m != nil {
+		return m.ProposalId
 	}
-	var l int
-	_ = l
-	l = len(m.Amount)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	return ""
+}
+
+type EventResolveVolumeReportDispute struct {
+	Epoch      string `protobuf:"bytes,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Resolution string `protobuf:"bytes,2,opt,name=resolution,proto3" json:"resolution,omitempty"`
+}
+
+func (m *EventResolveVolumeReportDispute) Reset()         { *m = EventResolveVolumeReportDispute{} }
+func (m *EventResolveVolumeReportDispute) String() string { return proto.CompactTextString(m) }
+func (*EventResolveVolumeReportDispute) ProtoMessage()    {}
+func (*EventResolveVolumeReportDispute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4848852d1aafb62a, []int{8}
+}
+func (m *EventResolveVolumeReportDispute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventResolveVolumeReportDispute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventResolveVolumeReportDispute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *EventResolveVolumeReportDispute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventResolveVolumeReportDispute.Merge(m, src)
+}
+func (m *EventResolveVolumeReportDispute) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventResolveVolumeReportDispute) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventResolveVolumeReportDispute.DiscardUnknown(m)
 }
 
-func (m *EventSlashing) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_EventResolveVolumeReportDispute proto.InternalMessageInfo
+
+func (m *EventResolveVolumeReportDispute) GetEpoch() string {
+	if m != nil {
+		return m.Epoch
 	}
-	var l int
-	_ = l
-	l = len(m.WalletAddress)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	return ""
+}
+
+func (m *EventResolveVolumeReportDispute) GetResolution() string {
+	if m != nil {
+		return m.Resolution
 	}
-	l = len(m.NetworkAddress)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	return ""
+}
+
+type EventReportEquivocation struct {
+	Epoch      string `protobuf:"bytes,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Accused    string `protobuf:"bytes,2,opt,name=accused,proto3" json:"accused,omitempty"`
+	Reporter   string `protobuf:"bytes,3,opt,name=reporter,proto3" json:"reporter,omitempty"`
+	Slashed    string `protobuf:"bytes,4,opt,name=slashed,proto3" json:"slashed,omitempty"`
+	KickStatus string `protobuf:"bytes,5,opt,name=kick_status,json=kickStatus,proto3" json:"kick_status,omitempty"`
+}
+
+func (m *EventReportEquivocation) Reset()         { *m = EventReportEquivocation{} }
+func (m *EventReportEquivocation) String() string { return proto.CompactTextString(m) }
+func (*EventReportEquivocation) ProtoMessage()    {}
+func (*EventReportEquivocation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4848852d1aafb62a, []int{9}
+}
+func (m *EventReportEquivocation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventReportEquivocation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventReportEquivocation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.Amount)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+}
+func (m *EventReportEquivocation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventReportEquivocation.Merge(m, src)
+}
+func (m *EventReportEquivocation) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventReportEquivocation) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventReportEquivocation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventReportEquivocation proto.InternalMessageInfo
+
+func (m *EventReportEquivocation) GetEpoch() string {
+	if m != nil {
+		return m.Epoch
 	}
-	l = len(m.SlashingType)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	return ""
+}
+
+func (m *EventReportEquivocation) GetAccused() string {
+	if m != nil {
+		return m.Accused
 	}
-	l = len(m.Suspend)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	return ""
+}
+
+func (m *EventReportEquivocation) GetReporter() string {
+	if m != nil {
+		return m.Reporter
+	}
+	return ""
+}
+
+func (m *EventReportEquivocation) GetSlashed() string {
+	if m != nil {
+		return m.Slashed
+	}
+	return ""
+}
+
+func (m *EventReportEquivocation) GetKickStatus() string {
+	if m != nil {
+		return m.KickStatus
+	}
+	return ""
+}
+
+type EventRewardDistributed struct {
+	Epoch       string `protobuf:"bytes,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	WalletCount string `protobuf:"bytes,2,opt,name=wallet_count,json=walletCount,proto3" json:"wallet_count,omitempty"`
+}
+
+func (m *EventRewardDistributed) Reset()         { *m = EventRewardDistributed{} }
+func (m *EventRewardDistributed) String() string { return proto.CompactTextString(m) }
+func (*EventRewardDistributed) ProtoMessage()    {}
+func (*EventRewardDistributed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4848852d1aafb62a, []int{10}
+}
+func (m *EventRewardDistributed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRewardDistributed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRewardDistributed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventRewardDistributed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRewardDistributed.Merge(m, src)
+}
+func (m *EventRewardDistributed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRewardDistributed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRewardDistributed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventRewardDistributed proto.InternalMessageInfo
+
+func (m *EventRewardDistributed) GetEpoch() string {
+	if m != nil {
+		return m.Epoch
+	}
+	return ""
+}
+
+func (m *EventRewardDistributed) GetWalletCount() string {
+	if m != nil {
+		return m.WalletCount
+	}
+	return ""
+}
+
+type EventSetAutoRestake struct {
+	WalletAddress  string `protobuf:"bytes,1,opt,name=wallet_address,json=walletAddress,proto3" json:"wallet_address,omitempty"`
+	NetworkAddress string `protobuf:"bytes,2,opt,name=network_address,json=networkAddress,proto3" json:"network_address,omitempty"`
+}
+
+func (m *EventSetAutoRestake) Reset()         { *m = EventSetAutoRestake{} }
+func (m *EventSetAutoRestake) String() string { return proto.CompactTextString(m) }
+func (*EventSetAutoRestake) ProtoMessage()    {}
+func (*EventSetAutoRestake) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4848852d1aafb62a, []int{11}
+}
+func (m *EventSetAutoRestake) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSetAutoRestake) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSetAutoRestake.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventSetAutoRestake) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSetAutoRestake.Merge(m, src)
+}
+func (m *EventSetAutoRestake) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSetAutoRestake) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSetAutoRestake.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSetAutoRestake proto.InternalMessageInfo
+
+func (m *EventSetAutoRestake) GetWalletAddress() string {
+	if m != nil {
+		return m.WalletAddress
+	}
+	return ""
+}
+
+func (m *EventSetAutoRestake) GetNetworkAddress() string {
+	if m != nil {
+		return m.NetworkAddress
+	}
+	return ""
+}
+
+type EventAutoRestake struct {
+	WalletAddress     string `protobuf:"bytes,1,opt,name=wallet_address,json=walletAddress,proto3" json:"wallet_address,omitempty"`
+	NetworkAddress    string `protobuf:"bytes,2,opt,name=network_address,json=networkAddress,proto3" json:"network_address,omitempty"`
+	Amount            string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	CurrentDeposit    string `protobuf:"bytes,4,opt,name=current_deposit,json=currentDeposit,proto3" json:"current_deposit,omitempty"`
+	OzoneLimitChanges string `protobuf:"bytes,5,opt,name=ozone_limit_changes,json=ozoneLimitChanges,proto3" json:"ozone_limit_changes,omitempty"`
+}
+
+func (m *EventAutoRestake) Reset()         { *m = EventAutoRestake{} }
+func (m *EventAutoRestake) String() string { return proto.CompactTextString(m) }
+func (*EventAutoRestake) ProtoMessage()    {}
+func (*EventAutoRestake) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4848852d1aafb62a, []int{12}
+}
+func (m *EventAutoRestake) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventAutoRestake) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventAutoRestake.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventAutoRestake) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventAutoRestake.Merge(m, src)
+}
+func (m *EventAutoRestake) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventAutoRestake) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventAutoRestake.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventAutoRestake proto.InternalMessageInfo
+
+func (m *EventAutoRestake) GetWalletAddress() string {
+	if m != nil {
+		return m.WalletAddress
+	}
+	return ""
+}
+
+func (m *EventAutoRestake) GetNetworkAddress() string {
+	if m != nil {
+		return m.NetworkAddress
+	}
+	return ""
+}
+
+func (m *EventAutoRestake) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *EventAutoRestake) GetCurrentDeposit() string {
+	if m != nil {
+		return m.CurrentDeposit
+	}
+	return ""
+}
+
+func (m *EventAutoRestake) GetOzoneLimitChanges() string {
+	if m != nil {
+		return m.OzoneLimitChanges
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*EventVolumeReport)(nil), "stratos.pot.v1.EventVolumeReport")
+	proto.RegisterType((*EventWithdraw)(nil), "stratos.pot.v1.EventWithdraw")
+	proto.RegisterType((*EventFoundationDeposit)(nil), "stratos.pot.v1.EventFoundationDeposit")
+	proto.RegisterType((*EventSlashing)(nil), "stratos.pot.v1.EventSlashing")
+	proto.RegisterType((*EventVolumeReportHeader)(nil), "stratos.pot.v1.EventVolumeReportHeader")
+	proto.RegisterType((*EventVolumeReportChunk)(nil), "stratos.pot.v1.EventVolumeReportChunk")
+	proto.RegisterType((*EventVolumeReportExpired)(nil), "stratos.pot.v1.EventVolumeReportExpired")
+	proto.RegisterType((*EventDisputeVolumeReport)(nil), "stratos.pot.v1.EventDisputeVolumeReport")
+	proto.RegisterType((*EventResolveVolumeReportDispute)(nil), "stratos.pot.v1.EventResolveVolumeReportDispute")
+	proto.RegisterType((*EventReportEquivocation)(nil), "stratos.pot.v1.EventReportEquivocation")
+	proto.RegisterType((*EventRewardDistributed)(nil), "stratos.pot.v1.EventRewardDistributed")
+	proto.RegisterType((*EventSetAutoRestake)(nil), "stratos.pot.v1.EventSetAutoRestake")
+	proto.RegisterType((*EventAutoRestake)(nil), "stratos.pot.v1.EventAutoRestake")
+}
+
+func init() { proto.RegisterFile("stratos/pot/v1/event.proto", fileDescriptor_4848852d1aafb62a) }
+
+var fileDescriptor_4848852d1aafb62a = []byte{
+	// 718 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x95, 0xcd, 0x4e, 0x1b, 0x3b,
+	0x14, 0xc7, 0x99, 0xcb, 0xd7, 0xbd, 0x86, 0x24, 0x10, 0xae, 0xb8, 0x11, 0x8b, 0x70, 0x3b, 0x15,
+	0xa2, 0x5d, 0x34, 0x29, 0xea, 0x13, 0x50, 0xa0, 0x02, 0xa9, 0x9b, 0x06, 0x54, 0xa4, 0x6e, 0x46,
+	0x66, 0x7c, 0x9a, 0xb1, 0x32, 0xb1, 0xa7, 0xf6, 0x71, 0x02, 0xdd, 0x77, 0xdf, 0x47, 0xa8, 0xd4,
+	0x3e, 0x43, 0x9f, 0xa1, 0x4b, 0xa4, 0x6e, 0xba, 0xac, 0xe0, 0x45, 0xaa, 0xf1, 0x07, 0x90, 0x40,
+	0x56, 0xb4, 0xbb, 0x39, 0xff, 0xe3, 0xf1, 0xf9, 0xf9, 0x1c, 0x9f, 0x63, 0xb2, 0xa6, 0x51, 0x51,
+	0x94, 0xba, 0x5d, 0x48, 0x6c, 0x0f, 0xb6, 0xda, 0x30, 0x00, 0x81, 0xad, 0x42, 0x49, 0x94, 0xf5,
+	0xaa, 0xf7, 0xb5, 0x0a, 0x89, 0xad, 0xc1, 0x56, 0x7c, 0x44, 0x96, 0xf7, 0x4a, 0xf7, 0x6b, 0x99,
+	0x9b, 0x3e, 0x74, 0xa0, 0x90, 0x0a, 0xeb, 0x8f, 0xc9, 0x92, 0xb2, 0x5f, 0x89, 0x82, 0xb7, 0xa0,
+	0x40, 0xa4, 0xd0, 0x88, 0xfe, 0x8f, 0x1e, 0xfd, 0xd3, 0xa9, 0x39, 0xbd, 0x13, 0xe4, 0xfa, 0xbf,
+	0x64, 0x16, 0x0a, 0x99, 0x66, 0x8d, 0xbf, 0xac, 0xdf, 0x19, 0xb1, 0x21, 0x15, 0xbb, 0xeb, 0x31,
+	0xc7, 0x8c, 0x29, 0x3a, 0xac, 0xaf, 0x92, 0x39, 0xda, 0x97, 0x46, 0xa0, 0xdf, 0xc7, 0x5b, 0xf5,
+	0x0d, 0x52, 0x1d, 0xd2, 0x3c, 0x07, 0x4c, 0x28, 0x63, 0x0a, 0xb4, 0xf6, 0xfb, 0x54, 0x9c, 0xba,
+	0xed, 0xc4, 0x72, 0x19, 0x52, 0xd5, 0xbd, 0xb1, 0x6c, 0xda, 0x2d, 0x73, 0xaa, 0x5f, 0x16, 0x3f,
+	0x25, 0xab, 0x36, 0xec, 0x0b, 0x69, 0x04, 0xa3, 0xc8, 0xa5, 0xd8, 0x85, 0x42, 0x6a, 0x8e, 0x93,
+	0xe2, 0xc7, 0x5f, 0x23, 0x4f, 0x7a, 0x98, 0x53, 0x9d, 0x71, 0xd1, 0xbd, 0x83, 0x28, 0xba, 0x8b,
+	0x68, 0x93, 0xd4, 0x04, 0xe0, 0x50, 0xaa, 0xde, 0x18, 0x79, 0xd5, 0xcb, 0x61, 0xe1, 0x75, 0xe4,
+	0xe9, 0x91, 0x93, 0x3f, 0x24, 0x15, 0xed, 0x63, 0x26, 0x78, 0x56, 0x40, 0x63, 0xc6, 0xba, 0x17,
+	0x83, 0x78, 0x74, 0x56, 0x40, 0xbd, 0x41, 0xe6, 0xb5, 0xd1, 0x05, 0x08, 0xd6, 0x98, 0xb5, 0xee,
+	0x60, 0xc6, 0x5f, 0x22, 0xf2, 0xdf, 0xad, 0xc2, 0xed, 0x03, 0x65, 0xa0, 0xee, 0x5d, 0xbe, 0xfa,
+	0x3a, 0x59, 0x40, 0x89, 0x34, 0x4f, 0xd2, 0x1b, 0xe0, 0xc4, 0x4a, 0x3b, 0x16, 0x7e, 0x93, 0xd4,
+	0x18, 0x50, 0x96, 0x73, 0x01, 0x49, 0x06, 0xbc, 0x9b, 0xa1, 0xc7, 0xaf, 0x06, 0x79, 0xdf, 0xaa,
+	0x31, 0x90, 0xd5, 0x5b, 0x94, 0x3b, 0x99, 0x11, 0xbd, 0xeb, 0xc8, 0xd1, 0x58, 0x64, 0x8d, 0x54,
+	0x61, 0xc2, 0x05, 0x83, 0x53, 0x4f, 0x45, 0xac, 0x74, 0x50, 0x2a, 0xe5, 0x6f, 0x37, 0xa1, 0x9c,
+	0x11, 0x7f, 0x8e, 0x48, 0xe3, 0x56, 0x9c, 0xbd, 0xd3, 0x82, 0x2b, 0x60, 0xf7, 0x4f, 0xc7, 0x06,
+	0xa9, 0x2a, 0x48, 0x81, 0x0f, 0x80, 0x8d, 0x64, 0xa4, 0x12, 0x54, 0x97, 0x94, 0xb1, 0xac, 0xcd,
+	0x8c, 0x67, 0x2d, 0xfe, 0x10, 0x28, 0x77, 0xb9, 0x2e, 0x0c, 0xc2, 0x48, 0xcf, 0xdd, 0x9d, 0x8f,
+	0x35, 0xf2, 0x37, 0x73, 0x8b, 0x95, 0x67, 0xba, 0xb2, 0xcb, 0x9b, 0xa5, 0x80, 0x6a, 0x29, 0xc2,
+	0xcd, 0x72, 0x56, 0xc9, 0x51, 0x28, 0x59, 0x48, 0x4d, 0xf3, 0x84, 0xb3, 0xc0, 0x11, 0xa4, 0x03,
+	0x16, 0x1f, 0x93, 0x75, 0x8b, 0xd1, 0x01, 0x2d, 0xf3, 0xc1, 0x08, 0x86, 0x27, 0x9b, 0x40, 0xd3,
+	0x24, 0x44, 0x95, 0xff, 0x98, 0xb2, 0xb5, 0x42, 0x71, 0xae, 0x95, 0xf8, 0x53, 0xb8, 0x94, 0xbe,
+	0x00, 0xef, 0x0c, 0x1f, 0xc8, 0xd4, 0x36, 0xe2, 0x84, 0x1d, 0x1b, 0x64, 0x9e, 0xa6, 0xa9, 0xd1,
+	0xc0, 0xfc, 0x76, 0xc1, 0x2c, 0x4f, 0xee, 0xaa, 0x03, 0xca, 0x9f, 0xef, 0xca, 0xb6, 0x6d, 0x51,
+	0xb6, 0x09, 0x84, 0xd3, 0x05, 0xb3, 0x3c, 0x7b, 0x8f, 0xa7, 0xbd, 0x44, 0x23, 0x45, 0xa3, 0x7d,
+	0xd3, 0x90, 0x52, 0x3a, 0xb4, 0x4a, 0xfc, 0xca, 0x5f, 0xc8, 0x0e, 0x0c, 0xa9, 0x62, 0xbb, 0x5c,
+	0xa3, 0xe2, 0x27, 0x06, 0x81, 0x4d, 0x00, 0x7c, 0x40, 0x16, 0xfd, 0x38, 0x70, 0x55, 0x75, 0x94,
+	0x0b, 0x4e, 0x73, 0x65, 0x05, 0xb2, 0xe2, 0x46, 0x08, 0xe0, 0xb6, 0x41, 0xd9, 0x01, 0x8d, 0xb4,
+	0x07, 0xbf, 0x7b, 0x90, 0xc4, 0xdf, 0x23, 0xb2, 0x64, 0xe3, 0xfc, 0xc1, 0x20, 0x13, 0xa7, 0xd5,
+	0x26, 0xa9, 0xa5, 0x46, 0x29, 0x10, 0x98, 0x30, 0x37, 0x52, 0x43, 0xc3, 0x7b, 0x39, 0x0c, 0xda,
+	0x16, 0x59, 0x91, 0xef, 0xa5, 0x80, 0x24, 0xe7, 0x7d, 0x8e, 0x49, 0x9a, 0x51, 0xd1, 0x85, 0x50,
+	0x88, 0x65, 0xeb, 0x7a, 0x59, 0x7a, 0x76, 0x9c, 0xe3, 0xf9, 0xc1, 0xb7, 0x8b, 0x66, 0x74, 0x7e,
+	0xd1, 0x8c, 0x7e, 0x5e, 0x34, 0xa3, 0x8f, 0x97, 0xcd, 0xa9, 0xf3, 0xcb, 0xe6, 0xd4, 0x8f, 0xcb,
+	0xe6, 0xd4, 0x9b, 0x76, 0x97, 0x63, 0x66, 0x4e, 0x5a, 0xa9, 0xec, 0xb7, 0xfd, 0xa3, 0x25, 0x00,
+	0xc3, 0xe7, 0x93, 0x34, 0xa3, 0x5c, 0xb4, 0x4f, 0xed, 0x1b, 0x57, 0xce, 0x4f, 0x7d, 0x32, 0x67,
+	0x5f, 0xb8, 0x67, 0xbf, 0x06, 0x00, 0xab, 0x19, 0x11, 0xfd, 0xff, 0x06, 0x00, 0x00,
+}
+
+func (m *EventVolumeReport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventVolumeReport) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventVolumeReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Epoch) > 0 {
+		i -= len(m.Epoch)
+		copy(dAtA[i:], m.Epoch)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Epoch)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ReportReference) > 0 {
+		i -= len(m.ReportReference)
+		copy(dAtA[i:], m.ReportReference)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ReportReference)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventWithdraw) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventWithdraw) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventWithdraw) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TargetAddress) > 0 {
+		i -= len(m.TargetAddress)
+		copy(dAtA[i:], m.TargetAddress)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.TargetAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.WalletAddress) > 0 {
+		i -= len(m.WalletAddress)
+		copy(dAtA[i:], m.WalletAddress)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.WalletAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventFoundationDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventFoundationDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventFoundationDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSlashing) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSlashing) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSlashing) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Suspend) > 0 {
+		i -= len(m.Suspend)
+		copy(dAtA[i:], m.Suspend)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Suspend)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.SlashingType) > 0 {
+		i -= len(m.SlashingType)
+		copy(dAtA[i:], m.SlashingType)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.SlashingType)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.NetworkAddress) > 0 {
+		i -= len(m.NetworkAddress)
+		copy(dAtA[i:], m.NetworkAddress)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.NetworkAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.WalletAddress) > 0 {
+		i -= len(m.WalletAddress)
+		copy(dAtA[i:], m.WalletAddress)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.WalletAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventVolumeReportHeader) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventVolumeReportHeader) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventVolumeReportHeader) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DeadlineHeight) > 0 {
+		i -= len(m.DeadlineHeight)
+		copy(dAtA[i:], m.DeadlineHeight)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.DeadlineHeight)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.TotalCount) > 0 {
+		i -= len(m.TotalCount)
+		copy(dAtA[i:], m.TotalCount)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.TotalCount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Epoch) > 0 {
+		i -= len(m.Epoch)
+		copy(dAtA[i:], m.Epoch)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Epoch)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ReportReference) > 0 {
+		i -= len(m.ReportReference)
+		copy(dAtA[i:], m.ReportReference)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ReportReference)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventVolumeReportChunk) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventVolumeReportChunk) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventVolumeReportChunk) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Count) > 0 {
+		i -= len(m.Count)
+		copy(dAtA[i:], m.Count)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Count)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.StartIndex) > 0 {
+		i -= len(m.StartIndex)
+		copy(dAtA[i:], m.StartIndex)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.StartIndex)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Epoch) > 0 {
+		i -= len(m.Epoch)
+		copy(dAtA[i:], m.Epoch)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Epoch)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventVolumeReportExpired) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventVolumeReportExpired) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventVolumeReportExpired) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TotalCount) > 0 {
+		i -= len(m.TotalCount)
+		copy(dAtA[i:], m.TotalCount)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.TotalCount)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ReceivedCount) > 0 {
+		i -= len(m.ReceivedCount)
+		copy(dAtA[i:], m.ReceivedCount)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ReceivedCount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Epoch) > 0 {
+		i -= len(m.Epoch)
+		copy(dAtA[i:], m.Epoch)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Epoch)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ReportReference) > 0 {
+		i -= len(m.ReportReference)
+		copy(dAtA[i:], m.ReportReference)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ReportReference)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventDisputeVolumeReport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventDisputeVolumeReport) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventDisputeVolumeReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProposalId) > 0 {
+		i -= len(m.ProposalId)
+		copy(dAtA[i:], m.ProposalId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ProposalId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Disputer) > 0 {
+		i -= len(m.Disputer)
+		copy(dAtA[i:], m.Disputer)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Disputer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Epoch) > 0 {
+		i -= len(m.Epoch)
+		copy(dAtA[i:], m.Epoch)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Epoch)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventResolveVolumeReportDispute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventResolveVolumeReportDispute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventResolveVolumeReportDispute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Resolution) > 0 {
+		i -= len(m.Resolution)
+		copy(dAtA[i:], m.Resolution)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Resolution)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Epoch) > 0 {
+		i -= len(m.Epoch)
+		copy(dAtA[i:], m.Epoch)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Epoch)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventReportEquivocation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventReportEquivocation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventReportEquivocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.KickStatus) > 0 {
+		i -= len(m.KickStatus)
+		copy(dAtA[i:], m.KickStatus)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.KickStatus)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Slashed) > 0 {
+		i -= len(m.Slashed)
+		copy(dAtA[i:], m.Slashed)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Slashed)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Reporter) > 0 {
+		i -= len(m.Reporter)
+		copy(dAtA[i:], m.Reporter)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Reporter)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Accused) > 0 {
+		i -= len(m.Accused)
+		copy(dAtA[i:], m.Accused)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Accused)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Epoch) > 0 {
+		i -= len(m.Epoch)
+		copy(dAtA[i:], m.Epoch)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Epoch)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventRewardDistributed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRewardDistributed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRewardDistributed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WalletCount) > 0 {
+		i -= len(m.WalletCount)
+		copy(dAtA[i:], m.WalletCount)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.WalletCount)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Epoch) > 0 {
+		i -= len(m.Epoch)
+		copy(dAtA[i:], m.Epoch)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Epoch)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSetAutoRestake) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSetAutoRestake) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSetAutoRestake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NetworkAddress) > 0 {
+		i -= len(m.NetworkAddress)
+		copy(dAtA[i:], m.NetworkAddress)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.NetworkAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.WalletAddress) > 0 {
+		i -= len(m.WalletAddress)
+		copy(dAtA[i:], m.WalletAddress)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.WalletAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventAutoRestake) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventAutoRestake) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventAutoRestake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OzoneLimitChanges) > 0 {
+		i -= len(m.OzoneLimitChanges)
+		copy(dAtA[i:], m.OzoneLimitChanges)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.OzoneLimitChanges)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.CurrentDeposit) > 0 {
+		i -= len(m.CurrentDeposit)
+		copy(dAtA[i:], m.CurrentDeposit)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.CurrentDeposit)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.NetworkAddress) > 0 {
+		i -= len(m.NetworkAddress)
+		copy(dAtA[i:], m.NetworkAddress)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.NetworkAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.WalletAddress) > 0 {
+		i -= len(m.WalletAddress)
+		copy(dAtA[i:], m.WalletAddress)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.WalletAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvent(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventVolumeReport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ReportReference)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Epoch)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *EventWithdraw) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.WalletAddress)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.TargetAddress)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *EventFoundationDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *EventSlashing) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.WalletAddress)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.NetworkAddress)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.SlashingType)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Suspend)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *EventVolumeReportHeader) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ReportReference)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Epoch)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.TotalCount)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.DeadlineHeight)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *EventVolumeReportChunk) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Epoch)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.StartIndex)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Count)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *EventVolumeReportExpired) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ReportReference)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Epoch)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.ReceivedCount)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.TotalCount)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *EventDisputeVolumeReport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Epoch)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Disputer)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.ProposalId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *EventResolveVolumeReportDispute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Epoch)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Resolution)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *EventReportEquivocation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Epoch)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Accused)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Reporter)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Slashed)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.KickStatus)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *EventRewardDistributed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Epoch)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.WalletCount)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *EventSetAutoRestake) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.WalletAddress)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.NetworkAddress)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *EventAutoRestake) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.WalletAddress)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.NetworkAddress)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.CurrentDeposit)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.OzoneLimitChanges)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func sovEvent(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvent(x uint64) (n int) {
+	return sovEvent(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventVolumeReport) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventVolumeReport: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventVolumeReport: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReportReference", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReportReference = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Epoch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventWithdraw) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventWithdraw: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventWithdraw: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WalletAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WalletAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventFoundationDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventFoundationDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventFoundationDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSlashing) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSlashing: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSlashing: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WalletAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WalletAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetworkAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NetworkAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashingType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashingType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Suspend", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Suspend = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventVolumeReportHeader) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventVolumeReportHeader: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventVolumeReportHeader: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReportReference", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReportReference = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Epoch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalCount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalCount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeadlineHeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeadlineHeight = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventVolumeReportChunk) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventVolumeReportChunk: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventVolumeReportChunk: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Epoch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StartIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Count = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventVolumeReportExpired) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventVolumeReportExpired: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventVolumeReportExpired: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReportReference", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReportReference = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Epoch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceivedCount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReceivedCount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalCount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalCount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventDisputeVolumeReport) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventDisputeVolumeReport: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventDisputeVolumeReport: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Epoch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Disputer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Disputer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposalId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func sovEvent(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozEvent(x uint64) (n int) {
-	return sovEvent(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *EventVolumeReport) Unmarshal(dAtA []byte) error {
+func (m *EventResolveVolumeReportDispute) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -582,15 +3050,15 @@ func (m *EventVolumeReport) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventVolumeReport: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventResolveVolumeReportDispute: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventVolumeReport: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventResolveVolumeReportDispute: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReportReference", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -618,11 +3086,11 @@ func (m *EventVolumeReport) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReportReference = string(dAtA[iNdEx:postIndex])
+			m.Epoch = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Resolution", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -650,7 +3118,7 @@ func (m *EventVolumeReport) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Epoch = string(dAtA[iNdEx:postIndex])
+			m.Resolution = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -673,7 +3141,7 @@ func (m *EventVolumeReport) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventWithdraw) Unmarshal(dAtA []byte) error {
+func (m *EventReportEquivocation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -696,15 +3164,15 @@ func (m *EventWithdraw) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventWithdraw: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventReportEquivocation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventWithdraw: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventReportEquivocation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -732,11 +3200,11 @@ func (m *EventWithdraw) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = string(dAtA[iNdEx:postIndex])
+			m.Epoch = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WalletAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Accused", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -764,11 +3232,11 @@ func (m *EventWithdraw) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WalletAddress = string(dAtA[iNdEx:postIndex])
+			m.Accused = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reporter", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -796,7 +3264,71 @@ func (m *EventWithdraw) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TargetAddress = string(dAtA[iNdEx:postIndex])
+			m.Reporter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slashed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Slashed = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KickStatus", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KickStatus = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -819,7 +3351,7 @@ func (m *EventWithdraw) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventFoundationDeposit) Unmarshal(dAtA []byte) error {
+func (m *EventRewardDistributed) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -842,15 +3374,15 @@ func (m *EventFoundationDeposit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventFoundationDeposit: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventRewardDistributed: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventFoundationDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventRewardDistributed: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -878,7 +3410,39 @@ func (m *EventFoundationDeposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = string(dAtA[iNdEx:postIndex])
+			m.Epoch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WalletCount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WalletCount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -901,7 +3465,7 @@ func (m *EventFoundationDeposit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventSlashing) Unmarshal(dAtA []byte) error {
+func (m *EventSetAutoRestake) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -924,10 +3488,124 @@ func (m *EventSlashing) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventSlashing: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventSetAutoRestake: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventSlashing: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventSetAutoRestake: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WalletAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WalletAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetworkAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NetworkAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventAutoRestake) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventAutoRestake: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventAutoRestake: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1028,7 +3706,7 @@ func (m *EventSlashing) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashingType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentDeposit", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1056,11 +3734,11 @@ func (m *EventSlashing) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SlashingType = string(dAtA[iNdEx:postIndex])
+			m.CurrentDeposit = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Suspend", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OzoneLimitChanges", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1088,7 +3766,7 @@ func (m *EventSlashing) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Suspend = string(dAtA[iNdEx:postIndex])
+			m.OzoneLimitChanges = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1,10 +1,13 @@
 package types
 
 import (
+	"time"
+
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	stratos "github.com/stratosnet/stratos-chain/types"
@@ -30,6 +33,8 @@ type BankKeeper interface {
 
 type RegisterKeeper interface {
 	GetMetaNode(ctx sdk.Context, p2pAddress stratos.SdsAddress) (metaNode types.MetaNode, found bool)
+	GetMetaNodeBLSPubKey(ctx sdk.Context, networkAddr stratos.SdsAddress) (blsPubKey []byte, found bool)
+	VerifyMetaNodeThresholdSignature(ctx sdk.Context, data, signature []byte, pubKeys [][]byte) error
 	GetResourceNode(ctx sdk.Context, p2pAddress stratos.SdsAddress) (resourceNode types.ResourceNode, found bool)
 	SetResourceNode(ctx sdk.Context, resourceNode types.ResourceNode)
 
@@ -39,6 +44,7 @@ type RegisterKeeper interface {
 
 	GetRemainingOzoneLimit(ctx sdk.Context) (value sdkmath.Int)
 	SetRemainingOzoneLimit(ctx sdk.Context, value sdkmath.Int)
+	ReturnPrepaidOzone(ctx sdk.Context, amount sdkmath.Int)
 	GetTotalUnissuedPrepay(ctx sdk.Context) (totalUnissuedPrepay sdk.Coin)
 	GetDepositNozRate(ctx sdk.Context) (depositNozRate sdkmath.LegacyDec)
 
@@ -57,6 +63,16 @@ type RegisterKeeper interface {
 	GetUnbondingNodeBalance(ctx sdk.Context, networkAddr stratos.SdsAddress) sdkmath.Int
 
 	OwnMetaNode(ctx sdk.Context, ownerAddr sdk.AccAddress, p2pAddr stratos.SdsAddress) (found bool)
+
+	UpdateResourceNodeDeposit(ctx sdk.Context, networkAddr stratos.SdsAddress, ownerAddr sdk.AccAddress, depositDelta sdk.Coin) (
+		ozoneLimitChange, availableTokenAmtBefore, availableTokenAmtAfter sdkmath.Int, completionTime time.Time, resourcenode types.ResourceNode, err error)
+	UpdateMetaNodeDeposit(ctx sdk.Context, networkAddr stratos.SdsAddress, ownerAddr sdk.AccAddress, depositDelta sdk.Coin) (
+		ozoneLimitChange, availableTokenAmtBefore, availableTokenAmtAfter sdkmath.Int, unbondingMatureTime time.Time, metaNode types.MetaNode, err error)
+
+	SlashMetaNode(ctx sdk.Context, networkAddr stratos.SdsAddress, fraction sdkmath.LegacyDec) (slashed sdk.Coin, err error)
+	HandleVoteForKickMetaNode(ctx sdk.Context, targetNetworkAddr stratos.SdsAddress, opinion types.VoteOpinion,
+		voterNetworkAddr stratos.SdsAddress, voterOwnerAddr sdk.AccAddress) (nodeStatus stakingtypes.BondStatus,
+		ozoneLimitChange sdkmath.Int, depositToRemove sdk.Coin, unbondingMatureTime time.Time, err error)
 }
 
 type StakingKeeper interface {
@@ -68,3 +84,9 @@ type DistrKeeper interface {
 	FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error
 	GetFeePool(ctx sdk.Context) (feePool distrtypes.FeePool)
 }
+
+// GovKeeper defines the expected gov keeper used to escalate volume report disputes to governance proposals
+type GovKeeper interface {
+	SubmitProposal(ctx sdk.Context, messages []sdk.Msg, metadata, title, summary string, proposer sdk.AccAddress) (govv1.Proposal, error)
+	ActivateVotingPeriod(ctx sdk.Context, proposal govv1.Proposal)
+}
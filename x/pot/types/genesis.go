@@ -1,14 +1,20 @@
 package types
 
 import (
+	"cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	stratos "github.com/stratosnet/stratos-chain/types"
 )
 
 // NewGenesisState creates a new GenesisState object
 func NewGenesisState(params Params, totalMinedToken sdk.Coin, lastDistributedEpoch sdkmath.Int,
 	immatureTotalInfo []ImmatureTotal, matureTotalInfo []MatureTotal, individualRewardInfo []Reward,
-	maturedEpoch sdkmath.Int, rewardTotalInfo []RewardTotal,
+	maturedEpoch sdkmath.Int, rewardTotalInfo []RewardTotal, pendingVolumeReport *PendingVolumeReport,
+	pendingWalletVolumes []SingleWalletVolume, pendingDistributions []PendingDistribution,
+	distributionVolumes []DistributionVolume, volumeReportDisputes []VolumeReportDispute,
+	reportEquivocations []ReportEquivocation, rewardHistories []RewardHistory, autoRestakes []AutoRestake,
 ) *GenesisState {
 
 	return &GenesisState{
@@ -20,6 +26,14 @@ func NewGenesisState(params Params, totalMinedToken sdk.Coin, lastDistributedEpo
 		IndividualRewardInfo: individualRewardInfo,
 		MaturedEpoch:         maturedEpoch,
 		RewardTotalInfo:      rewardTotalInfo,
+		PendingVolumeReport:  pendingVolumeReport,
+		PendingWalletVolumes: pendingWalletVolumes,
+		PendingDistributions: pendingDistributions,
+		DistributionVolumes:  distributionVolumes,
+		VolumeReportDisputes: volumeReportDisputes,
+		ReportEquivocations:  reportEquivocations,
+		RewardHistories:      rewardHistories,
+		AutoRestakes:         autoRestakes,
 	}
 }
 
@@ -41,6 +55,28 @@ func DefaultGenesisState() *GenesisState {
 
 // ValidateGenesis validates the pot genesis parameters
 func ValidateGenesis(data GenesisState) error {
+	if err := validatePendingDistributions(data); err != nil {
+		return err
+	}
+
+	report := data.GetPendingVolumeReport()
+	if report == nil {
+		if len(data.GetPendingWalletVolumes()) > 0 {
+			return errors.Wrap(ErrNoPendingVolumeReport, "pending wallet volumes without pending volume report")
+		}
+		return nil
+	}
+
+	if report.Epoch.IsNil() || !report.Epoch.IsPositive() {
+		return ErrEpochNotPositive
+	}
+	if len(report.GetVolumesRoot()) != VolumesRootLength {
+		return ErrInvalidVolumesRoot
+	}
+	if report.GetReceivedCount() != uint64(len(data.GetPendingWalletVolumes())) || report.GetReceivedCount() >= report.GetTotalCount() {
+		return errors.Wrapf(ErrInvalidVolumeChunk, "%d pending wallet volumes received for %d expected",
+			len(data.GetPendingWalletVolumes()), report.GetReceivedCount())
+	}
 	return nil
 }
 
@@ -64,3 +100,70 @@ func NewRewardTotal(epoch sdkmath.Int, reward TotalReward) RewardTotal {
 		TotalReward: reward,
 	}
 }
+
+func validatePendingDistributions(data GenesisState) error {
+	remainingCounts := make(map[string]uint64)
+	for _, distribution := range data.GetPendingDistributions() {
+		if distribution.Epoch.IsNil() || !distribution.Epoch.GT(data.LastDistributedEpoch) {
+			return errors.Wrapf(ErrMatureEpoch, "pending distribution of epoch %s is not after the last distributed epoch", distribution.Epoch)
+		}
+		if _, ok := remainingCounts[distribution.Epoch.String()]; ok {
+			return errors.Wrapf(ErrInvalid, "duplicated pending distribution of epoch %s", distribution.Epoch)
+		}
+		if distribution.GetProcessedCount() > distribution.GetTotalCount() {
+			return errors.Wrapf(ErrInvalid, "pending distribution of epoch %s processed more wallet volumes than reported", distribution.Epoch)
+		}
+		if distribution.CommunityTaxRemainder.IsNil() || distribution.CommunityTaxRemainder.IsNegative() ||
+			distribution.CommunityTaxRemainder.GTE(sdkmath.LegacyOneDec()) {
+			return errors.Wrapf(ErrInvalid, "invalid community tax remainder of pending distribution of epoch %s", distribution.Epoch)
+		}
+		remainingCounts[distribution.Epoch.String()] = distribution.GetTotalCount() - distribution.GetProcessedCount()
+	}
+
+	for _, distributionVolume := range data.GetDistributionVolumes() {
+		remaining, ok := remainingCounts[distributionVolume.Epoch.String()]
+		if !ok || remaining == 0 {
+			return errors.Wrapf(ErrInvalid, "unexpected wallet volume of pending distribution of epoch %s", distributionVolume.Epoch)
+		}
+		remainingCounts[distributionVolume.Epoch.String()] = remaining - 1
+	}
+	for epoch, remaining := range remainingCounts {
+		if remaining > 0 {
+			return errors.Wrapf(ErrInvalid, "missing %d wallet volumes of pending distribution of epoch %s", remaining, epoch)
+		}
+	}
+
+	for _, dispute := range data.GetVolumeReportDisputes() {
+		if _, ok := remainingCounts[dispute.Epoch.String()]; !ok {
+			return errors.Wrapf(ErrNoPendingDistribution, "dispute of epoch %s", dispute.Epoch)
+		}
+	}
+
+	for _, equivocation := range data.GetReportEquivocations() {
+		if equivocation.Epoch.IsNil() || !equivocation.Epoch.IsPositive() {
+			return errors.Wrapf(ErrInvalid, "report equivocation epoch %s", equivocation.Epoch)
+		}
+		if _, err := stratos.SdsAddressFromBech32(equivocation.GetAccused()); err != nil {
+			return errors.Wrap(ErrInvalidAddress, err.Error())
+		}
+	}
+
+	for _, history := range data.GetRewardHistories() {
+		if history.Epoch.IsNil() || !history.Epoch.IsPositive() {
+			return errors.Wrapf(ErrInvalid, "reward history epoch %s", history.Epoch)
+		}
+		if _, err := sdk.AccAddressFromBech32(history.GetWalletAddress()); err != nil {
+			return errors.Wrap(ErrInvalidAddress, err.Error())
+		}
+	}
+
+	for _, autoRestake := range data.GetAutoRestakes() {
+		if _, err := sdk.AccAddressFromBech32(autoRestake.GetWalletAddress()); err != nil {
+			return errors.Wrap(ErrInvalidAddress, err.Error())
+		}
+		if _, err := stratos.SdsAddressFromBech32(autoRestake.GetNetworkAddress()); err != nil {
+			return errors.Wrap(ErrInvalidAddress, err.Error())
+		}
+	}
+	return nil
+}
@@ -37,6 +37,15 @@ type GenesisState struct {
 	IndividualRewardInfo []Reward                               `protobuf:"bytes,6,rep,name=individual_reward_info,json=individualRewardInfo,proto3" json:"individual_reward_info" yaml:"individual_reward_info"`
 	MaturedEpoch         github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=matured_epoch,json=maturedEpoch,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"matured_epoch" yaml:"matured_epoch"`
 	RewardTotalInfo      []RewardTotal                          `protobuf:"bytes,8,rep,name=reward_total_info,json=rewardTotalInfo,proto3" json:"reward_total_info" yaml:"reward_total_info"`
+	PendingVolumeReport  *PendingVolumeReport                   `protobuf:"bytes,9,opt,name=pending_volume_report,json=pendingVolumeReport,proto3" json:"pending_volume_report,omitempty" yaml:"pending_volume_report"`
+	// pending_wallet_volumes are the wallet volumes received for the pending volume report, in report order
+	PendingWalletVolumes []SingleWalletVolume  `protobuf:"bytes,10,rep,name=pending_wallet_volumes,json=pendingWalletVolumes,proto3" json:"pending_wallet_volumes,omitempty" yaml:"pending_wallet_volumes"`
+	PendingDistributions []PendingDistribution `protobuf:"bytes,11,rep,name=pending_distributions,json=pendingDistributions,proto3" json:"pending_distributions,omitempty" yaml:"pending_distributions"`
+	DistributionVolumes  []DistributionVolume  `protobuf:"bytes,12,rep,name=distribution_volumes,json=distributionVolumes,proto3" json:"distribution_volumes,omitempty" yaml:"distribution_volumes"`
+	VolumeReportDisputes []VolumeReportDispute `protobuf:"bytes,13,rep,name=volume_report_disputes,json=volumeReportDisputes,proto3" json:"volume_report_disputes,omitempty" yaml:"volume_report_disputes"`
+	ReportEquivocations  []ReportEquivocation  `protobuf:"bytes,14,rep,name=report_equivocations,json=reportEquivocations,proto3" json:"report_equivocations,omitempty" yaml:"report_equivocations"`
+	RewardHistories      []RewardHistory       `protobuf:"bytes,15,rep,name=reward_histories,json=rewardHistories,proto3" json:"reward_histories,omitempty" yaml:"reward_histories"`
+	AutoRestakes         []AutoRestake         `protobuf:"bytes,16,rep,name=auto_restakes,json=autoRestakes,proto3" json:"auto_restakes,omitempty" yaml:"auto_restakes"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -114,6 +123,62 @@ func (m *GenesisState) GetRewardTotalInfo() []RewardTotal {
 	return nil
 }
 
+func (m *GenesisState) GetPendingVolumeReport() *PendingVolumeReport {
+	if m != nil {
+		return m.PendingVolumeReport
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingWalletVolumes() []SingleWalletVolume {
+	if m != nil {
+		return m.PendingWalletVolumes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingDistributions() []PendingDistribution {
+	if m != nil {
+		return m.PendingDistributions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDistributionVolumes() []DistributionVolume {
+	if m != nil {
+		return m.DistributionVolumes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetVolumeReportDisputes() []VolumeReportDispute {
+	if m != nil {
+		return m.VolumeReportDisputes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetReportEquivocations() []ReportEquivocation {
+	if m != nil {
+		return m.ReportEquivocations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRewardHistories() []RewardHistory {
+	if m != nil {
+		return m.RewardHistories
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAutoRestakes() []AutoRestake {
+	if m != nil {
+		return m.AutoRestakes
+	}
+	return nil
+}
+
 type ImmatureTotal struct {
 	WalletAddress string                                   `protobuf:"bytes,1,opt,name=wallet_address,json=walletAddress,proto3" json:"wallet_address" yaml:"wallet_address"`
 	Value         github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=value,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"value" yaml:"value"`
@@ -273,57 +338,78 @@ func init() {
 func init() { proto.RegisterFile("stratos/pot/v1/genesis.proto", fileDescriptor_abdde08c2564316a) }
 
 var fileDescriptor_abdde08c2564316a = []byte{
-	// 793 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x55, 0xcf, 0x6b, 0x13, 0x4d,
-	0x18, 0xce, 0xb4, 0x5f, 0xfa, 0xb5, 0x93, 0xa4, 0x25, 0xdb, 0x7c, 0x25, 0xed, 0xf7, 0x75, 0xb7,
-	0x2c, 0x1f, 0x12, 0x94, 0xee, 0x92, 0x8a, 0x97, 0x1e, 0x04, 0xd3, 0x4a, 0xc9, 0x21, 0x20, 0x69,
-	0x4f, 0x82, 0x2c, 0x93, 0xec, 0x34, 0x9d, 0x36, 0xbb, 0x13, 0x76, 0x27, 0xa9, 0x15, 0x11, 0xc4,
-	0xb3, 0x28, 0xfe, 0x15, 0xc5, 0x93, 0x88, 0x7f, 0x44, 0x8f, 0xc5, 0x93, 0xf6, 0xb0, 0x4a, 0x7a,
-	0x10, 0x7a, 0x5c, 0x10, 0xaf, 0xb2, 0x33, 0x13, 0xb3, 0xf9, 0xd1, 0x62, 0xcf, 0x5e, 0x92, 0xdd,
-	0xf7, 0x79, 0xe7, 0x7d, 0x9f, 0x67, 0xde, 0x67, 0x67, 0xe0, 0x7f, 0x3e, 0xf3, 0x10, 0xa3, 0xbe,
-	0xd9, 0xa2, 0xcc, 0xec, 0x14, 0xcd, 0x06, 0x76, 0xb1, 0x4f, 0x7c, 0xa3, 0xe5, 0x51, 0x46, 0x95,
-	0x59, 0x89, 0x1a, 0x2d, 0xca, 0x8c, 0x4e, 0x71, 0x29, 0xd7, 0xa0, 0x0d, 0xca, 0x21, 0x33, 0x7a,
-	0x12, 0x59, 0x4b, 0x8b, 0x75, 0xea, 0x3b, 0xd4, 0xb7, 0x04, 0x20, 0x5e, 0x24, 0x94, 0x45, 0x0e,
-	0x71, 0xa9, 0xc9, 0x7f, 0x65, 0x48, 0x15, 0x09, 0x66, 0x0d, 0xf9, 0xd8, 0xec, 0x14, 0x6b, 0x98,
-	0xa1, 0xa2, 0x59, 0xa7, 0xc4, 0x95, 0x78, 0x7e, 0x88, 0x51, 0xd4, 0x9a, 0x23, 0xfa, 0xd9, 0x34,
-	0x4c, 0x6f, 0x09, 0x7e, 0xdb, 0x0c, 0x31, 0xac, 0xec, 0xc0, 0xa9, 0x16, 0xf2, 0x90, 0xe3, 0xe7,
-	0xc1, 0x0a, 0x28, 0xa4, 0xd6, 0x16, 0x8c, 0x41, 0xbe, 0xc6, 0x03, 0x8e, 0x96, 0xfe, 0x3f, 0x09,
-	0xb4, 0xc4, 0x45, 0xa0, 0xc9, 0xec, 0x30, 0xd0, 0x32, 0x47, 0xc8, 0x69, 0xae, 0xeb, 0xe2, 0x5d,
-	0x3f, 0xfe, 0xf6, 0xee, 0x26, 0xa8, 0x4a, 0x54, 0x79, 0x0e, 0x60, 0x96, 0x51, 0x86, 0x9a, 0x96,
-	0x43, 0x5c, 0x6c, 0x5b, 0x8c, 0x1e, 0x60, 0x37, 0x3f, 0xc1, 0x3b, 0x2c, 0x1a, 0x52, 0x5e, 0xc4,
-	0xde, 0x90, 0xec, 0x8d, 0x0d, 0x4a, 0xdc, 0xd2, 0xba, 0x6c, 0x32, 0xba, 0x36, 0x0c, 0xb4, 0xbc,
-	0xe8, 0x37, 0x02, 0xc9, 0xd6, 0x73, 0x1c, 0xa8, 0x44, 0xf1, 0x9d, 0x28, 0xac, 0xbc, 0x07, 0x70,
-	0xa1, 0x89, 0x7c, 0x66, 0xd9, 0xc4, 0x67, 0x1e, 0xa9, 0xb5, 0x19, 0xb6, 0x2d, 0xdc, 0xa2, 0xf5,
-	0xbd, 0xfc, 0xe4, 0x0a, 0x28, 0xcc, 0x94, 0x9e, 0x46, 0xdd, 0xce, 0x02, 0xed, 0x46, 0x83, 0xb0,
-	0xbd, 0x76, 0xcd, 0xa8, 0x53, 0x47, 0xee, 0xbc, 0xfc, 0x5b, 0xf5, 0xed, 0x03, 0x93, 0x1d, 0xb5,
-	0xb0, 0x6f, 0x94, 0x5d, 0x76, 0x11, 0x68, 0x97, 0xd4, 0x0b, 0x03, 0x6d, 0x59, 0x90, 0x1b, 0x8f,
-	0xeb, 0x1f, 0x3f, 0xac, 0x42, 0x29, 0xba, 0xec, 0x32, 0xc1, 0x37, 0x17, 0xe5, 0x6e, 0xf6, 0x53,
-	0xef, 0x47, 0x99, 0xca, 0x4b, 0x00, 0xe7, 0x89, 0xe3, 0x20, 0xd6, 0xf6, 0xb0, 0x25, 0xa4, 0x12,
-	0x77, 0x97, 0xe6, 0xff, 0x5a, 0x99, 0x2c, 0xa4, 0xd6, 0x96, 0x87, 0x87, 0x53, 0x96, 0xa9, 0x3b,
-	0x51, 0x66, 0xe9, 0xae, 0xdc, 0xbe, 0x71, 0x15, 0xc2, 0x40, 0x5b, 0x12, 0x1c, 0xc7, 0x80, 0x72,
-	0x0b, 0xb3, 0x24, 0x5e, 0xae, 0xec, 0xee, 0x52, 0xe5, 0x05, 0x80, 0xd9, 0x51, 0x36, 0x49, 0xce,
-	0xe6, 0xdf, 0x61, 0x36, 0x95, 0x18, 0x97, 0x5f, 0xa3, 0x1c, 0xc7, 0x44, 0x8e, 0xf2, 0x32, 0x1e,
-	0x73, 0xc3, 0x2c, 0xde, 0x00, 0xb8, 0x40, 0x5c, 0x9b, 0x74, 0x88, 0xdd, 0x46, 0x4d, 0xcb, 0xc3,
-	0x87, 0xc8, 0xb3, 0x05, 0x95, 0x29, 0x4e, 0x65, 0xc4, 0xb5, 0x55, 0x9e, 0x52, 0xda, 0x94, 0x2c,
-	0x2e, 0x59, 0xdd, 0x1f, 0xdc, 0x78, 0x5c, 0xf2, 0xc9, 0xf5, 0x51, 0x51, 0x97, 0x93, 0x7a, 0x05,
-	0x60, 0x46, 0x10, 0xed, 0xd9, 0xea, 0x6f, 0x6e, 0xab, 0xfd, 0x6b, 0xdb, 0x6a, 0xb0, 0x4c, 0x18,
-	0x68, 0xb9, 0xf8, 0xfe, 0x5c, 0x65, 0xa2, 0xb4, 0x4c, 0x11, 0xe6, 0x79, 0x06, 0xb3, 0x92, 0x7c,
-	0x6c, 0x56, 0xd3, 0xe3, 0x67, 0x25, 0x84, 0x88, 0x59, 0xdd, 0xe9, 0xcd, 0x6a, 0x64, 0x75, 0x7f,
-	0x56, 0x23, 0x90, 0x5e, 0x9d, 0xf3, 0xfa, 0x35, 0xa2, 0x1d, 0xd1, 0x7f, 0x00, 0x98, 0x19, 0x70,
-	0xa4, 0xb2, 0x0f, 0x67, 0x0f, 0x51, 0xb3, 0x89, 0x99, 0x85, 0x6c, 0xdb, 0xc3, 0xbe, 0x38, 0x65,
-	0x66, 0x4a, 0x1b, 0x17, 0x81, 0x36, 0x84, 0x84, 0x81, 0xf6, 0x8f, 0x68, 0x35, 0x18, 0x8f, 0x74,
-	0xe7, 0xa4, 0xee, 0x7b, 0x22, 0xb4, 0xcd, 0x3c, 0xe2, 0x36, 0xaa, 0x19, 0x91, 0x28, 0x83, 0xca,
-	0x13, 0x98, 0xec, 0xa0, 0x66, 0x1b, 0xe7, 0x27, 0xb8, 0xe2, 0x2b, 0x8e, 0x99, 0xb2, 0xd4, 0x2b,
-	0xf2, 0xc3, 0x40, 0x4b, 0x8b, 0xc6, 0xfc, 0x55, 0x7f, 0xfb, 0x45, 0x2b, 0xfc, 0xc6, 0xe8, 0xa2,
-	0x4a, 0x7e, 0x55, 0x94, 0xd0, 0xbf, 0x03, 0x98, 0xaa, 0xfc, 0x81, 0xba, 0x3f, 0x03, 0x98, 0x8a,
-	0x39, 0x49, 0x79, 0x04, 0x93, 0xe2, 0x53, 0x10, 0x72, 0xb7, 0xae, 0xfd, 0x29, 0x24, 0x7b, 0x9f,
-	0x80, 0xa4, 0x26, 0xac, 0x5f, 0x15, 0x61, 0xe5, 0x00, 0xa6, 0x85, 0x01, 0x85, 0xf3, 0xe4, 0x85,
-	0x32, 0xe2, 0x6d, 0xce, 0x45, 0x9e, 0x00, 0xb7, 0xa4, 0xe6, 0x81, 0x85, 0x61, 0xa0, 0xcd, 0xc7,
-	0x6f, 0x13, 0x11, 0xd5, 0xab, 0x29, 0x16, 0x5b, 0x59, 0x39, 0xee, 0xaa, 0xe0, 0xa4, 0xab, 0x82,
-	0xd3, 0xae, 0x0a, 0xbe, 0x76, 0x55, 0xf0, 0xfa, 0x5c, 0x4d, 0x9c, 0x9e, 0xab, 0x89, 0x4f, 0xe7,
-	0x6a, 0xe2, 0xa1, 0x19, 0x93, 0x24, 0xdb, 0xbb, 0x98, 0xf5, 0x1e, 0x57, 0xeb, 0x7b, 0x88, 0xb8,
-	0xe6, 0x63, 0x7e, 0x01, 0x73, 0x7d, 0xb5, 0x29, 0x7e, 0x01, 0xdf, 0xfe, 0x19, 0x00, 0x00, 0xff,
-	0xff, 0x6e, 0x48, 0x56, 0xcf, 0x2e, 0x08, 0x00, 0x00,
+	// 1129 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x57, 0xcf, 0x6b, 0x24, 0x45,
+	0x14, 0x4e, 0x6d, 0xcc, 0x6a, 0x6a, 0x66, 0x92, 0x4d, 0x67, 0x36, 0xdb, 0x9b, 0xdd, 0x4c, 0x0f,
+	0xad, 0x48, 0x50, 0x33, 0x43, 0x56, 0x44, 0x58, 0x50, 0xd8, 0xd9, 0x84, 0x6c, 0x0e, 0x01, 0xe9,
+	0x04, 0x05, 0x41, 0x86, 0xca, 0x74, 0xed, 0xa4, 0x36, 0xd3, 0x5d, 0x6d, 0x57, 0xcd, 0xac, 0x11,
+	0x11, 0xc4, 0x83, 0x27, 0x51, 0x3c, 0x7b, 0x11, 0x3d, 0x2c, 0x82, 0x20, 0xe2, 0x1f, 0xb1, 0xc7,
+	0xc5, 0x93, 0x7a, 0x68, 0x25, 0x39, 0x08, 0x39, 0x0e, 0x88, 0x57, 0xe9, 0xaa, 0x37, 0x99, 0xee,
+	0xe9, 0x4a, 0x70, 0xcf, 0x5e, 0x92, 0xe9, 0x7a, 0xdf, 0xfb, 0xea, 0x7b, 0x3f, 0xfa, 0x55, 0x35,
+	0xbe, 0x29, 0x64, 0x4c, 0x24, 0x17, 0xcd, 0x88, 0xcb, 0xe6, 0x60, 0xbd, 0xd9, 0xa5, 0x21, 0x15,
+	0x4c, 0x34, 0xa2, 0x98, 0x4b, 0x6e, 0xcd, 0x81, 0xb5, 0x11, 0x71, 0xd9, 0x18, 0xac, 0x2f, 0x57,
+	0xbb, 0xbc, 0xcb, 0x95, 0xa9, 0x99, 0xfe, 0xd2, 0xa8, 0xe5, 0xeb, 0x1d, 0x2e, 0x02, 0x2e, 0xda,
+	0xda, 0xa0, 0x1f, 0xc0, 0xb4, 0x40, 0x02, 0x16, 0xf2, 0xa6, 0xfa, 0x0b, 0x4b, 0x35, 0x0d, 0x68,
+	0xee, 0x13, 0x41, 0x9b, 0x83, 0xf5, 0x7d, 0x2a, 0xc9, 0x7a, 0xb3, 0xc3, 0x59, 0x08, 0x76, 0x7b,
+	0x42, 0x51, 0xba, 0xb5, 0xb2, 0xb8, 0x3f, 0x58, 0xb8, 0xbc, 0xa5, 0xf5, 0xed, 0x4a, 0x22, 0xa9,
+	0xb5, 0x87, 0x2f, 0x47, 0x24, 0x26, 0x81, 0xb0, 0x51, 0x1d, 0xad, 0x96, 0x6e, 0x2d, 0x35, 0xf2,
+	0x7a, 0x1b, 0x6f, 0x29, 0x6b, 0xeb, 0x85, 0xc7, 0x89, 0x33, 0x75, 0x9a, 0x38, 0x80, 0x1e, 0x26,
+	0x4e, 0xe5, 0x88, 0x04, 0xbd, 0xdb, 0xae, 0x7e, 0x76, 0x1f, 0xfd, 0xf5, 0xe3, 0x4b, 0xc8, 0x03,
+	0xab, 0xf5, 0x09, 0xc2, 0x0b, 0x92, 0x4b, 0xd2, 0x6b, 0x07, 0x2c, 0xa4, 0x7e, 0x5b, 0xf2, 0x43,
+	0x1a, 0xda, 0x97, 0xd4, 0x0e, 0xd7, 0x1b, 0x10, 0x5e, 0xaa, 0xbe, 0x01, 0xea, 0x1b, 0x77, 0x39,
+	0x0b, 0x5b, 0xb7, 0x61, 0x93, 0xa2, 0xef, 0x30, 0x71, 0x6c, 0xbd, 0x5f, 0xc1, 0x04, 0x5b, 0xcf,
+	0x2b, 0xc3, 0x4e, 0xba, 0xbe, 0x97, 0x2e, 0x5b, 0x3f, 0x21, 0xbc, 0xd4, 0x23, 0x42, 0xb6, 0x7d,
+	0x26, 0x64, 0xcc, 0xf6, 0xfb, 0x92, 0xfa, 0x6d, 0x1a, 0xf1, 0xce, 0x81, 0x3d, 0x5d, 0x47, 0xab,
+	0xb3, 0xad, 0x8f, 0xd2, 0xdd, 0x7e, 0x4f, 0x9c, 0x17, 0xbb, 0x4c, 0x1e, 0xf4, 0xf7, 0x1b, 0x1d,
+	0x1e, 0x40, 0xe6, 0xe1, 0xdf, 0x9a, 0xf0, 0x0f, 0x9b, 0xf2, 0x28, 0xa2, 0xa2, 0xb1, 0x1d, 0xca,
+	0xd3, 0xc4, 0x39, 0x87, 0x6f, 0x98, 0x38, 0x2b, 0x5a, 0x9c, 0xd9, 0xee, 0xfe, 0xf2, 0xf3, 0x1a,
+	0x86, 0xa0, 0xb7, 0x43, 0xa9, 0xf5, 0x56, 0x53, 0xec, 0xc6, 0x18, 0xba, 0x99, 0x22, 0xad, 0xcf,
+	0x11, 0x5e, 0x64, 0x41, 0x40, 0x64, 0x3f, 0xa6, 0x6d, 0x1d, 0x2a, 0x0b, 0xef, 0x73, 0xfb, 0x99,
+	0xfa, 0xf4, 0x6a, 0xe9, 0xd6, 0xca, 0x64, 0x71, 0xb6, 0x01, 0xba, 0x97, 0x22, 0x5b, 0x6f, 0x42,
+	0xfa, 0x4c, 0x0c, 0xc3, 0xc4, 0x59, 0xd6, 0x1a, 0x0d, 0x46, 0x48, 0xe1, 0x02, 0xcb, 0xd2, 0x6d,
+	0x87, 0xf7, 0xb9, 0xf5, 0x29, 0xc2, 0x0b, 0x45, 0x35, 0x33, 0x4a, 0xcd, 0x8d, 0x49, 0x35, 0x3b,
+	0x19, 0x2d, 0x67, 0xa5, 0x34, 0x29, 0x81, 0x52, 0x9e, 0xa7, 0x63, 0x7e, 0x52, 0xc5, 0x57, 0x08,
+	0x2f, 0xb1, 0xd0, 0x67, 0x03, 0xe6, 0xf7, 0x49, 0xaf, 0x1d, 0xd3, 0x87, 0x24, 0xf6, 0xb5, 0x94,
+	0xcb, 0xf5, 0x69, 0x53, 0xd7, 0x7a, 0x0a, 0xd2, 0xda, 0x00, 0x15, 0xe7, 0x78, 0x8f, 0x0b, 0x67,
+	0xb6, 0x83, 0x9e, 0xea, 0xd8, 0xaa, 0x79, 0x95, 0xa8, 0x2f, 0x10, 0xae, 0x68, 0xa1, 0xa3, 0xb6,
+	0x7a, 0x56, 0xb5, 0xd5, 0x83, 0xa7, 0x6e, 0xab, 0x3c, 0xcd, 0x30, 0x71, 0xaa, 0xd9, 0xfc, 0x5c,
+	0xd4, 0x44, 0x65, 0x80, 0xe8, 0xe6, 0xf9, 0x18, 0x2f, 0x80, 0xf8, 0x4c, 0xad, 0x9e, 0x33, 0xd7,
+	0x4a, 0x07, 0xa2, 0x6b, 0xf5, 0xda, 0xa8, 0x56, 0x05, 0xef, 0x71, 0xad, 0x0a, 0x26, 0xd7, 0x9b,
+	0x8f, 0xc7, 0x1c, 0x2a, 0x23, 0x5f, 0x23, 0x7c, 0x35, 0xa2, 0xa1, 0xcf, 0xc2, 0x6e, 0x7b, 0xc0,
+	0x7b, 0xfd, 0x80, 0xb6, 0x63, 0x1a, 0xf1, 0x58, 0xda, 0xb3, 0xea, 0xcd, 0x7f, 0xbe, 0x30, 0x5b,
+	0x34, 0xf8, 0x6d, 0x85, 0xf5, 0x14, 0xb4, 0x75, 0xf7, 0x34, 0x71, 0x1c, 0x23, 0xcb, 0x2b, 0x3c,
+	0x60, 0x92, 0x06, 0x91, 0x3c, 0x1a, 0x26, 0xce, 0x4d, 0x98, 0x3e, 0x26, 0xa0, 0xeb, 0x2d, 0x46,
+	0x45, 0x66, 0xeb, 0x5b, 0x84, 0x97, 0x46, 0xf8, 0x87, 0xa4, 0xd7, 0xa3, 0x12, 0xdc, 0x84, 0x8d,
+	0x55, 0x92, 0xdc, 0x49, 0x7d, 0xbb, 0x2c, 0xec, 0xf6, 0xe8, 0x3b, 0x0a, 0xab, 0xa9, 0x5a, 0xdb,
+	0x90, 0xab, 0xba, 0x99, 0x29, 0xa7, 0x71, 0x25, 0xaf, 0x31, 0x8f, 0x74, 0xbd, 0x2a, 0x18, 0xb2,
+	0xfc, 0xc2, 0xfa, 0x26, 0x93, 0xc4, 0xb3, 0x49, 0xc2, 0x78, 0x28, 0xec, 0x52, 0x7d, 0xfa, 0x82,
+	0x24, 0x6e, 0x64, 0xb0, 0xad, 0x2d, 0x50, 0xe9, 0x18, 0x99, 0x2e, 0x4a, 0x64, 0x0e, 0x38, 0xd6,
+	0x98, 0x65, 0x17, 0x69, 0xa1, 0xab, 0x59, 0xe0, 0x59, 0x1e, 0xcb, 0xe6, 0x3c, 0x66, 0xbd, 0x21,
+	0x8f, 0x9b, 0xa0, 0xb0, 0x66, 0xe2, 0xc9, 0x09, 0xbc, 0xa1, 0x05, 0x9a, 0x70, 0xae, 0xb7, 0xe8,
+	0x17, 0xa8, 0x85, 0xf5, 0x1d, 0xc2, 0x4b, 0xb9, 0x86, 0x48, 0xa3, 0x8a, 0xfa, 0x92, 0x0a, 0xbb,
+	0x62, 0xce, 0x61, 0xb6, 0x4f, 0x36, 0x34, 0x76, 0x5c, 0x69, 0x33, 0x95, 0xa9, 0xd2, 0x66, 0xa4,
+	0xeb, 0x55, 0x07, 0x45, 0x7e, 0x9d, 0x45, 0x80, 0xd2, 0xf7, 0xfb, 0x6c, 0xc0, 0x3b, 0x44, 0x17,
+	0x7a, 0xce, 0x9c, 0x45, 0xed, 0xbe, 0x99, 0x81, 0x8e, 0xb3, 0x68, 0xe2, 0x31, 0x65, 0xd1, 0x84,
+	0x73, 0xbd, 0xc5, 0xb8, 0x40, 0x2d, 0xac, 0xcf, 0x10, 0xbe, 0x02, 0x6f, 0xfd, 0x01, 0x13, 0x92,
+	0xc7, 0x8c, 0x0a, 0x7b, 0xde, 0x7c, 0x0e, 0xe9, 0x69, 0x72, 0x4f, 0xc1, 0x8e, 0x5a, 0x6f, 0x80,
+	0xaa, 0xe5, 0x49, 0xf7, 0x9c, 0xa2, 0x6b, 0xb9, 0xc1, 0x72, 0x86, 0x39, 0x9b, 0x2b, 0xf7, 0x46,
+	0x2b, 0xd6, 0x11, 0xae, 0x90, 0xbe, 0xe4, 0xed, 0x98, 0x0a, 0x49, 0x0e, 0xa9, 0xb0, 0xaf, 0x98,
+	0x67, 0xda, 0x9d, 0xbe, 0xe4, 0x9e, 0xc6, 0xb4, 0x5e, 0x07, 0x0d, 0xd7, 0x72, 0x9e, 0x39, 0x01,
+	0x30, 0x65, 0x73, 0x00, 0xd7, 0x2b, 0x93, 0x31, 0x8b, 0x70, 0xff, 0x41, 0xb8, 0x92, 0x3b, 0x64,
+	0xad, 0x07, 0x78, 0x0e, 0x5e, 0x64, 0xe2, 0xfb, 0x31, 0x15, 0xfa, 0xe2, 0x34, 0xab, 0xe6, 0xd6,
+	0x84, 0x65, 0x98, 0x38, 0x57, 0xf5, 0x1e, 0xf9, 0xf5, 0x74, 0x94, 0x57, 0x61, 0x94, 0xdf, 0xd1,
+	0x4b, 0xbb, 0x32, 0x66, 0x61, 0xd7, 0xab, 0x68, 0x20, 0x2c, 0x5a, 0x1f, 0xe2, 0x99, 0x01, 0xe9,
+	0xf5, 0xa9, 0x7d, 0xa9, 0x3e, 0x7d, 0xf1, 0xcd, 0x69, 0xd4, 0xac, 0x1a, 0x3f, 0x4c, 0x9c, 0x32,
+	0x74, 0x64, 0xfa, 0xe8, 0x7e, 0xff, 0x87, 0xb3, 0xfa, 0x1f, 0x4e, 0xa3, 0x94, 0x49, 0x78, 0x9a,
+	0xc2, 0xfd, 0x1b, 0xe1, 0xd2, 0xce, 0xff, 0x30, 0xee, 0xdf, 0x10, 0x2e, 0x65, 0x0e, 0x47, 0xeb,
+	0x3d, 0x3c, 0xa3, 0x4f, 0x77, 0x1d, 0xee, 0xd6, 0x53, 0x9f, 0xee, 0x33, 0xa3, 0x53, 0x1d, 0xa4,
+	0xa9, 0x47, 0xd7, 0xd3, 0xcb, 0xd6, 0x21, 0x2e, 0xeb, 0x33, 0x55, 0x37, 0x3d, 0xdc, 0x91, 0x0b,
+	0xad, 0xad, 0xb4, 0xc0, 0xa5, 0xe6, 0x65, 0x88, 0x39, 0xe7, 0x38, 0x4c, 0x9c, 0xc5, 0xec, 0x05,
+	0x59, 0xaf, 0xba, 0x5e, 0x49, 0x66, 0x3c, 0x77, 0x1e, 0x1d, 0xd7, 0xd0, 0xe3, 0xe3, 0x1a, 0x7a,
+	0x72, 0x5c, 0x43, 0x7f, 0x1e, 0xd7, 0xd0, 0x97, 0x27, 0xb5, 0xa9, 0x27, 0x27, 0xb5, 0xa9, 0x5f,
+	0x4f, 0x6a, 0x53, 0xef, 0x36, 0x33, 0x21, 0xc1, 0xf6, 0x21, 0x95, 0xa3, 0x9f, 0x6b, 0x9d, 0x03,
+	0xc2, 0xc2, 0xe6, 0x07, 0xea, 0x9b, 0x42, 0xc5, 0xb7, 0x7f, 0x59, 0x7d, 0x53, 0xbc, 0xfa, 0xef,
+	0x00, 0xee, 0x3b, 0x22, 0xe7, 0x01, 0x0d, 0x00, 0x00,
 }
 
 func (this *GenesisState) Equal(that interface{}) bool {
@@ -389,6 +475,65 @@ func (this *GenesisState) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if !this.PendingVolumeReport.Equal(that1.PendingVolumeReport) {
+		return false
+	}
+	if len(this.PendingWalletVolumes) != len(that1.PendingWalletVolumes) {
+		return false
+	}
+	for i := range this.PendingWalletVolumes {
+		if !this.PendingWalletVolumes[i].Equal(&that1.PendingWalletVolumes[i]) {
+			return false
+		}
+	}
+	if len(this.PendingDistributions) != len(that1.PendingDistributions) {
+		return false
+	}
+	for i := range this.PendingDistributions {
+		if !this.PendingDistributions[i].Equal(&that1.PendingDistributions[i]) {
+			return false
+		}
+	}
+	if len(this.DistributionVolumes) != len(that1.DistributionVolumes) {
+		return false
+	}
+	for i := range this.DistributionVolumes {
+		if !this.DistributionVolumes[i].Equal(&that1.DistributionVolumes[i]) {
+			return false
+		}
+	}
+	if len(this.VolumeReportDisputes) != len(that1.VolumeReportDisputes) {
+		return false
+	}
+	for i := range this.VolumeReportDisputes {
+		if !this.VolumeReportDisputes[i].Equal(&that1.VolumeReportDisputes[i]) {
+			return false
+		}
+	}
+	if len(this.ReportEquivocations) != len(that1.ReportEquivocations) {
+		return false
+	}
+	for i := range this.ReportEquivocations {
+		if !this.ReportEquivocations[i].Equal(&that1.ReportEquivocations[i]) {
+			return false
+		}
+	}
+	if len(this.RewardHistories) != len(that1.RewardHistories) {
+		return false
+	}
+	for i := range this.RewardHistories {
+		if !this.RewardHistories[i].Equal(&that1.RewardHistories[i]) {
+			return false
+		}
+	}
+	if len(this.AutoRestakes) != len(that1.AutoRestakes) {
+		return false
+	}
+	for i := range this.AutoRestakes {
+		if !this.AutoRestakes[i].Equal(&that1.AutoRestakes[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (this *ImmatureTotal) Equal(that interface{}) bool {
@@ -502,6 +647,118 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AutoRestakes) > 0 {
+		for iNdEx := len(m.AutoRestakes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AutoRestakes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.RewardHistories) > 0 {
+		for iNdEx := len(m.RewardHistories) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardHistories[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.ReportEquivocations) > 0 {
+		for iNdEx := len(m.ReportEquivocations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReportEquivocations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.VolumeReportDisputes) > 0 {
+		for iNdEx := len(m.VolumeReportDisputes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VolumeReportDisputes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.DistributionVolumes) > 0 {
+		for iNdEx := len(m.DistributionVolumes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DistributionVolumes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.PendingDistributions) > 0 {
+		for iNdEx := len(m.PendingDistributions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingDistributions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.PendingWalletVolumes) > 0 {
+		for iNdEx := len(m.PendingWalletVolumes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingWalletVolumes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.PendingVolumeReport != nil {
+		{
+			size, err := m.PendingVolumeReport.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
 	if len(m.RewardTotalInfo) > 0 {
 		for iNdEx := len(m.RewardTotalInfo) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -781,6 +1038,52 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if m.PendingVolumeReport != nil {
+		l = m.PendingVolumeReport.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.PendingWalletVolumes) > 0 {
+		for _, e := range m.PendingWalletVolumes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingDistributions) > 0 {
+		for _, e := range m.PendingDistributions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DistributionVolumes) > 0 {
+		for _, e := range m.DistributionVolumes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.VolumeReportDisputes) > 0 {
+		for _, e := range m.VolumeReportDisputes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ReportEquivocations) > 0 {
+		for _, e := range m.ReportEquivocations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RewardHistories) > 0 {
+		for _, e := range m.RewardHistories {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AutoRestakes) > 0 {
+		for _, e := range m.AutoRestakes {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1140,6 +1443,280 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingVolumeReport", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PendingVolumeReport == nil {
+				m.PendingVolumeReport = &PendingVolumeReport{}
+			}
+			if err := m.PendingVolumeReport.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingWalletVolumes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingWalletVolumes = append(m.PendingWalletVolumes, SingleWalletVolume{})
+			if err := m.PendingWalletVolumes[len(m.PendingWalletVolumes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingDistributions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingDistributions = append(m.PendingDistributions, PendingDistribution{})
+			if err := m.PendingDistributions[len(m.PendingDistributions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionVolumes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DistributionVolumes = append(m.DistributionVolumes, DistributionVolume{})
+			if err := m.DistributionVolumes[len(m.DistributionVolumes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VolumeReportDisputes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VolumeReportDisputes = append(m.VolumeReportDisputes, VolumeReportDispute{})
+			if err := m.VolumeReportDisputes[len(m.VolumeReportDisputes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReportEquivocations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReportEquivocations = append(m.ReportEquivocations, ReportEquivocation{})
+			if err := m.ReportEquivocations[len(m.ReportEquivocations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardHistories", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardHistories = append(m.RewardHistories, RewardHistory{})
+			if err := m.RewardHistories[len(m.RewardHistories)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoRestakes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AutoRestakes = append(m.AutoRestakes, AutoRestake{})
+			if err := m.AutoRestakes[len(m.AutoRestakes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -3,6 +3,9 @@ package types
 import (
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+
+	stratos "github.com/stratosnet/stratos-chain/types"
 )
 
 const (
@@ -17,14 +20,25 @@ const (
 )
 
 var (
-	TotalMinedTokensKeyPrefix     = []byte{0x01}
-	LastDistributedEpochKeyPrefix = []byte{0x02}
-	IndividualRewardKeyPrefix     = []byte{0x03} // key: prefix{address}_{epoch}, the amount that is matured at {epoch}
-	MatureTotalRewardKeyPrefix    = []byte{0x04} // key: prefix{address}
-	ImmatureTotalRewardKeyPrefix  = []byte{0x05} // key: prefix{address}
-	VolumeReportStoreKeyPrefix    = []byte{0x06} // VolumeReportStoreKeyPrefix prefix for volumeReport store
-	MaturedEpochKeyPrefix         = []byte{0x07}
-	TotalRewardKeyPrefix          = []byte{0x08} // key: prefix{epoch}
+	TotalMinedTokensKeyPrefix         = []byte{0x01}
+	LastDistributedEpochKeyPrefix     = []byte{0x02}
+	IndividualRewardKeyPrefix         = []byte{0x03} // key: prefix{address}_{epoch}, the amount that is matured at {epoch}
+	MatureTotalRewardKeyPrefix        = []byte{0x04} // key: prefix{address}
+	ImmatureTotalRewardKeyPrefix      = []byte{0x05} // key: prefix{address}
+	VolumeReportStoreKeyPrefix        = []byte{0x06} // VolumeReportStoreKeyPrefix prefix for volumeReport store
+	MaturedEpochKeyPrefix             = []byte{0x07}
+	TotalRewardKeyPrefix              = []byte{0x08} // key: prefix{epoch}
+	PendingVolumeReportKey            = []byte{0x09}
+	PendingWalletVolumeKeyPrefix      = []byte{0x0a} // key: prefix{index}
+	PendingDistributionKeyPrefix      = []byte{0x0b} // key: prefix{epoch}
+	DistributionVolumeKeyPrefix       = []byte{0x0c} // key: prefix{epoch}{index}
+	VolumeReportDisputeKeyPrefix      = []byte{0x0d} // key: prefix{epoch}
+	ReportEquivocationKeyPrefix       = []byte{0x0e} // key: prefix{epoch}{accused}
+	RewardHistoryKeyPrefix            = []byte{0x0f} // key: prefix{len(address)}{address}{epoch}
+	RewardHistoryByEpochKeyPrefix     = []byte{0x10} // key: prefix{epoch}{address}, index for pruning the reward history
+	AutoRestakeKeyPrefix              = []byte{0x11} // key: prefix{address}
+	IndividualRewardByWalletKeyPrefix = []byte{0x12} // key: prefix{len(address)}{address}{epoch}, index of the individual rewards by wallet
+	VestingScheduleKeyPrefix          = []byte{0x13} // key: prefix{address}
 
 	ParamsKey = []byte{0x20}
 )
@@ -67,3 +81,74 @@ func GetImmatureTotalRewardKey(acc sdk.AccAddress) []byte {
 func GetTotalRewardKey(epoch sdkmath.Int) []byte {
 	return append(TotalRewardKeyPrefix, epoch.String()...)
 }
+
+// GetPendingWalletVolumeKey prefix{index}
+func GetPendingWalletVolumeKey(index uint64) []byte {
+	return append(PendingWalletVolumeKeyPrefix, sdk.Uint64ToBigEndian(index)...)
+}
+
+// GetPendingDistributionKey prefix{epoch}, the epoch is big endian encoded to distribute the reports in epoch order
+func GetPendingDistributionKey(epoch sdkmath.Int) []byte {
+	return append(PendingDistributionKeyPrefix, sdk.Uint64ToBigEndian(epoch.Uint64())...)
+}
+
+// GetDistributionVolumeIteratorKey prefix{epoch}
+func GetDistributionVolumeIteratorKey(epoch sdkmath.Int) []byte {
+	return append(DistributionVolumeKeyPrefix, sdk.Uint64ToBigEndian(epoch.Uint64())...)
+}
+
+// GetDistributionVolumeKey prefix{epoch}{index}
+func GetDistributionVolumeKey(epoch sdkmath.Int, index uint64) []byte {
+	return append(GetDistributionVolumeIteratorKey(epoch), sdk.Uint64ToBigEndian(index)...)
+}
+
+// GetVolumeReportDisputeKey prefix{epoch}
+func GetVolumeReportDisputeKey(epoch sdkmath.Int) []byte {
+	return append(VolumeReportDisputeKeyPrefix, sdk.Uint64ToBigEndian(epoch.Uint64())...)
+}
+
+// GetReportEquivocationKey prefix{epoch}{accused}
+func GetReportEquivocationKey(epoch sdkmath.Int, accused stratos.SdsAddress) []byte {
+	return append(append(ReportEquivocationKeyPrefix, sdk.Uint64ToBigEndian(epoch.Uint64())...), accused.Bytes()...)
+}
+
+// GetRewardHistoryIteratorKey prefix{len(address)}{address}
+func GetRewardHistoryIteratorKey(acc sdk.AccAddress) []byte {
+	return append(RewardHistoryKeyPrefix, address.MustLengthPrefix(acc.Bytes())...)
+}
+
+// GetRewardHistoryKey prefix{len(address)}{address}{epoch}, the epoch is big endian encoded to iterate the history in epoch order
+func GetRewardHistoryKey(acc sdk.AccAddress, epoch sdkmath.Int) []byte {
+	return append(GetRewardHistoryIteratorKey(acc), sdk.Uint64ToBigEndian(epoch.Uint64())...)
+}
+
+// GetRewardHistoryByEpochIteratorKey prefix{epoch}
+func GetRewardHistoryByEpochIteratorKey(epoch sdkmath.Int) []byte {
+	return append(RewardHistoryByEpochKeyPrefix, sdk.Uint64ToBigEndian(epoch.Uint64())...)
+}
+
+// GetRewardHistoryByEpochKey prefix{epoch}{address}
+func GetRewardHistoryByEpochKey(acc sdk.AccAddress, epoch sdkmath.Int) []byte {
+	return append(GetRewardHistoryByEpochIteratorKey(epoch), acc.Bytes()...)
+}
+
+// GetIndividualRewardByWalletIteratorKey prefix{len(address)}{address}
+func GetIndividualRewardByWalletIteratorKey(acc sdk.AccAddress) []byte {
+	return append(IndividualRewardByWalletKeyPrefix, address.MustLengthPrefix(acc.Bytes())...)
+}
+
+// GetIndividualRewardByWalletKey prefix{len(address)}{address}{epoch}, the epoch is big endian encoded to iterate the
+// individual rewards of a wallet in epoch order
+func GetIndividualRewardByWalletKey(acc sdk.AccAddress, epoch sdkmath.Int) []byte {
+	return append(GetIndividualRewardByWalletIteratorKey(acc), sdk.Uint64ToBigEndian(epoch.Uint64())...)
+}
+
+// GetAutoRestakeKey prefix{address}
+func GetAutoRestakeKey(acc sdk.AccAddress) []byte {
+	return append(AutoRestakeKeyPrefix, acc.Bytes()...)
+}
+
+// GetVestingScheduleKey prefix{address}
+func GetVestingScheduleKey(acc sdk.AccAddress) []byte {
+	return append(VestingScheduleKeyPrefix, acc.Bytes()...)
+}
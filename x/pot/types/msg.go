@@ -1,6 +1,7 @@
 package types
 
 import (
+	"cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/gogoproto/proto"
@@ -11,18 +12,30 @@ import (
 // verify interface at compile time
 var (
 	_ sdk.Msg = &MsgVolumeReport{}
+	_ sdk.Msg = &MsgVolumeReportHeader{}
+	_ sdk.Msg = &MsgVolumeReportChunk{}
 	_ sdk.Msg = &MsgWithdraw{}
 	_ sdk.Msg = &MsgFoundationDeposit{}
 	_ sdk.Msg = &MsgSlashingResourceNode{}
+	_ sdk.Msg = &MsgDisputeVolumeReport{}
+	_ sdk.Msg = &MsgSubmitReportEquivocation{}
+	_ sdk.Msg = &MsgSetAutoRestake{}
 	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgResolveVolumeReportDispute{}
 )
 
 const (
 	TypeMsgVolumeReport         = "volume_report"
+	TypeMsgVolumeReportHeader   = "volume_report_header"
+	TypeMsgVolumeReportChunk    = "volume_report_chunk"
 	TypeMsgWithdraw             = "withdraw"
 	TypeMsgFoundationDeposit    = "foundation_deposit"
 	TypeMsgSlashingResourceNode = "slashing_resource_node"
+	TypeMsgDisputeVolumeReport  = "dispute_volume_report"
+	TypeMsgReportEquivocation   = "submit_report_equivocation"
+	TypeMsgSetAutoRestake       = "set_auto_restake"
 	TypeMsgUpdateParams         = "update_params"
+	TypeMsgResolveDispute       = "resolve_volume_report_dispute"
 )
 
 // NewMsgVolumeReport creates a new MsgVolumeReport instance
@@ -125,6 +138,184 @@ func (msg MsgVolumeReport) ValidateBasic() error {
 
 // --------------------------------------------------------------------------------------------------------------------
 
+// NewMsgVolumeReportHeader creates a new MsgVolumeReportHeader instance
+func NewMsgVolumeReportHeader(
+	reporter stratos.SdsAddress,
+	epoch sdkmath.Int,
+	reportReference string,
+	reporterOwner sdk.AccAddress,
+	totalCount uint64,
+	volumesRoot []byte,
+) *MsgVolumeReportHeader {
+	return &MsgVolumeReportHeader{
+		Reporter:        reporter.String(),
+		Epoch:           epoch,
+		ReportReference: reportReference,
+		ReporterOwner:   reporterOwner.String(),
+		TotalCount:      totalCount,
+		VolumesRoot:     volumesRoot,
+	}
+}
+
+// Route Implement
+func (msg MsgVolumeReportHeader) Route() string { return RouterKey }
+
+// GetSigners Implement
+func (msg MsgVolumeReportHeader) GetSigners() []sdk.AccAddress {
+	reporterOwner, err := sdk.AccAddressFromBech32(msg.ReporterOwner)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{reporterOwner}
+}
+
+// Type Implement
+func (msg MsgVolumeReportHeader) Type() string { return TypeMsgVolumeReportHeader }
+
+// GetSignBytes gets the bytes for the message signer to sign on
+func (msg MsgVolumeReportHeader) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgVolumeReportHeader) GetBLSSignBytes() []byte {
+	msg.BLSSignature = BLSSignatureInfo{}
+	bz, err := proto.Marshal(&msg)
+	if err != nil {
+		panic(err)
+	}
+	return bz
+}
+
+// ValidateBasic validity check for the AnteHandler
+func (msg MsgVolumeReportHeader) ValidateBasic() error {
+	if len(msg.Reporter) == 0 {
+		return ErrEmptyReporterAddr
+	}
+	if !(msg.Epoch.IsPositive()) {
+		return ErrEpochNotPositive
+	}
+	if !(len(msg.ReportReference) > 0) {
+		return ErrEmptyReportReference
+	}
+	if len(msg.ReporterOwner) == 0 {
+		return ErrReporterOwnerAddr
+	}
+
+	_, err := sdk.AccAddressFromBech32(msg.GetReporterOwner())
+	if err != nil {
+		return ErrReporterAddress
+	}
+
+	if msg.TotalCount == 0 {
+		return ErrEmptyWalletVolumes
+	}
+	if len(msg.VolumesRoot) != VolumesRootLength {
+		return ErrInvalidVolumesRoot
+	}
+
+	if len(msg.BLSSignature.Signature) == 0 {
+		return ErrBLSSignatureInvalid
+	}
+	if len(msg.BLSSignature.TxData) == 0 {
+		return ErrBLSTxDataInvalid
+	}
+	for _, pubKey := range msg.BLSSignature.PubKeys {
+		if len(pubKey) == 0 {
+			return ErrBLSPubkeysInvalid
+		}
+	}
+
+	return nil
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// NewMsgVolumeReportChunk creates a new MsgVolumeReportChunk instance
+func NewMsgVolumeReportChunk(
+	reporter stratos.SdsAddress,
+	reporterOwner sdk.AccAddress,
+	epoch sdkmath.Int,
+	startIndex uint64,
+	walletVolumes []SingleWalletVolume,
+	proofs []VolumeInclusionProof,
+) *MsgVolumeReportChunk {
+	return &MsgVolumeReportChunk{
+		Reporter:      reporter.String(),
+		ReporterOwner: reporterOwner.String(),
+		Epoch:         epoch,
+		StartIndex:    startIndex,
+		WalletVolumes: walletVolumes,
+		Proofs:        proofs,
+	}
+}
+
+// Route Implement
+func (msg MsgVolumeReportChunk) Route() string { return RouterKey }
+
+// GetSigners Implement
+func (msg MsgVolumeReportChunk) GetSigners() []sdk.AccAddress {
+	reporterOwner, err := sdk.AccAddressFromBech32(msg.ReporterOwner)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{reporterOwner}
+}
+
+// Type Implement
+func (msg MsgVolumeReportChunk) Type() string { return TypeMsgVolumeReportChunk }
+
+// GetSignBytes gets the bytes for the message signer to sign on
+func (msg MsgVolumeReportChunk) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic validity check for the AnteHandler
+func (msg MsgVolumeReportChunk) ValidateBasic() error {
+	if len(msg.Reporter) == 0 {
+		return ErrEmptyReporterAddr
+	}
+	if len(msg.ReporterOwner) == 0 {
+		return ErrReporterOwnerAddr
+	}
+
+	_, err := sdk.AccAddressFromBech32(msg.GetReporterOwner())
+	if err != nil {
+		return ErrReporterAddress
+	}
+
+	if !(msg.Epoch.IsPositive()) {
+		return ErrEpochNotPositive
+	}
+	if !(len(msg.WalletVolumes) > 0) {
+		return ErrEmptyWalletVolumes
+	}
+	if len(msg.Proofs) != len(msg.WalletVolumes) {
+		return errors.Wrapf(ErrInvalidVolumeChunk, "got %d proofs for %d wallet volumes", len(msg.Proofs), len(msg.WalletVolumes))
+	}
+
+	for _, item := range msg.WalletVolumes {
+		if item.Volume.IsNegative() {
+			return ErrNegativeVolume
+		}
+		if len(item.WalletAddress) == 0 {
+			return ErrMissingWalletAddress
+		}
+	}
+	for _, proof := range msg.Proofs {
+		for _, hash := range proof.GetHashes() {
+			if len(hash) != VolumesRootLength {
+				return ErrInvalidVolumeProof
+			}
+		}
+	}
+
+	return nil
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
 func NewMsgWithdraw(amount sdk.Coins, walletAddress sdk.AccAddress, targetAddress sdk.AccAddress) *MsgWithdraw {
 	return &MsgWithdraw{
 		Amount:        amount,
@@ -298,6 +489,244 @@ func (msg MsgSlashingResourceNode) GetSigners() []sdk.AccAddress {
 
 // --------------------------------------------------------------------------------------------------------------------
 
+// NewMsgDisputeVolumeReport creates a new MsgDisputeVolumeReport instance
+func NewMsgDisputeVolumeReport(
+	disputer sdk.AccAddress,
+	reporter stratos.SdsAddress,
+	epoch sdkmath.Int,
+	walletVolumes []SingleWalletVolume,
+	reason string,
+) *MsgDisputeVolumeReport {
+	return &MsgDisputeVolumeReport{
+		Disputer:      disputer.String(),
+		Reporter:      reporter.String(),
+		Epoch:         epoch,
+		WalletVolumes: walletVolumes,
+		Reason:        reason,
+	}
+}
+
+// Route Implement
+func (msg MsgDisputeVolumeReport) Route() string { return RouterKey }
+
+// GetSigners Implement
+func (msg MsgDisputeVolumeReport) GetSigners() []sdk.AccAddress {
+	disputer, err := sdk.AccAddressFromBech32(msg.Disputer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{disputer}
+}
+
+// Type Implement
+func (msg MsgDisputeVolumeReport) Type() string { return TypeMsgDisputeVolumeReport }
+
+// GetSignBytes gets the bytes for the message signer to sign on
+func (msg MsgDisputeVolumeReport) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetBLSSignBytes returns the bytes of the counter-report signed by the disputing meta nodes
+func (msg MsgDisputeVolumeReport) GetBLSSignBytes() []byte {
+	msg.BLSSignature = BLSSignatureInfo{}
+	bz, err := proto.Marshal(&msg)
+	if err != nil {
+		panic(err)
+	}
+	return bz
+}
+
+// ValidateBasic validity check for the AnteHandler
+func (msg MsgDisputeVolumeReport) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.GetDisputer())
+	if err != nil {
+		return errors.Wrap(ErrInvalidAddress, err.Error())
+	}
+	if !(msg.Epoch.IsPositive()) {
+		return ErrEpochNotPositive
+	}
+	if len(msg.GetReason()) == 0 {
+		return ErrEmptyDisputeReason
+	}
+
+	for _, item := range msg.WalletVolumes {
+		if item.Volume.IsNegative() {
+			return ErrNegativeVolume
+		}
+		if len(item.WalletAddress) == 0 {
+			return ErrMissingWalletAddress
+		}
+	}
+
+	// the counter-report is the evidence of every dispute, it must be signed when filed by a meta node
+	if !(len(msg.WalletVolumes) > 0) {
+		return ErrEmptyWalletVolumes
+	}
+	if len(msg.Reporter) == 0 {
+		return nil
+	}
+	if len(msg.BLSSignature.Signature) == 0 {
+		return ErrBLSSignatureInvalid
+	}
+	if len(msg.BLSSignature.TxData) == 0 {
+		return ErrBLSTxDataInvalid
+	}
+	if len(msg.BLSSignature.PubKeys) == 0 {
+		return ErrBLSPubkeysInvalid
+	}
+	for _, pubKey := range msg.BLSSignature.PubKeys {
+		if len(pubKey) == 0 {
+			return ErrBLSPubkeysInvalid
+		}
+	}
+	return nil
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// NewMsgSubmitReportEquivocation creates a new MsgSubmitReportEquivocation instance
+func NewMsgSubmitReportEquivocation(
+	reporter stratos.SdsAddress,
+	reporterOwner sdk.AccAddress,
+	accused stratos.SdsAddress,
+	epoch sdkmath.Int,
+	firstReport []byte,
+	firstChunked bool,
+	firstSignature BLSSignatureInfo,
+	secondReport []byte,
+	secondChunked bool,
+	secondSignature BLSSignatureInfo,
+	accusedPubKey []byte,
+	accusedSignature []byte,
+	kick bool,
+) *MsgSubmitReportEquivocation {
+	return &MsgSubmitReportEquivocation{
+		Reporter:         reporter.String(),
+		ReporterOwner:    reporterOwner.String(),
+		Accused:          accused.String(),
+		Epoch:            epoch,
+		FirstReport:      firstReport,
+		FirstChunked:     firstChunked,
+		FirstSignature:   firstSignature,
+		SecondReport:     secondReport,
+		SecondChunked:    secondChunked,
+		SecondSignature:  secondSignature,
+		AccusedPubKey:    accusedPubKey,
+		AccusedSignature: accusedSignature,
+		Kick:             kick,
+	}
+}
+
+// Route Implement
+func (msg MsgSubmitReportEquivocation) Route() string { return RouterKey }
+
+// GetSigners Implement
+func (msg MsgSubmitReportEquivocation) GetSigners() []sdk.AccAddress {
+	reporterOwner, err := sdk.AccAddressFromBech32(msg.ReporterOwner)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{reporterOwner}
+}
+
+// Type Implement
+func (msg MsgSubmitReportEquivocation) Type() string { return TypeMsgReportEquivocation }
+
+// GetSignBytes gets the bytes for the message signer to sign on
+func (msg MsgSubmitReportEquivocation) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic validity check for the AnteHandler
+func (msg MsgSubmitReportEquivocation) ValidateBasic() error {
+	if len(msg.Reporter) == 0 {
+		return ErrEmptyReporterAddr
+	}
+	if len(msg.ReporterOwner) == 0 {
+		return ErrReporterOwnerAddr
+	}
+	_, err := sdk.AccAddressFromBech32(msg.GetReporterOwner())
+	if err != nil {
+		return ErrReporterOwnerAddr
+	}
+	_, err = stratos.SdsAddressFromBech32(msg.GetAccused())
+	if err != nil {
+		return errors.Wrap(ErrInvalidAddress, err.Error())
+	}
+	if !(msg.Epoch.IsPositive()) {
+		return ErrEpochNotPositive
+	}
+
+	if len(msg.FirstReport) == 0 || len(msg.SecondReport) == 0 {
+		return errors.Wrap(ErrInvalidEquivocation, "missing volume report")
+	}
+	for _, signature := range []BLSSignatureInfo{msg.FirstSignature, msg.SecondSignature} {
+		if len(signature.Signature) == 0 {
+			return ErrBLSSignatureInvalid
+		}
+		if len(signature.TxData) == 0 {
+			return ErrBLSTxDataInvalid
+		}
+	}
+	if len(msg.AccusedPubKey) == 0 {
+		return ErrBLSPubkeysInvalid
+	}
+	if len(msg.AccusedSignature) == 0 {
+		return ErrBLSSignatureInvalid
+	}
+	return nil
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// NewMsgSetAutoRestake creates a new MsgSetAutoRestake instance, an empty networkAddress disables auto-restake
+func NewMsgSetAutoRestake(walletAddress sdk.AccAddress, networkAddress string) *MsgSetAutoRestake {
+	return &MsgSetAutoRestake{
+		WalletAddress:  walletAddress.String(),
+		NetworkAddress: networkAddress,
+	}
+}
+
+// Route Implement
+func (msg MsgSetAutoRestake) Route() string { return RouterKey }
+
+// GetSigners Implement
+func (msg MsgSetAutoRestake) GetSigners() []sdk.AccAddress {
+	walletAddress, err := sdk.AccAddressFromBech32(msg.WalletAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{walletAddress}
+}
+
+// Type Implement
+func (msg MsgSetAutoRestake) Type() string { return TypeMsgSetAutoRestake }
+
+// GetSignBytes gets the bytes for the message signer to sign on
+func (msg MsgSetAutoRestake) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic validity check for the AnteHandler
+func (msg MsgSetAutoRestake) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.GetWalletAddress())
+	if err != nil {
+		return errors.Wrap(ErrInvalidAddress, err.Error())
+	}
+	if len(msg.GetNetworkAddress()) > 0 {
+		_, err = stratos.SdsAddressFromBech32(msg.GetNetworkAddress())
+		if err != nil {
+			return errors.Wrap(ErrInvalidAddress, err.Error())
+		}
+	}
+	return nil
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
 func NewMsgUpdateParams(params Params, authority string) *MsgUpdateParams {
 	return &MsgUpdateParams{
 		Params:    params,
@@ -336,3 +765,62 @@ func (msg *MsgUpdateParams) GetSignBytes() []byte {
 	bz := ModuleCdc.MustMarshalJSON(msg)
 	return sdk.MustSortJSON(bz)
 }
+
+// --------------------------------------------------------------------------------------------------------------------
+
+func NewMsgResolveVolumeReportDispute(authority string, epoch sdkmath.Int, cancel bool,
+	walletVolumes []SingleWalletVolume) *MsgResolveVolumeReportDispute {
+
+	return &MsgResolveVolumeReportDispute{
+		Authority:     authority,
+		Epoch:         epoch,
+		Cancel:        cancel,
+		WalletVolumes: walletVolumes,
+	}
+}
+
+// Route implements legacytx.LegacyMsg
+func (msg *MsgResolveVolumeReportDispute) Route() string {
+	return RouterKey
+}
+
+// Type implements legacytx.LegacyMsg
+func (msg *MsgResolveVolumeReportDispute) Type() string {
+	return TypeMsgResolveDispute
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg *MsgResolveVolumeReportDispute) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return err
+	}
+	if !(msg.Epoch.IsPositive()) {
+		return ErrEpochNotPositive
+	}
+	if msg.Cancel && len(msg.WalletVolumes) > 0 {
+		return errors.Wrap(ErrInvalid, "a cancelled volume report cannot be amended")
+	}
+
+	for _, item := range msg.WalletVolumes {
+		if item.Volume.IsNegative() {
+			return ErrNegativeVolume
+		}
+		if len(item.WalletAddress) == 0 {
+			return ErrMissingWalletAddress
+		}
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (msg *MsgResolveVolumeReportDispute) GetSigners() []sdk.AccAddress {
+	authority := sdk.MustAccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+// GetSignBytes implements legacytx.LegacyMsg
+func (msg *MsgResolveVolumeReportDispute) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
@@ -14,26 +14,52 @@ const (
 	DefaultBondDenom   = stratos.Wei
 	DefaultRewardDenom = stratos.Wei
 	DefaultMatureEpoch = 2016
+
+	DefaultVolumeReportChunkTimeout = int64(600)
+	DefaultDistributeBatchSize      = int64(500)
+	DefaultDisputeWindow            = int64(100)
+	DefaultVestingMode              = VestingModeCliff
+	DefaultVestingEpochs            = int64(144)  // about one day
+	DefaultRewardHistoryRetention   = int64(4320) // about 30 days
+
+	MaxVestingEpochs = int64(4320) // about 30 days
+)
+
+const (
+	// VestingModeCliff matures an individual reward all at once mature_epoch epochs after distribution
+	VestingModeCliff = "cliff"
+	// VestingModeLinear unlocks an individual reward linearly over the vesting_epochs epochs following its mature epoch
+	VestingModeLinear = "linear"
 )
 
 var (
-	DefaultCommunityTax       = sdkmath.LegacyNewDecWithPrec(2, 2) // 2%
-	DefaultInitialTotalSupply = sdk.NewCoin(DefaultBondDenom,
+	DefaultCommunityTax              = sdkmath.LegacyNewDecWithPrec(2, 2) // 2%
+	DefaultEquivocationSlashFraction = sdkmath.LegacyNewDecWithPrec(5, 2) // 5%
+	DefaultInitialTotalSupply        = sdk.NewCoin(DefaultBondDenom,
 		sdkmath.NewInt(1e8).MulRaw(stratos.StosToWei),
 	) //100,000,000 stos
 )
 
 // NewParams creates a new Params object
 func NewParams(bondDenom string, rewardDenom string, matureEpoch int64, miningRewardParams []MiningRewardParam,
-	communityTax sdkmath.LegacyDec, initialTotalSupply sdk.Coin) Params {
+	communityTax sdkmath.LegacyDec, initialTotalSupply sdk.Coin, volumeReportChunkTimeout int64,
+	distributeBatchSize int64, disputeWindow int64, equivocationSlashFraction sdkmath.LegacyDec,
+	vestingMode string, vestingEpochs int64, rewardHistoryRetention int64) Params {
 
 	return Params{
-		BondDenom:          bondDenom,
-		RewardDenom:        rewardDenom,
-		MatureEpoch:        matureEpoch,
-		MiningRewardParams: miningRewardParams,
-		CommunityTax:       communityTax,
-		InitialTotalSupply: initialTotalSupply,
+		BondDenom:                 bondDenom,
+		RewardDenom:               rewardDenom,
+		MatureEpoch:               matureEpoch,
+		MiningRewardParams:        miningRewardParams,
+		CommunityTax:              communityTax,
+		InitialTotalSupply:        initialTotalSupply,
+		VolumeReportChunkTimeout:  volumeReportChunkTimeout,
+		DistributeBatchSize:       distributeBatchSize,
+		DisputeWindow:             disputeWindow,
+		EquivocationSlashFraction: equivocationSlashFraction,
+		VestingMode:               vestingMode,
+		VestingEpochs:             vestingEpochs,
+		RewardHistoryRetention:    rewardHistoryRetention,
 	}
 }
 
@@ -83,6 +109,13 @@ func DefaultParams() Params {
 		miningRewardParams,
 		DefaultCommunityTax,
 		DefaultInitialTotalSupply,
+		DefaultVolumeReportChunkTimeout,
+		DefaultDistributeBatchSize,
+		DefaultDisputeWindow,
+		DefaultEquivocationSlashFraction,
+		DefaultVestingMode,
+		DefaultVestingEpochs,
+		DefaultRewardHistoryRetention,
 	)
 }
 
@@ -105,6 +138,27 @@ func (p Params) Validate() error {
 	if err := validateInitialTotalSupply(p.InitialTotalSupply); err != nil {
 		return errors.Wrap(ErrInitialTotalSupply, err.Error())
 	}
+	if err := validateVolumeReportChunkTimeout(p.VolumeReportChunkTimeout); err != nil {
+		return errors.Wrap(ErrInvalid, err.Error())
+	}
+	if err := validateDistributeBatchSize(p.DistributeBatchSize); err != nil {
+		return errors.Wrap(ErrInvalid, err.Error())
+	}
+	if err := validateDisputeWindow(p.DisputeWindow); err != nil {
+		return errors.Wrap(ErrInvalid, err.Error())
+	}
+	if err := validateEquivocationSlashFraction(p.EquivocationSlashFraction); err != nil {
+		return errors.Wrap(ErrInvalid, err.Error())
+	}
+	if err := validateVestingMode(p.VestingMode); err != nil {
+		return errors.Wrap(ErrInvalid, err.Error())
+	}
+	if err := validateVestingEpochs(p.VestingEpochs); err != nil {
+		return errors.Wrap(ErrInvalid, err.Error())
+	}
+	if err := validateRewardHistoryRetention(p.RewardHistoryRetention); err != nil {
+		return errors.Wrap(ErrInvalid, err.Error())
+	}
 	return nil
 }
 
@@ -200,3 +254,103 @@ func validateInitialTotalSupply(i interface{}) error {
 	}
 	return nil
 }
+
+func validateVolumeReportChunkTimeout(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v <= 0 {
+		return fmt.Errorf("volume report chunk timeout must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateDistributeBatchSize(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v <= 0 {
+		return fmt.Errorf("distribute batch size must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateDisputeWindow(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("dispute window cannot be negative: %d", v)
+	}
+
+	return nil
+}
+
+func validateEquivocationSlashFraction(i interface{}) error {
+	v, ok := i.(sdkmath.LegacyDec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("equivocation slash fraction must be not nil")
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("equivocation slash fraction must be positive: %s", v)
+	}
+	if v.GTE(sdkmath.LegacyOneDec()) {
+		return fmt.Errorf("equivocation slash fraction must be less than one: %s", v)
+	}
+
+	return nil
+}
+
+func validateVestingMode(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v != VestingModeCliff && v != VestingModeLinear {
+		return fmt.Errorf("vesting mode must be %s or %s: %s", VestingModeCliff, VestingModeLinear, v)
+	}
+
+	return nil
+}
+
+func validateVestingEpochs(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v <= 0 {
+		return fmt.Errorf("vesting epochs must be positive: %d", v)
+	}
+	if v > MaxVestingEpochs {
+		return fmt.Errorf("vesting epochs must not exceed %d: %d", MaxVestingEpochs, v)
+	}
+
+	return nil
+}
+
+func validateRewardHistoryRetention(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("reward history retention cannot be negative: %d", v)
+	}
+
+	return nil
+}
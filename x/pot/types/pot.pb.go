@@ -4,6 +4,7 @@
 package types
 
 import (
+	bytes "bytes"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
@@ -35,6 +36,21 @@ type Params struct {
 	MiningRewardParams []MiningRewardParam                    `protobuf:"bytes,4,rep,name=mining_reward_params,json=miningRewardParams,proto3" json:"mining_reward_params" yaml:"mining_reward_params"`
 	CommunityTax       github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=community_tax,json=communityTax,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"community_tax" yaml:"community_tax"`
 	InitialTotalSupply types.Coin                             `protobuf:"bytes,6,opt,name=initial_total_supply,json=initialTotalSupply,proto3" json:"initial_total_supply" yaml:"initial_total_supply"`
+	// volume_report_chunk_timeout is the number of blocks in which all chunks of a volume report must be submitted
+	VolumeReportChunkTimeout int64 `protobuf:"varint,7,opt,name=volume_report_chunk_timeout,json=volumeReportChunkTimeout,proto3" json:"volume_report_chunk_timeout" yaml:"volume_report_chunk_timeout"`
+	// distribute_batch_size is the maximum number of wallet volumes rewarded in a block
+	DistributeBatchSize int64 `protobuf:"varint,8,opt,name=distribute_batch_size,json=distributeBatchSize,proto3" json:"distribute_batch_size" yaml:"distribute_batch_size"`
+	// dispute_window is the number of blocks in which an accepted volume report can be disputed
+	DisputeWindow int64 `protobuf:"varint,9,opt,name=dispute_window,json=disputeWindow,proto3" json:"dispute_window" yaml:"dispute_window"`
+	// equivocation_slash_fraction is the fraction of the deposit slashed from a meta node signing conflicting volume reports
+	EquivocationSlashFraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=equivocation_slash_fraction,json=equivocationSlashFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"equivocation_slash_fraction" yaml:"equivocation_slash_fraction"`
+	// vesting_mode selects how individual rewards mature, either "cliff" or "linear"
+	VestingMode string `protobuf:"bytes,11,opt,name=vesting_mode,json=vestingMode,proto3" json:"vesting_mode" yaml:"vesting_mode"`
+	// vesting_epochs is the number of epochs over which an individual reward unlocks in linear vesting mode once it
+	// reaches its mature epoch. It is at most 4320.
+	VestingEpochs int64 `protobuf:"varint,12,opt,name=vesting_epochs,json=vestingEpochs,proto3" json:"vesting_epochs" yaml:"vesting_epochs"`
+	// reward_history_retention is the number of matured epochs the reward history is kept for, 0 keeps it forever
+	RewardHistoryRetention int64 `protobuf:"varint,13,opt,name=reward_history_retention,json=rewardHistoryRetention,proto3" json:"reward_history_retention" yaml:"reward_history_retention"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -105,6 +121,48 @@ func (m *Params) GetInitialTotalSupply() types.Coin {
 	return types.Coin{}
 }
 
+func (m *Params) GetVolumeReportChunkTimeout() int64 {
+	if m != nil {
+		return m.VolumeReportChunkTimeout
+	}
+	return 0
+}
+
+func (m *Params) GetDistributeBatchSize() int64 {
+	if m != nil {
+		return m.DistributeBatchSize
+	}
+	return 0
+}
+
+func (m *Params) GetDisputeWindow() int64 {
+	if m != nil {
+		return m.DisputeWindow
+	}
+	return 0
+}
+
+func (m *Params) GetVestingMode() string {
+	if m != nil {
+		return m.VestingMode
+	}
+	return ""
+}
+
+func (m *Params) GetVestingEpochs() int64 {
+	if m != nil {
+		return m.VestingEpochs
+	}
+	return 0
+}
+
+func (m *Params) GetRewardHistoryRetention() int64 {
+	if m != nil {
+		return m.RewardHistoryRetention
+	}
+	return 0
+}
+
 type MiningRewardParam struct {
 	TotalMinedValveStart       types.Coin                             `protobuf:"bytes,1,opt,name=total_mined_valve_start,json=totalMinedValveStart,proto3" json:"total_mined_valve_start" yaml:"total_mined_valve_start"`
 	TotalMinedValveEnd         types.Coin                             `protobuf:"bytes,2,opt,name=total_mined_valve_end,json=totalMinedValveEnd,proto3" json:"total_mined_valve_end" yaml:"total_mined_valve_end"`
@@ -480,383 +538,928 @@ func (m *Metrics) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Metrics proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*Params)(nil), "stratos.pot.v1.Params")
-	proto.RegisterType((*MiningRewardParam)(nil), "stratos.pot.v1.MiningRewardParam")
-	proto.RegisterType((*Reward)(nil), "stratos.pot.v1.Reward")
-	proto.RegisterType((*SingleWalletVolume)(nil), "stratos.pot.v1.SingleWalletVolume")
-	proto.RegisterType((*WalletVolumes)(nil), "stratos.pot.v1.WalletVolumes")
-	proto.RegisterType((*VolumeReportRecord)(nil), "stratos.pot.v1.VolumeReportRecord")
-	proto.RegisterType((*TotalReward)(nil), "stratos.pot.v1.TotalReward")
-	proto.RegisterType((*Metrics)(nil), "stratos.pot.v1.Metrics")
+// VolumeInclusionProof is the merkle proof of a wallet volume against the volumes root of a chunked volume report
+type VolumeInclusionProof struct {
+	Hashes [][]byte `protobuf:"bytes,1,rep,name=hashes,proto3" json:"hashes" yaml:"hashes"`
 }
 
-func init() { proto.RegisterFile("stratos/pot/v1/pot.proto", fileDescriptor_a05930b44d981057) }
-
-var fileDescriptor_a05930b44d981057 = []byte{
-	// 1685 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0x4f, 0x6c, 0xdc, 0x44,
-	0x17, 0xcf, 0x34, 0x6d, 0xd2, 0x4c, 0xfe, 0x35, 0xce, 0xa6, 0xdd, 0xe4, 0x6b, 0x77, 0x52, 0x57,
-	0x5f, 0xbf, 0xa8, 0x52, 0x77, 0x95, 0x7e, 0xd2, 0xf7, 0x49, 0x3d, 0xc1, 0x36, 0xad, 0x28, 0x52,
-	0x50, 0xb4, 0x09, 0x45, 0xaa, 0x10, 0xc6, 0xf1, 0x4e, 0x36, 0x6e, 0xd7, 0x33, 0x2b, 0x7b, 0x36,
-	0x4d, 0x10, 0xe2, 0x80, 0x10, 0x42, 0xfc, 0x11, 0xbd, 0x20, 0xca, 0xa5, 0x42, 0x45, 0x42, 0x15,
-	0x07, 0x54, 0x10, 0xe2, 0x86, 0x10, 0x82, 0x43, 0xe1, 0x54, 0xe0, 0x82, 0x38, 0x0c, 0x28, 0x3d,
-	0x54, 0xda, 0xa3, 0x0f, 0xdc, 0x90, 0x90, 0x67, 0xc6, 0x6b, 0x7b, 0xd7, 0xde, 0xb0, 0x01, 0x71,
-	0x49, 0xec, 0xf7, 0xde, 0xfc, 0xe6, 0xf7, 0x9e, 0xdf, 0x7b, 0xf3, 0x66, 0x61, 0xde, 0x63, 0xae,
-	0xc9, 0xa8, 0x57, 0x6a, 0x50, 0x56, 0xda, 0x5a, 0x0c, 0xfe, 0x15, 0x1b, 0x2e, 0x65, 0x54, 0x9b,
-	0x50, 0x9a, 0x62, 0x20, 0xda, 0x5a, 0x9c, 0xcb, 0xd5, 0x68, 0x8d, 0x0a, 0x55, 0x29, 0x78, 0x92,
-	0x56, 0x73, 0xb3, 0x16, 0xf5, 0x1c, 0xea, 0x19, 0x52, 0x21, 0x5f, 0x94, 0x6a, 0xca, 0x74, 0x6c,
-	0x42, 0x4b, 0xe2, 0xaf, 0x12, 0x15, 0xa4, 0x41, 0x69, 0xdd, 0xf4, 0x70, 0x69, 0x6b, 0x71, 0x1d,
-	0x33, 0x73, 0xb1, 0x64, 0x51, 0x9b, 0x48, 0xbd, 0xfe, 0xc5, 0x21, 0x38, 0xb4, 0x62, 0xba, 0xa6,
-	0xe3, 0x69, 0x65, 0x08, 0xd7, 0x29, 0xa9, 0x1a, 0x55, 0x4c, 0xa8, 0x93, 0x07, 0xf3, 0x60, 0x61,
-	0xa4, 0x7c, 0xaa, 0xc5, 0x51, 0x4c, 0xea, 0x73, 0x34, 0xb5, 0x63, 0x3a, 0xf5, 0xf3, 0x7a, 0x24,
-	0xd3, 0x2b, 0x23, 0xc1, 0xcb, 0x52, 0xf0, 0xac, 0x3d, 0x09, 0xc7, 0x5c, 0x7c, 0xc3, 0x74, 0x43,
-	0x94, 0x03, 0x02, 0xe5, 0x3f, 0x2d, 0x8e, 0x12, 0x72, 0x9f, 0xa3, 0x69, 0x89, 0x13, 0x97, 0xea,
-	0x95, 0x51, 0xf9, 0xda, 0xc6, 0x72, 0x4c, 0xd6, 0x74, 0xb1, 0x81, 0x1b, 0xd4, 0xda, 0xcc, 0x0f,
-	0xce, 0x83, 0x85, 0x41, 0x89, 0x15, 0x97, 0x47, 0x58, 0x71, 0xa9, 0x5e, 0x19, 0x95, 0xaf, 0x17,
-	0x83, 0x37, 0xed, 0x1d, 0x00, 0x73, 0x8e, 0x4d, 0x6c, 0x52, 0x33, 0xd4, 0x8e, 0x0d, 0xe1, 0x74,
-	0xfe, 0xe0, 0xfc, 0xe0, 0xc2, 0xe8, 0xb9, 0x93, 0xc5, 0x64, 0xe8, 0x8b, 0xcb, 0xc2, 0xb6, 0x22,
-	0x4c, 0x45, 0x78, 0xca, 0x8f, 0xdd, 0xe7, 0x68, 0xa0, 0xc5, 0x51, 0x2a, 0x8c, 0xcf, 0xd1, 0xbf,
-	0x14, 0x87, 0x14, 0xad, 0x7e, 0xf7, 0xd1, 0xbd, 0x33, 0xa0, 0xa2, 0x39, 0x9d, 0xa0, 0x9e, 0xf6,
-	0x36, 0x80, 0xe3, 0x16, 0x75, 0x9c, 0x26, 0xb1, 0xd9, 0x8e, 0xc1, 0xcc, 0xed, 0xfc, 0x21, 0x11,
-	0xb1, 0x6b, 0xc1, 0x6e, 0x3f, 0x73, 0x74, 0xba, 0x66, 0xb3, 0xcd, 0xe6, 0x7a, 0xd1, 0xa2, 0x8e,
-	0xfa, 0xd4, 0xea, 0xdf, 0x59, 0xaf, 0x7a, 0xbd, 0xc4, 0x76, 0x1a, 0xd8, 0x2b, 0x2e, 0x61, 0xab,
-	0xc5, 0x51, 0x12, 0xc6, 0xe7, 0x28, 0x27, 0x09, 0x25, 0xc4, 0xfa, 0x0f, 0x9f, 0x9d, 0x85, 0x2a,
-	0x65, 0x96, 0xb0, 0x25, 0x79, 0x8d, 0xb5, 0x4d, 0xd6, 0xcc, 0x6d, 0xed, 0x2d, 0x00, 0x73, 0x36,
-	0xb1, 0x99, 0x6d, 0xd6, 0x0d, 0x46, 0x99, 0x59, 0x37, 0xbc, 0x66, 0xa3, 0x51, 0xdf, 0xc9, 0x0f,
-	0xcd, 0x83, 0x85, 0xd1, 0x73, 0xb3, 0x45, 0xb5, 0x3c, 0x48, 0xa8, 0xa2, 0x4a, 0xa8, 0xe2, 0x05,
-	0x6a, 0x93, 0x28, 0x42, 0x69, 0xcb, 0xa3, 0x08, 0xa5, 0x69, 0xc3, 0x08, 0x29, 0xdd, 0x5a, 0xa0,
-	0x5a, 0x15, 0x9a, 0xf3, 0x27, 0x6e, 0xbd, 0x8f, 0xc0, 0xeb, 0x8f, 0xee, 0x9d, 0xc9, 0x85, 0x75,
-	0xb3, 0x2d, 0x2a, 0x47, 0x06, 0x50, 0x7f, 0xef, 0x30, 0x9c, 0xea, 0xfa, 0x58, 0xda, 0x2d, 0x00,
-	0x8f, 0x49, 0x7c, 0xc7, 0x26, 0xb8, 0x6a, 0x6c, 0x99, 0xf5, 0x2d, 0x6c, 0x78, 0xcc, 0x74, 0x99,
-	0x48, 0xec, 0x9e, 0x7e, 0x5c, 0x52, 0x7e, 0x64, 0x21, 0xf8, 0x1c, 0x15, 0xa4, 0x2b, 0x19, 0x06,
-	0xca, 0x9b, 0x9c, 0x50, 0x2f, 0x07, 0xda, 0x2b, 0x81, 0x72, 0x35, 0xd0, 0x69, 0x37, 0x01, 0x9c,
-	0xe9, 0x5e, 0x87, 0x49, 0x55, 0xd4, 0x4a, 0x4f, 0x62, 0x65, 0x45, 0x2c, 0x7d, 0xbd, 0xcf, 0xd1,
-	0xf1, 0x2c, 0x5a, 0x98, 0x54, 0xc3, 0x10, 0x77, 0x90, 0xba, 0x48, 0xaa, 0x9a, 0x0b, 0xc7, 0x13,
-	0x69, 0x2b, 0x2a, 0xad, 0x27, 0x93, 0x73, 0x8a, 0x49, 0x72, 0x5d, 0x94, 0x74, 0x09, 0xb1, 0xda,
-	0x79, 0x2c, 0x9e, 0xfe, 0xda, 0xb7, 0x00, 0x1e, 0x5f, 0xaf, 0x53, 0xeb, 0xba, 0x61, 0x6d, 0x9a,
-	0x36, 0x31, 0x1a, 0xd8, 0xb5, 0x30, 0x61, 0x66, 0x0d, 0x1b, 0x36, 0x31, 0xd6, 0x1b, 0xf9, 0x83,
-	0xa2, 0x0e, 0x5e, 0x03, 0x7d, 0x14, 0xc2, 0x65, 0xc2, 0x5a, 0x1c, 0xf5, 0x84, 0xf5, 0x39, 0x3a,
-	0xa5, 0x1a, 0x58, 0x0f, 0xab, 0x78, 0x99, 0x5c, 0x26, 0x4c, 0xf2, 0xcf, 0x8b, 0x15, 0x17, 0x82,
-	0x05, 0x2b, 0x6d, 0xfb, 0xcb, 0xa4, 0xdc, 0xd0, 0xbe, 0x07, 0xb0, 0xe0, 0x62, 0x8f, 0x36, 0x5d,
-	0x0b, 0x1b, 0x84, 0x56, 0x71, 0xb7, 0x37, 0xb2, 0xaa, 0xdf, 0xec, 0xdf, 0x9b, 0x3d, 0x80, 0x7d,
-	0x8e, 0xfe, 0x1d, 0x36, 0xd2, 0x5e, 0x76, 0xa9, 0x1e, 0xcd, 0x85, 0x6b, 0x9e, 0xa2, 0x55, 0xdc,
-	0xe1, 0xd3, 0x57, 0x00, 0xce, 0x39, 0x98, 0x99, 0x19, 0xfe, 0x0c, 0x09, 0x7f, 0x5e, 0xe9, 0xdf,
-	0x9f, 0x1e, 0xa0, 0x3e, 0x47, 0x27, 0x55, 0xfa, 0x64, 0xda, 0xa4, 0xfa, 0x71, 0x34, 0xb0, 0xef,
-	0xf6, 0x41, 0x7f, 0xf5, 0x20, 0x1c, 0x52, 0xe9, 0x76, 0x0d, 0x4e, 0xdc, 0x30, 0xeb, 0x75, 0xcc,
-	0x0c, 0xb3, 0x5a, 0x75, 0xb1, 0xe7, 0xa9, 0xf3, 0xed, 0x42, 0x8b, 0xa3, 0x0e, 0x8d, 0xcf, 0xd1,
-	0x8c, 0xa4, 0x91, 0x94, 0x07, 0x5b, 0xe7, 0xd4, 0xd6, 0x8f, 0x4b, 0xd1, 0x2a, 0x73, 0x83, 0x8c,
-	0x1e, 0x97, 0x86, 0x4a, 0xa8, 0x7d, 0x03, 0xe0, 0x31, 0xd5, 0xff, 0x37, 0x5c, 0xea, 0x18, 0xaa,
-	0x18, 0x1a, 0x94, 0xd6, 0xf3, 0x07, 0xc4, 0x71, 0xd3, 0xa3, 0xb2, 0xdc, 0xb0, 0xf9, 0x64, 0x20,
-	0x44, 0xcd, 0x27, 0xc3, 0x40, 0xff, 0xe8, 0x17, 0xb4, 0xf0, 0x27, 0x3e, 0x47, 0xb0, 0x9b, 0xa7,
-	0x1a, 0x95, 0x84, 0xba, 0xe4, 0x52, 0x47, 0xb6, 0xd2, 0x15, 0x4a, 0xeb, 0xda, 0x7d, 0x00, 0xf3,
-	0xf1, 0x3d, 0x98, 0x6b, 0x6e, 0x6c, 0xd8, 0x96, 0xf4, 0x63, 0x70, 0x2f, 0x3f, 0x98, 0xf2, 0x23,
-	0x13, 0xc2, 0xe7, 0x08, 0x75, 0x3b, 0x12, 0xb7, 0xd8, 0x87, 0x27, 0x33, 0x91, 0x27, 0x6b, 0x12,
-	0x29, 0x70, 0x45, 0xff, 0x0d, 0x40, 0x6d, 0xd5, 0x26, 0xb5, 0x3a, 0x7e, 0x46, 0x7c, 0xa9, 0x2b,
-	0xb4, 0xde, 0x74, 0xf0, 0x3f, 0x9a, 0x14, 0x0c, 0x0e, 0x6d, 0x89, 0x5d, 0xd5, 0x48, 0xf4, 0x6c,
-	0xdf, 0x95, 0xa3, 0xd6, 0xfb, 0x1c, 0x8d, 0x4b, 0x26, 0xf2, 0x3d, 0xb5, 0x22, 0x94, 0xad, 0xde,
-	0x84, 0xe3, 0x71, 0x8f, 0x3d, 0xad, 0x0a, 0x87, 0xa5, 0x2a, 0xf0, 0x35, 0xf8, 0x84, 0x7a, 0xe7,
-	0xe4, 0xd3, 0x1d, 0xa7, 0xf2, 0x69, 0xf5, 0x2d, 0xc3, 0xa5, 0x3e, 0x47, 0x13, 0x71, 0x0a, 0xe1,
-	0x80, 0x13, 0xea, 0xf5, 0xdf, 0x01, 0xd4, 0xe4, 0xda, 0x0a, 0x6e, 0x50, 0x97, 0x55, 0xb0, 0x45,
-	0xdd, 0xaa, 0xb6, 0x0a, 0x0f, 0xbb, 0xe2, 0x1d, 0xbb, 0x2a, 0xd2, 0xff, 0x6f, 0x71, 0xd4, 0x96,
-	0xf9, 0x1c, 0x4d, 0x86, 0x19, 0x21, 0x25, 0xd9, 0xd1, 0x6d, 0x2f, 0xd2, 0xae, 0xc2, 0x23, 0xf2,
-	0xd9, 0x70, 0xf1, 0x06, 0x76, 0x31, 0xb1, 0xc2, 0x10, 0x97, 0x5a, 0x1c, 0x75, 0xe9, 0x7c, 0x8e,
-	0x8e, 0xc5, 0x37, 0x89, 0x34, 0x7a, 0x65, 0xd2, 0x55, 0x6c, 0x95, 0x44, 0xfb, 0x1f, 0x1c, 0x66,
-	0xdb, 0xc6, 0xa6, 0xe9, 0xc9, 0xe1, 0x73, 0xa4, 0x7c, 0x22, 0x88, 0x82, 0x12, 0x45, 0x51, 0x50,
-	0x02, 0xbd, 0x32, 0xc4, 0xb6, 0x9f, 0x08, 0x1e, 0x7e, 0x3c, 0x00, 0x47, 0xc5, 0x0c, 0xa3, 0xba,
-	0xcf, 0x6d, 0xd0, 0x79, 0xc2, 0x82, 0xbd, 0xea, 0xe7, 0xb9, 0xbe, 0x4e, 0xd8, 0xfe, 0x2b, 0x25,
-	0x79, 0x1a, 0xdf, 0x01, 0x70, 0x22, 0x2c, 0x3d, 0xc5, 0x70, 0xcf, 0x4e, 0xf5, 0xbc, 0x62, 0xd8,
-	0xb1, 0x30, 0xaa, 0x94, 0xa4, 0x7c, 0x1f, 0x1c, 0xc7, 0x15, 0x82, 0x24, 0xa9, 0x7f, 0x92, 0x83,
-	0xc3, 0xcb, 0x98, 0xb9, 0xb6, 0xe5, 0x69, 0x6f, 0x00, 0x38, 0x96, 0x98, 0x4e, 0x65, 0x3e, 0x6d,
-	0xf6, 0x5d, 0x55, 0x63, 0x1d, 0x43, 0xea, 0x74, 0x7c, 0x84, 0x52, 0xc3, 0x69, 0x5a, 0x85, 0x8d,
-	0xb2, 0x68, 0x46, 0xd5, 0x3e, 0x04, 0x70, 0xba, 0x3d, 0x74, 0x05, 0x9f, 0x45, 0x91, 0x92, 0x79,
-	0xb8, 0xd5, 0x37, 0xa9, 0x34, 0x30, 0x9f, 0xa3, 0xb9, 0x8e, 0xf1, 0x2e, 0x52, 0xa6, 0x52, 0x9c,
-	0x0a, 0x47, 0x3d, 0x9b, 0xd4, 0x14, 0xd1, 0x3b, 0x00, 0x6a, 0xf1, 0xe9, 0x90, 0xd1, 0xeb, 0x98,
-	0x78, 0x2a, 0xb9, 0x59, 0xdf, 0x3c, 0x53, 0xb0, 0x7c, 0x8e, 0x66, 0xbb, 0xa7, 0x50, 0xa9, 0x4b,
-	0x65, 0x79, 0x24, 0x1a, 0x48, 0xd7, 0x84, 0x95, 0xf6, 0x1d, 0x80, 0xc7, 0xe5, 0xe2, 0xc4, 0x4c,
-	0xe3, 0x19, 0x55, 0xdc, 0xa0, 0x9e, 0xcd, 0xfe, 0xc2, 0x68, 0xd8, 0x0b, 0x36, 0x1a, 0x0d, 0x7b,
-	0x59, 0xa5, 0xfa, 0x30, 0xcb, 0x64, 0xb1, 0x47, 0xd3, 0x94, 0xb7, 0x24, 0xcd, 0xb5, 0xcf, 0xdb,
-	0x37, 0x91, 0xe0, 0x92, 0x8c, 0x83, 0x9b, 0x6e, 0x1d, 0xd7, 0x4c, 0x66, 0x53, 0xa2, 0x86, 0xc2,
-	0x97, 0xfa, 0x76, 0x23, 0x0b, 0xb0, 0xf3, 0x62, 0xd2, 0x65, 0x90, 0x4a, 0x5e, 0xde, 0x26, 0xca,
-	0xc2, 0x76, 0xa9, 0x6d, 0xaa, 0x7d, 0x09, 0xa0, 0x74, 0xcb, 0x68, 0x92, 0x6e, 0xea, 0x72, 0xfe,
-	0x7b, 0xb9, 0xff, 0x4f, 0x90, 0x8d, 0xe9, 0x73, 0x34, 0x1f, 0x67, 0x9f, 0x62, 0x92, 0xca, 0x5f,
-	0x46, 0xe3, 0x69, 0x65, 0x1d, 0xf3, 0xe0, 0x6b, 0x00, 0xe7, 0xe2, 0x50, 0x41, 0xb9, 0xc4, 0x5c,
-	0x18, 0xde, 0xf7, 0x08, 0x9b, 0x0d, 0x1a, 0x8d, 0xb0, 0xd9, 0x36, 0xe9, 0x97, 0x8b, 0x98, 0x13,
-	0x36, 0xa9, 0xc5, 0xbc, 0xf8, 0x00, 0x40, 0xcd, 0xb2, 0x5d, 0xab, 0x59, 0x17, 0xef, 0x61, 0x6b,
-	0x39, 0xbc, 0xdf, 0x92, 0xed, 0xc6, 0x8a, 0x4a, 0xb6, 0x5b, 0x97, 0xde, 0x58, 0x62, 0x76, 0x59,
-	0x1d, 0x50, 0x9d, 0x22, 0x23, 0x7f, 0x4b, 0x07, 0x6c, 0x9f, 0x2c, 0x69, 0x1d, 0x50, 0x1d, 0x2f,
-	0x7b, 0x75, 0x40, 0x75, 0xd2, 0x05, 0x44, 0xe5, 0xa5, 0x2f, 0x49, 0x14, 0xee, 0x97, 0x68, 0x0a,
-	0x58, 0x44, 0x34, 0x45, 0x99, 0x11, 0xd1, 0xc0, 0x30, 0x41, 0xf4, 0x53, 0x00, 0x8f, 0xb6, 0x5b,
-	0x50, 0x92, 0xeb, 0xa8, 0xe0, 0xfa, 0x62, 0xdf, 0x5c, 0x33, 0xf0, 0x7c, 0x8e, 0x4e, 0x74, 0xdc,
-	0x21, 0xf7, 0x66, 0x9c, 0x0b, 0x6d, 0x97, 0x3b, 0xe6, 0x08, 0x4d, 0x5c, 0xde, 0x92, 0x84, 0xc7,
-	0xf6, 0x9b, 0xac, 0xdd, 0x58, 0x51, 0xb2, 0x76, 0xeb, 0xd2, 0xcf, 0x97, 0xc0, 0x2e, 0x41, 0xf2,
-	0x63, 0x00, 0x67, 0x92, 0xb7, 0xe5, 0xf0, 0x60, 0x19, 0x17, 0x3c, 0x5f, 0xe8, 0x9b, 0x67, 0x3a,
-	0x5c, 0xf4, 0x83, 0x4c, 0xaa, 0x3a, 0x95, 0xed, 0x74, 0xfc, 0x4a, 0x1e, 0x9e, 0x21, 0xb7, 0x01,
-	0x9c, 0x8a, 0xae, 0xc4, 0x21, 0xd9, 0x09, 0x41, 0xd6, 0xed, 0x9b, 0x6c, 0x37, 0x94, 0xcf, 0x51,
-	0xbe, 0xf3, 0xe2, 0xdd, 0x8b, 0xe4, 0x64, 0x78, 0xdf, 0x0e, 0x09, 0xbe, 0x0b, 0xe0, 0x64, 0x93,
-	0xd8, 0x9e, 0xd7, 0xc4, 0x55, 0xa3, 0xe1, 0xe2, 0x86, 0xb9, 0x93, 0x9f, 0x14, 0xf4, 0x48, 0xdf,
-	0xf4, 0x3a, 0x81, 0x7c, 0x8e, 0x8e, 0x4a, 0x72, 0x1d, 0x8a, 0x54, 0x6a, 0x13, 0xa1, 0xd1, 0x8a,
-	0xb0, 0x29, 0x2f, 0xdf, 0xdd, 0x2d, 0x80, 0xfb, 0xbb, 0x05, 0xf0, 0x60, 0xb7, 0x00, 0x7e, 0xdd,
-	0x2d, 0x80, 0x9b, 0x0f, 0x0b, 0x03, 0x0f, 0x1e, 0x16, 0x06, 0x7e, 0x7a, 0x58, 0x18, 0xb8, 0x5a,
-	0x8a, 0xb1, 0x52, 0xd7, 0x20, 0x82, 0x59, 0xf8, 0x78, 0x56, 0x14, 0xa5, 0xfa, 0xb9, 0x51, 0x50,
-	0x5c, 0x1f, 0x12, 0x3f, 0x9a, 0xff, 0xf7, 0x8f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xa1, 0x30, 0xf4,
-	0xf7, 0xc4, 0x17, 0x00, 0x00,
+func (m *VolumeInclusionProof) Reset()         { *m = VolumeInclusionProof{} }
+func (m *VolumeInclusionProof) String() string { return proto.CompactTextString(m) }
+func (*VolumeInclusionProof) ProtoMessage()    {}
+func (*VolumeInclusionProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a05930b44d981057, []int{8}
+}
+func (m *VolumeInclusionProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VolumeInclusionProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VolumeInclusionProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VolumeInclusionProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VolumeInclusionProof.Merge(m, src)
+}
+func (m *VolumeInclusionProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *VolumeInclusionProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_VolumeInclusionProof.DiscardUnknown(m)
 }
 
-func (this *Params) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+var xxx_messageInfo_VolumeInclusionProof proto.InternalMessageInfo
+
+func (m *VolumeInclusionProof) GetHashes() [][]byte {
+	if m != nil {
+		return m.Hashes
 	}
+	return nil
+}
 
-	that1, ok := that.(*Params)
-	if !ok {
-		that2, ok := that.(Params)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// PendingVolumeReport is a chunked volume report whose header is accepted and whose chunks are being submitted
+type PendingVolumeReport struct {
+	Reporter        string                                 `protobuf:"bytes,1,opt,name=reporter,proto3" json:"reporter" yaml:"reporter"`
+	Epoch           github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=epoch,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"epoch" yaml:"epoch"`
+	ReportReference string                                 `protobuf:"bytes,3,opt,name=report_reference,json=reportReference,proto3" json:"report_reference" yaml:"report_reference"`
+	TotalCount      uint64                                 `protobuf:"varint,4,opt,name=total_count,json=totalCount,proto3" json:"total_count" yaml:"total_count"`
+	VolumesRoot     []byte                                 `protobuf:"bytes,5,opt,name=volumes_root,json=volumesRoot,proto3" json:"volumes_root" yaml:"volumes_root"`
+	ReceivedCount   uint64                                 `protobuf:"varint,6,opt,name=received_count,json=receivedCount,proto3" json:"received_count" yaml:"received_count"`
+	DeadlineHeight  int64                                  `protobuf:"varint,7,opt,name=deadline_height,json=deadlineHeight,proto3" json:"deadline_height" yaml:"deadline_height"`
+	TxHash          string                                 `protobuf:"bytes,8,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash" yaml:"tx_hash"`
+}
+
+func (m *PendingVolumeReport) Reset()         { *m = PendingVolumeReport{} }
+func (m *PendingVolumeReport) String() string { return proto.CompactTextString(m) }
+func (*PendingVolumeReport) ProtoMessage()    {}
+func (*PendingVolumeReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a05930b44d981057, []int{9}
+}
+func (m *PendingVolumeReport) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingVolumeReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingVolumeReport.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.BondDenom != that1.BondDenom {
-		return false
-	}
-	if this.RewardDenom != that1.RewardDenom {
-		return false
+}
+func (m *PendingVolumeReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingVolumeReport.Merge(m, src)
+}
+func (m *PendingVolumeReport) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingVolumeReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingVolumeReport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingVolumeReport proto.InternalMessageInfo
+
+func (m *PendingVolumeReport) GetReporter() string {
+	if m != nil {
+		return m.Reporter
 	}
-	if this.MatureEpoch != that1.MatureEpoch {
-		return false
+	return ""
+}
+
+func (m *PendingVolumeReport) GetReportReference() string {
+	if m != nil {
+		return m.ReportReference
 	}
-	if len(this.MiningRewardParams) != len(that1.MiningRewardParams) {
-		return false
+	return ""
+}
+
+func (m *PendingVolumeReport) GetTotalCount() uint64 {
+	if m != nil {
+		return m.TotalCount
 	}
-	for i := range this.MiningRewardParams {
-		if !this.MiningRewardParams[i].Equal(&that1.MiningRewardParams[i]) {
-			return false
-		}
+	return 0
+}
+
+func (m *PendingVolumeReport) GetVolumesRoot() []byte {
+	if m != nil {
+		return m.VolumesRoot
 	}
-	if !this.CommunityTax.Equal(that1.CommunityTax) {
-		return false
+	return nil
+}
+
+func (m *PendingVolumeReport) GetReceivedCount() uint64 {
+	if m != nil {
+		return m.ReceivedCount
 	}
-	if !this.InitialTotalSupply.Equal(&that1.InitialTotalSupply) {
-		return false
+	return 0
+}
+
+func (m *PendingVolumeReport) GetDeadlineHeight() int64 {
+	if m != nil {
+		return m.DeadlineHeight
 	}
-	return true
+	return 0
 }
-func (this *MiningRewardParam) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *PendingVolumeReport) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
 	}
+	return ""
+}
 
-	that1, ok := that.(*MiningRewardParam)
-	if !ok {
-		that2, ok := that.(MiningRewardParam)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// PendingDistribution is an accepted volume report whose rewards are being distributed in the end blockers
+type PendingDistribution struct {
+	Epoch                       github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=epoch,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"epoch" yaml:"epoch"`
+	TotalCount                  uint64                                 `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count" yaml:"total_count"`
+	ProcessedCount              uint64                                 `protobuf:"varint,3,opt,name=processed_count,json=processedCount,proto3" json:"processed_count" yaml:"processed_count"`
+	TotalConsumedNoz            github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=total_consumed_noz,json=totalConsumedNoz,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_consumed_noz" yaml:"total_consumed_noz"`
+	Started                     bool                                   `protobuf:"varint,5,opt,name=started,proto3" json:"started" yaml:"started"`
+	MiningRewardToResourceNode  types.Coin                             `protobuf:"bytes,6,opt,name=mining_reward_to_resource_node,json=miningRewardToResourceNode,proto3" json:"mining_reward_to_resource_node" yaml:"mining_reward_to_resource_node"`
+	TrafficRewardToResourceNode types.Coin                             `protobuf:"bytes,7,opt,name=traffic_reward_to_resource_node,json=trafficRewardToResourceNode,proto3" json:"traffic_reward_to_resource_node" yaml:"traffic_reward_to_resource_node"`
+	MiningRewardToMetaNode      types.Coin                             `protobuf:"bytes,8,opt,name=mining_reward_to_meta_node,json=miningRewardToMetaNode,proto3" json:"mining_reward_to_meta_node" yaml:"mining_reward_to_meta_node"`
+	TrafficRewardToMetaNode     types.Coin                             `protobuf:"bytes,9,opt,name=traffic_reward_to_meta_node,json=trafficRewardToMetaNode,proto3" json:"traffic_reward_to_meta_node" yaml:"traffic_reward_to_meta_node"`
+	MinedReward                 types.Coin                             `protobuf:"bytes,10,opt,name=mined_reward,json=minedReward,proto3" json:"mined_reward" yaml:"mined_reward"`
+	TrafficReward               types.Coin                             `protobuf:"bytes,11,opt,name=traffic_reward,json=trafficReward,proto3" json:"traffic_reward" yaml:"traffic_reward"`
+	DisputeDeadlineHeight       int64                                  `protobuf:"varint,12,opt,name=dispute_deadline_height,json=disputeDeadlineHeight,proto3" json:"dispute_deadline_height" yaml:"dispute_deadline_height"`
+	Disputed                    bool                                   `protobuf:"varint,13,opt,name=disputed,proto3" json:"disputed" yaml:"disputed"`
+	// the fraction of the community tax not transferred yet, carried to the next batch
+	CommunityTaxRemainder github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,14,opt,name=community_tax_remainder,json=communityTaxRemainder,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"community_tax_remainder" yaml:"community_tax_remainder"`
+}
+
+func (m *PendingDistribution) Reset()         { *m = PendingDistribution{} }
+func (m *PendingDistribution) String() string { return proto.CompactTextString(m) }
+func (*PendingDistribution) ProtoMessage()    {}
+func (*PendingDistribution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a05930b44d981057, []int{10}
+}
+func (m *PendingDistribution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingDistribution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingDistribution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.TotalMinedValveStart.Equal(&that1.TotalMinedValveStart) {
-		return false
-	}
-	if !this.TotalMinedValveEnd.Equal(&that1.TotalMinedValveEnd) {
-		return false
-	}
-	if !this.MiningReward.Equal(&that1.MiningReward) {
-		return false
+}
+func (m *PendingDistribution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingDistribution.Merge(m, src)
+}
+func (m *PendingDistribution) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingDistribution) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingDistribution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingDistribution proto.InternalMessageInfo
+
+func (m *PendingDistribution) GetTotalCount() uint64 {
+	if m != nil {
+		return m.TotalCount
 	}
-	if !this.BlockChainPercentageInBp.Equal(that1.BlockChainPercentageInBp) {
-		return false
+	return 0
+}
+
+func (m *PendingDistribution) GetProcessedCount() uint64 {
+	if m != nil {
+		return m.ProcessedCount
 	}
-	if !this.ResourceNodePercentageInBp.Equal(that1.ResourceNodePercentageInBp) {
-		return false
+	return 0
+}
+
+func (m *PendingDistribution) GetStarted() bool {
+	if m != nil {
+		return m.Started
 	}
-	if !this.MetaNodePercentageInBp.Equal(that1.MetaNodePercentageInBp) {
-		return false
+	return false
+}
+
+func (m *PendingDistribution) GetMiningRewardToResourceNode() types.Coin {
+	if m != nil {
+		return m.MiningRewardToResourceNode
 	}
-	return true
+	return types.Coin{}
 }
-func (this *Reward) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *PendingDistribution) GetTrafficRewardToResourceNode() types.Coin {
+	if m != nil {
+		return m.TrafficRewardToResourceNode
 	}
+	return types.Coin{}
+}
 
-	that1, ok := that.(*Reward)
-	if !ok {
-		that2, ok := that.(Reward)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *PendingDistribution) GetMiningRewardToMetaNode() types.Coin {
+	if m != nil {
+		return m.MiningRewardToMetaNode
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return types.Coin{}
+}
+
+func (m *PendingDistribution) GetTrafficRewardToMetaNode() types.Coin {
+	if m != nil {
+		return m.TrafficRewardToMetaNode
 	}
-	if this.WalletAddress != that1.WalletAddress {
-		return false
+	return types.Coin{}
+}
+
+func (m *PendingDistribution) GetMinedReward() types.Coin {
+	if m != nil {
+		return m.MinedReward
 	}
-	if len(this.RewardFromMiningPool) != len(that1.RewardFromMiningPool) {
-		return false
+	return types.Coin{}
+}
+
+func (m *PendingDistribution) GetTrafficReward() types.Coin {
+	if m != nil {
+		return m.TrafficReward
 	}
-	for i := range this.RewardFromMiningPool {
-		if !this.RewardFromMiningPool[i].Equal(&that1.RewardFromMiningPool[i]) {
-			return false
-		}
+	return types.Coin{}
+}
+
+func (m *PendingDistribution) GetDisputeDeadlineHeight() int64 {
+	if m != nil {
+		return m.DisputeDeadlineHeight
 	}
-	if len(this.RewardFromTrafficPool) != len(that1.RewardFromTrafficPool) {
-		return false
+	return 0
+}
+
+func (m *PendingDistribution) GetDisputed() bool {
+	if m != nil {
+		return m.Disputed
 	}
-	for i := range this.RewardFromTrafficPool {
-		if !this.RewardFromTrafficPool[i].Equal(&that1.RewardFromTrafficPool[i]) {
-			return false
-		}
-	}
-	return true
+	return false
 }
-func (this *SingleWalletVolume) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*SingleWalletVolume)
-	if !ok {
-		that2, ok := that.(SingleWalletVolume)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// DistributionVolume is a wallet volume of a pending distribution not rewarded yet
+type DistributionVolume struct {
+	Epoch  github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=epoch,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"epoch" yaml:"epoch"`
+	Index  uint64                                 `protobuf:"varint,2,opt,name=index,proto3" json:"index" yaml:"index"`
+	Volume SingleWalletVolume                     `protobuf:"bytes,3,opt,name=volume,proto3" json:"volume" yaml:"volume"`
+}
+
+func (m *DistributionVolume) Reset()         { *m = DistributionVolume{} }
+func (m *DistributionVolume) String() string { return proto.CompactTextString(m) }
+func (*DistributionVolume) ProtoMessage()    {}
+func (*DistributionVolume) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a05930b44d981057, []int{11}
+}
+func (m *DistributionVolume) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DistributionVolume) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DistributionVolume.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+}
+func (m *DistributionVolume) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DistributionVolume.Merge(m, src)
+}
+func (m *DistributionVolume) XXX_Size() int {
+	return m.Size()
+}
+func (m *DistributionVolume) XXX_DiscardUnknown() {
+	xxx_messageInfo_DistributionVolume.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DistributionVolume proto.InternalMessageInfo
+
+func (m *DistributionVolume) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
 	}
-	if this.WalletAddress != that1.WalletAddress {
-		return false
+	return 0
+}
+
+func (m *DistributionVolume) GetVolume() SingleWalletVolume {
+	if m != nil {
+		return m.Volume
 	}
-	if !this.Volume.Equal(that1.Volume) {
-		return false
+	return SingleWalletVolume{}
+}
+
+// VolumeReportDispute is the evidence filed against a volume report during its dispute window,
+// the disputed report waits for the governance proposal escalated from the dispute
+type VolumeReportDispute struct {
+	Epoch         github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=epoch,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"epoch" yaml:"epoch"`
+	Disputer      string                                 `protobuf:"bytes,2,opt,name=disputer,proto3" json:"disputer" yaml:"disputer"`
+	Reporter      string                                 `protobuf:"bytes,3,opt,name=reporter,proto3" json:"reporter" yaml:"reporter"`
+	Reason        string                                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason" yaml:"reason"`
+	WalletVolumes []SingleWalletVolume                   `protobuf:"bytes,5,rep,name=wallet_volumes,json=walletVolumes,proto3" json:"wallet_volumes" yaml:"wallet_volumes"`
+	Height        int64                                  `protobuf:"varint,6,opt,name=height,proto3" json:"height" yaml:"height"`
+	// proposal_id is the governance proposal amending the report with the counter-report
+	ProposalId uint64 `protobuf:"varint,7,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id" yaml:"proposal_id"`
+}
+
+func (m *VolumeReportDispute) Reset()         { *m = VolumeReportDispute{} }
+func (m *VolumeReportDispute) String() string { return proto.CompactTextString(m) }
+func (*VolumeReportDispute) ProtoMessage()    {}
+func (*VolumeReportDispute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a05930b44d981057, []int{12}
+}
+func (m *VolumeReportDispute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VolumeReportDispute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VolumeReportDispute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return true
 }
-func (this *WalletVolumes) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *VolumeReportDispute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VolumeReportDispute.Merge(m, src)
+}
+func (m *VolumeReportDispute) XXX_Size() int {
+	return m.Size()
+}
+func (m *VolumeReportDispute) XXX_DiscardUnknown() {
+	xxx_messageInfo_VolumeReportDispute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VolumeReportDispute proto.InternalMessageInfo
+
+func (m *VolumeReportDispute) GetDisputer() string {
+	if m != nil {
+		return m.Disputer
 	}
+	return ""
+}
 
-	that1, ok := that.(*WalletVolumes)
-	if !ok {
-		that2, ok := that.(WalletVolumes)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *VolumeReportDispute) GetReporter() string {
+	if m != nil {
+		return m.Reporter
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return ""
+}
+
+func (m *VolumeReportDispute) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	if len(this.Volumes) != len(that1.Volumes) {
-		return false
+	return ""
+}
+
+func (m *VolumeReportDispute) GetWalletVolumes() []SingleWalletVolume {
+	if m != nil {
+		return m.WalletVolumes
 	}
-	for i := range this.Volumes {
-		if !this.Volumes[i].Equal(&that1.Volumes[i]) {
-			return false
-		}
+	return nil
+}
+
+func (m *VolumeReportDispute) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	return true
+	return 0
 }
-func (this *VolumeReportRecord) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *VolumeReportDispute) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
 	}
+	return 0
+}
 
-	that1, ok := that.(*VolumeReportRecord)
-	if !ok {
-		that2, ok := that.(VolumeReportRecord)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// ReportEquivocation records a meta node slashed for signing conflicting volume reports of an epoch
+type ReportEquivocation struct {
+	Epoch    github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=epoch,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"epoch" yaml:"epoch"`
+	Accused  string                                 `protobuf:"bytes,2,opt,name=accused,proto3" json:"accused" yaml:"accused"`
+	Reporter string                                 `protobuf:"bytes,3,opt,name=reporter,proto3" json:"reporter" yaml:"reporter"`
+	Slashed  types.Coin                             `protobuf:"bytes,4,opt,name=slashed,proto3" json:"slashed" yaml:"slashed"`
+	Height   int64                                  `protobuf:"varint,5,opt,name=height,proto3" json:"height" yaml:"height"`
+}
+
+func (m *ReportEquivocation) Reset()         { *m = ReportEquivocation{} }
+func (m *ReportEquivocation) String() string { return proto.CompactTextString(m) }
+func (*ReportEquivocation) ProtoMessage()    {}
+func (*ReportEquivocation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a05930b44d981057, []int{13}
+}
+func (m *ReportEquivocation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReportEquivocation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReportEquivocation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Reporter != that1.Reporter {
-		return false
+}
+func (m *ReportEquivocation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReportEquivocation.Merge(m, src)
+}
+func (m *ReportEquivocation) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReportEquivocation) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReportEquivocation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReportEquivocation proto.InternalMessageInfo
+
+func (m *ReportEquivocation) GetAccused() string {
+	if m != nil {
+		return m.Accused
 	}
-	if this.ReportReference != that1.ReportReference {
-		return false
+	return ""
+}
+
+func (m *ReportEquivocation) GetReporter() string {
+	if m != nil {
+		return m.Reporter
 	}
-	if this.TxHash != that1.TxHash {
-		return false
+	return ""
+}
+
+func (m *ReportEquivocation) GetSlashed() types.Coin {
+	if m != nil {
+		return m.Slashed
 	}
-	return true
+	return types.Coin{}
 }
-func (this *TotalReward) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *ReportEquivocation) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
+	return 0
+}
 
-	that1, ok := that.(*TotalReward)
-	if !ok {
-		that2, ok := that.(TotalReward)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// RewardHistory is the reward of a wallet matured at an epoch
+type RewardHistory struct {
+	WalletAddress         string                                   `protobuf:"bytes,1,opt,name=wallet_address,json=walletAddress,proto3" json:"wallet_address" yaml:"wallet_address"`
+	Epoch                 github_com_cosmos_cosmos_sdk_types.Int   `protobuf:"bytes,2,opt,name=epoch,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"epoch" yaml:"epoch"`
+	RewardFromMiningPool  github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=reward_from_mining_pool,json=rewardFromMiningPool,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"reward_from_mining_pool" yaml:"reward_from_mining_pool"`
+	RewardFromTrafficPool github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=reward_from_traffic_pool,json=rewardFromTrafficPool,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"reward_from_traffic_pool" yaml:"reward_from_traffic_pool"`
+	SlashingDeducted      github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=slashing_deducted,json=slashingDeducted,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"slashing_deducted" yaml:"slashing_deducted"`
+}
+
+func (m *RewardHistory) Reset()         { *m = RewardHistory{} }
+func (m *RewardHistory) String() string { return proto.CompactTextString(m) }
+func (*RewardHistory) ProtoMessage()    {}
+func (*RewardHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a05930b44d981057, []int{14}
+}
+func (m *RewardHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RewardHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RewardHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+}
+func (m *RewardHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardHistory.Merge(m, src)
+}
+func (m *RewardHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *RewardHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RewardHistory proto.InternalMessageInfo
+
+func (m *RewardHistory) GetWalletAddress() string {
+	if m != nil {
+		return m.WalletAddress
 	}
-	if len(this.MiningReward) != len(that1.MiningReward) {
-		return false
+	return ""
+}
+
+func (m *RewardHistory) GetRewardFromMiningPool() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RewardFromMiningPool
 	}
-	for i := range this.MiningReward {
-		if !this.MiningReward[i].Equal(&that1.MiningReward[i]) {
-			return false
-		}
+	return nil
+}
+
+func (m *RewardHistory) GetRewardFromTrafficPool() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RewardFromTrafficPool
 	}
-	if len(this.TrafficReward) != len(that1.TrafficReward) {
-		return false
+	return nil
+}
+
+func (m *RewardHistory) GetSlashingDeducted() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SlashingDeducted
 	}
-	for i := range this.TrafficReward {
-		if !this.TrafficReward[i].Equal(&that1.TrafficReward[i]) {
-			return false
+	return nil
+}
+
+// AutoRestake is the node the matured rewards of a wallet are automatically added to the deposit of
+type AutoRestake struct {
+	WalletAddress  string `protobuf:"bytes,1,opt,name=wallet_address,json=walletAddress,proto3" json:"wallet_address" yaml:"wallet_address"`
+	NetworkAddress string `protobuf:"bytes,2,opt,name=network_address,json=networkAddress,proto3" json:"network_address" yaml:"network_address"`
+}
+
+func (m *AutoRestake) Reset()         { *m = AutoRestake{} }
+func (m *AutoRestake) String() string { return proto.CompactTextString(m) }
+func (*AutoRestake) ProtoMessage()    {}
+func (*AutoRestake) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a05930b44d981057, []int{15}
+}
+func (m *AutoRestake) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AutoRestake) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AutoRestake.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return true
 }
-func (this *Metrics) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *AutoRestake) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutoRestake.Merge(m, src)
+}
+func (m *AutoRestake) XXX_Size() int {
+	return m.Size()
+}
+func (m *AutoRestake) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutoRestake.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*Metrics)
+var xxx_messageInfo_AutoRestake proto.InternalMessageInfo
+
+func (m *AutoRestake) GetWalletAddress() string {
+	if m != nil {
+		return m.WalletAddress
+	}
+	return ""
+}
+
+func (m *AutoRestake) GetNetworkAddress() string {
+	if m != nil {
+		return m.NetworkAddress
+	}
+	return ""
+}
+
+// VestingSchedule is the matured reward of a wallet vesting linearly over vesting_epochs epochs from start_epoch on.
+// The rewards maturing while it vests restart the schedule with the part not released yet.
+type VestingSchedule struct {
+	WalletAddress           string                                   `protobuf:"bytes,1,opt,name=wallet_address,json=walletAddress,proto3" json:"wallet_address" yaml:"wallet_address"`
+	TotalFromMiningPool     github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=total_from_mining_pool,json=totalFromMiningPool,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_from_mining_pool" yaml:"total_from_mining_pool"`
+	TotalFromTrafficPool    github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=total_from_traffic_pool,json=totalFromTrafficPool,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_from_traffic_pool" yaml:"total_from_traffic_pool"`
+	ReleasedFromMiningPool  github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=released_from_mining_pool,json=releasedFromMiningPool,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"released_from_mining_pool" yaml:"released_from_mining_pool"`
+	ReleasedFromTrafficPool github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=released_from_traffic_pool,json=releasedFromTrafficPool,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"released_from_traffic_pool" yaml:"released_from_traffic_pool"`
+	StartEpoch              int64                                    `protobuf:"varint,6,opt,name=start_epoch,json=startEpoch,proto3" json:"start_epoch" yaml:"start_epoch"`
+	VestingEpochs           int64                                    `protobuf:"varint,7,opt,name=vesting_epochs,json=vestingEpochs,proto3" json:"vesting_epochs" yaml:"vesting_epochs"`
+}
+
+func (m *VestingSchedule) Reset()         { *m = VestingSchedule{} }
+func (m *VestingSchedule) String() string { return proto.CompactTextString(m) }
+func (*VestingSchedule) ProtoMessage()    {}
+func (*VestingSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a05930b44d981057, []int{16}
+}
+func (m *VestingSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VestingSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VestingSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VestingSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VestingSchedule.Merge(m, src)
+}
+func (m *VestingSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *VestingSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_VestingSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VestingSchedule proto.InternalMessageInfo
+
+func (m *VestingSchedule) GetWalletAddress() string {
+	if m != nil {
+		return m.WalletAddress
+	}
+	return ""
+}
+
+func (m *VestingSchedule) GetTotalFromMiningPool() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalFromMiningPool
+	}
+	return nil
+}
+
+func (m *VestingSchedule) GetTotalFromTrafficPool() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalFromTrafficPool
+	}
+	return nil
+}
+
+func (m *VestingSchedule) GetReleasedFromMiningPool() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.ReleasedFromMiningPool
+	}
+	return nil
+}
+
+func (m *VestingSchedule) GetReleasedFromTrafficPool() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.ReleasedFromTrafficPool
+	}
+	return nil
+}
+
+func (m *VestingSchedule) GetStartEpoch() int64 {
+	if m != nil {
+		return m.StartEpoch
+	}
+	return 0
+}
+
+func (m *VestingSchedule) GetVestingEpochs() int64 {
+	if m != nil {
+		return m.VestingEpochs
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "stratos.pot.v1.Params")
+	proto.RegisterType((*MiningRewardParam)(nil), "stratos.pot.v1.MiningRewardParam")
+	proto.RegisterType((*Reward)(nil), "stratos.pot.v1.Reward")
+	proto.RegisterType((*SingleWalletVolume)(nil), "stratos.pot.v1.SingleWalletVolume")
+	proto.RegisterType((*WalletVolumes)(nil), "stratos.pot.v1.WalletVolumes")
+	proto.RegisterType((*VolumeReportRecord)(nil), "stratos.pot.v1.VolumeReportRecord")
+	proto.RegisterType((*TotalReward)(nil), "stratos.pot.v1.TotalReward")
+	proto.RegisterType((*Metrics)(nil), "stratos.pot.v1.Metrics")
+	proto.RegisterType((*VolumeInclusionProof)(nil), "stratos.pot.v1.VolumeInclusionProof")
+	proto.RegisterType((*PendingVolumeReport)(nil), "stratos.pot.v1.PendingVolumeReport")
+	proto.RegisterType((*PendingDistribution)(nil), "stratos.pot.v1.PendingDistribution")
+	proto.RegisterType((*DistributionVolume)(nil), "stratos.pot.v1.DistributionVolume")
+	proto.RegisterType((*VolumeReportDispute)(nil), "stratos.pot.v1.VolumeReportDispute")
+	proto.RegisterType((*ReportEquivocation)(nil), "stratos.pot.v1.ReportEquivocation")
+	proto.RegisterType((*RewardHistory)(nil), "stratos.pot.v1.RewardHistory")
+	proto.RegisterType((*AutoRestake)(nil), "stratos.pot.v1.AutoRestake")
+	proto.RegisterType((*VestingSchedule)(nil), "stratos.pot.v1.VestingSchedule")
+}
+
+func init() { proto.RegisterFile("stratos/pot/v1/pot.proto", fileDescriptor_a05930b44d981057) }
+
+var fileDescriptor_a05930b44d981057 = []byte{
+	// 3053 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x5b, 0x5d, 0x6c, 0x1d, 0x47,
+	0x15, 0xce, 0xc4, 0xbf, 0x99, 0xeb, 0x6b, 0x37, 0x6b, 0x3b, 0x59, 0x3b, 0xad, 0x37, 0xdd, 0xd2,
+	0x12, 0x15, 0xc5, 0x26, 0x2d, 0x50, 0x51, 0x84, 0xa0, 0x37, 0x4e, 0xd4, 0x00, 0x2e, 0xd1, 0xda,
+	0xb8, 0x52, 0x41, 0x6c, 0xd7, 0xbb, 0x63, 0x7b, 0x9b, 0x7b, 0x77, 0x2e, 0xbb, 0x73, 0x1d, 0x3b,
+	0x42, 0x20, 0xa1, 0xaa, 0x42, 0xfc, 0x88, 0xbe, 0x20, 0x5a, 0x1e, 0x2a, 0xd4, 0x4a, 0x50, 0xf1,
+	0x80, 0xc2, 0x8f, 0xe0, 0x8d, 0x1f, 0x51, 0x44, 0x41, 0x3c, 0x14, 0x78, 0x41, 0x3c, 0x2c, 0x28,
+	0x45, 0xaa, 0xb0, 0x78, 0xba, 0x0f, 0xbc, 0x21, 0xa1, 0xf9, 0xd9, 0x9d, 0xd9, 0xbf, 0x7b, 0x73,
+	0x6f, 0x5b, 0x57, 0xf0, 0x92, 0xdc, 0x39, 0xe7, 0xcc, 0x99, 0xef, 0xcc, 0x9e, 0x39, 0x73, 0xce,
+	0xcc, 0x18, 0xea, 0x11, 0x09, 0x1d, 0x82, 0xa3, 0x95, 0x36, 0x26, 0x2b, 0x7b, 0x17, 0xe8, 0x7f,
+	0xcb, 0xed, 0x10, 0x13, 0xac, 0x4d, 0x0b, 0xce, 0x32, 0x25, 0xed, 0x5d, 0x58, 0x9c, 0xdb, 0xc1,
+	0x3b, 0x98, 0xb1, 0x56, 0xe8, 0x2f, 0x2e, 0xb5, 0xb8, 0xe0, 0xe2, 0xa8, 0x85, 0x23, 0x9b, 0x33,
+	0x78, 0x43, 0xb0, 0x4e, 0x3a, 0x2d, 0x3f, 0xc0, 0x2b, 0xec, 0x5f, 0x41, 0x5a, 0xe2, 0x02, 0x2b,
+	0x5b, 0x4e, 0x84, 0x56, 0xf6, 0x2e, 0x6c, 0x21, 0xe2, 0x5c, 0x58, 0x71, 0xb1, 0x1f, 0x70, 0xbe,
+	0xf9, 0xaf, 0x1a, 0x1c, 0xbf, 0xea, 0x84, 0x4e, 0x2b, 0xd2, 0x1a, 0x10, 0x6e, 0xe1, 0xc0, 0xb3,
+	0x3d, 0x14, 0xe0, 0x96, 0x0e, 0xce, 0x82, 0x73, 0x27, 0x1a, 0xf7, 0x1c, 0xc6, 0x86, 0x42, 0xed,
+	0xc6, 0xc6, 0xc9, 0x03, 0xa7, 0xd5, 0x7c, 0xd8, 0x94, 0x34, 0xd3, 0x3a, 0x41, 0x1b, 0xab, 0xf4,
+	0xb7, 0xf6, 0x31, 0x38, 0x15, 0xa2, 0xeb, 0x4e, 0x98, 0x68, 0x39, 0xce, 0xb4, 0xbc, 0xfb, 0x30,
+	0x36, 0x32, 0xf4, 0x6e, 0x6c, 0xcc, 0x72, 0x3d, 0x2a, 0xd5, 0xb4, 0x6a, 0xbc, 0x99, 0xea, 0x6a,
+	0x39, 0xa4, 0x13, 0x22, 0x1b, 0xb5, 0xb1, 0xbb, 0xab, 0x8f, 0x9c, 0x05, 0xe7, 0x46, 0xb8, 0x2e,
+	0x95, 0x2e, 0x75, 0xa9, 0x54, 0xd3, 0xaa, 0xf1, 0xe6, 0x25, 0xda, 0xd2, 0xbe, 0x09, 0xe0, 0x5c,
+	0xcb, 0x0f, 0xfc, 0x60, 0xc7, 0x16, 0x23, 0xb6, 0x99, 0xd1, 0xfa, 0xe8, 0xd9, 0x91, 0x73, 0xb5,
+	0x07, 0xee, 0x5e, 0xce, 0x4e, 0xfd, 0xf2, 0x1a, 0x93, 0xb5, 0x98, 0x28, 0x9b, 0x9e, 0xc6, 0x47,
+	0x5f, 0x8d, 0x8d, 0x63, 0x87, 0xb1, 0x51, 0xaa, 0xa6, 0x1b, 0x1b, 0x67, 0x04, 0x86, 0x12, 0xae,
+	0xf9, 0xf2, 0x1b, 0x37, 0xef, 0x07, 0x96, 0xd6, 0xca, 0x2b, 0x8d, 0xb4, 0x6f, 0x00, 0x58, 0x77,
+	0x71, 0xab, 0xd5, 0x09, 0x7c, 0x72, 0x60, 0x13, 0x67, 0x5f, 0x1f, 0x63, 0x33, 0xf6, 0x14, 0x1d,
+	0xed, 0xaf, 0xb1, 0x71, 0xdf, 0x8e, 0x4f, 0x76, 0x3b, 0x5b, 0xcb, 0x2e, 0x6e, 0x89, 0x4f, 0x2d,
+	0xfe, 0x3b, 0x1f, 0x79, 0xd7, 0x56, 0xc8, 0x41, 0x1b, 0x45, 0xcb, 0xab, 0xc8, 0x3d, 0x8c, 0x8d,
+	0xac, 0x9a, 0x6e, 0x6c, 0xcc, 0x71, 0x40, 0x19, 0xb2, 0xf9, 0xa7, 0x9f, 0x9c, 0x87, 0xc2, 0x65,
+	0x56, 0x91, 0xcb, 0x71, 0x4d, 0xa5, 0x22, 0x1b, 0xce, 0xbe, 0xf6, 0x75, 0x00, 0xe7, 0xfc, 0xc0,
+	0x27, 0xbe, 0xd3, 0xb4, 0x09, 0x26, 0x4e, 0xd3, 0x8e, 0x3a, 0xed, 0x76, 0xf3, 0x40, 0x1f, 0x3f,
+	0x0b, 0xce, 0xd5, 0x1e, 0x58, 0x58, 0x16, 0xdd, 0xa9, 0x43, 0x2d, 0x0b, 0x87, 0x5a, 0xbe, 0x88,
+	0xfd, 0x40, 0xce, 0x50, 0x59, 0x77, 0x39, 0x43, 0x65, 0xdc, 0x64, 0x86, 0x04, 0x6f, 0x83, 0xb2,
+	0xd6, 0x19, 0x47, 0x7b, 0x1a, 0xc0, 0x33, 0x7b, 0xb8, 0xd9, 0x69, 0x21, 0x3b, 0x44, 0x6d, 0x1c,
+	0x12, 0xdb, 0xdd, 0xed, 0x04, 0xd7, 0x6c, 0xe2, 0xb7, 0x10, 0xee, 0x10, 0x7d, 0x82, 0x79, 0xc5,
+	0xa5, 0xc3, 0xd8, 0xe8, 0x25, 0xd6, 0x8d, 0x0d, 0x93, 0x0f, 0xdf, 0x43, 0xc8, 0xb4, 0x74, 0xce,
+	0xb5, 0x18, 0xf3, 0x22, 0xe5, 0x6d, 0x70, 0x96, 0xd6, 0x82, 0xf3, 0x9e, 0x1f, 0x91, 0xd0, 0xdf,
+	0xea, 0x10, 0x64, 0x6f, 0x39, 0xc4, 0xdd, 0xb5, 0x23, 0xff, 0x06, 0xd2, 0x27, 0xd9, 0xf8, 0x1f,
+	0x3c, 0x8c, 0x8d, 0x72, 0x81, 0x6e, 0x6c, 0xdc, 0xc9, 0x47, 0x2e, 0x65, 0x9b, 0xd6, 0xac, 0xa4,
+	0x37, 0x28, 0x79, 0xdd, 0xbf, 0x81, 0x34, 0x0b, 0x4e, 0x7b, 0x7e, 0xd4, 0xa6, 0xb2, 0xd7, 0xfd,
+	0xc0, 0xc3, 0xd7, 0xf5, 0x13, 0x6c, 0x9c, 0xf7, 0x1c, 0xc6, 0x46, 0x8e, 0xd3, 0x8d, 0x8d, 0xf9,
+	0x74, 0x00, 0x85, 0x6e, 0x5a, 0x75, 0x41, 0x78, 0x9c, 0xb5, 0xb5, 0xdf, 0x02, 0x78, 0x06, 0x7d,
+	0xae, 0xe3, 0xef, 0x61, 0xd7, 0x21, 0x3e, 0x0e, 0xec, 0xa8, 0xe9, 0x44, 0xbb, 0xf6, 0x76, 0xe8,
+	0xb8, 0xb4, 0xa9, 0x43, 0xe6, 0x79, 0xcf, 0x80, 0x81, 0x5d, 0xaf, 0x97, 0x56, 0x39, 0xf1, 0x3d,
+	0x84, 0x4a, 0xdd, 0x72, 0x41, 0xed, 0xb0, 0x4e, 0xe5, 0x2f, 0x0b, 0x71, 0x1a, 0x19, 0xf6, 0x50,
+	0x44, 0xe8, 0x42, 0x6b, 0x61, 0x0f, 0xe9, 0x35, 0x19, 0x65, 0x54, 0xba, 0x8c, 0x0c, 0x2a, 0xd5,
+	0xb4, 0x6a, 0xa2, 0xb9, 0x86, 0x3d, 0x36, 0xd3, 0x09, 0x97, 0x05, 0x8e, 0x48, 0x9f, 0x92, 0x33,
+	0x9d, 0xe5, 0xc8, 0x99, 0xce, 0xd2, 0x4d, 0xab, 0x2e, 0x08, 0x2c, 0xd8, 0x44, 0xda, 0x01, 0xd4,
+	0x45, 0x00, 0xd8, 0xf5, 0x23, 0x82, 0xc3, 0x03, 0x3b, 0x44, 0x04, 0x05, 0x6c, 0x96, 0xeb, 0x4c,
+	0xfb, 0x47, 0x0e, 0x63, 0xa3, 0x52, 0xa6, 0x1b, 0x1b, 0x46, 0x26, 0x3a, 0x16, 0x24, 0x4c, 0xeb,
+	0x14, 0x67, 0x3d, 0xca, 0x39, 0x56, 0xc2, 0x78, 0xf8, 0xae, 0xe7, 0xbe, 0x63, 0x80, 0xaf, 0xbc,
+	0x71, 0xf3, 0xfe, 0xb9, 0x64, 0x9b, 0xd9, 0x67, 0x1b, 0x0d, 0x8f, 0x37, 0xe6, 0xf3, 0x93, 0xf0,
+	0x64, 0x21, 0xb6, 0x69, 0xcf, 0x01, 0x78, 0x9a, 0x2f, 0xc7, 0x96, 0x1f, 0x20, 0xcf, 0xde, 0x73,
+	0x9a, 0x7b, 0xc8, 0x8e, 0x88, 0x13, 0x12, 0x1d, 0xf4, 0x5b, 0xf6, 0x97, 0xc5, 0xb2, 0xaf, 0xd2,
+	0xd0, 0x8d, 0x8d, 0x25, 0x6e, 0x4d, 0x85, 0x80, 0x58, 0xfc, 0x73, 0x8c, 0xbd, 0x46, 0xb9, 0x9b,
+	0x94, 0xb9, 0x4e, 0x79, 0xda, 0xb3, 0x00, 0xce, 0x17, 0xfb, 0xa1, 0xc0, 0xd3, 0x8f, 0xf7, 0x03,
+	0xd6, 0x10, 0xc0, 0xca, 0xfb, 0xcb, 0x75, 0x59, 0xca, 0x4e, 0x22, 0x52, 0x0e, 0xd4, 0xa5, 0xc0,
+	0xd3, 0x42, 0x58, 0xcf, 0x44, 0x79, 0x7d, 0xa4, 0x1f, 0x92, 0x07, 0x04, 0x92, 0x6c, 0x3f, 0x19,
+	0xa3, 0x33, 0x64, 0x31, 0xf2, 0x94, 0xba, 0x5b, 0x68, 0xbf, 0x03, 0xf0, 0xce, 0xad, 0x26, 0x76,
+	0xaf, 0xd9, 0xee, 0xae, 0xe3, 0x07, 0x76, 0x1b, 0x85, 0x2e, 0x0a, 0x88, 0xb3, 0x83, 0x6c, 0x3f,
+	0xb0, 0xb7, 0xda, 0xfa, 0x28, 0x5b, 0x02, 0x5f, 0x1e, 0x64, 0xf1, 0x5e, 0x09, 0xc8, 0x61, 0x6c,
+	0xf4, 0x54, 0xdb, 0x8d, 0x8d, 0x7b, 0xc4, 0x7e, 0xdf, 0x43, 0x4a, 0x5d, 0xbe, 0x57, 0x02, 0xc2,
+	0xf1, 0xeb, 0xac, 0xc7, 0x45, 0xda, 0xe1, 0x6a, 0x2a, 0x7f, 0x25, 0x68, 0xb4, 0xb5, 0x3f, 0x02,
+	0xb8, 0x14, 0xa2, 0x08, 0x77, 0x42, 0x17, 0xd9, 0x01, 0xf6, 0x50, 0xd1, 0x1a, 0xbe, 0x09, 0x7e,
+	0x6d, 0x70, 0x6b, 0xfa, 0x28, 0xee, 0xc6, 0xc6, 0xbd, 0xc9, 0xca, 0xea, 0x25, 0x57, 0x6a, 0xd1,
+	0x62, 0xd2, 0xe7, 0x31, 0xec, 0xa1, 0x9c, 0x4d, 0xbf, 0x02, 0x70, 0xb1, 0x85, 0x88, 0x53, 0x61,
+	0xcf, 0x38, 0xb3, 0xe7, 0xe9, 0xc1, 0xed, 0xe9, 0xa1, 0xb4, 0x1b, 0x1b, 0x77, 0x0b, 0xf7, 0xa9,
+	0x94, 0x29, 0xb5, 0xe3, 0x14, 0x95, 0x2f, 0xda, 0x60, 0x3e, 0x33, 0x0a, 0xc7, 0x85, 0xbb, 0x3d,
+	0x05, 0xa7, 0xaf, 0x3b, 0xcd, 0x26, 0x22, 0xb6, 0xe3, 0x79, 0x21, 0x8a, 0x22, 0x91, 0x0e, 0x5e,
+	0xa4, 0x41, 0x31, 0xcb, 0x91, 0x41, 0x31, 0x4b, 0xa7, 0x43, 0xcf, 0x89, 0xa1, 0x1f, 0xe1, 0xa4,
+	0x75, 0x12, 0x52, 0x8f, 0xae, 0x73, 0x41, 0x41, 0xd4, 0x5e, 0x01, 0xf0, 0xb4, 0x88, 0x73, 0xdb,
+	0x21, 0x6e, 0xd9, 0x62, 0x31, 0xb4, 0x31, 0x6e, 0xea, 0xc7, 0xcf, 0x8e, 0xf4, 0x5e, 0x59, 0x61,
+	0x12, 0x7c, 0x2a, 0x34, 0xc8, 0xe0, 0x53, 0x21, 0x60, 0x7e, 0xff, 0x6f, 0xc6, 0xb9, 0xdb, 0xf8,
+	0x1c, 0x74, 0xb4, 0x48, 0x04, 0x2a, 0xae, 0xea, 0x72, 0x88, 0x5b, 0x3c, 0x94, 0x5e, 0xc5, 0xb8,
+	0xa9, 0xbd, 0x0a, 0xa0, 0xae, 0x8e, 0x41, 0x42, 0x67, 0x7b, 0xdb, 0x77, 0xb9, 0x1d, 0x23, 0xfd,
+	0xec, 0x20, 0xc2, 0x8e, 0x4a, 0x15, 0x85, 0x3d, 0xa1, 0x20, 0x31, 0x84, 0x25, 0xf3, 0xd2, 0x92,
+	0x0d, 0xae, 0x89, 0x9a, 0x62, 0xfe, 0x1b, 0x40, 0x6d, 0xdd, 0x0f, 0x76, 0x9a, 0xe8, 0x71, 0xf6,
+	0xa5, 0x36, 0x59, 0x52, 0x74, 0xa4, 0x4e, 0x41, 0xe0, 0x38, 0x4f, 0xc5, 0x44, 0x05, 0xf1, 0x99,
+	0x81, 0x57, 0x8e, 0xe8, 0xdf, 0x8d, 0x8d, 0xba, 0x9a, 0xf8, 0x95, 0xae, 0x08, 0x21, 0x6b, 0x76,
+	0x60, 0x5d, 0xb5, 0x38, 0xd2, 0x3c, 0x38, 0xc1, 0x59, 0xd4, 0x56, 0xfa, 0x09, 0xcd, 0x7c, 0xa1,
+	0x50, 0x9c, 0xa7, 0xc6, 0x7d, 0xe2, 0x5b, 0x26, 0x5d, 0xbb, 0xb1, 0x31, 0xad, 0x42, 0x48, 0xea,
+	0x81, 0x84, 0x6f, 0xfe, 0x07, 0x40, 0x6d, 0x53, 0x49, 0x3c, 0x2d, 0xe4, 0xe2, 0xd0, 0xd3, 0xd6,
+	0xe1, 0x24, 0xcf, 0x52, 0x51, 0x28, 0x66, 0xfa, 0xa1, 0xc3, 0xd8, 0x48, 0x69, 0xdd, 0xd8, 0x98,
+	0x49, 0x3c, 0x82, 0x53, 0xaa, 0x67, 0x37, 0xed, 0xa4, 0x3d, 0x01, 0xef, 0xe0, 0xbf, 0xed, 0x10,
+	0x6d, 0xa3, 0x10, 0x05, 0x6e, 0x32, 0xc5, 0x2b, 0x87, 0xb1, 0x51, 0xe0, 0x75, 0x63, 0xe3, 0xb4,
+	0x3a, 0x88, 0xe4, 0x98, 0xd6, 0x4c, 0x28, 0xd0, 0x0a, 0x8a, 0xf6, 0x01, 0x38, 0x41, 0xf6, 0xed,
+	0x5d, 0x27, 0xe2, 0xb5, 0xda, 0x89, 0xc6, 0x5d, 0x74, 0x16, 0x04, 0x49, 0xce, 0x82, 0x20, 0x98,
+	0xd6, 0x38, 0xd9, 0x7f, 0x94, 0xfe, 0xf8, 0xf3, 0x71, 0x58, 0x63, 0x29, 0xbf, 0x88, 0x3e, 0x2f,
+	0x80, 0xfc, 0x0e, 0x0b, 0xfa, 0xad, 0x9f, 0xcf, 0x0e, 0xb4, 0xc3, 0x0e, 0xbe, 0x52, 0xb2, 0xbb,
+	0xf1, 0x8b, 0x00, 0x4e, 0x27, 0x4b, 0x4f, 0x20, 0xec, 0x1b, 0xa9, 0x9e, 0x14, 0x08, 0x73, 0x1d,
+	0xe5, 0x4a, 0xc9, 0xd2, 0x87, 0xc0, 0x58, 0x17, 0x1a, 0x38, 0x48, 0xf3, 0x87, 0x73, 0x70, 0x62,
+	0x0d, 0x91, 0xd0, 0x77, 0x23, 0xed, 0xab, 0x00, 0x4e, 0x65, 0x8a, 0x39, 0xee, 0x4f, 0xbb, 0x03,
+	0xaf, 0xaa, 0xa9, 0x5c, 0x4d, 0x37, 0xab, 0xa6, 0x50, 0x9c, 0x5a, 0xba, 0xc2, 0x6a, 0x44, 0x29,
+	0xe9, 0xbe, 0x0b, 0xe0, 0x6c, 0x9a, 0x74, 0xd1, 0xcf, 0x22, 0x40, 0x71, 0x3f, 0xdc, 0x1b, 0x18,
+	0x54, 0x99, 0xb2, 0x6e, 0x6c, 0x2c, 0xe6, 0xd2, 0x3b, 0xc9, 0x2c, 0x85, 0x78, 0x32, 0x49, 0xf5,
+	0xfc, 0x60, 0x47, 0x00, 0x7d, 0x11, 0x40, 0x4d, 0xcd, 0x0e, 0x09, 0xbe, 0x86, 0x82, 0x48, 0x38,
+	0x37, 0x19, 0x18, 0x67, 0x89, 0xae, 0x6e, 0x6c, 0x2c, 0x14, 0xb3, 0x50, 0xce, 0x2b, 0x45, 0x79,
+	0x87, 0x4c, 0x48, 0x37, 0x98, 0x94, 0xf6, 0x7b, 0x00, 0xef, 0xe4, 0x9d, 0x33, 0x39, 0x4d, 0x64,
+	0x7b, 0xa8, 0x8d, 0x23, 0x9f, 0xbc, 0x89, 0xd4, 0xb0, 0x97, 0x5a, 0x99, 0x1a, 0xf6, 0x92, 0x2a,
+	0xb5, 0x61, 0x81, 0xf0, 0xc5, 0x2e, 0xb3, 0xa9, 0x68, 0x95, 0x8b, 0x6b, 0x3f, 0x4d, 0x2b, 0x11,
+	0x7a, 0xa6, 0x84, 0xe8, 0xc1, 0x50, 0x13, 0xed, 0xb0, 0x12, 0x50, 0x24, 0x85, 0x5f, 0x18, 0xd8,
+	0x8c, 0x2a, 0x85, 0xf9, 0xc2, 0xa4, 0x20, 0x50, 0x0a, 0x9e, 0x57, 0x13, 0x0d, 0x26, 0xbb, 0x9a,
+	0x8a, 0x6a, 0xbf, 0x00, 0x90, 0x9b, 0x65, 0x77, 0x82, 0x22, 0x74, 0x9e, 0xff, 0x7d, 0x69, 0xf0,
+	0x4f, 0x50, 0xad, 0xb3, 0x1b, 0x1b, 0x67, 0x55, 0xf4, 0x9d, 0xe0, 0xf6, 0xf0, 0xf3, 0xd9, 0xf8,
+	0x54, 0xb0, 0x95, 0xb7, 0xe0, 0xd7, 0x00, 0x2e, 0xaa, 0xaa, 0xe8, 0x72, 0x51, 0x4c, 0x98, 0x18,
+	0x3a, 0x85, 0xad, 0x56, 0x2a, 0x53, 0xd8, 0x6a, 0x99, 0xf2, 0xe2, 0x42, 0x31, 0xc2, 0x0f, 0x76,
+	0x14, 0x2b, 0x5e, 0x02, 0x50, 0x73, 0xfd, 0xd0, 0xed, 0x34, 0xc5, 0x41, 0x03, 0x0f, 0x2d, 0x93,
+	0xc3, 0x2e, 0xd9, 0xa2, 0x2e, 0xb9, 0x64, 0x8b, 0xbc, 0xf2, 0xc0, 0xa2, 0xc8, 0x55, 0x45, 0x40,
+	0xb1, 0x8b, 0x9c, 0x78, 0x4b, 0x22, 0x60, 0xba, 0xb3, 0x94, 0x45, 0x40, 0xce, 0xec, 0x1b, 0x01,
+	0xc5, 0x4e, 0x47, 0x81, 0xf2, 0xa2, 0x2f, 0x0b, 0x14, 0x0e, 0x0b, 0xb4, 0x44, 0x99, 0x04, 0x5a,
+	0xc2, 0xac, 0x98, 0x51, 0x2a, 0x98, 0x01, 0xfa, 0x23, 0x00, 0x4f, 0xa5, 0x21, 0x28, 0x8b, 0x95,
+	0x9f, 0x0e, 0x7d, 0x7e, 0x60, 0xac, 0x15, 0xfa, 0xba, 0xb1, 0x71, 0x57, 0xae, 0x86, 0xec, 0x8f,
+	0x78, 0x2e, 0x91, 0x5d, 0xcb, 0xe5, 0x11, 0x1a, 0x2b, 0xde, 0xb2, 0x80, 0xa7, 0x86, 0x75, 0xd6,
+	0xa2, 0x2e, 0xe9, 0xac, 0x45, 0x5e, 0xf9, 0xfe, 0x42, 0xe5, 0x32, 0x20, 0x7f, 0x00, 0xe0, 0x7c,
+	0xb6, 0x5a, 0x4e, 0x36, 0x96, 0x3a, 0xc3, 0x79, 0x63, 0x60, 0x9c, 0xe5, 0xea, 0xe4, 0x81, 0x4c,
+	0x29, 0xbb, 0x14, 0xed, 0xac, 0x5a, 0x92, 0x27, 0x7b, 0xc8, 0x0b, 0x00, 0x9e, 0x94, 0x25, 0x71,
+	0x02, 0x76, 0x9a, 0x81, 0x0d, 0x07, 0x06, 0x5b, 0x54, 0xd5, 0x8d, 0x0d, 0x3d, 0x5f, 0x78, 0xf7,
+	0x02, 0x39, 0x93, 0xd4, 0xdb, 0x09, 0xc0, 0x6f, 0x01, 0x38, 0xd3, 0x09, 0xfc, 0x28, 0xea, 0x20,
+	0xcf, 0x6e, 0x87, 0xa8, 0xed, 0x1c, 0xe8, 0x33, 0x0c, 0x5e, 0x30, 0x30, 0xbc, 0xbc, 0xa2, 0x6e,
+	0x6c, 0x9c, 0xe2, 0xe0, 0x72, 0x8c, 0x52, 0x68, 0xd3, 0x89, 0xd0, 0x55, 0x2e, 0xf3, 0x71, 0x38,
+	0xc7, 0x0b, 0x91, 0x2b, 0x81, 0xdb, 0xec, 0x44, 0x3e, 0x0e, 0xae, 0x86, 0x18, 0x6f, 0x6b, 0x0f,
+	0xc2, 0x71, 0x9a, 0xb2, 0x8b, 0x32, 0x68, 0xaa, 0x71, 0x86, 0x16, 0x58, 0x9c, 0x22, 0x0b, 0x2c,
+	0xde, 0x36, 0x2d, 0xc1, 0x30, 0x6f, 0x8e, 0xc1, 0xd9, 0xab, 0x88, 0x05, 0x68, 0xb5, 0xba, 0x79,
+	0x7b, 0xea, 0x9a, 0x36, 0x1c, 0xe3, 0xb7, 0x44, 0x3c, 0x89, 0x7c, 0x62, 0xe0, 0x89, 0x1c, 0x4b,
+	0x2e, 0x93, 0xa6, 0xf8, 0xe0, 0xac, 0x59, 0x3a, 0x69, 0x5c, 0xb2, 0xb4, 0x92, 0x1a, 0x79, 0x8b,
+	0x2a, 0xa9, 0xcb, 0x90, 0x27, 0xcc, 0xb6, 0x8b, 0x3b, 0x01, 0xcf, 0xe0, 0x46, 0x1b, 0xf7, 0x1e,
+	0xc6, 0x86, 0x4a, 0xee, 0xc6, 0x86, 0xa6, 0x06, 0x78, 0x46, 0x34, 0x2d, 0xc8, 0x5a, 0x17, 0x69,
+	0x83, 0x1d, 0x94, 0xf3, 0x22, 0xd3, 0x0e, 0x31, 0x26, 0x2c, 0x85, 0x9a, 0x12, 0x07, 0xe5, 0x0a,
+	0x5d, 0x39, 0x28, 0x57, 0xa8, 0xf4, 0xa0, 0x9c, 0x37, 0x2d, 0x8c, 0x09, 0x3d, 0x28, 0x0f, 0x91,
+	0x8b, 0xfc, 0x3d, 0xe4, 0x09, 0x58, 0xe3, 0x0c, 0x16, 0x3b, 0x28, 0xcf, 0x72, 0x64, 0x51, 0x93,
+	0xa5, 0x9b, 0x56, 0x3d, 0x21, 0x70, 0x7c, 0x9b, 0x70, 0xc6, 0x43, 0x8e, 0xd7, 0xf4, 0x03, 0x64,
+	0xef, 0x22, 0x7f, 0x67, 0x37, 0xb9, 0xcf, 0x39, 0x4f, 0x5d, 0x3b, 0xc7, 0x92, 0xae, 0x9d, 0x63,
+	0x98, 0xd6, 0x74, 0x42, 0x79, 0x94, 0x11, 0xd4, 0x4a, 0x74, 0x72, 0x90, 0x4a, 0xf4, 0xdb, 0xd3,
+	0xa9, 0xcb, 0xae, 0x26, 0xb7, 0x32, 0x34, 0xab, 0x48, 0xbd, 0x0b, 0x1c, 0x95, 0x77, 0xe5, 0x3c,
+	0xe0, 0xf8, 0xb0, 0x1e, 0xb0, 0x09, 0x67, 0xda, 0x21, 0x76, 0x51, 0x14, 0xa5, 0x9f, 0x6d, 0x84,
+	0xe9, 0x62, 0x33, 0x9c, 0x63, 0xc9, 0x19, 0xce, 0x31, 0x4c, 0x6b, 0x3a, 0xa5, 0x70, 0xbd, 0xb2,
+	0x34, 0x72, 0x71, 0x10, 0x75, 0x5a, 0xc8, 0xb3, 0x03, 0x7c, 0x43, 0x1f, 0x1d, 0x76, 0xeb, 0x2a,
+	0xea, 0xca, 0x97, 0x46, 0x2a, 0xaf, 0x47, 0x69, 0x74, 0x51, 0x88, 0x3d, 0x86, 0x6f, 0x68, 0x0f,
+	0xc1, 0x09, 0x76, 0xc3, 0x80, 0x3c, 0xe6, 0xf9, 0x93, 0xdc, 0x0d, 0x04, 0x49, 0xba, 0x81, 0x20,
+	0x98, 0x56, 0xc2, 0xd2, 0x7e, 0x0c, 0xe0, 0x52, 0xf6, 0x26, 0x97, 0xe0, 0x6c, 0x85, 0xd3, 0xff,
+	0x3a, 0x74, 0x43, 0x14, 0xfc, 0x7d, 0x14, 0xc9, 0x23, 0xe9, 0xde, 0x72, 0xe2, 0xd0, 0x68, 0x51,
+	0x3d, 0x88, 0xd8, 0xc0, 0x6a, 0x11, 0xa5, 0xfd, 0x0c, 0x40, 0x23, 0x7b, 0x8a, 0x50, 0x84, 0x3d,
+	0xd1, 0x0f, 0xf6, 0xa6, 0x80, 0xdd, 0x4f, 0x53, 0x37, 0x36, 0xee, 0x2b, 0x3b, 0xb8, 0xa8, 0x02,
+	0x7e, 0x26, 0x73, 0x3a, 0x91, 0x43, 0xfe, 0x12, 0x80, 0xc2, 0x30, 0x45, 0x4b, 0xba, 0xbb, 0xea,
+	0x93, 0xfd, 0x40, 0x7f, 0x42, 0x80, 0xee, 0xa1, 0x44, 0x39, 0x2e, 0xaf, 0x94, 0x31, 0x93, 0x03,
+	0xf2, 0xcc, 0x1c, 0xaf, 0x89, 0xed, 0x5b, 0xfb, 0x1e, 0x80, 0x67, 0x8a, 0xc6, 0x4a, 0x98, 0x27,
+	0xfa, 0xc1, 0x7c, 0x4c, 0xc0, 0xec, 0xa5, 0x45, 0x5e, 0x98, 0xf6, 0x10, 0x32, 0x93, 0x5a, 0x2e,
+	0x3b, 0xa7, 0x29, 0xd2, 0x00, 0x4e, 0xf1, 0x93, 0x04, 0x25, 0x5d, 0xef, 0x89, 0xec, 0xbd, 0x02,
+	0x59, 0xa6, 0x9b, 0xf2, 0xb2, 0x42, 0xa1, 0x8a, 0xb1, 0x6b, 0x8c, 0x26, 0x72, 0xc4, 0xbd, 0xc2,
+	0x79, 0x58, 0xad, 0xdf, 0x88, 0xef, 0x1b, 0xf0, 0x3c, 0xac, 0xec, 0x8c, 0x4b, 0xeb, 0xc0, 0xd3,
+	0xc9, 0xa5, 0x77, 0x7e, 0x1f, 0xe1, 0xb7, 0xb8, 0x1f, 0xa6, 0xf5, 0x7f, 0x85, 0x88, 0xac, 0xff,
+	0x2b, 0x04, 0x4c, 0x6b, 0x5e, 0x70, 0x56, 0xb3, 0xdb, 0xcb, 0x87, 0xe0, 0xa4, 0x60, 0x78, 0x2c,
+	0x09, 0x9e, 0x6c, 0x18, 0x34, 0x83, 0x49, 0x68, 0x32, 0x83, 0x49, 0x28, 0xa6, 0x95, 0x32, 0xd9,
+	0x11, 0x47, 0xe6, 0x51, 0x86, 0x1d, 0xa2, 0x96, 0xe3, 0x07, 0x1e, 0x0a, 0xf5, 0xe9, 0x81, 0x8f,
+	0x38, 0xf8, 0x0d, 0x7c, 0x95, 0x42, 0x69, 0x62, 0x85, 0x40, 0xe9, 0xcd, 0xfb, 0xbc, 0xfa, 0x20,
+	0xc4, 0x4a, 0x45, 0x9f, 0x3f, 0x0e, 0x35, 0x75, 0x57, 0x14, 0xd7, 0x02, 0x47, 0xbf, 0x37, 0xae,
+	0xc0, 0x31, 0x8a, 0x68, 0x5f, 0xec, 0x8a, 0x0b, 0x54, 0x07, 0x23, 0x48, 0x1d, 0xac, 0x69, 0x5a,
+	0x9c, 0xac, 0x3d, 0x99, 0xde, 0x26, 0xf0, 0xab, 0xda, 0xdb, 0x39, 0xc5, 0x7f, 0x97, 0xf0, 0xcf,
+	0xaa, 0x7b, 0x84, 0xec, 0xcd, 0xc1, 0x3f, 0x46, 0xe1, 0xac, 0x9a, 0xe4, 0xae, 0xf2, 0xaf, 0xfd,
+	0x0e, 0x4c, 0xce, 0x7a, 0xea, 0x9b, 0xa1, 0x7e, 0x5c, 0x66, 0xd7, 0x09, 0xad, 0xe0, 0x9b, 0xbd,
+	0xb2, 0xeb, 0x44, 0x24, 0x93, 0xb2, 0x8f, 0xbc, 0x55, 0x29, 0xfb, 0x83, 0x70, 0x3c, 0x44, 0x4e,
+	0x84, 0x03, 0x91, 0x35, 0xb0, 0xa2, 0x82, 0x53, 0xe4, 0x6c, 0xf3, 0xb6, 0x69, 0x09, 0x86, 0xf6,
+	0xc5, 0xf4, 0x12, 0x2a, 0xb9, 0x98, 0x19, 0xbb, 0xed, 0x8b, 0x99, 0x34, 0xe4, 0x64, 0x35, 0x14,
+	0x2e, 0xab, 0xb2, 0xd7, 0x34, 0xf5, 0xeb, 0x8a, 0x8e, 0x88, 0x95, 0x42, 0x3c, 0xc2, 0x8c, 0xb3,
+	0x08, 0xc3, 0x4b, 0xa1, 0x24, 0xa0, 0x24, 0xa5, 0x90, 0x88, 0x1f, 0x82, 0x41, 0xb3, 0xb9, 0x76,
+	0x88, 0xdb, 0x38, 0x72, 0x9a, 0xb6, 0xef, 0xe9, 0x13, 0x32, 0x9b, 0x53, 0xc8, 0x32, 0x9b, 0x53,
+	0x88, 0xa6, 0x05, 0x93, 0xd6, 0x15, 0xcf, 0xfc, 0xf9, 0x08, 0xd4, 0xb8, 0x83, 0x5d, 0x52, 0x1e,
+	0xc7, 0xbc, 0x03, 0x5e, 0xf6, 0x49, 0x38, 0xe1, 0xb8, 0x6e, 0x27, 0x42, 0x9e, 0x70, 0xb2, 0xf7,
+	0xd3, 0xcc, 0x4a, 0x90, 0x64, 0x66, 0x25, 0x08, 0xd5, 0xde, 0x90, 0x74, 0x79, 0x7b, 0x3c, 0xec,
+	0xd3, 0x70, 0x82, 0x3d, 0x34, 0x42, 0x9e, 0x3e, 0xda, 0x6f, 0x3f, 0x4a, 0x6f, 0xed, 0x44, 0x0f,
+	0x69, 0x84, 0x20, 0x24, 0xb7, 0x76, 0xa2, 0xa9, 0x38, 0xc2, 0xd8, 0x6d, 0x3b, 0x82, 0x79, 0x73,
+	0x1c, 0xd6, 0x2d, 0xf5, 0xe5, 0xce, 0x91, 0xde, 0xaa, 0x1e, 0x7d, 0x91, 0xdc, 0xeb, 0x72, 0x7f,
+	0xe4, 0xff, 0xe4, 0x72, 0x7f, 0xf4, 0x7f, 0xe9, 0x72, 0x9f, 0x1e, 0xe7, 0x9d, 0x64, 0x2e, 0xcc,
+	0x8f, 0xd9, 0xbd, 0x8e, 0xcb, 0xcb, 0xa3, 0x3e, 0x36, 0x6c, 0x0b, 0x1b, 0x8a, 0x7d, 0xe5, 0x71,
+	0x58, 0x81, 0x35, 0x04, 0xea, 0x3b, 0x12, 0x25, 0xab, 0x89, 0x8e, 0x7f, 0x02, 0x58, 0x7b, 0xa4,
+	0x43, 0x68, 0xc1, 0x40, 0x9c, 0x6b, 0x47, 0xfb, 0x0c, 0x21, 0x80, 0x33, 0x01, 0x22, 0xd7, 0x71,
+	0x78, 0x2d, 0x1d, 0x8c, 0x2f, 0x1d, 0xfa, 0xde, 0x34, 0xcf, 0x92, 0xd5, 0x73, 0x8e, 0x51, 0x3d,
+	0xdc, 0xb4, 0x90, 0x14, 0x54, 0xf3, 0x37, 0x93, 0x70, 0x66, 0x93, 0x3f, 0x25, 0x5c, 0x77, 0x77,
+	0x91, 0xd7, 0x69, 0x1e, 0xad, 0xbd, 0xbf, 0x04, 0xf0, 0x14, 0xaf, 0xb6, 0x07, 0x7f, 0x8a, 0xd3,
+	0x16, 0x1e, 0x52, 0xa1, 0x40, 0x1e, 0x9b, 0x97, 0xf3, 0x87, 0xf0, 0x15, 0x7e, 0xeb, 0x91, 0x5b,
+	0xaa, 0xaf, 0xa4, 0x37, 0x88, 0x43, 0x3c, 0xc3, 0x09, 0xb3, 0x6f, 0x19, 0xcb, 0x16, 0xea, 0x52,
+	0xc1, 0x88, 0x37, 0xb9, 0x4e, 0xe7, 0x52, 0x2b, 0xd4, 0x65, 0xfa, 0x07, 0x00, 0x17, 0x42, 0xd4,
+	0x44, 0x4e, 0x84, 0x4a, 0x42, 0x67, 0xdf, 0x90, 0xb3, 0x27, 0x0c, 0xa9, 0xd6, 0x21, 0xef, 0x0f,
+	0x2b, 0x45, 0x86, 0x30, 0xe6, 0x54, 0xa2, 0x2c, 0xf7, 0x55, 0x5e, 0x03, 0x70, 0x31, 0x3b, 0x4e,
+	0xe6, 0xc3, 0xf4, 0x0d, 0x3f, 0xfb, 0x49, 0x81, 0x5f, 0xad, 0x44, 0x16, 0xf8, 0xd5, 0x32, 0x43,
+	0x58, 0x74, 0x5a, 0xb5, 0x48, 0xfd, 0x42, 0x97, 0x61, 0x8d, 0x1d, 0x17, 0x89, 0xbf, 0x4e, 0xe0,
+	0xd9, 0x20, 0xcb, 0xe9, 0x14, 0xb2, 0xcc, 0xe9, 0x14, 0xa2, 0x69, 0x41, 0xd6, 0xe2, 0x7f, 0x9a,
+	0x50, 0x7c, 0x80, 0x3c, 0xf1, 0x66, 0x1f, 0x20, 0x37, 0xd6, 0x5e, 0xbe, 0xb5, 0x04, 0x5e, 0xbd,
+	0xb5, 0x04, 0x5e, 0xbb, 0xb5, 0x04, 0xfe, 0x7e, 0x6b, 0x09, 0x3c, 0xfb, 0xfa, 0xd2, 0xb1, 0xd7,
+	0x5e, 0x5f, 0x3a, 0xf6, 0x97, 0xd7, 0x97, 0x8e, 0x3d, 0xb1, 0xa2, 0xcc, 0x80, 0xc8, 0x9a, 0x03,
+	0x44, 0x92, 0x9f, 0xe7, 0xd9, 0xe5, 0x9a, 0x78, 0x36, 0xcc, 0xa6, 0x63, 0x6b, 0x9c, 0xfd, 0xad,
+	0xc8, 0x83, 0xff, 0x1d, 0x00, 0x3a, 0x76, 0xfc, 0x21, 0xbb, 0x32, 0x00, 0x00,
+}
+
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Params)
 	if !ok {
-		that2, ok := that.(Metrics)
+		that2, ok := that.(Params)
 		if ok {
 			that1 = &that2
 		} else {
@@ -868,793 +1471,2172 @@ func (this *Metrics) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.TotalSupply.Equal(that1.TotalSupply) {
-		return false
-	}
-	if !this.TotalMiningSupply.Equal(that1.TotalMiningSupply) {
+	if this.BondDenom != that1.BondDenom {
 		return false
 	}
-	if !this.TotalMinedTokens.Equal(that1.TotalMinedTokens) {
+	if this.RewardDenom != that1.RewardDenom {
 		return false
 	}
-	if !this.TotalResourceNodesDeposit.Equal(that1.TotalResourceNodesDeposit) {
+	if this.MatureEpoch != that1.MatureEpoch {
 		return false
 	}
-	if !this.TotalBondedDelegation.Equal(that1.TotalBondedDelegation) {
+	if len(this.MiningRewardParams) != len(that1.MiningRewardParams) {
 		return false
 	}
-	if !this.TotalUnbondedDelegation.Equal(that1.TotalUnbondedDelegation) {
-		return false
+	for i := range this.MiningRewardParams {
+		if !this.MiningRewardParams[i].Equal(&that1.MiningRewardParams[i]) {
+			return false
+		}
 	}
-	if !this.TotalUnbondingDelegation.Equal(that1.TotalUnbondingDelegation) {
+	if !this.CommunityTax.Equal(that1.CommunityTax) {
 		return false
 	}
-	if !this.CirculationSupply.Equal(that1.CirculationSupply) {
+	if !this.InitialTotalSupply.Equal(&that1.InitialTotalSupply) {
 		return false
 	}
-	if !this.TotalMiningReward.Equal(that1.TotalMiningReward) {
+	if this.VolumeReportChunkTimeout != that1.VolumeReportChunkTimeout {
 		return false
 	}
-	if !this.ChainMiningReward.Equal(that1.ChainMiningReward) {
+	if this.DistributeBatchSize != that1.DistributeBatchSize {
 		return false
 	}
-	if !this.ResourceMiningReward.Equal(that1.ResourceMiningReward) {
+	if this.DisputeWindow != that1.DisputeWindow {
 		return false
 	}
-	if !this.MetaMiningReward.Equal(that1.MetaMiningReward) {
+	if !this.EquivocationSlashFraction.Equal(that1.EquivocationSlashFraction) {
 		return false
 	}
-	if !this.ResourceNodeDeposit.Equal(that1.ResourceNodeDeposit) {
+	if this.VestingMode != that1.VestingMode {
 		return false
 	}
-	if !this.MetaNodeDeposit.Equal(that1.MetaNodeDeposit) {
+	if this.VestingEpochs != that1.VestingEpochs {
 		return false
 	}
-	if !this.UnissuedPrepay.Equal(that1.UnissuedPrepay) {
+	if this.RewardHistoryRetention != that1.RewardHistoryRetention {
 		return false
 	}
 	return true
 }
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *MiningRewardParam) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.InitialTotalSupply.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	that1, ok := that.(*MiningRewardParam)
+	if !ok {
+		that2, ok := that.(MiningRewardParam)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i -= size
-		i = encodeVarintPot(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x32
-	{
-		size := m.CommunityTax.Size()
-		i -= size
-		if _, err := m.CommunityTax.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintPot(dAtA, i, uint64(size))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	i--
-	dAtA[i] = 0x2a
-	if len(m.MiningRewardParams) > 0 {
-		for iNdEx := len(m.MiningRewardParams) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.MiningRewardParams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPot(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
+	if !this.TotalMinedValveStart.Equal(&that1.TotalMinedValveStart) {
+		return false
 	}
-	if m.MatureEpoch != 0 {
-		i = encodeVarintPot(dAtA, i, uint64(m.MatureEpoch))
-		i--
-		dAtA[i] = 0x18
+	if !this.TotalMinedValveEnd.Equal(&that1.TotalMinedValveEnd) {
+		return false
 	}
-	if len(m.RewardDenom) > 0 {
-		i -= len(m.RewardDenom)
-		copy(dAtA[i:], m.RewardDenom)
-		i = encodeVarintPot(dAtA, i, uint64(len(m.RewardDenom)))
-		i--
-		dAtA[i] = 0x12
+	if !this.MiningReward.Equal(&that1.MiningReward) {
+		return false
 	}
-	if len(m.BondDenom) > 0 {
-		i -= len(m.BondDenom)
-		copy(dAtA[i:], m.BondDenom)
-		i = encodeVarintPot(dAtA, i, uint64(len(m.BondDenom)))
-		i--
-		dAtA[i] = 0xa
+	if !this.BlockChainPercentageInBp.Equal(that1.BlockChainPercentageInBp) {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MiningRewardParam) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if !this.ResourceNodePercentageInBp.Equal(that1.ResourceNodePercentageInBp) {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MiningRewardParam) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if !this.MetaNodePercentageInBp.Equal(that1.MetaNodePercentageInBp) {
+		return false
+	}
+	return true
 }
+func (this *Reward) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *MiningRewardParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.MetaNodePercentageInBp.Size()
-		i -= size
-		if _, err := m.MetaNodePercentageInBp.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	that1, ok := that.(*Reward)
+	if !ok {
+		that2, ok := that.(Reward)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i = encodeVarintPot(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x32
-	{
-		size := m.ResourceNodePercentageInBp.Size()
-		i -= size
-		if _, err := m.ResourceNodePercentageInBp.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintPot(dAtA, i, uint64(size))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size := m.BlockChainPercentageInBp.Size()
-		i -= size
-		if _, err := m.BlockChainPercentageInBp.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintPot(dAtA, i, uint64(size))
+	if this.WalletAddress != that1.WalletAddress {
+		return false
 	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size, err := m.MiningReward.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintPot(dAtA, i, uint64(size))
+	if len(this.RewardFromMiningPool) != len(that1.RewardFromMiningPool) {
+		return false
 	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size, err := m.TotalMinedValveEnd.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	for i := range this.RewardFromMiningPool {
+		if !this.RewardFromMiningPool[i].Equal(&that1.RewardFromMiningPool[i]) {
+			return false
 		}
-		i -= size
-		i = encodeVarintPot(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.TotalMinedValveStart.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(this.RewardFromTrafficPool) != len(that1.RewardFromTrafficPool) {
+		return false
+	}
+	for i := range this.RewardFromTrafficPool {
+		if !this.RewardFromTrafficPool[i].Equal(&that1.RewardFromTrafficPool[i]) {
+			return false
 		}
-		i -= size
-		i = encodeVarintPot(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return true
 }
-
-func (m *Reward) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *SingleWalletVolume) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Reward) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *Reward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.RewardFromTrafficPool) > 0 {
-		for iNdEx := len(m.RewardFromTrafficPool) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.RewardFromTrafficPool[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPot(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+	that1, ok := that.(*SingleWalletVolume)
+	if !ok {
+		that2, ok := that.(SingleWalletVolume)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	if len(m.RewardFromMiningPool) > 0 {
-		for iNdEx := len(m.RewardFromMiningPool) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.RewardFromMiningPool[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPot(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.WalletAddress) > 0 {
-		i -= len(m.WalletAddress)
-		copy(dAtA[i:], m.WalletAddress)
-		i = encodeVarintPot(dAtA, i, uint64(len(m.WalletAddress)))
-		i--
-		dAtA[i] = 0xa
+	if this.WalletAddress != that1.WalletAddress {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *SingleWalletVolume) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if !this.Volume.Equal(that1.Volume) {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *SingleWalletVolume) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return true
 }
+func (this *WalletVolumes) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *SingleWalletVolume) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Volume.Size()
-		i -= size
-		if _, err := m.Volume.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	that1, ok := that.(*WalletVolumes)
+	if !ok {
+		that2, ok := that.(WalletVolumes)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i = encodeVarintPot(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.WalletAddress) > 0 {
-		i -= len(m.WalletAddress)
-		copy(dAtA[i:], m.WalletAddress)
-		i = encodeVarintPot(dAtA, i, uint64(len(m.WalletAddress)))
-		i--
-		dAtA[i] = 0xa
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *WalletVolumes) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(this.Volumes) != len(that1.Volumes) {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *WalletVolumes) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *WalletVolumes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Volumes) > 0 {
-		for iNdEx := len(m.Volumes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Volumes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPot(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	for i := range this.Volumes {
+		if !this.Volumes[i].Equal(&that1.Volumes[i]) {
+			return false
 		}
 	}
-	return len(dAtA) - i, nil
+	return true
 }
+func (this *VolumeReportRecord) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *VolumeReportRecord) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*VolumeReportRecord)
+	if !ok {
+		that2, ok := that.(VolumeReportRecord)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return dAtA[:n], nil
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Reporter != that1.Reporter {
+		return false
+	}
+	if this.ReportReference != that1.ReportReference {
+		return false
+	}
+	if this.TxHash != that1.TxHash {
+		return false
+	}
+	return true
 }
+func (this *TotalReward) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *VolumeReportRecord) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *VolumeReportRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TxHash) > 0 {
-		i -= len(m.TxHash)
-		copy(dAtA[i:], m.TxHash)
-		i = encodeVarintPot(dAtA, i, uint64(len(m.TxHash)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.ReportReference) > 0 {
-		i -= len(m.ReportReference)
-		copy(dAtA[i:], m.ReportReference)
-		i = encodeVarintPot(dAtA, i, uint64(len(m.ReportReference)))
-		i--
-		dAtA[i] = 0x12
+	that1, ok := that.(*TotalReward)
+	if !ok {
+		that2, ok := that.(TotalReward)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if len(m.Reporter) > 0 {
-		i -= len(m.Reporter)
-		copy(dAtA[i:], m.Reporter)
-		i = encodeVarintPot(dAtA, i, uint64(len(m.Reporter)))
-		i--
-		dAtA[i] = 0xa
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *TotalReward) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(this.MiningReward) != len(that1.MiningReward) {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *TotalReward) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *TotalReward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TrafficReward) > 0 {
-		for iNdEx := len(m.TrafficReward) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TrafficReward[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPot(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+	for i := range this.MiningReward {
+		if !this.MiningReward[i].Equal(&that1.MiningReward[i]) {
+			return false
 		}
 	}
-	if len(m.MiningReward) > 0 {
-		for iNdEx := len(m.MiningReward) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.MiningReward[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPot(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	if len(this.TrafficReward) != len(that1.TrafficReward) {
+		return false
+	}
+	for i := range this.TrafficReward {
+		if !this.TrafficReward[i].Equal(&that1.TrafficReward[i]) {
+			return false
 		}
 	}
-	return len(dAtA) - i, nil
+	return true
 }
-
-func (m *Metrics) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *Metrics) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
 
-func (m *Metrics) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Metrics) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.UnissuedPrepay.Size()
-		i -= size
-		if _, err := m.UnissuedPrepay.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	that1, ok := that.(*Metrics)
+	if !ok {
+		that2, ok := that.(Metrics)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i = encodeVarintPot(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x7a
-	{
-		size := m.MetaNodeDeposit.Size()
-		i -= size
-		if _, err := m.MetaNodeDeposit.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintPot(dAtA, i, uint64(size))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	i--
-	dAtA[i] = 0x72
-	{
-		size := m.ResourceNodeDeposit.Size()
-		i -= size
-		if _, err := m.ResourceNodeDeposit.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintPot(dAtA, i, uint64(size))
+	if !this.TotalSupply.Equal(that1.TotalSupply) {
+		return false
 	}
-	i--
-	dAtA[i] = 0x6a
-	{
-		size := m.MetaMiningReward.Size()
-		i -= size
-		if _, err := m.MetaMiningReward.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintPot(dAtA, i, uint64(size))
+	if !this.TotalMiningSupply.Equal(that1.TotalMiningSupply) {
+		return false
 	}
-	i--
-	dAtA[i] = 0x62
-	{
-		size := m.ResourceMiningReward.Size()
-		i -= size
-		if _, err := m.ResourceMiningReward.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintPot(dAtA, i, uint64(size))
+	if !this.TotalMinedTokens.Equal(that1.TotalMinedTokens) {
+		return false
 	}
-	i--
-	dAtA[i] = 0x5a
-	{
-		size := m.ChainMiningReward.Size()
-		i -= size
-		if _, err := m.ChainMiningReward.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintPot(dAtA, i, uint64(size))
+	if !this.TotalResourceNodesDeposit.Equal(that1.TotalResourceNodesDeposit) {
+		return false
 	}
-	i--
-	dAtA[i] = 0x52
-	{
-		size := m.TotalMiningReward.Size()
-		i -= size
-		if _, err := m.TotalMiningReward.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintPot(dAtA, i, uint64(size))
+	if !this.TotalBondedDelegation.Equal(that1.TotalBondedDelegation) {
+		return false
 	}
-	i--
-	dAtA[i] = 0x4a
-	{
-		size := m.CirculationSupply.Size()
-		i -= size
-		if _, err := m.CirculationSupply.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintPot(dAtA, i, uint64(size))
+	if !this.TotalUnbondedDelegation.Equal(that1.TotalUnbondedDelegation) {
+		return false
 	}
-	i--
-	dAtA[i] = 0x42
-	{
-		size := m.TotalUnbondingDelegation.Size()
-		i -= size
-		if _, err := m.TotalUnbondingDelegation.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintPot(dAtA, i, uint64(size))
+	if !this.TotalUnbondingDelegation.Equal(that1.TotalUnbondingDelegation) {
+		return false
 	}
-	i--
-	dAtA[i] = 0x3a
-	{
-		size := m.TotalUnbondedDelegation.Size()
-		i -= size
-		if _, err := m.TotalUnbondedDelegation.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintPot(dAtA, i, uint64(size))
+	if !this.CirculationSupply.Equal(that1.CirculationSupply) {
+		return false
 	}
-	i--
-	dAtA[i] = 0x32
-	{
-		size := m.TotalBondedDelegation.Size()
-		i -= size
-		if _, err := m.TotalBondedDelegation.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintPot(dAtA, i, uint64(size))
+	if !this.TotalMiningReward.Equal(that1.TotalMiningReward) {
+		return false
 	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size := m.TotalResourceNodesDeposit.Size()
-		i -= size
-		if _, err := m.TotalResourceNodesDeposit.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintPot(dAtA, i, uint64(size))
+	if !this.ChainMiningReward.Equal(that1.ChainMiningReward) {
+		return false
 	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.TotalMinedTokens.Size()
-		i -= size
-		if _, err := m.TotalMinedTokens.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintPot(dAtA, i, uint64(size))
+	if !this.ResourceMiningReward.Equal(that1.ResourceMiningReward) {
+		return false
 	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.TotalMiningSupply.Size()
-		i -= size
-		if _, err := m.TotalMiningSupply.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintPot(dAtA, i, uint64(size))
+	if !this.MetaMiningReward.Equal(that1.MetaMiningReward) {
+		return false
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.TotalSupply.Size()
-		i -= size
-		if _, err := m.TotalSupply.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintPot(dAtA, i, uint64(size))
+	if !this.ResourceNodeDeposit.Equal(that1.ResourceNodeDeposit) {
+		return false
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintPot(dAtA []byte, offset int, v uint64) int {
-	offset -= sovPot(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if !this.MetaNodeDeposit.Equal(that1.MetaNodeDeposit) {
+		return false
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	if !this.UnissuedPrepay.Equal(that1.UnissuedPrepay) {
+		return false
+	}
+	return true
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *VolumeInclusionProof) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	var l int
-	_ = l
-	l = len(m.BondDenom)
-	if l > 0 {
-		n += 1 + l + sovPot(uint64(l))
+
+	that1, ok := that.(*VolumeInclusionProof)
+	if !ok {
+		that2, ok := that.(VolumeInclusionProof)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	l = len(m.RewardDenom)
-	if l > 0 {
-		n += 1 + l + sovPot(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.MatureEpoch != 0 {
-		n += 1 + sovPot(uint64(m.MatureEpoch))
+	if len(this.Hashes) != len(that1.Hashes) {
+		return false
 	}
-	if len(m.MiningRewardParams) > 0 {
-		for _, e := range m.MiningRewardParams {
-			l = e.Size()
-			n += 1 + l + sovPot(uint64(l))
+	for i := range this.Hashes {
+		if !bytes.Equal(this.Hashes[i], that1.Hashes[i]) {
+			return false
 		}
 	}
-	l = m.CommunityTax.Size()
-	n += 1 + l + sovPot(uint64(l))
-	l = m.InitialTotalSupply.Size()
-	n += 1 + l + sovPot(uint64(l))
-	return n
+	return true
 }
-
-func (m *MiningRewardParam) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *PendingVolumeReport) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	var l int
-	_ = l
-	l = m.TotalMinedValveStart.Size()
-	n += 1 + l + sovPot(uint64(l))
-	l = m.TotalMinedValveEnd.Size()
-	n += 1 + l + sovPot(uint64(l))
-	l = m.MiningReward.Size()
-	n += 1 + l + sovPot(uint64(l))
-	l = m.BlockChainPercentageInBp.Size()
-	n += 1 + l + sovPot(uint64(l))
-	l = m.ResourceNodePercentageInBp.Size()
-	n += 1 + l + sovPot(uint64(l))
-	l = m.MetaNodePercentageInBp.Size()
-	n += 1 + l + sovPot(uint64(l))
-	return n
-}
 
-func (m *Reward) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*PendingVolumeReport)
+	if !ok {
+		that2, ok := that.(PendingVolumeReport)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	l = len(m.WalletAddress)
-	if l > 0 {
-		n += 1 + l + sovPot(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.RewardFromMiningPool) > 0 {
-		for _, e := range m.RewardFromMiningPool {
-			l = e.Size()
-			n += 1 + l + sovPot(uint64(l))
-		}
+	if this.Reporter != that1.Reporter {
+		return false
 	}
-	if len(m.RewardFromTrafficPool) > 0 {
-		for _, e := range m.RewardFromTrafficPool {
-			l = e.Size()
-			n += 1 + l + sovPot(uint64(l))
-		}
+	if !this.Epoch.Equal(that1.Epoch) {
+		return false
 	}
-	return n
-}
-
-func (m *SingleWalletVolume) Size() (n int) {
-	if m == nil {
-		return 0
+	if this.ReportReference != that1.ReportReference {
+		return false
 	}
-	var l int
-	_ = l
-	l = len(m.WalletAddress)
-	if l > 0 {
-		n += 1 + l + sovPot(uint64(l))
+	if this.TotalCount != that1.TotalCount {
+		return false
 	}
-	l = m.Volume.Size()
-	n += 1 + l + sovPot(uint64(l))
-	return n
-}
-
-func (m *WalletVolumes) Size() (n int) {
-	if m == nil {
-		return 0
+	if !bytes.Equal(this.VolumesRoot, that1.VolumesRoot) {
+		return false
 	}
-	var l int
-	_ = l
-	if len(m.Volumes) > 0 {
-		for _, e := range m.Volumes {
-			l = e.Size()
-			n += 1 + l + sovPot(uint64(l))
-		}
+	if this.ReceivedCount != that1.ReceivedCount {
+		return false
 	}
-	return n
+	if this.DeadlineHeight != that1.DeadlineHeight {
+		return false
+	}
+	if this.TxHash != that1.TxHash {
+		return false
+	}
+	return true
 }
+func (this *PendingDistribution) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *VolumeReportRecord) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*PendingDistribution)
+	if !ok {
+		that2, ok := that.(PendingDistribution)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	l = len(m.Reporter)
-	if l > 0 {
-		n += 1 + l + sovPot(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	l = len(m.ReportReference)
-	if l > 0 {
-		n += 1 + l + sovPot(uint64(l))
+	if !this.Epoch.Equal(that1.Epoch) {
+		return false
 	}
-	l = len(m.TxHash)
-	if l > 0 {
-		n += 1 + l + sovPot(uint64(l))
+	if this.TotalCount != that1.TotalCount {
+		return false
 	}
-	return n
-}
-
-func (m *TotalReward) Size() (n int) {
-	if m == nil {
-		return 0
+	if this.ProcessedCount != that1.ProcessedCount {
+		return false
 	}
-	var l int
-	_ = l
-	if len(m.MiningReward) > 0 {
-		for _, e := range m.MiningReward {
-			l = e.Size()
-			n += 1 + l + sovPot(uint64(l))
-		}
+	if !this.TotalConsumedNoz.Equal(that1.TotalConsumedNoz) {
+		return false
 	}
-	if len(m.TrafficReward) > 0 {
-		for _, e := range m.TrafficReward {
-			l = e.Size()
-			n += 1 + l + sovPot(uint64(l))
-		}
+	if this.Started != that1.Started {
+		return false
 	}
-	return n
-}
-
-func (m *Metrics) Size() (n int) {
-	if m == nil {
-		return 0
+	if !this.MiningRewardToResourceNode.Equal(&that1.MiningRewardToResourceNode) {
+		return false
 	}
-	var l int
-	_ = l
-	l = m.TotalSupply.Size()
-	n += 1 + l + sovPot(uint64(l))
-	l = m.TotalMiningSupply.Size()
-	n += 1 + l + sovPot(uint64(l))
-	l = m.TotalMinedTokens.Size()
-	n += 1 + l + sovPot(uint64(l))
-	l = m.TotalResourceNodesDeposit.Size()
-	n += 1 + l + sovPot(uint64(l))
-	l = m.TotalBondedDelegation.Size()
-	n += 1 + l + sovPot(uint64(l))
-	l = m.TotalUnbondedDelegation.Size()
-	n += 1 + l + sovPot(uint64(l))
-	l = m.TotalUnbondingDelegation.Size()
+	if !this.TrafficRewardToResourceNode.Equal(&that1.TrafficRewardToResourceNode) {
+		return false
+	}
+	if !this.MiningRewardToMetaNode.Equal(&that1.MiningRewardToMetaNode) {
+		return false
+	}
+	if !this.TrafficRewardToMetaNode.Equal(&that1.TrafficRewardToMetaNode) {
+		return false
+	}
+	if !this.MinedReward.Equal(&that1.MinedReward) {
+		return false
+	}
+	if !this.TrafficReward.Equal(&that1.TrafficReward) {
+		return false
+	}
+	if this.DisputeDeadlineHeight != that1.DisputeDeadlineHeight {
+		return false
+	}
+	if this.Disputed != that1.Disputed {
+		return false
+	}
+	if !this.CommunityTaxRemainder.Equal(that1.CommunityTaxRemainder) {
+		return false
+	}
+	return true
+}
+func (this *DistributionVolume) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DistributionVolume)
+	if !ok {
+		that2, ok := that.(DistributionVolume)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Epoch.Equal(that1.Epoch) {
+		return false
+	}
+	if this.Index != that1.Index {
+		return false
+	}
+	if !this.Volume.Equal(&that1.Volume) {
+		return false
+	}
+	return true
+}
+func (this *VolumeReportDispute) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*VolumeReportDispute)
+	if !ok {
+		that2, ok := that.(VolumeReportDispute)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Epoch.Equal(that1.Epoch) {
+		return false
+	}
+	if this.Disputer != that1.Disputer {
+		return false
+	}
+	if this.Reporter != that1.Reporter {
+		return false
+	}
+	if this.Reason != that1.Reason {
+		return false
+	}
+	if len(this.WalletVolumes) != len(that1.WalletVolumes) {
+		return false
+	}
+	for i := range this.WalletVolumes {
+		if !this.WalletVolumes[i].Equal(&that1.WalletVolumes[i]) {
+			return false
+		}
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	if this.ProposalId != that1.ProposalId {
+		return false
+	}
+	return true
+}
+func (this *ReportEquivocation) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ReportEquivocation)
+	if !ok {
+		that2, ok := that.(ReportEquivocation)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Epoch.Equal(that1.Epoch) {
+		return false
+	}
+	if this.Accused != that1.Accused {
+		return false
+	}
+	if this.Reporter != that1.Reporter {
+		return false
+	}
+	if !this.Slashed.Equal(&that1.Slashed) {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	return true
+}
+func (this *RewardHistory) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RewardHistory)
+	if !ok {
+		that2, ok := that.(RewardHistory)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.WalletAddress != that1.WalletAddress {
+		return false
+	}
+	if !this.Epoch.Equal(that1.Epoch) {
+		return false
+	}
+	if len(this.RewardFromMiningPool) != len(that1.RewardFromMiningPool) {
+		return false
+	}
+	for i := range this.RewardFromMiningPool {
+		if !this.RewardFromMiningPool[i].Equal(&that1.RewardFromMiningPool[i]) {
+			return false
+		}
+	}
+	if len(this.RewardFromTrafficPool) != len(that1.RewardFromTrafficPool) {
+		return false
+	}
+	for i := range this.RewardFromTrafficPool {
+		if !this.RewardFromTrafficPool[i].Equal(&that1.RewardFromTrafficPool[i]) {
+			return false
+		}
+	}
+	if len(this.SlashingDeducted) != len(that1.SlashingDeducted) {
+		return false
+	}
+	for i := range this.SlashingDeducted {
+		if !this.SlashingDeducted[i].Equal(&that1.SlashingDeducted[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *AutoRestake) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AutoRestake)
+	if !ok {
+		that2, ok := that.(AutoRestake)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.WalletAddress != that1.WalletAddress {
+		return false
+	}
+	if this.NetworkAddress != that1.NetworkAddress {
+		return false
+	}
+	return true
+}
+func (this *VestingSchedule) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*VestingSchedule)
+	if !ok {
+		that2, ok := that.(VestingSchedule)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.WalletAddress != that1.WalletAddress {
+		return false
+	}
+	if len(this.TotalFromMiningPool) != len(that1.TotalFromMiningPool) {
+		return false
+	}
+	for i := range this.TotalFromMiningPool {
+		if !this.TotalFromMiningPool[i].Equal(&that1.TotalFromMiningPool[i]) {
+			return false
+		}
+	}
+	if len(this.TotalFromTrafficPool) != len(that1.TotalFromTrafficPool) {
+		return false
+	}
+	for i := range this.TotalFromTrafficPool {
+		if !this.TotalFromTrafficPool[i].Equal(&that1.TotalFromTrafficPool[i]) {
+			return false
+		}
+	}
+	if len(this.ReleasedFromMiningPool) != len(that1.ReleasedFromMiningPool) {
+		return false
+	}
+	for i := range this.ReleasedFromMiningPool {
+		if !this.ReleasedFromMiningPool[i].Equal(&that1.ReleasedFromMiningPool[i]) {
+			return false
+		}
+	}
+	if len(this.ReleasedFromTrafficPool) != len(that1.ReleasedFromTrafficPool) {
+		return false
+	}
+	for i := range this.ReleasedFromTrafficPool {
+		if !this.ReleasedFromTrafficPool[i].Equal(&that1.ReleasedFromTrafficPool[i]) {
+			return false
+		}
+	}
+	if this.StartEpoch != that1.StartEpoch {
+		return false
+	}
+	if this.VestingEpochs != that1.VestingEpochs {
+		return false
+	}
+	return true
+}
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RewardHistoryRetention != 0 {
+		i = encodeVarintPot(dAtA, i, uint64(m.RewardHistoryRetention))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.VestingEpochs != 0 {
+		i = encodeVarintPot(dAtA, i, uint64(m.VestingEpochs))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.VestingMode) > 0 {
+		i -= len(m.VestingMode)
+		copy(dAtA[i:], m.VestingMode)
+		i = encodeVarintPot(dAtA, i, uint64(len(m.VestingMode)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	{
+		size := m.EquivocationSlashFraction.Size()
+		i -= size
+		if _, err := m.EquivocationSlashFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPot(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if m.DisputeWindow != 0 {
+		i = encodeVarintPot(dAtA, i, uint64(m.DisputeWindow))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.DistributeBatchSize != 0 {
+		i = encodeVarintPot(dAtA, i, uint64(m.DistributeBatchSize))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.VolumeReportChunkTimeout != 0 {
+		i = encodeVarintPot(dAtA, i, uint64(m.VolumeReportChunkTimeout))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size, err := m.InitialTotalSupply.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintPot(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.CommunityTax.Size()
+		i -= size
+		if _, err := m.CommunityTax.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPot(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.MiningRewardParams) > 0 {
+		for iNdEx := len(m.MiningRewardParams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MiningRewardParams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPot(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.MatureEpoch != 0 {
+		i = encodeVarintPot(dAtA, i, uint64(m.MatureEpoch))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.RewardDenom) > 0 {
+		i -= len(m.RewardDenom)
+		copy(dAtA[i:], m.RewardDenom)
+		i = encodeVarintPot(dAtA, i, uint64(len(m.RewardDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BondDenom) > 0 {
+		i -= len(m.BondDenom)
+		copy(dAtA[i:], m.BondDenom)
+		i = encodeVarintPot(dAtA, i, uint64(len(m.BondDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MiningRewardParam) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MiningRewardParam) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MiningRewardParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MetaNodePercentageInBp.Size()
+		i -= size
+		if _, err := m.MetaNodePercentageInBp.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPot(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.ResourceNodePercentageInBp.Size()
+		i -= size
+		if _, err := m.ResourceNodePercentageInBp.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPot(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.BlockChainPercentageInBp.Size()
+		i -= size
+		if _, err := m.BlockChainPercentageInBp.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPot(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.MiningReward.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintPot(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.TotalMinedValveEnd.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintPot(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.TotalMinedValveStart.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintPot(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Reward) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Reward) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Reward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RewardFromTrafficPool) > 0 {
+		for iNdEx := len(m.RewardFromTrafficPool) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardFromTrafficPool[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPot(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.RewardFromMiningPool) > 0 {
+		for iNdEx := len(m.RewardFromMiningPool) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardFromMiningPool[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPot(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.WalletAddress) > 0 {
+		i -= len(m.WalletAddress)
+		copy(dAtA[i:], m.WalletAddress)
+		i = encodeVarintPot(dAtA, i, uint64(len(m.WalletAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SingleWalletVolume) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SingleWalletVolume) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SingleWalletVolume) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Volume.Size()
+		i -= size
+		if _, err := m.Volume.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPot(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.WalletAddress) > 0 {
+		i -= len(m.WalletAddress)
+		copy(dAtA[i:], m.WalletAddress)
+		i = encodeVarintPot(dAtA, i, uint64(len(m.WalletAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WalletVolumes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WalletVolumes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WalletVolumes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Volumes) > 0 {
+		for iNdEx := len(m.Volumes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Volumes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPot(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VolumeReportRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VolumeReportRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VolumeReportRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintPot(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ReportReference) > 0 {
+		i -= len(m.ReportReference)
+		copy(dAtA[i:], m.ReportReference)
+		i = encodeVarintPot(dAtA, i, uint64(len(m.ReportReference)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Reporter) > 0 {
+		i -= len(m.Reporter)
+		copy(dAtA[i:], m.Reporter)
+		i = encodeVarintPot(dAtA, i, uint64(len(m.Reporter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TotalReward) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TotalReward) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TotalReward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TrafficReward) > 0 {
+		for iNdEx := len(m.TrafficReward) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TrafficReward[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPot(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.MiningReward) > 0 {
+		for iNdEx := len(m.MiningReward) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MiningReward[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPot(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Metrics) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Metrics) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Metrics) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.UnissuedPrepay.Size()
+		i -= size
+		if _, err := m.UnissuedPrepay.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPot(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x7a
+	{
+		size := m.MetaNodeDeposit.Size()
+		i -= size
+		if _, err := m.MetaNodeDeposit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPot(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
+	{
+		size := m.ResourceNodeDeposit.Size()
+		i -= size
+		if _, err := m.ResourceNodeDeposit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPot(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	{
+		size := m.MetaMiningReward.Size()
+		i -= size
+		if _, err := m.MetaMiningReward.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPot(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	{
+		size := m.ResourceMiningReward.Size()
+		i -= size
+		if _, err := m.ResourceMiningReward.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPot(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.ChainMiningReward.Size()
+		i -= size
+		if _, err := m.ChainMiningReward.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPot(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.TotalMiningReward.Size()
+		i -= size
+		if _, err := m.TotalMiningReward.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPot(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.CirculationSupply.Size()
+		i -= size
+		if _, err := m.CirculationSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPot(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.TotalUnbondingDelegation.Size()
+		i -= size
+		if _, err := m.TotalUnbondingDelegation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPot(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.TotalUnbondedDelegation.Size()
+		i -= size
+		if _, err := m.TotalUnbondedDelegation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPot(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.TotalBondedDelegation.Size()
+		i -= size
+		if _, err := m.TotalBondedDelegation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPot(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.TotalResourceNodesDeposit.Size()
+		i -= size
+		if _, err := m.TotalResourceNodesDeposit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPot(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.TotalMinedTokens.Size()
+		i -= size
+		if _, err := m.TotalMinedTokens.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPot(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.TotalMiningSupply.Size()
+		i -= size
+		if _, err := m.TotalMiningSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPot(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.TotalSupply.Size()
+		i -= size
+		if _, err := m.TotalSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPot(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *VolumeInclusionProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VolumeInclusionProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VolumeInclusionProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hashes) > 0 {
+		for iNdEx := len(m.Hashes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Hashes[iNdEx])
+			copy(dAtA[i:], m.Hashes[iNdEx])
+			i = encodeVarintPot(dAtA, i, uint64(len(m.Hashes[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingVolumeReport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingVolumeReport) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingVolumeReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintPot(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.DeadlineHeight != 0 {
+		i = encodeVarintPot(dAtA, i, uint64(m.DeadlineHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.ReceivedCount != 0 {
+		i = encodeVarintPot(dAtA, i, uint64(m.ReceivedCount))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.VolumesRoot) > 0 {
+		i -= len(m.VolumesRoot)
+		copy(dAtA[i:], m.VolumesRoot)
+		i = encodeVarintPot(dAtA, i, uint64(len(m.VolumesRoot)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.TotalCount != 0 {
+		i = encodeVarintPot(dAtA, i, uint64(m.TotalCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ReportReference) > 0 {
+		i -= len(m.ReportReference)
+		copy(dAtA[i:], m.ReportReference)
+		i = encodeVarintPot(dAtA, i, uint64(len(m.ReportReference)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.Epoch.Size()
+		i -= size
+		if _, err := m.Epoch.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPot(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Reporter) > 0 {
+		i -= len(m.Reporter)
+		copy(dAtA[i:], m.Reporter)
+		i = encodeVarintPot(dAtA, i, uint64(len(m.Reporter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingDistribution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingDistribution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingDistribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.CommunityTaxRemainder.Size()
+		i -= size
+		if _, err := m.CommunityTaxRemainder.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPot(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
+	if m.Disputed {
+		i--
+		if m.Disputed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.DisputeDeadlineHeight != 0 {
+		i = encodeVarintPot(dAtA, i, uint64(m.DisputeDeadlineHeight))
+		i--
+		dAtA[i] = 0x60
+	}
+	{
+		size, err := m.TrafficReward.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintPot(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size, err := m.MinedReward.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintPot(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size, err := m.TrafficRewardToMetaNode.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintPot(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size, err := m.MiningRewardToMetaNode.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintPot(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size, err := m.TrafficRewardToResourceNode.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintPot(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size, err := m.MiningRewardToResourceNode.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintPot(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.Started {
+		i--
+		if m.Started {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.TotalConsumedNoz.Size()
+		i -= size
+		if _, err := m.TotalConsumedNoz.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPot(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.ProcessedCount != 0 {
+		i = encodeVarintPot(dAtA, i, uint64(m.ProcessedCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TotalCount != 0 {
+		i = encodeVarintPot(dAtA, i, uint64(m.TotalCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.Epoch.Size()
+		i -= size
+		if _, err := m.Epoch.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPot(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *DistributionVolume) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DistributionVolume) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DistributionVolume) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Volume.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintPot(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Index != 0 {
+		i = encodeVarintPot(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.Epoch.Size()
+		i -= size
+		if _, err := m.Epoch.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPot(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *VolumeReportDispute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VolumeReportDispute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VolumeReportDispute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		i = encodeVarintPot(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Height != 0 {
+		i = encodeVarintPot(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.WalletVolumes) > 0 {
+		for iNdEx := len(m.WalletVolumes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WalletVolumes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPot(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintPot(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Reporter) > 0 {
+		i -= len(m.Reporter)
+		copy(dAtA[i:], m.Reporter)
+		i = encodeVarintPot(dAtA, i, uint64(len(m.Reporter)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Disputer) > 0 {
+		i -= len(m.Disputer)
+		copy(dAtA[i:], m.Disputer)
+		i = encodeVarintPot(dAtA, i, uint64(len(m.Disputer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.Epoch.Size()
+		i -= size
+		if _, err := m.Epoch.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPot(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ReportEquivocation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReportEquivocation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReportEquivocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintPot(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size, err := m.Slashed.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintPot(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Reporter) > 0 {
+		i -= len(m.Reporter)
+		copy(dAtA[i:], m.Reporter)
+		i = encodeVarintPot(dAtA, i, uint64(len(m.Reporter)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Accused) > 0 {
+		i -= len(m.Accused)
+		copy(dAtA[i:], m.Accused)
+		i = encodeVarintPot(dAtA, i, uint64(len(m.Accused)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.Epoch.Size()
+		i -= size
+		if _, err := m.Epoch.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPot(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *RewardHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RewardHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RewardHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SlashingDeducted) > 0 {
+		for iNdEx := len(m.SlashingDeducted) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SlashingDeducted[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPot(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.RewardFromTrafficPool) > 0 {
+		for iNdEx := len(m.RewardFromTrafficPool) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardFromTrafficPool[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPot(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.RewardFromMiningPool) > 0 {
+		for iNdEx := len(m.RewardFromMiningPool) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardFromMiningPool[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPot(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size := m.Epoch.Size()
+		i -= size
+		if _, err := m.Epoch.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPot(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.WalletAddress) > 0 {
+		i -= len(m.WalletAddress)
+		copy(dAtA[i:], m.WalletAddress)
+		i = encodeVarintPot(dAtA, i, uint64(len(m.WalletAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AutoRestake) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AutoRestake) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AutoRestake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NetworkAddress) > 0 {
+		i -= len(m.NetworkAddress)
+		copy(dAtA[i:], m.NetworkAddress)
+		i = encodeVarintPot(dAtA, i, uint64(len(m.NetworkAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.WalletAddress) > 0 {
+		i -= len(m.WalletAddress)
+		copy(dAtA[i:], m.WalletAddress)
+		i = encodeVarintPot(dAtA, i, uint64(len(m.WalletAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VestingSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VestingSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VestingSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.VestingEpochs != 0 {
+		i = encodeVarintPot(dAtA, i, uint64(m.VestingEpochs))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.StartEpoch != 0 {
+		i = encodeVarintPot(dAtA, i, uint64(m.StartEpoch))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.ReleasedFromTrafficPool) > 0 {
+		for iNdEx := len(m.ReleasedFromTrafficPool) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReleasedFromTrafficPool[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPot(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.ReleasedFromMiningPool) > 0 {
+		for iNdEx := len(m.ReleasedFromMiningPool) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReleasedFromMiningPool[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPot(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.TotalFromTrafficPool) > 0 {
+		for iNdEx := len(m.TotalFromTrafficPool) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalFromTrafficPool[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPot(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.TotalFromMiningPool) > 0 {
+		for iNdEx := len(m.TotalFromMiningPool) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalFromMiningPool[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPot(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.WalletAddress) > 0 {
+		i -= len(m.WalletAddress)
+		copy(dAtA[i:], m.WalletAddress)
+		i = encodeVarintPot(dAtA, i, uint64(len(m.WalletAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPot(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPot(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BondDenom)
+	if l > 0 {
+		n += 1 + l + sovPot(uint64(l))
+	}
+	l = len(m.RewardDenom)
+	if l > 0 {
+		n += 1 + l + sovPot(uint64(l))
+	}
+	if m.MatureEpoch != 0 {
+		n += 1 + sovPot(uint64(m.MatureEpoch))
+	}
+	if len(m.MiningRewardParams) > 0 {
+		for _, e := range m.MiningRewardParams {
+			l = e.Size()
+			n += 1 + l + sovPot(uint64(l))
+		}
+	}
+	l = m.CommunityTax.Size()
+	n += 1 + l + sovPot(uint64(l))
+	l = m.InitialTotalSupply.Size()
+	n += 1 + l + sovPot(uint64(l))
+	if m.VolumeReportChunkTimeout != 0 {
+		n += 1 + sovPot(uint64(m.VolumeReportChunkTimeout))
+	}
+	if m.DistributeBatchSize != 0 {
+		n += 1 + sovPot(uint64(m.DistributeBatchSize))
+	}
+	if m.DisputeWindow != 0 {
+		n += 1 + sovPot(uint64(m.DisputeWindow))
+	}
+	l = m.EquivocationSlashFraction.Size()
+	n += 1 + l + sovPot(uint64(l))
+	l = len(m.VestingMode)
+	if l > 0 {
+		n += 1 + l + sovPot(uint64(l))
+	}
+	if m.VestingEpochs != 0 {
+		n += 1 + sovPot(uint64(m.VestingEpochs))
+	}
+	if m.RewardHistoryRetention != 0 {
+		n += 1 + sovPot(uint64(m.RewardHistoryRetention))
+	}
+	return n
+}
+
+func (m *MiningRewardParam) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TotalMinedValveStart.Size()
+	n += 1 + l + sovPot(uint64(l))
+	l = m.TotalMinedValveEnd.Size()
+	n += 1 + l + sovPot(uint64(l))
+	l = m.MiningReward.Size()
+	n += 1 + l + sovPot(uint64(l))
+	l = m.BlockChainPercentageInBp.Size()
+	n += 1 + l + sovPot(uint64(l))
+	l = m.ResourceNodePercentageInBp.Size()
+	n += 1 + l + sovPot(uint64(l))
+	l = m.MetaNodePercentageInBp.Size()
+	n += 1 + l + sovPot(uint64(l))
+	return n
+}
+
+func (m *Reward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.WalletAddress)
+	if l > 0 {
+		n += 1 + l + sovPot(uint64(l))
+	}
+	if len(m.RewardFromMiningPool) > 0 {
+		for _, e := range m.RewardFromMiningPool {
+			l = e.Size()
+			n += 1 + l + sovPot(uint64(l))
+		}
+	}
+	if len(m.RewardFromTrafficPool) > 0 {
+		for _, e := range m.RewardFromTrafficPool {
+			l = e.Size()
+			n += 1 + l + sovPot(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SingleWalletVolume) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.WalletAddress)
+	if l > 0 {
+		n += 1 + l + sovPot(uint64(l))
+	}
+	l = m.Volume.Size()
+	n += 1 + l + sovPot(uint64(l))
+	return n
+}
+
+func (m *WalletVolumes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Volumes) > 0 {
+		for _, e := range m.Volumes {
+			l = e.Size()
+			n += 1 + l + sovPot(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *VolumeReportRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Reporter)
+	if l > 0 {
+		n += 1 + l + sovPot(uint64(l))
+	}
+	l = len(m.ReportReference)
+	if l > 0 {
+		n += 1 + l + sovPot(uint64(l))
+	}
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovPot(uint64(l))
+	}
+	return n
+}
+
+func (m *TotalReward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MiningReward) > 0 {
+		for _, e := range m.MiningReward {
+			l = e.Size()
+			n += 1 + l + sovPot(uint64(l))
+		}
+	}
+	if len(m.TrafficReward) > 0 {
+		for _, e := range m.TrafficReward {
+			l = e.Size()
+			n += 1 + l + sovPot(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Metrics) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TotalSupply.Size()
+	n += 1 + l + sovPot(uint64(l))
+	l = m.TotalMiningSupply.Size()
+	n += 1 + l + sovPot(uint64(l))
+	l = m.TotalMinedTokens.Size()
+	n += 1 + l + sovPot(uint64(l))
+	l = m.TotalResourceNodesDeposit.Size()
+	n += 1 + l + sovPot(uint64(l))
+	l = m.TotalBondedDelegation.Size()
+	n += 1 + l + sovPot(uint64(l))
+	l = m.TotalUnbondedDelegation.Size()
+	n += 1 + l + sovPot(uint64(l))
+	l = m.TotalUnbondingDelegation.Size()
 	n += 1 + l + sovPot(uint64(l))
 	l = m.CirculationSupply.Size()
 	n += 1 + l + sovPot(uint64(l))
@@ -1675,13 +3657,2176 @@ func (m *Metrics) Size() (n int) {
 	return n
 }
 
-func sovPot(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozPot(x uint64) (n int) {
-	return sovPot(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *VolumeInclusionProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Hashes) > 0 {
+		for _, b := range m.Hashes {
+			l = len(b)
+			n += 1 + l + sovPot(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PendingVolumeReport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Reporter)
+	if l > 0 {
+		n += 1 + l + sovPot(uint64(l))
+	}
+	l = m.Epoch.Size()
+	n += 1 + l + sovPot(uint64(l))
+	l = len(m.ReportReference)
+	if l > 0 {
+		n += 1 + l + sovPot(uint64(l))
+	}
+	if m.TotalCount != 0 {
+		n += 1 + sovPot(uint64(m.TotalCount))
+	}
+	l = len(m.VolumesRoot)
+	if l > 0 {
+		n += 1 + l + sovPot(uint64(l))
+	}
+	if m.ReceivedCount != 0 {
+		n += 1 + sovPot(uint64(m.ReceivedCount))
+	}
+	if m.DeadlineHeight != 0 {
+		n += 1 + sovPot(uint64(m.DeadlineHeight))
+	}
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovPot(uint64(l))
+	}
+	return n
+}
+
+func (m *PendingDistribution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Epoch.Size()
+	n += 1 + l + sovPot(uint64(l))
+	if m.TotalCount != 0 {
+		n += 1 + sovPot(uint64(m.TotalCount))
+	}
+	if m.ProcessedCount != 0 {
+		n += 1 + sovPot(uint64(m.ProcessedCount))
+	}
+	l = m.TotalConsumedNoz.Size()
+	n += 1 + l + sovPot(uint64(l))
+	if m.Started {
+		n += 2
+	}
+	l = m.MiningRewardToResourceNode.Size()
+	n += 1 + l + sovPot(uint64(l))
+	l = m.TrafficRewardToResourceNode.Size()
+	n += 1 + l + sovPot(uint64(l))
+	l = m.MiningRewardToMetaNode.Size()
+	n += 1 + l + sovPot(uint64(l))
+	l = m.TrafficRewardToMetaNode.Size()
+	n += 1 + l + sovPot(uint64(l))
+	l = m.MinedReward.Size()
+	n += 1 + l + sovPot(uint64(l))
+	l = m.TrafficReward.Size()
+	n += 1 + l + sovPot(uint64(l))
+	if m.DisputeDeadlineHeight != 0 {
+		n += 1 + sovPot(uint64(m.DisputeDeadlineHeight))
+	}
+	if m.Disputed {
+		n += 2
+	}
+	l = m.CommunityTaxRemainder.Size()
+	n += 1 + l + sovPot(uint64(l))
+	return n
+}
+
+func (m *DistributionVolume) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Epoch.Size()
+	n += 1 + l + sovPot(uint64(l))
+	if m.Index != 0 {
+		n += 1 + sovPot(uint64(m.Index))
+	}
+	l = m.Volume.Size()
+	n += 1 + l + sovPot(uint64(l))
+	return n
+}
+
+func (m *VolumeReportDispute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Epoch.Size()
+	n += 1 + l + sovPot(uint64(l))
+	l = len(m.Disputer)
+	if l > 0 {
+		n += 1 + l + sovPot(uint64(l))
+	}
+	l = len(m.Reporter)
+	if l > 0 {
+		n += 1 + l + sovPot(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovPot(uint64(l))
+	}
+	if len(m.WalletVolumes) > 0 {
+		for _, e := range m.WalletVolumes {
+			l = e.Size()
+			n += 1 + l + sovPot(uint64(l))
+		}
+	}
+	if m.Height != 0 {
+		n += 1 + sovPot(uint64(m.Height))
+	}
+	if m.ProposalId != 0 {
+		n += 1 + sovPot(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func (m *ReportEquivocation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Epoch.Size()
+	n += 1 + l + sovPot(uint64(l))
+	l = len(m.Accused)
+	if l > 0 {
+		n += 1 + l + sovPot(uint64(l))
+	}
+	l = len(m.Reporter)
+	if l > 0 {
+		n += 1 + l + sovPot(uint64(l))
+	}
+	l = m.Slashed.Size()
+	n += 1 + l + sovPot(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovPot(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *RewardHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.WalletAddress)
+	if l > 0 {
+		n += 1 + l + sovPot(uint64(l))
+	}
+	l = m.Epoch.Size()
+	n += 1 + l + sovPot(uint64(l))
+	if len(m.RewardFromMiningPool) > 0 {
+		for _, e := range m.RewardFromMiningPool {
+			l = e.Size()
+			n += 1 + l + sovPot(uint64(l))
+		}
+	}
+	if len(m.RewardFromTrafficPool) > 0 {
+		for _, e := range m.RewardFromTrafficPool {
+			l = e.Size()
+			n += 1 + l + sovPot(uint64(l))
+		}
+	}
+	if len(m.SlashingDeducted) > 0 {
+		for _, e := range m.SlashingDeducted {
+			l = e.Size()
+			n += 1 + l + sovPot(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AutoRestake) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.WalletAddress)
+	if l > 0 {
+		n += 1 + l + sovPot(uint64(l))
+	}
+	l = len(m.NetworkAddress)
+	if l > 0 {
+		n += 1 + l + sovPot(uint64(l))
+	}
+	return n
+}
+
+func (m *VestingSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.WalletAddress)
+	if l > 0 {
+		n += 1 + l + sovPot(uint64(l))
+	}
+	if len(m.TotalFromMiningPool) > 0 {
+		for _, e := range m.TotalFromMiningPool {
+			l = e.Size()
+			n += 1 + l + sovPot(uint64(l))
+		}
+	}
+	if len(m.TotalFromTrafficPool) > 0 {
+		for _, e := range m.TotalFromTrafficPool {
+			l = e.Size()
+			n += 1 + l + sovPot(uint64(l))
+		}
+	}
+	if len(m.ReleasedFromMiningPool) > 0 {
+		for _, e := range m.ReleasedFromMiningPool {
+			l = e.Size()
+			n += 1 + l + sovPot(uint64(l))
+		}
+	}
+	if len(m.ReleasedFromTrafficPool) > 0 {
+		for _, e := range m.ReleasedFromTrafficPool {
+			l = e.Size()
+			n += 1 + l + sovPot(uint64(l))
+		}
+	}
+	if m.StartEpoch != 0 {
+		n += 1 + sovPot(uint64(m.StartEpoch))
+	}
+	if m.VestingEpochs != 0 {
+		n += 1 + sovPot(uint64(m.VestingEpochs))
+	}
+	return n
+}
+
+func sovPot(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPot(x uint64) (n int) {
+	return sovPot(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPot
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPot
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BondDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPot
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MatureEpoch", wireType)
+			}
+			m.MatureEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MatureEpoch |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MiningRewardParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPot
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MiningRewardParams = append(m.MiningRewardParams, MiningRewardParam{})
+			if err := m.MiningRewardParams[len(m.MiningRewardParams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityTax", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPot
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommunityTax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialTotalSupply", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPot
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InitialTotalSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VolumeReportChunkTimeout", wireType)
+			}
+			m.VolumeReportChunkTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VolumeReportChunkTimeout |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributeBatchSize", wireType)
+			}
+			m.DistributeBatchSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DistributeBatchSize |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisputeWindow", wireType)
+			}
+			m.DisputeWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DisputeWindow |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EquivocationSlashFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPot
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EquivocationSlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingMode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPot
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingMode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingEpochs", wireType)
+			}
+			m.VestingEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VestingEpochs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardHistoryRetention", wireType)
+			}
+			m.RewardHistoryRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RewardHistoryRetention |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPot(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPot
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MiningRewardParam) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPot
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MiningRewardParam: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MiningRewardParam: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalMinedValveStart", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPot
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalMinedValveStart.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalMinedValveEnd", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPot
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalMinedValveEnd.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MiningReward", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPot
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MiningReward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockChainPercentageInBp", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPot
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BlockChainPercentageInBp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceNodePercentageInBp", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPot
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ResourceNodePercentageInBp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetaNodePercentageInBp", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPot
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MetaNodePercentageInBp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPot(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPot
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Reward) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPot
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Reward: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Reward: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WalletAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPot
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WalletAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardFromMiningPool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPot
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardFromMiningPool = append(m.RewardFromMiningPool, types.Coin{})
+			if err := m.RewardFromMiningPool[len(m.RewardFromMiningPool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardFromTrafficPool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPot
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardFromTrafficPool = append(m.RewardFromTrafficPool, types.Coin{})
+			if err := m.RewardFromTrafficPool[len(m.RewardFromTrafficPool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPot(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPot
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SingleWalletVolume) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPot
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SingleWalletVolume: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SingleWalletVolume: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WalletAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPot
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WalletAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPot
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Volume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPot(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPot
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WalletVolumes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPot
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WalletVolumes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WalletVolumes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volumes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPot
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Volumes = append(m.Volumes, SingleWalletVolume{})
+			if err := m.Volumes[len(m.Volumes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPot(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPot
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VolumeReportRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPot
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VolumeReportRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VolumeReportRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reporter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPot
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reporter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReportReference", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPot
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReportReference = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPot
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPot(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPot
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TotalReward) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPot
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TotalReward: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TotalReward: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MiningReward", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPot
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MiningReward = append(m.MiningReward, types.Coin{})
+			if err := m.MiningReward[len(m.MiningReward)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrafficReward", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPot
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TrafficReward = append(m.TrafficReward, types.Coin{})
+			if err := m.TrafficReward[len(m.TrafficReward)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPot(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPot
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Metrics) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPot
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Metrics: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Metrics: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPot
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalMiningSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPot
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalMiningSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalMinedTokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPot
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalMinedTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalResourceNodesDeposit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPot
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalResourceNodesDeposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalBondedDelegation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPot
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalBondedDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalUnbondedDelegation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPot
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalUnbondedDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalUnbondingDelegation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPot
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalUnbondingDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CirculationSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPot
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CirculationSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalMiningReward", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPot
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalMiningReward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainMiningReward", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPot
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ChainMiningReward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceMiningReward", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPot
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ResourceMiningReward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetaMiningReward", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPot
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MetaMiningReward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceNodeDeposit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPot
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ResourceNodeDeposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetaNodeDeposit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPot
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MetaNodeDeposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnissuedPrepay", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPot
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UnissuedPrepay.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPot(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPot
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VolumeInclusionProof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPot
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VolumeInclusionProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VolumeInclusionProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hashes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPot
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hashes = append(m.Hashes, make([]byte, postIndex-iNdEx))
+			copy(m.Hashes[len(m.Hashes)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPot(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPot
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *PendingVolumeReport) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1704,15 +5849,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: PendingVolumeReport: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PendingVolumeReport: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BondDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reporter", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1740,11 +5885,11 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BondDenom = string(dAtA[iNdEx:postIndex])
+			m.Reporter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1772,13 +5917,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RewardDenom = string(dAtA[iNdEx:postIndex])
+			if err := m.Epoch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MatureEpoch", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReportReference", wireType)
 			}
-			m.MatureEpoch = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPot
@@ -1788,16 +5935,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MatureEpoch |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPot
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReportReference = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MiningRewardParams", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalCount", wireType)
 			}
-			var msglen int
+			m.TotalCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPot
@@ -1807,31 +5967,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.TotalCount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPot
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPot
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.MiningRewardParams = append(m.MiningRewardParams, MiningRewardParam{})
-			if err := m.MiningRewardParams[len(m.MiningRewardParams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommunityTax", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VolumesRoot", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPot
@@ -1841,31 +5986,69 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthPot
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPot
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CommunityTax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.VolumesRoot = append(m.VolumesRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.VolumesRoot == nil {
+				m.VolumesRoot = []byte{}
 			}
 			iNdEx = postIndex
 		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceivedCount", wireType)
+			}
+			m.ReceivedCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReceivedCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeadlineHeight", wireType)
+			}
+			m.DeadlineHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeadlineHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitialTotalSupply", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPot
@@ -1875,24 +6058,23 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPot
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPot
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InitialTotalSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1915,7 +6097,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MiningRewardParam) Unmarshal(dAtA []byte) error {
+func (m *PendingDistribution) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1934,19 +6116,145 @@ func (m *MiningRewardParam) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MiningRewardParam: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MiningRewardParam: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingDistribution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingDistribution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPot
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Epoch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalCount", wireType)
+			}
+			m.TotalCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessedCount", wireType)
+			}
+			m.ProcessedCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProcessedCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalConsumedNoz", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPot
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalConsumedNoz.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Started", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Started = bool(v != 0)
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalMinedValveStart", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MiningRewardToResourceNode", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1973,13 +6281,13 @@ func (m *MiningRewardParam) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalMinedValveStart.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MiningRewardToResourceNode.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalMinedValveEnd", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TrafficRewardToResourceNode", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2006,13 +6314,13 @@ func (m *MiningRewardParam) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalMinedValveEnd.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TrafficRewardToResourceNode.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MiningReward", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MiningRewardToMetaNode", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2039,15 +6347,15 @@ func (m *MiningRewardParam) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MiningReward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MiningRewardToMetaNode.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockChainPercentageInBp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TrafficRewardToMetaNode", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPot
@@ -2057,31 +6365,30 @@ func (m *MiningRewardParam) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPot
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPot
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BlockChainPercentageInBp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TrafficRewardToMetaNode.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceNodePercentageInBp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinedReward", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPot
@@ -2091,31 +6398,30 @@ func (m *MiningRewardParam) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPot
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPot
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ResourceNodePercentageInBp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MinedReward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetaNodePercentageInBp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TrafficReward", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPot
@@ -2125,81 +6431,30 @@ func (m *MiningRewardParam) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPot
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPot
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MetaNodePercentageInBp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TrafficReward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPot(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPot
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Reward) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPot
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Reward: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Reward: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WalletAddress", wireType)
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisputeDeadlineHeight", wireType)
 			}
-			var stringLen uint64
+			m.DisputeDeadlineHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPot
@@ -2209,29 +6464,16 @@ func (m *Reward) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.DisputeDeadlineHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPot
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPot
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.WalletAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardFromMiningPool", wireType)
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Disputed", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPot
@@ -2241,31 +6483,17 @@ func (m *Reward) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPot
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPot
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RewardFromMiningPool = append(m.RewardFromMiningPool, types.Coin{})
-			if err := m.RewardFromMiningPool[len(m.RewardFromMiningPool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
+			m.Disputed = bool(v != 0)
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardFromTrafficPool", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityTaxRemainder", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPot
@@ -2275,23 +6503,23 @@ func (m *Reward) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPot
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPot
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RewardFromTrafficPool = append(m.RewardFromTrafficPool, types.Coin{})
-			if err := m.RewardFromTrafficPool[len(m.RewardFromTrafficPool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CommunityTaxRemainder.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2316,7 +6544,7 @@ func (m *Reward) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SingleWalletVolume) Unmarshal(dAtA []byte) error {
+func (m *DistributionVolume) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2339,15 +6567,15 @@ func (m *SingleWalletVolume) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SingleWalletVolume: wiretype end group for non-group")
+			return fmt.Errorf("proto: DistributionVolume: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SingleWalletVolume: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DistributionVolume: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WalletAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2375,13 +6603,15 @@ func (m *SingleWalletVolume) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WalletAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.Epoch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
-			var stringLen uint64
+			m.Index = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPot
@@ -2391,79 +6621,14 @@ func (m *SingleWalletVolume) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Index |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPot
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPot
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Volume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPot(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPot
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *WalletVolumes) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPot
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: WalletVolumes: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WalletVolumes: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Volumes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2490,8 +6655,7 @@ func (m *WalletVolumes) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Volumes = append(m.Volumes, SingleWalletVolume{})
-			if err := m.Volumes[len(m.Volumes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Volume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2516,7 +6680,7 @@ func (m *WalletVolumes) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *VolumeReportRecord) Unmarshal(dAtA []byte) error {
+func (m *VolumeReportDispute) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2539,15 +6703,15 @@ func (m *VolumeReportRecord) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: VolumeReportRecord: wiretype end group for non-group")
+			return fmt.Errorf("proto: VolumeReportDispute: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VolumeReportRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VolumeReportDispute: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reporter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2575,11 +6739,13 @@ func (m *VolumeReportRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reporter = string(dAtA[iNdEx:postIndex])
+			if err := m.Epoch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReportReference", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Disputer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2607,11 +6773,11 @@ func (m *VolumeReportRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReportReference = string(dAtA[iNdEx:postIndex])
+			m.Disputer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reporter", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2639,63 +6805,13 @@ func (m *VolumeReportRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TxHash = string(dAtA[iNdEx:postIndex])
+			m.Reporter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPot(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPot
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TotalReward) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPot
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TotalReward: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TotalReward: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MiningReward", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPot
@@ -2705,29 +6821,27 @@ func (m *TotalReward) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPot
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPot
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MiningReward = append(m.MiningReward, types.Coin{})
-			if err := m.MiningReward[len(m.MiningReward)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TrafficReward", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WalletVolumes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2754,11 +6868,49 @@ func (m *TotalReward) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TrafficReward = append(m.TrafficReward, types.Coin{})
-			if err := m.TrafficReward[len(m.TrafficReward)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.WalletVolumes = append(m.WalletVolumes, SingleWalletVolume{})
+			if err := m.WalletVolumes[len(m.WalletVolumes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPot(dAtA[iNdEx:])
@@ -2780,7 +6932,7 @@ func (m *TotalReward) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Metrics) Unmarshal(dAtA []byte) error {
+func (m *ReportEquivocation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2803,15 +6955,15 @@ func (m *Metrics) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Metrics: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReportEquivocation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Metrics: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReportEquivocation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalSupply", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2839,13 +6991,13 @@ func (m *Metrics) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Epoch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalMiningSupply", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Accused", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2873,13 +7025,145 @@ func (m *Metrics) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalMiningSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Accused = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reporter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPot
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reporter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slashed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPot
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Slashed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPot(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPot
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RewardHistory) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPot
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RewardHistory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RewardHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalMinedTokens", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WalletAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2907,13 +7191,11 @@ func (m *Metrics) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalMinedTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.WalletAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalResourceNodesDeposit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2941,15 +7223,15 @@ func (m *Metrics) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalResourceNodesDeposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Epoch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalBondedDelegation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardFromMiningPool", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPot
@@ -2959,31 +7241,31 @@ func (m *Metrics) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPot
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPot
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalBondedDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RewardFromMiningPool = append(m.RewardFromMiningPool, types.Coin{})
+			if err := m.RewardFromMiningPool[len(m.RewardFromMiningPool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalUnbondedDelegation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardFromTrafficPool", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPot
@@ -2993,31 +7275,31 @@ func (m *Metrics) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPot
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPot
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalUnbondedDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RewardFromTrafficPool = append(m.RewardFromTrafficPool, types.Coin{})
+			if err := m.RewardFromTrafficPool[len(m.RewardFromTrafficPool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalUnbondingDelegation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashingDeducted", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPot
@@ -3027,29 +7309,79 @@ func (m *Metrics) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPot
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPot
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalUnbondingDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SlashingDeducted = append(m.SlashingDeducted, types.Coin{})
+			if err := m.SlashingDeducted[len(m.SlashingDeducted)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPot(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPot
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AutoRestake) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPot
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AutoRestake: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AutoRestake: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CirculationSupply", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WalletAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3077,13 +7409,11 @@ func (m *Metrics) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CirculationSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.WalletAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalMiningReward", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NetworkAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3111,13 +7441,61 @@ func (m *Metrics) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalMiningReward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.NetworkAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPot(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 10:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPot
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VestingSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPot
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VestingSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VestingSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainMiningReward", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WalletAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3145,15 +7523,13 @@ func (m *Metrics) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ChainMiningReward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.WalletAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceMiningReward", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalFromMiningPool", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPot
@@ -3163,31 +7539,31 @@ func (m *Metrics) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPot
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPot
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ResourceMiningReward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TotalFromMiningPool = append(m.TotalFromMiningPool, types.Coin{})
+			if err := m.TotalFromMiningPool[len(m.TotalFromMiningPool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 12:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetaMiningReward", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalFromTrafficPool", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPot
@@ -3197,31 +7573,31 @@ func (m *Metrics) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPot
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPot
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MetaMiningReward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TotalFromTrafficPool = append(m.TotalFromTrafficPool, types.Coin{})
+			if err := m.TotalFromTrafficPool[len(m.TotalFromTrafficPool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 13:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceNodeDeposit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleasedFromMiningPool", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPot
@@ -3231,31 +7607,31 @@ func (m *Metrics) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPot
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPot
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ResourceNodeDeposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ReleasedFromMiningPool = append(m.ReleasedFromMiningPool, types.Coin{})
+			if err := m.ReleasedFromMiningPool[len(m.ReleasedFromMiningPool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 14:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetaNodeDeposit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleasedFromTrafficPool", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPot
@@ -3265,31 +7641,31 @@ func (m *Metrics) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPot
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPot
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MetaNodeDeposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ReleasedFromTrafficPool = append(m.ReleasedFromTrafficPool, types.Coin{})
+			if err := m.ReleasedFromTrafficPool[len(m.ReleasedFromTrafficPool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 15:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnissuedPrepay", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartEpoch", wireType)
 			}
-			var stringLen uint64
+			m.StartEpoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPot
@@ -3299,26 +7675,30 @@ func (m *Metrics) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.StartEpoch |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPot
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPot
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingEpochs", wireType)
 			}
-			if err := m.UnissuedPrepay.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.VestingEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VestingEpochs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPot(dAtA[iNdEx:])
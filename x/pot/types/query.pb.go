@@ -1069,1202 +1069,1517 @@ func (m *QueryMetricsResponse) GetMetrics() Metrics {
 	return Metrics{}
 }
 
-func init() {
-	proto.RegisterType((*QueryVolumeReportRequest)(nil), "stratos.pot.v1.QueryVolumeReportRequest")
-	proto.RegisterType((*QueryVolumeReportResponse)(nil), "stratos.pot.v1.QueryVolumeReportResponse")
-	proto.RegisterType((*ReportInfo)(nil), "stratos.pot.v1.ReportInfo")
-	proto.RegisterType((*QueryParamsRequest)(nil), "stratos.pot.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "stratos.pot.v1.QueryParamsResponse")
-	proto.RegisterType((*QueryRewardsByEpochRequest)(nil), "stratos.pot.v1.QueryRewardsByEpochRequest")
-	proto.RegisterType((*QueryRewardsByEpochResponse)(nil), "stratos.pot.v1.QueryRewardsByEpochResponse")
-	proto.RegisterType((*QueryRewardsByWalletRequest)(nil), "stratos.pot.v1.QueryRewardsByWalletRequest")
-	proto.RegisterType((*QueryRewardsByWalletResponse)(nil), "stratos.pot.v1.QueryRewardsByWalletResponse")
-	proto.RegisterType((*QueryRewardsByWalletAndEpochRequest)(nil), "stratos.pot.v1.QueryRewardsByWalletAndEpochRequest")
-	proto.RegisterType((*QueryRewardsByWalletAndEpochResponse)(nil), "stratos.pot.v1.QueryRewardsByWalletAndEpochResponse")
-	proto.RegisterType((*RewardByWallet)(nil), "stratos.pot.v1.RewardByWallet")
-	proto.RegisterType((*QuerySlashingByOwnerRequest)(nil), "stratos.pot.v1.QuerySlashingByOwnerRequest")
-	proto.RegisterType((*QuerySlashingByOwnerResponse)(nil), "stratos.pot.v1.QuerySlashingByOwnerResponse")
-	proto.RegisterType((*QueryTotalMinedTokenRequest)(nil), "stratos.pot.v1.QueryTotalMinedTokenRequest")
-	proto.RegisterType((*QueryTotalMinedTokenResponse)(nil), "stratos.pot.v1.QueryTotalMinedTokenResponse")
-	proto.RegisterType((*QueryCirculationSupplyRequest)(nil), "stratos.pot.v1.QueryCirculationSupplyRequest")
-	proto.RegisterType((*QueryCirculationSupplyResponse)(nil), "stratos.pot.v1.QueryCirculationSupplyResponse")
-	proto.RegisterType((*QueryTotalRewardByEpochRequest)(nil), "stratos.pot.v1.QueryTotalRewardByEpochRequest")
-	proto.RegisterType((*QueryTotalRewardByEpochResponse)(nil), "stratos.pot.v1.QueryTotalRewardByEpochResponse")
-	proto.RegisterType((*QueryMetricsRequest)(nil), "stratos.pot.v1.QueryMetricsRequest")
-	proto.RegisterType((*QueryMetricsResponse)(nil), "stratos.pot.v1.QueryMetricsResponse")
+// QueryPendingVolumeReportRequest is request type for the Query/PendingVolumeReport RPC method
+type QueryPendingVolumeReportRequest struct {
 }
 
-func init() { proto.RegisterFile("stratos/pot/v1/query.proto", fileDescriptor_c09bd09df76a68e0) }
-
-var fileDescriptor_c09bd09df76a68e0 = []byte{
-	// 1246 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x97, 0xcd, 0x6f, 0x1b, 0x45,
-	0x14, 0xc0, 0xb3, 0x49, 0x9b, 0x8f, 0x97, 0x92, 0x90, 0x49, 0xda, 0x38, 0xdb, 0xd4, 0x4e, 0x27,
-	0x69, 0x9b, 0x8f, 0x66, 0x37, 0x4e, 0x81, 0xa2, 0x22, 0x40, 0x75, 0x09, 0x50, 0x89, 0xaa, 0x65,
-	0x5b, 0x41, 0xc5, 0xc5, 0xda, 0xd8, 0x13, 0x7b, 0x15, 0x7b, 0x67, 0xb3, 0x33, 0x4e, 0xe2, 0x86,
-	0x0a, 0x89, 0xbf, 0x00, 0xc1, 0x81, 0x0a, 0x21, 0x10, 0x42, 0x5c, 0x7a, 0xe7, 0x7f, 0xa8, 0x84,
-	0x84, 0x2a, 0x71, 0xe1, 0x64, 0x50, 0xc2, 0xa9, 0xc7, 0xfc, 0x05, 0xc8, 0x33, 0xe3, 0x8f, 0x5d,
-	0xef, 0x26, 0x0e, 0x02, 0x71, 0xca, 0x7a, 0xde, 0xd7, 0xef, 0xbd, 0x37, 0x33, 0x6f, 0x02, 0x3a,
-	0xe3, 0xbe, 0xcd, 0x29, 0x33, 0x3d, 0xca, 0xcd, 0xed, 0xb4, 0xb9, 0x55, 0x21, 0x7e, 0xd5, 0xf0,
-	0x7c, 0xca, 0x29, 0x1a, 0x51, 0x32, 0xc3, 0xa3, 0xdc, 0xd8, 0x4e, 0xeb, 0x13, 0x05, 0x5a, 0xa0,
-	0x42, 0x64, 0xd6, 0xbf, 0xa4, 0x96, 0x3e, 0x5d, 0xa0, 0xb4, 0x50, 0x22, 0xa6, 0xed, 0x39, 0xa6,
-	0xed, 0xba, 0x94, 0xdb, 0xdc, 0xa1, 0x2e, 0x53, 0xd2, 0x64, 0x8e, 0xb2, 0x32, 0x65, 0xe6, 0xba,
-	0xcd, 0x88, 0xb9, 0x9d, 0x5e, 0x27, 0xdc, 0x4e, 0x9b, 0x39, 0xea, 0xb8, 0x4a, 0xbe, 0xd8, 0x2e,
-	0x17, 0xc1, 0x9b, 0x5a, 0x9e, 0x5d, 0x70, 0x5c, 0xe1, 0x4c, 0xe9, 0x26, 0x42, 0xac, 0x75, 0x2c,
-	0x21, 0xc1, 0x2b, 0x90, 0xf8, 0xb0, 0x6e, 0xfb, 0x11, 0x2d, 0x55, 0xca, 0xc4, 0x22, 0x1e, 0xf5,
-	0xb9, 0x45, 0xb6, 0x2a, 0x84, 0x71, 0x34, 0x01, 0xa7, 0x89, 0x47, 0x73, 0xc5, 0x84, 0x36, 0xa3,
-	0xcd, 0xf7, 0x59, 0xf2, 0x07, 0x7e, 0x08, 0x53, 0x11, 0x16, 0xcc, 0xa3, 0x2e, 0x23, 0xe8, 0x0d,
-	0x18, 0xf6, 0xc5, 0x4a, 0xd6, 0x71, 0x37, 0xa8, 0x30, 0x1c, 0x5e, 0xd5, 0x8d, 0x60, 0x39, 0x0c,
-	0x69, 0x74, 0xdb, 0xdd, 0xa0, 0x16, 0xf8, 0xcd, 0x6f, 0x5c, 0x01, 0x68, 0x49, 0xa2, 0xa3, 0xa3,
-	0x69, 0x18, 0xf2, 0xc9, 0x06, 0xf1, 0x89, 0x9b, 0x23, 0x89, 0xde, 0x19, 0x6d, 0x7e, 0xc8, 0x6a,
-	0x2d, 0xa0, 0x49, 0x18, 0xe0, 0xbb, 0xd9, 0xa2, 0xcd, 0x8a, 0x89, 0x3e, 0x21, 0xeb, 0xe7, 0xbb,
-	0xef, 0xdb, 0xac, 0x88, 0x74, 0x18, 0x94, 0x81, 0x88, 0x9f, 0x38, 0x25, 0x24, 0xcd, 0xdf, 0x78,
-	0x02, 0x90, 0x48, 0xe8, 0x9e, 0xed, 0xdb, 0x65, 0xa6, 0x92, 0xc7, 0x6b, 0x30, 0x1e, 0x58, 0x55,
-	0x09, 0x1a, 0xd0, 0xef, 0x89, 0x15, 0x95, 0xdb, 0xb9, 0x70, 0x6e, 0x4a, 0x5f, 0x69, 0xe1, 0x47,
-	0xa0, 0x0b, 0x37, 0x16, 0xd9, 0xb1, 0xfd, 0x3c, 0xcb, 0x54, 0xd7, 0xea, 0x69, 0x1c, 0x59, 0x61,
-	0xf4, 0x2e, 0x40, 0xab, 0x83, 0x22, 0xc9, 0xe1, 0xd5, 0xcb, 0x86, 0x6c, 0xb7, 0x51, 0x6f, 0xb7,
-	0x21, 0xf7, 0x9a, 0x6a, 0xb7, 0x71, 0xcf, 0x2e, 0x10, 0xe5, 0xd1, 0x6a, 0xb3, 0xc4, 0x4f, 0x34,
-	0x38, 0x1f, 0x19, 0x5c, 0xe5, 0xb2, 0x02, 0x03, 0xbe, 0x94, 0x24, 0xb4, 0x99, 0xbe, 0xa8, 0x64,
-	0xa4, 0xa1, 0xd5, 0x50, 0x43, 0xef, 0x45, 0x90, 0x5d, 0x39, 0x96, 0x4c, 0x86, 0x0b, 0xa0, 0xbd,
-	0x13, 0x26, 0xfb, 0xd8, 0x2e, 0x95, 0x48, 0x73, 0xe7, 0x5d, 0x82, 0x91, 0x1d, 0xb1, 0x90, 0xb5,
-	0xf3, 0x79, 0x9f, 0x30, 0x59, 0xed, 0x21, 0xeb, 0x25, 0xb9, 0x7a, 0x53, 0x2e, 0xe2, 0x87, 0x30,
-	0x1d, 0xed, 0x45, 0x25, 0xf8, 0x7a, 0x7b, 0x82, 0x75, 0xd6, 0x64, 0x74, 0x82, 0x4d, 0xc3, 0x86,
-	0x3a, 0x7e, 0xaa, 0xc1, 0x6c, 0x94, 0xeb, 0x9b, 0x6e, 0x3e, 0xd0, 0xc0, 0xee, 0x40, 0x5b, 0x7d,
-	0xee, 0x8d, 0xef, 0x73, 0xdf, 0x3f, 0xee, 0xf3, 0x0f, 0x1a, 0xcc, 0x1d, 0x0d, 0xfb, 0xff, 0x37,
-	0xfc, 0xe7, 0x5e, 0x18, 0x09, 0x16, 0xbb, 0xdb, 0xda, 0xed, 0xc1, 0x78, 0xd9, 0xe6, 0x15, 0x9f,
-	0x64, 0x39, 0xe5, 0x76, 0x29, 0x2b, 0xd1, 0x12, 0xbd, 0x22, 0x81, 0xa9, 0x00, 0x4b, 0x83, 0xe2,
-	0x16, 0x75, 0xdc, 0xcc, 0xca, 0xb3, 0x5a, 0xaa, 0xe7, 0xe9, 0x1f, 0xa9, 0xf9, 0x82, 0xc3, 0x8b,
-	0x95, 0x75, 0x23, 0x47, 0xcb, 0xa6, 0xba, 0x32, 0xe5, 0x9f, 0x65, 0x96, 0xdf, 0x34, 0x79, 0xd5,
-	0x23, 0x4c, 0x18, 0x30, 0x6b, 0x4c, 0xc6, 0x79, 0x50, 0x0f, 0x23, 0x59, 0xd1, 0x67, 0x70, 0xd6,
-	0x29, 0x47, 0x85, 0xef, 0xfb, 0xf7, 0xc3, 0x8f, 0x37, 0x22, 0xb5, 0x01, 0x34, 0x0f, 0xca, 0xfd,
-	0x92, 0xcd, 0x8a, 0x8e, 0x5b, 0xc8, 0x54, 0xef, 0xee, 0xb8, 0xc4, 0x3f, 0xe1, 0x41, 0xb9, 0xa1,
-	0x0e, 0x4a, 0x87, 0x17, 0xb5, 0x31, 0x74, 0x18, 0x64, 0x4a, 0xa4, 0x1c, 0x34, 0x7f, 0xe3, 0x0b,
-	0x8a, 0x40, 0x50, 0xdd, 0x71, 0x5c, 0x92, 0x7f, 0x40, 0x37, 0x89, 0xdb, 0xb8, 0x27, 0xbf, 0xd5,
-	0x94, 0xef, 0x0e, 0xb9, 0xf2, 0xfd, 0x29, 0x8c, 0xc9, 0xca, 0x95, 0xeb, 0xb2, 0x2c, 0xaf, 0x0b,
-	0xd5, 0x71, 0x3c, 0xa2, 0x7c, 0xaf, 0xd6, 0xcb, 0xf7, 0xa2, 0x96, 0xea, 0xb4, 0x3d, 0xac, 0xa5,
-	0x12, 0x55, 0xbb, 0x5c, 0xba, 0x81, 0x3b, 0x44, 0xd8, 0x1a, 0xe5, 0x41, 0x0a, 0x9c, 0x82, 0x0b,
-	0x82, 0xee, 0x96, 0xe3, 0xe7, 0x2a, 0x25, 0xb1, 0x17, 0xef, 0x57, 0x3c, 0xaf, 0x54, 0x6d, 0xe3,
-	0x4f, 0xc6, 0x69, 0xa8, 0x0c, 0x1e, 0x01, 0xca, 0xb5, 0x84, 0x59, 0x26, 0xa4, 0xff, 0xc5, 0x0e,
-	0x18, 0xcb, 0x85, 0x19, 0xf0, 0x6b, 0x8a, 0xae, 0x6d, 0x4f, 0x74, 0x33, 0x43, 0xf0, 0xaf, 0x1a,
-	0xa4, 0x62, 0x0d, 0x55, 0x5e, 0x9b, 0x70, 0x26, 0xb0, 0xa7, 0x65, 0x53, 0xce, 0x87, 0xef, 0x84,
-	0x76, 0x0f, 0x4b, 0xaa, 0x2d, 0x01, 0xc3, 0xc3, 0x5a, 0x6a, 0xbc, 0xbd, 0x23, 0x72, 0x15, 0x5b,
-	0xc3, 0xbc, 0xed, 0x24, 0xbd, 0x05, 0x43, 0x0e, 0xcb, 0x96, 0x48, 0xc1, 0xce, 0x55, 0xc5, 0x45,
-	0x32, 0x98, 0xb9, 0xf8, 0xa2, 0x96, 0x6a, 0x2d, 0x1e, 0xd6, 0x52, 0x2f, 0x4b, 0x2f, 0xcd, 0x25,
-	0x6c, 0x0d, 0x3a, 0xec, 0x03, 0xf9, 0x79, 0x56, 0xcd, 0xe3, 0x3b, 0x84, 0xfb, 0x4e, 0xae, 0x39,
-	0xa6, 0xef, 0xc2, 0x44, 0x70, 0x59, 0xe5, 0x76, 0x1d, 0x06, 0xca, 0x72, 0x49, 0xa5, 0x35, 0x19,
-	0x4e, 0x4b, 0x59, 0x64, 0x4e, 0xd5, 0x53, 0xb2, 0x1a, 0xda, 0xab, 0xdf, 0x9c, 0x81, 0xd3, 0xc2,
-	0x23, 0xfa, 0x52, 0x83, 0x33, 0xed, 0x8f, 0x1c, 0x34, 0x1f, 0x76, 0x11, 0xf7, 0x72, 0xd2, 0x17,
-	0xba, 0xd0, 0x94, 0xa0, 0x78, 0xf9, 0xf3, 0xdf, 0xfe, 0xfa, 0xaa, 0xf7, 0x0a, 0xba, 0x64, 0x86,
-	0xde, 0x68, 0xdb, 0x42, 0x3b, 0x2b, 0x9f, 0x29, 0xe6, 0x9e, 0x68, 0xeb, 0x63, 0xf4, 0x44, 0x6b,
-	0xdc, 0xa3, 0x8d, 0x71, 0x8e, 0x16, 0x23, 0x83, 0x45, 0x3e, 0x38, 0xf4, 0xa5, 0xae, 0x74, 0x8f,
-	0x43, 0x53, 0xd3, 0xc1, 0x14, 0x4c, 0x4d, 0xb4, 0x9f, 0x34, 0x18, 0x0d, 0x4d, 0x20, 0x74, 0x4c,
-	0xbc, 0xc0, 0xd4, 0xd7, 0xaf, 0x76, 0xa7, 0xac, 0xe8, 0xae, 0x0b, 0xba, 0x34, 0x32, 0xe3, 0xe8,
-	0xe4, 0x15, 0x68, 0xee, 0x05, 0x2f, 0xc8, 0xc7, 0xe8, 0x17, 0x0d, 0x26, 0x63, 0x26, 0x25, 0xba,
-	0xd6, 0x0d, 0x42, 0xe8, 0x11, 0xa0, 0xbf, 0x72, 0x32, 0x23, 0xc5, 0xbf, 0x26, 0xf8, 0xdf, 0x46,
-	0x6f, 0x9e, 0x90, 0x3f, 0x54, 0xf5, 0xef, 0x35, 0x18, 0x0d, 0x5d, 0xeb, 0x31, 0x55, 0x8f, 0x1e,
-	0x21, 0x31, 0x55, 0x8f, 0x99, 0x14, 0x38, 0x2d, 0xa8, 0x97, 0xd0, 0x42, 0x98, 0xba, 0x31, 0x2f,
-	0x3a, 0xeb, 0xbd, 0x05, 0xfd, 0xf2, 0x51, 0x8c, 0x70, 0x64, 0xa8, 0xc0, 0xbb, 0x5b, 0x9f, 0x3d,
-	0x52, 0x47, 0x51, 0x24, 0x05, 0x45, 0x02, 0x9d, 0x0b, 0x53, 0xc8, 0x57, 0x37, 0xfa, 0x5a, 0x83,
-	0xd1, 0xd0, 0x3c, 0x8a, 0x29, 0x4a, 0xf4, 0x54, 0x8b, 0x29, 0x4a, 0xcc, 0x88, 0xc3, 0x0b, 0x02,
-	0x67, 0x16, 0x5d, 0x0c, 0xe3, 0x74, 0x4c, 0x28, 0xf4, 0x9d, 0x06, 0x63, 0x1d, 0x93, 0x06, 0x2d,
-	0x47, 0x86, 0x8b, 0x9b, 0x59, 0xba, 0xd1, 0xad, 0xba, 0xe2, 0x5b, 0x14, 0x7c, 0x73, 0x08, 0x87,
-	0xf9, 0x3a, 0xc7, 0x1a, 0xfa, 0x51, 0x03, 0xd4, 0x39, 0x33, 0x90, 0x11, 0x5f, 0x90, 0xa8, 0xa9,
-	0xa4, 0x9b, 0x5d, 0xeb, 0x2b, 0xc6, 0xab, 0x82, 0xf1, 0x32, 0x9a, 0x8b, 0xae, 0xa1, 0x3c, 0x14,
-	0xcd, 0x5d, 0x5f, 0x81, 0x01, 0x75, 0x7f, 0xa3, 0xe8, 0x0d, 0x13, 0x1c, 0x13, 0xfa, 0xdc, 0xd1,
-	0x4a, 0x8a, 0x21, 0x25, 0x18, 0xa6, 0xd0, 0x64, 0x98, 0x41, 0x0d, 0x87, 0xcc, 0xed, 0x67, 0xfb,
-	0x49, 0xed, 0xf9, 0x7e, 0x52, 0xfb, 0x73, 0x3f, 0xa9, 0x7d, 0x71, 0x90, 0xec, 0x79, 0x7e, 0x90,
-	0xec, 0xf9, 0xfd, 0x20, 0xd9, 0xf3, 0x89, 0xd9, 0x36, 0xe4, 0x95, 0xb1, 0x4b, 0x78, 0xe3, 0x73,
-	0x39, 0x57, 0xb4, 0x1d, 0xd7, 0xdc, 0x15, 0xfe, 0xc4, 0xc4, 0x5f, 0xef, 0x17, 0xff, 0x7f, 0x5f,
-	0xfb, 0x3b, 0x00, 0x00, 0xff, 0xff, 0xaa, 0xc2, 0x09, 0xa3, 0x47, 0x10, 0x00, 0x00,
+func (m *QueryPendingVolumeReportRequest) Reset()         { *m = QueryPendingVolumeReportRequest{} }
+func (m *QueryPendingVolumeReportRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingVolumeReportRequest) ProtoMessage()    {}
+func (*QueryPendingVolumeReportRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c09bd09df76a68e0, []int{22}
+}
+func (m *QueryPendingVolumeReportRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingVolumeReportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingVolumeReportRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingVolumeReportRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingVolumeReportRequest.Merge(m, src)
+}
+func (m *QueryPendingVolumeReportRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingVolumeReportRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingVolumeReportRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryPendingVolumeReportRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// VolumeReport queries VolumeReport info for given epoch.
-	VolumeReport(ctx context.Context, in *QueryVolumeReportRequest, opts ...grpc.CallOption) (*QueryVolumeReportResponse, error)
-	// RewardsByEpoch queries Pot rewards by a given epoch.
-	RewardsByEpoch(ctx context.Context, in *QueryRewardsByEpochRequest, opts ...grpc.CallOption) (*QueryRewardsByEpochResponse, error)
-	// RewardsByOwner queries Pot rewards by a given beneficiary address.
-	RewardsByWallet(ctx context.Context, in *QueryRewardsByWalletRequest, opts ...grpc.CallOption) (*QueryRewardsByWalletResponse, error)
-	// RewardsByWalletAndEpoch queries Pot rewards by a given beneficiary address at the specific epoch.
-	RewardsByWalletAndEpoch(ctx context.Context, in *QueryRewardsByWalletAndEpochRequest, opts ...grpc.CallOption) (*QueryRewardsByWalletAndEpochResponse, error)
-	// SlashingByOwner queries Pot slashing by owner wallet address.
-	SlashingByOwner(ctx context.Context, in *QuerySlashingByOwnerRequest, opts ...grpc.CallOption) (*QuerySlashingByOwnerResponse, error)
-	// Params queries POT module Params info.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	TotalMinedToken(ctx context.Context, in *QueryTotalMinedTokenRequest, opts ...grpc.CallOption) (*QueryTotalMinedTokenResponse, error)
-	CirculationSupply(ctx context.Context, in *QueryCirculationSupplyRequest, opts ...grpc.CallOption) (*QueryCirculationSupplyResponse, error)
-	TotalRewardByEpoch(ctx context.Context, in *QueryTotalRewardByEpochRequest, opts ...grpc.CallOption) (*QueryTotalRewardByEpochResponse, error)
-	Metrics(ctx context.Context, in *QueryMetricsRequest, opts ...grpc.CallOption) (*QueryMetricsResponse, error)
+// QueryPendingVolumeReportResponse is response type for the Query/PendingVolumeReport RPC method
+type QueryPendingVolumeReportResponse struct {
+	Report *PendingVolumeReport `protobuf:"bytes,1,opt,name=report,proto3" json:"report,omitempty"`
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryPendingVolumeReportResponse) Reset()         { *m = QueryPendingVolumeReportResponse{} }
+func (m *QueryPendingVolumeReportResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingVolumeReportResponse) ProtoMessage()    {}
+func (*QueryPendingVolumeReportResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c09bd09df76a68e0, []int{23}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryPendingVolumeReportResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) VolumeReport(ctx context.Context, in *QueryVolumeReportRequest, opts ...grpc.CallOption) (*QueryVolumeReportResponse, error) {
-	out := new(QueryVolumeReportResponse)
-	err := c.cc.Invoke(ctx, "/stratos.pot.v1.Query/VolumeReport", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryPendingVolumeReportResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingVolumeReportResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *queryClient) RewardsByEpoch(ctx context.Context, in *QueryRewardsByEpochRequest, opts ...grpc.CallOption) (*QueryRewardsByEpochResponse, error) {
-	out := new(QueryRewardsByEpochResponse)
-	err := c.cc.Invoke(ctx, "/stratos.pot.v1.Query/RewardsByEpoch", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryPendingVolumeReportResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingVolumeReportResponse.Merge(m, src)
 }
-
-func (c *queryClient) RewardsByWallet(ctx context.Context, in *QueryRewardsByWalletRequest, opts ...grpc.CallOption) (*QueryRewardsByWalletResponse, error) {
-	out := new(QueryRewardsByWalletResponse)
-	err := c.cc.Invoke(ctx, "/stratos.pot.v1.Query/RewardsByWallet", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryPendingVolumeReportResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingVolumeReportResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingVolumeReportResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) RewardsByWalletAndEpoch(ctx context.Context, in *QueryRewardsByWalletAndEpochRequest, opts ...grpc.CallOption) (*QueryRewardsByWalletAndEpochResponse, error) {
-	out := new(QueryRewardsByWalletAndEpochResponse)
-	err := c.cc.Invoke(ctx, "/stratos.pot.v1.Query/RewardsByWalletAndEpoch", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryPendingVolumeReportResponse proto.InternalMessageInfo
+
+func (m *QueryPendingVolumeReportResponse) GetReport() *PendingVolumeReport {
+	if m != nil {
+		return m.Report
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) SlashingByOwner(ctx context.Context, in *QuerySlashingByOwnerRequest, opts ...grpc.CallOption) (*QuerySlashingByOwnerResponse, error) {
-	out := new(QuerySlashingByOwnerResponse)
-	err := c.cc.Invoke(ctx, "/stratos.pot.v1.Query/SlashingByOwner", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryVolumeReportDisputeRequest is request type for the Query/VolumeReportDispute RPC method
+type QueryVolumeReportDisputeRequest struct {
+	Epoch int64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/stratos.pot.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryVolumeReportDisputeRequest) Reset()         { *m = QueryVolumeReportDisputeRequest{} }
+func (m *QueryVolumeReportDisputeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVolumeReportDisputeRequest) ProtoMessage()    {}
+func (*QueryVolumeReportDisputeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c09bd09df76a68e0, []int{24}
+}
+func (m *QueryVolumeReportDisputeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVolumeReportDisputeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVolumeReportDisputeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryVolumeReportDisputeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVolumeReportDisputeRequest.Merge(m, src)
+}
+func (m *QueryVolumeReportDisputeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVolumeReportDisputeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVolumeReportDisputeRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) TotalMinedToken(ctx context.Context, in *QueryTotalMinedTokenRequest, opts ...grpc.CallOption) (*QueryTotalMinedTokenResponse, error) {
-	out := new(QueryTotalMinedTokenResponse)
-	err := c.cc.Invoke(ctx, "/stratos.pot.v1.Query/TotalMinedToken", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryVolumeReportDisputeRequest proto.InternalMessageInfo
+
+func (m *QueryVolumeReportDisputeRequest) GetEpoch() int64 {
+	if m != nil {
+		return m.Epoch
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) CirculationSupply(ctx context.Context, in *QueryCirculationSupplyRequest, opts ...grpc.CallOption) (*QueryCirculationSupplyResponse, error) {
-	out := new(QueryCirculationSupplyResponse)
-	err := c.cc.Invoke(ctx, "/stratos.pot.v1.Query/CirculationSupply", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryVolumeReportDisputeResponse is response type for the Query/VolumeReportDispute RPC method
+type QueryVolumeReportDisputeResponse struct {
+	Dispute *VolumeReportDispute `protobuf:"bytes,1,opt,name=dispute,proto3" json:"dispute,omitempty"`
 }
 
-func (c *queryClient) TotalRewardByEpoch(ctx context.Context, in *QueryTotalRewardByEpochRequest, opts ...grpc.CallOption) (*QueryTotalRewardByEpochResponse, error) {
-	out := new(QueryTotalRewardByEpochResponse)
-	err := c.cc.Invoke(ctx, "/stratos.pot.v1.Query/TotalRewardByEpoch", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryVolumeReportDisputeResponse) Reset()         { *m = QueryVolumeReportDisputeResponse{} }
+func (m *QueryVolumeReportDisputeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVolumeReportDisputeResponse) ProtoMessage()    {}
+func (*QueryVolumeReportDisputeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c09bd09df76a68e0, []int{25}
+}
+func (m *QueryVolumeReportDisputeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVolumeReportDisputeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVolumeReportDisputeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryVolumeReportDisputeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVolumeReportDisputeResponse.Merge(m, src)
+}
+func (m *QueryVolumeReportDisputeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVolumeReportDisputeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVolumeReportDisputeResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) Metrics(ctx context.Context, in *QueryMetricsRequest, opts ...grpc.CallOption) (*QueryMetricsResponse, error) {
-	out := new(QueryMetricsResponse)
-	err := c.cc.Invoke(ctx, "/stratos.pot.v1.Query/Metrics", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryVolumeReportDisputeResponse proto.InternalMessageInfo
+
+func (m *QueryVolumeReportDisputeResponse) GetDispute() *VolumeReportDispute {
+	if m != nil {
+		return m.Dispute
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// VolumeReport queries VolumeReport info for given epoch.
-	VolumeReport(context.Context, *QueryVolumeReportRequest) (*QueryVolumeReportResponse, error)
-	// RewardsByEpoch queries Pot rewards by a given epoch.
-	RewardsByEpoch(context.Context, *QueryRewardsByEpochRequest) (*QueryRewardsByEpochResponse, error)
-	// RewardsByOwner queries Pot rewards by a given beneficiary address.
-	RewardsByWallet(context.Context, *QueryRewardsByWalletRequest) (*QueryRewardsByWalletResponse, error)
-	// RewardsByWalletAndEpoch queries Pot rewards by a given beneficiary address at the specific epoch.
-	RewardsByWalletAndEpoch(context.Context, *QueryRewardsByWalletAndEpochRequest) (*QueryRewardsByWalletAndEpochResponse, error)
-	// SlashingByOwner queries Pot slashing by owner wallet address.
-	SlashingByOwner(context.Context, *QuerySlashingByOwnerRequest) (*QuerySlashingByOwnerResponse, error)
-	// Params queries POT module Params info.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	TotalMinedToken(context.Context, *QueryTotalMinedTokenRequest) (*QueryTotalMinedTokenResponse, error)
-	CirculationSupply(context.Context, *QueryCirculationSupplyRequest) (*QueryCirculationSupplyResponse, error)
-	TotalRewardByEpoch(context.Context, *QueryTotalRewardByEpochRequest) (*QueryTotalRewardByEpochResponse, error)
-	Metrics(context.Context, *QueryMetricsRequest) (*QueryMetricsResponse, error)
+// QueryDistributionProgressRequest is request type for the Query/DistributionProgress RPC method
+type QueryDistributionProgressRequest struct {
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryDistributionProgressRequest) Reset()         { *m = QueryDistributionProgressRequest{} }
+func (m *QueryDistributionProgressRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDistributionProgressRequest) ProtoMessage()    {}
+func (*QueryDistributionProgressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c09bd09df76a68e0, []int{26}
 }
-
-func (*UnimplementedQueryServer) VolumeReport(ctx context.Context, req *QueryVolumeReportRequest) (*QueryVolumeReportResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method VolumeReport not implemented")
+func (m *QueryDistributionProgressRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) RewardsByEpoch(ctx context.Context, req *QueryRewardsByEpochRequest) (*QueryRewardsByEpochResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RewardsByEpoch not implemented")
+func (m *QueryDistributionProgressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDistributionProgressRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) RewardsByWallet(ctx context.Context, req *QueryRewardsByWalletRequest) (*QueryRewardsByWalletResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RewardsByWallet not implemented")
+func (m *QueryDistributionProgressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDistributionProgressRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) RewardsByWalletAndEpoch(ctx context.Context, req *QueryRewardsByWalletAndEpochRequest) (*QueryRewardsByWalletAndEpochResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RewardsByWalletAndEpoch not implemented")
+func (m *QueryDistributionProgressRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) SlashingByOwner(ctx context.Context, req *QuerySlashingByOwnerRequest) (*QuerySlashingByOwnerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SlashingByOwner not implemented")
+func (m *QueryDistributionProgressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDistributionProgressRequest.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+
+var xxx_messageInfo_QueryDistributionProgressRequest proto.InternalMessageInfo
+
+// QueryDistributionProgressResponse is response type for the Query/DistributionProgress RPC method
+type QueryDistributionProgressResponse struct {
+	LastDistributedEpoch github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=last_distributed_epoch,json=lastDistributedEpoch,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"last_distributed_epoch"`
+	Distributions        []PendingDistribution                  `protobuf:"bytes,2,rep,name=distributions,proto3" json:"distributions"`
 }
-func (*UnimplementedQueryServer) TotalMinedToken(ctx context.Context, req *QueryTotalMinedTokenRequest) (*QueryTotalMinedTokenResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TotalMinedToken not implemented")
+
+func (m *QueryDistributionProgressResponse) Reset()         { *m = QueryDistributionProgressResponse{} }
+func (m *QueryDistributionProgressResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDistributionProgressResponse) ProtoMessage()    {}
+func (*QueryDistributionProgressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c09bd09df76a68e0, []int{27}
 }
-func (*UnimplementedQueryServer) CirculationSupply(ctx context.Context, req *QueryCirculationSupplyRequest) (*QueryCirculationSupplyResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CirculationSupply not implemented")
+func (m *QueryDistributionProgressResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) TotalRewardByEpoch(ctx context.Context, req *QueryTotalRewardByEpochRequest) (*QueryTotalRewardByEpochResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TotalRewardByEpoch not implemented")
+func (m *QueryDistributionProgressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDistributionProgressResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) Metrics(ctx context.Context, req *QueryMetricsRequest) (*QueryMetricsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Metrics not implemented")
+func (m *QueryDistributionProgressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDistributionProgressResponse.Merge(m, src)
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryDistributionProgressResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDistributionProgressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDistributionProgressResponse.DiscardUnknown(m)
 }
 
-func _Query_VolumeReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryVolumeReportRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).VolumeReport(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/stratos.pot.v1.Query/VolumeReport",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).VolumeReport(ctx, req.(*QueryVolumeReportRequest))
+var xxx_messageInfo_QueryDistributionProgressResponse proto.InternalMessageInfo
+
+func (m *QueryDistributionProgressResponse) GetDistributions() []PendingDistribution {
+	if m != nil {
+		return m.Distributions
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_RewardsByEpoch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryRewardsByEpochRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).RewardsByEpoch(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/stratos.pot.v1.Query/RewardsByEpoch",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).RewardsByEpoch(ctx, req.(*QueryRewardsByEpochRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryVestingScheduleRequest is request type for the Query/VestingSchedule RPC method
+type QueryVestingScheduleRequest struct {
+	WalletAddress string             `protobuf:"bytes,1,opt,name=wallet_address,json=walletAddress,proto3" json:"wallet_address,omitempty"`
+	Pagination    *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_RewardsByWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryRewardsByWalletRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).RewardsByWallet(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/stratos.pot.v1.Query/RewardsByWallet",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).RewardsByWallet(ctx, req.(*QueryRewardsByWalletRequest))
+func (m *QueryVestingScheduleRequest) Reset()         { *m = QueryVestingScheduleRequest{} }
+func (m *QueryVestingScheduleRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVestingScheduleRequest) ProtoMessage()    {}
+func (*QueryVestingScheduleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c09bd09df76a68e0, []int{28}
+}
+func (m *QueryVestingScheduleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVestingScheduleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVestingScheduleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryVestingScheduleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVestingScheduleRequest.Merge(m, src)
+}
+func (m *QueryVestingScheduleRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVestingScheduleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVestingScheduleRequest.DiscardUnknown(m)
 }
 
-func _Query_RewardsByWalletAndEpoch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryRewardsByWalletAndEpochRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).RewardsByWalletAndEpoch(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/stratos.pot.v1.Query/RewardsByWalletAndEpoch",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).RewardsByWalletAndEpoch(ctx, req.(*QueryRewardsByWalletAndEpochRequest))
+var xxx_messageInfo_QueryVestingScheduleRequest proto.InternalMessageInfo
+
+func (m *QueryVestingScheduleRequest) GetWalletAddress() string {
+	if m != nil {
+		return m.WalletAddress
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_SlashingByOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QuerySlashingByOwnerRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).SlashingByOwner(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/stratos.pot.v1.Query/SlashingByOwner",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).SlashingByOwner(ctx, req.(*QuerySlashingByOwnerRequest))
+func (m *QueryVestingScheduleRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/stratos.pot.v1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+// QueryVestingScheduleResponse is response type for the Query/VestingSchedule RPC method
+type QueryVestingScheduleResponse struct {
+	WalletAddress       string                                   `protobuf:"bytes,1,opt,name=wallet_address,json=walletAddress,proto3" json:"wallet_address,omitempty"`
+	VestingMode         string                                   `protobuf:"bytes,2,opt,name=vesting_mode,json=vestingMode,proto3" json:"vesting_mode,omitempty"`
+	MaturedEpoch        int64                                    `protobuf:"varint,3,opt,name=matured_epoch,json=maturedEpoch,proto3" json:"matured_epoch,omitempty"`
+	Entries             []VestingScheduleEntry                   `protobuf:"bytes,4,rep,name=entries,proto3" json:"entries"`
+	MatureTotalReward   github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=mature_total_reward,json=matureTotalReward,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"mature_total_reward"`
+	ImmatureTotalReward github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=immature_total_reward,json=immatureTotalReward,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"immature_total_reward"`
+	Pagination          *query.PageResponse                      `protobuf:"bytes,7,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// linear_vesting is the matured reward of the wallet vesting linearly, nil if none
+	LinearVesting *VestingSchedule `protobuf:"bytes,8,opt,name=linear_vesting,json=linearVesting,proto3" json:"linear_vesting,omitempty"`
+}
+
+func (m *QueryVestingScheduleResponse) Reset()         { *m = QueryVestingScheduleResponse{} }
+func (m *QueryVestingScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVestingScheduleResponse) ProtoMessage()    {}
+func (*QueryVestingScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c09bd09df76a68e0, []int{29}
+}
+func (m *QueryVestingScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVestingScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVestingScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryVestingScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVestingScheduleResponse.Merge(m, src)
+}
+func (m *QueryVestingScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVestingScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVestingScheduleResponse.DiscardUnknown(m)
 }
 
-func _Query_TotalMinedToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryTotalMinedTokenRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).TotalMinedToken(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/stratos.pot.v1.Query/TotalMinedToken",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).TotalMinedToken(ctx, req.(*QueryTotalMinedTokenRequest))
+var xxx_messageInfo_QueryVestingScheduleResponse proto.InternalMessageInfo
+
+func (m *QueryVestingScheduleResponse) GetWalletAddress() string {
+	if m != nil {
+		return m.WalletAddress
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_CirculationSupply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryCirculationSupplyRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).CirculationSupply(ctx, in)
+func (m *QueryVestingScheduleResponse) GetVestingMode() string {
+	if m != nil {
+		return m.VestingMode
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/stratos.pot.v1.Query/CirculationSupply",
+	return ""
+}
+
+func (m *QueryVestingScheduleResponse) GetMaturedEpoch() int64 {
+	if m != nil {
+		return m.MaturedEpoch
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).CirculationSupply(ctx, req.(*QueryCirculationSupplyRequest))
+	return 0
+}
+
+func (m *QueryVestingScheduleResponse) GetEntries() []VestingScheduleEntry {
+	if m != nil {
+		return m.Entries
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_TotalRewardByEpoch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryTotalRewardByEpochRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *QueryVestingScheduleResponse) GetMatureTotalReward() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.MatureTotalReward
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).TotalRewardByEpoch(ctx, in)
+	return nil
+}
+
+func (m *QueryVestingScheduleResponse) GetImmatureTotalReward() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.ImmatureTotalReward
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/stratos.pot.v1.Query/TotalRewardByEpoch",
+	return nil
+}
+
+func (m *QueryVestingScheduleResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).TotalRewardByEpoch(ctx, req.(*QueryTotalRewardByEpochRequest))
+	return nil
+}
+
+func (m *QueryVestingScheduleResponse) GetLinearVesting() *VestingSchedule {
+	if m != nil {
+		return m.LinearVesting
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_Metrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryMetricsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+// VestingScheduleEntry is the individual reward of a wallet maturing at an epoch
+type VestingScheduleEntry struct {
+	MatureEpoch           int64                                    `protobuf:"varint,1,opt,name=mature_epoch,json=matureEpoch,proto3" json:"mature_epoch,omitempty"`
+	RewardFromMiningPool  github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=reward_from_mining_pool,json=rewardFromMiningPool,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"reward_from_mining_pool"`
+	RewardFromTrafficPool github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=reward_from_traffic_pool,json=rewardFromTrafficPool,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"reward_from_traffic_pool"`
+}
+
+func (m *VestingScheduleEntry) Reset()         { *m = VestingScheduleEntry{} }
+func (m *VestingScheduleEntry) String() string { return proto.CompactTextString(m) }
+func (*VestingScheduleEntry) ProtoMessage()    {}
+func (*VestingScheduleEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c09bd09df76a68e0, []int{30}
+}
+func (m *VestingScheduleEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VestingScheduleEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VestingScheduleEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).Metrics(ctx, in)
+}
+func (m *VestingScheduleEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VestingScheduleEntry.Merge(m, src)
+}
+func (m *VestingScheduleEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *VestingScheduleEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_VestingScheduleEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VestingScheduleEntry proto.InternalMessageInfo
+
+func (m *VestingScheduleEntry) GetMatureEpoch() int64 {
+	if m != nil {
+		return m.MatureEpoch
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/stratos.pot.v1.Query/Metrics",
+	return 0
+}
+
+func (m *VestingScheduleEntry) GetRewardFromMiningPool() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RewardFromMiningPool
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Metrics(ctx, req.(*QueryMetricsRequest))
+	return nil
+}
+
+func (m *VestingScheduleEntry) GetRewardFromTrafficPool() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RewardFromTrafficPool
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "stratos.pot.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "VolumeReport",
-			Handler:    _Query_VolumeReport_Handler,
-		},
-		{
-			MethodName: "RewardsByEpoch",
-			Handler:    _Query_RewardsByEpoch_Handler,
-		},
-		{
-			MethodName: "RewardsByWallet",
-			Handler:    _Query_RewardsByWallet_Handler,
-		},
-		{
-			MethodName: "RewardsByWalletAndEpoch",
-			Handler:    _Query_RewardsByWalletAndEpoch_Handler,
-		},
-		{
-			MethodName: "SlashingByOwner",
-			Handler:    _Query_SlashingByOwner_Handler,
-		},
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "TotalMinedToken",
-			Handler:    _Query_TotalMinedToken_Handler,
-		},
-		{
-			MethodName: "CirculationSupply",
-			Handler:    _Query_CirculationSupply_Handler,
-		},
-		{
-			MethodName: "TotalRewardByEpoch",
-			Handler:    _Query_TotalRewardByEpoch_Handler,
-		},
-		{
-			MethodName: "Metrics",
-			Handler:    _Query_Metrics_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "stratos/pot/v1/query.proto",
+// QueryRewardHistoryRequest is request type for the Query/RewardHistory RPC method
+type QueryRewardHistoryRequest struct {
+	WalletAddress string `protobuf:"bytes,1,opt,name=wallet_address,json=walletAddress,proto3" json:"wallet_address,omitempty"`
+	// start_epoch is the first epoch of the range, 0 for no lower bound
+	StartEpoch int64 `protobuf:"varint,2,opt,name=start_epoch,json=startEpoch,proto3" json:"start_epoch,omitempty"`
+	// end_epoch is the last epoch of the range, 0 for no upper bound
+	EndEpoch   int64              `protobuf:"varint,3,opt,name=end_epoch,json=endEpoch,proto3" json:"end_epoch,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryVolumeReportRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryRewardHistoryRequest) Reset()         { *m = QueryRewardHistoryRequest{} }
+func (m *QueryRewardHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRewardHistoryRequest) ProtoMessage()    {}
+func (*QueryRewardHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c09bd09df76a68e0, []int{31}
 }
-
-func (m *QueryVolumeReportRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryRewardHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *QueryVolumeReportRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Epoch != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Epoch))
-		i--
-		dAtA[i] = 0x8
+func (m *QueryRewardHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRewardHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryRewardHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRewardHistoryRequest.Merge(m, src)
+}
+func (m *QueryRewardHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRewardHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRewardHistoryRequest.DiscardUnknown(m)
 }
 
-func (m *QueryVolumeReportResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryRewardHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryRewardHistoryRequest) GetWalletAddress() string {
+	if m != nil {
+		return m.WalletAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryVolumeReportResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryRewardHistoryRequest) GetStartEpoch() int64 {
+	if m != nil {
+		return m.StartEpoch
+	}
+	return 0
 }
 
-func (m *QueryVolumeReportResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ReportInfo != nil {
-		{
-			size, err := m.ReportInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (m *QueryRewardHistoryRequest) GetEndEpoch() int64 {
+	if m != nil {
+		return m.EndEpoch
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *ReportInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryRewardHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ReportInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryRewardHistoryResponse is response type for the Query/RewardHistory RPC method
+type QueryRewardHistoryResponse struct {
+	Histories  []RewardHistory     `protobuf:"bytes,1,rep,name=histories,proto3" json:"histories"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *ReportInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Reporter) > 0 {
-		i -= len(m.Reporter)
-		copy(dAtA[i:], m.Reporter)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Reporter)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.TxHash) > 0 {
-		i -= len(m.TxHash)
-		copy(dAtA[i:], m.TxHash)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.TxHash)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Reference) > 0 {
-		i -= len(m.Reference)
-		copy(dAtA[i:], m.Reference)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Reference)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Epoch != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Epoch))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (m *QueryRewardHistoryResponse) Reset()         { *m = QueryRewardHistoryResponse{} }
+func (m *QueryRewardHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRewardHistoryResponse) ProtoMessage()    {}
+func (*QueryRewardHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c09bd09df76a68e0, []int{32}
 }
-
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryRewardHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRewardHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRewardHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryRewardHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRewardHistoryResponse.Merge(m, src)
+}
+func (m *QueryRewardHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRewardHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRewardHistoryResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_QueryRewardHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryRewardHistoryResponse) GetHistories() []RewardHistory {
+	if m != nil {
+		return m.Histories
+	}
+	return nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryRewardHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryAutoRestakeRequest is request type for the Query/AutoRestake RPC method
+type QueryAutoRestakeRequest struct {
+	WalletAddress string `protobuf:"bytes,1,opt,name=wallet_address,json=walletAddress,proto3" json:"wallet_address,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Params != nil {
-		{
-			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryAutoRestakeRequest) Reset()         { *m = QueryAutoRestakeRequest{} }
+func (m *QueryAutoRestakeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAutoRestakeRequest) ProtoMessage()    {}
+func (*QueryAutoRestakeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c09bd09df76a68e0, []int{33}
+}
+func (m *QueryAutoRestakeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAutoRestakeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAutoRestakeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryAutoRestakeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAutoRestakeRequest.Merge(m, src)
+}
+func (m *QueryAutoRestakeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAutoRestakeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAutoRestakeRequest.DiscardUnknown(m)
 }
 
-func (m *QueryRewardsByEpochRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAutoRestakeRequest proto.InternalMessageInfo
+
+func (m *QueryAutoRestakeRequest) GetWalletAddress() string {
+	if m != nil {
+		return m.WalletAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryRewardsByEpochRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryAutoRestakeResponse is response type for the Query/AutoRestake RPC method
+type QueryAutoRestakeResponse struct {
+	AutoRestake *AutoRestake `protobuf:"bytes,1,opt,name=auto_restake,json=autoRestake,proto3" json:"auto_restake,omitempty"`
 }
 
-func (m *QueryRewardsByEpochRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryAutoRestakeResponse) Reset()         { *m = QueryAutoRestakeResponse{} }
+func (m *QueryAutoRestakeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAutoRestakeResponse) ProtoMessage()    {}
+func (*QueryAutoRestakeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c09bd09df76a68e0, []int{34}
+}
+func (m *QueryAutoRestakeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAutoRestakeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAutoRestakeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if m.Epoch != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Epoch))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *QueryAutoRestakeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAutoRestakeResponse.Merge(m, src)
+}
+func (m *QueryAutoRestakeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAutoRestakeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAutoRestakeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAutoRestakeResponse proto.InternalMessageInfo
+
+func (m *QueryAutoRestakeResponse) GetAutoRestake() *AutoRestake {
+	if m != nil {
+		return m.AutoRestake
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryRewardsByEpochResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterType((*QueryVolumeReportRequest)(nil), "stratos.pot.v1.QueryVolumeReportRequest")
+	proto.RegisterType((*QueryVolumeReportResponse)(nil), "stratos.pot.v1.QueryVolumeReportResponse")
+	proto.RegisterType((*ReportInfo)(nil), "stratos.pot.v1.ReportInfo")
+	proto.RegisterType((*QueryParamsRequest)(nil), "stratos.pot.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "stratos.pot.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryRewardsByEpochRequest)(nil), "stratos.pot.v1.QueryRewardsByEpochRequest")
+	proto.RegisterType((*QueryRewardsByEpochResponse)(nil), "stratos.pot.v1.QueryRewardsByEpochResponse")
+	proto.RegisterType((*QueryRewardsByWalletRequest)(nil), "stratos.pot.v1.QueryRewardsByWalletRequest")
+	proto.RegisterType((*QueryRewardsByWalletResponse)(nil), "stratos.pot.v1.QueryRewardsByWalletResponse")
+	proto.RegisterType((*QueryRewardsByWalletAndEpochRequest)(nil), "stratos.pot.v1.QueryRewardsByWalletAndEpochRequest")
+	proto.RegisterType((*QueryRewardsByWalletAndEpochResponse)(nil), "stratos.pot.v1.QueryRewardsByWalletAndEpochResponse")
+	proto.RegisterType((*RewardByWallet)(nil), "stratos.pot.v1.RewardByWallet")
+	proto.RegisterType((*QuerySlashingByOwnerRequest)(nil), "stratos.pot.v1.QuerySlashingByOwnerRequest")
+	proto.RegisterType((*QuerySlashingByOwnerResponse)(nil), "stratos.pot.v1.QuerySlashingByOwnerResponse")
+	proto.RegisterType((*QueryTotalMinedTokenRequest)(nil), "stratos.pot.v1.QueryTotalMinedTokenRequest")
+	proto.RegisterType((*QueryTotalMinedTokenResponse)(nil), "stratos.pot.v1.QueryTotalMinedTokenResponse")
+	proto.RegisterType((*QueryCirculationSupplyRequest)(nil), "stratos.pot.v1.QueryCirculationSupplyRequest")
+	proto.RegisterType((*QueryCirculationSupplyResponse)(nil), "stratos.pot.v1.QueryCirculationSupplyResponse")
+	proto.RegisterType((*QueryTotalRewardByEpochRequest)(nil), "stratos.pot.v1.QueryTotalRewardByEpochRequest")
+	proto.RegisterType((*QueryTotalRewardByEpochResponse)(nil), "stratos.pot.v1.QueryTotalRewardByEpochResponse")
+	proto.RegisterType((*QueryMetricsRequest)(nil), "stratos.pot.v1.QueryMetricsRequest")
+	proto.RegisterType((*QueryMetricsResponse)(nil), "stratos.pot.v1.QueryMetricsResponse")
+	proto.RegisterType((*QueryPendingVolumeReportRequest)(nil), "stratos.pot.v1.QueryPendingVolumeReportRequest")
+	proto.RegisterType((*QueryPendingVolumeReportResponse)(nil), "stratos.pot.v1.QueryPendingVolumeReportResponse")
+	proto.RegisterType((*QueryVolumeReportDisputeRequest)(nil), "stratos.pot.v1.QueryVolumeReportDisputeRequest")
+	proto.RegisterType((*QueryVolumeReportDisputeResponse)(nil), "stratos.pot.v1.QueryVolumeReportDisputeResponse")
+	proto.RegisterType((*QueryDistributionProgressRequest)(nil), "stratos.pot.v1.QueryDistributionProgressRequest")
+	proto.RegisterType((*QueryDistributionProgressResponse)(nil), "stratos.pot.v1.QueryDistributionProgressResponse")
+	proto.RegisterType((*QueryVestingScheduleRequest)(nil), "stratos.pot.v1.QueryVestingScheduleRequest")
+	proto.RegisterType((*QueryVestingScheduleResponse)(nil), "stratos.pot.v1.QueryVestingScheduleResponse")
+	proto.RegisterType((*VestingScheduleEntry)(nil), "stratos.pot.v1.VestingScheduleEntry")
+	proto.RegisterType((*QueryRewardHistoryRequest)(nil), "stratos.pot.v1.QueryRewardHistoryRequest")
+	proto.RegisterType((*QueryRewardHistoryResponse)(nil), "stratos.pot.v1.QueryRewardHistoryResponse")
+	proto.RegisterType((*QueryAutoRestakeRequest)(nil), "stratos.pot.v1.QueryAutoRestakeRequest")
+	proto.RegisterType((*QueryAutoRestakeResponse)(nil), "stratos.pot.v1.QueryAutoRestakeResponse")
+}
+
+func init() { proto.RegisterFile("stratos/pot/v1/query.proto", fileDescriptor_c09bd09df76a68e0) }
+
+var fileDescriptor_c09bd09df76a68e0 = []byte{
+	// 1896 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0xcd, 0x6f, 0x1b, 0xc7,
+	0x15, 0xf7, 0x4a, 0xb2, 0x3e, 0x1e, 0xf5, 0x51, 0x8f, 0x68, 0x8b, 0x5e, 0xcb, 0xa4, 0xb4, 0x52,
+	0x6c, 0xd9, 0x96, 0xb8, 0x92, 0xdd, 0xd4, 0x6d, 0x8a, 0xa4, 0xb5, 0x22, 0xbb, 0x31, 0x50, 0xc3,
+	0x2a, 0x6d, 0xa4, 0x41, 0x2e, 0x8b, 0x15, 0x39, 0x22, 0x17, 0x26, 0x77, 0xe8, 0xdd, 0xa1, 0x6c,
+	0xc6, 0x0d, 0x0a, 0x04, 0xbd, 0x14, 0xe8, 0xa1, 0x68, 0x0f, 0xc9, 0xa1, 0x68, 0x51, 0x14, 0x45,
+	0x0a, 0x5f, 0x7a, 0xea, 0x9f, 0x50, 0xc0, 0x40, 0x81, 0x22, 0x40, 0x2f, 0x45, 0x0e, 0x6a, 0x21,
+	0xf7, 0x94, 0xa3, 0xff, 0x82, 0x62, 0x67, 0x1e, 0xc9, 0xdd, 0xe5, 0x2c, 0xb9, 0x32, 0xec, 0xe4,
+	0x64, 0xf1, 0xcd, 0xfb, 0xf8, 0xbd, 0x8f, 0x99, 0xf7, 0xf6, 0x19, 0x74, 0x9f, 0x7b, 0x36, 0x67,
+	0xbe, 0xd9, 0x64, 0xdc, 0x3c, 0xd8, 0x32, 0x1f, 0xb6, 0xa8, 0xd7, 0x2e, 0x36, 0x3d, 0xc6, 0x19,
+	0x99, 0xc5, 0xb3, 0x62, 0x93, 0xf1, 0xe2, 0xc1, 0x96, 0x9e, 0xad, 0xb2, 0x2a, 0x13, 0x47, 0x66,
+	0xf0, 0x97, 0xe4, 0xd2, 0x17, 0xab, 0x8c, 0x55, 0xeb, 0xd4, 0xb4, 0x9b, 0x8e, 0x69, 0xbb, 0x2e,
+	0xe3, 0x36, 0x77, 0x98, 0xeb, 0xe3, 0x69, 0xbe, 0xcc, 0xfc, 0x06, 0xf3, 0xcd, 0x3d, 0xdb, 0xa7,
+	0xe6, 0xc1, 0xd6, 0x1e, 0xe5, 0xf6, 0x96, 0x59, 0x66, 0x8e, 0x8b, 0xe7, 0x97, 0xc3, 0xe7, 0xc2,
+	0x78, 0x97, 0xab, 0x69, 0x57, 0x1d, 0x57, 0x28, 0x43, 0xde, 0x5c, 0x0c, 0x6b, 0x00, 0x4b, 0x9c,
+	0x18, 0x9b, 0x90, 0xfb, 0x49, 0x20, 0xfb, 0x3e, 0xab, 0xb7, 0x1a, 0xb4, 0x44, 0x9b, 0xcc, 0xe3,
+	0x25, 0xfa, 0xb0, 0x45, 0x7d, 0x4e, 0xb2, 0x70, 0x92, 0x36, 0x59, 0xb9, 0x96, 0xd3, 0x96, 0xb4,
+	0xb5, 0xd1, 0x92, 0xfc, 0x61, 0x7c, 0x00, 0x67, 0x15, 0x12, 0x7e, 0x93, 0xb9, 0x3e, 0x25, 0xdf,
+	0x87, 0x8c, 0x27, 0x28, 0x96, 0xe3, 0xee, 0x33, 0x21, 0x98, 0xb9, 0xaa, 0x17, 0xa3, 0xe1, 0x28,
+	0x4a, 0xa1, 0xdb, 0xee, 0x3e, 0x2b, 0x81, 0xd7, 0xfd, 0xdb, 0x68, 0x01, 0xf4, 0x4e, 0xd4, 0xd6,
+	0xc9, 0x22, 0x4c, 0x79, 0x74, 0x9f, 0x7a, 0xd4, 0x2d, 0xd3, 0xdc, 0xc8, 0x92, 0xb6, 0x36, 0x55,
+	0xea, 0x11, 0xc8, 0x02, 0x4c, 0xf0, 0xc7, 0x56, 0xcd, 0xf6, 0x6b, 0xb9, 0x51, 0x71, 0x36, 0xce,
+	0x1f, 0xbf, 0x67, 0xfb, 0x35, 0xa2, 0xc3, 0xa4, 0x34, 0x44, 0xbd, 0xdc, 0x98, 0x38, 0xe9, 0xfe,
+	0x36, 0xb2, 0x40, 0x84, 0x43, 0xbb, 0xb6, 0x67, 0x37, 0x7c, 0x74, 0xde, 0xb8, 0x09, 0xf3, 0x11,
+	0x2a, 0x3a, 0x58, 0x84, 0xf1, 0xa6, 0xa0, 0xa0, 0x6f, 0x67, 0xe2, 0xbe, 0x21, 0x3f, 0x72, 0x19,
+	0x1f, 0x81, 0x2e, 0xd4, 0x94, 0xe8, 0x23, 0xdb, 0xab, 0xf8, 0xdb, 0xed, 0x9b, 0x81, 0x1b, 0x03,
+	0x23, 0x4c, 0x6e, 0x01, 0xf4, 0x32, 0x28, 0x9c, 0xcc, 0x5c, 0xbd, 0x50, 0x94, 0xe9, 0x2e, 0x06,
+	0xe9, 0x2e, 0xca, 0x5a, 0xc3, 0x74, 0x17, 0x77, 0xed, 0x2a, 0x45, 0x8d, 0xa5, 0x90, 0xa4, 0xf1,
+	0x99, 0x06, 0xe7, 0x94, 0xc6, 0xd1, 0x97, 0x4d, 0x98, 0xf0, 0xe4, 0x49, 0x4e, 0x5b, 0x1a, 0x55,
+	0x39, 0x23, 0x05, 0x4b, 0x1d, 0x36, 0xf2, 0x23, 0x05, 0xb2, 0x8b, 0x43, 0x91, 0x49, 0x73, 0x11,
+	0x68, 0x3b, 0x71, 0x64, 0x3f, 0xb5, 0xeb, 0x75, 0xda, 0xad, 0xbc, 0x37, 0x60, 0xf6, 0x91, 0x20,
+	0x58, 0x76, 0xa5, 0xe2, 0x51, 0x5f, 0x46, 0x7b, 0xaa, 0x34, 0x23, 0xa9, 0x37, 0x24, 0xd1, 0xf8,
+	0x00, 0x16, 0xd5, 0x5a, 0xd0, 0xc1, 0xef, 0x86, 0x1d, 0x0c, 0xb0, 0xe6, 0xd5, 0x0e, 0x76, 0x05,
+	0x3b, 0xec, 0xc6, 0x53, 0x0d, 0x56, 0x54, 0xaa, 0x6f, 0xb8, 0x95, 0x48, 0x02, 0xd3, 0x01, 0xed,
+	0xe5, 0x79, 0x24, 0x39, 0xcf, 0xa3, 0x2f, 0x9d, 0xe7, 0x3f, 0x6a, 0xb0, 0x3a, 0x18, 0xec, 0x37,
+	0x9f, 0xf0, 0xbf, 0x8d, 0xc0, 0x6c, 0x34, 0xd8, 0x69, 0x63, 0xf7, 0x04, 0xe6, 0x1b, 0x36, 0x6f,
+	0x79, 0xd4, 0xe2, 0x8c, 0xdb, 0x75, 0x4b, 0x42, 0xcb, 0x8d, 0x08, 0x07, 0xce, 0x46, 0xb0, 0x74,
+	0x50, 0xbc, 0xcb, 0x1c, 0x77, 0x7b, 0xf3, 0xd9, 0x61, 0xe1, 0xc4, 0xd3, 0xff, 0x14, 0xd6, 0xaa,
+	0x0e, 0xaf, 0xb5, 0xf6, 0x8a, 0x65, 0xd6, 0x30, 0xf1, 0xc9, 0x94, 0xff, 0x6c, 0xf8, 0x95, 0x07,
+	0x26, 0x6f, 0x37, 0xa9, 0x2f, 0x04, 0xfc, 0xd2, 0x29, 0x69, 0xe7, 0x7e, 0x60, 0x46, 0x62, 0x25,
+	0x3f, 0x87, 0xd3, 0x4e, 0x43, 0x65, 0x7e, 0xf4, 0xd5, 0x9b, 0x9f, 0x77, 0x1a, 0x7d, 0x00, 0xba,
+	0x17, 0xe5, 0x5e, 0xdd, 0xf6, 0x6b, 0x8e, 0x5b, 0xdd, 0x6e, 0xdf, 0x7d, 0xe4, 0x52, 0xef, 0x98,
+	0x17, 0xe5, 0x2d, 0x58, 0x54, 0x6b, 0xc1, 0xc2, 0xd0, 0x61, 0xd2, 0xc7, 0x23, 0x54, 0xd0, 0xfd,
+	0x6d, 0x9c, 0x47, 0x04, 0x02, 0xd5, 0x1d, 0xc7, 0xa5, 0x95, 0xfb, 0xec, 0x01, 0x75, 0x3b, 0xef,
+	0xe4, 0xef, 0x34, 0x58, 0x54, 0x9f, 0xa3, 0xee, 0x9f, 0xc1, 0x29, 0x19, 0xb9, 0x46, 0x70, 0x66,
+	0xf1, 0xe0, 0x10, 0xaf, 0xe3, 0x80, 0xf0, 0xbd, 0x19, 0x84, 0xef, 0xab, 0xc3, 0x42, 0xbf, 0xec,
+	0x8b, 0xc3, 0x42, 0xae, 0x6d, 0x37, 0xea, 0x6f, 0x19, 0x7d, 0x47, 0x46, 0x69, 0x8e, 0x47, 0x51,
+	0x18, 0x05, 0x38, 0x2f, 0xd0, 0xbd, 0xeb, 0x78, 0xe5, 0x56, 0x5d, 0xd4, 0xe2, 0xbd, 0x56, 0xb3,
+	0x59, 0x6f, 0x87, 0xf0, 0xe7, 0x93, 0x38, 0xd0, 0x83, 0x8f, 0x80, 0x94, 0x7b, 0x87, 0x96, 0x2f,
+	0x4e, 0x5f, 0x47, 0x05, 0x9c, 0x2a, 0xc7, 0x31, 0x18, 0xdf, 0x81, 0x7c, 0x2f, 0xba, 0x9d, 0x0b,
+	0x34, 0xbc, 0x87, 0x18, 0xff, 0xd4, 0xa0, 0x90, 0x28, 0x88, 0x7e, 0x3d, 0x80, 0xe9, 0x48, 0x4d,
+	0xcb, 0xa4, 0x9c, 0x8b, 0xbf, 0x09, 0x61, 0x0d, 0x57, 0x30, 0x2d, 0x11, 0xc1, 0x17, 0x87, 0x85,
+	0xf9, 0x70, 0x46, 0x24, 0xd5, 0x28, 0x65, 0x78, 0xe8, 0x26, 0xbd, 0x03, 0x53, 0x8e, 0x6f, 0xd5,
+	0x69, 0xd5, 0x2e, 0xb7, 0xc5, 0x43, 0x32, 0xb9, 0xbd, 0xfc, 0xd5, 0x61, 0xa1, 0x47, 0x7c, 0x71,
+	0x58, 0xf8, 0x96, 0xd4, 0xd2, 0x25, 0x19, 0xa5, 0x49, 0xc7, 0xff, 0xb1, 0xfc, 0xf3, 0x34, 0xf6,
+	0xe3, 0x3b, 0x94, 0x7b, 0x4e, 0xb9, 0xdb, 0xa6, 0xef, 0x42, 0x36, 0x4a, 0x46, 0xdf, 0xae, 0xc3,
+	0x44, 0x43, 0x92, 0xd0, 0xad, 0x85, 0xb8, 0x5b, 0x28, 0xb1, 0x3d, 0x16, 0xb8, 0x54, 0xea, 0x70,
+	0x1b, 0xcb, 0x18, 0xb7, 0x5d, 0xea, 0x56, 0x1c, 0xb7, 0xaa, 0x98, 0x8b, 0x0c, 0x0b, 0x96, 0x92,
+	0x59, 0xba, 0x83, 0xd0, 0xb8, 0x1c, 0x30, 0xd0, 0xfc, 0x4a, 0xdf, 0x9c, 0xa0, 0x10, 0x46, 0x11,
+	0xe3, 0x3a, 0x62, 0x08, 0x1f, 0xee, 0x38, 0x7e, 0xb3, 0xc5, 0xe9, 0xe0, 0xac, 0xdb, 0xb0, 0x94,
+	0x2c, 0x88, 0xc8, 0xde, 0x86, 0x89, 0x8a, 0x24, 0x25, 0x41, 0x53, 0x49, 0x77, 0x64, 0x0c, 0x03,
+	0x4d, 0xec, 0x38, 0x3e, 0xf7, 0x9c, 0xbd, 0x56, 0x50, 0xab, 0xbb, 0x1e, 0xab, 0x7a, 0xd4, 0xef,
+	0x26, 0xe5, 0x4b, 0x0d, 0x96, 0x07, 0x30, 0x21, 0x90, 0x0a, 0x9c, 0xa9, 0xdb, 0x3e, 0xb7, 0x2a,
+	0x1d, 0x26, 0x5a, 0xb1, 0x7a, 0x3e, 0x4d, 0x6d, 0x17, 0x83, 0xc4, 0x7c, 0x79, 0x58, 0xb8, 0x90,
+	0xe2, 0xfe, 0xdc, 0x76, 0x79, 0x29, 0x1b, 0x68, 0xdb, 0xe9, 0x29, 0x13, 0xc5, 0x4e, 0xee, 0xc2,
+	0x4c, 0x25, 0x84, 0xc2, 0xc7, 0xc6, 0x91, 0x94, 0x8f, 0x30, 0x62, 0x2c, 0x8d, 0xa8, 0xbc, 0xf1,
+	0xab, 0xce, 0x54, 0xf5, 0x3e, 0xf5, 0xb9, 0xe3, 0x56, 0xef, 0x95, 0x6b, 0xb4, 0xd2, 0xaa, 0xd3,
+	0x63, 0x8e, 0x04, 0xaf, 0x6a, 0xc8, 0x3b, 0x1a, 0x83, 0x45, 0x35, 0x1c, 0x0c, 0x73, 0x4a, 0x3c,
+	0xcb, 0x30, 0x7d, 0x20, 0x35, 0x58, 0x0d, 0x56, 0xe9, 0xcc, 0xd6, 0x19, 0xa4, 0xdd, 0x61, 0x15,
+	0x4a, 0x56, 0x60, 0x46, 0x36, 0xa8, 0x4e, 0x9e, 0x46, 0x45, 0xed, 0x4d, 0x23, 0x51, 0xc6, 0x7b,
+	0x07, 0x26, 0xa8, 0xcb, 0x3d, 0x87, 0xfa, 0xb9, 0x31, 0x11, 0xe9, 0xd5, 0xbe, 0xf2, 0x8a, 0x02,
+	0xbd, 0xe9, 0x72, 0xaf, 0xdd, 0xb9, 0x85, 0x28, 0x9a, 0xd4, 0xf4, 0x4f, 0x7e, 0xb3, 0x4d, 0x7f,
+	0xfc, 0xeb, 0x69, 0xfa, 0xb1, 0xa9, 0x6b, 0xe2, 0xa5, 0xa7, 0x2e, 0x72, 0x0b, 0x66, 0xeb, 0x8e,
+	0x4b, 0x6d, 0xcf, 0xc2, 0x3c, 0xe6, 0x26, 0x85, 0xb2, 0xc2, 0x90, 0x9c, 0x94, 0x66, 0xa4, 0x18,
+	0x92, 0x8d, 0xbf, 0x8f, 0x40, 0x56, 0x95, 0xb6, 0xa0, 0x6a, 0x30, 0x50, 0xe1, 0xd7, 0x28, 0x23,
+	0x69, 0xb2, 0x20, 0x3e, 0xd1, 0x60, 0x41, 0xc6, 0xcf, 0xda, 0xf7, 0x58, 0x23, 0xe8, 0xd7, 0x41,
+	0x91, 0x35, 0x19, 0xab, 0xbf, 0x8e, 0x21, 0x2e, 0x2b, 0x6d, 0xdd, 0xf2, 0x58, 0xe3, 0x8e, 0xb0,
+	0xb4, 0xcb, 0x58, 0x9d, 0xfc, 0x42, 0x83, 0x5c, 0x18, 0x04, 0xf7, 0xec, 0xfd, 0x7d, 0xa7, 0x2c,
+	0x51, 0xbc, 0x86, 0x4e, 0x7e, 0xba, 0x87, 0xe2, 0xbe, 0x34, 0x15, 0xc0, 0x30, 0x9e, 0x69, 0xf8,
+	0xf1, 0x2c, 0x13, 0xfd, 0x9e, 0xe3, 0x73, 0xe6, 0xb5, 0x8f, 0xf9, 0x72, 0x14, 0x20, 0xe3, 0x73,
+	0xdb, 0xe3, 0x56, 0xf8, 0x93, 0x02, 0x04, 0x49, 0x46, 0xfc, 0x1c, 0x4c, 0x51, 0x37, 0x7a, 0x47,
+	0x27, 0x29, 0x7e, 0x0b, 0xc4, 0xde, 0x9d, 0xb1, 0x97, 0x7e, 0x77, 0xfe, 0xa2, 0x81, 0xae, 0x72,
+	0x05, 0x5f, 0x9d, 0x1b, 0x30, 0x55, 0x13, 0x24, 0x87, 0x76, 0x3e, 0x36, 0xce, 0xab, 0x3f, 0x36,
+	0x50, 0x12, 0x5f, 0x80, 0x9e, 0xd4, 0xab, 0xfb, 0xf6, 0xf8, 0x21, 0x2c, 0x08, 0xa4, 0x37, 0x5a,
+	0x9c, 0x95, 0xa8, 0xcf, 0xed, 0x07, 0xc7, 0x7c, 0xac, 0x8d, 0x0f, 0x21, 0xd7, 0xaf, 0x01, 0x3d,
+	0x7d, 0x07, 0xa6, 0xed, 0x16, 0x67, 0x96, 0x27, 0xe9, 0x49, 0x53, 0x54, 0x58, 0x34, 0x63, 0xf7,
+	0x7e, 0x5c, 0xfd, 0x65, 0x16, 0x4e, 0x0a, 0xe5, 0xe4, 0x37, 0x1a, 0x4c, 0x87, 0x7b, 0x2f, 0x59,
+	0x8b, 0x2b, 0x49, 0x5a, 0xd5, 0xe8, 0x97, 0x52, 0x70, 0x4a, 0xbc, 0xc6, 0xc6, 0x27, 0xff, 0xfa,
+	0xdf, 0x6f, 0x47, 0x2e, 0x92, 0x37, 0xcc, 0xd8, 0x52, 0xe8, 0x40, 0x70, 0x5b, 0x72, 0x06, 0x31,
+	0x9f, 0x88, 0xf2, 0xf9, 0x98, 0x7c, 0xae, 0xc1, 0xbc, 0x62, 0x56, 0x21, 0xa6, 0xd2, 0x62, 0xf2,
+	0xd4, 0xa4, 0x6f, 0xa6, 0x17, 0x18, 0x86, 0xb4, 0x29, 0x85, 0xac, 0x08, 0x62, 0xf2, 0x54, 0x83,
+	0xac, 0x6a, 0xe0, 0x20, 0x6a, 0xcb, 0x03, 0x06, 0x18, 0x7d, 0xeb, 0x18, 0x12, 0xc3, 0xc0, 0x86,
+	0xa7, 0x07, 0xab, 0xd9, 0xc1, 0xf4, 0x57, 0x0d, 0xe6, 0x15, 0x73, 0x56, 0x42, 0x58, 0x93, 0x07,
+	0x41, 0x7d, 0x33, 0xbd, 0x00, 0x22, 0x7d, 0x53, 0x20, 0x35, 0xc9, 0xc6, 0xc0, 0x02, 0xb0, 0x70,
+	0xe0, 0xeb, 0x16, 0xc2, 0x67, 0x1a, 0xcc, 0x46, 0x17, 0x49, 0xe4, 0xb2, 0xd2, 0xb6, 0x72, 0xd5,
+	0xa5, 0x5f, 0x49, 0xc5, 0x3b, 0x2c, 0x98, 0xb8, 0x97, 0x30, 0x05, 0xa6, 0x2e, 0xb4, 0x3f, 0x6b,
+	0x30, 0x17, 0xdb, 0x7d, 0x90, 0x21, 0xf6, 0x22, 0xfb, 0x26, 0x7d, 0x3d, 0x1d, 0x33, 0xa2, 0xbb,
+	0x2e, 0xd0, 0x6d, 0x11, 0x33, 0x09, 0x9d, 0x7c, 0x3c, 0xcc, 0x27, 0xd1, 0xa7, 0xe5, 0x63, 0xf2,
+	0x0f, 0x0d, 0x16, 0x12, 0x76, 0x34, 0xe4, 0x5a, 0x1a, 0x08, 0xb1, 0xf5, 0x93, 0xfe, 0xed, 0xe3,
+	0x09, 0x21, 0xfe, 0x9b, 0x02, 0xff, 0x0f, 0xc8, 0xdb, 0xc7, 0xc4, 0x1f, 0x8b, 0xfa, 0xe7, 0x1a,
+	0xcc, 0xc5, 0x86, 0x82, 0x84, 0xa8, 0xab, 0x27, 0x65, 0x7d, 0x3d, 0x1d, 0x33, 0xa2, 0xfe, 0x9e,
+	0x40, 0x7d, 0x8d, 0x6c, 0xf5, 0x95, 0x2d, 0x8e, 0xad, 0x3e, 0x4a, 0xf4, 0xc7, 0xfd, 0x0f, 0x1a,
+	0xcc, 0x44, 0x9a, 0x0d, 0xb9, 0x34, 0x20, 0x70, 0xd1, 0xae, 0xac, 0x5f, 0x4e, 0xc3, 0x9a, 0xae,
+	0x32, 0x2c, 0xd9, 0xdc, 0xda, 0xfd, 0x08, 0x3f, 0xd5, 0x20, 0x13, 0xea, 0x10, 0xe4, 0xa2, 0xd2,
+	0x68, 0x7f, 0x03, 0xd3, 0xd7, 0x86, 0x33, 0x0e, 0xbb, 0xf6, 0xe1, 0xee, 0xa5, 0x8c, 0xdd, 0x5c,
+	0x6c, 0x6d, 0x94, 0x90, 0x65, 0xf5, 0x8a, 0x4a, 0x5f, 0x4f, 0xc7, 0x8c, 0x28, 0xb7, 0x04, 0xca,
+	0x2b, 0xe4, 0x52, 0x1c, 0x65, 0x67, 0x1f, 0xd5, 0x8f, 0xf0, 0x21, 0x8c, 0xcb, 0xa5, 0x3b, 0x31,
+	0xd4, 0x2d, 0x26, 0xbc, 0xd7, 0xd7, 0x57, 0x06, 0xf2, 0x20, 0x8a, 0xbc, 0x40, 0x91, 0x23, 0x67,
+	0xfa, 0x3a, 0x8f, 0x34, 0xf4, 0xa9, 0x06, 0x73, 0xb1, 0x7d, 0x57, 0x42, 0x50, 0xd4, 0x5b, 0x33,
+	0x7d, 0x3d, 0x1d, 0x33, 0xc2, 0xb9, 0x24, 0xe0, 0xac, 0x90, 0xe5, 0x38, 0x9c, 0xbe, 0x0d, 0x18,
+	0xf9, 0xbd, 0x06, 0xa7, 0xfa, 0x36, 0x59, 0x64, 0x43, 0x69, 0x2e, 0x69, 0x27, 0xa6, 0x17, 0xd3,
+	0xb2, 0x23, 0xbe, 0xcb, 0x02, 0xdf, 0x2a, 0x31, 0xe2, 0xf8, 0xfa, 0xd7, 0x66, 0xe4, 0x4f, 0x1a,
+	0x90, 0xfe, 0x9d, 0x14, 0x29, 0x26, 0x07, 0x44, 0xb5, 0xf5, 0xd2, 0xcd, 0xd4, 0xfc, 0x88, 0x71,
+	0x5d, 0x60, 0xbc, 0x40, 0x56, 0xd5, 0x31, 0x94, 0x17, 0xb4, 0xfb, 0xb6, 0xb5, 0x60, 0x02, 0xf7,
+	0x43, 0x44, 0x5d, 0x30, 0xd1, 0x35, 0x94, 0xbe, 0x3a, 0x98, 0x09, 0x31, 0x14, 0x04, 0x86, 0xb3,
+	0x64, 0x21, 0x8e, 0x01, 0x97, 0x4f, 0xdb, 0xb7, 0x9f, 0x1d, 0xe5, 0xb5, 0x2f, 0x8e, 0xf2, 0xda,
+	0x7f, 0x8f, 0xf2, 0xda, 0xaf, 0x9f, 0xe7, 0x4f, 0x7c, 0xf1, 0x3c, 0x7f, 0xe2, 0xdf, 0xcf, 0xf3,
+	0x27, 0x3e, 0x34, 0x43, 0x9f, 0x1e, 0x28, 0xec, 0x52, 0xde, 0xf9, 0x73, 0xa3, 0x5c, 0xb3, 0x1d,
+	0xd7, 0x7c, 0x2c, 0xf4, 0x89, 0xef, 0x90, 0xbd, 0x71, 0xf1, 0xff, 0x7b, 0xd7, 0xfe, 0x3f, 0x00,
+	0x68, 0x0b, 0xb5, 0x10, 0xa7, 0x1c, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// VolumeReport queries VolumeReport info for given epoch.
+	VolumeReport(ctx context.Context, in *QueryVolumeReportRequest, opts ...grpc.CallOption) (*QueryVolumeReportResponse, error)
+	// PendingVolumeReport queries the chunked volume report waiting for its chunks.
+	PendingVolumeReport(ctx context.Context, in *QueryPendingVolumeReportRequest, opts ...grpc.CallOption) (*QueryPendingVolumeReportResponse, error)
+	// DistributionProgress queries the volume reports whose rewards are being distributed.
+	DistributionProgress(ctx context.Context, in *QueryDistributionProgressRequest, opts ...grpc.CallOption) (*QueryDistributionProgressResponse, error)
+	// VolumeReportDispute queries the dispute filed against the volume report of an epoch.
+	VolumeReportDispute(ctx context.Context, in *QueryVolumeReportDisputeRequest, opts ...grpc.CallOption) (*QueryVolumeReportDisputeResponse, error)
+	// RewardsByEpoch queries Pot rewards by a given epoch.
+	RewardsByEpoch(ctx context.Context, in *QueryRewardsByEpochRequest, opts ...grpc.CallOption) (*QueryRewardsByEpochResponse, error)
+	// RewardsByOwner queries Pot rewards by a given beneficiary address.
+	RewardsByWallet(ctx context.Context, in *QueryRewardsByWalletRequest, opts ...grpc.CallOption) (*QueryRewardsByWalletResponse, error)
+	// RewardsByWalletAndEpoch queries Pot rewards by a given beneficiary address at the specific epoch.
+	RewardsByWalletAndEpoch(ctx context.Context, in *QueryRewardsByWalletAndEpochRequest, opts ...grpc.CallOption) (*QueryRewardsByWalletAndEpochResponse, error)
+	// SlashingByOwner queries Pot slashing by owner wallet address.
+	// VestingSchedule queries the immature rewards of a wallet by the epoch they mature at, and its linearly vesting
+	// matured reward.
+	VestingSchedule(ctx context.Context, in *QueryVestingScheduleRequest, opts ...grpc.CallOption) (*QueryVestingScheduleResponse, error)
+	// RewardHistory queries the matured rewards of a wallet in an epoch range.
+	RewardHistory(ctx context.Context, in *QueryRewardHistoryRequest, opts ...grpc.CallOption) (*QueryRewardHistoryResponse, error)
+	// AutoRestake queries the node the matured rewards of a wallet are automatically added to the deposit of.
+	AutoRestake(ctx context.Context, in *QueryAutoRestakeRequest, opts ...grpc.CallOption) (*QueryAutoRestakeResponse, error)
+	SlashingByOwner(ctx context.Context, in *QuerySlashingByOwnerRequest, opts ...grpc.CallOption) (*QuerySlashingByOwnerResponse, error)
+	// Params queries POT module Params info.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	TotalMinedToken(ctx context.Context, in *QueryTotalMinedTokenRequest, opts ...grpc.CallOption) (*QueryTotalMinedTokenResponse, error)
+	CirculationSupply(ctx context.Context, in *QueryCirculationSupplyRequest, opts ...grpc.CallOption) (*QueryCirculationSupplyResponse, error)
+	TotalRewardByEpoch(ctx context.Context, in *QueryTotalRewardByEpochRequest, opts ...grpc.CallOption) (*QueryTotalRewardByEpochResponse, error)
+	Metrics(ctx context.Context, in *QueryMetricsRequest, opts ...grpc.CallOption) (*QueryMetricsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) VolumeReport(ctx context.Context, in *QueryVolumeReportRequest, opts ...grpc.CallOption) (*QueryVolumeReportResponse, error) {
+	out := new(QueryVolumeReportResponse)
+	err := c.cc.Invoke(ctx, "/stratos.pot.v1.Query/VolumeReport", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryRewardsByEpochResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) PendingVolumeReport(ctx context.Context, in *QueryPendingVolumeReportRequest, opts ...grpc.CallOption) (*QueryPendingVolumeReportResponse, error) {
+	out := new(QueryPendingVolumeReportResponse)
+	err := c.cc.Invoke(ctx, "/stratos.pot.v1.Query/PendingVolumeReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryRewardsByEpochResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func (c *queryClient) DistributionProgress(ctx context.Context, in *QueryDistributionProgressRequest, opts ...grpc.CallOption) (*QueryDistributionProgressResponse, error) {
+	out := new(QueryDistributionProgressResponse)
+	err := c.cc.Invoke(ctx, "/stratos.pot.v1.Query/DistributionProgress", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Rewards) > 0 {
-		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	return out, nil
+}
+
+func (c *queryClient) VolumeReportDispute(ctx context.Context, in *QueryVolumeReportDisputeRequest, opts ...grpc.CallOption) (*QueryVolumeReportDisputeResponse, error) {
+	out := new(QueryVolumeReportDisputeResponse)
+	err := c.cc.Invoke(ctx, "/stratos.pot.v1.Query/VolumeReportDispute", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryRewardsByWalletRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) RewardsByEpoch(ctx context.Context, in *QueryRewardsByEpochRequest, opts ...grpc.CallOption) (*QueryRewardsByEpochResponse, error) {
+	out := new(QueryRewardsByEpochResponse)
+	err := c.cc.Invoke(ctx, "/stratos.pot.v1.Query/RewardsByEpoch", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryRewardsByWalletRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) RewardsByWallet(ctx context.Context, in *QueryRewardsByWalletRequest, opts ...grpc.CallOption) (*QueryRewardsByWalletResponse, error) {
+	out := new(QueryRewardsByWalletResponse)
+	err := c.cc.Invoke(ctx, "/stratos.pot.v1.Query/RewardsByWallet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryRewardsByWalletRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.WalletAddress) > 0 {
-		i -= len(m.WalletAddress)
-		copy(dAtA[i:], m.WalletAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.WalletAddress)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) RewardsByWalletAndEpoch(ctx context.Context, in *QueryRewardsByWalletAndEpochRequest, opts ...grpc.CallOption) (*QueryRewardsByWalletAndEpochResponse, error) {
+	out := new(QueryRewardsByWalletAndEpochResponse)
+	err := c.cc.Invoke(ctx, "/stratos.pot.v1.Query/RewardsByWalletAndEpoch", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryRewardsByWalletResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) VestingSchedule(ctx context.Context, in *QueryVestingScheduleRequest, opts ...grpc.CallOption) (*QueryVestingScheduleResponse, error) {
+	out := new(QueryVestingScheduleResponse)
+	err := c.cc.Invoke(ctx, "/stratos.pot.v1.Query/VestingSchedule", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryRewardsByWalletResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) RewardHistory(ctx context.Context, in *QueryRewardHistoryRequest, opts ...grpc.CallOption) (*QueryRewardHistoryResponse, error) {
+	out := new(QueryRewardHistoryResponse)
+	err := c.cc.Invoke(ctx, "/stratos.pot.v1.Query/RewardHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryRewardsByWalletResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Rewards != nil {
-		{
-			size, err := m.Rewards.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) AutoRestake(ctx context.Context, in *QueryAutoRestakeRequest, opts ...grpc.CallOption) (*QueryAutoRestakeResponse, error) {
+	out := new(QueryAutoRestakeResponse)
+	err := c.cc.Invoke(ctx, "/stratos.pot.v1.Query/AutoRestake", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryRewardsByWalletAndEpochRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) SlashingByOwner(ctx context.Context, in *QuerySlashingByOwnerRequest, opts ...grpc.CallOption) (*QuerySlashingByOwnerResponse, error) {
+	out := new(QuerySlashingByOwnerResponse)
+	err := c.cc.Invoke(ctx, "/stratos.pot.v1.Query/SlashingByOwner", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryRewardsByWalletAndEpochRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/stratos.pot.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryRewardsByWalletAndEpochRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Epoch != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Epoch))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.WalletAddress) > 0 {
-		i -= len(m.WalletAddress)
-		copy(dAtA[i:], m.WalletAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.WalletAddress)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) TotalMinedToken(ctx context.Context, in *QueryTotalMinedTokenRequest, opts ...grpc.CallOption) (*QueryTotalMinedTokenResponse, error) {
+	out := new(QueryTotalMinedTokenResponse)
+	err := c.cc.Invoke(ctx, "/stratos.pot.v1.Query/TotalMinedToken", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryRewardsByWalletAndEpochResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) CirculationSupply(ctx context.Context, in *QueryCirculationSupplyRequest, opts ...grpc.CallOption) (*QueryCirculationSupplyResponse, error) {
+	out := new(QueryCirculationSupplyResponse)
+	err := c.cc.Invoke(ctx, "/stratos.pot.v1.Query/CirculationSupply", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryRewardsByWalletAndEpochResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryRewardsByWalletAndEpochResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Rewards) > 0 {
-		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) TotalRewardByEpoch(ctx context.Context, in *QueryTotalRewardByEpochRequest, opts ...grpc.CallOption) (*QueryTotalRewardByEpochResponse, error) {
+	out := new(QueryTotalRewardByEpochResponse)
+	err := c.cc.Invoke(ctx, "/stratos.pot.v1.Query/TotalRewardByEpoch", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *RewardByWallet) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Metrics(ctx context.Context, in *QueryMetricsRequest, opts ...grpc.CallOption) (*QueryMetricsResponse, error) {
+	out := new(QueryMetricsResponse)
+	err := c.cc.Invoke(ctx, "/stratos.pot.v1.Query/Metrics", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *RewardByWallet) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// VolumeReport queries VolumeReport info for given epoch.
+	VolumeReport(context.Context, *QueryVolumeReportRequest) (*QueryVolumeReportResponse, error)
+	// PendingVolumeReport queries the chunked volume report waiting for its chunks.
+	PendingVolumeReport(context.Context, *QueryPendingVolumeReportRequest) (*QueryPendingVolumeReportResponse, error)
+	// DistributionProgress queries the volume reports whose rewards are being distributed.
+	DistributionProgress(context.Context, *QueryDistributionProgressRequest) (*QueryDistributionProgressResponse, error)
+	// VolumeReportDispute queries the dispute filed against the volume report of an epoch.
+	VolumeReportDispute(context.Context, *QueryVolumeReportDisputeRequest) (*QueryVolumeReportDisputeResponse, error)
+	// RewardsByEpoch queries Pot rewards by a given epoch.
+	RewardsByEpoch(context.Context, *QueryRewardsByEpochRequest) (*QueryRewardsByEpochResponse, error)
+	// RewardsByOwner queries Pot rewards by a given beneficiary address.
+	RewardsByWallet(context.Context, *QueryRewardsByWalletRequest) (*QueryRewardsByWalletResponse, error)
+	// RewardsByWalletAndEpoch queries Pot rewards by a given beneficiary address at the specific epoch.
+	RewardsByWalletAndEpoch(context.Context, *QueryRewardsByWalletAndEpochRequest) (*QueryRewardsByWalletAndEpochResponse, error)
+	// SlashingByOwner queries Pot slashing by owner wallet address.
+	// VestingSchedule queries the immature rewards of a wallet by the epoch they mature at, and its linearly vesting
+	// matured reward.
+	VestingSchedule(context.Context, *QueryVestingScheduleRequest) (*QueryVestingScheduleResponse, error)
+	// RewardHistory queries the matured rewards of a wallet in an epoch range.
+	RewardHistory(context.Context, *QueryRewardHistoryRequest) (*QueryRewardHistoryResponse, error)
+	// AutoRestake queries the node the matured rewards of a wallet are automatically added to the deposit of.
+	AutoRestake(context.Context, *QueryAutoRestakeRequest) (*QueryAutoRestakeResponse, error)
+	SlashingByOwner(context.Context, *QuerySlashingByOwnerRequest) (*QuerySlashingByOwnerResponse, error)
+	// Params queries POT module Params info.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	TotalMinedToken(context.Context, *QueryTotalMinedTokenRequest) (*QueryTotalMinedTokenResponse, error)
+	CirculationSupply(context.Context, *QueryCirculationSupplyRequest) (*QueryCirculationSupplyResponse, error)
+	TotalRewardByEpoch(context.Context, *QueryTotalRewardByEpochRequest) (*QueryTotalRewardByEpochResponse, error)
+	Metrics(context.Context, *QueryMetricsRequest) (*QueryMetricsResponse, error)
 }
 
-func (m *RewardByWallet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ImmatureTotalReward) > 0 {
-		for iNdEx := len(m.ImmatureTotalReward) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ImmatureTotalReward[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.MatureTotalReward) > 0 {
-		for iNdEx := len(m.MatureTotalReward) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.MatureTotalReward[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.WalletAddress) > 0 {
-		i -= len(m.WalletAddress)
-		copy(dAtA[i:], m.WalletAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.WalletAddress)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *QuerySlashingByOwnerRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) VolumeReport(ctx context.Context, req *QueryVolumeReportRequest) (*QueryVolumeReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VolumeReport not implemented")
+}
+func (*UnimplementedQueryServer) PendingVolumeReport(ctx context.Context, req *QueryPendingVolumeReportRequest) (*QueryPendingVolumeReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingVolumeReport not implemented")
+}
+func (*UnimplementedQueryServer) DistributionProgress(ctx context.Context, req *QueryDistributionProgressRequest) (*QueryDistributionProgressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DistributionProgress not implemented")
+}
+func (*UnimplementedQueryServer) VolumeReportDispute(ctx context.Context, req *QueryVolumeReportDisputeRequest) (*QueryVolumeReportDisputeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VolumeReportDispute not implemented")
+}
+func (*UnimplementedQueryServer) RewardsByEpoch(ctx context.Context, req *QueryRewardsByEpochRequest) (*QueryRewardsByEpochResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RewardsByEpoch not implemented")
+}
+func (*UnimplementedQueryServer) RewardsByWallet(ctx context.Context, req *QueryRewardsByWalletRequest) (*QueryRewardsByWalletResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RewardsByWallet not implemented")
+}
+func (*UnimplementedQueryServer) RewardsByWalletAndEpoch(ctx context.Context, req *QueryRewardsByWalletAndEpochRequest) (*QueryRewardsByWalletAndEpochResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RewardsByWalletAndEpoch not implemented")
+}
+func (*UnimplementedQueryServer) VestingSchedule(ctx context.Context, req *QueryVestingScheduleRequest) (*QueryVestingScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VestingSchedule not implemented")
+}
+func (*UnimplementedQueryServer) RewardHistory(ctx context.Context, req *QueryRewardHistoryRequest) (*QueryRewardHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RewardHistory not implemented")
+}
+func (*UnimplementedQueryServer) AutoRestake(ctx context.Context, req *QueryAutoRestakeRequest) (*QueryAutoRestakeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AutoRestake not implemented")
+}
+func (*UnimplementedQueryServer) SlashingByOwner(ctx context.Context, req *QuerySlashingByOwnerRequest) (*QuerySlashingByOwnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SlashingByOwner not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) TotalMinedToken(ctx context.Context, req *QueryTotalMinedTokenRequest) (*QueryTotalMinedTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotalMinedToken not implemented")
+}
+func (*UnimplementedQueryServer) CirculationSupply(ctx context.Context, req *QueryCirculationSupplyRequest) (*QueryCirculationSupplyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CirculationSupply not implemented")
+}
+func (*UnimplementedQueryServer) TotalRewardByEpoch(ctx context.Context, req *QueryTotalRewardByEpochRequest) (*QueryTotalRewardByEpochResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotalRewardByEpoch not implemented")
+}
+func (*UnimplementedQueryServer) Metrics(ctx context.Context, req *QueryMetricsRequest) (*QueryMetricsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Metrics not implemented")
 }
 
-func (m *QuerySlashingByOwnerRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
-func (m *QuerySlashingByOwnerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.WalletAddress) > 0 {
-		i -= len(m.WalletAddress)
-		copy(dAtA[i:], m.WalletAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.WalletAddress)))
-		i--
-		dAtA[i] = 0xa
+func _Query_VolumeReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVolumeReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).VolumeReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stratos.pot.v1.Query/VolumeReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VolumeReport(ctx, req.(*QueryVolumeReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QuerySlashingByOwnerResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_PendingVolumeReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingVolumeReportRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).PendingVolumeReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stratos.pot.v1.Query/PendingVolumeReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingVolumeReport(ctx, req.(*QueryPendingVolumeReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QuerySlashingByOwnerResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_DistributionProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDistributionProgressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DistributionProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stratos.pot.v1.Query/DistributionProgress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DistributionProgress(ctx, req.(*QueryDistributionProgressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QuerySlashingByOwnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Slashing) > 0 {
-		i -= len(m.Slashing)
-		copy(dAtA[i:], m.Slashing)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Slashing)))
-		i--
-		dAtA[i] = 0xa
+func _Query_VolumeReportDispute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVolumeReportDisputeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).VolumeReportDispute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stratos.pot.v1.Query/VolumeReportDispute",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VolumeReportDispute(ctx, req.(*QueryVolumeReportDisputeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryTotalMinedTokenRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_RewardsByEpoch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRewardsByEpochRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryTotalMinedTokenRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryTotalMinedTokenRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).RewardsByEpoch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stratos.pot.v1.Query/RewardsByEpoch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RewardsByEpoch(ctx, req.(*QueryRewardsByEpochRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryTotalMinedTokenResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_RewardsByWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRewardsByWalletRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryTotalMinedTokenResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryTotalMinedTokenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.TotalMinedToken.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+	if interceptor == nil {
+		return srv.(QueryServer).RewardsByWallet(ctx, in)
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stratos.pot.v1.Query/RewardsByWallet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RewardsByWallet(ctx, req.(*QueryRewardsByWalletRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryCirculationSupplyRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_RewardsByWalletAndEpoch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRewardsByWalletAndEpochRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).RewardsByWalletAndEpoch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stratos.pot.v1.Query/RewardsByWalletAndEpoch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RewardsByWalletAndEpoch(ctx, req.(*QueryRewardsByWalletAndEpochRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryCirculationSupplyRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_VestingSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVestingScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VestingSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stratos.pot.v1.Query/VestingSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VestingSchedule(ctx, req.(*QueryVestingScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryCirculationSupplyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Query_RewardHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRewardHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RewardHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stratos.pot.v1.Query/RewardHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RewardHistory(ctx, req.(*QueryRewardHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryCirculationSupplyResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_AutoRestake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAutoRestakeRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).AutoRestake(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stratos.pot.v1.Query/AutoRestake",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AutoRestake(ctx, req.(*QueryAutoRestakeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryCirculationSupplyResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_SlashingByOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySlashingByOwnerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SlashingByOwner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stratos.pot.v1.Query/SlashingByOwner",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SlashingByOwner(ctx, req.(*QuerySlashingByOwnerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryCirculationSupplyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.CirculationSupply) > 0 {
-		for iNdEx := len(m.CirculationSupply) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.CirculationSupply[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stratos.pot.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryTotalRewardByEpochRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_TotalMinedToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTotalMinedTokenRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).TotalMinedToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stratos.pot.v1.Query/TotalMinedToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TotalMinedToken(ctx, req.(*QueryTotalMinedTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryTotalRewardByEpochRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_CirculationSupply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCirculationSupplyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CirculationSupply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stratos.pot.v1.Query/CirculationSupply",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CirculationSupply(ctx, req.(*QueryCirculationSupplyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryTotalRewardByEpochRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
+func _Query_TotalRewardByEpoch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTotalRewardByEpochRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TotalRewardByEpoch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stratos.pot.v1.Query/TotalRewardByEpoch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TotalRewardByEpoch(ctx, req.(*QueryTotalRewardByEpochRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Metrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMetricsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Metrics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stratos.pot.v1.Query/Metrics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Metrics(ctx, req.(*QueryMetricsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "stratos.pot.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "VolumeReport",
+			Handler:    _Query_VolumeReport_Handler,
+		},
+		{
+			MethodName: "PendingVolumeReport",
+			Handler:    _Query_PendingVolumeReport_Handler,
+		},
+		{
+			MethodName: "DistributionProgress",
+			Handler:    _Query_DistributionProgress_Handler,
+		},
+		{
+			MethodName: "VolumeReportDispute",
+			Handler:    _Query_VolumeReportDispute_Handler,
+		},
+		{
+			MethodName: "RewardsByEpoch",
+			Handler:    _Query_RewardsByEpoch_Handler,
+		},
+		{
+			MethodName: "RewardsByWallet",
+			Handler:    _Query_RewardsByWallet_Handler,
+		},
+		{
+			MethodName: "RewardsByWalletAndEpoch",
+			Handler:    _Query_RewardsByWalletAndEpoch_Handler,
+		},
+		{
+			MethodName: "VestingSchedule",
+			Handler:    _Query_VestingSchedule_Handler,
+		},
+		{
+			MethodName: "RewardHistory",
+			Handler:    _Query_RewardHistory_Handler,
+		},
+		{
+			MethodName: "AutoRestake",
+			Handler:    _Query_AutoRestake_Handler,
+		},
+		{
+			MethodName: "SlashingByOwner",
+			Handler:    _Query_SlashingByOwner_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "TotalMinedToken",
+			Handler:    _Query_TotalMinedToken_Handler,
+		},
+		{
+			MethodName: "CirculationSupply",
+			Handler:    _Query_CirculationSupply_Handler,
+		},
+		{
+			MethodName: "TotalRewardByEpoch",
+			Handler:    _Query_TotalRewardByEpoch_Handler,
+		},
+		{
+			MethodName: "Metrics",
+			Handler:    _Query_Metrics_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "stratos/pot/v1/query.proto",
+}
+
+func (m *QueryVolumeReportRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVolumeReportRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVolumeReportRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
@@ -2276,7 +2591,7 @@ func (m *QueryTotalRewardByEpochRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryTotalRewardByEpochResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryVolumeReportResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2286,40 +2601,32 @@ func (m *QueryTotalRewardByEpochResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryTotalRewardByEpochResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryVolumeReportResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTotalRewardByEpochResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryVolumeReportResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.IsLegacy {
-		i--
-		if m.IsLegacy {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if m.ReportInfo != nil {
+		{
+			size, err := m.ReportInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x10
-	}
-	{
-		size, err := m.TotalReward.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		dAtA[i] = 0xa
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryMetricsRequest) Marshal() (dAtA []byte, err error) {
+func (m *ReportInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2329,20 +2636,46 @@ func (m *QueryMetricsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryMetricsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ReportInfo) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryMetricsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ReportInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Reporter) > 0 {
+		i -= len(m.Reporter)
+		copy(dAtA[i:], m.Reporter)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Reporter)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Reference) > 0 {
+		i -= len(m.Reference)
+		copy(dAtA[i:], m.Reference)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Reference)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryMetricsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2352,359 +2685,3339 @@ func (m *QueryMetricsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryMetricsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryMetricsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Metrics.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryVolumeReportRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Epoch != 0 {
-		n += 1 + sovQuery(uint64(m.Epoch))
-	}
-	return n
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryVolumeReportResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ReportInfo != nil {
-		l = m.ReportInfo.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Params != nil {
+		{
+			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ReportInfo) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryRewardsByEpochRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardsByEpochRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardsByEpochRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Epoch != 0 {
-		n += 1 + sovQuery(uint64(m.Epoch))
-	}
-	l = len(m.Reference)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.TxHash)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Reporter)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Epoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryRewardsByEpochResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Params != nil {
-		l = m.Params.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryRewardsByEpochResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryRewardsByEpochRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryRewardsByEpochResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Epoch != 0 {
-		n += 1 + sovQuery(uint64(m.Epoch))
-	}
 	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QueryRewardsByEpochResponse) Size() (n int) {
-	if m == nil {
-		return 0
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	var l int
-	_ = l
 	if len(m.Rewards) > 0 {
-		for _, e := range m.Rewards {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryRewardsByWalletRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryRewardsByWalletRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardsByWalletRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardsByWalletRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.WalletAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.WalletAddress) > 0 {
+		i -= len(m.WalletAddress)
+		copy(dAtA[i:], m.WalletAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.WalletAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryRewardsByWalletResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryRewardsByWalletResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardsByWalletResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardsByWalletResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.Rewards != nil {
-		l = m.Rewards.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Rewards.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryRewardsByWalletAndEpochRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryRewardsByWalletAndEpochRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardsByWalletAndEpochRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardsByWalletAndEpochRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.WalletAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
 	if m.Epoch != 0 {
-		n += 1 + sovQuery(uint64(m.Epoch))
+		i = encodeVarintQuery(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.WalletAddress) > 0 {
+		i -= len(m.WalletAddress)
+		copy(dAtA[i:], m.WalletAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.WalletAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryRewardsByWalletAndEpochResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Rewards) > 0 {
-		for _, e := range m.Rewards {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryRewardsByWalletAndEpochResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *RewardByWallet) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryRewardsByWalletAndEpochResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardsByWalletAndEpochResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.WalletAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if len(m.MatureTotalReward) > 0 {
-		for _, e := range m.MatureTotalReward {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.ImmatureTotalReward) > 0 {
-		for _, e := range m.ImmatureTotalReward {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QuerySlashingByOwnerRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RewardByWallet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RewardByWallet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RewardByWallet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.WalletAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.ImmatureTotalReward) > 0 {
+		for iNdEx := len(m.ImmatureTotalReward) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ImmatureTotalReward[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	return n
+	if len(m.MatureTotalReward) > 0 {
+		for iNdEx := len(m.MatureTotalReward) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MatureTotalReward[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.WalletAddress) > 0 {
+		i -= len(m.WalletAddress)
+		copy(dAtA[i:], m.WalletAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.WalletAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QuerySlashingByOwnerResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySlashingByOwnerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySlashingByOwnerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySlashingByOwnerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Slashing)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.WalletAddress) > 0 {
+		i -= len(m.WalletAddress)
+		copy(dAtA[i:], m.WalletAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.WalletAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryTotalMinedTokenRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySlashingByOwnerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryTotalMinedTokenResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QuerySlashingByOwnerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySlashingByOwnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.TotalMinedToken.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if len(m.Slashing) > 0 {
+		i -= len(m.Slashing)
+		copy(dAtA[i:], m.Slashing)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Slashing)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryCirculationSupplyRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryTotalMinedTokenRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalMinedTokenRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalMinedTokenRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryCirculationSupplyResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryTotalMinedTokenResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalMinedTokenResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalMinedTokenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.CirculationSupply) > 0 {
-		for _, e := range m.CirculationSupply {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.TotalMinedToken.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryTotalRewardByEpochRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryCirculationSupplyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCirculationSupplyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCirculationSupplyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Epoch != 0 {
-		n += 1 + sovQuery(uint64(m.Epoch))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryTotalRewardByEpochResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryCirculationSupplyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCirculationSupplyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCirculationSupplyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CirculationSupply) > 0 {
+		for iNdEx := len(m.CirculationSupply) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CirculationSupply[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalRewardByEpochRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalRewardByEpochRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalRewardByEpochRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalRewardByEpochResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalRewardByEpochResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalRewardByEpochResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.TotalReward.Size()
-	n += 1 + l + sovQuery(uint64(l))
 	if m.IsLegacy {
-		n += 2
+		i--
+		if m.IsLegacy {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	{
+		size, err := m.TotalReward.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryMetricsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryMetricsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMetricsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMetricsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMetricsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMetricsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMetricsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Metrics.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingVolumeReportRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingVolumeReportRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingVolumeReportRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingVolumeReportResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingVolumeReportResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingVolumeReportResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Report != nil {
+		{
+			size, err := m.Report.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVolumeReportDisputeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVolumeReportDisputeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVolumeReportDisputeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVolumeReportDisputeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVolumeReportDisputeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVolumeReportDisputeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Dispute != nil {
+		{
+			size, err := m.Dispute.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDistributionProgressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDistributionProgressRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDistributionProgressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDistributionProgressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDistributionProgressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDistributionProgressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Distributions) > 0 {
+		for iNdEx := len(m.Distributions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Distributions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size := m.LastDistributedEpoch.Size()
+		i -= size
+		if _, err := m.LastDistributedEpoch.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVestingScheduleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVestingScheduleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVestingScheduleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.WalletAddress) > 0 {
+		i -= len(m.WalletAddress)
+		copy(dAtA[i:], m.WalletAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.WalletAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVestingScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVestingScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVestingScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LinearVesting != nil {
+		{
+			size, err := m.LinearVesting.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.ImmatureTotalReward) > 0 {
+		for iNdEx := len(m.ImmatureTotalReward) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ImmatureTotalReward[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.MatureTotalReward) > 0 {
+		for iNdEx := len(m.MatureTotalReward) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MatureTotalReward[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.MaturedEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaturedEpoch))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.VestingMode) > 0 {
+		i -= len(m.VestingMode)
+		copy(dAtA[i:], m.VestingMode)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.VestingMode)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.WalletAddress) > 0 {
+		i -= len(m.WalletAddress)
+		copy(dAtA[i:], m.WalletAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.WalletAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VestingScheduleEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VestingScheduleEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VestingScheduleEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RewardFromTrafficPool) > 0 {
+		for iNdEx := len(m.RewardFromTrafficPool) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardFromTrafficPool[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.RewardFromMiningPool) > 0 {
+		for iNdEx := len(m.RewardFromMiningPool) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardFromMiningPool[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.MatureEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MatureEpoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRewardHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.EndEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndEpoch))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartEpoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.WalletAddress) > 0 {
+		i -= len(m.WalletAddress)
+		copy(dAtA[i:], m.WalletAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.WalletAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRewardHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Histories) > 0 {
+		for iNdEx := len(m.Histories) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Histories[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAutoRestakeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAutoRestakeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAutoRestakeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WalletAddress) > 0 {
+		i -= len(m.WalletAddress)
+		copy(dAtA[i:], m.WalletAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.WalletAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAutoRestakeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAutoRestakeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAutoRestakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AutoRestake != nil {
+		{
+			size, err := m.AutoRestake.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryVolumeReportRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovQuery(uint64(m.Epoch))
+	}
+	return n
+}
+
+func (m *QueryVolumeReportResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ReportInfo != nil {
+		l = m.ReportInfo.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ReportInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovQuery(uint64(m.Epoch))
+	}
+	l = len(m.Reference)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Reporter)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRewardsByEpochRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovQuery(uint64(m.Epoch))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRewardsByEpochResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRewardsByWalletRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.WalletAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRewardsByWalletResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Rewards != nil {
+		l = m.Rewards.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRewardsByWalletAndEpochRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.WalletAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovQuery(uint64(m.Epoch))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRewardsByWalletAndEpochResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *RewardByWallet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.WalletAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.MatureTotalReward) > 0 {
+		for _, e := range m.MatureTotalReward {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.ImmatureTotalReward) > 0 {
+		for _, e := range m.ImmatureTotalReward {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuerySlashingByOwnerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.WalletAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySlashingByOwnerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Slashing)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTotalMinedTokenRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryTotalMinedTokenResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TotalMinedToken.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryCirculationSupplyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryCirculationSupplyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CirculationSupply) > 0 {
+		for _, e := range m.CirculationSupply {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryTotalRewardByEpochRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovQuery(uint64(m.Epoch))
+	}
+	return n
+}
+
+func (m *QueryTotalRewardByEpochResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TotalReward.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.IsLegacy {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryMetricsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryMetricsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Metrics.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPendingVolumeReportRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPendingVolumeReportResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Report != nil {
+		l = m.Report.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVolumeReportDisputeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovQuery(uint64(m.Epoch))
+	}
+	return n
+}
+
+func (m *QueryVolumeReportDisputeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Dispute != nil {
+		l = m.Dispute.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDistributionProgressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryDistributionProgressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.LastDistributedEpoch.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Distributions) > 0 {
+		for _, e := range m.Distributions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryVestingScheduleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.WalletAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVestingScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.WalletAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.VestingMode)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.MaturedEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.MaturedEpoch))
+	}
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.MatureTotalReward) > 0 {
+		for _, e := range m.MatureTotalReward {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.ImmatureTotalReward) > 0 {
+		for _, e := range m.ImmatureTotalReward {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.LinearVesting != nil {
+		l = m.LinearVesting.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *VestingScheduleEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MatureEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.MatureEpoch))
+	}
+	if len(m.RewardFromMiningPool) > 0 {
+		for _, e := range m.RewardFromMiningPool {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.RewardFromTrafficPool) > 0 {
+		for _, e := range m.RewardFromTrafficPool {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryRewardHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.WalletAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.StartEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.StartEpoch))
+	}
+	if m.EndEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.EndEpoch))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRewardHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Histories) > 0 {
+		for _, e := range m.Histories {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAutoRestakeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.WalletAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAutoRestakeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AutoRestake != nil {
+		l = m.AutoRestake.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryVolumeReportRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVolumeReportRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVolumeReportRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVolumeReportResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVolumeReportResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVolumeReportResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReportInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ReportInfo == nil {
+				m.ReportInfo = &ReportInfo{}
+			}
+			if err := m.ReportInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReportInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReportInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReportInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reference", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reference = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reporter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reporter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Params == nil {
+				m.Params = &Params{}
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRewardsByEpochRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRewardsByEpochRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRewardsByEpochRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRewardsByEpochResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRewardsByEpochResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRewardsByEpochResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, &Reward{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRewardsByWalletRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRewardsByWalletRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRewardsByWalletRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WalletAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WalletAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRewardsByWalletResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRewardsByWalletResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRewardsByWalletResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Rewards == nil {
+				m.Rewards = &RewardByWallet{}
+			}
+			if err := m.Rewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRewardsByWalletAndEpochRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRewardsByWalletAndEpochRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRewardsByWalletAndEpochRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WalletAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WalletAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRewardsByWalletAndEpochResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRewardsByWalletAndEpochResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRewardsByWalletAndEpochResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, &Reward{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RewardByWallet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RewardByWallet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RewardByWallet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WalletAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WalletAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MatureTotalReward", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MatureTotalReward = append(m.MatureTotalReward, types.Coin{})
+			if err := m.MatureTotalReward[len(m.MatureTotalReward)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ImmatureTotalReward", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ImmatureTotalReward = append(m.ImmatureTotalReward, types.Coin{})
+			if err := m.ImmatureTotalReward[len(m.ImmatureTotalReward)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySlashingByOwnerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySlashingByOwnerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySlashingByOwnerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WalletAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WalletAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySlashingByOwnerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySlashingByOwnerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySlashingByOwnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slashing", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Slashing = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTotalMinedTokenRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalMinedTokenRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalMinedTokenRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTotalMinedTokenResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalMinedTokenResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalMinedTokenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalMinedToken", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalMinedToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryMetricsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Metrics.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryVolumeReportRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCirculationSupplyRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2727,31 +6040,12 @@ func (m *QueryVolumeReportRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVolumeReportRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCirculationSupplyRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVolumeReportRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCirculationSupplyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
-			}
-			m.Epoch = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Epoch |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2773,7 +6067,7 @@ func (m *QueryVolumeReportRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVolumeReportResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCirculationSupplyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2796,15 +6090,15 @@ func (m *QueryVolumeReportResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVolumeReportResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCirculationSupplyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVolumeReportResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCirculationSupplyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReportInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CirculationSupply", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2831,10 +6125,8 @@ func (m *QueryVolumeReportResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ReportInfo == nil {
-				m.ReportInfo = &ReportInfo{}
-			}
-			if err := m.ReportInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.CirculationSupply = append(m.CirculationSupply, types.Coin{})
+			if err := m.CirculationSupply[len(m.CirculationSupply)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2859,7 +6151,7 @@ func (m *QueryVolumeReportResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReportInfo) Unmarshal(dAtA []byte) error {
+func (m *QueryTotalRewardByEpochRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2882,10 +6174,10 @@ func (m *ReportInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReportInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTotalRewardByEpochRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReportInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTotalRewardByEpochRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2907,43 +6199,61 @@ func (m *ReportInfo) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reference", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTotalRewardByEpochResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reference = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalRewardByEpochResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalRewardByEpochResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalReward", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2953,29 +6263,30 @@ func (m *ReportInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TxHash = string(dAtA[iNdEx:postIndex])
+			if err := m.TotalReward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reporter", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsLegacy", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2985,24 +6296,12 @@ func (m *ReportInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Reporter = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.IsLegacy = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3024,7 +6323,7 @@ func (m *ReportInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryMetricsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3047,10 +6346,10 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMetricsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMetricsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3074,7 +6373,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryMetricsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3097,15 +6396,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMetricsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMetricsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metrics", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3132,13 +6431,60 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Params == nil {
-				m.Params = &Params{}
-			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if err := m.Metrics.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingVolumeReportRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingVolumeReportRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingVolumeReportRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3160,7 +6506,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRewardsByEpochRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPendingVolumeReportResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3183,34 +6529,15 @@ func (m *QueryRewardsByEpochRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRewardsByEpochRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPendingVolumeReportResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRewardsByEpochRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPendingVolumeReportResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
-			}
-			m.Epoch = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Epoch |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Report", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3237,10 +6564,10 @@ func (m *QueryRewardsByEpochRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+			if m.Report == nil {
+				m.Report = &PendingVolumeReport{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Report.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3265,7 +6592,7 @@ func (m *QueryRewardsByEpochRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRewardsByEpochResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryVolumeReportDisputeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3288,51 +6615,17 @@ func (m *QueryRewardsByEpochResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRewardsByEpochResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVolumeReportDisputeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRewardsByEpochResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVolumeReportDisputeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Rewards = append(m.Rewards, &Reward{})
-			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
 			}
-			var msglen int
+			m.Epoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3342,28 +6635,11 @@ func (m *QueryRewardsByEpochResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Epoch |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3385,7 +6661,7 @@ func (m *QueryRewardsByEpochResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRewardsByWalletRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryVolumeReportDisputeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3408,17 +6684,17 @@ func (m *QueryRewardsByWalletRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRewardsByWalletRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVolumeReportDisputeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRewardsByWalletRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVolumeReportDisputeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WalletAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Dispute", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3428,23 +6704,27 @@ func (m *QueryRewardsByWalletRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WalletAddress = string(dAtA[iNdEx:postIndex])
+			if m.Dispute == nil {
+				m.Dispute = &VolumeReportDispute{}
+			}
+			if err := m.Dispute.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3467,7 +6747,7 @@ func (m *QueryRewardsByWalletRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRewardsByWalletResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDistributionProgressRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3490,48 +6770,12 @@ func (m *QueryRewardsByWalletResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRewardsByWalletResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDistributionProgressRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRewardsByWalletResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDistributionProgressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Rewards == nil {
-				m.Rewards = &RewardByWallet{}
-			}
-			if err := m.Rewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3553,7 +6797,7 @@ func (m *QueryRewardsByWalletResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRewardsByWalletAndEpochRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDistributionProgressResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3576,15 +6820,15 @@ func (m *QueryRewardsByWalletAndEpochRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRewardsByWalletAndEpochRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDistributionProgressResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRewardsByWalletAndEpochRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDistributionProgressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WalletAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastDistributedEpoch", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3612,30 +6856,13 @@ func (m *QueryRewardsByWalletAndEpochRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WalletAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.LastDistributedEpoch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
-			}
-			m.Epoch = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Epoch |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Distributions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3662,10 +6889,8 @@ func (m *QueryRewardsByWalletAndEpochRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Distributions = append(m.Distributions, PendingDistribution{})
+			if err := m.Distributions[len(m.Distributions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3690,7 +6915,7 @@ func (m *QueryRewardsByWalletAndEpochRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRewardsByWalletAndEpochResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryVestingScheduleRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3713,17 +6938,17 @@ func (m *QueryRewardsByWalletAndEpochResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRewardsByWalletAndEpochResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVestingScheduleRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRewardsByWalletAndEpochResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVestingScheduleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WalletAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3733,25 +6958,23 @@ func (m *QueryRewardsByWalletAndEpochResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Rewards = append(m.Rewards, &Reward{})
-			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.WalletAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -3783,7 +7006,7 @@ func (m *QueryRewardsByWalletAndEpochResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+				m.Pagination = &query.PageRequest{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -3810,7 +7033,7 @@ func (m *QueryRewardsByWalletAndEpochResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RewardByWallet) Unmarshal(dAtA []byte) error {
+func (m *QueryVestingScheduleResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3833,10 +7056,10 @@ func (m *RewardByWallet) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RewardByWallet: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVestingScheduleResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RewardByWallet: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVestingScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3869,11 +7092,62 @@ func (m *RewardByWallet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WalletAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			m.WalletAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingMode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingMode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaturedEpoch", wireType)
+			}
+			m.MaturedEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaturedEpoch |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MatureTotalReward", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3900,14 +7174,14 @@ func (m *RewardByWallet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MatureTotalReward = append(m.MatureTotalReward, types.Coin{})
-			if err := m.MatureTotalReward[len(m.MatureTotalReward)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Entries = append(m.Entries, VestingScheduleEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ImmatureTotalReward", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MatureTotalReward", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3934,66 +7208,16 @@ func (m *RewardByWallet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ImmatureTotalReward = append(m.ImmatureTotalReward, types.Coin{})
-			if err := m.ImmatureTotalReward[len(m.ImmatureTotalReward)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.MatureTotalReward = append(m.MatureTotalReward, types.Coin{})
+			if err := m.MatureTotalReward[len(m.MatureTotalReward)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QuerySlashingByOwnerRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySlashingByOwnerRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySlashingByOwnerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WalletAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ImmatureTotalReward", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4003,79 +7227,31 @@ func (m *QuerySlashingByOwnerRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WalletAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			m.ImmatureTotalReward = append(m.ImmatureTotalReward, types.Coin{})
+			if err := m.ImmatureTotalReward[len(m.ImmatureTotalReward)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QuerySlashingByOwnerResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySlashingByOwnerResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySlashingByOwnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Slashing", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4085,127 +7261,31 @@ func (m *QuerySlashingByOwnerResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Slashing = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryTotalMinedTokenRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalMinedTokenRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalMinedTokenRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryTotalMinedTokenResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalMinedTokenResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalMinedTokenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			}
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalMinedToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LinearVesting", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4232,7 +7312,10 @@ func (m *QueryTotalMinedTokenResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalMinedToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.LinearVesting == nil {
+				m.LinearVesting = &VestingSchedule{}
+			}
+			if err := m.LinearVesting.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4257,7 +7340,7 @@ func (m *QueryTotalMinedTokenResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCirculationSupplyRequest) Unmarshal(dAtA []byte) error {
+func (m *VestingScheduleEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4280,65 +7363,68 @@ func (m *QueryCirculationSupplyRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCirculationSupplyRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: VestingScheduleEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCirculationSupplyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VestingScheduleEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MatureEpoch", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			m.MatureEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MatureEpoch |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardFromMiningPool", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryCirculationSupplyResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.RewardFromMiningPool = append(m.RewardFromMiningPool, types.Coin{})
+			if err := m.RewardFromMiningPool[len(m.RewardFromMiningPool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCirculationSupplyResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCirculationSupplyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CirculationSupply", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardFromTrafficPool", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4365,8 +7451,8 @@ func (m *QueryCirculationSupplyResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CirculationSupply = append(m.CirculationSupply, types.Coin{})
-			if err := m.CirculationSupply[len(m.CirculationSupply)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RewardFromTrafficPool = append(m.RewardFromTrafficPool, types.Coin{})
+			if err := m.RewardFromTrafficPool[len(m.RewardFromTrafficPool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4391,7 +7477,7 @@ func (m *QueryCirculationSupplyResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTotalRewardByEpochRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRewardHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4414,17 +7500,49 @@ func (m *QueryTotalRewardByEpochRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalRewardByEpochRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRewardHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalRewardByEpochRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRewardHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WalletAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WalletAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartEpoch", wireType)
 			}
-			m.Epoch = 0
+			m.StartEpoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4434,11 +7552,66 @@ func (m *QueryTotalRewardByEpochRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Epoch |= int64(b&0x7F) << shift
+				m.StartEpoch |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndEpoch", wireType)
+			}
+			m.EndEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndEpoch |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4460,7 +7633,7 @@ func (m *QueryTotalRewardByEpochRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTotalRewardByEpochResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRewardHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4483,15 +7656,15 @@ func (m *QueryTotalRewardByEpochResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalRewardByEpochResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRewardHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalRewardByEpochResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRewardHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalReward", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Histories", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4518,15 +7691,16 @@ func (m *QueryTotalRewardByEpochResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalReward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Histories = append(m.Histories, RewardHistory{})
+			if err := m.Histories[len(m.Histories)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsLegacy", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4536,12 +7710,28 @@ func (m *QueryTotalRewardByEpochResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsLegacy = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4563,7 +7753,7 @@ func (m *QueryTotalRewardByEpochResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMetricsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAutoRestakeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4586,12 +7776,44 @@ func (m *QueryMetricsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMetricsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAutoRestakeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMetricsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAutoRestakeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WalletAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WalletAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4613,7 +7835,7 @@ func (m *QueryMetricsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMetricsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAutoRestakeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4636,15 +7858,15 @@ func (m *QueryMetricsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMetricsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAutoRestakeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMetricsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAutoRestakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metrics", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoRestake", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4671,7 +7893,10 @@ func (m *QueryMetricsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Metrics.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.AutoRestake == nil {
+				m.AutoRestake = &AutoRestake{}
+			}
+			if err := m.AutoRestake.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -149,6 +149,257 @@ func (m *MsgVolumeReportResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgVolumeReportResponse proto.InternalMessageInfo
 
+// MsgVolumeReportHeader starts a volume report submitted in chunks. It is BLS-signed once by the meta nodes
+// and commits to all the wallet volumes of the epoch with their merkle root.
+type MsgVolumeReportHeader struct {
+	Reporter        string                                 `protobuf:"bytes,1,opt,name=reporter,proto3" json:"reporter" yaml:"reporter"`
+	Epoch           github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=epoch,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"epoch" yaml:"epoch"`
+	ReportReference string                                 `protobuf:"bytes,3,opt,name=report_reference,json=reportReference,proto3" json:"report_reference" yaml:"report_reference"`
+	ReporterOwner   string                                 `protobuf:"bytes,4,opt,name=reporter_owner,json=reporterOwner,proto3" json:"reporter_owner" yaml:"reporter_owner"`
+	// total_count is the number of wallet volumes in the report
+	TotalCount uint64 `protobuf:"varint,5,opt,name=total_count,json=totalCount,proto3" json:"total_count" yaml:"total_count"`
+	// volumes_root is the merkle root of the wallet volumes
+	VolumesRoot  []byte           `protobuf:"bytes,6,opt,name=volumes_root,json=volumesRoot,proto3" json:"volumes_root" yaml:"volumes_root"`
+	BLSSignature BLSSignatureInfo `protobuf:"bytes,7,opt,name=BLS_signature,json=BLSSignature,proto3" json:"bls_signature" yaml:"bls_signature"`
+}
+
+func (m *MsgVolumeReportHeader) Reset()         { *m = MsgVolumeReportHeader{} }
+func (m *MsgVolumeReportHeader) String() string { return proto.CompactTextString(m) }
+func (*MsgVolumeReportHeader) ProtoMessage()    {}
+func (*MsgVolumeReportHeader) Descriptor() ([]byte, []int) {
+	return fileDescriptor_103c258cace119ca, []int{2}
+}
+func (m *MsgVolumeReportHeader) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgVolumeReportHeader) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVolumeReportHeader.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgVolumeReportHeader) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVolumeReportHeader.Merge(m, src)
+}
+func (m *MsgVolumeReportHeader) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVolumeReportHeader) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVolumeReportHeader.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgVolumeReportHeader proto.InternalMessageInfo
+
+func (m *MsgVolumeReportHeader) GetReporter() string {
+	if m != nil {
+		return m.Reporter
+	}
+	return ""
+}
+
+func (m *MsgVolumeReportHeader) GetReportReference() string {
+	if m != nil {
+		return m.ReportReference
+	}
+	return ""
+}
+
+func (m *MsgVolumeReportHeader) GetReporterOwner() string {
+	if m != nil {
+		return m.ReporterOwner
+	}
+	return ""
+}
+
+func (m *MsgVolumeReportHeader) GetTotalCount() uint64 {
+	if m != nil {
+		return m.TotalCount
+	}
+	return 0
+}
+
+func (m *MsgVolumeReportHeader) GetVolumesRoot() []byte {
+	if m != nil {
+		return m.VolumesRoot
+	}
+	return nil
+}
+
+func (m *MsgVolumeReportHeader) GetBLSSignature() BLSSignatureInfo {
+	if m != nil {
+		return m.BLSSignature
+	}
+	return BLSSignatureInfo{}
+}
+
+// MsgVolumeReportHeaderResponse defines the MsgVolumeReportHeader response type
+type MsgVolumeReportHeaderResponse struct {
+}
+
+func (m *MsgVolumeReportHeaderResponse) Reset()         { *m = MsgVolumeReportHeaderResponse{} }
+func (m *MsgVolumeReportHeaderResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgVolumeReportHeaderResponse) ProtoMessage()    {}
+func (*MsgVolumeReportHeaderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_103c258cace119ca, []int{3}
+}
+func (m *MsgVolumeReportHeaderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgVolumeReportHeaderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVolumeReportHeaderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgVolumeReportHeaderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVolumeReportHeaderResponse.Merge(m, src)
+}
+func (m *MsgVolumeReportHeaderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVolumeReportHeaderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVolumeReportHeaderResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgVolumeReportHeaderResponse proto.InternalMessageInfo
+
+// MsgVolumeReportChunk carries a slice of the wallet volumes of a chunked volume report, each with its inclusion proof
+type MsgVolumeReportChunk struct {
+	Reporter      string                                 `protobuf:"bytes,1,opt,name=reporter,proto3" json:"reporter" yaml:"reporter"`
+	ReporterOwner string                                 `protobuf:"bytes,2,opt,name=reporter_owner,json=reporterOwner,proto3" json:"reporter_owner" yaml:"reporter_owner"`
+	Epoch         github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=epoch,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"epoch" yaml:"epoch"`
+	// start_index is the index of the first wallet volume of the chunk in the report
+	StartIndex    uint64                 `protobuf:"varint,4,opt,name=start_index,json=startIndex,proto3" json:"start_index" yaml:"start_index"`
+	WalletVolumes []SingleWalletVolume   `protobuf:"bytes,5,rep,name=wallet_volumes,json=walletVolumes,proto3" json:"wallet_volumes" yaml:"wallet_volumes"`
+	Proofs        []VolumeInclusionProof `protobuf:"bytes,6,rep,name=proofs,proto3" json:"proofs" yaml:"proofs"`
+}
+
+func (m *MsgVolumeReportChunk) Reset()         { *m = MsgVolumeReportChunk{} }
+func (m *MsgVolumeReportChunk) String() string { return proto.CompactTextString(m) }
+func (*MsgVolumeReportChunk) ProtoMessage()    {}
+func (*MsgVolumeReportChunk) Descriptor() ([]byte, []int) {
+	return fileDescriptor_103c258cace119ca, []int{4}
+}
+func (m *MsgVolumeReportChunk) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgVolumeReportChunk) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVolumeReportChunk.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgVolumeReportChunk) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVolumeReportChunk.Merge(m, src)
+}
+func (m *MsgVolumeReportChunk) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVolumeReportChunk) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVolumeReportChunk.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgVolumeReportChunk proto.InternalMessageInfo
+
+func (m *MsgVolumeReportChunk) GetReporter() string {
+	if m != nil {
+		return m.Reporter
+	}
+	return ""
+}
+
+func (m *MsgVolumeReportChunk) GetReporterOwner() string {
+	if m != nil {
+		return m.ReporterOwner
+	}
+	return ""
+}
+
+func (m *MsgVolumeReportChunk) GetStartIndex() uint64 {
+	if m != nil {
+		return m.StartIndex
+	}
+	return 0
+}
+
+func (m *MsgVolumeReportChunk) GetWalletVolumes() []SingleWalletVolume {
+	if m != nil {
+		return m.WalletVolumes
+	}
+	return nil
+}
+
+func (m *MsgVolumeReportChunk) GetProofs() []VolumeInclusionProof {
+	if m != nil {
+		return m.Proofs
+	}
+	return nil
+}
+
+// MsgVolumeReportChunkResponse defines the MsgVolumeReportChunk response type
+type MsgVolumeReportChunkResponse struct {
+	// finalized is true when the chunk completes the report and the rewards are distributed
+	Finalized bool `protobuf:"varint,1,opt,name=finalized,proto3" json:"finalized,omitempty"`
+}
+
+func (m *MsgVolumeReportChunkResponse) Reset()         { *m = MsgVolumeReportChunkResponse{} }
+func (m *MsgVolumeReportChunkResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgVolumeReportChunkResponse) ProtoMessage()    {}
+func (*MsgVolumeReportChunkResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_103c258cace119ca, []int{5}
+}
+func (m *MsgVolumeReportChunkResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgVolumeReportChunkResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVolumeReportChunkResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgVolumeReportChunkResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVolumeReportChunkResponse.Merge(m, src)
+}
+func (m *MsgVolumeReportChunkResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVolumeReportChunkResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVolumeReportChunkResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgVolumeReportChunkResponse proto.InternalMessageInfo
+
+func (m *MsgVolumeReportChunkResponse) GetFinalized() bool {
+	if m != nil {
+		return m.Finalized
+	}
+	return false
+}
+
 // MsgWithdraw encapsulates an withdraw transaction as an SDK message.
 type MsgWithdraw struct {
 	Amount        github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount" yaml:"amount"`
@@ -160,7 +411,7 @@ func (m *MsgWithdraw) Reset()         { *m = MsgWithdraw{} }
 func (m *MsgWithdraw) String() string { return proto.CompactTextString(m) }
 func (*MsgWithdraw) ProtoMessage()    {}
 func (*MsgWithdraw) Descriptor() ([]byte, []int) {
-	return fileDescriptor_103c258cace119ca, []int{2}
+	return fileDescriptor_103c258cace119ca, []int{6}
 }
 func (m *MsgWithdraw) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -218,7 +469,7 @@ func (m *MsgWithdrawResponse) Reset()         { *m = MsgWithdrawResponse{} }
 func (m *MsgWithdrawResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgWithdrawResponse) ProtoMessage()    {}
 func (*MsgWithdrawResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_103c258cace119ca, []int{3}
+	return fileDescriptor_103c258cace119ca, []int{7}
 }
 func (m *MsgWithdrawResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -257,7 +508,7 @@ func (m *MsgFoundationDeposit) Reset()         { *m = MsgFoundationDeposit{} }
 func (m *MsgFoundationDeposit) String() string { return proto.CompactTextString(m) }
 func (*MsgFoundationDeposit) ProtoMessage()    {}
 func (*MsgFoundationDeposit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_103c258cace119ca, []int{4}
+	return fileDescriptor_103c258cace119ca, []int{8}
 }
 func (m *MsgFoundationDeposit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -308,7 +559,7 @@ func (m *MsgFoundationDepositResponse) Reset()         { *m = MsgFoundationDepos
 func (m *MsgFoundationDepositResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgFoundationDepositResponse) ProtoMessage()    {}
 func (*MsgFoundationDepositResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_103c258cace119ca, []int{5}
+	return fileDescriptor_103c258cace119ca, []int{9}
 }
 func (m *MsgFoundationDepositResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -351,7 +602,7 @@ func (m *MsgSlashingResourceNode) Reset()         { *m = MsgSlashingResourceNode
 func (m *MsgSlashingResourceNode) String() string { return proto.CompactTextString(m) }
 func (*MsgSlashingResourceNode) ProtoMessage()    {}
 func (*MsgSlashingResourceNode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_103c258cace119ca, []int{6}
+	return fileDescriptor_103c258cace119ca, []int{10}
 }
 func (m *MsgSlashingResourceNode) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -423,7 +674,7 @@ func (m *MsgSlashingResourceNodeResponse) Reset()         { *m = MsgSlashingReso
 func (m *MsgSlashingResourceNodeResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSlashingResourceNodeResponse) ProtoMessage()    {}
 func (*MsgSlashingResourceNodeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_103c258cace119ca, []int{7}
+	return fileDescriptor_103c258cace119ca, []int{11}
 }
 func (m *MsgSlashingResourceNodeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -452,24 +703,30 @@ func (m *MsgSlashingResourceNodeResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSlashingResourceNodeResponse proto.InternalMessageInfo
 
-type BLSSignatureInfo struct {
-	PubKeys   [][]byte `protobuf:"bytes,1,rep,name=pub_keys,json=pubKeys,proto3" json:"pub_keys" yaml:"pub_keys"`
-	Signature []byte   `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature" yaml:"signature"`
-	TxData    []byte   `protobuf:"bytes,3,opt,name=txData,proto3" json:"tx_data" yaml:"tx_data"`
-}
-
-func (m *BLSSignatureInfo) Reset()         { *m = BLSSignatureInfo{} }
-func (m *BLSSignatureInfo) String() string { return proto.CompactTextString(m) }
-func (*BLSSignatureInfo) ProtoMessage()    {}
-func (*BLSSignatureInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_103c258cace119ca, []int{8}
-}
-func (m *BLSSignatureInfo) XXX_Unmarshal(b []byte) error {
+// MsgDisputeVolumeReport disputes a volume report during its dispute window with a counter-report, signed by a
+// threshold of the bonded meta nodes when filed by a meta node. The dispute escalates to a governance proposal amending
+// the report with the counter-report, the report is distributed unchanged if the proposal does not pass.
+type MsgDisputeVolumeReport struct {
+	Disputer      string                                 `protobuf:"bytes,1,opt,name=disputer,proto3" json:"disputer" yaml:"disputer"`
+	Reporter      string                                 `protobuf:"bytes,2,opt,name=reporter,proto3" json:"reporter" yaml:"reporter"`
+	Epoch         github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=epoch,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"epoch" yaml:"epoch"`
+	WalletVolumes []SingleWalletVolume                   `protobuf:"bytes,4,rep,name=wallet_volumes,json=walletVolumes,proto3" json:"wallet_volumes" yaml:"wallet_volumes"`
+	Reason        string                                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason" yaml:"reason"`
+	BLSSignature  BLSSignatureInfo                       `protobuf:"bytes,6,opt,name=BLS_signature,json=BLSSignature,proto3" json:"bls_signature" yaml:"bls_signature"`
+}
+
+func (m *MsgDisputeVolumeReport) Reset()         { *m = MsgDisputeVolumeReport{} }
+func (m *MsgDisputeVolumeReport) String() string { return proto.CompactTextString(m) }
+func (*MsgDisputeVolumeReport) ProtoMessage()    {}
+func (*MsgDisputeVolumeReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_103c258cace119ca, []int{12}
+}
+func (m *MsgDisputeVolumeReport) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *BLSSignatureInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgDisputeVolumeReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_BLSSignatureInfo.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgDisputeVolumeReport.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -479,60 +736,69 @@ func (m *BLSSignatureInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *BLSSignatureInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BLSSignatureInfo.Merge(m, src)
+func (m *MsgDisputeVolumeReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDisputeVolumeReport.Merge(m, src)
 }
-func (m *BLSSignatureInfo) XXX_Size() int {
+func (m *MsgDisputeVolumeReport) XXX_Size() int {
 	return m.Size()
 }
-func (m *BLSSignatureInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_BLSSignatureInfo.DiscardUnknown(m)
+func (m *MsgDisputeVolumeReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDisputeVolumeReport.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_BLSSignatureInfo proto.InternalMessageInfo
+var xxx_messageInfo_MsgDisputeVolumeReport proto.InternalMessageInfo
 
-func (m *BLSSignatureInfo) GetPubKeys() [][]byte {
+func (m *MsgDisputeVolumeReport) GetDisputer() string {
 	if m != nil {
-		return m.PubKeys
+		return m.Disputer
 	}
-	return nil
+	return ""
 }
 
-func (m *BLSSignatureInfo) GetSignature() []byte {
+func (m *MsgDisputeVolumeReport) GetReporter() string {
 	if m != nil {
-		return m.Signature
+		return m.Reporter
 	}
-	return nil
+	return ""
 }
 
-func (m *BLSSignatureInfo) GetTxData() []byte {
+func (m *MsgDisputeVolumeReport) GetWalletVolumes() []SingleWalletVolume {
 	if m != nil {
-		return m.TxData
+		return m.WalletVolumes
 	}
 	return nil
 }
 
-// MsgUpdateParams defines a Msg for updating the x/pot module parameters.
-type MsgUpdateParams struct {
-	// authority is the address of the governance account.
-	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	// params defines the x/pot parameters to update.
-	// NOTE: All parameters must be supplied.
-	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+func (m *MsgDisputeVolumeReport) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
 }
 
-func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
-func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateParams) ProtoMessage()    {}
-func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_103c258cace119ca, []int{9}
+func (m *MsgDisputeVolumeReport) GetBLSSignature() BLSSignatureInfo {
+	if m != nil {
+		return m.BLSSignature
+	}
+	return BLSSignatureInfo{}
 }
-func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+
+// MsgDisputeVolumeReportResponse defines the MsgDisputeVolumeReport response type
+type MsgDisputeVolumeReportResponse struct {
+}
+
+func (m *MsgDisputeVolumeReportResponse) Reset()         { *m = MsgDisputeVolumeReportResponse{} }
+func (m *MsgDisputeVolumeReportResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDisputeVolumeReportResponse) ProtoMessage()    {}
+func (*MsgDisputeVolumeReportResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_103c258cace119ca, []int{13}
+}
+func (m *MsgDisputeVolumeReportResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgDisputeVolumeReportResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgDisputeVolumeReportResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -542,49 +808,175 @@ func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+func (m *MsgDisputeVolumeReportResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDisputeVolumeReportResponse.Merge(m, src)
 }
-func (m *MsgUpdateParams) XXX_Size() int {
+func (m *MsgDisputeVolumeReportResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateParams) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+func (m *MsgDisputeVolumeReportResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDisputeVolumeReportResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDisputeVolumeReportResponse proto.InternalMessageInfo
+
+// MsgSubmitReportEquivocation submits two volume reports of the same epoch with different wallet volumes, both
+// BLS-signed by the accused meta node. The accused BLS public key is bound to its network address by accused_signature.
+type MsgSubmitReportEquivocation struct {
+	Reporter      string                                 `protobuf:"bytes,1,opt,name=reporter,proto3" json:"reporter" yaml:"reporter"`
+	ReporterOwner string                                 `protobuf:"bytes,2,opt,name=reporter_owner,json=reporterOwner,proto3" json:"reporter_owner" yaml:"reporter_owner"`
+	Accused       string                                 `protobuf:"bytes,3,opt,name=accused,proto3" json:"accused" yaml:"accused"`
+	Epoch         github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=epoch,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"epoch" yaml:"epoch"`
+	// first_report and second_report are the BLS sign bytes of two volume reports of the epoch, each a MsgVolumeReport
+	// or the MsgVolumeReportHeader of a chunked report
+	FirstReport     []byte           `protobuf:"bytes,5,opt,name=first_report,json=firstReport,proto3" json:"first_report" yaml:"first_report"`
+	FirstSignature  BLSSignatureInfo `protobuf:"bytes,6,opt,name=first_signature,json=firstSignature,proto3" json:"first_signature" yaml:"first_signature"`
+	SecondReport    []byte           `protobuf:"bytes,7,opt,name=second_report,json=secondReport,proto3" json:"second_report" yaml:"second_report"`
+	SecondSignature BLSSignatureInfo `protobuf:"bytes,8,opt,name=second_signature,json=secondSignature,proto3" json:"second_signature" yaml:"second_signature"`
+	AccusedPubKey   []byte           `protobuf:"bytes,9,opt,name=accused_pub_key,json=accusedPubKey,proto3" json:"accused_pub_key" yaml:"accused_pub_key"`
+	// accused_signature is the BLS signature of the accused meta node over the keccak hash of its network address
+	AccusedSignature []byte `protobuf:"bytes,10,opt,name=accused_signature,json=accusedSignature,proto3" json:"accused_signature" yaml:"accused_signature"`
+	// kick votes for kicking the accused meta node on behalf of the reporter
+	Kick bool `protobuf:"varint,11,opt,name=kick,proto3" json:"kick" yaml:"kick"`
+	// first_chunked is true if first_report are the BLS sign bytes of a MsgVolumeReportHeader
+	FirstChunked bool `protobuf:"varint,12,opt,name=first_chunked,json=firstChunked,proto3" json:"first_chunked" yaml:"first_chunked"`
+	// second_chunked is true if second_report are the BLS sign bytes of a MsgVolumeReportHeader
+	SecondChunked bool `protobuf:"varint,13,opt,name=second_chunked,json=secondChunked,proto3" json:"second_chunked" yaml:"second_chunked"`
+}
+
+func (m *MsgSubmitReportEquivocation) Reset()         { *m = MsgSubmitReportEquivocation{} }
+func (m *MsgSubmitReportEquivocation) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitReportEquivocation) ProtoMessage()    {}
+func (*MsgSubmitReportEquivocation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_103c258cace119ca, []int{14}
+}
+func (m *MsgSubmitReportEquivocation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitReportEquivocation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitReportEquivocation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitReportEquivocation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitReportEquivocation.Merge(m, src)
+}
+func (m *MsgSubmitReportEquivocation) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitReportEquivocation) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitReportEquivocation.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+var xxx_messageInfo_MsgSubmitReportEquivocation proto.InternalMessageInfo
 
-func (m *MsgUpdateParams) GetAuthority() string {
+func (m *MsgSubmitReportEquivocation) GetReporter() string {
 	if m != nil {
-		return m.Authority
+		return m.Reporter
 	}
 	return ""
 }
 
-func (m *MsgUpdateParams) GetParams() Params {
+func (m *MsgSubmitReportEquivocation) GetReporterOwner() string {
 	if m != nil {
-		return m.Params
+		return m.ReporterOwner
 	}
-	return Params{}
+	return ""
 }
 
-// MsgUpdateParamsResponse defines the response structure for executing a
-// MsgUpdateParams message.
-type MsgUpdateParamsResponse struct {
+func (m *MsgSubmitReportEquivocation) GetAccused() string {
+	if m != nil {
+		return m.Accused
+	}
+	return ""
 }
 
-func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
-func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateParamsResponse) ProtoMessage()    {}
-func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_103c258cace119ca, []int{10}
+func (m *MsgSubmitReportEquivocation) GetFirstReport() []byte {
+	if m != nil {
+		return m.FirstReport
+	}
+	return nil
 }
-func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *MsgSubmitReportEquivocation) GetFirstSignature() BLSSignatureInfo {
+	if m != nil {
+		return m.FirstSignature
+	}
+	return BLSSignatureInfo{}
+}
+
+func (m *MsgSubmitReportEquivocation) GetSecondReport() []byte {
+	if m != nil {
+		return m.SecondReport
+	}
+	return nil
+}
+
+func (m *MsgSubmitReportEquivocation) GetSecondSignature() BLSSignatureInfo {
+	if m != nil {
+		return m.SecondSignature
+	}
+	return BLSSignatureInfo{}
+}
+
+func (m *MsgSubmitReportEquivocation) GetAccusedPubKey() []byte {
+	if m != nil {
+		return m.AccusedPubKey
+	}
+	return nil
+}
+
+func (m *MsgSubmitReportEquivocation) GetAccusedSignature() []byte {
+	if m != nil {
+		return m.AccusedSignature
+	}
+	return nil
+}
+
+func (m *MsgSubmitReportEquivocation) GetKick() bool {
+	if m != nil {
+		return m.Kick
+	}
+	return false
+}
+
+func (m *MsgSubmitReportEquivocation) GetFirstChunked() bool {
+	if m != nil {
+		return m.FirstChunked
+	}
+	return false
+}
+
+func (m *MsgSubmitReportEquivocation) GetSecondChunked() bool {
+	if m != nil {
+		return m.SecondChunked
+	}
+	return false
+}
+
+// MsgSubmitReportEquivocationResponse defines the MsgSubmitReportEquivocation response type
+type MsgSubmitReportEquivocationResponse struct {
+}
+
+func (m *MsgSubmitReportEquivocationResponse) Reset()         { *m = MsgSubmitReportEquivocationResponse{} }
+func (m *MsgSubmitReportEquivocationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitReportEquivocationResponse) ProtoMessage()    {}
+func (*MsgSubmitReportEquivocationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_103c258cace119ca, []int{15}
+}
+func (m *MsgSubmitReportEquivocationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgSubmitReportEquivocationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgSubmitReportEquivocationResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -594,297 +986,535 @@ func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+func (m *MsgSubmitReportEquivocationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitReportEquivocationResponse.Merge(m, src)
 }
-func (m *MsgUpdateParamsResponse) XXX_Size() int {
+func (m *MsgSubmitReportEquivocationResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+func (m *MsgSubmitReportEquivocationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitReportEquivocationResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgSubmitReportEquivocationResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgVolumeReport)(nil), "stratos.pot.v1.MsgVolumeReport")
-	proto.RegisterType((*MsgVolumeReportResponse)(nil), "stratos.pot.v1.MsgVolumeReportResponse")
-	proto.RegisterType((*MsgWithdraw)(nil), "stratos.pot.v1.MsgWithdraw")
-	proto.RegisterType((*MsgWithdrawResponse)(nil), "stratos.pot.v1.MsgWithdrawResponse")
-	proto.RegisterType((*MsgFoundationDeposit)(nil), "stratos.pot.v1.MsgFoundationDeposit")
-	proto.RegisterType((*MsgFoundationDepositResponse)(nil), "stratos.pot.v1.MsgFoundationDepositResponse")
-	proto.RegisterType((*MsgSlashingResourceNode)(nil), "stratos.pot.v1.MsgSlashingResourceNode")
-	proto.RegisterType((*MsgSlashingResourceNodeResponse)(nil), "stratos.pot.v1.MsgSlashingResourceNodeResponse")
-	proto.RegisterType((*BLSSignatureInfo)(nil), "stratos.pot.v1.BLSSignatureInfo")
-	proto.RegisterType((*MsgUpdateParams)(nil), "stratos.pot.v1.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "stratos.pot.v1.MsgUpdateParamsResponse")
+// MsgSetAutoRestake - encapsulates a SetAutoRestake transaction as an SDK message
+type MsgSetAutoRestake struct {
+	WalletAddress string `protobuf:"bytes,1,opt,name=wallet_address,json=walletAddress,proto3" json:"wallet_address" yaml:"wallet_address"`
+	// network_address is the resource or meta node the matured rewards are added to the deposit of, empty to disable
+	NetworkAddress string `protobuf:"bytes,2,opt,name=network_address,json=networkAddress,proto3" json:"network_address" yaml:"network_address"`
 }
 
-func init() { proto.RegisterFile("stratos/pot/v1/tx.proto", fileDescriptor_103c258cace119ca) }
-
-var fileDescriptor_103c258cace119ca = []byte{
-	// 1217 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0x4d, 0x6f, 0x1b, 0xc5,
-	0x1b, 0xcf, 0x36, 0x2f, 0x8d, 0x27, 0x4e, 0xd2, 0x6e, 0xd3, 0xc6, 0xf5, 0xbf, 0xf5, 0xa4, 0xfb,
-	0x47, 0x10, 0x05, 0xea, 0x25, 0xe1, 0xa5, 0xaa, 0x2f, 0x88, 0x4d, 0x41, 0x54, 0x34, 0x50, 0xd9,
-	0x2a, 0x45, 0xb9, 0x98, 0xb1, 0x3d, 0x59, 0x2f, 0xb1, 0x77, 0x56, 0x3b, 0xe3, 0x24, 0x96, 0x90,
-	0x40, 0x70, 0x43, 0xaa, 0xc4, 0x91, 0x8f, 0x50, 0xf5, 0x94, 0x03, 0xe2, 0x23, 0xa0, 0x5e, 0x90,
-	0x2a, 0x4e, 0x88, 0xc3, 0x82, 0x92, 0x43, 0x24, 0x1f, 0xfd, 0x09, 0xd0, 0xbc, 0xec, 0x8b, 0x37,
-	0xeb, 0x10, 0x0e, 0x95, 0xb8, 0xb4, 0x9e, 0xdf, 0xf3, 0x9b, 0xe7, 0x99, 0xe7, 0x7d, 0x03, 0x96,
-	0x29, 0xf3, 0x11, 0x23, 0xd4, 0xf4, 0x08, 0x33, 0xf7, 0xd6, 0x4d, 0x76, 0x50, 0xf6, 0x7c, 0xc2,
-	0x88, 0xbe, 0xa0, 0x04, 0x65, 0x8f, 0xb0, 0xf2, 0xde, 0x7a, 0x71, 0xc9, 0x26, 0x36, 0x11, 0x22,
-	0x93, 0xff, 0x92, 0xac, 0xe2, 0xf5, 0x26, 0xa1, 0x5d, 0x42, 0xeb, 0x52, 0x20, 0x0f, 0x4a, 0x74,
-	0x19, 0x75, 0x1d, 0x97, 0x98, 0xe2, 0x5f, 0x05, 0x2d, 0x4b, 0x82, 0xd9, 0xa5, 0x36, 0xb7, 0xd5,
-	0xa5, 0xb6, 0x12, 0x94, 0x94, 0xa0, 0x81, 0x28, 0x36, 0xf7, 0xd6, 0x1b, 0x98, 0xa1, 0x75, 0xb3,
-	0x49, 0x1c, 0x57, 0xc9, 0x0b, 0xa9, 0x57, 0xf2, 0x37, 0x09, 0x89, 0xf1, 0xeb, 0x34, 0x58, 0xdc,
-	0xa2, 0xf6, 0x67, 0xa4, 0xd3, 0xeb, 0xe2, 0x2a, 0xf6, 0x88, 0xcf, 0xf4, 0xaf, 0xc1, 0xc2, 0x3e,
-	0xea, 0x74, 0x30, 0xab, 0xef, 0x09, 0x98, 0x16, 0xb4, 0x95, 0xc9, 0xd5, 0xb9, 0x0d, 0xa3, 0x3c,
-	0xea, 0x53, 0xb9, 0xe6, 0xb8, 0x76, 0x07, 0x3f, 0x16, 0x5c, 0xa9, 0xc1, 0x7a, 0xfb, 0x79, 0x00,
-	0x27, 0x06, 0x01, 0x4c, 0x69, 0x18, 0x06, 0xf0, 0x6a, 0x1f, 0x75, 0x3b, 0x15, 0x63, 0x14, 0x37,
-	0x9e, 0x9e, 0x1c, 0xae, 0x69, 0xd5, 0xf9, 0xfd, 0x84, 0x0e, 0xaa, 0xd7, 0xc0, 0xac, 0x2f, 0x9e,
-	0x82, 0xfd, 0xc2, 0x85, 0x15, 0x6d, 0x35, 0x67, 0xdd, 0x19, 0x04, 0x30, 0xc2, 0x86, 0x01, 0x5c,
-	0x94, 0xca, 0x42, 0xc4, 0xf8, 0xed, 0xa7, 0xdb, 0x4b, 0x2a, 0x7a, 0xef, 0xb7, 0x5a, 0x3e, 0xa6,
-	0xb4, 0xc6, 0x7c, 0xc7, 0xb5, 0xab, 0xd1, 0x25, 0xdd, 0x03, 0xd3, 0xd8, 0x23, 0xcd, 0x76, 0x61,
-	0x52, 0x68, 0xdc, 0xe6, 0x0f, 0xfd, 0x23, 0x80, 0xaf, 0xda, 0x0e, 0x6b, 0xf7, 0x1a, 0xe5, 0x26,
-	0xe9, 0xaa, 0xf8, 0xab, 0xff, 0x6e, 0xd3, 0xd6, 0xae, 0xc9, 0xfa, 0x1e, 0xa6, 0xe5, 0xfb, 0x2e,
-	0x1b, 0x04, 0x50, 0x5e, 0x1f, 0x06, 0x30, 0x2f, 0x8d, 0x8b, 0x23, 0xb7, 0x0c, 0x94, 0xe5, 0xfb,
-	0x2e, 0x93, 0xee, 0x48, 0xa6, 0xbe, 0x0d, 0x2e, 0x49, 0xeb, 0x75, 0x1f, 0xef, 0x60, 0x1f, 0xbb,
-	0x4d, 0x5c, 0x98, 0x12, 0xc6, 0xcd, 0x41, 0x00, 0x4f, 0xc9, 0x86, 0x01, 0x5c, 0x4e, 0xba, 0x15,
-	0x4b, 0x8c, 0xea, 0xa2, 0x84, 0xaa, 0x21, 0xa2, 0x7f, 0x09, 0x16, 0x42, 0xcf, 0xea, 0x64, 0xdf,
-	0xc5, 0x7e, 0x61, 0x5a, 0x68, 0xde, 0xe4, 0xb1, 0x1f, 0x95, 0xc4, 0xb1, 0x1f, 0xc5, 0xc7, 0x07,
-	0x6d, 0x3e, 0x24, 0x7e, 0xca, 0x79, 0x7a, 0x1f, 0xcc, 0x5b, 0x0f, 0x6a, 0x75, 0xea, 0xd8, 0x2e,
-	0x62, 0x3d, 0x1f, 0x17, 0x66, 0x56, 0xb4, 0xd5, 0xb9, 0x8d, 0x95, 0x74, 0x39, 0x58, 0x0f, 0x6a,
-	0xb5, 0x90, 0x73, 0xdf, 0xdd, 0x21, 0xd6, 0x86, 0x2a, 0x86, 0xf9, 0x46, 0x87, 0xc6, 0xd7, 0x87,
-	0x01, 0x5c, 0x92, 0xef, 0x19, 0x81, 0x55, 0x29, 0xe4, 0x93, 0x5a, 0x2a, 0xe6, 0xb7, 0x27, 0x87,
-	0x6b, 0x29, 0x7f, 0xbe, 0x3f, 0x39, 0x5c, 0x8b, 0xda, 0x2e, 0x55, 0xbb, 0xc6, 0x75, 0xb0, 0x9c,
-	0x82, 0xaa, 0x98, 0x7a, 0xc4, 0xa5, 0xd8, 0xf8, 0x71, 0x12, 0xcc, 0x6d, 0x51, 0xfb, 0xb1, 0xc3,
-	0xda, 0x2d, 0x1f, 0xed, 0xeb, 0xdf, 0x69, 0x60, 0x06, 0x75, 0x49, 0xcf, 0x65, 0xaa, 0xbe, 0xaf,
-	0x97, 0x55, 0x34, 0x78, 0x1b, 0x95, 0x55, 0x1b, 0x95, 0x37, 0x89, 0xe3, 0x5a, 0x0f, 0x95, 0x27,
-	0xea, 0xc2, 0x30, 0x80, 0xf3, 0xd2, 0x05, 0x79, 0x36, 0x9e, 0xfd, 0x09, 0x57, 0xcf, 0x51, 0x48,
-	0x5c, 0x17, 0x95, 0x7e, 0x2a, 0x4d, 0x3c, 0x91, 0xaa, 0x25, 0x90, 0xcc, 0x81, 0xaa, 0xf8, 0xcd,
-	0x44, 0x13, 0x29, 0xc9, 0xa9, 0x26, 0x52, 0xf8, 0x19, 0x89, 0x94, 0x44, 0x05, 0x72, 0x5b, 0x0c,
-	0xf9, 0x76, 0xc2, 0xd6, 0x64, 0x6c, 0x6b, 0x54, 0x12, 0xdb, 0x1a, 0xc5, 0xcf, 0xb0, 0x25, 0x89,
-	0x0a, 0xac, 0xbc, 0x2e, 0x32, 0x37, 0xfa, 0x50, 0x9e, 0xb9, 0x2b, 0x89, 0xcc, 0x85, 0xa9, 0x30,
-	0xae, 0x82, 0x2b, 0x89, 0x63, 0x94, 0xb1, 0x27, 0x17, 0xc0, 0xd2, 0x16, 0xb5, 0x3f, 0x24, 0x3d,
-	0xb7, 0x85, 0x98, 0x43, 0xdc, 0x7b, 0xd8, 0x23, 0xd4, 0x61, 0xff, 0x91, 0xd4, 0x6d, 0x82, 0xa9,
-	0x1d, 0x9f, 0x74, 0x55, 0xc2, 0x78, 0x4f, 0x8b, 0xf3, 0x30, 0x80, 0x73, 0xd2, 0x02, 0x3f, 0x8d,
-	0x0f, 0x98, 0x20, 0x57, 0xd6, 0x78, 0x9c, 0xc4, 0x4f, 0x1e, 0x9d, 0x1b, 0x89, 0xe8, 0x9c, 0x72,
-	0xdb, 0x28, 0x81, 0x1b, 0x59, 0x78, 0x14, 0xaf, 0x67, 0xd3, 0xa2, 0xfa, 0x6b, 0x1d, 0x44, 0xdb,
-	0xdc, 0x02, 0xa6, 0xa4, 0xe7, 0x37, 0xf1, 0x27, 0xa4, 0x85, 0xf5, 0xc7, 0x20, 0x17, 0xb6, 0x91,
-	0x9c, 0xe7, 0x39, 0xeb, 0xee, 0x20, 0x80, 0x31, 0x38, 0x0c, 0xe0, 0xa5, 0xd1, 0x31, 0x71, 0x46,
-	0xb2, 0xe3, 0x6b, 0x19, 0x93, 0xe8, 0x82, 0xd0, 0xfe, 0x32, 0x26, 0x91, 0x0b, 0x16, 0x5d, 0xcc,
-	0xf6, 0x89, 0xbf, 0x9b, 0xaa, 0xe0, 0x0f, 0x06, 0x01, 0x4c, 0x8b, 0x86, 0x01, 0xbc, 0x26, 0xad,
-	0xa5, 0x04, 0xe3, 0xcd, 0x2d, 0x28, 0x66, 0xa2, 0x61, 0x52, 0xcd, 0x39, 0xf5, 0xd2, 0x9a, 0xf3,
-	0x2b, 0x30, 0x4b, 0x55, 0xe2, 0xd4, 0x2c, 0xff, 0xe2, 0x5f, 0xaf, 0xa8, 0x48, 0x43, 0xbc, 0x22,
-	0x43, 0x24, 0x73, 0x51, 0x45, 0x7c, 0xfd, 0x0e, 0xb8, 0x48, 0x7b, 0xd4, 0xc3, 0x6e, 0x4b, 0x4c,
-	0xf7, 0x59, 0xeb, 0xe6, 0x20, 0x80, 0x21, 0x34, 0x0c, 0xe0, 0x82, 0xd2, 0x26, 0x01, 0xa3, 0x1a,
-	0x8a, 0x2a, 0x77, 0xc6, 0x4c, 0x68, 0x98, 0xa8, 0xe4, 0xac, 0x82, 0x34, 0x6e, 0x01, 0x38, 0x46,
-	0x14, 0xd5, 0xf3, 0x2f, 0x1a, 0xb8, 0x94, 0x5e, 0x2a, 0x7a, 0x05, 0xcc, 0x7a, 0xbd, 0x46, 0x7d,
-	0x17, 0xf7, 0x65, 0x1d, 0xe7, 0x2d, 0xc8, 0x3d, 0x0f, 0xb1, 0xd8, 0xf3, 0x10, 0x31, 0xaa, 0x17,
-	0xbd, 0x5e, 0xe3, 0x63, 0xdc, 0xa7, 0xfa, 0x7b, 0x20, 0x17, 0x6f, 0x31, 0xde, 0xb6, 0x79, 0xeb,
-	0x16, 0x6f, 0x82, 0xe4, 0x6e, 0x52, 0x4d, 0x10, 0xef, 0xa5, 0x6a, 0x2c, 0xd6, 0xdf, 0x01, 0x33,
-	0xec, 0xe0, 0x1e, 0x62, 0x48, 0xd4, 0x5d, 0x5e, 0x46, 0x89, 0x1d, 0xd4, 0x5b, 0x88, 0xa1, 0x38,
-	0x4a, 0x0a, 0x30, 0xaa, 0x8a, 0x6c, 0xfc, 0xac, 0x89, 0xaf, 0xac, 0x47, 0x5e, 0x0b, 0x31, 0xfc,
-	0x10, 0xf9, 0xa8, 0x4b, 0xf5, 0x77, 0x41, 0x0e, 0xf5, 0x58, 0x9b, 0xf8, 0x0e, 0xeb, 0x17, 0x34,
-	0x91, 0xf0, 0xc2, 0xf8, 0x7e, 0x8b, 0xa8, 0xfa, 0x5d, 0x30, 0xe3, 0x09, 0x0d, 0xc2, 0x81, 0xb9,
-	0x8d, 0x6b, 0xe9, 0x35, 0x2c, 0xf5, 0x5b, 0x39, 0x5e, 0x3d, 0x6a, 0x60, 0xc9, 0x0b, 0x95, 0x37,
-	0x79, 0xae, 0x62, 0x55, 0x3c, 0x4d, 0x37, 0xc3, 0x34, 0x1d, 0x88, 0x6f, 0xc3, 0xd4, 0x23, 0xd5,
-	0x3a, 0x4d, 0x42, 0x61, 0x72, 0x36, 0x9e, 0x4c, 0x81, 0xc9, 0x2d, 0x6a, 0xeb, 0x08, 0x5c, 0xfd,
-	0x08, 0xb9, 0xad, 0x0e, 0x4e, 0x7f, 0x46, 0xc2, 0xf4, 0xc3, 0x52, 0x84, 0xe2, 0x6b, 0xff, 0x40,
-	0x08, 0x4d, 0xe9, 0x8f, 0xc0, 0xe5, 0xc8, 0x44, 0xb4, 0xbe, 0xff, 0x97, 0x71, 0x3b, 0x14, 0x16,
-	0xff, 0x7f, 0x86, 0x30, 0x52, 0xeb, 0x81, 0x62, 0xa4, 0xf6, 0xf4, 0x8e, 0x79, 0x25, 0x43, 0xc5,
-	0x29, 0x56, 0xf1, 0x8d, 0xf3, 0xb0, 0x22, 0x8b, 0x7d, 0x70, 0x33, 0xb2, 0x98, 0x39, 0xa5, 0xb3,
-	0x42, 0x92, 0x45, 0x2c, 0x9a, 0xe7, 0x24, 0x46, 0xa6, 0x3f, 0x07, 0xf9, 0x91, 0xf2, 0xcb, 0xca,
-	0x4e, 0x92, 0x90, 0x99, 0x9d, 0xac, 0x42, 0x28, 0x4e, 0x7f, 0xc3, 0x8b, 0xcc, 0xda, 0x7a, 0x7a,
-	0x54, 0xd2, 0x9e, 0x1f, 0x95, 0xb4, 0x17, 0x47, 0x25, 0xed, 0xaf, 0xa3, 0x92, 0xf6, 0xc3, 0x71,
-	0x69, 0xe2, 0xc5, 0x71, 0x69, 0xe2, 0xf7, 0xe3, 0xd2, 0xc4, 0xb6, 0x99, 0x98, 0x61, 0x4a, 0xaf,
-	0x8b, 0x59, 0xf8, 0xf3, 0x76, 0xb3, 0x8d, 0x1c, 0x57, 0xd5, 0xa0, 0x18, 0x68, 0x8d, 0x19, 0xf1,
-	0xf7, 0xc9, 0x5b, 0x7f, 0x07, 0x00, 0x00, 0xff, 0xff, 0xe0, 0xbb, 0x3b, 0x48, 0x61, 0x0d, 0x00,
-	0x00,
+func (m *MsgSetAutoRestake) Reset()         { *m = MsgSetAutoRestake{} }
+func (m *MsgSetAutoRestake) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAutoRestake) ProtoMessage()    {}
+func (*MsgSetAutoRestake) Descriptor() ([]byte, []int) {
+	return fileDescriptor_103c258cace119ca, []int{16}
 }
-
-func (this *MsgVolumeReport) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
-
-	that1, ok := that.(*MsgVolumeReport)
-	if !ok {
-		that2, ok := that.(MsgVolumeReport)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if len(this.WalletVolumes) != len(that1.WalletVolumes) {
-		return false
-	}
-	for i := range this.WalletVolumes {
-		if !this.WalletVolumes[i].Equal(&that1.WalletVolumes[i]) {
-			return false
+func (m *MsgSetAutoRestake) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAutoRestake) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAutoRestake.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if this.Reporter != that1.Reporter {
-		return false
-	}
-	if !this.Epoch.Equal(that1.Epoch) {
-		return false
-	}
-	if this.ReportReference != that1.ReportReference {
-		return false
-	}
-	if this.ReporterOwner != that1.ReporterOwner {
-		return false
-	}
-	if !this.BLSSignature.Equal(&that1.BLSSignature) {
-		return false
-	}
-	return true
 }
-func (this *MsgVolumeReportResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *MsgSetAutoRestake) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAutoRestake.Merge(m, src)
+}
+func (m *MsgSetAutoRestake) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAutoRestake) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAutoRestake.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*MsgVolumeReportResponse)
-	if !ok {
-		that2, ok := that.(MsgVolumeReportResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+var xxx_messageInfo_MsgSetAutoRestake proto.InternalMessageInfo
+
+func (m *MsgSetAutoRestake) GetWalletAddress() string {
+	if m != nil {
+		return m.WalletAddress
 	}
-	return true
+	return ""
 }
-func (this *MsgWithdraw) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*MsgWithdraw)
-	if !ok {
-		that2, ok := that.(MsgWithdraw)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if len(this.Amount) != len(that1.Amount) {
-		return false
-	}
-	for i := range this.Amount {
-		if !this.Amount[i].Equal(&that1.Amount[i]) {
-			return false
-		}
-	}
-	if this.WalletAddress != that1.WalletAddress {
-		return false
-	}
-	if this.TargetAddress != that1.TargetAddress {
-		return false
+func (m *MsgSetAutoRestake) GetNetworkAddress() string {
+	if m != nil {
+		return m.NetworkAddress
 	}
-	return true
+	return ""
 }
-func (this *MsgWithdrawResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*MsgWithdrawResponse)
-	if !ok {
-		that2, ok := that.(MsgWithdrawResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	return true
+// MsgSetAutoRestakeResponse defines the Msg/MsgSetAutoRestake response type.
+type MsgSetAutoRestakeResponse struct {
 }
-func (this *MsgFoundationDeposit) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*MsgFoundationDeposit)
-	if !ok {
-		that2, ok := that.(MsgFoundationDeposit)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if len(this.Amount) != len(that1.Amount) {
-		return false
-	}
-	for i := range this.Amount {
-		if !this.Amount[i].Equal(&that1.Amount[i]) {
-			return false
+func (m *MsgSetAutoRestakeResponse) Reset()         { *m = MsgSetAutoRestakeResponse{} }
+func (m *MsgSetAutoRestakeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAutoRestakeResponse) ProtoMessage()    {}
+func (*MsgSetAutoRestakeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_103c258cace119ca, []int{17}
+}
+func (m *MsgSetAutoRestakeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAutoRestakeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAutoRestakeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if this.From != that1.From {
-		return false
-	}
-	return true
 }
-func (this *MsgFoundationDepositResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *MsgSetAutoRestakeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAutoRestakeResponse.Merge(m, src)
+}
+func (m *MsgSetAutoRestakeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAutoRestakeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAutoRestakeResponse.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*MsgFoundationDepositResponse)
-	if !ok {
-		that2, ok := that.(MsgFoundationDepositResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+var xxx_messageInfo_MsgSetAutoRestakeResponse proto.InternalMessageInfo
+
+type BLSSignatureInfo struct {
+	PubKeys   [][]byte `protobuf:"bytes,1,rep,name=pub_keys,json=pubKeys,proto3" json:"pub_keys" yaml:"pub_keys"`
+	Signature []byte   `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature" yaml:"signature"`
+	TxData    []byte   `protobuf:"bytes,3,opt,name=txData,proto3" json:"tx_data" yaml:"tx_data"`
+}
+
+func (m *BLSSignatureInfo) Reset()         { *m = BLSSignatureInfo{} }
+func (m *BLSSignatureInfo) String() string { return proto.CompactTextString(m) }
+func (*BLSSignatureInfo) ProtoMessage()    {}
+func (*BLSSignatureInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_103c258cace119ca, []int{18}
+}
+func (m *BLSSignatureInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BLSSignatureInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BLSSignatureInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+}
+func (m *BLSSignatureInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BLSSignatureInfo.Merge(m, src)
+}
+func (m *BLSSignatureInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *BLSSignatureInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_BLSSignatureInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BLSSignatureInfo proto.InternalMessageInfo
+
+func (m *BLSSignatureInfo) GetPubKeys() [][]byte {
+	if m != nil {
+		return m.PubKeys
 	}
-	return true
+	return nil
 }
-func (this *MsgSlashingResourceNode) Equal(that interface{}) bool {
+
+func (m *BLSSignatureInfo) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+func (m *BLSSignatureInfo) GetTxData() []byte {
+	if m != nil {
+		return m.TxData
+	}
+	return nil
+}
+
+// MsgUpdateParams defines a Msg for updating the x/pot module parameters.
+type MsgUpdateParams struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// params defines the x/pot parameters to update.
+	// NOTE: All parameters must be supplied.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+}
+
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_103c258cace119ca, []int{19}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateParams) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+// MsgUpdateParamsResponse defines the response structure for executing a
+// MsgUpdateParams message.
+type MsgUpdateParamsResponse struct {
+}
+
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_103c258cace119ca, []int{20}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
+// MsgResolveVolumeReportDispute resolves a disputed volume report. The report is cancelled when cancel is set,
+// amended with wallet_volumes when they are provided, and distributed unchanged otherwise.
+type MsgResolveVolumeReportDispute struct {
+	// authority is the address of the governance account.
+	Authority     string                                 `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Epoch         github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=epoch,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"epoch"`
+	Cancel        bool                                   `protobuf:"varint,3,opt,name=cancel,proto3" json:"cancel,omitempty"`
+	WalletVolumes []SingleWalletVolume                   `protobuf:"bytes,4,rep,name=wallet_volumes,json=walletVolumes,proto3" json:"wallet_volumes"`
+}
+
+func (m *MsgResolveVolumeReportDispute) Reset()         { *m = MsgResolveVolumeReportDispute{} }
+func (m *MsgResolveVolumeReportDispute) String() string { return proto.CompactTextString(m) }
+func (*MsgResolveVolumeReportDispute) ProtoMessage()    {}
+func (*MsgResolveVolumeReportDispute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_103c258cace119ca, []int{21}
+}
+func (m *MsgResolveVolumeReportDispute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResolveVolumeReportDispute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResolveVolumeReportDispute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgResolveVolumeReportDispute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResolveVolumeReportDispute.Merge(m, src)
+}
+func (m *MsgResolveVolumeReportDispute) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResolveVolumeReportDispute) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResolveVolumeReportDispute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgResolveVolumeReportDispute proto.InternalMessageInfo
+
+func (m *MsgResolveVolumeReportDispute) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgResolveVolumeReportDispute) GetCancel() bool {
+	if m != nil {
+		return m.Cancel
+	}
+	return false
+}
+
+func (m *MsgResolveVolumeReportDispute) GetWalletVolumes() []SingleWalletVolume {
+	if m != nil {
+		return m.WalletVolumes
+	}
+	return nil
+}
+
+// MsgResolveVolumeReportDisputeResponse defines the MsgResolveVolumeReportDispute response type
+type MsgResolveVolumeReportDisputeResponse struct {
+}
+
+func (m *MsgResolveVolumeReportDisputeResponse) Reset()         { *m = MsgResolveVolumeReportDisputeResponse{} }
+func (m *MsgResolveVolumeReportDisputeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgResolveVolumeReportDisputeResponse) ProtoMessage()    {}
+func (*MsgResolveVolumeReportDisputeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_103c258cace119ca, []int{22}
+}
+func (m *MsgResolveVolumeReportDisputeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResolveVolumeReportDisputeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResolveVolumeReportDisputeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgResolveVolumeReportDisputeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResolveVolumeReportDisputeResponse.Merge(m, src)
+}
+func (m *MsgResolveVolumeReportDisputeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResolveVolumeReportDisputeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResolveVolumeReportDisputeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgResolveVolumeReportDisputeResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgVolumeReport)(nil), "stratos.pot.v1.MsgVolumeReport")
+	proto.RegisterType((*MsgVolumeReportResponse)(nil), "stratos.pot.v1.MsgVolumeReportResponse")
+	proto.RegisterType((*MsgVolumeReportHeader)(nil), "stratos.pot.v1.MsgVolumeReportHeader")
+	proto.RegisterType((*MsgVolumeReportHeaderResponse)(nil), "stratos.pot.v1.MsgVolumeReportHeaderResponse")
+	proto.RegisterType((*MsgVolumeReportChunk)(nil), "stratos.pot.v1.MsgVolumeReportChunk")
+	proto.RegisterType((*MsgVolumeReportChunkResponse)(nil), "stratos.pot.v1.MsgVolumeReportChunkResponse")
+	proto.RegisterType((*MsgWithdraw)(nil), "stratos.pot.v1.MsgWithdraw")
+	proto.RegisterType((*MsgWithdrawResponse)(nil), "stratos.pot.v1.MsgWithdrawResponse")
+	proto.RegisterType((*MsgFoundationDeposit)(nil), "stratos.pot.v1.MsgFoundationDeposit")
+	proto.RegisterType((*MsgFoundationDepositResponse)(nil), "stratos.pot.v1.MsgFoundationDepositResponse")
+	proto.RegisterType((*MsgSlashingResourceNode)(nil), "stratos.pot.v1.MsgSlashingResourceNode")
+	proto.RegisterType((*MsgSlashingResourceNodeResponse)(nil), "stratos.pot.v1.MsgSlashingResourceNodeResponse")
+	proto.RegisterType((*MsgDisputeVolumeReport)(nil), "stratos.pot.v1.MsgDisputeVolumeReport")
+	proto.RegisterType((*MsgDisputeVolumeReportResponse)(nil), "stratos.pot.v1.MsgDisputeVolumeReportResponse")
+	proto.RegisterType((*MsgSubmitReportEquivocation)(nil), "stratos.pot.v1.MsgSubmitReportEquivocation")
+	proto.RegisterType((*MsgSubmitReportEquivocationResponse)(nil), "stratos.pot.v1.MsgSubmitReportEquivocationResponse")
+	proto.RegisterType((*MsgSetAutoRestake)(nil), "stratos.pot.v1.MsgSetAutoRestake")
+	proto.RegisterType((*MsgSetAutoRestakeResponse)(nil), "stratos.pot.v1.MsgSetAutoRestakeResponse")
+	proto.RegisterType((*BLSSignatureInfo)(nil), "stratos.pot.v1.BLSSignatureInfo")
+	proto.RegisterType((*MsgUpdateParams)(nil), "stratos.pot.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "stratos.pot.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgResolveVolumeReportDispute)(nil), "stratos.pot.v1.MsgResolveVolumeReportDispute")
+	proto.RegisterType((*MsgResolveVolumeReportDisputeResponse)(nil), "stratos.pot.v1.MsgResolveVolumeReportDisputeResponse")
+}
+
+func init() { proto.RegisterFile("stratos/pot/v1/tx.proto", fileDescriptor_103c258cace119ca) }
+
+var fileDescriptor_103c258cace119ca = []byte{
+	// 2093 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x5a, 0xcd, 0x6f, 0x1b, 0xc7,
+	0x15, 0x37, 0x25, 0x92, 0x12, 0x87, 0xd4, 0x87, 0xd7, 0x1f, 0xa2, 0x28, 0x99, 0x2b, 0xaf, 0xed,
+	0xd8, 0xb1, 0x22, 0xb2, 0x92, 0xe3, 0xb8, 0x61, 0x8b, 0x16, 0xa6, 0x1c, 0x23, 0x4a, 0xa3, 0xc4,
+	0x58, 0xd5, 0x75, 0xe1, 0x43, 0xd9, 0x25, 0x39, 0xa2, 0xb6, 0x22, 0x77, 0xd8, 0x9d, 0xa1, 0x3e,
+	0x8a, 0x02, 0x6d, 0x53, 0xe4, 0x52, 0xa0, 0x45, 0x6f, 0xed, 0xb9, 0xa7, 0x20, 0xe8, 0xc1, 0x87,
+	0xa2, 0x7f, 0x42, 0x91, 0x4b, 0x81, 0xa0, 0xa7, 0xa2, 0x87, 0x6d, 0x21, 0x1f, 0x0c, 0x10, 0x3d,
+	0xf1, 0x2f, 0x28, 0xe6, 0x63, 0x77, 0x76, 0x97, 0xbb, 0x94, 0xd4, 0x56, 0x4a, 0x9b, 0x4b, 0xa2,
+	0xf9, 0xbd, 0xb7, 0xef, 0xcd, 0x9b, 0xf9, 0xbd, 0x37, 0x6f, 0x86, 0x06, 0x73, 0x98, 0xd8, 0x06,
+	0x41, 0xb8, 0xdc, 0x45, 0xa4, 0xbc, 0xb7, 0x5a, 0x26, 0x07, 0xa5, 0xae, 0x8d, 0x08, 0x52, 0xa6,
+	0x85, 0xa0, 0xd4, 0x45, 0xa4, 0xb4, 0xb7, 0x5a, 0xb8, 0xdc, 0x42, 0x2d, 0xc4, 0x44, 0x65, 0xfa,
+	0x17, 0xd7, 0x2a, 0xcc, 0x37, 0x10, 0xee, 0x20, 0x5c, 0xe3, 0x02, 0x3e, 0x10, 0xa2, 0x8b, 0x46,
+	0xc7, 0xb4, 0x50, 0x99, 0xfd, 0x57, 0x40, 0x73, 0x5c, 0xa1, 0xdc, 0xc1, 0x2d, 0xea, 0xab, 0x83,
+	0x5b, 0x42, 0x50, 0x14, 0x82, 0xba, 0x81, 0x61, 0x79, 0x6f, 0xb5, 0x0e, 0x89, 0xb1, 0x5a, 0x6e,
+	0x20, 0xd3, 0x12, 0xf2, 0x7c, 0x68, 0x96, 0x74, 0x4e, 0x4c, 0xa2, 0xfd, 0x39, 0x05, 0x66, 0x36,
+	0x71, 0xeb, 0x3b, 0xa8, 0xdd, 0xeb, 0x40, 0x1d, 0x76, 0x91, 0x4d, 0x94, 0x9f, 0x80, 0xe9, 0x7d,
+	0xa3, 0xdd, 0x86, 0xa4, 0xb6, 0xc7, 0x60, 0x9c, 0x4f, 0x2c, 0x8d, 0xdf, 0xc9, 0xae, 0x69, 0xa5,
+	0x60, 0x4c, 0xa5, 0x2d, 0xd3, 0x6a, 0xb5, 0xe1, 0x33, 0xa6, 0xcb, 0x2d, 0x54, 0xdf, 0xfc, 0xcc,
+	0x51, 0x2f, 0xf4, 0x1d, 0x35, 0x64, 0x61, 0xe0, 0xa8, 0x57, 0x0e, 0x8d, 0x4e, 0xbb, 0xa2, 0x05,
+	0x71, 0xed, 0x93, 0x57, 0x2f, 0xee, 0x26, 0xf4, 0xa9, 0x7d, 0x9f, 0x0d, 0xac, 0x6c, 0x81, 0x49,
+	0x9b, 0x4d, 0x05, 0xda, 0xf9, 0xb1, 0xa5, 0xc4, 0x9d, 0x4c, 0xf5, 0x41, 0xdf, 0x51, 0x3d, 0x6c,
+	0xe0, 0xa8, 0x33, 0xdc, 0x98, 0x8b, 0x68, 0x7f, 0xf9, 0xc3, 0xca, 0x65, 0xb1, 0x7a, 0x0f, 0x9b,
+	0x4d, 0x1b, 0x62, 0xbc, 0x45, 0x6c, 0xd3, 0x6a, 0xe9, 0xde, 0x47, 0x4a, 0x17, 0xa4, 0x60, 0x17,
+	0x35, 0x76, 0xf2, 0xe3, 0xcc, 0xe2, 0x73, 0x3a, 0xd1, 0xbf, 0x39, 0xea, 0x6b, 0x2d, 0x93, 0xec,
+	0xf4, 0xea, 0xa5, 0x06, 0xea, 0x88, 0xf5, 0x17, 0xff, 0x5b, 0xc1, 0xcd, 0xdd, 0x32, 0x39, 0xec,
+	0x42, 0x5c, 0xda, 0xb0, 0x48, 0xdf, 0x51, 0xf9, 0xe7, 0x03, 0x47, 0xcd, 0x71, 0xe7, 0x6c, 0x48,
+	0x3d, 0x03, 0xe1, 0x79, 0xc3, 0x22, 0x3c, 0x1c, 0xae, 0xa9, 0x3c, 0x07, 0xb3, 0xdc, 0x7b, 0xcd,
+	0x86, 0xdb, 0xd0, 0x86, 0x56, 0x03, 0xe6, 0x93, 0xcc, 0x79, 0xb9, 0xef, 0xa8, 0x43, 0xb2, 0x81,
+	0xa3, 0xce, 0xf9, 0xc3, 0x92, 0x12, 0x4d, 0x9f, 0xe1, 0x90, 0xee, 0x22, 0xca, 0x0f, 0xc0, 0xb4,
+	0x1b, 0x59, 0x0d, 0xed, 0x5b, 0xd0, 0xce, 0xa7, 0x98, 0xe5, 0x75, 0xba, 0xf6, 0x41, 0x89, 0x5c,
+	0xfb, 0x20, 0x1e, 0xbf, 0x68, 0x53, 0xae, 0xe2, 0x87, 0x54, 0x4f, 0x39, 0x04, 0x53, 0xd5, 0xf7,
+	0xb7, 0x6a, 0xd8, 0x6c, 0x59, 0x06, 0xe9, 0xd9, 0x30, 0x9f, 0x5e, 0x4a, 0xdc, 0xc9, 0xae, 0x2d,
+	0x85, 0xe9, 0x50, 0x7d, 0x7f, 0x6b, 0xcb, 0xd5, 0xd9, 0xb0, 0xb6, 0x51, 0x75, 0x4d, 0x90, 0x61,
+	0xaa, 0xde, 0xc6, 0xf2, 0xf3, 0x81, 0xa3, 0x5e, 0xe6, 0xf3, 0x09, 0xc0, 0x82, 0x0a, 0x39, 0xbf,
+	0x95, 0x4a, 0xf9, 0xa3, 0x57, 0x2f, 0xee, 0x86, 0xe2, 0xf9, 0xc5, 0xab, 0x17, 0x77, 0xbd, 0xb4,
+	0x0b, 0x71, 0x57, 0x9b, 0x07, 0x73, 0x21, 0x48, 0x87, 0xb8, 0x8b, 0x2c, 0x0c, 0xb5, 0x7f, 0xa6,
+	0xc0, 0x95, 0x90, 0xec, 0x5d, 0x68, 0x34, 0xa1, 0x1d, 0xe0, 0x5b, 0xe2, 0xbf, 0xce, 0xb7, 0xb1,
+	0x2f, 0x92, 0x6f, 0xe3, 0x67, 0xc6, 0xb7, 0xe4, 0x99, 0xf1, 0xed, 0x31, 0xc8, 0x12, 0x44, 0x8c,
+	0x76, 0xad, 0x81, 0x7a, 0x16, 0x61, 0xc4, 0x4e, 0x56, 0x6f, 0xf5, 0x1d, 0xd5, 0x0f, 0x0f, 0x1c,
+	0x55, 0xe1, 0x5e, 0x7c, 0xa0, 0xa6, 0x03, 0x36, 0x5a, 0xa7, 0x03, 0xe5, 0x3d, 0x90, 0x13, 0x65,
+	0xa6, 0x66, 0x23, 0x44, 0x18, 0x6d, 0x73, 0xd5, 0xdb, 0x7d, 0x47, 0x0d, 0xe0, 0x03, 0x47, 0xbd,
+	0xc4, 0x2d, 0xf9, 0x51, 0x4d, 0xcf, 0x8a, 0xa1, 0x8e, 0x10, 0x19, 0xce, 0x81, 0x89, 0x73, 0xcb,
+	0x81, 0xfb, 0x31, 0x39, 0x70, 0x2d, 0x26, 0x07, 0x38, 0xa9, 0x35, 0x15, 0x5c, 0x8b, 0x14, 0x78,
+	0xf9, 0xf0, 0xfb, 0x14, 0xb8, 0x1c, 0xd2, 0x58, 0xdf, 0xe9, 0x59, 0xbb, 0x67, 0x93, 0x0e, 0xc3,
+	0x04, 0x1a, 0x3b, 0x33, 0x02, 0x9d, 0x7f, 0xa9, 0x7f, 0x0c, 0xb2, 0x98, 0x18, 0x36, 0xa9, 0x99,
+	0x56, 0x13, 0x1e, 0xe4, 0x93, 0x92, 0xb2, 0x3e, 0x58, 0x52, 0xd6, 0x07, 0x6a, 0x3a, 0x60, 0xa3,
+	0x0d, 0x3a, 0x88, 0x38, 0x7a, 0x53, 0xe7, 0x7b, 0xf4, 0xd6, 0x41, 0xba, 0x6b, 0x23, 0xb4, 0x8d,
+	0xf3, 0x69, 0xe6, 0xf8, 0x66, 0xd8, 0x31, 0x57, 0xdc, 0xb0, 0x1a, 0xed, 0x1e, 0x36, 0x91, 0xf5,
+	0x84, 0x2a, 0x57, 0x6f, 0x0a, 0xd7, 0xe2, 0xdb, 0x81, 0xa3, 0x4e, 0x71, 0x97, 0x7c, 0x2c, 0x5c,
+	0x09, 0x69, 0xe5, 0xcd, 0x18, 0x42, 0x2f, 0xc6, 0x10, 0x9a, 0xb1, 0x52, 0xfb, 0x3a, 0x58, 0x8c,
+	0xc2, 0x5d, 0x3a, 0x2b, 0x8b, 0x20, 0xb3, 0x6d, 0x5a, 0x46, 0xdb, 0xfc, 0x11, 0x6c, 0x32, 0xda,
+	0x4e, 0xea, 0x12, 0xd0, 0x7e, 0x3b, 0x0e, 0xb2, 0x9b, 0xb8, 0xf5, 0xcc, 0x24, 0x3b, 0x4d, 0xdb,
+	0xd8, 0x57, 0x7e, 0x9e, 0x00, 0x69, 0xa3, 0xc3, 0xea, 0x0b, 0x6f, 0x6e, 0xe6, 0x4b, 0x62, 0x6b,
+	0x69, 0x0f, 0x55, 0x12, 0x3d, 0x54, 0x69, 0x1d, 0x99, 0x56, 0xf5, 0x89, 0x1b, 0x1d, 0xff, 0x40,
+	0x46, 0xc7, 0xc7, 0xda, 0xa7, 0x7f, 0x57, 0xef, 0x9c, 0x80, 0x5a, 0xd4, 0x16, 0x16, 0x2b, 0xc1,
+	0xbf, 0xa4, 0x49, 0x21, 0x36, 0xc5, 0xe0, 0x7c, 0xf6, 0x27, 0x45, 0x50, 0x32, 0xb4, 0x8d, 0x02,
+	0x1f, 0x91, 0x14, 0x5c, 0x51, 0x80, 0xd4, 0x17, 0x31, 0xec, 0x96, 0xcf, 0xd7, 0xb8, 0xf4, 0x15,
+	0x94, 0x48, 0x5f, 0x41, 0x7c, 0x84, 0x2f, 0xae, 0x28, 0xc0, 0xca, 0x32, 0xdb, 0xe1, 0xe0, 0x44,
+	0xe9, 0x0e, 0x5f, 0xf2, 0xed, 0xb0, 0xbb, 0x15, 0xda, 0x15, 0x70, 0xc9, 0x37, 0xf4, 0xca, 0xd3,
+	0x2f, 0xc7, 0x58, 0x79, 0x7a, 0x8c, 0x7a, 0x56, 0xd3, 0x20, 0x26, 0xb2, 0x1e, 0xc1, 0x2e, 0xc2,
+	0x26, 0xf9, 0x1f, 0xd9, 0xba, 0x75, 0x90, 0xdc, 0xb6, 0x51, 0x27, 0x3f, 0xe6, 0x1d, 0xb0, 0x6c,
+	0x3c, 0x70, 0xd4, 0x2c, 0xf7, 0x40, 0x47, 0xf1, 0x0b, 0xc6, 0x94, 0x2b, 0x77, 0xe9, 0x3a, 0xb1,
+	0x3f, 0xc3, 0xfc, 0x1f, 0x0a, 0x5b, 0x2b, 0x82, 0xc5, 0x28, 0xdc, 0x5b, 0xaf, 0x4f, 0x53, 0xac,
+	0xf5, 0xd9, 0x6a, 0x1b, 0x78, 0x87, 0x7a, 0x80, 0x18, 0xf5, 0xec, 0x06, 0xfc, 0x00, 0x35, 0xa1,
+	0xf2, 0x0c, 0x64, 0xdc, 0x74, 0xe3, 0xcd, 0x7c, 0xa6, 0xfa, 0x76, 0xdf, 0x51, 0x25, 0x38, 0x70,
+	0xd4, 0xd9, 0x60, 0xc9, 0x1d, 0xb1, 0xd9, 0xf2, 0xb3, 0xc8, 0xaa, 0x3e, 0x7e, 0x46, 0x55, 0xdd,
+	0x02, 0x33, 0x16, 0x24, 0xfb, 0xc8, 0xde, 0x0d, 0x31, 0xf8, 0x9d, 0xbe, 0xa3, 0x86, 0x45, 0x03,
+	0x47, 0xbd, 0xca, 0xbd, 0x85, 0x04, 0xf1, 0xee, 0xa6, 0x85, 0xa6, 0x2f, 0x61, 0x42, 0xc9, 0x99,
+	0x3c, 0xb3, 0xe4, 0xfc, 0x31, 0x98, 0xc4, 0x62, 0xe3, 0x44, 0x23, 0xff, 0xfd, 0x53, 0x1f, 0x5a,
+	0x9e, 0x05, 0x79, 0x40, 0xbb, 0x48, 0xe4, 0xd1, 0xe5, 0xe9, 0x2b, 0x0f, 0xc0, 0x04, 0xee, 0xe1,
+	0x2e, 0xb4, 0x9a, 0xac, 0x47, 0x9a, 0xac, 0x5e, 0xeb, 0x3b, 0xaa, 0x0b, 0x0d, 0x1c, 0x75, 0x5a,
+	0x58, 0xe3, 0x80, 0xa6, 0xbb, 0xa2, 0xca, 0x83, 0x98, 0x4a, 0xae, 0xfa, 0x98, 0x1c, 0x45, 0x48,
+	0xed, 0x3a, 0x50, 0x63, 0x44, 0x1e, 0x9f, 0x7f, 0x93, 0x02, 0x57, 0x37, 0x71, 0xeb, 0x91, 0x89,
+	0xbb, 0x3d, 0x02, 0x03, 0x17, 0xd4, 0x2d, 0x30, 0xd9, 0xe4, 0x70, 0xa0, 0x41, 0x71, 0x31, 0x19,
+	0xbf, 0x8b, 0x8c, 0x68, 0x50, 0x5c, 0x95, 0x2f, 0xcb, 0xa5, 0x73, 0xb8, 0x83, 0x48, 0x9e, 0x6f,
+	0x07, 0x71, 0x0f, 0xa4, 0x6d, 0x68, 0x60, 0x64, 0x09, 0x22, 0x2f, 0xd0, 0xf2, 0xcb, 0x11, 0x59,
+	0x7e, 0xf9, 0x58, 0xd3, 0x85, 0xe0, 0x8b, 0xbc, 0x62, 0x7e, 0x85, 0x72, 0xd8, 0xa3, 0x01, 0x65,
+	0x6f, 0xd1, 0xc7, 0xde, 0x08, 0xfa, 0x69, 0x4b, 0xa0, 0x18, 0x2d, 0xf1, 0xb8, 0xfb, 0x2b, 0x00,
+	0x16, 0x28, 0xbf, 0x7b, 0xf5, 0x8e, 0x49, 0xb8, 0xec, 0x9d, 0x1f, 0xf6, 0xcc, 0x3d, 0xd4, 0x60,
+	0xa5, 0xfb, 0xff, 0xbf, 0xc3, 0xfe, 0x10, 0x4c, 0x18, 0x8d, 0x46, 0x0f, 0xc3, 0xa6, 0x60, 0xf6,
+	0x7d, 0x5a, 0x31, 0x04, 0x24, 0x2b, 0x86, 0x00, 0xe2, 0xcd, 0xba, 0x9f, 0xc8, 0x44, 0x49, 0x9e,
+	0x57, 0xa2, 0xbc, 0x07, 0x72, 0xdb, 0xa6, 0x8d, 0x49, 0x8d, 0x47, 0x96, 0x4f, 0xc9, 0xdb, 0xa1,
+	0x1f, 0x97, 0xb7, 0x43, 0x3f, 0xaa, 0xe9, 0x59, 0x36, 0x14, 0x05, 0xe9, 0x67, 0x09, 0x30, 0xc3,
+	0xc5, 0xa7, 0x67, 0xf0, 0x5b, 0x82, 0xc1, 0x61, 0x03, 0xf2, 0x04, 0x0b, 0x09, 0x04, 0x8b, 0xa7,
+	0x19, 0xec, 0xd9, 0x52, 0x3e, 0x00, 0x53, 0x18, 0x36, 0x90, 0xd5, 0x74, 0x03, 0x9a, 0x60, 0x01,
+	0xbd, 0x4e, 0x93, 0x23, 0x20, 0x90, 0xc9, 0x11, 0x80, 0x35, 0x3d, 0xc7, 0xc7, 0x22, 0xa6, 0x8f,
+	0x13, 0x60, 0x56, 0x28, 0xc8, 0xa0, 0x26, 0x4f, 0x18, 0xd4, 0x57, 0x45, 0x50, 0x43, 0x16, 0xe4,
+	0xa3, 0x43, 0x58, 0x22, 0xc2, 0x9a, 0xe1, 0xb8, 0x8c, 0xeb, 0x29, 0x98, 0x11, 0x24, 0xa9, 0x75,
+	0x7b, 0xf5, 0xda, 0x2e, 0x3c, 0xcc, 0x67, 0x58, 0x64, 0x2b, 0x74, 0xd1, 0x42, 0x22, 0xb9, 0x68,
+	0x21, 0x81, 0xa6, 0x4f, 0x09, 0xe4, 0x49, 0xaf, 0xfe, 0x2d, 0x78, 0xa8, 0x7c, 0x0f, 0x5c, 0x74,
+	0x55, 0x64, 0x78, 0x80, 0x19, 0x5e, 0xed, 0x3b, 0xea, 0xb0, 0x70, 0xe0, 0xa8, 0xf9, 0xa0, 0x69,
+	0x39, 0x75, 0x7d, 0x56, 0x60, 0x72, 0xda, 0xcb, 0x20, 0xb9, 0x6b, 0x36, 0x76, 0xf3, 0x59, 0x76,
+	0xa0, 0xce, 0xd1, 0xfe, 0x90, 0x8e, 0x65, 0x7f, 0x48, 0x47, 0x9a, 0xce, 0x40, 0xba, 0x77, 0x7c,
+	0x93, 0x1b, 0xf4, 0x4a, 0x03, 0x9b, 0xf9, 0x1c, 0xfb, 0x8a, 0xed, 0x5d, 0x40, 0x20, 0xf7, 0x2e,
+	0x00, 0x6b, 0x3a, 0xe7, 0xec, 0x3a, 0x1f, 0x2a, 0x3a, 0x98, 0x16, 0xcb, 0xeb, 0x1a, 0x9c, 0x62,
+	0x06, 0x97, 0x69, 0x29, 0x08, 0x4a, 0x64, 0x29, 0x08, 0xe2, 0x9a, 0x2e, 0x58, 0x23, 0x6c, 0x56,
+	0xbe, 0x16, 0x73, 0xd6, 0xdf, 0xf0, 0x9f, 0xf5, 0x31, 0x05, 0x4f, 0xbb, 0x05, 0x6e, 0x8c, 0x10,
+	0x7b, 0x75, 0xf3, 0x77, 0x63, 0xe0, 0x22, 0xd5, 0x83, 0xe4, 0x61, 0x8f, 0x20, 0x1d, 0x62, 0x62,
+	0xec, 0xc2, 0x88, 0x46, 0x2c, 0x71, 0x66, 0x8d, 0x58, 0x44, 0x93, 0x39, 0x76, 0x86, 0x4d, 0x66,
+	0x65, 0x35, 0xe6, 0xa6, 0x34, 0xef, 0x5f, 0xd5, 0xc0, 0x72, 0x68, 0x0b, 0x60, 0x7e, 0x08, 0xf4,
+	0x56, 0xf0, 0x4f, 0x09, 0x30, 0x1b, 0xce, 0x46, 0xa5, 0x02, 0x26, 0x45, 0x1a, 0xf0, 0xee, 0x3f,
+	0x57, 0x55, 0xe9, 0x71, 0xe3, 0x62, 0xf2, 0xb8, 0x71, 0x11, 0x4d, 0x9f, 0xe8, 0xb2, 0x34, 0xc1,
+	0xca, 0x37, 0x41, 0x46, 0xe6, 0xc7, 0x18, 0xcb, 0x8f, 0xeb, 0xf4, 0xea, 0xe0, 0xcf, 0x0b, 0x71,
+	0x75, 0xf0, 0xe5, 0x83, 0x14, 0x2b, 0xf7, 0x41, 0x9a, 0x1c, 0x3c, 0x32, 0x88, 0xc1, 0x4e, 0x8a,
+	0x1c, 0xef, 0x2d, 0xc9, 0x41, 0xad, 0x69, 0x10, 0x43, 0x9e, 0x14, 0x02, 0xd0, 0x74, 0xa1, 0xac,
+	0xfd, 0x31, 0xc1, 0x7e, 0x98, 0x78, 0xda, 0x6d, 0x1a, 0x04, 0x3e, 0x31, 0x6c, 0xa3, 0x83, 0x95,
+	0xb7, 0x40, 0xc6, 0xe8, 0x91, 0x1d, 0x64, 0x9b, 0xe4, 0x50, 0x70, 0x20, 0x1f, 0x7f, 0x4b, 0xf1,
+	0x54, 0x95, 0xb7, 0x41, 0xba, 0xcb, 0x2c, 0xb0, 0x00, 0xb2, 0x6b, 0x57, 0xc3, 0xf5, 0x8b, 0xdb,
+	0xaf, 0x66, 0x68, 0xd5, 0x72, 0xdf, 0x2a, 0x18, 0xc4, 0xbb, 0x03, 0x69, 0x2a, 0xf0, 0xee, 0x76,
+	0xc0, 0x7e, 0x4e, 0x09, 0x4d, 0x52, 0xbc, 0x40, 0xfb, 0x21, 0x6f, 0x73, 0x9c, 0x31, 0xf6, 0x26,
+	0x47, 0xdb, 0xdd, 0xf6, 0x5e, 0xa0, 0x73, 0x10, 0xcd, 0xc4, 0xbf, 0x1d, 0xe1, 0xb3, 0xe0, 0x63,
+	0xf3, 0xc3, 0xd3, 0x1d, 0x9f, 0x23, 0x4e, 0xc9, 0xab, 0x20, 0xdd, 0x30, 0xac, 0x06, 0x6c, 0xb3,
+	0xdd, 0x9b, 0xd4, 0xc5, 0x48, 0xf9, 0xf6, 0x7f, 0xd0, 0x66, 0xfa, 0x96, 0x39, 0xd8, 0x3b, 0x56,
+	0xbe, 0x31, 0xbc, 0xda, 0xcb, 0x43, 0xab, 0x1d, 0xbf, 0x7c, 0xda, 0x6d, 0x70, 0x6b, 0xa4, 0x82,
+	0xbb, 0x13, 0x6b, 0x47, 0x19, 0x30, 0xbe, 0x89, 0x5b, 0x8a, 0x01, 0xae, 0xbc, 0x6b, 0x58, 0xcd,
+	0x36, 0x0c, 0xff, 0x06, 0xa6, 0x86, 0xe3, 0x08, 0x29, 0x14, 0x6e, 0x1f, 0xa3, 0xe0, 0xbd, 0x4b,
+	0x61, 0xb0, 0x10, 0xe9, 0x42, 0xfc, 0xf6, 0x70, 0xeb, 0x18, 0x3b, 0x5c, 0xad, 0xb0, 0x72, 0x22,
+	0x35, 0xcf, 0x69, 0x17, 0x14, 0x22, 0x9d, 0xf2, 0x07, 0xde, 0x9b, 0xc7, 0x18, 0x63, 0x5a, 0x85,
+	0x37, 0x4e, 0xa2, 0xe5, 0x79, 0x7c, 0x0a, 0x2e, 0x7a, 0x1e, 0xbd, 0x57, 0xb6, 0x85, 0x08, 0x13,
+	0xae, 0xb0, 0x70, 0x63, 0x84, 0x30, 0x32, 0x90, 0xe1, 0xa7, 0xa0, 0xa8, 0x40, 0x86, 0xb4, 0x0a,
+	0x6f, 0x9c, 0x44, 0xcb, 0xf3, 0x78, 0x08, 0xae, 0x79, 0x1e, 0x23, 0x1f, 0x53, 0xa2, 0x76, 0x3e,
+	0x4a, 0xb1, 0x50, 0x3e, 0xa1, 0xa2, 0xe7, 0x7a, 0x0f, 0x2c, 0x7a, 0xae, 0xa3, 0xee, 0xbd, 0xaf,
+	0x45, 0x18, 0x8c, 0xd0, 0x2b, 0x94, 0x4e, 0xa6, 0xe7, 0xf9, 0xfd, 0x38, 0x01, 0xae, 0xcb, 0x98,
+	0xe3, 0x2e, 0x2d, 0xcb, 0x51, 0xe1, 0xc4, 0x28, 0x17, 0xee, 0x9d, 0x42, 0xd9, 0x9b, 0x47, 0x0b,
+	0xcc, 0xc9, 0x69, 0x04, 0x7b, 0x80, 0xeb, 0x51, 0xf6, 0x02, 0x2a, 0x85, 0xd7, 0x8f, 0x55, 0xf1,
+	0x1c, 0x7d, 0x94, 0x00, 0x85, 0x11, 0x55, 0x38, 0x2a, 0xd9, 0xe2, 0xd5, 0x0b, 0xf7, 0x4f, 0xa5,
+	0xee, 0x4d, 0xe2, 0xbb, 0x20, 0x17, 0x38, 0xdd, 0xa2, 0x4a, 0x8e, 0x5f, 0xa1, 0x70, 0xfb, 0x18,
+	0x05, 0xd7, 0x72, 0x21, 0xf5, 0x53, 0x5a, 0x5c, 0xab, 0x9b, 0x9f, 0x1c, 0x15, 0x13, 0x9f, 0x1d,
+	0x15, 0x13, 0x9f, 0x1f, 0x15, 0x13, 0xff, 0x38, 0x2a, 0x26, 0x7e, 0xfd, 0xb2, 0x78, 0xe1, 0xf3,
+	0x97, 0xc5, 0x0b, 0x7f, 0x7d, 0x59, 0xbc, 0xf0, 0xbc, 0xec, 0x3b, 0x1b, 0x84, 0x5d, 0x0b, 0x12,
+	0xf7, 0xcf, 0x95, 0xc6, 0x8e, 0x61, 0x5a, 0xa2, 0xe8, 0xb2, 0x83, 0xa2, 0x9e, 0x66, 0xff, 0x62,
+	0xe0, 0xde, 0xbf, 0x06, 0x00, 0xca, 0x73, 0x09, 0xc2, 0xf3, 0x20, 0x00, 0x00,
+}
+
+func (this *MsgVolumeReport) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MsgSlashingResourceNode)
+	that1, ok := that.(*MsgVolumeReport)
 	if !ok {
-		that2, ok := that.(MsgSlashingResourceNode)
+		that2, ok := that.(MsgVolumeReport)
 		if ok {
 			that1 = &that2
 		} else {
@@ -896,44 +1526,39 @@ func (this *MsgSlashingResourceNode) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if len(this.Reporters) != len(that1.Reporters) {
+	if len(this.WalletVolumes) != len(that1.WalletVolumes) {
 		return false
 	}
-	for i := range this.Reporters {
-		if this.Reporters[i] != that1.Reporters[i] {
+	for i := range this.WalletVolumes {
+		if !this.WalletVolumes[i].Equal(&that1.WalletVolumes[i]) {
 			return false
 		}
 	}
-	if len(this.ReporterOwner) != len(that1.ReporterOwner) {
+	if this.Reporter != that1.Reporter {
 		return false
 	}
-	for i := range this.ReporterOwner {
-		if this.ReporterOwner[i] != that1.ReporterOwner[i] {
-			return false
-		}
+	if !this.Epoch.Equal(that1.Epoch) {
+		return false
 	}
-	if this.NetworkAddress != that1.NetworkAddress {
-		return false
-	}
-	if this.WalletAddress != that1.WalletAddress {
+	if this.ReportReference != that1.ReportReference {
 		return false
 	}
-	if !this.Slashing.Equal(that1.Slashing) {
+	if this.ReporterOwner != that1.ReporterOwner {
 		return false
 	}
-	if this.Suspend != that1.Suspend {
+	if !this.BLSSignature.Equal(&that1.BLSSignature) {
 		return false
 	}
 	return true
 }
-func (this *MsgSlashingResourceNodeResponse) Equal(that interface{}) bool {
+func (this *MsgVolumeReportResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MsgSlashingResourceNodeResponse)
+	that1, ok := that.(*MsgVolumeReportResponse)
 	if !ok {
-		that2, ok := that.(MsgSlashingResourceNodeResponse)
+		that2, ok := that.(MsgVolumeReportResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -947,14 +1572,14 @@ func (this *MsgSlashingResourceNodeResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *BLSSignatureInfo) Equal(that interface{}) bool {
+func (this *MsgVolumeReportHeader) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*BLSSignatureInfo)
+	that1, ok := that.(*MsgVolumeReportHeader)
 	if !ok {
-		that2, ok := that.(BLSSignatureInfo)
+		that2, ok := that.(MsgVolumeReportHeader)
 		if ok {
 			that1 = &that2
 		} else {
@@ -966,30 +1591,37 @@ func (this *BLSSignatureInfo) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if len(this.PubKeys) != len(that1.PubKeys) {
+	if this.Reporter != that1.Reporter {
 		return false
 	}
-	for i := range this.PubKeys {
-		if !bytes.Equal(this.PubKeys[i], that1.PubKeys[i]) {
-			return false
-		}
+	if !this.Epoch.Equal(that1.Epoch) {
+		return false
 	}
-	if !bytes.Equal(this.Signature, that1.Signature) {
+	if this.ReportReference != that1.ReportReference {
 		return false
 	}
-	if !bytes.Equal(this.TxData, that1.TxData) {
+	if this.ReporterOwner != that1.ReporterOwner {
+		return false
+	}
+	if this.TotalCount != that1.TotalCount {
+		return false
+	}
+	if !bytes.Equal(this.VolumesRoot, that1.VolumesRoot) {
+		return false
+	}
+	if !this.BLSSignature.Equal(&that1.BLSSignature) {
 		return false
 	}
 	return true
 }
-func (this *MsgUpdateParams) Equal(that interface{}) bool {
+func (this *MsgVolumeReportHeaderResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MsgUpdateParams)
+	that1, ok := that.(*MsgVolumeReportHeaderResponse)
 	if !ok {
-		that2, ok := that.(MsgUpdateParams)
+		that2, ok := that.(MsgVolumeReportHeaderResponse)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1001,22 +1633,16 @@ func (this *MsgUpdateParams) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Authority != that1.Authority {
-		return false
-	}
-	if !this.Params.Equal(&that1.Params) {
-		return false
-	}
 	return true
 }
-func (this *MsgUpdateParamsResponse) Equal(that interface{}) bool {
+func (this *MsgVolumeReportChunk) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MsgUpdateParamsResponse)
+	that1, ok := that.(*MsgVolumeReportChunk)
 	if !ok {
-		that2, ok := that.(MsgUpdateParamsResponse)
+		that2, ok := that.(MsgVolumeReportChunk)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1028,894 +1654,4107 @@ func (this *MsgUpdateParamsResponse) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	return true
-}
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	HandleMsgVolumeReport(ctx context.Context, in *MsgVolumeReport, opts ...grpc.CallOption) (*MsgVolumeReportResponse, error)
-	HandleMsgWithdraw(ctx context.Context, in *MsgWithdraw, opts ...grpc.CallOption) (*MsgWithdrawResponse, error)
-	HandleMsgFoundationDeposit(ctx context.Context, in *MsgFoundationDeposit, opts ...grpc.CallOption) (*MsgFoundationDepositResponse, error)
-	HandleMsgSlashingResourceNode(ctx context.Context, in *MsgSlashingResourceNode, opts ...grpc.CallOption) (*MsgSlashingResourceNodeResponse, error)
-	// UpdateParams defined a governance operation for updating the x/pot module parameters.
-	// The authority is hard-coded to the Cosmos SDK x/gov module account
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
-}
-
-type msgClient struct {
-	cc grpc1.ClientConn
-}
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
-}
-
-func (c *msgClient) HandleMsgVolumeReport(ctx context.Context, in *MsgVolumeReport, opts ...grpc.CallOption) (*MsgVolumeReportResponse, error) {
-	out := new(MsgVolumeReportResponse)
-	err := c.cc.Invoke(ctx, "/stratos.pot.v1.Msg/HandleMsgVolumeReport", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *msgClient) HandleMsgWithdraw(ctx context.Context, in *MsgWithdraw, opts ...grpc.CallOption) (*MsgWithdrawResponse, error) {
-	out := new(MsgWithdrawResponse)
-	err := c.cc.Invoke(ctx, "/stratos.pot.v1.Msg/HandleMsgWithdraw", in, out, opts...)
-	if err != nil {
-		return nil, err
+	if this.Reporter != that1.Reporter {
+		return false
 	}
-	return out, nil
-}
-
-func (c *msgClient) HandleMsgFoundationDeposit(ctx context.Context, in *MsgFoundationDeposit, opts ...grpc.CallOption) (*MsgFoundationDepositResponse, error) {
-	out := new(MsgFoundationDepositResponse)
-	err := c.cc.Invoke(ctx, "/stratos.pot.v1.Msg/HandleMsgFoundationDeposit", in, out, opts...)
-	if err != nil {
-		return nil, err
+	if this.ReporterOwner != that1.ReporterOwner {
+		return false
 	}
-	return out, nil
-}
-
-func (c *msgClient) HandleMsgSlashingResourceNode(ctx context.Context, in *MsgSlashingResourceNode, opts ...grpc.CallOption) (*MsgSlashingResourceNodeResponse, error) {
-	out := new(MsgSlashingResourceNodeResponse)
-	err := c.cc.Invoke(ctx, "/stratos.pot.v1.Msg/HandleMsgSlashingResourceNode", in, out, opts...)
-	if err != nil {
-		return nil, err
+	if !this.Epoch.Equal(that1.Epoch) {
+		return false
 	}
-	return out, nil
-}
-
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/stratos.pot.v1.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+	if this.StartIndex != that1.StartIndex {
+		return false
 	}
-	return out, nil
-}
-
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	HandleMsgVolumeReport(context.Context, *MsgVolumeReport) (*MsgVolumeReportResponse, error)
-	HandleMsgWithdraw(context.Context, *MsgWithdraw) (*MsgWithdrawResponse, error)
-	HandleMsgFoundationDeposit(context.Context, *MsgFoundationDeposit) (*MsgFoundationDepositResponse, error)
-	HandleMsgSlashingResourceNode(context.Context, *MsgSlashingResourceNode) (*MsgSlashingResourceNodeResponse, error)
-	// UpdateParams defined a governance operation for updating the x/pot module parameters.
-	// The authority is hard-coded to the Cosmos SDK x/gov module account
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
-}
-
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
-}
-
-func (*UnimplementedMsgServer) HandleMsgVolumeReport(ctx context.Context, req *MsgVolumeReport) (*MsgVolumeReportResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method HandleMsgVolumeReport not implemented")
-}
-func (*UnimplementedMsgServer) HandleMsgWithdraw(ctx context.Context, req *MsgWithdraw) (*MsgWithdrawResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method HandleMsgWithdraw not implemented")
-}
-func (*UnimplementedMsgServer) HandleMsgFoundationDeposit(ctx context.Context, req *MsgFoundationDeposit) (*MsgFoundationDepositResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method HandleMsgFoundationDeposit not implemented")
-}
-func (*UnimplementedMsgServer) HandleMsgSlashingResourceNode(ctx context.Context, req *MsgSlashingResourceNode) (*MsgSlashingResourceNodeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method HandleMsgSlashingResourceNode not implemented")
-}
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
-}
-
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
-}
-
-func _Msg_HandleMsgVolumeReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgVolumeReport)
-	if err := dec(in); err != nil {
-		return nil, err
+	if len(this.WalletVolumes) != len(that1.WalletVolumes) {
+		return false
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).HandleMsgVolumeReport(ctx, in)
+	for i := range this.WalletVolumes {
+		if !this.WalletVolumes[i].Equal(&that1.WalletVolumes[i]) {
+			return false
+		}
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/stratos.pot.v1.Msg/HandleMsgVolumeReport",
+	if len(this.Proofs) != len(that1.Proofs) {
+		return false
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).HandleMsgVolumeReport(ctx, req.(*MsgVolumeReport))
+	for i := range this.Proofs {
+		if !this.Proofs[i].Equal(&that1.Proofs[i]) {
+			return false
+		}
 	}
-	return interceptor(ctx, in, info, handler)
+	return true
 }
-
-func _Msg_HandleMsgWithdraw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgWithdraw)
-	if err := dec(in); err != nil {
-		return nil, err
+func (this *MsgVolumeReportChunkResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).HandleMsgWithdraw(ctx, in)
+
+	that1, ok := that.(*MsgVolumeReportChunkResponse)
+	if !ok {
+		that2, ok := that.(MsgVolumeReportChunkResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/stratos.pot.v1.Msg/HandleMsgWithdraw",
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).HandleMsgWithdraw(ctx, req.(*MsgWithdraw))
+	if this.Finalized != that1.Finalized {
+		return false
 	}
-	return interceptor(ctx, in, info, handler)
+	return true
 }
+func (this *MsgWithdraw) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func _Msg_HandleMsgFoundationDeposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgFoundationDeposit)
-	if err := dec(in); err != nil {
-		return nil, err
+	that1, ok := that.(*MsgWithdraw)
+	if !ok {
+		that2, ok := that.(MsgWithdraw)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).HandleMsgFoundationDeposit(ctx, in)
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/stratos.pot.v1.Msg/HandleMsgFoundationDeposit",
+	if len(this.Amount) != len(that1.Amount) {
+		return false
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).HandleMsgFoundationDeposit(ctx, req.(*MsgFoundationDeposit))
+	for i := range this.Amount {
+		if !this.Amount[i].Equal(&that1.Amount[i]) {
+			return false
+		}
 	}
-	return interceptor(ctx, in, info, handler)
-}
-
-func _Msg_HandleMsgSlashingResourceNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSlashingResourceNode)
-	if err := dec(in); err != nil {
-		return nil, err
+	if this.WalletAddress != that1.WalletAddress {
+		return false
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).HandleMsgSlashingResourceNode(ctx, in)
+	if this.TargetAddress != that1.TargetAddress {
+		return false
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/stratos.pot.v1.Msg/HandleMsgSlashingResourceNode",
+	return true
+}
+func (this *MsgWithdrawResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).HandleMsgSlashingResourceNode(ctx, req.(*MsgSlashingResourceNode))
+
+	that1, ok := that.(*MsgWithdrawResponse)
+	if !ok {
+		that2, ok := that.(MsgWithdrawResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return interceptor(ctx, in, info, handler)
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
 }
+func (this *MsgFoundationDeposit) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
-		return nil, err
+	that1, ok := that.(*MsgFoundationDeposit)
+	if !ok {
+		that2, ok := that.(MsgFoundationDeposit)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/stratos.pot.v1.Msg/UpdateParams",
+	if len(this.Amount) != len(that1.Amount) {
+		return false
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	for i := range this.Amount {
+		if !this.Amount[i].Equal(&that1.Amount[i]) {
+			return false
+		}
 	}
-	return interceptor(ctx, in, info, handler)
-}
-
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "stratos.pot.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "HandleMsgVolumeReport",
-			Handler:    _Msg_HandleMsgVolumeReport_Handler,
-		},
-		{
-			MethodName: "HandleMsgWithdraw",
-			Handler:    _Msg_HandleMsgWithdraw_Handler,
-		},
-		{
-			MethodName: "HandleMsgFoundationDeposit",
-			Handler:    _Msg_HandleMsgFoundationDeposit_Handler,
-		},
-		{
-			MethodName: "HandleMsgSlashingResourceNode",
-			Handler:    _Msg_HandleMsgSlashingResourceNode_Handler,
-		},
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "stratos/pot/v1/tx.proto",
-}
-
-func (m *MsgVolumeReport) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.From != that1.From {
+		return false
 	}
-	return dAtA[:n], nil
+	return true
 }
+func (this *MsgFoundationDepositResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *MsgVolumeReport) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	that1, ok := that.(*MsgFoundationDepositResponse)
+	if !ok {
+		that2, ok := that.(MsgFoundationDepositResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
 }
+func (this *MsgSlashingResourceNode) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *MsgVolumeReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.BLSSignature.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	that1, ok := that.(*MsgSlashingResourceNode)
+	if !ok {
+		that2, ok := that.(MsgSlashingResourceNode)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x32
-	if len(m.ReporterOwner) > 0 {
-		i -= len(m.ReporterOwner)
-		copy(dAtA[i:], m.ReporterOwner)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ReporterOwner)))
-		i--
-		dAtA[i] = 0x2a
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.ReportReference) > 0 {
-		i -= len(m.ReportReference)
-		copy(dAtA[i:], m.ReportReference)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ReportReference)))
-		i--
-		dAtA[i] = 0x22
+	if len(this.Reporters) != len(that1.Reporters) {
+		return false
 	}
-	{
-		size := m.Epoch.Size()
-		i -= size
-		if _, err := m.Epoch.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	for i := range this.Reporters {
+		if this.Reporters[i] != that1.Reporters[i] {
+			return false
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.Reporter) > 0 {
-		i -= len(m.Reporter)
-		copy(dAtA[i:], m.Reporter)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Reporter)))
-		i--
-		dAtA[i] = 0x12
+	if len(this.ReporterOwner) != len(that1.ReporterOwner) {
+		return false
 	}
-	if len(m.WalletVolumes) > 0 {
-		for iNdEx := len(m.WalletVolumes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.WalletVolumes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	for i := range this.ReporterOwner {
+		if this.ReporterOwner[i] != that1.ReporterOwner[i] {
+			return false
 		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgVolumeReportResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.NetworkAddress != that1.NetworkAddress {
+		return false
 	}
-	return dAtA[:n], nil
+	if this.WalletAddress != that1.WalletAddress {
+		return false
+	}
+	if !this.Slashing.Equal(that1.Slashing) {
+		return false
+	}
+	if this.Suspend != that1.Suspend {
+		return false
+	}
+	return true
 }
+func (this *MsgSlashingResourceNodeResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *MsgVolumeReportResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	that1, ok := that.(*MsgSlashingResourceNodeResponse)
+	if !ok {
+		that2, ok := that.(MsgSlashingResourceNodeResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
 }
+func (this *MsgDisputeVolumeReport) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *MsgVolumeReportResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgWithdraw) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*MsgDisputeVolumeReport)
+	if !ok {
+		that2, ok := that.(MsgDisputeVolumeReport)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgWithdraw) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgWithdraw) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TargetAddress) > 0 {
-		i -= len(m.TargetAddress)
-		copy(dAtA[i:], m.TargetAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TargetAddress)))
-		i--
-		dAtA[i] = 0x1a
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.WalletAddress) > 0 {
-		i -= len(m.WalletAddress)
-		copy(dAtA[i:], m.WalletAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.WalletAddress)))
-		i--
-		dAtA[i] = 0x12
+	if this.Disputer != that1.Disputer {
+		return false
 	}
-	if len(m.Amount) > 0 {
-		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	if this.Reporter != that1.Reporter {
+		return false
+	}
+	if !this.Epoch.Equal(that1.Epoch) {
+		return false
+	}
+	if len(this.WalletVolumes) != len(that1.WalletVolumes) {
+		return false
+	}
+	for i := range this.WalletVolumes {
+		if !this.WalletVolumes[i].Equal(&that1.WalletVolumes[i]) {
+			return false
 		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgWithdrawResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.Reason != that1.Reason {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgWithdrawResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgWithdrawResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgFoundationDeposit) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if !this.BLSSignature.Equal(&that1.BLSSignature) {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgFoundationDeposit) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return true
 }
-
-func (m *MsgFoundationDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.From) > 0 {
-		i -= len(m.From)
-		copy(dAtA[i:], m.From)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
-		i--
-		dAtA[i] = 0x12
+func (this *MsgDisputeVolumeReportResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if len(m.Amount) > 0 {
-		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+
+	that1, ok := that.(*MsgDisputeVolumeReportResponse)
+	if !ok {
+		that2, ok := that.(MsgDisputeVolumeReportResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgFoundationDepositResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgFoundationDepositResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgFoundationDepositResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	return true
 }
-
-func (m *MsgSlashingResourceNode) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *MsgSubmitReportEquivocation) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgSlashingResourceNode) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *MsgSlashingResourceNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Suspend {
-		i--
-		if m.Suspend {
-			dAtA[i] = 1
+	that1, ok := that.(*MsgSubmitReportEquivocation)
+	if !ok {
+		that2, ok := that.(MsgSubmitReportEquivocation)
+		if ok {
+			that1 = &that2
 		} else {
-			dAtA[i] = 0
+			return false
 		}
-		i--
-		dAtA[i] = 0x30
 	}
-	{
-		size := m.Slashing.Size()
-		i -= size
-		if _, err := m.Slashing.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	i--
-	dAtA[i] = 0x2a
-	if len(m.WalletAddress) > 0 {
-		i -= len(m.WalletAddress)
-		copy(dAtA[i:], m.WalletAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.WalletAddress)))
-		i--
-		dAtA[i] = 0x22
+	if this.Reporter != that1.Reporter {
+		return false
 	}
-	if len(m.NetworkAddress) > 0 {
-		i -= len(m.NetworkAddress)
-		copy(dAtA[i:], m.NetworkAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.NetworkAddress)))
-		i--
-		dAtA[i] = 0x1a
+	if this.ReporterOwner != that1.ReporterOwner {
+		return false
 	}
-	if len(m.ReporterOwner) > 0 {
-		for iNdEx := len(m.ReporterOwner) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.ReporterOwner[iNdEx])
-			copy(dAtA[i:], m.ReporterOwner[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.ReporterOwner[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
+	if this.Accused != that1.Accused {
+		return false
 	}
-	if len(m.Reporters) > 0 {
-		for iNdEx := len(m.Reporters) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Reporters[iNdEx])
-			copy(dAtA[i:], m.Reporters[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Reporters[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
+	if !this.Epoch.Equal(that1.Epoch) {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgSlashingResourceNodeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if !bytes.Equal(this.FirstReport, that1.FirstReport) {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgSlashingResourceNodeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgSlashingResourceNodeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *BLSSignatureInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if !this.FirstSignature.Equal(&that1.FirstSignature) {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *BLSSignatureInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *BLSSignatureInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TxData) > 0 {
-		i -= len(m.TxData)
-		copy(dAtA[i:], m.TxData)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TxData)))
-		i--
-		dAtA[i] = 0x1a
+	if !bytes.Equal(this.SecondReport, that1.SecondReport) {
+		return false
 	}
-	if len(m.Signature) > 0 {
-		i -= len(m.Signature)
-		copy(dAtA[i:], m.Signature)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signature)))
-		i--
-		dAtA[i] = 0x12
+	if !this.SecondSignature.Equal(&that1.SecondSignature) {
+		return false
 	}
-	if len(m.PubKeys) > 0 {
-		for iNdEx := len(m.PubKeys) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.PubKeys[iNdEx])
-			copy(dAtA[i:], m.PubKeys[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.PubKeys[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
+	if !bytes.Equal(this.AccusedPubKey, that1.AccusedPubKey) {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if !bytes.Equal(this.AccusedSignature, that1.AccusedSignature) {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	if this.Kick != that1.Kick {
+		return false
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+	if this.FirstChunked != that1.FirstChunked {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.SecondChunked != that1.SecondChunked {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	return true
 }
+func (this *MsgSubmitReportEquivocationResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	that1, ok := that.(*MsgSubmitReportEquivocationResponse)
+	if !ok {
+		that2, ok := that.(MsgSubmitReportEquivocationResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
 }
-func (m *MsgVolumeReport) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *MsgSetAutoRestake) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	var l int
-	_ = l
-	if len(m.WalletVolumes) > 0 {
-		for _, e := range m.WalletVolumes {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+
+	that1, ok := that.(*MsgSetAutoRestake)
+	if !ok {
+		that2, ok := that.(MsgSetAutoRestake)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	l = len(m.Reporter)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	l = m.Epoch.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.ReportReference)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if this.WalletAddress != that1.WalletAddress {
+		return false
 	}
-	l = len(m.ReporterOwner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if this.NetworkAddress != that1.NetworkAddress {
+		return false
 	}
-	l = m.BLSSignature.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return true
 }
+func (this *MsgSetAutoRestakeResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *MsgVolumeReportResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*MsgSetAutoRestakeResponse)
+	if !ok {
+		that2, ok := that.(MsgSetAutoRestakeResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	return n
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
 }
+func (this *BLSSignatureInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *MsgWithdraw) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*BLSSignatureInfo)
+	if !ok {
+		that2, ok := that.(BLSSignatureInfo)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.PubKeys) != len(that1.PubKeys) {
+		return false
+	}
+	for i := range this.PubKeys {
+		if !bytes.Equal(this.PubKeys[i], that1.PubKeys[i]) {
+			return false
 		}
 	}
-	l = len(m.WalletAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if !bytes.Equal(this.Signature, that1.Signature) {
+		return false
 	}
-	l = len(m.TargetAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if !bytes.Equal(this.TxData, that1.TxData) {
+		return false
 	}
-	return n
+	return true
 }
+func (this *MsgUpdateParams) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *MsgWithdrawResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*MsgUpdateParams)
+	if !ok {
+		that2, ok := that.(MsgUpdateParams)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	return n
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Authority != that1.Authority {
+		return false
+	}
+	if !this.Params.Equal(&that1.Params) {
+		return false
+	}
+	return true
 }
+func (this *MsgUpdateParamsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *MsgFoundationDeposit) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*MsgUpdateParamsResponse)
+	if !ok {
+		that2, ok := that.(MsgUpdateParamsResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+func (this *MsgResolveVolumeReportDispute) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgResolveVolumeReportDispute)
+	if !ok {
+		that2, ok := that.(MsgResolveVolumeReportDispute)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	l = len(m.From)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return n
+	if this.Authority != that1.Authority {
+		return false
+	}
+	if !this.Epoch.Equal(that1.Epoch) {
+		return false
+	}
+	if this.Cancel != that1.Cancel {
+		return false
+	}
+	if len(this.WalletVolumes) != len(that1.WalletVolumes) {
+		return false
+	}
+	for i := range this.WalletVolumes {
+		if !this.WalletVolumes[i].Equal(&that1.WalletVolumes[i]) {
+			return false
+		}
+	}
+	return true
 }
+func (this *MsgResolveVolumeReportDisputeResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *MsgFoundationDepositResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*MsgResolveVolumeReportDisputeResponse)
+	if !ok {
+		that2, ok := that.(MsgResolveVolumeReportDisputeResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	return n
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
 }
 
-func (m *MsgSlashingResourceNode) Size() (n int) {
-	if m == nil {
-		return 0
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	HandleMsgVolumeReport(ctx context.Context, in *MsgVolumeReport, opts ...grpc.CallOption) (*MsgVolumeReportResponse, error)
+	HandleMsgVolumeReportHeader(ctx context.Context, in *MsgVolumeReportHeader, opts ...grpc.CallOption) (*MsgVolumeReportHeaderResponse, error)
+	HandleMsgVolumeReportChunk(ctx context.Context, in *MsgVolumeReportChunk, opts ...grpc.CallOption) (*MsgVolumeReportChunkResponse, error)
+	HandleMsgWithdraw(ctx context.Context, in *MsgWithdraw, opts ...grpc.CallOption) (*MsgWithdrawResponse, error)
+	HandleMsgFoundationDeposit(ctx context.Context, in *MsgFoundationDeposit, opts ...grpc.CallOption) (*MsgFoundationDepositResponse, error)
+	HandleMsgSlashingResourceNode(ctx context.Context, in *MsgSlashingResourceNode, opts ...grpc.CallOption) (*MsgSlashingResourceNodeResponse, error)
+	HandleMsgDisputeVolumeReport(ctx context.Context, in *MsgDisputeVolumeReport, opts ...grpc.CallOption) (*MsgDisputeVolumeReportResponse, error)
+	HandleMsgSubmitReportEquivocation(ctx context.Context, in *MsgSubmitReportEquivocation, opts ...grpc.CallOption) (*MsgSubmitReportEquivocationResponse, error)
+	HandleMsgSetAutoRestake(ctx context.Context, in *MsgSetAutoRestake, opts ...grpc.CallOption) (*MsgSetAutoRestakeResponse, error)
+	// ResolveVolumeReportDispute defines a governance operation for resolving a disputed volume report.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	ResolveVolumeReportDispute(ctx context.Context, in *MsgResolveVolumeReportDispute, opts ...grpc.CallOption) (*MsgResolveVolumeReportDisputeResponse, error)
+	// UpdateParams defined a governance operation for updating the x/pot module parameters.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) HandleMsgVolumeReport(ctx context.Context, in *MsgVolumeReport, opts ...grpc.CallOption) (*MsgVolumeReportResponse, error) {
+	out := new(MsgVolumeReportResponse)
+	err := c.cc.Invoke(ctx, "/stratos.pot.v1.Msg/HandleMsgVolumeReport", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Reporters) > 0 {
-		for _, s := range m.Reporters {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
+	return out, nil
+}
+
+func (c *msgClient) HandleMsgVolumeReportHeader(ctx context.Context, in *MsgVolumeReportHeader, opts ...grpc.CallOption) (*MsgVolumeReportHeaderResponse, error) {
+	out := new(MsgVolumeReportHeaderResponse)
+	err := c.cc.Invoke(ctx, "/stratos.pot.v1.Msg/HandleMsgVolumeReportHeader", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.ReporterOwner) > 0 {
-		for _, s := range m.ReporterOwner {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
+	return out, nil
+}
+
+func (c *msgClient) HandleMsgVolumeReportChunk(ctx context.Context, in *MsgVolumeReportChunk, opts ...grpc.CallOption) (*MsgVolumeReportChunkResponse, error) {
+	out := new(MsgVolumeReportChunkResponse)
+	err := c.cc.Invoke(ctx, "/stratos.pot.v1.Msg/HandleMsgVolumeReportChunk", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.NetworkAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) HandleMsgWithdraw(ctx context.Context, in *MsgWithdraw, opts ...grpc.CallOption) (*MsgWithdrawResponse, error) {
+	out := new(MsgWithdrawResponse)
+	err := c.cc.Invoke(ctx, "/stratos.pot.v1.Msg/HandleMsgWithdraw", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.WalletAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) HandleMsgFoundationDeposit(ctx context.Context, in *MsgFoundationDeposit, opts ...grpc.CallOption) (*MsgFoundationDepositResponse, error) {
+	out := new(MsgFoundationDepositResponse)
+	err := c.cc.Invoke(ctx, "/stratos.pot.v1.Msg/HandleMsgFoundationDeposit", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = m.Slashing.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.Suspend {
-		n += 2
+	return out, nil
+}
+
+func (c *msgClient) HandleMsgSlashingResourceNode(ctx context.Context, in *MsgSlashingResourceNode, opts ...grpc.CallOption) (*MsgSlashingResourceNodeResponse, error) {
+	out := new(MsgSlashingResourceNodeResponse)
+	err := c.cc.Invoke(ctx, "/stratos.pot.v1.Msg/HandleMsgSlashingResourceNode", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgSlashingResourceNodeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) HandleMsgDisputeVolumeReport(ctx context.Context, in *MsgDisputeVolumeReport, opts ...grpc.CallOption) (*MsgDisputeVolumeReportResponse, error) {
+	out := new(MsgDisputeVolumeReportResponse)
+	err := c.cc.Invoke(ctx, "/stratos.pot.v1.Msg/HandleMsgDisputeVolumeReport", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *BLSSignatureInfo) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) HandleMsgSubmitReportEquivocation(ctx context.Context, in *MsgSubmitReportEquivocation, opts ...grpc.CallOption) (*MsgSubmitReportEquivocationResponse, error) {
+	out := new(MsgSubmitReportEquivocationResponse)
+	err := c.cc.Invoke(ctx, "/stratos.pot.v1.Msg/HandleMsgSubmitReportEquivocation", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.PubKeys) > 0 {
-		for _, b := range m.PubKeys {
-			l = len(b)
-			n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) HandleMsgSetAutoRestake(ctx context.Context, in *MsgSetAutoRestake, opts ...grpc.CallOption) (*MsgSetAutoRestakeResponse, error) {
+	out := new(MsgSetAutoRestakeResponse)
+	err := c.cc.Invoke(ctx, "/stratos.pot.v1.Msg/HandleMsgSetAutoRestake", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ResolveVolumeReportDispute(ctx context.Context, in *MsgResolveVolumeReportDispute, opts ...grpc.CallOption) (*MsgResolveVolumeReportDisputeResponse, error) {
+	out := new(MsgResolveVolumeReportDisputeResponse)
+	err := c.cc.Invoke(ctx, "/stratos.pot.v1.Msg/ResolveVolumeReportDispute", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/stratos.pot.v1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	HandleMsgVolumeReport(context.Context, *MsgVolumeReport) (*MsgVolumeReportResponse, error)
+	HandleMsgVolumeReportHeader(context.Context, *MsgVolumeReportHeader) (*MsgVolumeReportHeaderResponse, error)
+	HandleMsgVolumeReportChunk(context.Context, *MsgVolumeReportChunk) (*MsgVolumeReportChunkResponse, error)
+	HandleMsgWithdraw(context.Context, *MsgWithdraw) (*MsgWithdrawResponse, error)
+	HandleMsgFoundationDeposit(context.Context, *MsgFoundationDeposit) (*MsgFoundationDepositResponse, error)
+	HandleMsgSlashingResourceNode(context.Context, *MsgSlashingResourceNode) (*MsgSlashingResourceNodeResponse, error)
+	HandleMsgDisputeVolumeReport(context.Context, *MsgDisputeVolumeReport) (*MsgDisputeVolumeReportResponse, error)
+	HandleMsgSubmitReportEquivocation(context.Context, *MsgSubmitReportEquivocation) (*MsgSubmitReportEquivocationResponse, error)
+	HandleMsgSetAutoRestake(context.Context, *MsgSetAutoRestake) (*MsgSetAutoRestakeResponse, error)
+	// ResolveVolumeReportDispute defines a governance operation for resolving a disputed volume report.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	ResolveVolumeReportDispute(context.Context, *MsgResolveVolumeReportDispute) (*MsgResolveVolumeReportDisputeResponse, error)
+	// UpdateParams defined a governance operation for updating the x/pot module parameters.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) HandleMsgVolumeReport(ctx context.Context, req *MsgVolumeReport) (*MsgVolumeReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HandleMsgVolumeReport not implemented")
+}
+func (*UnimplementedMsgServer) HandleMsgVolumeReportHeader(ctx context.Context, req *MsgVolumeReportHeader) (*MsgVolumeReportHeaderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HandleMsgVolumeReportHeader not implemented")
+}
+func (*UnimplementedMsgServer) HandleMsgVolumeReportChunk(ctx context.Context, req *MsgVolumeReportChunk) (*MsgVolumeReportChunkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HandleMsgVolumeReportChunk not implemented")
+}
+func (*UnimplementedMsgServer) HandleMsgWithdraw(ctx context.Context, req *MsgWithdraw) (*MsgWithdrawResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HandleMsgWithdraw not implemented")
+}
+func (*UnimplementedMsgServer) HandleMsgFoundationDeposit(ctx context.Context, req *MsgFoundationDeposit) (*MsgFoundationDepositResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HandleMsgFoundationDeposit not implemented")
+}
+func (*UnimplementedMsgServer) HandleMsgSlashingResourceNode(ctx context.Context, req *MsgSlashingResourceNode) (*MsgSlashingResourceNodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HandleMsgSlashingResourceNode not implemented")
+}
+func (*UnimplementedMsgServer) HandleMsgDisputeVolumeReport(ctx context.Context, req *MsgDisputeVolumeReport) (*MsgDisputeVolumeReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HandleMsgDisputeVolumeReport not implemented")
+}
+func (*UnimplementedMsgServer) HandleMsgSubmitReportEquivocation(ctx context.Context, req *MsgSubmitReportEquivocation) (*MsgSubmitReportEquivocationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HandleMsgSubmitReportEquivocation not implemented")
+}
+func (*UnimplementedMsgServer) HandleMsgSetAutoRestake(ctx context.Context, req *MsgSetAutoRestake) (*MsgSetAutoRestakeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HandleMsgSetAutoRestake not implemented")
+}
+func (*UnimplementedMsgServer) ResolveVolumeReportDispute(ctx context.Context, req *MsgResolveVolumeReportDispute) (*MsgResolveVolumeReportDisputeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveVolumeReportDispute not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_HandleMsgVolumeReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgVolumeReport)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).HandleMsgVolumeReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stratos.pot.v1.Msg/HandleMsgVolumeReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).HandleMsgVolumeReport(ctx, req.(*MsgVolumeReport))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_HandleMsgVolumeReportHeader_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgVolumeReportHeader)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).HandleMsgVolumeReportHeader(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stratos.pot.v1.Msg/HandleMsgVolumeReportHeader",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).HandleMsgVolumeReportHeader(ctx, req.(*MsgVolumeReportHeader))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_HandleMsgVolumeReportChunk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgVolumeReportChunk)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).HandleMsgVolumeReportChunk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stratos.pot.v1.Msg/HandleMsgVolumeReportChunk",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).HandleMsgVolumeReportChunk(ctx, req.(*MsgVolumeReportChunk))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_HandleMsgWithdraw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdraw)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).HandleMsgWithdraw(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stratos.pot.v1.Msg/HandleMsgWithdraw",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).HandleMsgWithdraw(ctx, req.(*MsgWithdraw))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_HandleMsgFoundationDeposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFoundationDeposit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).HandleMsgFoundationDeposit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stratos.pot.v1.Msg/HandleMsgFoundationDeposit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).HandleMsgFoundationDeposit(ctx, req.(*MsgFoundationDeposit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_HandleMsgSlashingResourceNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSlashingResourceNode)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).HandleMsgSlashingResourceNode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stratos.pot.v1.Msg/HandleMsgSlashingResourceNode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).HandleMsgSlashingResourceNode(ctx, req.(*MsgSlashingResourceNode))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_HandleMsgDisputeVolumeReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDisputeVolumeReport)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).HandleMsgDisputeVolumeReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stratos.pot.v1.Msg/HandleMsgDisputeVolumeReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).HandleMsgDisputeVolumeReport(ctx, req.(*MsgDisputeVolumeReport))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_HandleMsgSubmitReportEquivocation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitReportEquivocation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).HandleMsgSubmitReportEquivocation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stratos.pot.v1.Msg/HandleMsgSubmitReportEquivocation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).HandleMsgSubmitReportEquivocation(ctx, req.(*MsgSubmitReportEquivocation))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_HandleMsgSetAutoRestake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAutoRestake)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).HandleMsgSetAutoRestake(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stratos.pot.v1.Msg/HandleMsgSetAutoRestake",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).HandleMsgSetAutoRestake(ctx, req.(*MsgSetAutoRestake))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ResolveVolumeReportDispute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgResolveVolumeReportDispute)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ResolveVolumeReportDispute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stratos.pot.v1.Msg/ResolveVolumeReportDispute",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ResolveVolumeReportDispute(ctx, req.(*MsgResolveVolumeReportDispute))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stratos.pot.v1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "stratos.pot.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "HandleMsgVolumeReport",
+			Handler:    _Msg_HandleMsgVolumeReport_Handler,
+		},
+		{
+			MethodName: "HandleMsgVolumeReportHeader",
+			Handler:    _Msg_HandleMsgVolumeReportHeader_Handler,
+		},
+		{
+			MethodName: "HandleMsgVolumeReportChunk",
+			Handler:    _Msg_HandleMsgVolumeReportChunk_Handler,
+		},
+		{
+			MethodName: "HandleMsgWithdraw",
+			Handler:    _Msg_HandleMsgWithdraw_Handler,
+		},
+		{
+			MethodName: "HandleMsgFoundationDeposit",
+			Handler:    _Msg_HandleMsgFoundationDeposit_Handler,
+		},
+		{
+			MethodName: "HandleMsgSlashingResourceNode",
+			Handler:    _Msg_HandleMsgSlashingResourceNode_Handler,
+		},
+		{
+			MethodName: "HandleMsgDisputeVolumeReport",
+			Handler:    _Msg_HandleMsgDisputeVolumeReport_Handler,
+		},
+		{
+			MethodName: "HandleMsgSubmitReportEquivocation",
+			Handler:    _Msg_HandleMsgSubmitReportEquivocation_Handler,
+		},
+		{
+			MethodName: "HandleMsgSetAutoRestake",
+			Handler:    _Msg_HandleMsgSetAutoRestake_Handler,
+		},
+		{
+			MethodName: "ResolveVolumeReportDispute",
+			Handler:    _Msg_ResolveVolumeReportDispute_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "stratos/pot/v1/tx.proto",
+}
+
+func (m *MsgVolumeReport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVolumeReport) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVolumeReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.BLSSignature.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.ReporterOwner) > 0 {
+		i -= len(m.ReporterOwner)
+		copy(dAtA[i:], m.ReporterOwner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ReporterOwner)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ReportReference) > 0 {
+		i -= len(m.ReportReference)
+		copy(dAtA[i:], m.ReportReference)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ReportReference)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.Epoch.Size()
+		i -= size
+		if _, err := m.Epoch.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Reporter) > 0 {
+		i -= len(m.Reporter)
+		copy(dAtA[i:], m.Reporter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reporter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.WalletVolumes) > 0 {
+		for iNdEx := len(m.WalletVolumes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WalletVolumes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgVolumeReportResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVolumeReportResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVolumeReportResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgVolumeReportHeader) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVolumeReportHeader) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVolumeReportHeader) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.BLSSignature.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if len(m.VolumesRoot) > 0 {
+		i -= len(m.VolumesRoot)
+		copy(dAtA[i:], m.VolumesRoot)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VolumesRoot)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.TotalCount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TotalCount))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.ReporterOwner) > 0 {
+		i -= len(m.ReporterOwner)
+		copy(dAtA[i:], m.ReporterOwner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ReporterOwner)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ReportReference) > 0 {
+		i -= len(m.ReportReference)
+		copy(dAtA[i:], m.ReportReference)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ReportReference)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.Epoch.Size()
+		i -= size
+		if _, err := m.Epoch.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Reporter) > 0 {
+		i -= len(m.Reporter)
+		copy(dAtA[i:], m.Reporter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reporter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgVolumeReportHeaderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVolumeReportHeaderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVolumeReportHeaderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgVolumeReportChunk) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVolumeReportChunk) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVolumeReportChunk) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Proofs) > 0 {
+		for iNdEx := len(m.Proofs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Proofs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.WalletVolumes) > 0 {
+		for iNdEx := len(m.WalletVolumes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WalletVolumes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.StartIndex != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StartIndex))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.Epoch.Size()
+		i -= size
+		if _, err := m.Epoch.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ReporterOwner) > 0 {
+		i -= len(m.ReporterOwner)
+		copy(dAtA[i:], m.ReporterOwner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ReporterOwner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Reporter) > 0 {
+		i -= len(m.Reporter)
+		copy(dAtA[i:], m.Reporter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reporter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgVolumeReportChunkResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVolumeReportChunkResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVolumeReportChunkResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Finalized {
+		i--
+		if m.Finalized {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdraw) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdraw) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdraw) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TargetAddress) > 0 {
+		i -= len(m.TargetAddress)
+		copy(dAtA[i:], m.TargetAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TargetAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.WalletAddress) > 0 {
+		i -= len(m.WalletAddress)
+		copy(dAtA[i:], m.WalletAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.WalletAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFoundationDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFoundationDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFoundationDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFoundationDepositResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFoundationDepositResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFoundationDepositResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSlashingResourceNode) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSlashingResourceNode) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSlashingResourceNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Suspend {
+		i--
+		if m.Suspend {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.Slashing.Size()
+		i -= size
+		if _, err := m.Slashing.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.WalletAddress) > 0 {
+		i -= len(m.WalletAddress)
+		copy(dAtA[i:], m.WalletAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.WalletAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.NetworkAddress) > 0 {
+		i -= len(m.NetworkAddress)
+		copy(dAtA[i:], m.NetworkAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NetworkAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ReporterOwner) > 0 {
+		for iNdEx := len(m.ReporterOwner) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ReporterOwner[iNdEx])
+			copy(dAtA[i:], m.ReporterOwner[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.ReporterOwner[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Reporters) > 0 {
+		for iNdEx := len(m.Reporters) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Reporters[iNdEx])
+			copy(dAtA[i:], m.Reporters[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Reporters[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSlashingResourceNodeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSlashingResourceNodeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSlashingResourceNodeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDisputeVolumeReport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDisputeVolumeReport) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDisputeVolumeReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.BLSSignature.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.WalletVolumes) > 0 {
+		for iNdEx := len(m.WalletVolumes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WalletVolumes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size := m.Epoch.Size()
+		i -= size
+		if _, err := m.Epoch.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Reporter) > 0 {
+		i -= len(m.Reporter)
+		copy(dAtA[i:], m.Reporter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reporter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Disputer) > 0 {
+		i -= len(m.Disputer)
+		copy(dAtA[i:], m.Disputer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Disputer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDisputeVolumeReportResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDisputeVolumeReportResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDisputeVolumeReportResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitReportEquivocation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitReportEquivocation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitReportEquivocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SecondChunked {
+		i--
+		if m.SecondChunked {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.FirstChunked {
+		i--
+		if m.FirstChunked {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.Kick {
+		i--
+		if m.Kick {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.AccusedSignature) > 0 {
+		i -= len(m.AccusedSignature)
+		copy(dAtA[i:], m.AccusedSignature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AccusedSignature)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.AccusedPubKey) > 0 {
+		i -= len(m.AccusedPubKey)
+		copy(dAtA[i:], m.AccusedPubKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AccusedPubKey)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	{
+		size, err := m.SecondSignature.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if len(m.SecondReport) > 0 {
+		i -= len(m.SecondReport)
+		copy(dAtA[i:], m.SecondReport)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SecondReport)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	{
+		size, err := m.FirstSignature.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.FirstReport) > 0 {
+		i -= len(m.FirstReport)
+		copy(dAtA[i:], m.FirstReport)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FirstReport)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size := m.Epoch.Size()
+		i -= size
+		if _, err := m.Epoch.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Accused) > 0 {
+		i -= len(m.Accused)
+		copy(dAtA[i:], m.Accused)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Accused)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ReporterOwner) > 0 {
+		i -= len(m.ReporterOwner)
+		copy(dAtA[i:], m.ReporterOwner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ReporterOwner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Reporter) > 0 {
+		i -= len(m.Reporter)
+		copy(dAtA[i:], m.Reporter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reporter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitReportEquivocationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitReportEquivocationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitReportEquivocationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAutoRestake) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAutoRestake) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAutoRestake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NetworkAddress) > 0 {
+		i -= len(m.NetworkAddress)
+		copy(dAtA[i:], m.NetworkAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NetworkAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.WalletAddress) > 0 {
+		i -= len(m.WalletAddress)
+		copy(dAtA[i:], m.WalletAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.WalletAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAutoRestakeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAutoRestakeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAutoRestakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *BLSSignatureInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BLSSignatureInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BLSSignatureInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxData) > 0 {
+		i -= len(m.TxData)
+		copy(dAtA[i:], m.TxData)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TxData)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PubKeys) > 0 {
+		for iNdEx := len(m.PubKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PubKeys[iNdEx])
+			copy(dAtA[i:], m.PubKeys[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.PubKeys[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResolveVolumeReportDispute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResolveVolumeReportDispute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResolveVolumeReportDispute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WalletVolumes) > 0 {
+		for iNdEx := len(m.WalletVolumes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WalletVolumes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Cancel {
+		i--
+		if m.Cancel {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Epoch.Size()
+		i -= size
+		if _, err := m.Epoch.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResolveVolumeReportDisputeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResolveVolumeReportDisputeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResolveVolumeReportDisputeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgVolumeReport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.WalletVolumes) > 0 {
+		for _, e := range m.WalletVolumes {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Reporter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Epoch.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.ReportReference)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ReporterOwner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.BLSSignature.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgVolumeReportResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgVolumeReportHeader) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Reporter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Epoch.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.ReportReference)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ReporterOwner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TotalCount != 0 {
+		n += 1 + sovTx(uint64(m.TotalCount))
+	}
+	l = len(m.VolumesRoot)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.BLSSignature.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgVolumeReportHeaderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgVolumeReportChunk) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Reporter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ReporterOwner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Epoch.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.StartIndex != 0 {
+		n += 1 + sovTx(uint64(m.StartIndex))
+	}
+	if len(m.WalletVolumes) > 0 {
+		for _, e := range m.WalletVolumes {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Proofs) > 0 {
+		for _, e := range m.Proofs {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgVolumeReportChunkResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Finalized {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgWithdraw) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.WalletAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TargetAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWithdrawResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgFoundationDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgFoundationDepositResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSlashingResourceNode) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Reporters) > 0 {
+		for _, s := range m.Reporters {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.ReporterOwner) > 0 {
+		for _, s := range m.ReporterOwner {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.NetworkAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.WalletAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Slashing.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Suspend {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSlashingResourceNodeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDisputeVolumeReport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Disputer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Reporter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Epoch.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.WalletVolumes) > 0 {
+		for _, e := range m.WalletVolumes {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.BLSSignature.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgDisputeVolumeReportResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSubmitReportEquivocation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Reporter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ReporterOwner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Accused)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Epoch.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.FirstReport)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.FirstSignature.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.SecondReport)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.SecondSignature.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.AccusedPubKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.AccusedSignature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Kick {
+		n += 2
+	}
+	if m.FirstChunked {
+		n += 2
+	}
+	if m.SecondChunked {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSubmitReportEquivocationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetAutoRestake) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.WalletAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NetworkAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetAutoRestakeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *BLSSignatureInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PubKeys) > 0 {
+		for _, b := range m.PubKeys {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TxData)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgResolveVolumeReportDispute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Epoch.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Cancel {
+		n += 2
+	}
+	if len(m.WalletVolumes) > 0 {
+		for _, e := range m.WalletVolumes {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgResolveVolumeReportDisputeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgVolumeReport) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVolumeReport: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVolumeReport: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WalletVolumes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WalletVolumes = append(m.WalletVolumes, SingleWalletVolume{})
+			if err := m.WalletVolumes[len(m.WalletVolumes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reporter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reporter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Epoch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReportReference", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReportReference = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReporterOwner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReporterOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BLSSignature", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BLSSignature.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgVolumeReportResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVolumeReportResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVolumeReportResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgVolumeReportHeader) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVolumeReportHeader: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVolumeReportHeader: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reporter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reporter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Epoch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReportReference", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReportReference = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReporterOwner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReporterOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalCount", wireType)
+			}
+			m.TotalCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VolumesRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VolumesRoot = append(m.VolumesRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.VolumesRoot == nil {
+				m.VolumesRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BLSSignature", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BLSSignature.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgVolumeReportHeaderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVolumeReportHeaderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVolumeReportHeaderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgVolumeReportChunk) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVolumeReportChunk: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVolumeReportChunk: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reporter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reporter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReporterOwner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReporterOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Epoch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartIndex", wireType)
+			}
+			m.StartIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WalletVolumes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WalletVolumes = append(m.WalletVolumes, SingleWalletVolume{})
+			if err := m.WalletVolumes[len(m.WalletVolumes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proofs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proofs = append(m.Proofs, VolumeInclusionProof{})
+			if err := m.Proofs[len(m.Proofs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgVolumeReportChunkResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVolumeReportChunkResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVolumeReportChunkResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Finalized", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Finalized = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdraw) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdraw: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdraw: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WalletAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WalletAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFoundationDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFoundationDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFoundationDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFoundationDepositResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFoundationDepositResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFoundationDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSlashingResourceNode) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSlashingResourceNode: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSlashingResourceNode: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reporters", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reporters = append(m.Reporters, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReporterOwner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReporterOwner = append(m.ReporterOwner, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetworkAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NetworkAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WalletAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WalletAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slashing", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Slashing.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Suspend", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Suspend = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSlashingResourceNodeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSlashingResourceNodeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSlashingResourceNodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	l = len(m.Signature)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.TxData)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
-}
-
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgVolumeReport) Unmarshal(dAtA []byte) error {
+func (m *MsgDisputeVolumeReport) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1938,17 +5777,17 @@ func (m *MsgVolumeReport) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgVolumeReport: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDisputeVolumeReport: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgVolumeReport: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDisputeVolumeReport: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WalletVolumes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Disputer", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1958,25 +5797,23 @@ func (m *MsgVolumeReport) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WalletVolumes = append(m.WalletVolumes, SingleWalletVolume{})
-			if err := m.WalletVolumes[len(m.WalletVolumes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Disputer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -2046,9 +5883,9 @@ func (m *MsgVolumeReport) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReportReference", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WalletVolumes", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2058,27 +5895,29 @@ func (m *MsgVolumeReport) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReportReference = string(dAtA[iNdEx:postIndex])
+			m.WalletVolumes = append(m.WalletVolumes, SingleWalletVolume{})
+			if err := m.WalletVolumes[len(m.WalletVolumes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReporterOwner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2106,7 +5945,7 @@ func (m *MsgVolumeReport) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReporterOwner = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
@@ -2162,7 +6001,7 @@ func (m *MsgVolumeReport) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgVolumeReportResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDisputeVolumeReportResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2185,10 +6024,10 @@ func (m *MsgVolumeReportResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgVolumeReportResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDisputeVolumeReportResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgVolumeReportResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDisputeVolumeReportResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2212,7 +6051,7 @@ func (m *MsgVolumeReportResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdraw) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitReportEquivocation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2235,17 +6074,17 @@ func (m *MsgWithdraw) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdraw: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitReportEquivocation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdraw: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitReportEquivocation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reporter", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2255,29 +6094,27 @@ func (m *MsgWithdraw) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Reporter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WalletAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReporterOwner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2305,11 +6142,11 @@ func (m *MsgWithdraw) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WalletAddress = string(dAtA[iNdEx:postIndex])
+			m.ReporterOwner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Accused", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2337,111 +6174,146 @@ func (m *MsgWithdraw) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TargetAddress = string(dAtA[iNdEx:postIndex])
+			m.Accused = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgWithdrawResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+			if err := m.Epoch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstReport", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.FirstReport = append(m.FirstReport[:0], dAtA[iNdEx:postIndex]...)
+			if m.FirstReport == nil {
+				m.FirstReport = []byte{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstSignature", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FirstSignature.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondReport", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgFoundationDeposit) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.SecondReport = append(m.SecondReport[:0], dAtA[iNdEx:postIndex]...)
+			if m.SecondReport == nil {
+				m.SecondReport = []byte{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFoundationDeposit: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFoundationDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondSignature", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2468,16 +6340,15 @@ func (m *MsgFoundationDeposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SecondSignature.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccusedPubKey", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2487,24 +6358,120 @@ func (m *MsgFoundationDeposit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.From = string(dAtA[iNdEx:postIndex])
+			m.AccusedPubKey = append(m.AccusedPubKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.AccusedPubKey == nil {
+				m.AccusedPubKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccusedSignature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccusedSignature = append(m.AccusedSignature[:0], dAtA[iNdEx:postIndex]...)
+			if m.AccusedSignature == nil {
+				m.AccusedSignature = []byte{}
+			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kick", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Kick = bool(v != 0)
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstChunked", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FirstChunked = bool(v != 0)
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondChunked", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SecondChunked = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2526,7 +6493,7 @@ func (m *MsgFoundationDeposit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgFoundationDepositResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitReportEquivocationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2549,10 +6516,10 @@ func (m *MsgFoundationDepositResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFoundationDepositResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitReportEquivocationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFoundationDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitReportEquivocationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2576,7 +6543,7 @@ func (m *MsgFoundationDepositResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSlashingResourceNode) Unmarshal(dAtA []byte) error {
+func (m *MsgSetAutoRestake) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2593,115 +6560,19 @@ func (m *MsgSlashingResourceNode) Unmarshal(dAtA []byte) error {
 			iNdEx++
 			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSlashingResourceNode: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSlashingResourceNode: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reporters", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Reporters = append(m.Reporters, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReporterOwner", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ReporterOwner = append(m.ReporterOwner, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NetworkAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+				break
 			}
-			m.NetworkAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetAutoRestake: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetAutoRestake: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field WalletAddress", wireType)
 			}
@@ -2733,9 +6604,9 @@ func (m *MsgSlashingResourceNode) Unmarshal(dAtA []byte) error {
 			}
 			m.WalletAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Slashing", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NetworkAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2763,30 +6634,8 @@ func (m *MsgSlashingResourceNode) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Slashing.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.NetworkAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Suspend", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Suspend = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2808,7 +6657,7 @@ func (m *MsgSlashingResourceNode) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSlashingResourceNodeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetAutoRestakeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2831,10 +6680,10 @@ func (m *MsgSlashingResourceNodeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSlashingResourceNodeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetAutoRestakeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSlashingResourceNodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetAutoRestakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3173,6 +7022,226 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgResolveVolumeReportDispute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgResolveVolumeReportDispute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgResolveVolumeReportDispute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Epoch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cancel", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Cancel = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WalletVolumes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WalletVolumes = append(m.WalletVolumes, SingleWalletVolume{})
+			if err := m.WalletVolumes[len(m.WalletVolumes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgResolveVolumeReportDisputeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgResolveVolumeReportDisputeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgResolveVolumeReportDisputeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
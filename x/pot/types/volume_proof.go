@@ -0,0 +1,114 @@
+package types
+
+import (
+	"bytes"
+	"crypto/sha256"
+
+	"github.com/cosmos/gogoproto/proto"
+)
+
+// VolumesRootLength is the length of the merkle root and of the proof hashes of the wallet volumes
+const VolumesRootLength = sha256.Size
+
+var (
+	volumeLeafPrefix = []byte{0x00}
+	volumeNodePrefix = []byte{0x01}
+)
+
+// HashWalletVolumeLeaf returns the leaf hash of a wallet volume in the volumes tree
+func HashWalletVolumeLeaf(volume SingleWalletVolume) []byte {
+	bz, err := proto.Marshal(&volume)
+	if err != nil {
+		panic(err)
+	}
+	hash := sha256.Sum256(append(append([]byte{}, volumeLeafPrefix...), bz...))
+	return hash[:]
+}
+
+func hashWalletVolumeNode(left, right []byte) []byte {
+	data := make([]byte, 0, len(volumeNodePrefix)+len(left)+len(right))
+	data = append(data, volumeNodePrefix...)
+	data = append(data, left...)
+	data = append(data, right...)
+	hash := sha256.Sum256(data)
+	return hash[:]
+}
+
+// nextVolumesLevel hashes a level of the volumes tree into its parent level,
+// the last node of a level holding an odd number of nodes is paired with itself
+func nextVolumesLevel(level [][]byte) [][]byte {
+	parent := make([][]byte, 0, (len(level)+1)/2)
+	for i := 0; i < len(level); i += 2 {
+		right := level[i]
+		if i+1 < len(level) {
+			right = level[i+1]
+		}
+		parent = append(parent, hashWalletVolumeNode(level[i], right))
+	}
+	return parent
+}
+
+func walletVolumeLeaves(volumes []SingleWalletVolume) [][]byte {
+	leaves := make([][]byte, 0, len(volumes))
+	for _, volume := range volumes {
+		leaves = append(leaves, HashWalletVolumeLeaf(volume))
+	}
+	return leaves
+}
+
+// GetWalletVolumesRoot returns the merkle root committed in the header of a chunked volume report
+func GetWalletVolumesRoot(volumes []SingleWalletVolume) []byte {
+	if len(volumes) == 0 {
+		return nil
+	}
+	level := walletVolumeLeaves(volumes)
+	for len(level) > 1 {
+		level = nextVolumesLevel(level)
+	}
+	return level[0]
+}
+
+// GetWalletVolumeProof returns the inclusion proof of the wallet volume at index,
+// listing the sibling hashes from the leaf up to the root
+func GetWalletVolumeProof(volumes []SingleWalletVolume, index uint64) VolumeInclusionProof {
+	proof := VolumeInclusionProof{}
+	if index >= uint64(len(volumes)) {
+		return proof
+	}
+	level := walletVolumeLeaves(volumes)
+	for len(level) > 1 {
+		sibling := index ^ 1
+		if sibling >= uint64(len(level)) {
+			sibling = index
+		}
+		proof.Hashes = append(proof.Hashes, level[sibling])
+		level = nextVolumesLevel(level)
+		index /= 2
+	}
+	return proof
+}
+
+// VerifyWalletVolumeProof verifies the inclusion proof of the wallet volume at index against the volumes root
+func VerifyWalletVolumeProof(volumesRoot []byte, totalCount, index uint64, volume SingleWalletVolume, proof VolumeInclusionProof) bool {
+	if index >= totalCount {
+		return false
+	}
+	depth := 0
+	for width := uint64(1); width < totalCount; width <<= 1 {
+		depth++
+	}
+	if len(proof.GetHashes()) != depth {
+		return false
+	}
+
+	hash := HashWalletVolumeLeaf(volume)
+	for _, sibling := range proof.GetHashes() {
+		if index%2 == 0 {
+			hash = hashWalletVolumeNode(hash, sibling)
+		} else {
+			hash = hashWalletVolumeNode(sibling, hash)
+		}
+		index /= 2
+	}
+	return bytes.Equal(hash, volumesRoot)
+}
@@ -18,6 +18,28 @@ func (m *MsgVolumeReport) GetWeb3Msg() (*stratos.Web3Msg, error) {
 	}, nil
 }
 
+func (m *MsgVolumeReportHeader) GetWeb3Msg() (*stratos.Web3Msg, error) {
+	bFrom, err := sdk.AccAddressFromBech32(m.GetReporterOwner())
+	if err != nil {
+		return nil, err
+	}
+	from := common.BytesToAddress(bFrom.Bytes())
+	return &stratos.Web3Msg{
+		From: &from,
+	}, nil
+}
+
+func (m *MsgVolumeReportChunk) GetWeb3Msg() (*stratos.Web3Msg, error) {
+	bFrom, err := sdk.AccAddressFromBech32(m.GetReporterOwner())
+	if err != nil {
+		return nil, err
+	}
+	from := common.BytesToAddress(bFrom.Bytes())
+	return &stratos.Web3Msg{
+		From: &from,
+	}, nil
+}
+
 func (m *MsgWithdraw) GetWeb3Msg() (*stratos.Web3Msg, error) {
 	bFrom, err := sdk.AccAddressFromBech32(m.GetWalletAddress())
 	if err != nil {
@@ -55,3 +77,36 @@ func (m *MsgFoundationDeposit) GetWeb3Msg() (*stratos.Web3Msg, error) {
 func (m *MsgSlashingResourceNode) GetWeb3Msg() (*stratos.Web3Msg, error) {
 	return nil, nil // SKIP
 }
+
+func (m *MsgDisputeVolumeReport) GetWeb3Msg() (*stratos.Web3Msg, error) {
+	bFrom, err := sdk.AccAddressFromBech32(m.GetDisputer())
+	if err != nil {
+		return nil, err
+	}
+	from := common.BytesToAddress(bFrom.Bytes())
+	return &stratos.Web3Msg{
+		From: &from,
+	}, nil
+}
+
+func (m *MsgSubmitReportEquivocation) GetWeb3Msg() (*stratos.Web3Msg, error) {
+	bFrom, err := sdk.AccAddressFromBech32(m.GetReporterOwner())
+	if err != nil {
+		return nil, err
+	}
+	from := common.BytesToAddress(bFrom.Bytes())
+	return &stratos.Web3Msg{
+		From: &from,
+	}, nil
+}
+
+func (m *MsgSetAutoRestake) GetWeb3Msg() (*stratos.Web3Msg, error) {
+	bFrom, err := sdk.AccAddressFromBech32(m.GetWalletAddress())
+	if err != nil {
+		return nil, err
+	}
+	from := common.BytesToAddress(bFrom.Bytes())
+	return &stratos.Web3Msg{
+		From: &from,
+	}, nil
+}
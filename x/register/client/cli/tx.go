@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"encoding/hex"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -37,6 +38,7 @@ func NewTxCmd() *cobra.Command {
 		UpdateMetaNodeDepositCmd(),
 		MetaNodeRegistrationVoteCmd(),
 		KickMetaNodeVoteCmd(),
+		RegisterMetaNodeBLSPubKeyCmd(),
 	)
 
 	return registerTxCmd
@@ -356,6 +358,35 @@ func KickMetaNodeVoteCmd() *cobra.Command {
 	return cmd
 }
 
+// RegisterMetaNodeBLSPubKeyCmd will register the BLS public key of an owned meta node.
+func RegisterMetaNodeBLSPubKeyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-meta-node-bls-pubkey [bls-pub-key] [signature] [flags]",
+		Args:  cobra.ExactArgs(2),
+		Short: "register the BLS public key of a meta node",
+		Long: "register the hex encoded BLS public key of a meta node, " +
+			"signature is the hex encoded BLS signature of keccak256(network address bytes)",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg, err := newBuildRegisterMetaNodeBLSPubKeyMsg(clientCtx, cmd.Flags(), args[0], args[1])
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().AddFlagSet(flagSetNetworkAddress())
+	flags.AddTxFlagsToCmd(cmd)
+	_ = cmd.MarkFlagRequired(flags.FlagFrom)
+	_ = cmd.MarkFlagRequired(FlagNetworkAddress)
+	return cmd
+}
+
 // makes a new CreateResourceNodeMsg.
 func newBuildCreateResourceNodeMsg(clientCtx client.Context, fs *flag.FlagSet) (*types.MsgCreateResourceNode, error) {
 	flagAmountStr, err := fs.GetString(FlagAmount)
@@ -724,3 +755,30 @@ func newBuildKickMetaNodeVoteMsg(clientCtx client.Context, fs *flag.FlagSet) (*t
 	msg := types.NewMsgKickMetaNodeVote(targetNetworkAddress, opinionVal, voterNetworkAddr, voterOwnerAddr)
 	return msg, nil
 }
+
+func newBuildRegisterMetaNodeBLSPubKeyMsg(clientCtx client.Context, fs *flag.FlagSet, blsPubKeyStr, signatureStr string) (
+	*types.MsgRegisterMetaNodeBLSPubKey, error) {
+
+	flagNetworkAddrStr, err := fs.GetString(FlagNetworkAddress)
+	if err != nil {
+		return nil, err
+	}
+	networkAddr, err := stratos.SdsAddressFromBech32(flagNetworkAddrStr)
+	if err != nil {
+		return nil, err
+	}
+
+	blsPubKey, err := hex.DecodeString(strings.TrimPrefix(blsPubKeyStr, "0x"))
+	if err != nil {
+		return nil, err
+	}
+	signature, err := hex.DecodeString(strings.TrimPrefix(signatureStr, "0x"))
+	if err != nil {
+		return nil, err
+	}
+
+	ownerAddr := clientCtx.GetFromAddress()
+
+	msg := types.NewMsgRegisterMetaNodeBLSPubKey(networkAddr, ownerAddr, blsPubKey, signature)
+	return msg, nil
+}
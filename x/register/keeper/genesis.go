@@ -134,6 +134,9 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) {
 		initOzoneLimit = initOzoneLimit.Add(data.RemainingNozLimit)
 	}
 	k.SetRemainingOzoneLimit(ctx, initOzoneLimit)
+	if !data.PrepaidOzone.IsNil() {
+		k.SetPrepaidOzone(ctx, data.PrepaidOzone)
+	}
 
 	for _, slashing := range data.Slashing {
 		walletAddress, err := sdk.AccAddressFromBech32(slashing.GetWalletAddress())
@@ -188,6 +191,8 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) (data *types.GenesisState) {
 
 	kickMetaNodeVotePool := k.GetAllKickMetaNodeVotePool(ctx)
 
-	return types.NewGenesisState(params, resourceNodes, metaNodes, remainingNozLimit, slashingInfo, depositNozRate,
+	genesisState := types.NewGenesisState(params, resourceNodes, metaNodes, remainingNozLimit, slashingInfo, depositNozRate,
 		metaNodeRegVotePool, unbondingNodes, kickMetaNodeVotePool)
+	genesisState.PrepaidOzone = k.GetPrepaidOzone(ctx)
+	return genesisState
 }
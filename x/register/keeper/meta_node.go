@@ -3,6 +3,7 @@ package keeper
 import (
 	"errors"
 	"fmt"
+	"math"
 	"sort"
 	"sync"
 	"time"
@@ -12,6 +13,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
+	"github.com/stratosnet/stratos-chain/crypto"
+	"github.com/stratosnet/stratos-chain/crypto/bls"
 	stratos "github.com/stratosnet/stratos-chain/types"
 	"github.com/stratosnet/stratos-chain/x/register/types"
 )
@@ -43,6 +46,9 @@ func (k Keeper) SetMetaNode(ctx sdk.Context, metaNode types.MetaNode) {
 	bz := types.MustMarshalMetaNode(k.cdc, metaNode)
 	networkAddr, _ := stratos.SdsAddressFromBech32(metaNode.GetNetworkAddress())
 	store.Set(types.GetMetaNodeKey(networkAddr), bz)
+	if len(metaNode.GetBlsPubKey()) > 0 {
+		store.Set(types.GetMetaNodeBLSPubKeyKey(metaNode.GetBlsPubKey()), networkAddr.Bytes())
+	}
 }
 
 // GetAllMetaNodes get the set of all meta nodes with no limits, used during genesis dump
@@ -245,6 +251,42 @@ func (k Keeper) SubtractMetaNodeDeposit(ctx sdk.Context, metaNode types.MetaNode
 	return nil
 }
 
+// SlashMetaNode slashes a fraction of the deposit of a bonded meta node. The slashed amount is recorded as slashing of
+// the owner, so that SubtractMetaNodeDeposit transfers it to the community pool instead of refunding it to the owner.
+func (k Keeper) SlashMetaNode(ctx sdk.Context, networkAddr stratos.SdsAddress, fraction sdkmath.LegacyDec) (slashed sdk.Coin, err error) {
+	metaNode, found := k.GetMetaNode(ctx, networkAddr)
+	if !found {
+		return slashed, types.ErrNoMetaNodeFound
+	}
+	if metaNode.GetStatus() != stakingtypes.Bonded {
+		return slashed, types.ErrNoActiveTargetMetaNodeFound
+	}
+	ownerAddr, err := sdk.AccAddressFromBech32(metaNode.GetOwnerAddress())
+	if err != nil {
+		return slashed, types.ErrInvalidOwnerAddr
+	}
+
+	slashed = sdk.NewCoin(k.BondDenom(ctx), metaNode.Tokens.ToLegacyDec().Mul(fraction).TruncateInt())
+	if !slashed.IsPositive() {
+		return slashed, nil
+	}
+
+	err = k.RemoveTokenFromPoolWhileUnbondingMetaNode(ctx, slashed)
+	if err != nil {
+		return slashed, err
+	}
+	if !metaNode.Suspend {
+		k.DecreaseOzoneLimitBySubtractDeposit(ctx, slashed.Amount)
+	}
+
+	k.SetSlashing(ctx, ownerAddr, k.GetSlashing(ctx, ownerAddr).Add(slashed.Amount))
+	err = k.SubtractMetaNodeDeposit(ctx, metaNode, slashed)
+	if err != nil {
+		return slashed, err
+	}
+	return slashed, nil
+}
+
 // remove the meta node record and associated indexes
 func (k Keeper) removeMetaNode(ctx sdk.Context, addr stratos.SdsAddress) error {
 	// first retrieve the old meta node record
@@ -260,6 +302,9 @@ func (k Keeper) removeMetaNode(ctx sdk.Context, addr stratos.SdsAddress) error {
 	// delete the old meta node record
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(types.GetMetaNodeKey(addr))
+	if len(metaNode.GetBlsPubKey()) > 0 {
+		store.Delete(types.GetMetaNodeBLSPubKeyKey(metaNode.GetBlsPubKey()))
+	}
 	return nil
 }
 
@@ -366,6 +411,95 @@ func (k Keeper) UpdateMetaNode(ctx sdk.Context, description types.Description,
 	return nil
 }
 
+// RegisterMetaNodeBLSPubKey binds a BLS public key to the meta node once the signature proves possession of the key
+func (k Keeper) RegisterMetaNodeBLSPubKey(ctx sdk.Context, networkAddr stratos.SdsAddress, ownerAddr sdk.AccAddress,
+	blsPubKey, signature []byte) error {
+
+	node, found := k.GetMetaNode(ctx, networkAddr)
+	if !found {
+		return types.ErrNoMetaNodeFound
+	}
+
+	ownerAddrNode, _ := sdk.AccAddressFromBech32(node.GetOwnerAddress())
+	if !ownerAddrNode.Equals(ownerAddr) {
+		return types.ErrInvalidOwnerAddr
+	}
+
+	if holder, found := k.GetMetaNodeByBLSPubKey(ctx, blsPubKey); found && !holder.Equals(networkAddr) {
+		return types.ErrBLSPubKeyExists
+	}
+
+	verified, err := bls.Verify(crypto.Keccak256(networkAddr.Bytes()), signature, blsPubKey)
+	if err != nil {
+		return types.ErrBLSVerifyFailed.Wrap(err.Error())
+	}
+	if !verified {
+		return types.ErrBLSVerifyFailed
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	if len(node.GetBlsPubKey()) > 0 {
+		store.Delete(types.GetMetaNodeBLSPubKeyKey(node.GetBlsPubKey()))
+	}
+	node.BlsPubKey = blsPubKey
+	k.SetMetaNode(ctx, node)
+	return nil
+}
+
+// GetMetaNodeBLSPubKey returns the BLS public key registered by the meta node
+func (k Keeper) GetMetaNodeBLSPubKey(ctx sdk.Context, networkAddr stratos.SdsAddress) (blsPubKey []byte, found bool) {
+	node, found := k.GetMetaNode(ctx, networkAddr)
+	if !found || len(node.GetBlsPubKey()) == 0 {
+		return nil, false
+	}
+	return node.GetBlsPubKey(), true
+}
+
+// GetMetaNodeByBLSPubKey returns the network address of the meta node that registered the BLS public key
+func (k Keeper) GetMetaNodeByBLSPubKey(ctx sdk.Context, blsPubKey []byte) (networkAddr stratos.SdsAddress, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetMetaNodeBLSPubKeyKey(blsPubKey))
+	if bz == nil {
+		return nil, false
+	}
+	return bz, true
+}
+
+// VerifyMetaNodeThresholdSignature verifies the aggregated BLS signature of data. Every public key must be registered by
+// a distinct bonded meta node, and the signing meta nodes must reach 2/3 of all the bonded meta nodes.
+func (k Keeper) VerifyMetaNodeThresholdSignature(ctx sdk.Context, data, signature []byte, pubKeys [][]byte) error {
+	signers := make(map[string]bool, len(pubKeys))
+	for _, pubKey := range pubKeys {
+		networkAddr, found := k.GetMetaNodeByBLSPubKey(ctx, pubKey)
+		if !found {
+			return types.ErrInvalidBLSPubKey.Wrapf("BLS public key %x is not registered by any meta node", pubKey)
+		}
+		if signers[networkAddr.String()] {
+			return types.ErrInvalidBLSPubKey.Wrapf("duplicate signer %s", networkAddr.String())
+		}
+		node, found := k.GetMetaNode(ctx, networkAddr)
+		if !found || node.GetStatus() != stakingtypes.Bonded || node.GetSuspend() {
+			return types.ErrInvalidBLSPubKey.Wrapf("signer %s is not a bonded meta node", networkAddr.String())
+		}
+		signers[networkAddr.String()] = true
+	}
+
+	totalMetaNodes := k.GetBondedMetaNodeCnt(ctx).Int64()
+	threshold := int(math.Max(1, math.Floor(float64(totalMetaNodes)*2/3)))
+	if len(signers) < threshold {
+		return types.ErrBLSNotReachThreshold
+	}
+
+	verified, err := bls.Verify(data, signature, pubKeys...)
+	if err != nil {
+		return types.ErrBLSVerifyFailed.Wrap(err.Error())
+	}
+	if !verified {
+		return types.ErrBLSVerifyFailed
+	}
+	return nil
+}
+
 func (k Keeper) UpdateMetaNodeDeposit(ctx sdk.Context, networkAddr stratos.SdsAddress, ownerAddr sdk.AccAddress, depositDelta sdk.Coin) (
 	ozoneLimitChange, availableTokenAmtBefore, availableTokenAmtAfter sdkmath.Int, unbondingMatureTime time.Time, metaNode types.MetaNode, err error) {
 
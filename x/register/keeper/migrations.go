@@ -3,6 +3,7 @@ package keeper
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	v011 "github.com/stratosnet/stratos-chain/x/register/legacy/v011"
+	v012 "github.com/stratosnet/stratos-chain/x/register/legacy/v012"
 	"github.com/stratosnet/stratos-chain/x/register/types"
 )
 
@@ -21,3 +22,8 @@ func NewMigrator(keeper Keeper, legacySubspace types.ParamsSubspace) Migrator {
 func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 	return v011.MigrateStore(ctx, m.keeper.storeKey, m.legacySubspace, m.keeper.cdc)
 }
+
+// Migrate2to3 migrates from version 2 to 3.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	return v012.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc)
+}
@@ -468,6 +468,40 @@ func (k msgServer) HandleMsgUpdateMetaNode(goCtx context.Context, msg *types.Msg
 	return &types.MsgUpdateMetaNodeResponse{}, nil
 }
 
+func (k msgServer) HandleMsgRegisterMetaNodeBLSPubKey(goCtx context.Context, msg *types.MsgRegisterMetaNodeBLSPubKey) (
+	*types.MsgRegisterMetaNodeBLSPubKeyResponse, error) {
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	networkAddr, err := stratos.SdsAddressFromBech32(msg.NetworkAddress)
+	if err != nil {
+		return &types.MsgRegisterMetaNodeBLSPubKeyResponse{}, errors.Wrap(types.ErrInvalidNetworkAddr, err.Error())
+	}
+
+	ownerAddress, err := sdk.AccAddressFromBech32(msg.OwnerAddress)
+	if err != nil {
+		return &types.MsgRegisterMetaNodeBLSPubKeyResponse{}, errors.Wrap(types.ErrInvalidOwnerAddr, err.Error())
+	}
+
+	err = k.RegisterMetaNodeBLSPubKey(ctx, networkAddr, ownerAddress, msg.GetBlsPubKey(), msg.GetSignature())
+	if err != nil {
+		return nil, err
+	}
+
+	err = ctx.EventManager().EmitTypedEvents(
+		&types.EventRegisterMetaNodeBLSPubKey{
+			Sender:         msg.GetOwnerAddress(),
+			NetworkAddress: msg.GetNetworkAddress(),
+			BlsPubKey:      hex.EncodeToString(msg.GetBlsPubKey()),
+		},
+	)
+	if err != nil {
+		return nil, errors.Wrap(types.ErrEmitEvent, err.Error())
+	}
+
+	return &types.MsgRegisterMetaNodeBLSPubKeyResponse{}, nil
+}
+
 func (k msgServer) HandleMsgUpdateMetaNodeDeposit(goCtx context.Context, msg *types.MsgUpdateMetaNodeDeposit) (
 	*types.MsgUpdateMetaNodeDepositResponse, error) {
 
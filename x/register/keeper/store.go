@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"time"
 
+	"cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -49,6 +50,42 @@ func (k Keeper) GetRemainingOzoneLimit(ctx sdk.Context) (value sdkmath.Int) {
 	return
 }
 
+// GetPrepaidOzone returns the ozone issued to the noz ledger by prepay and not returned yet
+func (k Keeper) GetPrepaidOzone(ctx sdk.Context) (value sdkmath.Int) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.PrepaidOzoneKey)
+	if b == nil {
+		return sdkmath.ZeroInt()
+	}
+	intVal := stratos.Int{}
+	k.cdc.MustUnmarshalLengthPrefixed(b, &intVal)
+	value = *intVal.Value
+	return
+}
+
+func (k Keeper) SetPrepaidOzone(ctx sdk.Context, value sdkmath.Int) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshalLengthPrefixed(&stratos.Int{Value: &value})
+	store.Set(types.PrepaidOzoneKey, b)
+}
+
+// IssuePrepaidOzone takes the ozone purchased by a prepay out of the remaining ozone limit
+func (k Keeper) IssuePrepaidOzone(ctx sdk.Context, amount sdkmath.Int) error {
+	remaining := k.GetRemainingOzoneLimit(ctx)
+	if amount.GT(remaining) {
+		return errors.Wrapf(types.ErrRemainingNozLimit, "issued ozone %s exceeds remaining ozone limit %s", amount, remaining)
+	}
+	k.SetRemainingOzoneLimit(ctx, remaining.Sub(amount))
+	k.SetPrepaidOzone(ctx, k.GetPrepaidOzone(ctx).Add(amount))
+	return nil
+}
+
+// ReturnPrepaidOzone gives the prepaid ozone refunded or consumed from the noz ledger back to the remaining ozone limit
+func (k Keeper) ReturnPrepaidOzone(ctx sdk.Context, amount sdkmath.Int) {
+	k.SetRemainingOzoneLimit(ctx, k.GetRemainingOzoneLimit(ctx).Add(amount))
+	k.SetPrepaidOzone(ctx, k.GetPrepaidOzone(ctx).Sub(amount))
+}
+
 func (k *Keeper) GetPrepayParams(ctx sdk.Context) (St, Pt, Lt sdkmath.Int) {
 	St = k.GetEffectiveTotalDeposit(ctx)
 	Pt = k.GetTotalUnissuedPrepay(ctx).Amount
@@ -0,0 +1,32 @@
+package v012
+
+import (
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stratos "github.com/stratosnet/stratos-chain/types"
+	"github.com/stratosnet/stratos-chain/x/register/types"
+)
+
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.Codec) error {
+	store := ctx.KVStore(storeKey)
+
+	// init prepaid ozone
+	migratePrepaidOzone(store, cdc)
+
+	return nil
+}
+
+// migratePrepaidOzone will set the prepaid ozone to zero.
+// The ozone purchased before the noz ledger was only emitted in events and consumed off-chain, so there is no
+// per wallet record to seed the ledger balances from. Both the ledger and the prepaid ozone start from zero,
+// which keeps the noz ledger supply invariant of x/sds consistent.
+func migratePrepaidOzone(store storetypes.KVStore, cdc codec.Codec) {
+	if store.Has(types.PrepaidOzoneKey) {
+		return
+	}
+	zero := sdkmath.ZeroInt()
+	bz := cdc.MustMarshalLengthPrefixed(&stratos.Int{Value: &zero})
+	store.Set(types.PrepaidOzoneKey, bz)
+}
@@ -25,6 +25,7 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(MsgUpdateMetaNode{}, "register/UpdateMetaNodeTx", nil)
 	cdc.RegisterConcrete(MsgUpdateMetaNodeDeposit{}, "register/UpdateMetaNodeDepositTx", nil)
 	cdc.RegisterConcrete(MsgMetaNodeRegistrationVote{}, "register/MsgMetaNodeRegistrationVote", nil)
+	cdc.RegisterConcrete(MsgRegisterMetaNodeBLSPubKey{}, "register/MsgRegisterMetaNodeBLSPubKey", nil)
 
 	cdc.RegisterConcrete(MsgUpdateParams{}, "register/UpdateParamsTx", nil)
 }
@@ -41,6 +42,7 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgUpdateMetaNode{},
 		&MsgUpdateMetaNodeDeposit{},
 		&MsgMetaNodeRegistrationVote{},
+		&MsgRegisterMetaNodeBLSPubKey{},
 		&MsgUpdateParams{},
 		&v1_1.MsgCreateResourceNode{},
 		&v1_1.MsgUpdateResourceNode{},
@@ -71,6 +71,10 @@ const (
 	codeErrInvalidTargetNetworkAddr
 	codeErrNoActiveTargetMetaNodeFound
 	codeErrNoActiveVoterMetaNodeFound
+	codeErrInvalidBLSPubKey
+	codeErrBLSPubKeyExists
+	codeErrBLSVerifyFailed
+	codeErrBLSNotReachThreshold
 )
 
 var (
@@ -140,4 +144,8 @@ var (
 	ErrInvalidTargetNetworkAddr           = errors.Register(ModuleName, codeErrInvalidTargetNetworkAddr, "invalid target network address")
 	ErrNoActiveTargetMetaNodeFound        = errors.Register(ModuleName, codeErrNoActiveTargetMetaNodeFound, "target meta node does not exist or not active")
 	ErrNoActiveVoterMetaNodeFound         = errors.Register(ModuleName, codeErrNoActiveVoterMetaNodeFound, "voter meta node does not exist or not active")
+	ErrInvalidBLSPubKey                   = errors.Register(ModuleName, codeErrInvalidBLSPubKey, "invalid BLS public key")
+	ErrBLSPubKeyExists                    = errors.Register(ModuleName, codeErrBLSPubKeyExists, "BLS public key is registered by another meta node")
+	ErrBLSVerifyFailed                    = errors.Register(ModuleName, codeErrBLSVerifyFailed, "BLS signature verification failed")
+	ErrBLSNotReachThreshold               = errors.Register(ModuleName, codeErrBLSNotReachThreshold, "BLS signed meta nodes do not reach the threshold")
 )
@@ -719,6 +719,67 @@ func (m *EventUpdateMetaNode) GetNetworkAddress() string {
 	return ""
 }
 
+// EventRegisterMetaNodeBLSPubKey is emitted on Msg/MsgRegisterMetaNodeBLSPubKey
+type EventRegisterMetaNodeBLSPubKey struct {
+	Sender         string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	NetworkAddress string `protobuf:"bytes,2,opt,name=network_address,json=networkAddress,proto3" json:"network_address,omitempty"`
+	BlsPubKey      string `protobuf:"bytes,3,opt,name=bls_pub_key,json=blsPubKey,proto3" json:"bls_pub_key,omitempty"`
+}
+
+func (m *EventRegisterMetaNodeBLSPubKey) Reset()         { *m = EventRegisterMetaNodeBLSPubKey{} }
+func (m *EventRegisterMetaNodeBLSPubKey) String() string { return proto.CompactTextString(m) }
+func (*EventRegisterMetaNodeBLSPubKey) ProtoMessage()    {}
+func (*EventRegisterMetaNodeBLSPubKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9f4bedab5b811f13, []int{10}
+}
+func (m *EventRegisterMetaNodeBLSPubKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRegisterMetaNodeBLSPubKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRegisterMetaNodeBLSPubKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventRegisterMetaNodeBLSPubKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRegisterMetaNodeBLSPubKey.Merge(m, src)
+}
+func (m *EventRegisterMetaNodeBLSPubKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRegisterMetaNodeBLSPubKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRegisterMetaNodeBLSPubKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventRegisterMetaNodeBLSPubKey proto.InternalMessageInfo
+
+func (m *EventRegisterMetaNodeBLSPubKey) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *EventRegisterMetaNodeBLSPubKey) GetNetworkAddress() string {
+	if m != nil {
+		return m.NetworkAddress
+	}
+	return ""
+}
+
+func (m *EventRegisterMetaNodeBLSPubKey) GetBlsPubKey() string {
+	if m != nil {
+		return m.BlsPubKey
+	}
+	return ""
+}
+
 // EventUpdateMetaNodeDeposit is emitted on Msg/MsgUpdateMetaNodeDeposit
 type EventUpdateMetaNodeDeposit struct {
 	Sender               string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
@@ -735,7 +796,7 @@ func (m *EventUpdateMetaNodeDeposit) Reset()         { *m = EventUpdateMetaNodeD
 func (m *EventUpdateMetaNodeDeposit) String() string { return proto.CompactTextString(m) }
 func (*EventUpdateMetaNodeDeposit) ProtoMessage()    {}
 func (*EventUpdateMetaNodeDeposit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9f4bedab5b811f13, []int{10}
+	return fileDescriptor_9f4bedab5b811f13, []int{11}
 }
 func (m *EventUpdateMetaNodeDeposit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -830,7 +891,7 @@ func (m *EventCompleteUnBondingResourceNode) Reset()         { *m = EventComplet
 func (m *EventCompleteUnBondingResourceNode) String() string { return proto.CompactTextString(m) }
 func (*EventCompleteUnBondingResourceNode) ProtoMessage()    {}
 func (*EventCompleteUnBondingResourceNode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9f4bedab5b811f13, []int{11}
+	return fileDescriptor_9f4bedab5b811f13, []int{12}
 }
 func (m *EventCompleteUnBondingResourceNode) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -883,7 +944,7 @@ func (m *EventCompleteUnBondingMetaNode) Reset()         { *m = EventCompleteUnB
 func (m *EventCompleteUnBondingMetaNode) String() string { return proto.CompactTextString(m) }
 func (*EventCompleteUnBondingMetaNode) ProtoMessage()    {}
 func (*EventCompleteUnBondingMetaNode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9f4bedab5b811f13, []int{12}
+	return fileDescriptor_9f4bedab5b811f13, []int{13}
 }
 func (m *EventCompleteUnBondingMetaNode) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -937,6 +998,7 @@ func init() {
 	proto.RegisterType((*EventUpdateResourceNodeDeposit)(nil), "stratos.register.v1.EventUpdateResourceNodeDeposit")
 	proto.RegisterType((*EventUpdateEffectiveDeposit)(nil), "stratos.register.v1.EventUpdateEffectiveDeposit")
 	proto.RegisterType((*EventUpdateMetaNode)(nil), "stratos.register.v1.EventUpdateMetaNode")
+	proto.RegisterType((*EventRegisterMetaNodeBLSPubKey)(nil), "stratos.register.v1.EventRegisterMetaNodeBLSPubKey")
 	proto.RegisterType((*EventUpdateMetaNodeDeposit)(nil), "stratos.register.v1.EventUpdateMetaNodeDeposit")
 	proto.RegisterType((*EventCompleteUnBondingResourceNode)(nil), "stratos.register.v1.EventCompleteUnBondingResourceNode")
 	proto.RegisterType((*EventCompleteUnBondingMetaNode)(nil), "stratos.register.v1.EventCompleteUnBondingMetaNode")
@@ -945,56 +1007,58 @@ func init() {
 func init() { proto.RegisterFile("stratos/register/v1/event.proto", fileDescriptor_9f4bedab5b811f13) }
 
 var fileDescriptor_9f4bedab5b811f13 = []byte{
-	// 777 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0x3b, 0x6f, 0x13, 0x4b,
-	0x14, 0xce, 0x3a, 0x8f, 0x9b, 0x8c, 0xe2, 0xe4, 0xc6, 0x8e, 0x1d, 0xdf, 0x1b, 0x5d, 0x5f, 0xe4,
-	0x08, 0xf1, 0x90, 0xb0, 0x95, 0x10, 0x51, 0xd0, 0xe5, 0x55, 0x41, 0x52, 0x98, 0x24, 0x05, 0xcd,
-	0x6a, 0xbc, 0x7b, 0xec, 0x8c, 0xec, 0x9d, 0xb1, 0x66, 0xce, 0x1a, 0x42, 0x49, 0x4b, 0xc3, 0x9f,
-	0xe0, 0x47, 0xd0, 0x50, 0x23, 0xd1, 0xa4, 0xa4, 0x41, 0x42, 0xc9, 0xaf, 0xa0, 0x40, 0x42, 0xbb,
-	0x33, 0xb3, 0xb1, 0x1d, 0xaf, 0x08, 0x8a, 0x45, 0x45, 0xb7, 0x7b, 0xbe, 0x73, 0xce, 0x9c, 0xef,
-	0x3b, 0x67, 0x1e, 0xe4, 0x7f, 0x85, 0x92, 0xa2, 0x50, 0x35, 0x09, 0x2d, 0xa6, 0x10, 0x64, 0xad,
-	0xb7, 0x5e, 0x83, 0x1e, 0x70, 0xac, 0x76, 0xa5, 0x40, 0x91, 0xcb, 0x1b, 0x87, 0xaa, 0x75, 0xa8,
-	0xf6, 0xd6, 0x2b, 0x9f, 0x1c, 0xb2, 0xb2, 0x17, 0x39, 0xed, 0x48, 0xa0, 0x08, 0x75, 0x50, 0x22,
-	0x94, 0x1e, 0x1c, 0x08, 0x1f, 0x72, 0x45, 0x32, 0xa3, 0x80, 0xfb, 0x20, 0x4b, 0xce, 0x2d, 0xe7,
-	0xee, 0x5c, 0xdd, 0xfc, 0xe5, 0xee, 0x90, 0x45, 0x0e, 0xf8, 0x42, 0xc8, 0xb6, 0x4b, 0x7d, 0x5f,
-	0x82, 0x52, 0xa5, 0x4c, 0xec, 0xb0, 0x60, 0xcc, 0x5b, 0xda, 0x1a, 0x25, 0xe8, 0x86, 0x8d, 0x36,
-	0x9c, 0x96, 0x26, 0x75, 0x02, 0xfd, 0x97, 0xab, 0x92, 0xbc, 0x78, 0x25, 0x38, 0xb8, 0x1d, 0x16,
-	0x30, 0x74, 0xbd, 0x13, 0xca, 0x5b, 0xa0, 0x4a, 0x53, 0xb1, 0xd3, 0x52, 0x0c, 0x3d, 0x8d, 0x90,
-	0x1d, 0x0d, 0x44, 0x0b, 0x32, 0xce, 0x90, 0xd1, 0x8e, 0xeb, 0x43, 0x57, 0x28, 0x86, 0xa5, 0x69,
-	0xbd, 0xa0, 0x31, 0xef, 0x6a, 0x6b, 0xe5, 0x98, 0xe4, 0xfb, 0xc8, 0xec, 0x03, 0xd2, 0xb1, 0x10,
-	0xa9, 0xbc, 0x77, 0xc8, 0xbf, 0x71, 0xe2, 0x23, 0xbe, 0x2d, 0xb8, 0xcf, 0x78, 0xeb, 0x5a, 0x42,
-	0xad, 0x91, 0xac, 0x34, 0x7e, 0x2e, 0x17, 0x3e, 0x98, 0xec, 0xf3, 0xb2, 0x3f, 0xf8, 0x3e, 0x59,
-	0x32, 0xa4, 0x5c, 0x14, 0xae, 0x84, 0x40, 0xf4, 0xc0, 0xe8, 0xb5, 0x68, 0x80, 0x43, 0x51, 0x8f,
-	0xcd, 0xb9, 0x0d, 0x52, 0x08, 0x79, 0x43, 0x57, 0xe0, 0x06, 0x14, 0x43, 0x09, 0x2e, 0xb2, 0x00,
-	0x8c, 0x74, 0xf9, 0x04, 0xdc, 0x8f, 0xb1, 0x43, 0x16, 0x40, 0xe5, 0x8b, 0x43, 0x8a, 0x83, 0xb5,
-	0xff, 0x54, 0x97, 0x55, 0x32, 0x17, 0x00, 0xd2, 0xfe, 0x9a, 0x67, 0x03, 0x1b, 0x94, 0xd2, 0xbc,
-	0xc9, 0xb4, 0xe6, 0x8d, 0xe4, 0x37, 0xf5, 0x8b, 0xfc, 0xa6, 0xd3, 0xf9, 0xbd, 0xce, 0x90, 0xff,
-	0x62, 0x7e, 0x96, 0x56, 0x3d, 0x1e, 0x6f, 0x49, 0x91, 0x09, 0x7e, 0x2c, 0x30, 0x9d, 0xe6, 0x06,
-	0x29, 0xf4, 0x04, 0x82, 0x74, 0x47, 0x0f, 0x41, 0x3e, 0x06, 0x0f, 0x06, 0x47, 0xfa, 0x31, 0xf9,
-	0xc7, 0xa3, 0xdc, 0x67, 0x3e, 0x45, 0xb8, 0x12, 0xa7, 0x35, 0x58, 0x49, 0x1c, 0x86, 0x62, 0xef,
-	0x91, 0xbf, 0x2f, 0x63, 0x15, 0x52, 0x0c, 0xed, 0xcc, 0x2f, 0x26, 0xf6, 0x67, 0xb1, 0x39, 0x4d,
-	0xe4, 0xe9, 0x14, 0x91, 0x2b, 0x1f, 0x1c, 0x52, 0x88, 0x45, 0x78, 0xc2, 0xbc, 0xb6, 0x15, 0x62,
-	0xec, 0xe4, 0x37, 0x49, 0x11, 0xa9, 0x6c, 0x01, 0xa6, 0x30, 0x5f, 0xd6, 0xe8, 0x50, 0xd4, 0x1a,
-	0xc9, 0x9a, 0xa8, 0x01, 0xce, 0xf3, 0xda, 0xa8, 0x09, 0x57, 0xde, 0xd8, 0x73, 0xe8, 0xa8, 0xeb,
-	0x8f, 0xfd, 0x1c, 0xaa, 0x91, 0x7c, 0x03, 0x38, 0x34, 0x99, 0xc7, 0xa8, 0x3c, 0x1d, 0x2a, 0x3a,
-	0xd7, 0x07, 0xd9, 0xfd, 0xfe, 0x3d, 0x43, 0xca, 0x29, 0xd5, 0x98, 0xa3, 0xe6, 0xe6, 0x45, 0xad,
-	0x91, 0xac, 0xdd, 0x17, 0x3e, 0x74, 0x90, 0x9a, 0x72, 0xe6, 0x8d, 0x71, 0x37, 0xb2, 0x45, 0xd9,
-	0xbc, 0x50, 0x4a, 0xe0, 0x98, 0x9c, 0x7c, 0x5a, 0xbd, 0x05, 0x63, 0xb6, 0xe5, 0x6c, 0x92, 0x22,
-	0xed, 0x51, 0xd6, 0xa1, 0x8d, 0x0e, 0xb8, 0x28, 0xda, 0xc0, 0xdd, 0x06, 0x34, 0x85, 0xb4, 0x5b,
-	0x67, 0x39, 0x41, 0x0f, 0x23, 0x70, 0x3b, 0xc6, 0xa2, 0x21, 0x18, 0x8e, 0xa2, 0x4d, 0x04, 0x59,
-	0x9a, 0xd1, 0x43, 0x30, 0x18, 0xb4, 0x15, 0x41, 0x69, 0xa3, 0xf9, 0x57, 0xda, 0xfe, 0x4f, 0xdd,
-	0xd3, 0xb3, 0xe9, 0x7b, 0xfa, 0x9d, 0x43, 0x56, 0xfb, 0xf4, 0xdf, 0x6b, 0x36, 0xc1, 0x43, 0xd6,
-	0x4b, 0xc4, 0x1f, 0x21, 0xb2, 0x33, 0x52, 0xe4, 0x47, 0x64, 0x05, 0x6c, 0xb0, 0x55, 0xd0, 0x50,
-	0xd4, 0x5d, 0x29, 0xc0, 0x50, 0x6e, 0x4d, 0xf2, 0x36, 0x59, 0x60, 0xca, 0x0d, 0xb9, 0x0a, 0x55,
-	0x37, 0xea, 0xab, 0x6f, 0xba, 0x93, 0x65, 0xea, 0xe8, 0xd2, 0x98, 0xdc, 0x37, 0xba, 0xcc, 0xf1,
-	0xdd, 0x37, 0xdf, 0x32, 0xf6, 0xbe, 0x19, 0x48, 0xfc, 0x67, 0xf6, 0x7e, 0xc3, 0xec, 0x01, 0xa9,
-	0xe8, 0x37, 0x84, 0x08, 0xba, 0x1d, 0x40, 0x48, 0xbd, 0xf2, 0x69, 0x20, 0x42, 0x8e, 0xb6, 0x05,
-	0xfa, 0xef, 0xfa, 0x2d, 0xa6, 0xe6, 0x84, 0xb9, 0xb2, 0x4c, 0xff, 0x14, 0xdd, 0x68, 0x89, 0xed,
-	0x83, 0x8f, 0xe7, 0x65, 0xe7, 0xec, 0xbc, 0xec, 0x7c, 0x3d, 0x2f, 0x3b, 0x6f, 0x2f, 0xca, 0x13,
-	0x67, 0x17, 0xe5, 0x89, 0xcf, 0x17, 0xe5, 0x89, 0xe7, 0x9b, 0x2d, 0x86, 0x27, 0x61, 0xa3, 0xea,
-	0x89, 0xa0, 0x66, 0x5e, 0x85, 0x1c, 0xd0, 0x7e, 0x3e, 0xf0, 0x4e, 0x28, 0xe3, 0xb5, 0x97, 0x97,
-	0x2f, 0x49, 0x3c, 0xed, 0x82, 0x6a, 0xcc, 0xc4, 0xef, 0xc8, 0x87, 0x3f, 0x02, 0x00, 0x00, 0xff,
-	0xff, 0x4d, 0x39, 0xbb, 0x40, 0x6a, 0x0a, 0x00, 0x00,
+	// 811 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0x4b, 0x6f, 0xeb, 0x44,
+	0x14, 0xae, 0x73, 0x6f, 0xcb, 0xed, 0xd0, 0xb4, 0x34, 0x69, 0xd2, 0xd0, 0x0a, 0x83, 0x5c, 0x21,
+	0x1e, 0x12, 0x89, 0x5a, 0x2a, 0x16, 0xec, 0x9a, 0xb6, 0xab, 0x3e, 0x84, 0xd2, 0xc7, 0x82, 0x8d,
+	0x35, 0xb6, 0x4f, 0xd2, 0x51, 0xec, 0x19, 0x6b, 0xe6, 0x38, 0x10, 0x76, 0xb0, 0x65, 0xc3, 0x9f,
+	0xe0, 0x47, 0xb0, 0x61, 0x8d, 0xc4, 0xa6, 0x4b, 0x36, 0x48, 0xa8, 0xfd, 0x15, 0x2c, 0x90, 0x90,
+	0x3d, 0xe3, 0xbc, 0x88, 0x45, 0x51, 0x23, 0x56, 0x77, 0x67, 0x9f, 0x6f, 0xce, 0x99, 0xf3, 0x7d,
+	0xf3, 0xcd, 0x83, 0xbc, 0xab, 0x50, 0x52, 0x14, 0xaa, 0x25, 0xa1, 0xc7, 0x14, 0x82, 0x6c, 0x0d,
+	0xf6, 0x5b, 0x30, 0x00, 0x8e, 0xcd, 0x58, 0x0a, 0x14, 0x95, 0xaa, 0x19, 0xd0, 0xcc, 0x07, 0x34,
+	0x07, 0xfb, 0xce, 0xaf, 0x16, 0xd9, 0x3e, 0x4d, 0x07, 0x1d, 0x4b, 0xa0, 0x08, 0x1d, 0x50, 0x22,
+	0x91, 0x3e, 0x5c, 0x8a, 0x00, 0x2a, 0x75, 0xb2, 0xa2, 0x80, 0x07, 0x20, 0x1b, 0xd6, 0x7b, 0xd6,
+	0x87, 0xab, 0x1d, 0xf3, 0x57, 0xf9, 0x80, 0x6c, 0x70, 0xc0, 0xaf, 0x84, 0xec, 0xbb, 0x34, 0x08,
+	0x24, 0x28, 0xd5, 0x28, 0x65, 0x03, 0xd6, 0x4d, 0xf8, 0x48, 0x47, 0xd3, 0x02, 0x71, 0xe2, 0xf5,
+	0x61, 0xd8, 0x78, 0xa1, 0x0b, 0xe8, 0xbf, 0x4a, 0x93, 0x54, 0xc5, 0x37, 0x82, 0x83, 0x1b, 0xb2,
+	0x88, 0xa1, 0xeb, 0xdf, 0x51, 0xde, 0x03, 0xd5, 0x78, 0x99, 0x0d, 0xda, 0xcc, 0xa0, 0xf3, 0x14,
+	0x39, 0xd6, 0x40, 0x3a, 0x21, 0xe3, 0x0c, 0x19, 0x0d, 0xdd, 0x00, 0x62, 0xa1, 0x18, 0x36, 0x96,
+	0xf5, 0x84, 0x26, 0x7c, 0xa2, 0xa3, 0xce, 0x2d, 0xa9, 0x4e, 0x90, 0xb9, 0x00, 0xa4, 0x0b, 0x21,
+	0xe2, 0xfc, 0x64, 0x91, 0x9d, 0xac, 0xf0, 0x0d, 0x6f, 0x0b, 0x1e, 0x30, 0xde, 0x7b, 0x92, 0x50,
+	0x7b, 0xa4, 0x2c, 0xcd, 0x38, 0x97, 0x8b, 0x00, 0x4c, 0xf5, 0x35, 0x39, 0x99, 0xfc, 0x31, 0xd9,
+	0x34, 0xa4, 0x5c, 0x14, 0xae, 0x84, 0x48, 0x0c, 0xc0, 0xe8, 0xb5, 0x61, 0x80, 0x6b, 0xd1, 0xc9,
+	0xc2, 0x95, 0x03, 0x52, 0x4b, 0xb8, 0xa7, 0x3b, 0x70, 0x23, 0x8a, 0x89, 0x04, 0x17, 0x59, 0x04,
+	0x46, 0xba, 0xea, 0x08, 0xbc, 0xc8, 0xb0, 0x6b, 0x16, 0x81, 0xf3, 0xbb, 0x45, 0xea, 0xd3, 0xbd,
+	0xff, 0xab, 0x2e, 0xbb, 0x64, 0x35, 0x02, 0xa4, 0x93, 0x3d, 0xbf, 0x8a, 0xf2, 0xa4, 0x82, 0xc5,
+	0x7b, 0x51, 0xb4, 0x78, 0x73, 0xf9, 0xbd, 0xfc, 0x8f, 0xfc, 0x96, 0x8b, 0xf9, 0x7d, 0x57, 0x22,
+	0xef, 0x64, 0xfc, 0x72, 0x5a, 0x9d, 0xcc, 0xde, 0x92, 0x22, 0x13, 0xfc, 0x56, 0x60, 0x31, 0xcd,
+	0x03, 0x52, 0x1b, 0x08, 0x04, 0xe9, 0xce, 0x37, 0x41, 0x35, 0x03, 0x2f, 0xa7, 0x2d, 0xfd, 0x39,
+	0x79, 0xdb, 0xa7, 0x3c, 0x60, 0x01, 0x45, 0xf8, 0x47, 0x9e, 0xd6, 0x60, 0x7b, 0x34, 0x60, 0x26,
+	0xf7, 0x23, 0xf2, 0xd6, 0x38, 0x57, 0x21, 0xc5, 0x24, 0xf7, 0xfc, 0xc6, 0x28, 0x7e, 0x95, 0x85,
+	0x8b, 0x44, 0x5e, 0x2e, 0x10, 0xd9, 0xf9, 0xd9, 0x22, 0xb5, 0x4c, 0x84, 0x33, 0xe6, 0xf7, 0x73,
+	0x21, 0x16, 0x4e, 0xfe, 0x90, 0xd4, 0x91, 0xca, 0x1e, 0x60, 0x01, 0xf3, 0x2d, 0x8d, 0xce, 0x64,
+	0xed, 0x91, 0xb2, 0xc9, 0x9a, 0xe2, 0xbc, 0xa6, 0x83, 0x9a, 0xb0, 0xf3, 0x7d, 0x7e, 0x0e, 0xdd,
+	0xc4, 0xc1, 0xc2, 0xcf, 0xa1, 0x16, 0xa9, 0x7a, 0xc0, 0xa1, 0xcb, 0x7c, 0x46, 0xe5, 0x70, 0xa6,
+	0xe9, 0xca, 0x04, 0x94, 0xef, 0xf7, 0xbf, 0x4a, 0xc4, 0x2e, 0xe8, 0xc6, 0x1c, 0x35, 0xcf, 0x6f,
+	0x6a, 0x8f, 0x94, 0xf3, 0x7d, 0x11, 0x40, 0x88, 0xd4, 0xb4, 0xb3, 0x66, 0x82, 0x27, 0x69, 0x2c,
+	0xad, 0xe6, 0x27, 0x52, 0x02, 0xc7, 0xd1, 0xc9, 0xa7, 0xd5, 0x5b, 0x37, 0xe1, 0xbc, 0x9d, 0x43,
+	0x52, 0xa7, 0x03, 0xca, 0x42, 0xea, 0x85, 0xe0, 0xa2, 0xe8, 0x03, 0x77, 0x3d, 0xe8, 0x0a, 0x99,
+	0x6f, 0x9d, 0xad, 0x11, 0x7a, 0x9d, 0x82, 0xed, 0x0c, 0x4b, 0x4d, 0x30, 0x9b, 0x45, 0xbb, 0x08,
+	0xb2, 0xb1, 0xa2, 0x4d, 0x30, 0x9d, 0x74, 0x94, 0x42, 0x45, 0xd6, 0x7c, 0xa3, 0x68, 0xff, 0x17,
+	0xee, 0xe9, 0x57, 0xc5, 0x7b, 0xfa, 0x47, 0x8b, 0xec, 0x4e, 0xe8, 0x7f, 0xda, 0xed, 0x82, 0x8f,
+	0x6c, 0x30, 0x12, 0x7f, 0x8e, 0xc8, 0xd6, 0x5c, 0x91, 0x3f, 0x23, 0xdb, 0x90, 0x27, 0xe7, 0x0a,
+	0x1a, 0x8a, 0x7a, 0x55, 0x6a, 0x30, 0x53, 0x5b, 0x93, 0x7c, 0x9f, 0xac, 0x33, 0xe5, 0x26, 0x5c,
+	0x25, 0x2a, 0x06, 0x1e, 0x40, 0x60, 0x56, 0xa7, 0xcc, 0xd4, 0xcd, 0x38, 0x38, 0xba, 0x6f, 0x74,
+	0x9b, 0x8b, 0xbb, 0x6f, 0xbe, 0xb5, 0x8c, 0xff, 0x3a, 0xe6, 0xaa, 0xce, 0x4b, 0xb7, 0xcf, 0xaf,
+	0xbe, 0x48, 0xbc, 0x33, 0x18, 0x3e, 0xdf, 0x7f, 0x36, 0x79, 0xd3, 0x0b, 0x95, 0x1b, 0x27, 0x9e,
+	0x3b, 0xbe, 0xa1, 0x57, 0xbd, 0x50, 0xe9, 0x09, 0x9c, 0x3f, 0x4b, 0x64, 0x67, 0x0e, 0xb9, 0xd7,
+	0xfe, 0xff, 0x1f, 0xfc, 0x0f, 0xc4, 0xd1, 0xef, 0x18, 0x11, 0xc5, 0x21, 0x20, 0x14, 0x3e, 0x3b,
+	0x68, 0x24, 0x12, 0x8e, 0xf9, 0x12, 0xe8, 0xbf, 0xa7, 0xdb, 0x8c, 0x12, 0x7b, 0xfe, 0x34, 0x93,
+	0x4e, 0x7e, 0xd6, 0x14, 0xed, 0xcb, 0x5f, 0x1e, 0x6c, 0xeb, 0xfe, 0xc1, 0xb6, 0xfe, 0x78, 0xb0,
+	0xad, 0x1f, 0x1e, 0xed, 0xa5, 0xfb, 0x47, 0x7b, 0xe9, 0xb7, 0x47, 0x7b, 0xe9, 0xcb, 0xc3, 0x1e,
+	0xc3, 0xbb, 0xc4, 0x6b, 0xfa, 0x22, 0x6a, 0x99, 0x97, 0x29, 0x07, 0xcc, 0x3f, 0x3f, 0xf1, 0xef,
+	0x28, 0xe3, 0xad, 0xaf, 0xc7, 0xaf, 0x59, 0x1c, 0xc6, 0xa0, 0xbc, 0x95, 0xec, 0x2d, 0xfb, 0xe9,
+	0xdf, 0x03, 0x00, 0x09, 0x3b, 0x83, 0x3b, 0xee, 0x0a, 0x00, 0x00,
 }
 
 func (m *EventCreateResourceNode) Marshal() (dAtA []byte, err error) {
@@ -1514,6 +1578,50 @@ func (m *EventUpdateMetaNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventRegisterMetaNodeBLSPubKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRegisterMetaNodeBLSPubKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRegisterMetaNodeBLSPubKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BlsPubKey) > 0 {
+		i -= len(m.BlsPubKey)
+		copy(dAtA[i:], m.BlsPubKey)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.BlsPubKey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.NetworkAddress) > 0 {
+		i -= len(m.NetworkAddress)
+		copy(dAtA[i:], m.NetworkAddress)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.NetworkAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *EventUpdateMetaNodeDeposit) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1932,6 +2040,27 @@ func (m *EventUpdateMetaNode) Size() (n int) {
 	return n
 }
 
+func (m *EventRegisterMetaNodeBLSPubKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.NetworkAddress)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.BlsPubKey)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
 func (m *EventUpdateMetaNodeDeposit) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3825,6 +3954,152 @@ func (m *EventUpdateMetaNode) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventRegisterMetaNodeBLSPubKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventRegisterMetaNodeBLSPubKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventRegisterMetaNodeBLSPubKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetworkAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NetworkAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlsPubKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlsPubKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *EventUpdateMetaNodeDeposit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
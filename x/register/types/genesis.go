@@ -33,6 +33,7 @@ func NewGenesisState(params Params,
 		MetaNodeRegVotePool:  metaNodeRegVotePool,
 		UnbondingNodes:       unbondingNodes,
 		KickMetaNodeVotePool: kickMetaNodeVotePool,
+		PrepaidOzone:         sdkmath.ZeroInt(),
 	}
 }
 
@@ -48,6 +49,7 @@ func DefaultGenesisState() *GenesisState {
 		MetaNodeRegVotePool:  make([]MetaNodeRegistrationVotePool, 0),
 		UnbondingNodes:       make([]UnbondingNode, 0),
 		KickMetaNodeVotePool: make([]KickMetaNodeVotePool, 0),
+		PrepaidOzone:         sdkmath.ZeroInt(),
 	}
 }
 
@@ -40,6 +40,7 @@ type GenesisState struct {
 	MetaNodeRegVotePool  []MetaNodeRegistrationVotePool         `protobuf:"bytes,7,rep,name=meta_node_reg_vote_pool,json=metaNodeRegVotePool,proto3" json:"meta_node_reg_vote_pool" yaml:"meta_node_reg_vote_pool"`
 	UnbondingNodes       []UnbondingNode                        `protobuf:"bytes,8,rep,name=unbonding_nodes,json=unbondingNodes,proto3" json:"unbonding_nodes" yaml:"unbonding_nodes"`
 	KickMetaNodeVotePool []KickMetaNodeVotePool                 `protobuf:"bytes,9,rep,name=kick_meta_node_vote_pool,json=kickMetaNodeVotePool,proto3" json:"kick_meta_node_vote_pool" yaml:"kick_meta_node_vote_pool"`
+	PrepaidOzone         github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,10,opt,name=prepaid_ozone,json=prepaidOzone,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"prepaid_ozone" yaml:"prepaid_ozone"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -285,77 +286,79 @@ func init() {
 func init() { proto.RegisterFile("stratos/register/v1/genesis.proto", fileDescriptor_5bdab54ebea9e48e) }
 
 var fileDescriptor_5bdab54ebea9e48e = []byte{
-	// 1111 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0x4f, 0x6f, 0x1b, 0x45,
-	0x14, 0xcf, 0x36, 0xc4, 0x49, 0x26, 0x89, 0x43, 0x36, 0x81, 0x3a, 0xa9, 0xea, 0x49, 0x57, 0x15,
-	0x0a, 0x48, 0xd9, 0x55, 0x52, 0xfe, 0x08, 0x6e, 0xd9, 0xa6, 0x40, 0x55, 0x88, 0xa2, 0x8d, 0x00,
-	0xc1, 0xc5, 0x1a, 0xaf, 0x27, 0x9b, 0xc1, 0xf6, 0x8c, 0xb5, 0x33, 0x76, 0x70, 0xae, 0xdc, 0xb8,
-	0x80, 0xc4, 0x8d, 0x0f, 0x00, 0x15, 0x1c, 0xe0, 0xc0, 0x87, 0xa8, 0x38, 0x55, 0x9c, 0x10, 0x87,
-	0x05, 0x25, 0x07, 0x24, 0x1f, 0xf7, 0x13, 0xa0, 0x9d, 0x3f, 0xbb, 0xb6, 0xbb, 0xa9, 0xd4, 0x4b,
-	0xb2, 0xf3, 0xde, 0x6f, 0xde, 0xfb, 0xcd, 0x6f, 0xde, 0xbc, 0x67, 0x70, 0x87, 0x8b, 0x18, 0x09,
-	0xc6, 0xbd, 0x18, 0x47, 0x84, 0x0b, 0x1c, 0x7b, 0x83, 0x3d, 0x2f, 0xc2, 0x14, 0x73, 0xc2, 0xdd,
-	0x5e, 0xcc, 0x04, 0xb3, 0xd7, 0x35, 0xc4, 0x35, 0x10, 0x77, 0xb0, 0xb7, 0xb5, 0x19, 0x31, 0x16,
-	0x75, 0xb0, 0x27, 0x21, 0xcd, 0xfe, 0xa9, 0x87, 0xe8, 0x50, 0xe1, 0xb7, 0x36, 0x22, 0x16, 0x31,
-	0xf9, 0xe9, 0x65, 0x5f, 0xda, 0xba, 0x19, 0x32, 0xde, 0x65, 0xbc, 0xa1, 0x1c, 0x6a, 0xa1, 0x5d,
-	0x6b, 0xa8, 0x4b, 0x28, 0xf3, 0xe4, 0x5f, 0x6d, 0xaa, 0x2b, 0x80, 0xd7, 0x44, 0x1c, 0x7b, 0x83,
-	0xbd, 0x26, 0x16, 0x68, 0xcf, 0x0b, 0x19, 0xa1, 0xda, 0x7f, 0x57, 0xfb, 0xb9, 0x40, 0x6d, 0x42,
-	0xa3, 0x1c, 0xa2, 0xd7, 0x1a, 0xe5, 0x94, 0x1d, 0x2e, 0x3f, 0x85, 0xc4, 0x38, 0xdf, 0x00, 0xb0,
-	0xfc, 0x81, 0x3a, 0xef, 0x89, 0x40, 0x02, 0xdb, 0x9f, 0x83, 0x4a, 0x0f, 0xc5, 0xa8, 0xcb, 0x6b,
-	0xd6, 0xb6, 0xb5, 0xb3, 0xb4, 0x7f, 0xcb, 0x2d, 0x39, 0xbf, 0x7b, 0x2c, 0x21, 0xfe, 0xdd, 0x27,
-	0x09, 0x9c, 0x19, 0x25, 0x50, 0x6f, 0x49, 0x13, 0xb8, 0x32, 0x44, 0xdd, 0xce, 0x7b, 0x8e, 0x5a,
-	0x3b, 0x8f, 0xff, 0xfb, 0xed, 0x0d, 0x2b, 0xd0, 0x5e, 0xfb, 0x5b, 0x0b, 0x54, 0x63, 0xcc, 0x59,
-	0x3f, 0x0e, 0x71, 0x83, 0xb2, 0x16, 0xe6, 0xb5, 0x1b, 0xdb, 0xb3, 0x3b, 0x4b, 0xfb, 0x77, 0x4a,
-	0x73, 0x04, 0x1a, 0x7a, 0xc4, 0x5a, 0xd8, 0x7f, 0xa0, 0x33, 0x4d, 0x05, 0x48, 0x13, 0xf8, 0x8a,
-	0xca, 0x38, 0x69, 0x77, 0x7e, 0xfe, 0x07, 0xae, 0x8c, 0x07, 0xe0, 0x8a, 0xca, 0x4a, 0x3c, 0x6e,
-	0xb3, 0xcf, 0x01, 0xe8, 0x62, 0x81, 0x34, 0x99, 0x59, 0x49, 0xe6, 0x76, 0x29, 0x99, 0x8f, 0xb1,
-	0x40, 0x92, 0xc8, 0xbb, 0x9a, 0xc8, 0xd8, 0xc6, 0x34, 0x81, 0x6b, 0x8a, 0x44, 0x61, 0xcb, 0x08,
-	0x2c, 0x9a, 0x4d, 0x3a, 0xf9, 0x62, 0xd7, 0xac, 0xed, 0x1f, 0x2d, 0xb0, 0x1e, 0xe3, 0x2e, 0x22,
-	0x94, 0xd0, 0xa8, 0x41, 0xd9, 0x45, 0xa3, 0x43, 0xba, 0x44, 0xd4, 0x5e, 0xda, 0xb6, 0x76, 0x16,
-	0xfd, 0x41, 0x96, 0xe3, 0xef, 0x04, 0xbe, 0x16, 0x11, 0x71, 0xd6, 0x6f, 0xba, 0x21, 0xeb, 0xea,
-	0x92, 0xd1, 0xff, 0x76, 0x79, 0xab, 0xed, 0x89, 0x61, 0x0f, 0x73, 0xf7, 0x21, 0x15, 0xa3, 0x04,
-	0x96, 0x05, 0x4b, 0x13, 0xb8, 0x65, 0xb4, 0x79, 0xc6, 0xe9, 0xfc, 0xf9, 0xfb, 0x2e, 0xd0, 0x65,
-	0xf8, 0x90, 0x0a, 0x45, 0x70, 0x2d, 0x07, 0x1e, 0xb1, 0x8b, 0x8f, 0x32, 0x98, 0x7d, 0x06, 0x16,
-	0x78, 0x07, 0xf1, 0x33, 0x42, 0xa3, 0xda, 0xdc, 0x73, 0xf4, 0x39, 0xd1, 0x20, 0x7f, 0x57, 0xeb,
-	0x93, 0x6f, 0x4b, 0x13, 0xb8, 0xa1, 0x68, 0x18, 0x4b, 0x83, 0xd0, 0x53, 0xa6, 0x6b, 0x23, 0x87,
-	0xd9, 0x3f, 0x58, 0xe0, 0xe5, 0x16, 0xee, 0x31, 0x4e, 0x84, 0xa4, 0x19, 0x23, 0x81, 0x6b, 0x15,
-	0xa9, 0x47, 0xef, 0x05, 0xf4, 0x38, 0xc4, 0xe1, 0x28, 0x81, 0xcf, 0x44, 0x4a, 0x13, 0x78, 0x53,
-	0xb1, 0x98, 0xf6, 0x8c, 0x2b, 0x71, 0x88, 0x43, 0x45, 0xab, 0xaa, 0x51, 0x47, 0xec, 0x22, 0xc8,
-	0x5e, 0xc5, 0xaf, 0x16, 0xb8, 0x99, 0x5f, 0x6e, 0x23, 0xc6, 0x51, 0x63, 0xc0, 0x04, 0x6e, 0xf4,
-	0x18, 0xeb, 0xd4, 0xe6, 0xa5, 0x2c, 0x7b, 0xcf, 0x2d, 0x9b, 0x40, 0xda, 0x62, 0x24, 0x08, 0xa3,
-	0x9f, 0x32, 0x81, 0x8f, 0x19, 0xeb, 0xf8, 0xef, 0x6b, 0xa9, 0xae, 0x8b, 0x9c, 0x26, 0xb0, 0x3e,
-	0x55, 0x57, 0x93, 0x00, 0xad, 0xe1, 0x7a, 0xb7, 0xc8, 0x62, 0x82, 0xdb, 0x5f, 0x5b, 0x60, 0xb5,
-	0x4f, 0x9b, 0x8c, 0xb6, 0xd4, 0xbd, 0x67, 0x05, 0xbe, 0x20, 0x99, 0x3a, 0xa5, 0x4c, 0x3f, 0x31,
-	0x58, 0x59, 0xe5, 0x6f, 0x6b, 0x6a, 0xd3, 0x21, 0xd2, 0x04, 0xbe, 0xaa, 0x28, 0x4d, 0x39, 0x34,
-	0x95, 0x6a, 0x7f, 0x3c, 0x0c, 0xb7, 0x7f, 0xb1, 0x40, 0xad, 0x4d, 0xc2, 0x76, 0xa3, 0x38, 0x41,
-	0x21, 0xdc, 0xa2, 0xa4, 0xf3, 0x7a, 0x29, 0x9d, 0x47, 0x24, 0x6c, 0x1b, 0xf1, 0x72, 0xc1, 0x3e,
-	0xd4, 0xac, 0xae, 0x0d, 0x99, 0x26, 0x10, 0x2a, 0x7a, 0xd7, 0x21, 0x34, 0xcf, 0x8d, 0x76, 0x49,
-	0x7c, 0xe7, 0xfb, 0x0a, 0x58, 0xd5, 0xcd, 0xd0, 0xf8, 0x6c, 0x0a, 0x56, 0x29, 0x16, 0xe7, 0x2c,
-	0x6e, 0x37, 0x50, 0xab, 0x15, 0x63, 0xae, 0x1a, 0xe3, 0xa2, 0xff, 0x20, 0x93, 0x67, 0xca, 0x55,
-	0xc8, 0x33, 0xe5, 0xc8, 0x8a, 0x6c, 0x43, 0x17, 0xd9, 0x81, 0x32, 0x9d, 0x88, 0x98, 0xd0, 0x28,
-	0xa8, 0x6a, 0xa4, 0xb6, 0xda, 0x08, 0x54, 0x7a, 0xfd, 0x66, 0x1b, 0x0f, 0x6b, 0x37, 0x64, 0xff,
-	0xdd, 0x70, 0xd5, 0xa8, 0x71, 0xcd, 0xa8, 0x71, 0x0f, 0xe8, 0xd0, 0xbf, 0x27, 0x9b, 0xae, 0xc4,
-	0x8d, 0x35, 0x5d, 0xb9, 0x76, 0xfe, 0x28, 0x52, 0x85, 0xf1, 0xb0, 0x27, 0x98, 0x7b, 0xdc, 0x6f,
-	0x3e, 0xc2, 0xc3, 0x40, 0x6f, 0xb0, 0xdf, 0x01, 0xf3, 0xbc, 0xcf, 0x7b, 0x98, 0xb6, 0x6a, 0xb3,
-	0xdb, 0xd6, 0xce, 0x82, 0x7f, 0x7b, 0x94, 0x40, 0x63, 0x4a, 0x13, 0x58, 0xd5, 0xcf, 0x55, 0x19,
-	0x9c, 0xc0, 0xb8, 0xec, 0xcf, 0x40, 0x85, 0x0b, 0x24, 0xfa, 0x5c, 0xf6, 0xa9, 0xea, 0xbe, 0xe3,
-	0xea, 0x3c, 0x66, 0xee, 0xe8, 0x39, 0xe4, 0xfa, 0x8c, 0xb6, 0x4e, 0x24, 0xd2, 0xbf, 0x95, 0x31,
-	0x55, 0xbb, 0x0a, 0xa6, 0x6a, 0xed, 0x04, 0xda, 0x91, 0x05, 0x16, 0xac, 0x8d, 0x29, 0xaf, 0xcd,
-	0xc9, 0x43, 0x6f, 0x9a, 0xc0, 0xd9, 0x00, 0xcc, 0xa3, 0xde, 0x67, 0x84, 0x16, 0x23, 0x47, 0x6d,
-	0x28, 0x62, 0xaa, 0xb5, 0x19, 0x39, 0x6a, 0x65, 0x9f, 0x82, 0x15, 0x76, 0x4e, 0x71, 0x9c, 0xdf,
-	0x9d, 0x6a, 0x28, 0x07, 0xa3, 0x04, 0x4e, 0x3a, 0x8a, 0x2e, 0x35, 0x61, 0xbe, 0xfe, 0xde, 0x96,
-	0x25, 0xce, 0xdc, 0xda, 0x29, 0x58, 0x6f, 0x62, 0x8a, 0x4f, 0x49, 0x48, 0x50, 0x3c, 0xcc, 0xb3,
-	0xcd, 0xcb, 0x6c, 0x6f, 0x65, 0x0d, 0xba, 0xc4, 0x5d, 0x34, 0xe8, 0x12, 0xa7, 0x13, 0xd8, 0x63,
-	0x56, 0x93, 0x27, 0x06, 0x4b, 0x2d, 0xcc, 0xc3, 0x98, 0xf4, 0xb2, 0x4e, 0x52, 0x5b, 0x90, 0x6a,
-	0x6d, 0x97, 0xbe, 0xa0, 0xc3, 0x02, 0xe7, 0xbb, 0x5a, 0xb4, 0xf1, 0xcd, 0x69, 0x02, 0x6d, 0xd3,
-	0x11, 0x73, 0xa3, 0x96, 0x6f, 0x1c, 0xe7, 0xfc, 0x64, 0x81, 0x05, 0xd3, 0xde, 0xed, 0x2f, 0x41,
-	0xf5, 0x1c, 0x75, 0x3a, 0x58, 0x4c, 0xbd, 0x86, 0xfb, 0xd9, 0x6c, 0x9e, 0xf4, 0x14, 0xb3, 0x79,
-	0xd2, 0x7e, 0xbd, 0xa6, 0x2b, 0x0a, 0x68, 0x0e, 0xeb, 0x81, 0xb9, 0x01, 0xea, 0xf4, 0xb1, 0x7c,
-	0x09, 0xb3, 0xfe, 0xe6, 0x28, 0x81, 0xca, 0x90, 0x26, 0x70, 0x59, 0x45, 0x96, 0x4b, 0x27, 0x50,
-	0x66, 0x3f, 0x78, 0x7c, 0x59, 0xb7, 0x9e, 0x5c, 0xd6, 0xad, 0xa7, 0x97, 0x75, 0xeb, 0xdf, 0xcb,
-	0xba, 0xf5, 0xdd, 0x55, 0x7d, 0xe6, 0xe9, 0x55, 0x7d, 0xe6, 0xaf, 0xab, 0xfa, 0xcc, 0x17, 0x6f,
-	0x8e, 0x4d, 0x0f, 0x2d, 0x18, 0xc5, 0xc2, 0x7c, 0xee, 0x86, 0x67, 0x88, 0x50, 0xef, 0xab, 0xe2,
-	0xc7, 0x92, 0x9c, 0x27, 0xcd, 0x8a, 0x7c, 0x77, 0xf7, 0xfe, 0x0f, 0x00, 0x00, 0xff, 0xff, 0xb4,
-	0xf9, 0xf9, 0x78, 0x2a, 0x0a, 0x00, 0x00,
+	// 1142 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0x4f, 0x6f, 0xdc, 0x44,
+	0x14, 0x8f, 0x1b, 0xba, 0x49, 0x26, 0xd9, 0x0d, 0x71, 0x02, 0x75, 0x52, 0x75, 0x9d, 0x5a, 0x15,
+	0x0a, 0x48, 0xb1, 0x95, 0x94, 0x3f, 0x82, 0x5b, 0xdc, 0x14, 0xa8, 0x0a, 0x21, 0x72, 0x04, 0x08,
+	0x2e, 0xd6, 0xac, 0x3d, 0x71, 0xa6, 0xbb, 0x3b, 0x63, 0x79, 0x66, 0x37, 0x6c, 0xae, 0x7c, 0x00,
+	0x90, 0xb8, 0x21, 0xce, 0x50, 0xc1, 0x01, 0x0e, 0x7c, 0x88, 0x8a, 0x53, 0xc5, 0x09, 0x71, 0x30,
+	0x28, 0x39, 0x20, 0xe5, 0xe8, 0x4f, 0x80, 0x3c, 0x33, 0xb6, 0x77, 0xb7, 0x4e, 0xa5, 0x5e, 0x92,
+	0x9d, 0xdf, 0xfb, 0xcd, 0x7b, 0xbf, 0x79, 0xf3, 0xe6, 0x3d, 0x83, 0xdb, 0x8c, 0x27, 0x90, 0x53,
+	0xe6, 0x24, 0x28, 0xc2, 0x8c, 0xa3, 0xc4, 0x19, 0xee, 0x38, 0x11, 0x22, 0x88, 0x61, 0x66, 0xc7,
+	0x09, 0xe5, 0x54, 0x5f, 0x55, 0x14, 0xbb, 0xa0, 0xd8, 0xc3, 0x9d, 0x8d, 0xf5, 0x88, 0xd2, 0xa8,
+	0x87, 0x1c, 0x41, 0xe9, 0x0c, 0x8e, 0x1d, 0x48, 0x46, 0x92, 0xbf, 0xb1, 0x16, 0xd1, 0x88, 0x8a,
+	0x9f, 0x4e, 0xfe, 0x4b, 0xa1, 0xeb, 0x01, 0x65, 0x7d, 0xca, 0x7c, 0x69, 0x90, 0x0b, 0x65, 0x5a,
+	0x81, 0x7d, 0x4c, 0xa8, 0x23, 0xfe, 0x2a, 0xa8, 0x2d, 0x09, 0x4e, 0x07, 0x32, 0xe4, 0x0c, 0x77,
+	0x3a, 0x88, 0xc3, 0x1d, 0x27, 0xa0, 0x98, 0x28, 0xfb, 0x1d, 0x65, 0x67, 0x1c, 0x76, 0x31, 0x89,
+	0x4a, 0x8a, 0x5a, 0x2b, 0x96, 0x55, 0x77, 0xb8, 0xf2, 0x14, 0x82, 0x63, 0xfd, 0xb0, 0x08, 0x96,
+	0x3e, 0x90, 0xe7, 0x3d, 0xe2, 0x90, 0x23, 0xfd, 0x0b, 0xd0, 0x88, 0x61, 0x02, 0xfb, 0xcc, 0xd0,
+	0x36, 0xb5, 0xad, 0xc5, 0xdd, 0x9b, 0x76, 0xcd, 0xf9, 0xed, 0x43, 0x41, 0x71, 0xef, 0x3c, 0x49,
+	0xcd, 0x99, 0xcb, 0xd4, 0x54, 0x5b, 0xb2, 0xd4, 0x6c, 0x8e, 0x60, 0xbf, 0xf7, 0x9e, 0x25, 0xd7,
+	0xd6, 0xe3, 0xff, 0x7e, 0x7b, 0x43, 0xf3, 0x94, 0x55, 0xff, 0x46, 0x03, 0xad, 0x04, 0x31, 0x3a,
+	0x48, 0x02, 0xe4, 0x13, 0x1a, 0x22, 0x66, 0x5c, 0xdb, 0x9c, 0xdd, 0x5a, 0xdc, 0xbd, 0x5d, 0x1b,
+	0xc3, 0x53, 0xd4, 0x03, 0x1a, 0x22, 0xf7, 0xbe, 0x8a, 0x34, 0xe5, 0x20, 0x4b, 0xcd, 0x57, 0x64,
+	0xc4, 0x49, 0xdc, 0xfa, 0xf9, 0x1f, 0xb3, 0x39, 0xee, 0x80, 0x49, 0x29, 0xcd, 0x64, 0x1c, 0xd3,
+	0x4f, 0x01, 0xe8, 0x23, 0x0e, 0x95, 0x98, 0x59, 0x21, 0xe6, 0x56, 0xad, 0x98, 0x8f, 0x11, 0x87,
+	0x42, 0xc8, 0xbb, 0x4a, 0xc8, 0xd8, 0xc6, 0x2c, 0x35, 0x57, 0xa4, 0x88, 0x0a, 0xcb, 0x05, 0x2c,
+	0x14, 0x9b, 0x54, 0xf0, 0x85, 0x7e, 0xb1, 0xd6, 0x7f, 0xd4, 0xc0, 0x6a, 0x82, 0xfa, 0x10, 0x13,
+	0x4c, 0x22, 0x9f, 0xd0, 0x33, 0xbf, 0x87, 0xfb, 0x98, 0x1b, 0x2f, 0x6d, 0x6a, 0x5b, 0x0b, 0xee,
+	0x30, 0x8f, 0xf1, 0x77, 0x6a, 0xbe, 0x16, 0x61, 0x7e, 0x32, 0xe8, 0xd8, 0x01, 0xed, 0xab, 0x92,
+	0x51, 0xff, 0xb6, 0x59, 0xd8, 0x75, 0xf8, 0x28, 0x46, 0xcc, 0x7e, 0x40, 0xf8, 0x65, 0x6a, 0xd6,
+	0x39, 0xcb, 0x52, 0x73, 0xa3, 0xc8, 0xcd, 0x33, 0x46, 0xeb, 0xcf, 0xdf, 0xb7, 0x81, 0x2a, 0xc3,
+	0x07, 0x84, 0x4b, 0x81, 0x2b, 0x25, 0xf1, 0x80, 0x9e, 0x7d, 0x94, 0xd3, 0xf4, 0x13, 0x30, 0xcf,
+	0x7a, 0x90, 0x9d, 0x60, 0x12, 0x19, 0xd7, 0x9f, 0x93, 0x9f, 0x23, 0x45, 0x72, 0xb7, 0x55, 0x7e,
+	0xca, 0x6d, 0x59, 0x6a, 0xae, 0x49, 0x19, 0x05, 0xe2, 0x63, 0x72, 0x4c, 0x55, 0x6d, 0x94, 0x34,
+	0xfd, 0x7b, 0x0d, 0xbc, 0x1c, 0xa2, 0x98, 0x32, 0xcc, 0x85, 0xcc, 0x04, 0x72, 0x64, 0x34, 0x44,
+	0x3e, 0xe2, 0x17, 0xc8, 0xc7, 0x3e, 0x0a, 0x2e, 0x53, 0xf3, 0x19, 0x4f, 0x59, 0x6a, 0xde, 0x90,
+	0x2a, 0xa6, 0x2d, 0xe3, 0x99, 0xd8, 0x47, 0x81, 0x94, 0xd5, 0x52, 0xac, 0x03, 0x7a, 0xe6, 0xe5,
+	0xaf, 0xe2, 0x57, 0x0d, 0xdc, 0x28, 0x2f, 0xd7, 0x4f, 0x50, 0xe4, 0x0f, 0x29, 0x47, 0x7e, 0x4c,
+	0x69, 0xcf, 0x98, 0x13, 0x69, 0xd9, 0x79, 0x6e, 0xd9, 0x78, 0x02, 0x4b, 0x20, 0xc7, 0x94, 0x7c,
+	0x46, 0x39, 0x3a, 0xa4, 0xb4, 0xe7, 0xbe, 0xaf, 0x52, 0x75, 0x95, 0xe7, 0x2c, 0x35, 0xdb, 0x53,
+	0x75, 0x35, 0x49, 0x50, 0x39, 0x5c, 0xed, 0x57, 0x51, 0x0a, 0xe7, 0xfa, 0xd7, 0x1a, 0x58, 0x1e,
+	0x90, 0x0e, 0x25, 0xa1, 0xbc, 0xf7, 0xbc, 0xc0, 0xe7, 0x85, 0x52, 0xab, 0x56, 0xe9, 0xa7, 0x05,
+	0x57, 0x54, 0xf9, 0xdb, 0x4a, 0xda, 0xb4, 0x8b, 0x2c, 0x35, 0x5f, 0x95, 0x92, 0xa6, 0x0c, 0x4a,
+	0x4a, 0x6b, 0x30, 0xee, 0x86, 0xe9, 0xbf, 0x68, 0xc0, 0xe8, 0xe2, 0xa0, 0xeb, 0x57, 0x27, 0xa8,
+	0x12, 0xb7, 0x20, 0xe4, 0xbc, 0x5e, 0x2b, 0xe7, 0x21, 0x0e, 0xba, 0x45, 0xf2, 0xca, 0x84, 0x7d,
+	0xa8, 0x54, 0x5d, 0xe9, 0x32, 0x4b, 0x4d, 0x53, 0xca, 0xbb, 0x8a, 0xa1, 0x74, 0xae, 0x75, 0x6b,
+	0xfc, 0xe7, 0x0d, 0xaa, 0x19, 0x27, 0x28, 0x86, 0x38, 0xf4, 0xe9, 0x19, 0x25, 0xc8, 0x00, 0xa2,
+	0xfe, 0x1e, 0xbd, 0xf0, 0x7b, 0x9c, 0x74, 0x53, 0x3d, 0x81, 0x09, 0xb8, 0xf6, 0x0d, 0x2e, 0x29,
+	0xca, 0x27, 0x82, 0xf1, 0x5d, 0x03, 0x2c, 0xab, 0xf6, 0x5c, 0xa8, 0xd5, 0x09, 0x58, 0x26, 0x88,
+	0x9f, 0xd2, 0xa4, 0xeb, 0xc3, 0x30, 0x4c, 0x10, 0x93, 0xad, 0x7a, 0xc1, 0xbd, 0x9f, 0x5f, 0xd8,
+	0x94, 0xa9, 0xba, 0xb0, 0x29, 0x43, 0x1e, 0x7c, 0x4d, 0x05, 0xdf, 0x93, 0xd0, 0x11, 0x4f, 0x30,
+	0x89, 0xbc, 0x96, 0x62, 0x2a, 0x54, 0x87, 0xa0, 0x11, 0x0f, 0x3a, 0x5d, 0x34, 0x32, 0xae, 0x89,
+	0x89, 0xb0, 0x66, 0xcb, 0xe1, 0x67, 0x17, 0xc3, 0xcf, 0xde, 0x23, 0x23, 0xf7, 0xae, 0x18, 0x03,
+	0x82, 0x37, 0x36, 0x06, 0xc4, 0xda, 0xfa, 0xa3, 0x0a, 0x15, 0x24, 0xa3, 0x98, 0x53, 0xfb, 0x70,
+	0xd0, 0x79, 0x88, 0x46, 0x9e, 0xda, 0xa0, 0xbf, 0x03, 0xe6, 0xd8, 0x80, 0xc5, 0x88, 0x84, 0xc6,
+	0xec, 0xa6, 0xb6, 0x35, 0xef, 0xde, 0xba, 0x4c, 0xcd, 0x02, 0xca, 0x52, 0xb3, 0x25, 0xdd, 0x29,
+	0xc0, 0xf2, 0x0a, 0x93, 0xfe, 0x39, 0x68, 0x30, 0x0e, 0xf9, 0x80, 0x89, 0xce, 0xd9, 0xda, 0xb5,
+	0x6c, 0x15, 0xa7, 0x98, 0x84, 0x6a, 0x32, 0xda, 0x2e, 0x25, 0xe1, 0x91, 0x60, 0xba, 0x37, 0x73,
+	0xa5, 0x72, 0x57, 0xa5, 0x54, 0xae, 0x2d, 0x4f, 0x19, 0x72, 0xc7, 0x9c, 0x76, 0x11, 0x61, 0xc6,
+	0x75, 0x71, 0xe8, 0xf5, 0xc2, 0x71, 0x3e, 0x92, 0x4b, 0xaf, 0xf7, 0x28, 0x26, 0xd5, 0x10, 0x94,
+	0x1b, 0x2a, 0x9f, 0x72, 0x5d, 0x0c, 0x41, 0xb9, 0xd2, 0x8f, 0x41, 0x93, 0x9e, 0x12, 0x94, 0x94,
+	0x77, 0x27, 0x5b, 0xdc, 0x5e, 0x5e, 0x34, 0x13, 0x86, 0xaa, 0x68, 0x26, 0xe0, 0xab, 0xef, 0x6d,
+	0x49, 0xf0, 0x8a, 0x5b, 0x3b, 0x06, 0xab, 0x1d, 0x44, 0xd0, 0x31, 0x0e, 0x30, 0x4c, 0x46, 0x65,
+	0xb4, 0x39, 0x11, 0xed, 0xad, 0x7c, 0x64, 0xd4, 0x98, 0xab, 0x91, 0x51, 0x63, 0xb4, 0x3c, 0x7d,
+	0x0c, 0x2d, 0xe2, 0x24, 0x60, 0x31, 0x44, 0x2c, 0x48, 0x70, 0x9c, 0xf7, 0x36, 0x63, 0x5e, 0x64,
+	0x6b, 0xb3, 0xf6, 0x4d, 0xef, 0x57, 0x3c, 0xd7, 0x56, 0x49, 0x1b, 0xdf, 0x9c, 0xa5, 0xa6, 0x5e,
+	0xf4, 0xe8, 0x12, 0x54, 0xe9, 0x1b, 0xe7, 0x59, 0x3f, 0x69, 0x60, 0xbe, 0x18, 0x38, 0xfa, 0x23,
+	0xd0, 0x3a, 0x85, 0xbd, 0x1e, 0xe2, 0x53, 0xaf, 0xe1, 0x5e, 0xfe, 0xb5, 0x30, 0x69, 0xa9, 0xbe,
+	0x16, 0x26, 0xf1, 0xab, 0x73, 0xda, 0x94, 0xc4, 0xe2, 0xb0, 0x0e, 0xb8, 0x3e, 0x84, 0xbd, 0x01,
+	0x12, 0x2f, 0x61, 0xd6, 0x5d, 0xbf, 0x4c, 0x4d, 0x09, 0x64, 0xa9, 0xb9, 0x24, 0x3d, 0x8b, 0xa5,
+	0xe5, 0x49, 0xd8, 0xf5, 0x1e, 0x9f, 0xb7, 0xb5, 0x27, 0xe7, 0x6d, 0xed, 0xe9, 0x79, 0x5b, 0xfb,
+	0xf7, 0xbc, 0xad, 0x7d, 0x7b, 0xd1, 0x9e, 0x79, 0x7a, 0xd1, 0x9e, 0xf9, 0xeb, 0xa2, 0x3d, 0xf3,
+	0xe5, 0x9b, 0x63, 0xfd, 0x44, 0x25, 0x8c, 0x20, 0x5e, 0xfc, 0xdc, 0x0e, 0x4e, 0x20, 0x26, 0xce,
+	0x57, 0xd5, 0xe7, 0x9b, 0xe8, 0x30, 0x9d, 0x86, 0x78, 0x77, 0x77, 0xff, 0x1f, 0x00, 0x6b, 0xcf,
+	0xb4, 0x7c, 0xbc, 0x0a, 0x00, 0x00,
 }
 
 func (this *GenesisState) Equal(that interface{}) bool {
@@ -434,6 +437,9 @@ func (this *GenesisState) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if !this.PrepaidOzone.Equal(that1.PrepaidOzone) {
+		return false
+	}
 	return true
 }
 func (this *GenesisMetaNode) Equal(that interface{}) bool {
@@ -528,6 +534,16 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.PrepaidOzone.Size()
+		i -= size
+		if _, err := m.PrepaidOzone.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
 	if len(m.KickMetaNodeVotePool) > 0 {
 		for iNdEx := len(m.KickMetaNodeVotePool) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -830,6 +846,8 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	l = m.PrepaidOzone.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -1224,6 +1242,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrepaidOzone", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PrepaidOzone.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
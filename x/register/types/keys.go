@@ -41,6 +41,8 @@ var (
 	UBDNodeKey                      = []byte{0x11} // prefix for each key to an unbonding node
 	UBDNodeQueueKey                 = []byte{0x12} // prefix for the timestamps in unbonding node queue
 	KickMetaNodeVotesKey            = []byte{0x13} // prefix for the key to the vote for kicking meta node
+	PrepaidOzoneKey                 = []byte{0x14} // key of the ozone issued to the noz ledger by prepay and not returned yet
+	MetaNodeBLSPubKeyKey            = []byte{0x15} // prefix for each key to the meta node owning a BLS public key
 
 	ParamsKey = []byte{0x20}
 )
@@ -57,6 +59,12 @@ func GetMetaNodeKey(nodeAddr stratos.SdsAddress) []byte {
 	return append(MetaNodeKey, nodeAddr.Bytes()...)
 }
 
+// GetMetaNodeBLSPubKeyKey gets the key for the meta node owning the BLS public key
+// VALUE: network address of the meta node
+func GetMetaNodeBLSPubKeyKey(blsPubKey []byte) []byte {
+	return append(MetaNodeBLSPubKeyKey, blsPubKey...)
+}
+
 // GetMetaNodeRegistrationVotesKey get the key for the vote for Meta node registration
 func GetMetaNodeRegistrationVotesKey(nodeAddr stratos.SdsAddress) []byte {
 	return append(MetaNodeRegistrationVotesKey, nodeAddr.Bytes()...)
@@ -21,6 +21,7 @@ var (
 	_ sdk.Msg = &MsgUpdateMetaNode{}
 	_ sdk.Msg = &MsgUpdateMetaNodeDeposit{}
 	_ sdk.Msg = &MsgMetaNodeRegistrationVote{}
+	_ sdk.Msg = &MsgRegisterMetaNodeBLSPubKey{}
 	_ sdk.Msg = &MsgUpdateParams{}
 )
 
@@ -36,6 +37,7 @@ const (
 	TypeMsgUpdateMetaNode            = "update_meta_node"
 	TypeMsgUpdateMetaNodeDeposit     = "update_meta_node_deposit"
 	TypeMsgMetaNodeRegistrationVote  = "meta_node_registration_vote"
+	TypeMsgRegisterMetaNodeBLSPubKey = "register_meta_node_bls_pub_key"
 	TypeMsgUpdateParams              = "update_params"
 )
 
@@ -506,6 +508,67 @@ func (msg MsgUpdateMetaNode) ValidateBasic() error {
 
 // --------------------------------------------------------------------------------------------------------------------
 
+func NewMsgRegisterMetaNodeBLSPubKey(networkAddress stratos.SdsAddress, ownerAddress sdk.AccAddress,
+	blsPubKey, signature []byte) *MsgRegisterMetaNodeBLSPubKey {
+
+	return &MsgRegisterMetaNodeBLSPubKey{
+		NetworkAddress: networkAddress.String(),
+		OwnerAddress:   ownerAddress.String(),
+		BlsPubKey:      blsPubKey,
+		Signature:      signature,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgRegisterMetaNodeBLSPubKey) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgRegisterMetaNodeBLSPubKey) Type() string { return TypeMsgRegisterMetaNodeBLSPubKey }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgRegisterMetaNodeBLSPubKey) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.OwnerAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr.Bytes()}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgRegisterMetaNodeBLSPubKey) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgRegisterMetaNodeBLSPubKey) ValidateBasic() error {
+	netAddr, err := stratos.SdsAddressFromBech32(msg.NetworkAddress)
+	if err != nil {
+		return ErrInvalidNetworkAddr
+	}
+	if netAddr.Empty() {
+		return ErrEmptyNodeNetworkAddress
+	}
+
+	ownerAddr, err := sdk.AccAddressFromBech32(msg.OwnerAddress)
+	if err != nil {
+		return ErrInvalidOwnerAddr
+	}
+	if ownerAddr.Empty() {
+		return ErrEmptyOwnerAddr
+	}
+
+	if len(msg.BlsPubKey) == 0 {
+		return ErrInvalidBLSPubKey
+	}
+	if len(msg.Signature) == 0 {
+		return ErrBLSVerifyFailed
+	}
+	return nil
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
 func NewMsgUpdateMetaNodeDeposit(networkAddress stratos.SdsAddress, ownerAddress sdk.AccAddress,
 	depositDelta sdk.Coin) *MsgUpdateMetaNodeDeposit {
 	return &MsgUpdateMetaNodeDeposit{
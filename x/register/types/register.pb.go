@@ -4,6 +4,7 @@
 package types
 
 import (
+	bytes "bytes"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	types1 "github.com/cosmos/cosmos-sdk/codec/types"
@@ -247,6 +248,7 @@ type MetaNode struct {
 	Description        Description                            `protobuf:"bytes,7,opt,name=description,proto3" json:"description" yaml:"description"`
 	CreationTime       time.Time                              `protobuf:"bytes,8,opt,name=creation_time,json=creationTime,proto3,stdtime" json:"creation_time" yaml:"creation_time"`
 	BeneficiaryAddress string                                 `protobuf:"bytes,9,opt,name=beneficiary_address,json=beneficiaryAddress,proto3" json:"beneficiary_address" yaml:"beneficiary_address"`
+	BlsPubKey          []byte                                 `protobuf:"bytes,10,opt,name=bls_pub_key,json=blsPubKey,proto3" json:"bls_pub_key" yaml:"bls_pub_key"`
 }
 
 func (m *MetaNode) Reset()         { *m = MetaNode{} }
@@ -338,6 +340,13 @@ func (m *MetaNode) GetBeneficiaryAddress() string {
 	return ""
 }
 
+func (m *MetaNode) GetBlsPubKey() []byte {
+	if m != nil {
+		return m.BlsPubKey
+	}
+	return nil
+}
+
 type MetaNodeRegistrationVotePool struct {
 	NetworkAddress string    `protobuf:"bytes,1,opt,name=network_address,json=networkAddress,proto3" json:"network_address" yaml:"network_address"`
 	ApproveList    []string  `protobuf:"bytes,2,rep,name=approve_list,json=approveList,proto3" json:"approve_list" yaml:"approve_list"`
@@ -698,110 +707,112 @@ func init() {
 }
 
 var fileDescriptor_fef1e3aeec8499d6 = []byte{
-	// 1642 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0xcf, 0x6f, 0xdb, 0xc8,
-	0x15, 0x36, 0xed, 0xd4, 0xb2, 0x46, 0xfe, 0x91, 0x30, 0x6e, 0x22, 0xbb, 0x89, 0xc6, 0x61, 0x7f,
-	0xc4, 0x49, 0x6b, 0x09, 0x76, 0x8a, 0x16, 0xcd, 0xa1, 0x85, 0x19, 0xbb, 0x68, 0x92, 0x26, 0x70,
-	0x19, 0x27, 0x05, 0x8a, 0x02, 0x2c, 0x45, 0x8e, 0xe5, 0xa9, 0xc5, 0x19, 0x82, 0x33, 0x92, 0x2d,
-	0xb4, 0xe8, 0xbd, 0x3d, 0x14, 0x39, 0x06, 0x28, 0x0a, 0xe4, 0xb2, 0x40, 0x80, 0xbd, 0xe4, 0xb0,
-	0xb7, 0xfd, 0x07, 0x82, 0x3d, 0x05, 0x7b, 0x5a, 0xec, 0x81, 0xbb, 0x70, 0x80, 0x0d, 0xa0, 0xbd,
-	0xf1, 0xb8, 0x97, 0x5d, 0x90, 0x33, 0x23, 0x52, 0xb4, 0x84, 0x6c, 0x16, 0xd8, 0x9c, 0x7c, 0xb1,
-	0x39, 0xdf, 0xf7, 0xf8, 0xde, 0xe3, 0xbc, 0xef, 0xbd, 0xa1, 0x08, 0x0c, 0xc6, 0x43, 0x87, 0x53,
-	0xd6, 0x08, 0x51, 0x0b, 0x33, 0x8e, 0xc2, 0x46, 0x77, 0x7d, 0x70, 0x5d, 0x0f, 0x42, 0xca, 0xa9,
-	0x7e, 0x5e, 0xda, 0xd4, 0x07, 0x78, 0x77, 0x7d, 0x79, 0xb1, 0x45, 0x5b, 0x34, 0xe5, 0x1b, 0xc9,
-	0x95, 0x30, 0x5d, 0x5e, 0x6a, 0x51, 0xda, 0x6a, 0xa3, 0x46, 0xba, 0x6a, 0x76, 0xf6, 0x1a, 0x0e,
-	0xe9, 0x49, 0x0a, 0x16, 0x29, 0x8e, 0x7d, 0xc4, 0xb8, 0xe3, 0x07, 0xd2, 0xa0, 0x56, 0x34, 0xf0,
-	0x3a, 0xa1, 0xc3, 0x31, 0x25, 0xca, 0xb7, 0x4b, 0x99, 0x4f, 0x99, 0x2d, 0x82, 0x8a, 0x85, 0xa4,
-	0xce, 0x39, 0x3e, 0x26, 0xb4, 0x91, 0xfe, 0x55, 0xde, 0x84, 0x41, 0xa3, 0xe9, 0x30, 0xd4, 0xe8,
-	0xae, 0x37, 0x11, 0x77, 0xd6, 0x1b, 0x2e, 0xc5, 0xca, 0xdb, 0x4f, 0x24, 0xcf, 0xb8, 0x73, 0x80,
-	0x49, 0x6b, 0x60, 0x22, 0xd7, 0xc2, 0xca, 0x78, 0xbf, 0x04, 0xa6, 0x77, 0x9c, 0xd0, 0xf1, 0x99,
-	0x6e, 0x02, 0xd0, 0xa4, 0xc4, 0xb3, 0x3d, 0x44, 0xa8, 0x5f, 0xd5, 0x56, 0xb4, 0xd5, 0xb2, 0xf9,
-	0xe3, 0x7e, 0x04, 0x73, 0x68, 0x1c, 0xc1, 0x73, 0x3d, 0xc7, 0x6f, 0xdf, 0x34, 0x32, 0xcc, 0xb0,
-	0xca, 0xc9, 0x62, 0x2b, 0xb9, 0xd6, 0xdf, 0xd3, 0xc0, 0x52, 0x87, 0x24, 0x6b, 0x4c, 0x5a, 0x36,
-	0xdf, 0x0f, 0x91, 0xc3, 0xf6, 0x69, 0xdb, 0xb3, 0x93, 0xbd, 0xa8, 0x4e, 0xae, 0x68, 0xab, 0x95,
-	0x8d, 0xa5, 0xba, 0xd8, 0x87, 0xba, 0xda, 0x87, 0xfa, 0x96, 0xdc, 0x07, 0xf3, 0xfe, 0x8b, 0x08,
-	0x4e, 0xf4, 0x23, 0x38, 0xde, 0x47, 0x1c, 0xc1, 0x15, 0x91, 0xc1, 0x58, 0x13, 0xe3, 0xc9, 0x67,
-	0x50, 0x7b, 0xf6, 0xfa, 0xf9, 0x75, 0xcd, 0xba, 0x38, 0x30, 0xda, 0x1d, 0xd8, 0xec, 0x62, 0x1f,
-	0x15, 0xf2, 0x74, 0xa9, 0x1f, 0xb4, 0x51, 0x92, 0x81, 0xc8, 0x73, 0xea, 0x3b, 0xe4, 0x59, 0xf0,
-	0x31, 0x2a, 0xcf, 0x82, 0xc9, 0xc8, 0x3c, 0x6f, 0x0d, 0x6c, 0xd2, 0x3c, 0x77, 0x40, 0xc5, 0x77,
-	0x8e, 0x6c, 0x44, 0x78, 0x88, 0x11, 0xab, 0x9e, 0x59, 0xd1, 0x56, 0xe7, 0xcc, 0x46, 0x3f, 0x82,
-	0x79, 0x38, 0x8e, 0xe0, 0x25, 0x11, 0x2b, 0x07, 0x1a, 0xbf, 0xa0, 0x3e, 0xe6, 0xc8, 0x0f, 0x78,
-	0xcf, 0x02, 0xbe, 0x73, 0xb4, 0x2d, 0x60, 0xfd, 0x9f, 0x60, 0x29, 0x44, 0x8c, 0x76, 0x42, 0x17,
-	0xd9, 0x84, 0x7a, 0xc8, 0x0e, 0x51, 0xcb, 0x46, 0xc4, 0x69, 0xb6, 0x91, 0x57, 0xfd, 0xc1, 0x8a,
-	0xb6, 0x3a, 0x63, 0x6e, 0x26, 0x4f, 0x36, 0xd6, 0x28, 0x7b, 0xb2, 0xb1, 0x26, 0x86, 0x75, 0x41,
-	0x71, 0xf7, 0xa9, 0x87, 0x2c, 0xd4, 0xda, 0x16, 0x84, 0xfe, 0x54, 0x2b, 0x86, 0xf7, 0x31, 0xb1,
-	0x3d, 0x14, 0x50, 0x86, 0x79, 0x75, 0x5a, 0xee, 0xbb, 0x94, 0x7e, 0xa2, 0xec, 0xba, 0x94, 0x6d,
-	0xfd, 0x16, 0xc5, 0xc4, 0xbc, 0xa3, 0xf6, 0x7d, 0xac, 0x8f, 0x71, 0xd9, 0xe5, 0x4c, 0x0c, 0xb1,
-	0xe7, 0x43, 0x29, 0xde, 0xc3, 0x64, 0x4b, 0xb0, 0x7a, 0x17, 0xcc, 0x75, 0x29, 0x4f, 0xea, 0x15,
-	0xa0, 0x10, 0x53, 0xaf, 0x5a, 0x7a, 0x93, 0x1a, 0x7e, 0x25, 0xb3, 0x1a, 0xbe, 0x2f, 0x8e, 0xe0,
-	0xa2, 0xc8, 0x64, 0x08, 0xce, 0x55, 0x7d, 0x56, 0x10, 0x3b, 0x29, 0x7e, 0xd3, 0x78, 0xf2, 0x14,
-	0x6a, 0xff, 0x79, 0xfd, 0xfc, 0xfa, 0x92, 0x9a, 0x58, 0x47, 0xd9, 0xcc, 0x12, 0x2d, 0x6a, 0xfc,
-	0xbf, 0x0c, 0x66, 0xad, 0x5c, 0xda, 0x3a, 0x01, 0x0b, 0x04, 0xf1, 0x43, 0x1a, 0x1e, 0xd8, 0x8e,
-	0xe7, 0x85, 0x88, 0x31, 0xd9, 0xb8, 0xdb, 0xfd, 0x08, 0x16, 0xa9, 0x38, 0x82, 0x17, 0x44, 0x46,
-	0x05, 0xc2, 0xf8, 0xf8, 0x83, 0xb5, 0x45, 0xb9, 0xe9, 0x9b, 0x02, 0x7a, 0xc0, 0x43, 0x4c, 0x5a,
-	0xd6, 0xbc, 0xb4, 0x94, 0xa8, 0xee, 0x80, 0xe9, 0xa0, 0xd3, 0x3c, 0x40, 0x3d, 0xd9, 0xcb, 0x8b,
-	0x27, 0x76, 0x65, 0x93, 0xf4, 0xcc, 0x1b, 0xfd, 0x08, 0x4a, 0xbb, 0x38, 0x82, 0x73, 0x22, 0xa6,
-	0x58, 0x1b, 0x1f, 0x65, 0xa1, 0xdc, 0xb0, 0x17, 0x70, 0x5a, 0xdf, 0xe9, 0x34, 0xef, 0xa2, 0x9e,
-	0x25, 0x6f, 0xd0, 0x7f, 0x0d, 0x4a, 0xac, 0xc3, 0x02, 0x44, 0xbc, 0xb4, 0x0f, 0x67, 0xcc, 0xcb,
-	0xfd, 0x08, 0x2a, 0x28, 0x8e, 0xe0, 0xbc, 0x70, 0x27, 0x01, 0xc3, 0x52, 0x94, 0xfe, 0x67, 0x30,
-	0xcd, 0xb8, 0xc3, 0x3b, 0xa2, 0x4d, 0xe6, 0x37, 0x0c, 0xa5, 0x23, 0x35, 0xf1, 0x94, 0x94, 0x4c,
-	0x4a, 0xbc, 0x07, 0xa9, 0xa5, 0xf9, 0xa3, 0x24, 0x53, 0x71, 0x57, 0x96, 0xa9, 0x58, 0x1b, 0x96,
-	0x24, 0x74, 0x0e, 0xa6, 0x39, 0x3d, 0x40, 0x84, 0xa5, 0xfd, 0x51, 0x36, 0xff, 0x9a, 0xd4, 0xfb,
-	0xd3, 0x08, 0xfe, 0xac, 0x85, 0xf9, 0x7e, 0xa7, 0x59, 0x77, 0xa9, 0x2f, 0xa7, 0xb5, 0xfc, 0xb7,
-	0xc6, 0xbc, 0x83, 0x06, 0xef, 0x05, 0x88, 0xd5, 0x6f, 0x13, 0x9e, 0x84, 0x10, 0xf7, 0x67, 0x21,
-	0xc4, 0x3a, 0xd9, 0x77, 0x20, 0x93, 0xbc, 0x4d, 0xb8, 0x50, 0x85, 0xb4, 0xd5, 0xf7, 0xc0, 0x1c,
-	0x3d, 0x24, 0x28, 0x1c, 0x14, 0x76, 0x3a, 0x0d, 0x9e, 0x34, 0xe7, 0x30, 0x91, 0x09, 0x6d, 0x08,
-	0x1e, 0x5f, 0xd4, 0xd9, 0xd4, 0x4e, 0x95, 0xd4, 0x07, 0x15, 0x0f, 0x31, 0x37, 0xc4, 0x41, 0x22,
-	0x66, 0xa9, 0xf6, 0x95, 0xfa, 0x88, 0x23, 0xb1, 0xbe, 0x95, 0xd9, 0x99, 0xd7, 0xa4, 0xe8, 0xf3,
-	0x37, 0xc7, 0x11, 0xd4, 0x45, 0x26, 0x39, 0xd0, 0xb0, 0xf2, 0x26, 0xfa, 0x21, 0x98, 0x73, 0x43,
-	0xe4, 0x64, 0xc3, 0x76, 0x26, 0x0d, 0xb8, 0x7c, 0x42, 0x48, 0xbb, 0xea, 0xf4, 0xcc, 0xfa, 0x6b,
-	0xe8, 0xc6, 0xec, 0xb1, 0x87, 0x60, 0xe3, 0x71, 0xd6, 0x5f, 0x8a, 0x48, 0x47, 0xe9, 0x6f, 0x41,
-	0x39, 0x9d, 0x04, 0x49, 0x5d, 0xaa, 0xe5, 0x74, 0x90, 0x5e, 0xe9, 0x47, 0x30, 0x03, 0xe3, 0x08,
-	0x9e, 0x95, 0xed, 0xa1, 0x20, 0xc3, 0x9a, 0x49, 0xae, 0x77, 0x7b, 0x01, 0xd2, 0xff, 0xa7, 0x81,
-	0xb3, 0x68, 0x6f, 0x0f, 0xb9, 0x1c, 0x77, 0x91, 0x2d, 0x05, 0x01, 0xd2, 0x9a, 0x04, 0x6f, 0x2d,
-	0x88, 0x13, 0x9e, 0xe2, 0x08, 0x5e, 0x14, 0xc1, 0x8b, 0xcc, 0x48, 0x91, 0x2c, 0x0c, 0xac, 0x76,
-	0x85, 0x5a, 0xfe, 0x05, 0xce, 0x37, 0x11, 0x41, 0x7b, 0xd8, 0xc5, 0x4e, 0xd8, 0x1b, 0x68, 0xa6,
-	0x92, 0xe6, 0x77, 0xaf, 0x1f, 0xc1, 0x51, 0x74, 0x1c, 0xc1, 0x65, 0x79, 0x9c, 0x9f, 0x24, 0xc7,
-	0xeb, 0x47, 0xcf, 0x59, 0x4b, 0xe6, 0xe6, 0x99, 0x64, 0x7a, 0x19, 0x1f, 0x96, 0xc0, 0xcc, 0x3d,
-	0xc4, 0x9d, 0xd3, 0xd9, 0x74, 0x3a, 0x9b, 0x4e, 0x67, 0xd3, 0xb7, 0x9f, 0x4d, 0x63, 0xba, 0xb7,
-	0xfc, 0x6e, 0xbb, 0xf7, 0x8b, 0x29, 0x70, 0x49, 0x75, 0xaf, 0x95, 0x6e, 0xad, 0x78, 0xc1, 0x79,
-	0x44, 0x39, 0xda, 0xa1, 0xb4, 0xfd, 0xce, 0x3b, 0xfa, 0x0e, 0x98, 0x75, 0x82, 0x20, 0xa4, 0x5d,
-	0x64, 0xb7, 0x31, 0xe3, 0xd5, 0xc9, 0x95, 0xa9, 0xd5, 0xb2, 0x79, 0xb5, 0x1f, 0xc1, 0x21, 0x3c,
-	0x8e, 0xe0, 0x79, 0x11, 0x29, 0x8f, 0x1a, 0x56, 0x45, 0x2e, 0xff, 0x88, 0x19, 0xd7, 0x7f, 0x0f,
-	0x2a, 0x21, 0xfa, 0x3b, 0x72, 0xb9, 0x70, 0x35, 0x95, 0xba, 0xfa, 0x69, 0x22, 0x92, 0x1c, 0x9c,
-	0x89, 0x24, 0x07, 0x1a, 0x16, 0x10, 0xab, 0xd4, 0x0f, 0x05, 0x15, 0x74, 0x14, 0xe0, 0x10, 0x09,
-	0x85, 0x9c, 0x79, 0xa3, 0x42, 0x36, 0x94, 0x18, 0x73, 0xb7, 0x65, 0x71, 0x72, 0x60, 0x4e, 0x1d,
-	0x40, 0xc0, 0xa9, 0x36, 0xfe, 0x04, 0xe6, 0x31, 0xb3, 0xbb, 0x94, 0x23, 0x3b, 0x70, 0x18, 0x1b,
-	0xbc, 0xa5, 0xff, 0xbc, 0x1f, 0xc1, 0x02, 0x13, 0x47, 0xf0, 0x87, 0xc2, 0xed, 0x30, 0x6e, 0x58,
-	0xb3, 0x98, 0xa5, 0x55, 0x14, 0xcb, 0xaf, 0xa6, 0xc0, 0xe2, 0x5d, 0xec, 0x1e, 0xa8, 0x62, 0x0f,
-	0x0a, 0xfc, 0x6f, 0x0d, 0x5c, 0xe0, 0x4e, 0xd8, 0x42, 0xdc, 0x1e, 0x5d, 0xe8, 0x07, 0xfd, 0x08,
-	0x8e, 0xb1, 0x88, 0x23, 0x78, 0x59, 0x0e, 0x90, 0x91, 0xfc, 0xf8, 0xb2, 0x2f, 0x8a, 0x1b, 0xee,
-	0x9f, 0x16, 0xff, 0x5d, 0x14, 0xff, 0xeb, 0x49, 0x50, 0xc9, 0x0d, 0xcb, 0xe4, 0x4c, 0xf3, 0x29,
-	0xc1, 0x07, 0x28, 0x94, 0x35, 0x4e, 0xcf, 0x34, 0x09, 0x65, 0x67, 0x9a, 0x04, 0x0c, 0x4b, 0x51,
-	0xfa, 0x36, 0x98, 0xc1, 0x1e, 0x22, 0x1c, 0x73, 0x71, 0xe2, 0x96, 0xcd, 0x6b, 0xfd, 0x08, 0x0e,
-	0xb0, 0x38, 0x82, 0x4b, 0x32, 0x1f, 0x89, 0xe4, 0x7f, 0x92, 0x0e, 0xcc, 0xf4, 0x4d, 0x50, 0x3a,
-	0x44, 0x4d, 0x86, 0xb9, 0xf8, 0xdd, 0x2d, 0x4a, 0xac, 0xa0, 0x38, 0x82, 0x55, 0xe1, 0x44, 0x02,
-	0x79, 0x1f, 0xca, 0x48, 0xf7, 0xc0, 0x59, 0x86, 0xdc, 0x4e, 0x88, 0x79, 0xcf, 0x76, 0x29, 0xe1,
-	0x8e, 0xcb, 0xd3, 0xda, 0x94, 0xcd, 0xdf, 0x24, 0xef, 0x5a, 0x45, 0x2e, 0x8e, 0xe0, 0x15, 0x79,
-	0x9a, 0x16, 0x98, 0xbc, 0xf7, 0x05, 0x45, 0xde, 0x12, 0x5c, 0x92, 0xa8, 0x87, 0xb8, 0x83, 0xdb,
-	0xea, 0xac, 0x4d, 0x13, 0x95, 0x50, 0x96, 0xa8, 0x04, 0x86, 0x12, 0x95, 0x98, 0x9c, 0xb3, 0x4f,
-	0x27, 0xc1, 0xdc, 0x43, 0xf5, 0x83, 0x3f, 0x7d, 0x55, 0x6a, 0x82, 0xd9, 0x7c, 0xb7, 0xc8, 0x42,
-	0xfc, 0x2e, 0xd1, 0x7a, 0x1e, 0xcf, 0xb4, 0x9e, 0x47, 0xc7, 0x37, 0x56, 0x25, 0x37, 0x4f, 0xf5,
-	0xdb, 0x60, 0x16, 0x33, 0xdb, 0x47, 0xdc, 0x49, 0x7f, 0x11, 0xa7, 0x25, 0x9b, 0x11, 0xfd, 0x94,
-	0xc7, 0xb3, 0x18, 0x79, 0xd4, 0xb0, 0x00, 0x66, 0x83, 0x37, 0xbb, 0xbf, 0x81, 0x92, 0xfa, 0x22,
-	0x91, 0xb4, 0x52, 0x65, 0xe3, 0xea, 0xc8, 0x23, 0x79, 0xe8, 0x19, 0xb7, 0x09, 0x0f, 0x7b, 0x42,
-	0x5b, 0xd9, 0x67, 0x0b, 0xa9, 0x2d, 0xf5, 0xc9, 0xc2, 0x52, 0x94, 0xf1, 0xe5, 0x14, 0xd0, 0x4f,
-	0xde, 0xae, 0x3f, 0x02, 0x0b, 0x83, 0x03, 0x75, 0x1f, 0xe1, 0xd6, 0x3e, 0x4f, 0xb7, 0x6a, 0xca,
-	0x5c, 0x4b, 0x0e, 0xa0, 0x02, 0x95, 0x1d, 0x40, 0x05, 0xc2, 0xb0, 0xe6, 0x15, 0xf2, 0x87, 0x14,
-	0xd0, 0xff, 0x01, 0x16, 0x8a, 0xdf, 0x80, 0x26, 0xbf, 0xb7, 0xa3, 0x7f, 0xde, 0x1d, 0xfe, 0xc6,
-	0xf3, 0x5f, 0x0d, 0x2c, 0x60, 0x82, 0x39, 0x76, 0xda, 0x76, 0xd3, 0x69, 0x3b, 0xc4, 0x55, 0x9d,
-	0x80, 0xde, 0xea, 0x45, 0xae, 0xe8, 0x24, 0x7b, 0xfe, 0x02, 0x51, 0x78, 0xb5, 0xb3, 0xe6, 0x25,
-	0x6f, 0x0a, 0x5a, 0xa7, 0xa0, 0xa4, 0xf2, 0x10, 0x5d, 0xf4, 0xf0, 0xad, 0xf2, 0x28, 0x65, 0xf1,
-	0x65, 0x7d, 0xc7, 0xc4, 0x55, 0x86, 0xa6, 0xf5, 0xec, 0xb8, 0xa6, 0xbd, 0x38, 0xae, 0x69, 0x2f,
-	0x8f, 0x6b, 0xda, 0xe7, 0xc7, 0x35, 0xed, 0xf1, 0xab, 0xda, 0xc4, 0xcb, 0x57, 0xb5, 0x89, 0x4f,
-	0x5e, 0xd5, 0x26, 0xfe, 0xf2, 0xcb, 0x5c, 0x64, 0x29, 0x33, 0x82, 0xb8, 0xba, 0x5c, 0x73, 0xf7,
-	0x1d, 0x4c, 0xf2, 0xdf, 0x4a, 0xd2, 0x5c, 0x9a, 0xd3, 0x69, 0xc5, 0x6e, 0x7c, 0x13, 0x00, 0x00,
-	0xff, 0xff, 0xd5, 0xd6, 0x19, 0x8f, 0x00, 0x16, 0x00, 0x00,
+	// 1667 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0xcd, 0x6f, 0x1c, 0x49,
+	0x15, 0x77, 0xdb, 0xc1, 0x9e, 0xa9, 0x19, 0xdb, 0xd9, 0x8e, 0xc9, 0x8e, 0xcd, 0xee, 0x94, 0xb7,
+	0xf9, 0x58, 0xef, 0x82, 0x67, 0x64, 0x2f, 0x02, 0x91, 0x03, 0xc8, 0x1d, 0x1b, 0x91, 0x5d, 0x12,
+	0x99, 0x8e, 0x13, 0x24, 0x84, 0xd4, 0xf4, 0x74, 0x97, 0xc7, 0x85, 0xa7, 0xab, 0x5a, 0x5d, 0x35,
+	0x63, 0x8f, 0x40, 0x1c, 0xb8, 0xc1, 0x01, 0xe5, 0x18, 0x09, 0x21, 0xe5, 0x82, 0x14, 0x89, 0x4b,
+	0x0e, 0xfc, 0x11, 0x11, 0xa7, 0x88, 0x13, 0xe2, 0xd0, 0x20, 0x47, 0x22, 0xd2, 0x70, 0xeb, 0x23,
+	0x17, 0x50, 0xd7, 0xc7, 0x74, 0x4f, 0x7b, 0x46, 0x21, 0x48, 0xe4, 0xe4, 0x8b, 0xdd, 0xf5, 0xfb,
+	0xbd, 0x7a, 0xef, 0xf5, 0xfb, 0xaa, 0x9a, 0x06, 0x16, 0xe3, 0xb1, 0xc7, 0x29, 0x6b, 0xc7, 0xa8,
+	0x8b, 0x19, 0x47, 0x71, 0x7b, 0xb0, 0x33, 0x7e, 0x6e, 0x45, 0x31, 0xe5, 0xd4, 0xbc, 0xa1, 0x64,
+	0x5a, 0x63, 0x7c, 0xb0, 0xb3, 0xb1, 0xd6, 0xa5, 0x5d, 0x2a, 0xf8, 0x76, 0xf6, 0x24, 0x45, 0x37,
+	0xd6, 0xbb, 0x94, 0x76, 0x7b, 0xa8, 0x2d, 0x56, 0x9d, 0xfe, 0x71, 0xdb, 0x23, 0x43, 0x45, 0xc1,
+	0x32, 0xc5, 0x71, 0x88, 0x18, 0xf7, 0xc2, 0x48, 0x09, 0x34, 0xcb, 0x02, 0x41, 0x3f, 0xf6, 0x38,
+	0xa6, 0x44, 0xeb, 0xf6, 0x29, 0x0b, 0x29, 0x73, 0xa5, 0x51, 0xb9, 0x50, 0xd4, 0x3b, 0x5e, 0x88,
+	0x09, 0x6d, 0x8b, 0xbf, 0x5a, 0x9b, 0x14, 0x68, 0x77, 0x3c, 0x86, 0xda, 0x83, 0x9d, 0x0e, 0xe2,
+	0xde, 0x4e, 0xdb, 0xa7, 0x58, 0x6b, 0xfb, 0x92, 0xe2, 0x19, 0xf7, 0x4e, 0x31, 0xe9, 0x8e, 0x45,
+	0xd4, 0x5a, 0x4a, 0x59, 0x7f, 0x58, 0x02, 0x8b, 0x87, 0x5e, 0xec, 0x85, 0xcc, 0xb4, 0x01, 0xe8,
+	0x50, 0x12, 0xb8, 0x01, 0x22, 0x34, 0x6c, 0x18, 0x9b, 0xc6, 0x56, 0xd5, 0xfe, 0xe2, 0x28, 0x81,
+	0x05, 0x34, 0x4d, 0xe0, 0x3b, 0x43, 0x2f, 0xec, 0xdd, 0xb2, 0x72, 0xcc, 0x72, 0xaa, 0xd9, 0x62,
+	0x3f, 0x7b, 0x36, 0x7f, 0x6f, 0x80, 0xf5, 0x3e, 0xc9, 0xd6, 0x98, 0x74, 0x5d, 0x7e, 0x12, 0x23,
+	0x8f, 0x9d, 0xd0, 0x5e, 0xe0, 0x66, 0xb1, 0x68, 0xcc, 0x6f, 0x1a, 0x5b, 0xb5, 0xdd, 0xf5, 0x96,
+	0x8c, 0x43, 0x4b, 0xc7, 0xa1, 0xb5, 0xaf, 0xe2, 0x60, 0xdf, 0x7b, 0x9e, 0xc0, 0xb9, 0x51, 0x02,
+	0x67, 0xeb, 0x48, 0x13, 0xb8, 0x29, 0x3d, 0x98, 0x29, 0x62, 0x3d, 0xfe, 0x1b, 0x34, 0x9e, 0xbe,
+	0x7a, 0xf6, 0xb1, 0xe1, 0xbc, 0x3b, 0x16, 0x3a, 0x1a, 0xcb, 0x1c, 0xe1, 0x10, 0x95, 0xfc, 0xf4,
+	0x69, 0x18, 0xf5, 0x50, 0xe6, 0x81, 0xf4, 0x73, 0xe1, 0x7f, 0xf0, 0xb3, 0xa4, 0x63, 0x9a, 0x9f,
+	0x25, 0x91, 0xa9, 0x7e, 0xde, 0x1e, 0xcb, 0x08, 0x3f, 0x0f, 0x41, 0x2d, 0xf4, 0xce, 0x5d, 0x44,
+	0x78, 0x8c, 0x11, 0x6b, 0x5c, 0xdb, 0x34, 0xb6, 0x96, 0xed, 0xf6, 0x28, 0x81, 0x45, 0x38, 0x4d,
+	0xe0, 0x7b, 0xd2, 0x56, 0x01, 0xb4, 0xbe, 0x46, 0x43, 0xcc, 0x51, 0x18, 0xf1, 0xa1, 0x03, 0x42,
+	0xef, 0xfc, 0x40, 0xc2, 0xe6, 0xcf, 0xc1, 0x7a, 0x8c, 0x18, 0xed, 0xc7, 0x3e, 0x72, 0x09, 0x0d,
+	0x90, 0x1b, 0xa3, 0xae, 0x8b, 0x88, 0xd7, 0xe9, 0xa1, 0xa0, 0xf1, 0xb9, 0x4d, 0x63, 0xab, 0x62,
+	0xef, 0x65, 0x6f, 0x36, 0x53, 0x28, 0x7f, 0xb3, 0x99, 0x22, 0x96, 0x73, 0x53, 0x73, 0xf7, 0x68,
+	0x80, 0x1c, 0xd4, 0x3d, 0x90, 0x84, 0xf9, 0xc4, 0x28, 0x9b, 0x0f, 0x31, 0x71, 0x03, 0x14, 0x51,
+	0x86, 0x79, 0x63, 0x51, 0xc5, 0x5d, 0x95, 0x7e, 0x56, 0xd9, 0x2d, 0x55, 0xb6, 0xad, 0xdb, 0x14,
+	0x13, 0xfb, 0x53, 0x1d, 0xf7, 0x99, 0x3a, 0x66, 0x79, 0x57, 0x10, 0xb1, 0x64, 0xcc, 0x27, 0x5c,
+	0xbc, 0x8b, 0xc9, 0xbe, 0x64, 0xcd, 0x01, 0x58, 0x1e, 0x50, 0x9e, 0xe5, 0x2b, 0x42, 0x31, 0xa6,
+	0x41, 0x63, 0xe9, 0x75, 0xd5, 0xf0, 0x0d, 0xe5, 0xd5, 0xe4, 0xbe, 0x34, 0x81, 0x6b, 0xd2, 0x93,
+	0x09, 0xb8, 0x90, 0xf5, 0xba, 0x24, 0x0e, 0x05, 0x7e, 0xcb, 0x7a, 0xfc, 0x04, 0x1a, 0xbf, 0x7e,
+	0xf5, 0xec, 0xe3, 0x75, 0x3d, 0xb1, 0xce, 0xf3, 0x99, 0x25, 0x5b, 0xd4, 0xfa, 0x5d, 0x15, 0xd4,
+	0x9d, 0x82, 0xdb, 0x26, 0x01, 0xab, 0x04, 0xf1, 0x33, 0x1a, 0x9f, 0xba, 0x5e, 0x10, 0xc4, 0x88,
+	0x31, 0xd5, 0xb8, 0x07, 0xa3, 0x04, 0x96, 0xa9, 0x34, 0x81, 0x37, 0xa5, 0x47, 0x25, 0xc2, 0xfa,
+	0xf3, 0x1f, 0xb7, 0xd7, 0x54, 0xd0, 0xf7, 0x24, 0x74, 0x9f, 0xc7, 0x98, 0x74, 0x9d, 0x15, 0x25,
+	0xa9, 0x50, 0xd3, 0x03, 0x8b, 0x51, 0xbf, 0x73, 0x8a, 0x86, 0xaa, 0x97, 0xd7, 0x2e, 0x45, 0x65,
+	0x8f, 0x0c, 0xed, 0x4f, 0x46, 0x09, 0x54, 0x72, 0x69, 0x02, 0x97, 0xa5, 0x4d, 0xb9, 0xb6, 0xfe,
+	0x94, 0x9b, 0xf2, 0xe3, 0x61, 0xc4, 0x69, 0xeb, 0xb0, 0xdf, 0xf9, 0x0c, 0x0d, 0x1d, 0xb5, 0xc1,
+	0xfc, 0x26, 0x58, 0x62, 0x7d, 0x16, 0x21, 0x12, 0x88, 0x3e, 0xac, 0xd8, 0xef, 0x8f, 0x12, 0xa8,
+	0xa1, 0x34, 0x81, 0x2b, 0x52, 0x9d, 0x02, 0x2c, 0x47, 0x53, 0xe6, 0x0f, 0xc1, 0x22, 0xe3, 0x1e,
+	0xef, 0xcb, 0x36, 0x59, 0xd9, 0xb5, 0x74, 0x1d, 0xe9, 0x89, 0xa7, 0x4b, 0xc9, 0xa6, 0x24, 0xb8,
+	0x2f, 0x24, 0xed, 0x2f, 0x64, 0x9e, 0xca, 0x5d, 0xb9, 0xa7, 0x72, 0x6d, 0x39, 0x8a, 0x30, 0x39,
+	0x58, 0xe4, 0xf4, 0x14, 0x11, 0x26, 0xfa, 0xa3, 0x6a, 0xff, 0x38, 0xcb, 0xf7, 0x5f, 0x13, 0xf8,
+	0x95, 0x2e, 0xe6, 0x27, 0xfd, 0x4e, 0xcb, 0xa7, 0xa1, 0x9a, 0xd6, 0xea, 0xdf, 0x36, 0x0b, 0x4e,
+	0xdb, 0x7c, 0x18, 0x21, 0xd6, 0xba, 0x43, 0x78, 0x66, 0x42, 0xee, 0xcf, 0x4d, 0xc8, 0x75, 0x16,
+	0x77, 0xa0, 0x9c, 0xbc, 0x43, 0xb8, 0xac, 0x0a, 0x25, 0x6b, 0x1e, 0x83, 0x65, 0x7a, 0x46, 0x50,
+	0x3c, 0x4e, 0xec, 0xa2, 0x30, 0x9e, 0x35, 0xe7, 0x24, 0x91, 0x17, 0xda, 0x04, 0x3c, 0x3b, 0xa9,
+	0x75, 0x21, 0xa7, 0x53, 0x1a, 0x82, 0x5a, 0x80, 0x98, 0x1f, 0xe3, 0x28, 0x2b, 0x66, 0x55, 0xed,
+	0x9b, 0xad, 0x29, 0x47, 0x62, 0x6b, 0x3f, 0x97, 0xb3, 0x3f, 0x52, 0x45, 0x5f, 0xdc, 0x9c, 0x26,
+	0xd0, 0x94, 0x9e, 0x14, 0x40, 0xcb, 0x29, 0x8a, 0x98, 0x67, 0x60, 0xd9, 0x8f, 0x91, 0x97, 0x0f,
+	0xdb, 0x8a, 0x30, 0xb8, 0x71, 0xa9, 0x90, 0x8e, 0xf4, 0xe9, 0x99, 0xf7, 0xd7, 0xc4, 0xc6, 0xfc,
+	0xb5, 0x27, 0x60, 0xeb, 0x51, 0xde, 0x5f, 0x9a, 0x10, 0xa3, 0xf4, 0xdb, 0xa0, 0x2a, 0x26, 0x41,
+	0x96, 0x97, 0x46, 0x55, 0x0c, 0xd2, 0x0f, 0x46, 0x09, 0xcc, 0xc1, 0x34, 0x81, 0xd7, 0x55, 0x7b,
+	0x68, 0xc8, 0x72, 0x2a, 0xd9, 0xf3, 0xd1, 0x30, 0x42, 0xe6, 0x6f, 0x0d, 0x70, 0x1d, 0x1d, 0x1f,
+	0x23, 0x9f, 0xe3, 0x01, 0x72, 0x55, 0x41, 0x00, 0x91, 0x93, 0xe8, 0x8d, 0x0b, 0xe2, 0x92, 0xa6,
+	0x34, 0x81, 0xef, 0x4a, 0xe3, 0x65, 0x66, 0x6a, 0x91, 0xac, 0x8e, 0xa5, 0x8e, 0x64, 0xb5, 0xfc,
+	0x02, 0xdc, 0xe8, 0x20, 0x82, 0x8e, 0xb1, 0x8f, 0xbd, 0x78, 0x38, 0xae, 0x99, 0x9a, 0xf0, 0xef,
+	0xee, 0x28, 0x81, 0xd3, 0xe8, 0x34, 0x81, 0x1b, 0xea, 0x38, 0xbf, 0x4c, 0xce, 0xae, 0x1f, 0xb3,
+	0x20, 0xad, 0x98, 0x5b, 0xd7, 0xb2, 0xe9, 0x65, 0xfd, 0xb2, 0x02, 0x2a, 0x77, 0x11, 0xf7, 0xae,
+	0x66, 0xd3, 0xd5, 0x6c, 0xba, 0x9a, 0x4d, 0xff, 0xfd, 0x6c, 0x9a, 0xd1, 0xbd, 0xd5, 0xb7, 0xd4,
+	0xbd, 0xe6, 0x01, 0xa8, 0x75, 0x7a, 0xcc, 0x8d, 0xfa, 0x1d, 0x37, 0xeb, 0x9f, 0x6c, 0xaa, 0xd5,
+	0xed, 0x2f, 0x67, 0x11, 0x2c, 0xc0, 0x79, 0x04, 0x0b, 0x60, 0x76, 0xfb, 0xef, 0x31, 0xd9, 0x26,
+	0x6a, 0x08, 0xfc, 0x63, 0x01, 0xbc, 0xa7, 0x87, 0x80, 0x23, 0x32, 0x24, 0xef, 0x49, 0x0f, 0x29,
+	0x47, 0x87, 0x94, 0xf6, 0xde, 0xfa, 0x60, 0xf8, 0x14, 0xd4, 0xbd, 0x28, 0x8a, 0xe9, 0x00, 0xb9,
+	0x3d, 0xcc, 0x78, 0x63, 0x7e, 0x73, 0x61, 0xab, 0x6a, 0x7f, 0x38, 0x4a, 0xe0, 0x04, 0x9e, 0x26,
+	0xf0, 0x86, 0xb4, 0x54, 0x44, 0x2d, 0xa7, 0xa6, 0x96, 0xdf, 0xc7, 0x8c, 0x9b, 0xdf, 0x05, 0xb5,
+	0x18, 0xfd, 0x14, 0xf9, 0x5c, 0xaa, 0x5a, 0x10, 0xaa, 0x44, 0xa4, 0x0a, 0x70, 0x1e, 0xa9, 0x02,
+	0x68, 0x39, 0x40, 0xae, 0x84, 0x1e, 0x0a, 0x6a, 0xe8, 0x3c, 0xc2, 0x31, 0x92, 0x85, 0x76, 0xed,
+	0xb5, 0x85, 0xb6, 0xab, 0x6b, 0xba, 0xb0, 0x2d, 0xb7, 0x53, 0x00, 0x0b, 0x45, 0x06, 0x24, 0x2c,
+	0x4a, 0xec, 0x07, 0x60, 0x05, 0x33, 0x77, 0x40, 0x39, 0x72, 0x23, 0x8f, 0xb1, 0xf1, 0x65, 0xff,
+	0xab, 0xa3, 0x04, 0x96, 0x98, 0x34, 0x81, 0x9f, 0x97, 0x6a, 0x27, 0x71, 0xcb, 0xa9, 0x63, 0x26,
+	0xb2, 0x28, 0x97, 0xff, 0x5a, 0x00, 0x6b, 0x9f, 0x61, 0xff, 0x54, 0x27, 0x7b, 0x9c, 0xe0, 0x5f,
+	0x19, 0xe0, 0x26, 0xf7, 0xe2, 0x2e, 0xe2, 0xee, 0xf4, 0x44, 0xdf, 0x1f, 0x25, 0x70, 0x86, 0x44,
+	0x9a, 0xc0, 0xf7, 0xa5, 0xf1, 0xe9, 0xfc, 0xec, 0xb4, 0xaf, 0xc9, 0x0d, 0xf7, 0xae, 0x92, 0xff,
+	0x36, 0x92, 0xff, 0xef, 0x79, 0x50, 0x2b, 0xcc, 0xdc, 0xec, 0x68, 0x0c, 0x29, 0xc1, 0xa7, 0x28,
+	0x56, 0x39, 0x16, 0x47, 0xa3, 0x82, 0xf2, 0xa3, 0x51, 0x01, 0x96, 0xa3, 0x29, 0xf3, 0x00, 0x54,
+	0x70, 0x80, 0x08, 0xc7, 0x5c, 0x1e, 0xdc, 0x55, 0xfb, 0xa3, 0x51, 0x02, 0xc7, 0x58, 0x9a, 0xc0,
+	0x75, 0xe5, 0x8f, 0x42, 0x8a, 0xbf, 0x6c, 0xc7, 0x62, 0xe6, 0x1e, 0x58, 0x3a, 0x43, 0x1d, 0x86,
+	0xb9, 0xfc, 0xf9, 0x2e, 0x53, 0xac, 0xa1, 0x34, 0x81, 0x0d, 0xa9, 0x44, 0x01, 0x45, 0x1d, 0x5a,
+	0xc8, 0x0c, 0xc0, 0x75, 0x86, 0xfc, 0x7e, 0x8c, 0xf9, 0xd0, 0xf5, 0x29, 0xe1, 0x9e, 0xcf, 0x45,
+	0x6e, 0xaa, 0xf6, 0xb7, 0xb2, 0x2b, 0x5b, 0x99, 0x4b, 0x13, 0xf8, 0x81, 0x54, 0x5a, 0x66, 0x8a,
+	0xda, 0x57, 0x35, 0x79, 0x5b, 0x72, 0x99, 0xa3, 0x01, 0xe2, 0x1e, 0xee, 0xe9, 0x23, 0x5b, 0x38,
+	0xaa, 0xa0, 0xdc, 0x51, 0x05, 0x4c, 0x38, 0xaa, 0x30, 0x35, 0x67, 0x9f, 0xcc, 0x83, 0xe5, 0x07,
+	0xfa, 0xbb, 0x81, 0xb8, 0x71, 0x75, 0x40, 0xbd, 0xd8, 0x2d, 0x2a, 0x11, 0xdf, 0xc9, 0x6a, 0xbd,
+	0x88, 0xe7, 0xb5, 0x5e, 0x44, 0x67, 0x37, 0x56, 0xad, 0x30, 0x4f, 0xcd, 0x3b, 0xa0, 0x8e, 0x99,
+	0x1b, 0x22, 0xee, 0x89, 0x1f, 0xd6, 0x22, 0x65, 0x15, 0xd9, 0x4f, 0x45, 0x3c, 0xb7, 0x51, 0x44,
+	0x2d, 0x07, 0x60, 0x36, 0xbe, 0x20, 0xfe, 0x04, 0x2c, 0xe9, 0x0f, 0x1b, 0x59, 0x2b, 0xd5, 0x76,
+	0x3f, 0x9c, 0x7a, 0xb2, 0x4f, 0xbc, 0x63, 0xf6, 0x19, 0x63, 0x28, 0x6b, 0x2b, 0xff, 0xfa, 0xa1,
+	0x6a, 0x4b, 0x01, 0x96, 0xa3, 0x29, 0xeb, 0x9f, 0x0b, 0xc0, 0xbc, 0xbc, 0xdd, 0x7c, 0x08, 0x56,
+	0xc7, 0xe7, 0xf2, 0x09, 0xc2, 0xdd, 0x13, 0x2e, 0x42, 0xb5, 0x60, 0x6f, 0x67, 0x07, 0x50, 0x89,
+	0xca, 0x0f, 0xa0, 0x12, 0x61, 0x39, 0x2b, 0x1a, 0xf9, 0x9e, 0x00, 0xcc, 0x9f, 0x81, 0xd5, 0xf2,
+	0xa7, 0xa4, 0xf9, 0xff, 0xdb, 0x0d, 0x62, 0xc5, 0x9f, 0xfc, 0x54, 0xf4, 0x1b, 0x03, 0xac, 0x62,
+	0x82, 0x39, 0xf6, 0x7a, 0x6e, 0xc7, 0xeb, 0x79, 0xc4, 0xd7, 0x9d, 0x80, 0xde, 0xe8, 0x3e, 0x58,
+	0x56, 0x92, 0xbf, 0x7f, 0x89, 0x28, 0xdd, 0x10, 0x9d, 0x15, 0xc5, 0xdb, 0x92, 0x36, 0x29, 0x58,
+	0xd2, 0x7e, 0xc8, 0x2e, 0x7a, 0xf0, 0x46, 0x7e, 0x2c, 0xe5, 0xf6, 0x55, 0x7e, 0x67, 0xd8, 0xd5,
+	0x82, 0xb6, 0xf3, 0xf4, 0xa2, 0x69, 0x3c, 0xbf, 0x68, 0x1a, 0x2f, 0x2e, 0x9a, 0xc6, 0xdf, 0x2f,
+	0x9a, 0xc6, 0xa3, 0x97, 0xcd, 0xb9, 0x17, 0x2f, 0x9b, 0x73, 0x7f, 0x79, 0xd9, 0x9c, 0xfb, 0xd1,
+	0xd7, 0x0b, 0x96, 0x55, 0x99, 0x11, 0xc4, 0xf5, 0xe3, 0xb6, 0x7f, 0xe2, 0x61, 0x52, 0xfc, 0xe4,
+	0x22, 0x7c, 0xe9, 0x2c, 0x8a, 0x8c, 0x7d, 0xf2, 0x9f, 0x01, 0x00, 0x44, 0x53, 0x3d, 0xdc, 0x47,
+	0x16, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -946,6 +957,9 @@ func (this *MetaNode) Equal(that interface{}) bool {
 	if this.BeneficiaryAddress != that1.BeneficiaryAddress {
 		return false
 	}
+	if !bytes.Equal(this.BlsPubKey, that1.BlsPubKey) {
+		return false
+	}
 	return true
 }
 func (this *MetaNodeRegistrationVotePool) Equal(that interface{}) bool {
@@ -1365,6 +1379,13 @@ func (m *MetaNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.BlsPubKey) > 0 {
+		i -= len(m.BlsPubKey)
+		copy(dAtA[i:], m.BlsPubKey)
+		i = encodeVarintRegister(dAtA, i, uint64(len(m.BlsPubKey)))
+		i--
+		dAtA[i] = 0x52
+	}
 	if len(m.BeneficiaryAddress) > 0 {
 		i -= len(m.BeneficiaryAddress)
 		copy(dAtA[i:], m.BeneficiaryAddress)
@@ -1862,6 +1883,10 @@ func (m *MetaNode) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovRegister(uint64(l))
 	}
+	l = len(m.BlsPubKey)
+	if l > 0 {
+		n += 1 + l + sovRegister(uint64(l))
+	}
 	return n
 }
 
@@ -2931,6 +2956,40 @@ func (m *MetaNode) Unmarshal(dAtA []byte) error {
 			}
 			m.BeneficiaryAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlsPubKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegister
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRegister
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRegister
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlsPubKey = append(m.BlsPubKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlsPubKey == nil {
+				m.BlsPubKey = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRegister(dAtA[iNdEx:])
@@ -4,6 +4,7 @@
 package types
 
 import (
+	bytes "bytes"
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
@@ -1199,6 +1200,113 @@ func (m *MsgKickMetaNodeVoteResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgKickMetaNodeVoteResponse proto.InternalMessageInfo
 
+// MsgRegisterMetaNodeBLSPubKey registers the BLS public key of a meta node.
+// The signature is the BLS signature of keccak256(network address bytes) and proves possession of the key.
+type MsgRegisterMetaNodeBLSPubKey struct {
+	NetworkAddress string `protobuf:"bytes,1,opt,name=network_address,json=networkAddress,proto3" json:"network_address" yaml:"network_address"`
+	OwnerAddress   string `protobuf:"bytes,2,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address" yaml:"owner_address"`
+	BlsPubKey      []byte `protobuf:"bytes,3,opt,name=bls_pub_key,json=blsPubKey,proto3" json:"bls_pub_key" yaml:"bls_pub_key"`
+	Signature      []byte `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature" yaml:"signature"`
+}
+
+func (m *MsgRegisterMetaNodeBLSPubKey) Reset()         { *m = MsgRegisterMetaNodeBLSPubKey{} }
+func (m *MsgRegisterMetaNodeBLSPubKey) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterMetaNodeBLSPubKey) ProtoMessage()    {}
+func (*MsgRegisterMetaNodeBLSPubKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_75d4b90d7a185a31, []int{22}
+}
+func (m *MsgRegisterMetaNodeBLSPubKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterMetaNodeBLSPubKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterMetaNodeBLSPubKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterMetaNodeBLSPubKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterMetaNodeBLSPubKey.Merge(m, src)
+}
+func (m *MsgRegisterMetaNodeBLSPubKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterMetaNodeBLSPubKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterMetaNodeBLSPubKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterMetaNodeBLSPubKey proto.InternalMessageInfo
+
+func (m *MsgRegisterMetaNodeBLSPubKey) GetNetworkAddress() string {
+	if m != nil {
+		return m.NetworkAddress
+	}
+	return ""
+}
+
+func (m *MsgRegisterMetaNodeBLSPubKey) GetOwnerAddress() string {
+	if m != nil {
+		return m.OwnerAddress
+	}
+	return ""
+}
+
+func (m *MsgRegisterMetaNodeBLSPubKey) GetBlsPubKey() []byte {
+	if m != nil {
+		return m.BlsPubKey
+	}
+	return nil
+}
+
+func (m *MsgRegisterMetaNodeBLSPubKey) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+// MsgRegisterMetaNodeBLSPubKeyResponse defines the Msg/RegisterMetaNodeBLSPubKey response type.
+type MsgRegisterMetaNodeBLSPubKeyResponse struct {
+}
+
+func (m *MsgRegisterMetaNodeBLSPubKeyResponse) Reset()         { *m = MsgRegisterMetaNodeBLSPubKeyResponse{} }
+func (m *MsgRegisterMetaNodeBLSPubKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterMetaNodeBLSPubKeyResponse) ProtoMessage()    {}
+func (*MsgRegisterMetaNodeBLSPubKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_75d4b90d7a185a31, []int{23}
+}
+func (m *MsgRegisterMetaNodeBLSPubKeyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterMetaNodeBLSPubKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterMetaNodeBLSPubKeyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterMetaNodeBLSPubKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterMetaNodeBLSPubKeyResponse.Merge(m, src)
+}
+func (m *MsgRegisterMetaNodeBLSPubKeyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterMetaNodeBLSPubKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterMetaNodeBLSPubKeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterMetaNodeBLSPubKeyResponse proto.InternalMessageInfo
+
 // MsgUpdateParams defines a Msg for updating the x/register module parameters.
 type MsgUpdateParams struct {
 	// authority is the address of the governance account.
@@ -1212,7 +1320,7 @@ func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
 func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParams) ProtoMessage()    {}
 func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_75d4b90d7a185a31, []int{22}
+	return fileDescriptor_75d4b90d7a185a31, []int{24}
 }
 func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1264,7 +1372,7 @@ func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse
 func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParamsResponse) ProtoMessage()    {}
 func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_75d4b90d7a185a31, []int{23}
+	return fileDescriptor_75d4b90d7a185a31, []int{25}
 }
 func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1316,6 +1424,8 @@ func init() {
 	proto.RegisterType((*MsgMetaNodeRegistrationVoteResponse)(nil), "stratos.register.v1.MsgMetaNodeRegistrationVoteResponse")
 	proto.RegisterType((*MsgKickMetaNodeVote)(nil), "stratos.register.v1.MsgKickMetaNodeVote")
 	proto.RegisterType((*MsgKickMetaNodeVoteResponse)(nil), "stratos.register.v1.MsgKickMetaNodeVoteResponse")
+	proto.RegisterType((*MsgRegisterMetaNodeBLSPubKey)(nil), "stratos.register.v1.MsgRegisterMetaNodeBLSPubKey")
+	proto.RegisterType((*MsgRegisterMetaNodeBLSPubKeyResponse)(nil), "stratos.register.v1.MsgRegisterMetaNodeBLSPubKeyResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "stratos.register.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "stratos.register.v1.MsgUpdateParamsResponse")
 }
@@ -1323,113 +1433,119 @@ func init() {
 func init() { proto.RegisterFile("stratos/register/v1/tx.proto", fileDescriptor_75d4b90d7a185a31) }
 
 var fileDescriptor_75d4b90d7a185a31 = []byte{
-	// 1681 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0x4f, 0x6c, 0x13, 0xc7,
-	0x1a, 0xcf, 0xe6, 0x1f, 0x64, 0x48, 0x08, 0xd9, 0x24, 0xc4, 0x71, 0x88, 0x27, 0x59, 0x78, 0x51,
-	0x14, 0xbd, 0xac, 0x49, 0xf8, 0x9f, 0x27, 0xa1, 0x87, 0x01, 0xe9, 0x21, 0x14, 0xe0, 0x19, 0x68,
-	0xd5, 0x5e, 0xac, 0xb5, 0x3d, 0x31, 0xdb, 0xc4, 0x3b, 0xee, 0xee, 0x3a, 0xe0, 0x03, 0x55, 0x55,
-	0xa9, 0x95, 0xa8, 0x54, 0xb5, 0x54, 0xea, 0x89, 0x43, 0x2f, 0x95, 0x4a, 0x6f, 0x1c, 0x7a, 0xad,
-	0xd4, 0x5b, 0x69, 0x4f, 0xa8, 0xa7, 0xb6, 0x87, 0x51, 0x15, 0x0e, 0x48, 0x3e, 0xfa, 0xda, 0x4b,
-	0xb5, 0x3b, 0xb3, 0x63, 0xef, 0xee, 0x8c, 0x71, 0x24, 0xb0, 0x50, 0x95, 0x0b, 0x64, 0xbf, 0xdf,
-	0x6f, 0xe6, 0xfb, 0xe6, 0x9b, 0xdf, 0x7c, 0xf3, 0xc7, 0xe0, 0x88, 0xe3, 0xda, 0x86, 0x8b, 0x9d,
-	0xb4, 0x8d, 0x4a, 0xa6, 0xe3, 0x22, 0x3b, 0xbd, 0xbd, 0x92, 0x76, 0xef, 0xe9, 0x15, 0x1b, 0xbb,
-	0x58, 0x1d, 0x67, 0xa8, 0x1e, 0xa0, 0xfa, 0xf6, 0x4a, 0x72, 0xa2, 0x84, 0x4b, 0xd8, 0xc7, 0xd3,
-	0xde, 0x5f, 0x94, 0x9a, 0x9c, 0x2e, 0x60, 0xa7, 0x8c, 0x9d, 0x1c, 0x05, 0xe8, 0x07, 0x83, 0xc6,
-	0x8c, 0xb2, 0x69, 0xe1, 0xb4, 0xff, 0x2f, 0x33, 0x4d, 0x51, 0x42, 0xba, 0xec, 0x94, 0x3c, 0x87,
-	0x65, 0xa7, 0xc4, 0x80, 0x14, 0x03, 0xf2, 0x86, 0x83, 0xd2, 0xdb, 0x2b, 0x79, 0xe4, 0x1a, 0x2b,
-	0xe9, 0x02, 0x36, 0xad, 0xc0, 0x4d, 0x09, 0xe3, 0xd2, 0x16, 0x4a, 0xfb, 0x5f, 0xf9, 0xea, 0x46,
-	0xda, 0xb0, 0x6a, 0x0c, 0xd2, 0x44, 0x43, 0xe1, 0x81, 0xfb, 0x1c, 0xed, 0xe1, 0x20, 0x98, 0x5c,
-	0x77, 0x4a, 0x17, 0x6d, 0x64, 0xb8, 0x28, 0x8b, 0x1c, 0x5c, 0xb5, 0x0b, 0xe8, 0x1a, 0x2e, 0x22,
-	0xd5, 0x02, 0xa3, 0x16, 0x72, 0xef, 0x62, 0x7b, 0x33, 0x67, 0x14, 0x8b, 0x36, 0x72, 0x9c, 0x84,
-	0x32, 0xa7, 0x2c, 0x0e, 0x65, 0x2e, 0xd7, 0x09, 0x8c, 0x42, 0x0d, 0x02, 0x0f, 0xd7, 0x8c, 0xf2,
-	0xd6, 0x9a, 0x16, 0x01, 0xb4, 0x5f, 0xbf, 0x5f, 0x9e, 0x60, 0x83, 0xbf, 0x40, 0x4d, 0x37, 0x5d,
-	0xdb, 0xb4, 0x4a, 0xd9, 0x83, 0x8c, 0xc9, 0xac, 0xaa, 0x01, 0x06, 0x2b, 0xd5, 0xfc, 0x26, 0xaa,
-	0x25, 0x7a, 0xe7, 0x94, 0xc5, 0x03, 0xab, 0x13, 0x3a, 0x1d, 0x99, 0x1e, 0x8c, 0x4c, 0xbf, 0x60,
-	0xd5, 0x32, 0x27, 0xea, 0x04, 0x32, 0x5e, 0x83, 0xc0, 0x11, 0xea, 0x93, 0x7e, 0x6b, 0xbf, 0x34,
-	0x5d, 0x15, 0xec, 0x5a, 0xc5, 0xc5, 0xfa, 0x8d, 0x6a, 0xfe, 0x2a, 0xaa, 0x65, 0x59, 0x03, 0xf5,
-	0x26, 0x18, 0xd8, 0x36, 0xb6, 0xaa, 0x28, 0xd1, 0xe7, 0x7b, 0x98, 0xd6, 0x19, 0xdb, 0xcb, 0xad,
-	0xce, 0x72, 0xab, 0x5f, 0xc4, 0xa6, 0x95, 0xd1, 0x9e, 0x12, 0xd8, 0x53, 0x27, 0x90, 0xf2, 0x1b,
-	0x04, 0x0e, 0x53, 0x4f, 0xfe, 0xa7, 0xf6, 0xf8, 0xc5, 0x93, 0x25, 0x25, 0x4b, 0x31, 0x75, 0x03,
-	0x8c, 0xe0, 0xbb, 0x16, 0xb2, 0x79, 0x96, 0xfa, 0xfd, 0x2c, 0x5d, 0xa8, 0x13, 0x18, 0x06, 0x1a,
-	0x04, 0x4e, 0xd0, 0x5e, 0x42, 0x66, 0x79, 0x86, 0x86, 0x7d, 0x5e, 0x90, 0x9f, 0x0f, 0xc0, 0x78,
-	0x1e, 0x59, 0x68, 0xc3, 0x2c, 0x98, 0x86, 0x5d, 0xe3, 0xde, 0x06, 0x7c, 0x6f, 0xeb, 0x75, 0x02,
-	0x45, 0x70, 0x83, 0xc0, 0x24, 0xf5, 0x29, 0x00, 0xe5, 0x9e, 0xd5, 0x16, 0x76, 0xe0, 0xdf, 0x06,
-	0x07, 0x8a, 0xc8, 0x29, 0xd8, 0x66, 0xc5, 0x35, 0xb1, 0x95, 0x18, 0xf4, 0x53, 0x38, 0xa7, 0x0b,
-	0x16, 0x84, 0x7e, 0xa9, 0xc9, 0xcb, 0xe8, 0x2c, 0x93, 0xad, 0x8d, 0x1b, 0x04, 0xaa, 0x34, 0xaa,
-	0x16, 0x23, 0xcb, 0x6a, 0x2b, 0x4f, 0x3d, 0x0f, 0x86, 0x2c, 0x5c, 0x44, 0x39, 0xb7, 0x56, 0x41,
-	0x89, 0x7d, 0x73, 0xca, 0xe2, 0x48, 0x66, 0xbe, 0x4e, 0x60, 0xd3, 0xd8, 0x20, 0xf0, 0x10, 0xd3,
-	0x5d, 0x60, 0xd2, 0xb2, 0xfb, 0xbd, 0xbf, 0x6f, 0xd5, 0x2a, 0x68, 0xed, 0xe4, 0x47, 0x2f, 0x9e,
-	0x2c, 0x85, 0x67, 0xe1, 0xd3, 0x17, 0x4f, 0x96, 0x66, 0x83, 0x85, 0x21, 0x54, 0xbe, 0x06, 0xc1,
-	0xac, 0x10, 0xc8, 0x22, 0xa7, 0x82, 0x2d, 0x07, 0x69, 0x3f, 0x0f, 0x80, 0x31, 0xce, 0x58, 0x47,
-	0xae, 0xb1, 0xb7, 0x60, 0xf6, 0x16, 0xcc, 0x9b, 0xb0, 0x60, 0xd6, 0x8e, 0x8b, 0x05, 0x3f, 0x1d,
-	0x13, 0x7c, 0xa0, 0x5a, 0x6d, 0x06, 0x4c, 0xc7, 0x8c, 0x5c, 0xe8, 0x3f, 0xf4, 0xfa, 0xbb, 0x43,
-	0x16, 0x95, 0xf1, 0x76, 0x78, 0x77, 0xf8, 0x58, 0x01, 0x93, 0x36, 0x33, 0xe4, 0xfc, 0xb5, 0x17,
-	0xd6, 0xfc, 0xff, 0xeb, 0x04, 0x8a, 0x09, 0x0d, 0x02, 0x8f, 0xd0, 0xb1, 0x08, 0x61, 0x79, 0x8e,
-	0xc7, 0xed, 0x96, 0x00, 0x82, 0x24, 0xc7, 0xc4, 0xd4, 0xfb, 0x5a, 0xc4, 0xd4, 0x49, 0x25, 0x89,
-	0x67, 0x89, 0x55, 0x92, 0x38, 0xc0, 0x13, 0xfc, 0x4d, 0xaf, 0x5f, 0x49, 0x28, 0x83, 0x57, 0x92,
-	0x2a, 0x18, 0x2b, 0x23, 0xd7, 0x10, 0xe5, 0xf5, 0x4a, 0x9d, 0xc0, 0x38, 0xd8, 0x20, 0x30, 0x41,
-	0x07, 0x17, 0x83, 0xe4, 0x03, 0x1c, 0x2d, 0x33, 0x7f, 0xdd, 0xce, 0x65, 0x07, 0x22, 0x0d, 0x27,
-	0x84, 0x89, 0x34, 0x6c, 0xe4, 0x39, 0xfc, 0xbd, 0xdf, 0x17, 0xe9, 0xed, 0x4a, 0x31, 0x7a, 0x84,
-	0x89, 0xac, 0x40, 0xa5, 0x1b, 0x5b, 0x96, 0xa4, 0xea, 0xf4, 0x76, 0xab, 0xea, 0x08, 0x76, 0xa1,
-	0xbe, 0xd7, 0xb9, 0x0b, 0x75, 0xab, 0x9a, 0x87, 0x8e, 0x02, 0x03, 0xaf, 0xe5, 0x28, 0x10, 0x57,
-	0x10, 0x5b, 0xc0, 0x71, 0x80, 0x8b, 0xef, 0x61, 0xbf, 0xbf, 0x80, 0x29, 0x83, 0x2f, 0xe0, 0x3d,
-	0xe1, 0xfd, 0x63, 0x84, 0xd7, 0x49, 0xb5, 0x0a, 0xcf, 0x3e, 0xab, 0x56, 0x61, 0x23, 0x17, 0xcc,
-	0xb7, 0x7d, 0xe0, 0x88, 0x50, 0x52, 0x97, 0x50, 0x05, 0x3b, 0xa6, 0xdb, 0xf5, 0x63, 0x64, 0x97,
-	0xaa, 0xbe, 0x6a, 0x83, 0x91, 0x22, 0x1d, 0x62, 0xae, 0x88, 0xb6, 0x5c, 0xe3, 0xe5, 0x67, 0xca,
-	0x55, 0xb6, 0x1c, 0xc2, 0xed, 0x9a, 0x61, 0x84, 0xcc, 0x6c, 0x49, 0x0c, 0x33, 0xe3, 0x25, 0xcf,
-	0xb6, 0xf6, 0x1f, 0xf1, 0xdc, 0x1d, 0x6b, 0xbb, 0xe8, 0xd9, 0x44, 0x68, 0x0b, 0xe0, 0x58, 0x3b,
-	0x9c, 0xcf, 0xe8, 0x77, 0xfd, 0x2d, 0xf3, 0x7d, 0x79, 0x63, 0x03, 0x15, 0x5c, 0x73, 0x9b, 0x4f,
-	0xe7, 0xdb, 0x60, 0xc8, 0x46, 0x15, 0x6c, 0xbb, 0xc8, 0xf6, 0x26, 0xb2, 0x6f, 0x71, 0x28, 0x73,
-	0xce, 0xab, 0x5b, 0xdc, 0xd8, 0xac, 0x5b, 0xdc, 0x24, 0x4f, 0x69, 0xb3, 0x99, 0xfa, 0x1e, 0x38,
-	0x18, 0x7c, 0xe4, 0xfc, 0x11, 0x26, 0x7a, 0xfd, 0xde, 0x2f, 0xd6, 0x09, 0x8c, 0x20, 0x0d, 0x02,
-	0x27, 0xc3, 0x2e, 0xa8, 0x5d, 0xee, 0x67, 0x24, 0x20, 0x5e, 0xf7, 0x78, 0x5d, 0x5f, 0xdb, 0x8f,
-	0x14, 0x70, 0x08, 0x05, 0x99, 0xcc, 0xb9, 0x78, 0x13, 0x59, 0xc1, 0xfa, 0xae, 0x78, 0xa2, 0xf8,
-	0x83, 0xc0, 0x85, 0x92, 0xe9, 0xde, 0xa9, 0xe6, 0xf5, 0x02, 0x2e, 0xb3, 0xc7, 0x15, 0xf6, 0xdf,
-	0xb2, 0x53, 0xdc, 0x4c, 0x7b, 0xd5, 0xdf, 0xd1, 0xaf, 0x58, 0x6e, 0x9d, 0xc0, 0x58, 0x4f, 0x0d,
-	0x02, 0xa7, 0x68, 0x80, 0x51, 0xc4, 0x8b, 0x10, 0xb0, 0x08, 0xaf, 0x58, 0x2e, 0x95, 0xd4, 0x28,
-	0x67, 0xdd, 0xf2, 0x49, 0x6b, 0xe7, 0x3c, 0x55, 0x45, 0x52, 0xec, 0xc9, 0x6a, 0x3e, 0x26, 0xab,
-	0xa8, 0x1a, 0xb4, 0xa3, 0x60, 0x5e, 0x0a, 0x72, 0x41, 0x7d, 0xdd, 0x07, 0x12, 0xb1, 0x02, 0xb2,
-	0x57, 0x1e, 0x5e, 0x7d, 0x79, 0x38, 0x23, 0x2e, 0x0f, 0x73, 0xd2, 0xd2, 0x1e, 0x4c, 0xa3, 0x06,
-	0xe6, 0x64, 0x18, 0x9f, 0xc5, 0x9f, 0x06, 0xc0, 0xcc, 0xba, 0x53, 0x6a, 0x6e, 0x00, 0xde, 0x9e,
-	0x6f, 0x1b, 0xde, 0x5e, 0xfd, 0x16, 0x76, 0x91, 0xfa, 0x95, 0x02, 0xa6, 0x0b, 0x86, 0x55, 0x34,
-	0xbd, 0x4e, 0x72, 0xe2, 0x39, 0x7d, 0xa7, 0x4e, 0xa0, 0x9c, 0xd4, 0x20, 0x70, 0x8e, 0x0e, 0x55,
-	0x4a, 0x91, 0x67, 0x7f, 0x8a, 0xb7, 0xb9, 0x16, 0x9e, 0xf0, 0xcf, 0x14, 0xd0, 0xc4, 0x72, 0xa2,
-	0xb9, 0xbf, 0x5d, 0x27, 0x50, 0x46, 0x69, 0x10, 0x98, 0x8a, 0xc6, 0xd4, 0xa1, 0x1e, 0x26, 0x79,
-	0x8b, 0xeb, 0xad, 0xc2, 0x38, 0x03, 0xf6, 0xe1, 0x8a, 0x69, 0x79, 0xe7, 0x28, 0x4f, 0x12, 0xfb,
-	0x33, 0xb3, 0x75, 0x02, 0x03, 0x53, 0x83, 0xc0, 0x83, 0x4c, 0x74, 0xd4, 0xa0, 0x65, 0x03, 0xc8,
-	0xbf, 0xa2, 0x6e, 0x63, 0x6f, 0x85, 0x46, 0x93, 0xdb, 0xdf, 0xbc, 0xa2, 0x0a, 0x09, 0xcd, 0x2b,
-	0xaa, 0x10, 0x6e, 0x73, 0x45, 0xf5, 0xf9, 0x91, 0x84, 0xde, 0x07, 0xd4, 0x1c, 0xc9, 0x65, 0xcb,
-	0x3b, 0x84, 0x00, 0x6e, 0x1e, 0xcc, 0x04, 0xa0, 0x3c, 0x80, 0x31, 0x9f, 0xdd, 0x9a, 0xbf, 0xb5,
-	0xff, 0x7a, 0x22, 0x17, 0xb9, 0xf0, 0xa4, 0x7e, 0xb4, 0x45, 0xea, 0x32, 0xa5, 0x6a, 0xff, 0x02,
-	0x47, 0xdb, 0xc0, 0x5c, 0xf0, 0x8f, 0xfa, 0xc1, 0xf8, 0xba, 0x53, 0xba, 0x6a, 0x16, 0x36, 0x03,
-	0xae, 0x2f, 0xf4, 0x07, 0x0a, 0x38, 0xec, 0x1a, 0x76, 0x09, 0xb9, 0x12, 0x95, 0xdf, 0xac, 0x13,
-	0x28, 0x61, 0x34, 0x08, 0x9c, 0xa5, 0x69, 0x10, 0xe3, 0xf2, 0x4c, 0x4c, 0xd0, 0x06, 0x91, 0xb9,
-	0x68, 0x11, 0x53, 0xef, 0x2b, 0x12, 0x53, 0xdf, 0x9b, 0x20, 0xa6, 0xfe, 0x2e, 0x89, 0xe9, 0x6c,
-	0x3b, 0x31, 0xcd, 0xb4, 0x88, 0x29, 0xaa, 0x02, 0x6d, 0xd6, 0xaf, 0x86, 0x51, 0x33, 0x17, 0xcf,
-	0x8f, 0x0a, 0x18, 0xe5, 0x25, 0xf5, 0x86, 0x61, 0x1b, 0x65, 0x47, 0x3d, 0x0d, 0x86, 0x8c, 0xaa,
-	0x7b, 0x07, 0xdb, 0xa6, 0x5b, 0x63, 0x52, 0x49, 0xc8, 0x4f, 0x46, 0x9c, 0xaa, 0x9e, 0x07, 0x83,
-	0x15, 0xbf, 0x07, 0xf6, 0x30, 0x3a, 0x23, 0xbc, 0x78, 0x51, 0x27, 0x99, 0x21, 0x6f, 0x17, 0xa1,
-	0x9b, 0x03, 0x6b, 0xb5, 0x76, 0xca, 0x1b, 0x64, 0xb3, 0x3f, 0x6f, 0x68, 0xfc, 0xa7, 0x94, 0x7b,
-	0xcd, 0x1f, 0x53, 0x22, 0xe1, 0x6a, 0xd3, 0x60, 0x2a, 0x62, 0x0a, 0x46, 0xb7, 0xfa, 0xd7, 0x01,
-	0xd0, 0xb7, 0xee, 0x94, 0xd4, 0xfb, 0x60, 0xe6, 0x7f, 0x86, 0x55, 0xdc, 0x42, 0xe2, 0x9f, 0x5c,
-	0x96, 0x84, 0x81, 0x0a, 0xb9, 0xc9, 0xd5, 0xce, 0xb9, 0x41, 0x18, 0x21, 0xf7, 0x82, 0x37, 0x3d,
-	0xa9, 0xfb, 0x38, 0x57, 0xee, 0x5e, 0xfe, 0xd8, 0x15, 0x72, 0x2f, 0x78, 0xad, 0x91, 0xba, 0x8f,
-	0x73, 0xe5, 0xee, 0xe5, 0x57, 0x75, 0xf5, 0x4b, 0x05, 0x68, 0x6d, 0xfc, 0x07, 0x07, 0xac, 0x95,
-	0xce, 0xbb, 0x66, 0x4d, 0x92, 0xe7, 0x76, 0xdd, 0x84, 0x07, 0xf5, 0x40, 0x01, 0x30, 0x12, 0x54,
-	0xec, 0x0a, 0xa1, 0xb7, 0xef, 0x3e, 0xca, 0x4f, 0x9e, 0xde, 0x1d, 0x9f, 0xc7, 0xf2, 0x3e, 0x98,
-	0x8a, 0xa8, 0x93, 0x3f, 0x68, 0x2c, 0xb4, 0x57, 0x5b, 0xc0, 0x4b, 0xea, 0x9d, 0xf1, 0x84, 0x2e,
-	0x23, 0x8f, 0xa0, 0x0b, 0xed, 0x15, 0xf6, 0x72, 0x97, 0xe2, 0xe7, 0xc2, 0x90, 0xcb, 0xc8, 0xb3,
-	0xcd, 0x42, 0xfb, 0xc4, 0xbd, 0xdc, 0xa5, 0xf8, 0xce, 0xaf, 0x7e, 0xa2, 0x80, 0x94, 0xc4, 0x67,
-	0x30, 0xc7, 0xcb, 0x9d, 0x75, 0x19, 0x4c, 0xf1, 0xa9, 0x5d, 0xd1, 0x79, 0x20, 0x9f, 0x2b, 0x60,
-	0x9e, 0x07, 0x22, 0x3d, 0x99, 0x1e, 0x97, 0x75, 0x2e, 0x6b, 0x91, 0x3c, 0xbb, 0xdb, 0x16, 0x3c,
-	0xa2, 0xbb, 0x60, 0x9a, 0x07, 0x14, 0x3b, 0x39, 0x2c, 0xca, 0xba, 0x8d, 0x32, 0x93, 0xc7, 0x3b,
-	0x65, 0x72, 0xc7, 0x79, 0x30, 0x1c, 0xda, 0x6c, 0x8e, 0xb5, 0xcf, 0x28, 0x65, 0x25, 0xff, 0xdd,
-	0x09, 0x2b, 0xf0, 0x91, 0x1c, 0xf8, 0xd0, 0xdb, 0x57, 0x32, 0xd9, 0xc7, 0x3b, 0x29, 0xe5, 0xe9,
-	0x4e, 0x4a, 0x79, 0xb6, 0x93, 0x52, 0xfe, 0xdc, 0x49, 0x29, 0x5f, 0x3c, 0x4f, 0xf5, 0x3c, 0x7b,
-	0x9e, 0xea, 0xf9, 0xed, 0x79, 0xaa, 0xe7, 0xdd, 0x93, 0x2d, 0x17, 0x59, 0xd6, 0xb9, 0x85, 0xdc,
-	0xe0, 0xcf, 0xe5, 0xc2, 0x1d, 0xc3, 0xb4, 0x5a, 0xb7, 0x1d, 0xff, 0x6a, 0x9b, 0x1f, 0xf4, 0x7f,
-	0xe4, 0x3b, 0xf1, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0x9a, 0x7a, 0xc2, 0xf7, 0xaf, 0x20, 0x00,
-	0x00,
+	// 1781 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0xcd, 0x6f, 0x14, 0x47,
+	0x16, 0x77, 0xfb, 0x0b, 0xa6, 0xb0, 0x31, 0x1e, 0xdb, 0x78, 0x3c, 0xc6, 0x53, 0x76, 0x03, 0x96,
+	0x65, 0xad, 0x7b, 0xb0, 0xf9, 0xb4, 0x57, 0x62, 0x97, 0x01, 0xa4, 0x45, 0xac, 0x81, 0x1d, 0xc3,
+	0xae, 0x76, 0x2f, 0xa3, 0x9e, 0x99, 0xf2, 0xd0, 0x6b, 0x4f, 0xf7, 0x6c, 0x77, 0x8f, 0x61, 0x0e,
+	0xac, 0x56, 0x2b, 0x25, 0x12, 0x91, 0xa2, 0x40, 0xa4, 0x9c, 0x38, 0xe4, 0x12, 0x29, 0xe4, 0xc6,
+	0x21, 0xb7, 0x28, 0x52, 0x6e, 0x21, 0x39, 0xa1, 0x9c, 0x92, 0x1c, 0x4a, 0x91, 0x39, 0x20, 0xcd,
+	0x71, 0xfe, 0x82, 0xa8, 0xbb, 0xaa, 0xab, 0xbf, 0xaa, 0x86, 0xb1, 0x04, 0x23, 0x84, 0x7c, 0x01,
+	0xf7, 0x7b, 0xbf, 0xaa, 0xf7, 0xea, 0xbd, 0x5f, 0xbd, 0x7a, 0x55, 0x03, 0x8e, 0x59, 0xb6, 0xa9,
+	0xda, 0x86, 0x95, 0x35, 0x51, 0x45, 0xb3, 0x6c, 0x64, 0x66, 0x77, 0x96, 0xb3, 0xf6, 0x7d, 0xa5,
+	0x66, 0x1a, 0xb6, 0x91, 0x1c, 0xa3, 0x5a, 0xc5, 0xd3, 0x2a, 0x3b, 0xcb, 0xe9, 0xf1, 0x8a, 0x51,
+	0x31, 0x5c, 0x7d, 0xd6, 0xf9, 0x8b, 0x40, 0xd3, 0x53, 0x25, 0xc3, 0xaa, 0x1a, 0x56, 0x81, 0x28,
+	0xc8, 0x07, 0x55, 0x8d, 0xaa, 0x55, 0x4d, 0x37, 0xb2, 0xee, 0xbf, 0x54, 0x34, 0x49, 0x00, 0xd9,
+	0xaa, 0x55, 0x71, 0x0c, 0x56, 0xad, 0x0a, 0x55, 0x64, 0xa8, 0xa2, 0xa8, 0x5a, 0x28, 0xbb, 0xb3,
+	0x5c, 0x44, 0xb6, 0xba, 0x9c, 0x2d, 0x19, 0x9a, 0xee, 0x99, 0xa9, 0x18, 0x46, 0x65, 0x1b, 0x65,
+	0xdd, 0xaf, 0x62, 0x7d, 0x33, 0xab, 0xea, 0x0d, 0xaa, 0x92, 0x79, 0x4b, 0x61, 0x8e, 0xbb, 0x18,
+	0xf9, 0xf1, 0x20, 0x98, 0x58, 0xb7, 0x2a, 0x97, 0x4d, 0xa4, 0xda, 0x28, 0x8f, 0x2c, 0xa3, 0x6e,
+	0x96, 0xd0, 0x0d, 0xa3, 0x8c, 0x92, 0x3a, 0x18, 0xd1, 0x91, 0x7d, 0xcf, 0x30, 0xb7, 0x0a, 0x6a,
+	0xb9, 0x6c, 0x22, 0xcb, 0x4a, 0x49, 0xb3, 0xd2, 0x42, 0x22, 0x77, 0xb5, 0x89, 0x61, 0x54, 0xd5,
+	0xc2, 0xf0, 0x68, 0x43, 0xad, 0x6e, 0xaf, 0xc9, 0x11, 0x85, 0xfc, 0xd3, 0xd7, 0x4b, 0xe3, 0x74,
+	0xf1, 0x97, 0x88, 0x68, 0xc3, 0x36, 0x35, 0xbd, 0x92, 0x3f, 0x4c, 0x91, 0x54, 0x9a, 0x54, 0xc1,
+	0x60, 0xad, 0x5e, 0xdc, 0x42, 0x8d, 0x54, 0xef, 0xac, 0xb4, 0x70, 0x68, 0x65, 0x5c, 0x21, 0x2b,
+	0x53, 0xbc, 0x95, 0x29, 0x97, 0xf4, 0x46, 0xee, 0x74, 0x13, 0x43, 0x8a, 0x6b, 0x61, 0x38, 0x4c,
+	0x6c, 0x92, 0x6f, 0xf9, 0x47, 0xdf, 0x54, 0xc9, 0x6c, 0xd4, 0x6c, 0x43, 0xb9, 0x55, 0x2f, 0x5e,
+	0x47, 0x8d, 0x3c, 0x1d, 0x90, 0xdc, 0x00, 0x03, 0x3b, 0xea, 0x76, 0x1d, 0xa5, 0xfa, 0x5c, 0x0b,
+	0x53, 0x0a, 0x45, 0x3b, 0xb1, 0x55, 0x68, 0x6c, 0x95, 0xcb, 0x86, 0xa6, 0xe7, 0xe4, 0xe7, 0x18,
+	0xf6, 0x34, 0x31, 0x24, 0xf8, 0x16, 0x86, 0x43, 0xc4, 0x92, 0xfb, 0x29, 0x3f, 0x7d, 0xf5, 0x6c,
+	0x51, 0xca, 0x13, 0x5d, 0x72, 0x13, 0x0c, 0x1b, 0xf7, 0x74, 0x64, 0xb2, 0x28, 0xf5, 0xbb, 0x51,
+	0xba, 0xd4, 0xc4, 0x30, 0xac, 0x68, 0x61, 0x38, 0x4e, 0x66, 0x09, 0x89, 0xc5, 0x11, 0x1a, 0x72,
+	0x71, 0x5e, 0x7c, 0xfe, 0x0b, 0xc6, 0x8a, 0x48, 0x47, 0x9b, 0x5a, 0x49, 0x53, 0xcd, 0x06, 0xb3,
+	0x36, 0xe0, 0x5a, 0x5b, 0x6f, 0x62, 0xc8, 0x53, 0xb7, 0x30, 0x4c, 0x13, 0x9b, 0x1c, 0xa5, 0xd8,
+	0x72, 0x32, 0x80, 0xf6, 0xec, 0x9b, 0xe0, 0x50, 0x19, 0x59, 0x25, 0x53, 0xab, 0xd9, 0x9a, 0xa1,
+	0xa7, 0x06, 0xdd, 0x10, 0xce, 0x2a, 0x9c, 0x0d, 0xa1, 0x5c, 0xf1, 0x71, 0x39, 0x85, 0x46, 0x32,
+	0x38, 0xb8, 0x85, 0x61, 0x92, 0x78, 0x15, 0x10, 0xd2, 0xa8, 0x06, 0x71, 0xc9, 0x8b, 0x20, 0xa1,
+	0x1b, 0x65, 0x54, 0xb0, 0x1b, 0x35, 0x94, 0x3a, 0x30, 0x2b, 0x2d, 0x0c, 0xe7, 0xe6, 0x9a, 0x18,
+	0xfa, 0xc2, 0x16, 0x86, 0x47, 0x28, 0xef, 0x3c, 0x91, 0x9c, 0x3f, 0xe8, 0xfc, 0x7d, 0xbb, 0x51,
+	0x43, 0x6b, 0x67, 0xfe, 0xff, 0xea, 0xd9, 0x62, 0x38, 0x0b, 0x1f, 0xbd, 0x7a, 0xb6, 0x38, 0xe3,
+	0x6d, 0x0c, 0x2e, 0xf3, 0x65, 0x08, 0x66, 0xb8, 0x8a, 0x3c, 0xb2, 0x6a, 0x86, 0x6e, 0x21, 0xf9,
+	0x87, 0x01, 0x30, 0xca, 0x10, 0xeb, 0xc8, 0x56, 0xf7, 0x37, 0xcc, 0xfe, 0x86, 0x79, 0x17, 0x36,
+	0xcc, 0xda, 0x29, 0x3e, 0xe1, 0xa7, 0x62, 0x84, 0xf7, 0x58, 0x2b, 0x4f, 0x83, 0xa9, 0x98, 0x90,
+	0x11, 0xfd, 0xdb, 0x5e, 0xf7, 0x74, 0xc8, 0xa3, 0xaa, 0xb1, 0x13, 0x3e, 0x1d, 0x3e, 0x90, 0xc0,
+	0x84, 0x49, 0x05, 0x05, 0x77, 0xef, 0x85, 0x39, 0xff, 0xb7, 0x26, 0x86, 0x7c, 0x40, 0x0b, 0xc3,
+	0x63, 0x64, 0x2d, 0x5c, 0xb5, 0x38, 0xc6, 0x63, 0x66, 0xc0, 0x01, 0x2f, 0xc8, 0x31, 0x32, 0xf5,
+	0xbe, 0x15, 0x32, 0x75, 0x52, 0x49, 0xe2, 0x51, 0xa2, 0x95, 0x24, 0xae, 0x60, 0x01, 0xfe, 0xa2,
+	0x17, 0x8c, 0x32, 0x04, 0xab, 0x24, 0x75, 0x30, 0x5a, 0x45, 0xb6, 0xca, 0x8b, 0xeb, 0xb5, 0x26,
+	0x86, 0x71, 0x65, 0x0b, 0xc3, 0x14, 0x59, 0x5c, 0x4c, 0x25, 0x5e, 0xe0, 0x48, 0x95, 0xda, 0xeb,
+	0x76, 0x2c, 0x3b, 0x20, 0x69, 0x38, 0x20, 0x94, 0xa4, 0x61, 0x21, 0x8b, 0xe1, 0x2f, 0xfd, 0x2e,
+	0x49, 0xef, 0xd4, 0xca, 0xd1, 0x16, 0x26, 0xb2, 0x03, 0xa5, 0x6e, 0x1c, 0x59, 0x82, 0xaa, 0xd3,
+	0xdb, 0xad, 0xaa, 0xc3, 0x39, 0x85, 0xfa, 0xde, 0xe6, 0x29, 0xd4, 0xad, 0x6a, 0x1e, 0x6a, 0x05,
+	0x06, 0xde, 0x4a, 0x2b, 0x10, 0x67, 0x10, 0xdd, 0xc0, 0x71, 0x05, 0x23, 0xdf, 0xe3, 0x7e, 0x30,
+	0xca, 0x10, 0x6c, 0x03, 0xef, 0x13, 0xef, 0xbd, 0x21, 0x5e, 0x27, 0xd5, 0x2a, 0x9c, 0x7d, 0x5a,
+	0xad, 0xc2, 0x42, 0x46, 0x98, 0x2f, 0xfb, 0xc0, 0x31, 0x2e, 0xa5, 0xae, 0xa0, 0x9a, 0x61, 0x69,
+	0x76, 0xd7, 0xdb, 0xc8, 0x2e, 0x55, 0xfd, 0xa4, 0x09, 0x86, 0xcb, 0x64, 0x89, 0x85, 0x32, 0xda,
+	0xb6, 0xd5, 0xd7, 0xf7, 0x94, 0x2b, 0x74, 0x3b, 0x84, 0xc7, 0xf9, 0x6e, 0x84, 0xc4, 0x74, 0x4b,
+	0x0c, 0x51, 0xe1, 0x15, 0x47, 0xb6, 0xf6, 0x47, 0x7e, 0xee, 0x4e, 0xb4, 0xdd, 0xf4, 0x34, 0x11,
+	0xf2, 0x3c, 0x38, 0xd1, 0x4e, 0xcf, 0x32, 0xfa, 0x55, 0x7f, 0x20, 0xdf, 0x57, 0x37, 0x37, 0x51,
+	0xc9, 0xd6, 0x76, 0x58, 0x3a, 0xff, 0x01, 0x12, 0x26, 0xaa, 0x19, 0xa6, 0x8d, 0x4c, 0x27, 0x91,
+	0x7d, 0x0b, 0x89, 0xdc, 0xaa, 0x53, 0xb7, 0x98, 0xd0, 0xaf, 0x5b, 0x4c, 0x24, 0x0e, 0xa9, 0x3f,
+	0x2c, 0xf9, 0x6f, 0x70, 0xd8, 0xfb, 0x28, 0xb8, 0x2b, 0x4c, 0xf5, 0xba, 0xb3, 0x5f, 0x6e, 0x62,
+	0x18, 0xd1, 0xb4, 0x30, 0x9c, 0x08, 0x9b, 0x20, 0x72, 0xb1, 0x9d, 0x61, 0x0f, 0x78, 0xd3, 0xc1,
+	0x75, 0x7d, 0x6f, 0x3f, 0x91, 0xc0, 0x11, 0xe4, 0x45, 0xb2, 0x60, 0x1b, 0x5b, 0x48, 0xf7, 0xf6,
+	0x77, 0xcd, 0x21, 0xc5, 0xaf, 0x18, 0xce, 0x57, 0x34, 0xfb, 0x6e, 0xbd, 0xa8, 0x94, 0x8c, 0x2a,
+	0x7d, 0x5c, 0xa1, 0xff, 0x2d, 0x59, 0xe5, 0xad, 0xac, 0x53, 0xfd, 0x2d, 0xe5, 0x9a, 0x6e, 0x37,
+	0x31, 0x8c, 0xcd, 0xd4, 0xc2, 0x70, 0x92, 0x38, 0x18, 0xd5, 0x38, 0x1e, 0x02, 0xea, 0xe1, 0x35,
+	0xdd, 0x26, 0x94, 0x1a, 0x61, 0xa8, 0xdb, 0x2e, 0x68, 0x6d, 0xd5, 0x61, 0x55, 0x24, 0xc4, 0x0e,
+	0xad, 0xe6, 0x62, 0xb4, 0x8a, 0xb2, 0x41, 0x3e, 0x0e, 0xe6, 0x84, 0x4a, 0x46, 0xa8, 0xcf, 0xfb,
+	0x40, 0x2a, 0x56, 0x40, 0xf6, 0xcb, 0xc3, 0x9b, 0x2f, 0x0f, 0xe7, 0xf9, 0xe5, 0x61, 0x56, 0x58,
+	0xda, 0xbd, 0x34, 0xca, 0x60, 0x56, 0xa4, 0x63, 0x59, 0xfc, 0x7e, 0x00, 0x4c, 0xaf, 0x5b, 0x15,
+	0xff, 0x00, 0x70, 0xce, 0x7c, 0x53, 0x75, 0xce, 0xea, 0xbf, 0x1b, 0x36, 0x4a, 0x7e, 0x26, 0x81,
+	0xa9, 0x92, 0xaa, 0x97, 0x35, 0x67, 0x92, 0x02, 0x3f, 0xa7, 0xff, 0x6c, 0x62, 0x28, 0x06, 0xb5,
+	0x30, 0x9c, 0x25, 0x4b, 0x15, 0x42, 0xc4, 0xd1, 0x9f, 0x64, 0x63, 0x6e, 0x84, 0x13, 0xfe, 0xb1,
+	0x04, 0x7c, 0x5d, 0x81, 0x97, 0xfb, 0x3b, 0x4d, 0x0c, 0x45, 0x90, 0x16, 0x86, 0x99, 0xa8, 0x4f,
+	0x1d, 0xf2, 0x61, 0x82, 0x8d, 0xb8, 0x19, 0x24, 0xc6, 0x79, 0x70, 0xc0, 0xa8, 0x69, 0xba, 0xd3,
+	0x47, 0x39, 0x94, 0x38, 0x98, 0x9b, 0x69, 0x62, 0xe8, 0x89, 0x5a, 0x18, 0x1e, 0xa6, 0xa4, 0x23,
+	0x02, 0x39, 0xef, 0xa9, 0xdc, 0x2b, 0xea, 0x8e, 0xe1, 0xec, 0xd0, 0x68, 0x70, 0xfb, 0xfd, 0x2b,
+	0x2a, 0x17, 0xe0, 0x5f, 0x51, 0xb9, 0xea, 0x36, 0x57, 0x54, 0x17, 0x1f, 0x09, 0xe8, 0x03, 0x40,
+	0xc4, 0x91, 0x58, 0x06, 0xde, 0x21, 0x38, 0x6a, 0xbf, 0x31, 0xe3, 0x28, 0xc5, 0x0e, 0x8c, 0xba,
+	0xe8, 0x60, 0xfc, 0xd6, 0xfe, 0xec, 0x90, 0x9c, 0x67, 0xc2, 0xa1, 0xfa, 0xf1, 0x00, 0xd5, 0x45,
+	0x4c, 0x95, 0x4f, 0x82, 0xe3, 0x6d, 0xd4, 0x8c, 0xf0, 0x4f, 0xfa, 0xc1, 0xd8, 0xba, 0x55, 0xb9,
+	0xae, 0x95, 0xb6, 0x3c, 0xac, 0x4b, 0xf4, 0x87, 0x12, 0x38, 0x6a, 0xab, 0x66, 0x05, 0xd9, 0x02,
+	0x96, 0x6f, 0x34, 0x31, 0x14, 0x20, 0x5a, 0x18, 0xce, 0x90, 0x30, 0xf0, 0xf5, 0xe2, 0x48, 0x8c,
+	0x93, 0x01, 0x91, 0x5c, 0x04, 0xc8, 0xd4, 0xfb, 0x86, 0xc8, 0xd4, 0xf7, 0x2e, 0x90, 0xa9, 0xbf,
+	0x4b, 0x64, 0xba, 0xd0, 0x8e, 0x4c, 0xd3, 0x01, 0x32, 0x45, 0x59, 0x20, 0xcf, 0x80, 0x69, 0x8e,
+	0x98, 0x91, 0xe7, 0x1b, 0xd2, 0x16, 0xe7, 0xe9, 0xcd, 0xc8, 0xc3, 0xe4, 0xfe, 0xba, 0x41, 0x9e,
+	0x24, 0xdf, 0xdb, 0x73, 0xef, 0x2a, 0x38, 0x54, 0xdc, 0xb6, 0x0a, 0xb5, 0x7a, 0xb1, 0xe0, 0x3c,
+	0xe5, 0x3a, 0x6c, 0x1a, 0xca, 0x9d, 0x74, 0x2e, 0x81, 0x01, 0xb1, 0x7f, 0x09, 0x0c, 0x08, 0xe5,
+	0x7c, 0xa2, 0xb8, 0x6d, 0xd1, 0xf0, 0xfc, 0x09, 0x24, 0x2c, 0xad, 0xa2, 0xab, 0x76, 0xdd, 0x44,
+	0x2e, 0x1b, 0x86, 0xc8, 0xf5, 0x98, 0x09, 0xfd, 0x36, 0x93, 0x89, 0xe4, 0xbc, 0xaf, 0xee, 0xa4,
+	0x55, 0x16, 0x26, 0x87, 0xb6, 0xca, 0x42, 0x3d, 0xcb, 0xf2, 0x77, 0x12, 0x18, 0x61, 0x07, 0xe7,
+	0x2d, 0xd5, 0x54, 0xab, 0x56, 0xf2, 0x1c, 0x48, 0xa8, 0x75, 0xfb, 0xae, 0x61, 0x6a, 0x76, 0x83,
+	0xa6, 0x34, 0x25, 0xee, 0x7f, 0x19, 0x34, 0x79, 0x11, 0x0c, 0xd6, 0xdc, 0x19, 0xe8, 0xf3, 0xf7,
+	0x34, 0xf7, 0x7a, 0x4d, 0x8c, 0xe4, 0x12, 0x4e, 0xaf, 0x40, 0x5a, 0x00, 0x3a, 0x6a, 0xed, 0xac,
+	0xb3, 0x60, 0x7f, 0x3e, 0x67, 0xb1, 0xec, 0x07, 0xb3, 0xfb, 0xfe, 0x4f, 0x66, 0x11, 0x77, 0xe5,
+	0x29, 0x30, 0x19, 0x11, 0x79, 0xab, 0x5b, 0x79, 0x34, 0x0c, 0xfa, 0xd6, 0xad, 0x4a, 0xf2, 0x01,
+	0x98, 0xfe, 0x8b, 0xaa, 0x97, 0xb7, 0x11, 0xff, 0x87, 0xb5, 0x45, 0xae, 0xa3, 0x5c, 0x6c, 0x7a,
+	0xa5, 0x73, 0xac, 0xe7, 0x46, 0xc8, 0x3c, 0xe7, 0xe5, 0x56, 0x68, 0x3e, 0x8e, 0x4d, 0xaf, 0x74,
+	0x8e, 0xe5, 0x9a, 0xe7, 0xbc, 0xc9, 0x09, 0xcd, 0xc7, 0xb1, 0xe9, 0x95, 0xce, 0xb1, 0xcc, 0xfc,
+	0xa7, 0x12, 0x90, 0xdb, 0xd8, 0xf7, 0xda, 0xe8, 0xe5, 0xce, 0xa7, 0xa6, 0x43, 0xd2, 0xab, 0x7b,
+	0x1e, 0xc2, 0x9c, 0x7a, 0x28, 0x01, 0x18, 0x71, 0x2a, 0x76, 0x51, 0x54, 0xda, 0x4f, 0x1f, 0xc5,
+	0xa7, 0xcf, 0xed, 0x0d, 0xcf, 0x7c, 0xf9, 0x0f, 0x98, 0x8c, 0xb0, 0x93, 0x3d, 0x5b, 0xcd, 0xb7,
+	0x67, 0x9b, 0x87, 0x4b, 0x2b, 0x9d, 0xe1, 0xb8, 0x26, 0x23, 0x4f, 0xdd, 0xf3, 0xed, 0x19, 0xf6,
+	0x7a, 0x93, 0xfc, 0x47, 0xe1, 0x90, 0xc9, 0xc8, 0xe3, 0xdc, 0x7c, 0xfb, 0xc0, 0xbd, 0xde, 0x24,
+	0xff, 0x65, 0x27, 0xf9, 0xa1, 0x04, 0x32, 0x02, 0x9b, 0x5e, 0x8e, 0x97, 0x3a, 0x9b, 0xd2, 0x4b,
+	0xf1, 0xd9, 0x3d, 0xc1, 0x99, 0x23, 0x9f, 0x48, 0x60, 0x8e, 0x39, 0x22, 0xbc, 0x7f, 0x9c, 0x12,
+	0x4d, 0x2e, 0x1a, 0x91, 0xbe, 0xb0, 0xd7, 0x11, 0xcc, 0xa3, 0x7b, 0x60, 0x8a, 0x39, 0x14, 0xeb,
+	0x0f, 0x17, 0x44, 0xd3, 0x46, 0x91, 0xe9, 0x53, 0x9d, 0x22, 0xf9, 0xd5, 0x40, 0xdc, 0x5c, 0x2c,
+	0x8b, 0xd9, 0x25, 0x18, 0x92, 0x5e, 0xdd, 0xf3, 0x10, 0xe6, 0x54, 0x11, 0x0c, 0x85, 0x4e, 0xc0,
+	0x13, 0xed, 0xd3, 0x4c, 0x50, 0xe9, 0x3f, 0x74, 0x82, 0xf2, 0x6c, 0xa4, 0x07, 0xfe, 0xe7, 0x1c,
+	0x76, 0xb9, 0xfc, 0xd3, 0xdd, 0x8c, 0xf4, 0x7c, 0x37, 0x23, 0xbd, 0xd8, 0xcd, 0x48, 0xbf, 0xed,
+	0x66, 0xa4, 0x47, 0x2f, 0x33, 0x3d, 0x2f, 0x5e, 0x66, 0x7a, 0x7e, 0x7e, 0x99, 0xe9, 0xf9, 0xd7,
+	0x99, 0xc0, 0x1b, 0x0a, 0x9d, 0x5c, 0x47, 0xb6, 0xf7, 0xe7, 0x52, 0xe9, 0xae, 0xaa, 0xe9, 0xc1,
+	0xb3, 0xd0, 0x7d, 0x55, 0x29, 0x0e, 0xba, 0xbf, 0x2f, 0x9f, 0xfe, 0x7d, 0x00, 0x5f, 0xc9, 0xde,
+	0x51, 0x2a, 0x23, 0x00, 0x00,
 }
 
 func (this *MsgCreateResourceNode) Equal(that interface{}) bool {
@@ -2039,6 +2155,60 @@ func (this *MsgKickMetaNodeVoteResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *MsgRegisterMetaNodeBLSPubKey) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgRegisterMetaNodeBLSPubKey)
+	if !ok {
+		that2, ok := that.(MsgRegisterMetaNodeBLSPubKey)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.NetworkAddress != that1.NetworkAddress {
+		return false
+	}
+	if this.OwnerAddress != that1.OwnerAddress {
+		return false
+	}
+	if !bytes.Equal(this.BlsPubKey, that1.BlsPubKey) {
+		return false
+	}
+	if !bytes.Equal(this.Signature, that1.Signature) {
+		return false
+	}
+	return true
+}
+func (this *MsgRegisterMetaNodeBLSPubKeyResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgRegisterMetaNodeBLSPubKeyResponse)
+	if !ok {
+		that2, ok := that.(MsgRegisterMetaNodeBLSPubKeyResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
 func (this *MsgUpdateParams) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -2112,6 +2282,7 @@ type MsgClient interface {
 	HandleMsgUpdateMetaNodeDeposit(ctx context.Context, in *MsgUpdateMetaNodeDeposit, opts ...grpc.CallOption) (*MsgUpdateMetaNodeDepositResponse, error)
 	HandleMsgMetaNodeRegistrationVote(ctx context.Context, in *MsgMetaNodeRegistrationVote, opts ...grpc.CallOption) (*MsgMetaNodeRegistrationVoteResponse, error)
 	HandleMsgKickMetaNodeVote(ctx context.Context, in *MsgKickMetaNodeVote, opts ...grpc.CallOption) (*MsgKickMetaNodeVoteResponse, error)
+	HandleMsgRegisterMetaNodeBLSPubKey(ctx context.Context, in *MsgRegisterMetaNodeBLSPubKey, opts ...grpc.CallOption) (*MsgRegisterMetaNodeBLSPubKeyResponse, error)
 	// UpdateParams defined a governance operation for updating the x/register module parameters.
 	// The authority is hard-coded to the Cosmos SDK x/gov module account
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
@@ -2224,6 +2395,15 @@ func (c *msgClient) HandleMsgKickMetaNodeVote(ctx context.Context, in *MsgKickMe
 	return out, nil
 }
 
+func (c *msgClient) HandleMsgRegisterMetaNodeBLSPubKey(ctx context.Context, in *MsgRegisterMetaNodeBLSPubKey, opts ...grpc.CallOption) (*MsgRegisterMetaNodeBLSPubKeyResponse, error) {
+	out := new(MsgRegisterMetaNodeBLSPubKeyResponse)
+	err := c.cc.Invoke(ctx, "/stratos.register.v1.Msg/HandleMsgRegisterMetaNodeBLSPubKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
 	out := new(MsgUpdateParamsResponse)
 	err := c.cc.Invoke(ctx, "/stratos.register.v1.Msg/UpdateParams", in, out, opts...)
@@ -2247,6 +2427,7 @@ type MsgServer interface {
 	HandleMsgUpdateMetaNodeDeposit(context.Context, *MsgUpdateMetaNodeDeposit) (*MsgUpdateMetaNodeDepositResponse, error)
 	HandleMsgMetaNodeRegistrationVote(context.Context, *MsgMetaNodeRegistrationVote) (*MsgMetaNodeRegistrationVoteResponse, error)
 	HandleMsgKickMetaNodeVote(context.Context, *MsgKickMetaNodeVote) (*MsgKickMetaNodeVoteResponse, error)
+	HandleMsgRegisterMetaNodeBLSPubKey(context.Context, *MsgRegisterMetaNodeBLSPubKey) (*MsgRegisterMetaNodeBLSPubKeyResponse, error)
 	// UpdateParams defined a governance operation for updating the x/register module parameters.
 	// The authority is hard-coded to the Cosmos SDK x/gov module account
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
@@ -2289,6 +2470,9 @@ func (*UnimplementedMsgServer) HandleMsgMetaNodeRegistrationVote(ctx context.Con
 func (*UnimplementedMsgServer) HandleMsgKickMetaNodeVote(ctx context.Context, req *MsgKickMetaNodeVote) (*MsgKickMetaNodeVoteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method HandleMsgKickMetaNodeVote not implemented")
 }
+func (*UnimplementedMsgServer) HandleMsgRegisterMetaNodeBLSPubKey(ctx context.Context, req *MsgRegisterMetaNodeBLSPubKey) (*MsgRegisterMetaNodeBLSPubKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HandleMsgRegisterMetaNodeBLSPubKey not implemented")
+}
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
@@ -2495,6 +2679,24 @@ func _Msg_HandleMsgKickMetaNodeVote_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_HandleMsgRegisterMetaNodeBLSPubKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterMetaNodeBLSPubKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).HandleMsgRegisterMetaNodeBLSPubKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stratos.register.v1.Msg/HandleMsgRegisterMetaNodeBLSPubKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).HandleMsgRegisterMetaNodeBLSPubKey(ctx, req.(*MsgRegisterMetaNodeBLSPubKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgUpdateParams)
 	if err := dec(in); err != nil {
@@ -2561,6 +2763,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "HandleMsgKickMetaNodeVote",
 			Handler:    _Msg_HandleMsgKickMetaNodeVote_Handler,
 		},
+		{
+			MethodName: "HandleMsgRegisterMetaNodeBLSPubKey",
+			Handler:    _Msg_HandleMsgRegisterMetaNodeBLSPubKey_Handler,
+		},
 		{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
@@ -3434,6 +3640,80 @@ func (m *MsgKickMetaNodeVoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgRegisterMetaNodeBLSPubKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterMetaNodeBLSPubKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterMetaNodeBLSPubKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.BlsPubKey) > 0 {
+		i -= len(m.BlsPubKey)
+		copy(dAtA[i:], m.BlsPubKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BlsPubKey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OwnerAddress) > 0 {
+		i -= len(m.OwnerAddress)
+		copy(dAtA[i:], m.OwnerAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OwnerAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NetworkAddress) > 0 {
+		i -= len(m.NetworkAddress)
+		copy(dAtA[i:], m.NetworkAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NetworkAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterMetaNodeBLSPubKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterMetaNodeBLSPubKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterMetaNodeBLSPubKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3868,6 +4148,40 @@ func (m *MsgKickMetaNodeVoteResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgRegisterMetaNodeBLSPubKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NetworkAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BlsPubKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRegisterMetaNodeBLSPubKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgUpdateParams) Size() (n int) {
 	if m == nil {
 		return 0
@@ -6406,6 +6720,238 @@ func (m *MsgKickMetaNodeVoteResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgRegisterMetaNodeBLSPubKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterMetaNodeBLSPubKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterMetaNodeBLSPubKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetworkAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NetworkAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlsPubKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlsPubKey = append(m.BlsPubKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlsPubKey == nil {
+				m.BlsPubKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterMetaNodeBLSPubKeyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterMetaNodeBLSPubKeyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterMetaNodeBLSPubKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
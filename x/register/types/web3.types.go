@@ -60,6 +60,10 @@ func (m *MsgUpdateMetaNodeDeposit) GetWeb3Msg() (*stratos.Web3Msg, error) {
 	return nil, nil // SKIP
 }
 
+func (m *MsgRegisterMetaNodeBLSPubKey) GetWeb3Msg() (*stratos.Web3Msg, error) {
+	return nil, nil // SKIP
+}
+
 func (m *MsgKickMetaNodeVote) GetWeb3Msg() (*stratos.Web3Msg, error) {
 	return nil, nil // SKIP
 }
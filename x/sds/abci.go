@@ -15,6 +15,12 @@ func BeginBlocker(_ sdk.Context, _ abci.RequestBeginBlock, _ keeper.Keeper) {
 }
 
 // EndBlocker called every block, process inflation, update validator set.
-func EndBlocker(_ sdk.Context, _ abci.RequestEndBlock, _ keeper.Keeper) []abci.ValidatorUpdate {
+func EndBlocker(ctx sdk.Context, _ abci.RequestEndBlock, k keeper.Keeper) []abci.ValidatorUpdate {
+	k.ExecuteDuePrepaySubscriptions(ctx)
+	k.RecordNozPriceSnapshot(ctx)
+	k.PruneExpiredFileAccessGrants(ctx)
+	k.PayoutStorageRents(ctx)
+	k.ExpireStorageChallenges(ctx)
+	k.IssueStorageChallenges(ctx)
 	return []abci.ValidatorUpdate{}
 }
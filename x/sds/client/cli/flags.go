@@ -1,13 +1,32 @@
 package cli
 
 import (
+	"fmt"
+
 	flag "github.com/spf13/pflag"
+
+	"github.com/stratosnet/stratos-chain/x/sds/types"
 )
 
 const (
-	FlagFileHash = "file-hash"
-	FlagReporter = "reporter"
-	FlagUploader = "uploader"
+	FlagFileHash       = "file-hash"
+	FlagReporter       = "reporter"
+	FlagUploader       = "uploader"
+	FlagMinNoz         = "min-noz"
+	FlagSlippage       = "slippage"
+	FlagDeadlineHeight = "deadline-height"
+	FlagMaxCount       = "max-count"
+	FlagEndHeight      = "end-height"
+	FlagStartHeight    = "start-height"
+	FlagExpiryHeight   = "expiry-height"
+	FlagFee            = "fee"
+	FlagSegmentRoot    = "segment-root"
+	FlagSegmentCount   = "segment-count"
+	FlagStorageNodes   = "storage-nodes"
+	FlagProof          = "proof"
+	FlagRentAmount     = "rent-amount"
+	FlagRentDuration   = "rent-duration"
+	FlagRentReplicas   = "rent-replicas"
 )
 
 func flagSetFileHash() *flag.FlagSet {
@@ -27,3 +46,54 @@ func flagSetUploader() *flag.FlagSet {
 	fs.String(FlagUploader, "", "The owner address of resource node that uploaded the file")
 	return fs
 }
+
+func flagSetPrepaySlippage() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.String(FlagMinNoz, "", "The minimum amount of noz the prepay must purchase")
+	fs.String(FlagSlippage, "", "The max accepted slippage in percent of the noz amount simulated by SimPrepay, e.g. 0.5")
+	fs.Int64(FlagDeadlineHeight, 0, "The last block height at which the prepay can be executed")
+	return fs
+}
+
+func flagSetPrepaySubscription() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.Uint64(FlagMaxCount, 0, "The max number of executions of the subscription, 0 means unlimited")
+	fs.Int64(FlagEndHeight, 0, "The last block height at which the subscription can be executed, 0 means no end height")
+	return fs
+}
+
+func flagSetHeightRange() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.Int64(FlagStartHeight, 0, "The lowest block height of the range")
+	fs.Int64(FlagEndHeight, 0, "The highest block height of the range, 0 means no upper bound")
+	return fs
+}
+
+func flagSetFileAccess() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.Int64(FlagExpiryHeight, 0, "The block height from which the access is no longer valid, 0 means no expiry")
+	fs.String(FlagFee, "0", "The amount of noz the grantee pays through the noz allowance approved to the uploader")
+	return fs
+}
+
+func flagSetFileSegments() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.String(FlagSegmentRoot, "", "The hex encoded merkle root of the file segments, files without segment root are not challenged")
+	fs.Uint64(FlagSegmentCount, 0, fmt.Sprintf("The number of segments of the file, a segment holds at most %d bytes", types.MaxSegmentSize))
+	fs.StringSlice(FlagStorageNodes, []string{}, "The network addresses of the resource nodes storing the file")
+	return fs
+}
+
+func flagSetStorageProof() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.StringSlice(FlagProof, []string{}, "The hex encoded sibling hashes from the segment leaf up to the segment root")
+	return fs
+}
+
+func flagSetStorageRent() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.String(FlagRentAmount, "0", "The amount of noz locked as storage rent through the noz allowance approved by the uploader to the sender, 0 means no rent")
+	fs.Int64(FlagRentDuration, 0, "The number of pot epochs over which the storage rent is paid out")
+	fs.Uint32(FlagRentReplicas, 0, "The number of resource nodes paid for storing the file")
+	return fs
+}
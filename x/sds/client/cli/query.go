@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -28,6 +29,15 @@ func GetQueryCmd() *cobra.Command {
 	sdsQueryCmd.AddCommand(
 		GetCmdQueryUploadedFile(),
 		GetCmdQueryParams(),
+		GetCmdQueryPrepaySubscriptions(),
+		GetCmdQueryNozPriceHistory(),
+		GetCmdQueryNozPriceTWAP(),
+		GetCmdQueryNozBalance(),
+		GetCmdQueryNozAllowance(),
+		GetCmdQueryFileAccess(),
+		GetCmdQueryStorageChallenges(),
+		GetCmdQuerySimRefund(),
+		GetCmdQueryStorageRent(),
 	)
 
 	return sdsQueryCmd
@@ -112,3 +122,374 @@ $ %s query sds upload c03661732294feb49caf6dc16c7cbb2534986d73
 	flags.AddPaginationFlagsToCmd(cmd, "upload")
 	return cmd
 }
+
+// GetCmdQueryPrepaySubscriptions implements the query prepay subscriptions command.
+func GetCmdQueryPrepaySubscriptions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "prepay-subscriptions [sender]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query recurring prepay subscriptions created by sender",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query recurring prepay subscriptions created by sender.
+
+Example:
+$ %s query sds prepay-subscriptions st1yx3kkx9jnqrgvh7ar2v3u2ymsrz2v3k0kymp7n
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			result, err := queryClient.PrepaySubscriptions(cmd.Context(), &types.QueryPrepaySubscriptionsRequest{
+				Sender:     strings.TrimSpace(args[0]),
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(result)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "prepay-subscriptions")
+	return cmd
+}
+
+// GetCmdQueryNozPriceHistory implements the query noz price history command.
+func GetCmdQueryNozPriceHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "noz-price-history",
+		Args:  cobra.NoArgs,
+		Short: "Query recorded noz price snapshots within a height range",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query recorded noz price snapshots within a height range.
+
+Example:
+$ %s query sds noz-price-history --start-height=1000 --end-height=2000
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			startHeight, err := cmd.Flags().GetInt64(FlagStartHeight)
+			if err != nil {
+				return err
+			}
+			endHeight, err := cmd.Flags().GetInt64(FlagEndHeight)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			result, err := queryClient.NozPriceHistory(cmd.Context(), &types.QueryNozPriceHistoryRequest{
+				StartHeight: startHeight,
+				EndHeight:   endHeight,
+				Pagination:  pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(result)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(flagSetHeightRange())
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "noz-price-history")
+	return cmd
+}
+
+// GetCmdQueryNozPriceTWAP implements the query noz price twap command.
+func GetCmdQueryNozPriceTWAP() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "noz-price-twap [window]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the time weighted average noz price over the latest [window] blocks",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the time weighted average noz price over the latest [window] blocks.
+
+Example:
+$ %s query sds noz-price-twap 1000
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			window, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			result, err := queryClient.NozPriceTWAP(cmd.Context(), &types.QueryNozPriceTWAPRequest{
+				Window: window,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(result)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryNozBalance implements the query noz balance command.
+func GetCmdQueryNozBalance() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "noz-balance [owner]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the noz ledger balance of a wallet",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the noz ledger balance of a wallet.
+
+Example:
+$ %s query sds noz-balance st1yx3kkx9jnqrgvh7ar2v3u2ymsrz2v3k0kymp7n
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			result, err := queryClient.NozBalance(cmd.Context(), &types.QueryNozBalanceRequest{
+				Owner: strings.TrimSpace(args[0]),
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(result)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryNozAllowance implements the query noz allowance command.
+func GetCmdQueryNozAllowance() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "noz-allowance [owner] [spender]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query the amount of noz a spender is allowed to transfer on behalf of the owner",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the amount of noz a spender is allowed to transfer on behalf of the owner.
+
+Example:
+$ %s query sds noz-allowance st1yx3kkx9jnqrgvh7ar2v3u2ymsrz2v3k0kymp7n st1k4ach36c8qwuckefz94vy83y308h5uzyrsllx6
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			result, err := queryClient.NozAllowance(cmd.Context(), &types.QueryNozAllowanceRequest{
+				Owner:   strings.TrimSpace(args[0]),
+				Spender: strings.TrimSpace(args[1]),
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(result)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryFileAccess implements the query file access command.
+func GetCmdQueryFileAccess() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "file-access [file_hash] [grantee]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query the read access to a file granted to a wallet",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the read access to a file granted to a wallet.
+
+Example:
+$ %s query sds file-access c03661732294feb49caf6dc16c7cbb2534986d73 st1yx3kkx9jnqrgvh7ar2v3u2ymsrz2v3k0kymp7n
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			result, err := queryClient.FileAccess(cmd.Context(), &types.QueryFileAccessRequest{
+				FileHash: strings.TrimSpace(args[0]),
+				Grantee:  strings.TrimSpace(args[1]),
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(result)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryStorageChallenges implements the query storage challenges command.
+func GetCmdQueryStorageChallenges() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "storage-challenges [network_address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the pending storage challenges of a resource node",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the pending storage challenges of a resource node and its number of consecutive failed challenges.
+
+Example:
+$ %s query sds storage-challenges stsds1cw8qhgsxddak8hh8gs7veqmy5ku8f8za6qlq64
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			result, err := queryClient.StorageChallenges(cmd.Context(), &types.QueryStorageChallengesRequest{
+				NetworkAddress: strings.TrimSpace(args[0]),
+				Pagination:     pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(result)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "storage-challenges")
+	return cmd
+}
+
+// GetCmdQuerySimRefund implements the query sim refund command.
+func GetCmdQuerySimRefund() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sim-refund [amount]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Simulate the STOS refunded for burning an amount of noz",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Simulate the STOS refunded for burning an amount of noz, and the refund fee sent to the community pool.
+
+Example:
+$ %s query sds sim-refund 1000000000
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			result, err := queryClient.SimRefund(cmd.Context(), &types.QuerySimRefundRequest{
+				Amount: strings.TrimSpace(args[0]),
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(result)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryStorageRent implements the query storage rent command.
+func GetCmdQueryStorageRent() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "storage-rent [file_hash]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the storage rent escrow of a file",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the storage rent escrow of a file.
+
+Example:
+$ %s query sds storage-rent c03661732294feb49caf6dc16c7cbb2534986d73
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			result, err := queryClient.StorageRent(cmd.Context(), &types.QueryStorageRentRequest{
+				FileHash: strings.TrimSpace(args[0]),
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(result)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
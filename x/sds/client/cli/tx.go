@@ -1,11 +1,16 @@
 package cli
 
 import (
+	"context"
+	"encoding/hex"
 	"fmt"
+	"os"
+	"strconv"
 
 	"github.com/spf13/cobra"
 	flag "github.com/spf13/pflag"
 
+	sdkmath "cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
@@ -28,6 +33,16 @@ func GetTxCmd() *cobra.Command {
 	sdsTxCmd.AddCommand(
 		FileUploadTxCmd(),
 		PrepayTxCmd(),
+		CreatePrepaySubscriptionTxCmd(),
+		CancelPrepaySubscriptionTxCmd(),
+		TransferNozTxCmd(),
+		ApproveNozTxCmd(),
+		TransferNozFromTxCmd(),
+		GrantFileAccessTxCmd(),
+		RevokeFileAccessTxCmd(),
+		SubmitStorageProofTxCmd(),
+		RefundNozTxCmd(),
+		DeleteFileTxCmd(),
 	)
 	return sdsTxCmd
 }
@@ -56,6 +71,8 @@ func FileUploadTxCmd() *cobra.Command {
 	cmd.Flags().AddFlagSet(flagSetFileHash())
 	cmd.Flags().AddFlagSet(flagSetReporter())
 	cmd.Flags().AddFlagSet(flagSetUploader())
+	cmd.Flags().AddFlagSet(flagSetFileSegments())
+	cmd.Flags().AddFlagSet(flagSetStorageRent())
 
 	flags.AddTxFlagsToCmd(cmd)
 
@@ -94,6 +111,381 @@ func PrepayTxCmd() *cobra.Command {
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
+
+	cmd.Flags().AddFlagSet(flagSetPrepaySlippage())
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// CreatePrepaySubscriptionTxCmd will create a recurring prepay subscription tx and sign it with the given key.
+func CreatePrepaySubscriptionTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-prepay-subscription [from_address] [beneficiary_address] [coins] [interval]",
+		Short: "Create and sign a tx which prepays the given coins every [interval] blocks",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			fromAddr, fromName, _, err := client.GetFromFields(cliCtx, cliCtx.Keyring, args[0])
+			if err != nil {
+				return err
+			}
+
+			clientCtx := cliCtx.WithFrom(args[0]).WithFromAddress(fromAddr).WithFromName(fromName)
+
+			msg, err := newBuildCreatePrepaySubscriptionMsg(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(flagSetPrepaySubscription())
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// CancelPrepaySubscriptionTxCmd will create a cancel prepay subscription tx and sign it with the given key.
+func CancelPrepaySubscriptionTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-prepay-subscription [from_address] [subscription_id]",
+		Short: "Create and sign a cancel prepay subscription tx",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			fromAddr, fromName, _, err := client.GetFromFields(cliCtx, cliCtx.Keyring, args[0])
+			if err != nil {
+				return err
+			}
+
+			clientCtx := cliCtx.WithFrom(args[0]).WithFromAddress(fromAddr).WithFromName(fromName)
+
+			subscriptionId, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelPrepaySubscription(clientCtx.GetFromAddress().String(), subscriptionId)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// TransferNozTxCmd will create a noz transfer tx and sign it with the given key.
+func TransferNozTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-noz [from_address] [recipient_address] [amount]",
+		Short: "Create and sign a noz transfer tx",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			fromAddr, fromName, _, err := client.GetFromFields(cliCtx, cliCtx.Keyring, args[0])
+			if err != nil {
+				return err
+			}
+
+			clientCtx := cliCtx.WithFrom(args[0]).WithFromAddress(fromAddr).WithFromName(fromName)
+
+			recipient, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			amount, ok := sdkmath.NewIntFromString(args[2])
+			if !ok {
+				return fmt.Errorf("invalid noz amount %s", args[2])
+			}
+
+			msg := types.NewMsgTransferNoz(clientCtx.GetFromAddress().String(), recipient.String(), amount)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// RefundNozTxCmd will create a noz refund tx and sign it with the given key.
+func RefundNozTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "refund-noz [from_address] [amount] [nonce] [bls_signature_file]",
+		Short: "Create and sign a tx burning unused noz in exchange of the prepaid STOS",
+		Long: "Create and sign a tx burning unused noz in exchange of the prepaid STOS. " +
+			"bls_signature_file is the JSON encoded BLS signature of the meta nodes attesting the refund",
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			fromAddr, fromName, _, err := client.GetFromFields(cliCtx, cliCtx.Keyring, args[0])
+			if err != nil {
+				return err
+			}
+
+			clientCtx := cliCtx.WithFrom(args[0]).WithFromAddress(fromAddr).WithFromName(fromName)
+
+			amount, ok := sdkmath.NewIntFromString(args[1])
+			if !ok {
+				return fmt.Errorf("invalid noz amount %s", args[1])
+			}
+
+			nonce, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid refund nonce %s", args[2])
+			}
+
+			bz, err := os.ReadFile(args[3])
+			if err != nil {
+				return err
+			}
+			var blsSignature types.BLSSignatureInfo
+			err = clientCtx.Codec.UnmarshalJSON(bz, &blsSignature)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRefundNoz(clientCtx.GetFromAddress().String(), amount, nonce, blsSignature)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// ApproveNozTxCmd will create a noz approve tx and sign it with the given key.
+func ApproveNozTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "approve-noz [owner_address] [spender_address] [amount]",
+		Short: "Create and sign a noz approve tx",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			fromAddr, fromName, _, err := client.GetFromFields(cliCtx, cliCtx.Keyring, args[0])
+			if err != nil {
+				return err
+			}
+
+			clientCtx := cliCtx.WithFrom(args[0]).WithFromAddress(fromAddr).WithFromName(fromName)
+
+			spender, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			amount, ok := sdkmath.NewIntFromString(args[2])
+			if !ok {
+				return fmt.Errorf("invalid noz amount %s", args[2])
+			}
+
+			msg := types.NewMsgApproveNoz(clientCtx.GetFromAddress().String(), spender.String(), amount)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// TransferNozFromTxCmd will create a noz transfer on behalf of the owner tx and sign it with the given key.
+func TransferNozFromTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-noz-from [spender_address] [owner_address] [recipient_address] [amount]",
+		Short: "Create and sign a noz transfer on behalf of the owner tx",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			fromAddr, fromName, _, err := client.GetFromFields(cliCtx, cliCtx.Keyring, args[0])
+			if err != nil {
+				return err
+			}
+
+			clientCtx := cliCtx.WithFrom(args[0]).WithFromAddress(fromAddr).WithFromName(fromName)
+
+			owner, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			recipient, err := sdk.AccAddressFromBech32(args[2])
+			if err != nil {
+				return err
+			}
+
+			amount, ok := sdkmath.NewIntFromString(args[3])
+			if !ok {
+				return fmt.Errorf("invalid noz amount %s", args[3])
+			}
+
+			msg := types.NewMsgTransferNozFrom(clientCtx.GetFromAddress().String(), owner.String(), recipient.String(), amount)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GrantFileAccessTxCmd will create a file access grant tx and sign it with the given key.
+func GrantFileAccessTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant-file-access [granter_address] [file_hash] [grantee_address]",
+		Short: "Create and sign a tx granting read access to an uploaded file",
+		Long: "Create and sign a tx granting read access to an uploaded file. " +
+			"A positive --fee is paid by the grantee in two steps: the grantee first approves at least the fee " +
+			"to the uploader with approve-noz, then the uploader sends this tx, which transfers the fee " +
+			"from the grantee to the uploader through that noz allowance",
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			fromAddr, fromName, _, err := client.GetFromFields(cliCtx, cliCtx.Keyring, args[0])
+			if err != nil {
+				return err
+			}
+
+			clientCtx := cliCtx.WithFrom(args[0]).WithFromAddress(fromAddr).WithFromName(fromName)
+
+			msg, err := newBuildGrantFileAccessMsg(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(flagSetFileAccess())
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// RevokeFileAccessTxCmd will create a file access revocation tx and sign it with the given key.
+func RevokeFileAccessTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke-file-access [granter_address] [file_hash] [grantee_address]",
+		Short: "Create and sign a tx revoking read access to an uploaded file",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			fromAddr, fromName, _, err := client.GetFromFields(cliCtx, cliCtx.Keyring, args[0])
+			if err != nil {
+				return err
+			}
+
+			clientCtx := cliCtx.WithFrom(args[0]).WithFromAddress(fromAddr).WithFromName(fromName)
+
+			grantee, err := sdk.AccAddressFromBech32(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRevokeFileAccess(clientCtx.GetFromAddress().String(), args[1], grantee.String())
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// SubmitStorageProofTxCmd will create a storage proof tx answering a storage challenge and sign it with the given key.
+func SubmitStorageProofTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-storage-proof [from_address] [network_address] [challenge_id] [segment_file]",
+		Short: "Create and sign a tx answering a storage challenge with the challenged segment and its merkle proof",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			fromAddr, fromName, _, err := client.GetFromFields(cliCtx, cliCtx.Keyring, args[0])
+			if err != nil {
+				return err
+			}
+
+			clientCtx := cliCtx.WithFrom(args[0]).WithFromAddress(fromAddr).WithFromName(fromName)
+
+			msg, err := newBuildSubmitStorageProofMsg(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(flagSetStorageProof())
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// DeleteFileTxCmd will create a file deletion tx and sign it with the given key.
+func DeleteFileTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete-file [uploader_address] [file_hash]",
+		Short: "Create and sign a tx deleting an uploaded file and refunding its unspent storage rent",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			fromAddr, fromName, _, err := client.GetFromFields(cliCtx, cliCtx.Keyring, args[0])
+			if err != nil {
+				return err
+			}
+
+			clientCtx := cliCtx.WithFrom(args[0]).WithFromAddress(fromAddr).WithFromName(fromName)
+
+			msg := types.NewMsgDeleteFile(clientCtx.GetFromAddress().String(), args[1])
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -123,11 +515,55 @@ func newBuildFileuploadMsg(clientCtx client.Context, fs *flag.FlagSet) (*types.M
 		return nil, err
 	}
 
+	segmentRootStr, err := fs.GetString(FlagSegmentRoot)
+	if err != nil {
+		return nil, err
+	}
+	segmentRoot, err := hex.DecodeString(segmentRootStr)
+	if err != nil {
+		return nil, err
+	}
+
+	segmentCount, err := fs.GetUint64(FlagSegmentCount)
+	if err != nil {
+		return nil, err
+	}
+
+	storageNodes, err := fs.GetStringSlice(FlagStorageNodes)
+	if err != nil {
+		return nil, err
+	}
+
+	rentAmountStr, err := fs.GetString(FlagRentAmount)
+	if err != nil {
+		return nil, err
+	}
+	rentAmount, ok := sdkmath.NewIntFromString(rentAmountStr)
+	if !ok {
+		return nil, fmt.Errorf("invalid rent amount %s", rentAmountStr)
+	}
+
+	rentDuration, err := fs.GetInt64(FlagRentDuration)
+	if err != nil {
+		return nil, err
+	}
+
+	rentReplicas, err := fs.GetUint32(FlagRentReplicas)
+	if err != nil {
+		return nil, err
+	}
+
 	msg := types.NewMsgUpload(
 		fileHash,
 		clientCtx.GetFromAddress().String(),
 		flagReporterStr,
 		flagUploaderStr,
+		segmentRoot,
+		segmentCount,
+		storageNodes,
+		rentAmount,
+		rentDuration,
+		rentReplicas,
 	)
 
 	return msg, nil
@@ -145,8 +581,160 @@ func newBuildPrepayMsg(clientCtx client.Context, fs *flag.FlagSet) (*types.MsgPr
 		return nil, err
 	}
 
+	minNozOut, err := getMinNozOut(clientCtx, fs, amount)
+	if err != nil {
+		return nil, err
+	}
+
+	deadlineHeight, err := fs.GetInt64(FlagDeadlineHeight)
+	if err != nil {
+		return nil, err
+	}
+
 	// build and sign the transaction, then broadcast to Tendermint
-	msg := types.NewMsgPrepay(clientCtx.GetFromAddress().String(), beneficiary.String(), sdk.NewCoins(amount))
+	msg := types.NewMsgPrepay(clientCtx.GetFromAddress().String(), beneficiary.String(), sdk.NewCoins(amount), minNozOut, deadlineHeight)
 
 	return msg, nil
 }
+
+// makes a new newBuildCreatePrepaySubscriptionMsg
+func newBuildCreatePrepaySubscriptionMsg(clientCtx client.Context, fs *flag.FlagSet) (*types.MsgCreatePrepaySubscription, error) {
+	beneficiary, err := sdk.AccAddressFromBech32(fs.Arg(1))
+	if err != nil {
+		return nil, err
+	}
+
+	amount, err := sdk.ParseCoinNormalized(fs.Arg(2))
+	if err != nil {
+		return nil, err
+	}
+
+	interval, err := strconv.ParseInt(fs.Arg(3), 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	maxCount, err := fs.GetUint64(FlagMaxCount)
+	if err != nil {
+		return nil, err
+	}
+
+	endHeight, err := fs.GetInt64(FlagEndHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := types.NewMsgCreatePrepaySubscription(clientCtx.GetFromAddress().String(), beneficiary.String(),
+		sdk.NewCoins(amount), interval, maxCount, endHeight)
+
+	return msg, nil
+}
+
+// makes a new newBuildGrantFileAccessMsg
+func newBuildGrantFileAccessMsg(clientCtx client.Context, fs *flag.FlagSet) (*types.MsgGrantFileAccess, error) {
+	grantee, err := sdk.AccAddressFromBech32(fs.Arg(2))
+	if err != nil {
+		return nil, err
+	}
+
+	expiryHeight, err := fs.GetInt64(FlagExpiryHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	feeStr, err := fs.GetString(FlagFee)
+	if err != nil {
+		return nil, err
+	}
+	fee, ok := sdkmath.NewIntFromString(feeStr)
+	if !ok {
+		return nil, fmt.Errorf("invalid fee %s", feeStr)
+	}
+
+	msg := types.NewMsgGrantFileAccess(clientCtx.GetFromAddress().String(), fs.Arg(1), grantee.String(), expiryHeight, fee)
+
+	return msg, nil
+}
+
+// makes a new newBuildSubmitStorageProofMsg
+func newBuildSubmitStorageProofMsg(clientCtx client.Context, fs *flag.FlagSet) (*types.MsgSubmitStorageProof, error) {
+	_, err := stratos.SdsAddressFromBech32(fs.Arg(1))
+	if err != nil {
+		return nil, err
+	}
+
+	challengeId, err := strconv.ParseUint(fs.Arg(2), 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	segment, err := os.ReadFile(fs.Arg(3))
+	if err != nil {
+		return nil, err
+	}
+
+	proofStrs, err := fs.GetStringSlice(FlagProof)
+	if err != nil {
+		return nil, err
+	}
+	proof := make([][]byte, 0, len(proofStrs))
+	for _, proofStr := range proofStrs {
+		hash, err := hex.DecodeString(proofStr)
+		if err != nil {
+			return nil, err
+		}
+		proof = append(proof, hash)
+	}
+
+	msg := types.NewMsgSubmitStorageProof(clientCtx.GetFromAddress().String(), fs.Arg(1), challengeId, segment, proof)
+
+	return msg, nil
+}
+
+// getMinNozOut returns the min noz out given by flag, or computes it from SimPrepay and the accepted slippage
+func getMinNozOut(clientCtx client.Context, fs *flag.FlagSet, amount sdk.Coin) (sdkmath.Int, error) {
+	minNozStr, err := fs.GetString(FlagMinNoz)
+	if err != nil {
+		return sdkmath.ZeroInt(), err
+	}
+	slippageStr, err := fs.GetString(FlagSlippage)
+	if err != nil {
+		return sdkmath.ZeroInt(), err
+	}
+
+	if len(minNozStr) > 0 && len(slippageStr) > 0 {
+		return sdkmath.ZeroInt(), fmt.Errorf("flags --%s and --%s cannot be used together", FlagMinNoz, FlagSlippage)
+	}
+
+	if len(minNozStr) > 0 {
+		minNozOut, ok := sdkmath.NewIntFromString(minNozStr)
+		if !ok || minNozOut.IsNegative() {
+			return sdkmath.ZeroInt(), fmt.Errorf("invalid min noz amount: %s", minNozStr)
+		}
+		return minNozOut, nil
+	}
+
+	if len(slippageStr) == 0 {
+		return sdkmath.ZeroInt(), nil
+	}
+
+	slippage, err := sdkmath.LegacyNewDecFromStr(slippageStr)
+	if err != nil {
+		return sdkmath.ZeroInt(), fmt.Errorf("invalid slippage: %w", err)
+	}
+	if slippage.IsNegative() || slippage.GT(sdkmath.LegacyNewDec(100)) {
+		return sdkmath.ZeroInt(), fmt.Errorf("slippage must be between 0 and 100 percent")
+	}
+
+	queryClient := types.NewQueryClient(clientCtx)
+	res, err := queryClient.SimPrepay(context.Background(), &types.QuerySimPrepayRequest{Amount: amount.String()})
+	if err != nil {
+		return sdkmath.ZeroInt(), err
+	}
+
+	minNozOut := res.Noz.ToLegacyDec().
+		Mul(sdkmath.LegacyNewDec(100).Sub(slippage)).
+		Quo(sdkmath.LegacyNewDec(100)).
+		TruncateInt()
+	return minNozOut, nil
+}
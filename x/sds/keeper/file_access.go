@@ -0,0 +1,163 @@
+package keeper
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/stratosnet/stratos-chain/x/sds/types"
+)
+
+const (
+	FileAccessPruneCountPerBlock = 100
+)
+
+func (k Keeper) GetFileAccessGrant(ctx sdk.Context, fileHash string, grantee sdk.AccAddress) (grant types.FileAccessGrant, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetFileAccessKey(fileHash, grantee))
+	if bz == nil {
+		return grant, false
+	}
+	k.cdc.MustUnmarshalLengthPrefixed(bz, &grant)
+	return grant, true
+}
+
+// SetFileAccessGrant stores the grant together with its entry in the expiry queue
+func (k Keeper) SetFileAccessGrant(ctx sdk.Context, grant types.FileAccessGrant) {
+	grantee := sdk.MustAccAddressFromBech32(grant.GetGrantee())
+
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalLengthPrefixed(&grant)
+	store.Set(types.GetFileAccessKey(grant.GetFileHash(), grantee), bz)
+	if grant.GetExpiryHeight() > 0 {
+		store.Set(types.GetFileAccessExpiryQueueKey(grant.GetExpiryHeight(), grant.GetFileHash(), grantee), []byte{})
+	}
+}
+
+// RemoveFileAccessGrant removes the grant together with its entry in the expiry queue
+func (k Keeper) RemoveFileAccessGrant(ctx sdk.Context, grant types.FileAccessGrant) {
+	grantee := sdk.MustAccAddressFromBech32(grant.GetGrantee())
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetFileAccessKey(grant.GetFileHash(), grantee))
+	if grant.GetExpiryHeight() > 0 {
+		store.Delete(types.GetFileAccessExpiryQueueKey(grant.GetExpiryHeight(), grant.GetFileHash(), grantee))
+	}
+}
+
+// IterateFileAccessGrants Iteration for all file access grants
+func (k Keeper) IterateFileAccessGrants(ctx sdk.Context, handler func(grant types.FileAccessGrant) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.FileAccessKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var grant types.FileAccessGrant
+		k.cdc.MustUnmarshalLengthPrefixed(iter.Value(), &grant)
+		if handler(grant) {
+			break
+		}
+	}
+}
+
+// RemoveFileAccessGrantsOfFile removes all the grants of a file
+func (k Keeper) RemoveFileAccessGrantsOfFile(ctx sdk.Context, fileHash string) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetFileAccessFilePrefix(fileHash))
+
+	grants := make([]types.FileAccessGrant, 0)
+	for ; iter.Valid(); iter.Next() {
+		var grant types.FileAccessGrant
+		k.cdc.MustUnmarshalLengthPrefixed(iter.Value(), &grant)
+		grants = append(grants, grant)
+	}
+	iter.Close()
+
+	for _, grant := range grants {
+		k.RemoveFileAccessGrant(ctx, grant)
+	}
+}
+
+// IsFileAccessGrantActive returns true when the grant is not expired at the current height
+func (k Keeper) IsFileAccessGrantActive(ctx sdk.Context, grant types.FileAccessGrant) bool {
+	return grant.GetExpiryHeight() == 0 || ctx.BlockHeight() < grant.GetExpiryHeight()
+}
+
+// GrantFileAccess grants the read access to a file uploaded by granter. A positive fee is transferred from the
+// grantee to the granter through the noz allowance the grantee approved to the granter.
+func (k Keeper) GrantFileAccess(ctx sdk.Context, msg *types.MsgGrantFileAccess) error {
+	granter, err := sdk.AccAddressFromBech32(msg.GetGranter())
+	if err != nil {
+		return errors.Wrap(types.ErrInvalidGranterAddr, err.Error())
+	}
+	grantee, err := sdk.AccAddressFromBech32(msg.GetGrantee())
+	if err != nil {
+		return errors.Wrap(types.ErrInvalidGranteeAddr, err.Error())
+	}
+
+	fileInfo, found := k.GetFileInfoByFileHash(ctx, []byte(msg.GetFileHash()))
+	if !found {
+		return types.ErrNoFileFound
+	}
+	if fileInfo.GetUploader() != msg.GetGranter() {
+		return errors.Wrapf(types.ErrNotFileUploader, "uploader of file %s is %s", msg.GetFileHash(), fileInfo.GetUploader())
+	}
+
+	if msg.GetExpiryHeight() > 0 && msg.GetExpiryHeight() <= ctx.BlockHeight() {
+		return errors.Wrapf(types.ErrInvalidHeight, "expiry height %d is not higher than current height %d",
+			msg.GetExpiryHeight(), ctx.BlockHeight())
+	}
+
+	if msg.Fee.IsPositive() {
+		err = k.TransferNozFrom(ctx, granter, grantee, granter, msg.Fee)
+		if err != nil {
+			return err
+		}
+	}
+
+	// replace the previous grant of the same grantee
+	if grant, found := k.GetFileAccessGrant(ctx, msg.GetFileHash(), grantee); found {
+		k.RemoveFileAccessGrant(ctx, grant)
+	}
+
+	k.SetFileAccessGrant(ctx, types.FileAccessGrant{
+		FileHash:     msg.GetFileHash(),
+		Granter:      msg.GetGranter(),
+		Grantee:      msg.GetGrantee(),
+		ExpiryHeight: msg.GetExpiryHeight(),
+		Fee:          msg.Fee,
+	})
+	return nil
+}
+
+// RevokeFileAccess removes a file access grant created by granter
+func (k Keeper) RevokeFileAccess(ctx sdk.Context, granter string, fileHash string, grantee sdk.AccAddress) error {
+	grant, found := k.GetFileAccessGrant(ctx, fileHash, grantee)
+	if !found || grant.GetGranter() != granter {
+		return errors.Wrapf(types.ErrNoFileAccessFound, "file %s grantee %s", fileHash, grantee.String())
+	}
+
+	k.RemoveFileAccessGrant(ctx, grant)
+	return nil
+}
+
+// PruneExpiredFileAccessGrants removes at most FileAccessPruneCountPerBlock grants expired at the current height
+func (k Keeper) PruneExpiredFileAccessGrants(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(types.FileAccessExpiryQueueKeyPrefix,
+		sdk.PrefixEndBytes(types.GetFileAccessExpiryQueueHeightPrefix(ctx.BlockHeight())))
+
+	expiredKeys := make([][]byte, 0)
+	for ; iter.Valid() && len(expiredKeys) < FileAccessPruneCountPerBlock; iter.Next() {
+		expiredKeys = append(expiredKeys, iter.Key())
+	}
+	iter.Close()
+
+	for _, key := range expiredKeys {
+		fileHash, grantee := types.ParseFileAccessExpiryQueueKey(key)
+		grant, found := k.GetFileAccessGrant(ctx, fileHash, grantee)
+		if !found {
+			store.Delete(key)
+			continue
+		}
+		k.RemoveFileAccessGrant(ctx, grant)
+	}
+}
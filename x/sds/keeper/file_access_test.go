@@ -0,0 +1,165 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/stratosnet/stratos-chain/x/sds/keeper"
+	"github.com/stratosnet/stratos-chain/x/sds/types"
+)
+
+const testFileHash = "a2a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f7081"
+
+// uploadFile stores a file uploaded by uploader
+func uploadFile(ctx sdk.Context, k keeper.Keeper, fileHash string, uploader sdk.AccAddress) {
+	k.SetFileInfo(ctx, []byte(fileHash), types.NewFileInfo(sdkmath.NewInt(ctx.BlockHeight()), nil, uploader.String(),
+		nil, 0, nil))
+}
+
+func TestGrantFileAccess(t *testing.T) {
+	stApp, ctx := setupApp(t)
+	k := stApp.GetSdsKeeper()
+	msgServer := keeper.NewMsgServerImpl(k)
+	uploader, grantee := user(0), user(1)
+	uploadFile(ctx, k, testFileHash, uploader)
+	purchased := prepayNoz(t, ctx, k, grantee)
+	fee := purchased.QuoRaw(4)
+
+	grant := func(ctx sdk.Context, granter sdk.AccAddress, expiryHeight int64, fee sdkmath.Int) error {
+		_, err := msgServer.HandleMsgGrantFileAccess(sdk.WrapSDKContext(ctx),
+			types.NewMsgGrantFileAccess(granter.String(), testFileHash, grantee.String(), expiryHeight, fee))
+		return err
+	}
+
+	// only the uploader of an existing file can grant
+	cacheCtx, _ := ctx.CacheContext()
+	require.ErrorIs(t, grant(cacheCtx, grantee, 0, sdkmath.ZeroInt()), types.ErrNotFileUploader)
+	_, err := msgServer.HandleMsgGrantFileAccess(sdk.WrapSDKContext(cacheCtx),
+		types.NewMsgGrantFileAccess(uploader.String(), "unknown", grantee.String(), 0, sdkmath.ZeroInt()))
+	require.ErrorIs(t, err, types.ErrNoFileFound)
+	require.ErrorIs(t, grant(cacheCtx, uploader, ctx.BlockHeight(), sdkmath.ZeroInt()), types.ErrInvalidHeight)
+
+	// the fee is only paid through an allowance approved by the grantee to the uploader
+	require.ErrorIs(t, grant(cacheCtx, uploader, 0, fee), types.ErrInsufficientNozAllowance)
+	_, err = msgServer.HandleMsgApproveNoz(sdk.WrapSDKContext(ctx),
+		types.NewMsgApproveNoz(grantee.String(), uploader.String(), fee))
+	require.NoError(t, err)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, grant(ctx, uploader, 0, fee))
+	require.Equal(t, 1, countEvents(ctx, &types.EventGrantFileAccess{}))
+	require.True(t, fee.Equal(k.GetNozBalance(ctx, uploader)))
+	require.True(t, purchased.Sub(fee).Equal(k.GetNozBalance(ctx, grantee)))
+	require.True(t, k.GetNozAllowance(ctx, grantee, uploader).IsZero())
+
+	accessGrant, found := k.GetFileAccessGrant(ctx, testFileHash, grantee)
+	require.True(t, found)
+	require.Equal(t, uploader.String(), accessGrant.GetGranter())
+	require.True(t, fee.Equal(accessGrant.Fee))
+	require.True(t, k.IsFileAccessGrantActive(ctx, accessGrant))
+
+	// a new grant replaces the previous one
+	expiryHeight := ctx.BlockHeight() + 10
+	require.NoError(t, grant(ctx, uploader, expiryHeight, sdkmath.ZeroInt()))
+	accessGrant, found = k.GetFileAccessGrant(ctx, testFileHash, grantee)
+	require.True(t, found)
+	require.Equal(t, expiryHeight, accessGrant.GetExpiryHeight())
+	require.False(t, k.IsFileAccessGrantActive(ctx.WithBlockHeight(expiryHeight), accessGrant))
+}
+
+func TestRevokeFileAccess(t *testing.T) {
+	stApp, ctx := setupApp(t)
+	k := stApp.GetSdsKeeper()
+	msgServer := keeper.NewMsgServerImpl(k)
+	uploader, grantee := user(0), user(1)
+	uploadFile(ctx, k, testFileHash, uploader)
+
+	revoke := func(granter sdk.AccAddress) error {
+		_, err := msgServer.HandleMsgRevokeFileAccess(sdk.WrapSDKContext(ctx),
+			types.NewMsgRevokeFileAccess(granter.String(), testFileHash, grantee.String()))
+		return err
+	}
+
+	require.ErrorIs(t, revoke(uploader), types.ErrNoFileAccessFound)
+
+	expiryHeight := ctx.BlockHeight() + 10
+	require.NoError(t, k.GrantFileAccess(ctx,
+		types.NewMsgGrantFileAccess(uploader.String(), testFileHash, grantee.String(), expiryHeight, sdkmath.ZeroInt())))
+
+	// only the granter can revoke
+	require.ErrorIs(t, revoke(grantee), types.ErrNoFileAccessFound)
+	require.NoError(t, revoke(uploader))
+	_, found := k.GetFileAccessGrant(ctx, testFileHash, grantee)
+	require.False(t, found)
+
+	// the expiry queue entry is removed as well
+	store := ctx.KVStore(stApp.GetKey(types.StoreKey))
+	require.False(t, store.Has(types.GetFileAccessExpiryQueueKey(expiryHeight, testFileHash, grantee)))
+}
+
+func TestPruneExpiredFileAccessGrants(t *testing.T) {
+	stApp, ctx := setupApp(t)
+	k := stApp.GetSdsKeeper()
+	uploader := user(0)
+	uploadFile(ctx, k, testFileHash, uploader)
+
+	grantees := []sdk.AccAddress{user(1), resOwner(0), resOwner(1)}
+	expiryHeights := []int64{ctx.BlockHeight() + 5, ctx.BlockHeight() + 10, 0}
+	for i, grantee := range grantees {
+		require.NoError(t, k.GrantFileAccess(ctx,
+			types.NewMsgGrantFileAccess(uploader.String(), testFileHash, grantee.String(), expiryHeights[i], sdkmath.ZeroInt())))
+	}
+
+	isGranted := func(grantee sdk.AccAddress) bool {
+		_, found := k.GetFileAccessGrant(ctx, testFileHash, grantee)
+		return found
+	}
+
+	// nothing expires before its expiry height
+	k.PruneExpiredFileAccessGrants(ctx.WithBlockHeight(expiryHeights[0] - 1))
+	require.True(t, isGranted(grantees[0]))
+
+	// a grant is pruned at its expiry height
+	k.PruneExpiredFileAccessGrants(ctx.WithBlockHeight(expiryHeights[0]))
+	require.False(t, isGranted(grantees[0]))
+	require.True(t, isGranted(grantees[1]))
+
+	// grants missed at their expiry height are pruned later, grants without expiry are kept
+	k.PruneExpiredFileAccessGrants(ctx.WithBlockHeight(expiryHeights[1] + 100))
+	require.False(t, isGranted(grantees[1]))
+	require.True(t, isGranted(grantees[2]))
+}
+
+func TestDeleteFileRemovesFileAccessGrants(t *testing.T) {
+	stApp, ctx := setupApp(t)
+	k := stApp.GetSdsKeeper()
+	uploader := user(0)
+	uploadFile(ctx, k, testFileHash, uploader)
+	otherFileHash := testFileHash[1:] + "0"
+	uploadFile(ctx, k, otherFileHash, uploader)
+
+	expiryHeight := ctx.BlockHeight() + 10
+	for _, fileHash := range []string{testFileHash, otherFileHash} {
+		require.NoError(t, k.GrantFileAccess(ctx,
+			types.NewMsgGrantFileAccess(uploader.String(), fileHash, user(1).String(), expiryHeight, sdkmath.ZeroInt())))
+		require.NoError(t, k.GrantFileAccess(ctx,
+			types.NewMsgGrantFileAccess(uploader.String(), fileHash, resOwner(0).String(), 0, sdkmath.ZeroInt())))
+	}
+
+	_, err := k.DeleteFile(ctx, types.NewMsgDeleteFile(uploader.String(), testFileHash))
+	require.NoError(t, err)
+
+	// only the grants of the deleted file are removed, with their expiry queue entries
+	var fileHashes []string
+	k.IterateFileAccessGrants(ctx, func(grant types.FileAccessGrant) (stop bool) {
+		fileHashes = append(fileHashes, grant.GetFileHash())
+		return false
+	})
+	require.Equal(t, []string{otherFileHash, otherFileHash}, fileHashes)
+	store := ctx.KVStore(stApp.GetKey(types.StoreKey))
+	require.False(t, store.Has(types.GetFileAccessExpiryQueueKey(expiryHeight, testFileHash, user(1))))
+}
@@ -1,8 +1,11 @@
 package keeper
 
 import (
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	stratos "github.com/stratosnet/stratos-chain/types"
+
 	"github.com/stratosnet/stratos-chain/x/sds/types"
 )
 
@@ -13,6 +16,56 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) {
 
 	for _, file := range data.GetFiles() {
 		k.SetFileInfo(ctx, []byte(file.FileHash), file.GetFileInfo())
+		if file.GetFileInfo().IsChallengeable() {
+			k.AddChallengeableFile(ctx, file.FileHash)
+		}
+	}
+
+	for _, subscription := range data.GetPrepaySubscriptions() {
+		k.SetPrepaySubscription(ctx, subscription)
+	}
+	if data.GetNextPrepaySubscriptionId() > 0 {
+		k.SetNextPrepaySubscriptionId(ctx, data.GetNextPrepaySubscriptionId())
+	}
+
+	for _, snapshot := range data.GetNozPriceHistory() {
+		k.AppendNozPriceSnapshot(ctx, snapshot, uint64(len(data.GetNozPriceHistory())))
+	}
+
+	for _, balance := range data.GetNozBalances() {
+		k.MintNoz(ctx, sdk.MustAccAddressFromBech32(balance.GetOwner()), balance.Amount)
+	}
+	for _, allowance := range data.GetNozAllowances() {
+		k.SetNozAllowance(ctx, sdk.MustAccAddressFromBech32(allowance.GetOwner()),
+			sdk.MustAccAddressFromBech32(allowance.GetSpender()), allowance.Amount)
+	}
+
+	for _, grant := range data.GetFileAccessGrants() {
+		k.SetFileAccessGrant(ctx, grant)
+	}
+
+	for _, challenge := range data.GetStorageChallenges() {
+		k.SetStorageChallenge(ctx, challenge)
+	}
+	if data.GetNextStorageChallengeId() > 0 {
+		k.SetNextStorageChallengeId(ctx, data.GetNextStorageChallengeId())
+	}
+	for _, failure := range data.GetStorageChallengeFailures() {
+		networkAddr, err := stratos.SdsAddressFromBech32(failure.GetNetworkAddress())
+		if err != nil {
+			panic(err)
+		}
+		k.SetStorageChallengeFailureCount(ctx, networkAddr, failure.GetCount())
+	}
+
+	for _, rent := range data.GetStorageRents() {
+		// the escrowed noz is part of the total issued noz
+		k.SetStorageRent(ctx, rent)
+		k.SetTotalIssuedNoz(ctx, k.GetTotalIssuedNoz(ctx).Add(rent.Remaining))
+	}
+
+	for _, refundNonce := range data.GetRefundNonces() {
+		k.SetRefundNonce(ctx, sdk.MustAccAddressFromBech32(refundNonce.GetSender()), refundNonce.GetNonce())
 	}
 	return
 }
@@ -29,5 +82,64 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) (data *types.GenesisState) {
 		return false
 	})
 
-	return types.NewGenesisState(params, files)
+	var subscriptions []types.PrepaySubscription
+	k.IteratePrepaySubscriptions(ctx, func(subscription types.PrepaySubscription) (stop bool) {
+		subscriptions = append(subscriptions, subscription)
+		return false
+	})
+
+	var nozPriceHistory []types.NozPriceSnapshot
+	k.IterateNozPriceSnapshots(ctx, false, func(snapshot types.NozPriceSnapshot) (stop bool) {
+		nozPriceHistory = append(nozPriceHistory, snapshot)
+		return false
+	})
+
+	var nozBalances []types.NozBalance
+	k.IterateNozBalances(ctx, func(owner sdk.AccAddress, balance sdkmath.Int) (stop bool) {
+		nozBalances = append(nozBalances, types.NozBalance{Owner: owner.String(), Amount: balance})
+		return false
+	})
+
+	var nozAllowances []types.NozAllowance
+	k.IterateNozAllowances(ctx, func(owner, spender sdk.AccAddress, allowance sdkmath.Int) (stop bool) {
+		nozAllowances = append(nozAllowances, types.NozAllowance{Owner: owner.String(), Spender: spender.String(), Amount: allowance})
+		return false
+	})
+
+	var fileAccessGrants []types.FileAccessGrant
+	k.IterateFileAccessGrants(ctx, func(grant types.FileAccessGrant) (stop bool) {
+		fileAccessGrants = append(fileAccessGrants, grant)
+		return false
+	})
+
+	var storageChallenges []types.StorageChallenge
+	k.IterateStorageChallenges(ctx, func(challenge types.StorageChallenge) (stop bool) {
+		storageChallenges = append(storageChallenges, challenge)
+		return false
+	})
+
+	var storageChallengeFailures []types.StorageChallengeFailure
+	k.IterateStorageChallengeFailures(ctx, func(networkAddr stratos.SdsAddress, count uint64) (stop bool) {
+		storageChallengeFailures = append(storageChallengeFailures, types.StorageChallengeFailure{
+			NetworkAddress: networkAddr.String(),
+			Count:          count,
+		})
+		return false
+	})
+
+	var storageRents []types.StorageRent
+	k.IterateStorageRents(ctx, func(rent types.StorageRent) (stop bool) {
+		storageRents = append(storageRents, rent)
+		return false
+	})
+
+	var refundNonces []types.RefundNonce
+	k.IterateRefundNonces(ctx, func(sender sdk.AccAddress, nonce uint64) (stop bool) {
+		refundNonces = append(refundNonces, types.RefundNonce{Sender: sender.String(), Nonce: nonce})
+		return false
+	})
+
+	return types.NewGenesisState(params, files, subscriptions, k.GetNextPrepaySubscriptionId(ctx), nozPriceHistory,
+		nozBalances, nozAllowances, fileAccessGrants, storageChallenges, k.GetNextStorageChallengeId(ctx),
+		storageChallengeFailures, storageRents, refundNonces)
 }
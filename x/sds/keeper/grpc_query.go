@@ -8,8 +8,12 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
+	stratos "github.com/stratosnet/stratos-chain/types"
 	"github.com/stratosnet/stratos-chain/x/sds/types"
 )
 
@@ -61,6 +65,26 @@ func (q Querier) SimPrepay(c context.Context, request *types.QuerySimPrepayReque
 	return &types.QuerySimPrepayResponse{Noz: noz}, nil
 }
 
+func (q Querier) SimRefund(c context.Context, request *types.QuerySimRefundRequest) (*types.QuerySimRefundResponse, error) {
+	if request == nil {
+		return &types.QuerySimRefundResponse{}, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if request.GetAmount() == "" {
+		return &types.QuerySimRefundResponse{}, status.Error(codes.InvalidArgument, "Amount cannot be empty")
+	}
+	amount, ok := sdkmath.NewIntFromString(request.GetAmount())
+	if !ok || !amount.IsPositive() {
+		return &types.QuerySimRefundResponse{}, status.Error(codes.InvalidArgument, "Amount invalid")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	refunded, fee, err := q.simulateRefundNoz(ctx, amount)
+	if err != nil {
+		return &types.QuerySimRefundResponse{}, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return &types.QuerySimRefundResponse{Refunded: refunded, Fee: fee}, nil
+}
+
 func (q Querier) NozPrice(c context.Context, _ *types.QueryNozPriceRequest) (*types.QueryNozPriceResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 	St, Pt, Lt := q.registerKeeper.GetCurrNozPriceParams(ctx)
@@ -79,3 +103,191 @@ func (q Querier) Params(c context.Context, _ *types.QueryParamsRequest) (*types.
 	params := q.GetParams(ctx)
 	return &types.QueryParamsResponse{Params: &params}, nil
 }
+
+func (q Querier) PrepaySubscriptions(c context.Context, req *types.QueryPrepaySubscriptionsRequest) (*types.QueryPrepaySubscriptionsResponse, error) {
+	if req == nil {
+		return &types.QueryPrepaySubscriptionsResponse{}, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sender, err := sdk.AccAddressFromBech32(req.GetSender())
+	if err != nil {
+		return &types.QueryPrepaySubscriptionsResponse{}, status.Error(codes.InvalidArgument, "invalid sender address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(q.storeKey)
+	senderStore := prefix.NewStore(store, types.GetPrepaySubscriptionBySenderPrefix(sender))
+
+	subscriptions := make([]types.PrepaySubscription, 0)
+	pageRes, err := query.Paginate(senderStore, req.Pagination, func(key []byte, _ []byte) error {
+		subscription, found := q.GetPrepaySubscription(ctx, sdk.BigEndianToUint64(key))
+		if found {
+			subscriptions = append(subscriptions, subscription)
+		}
+		return nil
+	})
+	if err != nil {
+		return &types.QueryPrepaySubscriptionsResponse{}, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPrepaySubscriptionsResponse{Subscriptions: subscriptions, Pagination: pageRes}, nil
+}
+
+func (q Querier) NozPriceHistory(c context.Context, req *types.QueryNozPriceHistoryRequest) (*types.QueryNozPriceHistoryResponse, error) {
+	if req == nil {
+		return &types.QueryNozPriceHistoryResponse{}, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.GetStartHeight() < 0 || req.GetEndHeight() < 0 {
+		return &types.QueryNozPriceHistoryResponse{}, status.Error(codes.InvalidArgument, "height cannot be negative")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(q.storeKey)
+	snapshotStore := prefix.NewStore(store, types.NozPriceSnapshotKeyPrefix)
+
+	snapshots := make([]types.NozPriceSnapshot, 0)
+	pageRes, err := query.FilteredPaginate(snapshotStore, req.Pagination, func(_ []byte, value []byte, accumulate bool) (bool, error) {
+		var snapshot types.NozPriceSnapshot
+		if err := q.cdc.UnmarshalLengthPrefixed(value, &snapshot); err != nil {
+			return false, err
+		}
+		if snapshot.Height < req.GetStartHeight() || (req.GetEndHeight() > 0 && snapshot.Height > req.GetEndHeight()) {
+			return false, nil
+		}
+		if accumulate {
+			snapshots = append(snapshots, snapshot)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return &types.QueryNozPriceHistoryResponse{}, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryNozPriceHistoryResponse{Snapshots: snapshots, Pagination: pageRes}, nil
+}
+
+func (q Querier) NozPriceTWAP(c context.Context, req *types.QueryNozPriceTWAPRequest) (*types.QueryNozPriceTWAPResponse, error) {
+	if req == nil {
+		return &types.QueryNozPriceTWAPResponse{}, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.GetWindow() <= 0 {
+		return &types.QueryNozPriceTWAPResponse{}, status.Error(codes.InvalidArgument, "window must be positive")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	price, err := q.GetNozPriceTWAP(ctx, req.GetWindow())
+	if err != nil {
+		return &types.QueryNozPriceTWAPResponse{}, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QueryNozPriceTWAPResponse{Price: price}, nil
+}
+
+func (q Querier) NozBalance(c context.Context, req *types.QueryNozBalanceRequest) (*types.QueryNozBalanceResponse, error) {
+	if req == nil {
+		return &types.QueryNozBalanceResponse{}, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	owner, err := sdk.AccAddressFromBech32(req.GetOwner())
+	if err != nil {
+		return &types.QueryNozBalanceResponse{}, status.Error(codes.InvalidArgument, "invalid owner address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryNozBalanceResponse{Balance: q.GetNozBalance(ctx, owner)}, nil
+}
+
+func (q Querier) NozAllowance(c context.Context, req *types.QueryNozAllowanceRequest) (*types.QueryNozAllowanceResponse, error) {
+	if req == nil {
+		return &types.QueryNozAllowanceResponse{}, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	owner, err := sdk.AccAddressFromBech32(req.GetOwner())
+	if err != nil {
+		return &types.QueryNozAllowanceResponse{}, status.Error(codes.InvalidArgument, "invalid owner address")
+	}
+
+	spender, err := sdk.AccAddressFromBech32(req.GetSpender())
+	if err != nil {
+		return &types.QueryNozAllowanceResponse{}, status.Error(codes.InvalidArgument, "invalid spender address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryNozAllowanceResponse{Allowance: q.GetNozAllowance(ctx, owner, spender)}, nil
+}
+
+func (q Querier) FileAccess(c context.Context, req *types.QueryFileAccessRequest) (*types.QueryFileAccessResponse, error) {
+	if req == nil {
+		return &types.QueryFileAccessResponse{}, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	_, err := cid.Decode(req.GetFileHash())
+	if err != nil {
+		return &types.QueryFileAccessResponse{}, status.Error(codes.InvalidArgument, "invalid file hash")
+	}
+
+	grantee, err := sdk.AccAddressFromBech32(req.GetGrantee())
+	if err != nil {
+		return &types.QueryFileAccessResponse{}, status.Error(codes.InvalidArgument, "invalid grantee address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	grant, found := q.GetFileAccessGrant(ctx, req.GetFileHash(), grantee)
+	if !found {
+		return &types.QueryFileAccessResponse{HasAccess: false}, nil
+	}
+
+	return &types.QueryFileAccessResponse{HasAccess: q.IsFileAccessGrantActive(ctx, grant), Grant: &grant}, nil
+}
+
+func (q Querier) StorageRent(c context.Context, req *types.QueryStorageRentRequest) (*types.QueryStorageRentResponse, error) {
+	if req == nil {
+		return &types.QueryStorageRentResponse{}, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	_, err := cid.Decode(req.GetFileHash())
+	if err != nil {
+		return &types.QueryStorageRentResponse{}, status.Error(codes.InvalidArgument, "invalid file hash")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	rent, found := q.GetStorageRent(ctx, req.GetFileHash())
+	if !found {
+		return &types.QueryStorageRentResponse{}, status.Error(codes.NotFound, types.ErrNoStorageRentFound.Error())
+	}
+
+	return &types.QueryStorageRentResponse{Rent: &rent}, nil
+}
+
+func (q Querier) StorageChallenges(c context.Context, req *types.QueryStorageChallengesRequest) (*types.QueryStorageChallengesResponse, error) {
+	if req == nil {
+		return &types.QueryStorageChallengesResponse{}, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	networkAddr, err := stratos.SdsAddressFromBech32(req.GetNetworkAddress())
+	if err != nil {
+		return &types.QueryStorageChallengesResponse{}, status.Error(codes.InvalidArgument, "invalid network address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(q.storeKey)
+	nodeStore := prefix.NewStore(store, types.GetStorageChallengeByNodePrefix(networkAddr))
+
+	challenges := make([]types.StorageChallenge, 0)
+	pageRes, err := query.Paginate(nodeStore, req.Pagination, func(key []byte, _ []byte) error {
+		challenge, found := q.GetStorageChallenge(ctx, sdk.BigEndianToUint64(key))
+		if found {
+			challenges = append(challenges, challenge)
+		}
+		return nil
+	})
+	if err != nil {
+		return &types.QueryStorageChallengesResponse{}, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryStorageChallengesResponse{
+		Challenges:   challenges,
+		FailureCount: q.GetStorageChallengeFailureCount(ctx, networkAddr),
+		Pagination:   pageRes,
+	}, nil
+}
@@ -0,0 +1,84 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/stratosnet/stratos-chain/x/sds/types"
+)
+
+// RegisterInvariants registers all sds invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "noz-ledger-supply", NozLedgerSupplyInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "noz-ledger-non-negative", NozLedgerNonNegativeInvariant(k))
+}
+
+// AllInvariants runs all invariants of the sds module.
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		res, stop := NozLedgerSupplyInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		return NozLedgerNonNegativeInvariant(k)(ctx)
+	}
+}
+
+// NozLedgerSupplyInvariant checks that the total noz held in the ledger balances and the storage rent escrows
+// equals the ozone the register module issued by prepay and has not got back through refunds or consumption,
+// as well as the noz issued by the ledger itself
+func NozLedgerSupplyInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		totalBalances := sdkmath.ZeroInt()
+		k.IterateNozBalances(ctx, func(_ sdk.AccAddress, balance sdkmath.Int) (stop bool) {
+			totalBalances = totalBalances.Add(balance)
+			return false
+		})
+		totalEscrowed := sdkmath.ZeroInt()
+		k.IterateStorageRents(ctx, func(rent types.StorageRent) (stop bool) {
+			totalEscrowed = totalEscrowed.Add(rent.Remaining)
+			return false
+		})
+		totalLedger := totalBalances.Add(totalEscrowed)
+		prepaidOzone := k.registerKeeper.GetPrepaidOzone(ctx)
+		totalIssued := k.GetTotalIssuedNoz(ctx)
+
+		broken := !totalLedger.Equal(prepaidOzone) || !totalLedger.Equal(totalIssued)
+		return sdk.FormatInvariant(types.ModuleName, "noz-ledger-supply", fmt.Sprintf(
+			"\tsum of noz balances: %v\n"+
+				"\tsum of storage rent escrows: %v\n"+
+				"\tprepaid ozone of register: %v\n"+
+				"\ttotal issued noz: %v\n", totalBalances, totalEscrowed, prepaidOzone, totalIssued)), broken
+	}
+}
+
+// NozLedgerNonNegativeInvariant checks that all noz balances and allowances are positive
+func NozLedgerNonNegativeInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg   string
+			count int
+		)
+
+		k.IterateNozBalances(ctx, func(owner sdk.AccAddress, balance sdkmath.Int) (stop bool) {
+			if !balance.IsPositive() {
+				count++
+				msg += fmt.Sprintf("\tnoz balance of %s is not positive: %v\n", owner.String(), balance)
+			}
+			return false
+		})
+		k.IterateNozAllowances(ctx, func(owner, spender sdk.AccAddress, allowance sdkmath.Int) (stop bool) {
+			if !allowance.IsPositive() {
+				count++
+				msg += fmt.Sprintf("\tnoz allowance of %s for %s is not positive: %v\n", owner.String(), spender.String(), allowance)
+			}
+			return false
+		})
+
+		broken := count != 0
+		return sdk.FormatInvariant(types.ModuleName, "noz-ledger-non-negative", fmt.Sprintf(
+			"found %d invalid noz ledger entries\n%s", count, msg)), broken
+	}
+}
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"bytes"
+
 	"github.com/kelindar/bitmap"
 
 	"github.com/cometbft/cometbft/libs/log"
@@ -11,6 +13,9 @@ import (
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/stratosnet/stratos-chain/crypto"
 	stratos "github.com/stratosnet/stratos-chain/types"
 	registertypes "github.com/stratosnet/stratos-chain/x/register/types"
 	"github.com/stratosnet/stratos-chain/x/sds/types"
@@ -22,6 +27,7 @@ type Keeper struct {
 	storeKey       storetypes.StoreKey
 	cdc            codec.Codec
 	bankKeeper     types.BankKeeper
+	distrKeeper    types.DistrKeeper
 	registerKeeper types.RegisterKeeper
 	potKeeper      types.PotKeeper
 
@@ -37,6 +43,7 @@ func NewKeeper(
 	cdc codec.Codec,
 	storeKey storetypes.StoreKey,
 	bankKeeper types.BankKeeper,
+	distrKeeper types.DistrKeeper,
 	registerKeeper types.RegisterKeeper,
 	potKeeper types.PotKeeper,
 	authority string,
@@ -45,6 +52,7 @@ func NewKeeper(
 		storeKey:       storeKey,
 		cdc:            cdc,
 		bankKeeper:     bankKeeper,
+		distrKeeper:    distrKeeper,
 		registerKeeper: registerKeeper,
 		potKeeper:      potKeeper,
 		authority:      authority,
@@ -56,7 +64,8 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+types.ModuleName)
 }
 
-func (k Keeper) FileUpload(ctx sdk.Context, fileHash string, reporter stratos.SdsAddress, reporterOwner, uploader sdk.AccAddress) (err error) {
+func (k Keeper) FileUpload(ctx sdk.Context, fileHash string, reporter stratos.SdsAddress, reporterOwner, uploader sdk.AccAddress,
+	segmentRoot []byte, segmentCount uint64, storageNodes []string) (err error) {
 	if !(k.registerKeeper.OwnMetaNode(ctx, reporterOwner, reporter)) {
 		return types.ErrReporterAddressOrOwner
 	}
@@ -73,9 +82,16 @@ func (k Keeper) FileUpload(ctx sdk.Context, fileHash string, reporter stratos.Sd
 	fileUploadReporters.Set(uint32(reporterIndex))
 	height := sdkmath.NewInt(ctx.BlockHeight())
 
-	newFileInfo := types.NewFileInfo(height, fileUploadReporters.ToBytes(), uploader.String())
+	// keep the segment root reported first, the storage challenges are based on it
+	if found && len(fileInfo.GetSegmentRoot()) > 0 {
+		segmentRoot, segmentCount, storageNodes = fileInfo.GetSegmentRoot(), fileInfo.GetSegmentCount(), fileInfo.GetStorageNodes()
+	}
+	newFileInfo := types.NewFileInfo(height, fileUploadReporters.ToBytes(), uploader.String(), segmentRoot, segmentCount, storageNodes)
 
 	k.SetFileInfo(ctx, []byte(fileHash), newFileInfo)
+	if newFileInfo.IsChallengeable() && !fileInfo.IsChallengeable() {
+		k.AddChallengeableFile(ctx, fileHash)
+	}
 
 	return nil
 }
@@ -86,7 +102,7 @@ func (k Keeper) FileUpload(ctx sdk.Context, fileHash string, reporter stratos.Sd
 // [X] is the total amount of STOS token prepaid by user at time t
 // the total amount of Ozone the user gets = Lt * X / (S + Pt + X)
 func (k Keeper) purchaseNozAndSubCoins(ctx sdk.Context, from sdk.AccAddress, amount sdkmath.Int) (sdkmath.Int, error) {
-	purchased, newRemainingOzoneLimit, err := k.registerKeeper.CalculatePurchaseAmount(ctx, amount)
+	purchased, _, err := k.registerKeeper.CalculatePurchaseAmount(ctx, amount)
 	if err != nil {
 		return sdkmath.ZeroInt(), err
 	}
@@ -97,8 +113,11 @@ func (k Keeper) purchaseNozAndSubCoins(ctx sdk.Context, from sdk.AccAddress, amo
 		return sdkmath.ZeroInt(), err
 	}
 
-	// update remaining noz limit
-	k.registerKeeper.SetRemainingOzoneLimit(ctx, newRemainingOzoneLimit)
+	// take the purchased noz out of the remaining ozone limit
+	err = k.registerKeeper.IssuePrepaidOzone(ctx, purchased)
+	if err != nil {
+		return sdkmath.ZeroInt(), err
+	}
 
 	return purchased, nil
 }
@@ -119,12 +138,108 @@ func (k Keeper) simulatePurchaseNoz(ctx sdk.Context, coins sdk.Coins) sdkmath.In
 }
 
 // Prepay transfers coins from bank to sds (volume) pool
-func (k Keeper) Prepay(ctx sdk.Context, sender sdk.AccAddress, coins sdk.Coins) (sdkmath.Int, error) {
+// Prepay purchases noz with the coins of sender and credits it to the noz ledger balance of beneficiary
+func (k Keeper) Prepay(ctx sdk.Context, sender, beneficiary sdk.AccAddress, coins sdk.Coins) (sdkmath.Int, error) {
 	validPrepayAmt := coins.AmountOf(k.BondDenom(ctx))
 	hasCoin := k.bankKeeper.HasBalance(ctx, sender, sdk.NewCoin(k.BondDenom(ctx), validPrepayAmt))
 	if !hasCoin {
 		return sdkmath.ZeroInt(), errors.Wrapf(sdkerrors.ErrInsufficientFunds, "Insufficient balance in the acc %s", sender.String())
 	}
 
-	return k.purchaseNozAndSubCoins(ctx, sender, validPrepayAmt)
+	purchased, err := k.purchaseNozAndSubCoins(ctx, sender, validPrepayAmt)
+	if err != nil {
+		return sdkmath.ZeroInt(), err
+	}
+
+	k.MintNoz(ctx, beneficiary, purchased)
+	return purchased, nil
+}
+
+// Refunding [N] ozone inverts the prepay formula: a prepay of [X] at the state before purchase (Pt - X, Lt + N) gives
+// N = (Lt + N) * X / (S + Pt), so the amount of STOS refunded for N ozone is X = N * (S + Pt) / (Lt + N)
+func (k Keeper) calculateRefund(ctx sdk.Context, nozAmt sdkmath.Int) (refund, fee sdkmath.Int, err error) {
+	St := k.registerKeeper.GetEffectiveTotalDeposit(ctx)
+	Pt := k.registerKeeper.GetTotalUnissuedPrepay(ctx).Amount
+	Lt := k.registerKeeper.GetRemainingOzoneLimit(ctx)
+
+	refund = nozAmt.ToLegacyDec().
+		Mul(St.Add(Pt).ToLegacyDec()).
+		Quo(Lt.Add(nozAmt).ToLegacyDec()).
+		TruncateInt()
+	if refund.GT(Pt) {
+		return sdkmath.ZeroInt(), sdkmath.ZeroInt(), errors.Wrapf(types.ErrInsufficientUnissuedPrepay,
+			"refund %s is greater than unissued prepay %s", refund, Pt)
+	}
+
+	fee = k.GetParams(ctx).NozRefundFeeRate.MulInt(refund).TruncateInt()
+	return refund.Sub(fee), fee, nil
+}
+
+func (k Keeper) simulateRefundNoz(ctx sdk.Context, nozAmt sdkmath.Int) (refunded, fee sdk.Coin, err error) {
+	refundAmt, feeAmt, err := k.calculateRefund(ctx, nozAmt)
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+	return sdk.NewCoin(k.BondDenom(ctx), refundAmt), sdk.NewCoin(k.BondDenom(ctx), feeAmt), nil
+}
+
+// verifyRefundAttestation verifies that the meta nodes attested, with the current refund nonce of sender,
+// that the refunded noz has not been consumed by traffic
+func (k Keeper) verifyRefundAttestation(ctx sdk.Context, msg *types.MsgRefundNoz, sender sdk.AccAddress) error {
+	if msg.GetNonce() != k.GetRefundNonce(ctx, sender) {
+		return errors.Wrapf(types.ErrInvalidRefundNonce, "expected nonce %d, got %d", k.GetRefundNonce(ctx, sender), msg.GetNonce())
+	}
+
+	blsSignature := msg.GetBLSSignature()
+	if !bytes.Equal(crypto.Keccak256(msg.GetBLSSignBytes()), blsSignature.GetTxData()) {
+		return types.ErrBLSTxDataInvalid
+	}
+	return k.registerKeeper.VerifyMetaNodeThresholdSignature(ctx, blsSignature.GetTxData(), blsSignature.GetSignature(),
+		blsSignature.GetPubKeys())
+}
+
+// RefundNoz burns the unused noz of sender attested by the meta nodes and returns the STOS from the total
+// unissued prepay pool, the refund fee is sent to the community pool and the ozone limit is restored
+func (k Keeper) RefundNoz(ctx sdk.Context, msg *types.MsgRefundNoz) (refunded, fee sdk.Coin, err error) {
+	sender, err := sdk.AccAddressFromBech32(msg.GetSender())
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, errors.Wrap(types.ErrInvalidSenderAddr, err.Error())
+	}
+	nozAmt := msg.Amount
+	if nozAmt.IsNil() || !nozAmt.IsPositive() {
+		return sdk.Coin{}, sdk.Coin{}, errors.Wrapf(types.ErrInvalidNozAmount, "amount %s", nozAmt)
+	}
+
+	err = k.verifyRefundAttestation(ctx, msg, sender)
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+	k.SetRefundNonce(ctx, sender, msg.GetNonce()+1)
+
+	refunded, fee, err = k.simulateRefundNoz(ctx, nozAmt)
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+
+	err = k.BurnNoz(ctx, sender, nozAmt)
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+	k.registerKeeper.ReturnPrepaidOzone(ctx, nozAmt)
+
+	if refunded.IsPositive() {
+		err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, registertypes.TotalUnissuedPrepay, sender, sdk.NewCoins(refunded))
+		if err != nil {
+			return sdk.Coin{}, sdk.Coin{}, err
+		}
+	}
+	if fee.IsPositive() {
+		prepayAccAddr := authtypes.NewModuleAddress(registertypes.TotalUnissuedPrepay)
+		err = k.distrKeeper.FundCommunityPool(ctx, sdk.NewCoins(fee), prepayAccAddr)
+		if err != nil {
+			return sdk.Coin{}, sdk.Coin{}, err
+		}
+	}
+
+	return refunded, fee, nil
 }
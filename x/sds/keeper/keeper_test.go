@@ -0,0 +1,183 @@
+package keeper_test
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	tmtypes "github.com/cometbft/cometbft/types"
+
+	sdkmath "cosmossdk.io/math"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	stratosapp "github.com/stratosnet/stratos-chain/app"
+	"github.com/stratosnet/stratos-chain/crypto/bls"
+	stratostestutil "github.com/stratosnet/stratos-chain/testutil"
+	stratos "github.com/stratosnet/stratos-chain/types"
+	registertypes "github.com/stratosnet/stratos-chain/x/register/types"
+)
+
+const (
+	chainID = "testchain_1-1"
+
+	resourceNodeCount = 3
+	metaNodeCount     = 3
+	userCount         = 2
+)
+
+var (
+	nodeInitialDeposit = sdkmath.NewInt(1 * stratos.StosToWei)
+	initBalance        = sdkmath.NewInt(100).MulRaw(stratos.StosToWei)
+	unissuedPrepay     = sdkmath.NewInt(1e3).MulRaw(stratos.StosToWei)
+
+	resOwnerPrivKeys  = genPrivKeys(resourceNodeCount)
+	metaOwnerPrivKeys = genPrivKeys(metaNodeCount)
+	userPrivKeys      = genPrivKeys(userCount)
+
+	resNodeP2PPubKeys  = genP2PPubKeys(resourceNodeCount)
+	metaNodeP2PPubKeys = genP2PPubKeys(metaNodeCount)
+
+	metaNodeBLSPrivKeys, metaNodeBLSPubKeys = genBLSKeyPairs(metaNodeCount)
+
+	valConsPubKey = ed25519.GenPrivKey().PubKey()
+)
+
+func TestMain(m *testing.M) {
+	config := stratos.GetConfig()
+	config.Seal()
+	exitVal := m.Run()
+	os.Exit(exitVal)
+}
+
+func genPrivKeys(count int) []*secp256k1.PrivKey {
+	privKeys := make([]*secp256k1.PrivKey, count)
+	for i := range privKeys {
+		privKeys[i] = secp256k1.GenPrivKey()
+	}
+	return privKeys
+}
+
+func genP2PPubKeys(count int) []*ed25519.PubKey {
+	pubKeys := make([]*ed25519.PubKey, count)
+	for i := range pubKeys {
+		pubKeys[i] = ed25519.GenPrivKey().PubKey().(*ed25519.PubKey)
+	}
+	return pubKeys
+}
+
+func genBLSKeyPairs(count int) (privKeys, pubKeys [][]byte) {
+	privKeys = make([][]byte, count)
+	pubKeys = make([][]byte, count)
+	for i := range privKeys {
+		privKey, pubKey, err := bls.NewKeyPair()
+		if err != nil {
+			panic(err)
+		}
+		privKeys[i], pubKeys[i] = privKey, pubKey
+	}
+	return privKeys, pubKeys
+}
+
+func resOwner(i int) sdk.AccAddress {
+	return sdk.AccAddress(resOwnerPrivKeys[i].PubKey().Address())
+}
+
+func metaOwner(i int) sdk.AccAddress {
+	return sdk.AccAddress(metaOwnerPrivKeys[i].PubKey().Address())
+}
+
+func user(i int) sdk.AccAddress {
+	return sdk.AccAddress(userPrivKeys[i].PubKey().Address())
+}
+
+func resNodeP2PAddr(i int) stratos.SdsAddress {
+	return stratos.SdsAddress(resNodeP2PPubKeys[i].Address())
+}
+
+func metaNodeP2PAddr(i int) stratos.SdsAddress {
+	return stratos.SdsAddress(metaNodeP2PPubKeys[i].Address())
+}
+
+// setupApp starts a chain with bonded resource and meta nodes holding BLS keys, funded user accounts and unissued prepay,
+// and returns the context of the next block
+func setupApp(t *testing.T) (*stratosapp.StratosApp, sdk.Context) {
+	consPubKey, err := cryptocodec.ToTmPubKeyInterface(valConsPubKey)
+	require.NoError(t, err)
+	validator := tmtypes.NewValidator(consPubKey, 1)
+	valSet := tmtypes.NewValidatorSet([]*tmtypes.Validator{validator})
+
+	accs, balances := setupAccounts()
+	stApp := stratostestutil.SetupWithGenesisNodeSet(t, valSet, setupMetaNodes(), setupResourceNodes(), accs, chainID,
+		false, balances...)
+
+	header := tmproto.Header{Height: stApp.LastBlockHeight() + 1, ChainID: chainID}
+	stApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+	ctx := stApp.BaseApp.NewContext(false, header)
+	return stApp, ctx
+}
+
+func setupAccounts() ([]authtypes.GenesisAccount, []banktypes.Balance) {
+	var accs []authtypes.GenesisAccount
+	var balances []banktypes.Balance
+	addAccount := func(addr sdk.AccAddress) {
+		accs = append(accs, &authtypes.BaseAccount{Address: addr.String()})
+		balances = append(balances, banktypes.Balance{Address: addr.String(), Coins: sdk.Coins{stratos.NewCoin(initBalance)}})
+	}
+	for i := 0; i < resourceNodeCount; i++ {
+		addAccount(resOwner(i))
+	}
+	for i := 0; i < metaNodeCount; i++ {
+		addAccount(metaOwner(i))
+	}
+	for i := 0; i < userCount; i++ {
+		addAccount(user(i))
+	}
+
+	balances = append(balances, banktypes.Balance{
+		Address: authtypes.NewModuleAddress(registertypes.TotalUnissuedPrepay).String(),
+		Coins:   sdk.Coins{stratos.NewCoin(unissuedPrepay)},
+	})
+	return accs, balances
+}
+
+func setupResourceNodes() []registertypes.ResourceNode {
+	createTime, _ := time.Parse(time.RubyDate, "Fri Sep 24 10:37:13 -0400 2021")
+
+	var resourceNodes []registertypes.ResourceNode
+	for i := 0; i < resourceNodeCount; i++ {
+		resourceNode, _ := registertypes.NewResourceNode(resNodeP2PAddr(i), resNodeP2PPubKeys[i], resOwner(i), resOwner(i),
+			registertypes.NewDescription("resourceNode", "", "", "", ""), registertypes.STORAGE, createTime)
+		resourceNode = resourceNode.AddToken(nodeInitialDeposit)
+		resourceNode.EffectiveTokens = nodeInitialDeposit
+		resourceNode.Status = stakingtypes.Bonded
+		resourceNode.Suspend = false
+		resourceNodes = append(resourceNodes, resourceNode)
+	}
+	return resourceNodes
+}
+
+func setupMetaNodes() []registertypes.MetaNode {
+	createTime, _ := time.Parse(time.RubyDate, "Fri Sep 24 10:37:13 -0400 2021")
+
+	var metaNodes []registertypes.MetaNode
+	for i := 0; i < metaNodeCount; i++ {
+		metaNode, _ := registertypes.NewMetaNode(metaNodeP2PAddr(i), metaNodeP2PPubKeys[i], metaOwner(i), metaOwner(i),
+			registertypes.NewDescription("metaNode", "", "", "", ""), createTime)
+		metaNode = metaNode.AddToken(nodeInitialDeposit)
+		metaNode.Status = stakingtypes.Bonded
+		metaNode.Suspend = false
+		metaNode.BlsPubKey = metaNodeBLSPubKeys[i]
+		metaNodes = append(metaNodes, metaNode)
+	}
+	return metaNodes
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	v011 "github.com/stratosnet/stratos-chain/x/sds/legacy/v011"
+	v012 "github.com/stratosnet/stratos-chain/x/sds/legacy/v012"
 	"github.com/stratosnet/stratos-chain/x/sds/types"
 )
 
@@ -21,3 +22,18 @@ func NewMigrator(keeper Keeper, legacySubspace types.ParamsSubspace) Migrator {
 func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 	return v011.MigrateStore(ctx, m.keeper.storeKey, m.legacySubspace, m.keeper.cdc)
 }
+
+// Migrate2to3 migrates from version 2 to 3.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	return v012.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc)
+}
+
+// Migrate3to4 migrates from version 3 to 4.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	return v012.MigrateStorageChallengeParams(ctx, m.keeper.storeKey, m.keeper.cdc)
+}
+
+// Migrate4to5 migrates from version 4 to 5.
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	return v012.MigrateNozRefundParams(ctx, m.keeper.storeKey, m.keeper.cdc)
+}
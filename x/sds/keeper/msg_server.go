@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"strconv"
 
 	"cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -42,7 +43,8 @@ func (k msgServer) HandleMsgFileUpload(c context.Context, msg *types.MsgFileUplo
 		return &types.MsgFileUploadResponse{}, errors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
 	}
 
-	err = k.FileUpload(ctx, msg.GetFileHash(), reporter, reporterOwner, uploader)
+	err = k.FileUpload(ctx, msg.GetFileHash(), reporter, reporterOwner, uploader, msg.GetSegmentRoot(), msg.GetSegmentCount(),
+		msg.GetStorageNodes())
 	if err != nil {
 		return &types.MsgFileUploadResponse{}, err
 	}
@@ -59,6 +61,30 @@ func (k msgServer) HandleMsgFileUpload(c context.Context, msg *types.MsgFileUplo
 		return nil, errors.Wrap(types.ErrEmitEvent, err.Error())
 	}
 
+	// the rent is locked by the first report of the upload, the reports of the other meta nodes do not lock it again
+	if _, found := k.GetStorageRent(ctx, msg.GetFileHash()); !msg.HasStorageRent() || found {
+		return &types.MsgFileUploadResponse{}, nil
+	}
+
+	err = k.CreateStorageRent(ctx, msg.GetFileHash(), reporterOwner, uploader, msg.RentAmount, msg.GetRentDuration(),
+		msg.GetRentReplicas())
+	if err != nil {
+		return &types.MsgFileUploadResponse{}, err
+	}
+
+	err = ctx.EventManager().EmitTypedEvents(
+		&types.EventCreateStorageRent{
+			Uploader: msg.GetUploader(),
+			FileHash: msg.GetFileHash(),
+			Amount:   msg.RentAmount.String(),
+			Duration: strconv.FormatInt(msg.GetRentDuration(), 10),
+			Replicas: strconv.FormatUint(uint64(msg.GetRentReplicas()), 10),
+		},
+	)
+	if err != nil {
+		return nil, errors.Wrap(types.ErrEmitEvent, err.Error())
+	}
+
 	return &types.MsgFileUploadResponse{}, nil
 }
 
@@ -71,16 +97,27 @@ func (k msgServer) HandleMsgPrepay(c context.Context, msg *types.MsgPrepay) (*ty
 		return &types.MsgPrepayResponse{}, errors.Wrap(types.ErrInvalidSenderAddr, err.Error())
 	}
 
-	_, err = sdk.AccAddressFromBech32(msg.GetBeneficiary())
+	beneficiary, err := sdk.AccAddressFromBech32(msg.GetBeneficiary())
 	if err != nil {
 		return &types.MsgPrepayResponse{}, errors.Wrap(types.ErrInvalidBeneficiaryAddr, err.Error())
 	}
 
-	purchased, err := k.Prepay(ctx, sender, msg.GetAmount())
+	if msg.GetDeadlineHeight() > 0 && ctx.BlockHeight() > msg.GetDeadlineHeight() {
+		return nil, errors.Wrapf(types.ErrPrepayDeadlineExceeded, "current height %d is beyond deadline height %d",
+			ctx.BlockHeight(), msg.GetDeadlineHeight())
+	}
+
+	purchased, err := k.Prepay(ctx, sender, beneficiary, msg.GetAmount())
 	if err != nil {
 		return nil, errors.Wrap(types.ErrPrepayFailure, err.Error())
 	}
 
+	// the whole tx is reverted when the purchased amount falls below the requested minimum
+	if !msg.MinNozOut.IsNil() && purchased.LT(msg.MinNozOut) {
+		return nil, errors.Wrapf(types.ErrPrepaySlippageExceeded, "purchased noz %s, min noz out %s",
+			purchased.String(), msg.MinNozOut.String())
+	}
+
 	err = ctx.EventManager().EmitTypedEvents(
 		&types.EventPrePay{
 			Sender:       msg.GetSender(),
@@ -96,6 +133,295 @@ func (k msgServer) HandleMsgPrepay(c context.Context, msg *types.MsgPrepay) (*ty
 	return &types.MsgPrepayResponse{}, nil
 }
 
+// HandleMsgCreatePrepaySubscription Handles MsgCreatePrepaySubscription.
+func (k msgServer) HandleMsgCreatePrepaySubscription(c context.Context, msg *types.MsgCreatePrepaySubscription) (*types.MsgCreatePrepaySubscriptionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	_, err := sdk.AccAddressFromBech32(msg.GetSender())
+	if err != nil {
+		return &types.MsgCreatePrepaySubscriptionResponse{}, errors.Wrap(types.ErrInvalidSenderAddr, err.Error())
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.GetBeneficiary())
+	if err != nil {
+		return &types.MsgCreatePrepaySubscriptionResponse{}, errors.Wrap(types.ErrInvalidBeneficiaryAddr, err.Error())
+	}
+
+	subscription, err := k.CreatePrepaySubscription(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ctx.EventManager().EmitTypedEvents(
+		&types.EventCreatePrepaySubscription{
+			Sender:         msg.GetSender(),
+			Beneficiary:    msg.GetBeneficiary(),
+			SubscriptionId: strconv.FormatUint(subscription.GetId(), 10),
+			Amount:         msg.GetAmount().String(),
+			Interval:       strconv.FormatInt(msg.GetInterval(), 10),
+		},
+	)
+	if err != nil {
+		return nil, errors.Wrap(types.ErrEmitEvent, err.Error())
+	}
+
+	return &types.MsgCreatePrepaySubscriptionResponse{SubscriptionId: subscription.GetId()}, nil
+}
+
+// HandleMsgCancelPrepaySubscription Handles MsgCancelPrepaySubscription.
+func (k msgServer) HandleMsgCancelPrepaySubscription(c context.Context, msg *types.MsgCancelPrepaySubscription) (*types.MsgCancelPrepaySubscriptionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	sender, err := sdk.AccAddressFromBech32(msg.GetSender())
+	if err != nil {
+		return &types.MsgCancelPrepaySubscriptionResponse{}, errors.Wrap(types.ErrInvalidSenderAddr, err.Error())
+	}
+
+	err = k.CancelPrepaySubscription(ctx, sender, msg.GetSubscriptionId())
+	if err != nil {
+		return nil, err
+	}
+
+	err = ctx.EventManager().EmitTypedEvents(
+		&types.EventCancelPrepaySubscription{
+			Sender:         msg.GetSender(),
+			SubscriptionId: strconv.FormatUint(msg.GetSubscriptionId(), 10),
+		},
+	)
+	if err != nil {
+		return nil, errors.Wrap(types.ErrEmitEvent, err.Error())
+	}
+
+	return &types.MsgCancelPrepaySubscriptionResponse{}, nil
+}
+
+// HandleMsgTransferNoz Handles MsgTransferNoz.
+func (k msgServer) HandleMsgTransferNoz(c context.Context, msg *types.MsgTransferNoz) (*types.MsgTransferNozResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	sender, err := sdk.AccAddressFromBech32(msg.GetSender())
+	if err != nil {
+		return &types.MsgTransferNozResponse{}, errors.Wrap(types.ErrInvalidSenderAddr, err.Error())
+	}
+
+	recipient, err := sdk.AccAddressFromBech32(msg.GetRecipient())
+	if err != nil {
+		return &types.MsgTransferNozResponse{}, errors.Wrap(types.ErrInvalidRecipientAddr, err.Error())
+	}
+
+	err = k.TransferNoz(ctx, sender, recipient, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ctx.EventManager().EmitTypedEvents(
+		&types.EventTransferNoz{
+			Sender:    msg.GetSender(),
+			Recipient: msg.GetRecipient(),
+			Amount:    msg.Amount.String(),
+		},
+	)
+	if err != nil {
+		return nil, errors.Wrap(types.ErrEmitEvent, err.Error())
+	}
+
+	return &types.MsgTransferNozResponse{}, nil
+}
+
+// HandleMsgApproveNoz Handles MsgApproveNoz.
+func (k msgServer) HandleMsgApproveNoz(c context.Context, msg *types.MsgApproveNoz) (*types.MsgApproveNozResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	owner, err := sdk.AccAddressFromBech32(msg.GetOwner())
+	if err != nil {
+		return &types.MsgApproveNozResponse{}, errors.Wrap(types.ErrInvalidOwnerAddr, err.Error())
+	}
+
+	spender, err := sdk.AccAddressFromBech32(msg.GetSpender())
+	if err != nil {
+		return &types.MsgApproveNozResponse{}, errors.Wrap(types.ErrInvalidSpenderAddr, err.Error())
+	}
+
+	k.SetNozAllowance(ctx, owner, spender, msg.Amount)
+
+	err = ctx.EventManager().EmitTypedEvents(
+		&types.EventApproveNoz{
+			Owner:   msg.GetOwner(),
+			Spender: msg.GetSpender(),
+			Amount:  msg.Amount.String(),
+		},
+	)
+	if err != nil {
+		return nil, errors.Wrap(types.ErrEmitEvent, err.Error())
+	}
+
+	return &types.MsgApproveNozResponse{}, nil
+}
+
+// HandleMsgTransferNozFrom Handles MsgTransferNozFrom.
+func (k msgServer) HandleMsgTransferNozFrom(c context.Context, msg *types.MsgTransferNozFrom) (*types.MsgTransferNozFromResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	spender, err := sdk.AccAddressFromBech32(msg.GetSpender())
+	if err != nil {
+		return &types.MsgTransferNozFromResponse{}, errors.Wrap(types.ErrInvalidSpenderAddr, err.Error())
+	}
+
+	owner, err := sdk.AccAddressFromBech32(msg.GetOwner())
+	if err != nil {
+		return &types.MsgTransferNozFromResponse{}, errors.Wrap(types.ErrInvalidOwnerAddr, err.Error())
+	}
+
+	recipient, err := sdk.AccAddressFromBech32(msg.GetRecipient())
+	if err != nil {
+		return &types.MsgTransferNozFromResponse{}, errors.Wrap(types.ErrInvalidRecipientAddr, err.Error())
+	}
+
+	err = k.TransferNozFrom(ctx, spender, owner, recipient, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ctx.EventManager().EmitTypedEvents(
+		&types.EventTransferNozFrom{
+			Spender:   msg.GetSpender(),
+			Owner:     msg.GetOwner(),
+			Recipient: msg.GetRecipient(),
+			Amount:    msg.Amount.String(),
+		},
+	)
+	if err != nil {
+		return nil, errors.Wrap(types.ErrEmitEvent, err.Error())
+	}
+
+	return &types.MsgTransferNozFromResponse{}, nil
+}
+
+// HandleMsgGrantFileAccess Handles MsgGrantFileAccess.
+func (k msgServer) HandleMsgGrantFileAccess(c context.Context, msg *types.MsgGrantFileAccess) (*types.MsgGrantFileAccessResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	err := k.GrantFileAccess(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ctx.EventManager().EmitTypedEvents(
+		&types.EventGrantFileAccess{
+			Granter:      msg.GetGranter(),
+			FileHash:     msg.GetFileHash(),
+			Grantee:      msg.GetGrantee(),
+			ExpiryHeight: strconv.FormatInt(msg.GetExpiryHeight(), 10),
+			Fee:          msg.Fee.String(),
+		},
+	)
+	if err != nil {
+		return nil, errors.Wrap(types.ErrEmitEvent, err.Error())
+	}
+
+	return &types.MsgGrantFileAccessResponse{}, nil
+}
+
+// HandleMsgRevokeFileAccess Handles MsgRevokeFileAccess.
+func (k msgServer) HandleMsgRevokeFileAccess(c context.Context, msg *types.MsgRevokeFileAccess) (*types.MsgRevokeFileAccessResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	grantee, err := sdk.AccAddressFromBech32(msg.GetGrantee())
+	if err != nil {
+		return &types.MsgRevokeFileAccessResponse{}, errors.Wrap(types.ErrInvalidGranteeAddr, err.Error())
+	}
+
+	err = k.RevokeFileAccess(ctx, msg.GetGranter(), msg.GetFileHash(), grantee)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ctx.EventManager().EmitTypedEvents(
+		&types.EventRevokeFileAccess{
+			Granter:  msg.GetGranter(),
+			FileHash: msg.GetFileHash(),
+			Grantee:  msg.GetGrantee(),
+		},
+	)
+	if err != nil {
+		return nil, errors.Wrap(types.ErrEmitEvent, err.Error())
+	}
+
+	return &types.MsgRevokeFileAccessResponse{}, nil
+}
+
+// HandleMsgSubmitStorageProof Handles MsgSubmitStorageProof.
+func (k msgServer) HandleMsgSubmitStorageProof(c context.Context, msg *types.MsgSubmitStorageProof) (*types.MsgSubmitStorageProofResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	valid, err := k.SubmitStorageProof(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+	if !valid {
+		return &types.MsgSubmitStorageProofResponse{Valid: false}, nil
+	}
+
+	err = ctx.EventManager().EmitTypedEvents(
+		&types.EventStorageProofVerified{
+			ChallengeId:    strconv.FormatUint(msg.GetChallengeId(), 10),
+			NetworkAddress: msg.GetNetworkAddress(),
+		},
+	)
+	if err != nil {
+		return nil, errors.Wrap(types.ErrEmitEvent, err.Error())
+	}
+
+	return &types.MsgSubmitStorageProofResponse{Valid: true}, nil
+}
+
+// HandleMsgRefundNoz Handles MsgRefundNoz.
+func (k msgServer) HandleMsgRefundNoz(c context.Context, msg *types.MsgRefundNoz) (*types.MsgRefundNozResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	refunded, fee, err := k.RefundNoz(ctx, msg)
+	if err != nil {
+		return nil, errors.Wrap(types.ErrRefundFailure, err.Error())
+	}
+
+	err = ctx.EventManager().EmitTypedEvents(
+		&types.EventRefundNoz{
+			Sender:    msg.GetSender(),
+			NozAmount: msg.Amount.String(),
+			Refunded:  refunded.String(),
+			Fee:       fee.String(),
+		},
+	)
+	if err != nil {
+		return nil, errors.Wrap(types.ErrEmitEvent, err.Error())
+	}
+
+	return &types.MsgRefundNozResponse{Refunded: refunded, Fee: fee}, nil
+}
+
+// HandleMsgDeleteFile Handles MsgDeleteFile.
+func (k msgServer) HandleMsgDeleteFile(c context.Context, msg *types.MsgDeleteFile) (*types.MsgDeleteFileResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	refunded, err := k.DeleteFile(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ctx.EventManager().EmitTypedEvents(
+		&types.EventDeleteFile{
+			Uploader:    msg.GetUploader(),
+			FileHash:    msg.GetFileHash(),
+			RefundedNoz: refunded.String(),
+		},
+	)
+	if err != nil {
+		return nil, errors.Wrap(types.ErrEmitEvent, err.Error())
+	}
+
+	return &types.MsgDeleteFileResponse{RefundedNoz: refunded}, nil
+}
+
 // UpdateParams updates the module parameters
 func (k msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
 	if k.authority != msg.Authority {
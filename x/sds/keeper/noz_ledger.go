@@ -0,0 +1,164 @@
+package keeper
+
+import (
+	"cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	stratos "github.com/stratosnet/stratos-chain/types"
+	"github.com/stratosnet/stratos-chain/x/sds/types"
+)
+
+func (k Keeper) getInt(ctx sdk.Context, key []byte) sdkmath.Int {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(key)
+	if bz == nil {
+		return sdkmath.ZeroInt()
+	}
+	intVal := stratos.Int{}
+	k.cdc.MustUnmarshalLengthPrefixed(bz, &intVal)
+	return *intVal.Value
+}
+
+// setInt stores a positive value, the key is removed from store when value is zero
+func (k Keeper) setInt(ctx sdk.Context, key []byte, value sdkmath.Int) {
+	store := ctx.KVStore(k.storeKey)
+	if value.IsZero() {
+		store.Delete(key)
+		return
+	}
+	store.Set(key, k.cdc.MustMarshalLengthPrefixed(&stratos.Int{Value: &value}))
+}
+
+func (k Keeper) GetTotalIssuedNoz(ctx sdk.Context) sdkmath.Int {
+	return k.getInt(ctx, types.TotalIssuedNozKey)
+}
+
+func (k Keeper) SetTotalIssuedNoz(ctx sdk.Context, value sdkmath.Int) {
+	k.setInt(ctx, types.TotalIssuedNozKey, value)
+}
+
+func (k Keeper) GetNozBalance(ctx sdk.Context, owner sdk.AccAddress) sdkmath.Int {
+	return k.getInt(ctx, types.GetNozBalanceKey(owner))
+}
+
+func (k Keeper) SetNozBalance(ctx sdk.Context, owner sdk.AccAddress, value sdkmath.Int) {
+	k.setInt(ctx, types.GetNozBalanceKey(owner), value)
+}
+
+func (k Keeper) GetNozAllowance(ctx sdk.Context, owner, spender sdk.AccAddress) sdkmath.Int {
+	return k.getInt(ctx, types.GetNozAllowanceKey(owner, spender))
+}
+
+func (k Keeper) SetNozAllowance(ctx sdk.Context, owner, spender sdk.AccAddress, value sdkmath.Int) {
+	k.setInt(ctx, types.GetNozAllowanceKey(owner, spender), value)
+}
+
+// IterateNozBalances Iteration for all noz balances
+func (k Keeper) IterateNozBalances(ctx sdk.Context, handler func(owner sdk.AccAddress, balance sdkmath.Int) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.NozBalanceKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		key := iter.Key()[len(types.NozBalanceKeyPrefix):]
+		owner := sdk.AccAddress(key[1 : 1+int(key[0])])
+		intVal := stratos.Int{}
+		k.cdc.MustUnmarshalLengthPrefixed(iter.Value(), &intVal)
+		if handler(owner, *intVal.Value) {
+			break
+		}
+	}
+}
+
+// IterateNozAllowances Iteration for all noz allowances
+func (k Keeper) IterateNozAllowances(ctx sdk.Context, handler func(owner, spender sdk.AccAddress, allowance sdkmath.Int) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.NozAllowanceKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		owner, spender := types.ParseNozAllowanceKey(iter.Key())
+		intVal := stratos.Int{}
+		k.cdc.MustUnmarshalLengthPrefixed(iter.Value(), &intVal)
+		if handler(owner, spender, *intVal.Value) {
+			break
+		}
+	}
+}
+
+// MintNoz credits the purchased noz to the owner and increases the total issued noz
+func (k Keeper) MintNoz(ctx sdk.Context, owner sdk.AccAddress, amount sdkmath.Int) {
+	k.SetNozBalance(ctx, owner, k.GetNozBalance(ctx, owner).Add(amount))
+	k.SetTotalIssuedNoz(ctx, k.GetTotalIssuedNoz(ctx).Add(amount))
+}
+
+// BurnNoz debits the refunded noz from the owner and decreases the total issued noz
+func (k Keeper) BurnNoz(ctx sdk.Context, owner sdk.AccAddress, amount sdkmath.Int) error {
+	balance := k.GetNozBalance(ctx, owner)
+	if balance.LT(amount) {
+		return errors.Wrapf(types.ErrInsufficientNozBalance, "balance %s is smaller than %s", balance, amount)
+	}
+
+	k.SetNozBalance(ctx, owner, balance.Sub(amount))
+	k.SetTotalIssuedNoz(ctx, k.GetTotalIssuedNoz(ctx).Sub(amount))
+	return nil
+}
+
+// TransferNoz moves noz between two wallets of the noz ledger
+func (k Keeper) TransferNoz(ctx sdk.Context, from, to sdk.AccAddress, amount sdkmath.Int) error {
+	if amount.IsNil() || !amount.IsPositive() {
+		return errors.Wrapf(types.ErrInvalidNozAmount, "amount %s", amount)
+	}
+
+	fromBalance := k.GetNozBalance(ctx, from)
+	if fromBalance.LT(amount) {
+		return errors.Wrapf(types.ErrInsufficientNozBalance, "balance %s is smaller than %s", fromBalance, amount)
+	}
+
+	k.SetNozBalance(ctx, from, fromBalance.Sub(amount))
+	k.SetNozBalance(ctx, to, k.GetNozBalance(ctx, to).Add(amount))
+	return nil
+}
+
+// TransferNozFrom moves noz from the owner to the recipient and deducts the amount from the spender allowance
+func (k Keeper) TransferNozFrom(ctx sdk.Context, spender, owner, recipient sdk.AccAddress, amount sdkmath.Int) error {
+	allowance := k.GetNozAllowance(ctx, owner, spender)
+	if allowance.LT(amount) {
+		return errors.Wrapf(types.ErrInsufficientNozAllowance, "allowance %s is smaller than %s", allowance, amount)
+	}
+
+	err := k.TransferNoz(ctx, owner, recipient, amount)
+	if err != nil {
+		return err
+	}
+
+	k.SetNozAllowance(ctx, owner, spender, allowance.Sub(amount))
+	return nil
+}
+
+// GetRefundNonce returns the nonce the next noz refund of sender must be attested with
+func (k Keeper) GetRefundNonce(ctx sdk.Context, sender sdk.AccAddress) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetRefundNonceKey(sender))
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+func (k Keeper) SetRefundNonce(ctx sdk.Context, sender sdk.AccAddress, nonce uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetRefundNonceKey(sender), sdk.Uint64ToBigEndian(nonce))
+}
+
+// IterateRefundNonces Iteration for all senders with a refund nonce
+func (k Keeper) IterateRefundNonces(ctx sdk.Context, handler func(sender sdk.AccAddress, nonce uint64) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.RefundNonceKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		sender := sdk.AccAddress(iter.Key()[len(types.RefundNonceKeyPrefix):])
+		if handler(sender, sdk.BigEndianToUint64(iter.Value())) {
+			break
+		}
+	}
+}
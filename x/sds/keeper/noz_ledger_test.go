@@ -0,0 +1,158 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/stratosnet/stratos-chain/x/sds/keeper"
+	"github.com/stratosnet/stratos-chain/x/sds/types"
+)
+
+// prepayNoz purchases noz for the beneficiary and returns the purchased amount
+func prepayNoz(t *testing.T, ctx sdk.Context, k keeper.Keeper, beneficiary sdk.AccAddress) sdkmath.Int {
+	before := k.GetNozBalance(ctx, beneficiary)
+	_, err := keeper.NewMsgServerImpl(k).HandleMsgPrepay(sdk.WrapSDKContext(ctx),
+		types.NewMsgPrepay(user(0).String(), beneficiary.String(), prepayAmount, sdkmath.ZeroInt(), 0))
+	require.NoError(t, err)
+	purchased := k.GetNozBalance(ctx, beneficiary).Sub(before)
+	require.True(t, purchased.IsPositive())
+	return purchased
+}
+
+func requireNozLedgerInvariants(t *testing.T, ctx sdk.Context, k keeper.Keeper) {
+	msg, broken := keeper.AllInvariants(k)(ctx)
+	require.False(t, broken, msg)
+}
+
+func TestNozLedgerMintBurn(t *testing.T) {
+	stApp, ctx := setupApp(t)
+	k := stApp.GetSdsKeeper()
+	registerKeeper := stApp.GetRegisterKeeper()
+
+	// the ledger starts empty, consistently with the prepaid ozone of register
+	require.True(t, k.GetTotalIssuedNoz(ctx).IsZero())
+	require.True(t, registerKeeper.GetPrepaidOzone(ctx).IsZero())
+	requireNozLedgerInvariants(t, ctx, k)
+
+	// a prepay mints the purchased noz and issues the same amount of prepaid ozone
+	remainingBefore := registerKeeper.GetRemainingOzoneLimit(ctx)
+	purchased := prepayNoz(t, ctx, k, user(1))
+	require.True(t, purchased.Equal(k.GetTotalIssuedNoz(ctx)))
+	require.True(t, purchased.Equal(registerKeeper.GetPrepaidOzone(ctx)))
+	require.True(t, remainingBefore.Sub(purchased).Equal(registerKeeper.GetRemainingOzoneLimit(ctx)))
+	requireNozLedgerInvariants(t, ctx, k)
+
+	// more than the balance cannot be burnt
+	require.ErrorIs(t, k.BurnNoz(ctx, user(1), purchased.AddRaw(1)), types.ErrInsufficientNozBalance)
+
+	// burning returns the noz to the issuer side
+	half := purchased.QuoRaw(2)
+	require.NoError(t, k.BurnNoz(ctx, user(1), half))
+	registerKeeper.ReturnPrepaidOzone(ctx, half)
+	require.True(t, purchased.Sub(half).Equal(k.GetNozBalance(ctx, user(1))))
+	require.True(t, purchased.Sub(half).Equal(k.GetTotalIssuedNoz(ctx)))
+	requireNozLedgerInvariants(t, ctx, k)
+
+	// an empty balance is removed from the store
+	require.NoError(t, k.BurnNoz(ctx, user(1), purchased.Sub(half)))
+	registerKeeper.ReturnPrepaidOzone(ctx, purchased.Sub(half))
+	k.IterateNozBalances(ctx, func(owner sdk.AccAddress, _ sdkmath.Int) (stop bool) {
+		require.NotEqual(t, user(1), owner)
+		return false
+	})
+	requireNozLedgerInvariants(t, ctx, k)
+
+	// a mint not backed by prepaid ozone breaks the supply invariant
+	k.MintNoz(ctx, user(0), sdkmath.OneInt())
+	_, broken := keeper.NozLedgerSupplyInvariant(k)(ctx)
+	require.True(t, broken)
+}
+
+func TestNozLedgerTransfer(t *testing.T) {
+	stApp, ctx := setupApp(t)
+	k := stApp.GetSdsKeeper()
+	msgServer := keeper.NewMsgServerImpl(k)
+	purchased := prepayNoz(t, ctx, k, user(0))
+
+	// amounts must be positive and covered by the balance
+	cacheCtx, _ := ctx.CacheContext()
+	_, err := msgServer.HandleMsgTransferNoz(sdk.WrapSDKContext(cacheCtx),
+		types.NewMsgTransferNoz(user(0).String(), user(1).String(), sdkmath.ZeroInt()))
+	require.ErrorIs(t, err, types.ErrInvalidNozAmount)
+	_, err = msgServer.HandleMsgTransferNoz(sdk.WrapSDKContext(cacheCtx),
+		types.NewMsgTransferNoz(user(0).String(), user(1).String(), purchased.AddRaw(1)))
+	require.ErrorIs(t, err, types.ErrInsufficientNozBalance)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	amount := purchased.QuoRaw(3)
+	_, err = msgServer.HandleMsgTransferNoz(sdk.WrapSDKContext(ctx),
+		types.NewMsgTransferNoz(user(0).String(), user(1).String(), amount))
+	require.NoError(t, err)
+	require.Equal(t, 1, countEvents(ctx, &types.EventTransferNoz{}))
+	require.True(t, purchased.Sub(amount).Equal(k.GetNozBalance(ctx, user(0))))
+	require.True(t, amount.Equal(k.GetNozBalance(ctx, user(1))))
+
+	// transfers do not change the total issued noz
+	require.True(t, purchased.Equal(k.GetTotalIssuedNoz(ctx)))
+	requireNozLedgerInvariants(t, ctx, k)
+}
+
+func TestNozLedgerAllowance(t *testing.T) {
+	stApp, ctx := setupApp(t)
+	k := stApp.GetSdsKeeper()
+	msgServer := keeper.NewMsgServerImpl(k)
+	purchased := prepayNoz(t, ctx, k, user(0))
+	owner, spender, recipient := user(0), user(1), resOwner(0)
+
+	// nothing can be spent without an allowance
+	cacheCtx, _ := ctx.CacheContext()
+	_, err := msgServer.HandleMsgTransferNozFrom(sdk.WrapSDKContext(cacheCtx),
+		types.NewMsgTransferNozFrom(spender.String(), owner.String(), recipient.String(), sdkmath.OneInt()))
+	require.ErrorIs(t, err, types.ErrInsufficientNozAllowance)
+
+	allowance := purchased.QuoRaw(2)
+	_, err = msgServer.HandleMsgApproveNoz(sdk.WrapSDKContext(ctx),
+		types.NewMsgApproveNoz(owner.String(), spender.String(), allowance))
+	require.NoError(t, err)
+	require.True(t, allowance.Equal(k.GetNozAllowance(ctx, owner, spender)))
+
+	// more than the allowance is rejected
+	cacheCtx, _ = ctx.CacheContext()
+	_, err = msgServer.HandleMsgTransferNozFrom(sdk.WrapSDKContext(cacheCtx),
+		types.NewMsgTransferNozFrom(spender.String(), owner.String(), recipient.String(), allowance.AddRaw(1)))
+	require.ErrorIs(t, err, types.ErrInsufficientNozAllowance)
+
+	// the transferred amount is deducted from the allowance and moved from the owner to the recipient
+	amount := allowance.QuoRaw(2)
+	_, err = msgServer.HandleMsgTransferNozFrom(sdk.WrapSDKContext(ctx),
+		types.NewMsgTransferNozFrom(spender.String(), owner.String(), recipient.String(), amount))
+	require.NoError(t, err)
+	require.True(t, allowance.Sub(amount).Equal(k.GetNozAllowance(ctx, owner, spender)))
+	require.True(t, purchased.Sub(amount).Equal(k.GetNozBalance(ctx, owner)))
+	require.True(t, amount.Equal(k.GetNozBalance(ctx, recipient)))
+	require.True(t, k.GetNozBalance(ctx, spender).IsZero())
+
+	// an allowance above the balance of the owner is still limited by the balance
+	_, err = msgServer.HandleMsgApproveNoz(sdk.WrapSDKContext(ctx),
+		types.NewMsgApproveNoz(owner.String(), spender.String(), purchased))
+	require.NoError(t, err)
+	cacheCtx, _ = ctx.CacheContext()
+	_, err = msgServer.HandleMsgTransferNozFrom(sdk.WrapSDKContext(cacheCtx),
+		types.NewMsgTransferNozFrom(spender.String(), owner.String(), recipient.String(), purchased))
+	require.ErrorIs(t, err, types.ErrInsufficientNozBalance)
+	require.True(t, purchased.Equal(k.GetNozAllowance(ctx, owner, spender)))
+
+	// approving zero removes the allowance
+	_, err = msgServer.HandleMsgApproveNoz(sdk.WrapSDKContext(ctx),
+		types.NewMsgApproveNoz(owner.String(), spender.String(), sdkmath.ZeroInt()))
+	require.NoError(t, err)
+	k.IterateNozAllowances(ctx, func(_, _ sdk.AccAddress, _ sdkmath.Int) (stop bool) {
+		t.Fatal("no allowance expected")
+		return true
+	})
+	requireNozLedgerInvariants(t, ctx, k)
+}
@@ -0,0 +1,123 @@
+package keeper
+
+import (
+	"cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/stratosnet/stratos-chain/x/sds/types"
+)
+
+func (k Keeper) GetNextNozPriceSnapshotSeq(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.NextNozPriceSnapshotSeqKey)
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+func (k Keeper) SetNextNozPriceSnapshotSeq(ctx sdk.Context, seq uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.NextNozPriceSnapshotSeqKey, sdk.Uint64ToBigEndian(seq))
+}
+
+// AppendNozPriceSnapshot appends a snapshot to the noz price history and drops the oldest ones,
+// so that at most historySize snapshots are kept in store
+func (k Keeper) AppendNozPriceSnapshot(ctx sdk.Context, snapshot types.NozPriceSnapshot, historySize uint64) {
+	store := ctx.KVStore(k.storeKey)
+	seq := k.GetNextNozPriceSnapshotSeq(ctx)
+	store.Set(types.GetNozPriceSnapshotKey(seq), k.cdc.MustMarshalLengthPrefixed(&snapshot))
+	seq++
+	k.SetNextNozPriceSnapshotSeq(ctx, seq)
+
+	iter := sdk.KVStorePrefixIterator(store, types.NozPriceSnapshotKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		oldestSeq := sdk.BigEndianToUint64(iter.Key()[len(types.NozPriceSnapshotKeyPrefix):])
+		if seq-oldestSeq <= historySize {
+			break
+		}
+		store.Delete(iter.Key())
+	}
+}
+
+// IterateNozPriceSnapshots iterates the noz price history from the oldest snapshot to the latest one
+func (k Keeper) IterateNozPriceSnapshots(ctx sdk.Context, reverse bool, handler func(snapshot types.NozPriceSnapshot) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	var iter sdk.Iterator
+	if reverse {
+		iter = sdk.KVStoreReversePrefixIterator(store, types.NozPriceSnapshotKeyPrefix)
+	} else {
+		iter = sdk.KVStorePrefixIterator(store, types.NozPriceSnapshotKeyPrefix)
+	}
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var snapshot types.NozPriceSnapshot
+		k.cdc.MustUnmarshalLengthPrefixed(iter.Value(), &snapshot)
+		if handler(snapshot) {
+			break
+		}
+	}
+}
+
+// RecordNozPriceSnapshot records the current noz price every NozPriceSnapshotInterval blocks
+func (k Keeper) RecordNozPriceSnapshot(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	if params.NozPriceSnapshotInterval <= 0 || ctx.BlockHeight()%params.NozPriceSnapshotInterval != 0 {
+		return
+	}
+
+	St, Pt, Lt := k.registerKeeper.GetCurrNozPriceParams(ctx)
+	if Lt.IsZero() {
+		return
+	}
+
+	snapshot := types.NozPriceSnapshot{
+		Height: ctx.BlockHeight(),
+		St:     St,
+		Pt:     Pt,
+		Lt:     Lt,
+		Price:  k.potKeeper.GetCurrentNozPrice(St, Pt, Lt),
+	}
+	k.AppendNozPriceSnapshot(ctx, snapshot, params.NozPriceHistorySize)
+}
+
+// GetNozPriceTWAP returns the time weighted average noz price over the latest window of blocks.
+// Each snapshot price is weighted by the number of blocks until the next snapshot (or the current height),
+// the snapshot prior to the window start covers the beginning of the window.
+func (k Keeper) GetNozPriceTWAP(ctx sdk.Context, window int64) (sdkmath.LegacyDec, error) {
+	if window <= 0 {
+		return sdkmath.LegacyZeroDec(), errors.Wrapf(types.ErrInvalidTWAPWindow, "window %d", window)
+	}
+
+	startHeight := ctx.BlockHeight() - window
+	endHeight := ctx.BlockHeight()
+	weightedSum := sdkmath.LegacyZeroDec()
+	var latest *types.NozPriceSnapshot
+
+	k.IterateNozPriceSnapshots(ctx, true, func(snapshot types.NozPriceSnapshot) (stop bool) {
+		if latest == nil {
+			latest = &snapshot
+		}
+		segmentStart := snapshot.Height
+		if segmentStart < startHeight {
+			segmentStart = startHeight
+		}
+		if endHeight > segmentStart {
+			weightedSum = weightedSum.Add(snapshot.Price.MulInt64(endHeight - segmentStart))
+			endHeight = segmentStart
+		}
+		return snapshot.Height <= startHeight
+	})
+
+	if latest == nil {
+		return sdkmath.LegacyZeroDec(), types.ErrNoNozPriceHistory
+	}
+
+	coveredBlocks := ctx.BlockHeight() - endHeight
+	if coveredBlocks <= 0 {
+		return latest.Price, nil
+	}
+	return weightedSum.QuoInt64(coveredBlocks), nil
+}
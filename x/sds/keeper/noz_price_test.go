@@ -0,0 +1,152 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	evmtypes "github.com/stratosnet/stratos-chain/x/evm/types"
+	"github.com/stratosnet/stratos-chain/x/sds/keeper"
+	"github.com/stratosnet/stratos-chain/x/sds/types"
+)
+
+func snapshotHeights(ctx sdk.Context, k keeper.Keeper) (heights []int64) {
+	k.IterateNozPriceSnapshots(ctx, false, func(snapshot types.NozPriceSnapshot) (stop bool) {
+		heights = append(heights, snapshot.Height)
+		return false
+	})
+	return heights
+}
+
+func appendSnapshot(ctx sdk.Context, k keeper.Keeper, height int64, price int64) {
+	k.AppendNozPriceSnapshot(ctx, types.NozPriceSnapshot{
+		Height: height,
+		St:     sdkmath.ZeroInt(),
+		Pt:     sdkmath.ZeroInt(),
+		Lt:     sdkmath.OneInt(),
+		Price:  sdkmath.LegacyNewDec(price),
+	}, k.GetParams(ctx).NozPriceHistorySize)
+}
+
+func TestRecordNozPriceSnapshot(t *testing.T) {
+	stApp, ctx := setupApp(t)
+	k := stApp.GetSdsKeeper()
+
+	params := k.GetParams(ctx)
+	params.NozPriceSnapshotInterval = 10
+	params.NozPriceHistorySize = 3
+	require.NoError(t, k.SetParams(ctx, params))
+
+	// a snapshot is recorded every interval and only the latest history size snapshots are kept
+	for height := int64(10); height <= 55; height++ {
+		k.RecordNozPriceSnapshot(ctx.WithBlockHeight(height))
+	}
+	require.Equal(t, []int64{30, 40, 50}, snapshotHeights(ctx, k))
+	require.Equal(t, uint64(5), k.GetNextNozPriceSnapshotSeq(ctx))
+
+	St, Pt, Lt := stApp.GetRegisterKeeper().GetCurrNozPriceParams(ctx)
+	k.IterateNozPriceSnapshots(ctx, true, func(snapshot types.NozPriceSnapshot) (stop bool) {
+		require.True(t, St.Equal(snapshot.St))
+		require.True(t, Pt.Equal(snapshot.Pt))
+		require.True(t, Lt.Equal(snapshot.Lt))
+		require.True(t, stApp.GetPotKeeper().GetCurrentNozPrice(St, Pt, Lt).Equal(snapshot.Price))
+		return true
+	})
+
+	// shrinking the history drops the oldest snapshots with the next one
+	params.NozPriceHistorySize = 1
+	require.NoError(t, k.SetParams(ctx, params))
+	k.RecordNozPriceSnapshot(ctx.WithBlockHeight(60))
+	require.Equal(t, []int64{60}, snapshotHeights(ctx, k))
+
+	// no snapshot is recorded once disabled
+	params.NozPriceSnapshotInterval = 0
+	require.NoError(t, k.SetParams(ctx, params))
+	k.RecordNozPriceSnapshot(ctx.WithBlockHeight(70))
+	require.Equal(t, []int64{60}, snapshotHeights(ctx, k))
+}
+
+func TestGetNozPriceTWAP(t *testing.T) {
+	stApp, ctx := setupApp(t)
+	k := stApp.GetSdsKeeper()
+	ctx = ctx.WithBlockHeight(300)
+
+	_, err := k.GetNozPriceTWAP(ctx, 100)
+	require.ErrorIs(t, err, types.ErrNoNozPriceHistory)
+	_, err = k.GetNozPriceTWAP(ctx, 0)
+	require.ErrorIs(t, err, types.ErrInvalidTWAPWindow)
+
+	appendSnapshot(ctx, k, 100, 1)
+	appendSnapshot(ctx, k, 200, 3)
+
+	for _, tc := range []struct {
+		window   int64
+		expected sdkmath.LegacyDec
+	}{
+		// within the latest snapshot
+		{window: 100, expected: sdkmath.LegacyNewDec(3)},
+		{window: 50, expected: sdkmath.LegacyNewDec(3)},
+		// (1 * 50 + 3 * 100) / 150
+		{window: 150, expected: sdkmath.LegacyNewDec(350).QuoInt64(150)},
+		// (1 * 100 + 3 * 100) / 200
+		{window: 200, expected: sdkmath.LegacyNewDec(2)},
+		// the blocks before the first snapshot are not covered
+		{window: 1000, expected: sdkmath.LegacyNewDec(2)},
+	} {
+		twap, err := k.GetNozPriceTWAP(ctx, tc.window)
+		require.NoError(t, err)
+		require.True(t, tc.expected.Equal(twap), "window %d: expected %s, got %s", tc.window, tc.expected, twap)
+	}
+
+	// the price of a snapshot recorded at the current height has not lasted any block yet
+	twap, err := k.GetNozPriceTWAP(ctx.WithBlockHeight(200), 100)
+	require.NoError(t, err)
+	require.True(t, sdkmath.LegacyNewDec(1).Equal(twap))
+}
+
+func TestNozPriceTWAPFn(t *testing.T) {
+	stApp, ctx := setupApp(t)
+	k := stApp.GetSdsKeeper()
+	ctx = ctx.WithBlockHeight(300).WithGasMeter(sdk.NewInfiniteGasMeter())
+	appendSnapshot(ctx, k, 100, 1)
+	appendSnapshot(ctx, k, 200, 3)
+
+	uintTy, _ := abi.NewType("uint256", "", nil)
+	arguments := abi.Arguments{{Type: uintTy}}
+	window, err := arguments.Pack(big.NewInt(200))
+	require.NoError(t, err)
+
+	nozPriceTWAP := stApp.GetEVMKeeper().NozPriceTWAPFn(ctx)
+
+	// the twap is returned with 18 decimals and at least 2500 gas is charged
+	gas := uint64(100000)
+	ret, returnGas, err := nozPriceTWAP(window, gas)
+	require.NoError(t, err)
+	require.LessOrEqual(t, returnGas, gas-2500)
+	results, err := arguments.Unpack(ret)
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.LegacyNewDec(2).BigInt(), results[0].(*big.Int))
+
+	// not enough gas
+	_, returnGas, err = nozPriceTWAP(window, 2499)
+	require.ErrorIs(t, err, evmtypes.ErrGasOverflow)
+	require.Zero(t, returnGas)
+
+	// the keeper error is returned
+	zeroWindow, err := arguments.Pack(big.NewInt(0))
+	require.NoError(t, err)
+	_, _, err = nozPriceTWAP(zeroWindow, gas)
+	require.ErrorIs(t, err, types.ErrInvalidTWAPWindow)
+
+	// the window does not fit in int64
+	hugeWindow, err := arguments.Pack(new(big.Int).Lsh(big.NewInt(1), 64))
+	require.NoError(t, err)
+	_, returnGas, err = nozPriceTWAP(hugeWindow, gas)
+	require.Error(t, err)
+	require.Equal(t, gas, returnGas)
+}
@@ -0,0 +1,72 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	stratos "github.com/stratosnet/stratos-chain/types"
+	"github.com/stratosnet/stratos-chain/x/sds/keeper"
+	"github.com/stratosnet/stratos-chain/x/sds/types"
+)
+
+var prepayAmount = sdk.NewCoins(stratos.NewCoin(sdkmath.NewInt(1).MulRaw(stratos.StosToWei)))
+
+func TestPrepayMinNozOut(t *testing.T) {
+	stApp, ctx := setupApp(t)
+	k := stApp.GetSdsKeeper()
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	registerKeeper := stApp.GetRegisterKeeper()
+	expected, _, err := registerKeeper.CalculatePurchaseAmount(ctx, prepayAmount.AmountOf(k.BondDenom(ctx)))
+	require.NoError(t, err)
+	require.True(t, expected.IsPositive())
+
+	// a purchase yielding less than min noz out is rejected
+	cacheCtx, _ := ctx.CacheContext()
+	_, err = msgServer.HandleMsgPrepay(sdk.WrapSDKContext(cacheCtx),
+		types.NewMsgPrepay(user(0).String(), user(1).String(), prepayAmount, expected.AddRaw(1), 0))
+	require.ErrorIs(t, err, types.ErrPrepaySlippageExceeded)
+
+	// the exact amount is accepted and credited to the beneficiary
+	_, err = msgServer.HandleMsgPrepay(sdk.WrapSDKContext(ctx),
+		types.NewMsgPrepay(user(0).String(), user(1).String(), prepayAmount, expected, 0))
+	require.NoError(t, err)
+	require.True(t, expected.Equal(k.GetNozBalance(ctx, user(1))))
+	require.True(t, k.GetNozBalance(ctx, user(0)).IsZero())
+
+	// min noz out is optional
+	_, err = msgServer.HandleMsgPrepay(sdk.WrapSDKContext(ctx),
+		types.NewMsgPrepay(user(0).String(), user(0).String(), prepayAmount, sdkmath.Int{}, 0))
+	require.NoError(t, err)
+	require.True(t, k.GetNozBalance(ctx, user(0)).IsPositive())
+}
+
+func TestPrepayDeadlineHeight(t *testing.T) {
+	stApp, ctx := setupApp(t)
+	k := stApp.GetSdsKeeper()
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	// the deadline height itself is accepted
+	_, err := msgServer.HandleMsgPrepay(sdk.WrapSDKContext(ctx),
+		types.NewMsgPrepay(user(0).String(), user(0).String(), prepayAmount, sdkmath.ZeroInt(), ctx.BlockHeight()))
+	require.NoError(t, err)
+	purchased := k.GetNozBalance(ctx, user(0))
+	require.True(t, purchased.IsPositive())
+
+	// a prepay landing after its deadline is rejected before anything is purchased
+	balanceBefore := stApp.GetBankKeeper().GetBalance(ctx, user(0), k.BondDenom(ctx))
+	_, err = msgServer.HandleMsgPrepay(sdk.WrapSDKContext(ctx),
+		types.NewMsgPrepay(user(0).String(), user(0).String(), prepayAmount, sdkmath.ZeroInt(), ctx.BlockHeight()-1))
+	require.ErrorIs(t, err, types.ErrPrepayDeadlineExceeded)
+	require.True(t, purchased.Equal(k.GetNozBalance(ctx, user(0))))
+	require.True(t, balanceBefore.IsEqual(stApp.GetBankKeeper().GetBalance(ctx, user(0), k.BondDenom(ctx))))
+
+	// no deadline
+	_, err = msgServer.HandleMsgPrepay(sdk.WrapSDKContext(ctx),
+		types.NewMsgPrepay(user(0).String(), user(0).String(), prepayAmount, sdkmath.ZeroInt(), 0))
+	require.NoError(t, err)
+}
@@ -0,0 +1,152 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/stratosnet/stratos-chain/crypto"
+	"github.com/stratosnet/stratos-chain/crypto/bls"
+	registertypes "github.com/stratosnet/stratos-chain/x/register/types"
+	"github.com/stratosnet/stratos-chain/x/sds/keeper"
+	"github.com/stratosnet/stratos-chain/x/sds/types"
+)
+
+// newMsgRefundNoz creates a refund of sender attested by the meta nodes of signers
+func newMsgRefundNoz(t *testing.T, sender sdk.AccAddress, amount sdkmath.Int, nonce uint64, signers ...int) *types.MsgRefundNoz {
+	msg := types.NewMsgRefundNoz(sender.String(), amount, nonce, types.BLSSignatureInfo{})
+	txData := crypto.Keccak256(msg.GetBLSSignBytes())
+
+	var signatures, pubKeys [][]byte
+	for _, i := range signers {
+		signature, err := bls.Sign(txData, metaNodeBLSPrivKeys[i])
+		require.NoError(t, err)
+		signatures = append(signatures, signature)
+		pubKeys = append(pubKeys, metaNodeBLSPubKeys[i])
+	}
+	signature, err := bls.AggregateSignatures(signatures...)
+	require.NoError(t, err)
+
+	msg.BLSSignature = types.BLSSignatureInfo{PubKeys: pubKeys, Signature: signature, TxData: txData}
+	require.NoError(t, msg.ValidateBasic())
+	return msg
+}
+
+func TestRefundNoz(t *testing.T) {
+	stApp, ctx := setupApp(t)
+	k := stApp.GetSdsKeeper()
+	bankKeeper := stApp.GetBankKeeper()
+	distrKeeper := stApp.GetDistrKeeper()
+	registerKeeper := stApp.GetRegisterKeeper()
+
+	purchased := prepayNoz(t, ctx, k, user(0))
+	balanceBefore := bankKeeper.GetBalance(ctx, user(0), k.BondDenom(ctx))
+	communityPoolBefore := distrKeeper.GetFeePoolCommunityCoins(ctx).AmountOf(k.BondDenom(ctx))
+	remainingBefore := registerKeeper.GetRemainingOzoneLimit(ctx)
+
+	refunded, fee, err := k.RefundNoz(ctx, newMsgRefundNoz(t, user(0), purchased, 0, 0, 1, 2))
+	require.NoError(t, err)
+
+	// refunding all the noz of a prepay gives back the prepaid amount minus the fee, up to the rounding of the purchase
+	prepaid := prepayAmount.AmountOf(k.BondDenom(ctx))
+	total := refunded.Amount.Add(fee.Amount)
+	require.True(t, total.LTE(prepaid))
+	require.True(t, prepaid.Sub(total).LTE(prepaid.QuoRaw(1e9)), "prepaid %s, refunded %s", prepaid, total)
+	expectedFee := k.GetParams(ctx).NozRefundFeeRate.MulInt(total).TruncateInt()
+	require.True(t, expectedFee.Equal(fee.Amount), "expected fee %s, got %s", expectedFee, fee.Amount)
+	require.True(t, fee.IsPositive())
+
+	// the refund goes to the sender and the fee to the community pool
+	require.True(t, balanceBefore.Add(refunded).IsEqual(bankKeeper.GetBalance(ctx, user(0), k.BondDenom(ctx))))
+	communityPool := distrKeeper.GetFeePoolCommunityCoins(ctx).AmountOf(k.BondDenom(ctx))
+	require.True(t, communityPoolBefore.Add(fee.Amount.ToLegacyDec()).Equal(communityPool))
+
+	// the noz is burnt and the ozone limit restored
+	require.True(t, k.GetNozBalance(ctx, user(0)).IsZero())
+	require.True(t, remainingBefore.Add(purchased).Equal(registerKeeper.GetRemainingOzoneLimit(ctx)))
+	require.Equal(t, uint64(1), k.GetRefundNonce(ctx, user(0)))
+	requireNozLedgerInvariants(t, ctx, k)
+}
+
+func TestRefundNozNonce(t *testing.T) {
+	stApp, ctx := setupApp(t)
+	k := stApp.GetSdsKeeper()
+	purchased := prepayNoz(t, ctx, k, user(0))
+	amount := purchased.QuoRaw(4)
+
+	msg := newMsgRefundNoz(t, user(0), amount, 0, 0, 1)
+	_, _, err := k.RefundNoz(ctx, msg)
+	require.NoError(t, err)
+
+	// the same attestation cannot be replayed
+	cacheCtx, _ := ctx.CacheContext()
+	_, _, err = k.RefundNoz(cacheCtx, msg)
+	require.ErrorIs(t, err, types.ErrInvalidRefundNonce)
+
+	// nor a future nonce used
+	_, _, err = k.RefundNoz(cacheCtx, newMsgRefundNoz(t, user(0), amount, 2, 0, 1))
+	require.ErrorIs(t, err, types.ErrInvalidRefundNonce)
+
+	// the nonce is per sender
+	_, _, err = k.RefundNoz(ctx, newMsgRefundNoz(t, user(0), amount, 1, 0, 1))
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), k.GetRefundNonce(ctx, user(0)))
+	require.Zero(t, k.GetRefundNonce(ctx, user(1)))
+}
+
+func TestRefundNozAttestation(t *testing.T) {
+	stApp, ctx := setupApp(t)
+	k := stApp.GetSdsKeeper()
+	purchased := prepayNoz(t, ctx, k, user(0))
+	cacheCtx, _ := ctx.CacheContext()
+
+	// less than 2/3 of the bonded meta nodes
+	_, _, err := k.RefundNoz(cacheCtx, newMsgRefundNoz(t, user(0), purchased, 0, 0))
+	require.ErrorIs(t, err, registertypes.ErrBLSNotReachThreshold)
+
+	// the same meta node counted twice
+	_, _, err = k.RefundNoz(cacheCtx, newMsgRefundNoz(t, user(0), purchased, 0, 0, 0))
+	require.ErrorIs(t, err, registertypes.ErrInvalidBLSPubKey)
+
+	// an attestation of another amount
+	msg := newMsgRefundNoz(t, user(0), purchased, 0, 0, 1)
+	msg.Amount = purchased.AddRaw(1)
+	_, _, err = k.RefundNoz(cacheCtx, msg)
+	require.ErrorIs(t, err, types.ErrBLSTxDataInvalid)
+
+	// a BLS key not registered by a meta node
+	_, pubKey, err := bls.NewKeyPair()
+	require.NoError(t, err)
+	msg = newMsgRefundNoz(t, user(0), purchased, 0, 0, 1)
+	msg.BLSSignature.PubKeys[1] = pubKey
+	_, _, err = k.RefundNoz(cacheCtx, msg)
+	require.ErrorIs(t, err, registertypes.ErrInvalidBLSPubKey)
+
+	// more than the noz balance
+	_, _, err = k.RefundNoz(cacheCtx, newMsgRefundNoz(t, user(0), purchased.AddRaw(1), 0, 0, 1))
+	require.ErrorIs(t, err, types.ErrInsufficientNozBalance)
+}
+
+func TestRefundNozInsufficientUnissuedPrepay(t *testing.T) {
+	stApp, ctx := setupApp(t)
+	k := stApp.GetSdsKeeper()
+	bankKeeper := stApp.GetBankKeeper()
+	purchased := prepayNoz(t, ctx, k, user(0))
+
+	// drain the total unissued prepay pool below the refund
+	pool := bankKeeper.GetBalance(ctx, stApp.GetAccountKeeper().GetModuleAddress(registertypes.TotalUnissuedPrepay), k.BondDenom(ctx))
+	require.NoError(t, bankKeeper.SendCoinsFromModuleToAccount(ctx, registertypes.TotalUnissuedPrepay, user(1),
+		sdk.NewCoins(pool.SubAmount(sdkmath.OneInt()))))
+
+	_, _, err := k.RefundNoz(ctx, newMsgRefundNoz(t, user(0), purchased, 0, 0, 1))
+	require.ErrorIs(t, err, types.ErrInsufficientUnissuedPrepay)
+
+	// the refund is rejected through the msg server before anything is burnt
+	_, err = keeper.NewMsgServerImpl(k).HandleMsgRefundNoz(sdk.WrapSDKContext(ctx),
+		newMsgRefundNoz(t, user(0), purchased, 0, 0, 1))
+	require.ErrorIs(t, err, types.ErrRefundFailure)
+	require.True(t, purchased.Equal(k.GetNozBalance(ctx, user(0))))
+}
@@ -0,0 +1,348 @@
+package keeper
+
+import (
+	"crypto/sha256"
+	"strconv"
+
+	"cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	stratos "github.com/stratosnet/stratos-chain/types"
+	"github.com/stratosnet/stratos-chain/x/sds/types"
+)
+
+const (
+	StorageChallengeExpireCountPerBlock = 100
+
+	storageChallengeReasonMissed       = "missed"
+	storageChallengeReasonInvalidProof = "invalid proof"
+)
+
+func (k Keeper) GetChallengeableFileCount(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ChallengeableFileCountKey)
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// AddChallengeableFile appends a file to the index from which the storage challenges are drawn
+func (k Keeper) AddChallengeableFile(ctx sdk.Context, fileHash string) {
+	store := ctx.KVStore(k.storeKey)
+	count := k.GetChallengeableFileCount(ctx)
+	store.Set(types.GetChallengeableFileKey(count), []byte(fileHash))
+	store.Set(types.GetChallengeableFileSeqKey(fileHash), sdk.Uint64ToBigEndian(count))
+	store.Set(types.ChallengeableFileCountKey, sdk.Uint64ToBigEndian(count+1))
+}
+
+// RemoveChallengeableFile removes a file from the index from which the storage challenges are drawn.
+// The last file of the index is moved to the freed seq, so that the index stays contiguous.
+func (k Keeper) RemoveChallengeableFile(ctx sdk.Context, fileHash string) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetChallengeableFileSeqKey(fileHash))
+	if bz == nil {
+		return
+	}
+	seq := sdk.BigEndianToUint64(bz)
+	lastSeq := k.GetChallengeableFileCount(ctx) - 1
+
+	if seq != lastSeq {
+		lastFileHash, _ := k.getChallengeableFile(ctx, lastSeq)
+		store.Set(types.GetChallengeableFileKey(seq), []byte(lastFileHash))
+		store.Set(types.GetChallengeableFileSeqKey(lastFileHash), sdk.Uint64ToBigEndian(seq))
+	}
+	store.Delete(types.GetChallengeableFileKey(lastSeq))
+	store.Delete(types.GetChallengeableFileSeqKey(fileHash))
+	store.Set(types.ChallengeableFileCountKey, sdk.Uint64ToBigEndian(lastSeq))
+}
+
+func (k Keeper) getChallengeableFile(ctx sdk.Context, seq uint64) (fileHash string, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetChallengeableFileKey(seq))
+	if bz == nil {
+		return "", false
+	}
+	return string(bz), true
+}
+
+func (k Keeper) GetNextStorageChallengeId(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.NextStorageChallengeIdKey)
+	if bz == nil {
+		return 1
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+func (k Keeper) SetNextStorageChallengeId(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.NextStorageChallengeIdKey, sdk.Uint64ToBigEndian(id))
+}
+
+func (k Keeper) GetStorageChallenge(ctx sdk.Context, id uint64) (challenge types.StorageChallenge, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetStorageChallengeKey(id))
+	if bz == nil {
+		return challenge, false
+	}
+	k.cdc.MustUnmarshalLengthPrefixed(bz, &challenge)
+	return challenge, true
+}
+
+// SetStorageChallenge stores the challenge together with its resource node index and its entry in the deadline queue
+func (k Keeper) SetStorageChallenge(ctx sdk.Context, challenge types.StorageChallenge) {
+	networkAddr, err := stratos.SdsAddressFromBech32(challenge.GetNetworkAddress())
+	if err != nil {
+		panic(err)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalLengthPrefixed(&challenge)
+	store.Set(types.GetStorageChallengeKey(challenge.GetId()), bz)
+	store.Set(types.GetStorageChallengeByNodeKey(networkAddr, challenge.GetId()), []byte{})
+	store.Set(types.GetStorageChallengeDeadlineKey(challenge.GetDeadlineHeight(), challenge.GetId()), []byte{})
+}
+
+// RemoveStorageChallenge removes the challenge together with its resource node index and its entry in the deadline queue
+func (k Keeper) RemoveStorageChallenge(ctx sdk.Context, challenge types.StorageChallenge) {
+	networkAddr, err := stratos.SdsAddressFromBech32(challenge.GetNetworkAddress())
+	if err != nil {
+		panic(err)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetStorageChallengeKey(challenge.GetId()))
+	store.Delete(types.GetStorageChallengeByNodeKey(networkAddr, challenge.GetId()))
+	store.Delete(types.GetStorageChallengeDeadlineKey(challenge.GetDeadlineHeight(), challenge.GetId()))
+}
+
+// IterateStorageChallenges Iteration for all pending storage challenges
+func (k Keeper) IterateStorageChallenges(ctx sdk.Context, handler func(challenge types.StorageChallenge) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.StorageChallengeKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var challenge types.StorageChallenge
+		k.cdc.MustUnmarshalLengthPrefixed(iter.Value(), &challenge)
+		if handler(challenge) {
+			break
+		}
+	}
+}
+
+func (k Keeper) GetStorageChallengeFailureCount(ctx sdk.Context, networkAddr stratos.SdsAddress) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetStorageChallengeFailureKey(networkAddr))
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetStorageChallengeFailureCount stores a positive count, the key is removed from store when count is zero
+func (k Keeper) SetStorageChallengeFailureCount(ctx sdk.Context, networkAddr stratos.SdsAddress, count uint64) {
+	store := ctx.KVStore(k.storeKey)
+	if count == 0 {
+		store.Delete(types.GetStorageChallengeFailureKey(networkAddr))
+		return
+	}
+	store.Set(types.GetStorageChallengeFailureKey(networkAddr), sdk.Uint64ToBigEndian(count))
+}
+
+// IterateStorageChallengeFailures Iteration for all resource nodes with failed storage challenges
+func (k Keeper) IterateStorageChallengeFailures(ctx sdk.Context, handler func(networkAddr stratos.SdsAddress, count uint64) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.StorageChallengeFailureKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		networkAddr := stratos.SdsAddress(iter.Key()[len(types.StorageChallengeFailureKeyPrefix):])
+		if handler(networkAddr, sdk.BigEndianToUint64(iter.Value())) {
+			break
+		}
+	}
+}
+
+// IssueStorageChallenges issues a round of storage challenges every StorageChallengeInterval blocks.
+// The challenged (resource node, file hash, segment index) are derived from the block hashes,
+// files and resource nodes which can not be challenged are skipped.
+func (k Keeper) IssueStorageChallenges(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	if params.StorageChallengeInterval <= 0 || ctx.BlockHeight()%params.StorageChallengeInterval != 0 {
+		return
+	}
+
+	fileCount := k.GetChallengeableFileCount(ctx)
+	if fileCount == 0 {
+		return
+	}
+
+	header := ctx.BlockHeader()
+	for i := uint32(0); i < params.StorageChallengesPerRound; i++ {
+		seedData := make([]byte, 0)
+		seedData = append(seedData, ctx.HeaderHash()...)
+		seedData = append(seedData, header.LastBlockId.Hash...)
+		seedData = append(seedData, sdk.Uint64ToBigEndian(uint64(ctx.BlockHeight()))...)
+		seedData = append(seedData, sdk.Uint64ToBigEndian(uint64(i))...)
+		seed := sha256.Sum256(seedData)
+
+		fileHash, found := k.getChallengeableFile(ctx, sdk.BigEndianToUint64(seed[0:8])%fileCount)
+		if !found {
+			continue
+		}
+		fileInfo, found := k.GetFileInfoByFileHash(ctx, []byte(fileHash))
+		if !found || !fileInfo.IsChallengeable() {
+			continue
+		}
+
+		storageNodes := fileInfo.GetStorageNodes()
+		networkAddrStr := storageNodes[sdk.BigEndianToUint64(seed[8:16])%uint64(len(storageNodes))]
+		networkAddr, err := stratos.SdsAddressFromBech32(networkAddrStr)
+		if err != nil {
+			continue
+		}
+		node, found := k.registerKeeper.GetResourceNode(ctx, networkAddr)
+		if !found || node.GetSuspend() {
+			continue
+		}
+
+		k.issueStorageChallenge(ctx, networkAddrStr, fileHash, sdk.BigEndianToUint64(seed[16:24])%fileInfo.GetSegmentCount(),
+			ctx.BlockHeight()+params.StorageChallengeResponseWindow)
+	}
+}
+
+func (k Keeper) issueStorageChallenge(ctx sdk.Context, networkAddr, fileHash string, segmentIndex uint64, deadlineHeight int64) {
+	id := k.GetNextStorageChallengeId(ctx)
+	challenge := types.StorageChallenge{
+		Id:             id,
+		NetworkAddress: networkAddr,
+		FileHash:       fileHash,
+		SegmentIndex:   segmentIndex,
+		Height:         ctx.BlockHeight(),
+		DeadlineHeight: deadlineHeight,
+	}
+	k.SetStorageChallenge(ctx, challenge)
+	k.SetNextStorageChallengeId(ctx, id+1)
+
+	err := ctx.EventManager().EmitTypedEvent(&types.EventStorageChallenge{
+		ChallengeId:    strconv.FormatUint(id, 10),
+		NetworkAddress: networkAddr,
+		FileHash:       fileHash,
+		SegmentIndex:   strconv.FormatUint(segmentIndex, 10),
+		DeadlineHeight: strconv.FormatInt(deadlineHeight, 10),
+	})
+	if err != nil {
+		k.Logger(ctx).Error("failed to emit event", "ErrMsg", err.Error())
+	}
+}
+
+// SubmitStorageProof verifies the answer of a resource node to a storage challenge.
+// An invalid proof is handled as a failed challenge and does not return an error, so that the slashing is committed.
+func (k Keeper) SubmitStorageProof(ctx sdk.Context, msg *types.MsgSubmitStorageProof) (valid bool, err error) {
+	networkAddr, err := stratos.SdsAddressFromBech32(msg.GetNetworkAddress())
+	if err != nil {
+		return false, errors.Wrap(types.ErrInvalid, err.Error())
+	}
+
+	challenge, found := k.GetStorageChallenge(ctx, msg.GetChallengeId())
+	if !found || challenge.GetNetworkAddress() != msg.GetNetworkAddress() {
+		return false, errors.Wrapf(types.ErrNoStorageChallengeFound, "challenge %d of resource node %s",
+			msg.GetChallengeId(), msg.GetNetworkAddress())
+	}
+
+	node, found := k.registerKeeper.GetResourceNode(ctx, networkAddr)
+	if !found || node.GetOwnerAddress() != msg.GetFrom() {
+		return false, errors.Wrapf(types.ErrNotResourceNodeOwner, "resource node %s", msg.GetNetworkAddress())
+	}
+
+	k.RemoveStorageChallenge(ctx, challenge)
+
+	fileInfo, found := k.GetFileInfoByFileHash(ctx, []byte(challenge.GetFileHash()))
+	if !found {
+		return false, types.ErrNoFileFound
+	}
+
+	valid = types.VerifySegmentProof(fileInfo.GetSegmentRoot(), fileInfo.GetSegmentCount(), challenge.GetSegmentIndex(),
+		msg.GetSegment(), msg.GetProof())
+	if !valid {
+		k.failStorageChallenge(ctx, challenge, storageChallengeReasonInvalidProof)
+		return false, nil
+	}
+
+	k.SetStorageChallengeFailureCount(ctx, networkAddr, 0)
+	return true, nil
+}
+
+// ExpireStorageChallenges handles at most StorageChallengeExpireCountPerBlock challenges whose deadline is reached
+// without a response as failed challenges
+func (k Keeper) ExpireStorageChallenges(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(types.StorageChallengeDeadlineKeyPrefix,
+		sdk.PrefixEndBytes(types.GetStorageChallengeDeadlineHeightPrefix(ctx.BlockHeight())))
+
+	expiredIds := make([]uint64, 0)
+	for ; iter.Valid() && len(expiredIds) < StorageChallengeExpireCountPerBlock; iter.Next() {
+		idBytes := iter.Key()[len(types.StorageChallengeDeadlineKeyPrefix)+8:]
+		expiredIds = append(expiredIds, sdk.BigEndianToUint64(idBytes))
+	}
+	iter.Close()
+
+	for _, id := range expiredIds {
+		challenge, found := k.GetStorageChallenge(ctx, id)
+		if !found {
+			continue
+		}
+		k.RemoveStorageChallenge(ctx, challenge)
+		// the file may have been deleted by its uploader after the challenge was issued
+		if _, found = k.GetFileInfoByFileHash(ctx, []byte(challenge.GetFileHash())); !found {
+			continue
+		}
+		k.failStorageChallenge(ctx, challenge, storageChallengeReasonMissed)
+	}
+}
+
+// failStorageChallenge records the slashing of a failed challenge, the resource node is suspended once
+// its consecutive failures reach StorageChallengeMaxFailures
+func (k Keeper) failStorageChallenge(ctx sdk.Context, challenge types.StorageChallenge, reason string) {
+	logger := k.Logger(ctx)
+	params := k.GetParams(ctx)
+
+	networkAddr, err := stratos.SdsAddressFromBech32(challenge.GetNetworkAddress())
+	if err != nil {
+		logger.Error("invalid network address of storage challenge", "id", challenge.GetId(), "ErrMsg", err.Error())
+		return
+	}
+	node, found := k.registerKeeper.GetResourceNode(ctx, networkAddr)
+	if !found {
+		return
+	}
+	ownerAddr, err := sdk.AccAddressFromBech32(node.GetOwnerAddress())
+	if err != nil {
+		logger.Error("invalid owner address of resource node", "network_address", challenge.GetNetworkAddress(), "ErrMsg", err.Error())
+		return
+	}
+
+	failures := k.GetStorageChallengeFailureCount(ctx, networkAddr) + 1
+	k.SetStorageChallengeFailureCount(ctx, networkAddr, failures)
+
+	// keep the node suspended if it is already, SlashingResourceNode overwrites the suspend state
+	suspend := node.GetSuspend() || failures >= uint64(params.StorageChallengeMaxFailures)
+
+	slashed, _, err := k.potKeeper.SlashingResourceNode(ctx, networkAddr, ownerAddr, params.StorageChallengeSlashNoz, suspend)
+	if err != nil {
+		logger.Error("failed to slash resource node", "network_address", challenge.GetNetworkAddress(), "ErrMsg", err.Error())
+		slashed = sdkmath.ZeroInt()
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventStorageChallengeFailed{
+		ChallengeId:    strconv.FormatUint(challenge.GetId(), 10),
+		NetworkAddress: challenge.GetNetworkAddress(),
+		Reason:         reason,
+		SlashedAmount:  slashed.String(),
+		Suspended:      strconv.FormatBool(suspend),
+	})
+	if err != nil {
+		logger.Error("failed to emit event", "ErrMsg", err.Error())
+	}
+}
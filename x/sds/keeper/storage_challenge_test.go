@@ -0,0 +1,275 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/stratosnet/stratos-chain/x/sds/keeper"
+	"github.com/stratosnet/stratos-chain/x/sds/types"
+)
+
+const (
+	testChallengeInterval = int64(10)
+	testResponseWindow    = int64(5)
+	testMaxFailures       = uint32(2)
+)
+
+var testSegments = [][]byte{[]byte("segment 0"), []byte("segment 1"), []byte("segment 2"), []byte("segment 3"), []byte("segment 4")}
+
+// buildSegmentTree returns the segment root and the proof of every segment,
+// the last node of a level holding an odd number of nodes is duplicated
+func buildSegmentTree(segments [][]byte) (root []byte, proofs [][][]byte) {
+	level := make([][]byte, len(segments))
+	indexes := make([]int, len(segments))
+	for i, segment := range segments {
+		level[i] = types.HashSegmentLeaf(segment)
+		indexes[i] = i
+	}
+
+	proofs = make([][][]byte, len(segments))
+	for len(level) > 1 {
+		if len(level)%2 == 1 {
+			level = append(level, level[len(level)-1])
+		}
+		for i, index := range indexes {
+			proofs[i] = append(proofs[i], level[index^1])
+			indexes[i] = index / 2
+		}
+		next := make([][]byte, len(level)/2)
+		for j := range next {
+			next[j] = types.HashSegmentNode(level[2*j], level[2*j+1])
+		}
+		level = next
+	}
+	return level[0], proofs
+}
+
+// uploadChallengeableFile stores a file of testSegments stored by the first resource node
+func uploadChallengeableFile(ctx sdk.Context, k keeper.Keeper, fileHash string, uploader sdk.AccAddress) {
+	root, _ := buildSegmentTree(testSegments)
+	k.SetFileInfo(ctx, []byte(fileHash), types.NewFileInfo(sdkmath.NewInt(ctx.BlockHeight()), nil, uploader.String(),
+		root, uint64(len(testSegments)), []string{resNodeP2PAddr(0).String()}))
+	k.AddChallengeableFile(ctx, fileHash)
+}
+
+func enableStorageChallenges(t *testing.T, ctx sdk.Context, k keeper.Keeper) {
+	params := k.GetParams(ctx)
+	params.StorageChallengeInterval = testChallengeInterval
+	params.StorageChallengesPerRound = 1
+	params.StorageChallengeResponseWindow = testResponseWindow
+	params.StorageChallengeMaxFailures = testMaxFailures
+	require.NoError(t, k.SetParams(ctx, params))
+}
+
+func storageChallenges(ctx sdk.Context, k keeper.Keeper) (challenges []types.StorageChallenge) {
+	k.IterateStorageChallenges(ctx, func(challenge types.StorageChallenge) (stop bool) {
+		challenges = append(challenges, challenge)
+		return false
+	})
+	return challenges
+}
+
+// issueStorageChallenge issues a round of challenges at height and returns the single issued challenge
+func issueStorageChallenge(t *testing.T, ctx sdk.Context, k keeper.Keeper, height int64) types.StorageChallenge {
+	before := storageChallenges(ctx, k)
+	k.IssueStorageChallenges(ctx.WithBlockHeight(height))
+	challenges := storageChallenges(ctx, k)
+	require.Len(t, challenges, len(before)+1)
+	return challenges[len(challenges)-1]
+}
+
+func TestIssueStorageChallenges(t *testing.T) {
+	stApp, ctx := setupApp(t)
+	k := stApp.GetSdsKeeper()
+	uploadChallengeableFile(ctx, k, testFileHash, user(0))
+
+	// challenges are disabled by default
+	require.Zero(t, k.GetParams(ctx).StorageChallengeInterval)
+	k.IssueStorageChallenges(ctx.WithBlockHeight(testChallengeInterval))
+	require.Empty(t, storageChallenges(ctx, k))
+
+	// challenges are issued every interval
+	enableStorageChallenges(t, ctx, k)
+	k.IssueStorageChallenges(ctx.WithBlockHeight(2*testChallengeInterval + 1))
+	require.Empty(t, storageChallenges(ctx, k))
+
+	height := 2 * testChallengeInterval
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	challenge := issueStorageChallenge(t, ctx, k, height)
+	require.Equal(t, 1, countEvents(ctx, &types.EventStorageChallenge{}))
+	require.Equal(t, uint64(1), challenge.GetId())
+	require.Equal(t, resNodeP2PAddr(0).String(), challenge.GetNetworkAddress())
+	require.Equal(t, testFileHash, challenge.GetFileHash())
+	require.Less(t, challenge.GetSegmentIndex(), uint64(len(testSegments)))
+	require.Equal(t, height, challenge.GetHeight())
+	require.Equal(t, height+testResponseWindow, challenge.GetDeadlineHeight())
+	require.Equal(t, uint64(2), k.GetNextStorageChallengeId(ctx))
+
+	// suspended resource nodes are not challenged
+	registerKeeper := stApp.GetRegisterKeeper()
+	node, found := registerKeeper.GetResourceNode(ctx, resNodeP2PAddr(0))
+	require.True(t, found)
+	node.Suspend = true
+	registerKeeper.SetResourceNode(ctx, node)
+	k.IssueStorageChallenges(ctx.WithBlockHeight(3 * testChallengeInterval))
+	require.Len(t, storageChallenges(ctx, k), 1)
+
+	// files removed from the index are not challenged
+	node.Suspend = false
+	registerKeeper.SetResourceNode(ctx, node)
+	k.RemoveChallengeableFile(ctx, testFileHash)
+	k.IssueStorageChallenges(ctx.WithBlockHeight(4 * testChallengeInterval))
+	require.Len(t, storageChallenges(ctx, k), 1)
+}
+
+func TestSubmitStorageProof(t *testing.T) {
+	stApp, ctx := setupApp(t)
+	k := stApp.GetSdsKeeper()
+	msgServer := keeper.NewMsgServerImpl(k)
+	enableStorageChallenges(t, ctx, k)
+	uploadChallengeableFile(ctx, k, testFileHash, user(0))
+	_, proofs := buildSegmentTree(testSegments)
+
+	submit := func(ctx sdk.Context, from sdk.AccAddress, challenge types.StorageChallenge, segment []byte) (bool, error) {
+		msg := types.NewMsgSubmitStorageProof(from.String(), challenge.GetNetworkAddress(), challenge.GetId(), segment,
+			proofs[challenge.GetSegmentIndex()])
+		if err := msg.ValidateBasic(); err != nil {
+			return false, err
+		}
+		res, err := msgServer.HandleMsgSubmitStorageProof(sdk.WrapSDKContext(ctx), msg)
+		if err != nil {
+			return false, err
+		}
+		return res.GetValid(), nil
+	}
+
+	challenge := issueStorageChallenge(t, ctx, k, testChallengeInterval)
+	segment := testSegments[challenge.GetSegmentIndex()]
+
+	// only the owner of the challenged resource node can answer
+	cacheCtx, _ := ctx.CacheContext()
+	_, err := submit(cacheCtx, resOwner(1), challenge, segment)
+	require.ErrorIs(t, err, types.ErrNotResourceNodeOwner)
+
+	// the segment size is bounded
+	_, err = submit(cacheCtx, resOwner(0), challenge, make([]byte, types.MaxSegmentSize+1))
+	require.Error(t, err)
+
+	// a valid proof answers the challenge and resets the consecutive failures
+	k.SetStorageChallengeFailureCount(ctx, resNodeP2PAddr(0), 1)
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	valid, err := submit(ctx, resOwner(0), challenge, segment)
+	require.NoError(t, err)
+	require.True(t, valid)
+	require.Equal(t, 1, countEvents(ctx, &types.EventStorageProofVerified{}))
+	require.Empty(t, storageChallenges(ctx, k))
+	require.Zero(t, k.GetStorageChallengeFailureCount(ctx, resNodeP2PAddr(0)))
+
+	// a challenge is answered once
+	_, err = submit(ctx, resOwner(0), challenge, segment)
+	require.ErrorIs(t, err, types.ErrNoStorageChallengeFound)
+
+	// an invalid proof fails the challenge without returning an error
+	challenge = issueStorageChallenge(t, ctx, k, 2*testChallengeInterval)
+	slashingBefore := stApp.GetRegisterKeeper().GetSlashing(ctx, resOwner(0))
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	valid, err = submit(ctx, resOwner(0), challenge, []byte("corrupted segment"))
+	require.NoError(t, err)
+	require.False(t, valid)
+	require.Equal(t, 1, countEvents(ctx, &types.EventStorageChallengeFailed{}))
+	require.Empty(t, storageChallenges(ctx, k))
+	require.Equal(t, uint64(1), k.GetStorageChallengeFailureCount(ctx, resNodeP2PAddr(0)))
+	require.True(t, stApp.GetRegisterKeeper().GetSlashing(ctx, resOwner(0)).GT(slashingBefore))
+}
+
+func TestExpireStorageChallenges(t *testing.T) {
+	stApp, ctx := setupApp(t)
+	k := stApp.GetSdsKeeper()
+	registerKeeper := stApp.GetRegisterKeeper()
+	enableStorageChallenges(t, ctx, k)
+	uploadChallengeableFile(ctx, k, testFileHash, user(0))
+
+	isSuspended := func() bool {
+		node, found := registerKeeper.GetResourceNode(ctx, resNodeP2PAddr(0))
+		require.True(t, found)
+		return node.GetSuspend()
+	}
+
+	// a challenge is answerable until its deadline height
+	challenge := issueStorageChallenge(t, ctx, k, testChallengeInterval)
+	k.ExpireStorageChallenges(ctx.WithBlockHeight(challenge.GetDeadlineHeight() - 1))
+	require.Len(t, storageChallenges(ctx, k), 1)
+
+	// a missed challenge fails at its deadline height
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	k.ExpireStorageChallenges(ctx.WithBlockHeight(challenge.GetDeadlineHeight()))
+	require.Empty(t, storageChallenges(ctx, k))
+	require.Equal(t, 1, countEvents(ctx, &types.EventStorageChallengeFailed{}))
+	require.Equal(t, uint64(1), k.GetStorageChallengeFailureCount(ctx, resNodeP2PAddr(0)))
+	require.False(t, isSuspended())
+
+	// the resource node is suspended once its consecutive failures reach the max failures
+	challenge = issueStorageChallenge(t, ctx, k, 2*testChallengeInterval)
+	k.ExpireStorageChallenges(ctx.WithBlockHeight(challenge.GetDeadlineHeight()))
+	require.Equal(t, uint64(testMaxFailures), k.GetStorageChallengeFailureCount(ctx, resNodeP2PAddr(0)))
+	require.True(t, isSuspended())
+}
+
+func TestExpireStorageChallengesOfDeletedFile(t *testing.T) {
+	stApp, ctx := setupApp(t)
+	k := stApp.GetSdsKeeper()
+	enableStorageChallenges(t, ctx, k)
+	uploadChallengeableFile(ctx, k, testFileHash, user(0))
+
+	challenge := issueStorageChallenge(t, ctx, k, testChallengeInterval)
+	_, err := k.DeleteFile(ctx, types.NewMsgDeleteFile(user(0).String(), testFileHash))
+	require.NoError(t, err)
+
+	// the challenge of a deleted file is dropped without slashing
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	k.ExpireStorageChallenges(ctx.WithBlockHeight(challenge.GetDeadlineHeight()))
+	require.Empty(t, storageChallenges(ctx, k))
+	require.Zero(t, countEvents(ctx, &types.EventStorageChallengeFailed{}))
+	require.Zero(t, k.GetStorageChallengeFailureCount(ctx, resNodeP2PAddr(0)))
+
+	// and the file is not challenged anymore
+	require.Zero(t, k.GetChallengeableFileCount(ctx))
+	k.IssueStorageChallenges(ctx.WithBlockHeight(2 * testChallengeInterval))
+	require.Empty(t, storageChallenges(ctx, k))
+}
+
+func TestRemoveChallengeableFile(t *testing.T) {
+	stApp, ctx := setupApp(t)
+	k := stApp.GetSdsKeeper()
+
+	fileHashes := []string{"file0", "file1", "file2"}
+	for _, fileHash := range fileHashes {
+		k.AddChallengeableFile(ctx, fileHash)
+	}
+
+	challengeableFiles := func() (files []string) {
+		store := ctx.KVStore(stApp.GetKey(types.StoreKey))
+		for seq := uint64(0); seq < k.GetChallengeableFileCount(ctx); seq++ {
+			files = append(files, string(store.Get(types.GetChallengeableFileKey(seq))))
+		}
+		return files
+	}
+
+	// the last file takes the place of the removed one
+	k.RemoveChallengeableFile(ctx, "file0")
+	require.Equal(t, []string{"file2", "file1"}, challengeableFiles())
+
+	// removing an unknown file is a no-op
+	k.RemoveChallengeableFile(ctx, "file0")
+	require.Equal(t, []string{"file2", "file1"}, challengeableFiles())
+
+	k.RemoveChallengeableFile(ctx, "file1")
+	require.Equal(t, []string{"file2"}, challengeableFiles())
+	k.RemoveChallengeableFile(ctx, "file2")
+	require.Empty(t, challengeableFiles())
+	require.Zero(t, k.GetChallengeableFileCount(ctx))
+}
@@ -0,0 +1,231 @@
+package keeper
+
+import (
+	"cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	stratos "github.com/stratosnet/stratos-chain/types"
+	"github.com/stratosnet/stratos-chain/x/sds/types"
+)
+
+const (
+	StorageRentPayoutCountPerBlock = 100
+)
+
+func (k Keeper) GetStorageRent(ctx sdk.Context, fileHash string) (rent types.StorageRent, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetStorageRentKey(fileHash))
+	if bz == nil {
+		return rent, false
+	}
+	k.cdc.MustUnmarshalLengthPrefixed(bz, &rent)
+	return rent, true
+}
+
+// SetStorageRent stores the rent together with its entry in the payout queue
+func (k Keeper) SetStorageRent(ctx sdk.Context, rent types.StorageRent) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalLengthPrefixed(&rent)
+	store.Set(types.GetStorageRentKey(rent.GetFileHash()), bz)
+	store.Set(types.GetStorageRentPayoutQueueKey(rent.GetNextPayoutEpoch(), rent.GetFileHash()), []byte{})
+}
+
+// RemoveStorageRent removes the rent together with its entry in the payout queue
+func (k Keeper) RemoveStorageRent(ctx sdk.Context, rent types.StorageRent) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetStorageRentKey(rent.GetFileHash()))
+	store.Delete(types.GetStorageRentPayoutQueueKey(rent.GetNextPayoutEpoch(), rent.GetFileHash()))
+}
+
+// IterateStorageRents Iteration for all storage rents
+func (k Keeper) IterateStorageRents(ctx sdk.Context, handler func(rent types.StorageRent) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.StorageRentKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var rent types.StorageRent
+		k.cdc.MustUnmarshalLengthPrefixed(iter.Value(), &rent)
+		if handler(rent) {
+			break
+		}
+	}
+}
+
+// CreateStorageRent locks noz of the uploader of a file in an escrow paid out at every pot epoch to the resource nodes
+// storing the file, until the rent duration is over. The noz is taken through the allowance approved by the uploader
+// to spender, the owner of the meta node reporting the upload.
+func (k Keeper) CreateStorageRent(ctx sdk.Context, fileHash string, spender, uploader sdk.AccAddress, amount sdkmath.Int,
+	duration int64, replicas uint32) error {
+
+	if _, found := k.GetStorageRent(ctx, fileHash); found {
+		return errors.Wrapf(types.ErrStorageRentExists, "file %s", fileHash)
+	}
+
+	// the locked noz is moved out of the uploader balance but stays in the total issued noz until it is paid out
+	allowance := k.GetNozAllowance(ctx, uploader, spender)
+	if allowance.LT(amount) {
+		return errors.Wrapf(types.ErrInsufficientNozAllowance, "allowance %s is smaller than %s", allowance, amount)
+	}
+	balance := k.GetNozBalance(ctx, uploader)
+	if balance.LT(amount) {
+		return errors.Wrapf(types.ErrInsufficientNozBalance, "balance %s is smaller than %s", balance, amount)
+	}
+	k.SetNozBalance(ctx, uploader, balance.Sub(amount))
+	k.SetNozAllowance(ctx, uploader, spender, allowance.Sub(amount))
+
+	epoch := k.potKeeper.GetLastDistributedEpoch(ctx).Int64()
+	rent := types.StorageRent{
+		FileHash:        fileHash,
+		Uploader:        uploader.String(),
+		Amount:          amount,
+		Remaining:       amount,
+		Replicas:        replicas,
+		StartEpoch:      epoch,
+		EndEpoch:        epoch + duration,
+		LastPayoutEpoch: epoch,
+		NextPayoutEpoch: epoch + 1,
+	}
+	k.SetStorageRent(ctx, rent)
+	return nil
+}
+
+// DeleteFile removes a file uploaded by uploader together with its access grants and its storage challenge index.
+// The rent accrued until the last distributed pot epoch is paid out and the unspent part of the escrow is refunded to the uploader.
+func (k Keeper) DeleteFile(ctx sdk.Context, msg *types.MsgDeleteFile) (refunded sdkmath.Int, err error) {
+	uploader, err := sdk.AccAddressFromBech32(msg.GetUploader())
+	if err != nil {
+		return sdkmath.ZeroInt(), errors.Wrap(types.ErrInvalidSenderAddr, err.Error())
+	}
+
+	fileInfo, found := k.GetFileInfoByFileHash(ctx, []byte(msg.GetFileHash()))
+	if !found {
+		return sdkmath.ZeroInt(), types.ErrNoFileFound
+	}
+	if fileInfo.GetUploader() != msg.GetUploader() {
+		return sdkmath.ZeroInt(), errors.Wrapf(types.ErrNotFileUploader, "uploader of file %s is %s", msg.GetFileHash(), fileInfo.GetUploader())
+	}
+
+	refunded = sdkmath.ZeroInt()
+	if rent, found := k.GetStorageRent(ctx, msg.GetFileHash()); found {
+		k.RemoveStorageRent(ctx, rent)
+		rent = k.payStorageRent(ctx, rent, fileInfo)
+
+		refunded = rent.Remaining
+		k.SetNozBalance(ctx, uploader, k.GetNozBalance(ctx, uploader).Add(refunded))
+	}
+
+	k.RemoveFileAccessGrantsOfFile(ctx, msg.GetFileHash())
+	k.RemoveChallengeableFile(ctx, msg.GetFileHash())
+	k.RemoveFileInfo(ctx, []byte(msg.GetFileHash()))
+	return refunded, nil
+}
+
+// PayoutStorageRents pays out at most StorageRentPayoutCountPerBlock storage rents whose payout epoch is reached
+// by the last distributed pot epoch
+func (k Keeper) PayoutStorageRents(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	epoch := k.potKeeper.GetLastDistributedEpoch(ctx).Int64()
+	iter := store.Iterator(types.StorageRentPayoutQueueKeyPrefix,
+		sdk.PrefixEndBytes(types.GetStorageRentPayoutQueueEpochPrefix(epoch)))
+
+	dueKeys := make([][]byte, 0)
+	for ; iter.Valid() && len(dueKeys) < StorageRentPayoutCountPerBlock; iter.Next() {
+		dueKeys = append(dueKeys, iter.Key())
+	}
+	iter.Close()
+
+	for _, key := range dueKeys {
+		fileHash := string(key[len(types.StorageRentPayoutQueueKeyPrefix)+8:])
+		rent, found := k.GetStorageRent(ctx, fileHash)
+		if !found {
+			store.Delete(key)
+			continue
+		}
+		k.RemoveStorageRent(ctx, rent)
+
+		fileInfo, _ := k.GetFileInfoByFileHash(ctx, []byte(fileHash))
+		rent = k.payStorageRent(ctx, rent, fileInfo)
+		if rent.GetLastPayoutEpoch() >= rent.GetEndEpoch() || !rent.Remaining.IsPositive() {
+			continue
+		}
+
+		rent.NextPayoutEpoch = rent.GetLastPayoutEpoch() + 1
+		k.SetStorageRent(ctx, rent)
+	}
+}
+
+// payStorageRent pays the rent accrued between the last payout and the last distributed pot epoch to the owners
+// of the first Replicas active resource nodes storing the file. The shares of missing or suspended replicas are refunded
+// to the uploader.
+func (k Keeper) payStorageRent(ctx sdk.Context, rent types.StorageRent, fileInfo types.FileInfo) types.StorageRent {
+	logger := k.Logger(ctx)
+
+	epoch := k.potKeeper.GetLastDistributedEpoch(ctx)
+	payoutEpoch := epoch.Int64()
+	if payoutEpoch > rent.GetEndEpoch() {
+		payoutEpoch = rent.GetEndEpoch()
+	}
+	if payoutEpoch <= rent.GetLastPayoutEpoch() {
+		return rent
+	}
+
+	payout := rent.Remaining
+	if payoutEpoch < rent.GetEndEpoch() {
+		payout = rent.Remaining.MulRaw(payoutEpoch - rent.GetLastPayoutEpoch()).
+			QuoRaw(rent.GetEndEpoch() - rent.GetLastPayoutEpoch())
+	}
+	share := payout.QuoRaw(int64(rent.GetReplicas()))
+
+	paid := sdkmath.ZeroInt()
+	replicas := uint32(0)
+	for _, networkAddrStr := range fileInfo.GetStorageNodes() {
+		if replicas >= rent.GetReplicas() || !share.IsPositive() {
+			break
+		}
+		networkAddr, err := stratos.SdsAddressFromBech32(networkAddrStr)
+		if err != nil {
+			continue
+		}
+		node, found := k.registerKeeper.GetResourceNode(ctx, networkAddr)
+		if !found || node.GetSuspend() {
+			continue
+		}
+		ownerAddr, err := sdk.AccAddressFromBech32(node.GetOwnerAddress())
+		if err != nil {
+			continue
+		}
+		replicas++
+
+		cacheCtx, write := ctx.CacheContext()
+		reward, err := k.potKeeper.RewardStorageRent(cacheCtx, ownerAddr, share, epoch)
+		if err != nil {
+			logger.Error("failed to pay storage rent", "file_hash", rent.GetFileHash(), "network_address", networkAddrStr,
+				"ErrMsg", err.Error())
+			continue
+		}
+		write()
+		paid = paid.Add(share)
+
+		err = ctx.EventManager().EmitTypedEvent(&types.EventStorageRentPaid{
+			FileHash:       rent.GetFileHash(),
+			NetworkAddress: networkAddrStr,
+			NozAmount:      share.String(),
+			Reward:         reward.String(),
+		})
+		if err != nil {
+			logger.Error("failed to emit event", "ErrMsg", err.Error())
+		}
+	}
+
+	// the paid noz is consumed, the unpaid shares and the rounding remainder go back to the uploader
+	k.SetTotalIssuedNoz(ctx, k.GetTotalIssuedNoz(ctx).Sub(paid))
+	if unpaid := payout.Sub(paid); unpaid.IsPositive() {
+		uploader := sdk.MustAccAddressFromBech32(rent.GetUploader())
+		k.SetNozBalance(ctx, uploader, k.GetNozBalance(ctx, uploader).Add(unpaid))
+	}
+
+	rent.Remaining = rent.Remaining.Sub(payout)
+	rent.LastPayoutEpoch = payoutEpoch
+	return rent
+}
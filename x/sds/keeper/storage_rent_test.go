@@ -0,0 +1,221 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	stratosapp "github.com/stratosnet/stratos-chain/app"
+	pottypes "github.com/stratosnet/stratos-chain/x/pot/types"
+	"github.com/stratosnet/stratos-chain/x/sds/keeper"
+	"github.com/stratosnet/stratos-chain/x/sds/types"
+)
+
+const (
+	testRentStartEpoch = int64(10)
+	testRentDuration   = int64(4)
+)
+
+// uploadFileWithRent reports the upload of a file stored by all the resource nodes by the meta node reporter,
+// locking rentAmount noz of uploader for replicas resource nodes
+func uploadFileWithRent(ctx sdk.Context, k keeper.Keeper, reporter int, uploader sdk.AccAddress, rentAmount sdkmath.Int,
+	replicas uint32) error {
+
+	storageNodes := make([]string, 0, resourceNodeCount)
+	for i := 0; i < resourceNodeCount; i++ {
+		storageNodes = append(storageNodes, resNodeP2PAddr(i).String())
+	}
+	_, err := keeper.NewMsgServerImpl(k).HandleMsgFileUpload(sdk.WrapSDKContext(ctx),
+		types.NewMsgUpload(testFileHash, metaOwner(reporter).String(), metaNodeP2PAddr(reporter).String(), uploader.String(),
+			nil, 0, storageNodes, rentAmount, testRentDuration, replicas))
+	return err
+}
+
+// setupStorageRent locks a rent of half the noz purchased by the uploader for replicas resource nodes
+func setupStorageRent(t *testing.T, stApp *stratosapp.StratosApp, ctx sdk.Context, replicas uint32) (rentAmount sdkmath.Int) {
+	k := stApp.GetSdsKeeper()
+	stApp.GetPotKeeper().SetLastDistributedEpoch(ctx, sdkmath.NewInt(testRentStartEpoch))
+
+	rentAmount = prepayNoz(t, ctx, k, user(0)).QuoRaw(2)
+	k.SetNozAllowance(ctx, user(0), metaOwner(0), rentAmount)
+	require.NoError(t, uploadFileWithRent(ctx, k, 0, user(0), rentAmount, replicas))
+	return rentAmount
+}
+
+func immatureReward(stApp *stratosapp.StratosApp, ctx sdk.Context, walletAddr sdk.AccAddress) sdkmath.Int {
+	potKeeper := stApp.GetPotKeeper()
+	return potKeeper.GetImmatureTotalReward(ctx, walletAddr).AmountOf(potKeeper.BondDenom(ctx))
+}
+
+func TestUploadLocksStorageRent(t *testing.T) {
+	stApp, ctx := setupApp(t)
+	k := stApp.GetSdsKeeper()
+	stApp.GetPotKeeper().SetLastDistributedEpoch(ctx, sdkmath.NewInt(testRentStartEpoch))
+	purchased := prepayNoz(t, ctx, k, user(0))
+	rentAmount := purchased.QuoRaw(2)
+
+	// the rent is only taken through an allowance approved by the uploader to the reporter owner
+	cacheCtx, _ := ctx.CacheContext()
+	require.ErrorIs(t, uploadFileWithRent(cacheCtx, k, 0, user(0), rentAmount, 2), types.ErrInsufficientNozAllowance)
+	k.SetNozAllowance(ctx, user(0), metaOwner(0), purchased.MulRaw(2))
+	cacheCtx, _ = ctx.CacheContext()
+	require.ErrorIs(t, uploadFileWithRent(cacheCtx, k, 0, user(0), purchased.AddRaw(1), 2), types.ErrInsufficientNozBalance)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, uploadFileWithRent(ctx, k, 0, user(0), rentAmount, 2))
+	require.Equal(t, 1, countEvents(ctx, &types.EventCreateStorageRent{}))
+	require.True(t, purchased.Sub(rentAmount).Equal(k.GetNozBalance(ctx, user(0))))
+	require.True(t, purchased.MulRaw(2).Sub(rentAmount).Equal(k.GetNozAllowance(ctx, user(0), metaOwner(0))))
+
+	rent, found := k.GetStorageRent(ctx, testFileHash)
+	require.True(t, found)
+	require.True(t, rentAmount.Equal(rent.Remaining))
+	require.Equal(t, testRentStartEpoch, rent.GetStartEpoch())
+	require.Equal(t, testRentStartEpoch+testRentDuration, rent.GetEndEpoch())
+	require.Equal(t, testRentStartEpoch+1, rent.GetNextPayoutEpoch())
+	requireNozLedgerInvariants(t, ctx, k)
+
+	// the reports of the same upload by the other meta nodes do not lock the rent again
+	k.SetNozAllowance(ctx, user(0), metaOwner(1), rentAmount)
+	require.NoError(t, uploadFileWithRent(ctx, k, 1, user(0), rentAmount, 2))
+	require.Equal(t, 1, countEvents(ctx, &types.EventCreateStorageRent{}))
+	require.True(t, purchased.Sub(rentAmount).Equal(k.GetNozBalance(ctx, user(0))))
+}
+
+func TestPayoutStorageRents(t *testing.T) {
+	stApp, ctx := setupApp(t)
+	k := stApp.GetSdsKeeper()
+	potKeeper := stApp.GetPotKeeper()
+	rentAmount := setupStorageRent(t, stApp, ctx, 2)
+	issuedBefore := k.GetTotalIssuedNoz(ctx)
+
+	// nothing is paid out before the next pot epoch is distributed
+	k.PayoutStorageRents(ctx)
+	rent, _ := k.GetStorageRent(ctx, testFileHash)
+	require.True(t, rentAmount.Equal(rent.Remaining))
+
+	// the rent accrued over one epoch of the duration is shared by the first replicas resource nodes
+	epoch := sdkmath.NewInt(testRentStartEpoch + 1)
+	potKeeper.SetLastDistributedEpoch(ctx, epoch)
+	k.PayoutStorageRents(ctx)
+
+	payout := rentAmount.QuoRaw(testRentDuration)
+	share := payout.QuoRaw(2)
+	rent, found := k.GetStorageRent(ctx, testFileHash)
+	require.True(t, found)
+	require.True(t, rentAmount.Sub(payout).Equal(rent.Remaining))
+	require.Equal(t, epoch.Int64(), rent.GetLastPayoutEpoch())
+	require.Equal(t, epoch.Int64()+1, rent.GetNextPayoutEpoch())
+	require.True(t, issuedBefore.Sub(share.MulRaw(2)).Equal(k.GetTotalIssuedNoz(ctx)))
+
+	// the rewards mature like the rewards distributed at the payout epoch
+	matureEpoch := epoch.AddRaw(potKeeper.MatureEpoch(ctx))
+	for i := 0; i < 2; i++ {
+		require.True(t, immatureReward(stApp, ctx, resOwner(i)).IsPositive())
+		_, found = potKeeper.GetIndividualReward(ctx, resOwner(i), matureEpoch)
+		require.True(t, found)
+	}
+	require.True(t, immatureReward(stApp, ctx, resOwner(2)).IsZero())
+
+	// epochs missed by the payouts are paid at once, the last payout pays the whole remaining rent
+	potKeeper.SetLastDistributedEpoch(ctx, sdkmath.NewInt(testRentStartEpoch+testRentDuration+5))
+	k.PayoutStorageRents(ctx)
+	_, found = k.GetStorageRent(ctx, testFileHash)
+	require.False(t, found)
+	store := ctx.KVStore(stApp.GetKey(types.StoreKey))
+	require.False(t, store.Has(types.GetStorageRentPayoutQueueKey(epoch.Int64()+1, testFileHash)))
+	requireNozLedgerInvariants(t, ctx, k)
+}
+
+func TestPayoutStorageRentsLinearVesting(t *testing.T) {
+	stApp, ctx := setupApp(t)
+	k := stApp.GetSdsKeeper()
+	potKeeper := stApp.GetPotKeeper()
+	params := potKeeper.GetParams(ctx)
+	params.VestingMode = pottypes.VestingModeLinear
+	params.VestingEpochs = 4
+	require.NoError(t, potKeeper.SetParams(ctx, params))
+	setupStorageRent(t, stApp, ctx, 1)
+
+	epoch := sdkmath.NewInt(testRentStartEpoch + 1)
+	potKeeper.SetLastDistributedEpoch(ctx, epoch)
+	k.PayoutStorageRents(ctx)
+
+	// the reward matures at the mature epoch of the payout epoch, then vests linearly over vesting_epochs
+	matureEpoch := epoch.AddRaw(potKeeper.MatureEpoch(ctx))
+	reward := immatureReward(stApp, ctx, resOwner(0))
+	require.True(t, reward.IsPositive())
+	individualReward, found := potKeeper.GetIndividualReward(ctx, resOwner(0), matureEpoch)
+	require.True(t, found)
+	require.True(t, reward.Equal(individualReward.RewardFromTrafficPool.AmountOf(potKeeper.BondDenom(ctx))))
+
+	potKeeper.SetLastDistributedEpoch(ctx, matureEpoch)
+	require.NoError(t, potKeeper.RewardMatureAndSubSlashing(ctx))
+	schedule, found := potKeeper.GetVestingSchedule(ctx, resOwner(0))
+	require.True(t, found)
+	require.True(t, reward.Equal(schedule.TotalFromTrafficPool.AmountOf(potKeeper.BondDenom(ctx))))
+
+	potKeeper.SetLastDistributedEpoch(ctx, matureEpoch.AddRaw(2))
+	require.NoError(t, potKeeper.RewardMatureAndSubSlashing(ctx))
+	matured := potKeeper.GetMatureTotalReward(ctx, resOwner(0)).AmountOf(potKeeper.BondDenom(ctx))
+	require.True(t, reward.MulRaw(2).QuoRaw(params.VestingEpochs).Equal(matured))
+	require.True(t, reward.Sub(matured).Equal(immatureReward(stApp, ctx, resOwner(0))))
+}
+
+func TestPayoutStorageRentsRefundsUnpaidShares(t *testing.T) {
+	stApp, ctx := setupApp(t)
+	k := stApp.GetSdsKeeper()
+	registerKeeper := stApp.GetRegisterKeeper()
+	rentAmount := setupStorageRent(t, stApp, ctx, 3)
+	balanceBefore := k.GetNozBalance(ctx, user(0))
+
+	// a suspended resource node is not paid, its share goes back to the uploader
+	node, found := registerKeeper.GetResourceNode(ctx, resNodeP2PAddr(1))
+	require.True(t, found)
+	node.Suspend = true
+	registerKeeper.SetResourceNode(ctx, node)
+
+	stApp.GetPotKeeper().SetLastDistributedEpoch(ctx, sdkmath.NewInt(testRentStartEpoch+1))
+	k.PayoutStorageRents(ctx)
+
+	payout := rentAmount.QuoRaw(testRentDuration)
+	share := payout.QuoRaw(3)
+	require.True(t, balanceBefore.Add(payout.Sub(share.MulRaw(2))).Equal(k.GetNozBalance(ctx, user(0))))
+	require.True(t, immatureReward(stApp, ctx, resOwner(0)).IsPositive())
+	require.True(t, immatureReward(stApp, ctx, resOwner(1)).IsZero())
+	require.True(t, immatureReward(stApp, ctx, resOwner(2)).IsPositive())
+	requireNozLedgerInvariants(t, ctx, k)
+}
+
+func TestDeleteFileRefundsStorageRent(t *testing.T) {
+	stApp, ctx := setupApp(t)
+	k := stApp.GetSdsKeeper()
+	rentAmount := setupStorageRent(t, stApp, ctx, 2)
+	balanceBefore := k.GetNozBalance(ctx, user(0))
+
+	// only the uploader can delete the file
+	cacheCtx, _ := ctx.CacheContext()
+	_, err := k.DeleteFile(cacheCtx, types.NewMsgDeleteFile(user(1).String(), testFileHash))
+	require.ErrorIs(t, err, types.ErrNotFileUploader)
+
+	// the rent accrued until the last distributed epoch is paid out and the rest refunded
+	stApp.GetPotKeeper().SetLastDistributedEpoch(ctx, sdkmath.NewInt(testRentStartEpoch+1))
+	refunded, err := k.DeleteFile(ctx, types.NewMsgDeleteFile(user(0).String(), testFileHash))
+	require.NoError(t, err)
+
+	payout := rentAmount.QuoRaw(testRentDuration)
+	require.True(t, rentAmount.Sub(payout).Equal(refunded))
+	require.True(t, balanceBefore.Add(refunded).Equal(k.GetNozBalance(ctx, user(0))))
+	require.True(t, immatureReward(stApp, ctx, resOwner(0)).IsPositive())
+
+	_, found := k.GetStorageRent(ctx, testFileHash)
+	require.False(t, found)
+	_, found = k.GetFileInfoByFileHash(ctx, []byte(testFileHash))
+	require.False(t, found)
+	store := ctx.KVStore(stApp.GetKey(types.StoreKey))
+	require.False(t, store.Has(types.GetStorageRentPayoutQueueKey(testRentStartEpoch+1, testFileHash)))
+	requireNozLedgerInvariants(t, ctx, k)
+}
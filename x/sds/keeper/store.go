@@ -24,6 +24,11 @@ func (k Keeper) SetFileInfo(ctx sdk.Context, fileHash []byte, fileInfo types.Fil
 	store.Set(storeKey, bz)
 }
 
+func (k Keeper) RemoveFileInfo(ctx sdk.Context, fileHash []byte) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetFileStoreKey(fileHash))
+}
+
 // IterateFileInfo Iterate over all uploaded files.
 // Iteration for all uploaded files
 func (k Keeper) IterateFileInfo(ctx sdk.Context, handler func(string, types.FileInfo) (stop bool)) {
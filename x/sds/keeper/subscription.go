@@ -0,0 +1,223 @@
+package keeper
+
+import (
+	"strconv"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/stratosnet/stratos-chain/x/sds/types"
+)
+
+const (
+	PrepaySubscriptionCountPerBlock = 100
+)
+
+func (k Keeper) GetNextPrepaySubscriptionId(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.NextPrepaySubscriptionIdKey)
+	if bz == nil {
+		return 1
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+func (k Keeper) SetNextPrepaySubscriptionId(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.NextPrepaySubscriptionIdKey, sdk.Uint64ToBigEndian(id))
+}
+
+func (k Keeper) GetPrepaySubscription(ctx sdk.Context, id uint64) (subscription types.PrepaySubscription, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetPrepaySubscriptionKey(id))
+	if bz == nil {
+		return subscription, false
+	}
+	k.cdc.MustUnmarshalLengthPrefixed(bz, &subscription)
+	return subscription, true
+}
+
+// SetPrepaySubscription stores the subscription together with its sender index and its entry in the execution queue
+func (k Keeper) SetPrepaySubscription(ctx sdk.Context, subscription types.PrepaySubscription) {
+	sender := sdk.MustAccAddressFromBech32(subscription.GetSender())
+
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalLengthPrefixed(&subscription)
+	store.Set(types.GetPrepaySubscriptionKey(subscription.GetId()), bz)
+	store.Set(types.GetPrepaySubscriptionBySenderKey(sender, subscription.GetId()), []byte{})
+	store.Set(types.GetPrepaySubscriptionQueueKey(subscription.GetNextHeight(), subscription.GetId()), []byte{})
+}
+
+// RemovePrepaySubscription removes the subscription together with its sender index and its entry in the execution queue
+func (k Keeper) RemovePrepaySubscription(ctx sdk.Context, subscription types.PrepaySubscription) {
+	sender := sdk.MustAccAddressFromBech32(subscription.GetSender())
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetPrepaySubscriptionKey(subscription.GetId()))
+	store.Delete(types.GetPrepaySubscriptionBySenderKey(sender, subscription.GetId()))
+	store.Delete(types.GetPrepaySubscriptionQueueKey(subscription.GetNextHeight(), subscription.GetId()))
+}
+
+// IteratePrepaySubscriptions Iteration for all prepay subscriptions
+func (k Keeper) IteratePrepaySubscriptions(ctx sdk.Context, handler func(subscription types.PrepaySubscription) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.PrepaySubscriptionKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var subscription types.PrepaySubscription
+		k.cdc.MustUnmarshalLengthPrefixed(iter.Value(), &subscription)
+		if handler(subscription) {
+			break
+		}
+	}
+}
+
+// GetPrepaySubscriptionCountBySender returns the number of prepay subscriptions owned by sender
+func (k Keeper) GetPrepaySubscriptionCountBySender(ctx sdk.Context, sender sdk.AccAddress) (count int) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetPrepaySubscriptionBySenderPrefix(sender))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		count++
+	}
+	return count
+}
+
+// CreatePrepaySubscription creates a new prepay subscription, the first execution happens one interval after creation
+func (k Keeper) CreatePrepaySubscription(ctx sdk.Context, msg *types.MsgCreatePrepaySubscription) (types.PrepaySubscription, error) {
+	if msg.GetInterval() < types.MinPrepaySubscriptionInterval {
+		return types.PrepaySubscription{}, errors.Wrapf(types.ErrInvalidSubscriptionInterval,
+			"interval must be at least %d blocks", types.MinPrepaySubscriptionInterval)
+	}
+	sender, err := sdk.AccAddressFromBech32(msg.GetSender())
+	if err != nil {
+		return types.PrepaySubscription{}, errors.Wrap(types.ErrInvalidSenderAddr, err.Error())
+	}
+	if k.GetPrepaySubscriptionCountBySender(ctx, sender) >= types.MaxPrepaySubscriptionsPerSender {
+		return types.PrepaySubscription{}, errors.Wrapf(types.ErrTooManyPrepaySubscriptions,
+			"sender %s already owns %d subscriptions", msg.GetSender(), types.MaxPrepaySubscriptionsPerSender)
+	}
+
+	nextHeight := ctx.BlockHeight() + msg.GetInterval()
+	if msg.GetEndHeight() > 0 && msg.GetEndHeight() < nextHeight {
+		return types.PrepaySubscription{}, errors.Wrapf(types.ErrInvalidSubscriptionEndHeight,
+			"end height %d is lower than the first execution height %d", msg.GetEndHeight(), nextHeight)
+	}
+
+	id := k.GetNextPrepaySubscriptionId(ctx)
+	subscription := types.PrepaySubscription{
+		Id:          id,
+		Sender:      msg.GetSender(),
+		Beneficiary: msg.GetBeneficiary(),
+		Amount:      msg.GetAmount(),
+		Interval:    msg.GetInterval(),
+		MaxCount:    msg.GetMaxCount(),
+		EndHeight:   msg.GetEndHeight(),
+		NextHeight:  nextHeight,
+	}
+	k.SetPrepaySubscription(ctx, subscription)
+	k.SetNextPrepaySubscriptionId(ctx, id+1)
+
+	return subscription, nil
+}
+
+// CancelPrepaySubscription removes a prepay subscription owned by sender
+func (k Keeper) CancelPrepaySubscription(ctx sdk.Context, sender sdk.AccAddress, id uint64) error {
+	subscription, found := k.GetPrepaySubscription(ctx, id)
+	if !found || subscription.GetSender() != sender.String() {
+		return errors.Wrapf(types.ErrNoPrepaySubscriptionFound, "subscription %d of sender %s", id, sender.String())
+	}
+
+	k.RemovePrepaySubscription(ctx, subscription)
+	return nil
+}
+
+// ExecuteDuePrepaySubscriptions executes at most PrepaySubscriptionCountPerBlock subscriptions that are due at the current height.
+// A subscription that can not be executed (e.g. insufficient balance) is skipped until its next interval, and removed
+// after MaxPrepaySubscriptionFailures consecutive failures.
+func (k Keeper) ExecuteDuePrepaySubscriptions(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(types.PrepaySubscriptionQueueKeyPrefix,
+		sdk.PrefixEndBytes(types.GetPrepaySubscriptionQueueHeightPrefix(ctx.BlockHeight())))
+
+	dueIds := make([]uint64, 0)
+	for ; iter.Valid() && len(dueIds) < PrepaySubscriptionCountPerBlock; iter.Next() {
+		idBytes := iter.Key()[len(types.PrepaySubscriptionQueueKeyPrefix)+8:]
+		dueIds = append(dueIds, sdk.BigEndianToUint64(idBytes))
+	}
+	iter.Close()
+
+	for _, id := range dueIds {
+		subscription, found := k.GetPrepaySubscription(ctx, id)
+		if !found {
+			continue
+		}
+		k.RemovePrepaySubscription(ctx, subscription)
+
+		if !k.executePrepaySubscription(ctx, &subscription) {
+			continue
+		}
+
+		subscription.NextHeight = ctx.BlockHeight() + subscription.GetInterval()
+		if subscription.GetMaxCount() > 0 && subscription.GetExecutedCount() >= subscription.GetMaxCount() {
+			continue
+		}
+		if subscription.GetEndHeight() > 0 && subscription.GetNextHeight() > subscription.GetEndHeight() {
+			continue
+		}
+		k.SetPrepaySubscription(ctx, subscription)
+	}
+}
+
+// executePrepaySubscription executes the subscription once and returns false when it has to be removed
+func (k Keeper) executePrepaySubscription(ctx sdk.Context, subscription *types.PrepaySubscription) (keep bool) {
+	logger := k.Logger(ctx)
+	subscriptionId := strconv.FormatUint(subscription.GetId(), 10)
+
+	sender, err := sdk.AccAddressFromBech32(subscription.GetSender())
+	if err != nil {
+		logger.Error("invalid sender of prepay subscription", "id", subscriptionId, "ErrMsg", err.Error())
+		return false
+	}
+
+	beneficiary, err := sdk.AccAddressFromBech32(subscription.GetBeneficiary())
+	if err != nil {
+		logger.Error("invalid beneficiary of prepay subscription", "id", subscriptionId, "ErrMsg", err.Error())
+		return false
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+	purchased, err := k.Prepay(cacheCtx, sender, beneficiary, subscription.GetAmount())
+	if err != nil {
+		subscription.FailedCount++
+		keep = subscription.GetFailedCount() < types.MaxPrepaySubscriptionFailures
+		err = ctx.EventManager().EmitTypedEvent(&types.EventPrepaySubscriptionFailed{
+			Sender:         subscription.GetSender(),
+			Beneficiary:    subscription.GetBeneficiary(),
+			SubscriptionId: subscriptionId,
+			Amount:         subscription.GetAmount().String(),
+			Reason:         err.Error(),
+			FailedCount:    strconv.FormatUint(uint64(subscription.GetFailedCount()), 10),
+			Removed:        strconv.FormatBool(!keep),
+		})
+		if err != nil {
+			logger.Error("failed to emit event", "ErrMsg", err.Error())
+		}
+		return keep
+	}
+	write()
+	subscription.ExecutedCount++
+	subscription.FailedCount = 0
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventPrepaySubscriptionExecuted{
+		Sender:         subscription.GetSender(),
+		Beneficiary:    subscription.GetBeneficiary(),
+		SubscriptionId: subscriptionId,
+		Amount:         subscription.GetAmount().String(),
+		PurchasedNoz:   purchased.String(),
+	})
+	if err != nil {
+		logger.Error("failed to emit event", "ErrMsg", err.Error())
+	}
+	return true
+}
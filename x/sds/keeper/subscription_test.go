@@ -0,0 +1,176 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	stratos "github.com/stratosnet/stratos-chain/types"
+	"github.com/stratosnet/stratos-chain/x/sds/types"
+)
+
+// countEvents returns the number of typed events of msg emitted in ctx
+func countEvents(ctx sdk.Context, msg proto.Message) (count int) {
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == proto.MessageName(msg) {
+			count++
+		}
+	}
+	return count
+}
+
+func TestPrepaySubscriptionExecution(t *testing.T) {
+	stApp, ctx := setupApp(t)
+	k := stApp.GetSdsKeeper()
+
+	interval := types.MinPrepaySubscriptionInterval
+	subscription, err := k.CreatePrepaySubscription(ctx, types.NewMsgCreatePrepaySubscription(user(0).String(),
+		user(1).String(), prepayAmount, interval, 2, 0))
+	require.NoError(t, err)
+	require.Equal(t, ctx.BlockHeight()+interval, subscription.GetNextHeight())
+
+	// nothing is due before the first interval elapses
+	ctx = ctx.WithBlockHeight(subscription.GetNextHeight() - 1)
+	k.ExecuteDuePrepaySubscriptions(ctx)
+	require.True(t, k.GetNozBalance(ctx, user(1)).IsZero())
+
+	ctx = ctx.WithBlockHeight(subscription.GetNextHeight()).WithEventManager(sdk.NewEventManager())
+	k.ExecuteDuePrepaySubscriptions(ctx)
+	require.Equal(t, 1, countEvents(ctx, &types.EventPrepaySubscriptionExecuted{}))
+	purchased := k.GetNozBalance(ctx, user(1))
+	require.True(t, purchased.IsPositive())
+
+	subscription, found := k.GetPrepaySubscription(ctx, subscription.GetId())
+	require.True(t, found)
+	require.Equal(t, uint64(1), subscription.GetExecutedCount())
+	require.Equal(t, ctx.BlockHeight()+interval, subscription.GetNextHeight())
+
+	// the subscription is removed once it reaches its max count
+	ctx = ctx.WithBlockHeight(subscription.GetNextHeight()).WithEventManager(sdk.NewEventManager())
+	k.ExecuteDuePrepaySubscriptions(ctx)
+	require.Equal(t, 1, countEvents(ctx, &types.EventPrepaySubscriptionExecuted{}))
+	require.True(t, k.GetNozBalance(ctx, user(1)).GT(purchased))
+	_, found = k.GetPrepaySubscription(ctx, subscription.GetId())
+	require.False(t, found)
+	require.Zero(t, k.GetPrepaySubscriptionCountBySender(ctx, user(0)))
+}
+
+func TestPrepaySubscriptionEndHeight(t *testing.T) {
+	stApp, ctx := setupApp(t)
+	k := stApp.GetSdsKeeper()
+
+	interval := types.MinPrepaySubscriptionInterval
+	_, err := k.CreatePrepaySubscription(ctx, types.NewMsgCreatePrepaySubscription(user(0).String(),
+		user(0).String(), prepayAmount, interval, 0, ctx.BlockHeight()+interval-1))
+	require.ErrorIs(t, err, types.ErrInvalidSubscriptionEndHeight)
+
+	subscription, err := k.CreatePrepaySubscription(ctx, types.NewMsgCreatePrepaySubscription(user(0).String(),
+		user(0).String(), prepayAmount, interval, 0, ctx.BlockHeight()+interval))
+	require.NoError(t, err)
+
+	// the last execution happens at the end height
+	ctx = ctx.WithBlockHeight(subscription.GetNextHeight())
+	k.ExecuteDuePrepaySubscriptions(ctx)
+	require.True(t, k.GetNozBalance(ctx, user(0)).IsPositive())
+	_, found := k.GetPrepaySubscription(ctx, subscription.GetId())
+	require.False(t, found)
+}
+
+func TestPrepaySubscriptionFailures(t *testing.T) {
+	stApp, ctx := setupApp(t)
+	k := stApp.GetSdsKeeper()
+	bankKeeper := stApp.GetBankKeeper()
+
+	// more than the balance of the sender
+	amount := sdk.NewCoins(stratos.NewCoin(initBalance.Add(sdkmath.OneInt())))
+	subscription, err := k.CreatePrepaySubscription(ctx, types.NewMsgCreatePrepaySubscription(user(0).String(),
+		user(1).String(), amount, types.MinPrepaySubscriptionInterval, 0, 0))
+	require.NoError(t, err)
+	balanceBefore := bankKeeper.GetBalance(ctx, user(0), k.BondDenom(ctx))
+
+	for failures := uint32(1); failures <= types.MaxPrepaySubscriptionFailures; failures++ {
+		ctx = ctx.WithBlockHeight(subscription.GetNextHeight()).WithEventManager(sdk.NewEventManager())
+		k.ExecuteDuePrepaySubscriptions(ctx)
+		require.Equal(t, 1, countEvents(ctx, &types.EventPrepaySubscriptionFailed{}))
+		require.True(t, k.GetNozBalance(ctx, user(1)).IsZero())
+		require.True(t, balanceBefore.IsEqual(bankKeeper.GetBalance(ctx, user(0), k.BondDenom(ctx))))
+
+		var found bool
+		subscription, found = k.GetPrepaySubscription(ctx, subscription.GetId())
+		if failures < types.MaxPrepaySubscriptionFailures {
+			// skipped until its next interval
+			require.True(t, found)
+			require.Equal(t, failures, subscription.GetFailedCount())
+			require.Zero(t, subscription.GetExecutedCount())
+			require.Equal(t, ctx.BlockHeight()+types.MinPrepaySubscriptionInterval, subscription.GetNextHeight())
+		} else {
+			require.False(t, found)
+		}
+	}
+}
+
+func TestPrepaySubscriptionFailuresReset(t *testing.T) {
+	stApp, ctx := setupApp(t)
+	k := stApp.GetSdsKeeper()
+	bankKeeper := stApp.GetBankKeeper()
+
+	amount := sdk.NewCoins(stratos.NewCoin(initBalance))
+	subscription, err := k.CreatePrepaySubscription(ctx, types.NewMsgCreatePrepaySubscription(user(0).String(),
+		user(0).String(), amount, types.MinPrepaySubscriptionInterval, 0, 0))
+	require.NoError(t, err)
+
+	// spend part of the balance so that the first execution fails
+	require.NoError(t, bankKeeper.SendCoins(ctx, user(0), user(1), prepayAmount))
+	ctx = ctx.WithBlockHeight(subscription.GetNextHeight())
+	k.ExecuteDuePrepaySubscriptions(ctx)
+	subscription, _ = k.GetPrepaySubscription(ctx, subscription.GetId())
+	require.Equal(t, uint32(1), subscription.GetFailedCount())
+
+	// a successful execution resets the consecutive failures
+	require.NoError(t, bankKeeper.SendCoins(ctx, user(1), user(0), prepayAmount))
+	ctx = ctx.WithBlockHeight(subscription.GetNextHeight())
+	k.ExecuteDuePrepaySubscriptions(ctx)
+	subscription, found := k.GetPrepaySubscription(ctx, subscription.GetId())
+	require.True(t, found)
+	require.Zero(t, subscription.GetFailedCount())
+	require.Equal(t, uint64(1), subscription.GetExecutedCount())
+	require.True(t, k.GetNozBalance(ctx, user(0)).IsPositive())
+}
+
+func TestPrepaySubscriptionCap(t *testing.T) {
+	stApp, ctx := setupApp(t)
+	k := stApp.GetSdsKeeper()
+
+	newMsg := func(interval int64) *types.MsgCreatePrepaySubscription {
+		return types.NewMsgCreatePrepaySubscription(user(0).String(), user(0).String(), prepayAmount, interval, 0, 0)
+	}
+
+	_, err := k.CreatePrepaySubscription(ctx, newMsg(types.MinPrepaySubscriptionInterval-1))
+	require.ErrorIs(t, err, types.ErrInvalidSubscriptionInterval)
+
+	var ids []uint64
+	for i := 0; i < types.MaxPrepaySubscriptionsPerSender; i++ {
+		subscription, err := k.CreatePrepaySubscription(ctx, newMsg(types.MinPrepaySubscriptionInterval))
+		require.NoError(t, err)
+		ids = append(ids, subscription.GetId())
+	}
+	_, err = k.CreatePrepaySubscription(ctx, newMsg(types.MinPrepaySubscriptionInterval))
+	require.ErrorIs(t, err, types.ErrTooManyPrepaySubscriptions)
+
+	// the cap is per sender
+	_, err = k.CreatePrepaySubscription(ctx, types.NewMsgCreatePrepaySubscription(user(1).String(), user(0).String(),
+		prepayAmount, types.MinPrepaySubscriptionInterval, 0, 0))
+	require.NoError(t, err)
+
+	// only the sender can cancel its subscription, which frees a slot
+	require.ErrorIs(t, k.CancelPrepaySubscription(ctx, user(1), ids[0]), types.ErrNoPrepaySubscriptionFound)
+	require.NoError(t, k.CancelPrepaySubscription(ctx, user(0), ids[0]))
+	_, found := k.GetPrepaySubscription(ctx, ids[0])
+	require.False(t, found)
+	_, err = k.CreatePrepaySubscription(ctx, newMsg(types.MinPrepaySubscriptionInterval))
+	require.NoError(t, err)
+}
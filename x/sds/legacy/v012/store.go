@@ -0,0 +1,76 @@
+package v012
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stratosnet/stratos-chain/x/sds/types"
+)
+
+// MigrateStore migrates from version 2 to 3. The params added after v0.11 are not part of the params moved out of the
+// legacy subspace by v011, they are set to their defaults by the migration steps of this package.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.Codec) error {
+	store := ctx.KVStore(storeKey)
+
+	// migrate params
+	if err := migrateParams(store, cdc); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MigrateStorageChallengeParams will set the storage challenge params to their default values and keep the existing ones.
+// The storage challenges stay disabled until StorageChallengeInterval is set by governance.
+func MigrateStorageChallengeParams(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.Codec) error {
+	store := ctx.KVStore(storeKey)
+	params := getParams(store, cdc)
+
+	defaultParams := types.DefaultParams()
+	params.StorageChallengeInterval = defaultParams.StorageChallengeInterval
+	params.StorageChallengesPerRound = defaultParams.StorageChallengesPerRound
+	params.StorageChallengeResponseWindow = defaultParams.StorageChallengeResponseWindow
+	params.StorageChallengeSlashNoz = defaultParams.StorageChallengeSlashNoz
+	params.StorageChallengeMaxFailures = defaultParams.StorageChallengeMaxFailures
+
+	setParams(store, cdc, params)
+	return nil
+}
+
+// MigrateNozRefundParams will set the noz refund fee rate to its default value and keep the existing params
+func MigrateNozRefundParams(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.Codec) error {
+	store := ctx.KVStore(storeKey)
+	params := getParams(store, cdc)
+
+	params.NozRefundFeeRate = types.DefaultParams().NozRefundFeeRate
+
+	setParams(store, cdc, params)
+	return nil
+}
+
+// migrateParams will set the noz price history params to their default values and keep the existing ones
+func migrateParams(store storetypes.KVStore, cdc codec.Codec) error {
+	params := getParams(store, cdc)
+
+	defaultParams := types.DefaultParams()
+	if params.BondDenom == "" {
+		params.BondDenom = defaultParams.BondDenom
+	}
+	params.NozPriceSnapshotInterval = defaultParams.NozPriceSnapshotInterval
+	params.NozPriceHistorySize = defaultParams.NozPriceHistorySize
+
+	setParams(store, cdc, params)
+	return nil
+}
+
+func getParams(store storetypes.KVStore, cdc codec.Codec) (params types.Params) {
+	if bz := store.Get(types.ParamsKey); bz != nil {
+		cdc.MustUnmarshal(bz, &params)
+	}
+	return params
+}
+
+func setParams(store storetypes.KVStore, cdc codec.Codec, params types.Params) {
+	bz := cdc.MustMarshal(&params)
+	store.Set(types.ParamsKey, bz)
+}
@@ -21,6 +21,7 @@ import (
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	modulev1 "github.com/stratosnet/stratos-chain/api/stratos/sds/module/v1"
@@ -32,7 +33,7 @@ import (
 )
 
 const (
-	consensusVersion = 2
+	consensusVersion = 5
 )
 
 // Type check to ensure the interface is properly implemented
@@ -135,10 +136,21 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	if err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
 		panic(fmt.Sprintf("failed to migrate x/%s from version 1 to 2: %v", types.ModuleName, err))
 	}
+	if err := cfg.RegisterMigration(types.ModuleName, 2, m.Migrate2to3); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 2 to 3: %v", types.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 3, m.Migrate3to4); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 3 to 4: %v", types.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 4, m.Migrate4to5); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 4 to 5: %v", types.ModuleName, err))
+	}
 }
 
 // RegisterInvariants registers the sds module invariants.
-func (am AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {}
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	keeper.RegisterInvariants(ir, am.keeper)
+}
 
 // InitGenesis performs genesis initialization for the sds module. It returns
 // no validator updates.
@@ -223,6 +235,7 @@ type ModuleInputs struct {
 	Cdc            codec.Codec
 	Key            *storetypes.KVStoreKey
 	BankKeeper     bankkeeper.Keeper
+	DistrKeeper    distrkeeper.Keeper
 	RegisterKeeper registerkeeper.Keeper
 	PotKeeper      potkeeper.Keeper
 
@@ -248,6 +261,7 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		in.Cdc,
 		in.Key,
 		in.BankKeeper,
+		in.DistrKeeper,
 		&in.RegisterKeeper,
 		in.PotKeeper,
 		authority.String(),
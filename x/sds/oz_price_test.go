@@ -608,7 +608,7 @@ func setupPrepayMsg() *sdstypes.MsgPrepay {
 	sender := resOwner1
 	amount := sdkmath.NewInt(1).MulRaw(stratos.StosToWei)
 	coin := sdk.NewCoin(stratos.Wei, amount)
-	prepayMsg := sdstypes.NewMsgPrepay(sender.String(), sender.String(), sdk.NewCoins(coin))
+	prepayMsg := sdstypes.NewMsgPrepay(sender.String(), sender.String(), sdk.NewCoins(coin), sdkmath.ZeroInt(), 0)
 	return prepayMsg
 }
 
@@ -616,7 +616,7 @@ func setupPrepayMsgWithResOwner(resOwner sdk.AccAddress) *sdstypes.MsgPrepay {
 	sender := resOwner
 	amount := sdkmath.NewInt(3).MulRaw(stratos.StosToWei)
 	coin := sdk.NewCoin(stratos.Wei, amount)
-	prepayMsg := sdstypes.NewMsgPrepay(sender.String(), sender.String(), sdk.NewCoins(coin))
+	prepayMsg := sdstypes.NewMsgPrepay(sender.String(), sender.String(), sdk.NewCoins(coin), sdkmath.ZeroInt(), 0)
 	return prepayMsg
 }
 
@@ -17,6 +17,16 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	// this line is used by starport scaffolding # 1
 	cdc.RegisterConcrete(MsgFileUpload{}, "sds/FileUploadTx", nil)
 	cdc.RegisterConcrete(MsgPrepay{}, "sds/PrepayTx", nil)
+	cdc.RegisterConcrete(MsgCreatePrepaySubscription{}, "sds/CreatePrepaySubscriptionTx", nil)
+	cdc.RegisterConcrete(MsgCancelPrepaySubscription{}, "sds/CancelPrepaySubscriptionTx", nil)
+	cdc.RegisterConcrete(MsgTransferNoz{}, "sds/TransferNozTx", nil)
+	cdc.RegisterConcrete(MsgApproveNoz{}, "sds/ApproveNozTx", nil)
+	cdc.RegisterConcrete(MsgTransferNozFrom{}, "sds/TransferNozFromTx", nil)
+	cdc.RegisterConcrete(MsgGrantFileAccess{}, "sds/GrantFileAccessTx", nil)
+	cdc.RegisterConcrete(MsgRevokeFileAccess{}, "sds/RevokeFileAccessTx", nil)
+	cdc.RegisterConcrete(MsgSubmitStorageProof{}, "sds/SubmitStorageProofTx", nil)
+	cdc.RegisterConcrete(MsgRefundNoz{}, "sds/RefundNozTx", nil)
+	cdc.RegisterConcrete(MsgDeleteFile{}, "sds/DeleteFileTx", nil)
 	cdc.RegisterConcrete(MsgUpdateParams{}, "sds/UpdateParamsTx", nil)
 }
 
@@ -25,6 +35,16 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgFileUpload{},
 		&MsgPrepay{},
+		&MsgCreatePrepaySubscription{},
+		&MsgCancelPrepaySubscription{},
+		&MsgTransferNoz{},
+		&MsgApproveNoz{},
+		&MsgTransferNozFrom{},
+		&MsgGrantFileAccess{},
+		&MsgRevokeFileAccess{},
+		&MsgSubmitStorageProof{},
+		&MsgRefundNoz{},
+		&MsgDeleteFile{},
 		&MsgUpdateParams{},
 	)
 	registry.RegisterImplementations(
@@ -19,21 +19,83 @@ const (
 	codeErrInvalidBeneficiaryAddr
 	codeErrOzoneLimitNotEnough
 	codeErrEmitEvent
+	codeErrInvalidMinNozOut
+	codeErrInvalidDeadlineHeight
+	codeErrPrepayDeadlineExceeded
+	codeErrPrepaySlippageExceeded
+	codeErrInvalidSubscriptionInterval
+	codeErrInvalidSubscriptionEndHeight
+	codeErrNoPrepaySubscriptionFound
+	codeErrInvalidTWAPWindow
+	codeErrNoNozPriceHistory
+	codeErrInvalidRecipientAddr
+	codeErrInvalidOwnerAddr
+	codeErrInvalidSpenderAddr
+	codeErrInvalidNozAmount
+	codeErrInsufficientNozBalance
+	codeErrInsufficientNozAllowance
+	codeErrInvalidGranterAddr
+	codeErrInvalidGranteeAddr
+	codeErrNotFileUploader
+	codeErrNoFileAccessFound
+	codeErrInvalidSegmentRoot
+	codeErrNoStorageChallengeFound
+	codeErrNotResourceNodeOwner
+	codeErrInsufficientUnissuedPrepay
+	codeErrRefundFailure
+	codeErrStorageRentExists
+	codeErrNoStorageRentFound
+	codeErrInvalidStorageRentDuration
+	codeErrInvalidReplicas
+	codeErrTooManyPrepaySubscriptions
+	codeErrInvalidRefundNonce
+	codeErrBLSTxDataInvalid
 )
 
 var (
-	ErrInvalid                = errors.Register(ModuleName, codeErrInvalid, "error invalid")
-	ErrInvalidHeight          = errors.Register(ModuleName, codeErrInvalidHeight, "invalid height")
-	ErrEmptyUploaderAddr      = errors.Register(ModuleName, codeErrEmptyUploaderAddr, "missing uploader address")
-	ErrEmptyReporters         = errors.Register(ModuleName, codeErrEmptyReporters, "missing reporters")
-	ErrEmptyFileHash          = errors.Register(ModuleName, codeErrEmptyFileHash, "missing file hash")
-	ErrInvalidFileHash        = errors.Register(ModuleName, codeErrInvalidFileHash, "invalid file hash")
-	ErrNoFileFound            = errors.Register(ModuleName, codeErrNoFileFound, "file does not exist")
-	ErrInvalidDenom           = errors.Register(ModuleName, codeErrInvalidDenom, "invalid denomination")
-	ErrPrepayFailure          = errors.Register(ModuleName, codeErrPrepayFailure, "failure during prepay")
-	ErrReporterAddressOrOwner = errors.Register(ModuleName, codeErrReporterAddressOrOwner, "invalid reporter address or owner address")
-	ErrInvalidSenderAddr      = errors.Register(ModuleName, codeErrInvalidSenderAddr, "invalid sender address")
-	ErrInvalidBeneficiaryAddr = errors.Register(ModuleName, codeErrInvalidBeneficiaryAddr, "invalid beneficiary address")
-	ErrOzoneLimitNotEnough    = errors.Register(ModuleName, codeErrOzoneLimitNotEnough, "not enough remaining ozone limit to complete prepay")
-	ErrEmitEvent              = errors.Register(ModuleName, codeErrEmitEvent, "failed to emit event")
+	ErrInvalid                      = errors.Register(ModuleName, codeErrInvalid, "error invalid")
+	ErrInvalidHeight                = errors.Register(ModuleName, codeErrInvalidHeight, "invalid height")
+	ErrEmptyUploaderAddr            = errors.Register(ModuleName, codeErrEmptyUploaderAddr, "missing uploader address")
+	ErrEmptyReporters               = errors.Register(ModuleName, codeErrEmptyReporters, "missing reporters")
+	ErrEmptyFileHash                = errors.Register(ModuleName, codeErrEmptyFileHash, "missing file hash")
+	ErrInvalidFileHash              = errors.Register(ModuleName, codeErrInvalidFileHash, "invalid file hash")
+	ErrNoFileFound                  = errors.Register(ModuleName, codeErrNoFileFound, "file does not exist")
+	ErrInvalidDenom                 = errors.Register(ModuleName, codeErrInvalidDenom, "invalid denomination")
+	ErrPrepayFailure                = errors.Register(ModuleName, codeErrPrepayFailure, "failure during prepay")
+	ErrReporterAddressOrOwner       = errors.Register(ModuleName, codeErrReporterAddressOrOwner, "invalid reporter address or owner address")
+	ErrInvalidSenderAddr            = errors.Register(ModuleName, codeErrInvalidSenderAddr, "invalid sender address")
+	ErrInvalidBeneficiaryAddr       = errors.Register(ModuleName, codeErrInvalidBeneficiaryAddr, "invalid beneficiary address")
+	ErrOzoneLimitNotEnough          = errors.Register(ModuleName, codeErrOzoneLimitNotEnough, "not enough remaining ozone limit to complete prepay")
+	ErrEmitEvent                    = errors.Register(ModuleName, codeErrEmitEvent, "failed to emit event")
+	ErrInvalidMinNozOut             = errors.Register(ModuleName, codeErrInvalidMinNozOut, "invalid min noz out")
+	ErrInvalidDeadlineHeight        = errors.Register(ModuleName, codeErrInvalidDeadlineHeight, "invalid deadline height")
+	ErrPrepayDeadlineExceeded       = errors.Register(ModuleName, codeErrPrepayDeadlineExceeded, "prepay deadline height exceeded")
+	ErrPrepaySlippageExceeded       = errors.Register(ModuleName, codeErrPrepaySlippageExceeded, "purchased noz is less than min noz out")
+	ErrInvalidSubscriptionInterval  = errors.Register(ModuleName, codeErrInvalidSubscriptionInterval, "invalid prepay subscription interval")
+	ErrInvalidSubscriptionEndHeight = errors.Register(ModuleName, codeErrInvalidSubscriptionEndHeight, "invalid prepay subscription end height")
+	ErrNoPrepaySubscriptionFound    = errors.Register(ModuleName, codeErrNoPrepaySubscriptionFound, "prepay subscription does not exist")
+	ErrInvalidTWAPWindow            = errors.Register(ModuleName, codeErrInvalidTWAPWindow, "invalid noz price twap window")
+	ErrNoNozPriceHistory            = errors.Register(ModuleName, codeErrNoNozPriceHistory, "no noz price history recorded")
+	ErrInvalidRecipientAddr         = errors.Register(ModuleName, codeErrInvalidRecipientAddr, "invalid recipient address")
+	ErrInvalidOwnerAddr             = errors.Register(ModuleName, codeErrInvalidOwnerAddr, "invalid owner address")
+	ErrInvalidSpenderAddr           = errors.Register(ModuleName, codeErrInvalidSpenderAddr, "invalid spender address")
+	ErrInvalidNozAmount             = errors.Register(ModuleName, codeErrInvalidNozAmount, "invalid noz amount")
+	ErrInsufficientNozBalance       = errors.Register(ModuleName, codeErrInsufficientNozBalance, "insufficient noz balance")
+	ErrInsufficientNozAllowance     = errors.Register(ModuleName, codeErrInsufficientNozAllowance, "insufficient noz allowance")
+	ErrInvalidGranterAddr           = errors.Register(ModuleName, codeErrInvalidGranterAddr, "invalid granter address")
+	ErrInvalidGranteeAddr           = errors.Register(ModuleName, codeErrInvalidGranteeAddr, "invalid grantee address")
+	ErrNotFileUploader              = errors.Register(ModuleName, codeErrNotFileUploader, "signer is not the uploader of the file")
+	ErrNoFileAccessFound            = errors.Register(ModuleName, codeErrNoFileAccessFound, "file access grant does not exist")
+	ErrInvalidSegmentRoot           = errors.Register(ModuleName, codeErrInvalidSegmentRoot, "invalid file segment root")
+	ErrNoStorageChallengeFound      = errors.Register(ModuleName, codeErrNoStorageChallengeFound, "storage challenge does not exist")
+	ErrNotResourceNodeOwner         = errors.Register(ModuleName, codeErrNotResourceNodeOwner, "sender is not the owner of the resource node")
+	ErrInsufficientUnissuedPrepay   = errors.Register(ModuleName, codeErrInsufficientUnissuedPrepay, "insufficient unissued prepay to refund noz")
+	ErrRefundFailure                = errors.Register(ModuleName, codeErrRefundFailure, "failure during noz refund")
+	ErrStorageRentExists            = errors.Register(ModuleName, codeErrStorageRentExists, "storage rent of the file already exists")
+	ErrNoStorageRentFound           = errors.Register(ModuleName, codeErrNoStorageRentFound, "storage rent does not exist")
+	ErrInvalidStorageRentDuration   = errors.Register(ModuleName, codeErrInvalidStorageRentDuration, "invalid storage rent duration")
+	ErrInvalidReplicas              = errors.Register(ModuleName, codeErrInvalidReplicas, "invalid number of replicas")
+	ErrTooManyPrepaySubscriptions   = errors.Register(ModuleName, codeErrTooManyPrepaySubscriptions, "too many prepay subscriptions")
+	ErrInvalidRefundNonce           = errors.Register(ModuleName, codeErrInvalidRefundNonce, "invalid refund nonce")
+	ErrBLSTxDataInvalid             = errors.Register(ModuleName, codeErrBLSTxDataInvalid, "BLS signed txData is invalid")
 )
@@ -4,12 +4,19 @@ import (
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stratos "github.com/stratosnet/stratos-chain/types"
+	registertypes "github.com/stratosnet/stratos-chain/x/register/types"
 )
 
 // BankKeeper defines the expected interface needed to retrieve account balances.
 type BankKeeper interface {
 	HasBalance(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coin) bool
 	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+}
+
+// DistrKeeper defines the expected interface needed to fund the community pool.
+type DistrKeeper interface {
+	FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error
 }
 
 type RegisterKeeper interface {
@@ -18,12 +25,20 @@ type RegisterKeeper interface {
 	GetMetaNodeBitMapIndex(ctx sdk.Context, networkAddr stratos.SdsAddress) (index int, err error)
 	GetRemainingOzoneLimit(ctx sdk.Context) (value sdkmath.Int)
 	GetTotalUnissuedPrepay(ctx sdk.Context) (totalUnissuedPrepay sdk.Coin)
-	SetRemainingOzoneLimit(ctx sdk.Context, value sdkmath.Int)
+	GetPrepaidOzone(ctx sdk.Context) (value sdkmath.Int)
+	IssuePrepaidOzone(ctx sdk.Context, amount sdkmath.Int) error
+	ReturnPrepaidOzone(ctx sdk.Context, amount sdkmath.Int)
 	OwnMetaNode(ctx sdk.Context, ownerAddr sdk.AccAddress, p2pAddr stratos.SdsAddress) bool
 	CalculatePurchaseAmount(ctx sdk.Context, amount sdkmath.Int) (sdkmath.Int, sdkmath.Int, error)
+	GetResourceNode(ctx sdk.Context, p2pAddress stratos.SdsAddress) (resourceNode registertypes.ResourceNode, found bool)
+	VerifyMetaNodeThresholdSignature(ctx sdk.Context, data, signature []byte, pubKeys [][]byte) error
 }
 
 type PotKeeper interface {
 	GetCurrentNozPrice(St, Pt, Lt sdkmath.Int) (currentNozPrice sdkmath.LegacyDec)
 	NozSupply(ctx sdk.Context) (remaining, total sdkmath.Int)
+	SlashingResourceNode(ctx sdk.Context, p2pAddr stratos.SdsAddress, walletAddr sdk.AccAddress,
+		nozAmt sdkmath.Int, suspend bool) (tokenAmt sdkmath.Int, nodeType registertypes.NodeType, err error)
+	RewardStorageRent(ctx sdk.Context, walletAddr sdk.AccAddress, nozAmt sdkmath.Int, epoch sdkmath.Int) (reward sdk.Coin, err error)
+	GetLastDistributedEpoch(ctx sdk.Context) (epoch sdkmath.Int)
 }
@@ -3,22 +3,42 @@ package types
 import (
 	"encoding/json"
 
+	"cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	stratos "github.com/stratosnet/stratos-chain/types"
 )
 
 // NewGenesisState creates a new GenesisState object
-func NewGenesisState(params Params, files []GenesisFileInfo) *GenesisState {
+func NewGenesisState(params Params, files []GenesisFileInfo, subscriptions []PrepaySubscription, nextSubscriptionId uint64,
+	nozPriceHistory []NozPriceSnapshot, nozBalances []NozBalance, nozAllowances []NozAllowance,
+	fileAccessGrants []FileAccessGrant, storageChallenges []StorageChallenge, nextStorageChallengeId uint64,
+	storageChallengeFailures []StorageChallengeFailure, storageRents []StorageRent, refundNonces []RefundNonce) *GenesisState {
 	return &GenesisState{
-		Params: params,
-		Files:  files,
+		Params:                   params,
+		Files:                    files,
+		PrepaySubscriptions:      subscriptions,
+		NextPrepaySubscriptionId: nextSubscriptionId,
+		NozPriceHistory:          nozPriceHistory,
+		NozBalances:              nozBalances,
+		NozAllowances:            nozAllowances,
+		FileAccessGrants:         fileAccessGrants,
+		StorageChallenges:        storageChallenges,
+		NextStorageChallengeId:   nextStorageChallengeId,
+		StorageChallengeFailures: storageChallengeFailures,
+		StorageRents:             storageRents,
+		RefundNonces:             refundNonces,
 	}
 }
 
 // DefaultGenesisState - default GenesisState used by Cosmos Hub
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		Params: DefaultParams(),
+		Params:                   DefaultParams(),
+		NextPrepaySubscriptionId: 1,
+		NextStorageChallengeId:   1,
 	}
 }
 
@@ -53,6 +73,141 @@ func ValidateGenesis(data GenesisState) error {
 			if len(file.FileInfo.Uploader) == 0 {
 				return ErrEmptyUploaderAddr
 			}
+			if len(file.FileInfo.SegmentRoot) > 0 && len(file.FileInfo.SegmentRoot) != SegmentRootLength {
+				return errors.Wrapf(ErrInvalidSegmentRoot, "segment root of file %s", file.FileHash)
+			}
+		}
+	}
+
+	seenIds := make(map[uint64]bool)
+	for _, subscription := range data.GetPrepaySubscriptions() {
+		if seenIds[subscription.GetId()] {
+			return errors.Wrapf(ErrInvalid, "duplicated prepay subscription id %d", subscription.GetId())
+		}
+		seenIds[subscription.GetId()] = true
+		if subscription.GetId() >= data.GetNextPrepaySubscriptionId() {
+			return errors.Wrapf(ErrInvalid, "prepay subscription id %d is not lower than next id %d",
+				subscription.GetId(), data.GetNextPrepaySubscriptionId())
+		}
+		if _, err := sdk.AccAddressFromBech32(subscription.GetSender()); err != nil {
+			return ErrInvalidSenderAddr
+		}
+		if _, err := sdk.AccAddressFromBech32(subscription.GetBeneficiary()); err != nil {
+			return ErrInvalidBeneficiaryAddr
+		}
+		if !subscription.GetAmount().IsValid() || subscription.GetAmount().IsZero() {
+			return errors.Wrapf(ErrInvalid, "invalid amount of prepay subscription %d", subscription.GetId())
+		}
+		if subscription.GetInterval() <= 0 {
+			return ErrInvalidSubscriptionInterval
+		}
+	}
+
+	lastHeight := int64(0)
+	for _, snapshot := range data.GetNozPriceHistory() {
+		if snapshot.Height <= lastHeight {
+			return errors.Wrapf(ErrInvalidHeight, "noz price snapshots must be sorted by height, got %d after %d",
+				snapshot.Height, lastHeight)
+		}
+		lastHeight = snapshot.Height
+		if snapshot.Price.IsNil() || snapshot.Price.IsNegative() {
+			return errors.Wrapf(ErrInvalid, "invalid noz price of snapshot at height %d", snapshot.Height)
+		}
+	}
+
+	seenOwners := make(map[string]bool)
+	for _, balance := range data.GetNozBalances() {
+		if _, err := sdk.AccAddressFromBech32(balance.GetOwner()); err != nil {
+			return ErrInvalidOwnerAddr
+		}
+		if seenOwners[balance.GetOwner()] {
+			return errors.Wrapf(ErrInvalid, "duplicated noz balance of %s", balance.GetOwner())
+		}
+		seenOwners[balance.GetOwner()] = true
+		if balance.Amount.IsNil() || !balance.Amount.IsPositive() {
+			return errors.Wrapf(ErrInvalidNozAmount, "noz balance of %s", balance.GetOwner())
+		}
+	}
+
+	for _, allowance := range data.GetNozAllowances() {
+		if _, err := sdk.AccAddressFromBech32(allowance.GetOwner()); err != nil {
+			return ErrInvalidOwnerAddr
+		}
+		if _, err := sdk.AccAddressFromBech32(allowance.GetSpender()); err != nil {
+			return ErrInvalidSpenderAddr
+		}
+		if allowance.Amount.IsNil() || !allowance.Amount.IsPositive() {
+			return errors.Wrapf(ErrInvalidNozAmount, "noz allowance of %s for %s", allowance.GetOwner(), allowance.GetSpender())
+		}
+	}
+
+	for _, grant := range data.GetFileAccessGrants() {
+		if len(grant.GetFileHash()) == 0 {
+			return ErrEmptyFileHash
+		}
+		if _, err := sdk.AccAddressFromBech32(grant.GetGranter()); err != nil {
+			return ErrInvalidGranterAddr
+		}
+		if _, err := sdk.AccAddressFromBech32(grant.GetGrantee()); err != nil {
+			return ErrInvalidGranteeAddr
+		}
+		if grant.GetExpiryHeight() < 0 {
+			return errors.Wrapf(ErrInvalidHeight, "expiry height of file access grant %s %s", grant.GetFileHash(), grant.GetGrantee())
+		}
+		if grant.Fee.IsNil() || grant.Fee.IsNegative() {
+			return errors.Wrapf(ErrInvalidNozAmount, "fee of file access grant %s %s", grant.GetFileHash(), grant.GetGrantee())
+		}
+	}
+
+	seenIds = make(map[uint64]bool)
+	for _, challenge := range data.GetStorageChallenges() {
+		if seenIds[challenge.GetId()] {
+			return errors.Wrapf(ErrInvalid, "duplicated storage challenge id %d", challenge.GetId())
+		}
+		seenIds[challenge.GetId()] = true
+		if challenge.GetId() >= data.GetNextStorageChallengeId() {
+			return errors.Wrapf(ErrInvalid, "storage challenge id %d is not lower than next id %d",
+				challenge.GetId(), data.GetNextStorageChallengeId())
+		}
+		if _, err := stratos.SdsAddressFromBech32(challenge.GetNetworkAddress()); err != nil {
+			return errors.Wrapf(ErrInvalid, "invalid network address of storage challenge %d", challenge.GetId())
+		}
+		if len(challenge.GetFileHash()) == 0 {
+			return ErrEmptyFileHash
+		}
+		if challenge.GetDeadlineHeight() < challenge.GetHeight() {
+			return errors.Wrapf(ErrInvalidHeight, "deadline height of storage challenge %d", challenge.GetId())
+		}
+	}
+
+	for _, failure := range data.GetStorageChallengeFailures() {
+		if _, err := stratos.SdsAddressFromBech32(failure.GetNetworkAddress()); err != nil {
+			return errors.Wrapf(ErrInvalid, "invalid network address of storage challenge failure %s", failure.GetNetworkAddress())
+		}
+	}
+
+	for _, rent := range data.GetStorageRents() {
+		if len(rent.GetFileHash()) == 0 {
+			return ErrEmptyFileHash
+		}
+		if _, err := sdk.AccAddressFromBech32(rent.GetUploader()); err != nil {
+			return errors.Wrapf(ErrInvalid, "invalid uploader address of storage rent %s", rent.GetFileHash())
+		}
+		if rent.Amount.IsNil() || rent.Remaining.IsNil() || rent.Remaining.IsNegative() || rent.Remaining.GT(rent.Amount) {
+			return errors.Wrapf(ErrInvalidNozAmount, "storage rent %s", rent.GetFileHash())
+		}
+		if rent.GetReplicas() == 0 {
+			return errors.Wrapf(ErrInvalidReplicas, "storage rent %s", rent.GetFileHash())
+		}
+		if rent.GetLastPayoutEpoch() < rent.GetStartEpoch() || rent.GetNextPayoutEpoch() <= rent.GetLastPayoutEpoch() ||
+			rent.GetNextPayoutEpoch() > rent.GetEndEpoch() {
+			return errors.Wrapf(ErrInvalidStorageRentDuration, "payout epochs of storage rent %s", rent.GetFileHash())
+		}
+	}
+
+	for _, refundNonce := range data.GetRefundNonces() {
+		if _, err := sdk.AccAddressFromBech32(refundNonce.GetSender()); err != nil {
+			return errors.Wrapf(ErrInvalid, "invalid sender address of refund nonce %s", refundNonce.GetSender())
 		}
 	}
 	return nil
@@ -26,8 +26,19 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the register module's genesis state.
 type GenesisState struct {
-	Params Params            `protobuf:"bytes,1,opt,name=params,proto3" json:"params" yaml:"params"`
-	Files  []GenesisFileInfo `protobuf:"bytes,2,rep,name=files,proto3" json:"files" yaml:"files"`
+	Params                   Params                    `protobuf:"bytes,1,opt,name=params,proto3" json:"params" yaml:"params"`
+	Files                    []GenesisFileInfo         `protobuf:"bytes,2,rep,name=files,proto3" json:"files" yaml:"files"`
+	PrepaySubscriptions      []PrepaySubscription      `protobuf:"bytes,3,rep,name=prepay_subscriptions,json=prepaySubscriptions,proto3" json:"prepay_subscriptions" yaml:"prepay_subscriptions"`
+	NextPrepaySubscriptionId uint64                    `protobuf:"varint,4,opt,name=next_prepay_subscription_id,json=nextPrepaySubscriptionId,proto3" json:"next_prepay_subscription_id" yaml:"next_prepay_subscription_id"`
+	NozPriceHistory          []NozPriceSnapshot        `protobuf:"bytes,5,rep,name=noz_price_history,json=nozPriceHistory,proto3" json:"noz_price_history" yaml:"noz_price_history"`
+	NozBalances              []NozBalance              `protobuf:"bytes,6,rep,name=noz_balances,json=nozBalances,proto3" json:"noz_balances" yaml:"noz_balances"`
+	NozAllowances            []NozAllowance            `protobuf:"bytes,7,rep,name=noz_allowances,json=nozAllowances,proto3" json:"noz_allowances" yaml:"noz_allowances"`
+	FileAccessGrants         []FileAccessGrant         `protobuf:"bytes,8,rep,name=file_access_grants,json=fileAccessGrants,proto3" json:"file_access_grants" yaml:"file_access_grants"`
+	StorageChallenges        []StorageChallenge        `protobuf:"bytes,9,rep,name=storage_challenges,json=storageChallenges,proto3" json:"storage_challenges" yaml:"storage_challenges"`
+	NextStorageChallengeId   uint64                    `protobuf:"varint,10,opt,name=next_storage_challenge_id,json=nextStorageChallengeId,proto3" json:"next_storage_challenge_id" yaml:"next_storage_challenge_id"`
+	StorageChallengeFailures []StorageChallengeFailure `protobuf:"bytes,11,rep,name=storage_challenge_failures,json=storageChallengeFailures,proto3" json:"storage_challenge_failures" yaml:"storage_challenge_failures"`
+	StorageRents             []StorageRent             `protobuf:"bytes,12,rep,name=storage_rents,json=storageRents,proto3" json:"storage_rents" yaml:"storage_rents"`
+	RefundNonces             []RefundNonce             `protobuf:"bytes,13,rep,name=refund_nonces,json=refundNonces,proto3" json:"refund_nonces" yaml:"refund_nonces"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -77,6 +88,83 @@ func (m *GenesisState) GetFiles() []GenesisFileInfo {
 	return nil
 }
 
+func (m *GenesisState) GetPrepaySubscriptions() []PrepaySubscription {
+	if m != nil {
+		return m.PrepaySubscriptions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextPrepaySubscriptionId() uint64 {
+	if m != nil {
+		return m.NextPrepaySubscriptionId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetNozPriceHistory() []NozPriceSnapshot {
+	if m != nil {
+		return m.NozPriceHistory
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNozBalances() []NozBalance {
+	if m != nil {
+		return m.NozBalances
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNozAllowances() []NozAllowance {
+	if m != nil {
+		return m.NozAllowances
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFileAccessGrants() []FileAccessGrant {
+	if m != nil {
+		return m.FileAccessGrants
+	}
+	return nil
+}
+
+func (m *GenesisState) GetStorageChallenges() []StorageChallenge {
+	if m != nil {
+		return m.StorageChallenges
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextStorageChallengeId() uint64 {
+	if m != nil {
+		return m.NextStorageChallengeId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetStorageChallengeFailures() []StorageChallengeFailure {
+	if m != nil {
+		return m.StorageChallengeFailures
+	}
+	return nil
+}
+
+func (m *GenesisState) GetStorageRents() []StorageRent {
+	if m != nil {
+		return m.StorageRents
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRefundNonces() []RefundNonce {
+	if m != nil {
+		return m.RefundNonces
+	}
+	return nil
+}
+
 type GenesisFileInfo struct {
 	FileHash string   `protobuf:"bytes,1,opt,name=file_hash,json=fileHash,proto3" json:"file_hash" yaml:"file_hash"`
 	FileInfo FileInfo `protobuf:"bytes,2,opt,name=file_info,json=fileInfo,proto3" json:"file_info" yaml:"file_info"`
@@ -137,30 +225,59 @@ func init() {
 func init() { proto.RegisterFile("stratos/sds/v1/genesis.proto", fileDescriptor_a3396301dd7676d6) }
 
 var fileDescriptor_a3396301dd7676d6 = []byte{
-	// 363 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x29, 0x2e, 0x29, 0x4a,
-	0x2c, 0xc9, 0x2f, 0xd6, 0x2f, 0x4e, 0x29, 0xd6, 0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d,
-	0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x83, 0xca, 0xea, 0x15, 0xa7, 0x14,
-	0xeb, 0x95, 0x19, 0x4a, 0x89, 0xa4, 0xe7, 0xa7, 0xe7, 0x83, 0xa5, 0xf4, 0x41, 0x2c, 0x88, 0x2a,
-	0x29, 0xc1, 0xc4, 0xdc, 0xcc, 0xbc, 0x7c, 0x7d, 0x30, 0x09, 0x15, 0x92, 0x40, 0x33, 0x16, 0xa4,
-	0x1f, 0x2c, 0xa3, 0xb4, 0x9f, 0x91, 0x8b, 0xc7, 0x1d, 0x62, 0x49, 0x70, 0x49, 0x62, 0x49, 0xaa,
-	0x50, 0x08, 0x17, 0x5b, 0x41, 0x62, 0x51, 0x62, 0x6e, 0xb1, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0xb7,
-	0x91, 0x98, 0x1e, 0xaa, 0xa5, 0x7a, 0x01, 0x60, 0x59, 0x27, 0x95, 0x13, 0xf7, 0xe4, 0x19, 0x5e,
-	0xdd, 0x93, 0x87, 0xaa, 0xfe, 0x74, 0x4f, 0x9e, 0xb7, 0x32, 0x31, 0x37, 0xc7, 0x4a, 0x09, 0xc2,
-	0x57, 0x5a, 0xf1, 0x7c, 0x83, 0x16, 0x63, 0x10, 0x54, 0x56, 0x28, 0x92, 0x8b, 0x35, 0x2d, 0x33,
-	0x27, 0xb5, 0x58, 0x82, 0x49, 0x81, 0x59, 0x83, 0xdb, 0x48, 0x1e, 0xdd, 0x50, 0xa8, 0x13, 0xdc,
-	0x32, 0x73, 0x52, 0x3d, 0xf3, 0xd2, 0xf2, 0x9d, 0x94, 0xa0, 0xa6, 0x43, 0x74, 0x7d, 0xba, 0x27,
-	0xcf, 0x03, 0x31, 0x1c, 0xcc, 0x85, 0x9a, 0x0d, 0x91, 0x53, 0x5a, 0xcf, 0xc8, 0xc5, 0x8f, 0xa6,
-	0x5d, 0xc8, 0x8e, 0x8b, 0x13, 0x24, 0x19, 0x9f, 0x91, 0x58, 0x9c, 0x01, 0xf6, 0x07, 0xa7, 0x93,
-	0xe2, 0xab, 0x7b, 0xf2, 0x08, 0xc1, 0x4f, 0xf7, 0xe4, 0x05, 0x10, 0x26, 0x82, 0x85, 0x94, 0x82,
-	0x38, 0x40, 0x6c, 0x8f, 0xc4, 0xe2, 0x0c, 0xa1, 0x18, 0xa8, 0xfe, 0xcc, 0xbc, 0xb4, 0x7c, 0x09,
-	0x26, 0x70, 0x38, 0x48, 0xa0, 0x3b, 0x19, 0xee, 0x56, 0x55, 0xa8, 0x5b, 0x11, 0x5a, 0xd0, 0x4c,
-	0x07, 0x09, 0x41, 0x4d, 0x07, 0x6b, 0xf0, 0x5d, 0xf1, 0x48, 0x8e, 0xf1, 0xc4, 0x23, 0x39, 0xc6,
-	0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39,
-	0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0xf4, 0xd3, 0x33, 0x4b, 0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3,
-	0x73, 0xf5, 0xa1, 0x56, 0xe6, 0xa5, 0x96, 0xc0, 0x98, 0xba, 0xc9, 0x19, 0x89, 0x99, 0x79, 0xfa,
-	0x15, 0xe0, 0x98, 0x2c, 0xa9, 0x2c, 0x48, 0x2d, 0x4e, 0x62, 0x03, 0xc7, 0xa4, 0x31, 0x20, 0x00,
-	0x00, 0xff, 0xff, 0x8e, 0xac, 0xcf, 0x21, 0x3c, 0x02, 0x00, 0x00,
+	// 830 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x55, 0x4f, 0x6f, 0xdc, 0x44,
+	0x14, 0x8f, 0xfb, 0x27, 0x34, 0x93, 0x4d, 0xdb, 0x4c, 0x43, 0xe5, 0x26, 0xd5, 0xce, 0x76, 0x04,
+	0x22, 0x02, 0x75, 0xad, 0x16, 0x21, 0x24, 0x90, 0x90, 0x62, 0x44, 0xd3, 0x1c, 0xa8, 0x2a, 0x87,
+	0x0b, 0x08, 0xc9, 0x9a, 0x78, 0x67, 0xd7, 0x46, 0xce, 0x8c, 0xe5, 0xe7, 0x94, 0xa6, 0xe2, 0x06,
+	0x42, 0x1c, 0x11, 0x9f, 0xa2, 0x27, 0xe0, 0x63, 0xf4, 0xd8, 0x23, 0xa7, 0x11, 0x4a, 0x0e, 0xa0,
+	0x1c, 0xfd, 0x09, 0xd0, 0x8c, 0x67, 0xeb, 0xf5, 0x9f, 0x84, 0x4b, 0x64, 0xbf, 0xdf, 0xbf, 0xe7,
+	0x97, 0x37, 0x3b, 0xe8, 0x2e, 0x14, 0x39, 0x2b, 0x24, 0x78, 0x30, 0x01, 0xef, 0xd9, 0x03, 0x6f,
+	0xc6, 0x05, 0x87, 0x04, 0xc6, 0x59, 0x2e, 0x0b, 0x89, 0xaf, 0x5b, 0x74, 0x0c, 0x13, 0x18, 0x3f,
+	0x7b, 0xb0, 0xb9, 0x31, 0x93, 0x33, 0x69, 0x20, 0x4f, 0x3f, 0x55, 0xac, 0xcd, 0x75, 0x76, 0x98,
+	0x08, 0xe9, 0x99, 0xbf, 0xb6, 0xe4, 0xb6, 0x6c, 0xb5, 0xde, 0x20, 0xf4, 0xdf, 0x01, 0x1a, 0xec,
+	0x56, 0x21, 0xfb, 0x05, 0x2b, 0x38, 0xfe, 0x0a, 0x2d, 0x67, 0x2c, 0x67, 0x87, 0xe0, 0x3a, 0x23,
+	0x67, 0x7b, 0xf5, 0xe1, 0xed, 0x71, 0x33, 0x74, 0xfc, 0xd4, 0xa0, 0xfe, 0x3b, 0xaf, 0x14, 0x59,
+	0x3a, 0x53, 0xc4, 0xb2, 0x4b, 0x45, 0xd6, 0x8e, 0xd9, 0x61, 0xfa, 0x09, 0xad, 0xde, 0xe9, 0xcb,
+	0x7f, 0xfe, 0x7c, 0xdf, 0x09, 0x2c, 0x8a, 0xbf, 0x46, 0x57, 0xa7, 0x49, 0xca, 0xc1, 0xbd, 0x34,
+	0xba, 0xbc, 0xbd, 0xfa, 0x90, 0xb4, 0x4d, 0x6d, 0x0b, 0x8f, 0x92, 0x94, 0xef, 0x89, 0xa9, 0xf4,
+	0xa9, 0x75, 0xaf, 0x54, 0xa5, 0x22, 0x83, 0xca, 0xdc, 0xbc, 0x5a, 0xef, 0x0a, 0xc3, 0xbf, 0x39,
+	0x68, 0x23, 0xcb, 0x79, 0xc6, 0x8e, 0x43, 0x38, 0x3a, 0x80, 0x28, 0x4f, 0xb2, 0x22, 0x91, 0x02,
+	0xdc, 0xcb, 0x26, 0x8a, 0x76, 0xfa, 0x37, 0xdc, 0xfd, 0x05, 0xaa, 0xff, 0xa9, 0x4d, 0xeb, 0xf5,
+	0x29, 0x15, 0xd9, 0xb2, 0x5f, 0xd6, 0x83, 0xd2, 0xe0, 0x56, 0xd6, 0x31, 0x04, 0xfc, 0x93, 0x83,
+	0xb6, 0x04, 0x7f, 0x5e, 0x84, 0x3d, 0x9a, 0x30, 0x99, 0xb8, 0x57, 0x46, 0xce, 0xf6, 0x15, 0xff,
+	0x8b, 0x33, 0x45, 0x2e, 0xa2, 0x95, 0x8a, 0xd0, 0x2a, 0xfa, 0x02, 0x12, 0x0d, 0x5c, 0x8d, 0x76,
+	0x3f, 0x6b, 0x6f, 0x82, 0x7f, 0x74, 0xd0, 0xba, 0x90, 0x2f, 0xc2, 0x2c, 0x4f, 0x22, 0x1e, 0xc6,
+	0x09, 0x14, 0x32, 0x3f, 0x76, 0xaf, 0x9a, 0xc1, 0x8c, 0xda, 0x83, 0x79, 0x22, 0x5f, 0x3c, 0xd5,
+	0xbc, 0x7d, 0xc1, 0x32, 0x88, 0x65, 0xe1, 0x7f, 0x64, 0xc7, 0xd2, 0xb5, 0x28, 0x15, 0x71, 0x6d,
+	0x63, 0x6d, 0x88, 0x06, 0x37, 0x84, 0x35, 0x7a, 0x5c, 0x55, 0xf0, 0x77, 0x68, 0xa0, 0x69, 0x07,
+	0x2c, 0x65, 0x22, 0xe2, 0xe0, 0x2e, 0x9b, 0xfc, 0xcd, 0x9e, 0x7c, 0xbf, 0xa2, 0xf8, 0x1f, 0xd8,
+	0xe4, 0x86, 0xae, 0x54, 0xe4, 0x56, 0x1d, 0x3a, 0xaf, 0xd2, 0x60, 0x55, 0xbc, 0x11, 0x02, 0x2e,
+	0xd0, 0x75, 0x8d, 0xb2, 0x34, 0x95, 0xdf, 0x57, 0x69, 0x6f, 0x99, 0xb4, 0xbb, 0x3d, 0x69, 0x3b,
+	0x73, 0x92, 0xef, 0xd9, 0xbc, 0x96, 0xb6, 0x54, 0xe4, 0xed, 0x3a, 0xb1, 0xae, 0xd3, 0x60, 0x4d,
+	0x2c, 0xc8, 0x01, 0xff, 0xec, 0x20, 0xac, 0xb7, 0x31, 0x64, 0x51, 0xc4, 0x01, 0xc2, 0x59, 0xce,
+	0x44, 0x01, 0xee, 0xb5, 0xfe, 0x65, 0xd7, 0x5b, 0xbe, 0x63, 0x88, 0xbb, 0x9a, 0xe7, 0x7f, 0x6c,
+	0xd3, 0x7b, 0x2c, 0x4a, 0x45, 0xee, 0xd4, 0x9b, 0xdf, 0xc4, 0x68, 0x70, 0x73, 0xda, 0x74, 0x02,
+	0xfc, 0x8b, 0x83, 0xb0, 0x1e, 0x3a, 0x9b, 0xf1, 0x30, 0x8a, 0x59, 0x9a, 0x72, 0x31, 0xe3, 0xe0,
+	0xae, 0xf4, 0xff, 0xc7, 0xf7, 0x2b, 0xe6, 0xe7, 0x73, 0x62, 0xdd, 0x49, 0xd7, 0xa3, 0xee, 0xa4,
+	0x8b, 0xd1, 0x60, 0x1d, 0x5a, 0x56, 0x80, 0x7f, 0x40, 0x77, 0xcc, 0xd6, 0x76, 0xe8, 0x7a, 0xff,
+	0x91, 0xd9, 0xff, 0x9d, 0x33, 0x45, 0xce, 0x27, 0x95, 0x8a, 0x8c, 0x16, 0xb6, 0xbf, 0x8f, 0x42,
+	0x83, 0xdb, 0x1a, 0x6b, 0x7f, 0xc7, 0xde, 0x04, 0xff, 0xee, 0xa0, 0xcd, 0xae, 0x62, 0xca, 0x92,
+	0xf4, 0x28, 0xe7, 0xe0, 0xae, 0x9a, 0x81, 0xbc, 0xf7, 0x7f, 0x03, 0x79, 0x54, 0xf1, 0xfd, 0x5d,
+	0x3b, 0x97, 0x0b, 0x2c, 0x4b, 0x45, 0xee, 0x9d, 0x33, 0x9f, 0x37, 0x1c, 0x1a, 0xb8, 0xd0, 0x9f,
+	0x00, 0x58, 0xa2, 0xb5, 0xb9, 0x30, 0xe7, 0x7a, 0x79, 0x06, 0xa6, 0xc5, 0xad, 0x73, 0x5a, 0x0c,
+	0xb8, 0x28, 0xfc, 0xfb, 0xb6, 0xad, 0xa6, 0xb2, 0x54, 0x64, 0xa3, 0xd9, 0x89, 0x29, 0xd3, 0x60,
+	0x00, 0xb5, 0xd6, 0x04, 0xe6, 0x7c, 0x7a, 0x24, 0x26, 0xa1, 0x90, 0xe6, 0xa0, 0xac, 0xf5, 0x07,
+	0x06, 0x86, 0xf4, 0x44, 0x73, 0xea, 0xc0, 0x86, 0xb2, 0x0e, 0x6c, 0x94, 0x69, 0x30, 0xc8, 0x6b,
+	0x2d, 0xd0, 0x3f, 0x1c, 0x74, 0xa3, 0xf5, 0x3b, 0x8f, 0x3f, 0x43, 0x2b, 0x66, 0xb1, 0x63, 0x06,
+	0xb1, 0xb9, 0x70, 0x56, 0xfc, 0x7b, 0x67, 0x8a, 0xd4, 0xc5, 0x52, 0x91, 0x9b, 0x0b, 0x07, 0x40,
+	0x97, 0x68, 0x70, 0x4d, 0x3f, 0x3f, 0x66, 0x10, 0xe3, 0x6f, 0xad, 0x3e, 0x11, 0x53, 0xe9, 0x5e,
+	0x32, 0x17, 0x96, 0xdb, 0x77, 0xdc, 0xcc, 0xa5, 0xf2, 0xae, 0xed, 0xbe, 0x96, 0xb4, 0xdc, 0x75,
+	0xc9, 0xba, 0x1b, 0xc1, 0x97, 0x2f, 0x4f, 0x86, 0xce, 0xab, 0x93, 0xa1, 0xf3, 0xfa, 0x64, 0xe8,
+	0xfc, 0x7d, 0x32, 0x74, 0x7e, 0x3d, 0x1d, 0x2e, 0xbd, 0x3e, 0x1d, 0x2e, 0xfd, 0x75, 0x3a, 0x5c,
+	0xfa, 0xc6, 0x9b, 0x25, 0x45, 0x7c, 0x74, 0x30, 0x8e, 0xe4, 0xa1, 0x67, 0x23, 0x05, 0x2f, 0xe6,
+	0x8f, 0xf7, 0xa3, 0x98, 0x25, 0xc2, 0x7b, 0x6e, 0xae, 0xdc, 0xe2, 0x38, 0xe3, 0x70, 0xb0, 0x6c,
+	0xae, 0xdc, 0x0f, 0xff, 0x1b, 0x00, 0x6f, 0x65, 0x40, 0xd9, 0xe5, 0x07, 0x00, 0x00,
 }
 
 func (this *GenesisState) Equal(that interface{}) bool {
@@ -193,6 +310,84 @@ func (this *GenesisState) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if len(this.PrepaySubscriptions) != len(that1.PrepaySubscriptions) {
+		return false
+	}
+	for i := range this.PrepaySubscriptions {
+		if !this.PrepaySubscriptions[i].Equal(&that1.PrepaySubscriptions[i]) {
+			return false
+		}
+	}
+	if this.NextPrepaySubscriptionId != that1.NextPrepaySubscriptionId {
+		return false
+	}
+	if len(this.NozPriceHistory) != len(that1.NozPriceHistory) {
+		return false
+	}
+	for i := range this.NozPriceHistory {
+		if !this.NozPriceHistory[i].Equal(&that1.NozPriceHistory[i]) {
+			return false
+		}
+	}
+	if len(this.NozBalances) != len(that1.NozBalances) {
+		return false
+	}
+	for i := range this.NozBalances {
+		if !this.NozBalances[i].Equal(&that1.NozBalances[i]) {
+			return false
+		}
+	}
+	if len(this.NozAllowances) != len(that1.NozAllowances) {
+		return false
+	}
+	for i := range this.NozAllowances {
+		if !this.NozAllowances[i].Equal(&that1.NozAllowances[i]) {
+			return false
+		}
+	}
+	if len(this.FileAccessGrants) != len(that1.FileAccessGrants) {
+		return false
+	}
+	for i := range this.FileAccessGrants {
+		if !this.FileAccessGrants[i].Equal(&that1.FileAccessGrants[i]) {
+			return false
+		}
+	}
+	if len(this.StorageChallenges) != len(that1.StorageChallenges) {
+		return false
+	}
+	for i := range this.StorageChallenges {
+		if !this.StorageChallenges[i].Equal(&that1.StorageChallenges[i]) {
+			return false
+		}
+	}
+	if this.NextStorageChallengeId != that1.NextStorageChallengeId {
+		return false
+	}
+	if len(this.StorageChallengeFailures) != len(that1.StorageChallengeFailures) {
+		return false
+	}
+	for i := range this.StorageChallengeFailures {
+		if !this.StorageChallengeFailures[i].Equal(&that1.StorageChallengeFailures[i]) {
+			return false
+		}
+	}
+	if len(this.StorageRents) != len(that1.StorageRents) {
+		return false
+	}
+	for i := range this.StorageRents {
+		if !this.StorageRents[i].Equal(&that1.StorageRents[i]) {
+			return false
+		}
+	}
+	if len(this.RefundNonces) != len(that1.RefundNonces) {
+		return false
+	}
+	for i := range this.RefundNonces {
+		if !this.RefundNonces[i].Equal(&that1.RefundNonces[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (this *GenesisFileInfo) Equal(that interface{}) bool {
@@ -242,6 +437,142 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RefundNonces) > 0 {
+		for iNdEx := len(m.RefundNonces) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RefundNonces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.StorageRents) > 0 {
+		for iNdEx := len(m.StorageRents) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StorageRents[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.StorageChallengeFailures) > 0 {
+		for iNdEx := len(m.StorageChallengeFailures) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StorageChallengeFailures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.NextStorageChallengeId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextStorageChallengeId))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.StorageChallenges) > 0 {
+		for iNdEx := len(m.StorageChallenges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StorageChallenges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.FileAccessGrants) > 0 {
+		for iNdEx := len(m.FileAccessGrants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FileAccessGrants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.NozAllowances) > 0 {
+		for iNdEx := len(m.NozAllowances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NozAllowances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.NozBalances) > 0 {
+		for iNdEx := len(m.NozBalances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NozBalances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.NozPriceHistory) > 0 {
+		for iNdEx := len(m.NozPriceHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NozPriceHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.NextPrepaySubscriptionId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextPrepaySubscriptionId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.PrepaySubscriptions) > 0 {
+		for iNdEx := len(m.PrepaySubscriptions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PrepaySubscriptions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.Files) > 0 {
 		for iNdEx := len(m.Files) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -334,6 +665,66 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.PrepaySubscriptions) > 0 {
+		for _, e := range m.PrepaySubscriptions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextPrepaySubscriptionId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextPrepaySubscriptionId))
+	}
+	if len(m.NozPriceHistory) > 0 {
+		for _, e := range m.NozPriceHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.NozBalances) > 0 {
+		for _, e := range m.NozBalances {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.NozAllowances) > 0 {
+		for _, e := range m.NozAllowances {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FileAccessGrants) > 0 {
+		for _, e := range m.FileAccessGrants {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.StorageChallenges) > 0 {
+		for _, e := range m.StorageChallenges {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextStorageChallengeId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextStorageChallengeId))
+	}
+	if len(m.StorageChallengeFailures) > 0 {
+		for _, e := range m.StorageChallengeFailures {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.StorageRents) > 0 {
+		for _, e := range m.StorageRents {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RefundNonces) > 0 {
+		for _, e := range m.RefundNonces {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -454,6 +845,350 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrepaySubscriptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrepaySubscriptions = append(m.PrepaySubscriptions, PrepaySubscription{})
+			if err := m.PrepaySubscriptions[len(m.PrepaySubscriptions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPrepaySubscriptionId", wireType)
+			}
+			m.NextPrepaySubscriptionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextPrepaySubscriptionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NozPriceHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NozPriceHistory = append(m.NozPriceHistory, NozPriceSnapshot{})
+			if err := m.NozPriceHistory[len(m.NozPriceHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NozBalances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NozBalances = append(m.NozBalances, NozBalance{})
+			if err := m.NozBalances[len(m.NozBalances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NozAllowances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NozAllowances = append(m.NozAllowances, NozAllowance{})
+			if err := m.NozAllowances[len(m.NozAllowances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FileAccessGrants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FileAccessGrants = append(m.FileAccessGrants, FileAccessGrant{})
+			if err := m.FileAccessGrants[len(m.FileAccessGrants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageChallenges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StorageChallenges = append(m.StorageChallenges, StorageChallenge{})
+			if err := m.StorageChallenges[len(m.StorageChallenges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextStorageChallengeId", wireType)
+			}
+			m.NextStorageChallengeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextStorageChallengeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageChallengeFailures", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StorageChallengeFailures = append(m.StorageChallengeFailures, StorageChallengeFailure{})
+			if err := m.StorageChallengeFailures[len(m.StorageChallengeFailures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageRents", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StorageRents = append(m.StorageRents, StorageRent{})
+			if err := m.StorageRents[len(m.StorageRents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundNonces", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RefundNonces = append(m.RefundNonces, RefundNonce{})
+			if err := m.RefundNonces[len(m.RefundNonces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
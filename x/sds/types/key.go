@@ -1,5 +1,12 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+
+	stratos "github.com/stratosnet/stratos-chain/types"
+)
+
 const (
 	// ModuleName is the name of the module
 	ModuleName = "sds"
@@ -12,12 +19,183 @@ const (
 )
 
 var (
-	FileStoreKeyPrefix = []byte{0x01} // FileStorage prefix for sds store
+	FileStoreKeyPrefix                  = []byte{0x01} // FileStorage prefix for sds store
+	PrepaySubscriptionKeyPrefix         = []byte{0x02} // key: prefix{id}
+	PrepaySubscriptionBySenderKeyPrefix = []byte{0x03} // key: prefix{len(sender)}{sender}{id}
+	PrepaySubscriptionQueueKeyPrefix    = []byte{0x04} // key: prefix{next_height}{id}
+	NozPriceSnapshotKeyPrefix           = []byte{0x05} // key: prefix{seq}
+	NozBalanceKeyPrefix                 = []byte{0x06} // key: prefix{len(owner)}{owner}
+	NozAllowanceKeyPrefix               = []byte{0x07} // key: prefix{len(owner)}{owner}{len(spender)}{spender}
+	FileAccessKeyPrefix                 = []byte{0x08} // key: prefix{len(file_hash)}{file_hash}{len(grantee)}{grantee}
+	FileAccessExpiryQueueKeyPrefix      = []byte{0x09} // key: prefix{expiry_height}{len(file_hash)}{file_hash}{len(grantee)}{grantee}
+	ChallengeableFileKeyPrefix          = []byte{0x0a} // key: prefix{seq}, value: file_hash
+	StorageChallengeKeyPrefix           = []byte{0x0b} // key: prefix{id}
+	StorageChallengeByNodeKeyPrefix     = []byte{0x0c} // key: prefix{len(network_address)}{network_address}{id}
+	StorageChallengeDeadlineKeyPrefix   = []byte{0x0d} // key: prefix{deadline_height}{id}
+	StorageChallengeFailureKeyPrefix    = []byte{0x0e} // key: prefix{network_address}, value: count
+	StorageRentKeyPrefix                = []byte{0x0f} // key: prefix{file_hash}
+	StorageRentPayoutQueueKeyPrefix     = []byte{0x10} // key: prefix{payout_epoch}{file_hash}
+	RefundNonceKeyPrefix                = []byte{0x11} // key: prefix{sender}, value: nonce
+	ChallengeableFileSeqKeyPrefix       = []byte{0x12} // key: prefix{file_hash}, value: seq
 
-	ParamsKey = []byte{0x20}
+	ParamsKey                   = []byte{0x20}
+	NextPrepaySubscriptionIdKey = []byte{0x21}
+	NextNozPriceSnapshotSeqKey  = []byte{0x22}
+	TotalIssuedNozKey           = []byte{0x23}
+	ChallengeableFileCountKey   = []byte{0x24}
+	NextStorageChallengeIdKey   = []byte{0x25}
 )
 
 // GetFileStoreKey turn an address to key used to get it from the account store
 func GetFileStoreKey(sender []byte) []byte {
 	return append(FileStoreKeyPrefix, sender...)
 }
+
+// GetPrepaySubscriptionKey prefix{id}
+func GetPrepaySubscriptionKey(id uint64) []byte {
+	return append(PrepaySubscriptionKeyPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetPrepaySubscriptionBySenderPrefix prefix{len(sender)}{sender}
+func GetPrepaySubscriptionBySenderPrefix(sender sdk.AccAddress) []byte {
+	return append(PrepaySubscriptionBySenderKeyPrefix, address.MustLengthPrefix(sender)...)
+}
+
+// GetPrepaySubscriptionBySenderKey prefix{len(sender)}{sender}{id}
+func GetPrepaySubscriptionBySenderKey(sender sdk.AccAddress, id uint64) []byte {
+	return append(GetPrepaySubscriptionBySenderPrefix(sender), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetPrepaySubscriptionQueueHeightPrefix prefix{next_height}
+func GetPrepaySubscriptionQueueHeightPrefix(height int64) []byte {
+	return append(PrepaySubscriptionQueueKeyPrefix, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// GetPrepaySubscriptionQueueKey prefix{next_height}{id}
+func GetPrepaySubscriptionQueueKey(height int64, id uint64) []byte {
+	return append(GetPrepaySubscriptionQueueHeightPrefix(height), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetNozPriceSnapshotKey prefix{seq}
+func GetNozPriceSnapshotKey(seq uint64) []byte {
+	return append(NozPriceSnapshotKeyPrefix, sdk.Uint64ToBigEndian(seq)...)
+}
+
+// GetNozBalanceKey prefix{len(owner)}{owner}
+func GetNozBalanceKey(owner sdk.AccAddress) []byte {
+	return append(NozBalanceKeyPrefix, address.MustLengthPrefix(owner)...)
+}
+
+// GetRefundNonceKey prefix{sender}
+func GetRefundNonceKey(sender sdk.AccAddress) []byte {
+	return append(RefundNonceKeyPrefix, sender...)
+}
+
+// GetNozAllowanceOwnerPrefix prefix{len(owner)}{owner}
+func GetNozAllowanceOwnerPrefix(owner sdk.AccAddress) []byte {
+	return append(NozAllowanceKeyPrefix, address.MustLengthPrefix(owner)...)
+}
+
+// GetNozAllowanceKey prefix{len(owner)}{owner}{len(spender)}{spender}
+func GetNozAllowanceKey(owner, spender sdk.AccAddress) []byte {
+	return append(GetNozAllowanceOwnerPrefix(owner), address.MustLengthPrefix(spender)...)
+}
+
+// ParseNozAllowanceKey returns owner and spender from the key prefix{len(owner)}{owner}{len(spender)}{spender}
+func ParseNozAllowanceKey(key []byte) (owner, spender sdk.AccAddress) {
+	key = key[len(NozAllowanceKeyPrefix):]
+	ownerLen := int(key[0])
+	owner = key[1 : 1+ownerLen]
+	key = key[1+ownerLen:]
+	spender = key[1 : 1+int(key[0])]
+	return
+}
+
+// GetFileAccessFilePrefix prefix{len(file_hash)}{file_hash}
+func GetFileAccessFilePrefix(fileHash string) []byte {
+	return append(FileAccessKeyPrefix, address.MustLengthPrefix([]byte(fileHash))...)
+}
+
+// GetFileAccessKey prefix{len(file_hash)}{file_hash}{len(grantee)}{grantee}
+func GetFileAccessKey(fileHash string, grantee sdk.AccAddress) []byte {
+	return append(FileAccessKeyPrefix, fileAccessKeySuffix(fileHash, grantee)...)
+}
+
+// GetFileAccessExpiryQueueHeightPrefix prefix{expiry_height}
+func GetFileAccessExpiryQueueHeightPrefix(height int64) []byte {
+	return append(FileAccessExpiryQueueKeyPrefix, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// GetFileAccessExpiryQueueKey prefix{expiry_height}{len(file_hash)}{file_hash}{len(grantee)}{grantee}
+func GetFileAccessExpiryQueueKey(height int64, fileHash string, grantee sdk.AccAddress) []byte {
+	return append(GetFileAccessExpiryQueueHeightPrefix(height), fileAccessKeySuffix(fileHash, grantee)...)
+}
+
+// ParseFileAccessExpiryQueueKey returns file hash and grantee from the key prefix{expiry_height}{len(file_hash)}{file_hash}{len(grantee)}{grantee}
+func ParseFileAccessExpiryQueueKey(key []byte) (fileHash string, grantee sdk.AccAddress) {
+	key = key[len(FileAccessExpiryQueueKeyPrefix)+8:]
+	fileHashLen := int(key[0])
+	fileHash = string(key[1 : 1+fileHashLen])
+	key = key[1+fileHashLen:]
+	grantee = key[1 : 1+int(key[0])]
+	return
+}
+
+func fileAccessKeySuffix(fileHash string, grantee sdk.AccAddress) []byte {
+	return append(address.MustLengthPrefix([]byte(fileHash)), address.MustLengthPrefix(grantee)...)
+}
+
+// GetChallengeableFileKey prefix{seq}
+func GetChallengeableFileKey(seq uint64) []byte {
+	return append(ChallengeableFileKeyPrefix, sdk.Uint64ToBigEndian(seq)...)
+}
+
+// GetChallengeableFileSeqKey prefix{file_hash}
+func GetChallengeableFileSeqKey(fileHash string) []byte {
+	return append(ChallengeableFileSeqKeyPrefix, []byte(fileHash)...)
+}
+
+// GetStorageChallengeKey prefix{id}
+func GetStorageChallengeKey(id uint64) []byte {
+	return append(StorageChallengeKeyPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetStorageChallengeByNodePrefix prefix{len(network_address)}{network_address}
+func GetStorageChallengeByNodePrefix(networkAddr stratos.SdsAddress) []byte {
+	return append(StorageChallengeByNodeKeyPrefix, address.MustLengthPrefix(networkAddr)...)
+}
+
+// GetStorageChallengeByNodeKey prefix{len(network_address)}{network_address}{id}
+func GetStorageChallengeByNodeKey(networkAddr stratos.SdsAddress, id uint64) []byte {
+	return append(GetStorageChallengeByNodePrefix(networkAddr), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetStorageChallengeDeadlineHeightPrefix prefix{deadline_height}
+func GetStorageChallengeDeadlineHeightPrefix(height int64) []byte {
+	return append(StorageChallengeDeadlineKeyPrefix, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// GetStorageChallengeDeadlineKey prefix{deadline_height}{id}
+func GetStorageChallengeDeadlineKey(height int64, id uint64) []byte {
+	return append(GetStorageChallengeDeadlineHeightPrefix(height), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetStorageChallengeFailureKey prefix{network_address}
+func GetStorageChallengeFailureKey(networkAddr stratos.SdsAddress) []byte {
+	return append(StorageChallengeFailureKeyPrefix, networkAddr...)
+}
+
+// GetStorageRentKey prefix{file_hash}
+func GetStorageRentKey(fileHash string) []byte {
+	return append(StorageRentKeyPrefix, []byte(fileHash)...)
+}
+
+// GetStorageRentPayoutQueueEpochPrefix prefix{payout_epoch}
+func GetStorageRentPayoutQueueEpochPrefix(epoch int64) []byte {
+	return append(StorageRentPayoutQueueKeyPrefix, sdk.Uint64ToBigEndian(uint64(epoch))...)
+}
+
+// GetStorageRentPayoutQueueKey prefix{payout_epoch}{file_hash}
+func GetStorageRentPayoutQueueKey(epoch int64, fileHash string) []byte {
+	return append(GetStorageRentPayoutQueueEpochPrefix(epoch), []byte(fileHash)...)
+}
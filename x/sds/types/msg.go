@@ -4,8 +4,10 @@ import (
 	"github.com/ipfs/go-cid"
 
 	"cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/gogoproto/proto"
 
 	stratos "github.com/stratosnet/stratos-chain/types"
 )
@@ -14,25 +16,58 @@ import (
 var (
 	_ sdk.Msg = &MsgFileUpload{}
 	_ sdk.Msg = &MsgPrepay{}
+	_ sdk.Msg = &MsgCreatePrepaySubscription{}
+	_ sdk.Msg = &MsgCancelPrepaySubscription{}
+	_ sdk.Msg = &MsgTransferNoz{}
+	_ sdk.Msg = &MsgApproveNoz{}
+	_ sdk.Msg = &MsgTransferNozFrom{}
+	_ sdk.Msg = &MsgGrantFileAccess{}
+	_ sdk.Msg = &MsgRevokeFileAccess{}
+	_ sdk.Msg = &MsgSubmitStorageProof{}
+	_ sdk.Msg = &MsgRefundNoz{}
+	_ sdk.Msg = &MsgDeleteFile{}
 	_ sdk.Msg = &MsgUpdateParams{}
 )
 
 const (
-	TypeMsgFileUpload   = "FileUploadTx"
-	TypeMsgPrepay       = "SdsPrepayTx"
-	TypeMsgUpdateParams = "update_params"
+	TypeMsgFileUpload               = "FileUploadTx"
+	TypeMsgPrepay                   = "SdsPrepayTx"
+	TypeMsgCreatePrepaySubscription = "create_prepay_subscription"
+	TypeMsgCancelPrepaySubscription = "cancel_prepay_subscription"
+	TypeMsgTransferNoz              = "transfer_noz"
+	TypeMsgApproveNoz               = "approve_noz"
+	TypeMsgTransferNozFrom          = "transfer_noz_from"
+	TypeMsgGrantFileAccess          = "grant_file_access"
+	TypeMsgRevokeFileAccess         = "revoke_file_access"
+	TypeMsgSubmitStorageProof       = "submit_storage_proof"
+	TypeMsgRefundNoz                = "refund_noz"
+	TypeMsgDeleteFile               = "delete_file"
+	TypeMsgUpdateParams             = "update_params"
 )
 
 // NewMsgUpload creates a new Msg<Action> instance
-func NewMsgUpload(fileHash string, from, reporter, uploader string) *MsgFileUpload {
+func NewMsgUpload(fileHash string, from, reporter, uploader string, segmentRoot []byte, segmentCount uint64,
+	storageNodes []string, rentAmount sdkmath.Int, rentDuration int64, rentReplicas uint32) *MsgFileUpload {
+
 	return &MsgFileUpload{
-		FileHash: fileHash,
-		From:     from,
-		Reporter: reporter,
-		Uploader: uploader,
+		FileHash:     fileHash,
+		From:         from,
+		Reporter:     reporter,
+		Uploader:     uploader,
+		SegmentRoot:  segmentRoot,
+		SegmentCount: segmentCount,
+		StorageNodes: storageNodes,
+		RentAmount:   rentAmount,
+		RentDuration: rentDuration,
+		RentReplicas: rentReplicas,
 	}
 }
 
+// HasStorageRent returns true if the upload locks a storage rent
+func (msg MsgFileUpload) HasStorageRent() bool {
+	return !msg.RentAmount.IsNil() && !msg.RentAmount.IsZero()
+}
+
 // nolint
 func (msg MsgFileUpload) Route() string {
 	return RouterKey
@@ -90,17 +125,52 @@ func (msg MsgFileUpload) ValidateBasic() error {
 	if len(msg.FileHash) == 0 {
 		return errors.Wrap(sdkerrors.ErrInvalidRequest, "missing file hash")
 	}
+
+	// storage rent is optional, files uploaded without it are stored without paying the resource nodes
+	if msg.HasStorageRent() {
+		if msg.RentAmount.IsNegative() {
+			return errors.Wrap(ErrInvalidNozAmount, "rent amount cannot be negative")
+		}
+		if msg.GetRentDuration() <= 0 {
+			return ErrInvalidStorageRentDuration
+		}
+		if msg.GetRentReplicas() == 0 {
+			return ErrInvalidReplicas
+		}
+	}
+
+	// segment root is optional, files uploaded without it are not challenged
+	if len(msg.GetSegmentRoot()) == 0 {
+		return nil
+	}
+	if len(msg.GetSegmentRoot()) != SegmentRootLength {
+		return errors.Wrapf(ErrInvalidSegmentRoot, "segment root length %d", len(msg.GetSegmentRoot()))
+	}
+	if msg.GetSegmentCount() == 0 {
+		return errors.Wrap(ErrInvalidSegmentRoot, "missing segment count")
+	}
+	if len(msg.GetStorageNodes()) == 0 {
+		return errors.Wrap(ErrInvalidSegmentRoot, "missing storage nodes")
+	}
+	for _, node := range msg.GetStorageNodes() {
+		_, err = stratos.SdsAddressFromBech32(node)
+		if err != nil {
+			return errors.Wrap(sdkerrors.ErrInvalidAddress, "failed to parse storage node address")
+		}
+	}
 	return nil
 }
 
 // --------------------------------------------------------------------------------------------------------------------
 
 // NewMsgPrepay NewMsg<Action> creates a new Msg<Action> instance
-func NewMsgPrepay(sender string, beneficiary string, amount sdk.Coins) *MsgPrepay {
+func NewMsgPrepay(sender string, beneficiary string, amount sdk.Coins, minNozOut sdkmath.Int, deadlineHeight int64) *MsgPrepay {
 	return &MsgPrepay{
-		Sender:      sender,
-		Beneficiary: beneficiary,
-		Amount:      amount,
+		Sender:         sender,
+		Beneficiary:    beneficiary,
+		Amount:         amount,
+		MinNozOut:      minNozOut,
+		DeadlineHeight: deadlineHeight,
 	}
 }
 
@@ -143,6 +213,582 @@ func (msg MsgPrepay) ValidateBasic() error {
 		return errors.Wrap(sdkerrors.ErrInvalidCoins, "missing amount to send")
 	}
 
+	if !msg.MinNozOut.IsNil() && msg.MinNozOut.IsNegative() {
+		return errors.Wrap(ErrInvalidMinNozOut, "min noz out cannot be negative")
+	}
+
+	if msg.DeadlineHeight < 0 {
+		return errors.Wrap(ErrInvalidDeadlineHeight, "deadline height cannot be negative")
+	}
+
+	return nil
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// NewMsgCreatePrepaySubscription creates a new MsgCreatePrepaySubscription instance
+func NewMsgCreatePrepaySubscription(sender, beneficiary string, amount sdk.Coins, interval int64, maxCount uint64, endHeight int64,
+) *MsgCreatePrepaySubscription {
+	return &MsgCreatePrepaySubscription{
+		Sender:      sender,
+		Beneficiary: beneficiary,
+		Amount:      amount,
+		Interval:    interval,
+		MaxCount:    maxCount,
+		EndHeight:   endHeight,
+	}
+}
+
+func (msg MsgCreatePrepaySubscription) Route() string {
+	return RouterKey
+}
+
+func (msg MsgCreatePrepaySubscription) Type() string {
+	return TypeMsgCreatePrepaySubscription
+}
+
+func (msg MsgCreatePrepaySubscription) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.GetSender())
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{sender.Bytes()}
+}
+
+// GetSignBytes gets the bytes for the message signer to sign on
+func (msg MsgCreatePrepaySubscription) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic validity check for the AnteHandler
+func (msg MsgCreatePrepaySubscription) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.GetSender())
+	if err != nil {
+		return ErrInvalidSenderAddr
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.GetBeneficiary())
+	if err != nil {
+		return ErrInvalidBeneficiaryAddr
+	}
+
+	if !msg.Amount.IsValid() || msg.Amount.IsZero() {
+		return errors.Wrap(sdkerrors.ErrInvalidCoins, "invalid amount to prepay")
+	}
+
+	if msg.Interval < MinPrepaySubscriptionInterval {
+		return errors.Wrapf(ErrInvalidSubscriptionInterval, "interval must be at least %d blocks", MinPrepaySubscriptionInterval)
+	}
+
+	if msg.EndHeight < 0 {
+		return errors.Wrap(ErrInvalidSubscriptionEndHeight, "end height cannot be negative")
+	}
+
+	return nil
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// NewMsgCancelPrepaySubscription creates a new MsgCancelPrepaySubscription instance
+func NewMsgCancelPrepaySubscription(sender string, subscriptionId uint64) *MsgCancelPrepaySubscription {
+	return &MsgCancelPrepaySubscription{
+		Sender:         sender,
+		SubscriptionId: subscriptionId,
+	}
+}
+
+func (msg MsgCancelPrepaySubscription) Route() string {
+	return RouterKey
+}
+
+func (msg MsgCancelPrepaySubscription) Type() string {
+	return TypeMsgCancelPrepaySubscription
+}
+
+func (msg MsgCancelPrepaySubscription) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.GetSender())
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{sender.Bytes()}
+}
+
+// GetSignBytes gets the bytes for the message signer to sign on
+func (msg MsgCancelPrepaySubscription) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic validity check for the AnteHandler
+func (msg MsgCancelPrepaySubscription) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.GetSender())
+	if err != nil {
+		return ErrInvalidSenderAddr
+	}
+
+	return nil
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// NewMsgTransferNoz creates a new MsgTransferNoz instance
+func NewMsgTransferNoz(sender, recipient string, amount sdkmath.Int) *MsgTransferNoz {
+	return &MsgTransferNoz{
+		Sender:    sender,
+		Recipient: recipient,
+		Amount:    amount,
+	}
+}
+
+func (msg MsgTransferNoz) Route() string {
+	return RouterKey
+}
+
+func (msg MsgTransferNoz) Type() string {
+	return TypeMsgTransferNoz
+}
+
+func (msg MsgTransferNoz) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.GetSender())
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{sender.Bytes()}
+}
+
+// GetSignBytes gets the bytes for the message signer to sign on
+func (msg MsgTransferNoz) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic validity check for the AnteHandler
+func (msg MsgTransferNoz) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.GetSender())
+	if err != nil {
+		return ErrInvalidSenderAddr
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.GetRecipient())
+	if err != nil {
+		return ErrInvalidRecipientAddr
+	}
+
+	if msg.Amount.IsNil() || !msg.Amount.IsPositive() {
+		return errors.Wrap(ErrInvalidNozAmount, "noz amount must be positive")
+	}
+
+	return nil
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// NewMsgDeleteFile creates a new MsgDeleteFile instance
+func NewMsgDeleteFile(uploader, fileHash string) *MsgDeleteFile {
+	return &MsgDeleteFile{
+		Uploader: uploader,
+		FileHash: fileHash,
+	}
+}
+
+func (msg MsgDeleteFile) Route() string {
+	return RouterKey
+}
+
+func (msg MsgDeleteFile) Type() string {
+	return TypeMsgDeleteFile
+}
+
+func (msg MsgDeleteFile) GetSigners() []sdk.AccAddress {
+	uploader, err := sdk.AccAddressFromBech32(msg.GetUploader())
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{uploader.Bytes()}
+}
+
+// GetSignBytes gets the bytes for the message signer to sign on
+func (msg MsgDeleteFile) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic validity check for the AnteHandler
+func (msg MsgDeleteFile) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.GetUploader())
+	if err != nil {
+		return errors.Wrap(sdkerrors.ErrInvalidAddress, "failed to validate uploader address")
+	}
+
+	if len(msg.GetFileHash()) == 0 {
+		return ErrEmptyFileHash
+	}
+
+	return nil
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// NewMsgApproveNoz creates a new MsgApproveNoz instance
+func NewMsgApproveNoz(owner, spender string, amount sdkmath.Int) *MsgApproveNoz {
+	return &MsgApproveNoz{
+		Owner:   owner,
+		Spender: spender,
+		Amount:  amount,
+	}
+}
+
+func (msg MsgApproveNoz) Route() string {
+	return RouterKey
+}
+
+func (msg MsgApproveNoz) Type() string {
+	return TypeMsgApproveNoz
+}
+
+func (msg MsgApproveNoz) GetSigners() []sdk.AccAddress {
+	owner, err := sdk.AccAddressFromBech32(msg.GetOwner())
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{owner.Bytes()}
+}
+
+// GetSignBytes gets the bytes for the message signer to sign on
+func (msg MsgApproveNoz) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic validity check for the AnteHandler
+func (msg MsgApproveNoz) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.GetOwner())
+	if err != nil {
+		return ErrInvalidOwnerAddr
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.GetSpender())
+	if err != nil {
+		return ErrInvalidSpenderAddr
+	}
+
+	if msg.GetOwner() == msg.GetSpender() {
+		return errors.Wrap(ErrInvalidSpenderAddr, "spender cannot be the owner")
+	}
+
+	if msg.Amount.IsNil() || msg.Amount.IsNegative() {
+		return errors.Wrap(ErrInvalidNozAmount, "allowance cannot be negative")
+	}
+
+	return nil
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// NewMsgTransferNozFrom creates a new MsgTransferNozFrom instance
+func NewMsgTransferNozFrom(spender, owner, recipient string, amount sdkmath.Int) *MsgTransferNozFrom {
+	return &MsgTransferNozFrom{
+		Spender:   spender,
+		Owner:     owner,
+		Recipient: recipient,
+		Amount:    amount,
+	}
+}
+
+func (msg MsgTransferNozFrom) Route() string {
+	return RouterKey
+}
+
+func (msg MsgTransferNozFrom) Type() string {
+	return TypeMsgTransferNozFrom
+}
+
+func (msg MsgTransferNozFrom) GetSigners() []sdk.AccAddress {
+	spender, err := sdk.AccAddressFromBech32(msg.GetSpender())
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{spender.Bytes()}
+}
+
+// GetSignBytes gets the bytes for the message signer to sign on
+func (msg MsgTransferNozFrom) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic validity check for the AnteHandler
+func (msg MsgTransferNozFrom) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.GetSpender())
+	if err != nil {
+		return ErrInvalidSpenderAddr
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.GetOwner())
+	if err != nil {
+		return ErrInvalidOwnerAddr
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.GetRecipient())
+	if err != nil {
+		return ErrInvalidRecipientAddr
+	}
+
+	if msg.Amount.IsNil() || !msg.Amount.IsPositive() {
+		return errors.Wrap(ErrInvalidNozAmount, "noz amount must be positive")
+	}
+
+	return nil
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// NewMsgGrantFileAccess creates a new MsgGrantFileAccess instance
+func NewMsgGrantFileAccess(granter, fileHash, grantee string, expiryHeight int64, fee sdkmath.Int) *MsgGrantFileAccess {
+	return &MsgGrantFileAccess{
+		Granter:      granter,
+		FileHash:     fileHash,
+		Grantee:      grantee,
+		ExpiryHeight: expiryHeight,
+		Fee:          fee,
+	}
+}
+
+func (msg MsgGrantFileAccess) Route() string {
+	return RouterKey
+}
+
+func (msg MsgGrantFileAccess) Type() string {
+	return TypeMsgGrantFileAccess
+}
+
+func (msg MsgGrantFileAccess) GetSigners() []sdk.AccAddress {
+	granter, err := sdk.AccAddressFromBech32(msg.GetGranter())
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{granter.Bytes()}
+}
+
+// GetSignBytes gets the bytes for the message signer to sign on
+func (msg MsgGrantFileAccess) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic validity check for the AnteHandler
+func (msg MsgGrantFileAccess) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.GetGranter())
+	if err != nil {
+		return ErrInvalidGranterAddr
+	}
+
+	_, err = cid.Decode(msg.GetFileHash())
+	if err != nil {
+		return errors.Wrap(ErrInvalidFileHash, "failed to validate file hash")
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.GetGrantee())
+	if err != nil {
+		return ErrInvalidGranteeAddr
+	}
+
+	if msg.GetGranter() == msg.GetGrantee() {
+		return errors.Wrap(ErrInvalidGranteeAddr, "grantee cannot be the granter")
+	}
+
+	if msg.ExpiryHeight < 0 {
+		return errors.Wrap(ErrInvalidHeight, "expiry height cannot be negative")
+	}
+
+	if msg.Fee.IsNil() || msg.Fee.IsNegative() {
+		return errors.Wrap(ErrInvalidNozAmount, "fee cannot be negative")
+	}
+
+	return nil
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// NewMsgRevokeFileAccess creates a new MsgRevokeFileAccess instance
+func NewMsgRevokeFileAccess(granter, fileHash, grantee string) *MsgRevokeFileAccess {
+	return &MsgRevokeFileAccess{
+		Granter:  granter,
+		FileHash: fileHash,
+		Grantee:  grantee,
+	}
+}
+
+func (msg MsgRevokeFileAccess) Route() string {
+	return RouterKey
+}
+
+func (msg MsgRevokeFileAccess) Type() string {
+	return TypeMsgRevokeFileAccess
+}
+
+func (msg MsgRevokeFileAccess) GetSigners() []sdk.AccAddress {
+	granter, err := sdk.AccAddressFromBech32(msg.GetGranter())
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{granter.Bytes()}
+}
+
+// GetSignBytes gets the bytes for the message signer to sign on
+func (msg MsgRevokeFileAccess) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic validity check for the AnteHandler
+func (msg MsgRevokeFileAccess) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.GetGranter())
+	if err != nil {
+		return ErrInvalidGranterAddr
+	}
+
+	_, err = cid.Decode(msg.GetFileHash())
+	if err != nil {
+		return errors.Wrap(ErrInvalidFileHash, "failed to validate file hash")
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.GetGrantee())
+	if err != nil {
+		return ErrInvalidGranteeAddr
+	}
+
+	return nil
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// NewMsgSubmitStorageProof creates a new Msg<Action> instance
+func NewMsgSubmitStorageProof(from, networkAddress string, challengeId uint64, segment []byte, proof [][]byte) *MsgSubmitStorageProof {
+	return &MsgSubmitStorageProof{
+		From:           from,
+		NetworkAddress: networkAddress,
+		ChallengeId:    challengeId,
+		Segment:        segment,
+		Proof:          proof,
+	}
+}
+
+func (msg MsgSubmitStorageProof) Route() string {
+	return RouterKey
+}
+
+func (msg MsgSubmitStorageProof) Type() string {
+	return TypeMsgSubmitStorageProof
+}
+
+func (msg MsgSubmitStorageProof) GetSigners() []sdk.AccAddress {
+	accAddr, err := sdk.AccAddressFromBech32(msg.GetFrom())
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{accAddr.Bytes()}
+}
+
+// GetSignBytes gets the bytes for the message signer to sign on
+func (msg MsgSubmitStorageProof) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic validity check for the AnteHandler
+func (msg MsgSubmitStorageProof) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.GetFrom())
+	if err != nil {
+		return errors.Wrap(sdkerrors.ErrInvalidAddress, "failed to validate from address")
+	}
+
+	_, err = stratos.SdsAddressFromBech32(msg.GetNetworkAddress())
+	if err != nil {
+		return errors.Wrap(sdkerrors.ErrInvalidAddress, "failed to parse network address")
+	}
+
+	if len(msg.GetSegment()) > MaxSegmentSize {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "segment size %d exceeds %d", len(msg.GetSegment()), MaxSegmentSize)
+	}
+	for _, hash := range msg.GetProof() {
+		if len(hash) != SegmentRootLength {
+			return errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid proof hash length %d", len(hash))
+		}
+	}
+	return nil
+}
+
+// --------------------------------------------------------------------------------------------------------------------
+
+// NewMsgRefundNoz creates a new MsgRefundNoz instance
+func NewMsgRefundNoz(sender string, amount sdkmath.Int, nonce uint64, blsSignature BLSSignatureInfo) *MsgRefundNoz {
+	return &MsgRefundNoz{
+		Sender:       sender,
+		Amount:       amount,
+		Nonce:        nonce,
+		BLSSignature: blsSignature,
+	}
+}
+
+func (msg MsgRefundNoz) Route() string {
+	return RouterKey
+}
+
+func (msg MsgRefundNoz) Type() string {
+	return TypeMsgRefundNoz
+}
+
+func (msg MsgRefundNoz) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.GetSender())
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{sender.Bytes()}
+}
+
+// GetSignBytes gets the bytes for the message signer to sign on
+func (msg MsgRefundNoz) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetBLSSignBytes returns the bytes of the refund attested by the meta nodes
+func (msg MsgRefundNoz) GetBLSSignBytes() []byte {
+	msg.BLSSignature = BLSSignatureInfo{}
+	bz, err := proto.Marshal(&msg)
+	if err != nil {
+		panic(err)
+	}
+	return bz
+}
+
+// ValidateBasic validity check for the AnteHandler
+func (msg MsgRefundNoz) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.GetSender())
+	if err != nil {
+		return ErrInvalidSenderAddr
+	}
+
+	if msg.Amount.IsNil() || !msg.Amount.IsPositive() {
+		return errors.Wrap(ErrInvalidNozAmount, "noz amount must be positive")
+	}
+
+	if len(msg.BLSSignature.GetPubKeys()) == 0 || len(msg.BLSSignature.GetSignature()) == 0 ||
+		len(msg.BLSSignature.GetTxData()) == 0 {
+		return errors.Wrap(ErrInvalid, "missing BLS signature of the meta nodes")
+	}
+
 	return nil
 }
 
@@ -5,25 +5,51 @@ import (
 	"strings"
 
 	"cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	stratos "github.com/stratosnet/stratos-chain/types"
 )
 
 const (
-	DefaultBondDenom = stratos.Wei
+	DefaultBondDenom                = stratos.Wei
+	DefaultNozPriceSnapshotInterval = int64(100)
+	DefaultNozPriceHistorySize      = uint64(1000)
+
+	DefaultStorageChallengeInterval       = int64(0) // disabled
+	DefaultStorageChallengesPerRound      = uint32(10)
+	DefaultStorageChallengeResponseWindow = int64(100)
+	DefaultStorageChallengeMaxFailures    = uint32(3)
+)
+
+var (
+	DefaultStorageChallengeSlashNoz = sdkmath.NewInt(1000000000)
+	DefaultNozRefundFeeRate         = sdkmath.LegacyNewDecWithPrec(1, 2) // 1%
 )
 
 // NewParams creates a new Params object
-func NewParams(bondDenom string) Params {
+func NewParams(bondDenom string, nozPriceSnapshotInterval int64, nozPriceHistorySize uint64, storageChallengeInterval int64,
+	storageChallengesPerRound uint32, storageChallengeResponseWindow int64, storageChallengeSlashNoz sdkmath.Int,
+	storageChallengeMaxFailures uint32, nozRefundFeeRate sdkmath.LegacyDec) Params {
+
 	return Params{
-		BondDenom: bondDenom,
+		BondDenom:                      bondDenom,
+		NozPriceSnapshotInterval:       nozPriceSnapshotInterval,
+		NozPriceHistorySize:            nozPriceHistorySize,
+		StorageChallengeInterval:       storageChallengeInterval,
+		StorageChallengesPerRound:      storageChallengesPerRound,
+		StorageChallengeResponseWindow: storageChallengeResponseWindow,
+		StorageChallengeSlashNoz:       storageChallengeSlashNoz,
+		StorageChallengeMaxFailures:    storageChallengeMaxFailures,
+		NozRefundFeeRate:               nozRefundFeeRate,
 	}
 }
 
 // DefaultParams defines the parameters for this module
 func DefaultParams() Params {
-	p := NewParams(DefaultBondDenom)
+	p := NewParams(DefaultBondDenom, DefaultNozPriceSnapshotInterval, DefaultNozPriceHistorySize, DefaultStorageChallengeInterval,
+		DefaultStorageChallengesPerRound, DefaultStorageChallengeResponseWindow, DefaultStorageChallengeSlashNoz,
+		DefaultStorageChallengeMaxFailures, DefaultNozRefundFeeRate)
 	return p
 }
 
@@ -31,6 +57,27 @@ func (p Params) Validate() error {
 	if err := validateBondDenom(p.BondDenom); err != nil {
 		return errors.Wrap(ErrInvalidDenom, "failed to validate bond denomination")
 	}
+	if err := validateNozPriceSnapshotInterval(p.NozPriceSnapshotInterval); err != nil {
+		return errors.Wrap(ErrInvalid, err.Error())
+	}
+	if p.NozPriceSnapshotInterval > 0 && p.NozPriceHistorySize == 0 {
+		return errors.Wrap(ErrInvalid, "noz price history size must be positive when snapshots are enabled")
+	}
+	if p.StorageChallengeInterval < 0 {
+		return errors.Wrapf(ErrInvalid, "storage challenge interval cannot be negative: %d", p.StorageChallengeInterval)
+	}
+	if p.StorageChallengeInterval > 0 && p.StorageChallengeResponseWindow <= 0 {
+		return errors.Wrap(ErrInvalid, "storage challenge response window must be positive when challenges are enabled")
+	}
+	if p.StorageChallengeSlashNoz.IsNil() || p.StorageChallengeSlashNoz.IsNegative() {
+		return errors.Wrap(ErrInvalid, "storage challenge slash noz cannot be negative")
+	}
+	if p.StorageChallengeInterval > 0 && p.StorageChallengeMaxFailures == 0 {
+		return errors.Wrap(ErrInvalid, "storage challenge max failures must be positive when challenges are enabled")
+	}
+	if p.NozRefundFeeRate.IsNil() || p.NozRefundFeeRate.IsNegative() || p.NozRefundFeeRate.GT(sdkmath.LegacyOneDec()) {
+		return errors.Wrap(ErrInvalid, "noz refund fee rate must be between 0 and 1")
+	}
 	return nil
 }
 
@@ -49,3 +96,16 @@ func validateBondDenom(i interface{}) error {
 
 	return nil
 }
+
+func validateNozPriceSnapshotInterval(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("noz price snapshot interval cannot be negative: %d", v)
+	}
+
+	return nil
+}
@@ -7,8 +7,9 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
-	_ "github.com/cosmos/cosmos-sdk/types"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -434,739 +435,4701 @@ func (m *QueryParamsResponse) GetParams() *Params {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryFileUploadRequest)(nil), "stratos.sds.v1.QueryFileUploadRequest")
-	proto.RegisterType((*QueryFileUploadResponse)(nil), "stratos.sds.v1.QueryFileUploadResponse")
-	proto.RegisterType((*QuerySimPrepayRequest)(nil), "stratos.sds.v1.QuerySimPrepayRequest")
-	proto.RegisterType((*QuerySimPrepayResponse)(nil), "stratos.sds.v1.QuerySimPrepayResponse")
-	proto.RegisterType((*QueryNozPriceRequest)(nil), "stratos.sds.v1.QueryNozPriceRequest")
-	proto.RegisterType((*QueryNozPriceResponse)(nil), "stratos.sds.v1.QueryNozPriceResponse")
-	proto.RegisterType((*QueryNozSupplyRequest)(nil), "stratos.sds.v1.QueryNozSupplyRequest")
-	proto.RegisterType((*QueryNozSupplyResponse)(nil), "stratos.sds.v1.QueryNozSupplyResponse")
-	proto.RegisterType((*QueryParamsRequest)(nil), "stratos.sds.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "stratos.sds.v1.QueryParamsResponse")
+// QueryPrepaySubscriptionsRequest is request type for the Query/PrepaySubscriptions RPC method.
+type QueryPrepaySubscriptionsRequest struct {
+	Sender     string             `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func init() { proto.RegisterFile("stratos/sds/v1/query.proto", fileDescriptor_5b213ac8f144321e) }
-
-var fileDescriptor_5b213ac8f144321e = []byte{
-	// 729 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x95, 0xcf, 0x4f, 0x13, 0x41,
-	0x14, 0xc7, 0xbb, 0x18, 0x1a, 0x3a, 0x1a, 0x63, 0x46, 0x2c, 0x65, 0x21, 0x5b, 0x1d, 0x04, 0x34,
-	0x86, 0x9d, 0x14, 0xc3, 0xc5, 0x23, 0x41, 0x63, 0x2f, 0xa6, 0x94, 0x70, 0x31, 0x31, 0xcd, 0x74,
-	0x3b, 0xb4, 0x1b, 0xba, 0x33, 0x4b, 0x67, 0x4a, 0x6c, 0x09, 0x1e, 0x88, 0x89, 0x37, 0xa3, 0xf1,
-	0x5f, 0xf1, 0x8f, 0xe0, 0x48, 0xf4, 0x62, 0x3c, 0x6c, 0x0c, 0x78, 0xe2, 0xc8, 0x5f, 0x60, 0x76,
-	0x66, 0xfa, 0x6b, 0x29, 0x12, 0x4e, 0xcc, 0xcc, 0xfb, 0xbe, 0xf7, 0x3e, 0xfb, 0x78, 0xdf, 0x14,
-	0xd8, 0x42, 0xb6, 0x88, 0xe4, 0x02, 0x8b, 0x9a, 0xc0, 0xfb, 0x05, 0xbc, 0xd7, 0xa6, 0xad, 0x8e,
-	0x1b, 0xb6, 0xb8, 0xe4, 0xf0, 0xae, 0x89, 0xb9, 0xa2, 0x26, 0xdc, 0xfd, 0x82, 0x3d, 0x5d, 0xe7,
-	0x75, 0xae, 0x42, 0x38, 0x3e, 0x69, 0x95, 0x3d, 0xeb, 0x71, 0x11, 0x70, 0x51, 0xd1, 0x01, 0x7d,
-	0x31, 0xa1, 0xf9, 0x3a, 0xe7, 0xf5, 0x26, 0xc5, 0x24, 0xf4, 0x31, 0x61, 0x8c, 0x4b, 0x22, 0x7d,
-	0xce, 0x7a, 0x51, 0x47, 0x6b, 0x71, 0x95, 0x08, 0x8a, 0xf7, 0x0b, 0x55, 0x2a, 0x49, 0x01, 0x7b,
-	0xdc, 0x67, 0x26, 0x9e, 0x4b, 0xa0, 0xc5, 0x14, 0x2a, 0x82, 0xd6, 0x40, 0x76, 0x33, 0xe6, 0x7c,
-	0xe5, 0x37, 0xe9, 0x76, 0xd8, 0xe4, 0xa4, 0x56, 0xa6, 0x7b, 0x6d, 0x2a, 0x24, 0x9c, 0x03, 0x99,
-	0x1d, 0xbf, 0x49, 0x2b, 0x0d, 0x22, 0x1a, 0x39, 0xeb, 0xa1, 0xf5, 0x24, 0x53, 0x9e, 0x8a, 0x1f,
-	0x5e, 0x13, 0xd1, 0x40, 0x25, 0x30, 0x73, 0x29, 0x4d, 0x84, 0x9c, 0x09, 0x0a, 0xd7, 0x4c, 0x9e,
-	0xcf, 0x76, 0xb8, 0xca, 0xbb, 0xbd, 0x9a, 0x73, 0x47, 0x3f, 0xdf, 0x8d, 0xd3, 0x8a, 0x6c, 0x87,
-	0xeb, 0x8a, 0xf1, 0x09, 0x61, 0xf0, 0x40, 0x55, 0xdc, 0xf2, 0x83, 0x52, 0x8b, 0x86, 0xa4, 0xd3,
-	0xe3, 0xc8, 0x82, 0x34, 0x09, 0x78, 0x9b, 0x49, 0x03, 0x61, 0x6e, 0xe8, 0xd0, 0x90, 0x0f, 0x25,
-	0x18, 0x02, 0x0f, 0xdc, 0x62, 0xbc, 0xab, 0xe5, 0xeb, 0x9b, 0xc7, 0x51, 0x3e, 0xf5, 0x3b, 0xca,
-	0x2f, 0xd5, 0x7d, 0xd9, 0x68, 0x57, 0x5d, 0x8f, 0x07, 0x66, 0xb2, 0xe6, 0xcf, 0x8a, 0xa8, 0xed,
-	0x62, 0xd9, 0x09, 0xa9, 0x70, 0x8b, 0x4c, 0x9e, 0x47, 0xf9, 0x38, 0xf9, 0x22, 0xca, 0x83, 0x0e,
-	0x09, 0x9a, 0x2f, 0x10, 0xe3, 0x5d, 0xf4, 0xe3, 0xfb, 0x0a, 0x30, 0xff, 0x8d, 0x22, 0x93, 0xe5,
-	0x58, 0x80, 0xb2, 0x60, 0x5a, 0xb5, 0x7f, 0xc3, 0xbb, 0xa5, 0x96, 0xef, 0x51, 0x83, 0x8b, 0x3e,
-	0x5a, 0xe6, 0x43, 0x06, 0x01, 0x83, 0xb5, 0x0b, 0x26, 0xc3, 0xf8, 0xc1, 0x80, 0x6d, 0xdf, 0x00,
-	0x6c, 0x83, 0x7a, 0xe7, 0x51, 0x5e, 0xa7, 0x5f, 0x44, 0xf9, 0x3b, 0x1a, 0x4d, 0x5d, 0x87, 0xe1,
-	0x36, 0xa8, 0x57, 0xd6, 0x22, 0x34, 0x33, 0xa0, 0xd8, 0x6a, 0x87, 0x61, 0xb3, 0x37, 0x4e, 0x74,
-	0x34, 0x61, 0xe6, 0x36, 0x14, 0x31, 0x80, 0x07, 0x20, 0xd3, 0xa2, 0x01, 0xf1, 0x99, 0xcf, 0xea,
-	0x06, 0xf2, 0xdd, 0x8d, 0xa7, 0x37, 0x28, 0x71, 0x11, 0xe5, 0xef, 0x69, 0xd0, 0xfe, 0x53, 0x72,
-	0x92, 0x03, 0x71, 0x3c, 0x1d, 0xc9, 0x25, 0x69, 0xe6, 0x26, 0x6e, 0x3c, 0x1d, 0xdd, 0x58, 0xa7,
-	0x0f, 0xa6, 0xa3, 0xae, 0xc9, 0x86, 0x5a, 0x84, 0xa6, 0x01, 0x54, 0x33, 0x28, 0x91, 0x16, 0x09,
-	0x44, 0x6f, 0x34, 0x2f, 0xc1, 0xfd, 0x91, 0x57, 0x33, 0x16, 0x17, 0xa4, 0x43, 0xf5, 0x62, 0xb6,
-	0x39, 0x9b, 0xdc, 0x66, 0xa3, 0x37, 0xaa, 0xd5, 0xaf, 0x93, 0x60, 0x52, 0xd5, 0x81, 0x9f, 0x2d,
-	0x00, 0xe2, 0x55, 0x6f, 0x2b, 0x87, 0xc0, 0xa5, 0x64, 0xe2, 0x78, 0xe7, 0xd9, 0xcb, 0xd7, 0xea,
-	0x34, 0x19, 0xc2, 0x47, 0x3f, 0xff, 0x7e, 0x9b, 0x78, 0x0a, 0x97, 0x71, 0xc2, 0xdf, 0xca, 0x80,
-	0xba, 0x2b, 0x3e, 0xe8, 0xbb, 0xf8, 0x10, 0x7e, 0xb2, 0x40, 0xa6, 0xef, 0x17, 0xb8, 0x38, 0xb6,
-	0x4f, 0xd2, 0x80, 0xf6, 0xd2, 0x75, 0x32, 0x43, 0xf3, 0x4c, 0xd1, 0x2c, 0xc2, 0x85, 0x24, 0x8d,
-	0xf0, 0x83, 0x4a, 0xa8, 0xb4, 0xf8, 0x40, 0x9b, 0xf7, 0x10, 0x76, 0xc1, 0x54, 0xcf, 0x20, 0xf0,
-	0xf1, 0xd8, 0x06, 0x09, 0x63, 0xd9, 0x8b, 0xd7, 0xa8, 0x0c, 0xc5, 0x23, 0x45, 0x31, 0x07, 0x67,
-	0x93, 0x14, 0x8c, 0x77, 0x2b, 0xca, 0x1b, 0xf0, 0x03, 0xc8, 0xf4, 0x97, 0x1f, 0x5e, 0x59, 0x76,
-	0xc4, 0x36, 0x57, 0x0c, 0xe1, 0x92, 0x87, 0x10, 0x52, 0xed, 0xe7, 0xa1, 0x3d, 0xae, 0xbd, 0xd0,
-	0x2d, 0xf7, 0x40, 0x5a, 0xaf, 0x0c, 0x44, 0x63, 0xab, 0x8e, 0x6c, 0xa5, 0xbd, 0xf0, 0x5f, 0x8d,
-	0x69, 0xeb, 0xa8, 0xb6, 0x39, 0x98, 0x4d, 0xb6, 0xd5, 0x3b, 0xb9, 0x5e, 0x3c, 0x3e, 0x75, 0xac,
-	0x93, 0x53, 0xc7, 0xfa, 0x73, 0xea, 0x58, 0x5f, 0xce, 0x9c, 0xd4, 0xc9, 0x99, 0x93, 0xfa, 0x75,
-	0xe6, 0xa4, 0xde, 0xe2, 0x21, 0x83, 0x99, 0x5c, 0x46, 0x65, 0xef, 0xb8, 0xe2, 0x35, 0x88, 0xcf,
-	0xf0, 0x7b, 0x55, 0x4e, 0xb9, 0xad, 0x9a, 0x56, 0x3f, 0x1c, 0xcf, 0xff, 0x05, 0x00, 0x00, 0xff,
-	0xff, 0xab, 0x48, 0x83, 0x61, 0xef, 0x06, 0x00, 0x00,
+func (m *QueryPrepaySubscriptionsRequest) Reset()         { *m = QueryPrepaySubscriptionsRequest{} }
+func (m *QueryPrepaySubscriptionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPrepaySubscriptionsRequest) ProtoMessage()    {}
+func (*QueryPrepaySubscriptionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5b213ac8f144321e, []int{10}
+}
+func (m *QueryPrepaySubscriptionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPrepaySubscriptionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPrepaySubscriptionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPrepaySubscriptionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPrepaySubscriptionsRequest.Merge(m, src)
+}
+func (m *QueryPrepaySubscriptionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPrepaySubscriptionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPrepaySubscriptionsRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_QueryPrepaySubscriptionsRequest proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *QueryPrepaySubscriptionsRequest) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Query uploaded file info by hash
-	Fileupload(ctx context.Context, in *QueryFileUploadRequest, opts ...grpc.CallOption) (*QueryFileUploadResponse, error)
-	SimPrepay(ctx context.Context, in *QuerySimPrepayRequest, opts ...grpc.CallOption) (*QuerySimPrepayResponse, error)
-	NozPrice(ctx context.Context, in *QueryNozPriceRequest, opts ...grpc.CallOption) (*QueryNozPriceResponse, error)
-	NozSupply(ctx context.Context, in *QueryNozSupplyRequest, opts ...grpc.CallOption) (*QueryNozSupplyResponse, error)
-	// Params queries SDS module Params info.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+func (m *QueryPrepaySubscriptionsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+// QueryPrepaySubscriptionsResponse is response type for the Query/PrepaySubscriptions RPC method.
+type QueryPrepaySubscriptionsResponse struct {
+	Subscriptions []PrepaySubscription `protobuf:"bytes,1,rep,name=subscriptions,proto3" json:"subscriptions"`
+	Pagination    *query.PageResponse  `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryPrepaySubscriptionsResponse) Reset()         { *m = QueryPrepaySubscriptionsResponse{} }
+func (m *QueryPrepaySubscriptionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPrepaySubscriptionsResponse) ProtoMessage()    {}
+func (*QueryPrepaySubscriptionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5b213ac8f144321e, []int{11}
+}
+func (m *QueryPrepaySubscriptionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPrepaySubscriptionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPrepaySubscriptionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPrepaySubscriptionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPrepaySubscriptionsResponse.Merge(m, src)
+}
+func (m *QueryPrepaySubscriptionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPrepaySubscriptionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPrepaySubscriptionsResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) Fileupload(ctx context.Context, in *QueryFileUploadRequest, opts ...grpc.CallOption) (*QueryFileUploadResponse, error) {
-	out := new(QueryFileUploadResponse)
-	err := c.cc.Invoke(ctx, "/stratos.sds.v1.Query/Fileupload", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryPrepaySubscriptionsResponse proto.InternalMessageInfo
+
+func (m *QueryPrepaySubscriptionsResponse) GetSubscriptions() []PrepaySubscription {
+	if m != nil {
+		return m.Subscriptions
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) SimPrepay(ctx context.Context, in *QuerySimPrepayRequest, opts ...grpc.CallOption) (*QuerySimPrepayResponse, error) {
-	out := new(QuerySimPrepayResponse)
-	err := c.cc.Invoke(ctx, "/stratos.sds.v1.Query/SimPrepay", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryPrepaySubscriptionsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) NozPrice(ctx context.Context, in *QueryNozPriceRequest, opts ...grpc.CallOption) (*QueryNozPriceResponse, error) {
-	out := new(QueryNozPriceResponse)
-	err := c.cc.Invoke(ctx, "/stratos.sds.v1.Query/NozPrice", in, out, opts...)
-	if err != nil {
-		return nil, err
+// QueryNozPriceHistoryRequest is request type for the Query/NozPriceHistory RPC method.
+type QueryNozPriceHistoryRequest struct {
+	// start_height is the lowest height of returned snapshots
+	StartHeight int64 `protobuf:"varint,1,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// end_height is the highest height of returned snapshots, zero means no upper bound
+	EndHeight  int64              `protobuf:"varint,2,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryNozPriceHistoryRequest) Reset()         { *m = QueryNozPriceHistoryRequest{} }
+func (m *QueryNozPriceHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryNozPriceHistoryRequest) ProtoMessage()    {}
+func (*QueryNozPriceHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5b213ac8f144321e, []int{12}
+}
+func (m *QueryNozPriceHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNozPriceHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNozPriceHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryNozPriceHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNozPriceHistoryRequest.Merge(m, src)
+}
+func (m *QueryNozPriceHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNozPriceHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNozPriceHistoryRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) NozSupply(ctx context.Context, in *QueryNozSupplyRequest, opts ...grpc.CallOption) (*QueryNozSupplyResponse, error) {
-	out := new(QueryNozSupplyResponse)
-	err := c.cc.Invoke(ctx, "/stratos.sds.v1.Query/NozSupply", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryNozPriceHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryNozPriceHistoryRequest) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/stratos.sds.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryNozPriceHistoryRequest) GetEndHeight() int64 {
+	if m != nil {
+		return m.EndHeight
 	}
-	return out, nil
+	return 0
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Query uploaded file info by hash
-	Fileupload(context.Context, *QueryFileUploadRequest) (*QueryFileUploadResponse, error)
-	SimPrepay(context.Context, *QuerySimPrepayRequest) (*QuerySimPrepayResponse, error)
-	NozPrice(context.Context, *QueryNozPriceRequest) (*QueryNozPriceResponse, error)
-	NozSupply(context.Context, *QueryNozSupplyRequest) (*QueryNozSupplyResponse, error)
-	// Params queries SDS module Params info.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+func (m *QueryNozPriceHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+// QueryNozPriceHistoryResponse is response type for the Query/NozPriceHistory RPC method.
+type QueryNozPriceHistoryResponse struct {
+	Snapshots  []NozPriceSnapshot  `protobuf:"bytes,1,rep,name=snapshots,proto3" json:"snapshots"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (*UnimplementedQueryServer) Fileupload(ctx context.Context, req *QueryFileUploadRequest) (*QueryFileUploadResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Fileupload not implemented")
+func (m *QueryNozPriceHistoryResponse) Reset()         { *m = QueryNozPriceHistoryResponse{} }
+func (m *QueryNozPriceHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryNozPriceHistoryResponse) ProtoMessage()    {}
+func (*QueryNozPriceHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5b213ac8f144321e, []int{13}
 }
-func (*UnimplementedQueryServer) SimPrepay(ctx context.Context, req *QuerySimPrepayRequest) (*QuerySimPrepayResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SimPrepay not implemented")
+func (m *QueryNozPriceHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) NozPrice(ctx context.Context, req *QueryNozPriceRequest) (*QueryNozPriceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method NozPrice not implemented")
+func (m *QueryNozPriceHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNozPriceHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) NozSupply(ctx context.Context, req *QueryNozSupplyRequest) (*QueryNozSupplyResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method NozSupply not implemented")
+func (m *QueryNozPriceHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNozPriceHistoryResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryNozPriceHistoryResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryNozPriceHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNozPriceHistoryResponse.DiscardUnknown(m)
 }
 
-func _Query_Fileupload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryFileUploadRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Fileupload(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/stratos.sds.v1.Query/Fileupload",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Fileupload(ctx, req.(*QueryFileUploadRequest))
+var xxx_messageInfo_QueryNozPriceHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryNozPriceHistoryResponse) GetSnapshots() []NozPriceSnapshot {
+	if m != nil {
+		return m.Snapshots
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_SimPrepay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QuerySimPrepayRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).SimPrepay(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/stratos.sds.v1.Query/SimPrepay",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).SimPrepay(ctx, req.(*QuerySimPrepayRequest))
+func (m *QueryNozPriceHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_NozPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryNozPriceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).NozPrice(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/stratos.sds.v1.Query/NozPrice",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).NozPrice(ctx, req.(*QueryNozPriceRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryNozPriceTWAPRequest is request type for the Query/NozPriceTWAP RPC method.
+type QueryNozPriceTWAPRequest struct {
+	// window is the number of latest blocks the average is computed over
+	Window int64 `protobuf:"varint,1,opt,name=window,proto3" json:"window,omitempty"`
 }
 
-func _Query_NozSupply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryNozSupplyRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).NozSupply(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/stratos.sds.v1.Query/NozSupply",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).NozSupply(ctx, req.(*QueryNozSupplyRequest))
+func (m *QueryNozPriceTWAPRequest) Reset()         { *m = QueryNozPriceTWAPRequest{} }
+func (m *QueryNozPriceTWAPRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryNozPriceTWAPRequest) ProtoMessage()    {}
+func (*QueryNozPriceTWAPRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5b213ac8f144321e, []int{14}
+}
+func (m *QueryNozPriceTWAPRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNozPriceTWAPRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNozPriceTWAPRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryNozPriceTWAPRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNozPriceTWAPRequest.Merge(m, src)
+}
+func (m *QueryNozPriceTWAPRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNozPriceTWAPRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNozPriceTWAPRequest.DiscardUnknown(m)
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_QueryNozPriceTWAPRequest proto.InternalMessageInfo
+
+func (m *QueryNozPriceTWAPRequest) GetWindow() int64 {
+	if m != nil {
+		return m.Window
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/stratos.sds.v1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "stratos.sds.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Fileupload",
-			Handler:    _Query_Fileupload_Handler,
-		},
-		{
-			MethodName: "SimPrepay",
-			Handler:    _Query_SimPrepay_Handler,
-		},
-		{
-			MethodName: "NozPrice",
-			Handler:    _Query_NozPrice_Handler,
-		},
-		{
-			MethodName: "NozSupply",
-			Handler:    _Query_NozSupply_Handler,
-		},
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "stratos/sds/v1/query.proto",
+// QueryNozPriceTWAPResponse is response type for the Query/NozPriceTWAP RPC method.
+type QueryNozPriceTWAPResponse struct {
+	Price github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price" yaml:"price"`
 }
 
-func (m *QueryFileUploadRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryNozPriceTWAPResponse) Reset()         { *m = QueryNozPriceTWAPResponse{} }
+func (m *QueryNozPriceTWAPResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryNozPriceTWAPResponse) ProtoMessage()    {}
+func (*QueryNozPriceTWAPResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5b213ac8f144321e, []int{15}
 }
-
-func (m *QueryFileUploadRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryNozPriceTWAPResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *QueryFileUploadRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.FileHash) > 0 {
-		i -= len(m.FileHash)
-		copy(dAtA[i:], m.FileHash)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.FileHash)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryNozPriceTWAPResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNozPriceTWAPResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryFileUploadResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryNozPriceTWAPResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNozPriceTWAPResponse.Merge(m, src)
+}
+func (m *QueryNozPriceTWAPResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNozPriceTWAPResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNozPriceTWAPResponse.DiscardUnknown(m)
 }
 
-func (m *QueryFileUploadResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryNozPriceTWAPResponse proto.InternalMessageInfo
+
+// QueryNozBalanceRequest is request type for the Query/NozBalance RPC method.
+type QueryNozBalanceRequest struct {
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
 }
 
-func (m *QueryFileUploadResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.FileInfo != nil {
-		{
-			size, err := m.FileInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryNozBalanceRequest) Reset()         { *m = QueryNozBalanceRequest{} }
+func (m *QueryNozBalanceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryNozBalanceRequest) ProtoMessage()    {}
+func (*QueryNozBalanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5b213ac8f144321e, []int{16}
+}
+func (m *QueryNozBalanceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNozBalanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNozBalanceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QuerySimPrepayRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryNozBalanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNozBalanceRequest.Merge(m, src)
 }
-
-func (m *QuerySimPrepayRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryNozBalanceRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QuerySimPrepayRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Amount) > 0 {
-		i -= len(m.Amount)
-		copy(dAtA[i:], m.Amount)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Amount)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *QueryNozBalanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNozBalanceRequest.DiscardUnknown(m)
 }
 
-func (m *QuerySimPrepayResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryNozBalanceRequest proto.InternalMessageInfo
+
+func (m *QueryNozBalanceRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QuerySimPrepayResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryNozBalanceResponse is response type for the Query/NozBalance RPC method.
+type QueryNozBalanceResponse struct {
+	Balance github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=balance,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"balance" yaml:"balance"`
 }
 
-func (m *QuerySimPrepayResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Noz.Size()
-		i -= size
-		if _, err := m.Noz.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *QueryNozBalanceResponse) Reset()         { *m = QueryNozBalanceResponse{} }
+func (m *QueryNozBalanceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryNozBalanceResponse) ProtoMessage()    {}
+func (*QueryNozBalanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5b213ac8f144321e, []int{17}
+}
+func (m *QueryNozBalanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNozBalanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNozBalanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryNozPriceRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryNozBalanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNozBalanceResponse.Merge(m, src)
 }
-
-func (m *QueryNozPriceRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryNozBalanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNozBalanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNozBalanceResponse.DiscardUnknown(m)
 }
 
-func (m *QueryNozPriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_QueryNozBalanceResponse proto.InternalMessageInfo
+
+// QueryNozAllowanceRequest is request type for the Query/NozAllowance RPC method.
+type QueryNozAllowanceRequest struct {
+	Owner   string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Spender string `protobuf:"bytes,2,opt,name=spender,proto3" json:"spender,omitempty"`
 }
 
-func (m *QueryNozPriceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryNozAllowanceRequest) Reset()         { *m = QueryNozAllowanceRequest{} }
+func (m *QueryNozAllowanceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryNozAllowanceRequest) ProtoMessage()    {}
+func (*QueryNozAllowanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5b213ac8f144321e, []int{18}
+}
+func (m *QueryNozAllowanceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNozAllowanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNozAllowanceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryNozPriceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryNozAllowanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNozAllowanceRequest.Merge(m, src)
+}
+func (m *QueryNozAllowanceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNozAllowanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNozAllowanceRequest.DiscardUnknown(m)
 }
 
-func (m *QueryNozPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Price.Size()
-		i -= size
-		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+var xxx_messageInfo_QueryNozAllowanceRequest proto.InternalMessageInfo
+
+func (m *QueryNozAllowanceRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryNozSupplyRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryNozAllowanceRequest) GetSpender() string {
+	if m != nil {
+		return m.Spender
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryNozSupplyRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryNozAllowanceResponse is response type for the Query/NozAllowance RPC method.
+type QueryNozAllowanceResponse struct {
+	Allowance github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=allowance,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"allowance" yaml:"allowance"`
 }
 
-func (m *QueryNozSupplyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryNozAllowanceResponse) Reset()         { *m = QueryNozAllowanceResponse{} }
+func (m *QueryNozAllowanceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryNozAllowanceResponse) ProtoMessage()    {}
+func (*QueryNozAllowanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5b213ac8f144321e, []int{19}
 }
-
-func (m *QueryNozSupplyResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryNozAllowanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNozAllowanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNozAllowanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryNozAllowanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNozAllowanceResponse.Merge(m, src)
+}
+func (m *QueryNozAllowanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNozAllowanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNozAllowanceResponse.DiscardUnknown(m)
 }
 
-func (m *QueryNozSupplyResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryNozAllowanceResponse proto.InternalMessageInfo
+
+// QueryFileAccessRequest is request type for the Query/FileAccess RPC method.
+type QueryFileAccessRequest struct {
+	FileHash string `protobuf:"bytes,1,opt,name=file_hash,json=fileHash,proto3" json:"file_hash,omitempty"`
+	Grantee  string `protobuf:"bytes,2,opt,name=grantee,proto3" json:"grantee,omitempty"`
 }
 
-func (m *QueryNozSupplyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Total.Size()
-		i -= size
-		if _, err := m.Total.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *QueryFileAccessRequest) Reset()         { *m = QueryFileAccessRequest{} }
+func (m *QueryFileAccessRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFileAccessRequest) ProtoMessage()    {}
+func (*QueryFileAccessRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5b213ac8f144321e, []int{20}
+}
+func (m *QueryFileAccessRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFileAccessRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFileAccessRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.Remaining.Size()
-		i -= size
-		if _, err := m.Remaining.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+}
+func (m *QueryFileAccessRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFileAccessRequest.Merge(m, src)
+}
+func (m *QueryFileAccessRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFileAccessRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFileAccessRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFileAccessRequest proto.InternalMessageInfo
+
+func (m *QueryFileAccessRequest) GetFileHash() string {
+	if m != nil {
+		return m.FileHash
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryFileAccessRequest) GetGrantee() string {
+	if m != nil {
+		return m.Grantee
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryFileAccessResponse is response type for the Query/FileAccess RPC method.
+type QueryFileAccessResponse struct {
+	// has_access is true when a grant exists and is not expired at the current height
+	HasAccess bool             `protobuf:"varint,1,opt,name=has_access,json=hasAccess,proto3" json:"has_access,omitempty"`
+	Grant     *FileAccessGrant `protobuf:"bytes,2,opt,name=grant,proto3" json:"grant,omitempty"`
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryFileAccessResponse) Reset()         { *m = QueryFileAccessResponse{} }
+func (m *QueryFileAccessResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFileAccessResponse) ProtoMessage()    {}
+func (*QueryFileAccessResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5b213ac8f144321e, []int{21}
+}
+func (m *QueryFileAccessResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFileAccessResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFileAccessResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFileAccessResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFileAccessResponse.Merge(m, src)
+}
+func (m *QueryFileAccessResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFileAccessResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFileAccessResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryFileAccessResponse proto.InternalMessageInfo
+
+func (m *QueryFileAccessResponse) GetHasAccess() bool {
+	if m != nil {
+		return m.HasAccess
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryFileAccessResponse) GetGrant() *FileAccessGrant {
+	if m != nil {
+		return m.Grant
+	}
+	return nil
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Params != nil {
-		{
-			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+// QueryStorageChallengesRequest is request type for the Query/StorageChallenges RPC method.
+type QueryStorageChallengesRequest struct {
+	NetworkAddress string             `protobuf:"bytes,1,opt,name=network_address,json=networkAddress,proto3" json:"network_address,omitempty"`
+	Pagination     *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryStorageChallengesRequest) Reset()         { *m = QueryStorageChallengesRequest{} }
+func (m *QueryStorageChallengesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStorageChallengesRequest) ProtoMessage()    {}
+func (*QueryStorageChallengesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5b213ac8f144321e, []int{22}
+}
+func (m *QueryStorageChallengesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStorageChallengesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStorageChallengesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryStorageChallengesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStorageChallengesRequest.Merge(m, src)
+}
+func (m *QueryStorageChallengesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStorageChallengesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStorageChallengesRequest.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_QueryStorageChallengesRequest proto.InternalMessageInfo
+
+func (m *QueryStorageChallengesRequest) GetNetworkAddress() string {
+	if m != nil {
+		return m.NetworkAddress
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *QueryFileUploadRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.FileHash)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+
+func (m *QueryStorageChallengesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return n
+	return nil
 }
 
-func (m *QueryFileUploadResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.FileInfo != nil {
-		l = m.FileInfo.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+// QueryStorageChallengesResponse is response type for the Query/StorageChallenges RPC method.
+type QueryStorageChallengesResponse struct {
+	Challenges []StorageChallenge `protobuf:"bytes,1,rep,name=challenges,proto3" json:"challenges"`
+	// failure_count is the number of consecutive failed challenges of the resource node
+	FailureCount uint64              `protobuf:"varint,2,opt,name=failure_count,json=failureCount,proto3" json:"failure_count,omitempty"`
+	Pagination   *query.PageResponse `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QuerySimPrepayRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Amount)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryStorageChallengesResponse) Reset()         { *m = QueryStorageChallengesResponse{} }
+func (m *QueryStorageChallengesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStorageChallengesResponse) ProtoMessage()    {}
+func (*QueryStorageChallengesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5b213ac8f144321e, []int{23}
+}
+func (m *QueryStorageChallengesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStorageChallengesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStorageChallengesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryStorageChallengesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStorageChallengesResponse.Merge(m, src)
+}
+func (m *QueryStorageChallengesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStorageChallengesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStorageChallengesResponse.DiscardUnknown(m)
 }
 
-func (m *QuerySimPrepayResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryStorageChallengesResponse proto.InternalMessageInfo
+
+func (m *QueryStorageChallengesResponse) GetChallenges() []StorageChallenge {
+	if m != nil {
+		return m.Challenges
 	}
-	var l int
-	_ = l
-	l = m.Noz.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return nil
 }
 
-func (m *QueryNozPriceRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryStorageChallengesResponse) GetFailureCount() uint64 {
+	if m != nil {
+		return m.FailureCount
 	}
-	var l int
-	_ = l
-	return n
+	return 0
 }
 
-func (m *QueryNozPriceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryStorageChallengesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	var l int
-	_ = l
-	l = m.Price.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return nil
 }
 
-func (m *QueryNozSupplyRequest) Size() (n int) {
-	if m == nil {
-		return 0
+// QuerySimRefundRequest is request type for the Query/SimRefund RPC method.
+type QuerySimRefundRequest struct {
+	// amount is the amount of noz to refund
+	Amount string `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (m *QuerySimRefundRequest) Reset()         { *m = QuerySimRefundRequest{} }
+func (m *QuerySimRefundRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySimRefundRequest) ProtoMessage()    {}
+func (*QuerySimRefundRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5b213ac8f144321e, []int{24}
+}
+func (m *QuerySimRefundRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimRefundRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimRefundRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySimRefundRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimRefundRequest.Merge(m, src)
+}
+func (m *QuerySimRefundRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimRefundRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimRefundRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySimRefundRequest proto.InternalMessageInfo
+
+func (m *QuerySimRefundRequest) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+// QuerySimRefundResponse is response type for the Query/SimRefund RPC method.
+type QuerySimRefundResponse struct {
+	// refunded is the amount of STOS sent to the sender, fee excluded
+	Refunded types.Coin `protobuf:"bytes,1,opt,name=refunded,proto3" json:"refunded"`
+	Fee      types.Coin `protobuf:"bytes,2,opt,name=fee,proto3" json:"fee"`
+}
+
+func (m *QuerySimRefundResponse) Reset()         { *m = QuerySimRefundResponse{} }
+func (m *QuerySimRefundResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySimRefundResponse) ProtoMessage()    {}
+func (*QuerySimRefundResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5b213ac8f144321e, []int{25}
+}
+func (m *QuerySimRefundResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimRefundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimRefundResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySimRefundResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimRefundResponse.Merge(m, src)
+}
+func (m *QuerySimRefundResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimRefundResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimRefundResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySimRefundResponse proto.InternalMessageInfo
+
+func (m *QuerySimRefundResponse) GetRefunded() types.Coin {
+	if m != nil {
+		return m.Refunded
+	}
+	return types.Coin{}
+}
+
+func (m *QuerySimRefundResponse) GetFee() types.Coin {
+	if m != nil {
+		return m.Fee
+	}
+	return types.Coin{}
+}
+
+// QueryStorageRentRequest is request type for the Query/StorageRent RPC method.
+type QueryStorageRentRequest struct {
+	FileHash string `protobuf:"bytes,1,opt,name=file_hash,json=fileHash,proto3" json:"file_hash,omitempty"`
+}
+
+func (m *QueryStorageRentRequest) Reset()         { *m = QueryStorageRentRequest{} }
+func (m *QueryStorageRentRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStorageRentRequest) ProtoMessage()    {}
+func (*QueryStorageRentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5b213ac8f144321e, []int{26}
+}
+func (m *QueryStorageRentRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStorageRentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStorageRentRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStorageRentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStorageRentRequest.Merge(m, src)
+}
+func (m *QueryStorageRentRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStorageRentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStorageRentRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStorageRentRequest proto.InternalMessageInfo
+
+func (m *QueryStorageRentRequest) GetFileHash() string {
+	if m != nil {
+		return m.FileHash
+	}
+	return ""
+}
+
+// QueryStorageRentResponse is response type for the Query/StorageRent RPC method.
+type QueryStorageRentResponse struct {
+	Rent *StorageRent `protobuf:"bytes,1,opt,name=rent,proto3" json:"rent,omitempty"`
+}
+
+func (m *QueryStorageRentResponse) Reset()         { *m = QueryStorageRentResponse{} }
+func (m *QueryStorageRentResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStorageRentResponse) ProtoMessage()    {}
+func (*QueryStorageRentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5b213ac8f144321e, []int{27}
+}
+func (m *QueryStorageRentResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStorageRentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStorageRentResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStorageRentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStorageRentResponse.Merge(m, src)
+}
+func (m *QueryStorageRentResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStorageRentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStorageRentResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStorageRentResponse proto.InternalMessageInfo
+
+func (m *QueryStorageRentResponse) GetRent() *StorageRent {
+	if m != nil {
+		return m.Rent
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryFileUploadRequest)(nil), "stratos.sds.v1.QueryFileUploadRequest")
+	proto.RegisterType((*QueryFileUploadResponse)(nil), "stratos.sds.v1.QueryFileUploadResponse")
+	proto.RegisterType((*QuerySimPrepayRequest)(nil), "stratos.sds.v1.QuerySimPrepayRequest")
+	proto.RegisterType((*QuerySimPrepayResponse)(nil), "stratos.sds.v1.QuerySimPrepayResponse")
+	proto.RegisterType((*QueryNozPriceRequest)(nil), "stratos.sds.v1.QueryNozPriceRequest")
+	proto.RegisterType((*QueryNozPriceResponse)(nil), "stratos.sds.v1.QueryNozPriceResponse")
+	proto.RegisterType((*QueryNozSupplyRequest)(nil), "stratos.sds.v1.QueryNozSupplyRequest")
+	proto.RegisterType((*QueryNozSupplyResponse)(nil), "stratos.sds.v1.QueryNozSupplyResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "stratos.sds.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "stratos.sds.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryPrepaySubscriptionsRequest)(nil), "stratos.sds.v1.QueryPrepaySubscriptionsRequest")
+	proto.RegisterType((*QueryPrepaySubscriptionsResponse)(nil), "stratos.sds.v1.QueryPrepaySubscriptionsResponse")
+	proto.RegisterType((*QueryNozPriceHistoryRequest)(nil), "stratos.sds.v1.QueryNozPriceHistoryRequest")
+	proto.RegisterType((*QueryNozPriceHistoryResponse)(nil), "stratos.sds.v1.QueryNozPriceHistoryResponse")
+	proto.RegisterType((*QueryNozPriceTWAPRequest)(nil), "stratos.sds.v1.QueryNozPriceTWAPRequest")
+	proto.RegisterType((*QueryNozPriceTWAPResponse)(nil), "stratos.sds.v1.QueryNozPriceTWAPResponse")
+	proto.RegisterType((*QueryNozBalanceRequest)(nil), "stratos.sds.v1.QueryNozBalanceRequest")
+	proto.RegisterType((*QueryNozBalanceResponse)(nil), "stratos.sds.v1.QueryNozBalanceResponse")
+	proto.RegisterType((*QueryNozAllowanceRequest)(nil), "stratos.sds.v1.QueryNozAllowanceRequest")
+	proto.RegisterType((*QueryNozAllowanceResponse)(nil), "stratos.sds.v1.QueryNozAllowanceResponse")
+	proto.RegisterType((*QueryFileAccessRequest)(nil), "stratos.sds.v1.QueryFileAccessRequest")
+	proto.RegisterType((*QueryFileAccessResponse)(nil), "stratos.sds.v1.QueryFileAccessResponse")
+	proto.RegisterType((*QueryStorageChallengesRequest)(nil), "stratos.sds.v1.QueryStorageChallengesRequest")
+	proto.RegisterType((*QueryStorageChallengesResponse)(nil), "stratos.sds.v1.QueryStorageChallengesResponse")
+	proto.RegisterType((*QuerySimRefundRequest)(nil), "stratos.sds.v1.QuerySimRefundRequest")
+	proto.RegisterType((*QuerySimRefundResponse)(nil), "stratos.sds.v1.QuerySimRefundResponse")
+	proto.RegisterType((*QueryStorageRentRequest)(nil), "stratos.sds.v1.QueryStorageRentRequest")
+	proto.RegisterType((*QueryStorageRentResponse)(nil), "stratos.sds.v1.QueryStorageRentResponse")
+}
+
+func init() { proto.RegisterFile("stratos/sds/v1/query.proto", fileDescriptor_5b213ac8f144321e) }
+
+var fileDescriptor_5b213ac8f144321e = []byte{
+	// 1615 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0xcf, 0x6f, 0x1b, 0x4f,
+	0x15, 0xcf, 0x26, 0x4d, 0xbe, 0xf1, 0x4b, 0xbe, 0xfd, 0x96, 0x69, 0xea, 0x38, 0x9b, 0xd6, 0x4e,
+	0x37, 0x34, 0x3f, 0x5a, 0xe2, 0x6d, 0x52, 0x52, 0xc4, 0x8f, 0x4b, 0xd2, 0xd2, 0x36, 0x20, 0x95,
+	0xd4, 0xa1, 0x42, 0x20, 0x21, 0x6b, 0x62, 0x4f, 0xec, 0x55, 0xed, 0x9d, 0xed, 0xce, 0xba, 0x21,
+	0xb1, 0x8c, 0x44, 0x85, 0x44, 0x85, 0x44, 0x55, 0x01, 0x12, 0x3d, 0x70, 0xe7, 0x84, 0xe0, 0xc0,
+	0x8d, 0x7f, 0xa0, 0xc7, 0x0a, 0x24, 0x84, 0x38, 0x58, 0xa8, 0xe5, 0xd4, 0x63, 0xfe, 0x02, 0xb4,
+	0x33, 0x6f, 0xd7, 0xbb, 0x6b, 0x3b, 0x76, 0xaa, 0x4a, 0xdf, 0x53, 0x3c, 0x6f, 0x3e, 0xef, 0xbd,
+	0xcf, 0xbc, 0x7d, 0xfb, 0xe6, 0xb3, 0x01, 0x5d, 0x78, 0x2e, 0xf5, 0xb8, 0x30, 0x45, 0x59, 0x98,
+	0xcf, 0xd6, 0xcd, 0xa7, 0x0d, 0xe6, 0x1e, 0xe5, 0x1d, 0x97, 0x7b, 0x9c, 0x9c, 0xc7, 0xbd, 0xbc,
+	0x28, 0x8b, 0xfc, 0xb3, 0x75, 0x7d, 0xa6, 0xc2, 0x2b, 0x5c, 0x6e, 0x99, 0xfe, 0x2f, 0x85, 0xd2,
+	0xe7, 0x4a, 0x5c, 0xd4, 0xb9, 0x28, 0xaa, 0x0d, 0xb5, 0xc0, 0xad, 0xcb, 0x15, 0xce, 0x2b, 0x35,
+	0x66, 0x52, 0xc7, 0x32, 0xa9, 0x6d, 0x73, 0x8f, 0x7a, 0x16, 0xb7, 0x83, 0xdd, 0xac, 0xc2, 0x9a,
+	0xfb, 0x54, 0x30, 0xf3, 0xd9, 0xfa, 0x3e, 0xf3, 0xe8, 0xba, 0x59, 0xe2, 0x96, 0x8d, 0xfb, 0xd7,
+	0xa3, 0xfb, 0x92, 0x57, 0x88, 0x72, 0x68, 0xc5, 0xb2, 0x65, 0x30, 0xc4, 0x66, 0x12, 0xc7, 0xf0,
+	0x19, 0xcb, 0x1d, 0x63, 0x13, 0xd2, 0x8f, 0x7c, 0xdf, 0x7b, 0x56, 0x8d, 0x3d, 0x76, 0x6a, 0x9c,
+	0x96, 0x0b, 0xec, 0x69, 0x83, 0x09, 0x8f, 0xcc, 0x43, 0xea, 0xc0, 0xaa, 0xb1, 0x62, 0x95, 0x8a,
+	0x6a, 0x46, 0x5b, 0xd0, 0x56, 0x52, 0x85, 0x49, 0xdf, 0xf0, 0x80, 0x8a, 0xaa, 0xb1, 0x0b, 0xb3,
+	0x5d, 0x6e, 0xc2, 0xe1, 0xb6, 0x60, 0x64, 0x13, 0xfd, 0x2c, 0xfb, 0x80, 0x4b, 0xbf, 0xa9, 0x8d,
+	0x4c, 0x3e, 0x5e, 0xaa, 0xbc, 0xef, 0xb6, 0x63, 0x1f, 0x70, 0x15, 0xd1, 0xff, 0x65, 0x98, 0x70,
+	0x49, 0x46, 0xdc, 0xb3, 0xea, 0xbb, 0x2e, 0x73, 0xe8, 0x51, 0xc0, 0x23, 0x0d, 0x13, 0xb4, 0xce,
+	0x1b, 0xb6, 0x87, 0x24, 0x70, 0x65, 0xb4, 0x20, 0x9d, 0x74, 0x40, 0x06, 0x25, 0x18, 0xb3, 0xf9,
+	0xb1, 0x82, 0x6f, 0x3f, 0x7a, 0xd3, 0xce, 0x8d, 0xfc, 0xa7, 0x9d, 0x5b, 0xaa, 0x58, 0x5e, 0xb5,
+	0xb1, 0x9f, 0x2f, 0xf1, 0x3a, 0x3e, 0x05, 0xfc, 0xb3, 0x26, 0xca, 0x4f, 0x4c, 0xef, 0xc8, 0x61,
+	0x22, 0xbf, 0x63, 0x7b, 0x1f, 0xda, 0x39, 0xdf, 0xf9, 0xa4, 0x9d, 0x83, 0x23, 0x5a, 0xaf, 0x7d,
+	0xcb, 0xb0, 0xf9, 0xb1, 0xf1, 0x8f, 0xbf, 0xad, 0x01, 0x3e, 0xb9, 0x1d, 0xdb, 0x2b, 0xf8, 0x00,
+	0x23, 0x0d, 0x33, 0x32, 0xfd, 0x43, 0x7e, 0xbc, 0xeb, 0x5a, 0x25, 0x86, 0x74, 0x8d, 0x5f, 0x6a,
+	0x70, 0x29, 0xb1, 0x81, 0xb4, 0x9e, 0xc0, 0xb8, 0xe3, 0x1b, 0x90, 0xd8, 0xe3, 0x33, 0x10, 0xbb,
+	0xcb, 0x4a, 0x1f, 0xda, 0x39, 0xe5, 0x7e, 0xd2, 0xce, 0x4d, 0x2b, 0x6a, 0x72, 0x19, 0x25, 0x77,
+	0x97, 0x95, 0x0a, 0x0a, 0x64, 0xcc, 0x76, 0x58, 0xec, 0x35, 0x1c, 0xa7, 0x16, 0x94, 0xd3, 0x78,
+	0x3e, 0x0a, 0xe9, 0xe4, 0x0e, 0x12, 0x6c, 0x42, 0xca, 0x65, 0x75, 0x6a, 0xd9, 0x96, 0x5d, 0x41,
+	0x92, 0x3f, 0x3d, 0x73, 0xf5, 0x3a, 0x21, 0x4e, 0xda, 0xb9, 0x0b, 0x8a, 0x68, 0x68, 0x4a, 0x56,
+	0xb2, 0x03, 0xf6, 0xab, 0xe3, 0x71, 0x8f, 0xd6, 0x32, 0xa3, 0x67, 0xae, 0x8e, 0x4a, 0xac, 0xdc,
+	0x3b, 0xd5, 0x91, 0xcb, 0x64, 0x42, 0x05, 0x32, 0x66, 0x80, 0xc8, 0x1a, 0xec, 0x52, 0x97, 0xd6,
+	0x45, 0x50, 0x9a, 0xef, 0xc2, 0xc5, 0x98, 0x15, 0xcb, 0x92, 0x87, 0x09, 0x47, 0x5a, 0xb0, 0x9b,
+	0xd3, 0xc9, 0x6e, 0x46, 0x3c, 0xa2, 0x8c, 0x5f, 0x68, 0x90, 0x53, 0x71, 0x64, 0x5b, 0xee, 0x35,
+	0xf6, 0x45, 0xc9, 0xb5, 0x1c, 0xf9, 0x6e, 0x47, 0x9a, 0x5a, 0x30, 0xbb, 0xcc, 0xdc, 0xa0, 0xa9,
+	0xd5, 0x8a, 0xdc, 0x03, 0xe8, 0xbc, 0xbc, 0xb2, 0x14, 0x53, 0x1b, 0x4b, 0x79, 0x3c, 0x80, 0xff,
+	0xa6, 0xe7, 0xd5, 0x04, 0xc2, 0x37, 0x3d, 0xbf, 0x4b, 0x2b, 0x41, 0xe7, 0x15, 0x22, 0x9e, 0xc6,
+	0xdf, 0x35, 0x58, 0xe8, 0xcf, 0x01, 0x0f, 0xf6, 0x10, 0x3e, 0x17, 0xd1, 0x8d, 0x8c, 0xb6, 0x30,
+	0xb6, 0x32, 0xb5, 0x61, 0x74, 0x9d, 0xaf, 0x2b, 0xc6, 0xf6, 0x39, 0xff, 0xf1, 0x14, 0xe2, 0xee,
+	0xe4, 0x7e, 0x0f, 0xf2, 0xcb, 0x03, 0xc9, 0x2b, 0x32, 0x31, 0xf6, 0x7f, 0xd2, 0x60, 0x3e, 0xf6,
+	0x0e, 0x3d, 0xb0, 0x84, 0xc7, 0xdd, 0x70, 0x24, 0x5c, 0x85, 0x69, 0xe1, 0x51, 0xd7, 0x2b, 0x56,
+	0x99, 0x55, 0xa9, 0xaa, 0xc1, 0x30, 0x56, 0x98, 0x92, 0xb6, 0x07, 0xd2, 0x44, 0xae, 0x00, 0x30,
+	0xbb, 0x1c, 0x00, 0x46, 0x25, 0x20, 0xc5, 0xec, 0x32, 0x6e, 0xc7, 0xeb, 0x3c, 0xf6, 0xd1, 0x75,
+	0xfe, 0xb3, 0x06, 0x97, 0x7b, 0x33, 0xc5, 0x1a, 0xdf, 0x85, 0x94, 0xb0, 0xa9, 0x23, 0xaa, 0xdc,
+	0x0b, 0xea, 0xbb, 0x90, 0xac, 0x6f, 0xe0, 0xbb, 0x87, 0x40, 0xac, 0x6e, 0xc7, 0xf1, 0xd3, 0x55,
+	0x76, 0x03, 0x32, 0x31, 0xba, 0x3f, 0xfc, 0xd1, 0xd6, 0x6e, 0xa4, 0x27, 0x0f, 0x2d, 0xbb, 0xcc,
+	0x0f, 0xb1, 0x9e, 0xb8, 0x32, 0x5e, 0x68, 0x30, 0xd7, 0xc3, 0xe9, 0xcb, 0x98, 0x6a, 0xf9, 0xce,
+	0xec, 0xda, 0xa6, 0x35, 0x6a, 0x87, 0x63, 0x97, 0xcc, 0xc0, 0x38, 0x3f, 0xb4, 0xc3, 0xf7, 0x49,
+	0x2d, 0x8c, 0x97, 0x1a, 0xcc, 0x76, 0x39, 0x20, 0x71, 0x01, 0x9f, 0xed, 0x2b, 0x13, 0x52, 0xff,
+	0xf1, 0x99, 0x47, 0x4e, 0x10, 0xe0, 0xa4, 0x9d, 0x3b, 0xaf, 0xc8, 0xa3, 0x21, 0x39, 0x76, 0x02,
+	0xa0, 0xf1, 0xbd, 0x4e, 0xfd, 0xb7, 0x6a, 0x35, 0x7e, 0x38, 0xf0, 0x08, 0x24, 0x03, 0x9f, 0x09,
+	0x47, 0x8d, 0x0a, 0x39, 0x19, 0x0b, 0xc1, 0xd2, 0x78, 0x1d, 0x79, 0x2e, 0x91, 0x60, 0x9d, 0x61,
+	0x4e, 0x03, 0xe3, 0xc7, 0x0f, 0xf3, 0x30, 0x44, 0x67, 0x98, 0x87, 0xa6, 0xae, 0x61, 0xde, 0xd9,
+	0xf9, 0x41, 0x44, 0x55, 0x6c, 0x95, 0x4a, 0x4c, 0x88, 0x61, 0x54, 0x85, 0x7f, 0xd6, 0x8a, 0x4b,
+	0x6d, 0x8f, 0xb1, 0xe0, 0xac, 0xb8, 0x34, 0x38, 0xcc, 0x76, 0x05, 0xc4, 0x83, 0x5e, 0x01, 0xa8,
+	0x52, 0x51, 0xa4, 0xd2, 0x2a, 0x43, 0x4e, 0x16, 0x52, 0x55, 0x2a, 0x14, 0x8c, 0x6c, 0xc2, 0xb8,
+	0x0c, 0x82, 0x6f, 0x4d, 0xae, 0x97, 0x14, 0x51, 0xd0, 0xfb, 0x3e, 0xac, 0xa0, 0xd0, 0xc6, 0x2b,
+	0x0d, 0xae, 0x28, 0x79, 0xe1, 0x71, 0x97, 0x56, 0xd8, 0x9d, 0x2a, 0xad, 0xd5, 0x98, 0x5d, 0x61,
+	0xe1, 0x49, 0x96, 0xe1, 0x0b, 0x9b, 0x79, 0x87, 0xdc, 0x7d, 0x52, 0xa4, 0xe5, 0xb2, 0x1b, 0x24,
+	0x4f, 0x15, 0xce, 0xa3, 0x79, 0x4b, 0x59, 0x3f, 0xd9, 0x4c, 0xff, 0x97, 0x06, 0xd9, 0x7e, 0x94,
+	0xb0, 0x16, 0xf7, 0x00, 0x4a, 0xa1, 0xb5, 0xdf, 0xb8, 0x49, 0xba, 0xe3, 0xb8, 0x89, 0x78, 0x92,
+	0x45, 0xf8, 0xfc, 0x80, 0x5a, 0xb5, 0x86, 0xcb, 0x8a, 0x25, 0x29, 0xbd, 0x7c, 0xd6, 0xe7, 0x0a,
+	0xd3, 0x68, 0xbc, 0xe3, 0xdb, 0xc8, 0xfd, 0x1e, 0x33, 0xf4, 0xa3, 0x86, 0x52, 0x44, 0xfa, 0x15,
+	0xd8, 0x41, 0xc3, 0x2e, 0x0f, 0x92, 0x7e, 0x2f, 0x34, 0x48, 0x27, 0x3d, 0xb0, 0x02, 0xdf, 0x86,
+	0x49, 0x57, 0x5a, 0x58, 0x19, 0xaf, 0xeb, 0xb9, 0x18, 0xa5, 0x80, 0xcc, 0x1d, 0x6e, 0x05, 0xb7,
+	0x58, 0xe8, 0x40, 0xd6, 0x61, 0xec, 0x00, 0x7b, 0x6f, 0x08, 0x3f, 0x1f, 0x6b, 0xdc, 0xc6, 0xc6,
+	0xc4, 0xa2, 0x16, 0x98, 0xed, 0x0d, 0x25, 0xa0, 0xbf, 0x0f, 0x99, 0x6e, 0x3f, 0x3c, 0x83, 0x09,
+	0xe7, 0x5c, 0x86, 0x87, 0x9e, 0xda, 0x98, 0xef, 0xf3, 0xfc, 0xa4, 0x8b, 0x04, 0x6e, 0xfc, 0xe6,
+	0x02, 0x8c, 0xcb, 0x68, 0xe4, 0xa5, 0x06, 0xe0, 0x77, 0x74, 0x43, 0x6a, 0x72, 0xb2, 0x94, 0xf4,
+	0xed, 0xad, 0xf5, 0xf5, 0xe5, 0x81, 0x38, 0x45, 0xcd, 0x30, 0x9f, 0xff, 0xf3, 0x7f, 0xbf, 0x1b,
+	0x5d, 0x25, 0xcb, 0x66, 0xe2, 0x8b, 0x42, 0x9e, 0x54, 0x65, 0x35, 0x9b, 0xe1, 0xb1, 0x5b, 0xe4,
+	0x57, 0x1a, 0xa4, 0x42, 0x85, 0x4e, 0xae, 0xf5, 0xcc, 0x93, 0x94, 0xfc, 0xfa, 0xd2, 0x20, 0x18,
+	0xb2, 0xb9, 0x21, 0xd9, 0x5c, 0x23, 0x8b, 0x49, 0x36, 0xc2, 0xaa, 0x17, 0x1d, 0x89, 0x35, 0x9b,
+	0xaa, 0x67, 0x42, 0x26, 0xaa, 0x5f, 0xfa, 0x33, 0x89, 0x75, 0xa0, 0xbe, 0x34, 0x08, 0x36, 0x0c,
+	0x13, 0xd5, 0x5f, 0x1d, 0x26, 0xc7, 0x30, 0x19, 0x5c, 0xa5, 0xe4, 0xab, 0x3d, 0x13, 0x24, 0x3e,
+	0x2a, 0xf4, 0x6b, 0x03, 0x50, 0xc8, 0xe2, 0xaa, 0x64, 0x31, 0x4f, 0xe6, 0x92, 0x2c, 0x6c, 0x7e,
+	0x5c, 0x94, 0x37, 0x28, 0xf9, 0x39, 0xa4, 0x42, 0xe1, 0x4f, 0xfa, 0x86, 0x8d, 0x7d, 0x32, 0xe8,
+	0x4b, 0x83, 0x60, 0x98, 0xde, 0x90, 0xe9, 0x2f, 0x13, 0xbd, 0x57, 0x7a, 0xa1, 0x52, 0x3e, 0x85,
+	0x09, 0x25, 0x97, 0x89, 0xd1, 0x33, 0x6a, 0x4c, 0x91, 0xeb, 0x8b, 0xa7, 0x62, 0x30, 0x6d, 0x56,
+	0xa6, 0xcd, 0x90, 0x74, 0x32, 0xad, 0xd2, 0xe3, 0xe4, 0x2f, 0x1a, 0x5c, 0xec, 0x21, 0x83, 0x89,
+	0xd9, 0x3b, 0x78, 0x5f, 0xd1, 0xae, 0xdf, 0x1c, 0xde, 0x01, 0xa9, 0x6d, 0x4a, 0x6a, 0x26, 0x59,
+	0xeb, 0xa2, 0x26, 0x9d, 0x8a, 0x31, 0xfd, 0x6c, 0x36, 0xd5, 0x47, 0x40, 0x8b, 0xfc, 0x41, 0x83,
+	0x2f, 0x12, 0x82, 0x92, 0xdc, 0x38, 0xb5, 0x05, 0xe2, 0x02, 0x59, 0xff, 0xda, 0x70, 0x60, 0x64,
+	0xb9, 0x2a, 0x59, 0x2e, 0x92, 0xab, 0x7d, 0xdb, 0xa6, 0x58, 0x45, 0x16, 0xbf, 0xd7, 0x60, 0x3a,
+	0x2a, 0x03, 0xc9, 0xca, 0xa9, 0x99, 0x22, 0xf2, 0x52, 0x5f, 0x1d, 0x02, 0x39, 0x68, 0xca, 0x74,
+	0x08, 0x79, 0x87, 0xd4, 0x31, 0x9b, 0x4a, 0xa1, 0xb6, 0xc8, 0xaf, 0x35, 0x80, 0x8e, 0xc4, 0x23,
+	0x7d, 0x1b, 0x36, 0x2e, 0x1a, 0xf5, 0xe5, 0x81, 0xb8, 0x41, 0xaf, 0xb7, 0x4f, 0x08, 0xb5, 0x9d,
+	0xd9, 0x94, 0x82, 0xad, 0x45, 0xfe, 0xa8, 0x6a, 0x14, 0x4a, 0xb2, 0xfe, 0x35, 0x4a, 0x4a, 0x40,
+	0x7d, 0x75, 0x08, 0x24, 0x52, 0xfa, 0x86, 0xa4, 0xb4, 0x4e, 0xcc, 0x5e, 0x94, 0x42, 0x25, 0x16,
+	0x90, 0x32, 0x9b, 0xa8, 0x1a, 0x5b, 0xe4, 0x35, 0x5e, 0x11, 0xa8, 0x8f, 0xfa, 0x5f, 0x11, 0x31,
+	0xe1, 0xa6, 0x2f, 0x0f, 0xc4, 0x21, 0xb1, 0x6f, 0x4a, 0x62, 0xb7, 0xc8, 0x7a, 0xcf, 0x2b, 0x42,
+	0xc9, 0xb4, 0xe8, 0x15, 0x61, 0x36, 0x51, 0xe4, 0xb5, 0xc8, 0x6f, 0x35, 0x98, 0x8a, 0xdc, 0x6e,
+	0xa4, 0x77, 0xce, 0xee, 0xab, 0x56, 0x5f, 0x19, 0x0c, 0x44, 0x76, 0x37, 0x25, 0xbb, 0xeb, 0x64,
+	0x25, 0xc9, 0x4e, 0x28, 0x70, 0xd1, 0xbf, 0x50, 0x63, 0x37, 0xd8, 0x5f, 0x35, 0xf8, 0x4a, 0x97,
+	0xe2, 0x22, 0x6b, 0xa7, 0x65, 0xec, 0x12, 0x8b, 0x7a, 0x7e, 0x58, 0x38, 0xd2, 0xfc, 0x8e, 0xa4,
+	0x79, 0x9b, 0x7c, 0xbd, 0x1f, 0xcd, 0x8e, 0x58, 0x33, 0x9b, 0x09, 0x19, 0xda, 0xda, 0xde, 0x79,
+	0xf3, 0x2e, 0xab, 0xbd, 0x7d, 0x97, 0xd5, 0xfe, 0xfb, 0x2e, 0xab, 0xbd, 0x7a, 0x9f, 0x1d, 0x79,
+	0xfb, 0x3e, 0x3b, 0xf2, 0xef, 0xf7, 0xd9, 0x91, 0x9f, 0x98, 0x11, 0xe9, 0x8f, 0x91, 0x6d, 0xe6,
+	0x05, 0x3f, 0xd7, 0x4a, 0x55, 0x6a, 0xd9, 0xe6, 0xcf, 0x64, 0x32, 0xf9, 0x1d, 0xb0, 0x3f, 0x21,
+	0xff, 0x4d, 0x78, 0xeb, 0xff, 0x03, 0x00, 0x31, 0xd2, 0xe6, 0xe2, 0x09, 0x15, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Query uploaded file info by hash
+	Fileupload(ctx context.Context, in *QueryFileUploadRequest, opts ...grpc.CallOption) (*QueryFileUploadResponse, error)
+	SimPrepay(ctx context.Context, in *QuerySimPrepayRequest, opts ...grpc.CallOption) (*QuerySimPrepayResponse, error)
+	// SimRefund simulates the STOS refunded for burning an amount of noz.
+	SimRefund(ctx context.Context, in *QuerySimRefundRequest, opts ...grpc.CallOption) (*QuerySimRefundResponse, error)
+	NozPrice(ctx context.Context, in *QueryNozPriceRequest, opts ...grpc.CallOption) (*QueryNozPriceResponse, error)
+	NozSupply(ctx context.Context, in *QueryNozSupplyRequest, opts ...grpc.CallOption) (*QueryNozSupplyResponse, error)
+	// Params queries SDS module Params info.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// PrepaySubscriptions queries all prepay subscriptions created by the given sender.
+	PrepaySubscriptions(ctx context.Context, in *QueryPrepaySubscriptionsRequest, opts ...grpc.CallOption) (*QueryPrepaySubscriptionsResponse, error)
+	// NozPriceHistory queries the recorded noz price snapshots within a height range.
+	NozPriceHistory(ctx context.Context, in *QueryNozPriceHistoryRequest, opts ...grpc.CallOption) (*QueryNozPriceHistoryResponse, error)
+	// NozPriceTWAP queries the time weighted average noz price over the latest window of blocks.
+	NozPriceTWAP(ctx context.Context, in *QueryNozPriceTWAPRequest, opts ...grpc.CallOption) (*QueryNozPriceTWAPResponse, error)
+	// NozBalance queries the noz ledger balance of a wallet.
+	NozBalance(ctx context.Context, in *QueryNozBalanceRequest, opts ...grpc.CallOption) (*QueryNozBalanceResponse, error)
+	// NozAllowance queries the amount of noz a spender is allowed to transfer on behalf of the owner.
+	NozAllowance(ctx context.Context, in *QueryNozAllowanceRequest, opts ...grpc.CallOption) (*QueryNozAllowanceResponse, error)
+	// FileAccess queries the read access to a file granted to a wallet.
+	FileAccess(ctx context.Context, in *QueryFileAccessRequest, opts ...grpc.CallOption) (*QueryFileAccessResponse, error)
+	// StorageRent queries the storage rent escrow of a file.
+	StorageRent(ctx context.Context, in *QueryStorageRentRequest, opts ...grpc.CallOption) (*QueryStorageRentResponse, error)
+	// StorageChallenges queries the pending storage challenges of a resource node.
+	StorageChallenges(ctx context.Context, in *QueryStorageChallengesRequest, opts ...grpc.CallOption) (*QueryStorageChallengesResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Fileupload(ctx context.Context, in *QueryFileUploadRequest, opts ...grpc.CallOption) (*QueryFileUploadResponse, error) {
+	out := new(QueryFileUploadResponse)
+	err := c.cc.Invoke(ctx, "/stratos.sds.v1.Query/Fileupload", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SimPrepay(ctx context.Context, in *QuerySimPrepayRequest, opts ...grpc.CallOption) (*QuerySimPrepayResponse, error) {
+	out := new(QuerySimPrepayResponse)
+	err := c.cc.Invoke(ctx, "/stratos.sds.v1.Query/SimPrepay", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SimRefund(ctx context.Context, in *QuerySimRefundRequest, opts ...grpc.CallOption) (*QuerySimRefundResponse, error) {
+	out := new(QuerySimRefundResponse)
+	err := c.cc.Invoke(ctx, "/stratos.sds.v1.Query/SimRefund", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) NozPrice(ctx context.Context, in *QueryNozPriceRequest, opts ...grpc.CallOption) (*QueryNozPriceResponse, error) {
+	out := new(QueryNozPriceResponse)
+	err := c.cc.Invoke(ctx, "/stratos.sds.v1.Query/NozPrice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) NozSupply(ctx context.Context, in *QueryNozSupplyRequest, opts ...grpc.CallOption) (*QueryNozSupplyResponse, error) {
+	out := new(QueryNozSupplyResponse)
+	err := c.cc.Invoke(ctx, "/stratos.sds.v1.Query/NozSupply", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/stratos.sds.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PrepaySubscriptions(ctx context.Context, in *QueryPrepaySubscriptionsRequest, opts ...grpc.CallOption) (*QueryPrepaySubscriptionsResponse, error) {
+	out := new(QueryPrepaySubscriptionsResponse)
+	err := c.cc.Invoke(ctx, "/stratos.sds.v1.Query/PrepaySubscriptions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) NozPriceHistory(ctx context.Context, in *QueryNozPriceHistoryRequest, opts ...grpc.CallOption) (*QueryNozPriceHistoryResponse, error) {
+	out := new(QueryNozPriceHistoryResponse)
+	err := c.cc.Invoke(ctx, "/stratos.sds.v1.Query/NozPriceHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) NozPriceTWAP(ctx context.Context, in *QueryNozPriceTWAPRequest, opts ...grpc.CallOption) (*QueryNozPriceTWAPResponse, error) {
+	out := new(QueryNozPriceTWAPResponse)
+	err := c.cc.Invoke(ctx, "/stratos.sds.v1.Query/NozPriceTWAP", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) NozBalance(ctx context.Context, in *QueryNozBalanceRequest, opts ...grpc.CallOption) (*QueryNozBalanceResponse, error) {
+	out := new(QueryNozBalanceResponse)
+	err := c.cc.Invoke(ctx, "/stratos.sds.v1.Query/NozBalance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) NozAllowance(ctx context.Context, in *QueryNozAllowanceRequest, opts ...grpc.CallOption) (*QueryNozAllowanceResponse, error) {
+	out := new(QueryNozAllowanceResponse)
+	err := c.cc.Invoke(ctx, "/stratos.sds.v1.Query/NozAllowance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FileAccess(ctx context.Context, in *QueryFileAccessRequest, opts ...grpc.CallOption) (*QueryFileAccessResponse, error) {
+	out := new(QueryFileAccessResponse)
+	err := c.cc.Invoke(ctx, "/stratos.sds.v1.Query/FileAccess", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) StorageRent(ctx context.Context, in *QueryStorageRentRequest, opts ...grpc.CallOption) (*QueryStorageRentResponse, error) {
+	out := new(QueryStorageRentResponse)
+	err := c.cc.Invoke(ctx, "/stratos.sds.v1.Query/StorageRent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) StorageChallenges(ctx context.Context, in *QueryStorageChallengesRequest, opts ...grpc.CallOption) (*QueryStorageChallengesResponse, error) {
+	out := new(QueryStorageChallengesResponse)
+	err := c.cc.Invoke(ctx, "/stratos.sds.v1.Query/StorageChallenges", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Query uploaded file info by hash
+	Fileupload(context.Context, *QueryFileUploadRequest) (*QueryFileUploadResponse, error)
+	SimPrepay(context.Context, *QuerySimPrepayRequest) (*QuerySimPrepayResponse, error)
+	// SimRefund simulates the STOS refunded for burning an amount of noz.
+	SimRefund(context.Context, *QuerySimRefundRequest) (*QuerySimRefundResponse, error)
+	NozPrice(context.Context, *QueryNozPriceRequest) (*QueryNozPriceResponse, error)
+	NozSupply(context.Context, *QueryNozSupplyRequest) (*QueryNozSupplyResponse, error)
+	// Params queries SDS module Params info.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// PrepaySubscriptions queries all prepay subscriptions created by the given sender.
+	PrepaySubscriptions(context.Context, *QueryPrepaySubscriptionsRequest) (*QueryPrepaySubscriptionsResponse, error)
+	// NozPriceHistory queries the recorded noz price snapshots within a height range.
+	NozPriceHistory(context.Context, *QueryNozPriceHistoryRequest) (*QueryNozPriceHistoryResponse, error)
+	// NozPriceTWAP queries the time weighted average noz price over the latest window of blocks.
+	NozPriceTWAP(context.Context, *QueryNozPriceTWAPRequest) (*QueryNozPriceTWAPResponse, error)
+	// NozBalance queries the noz ledger balance of a wallet.
+	NozBalance(context.Context, *QueryNozBalanceRequest) (*QueryNozBalanceResponse, error)
+	// NozAllowance queries the amount of noz a spender is allowed to transfer on behalf of the owner.
+	NozAllowance(context.Context, *QueryNozAllowanceRequest) (*QueryNozAllowanceResponse, error)
+	// FileAccess queries the read access to a file granted to a wallet.
+	FileAccess(context.Context, *QueryFileAccessRequest) (*QueryFileAccessResponse, error)
+	// StorageRent queries the storage rent escrow of a file.
+	StorageRent(context.Context, *QueryStorageRentRequest) (*QueryStorageRentResponse, error)
+	// StorageChallenges queries the pending storage challenges of a resource node.
+	StorageChallenges(context.Context, *QueryStorageChallengesRequest) (*QueryStorageChallengesResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Fileupload(ctx context.Context, req *QueryFileUploadRequest) (*QueryFileUploadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Fileupload not implemented")
+}
+func (*UnimplementedQueryServer) SimPrepay(ctx context.Context, req *QuerySimPrepayRequest) (*QuerySimPrepayResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimPrepay not implemented")
+}
+func (*UnimplementedQueryServer) SimRefund(ctx context.Context, req *QuerySimRefundRequest) (*QuerySimRefundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimRefund not implemented")
+}
+func (*UnimplementedQueryServer) NozPrice(ctx context.Context, req *QueryNozPriceRequest) (*QueryNozPriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NozPrice not implemented")
+}
+func (*UnimplementedQueryServer) NozSupply(ctx context.Context, req *QueryNozSupplyRequest) (*QueryNozSupplyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NozSupply not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) PrepaySubscriptions(ctx context.Context, req *QueryPrepaySubscriptionsRequest) (*QueryPrepaySubscriptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PrepaySubscriptions not implemented")
+}
+func (*UnimplementedQueryServer) NozPriceHistory(ctx context.Context, req *QueryNozPriceHistoryRequest) (*QueryNozPriceHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NozPriceHistory not implemented")
+}
+func (*UnimplementedQueryServer) NozPriceTWAP(ctx context.Context, req *QueryNozPriceTWAPRequest) (*QueryNozPriceTWAPResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NozPriceTWAP not implemented")
+}
+func (*UnimplementedQueryServer) NozBalance(ctx context.Context, req *QueryNozBalanceRequest) (*QueryNozBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NozBalance not implemented")
+}
+func (*UnimplementedQueryServer) NozAllowance(ctx context.Context, req *QueryNozAllowanceRequest) (*QueryNozAllowanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NozAllowance not implemented")
+}
+func (*UnimplementedQueryServer) FileAccess(ctx context.Context, req *QueryFileAccessRequest) (*QueryFileAccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FileAccess not implemented")
+}
+func (*UnimplementedQueryServer) StorageRent(ctx context.Context, req *QueryStorageRentRequest) (*QueryStorageRentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StorageRent not implemented")
+}
+func (*UnimplementedQueryServer) StorageChallenges(ctx context.Context, req *QueryStorageChallengesRequest) (*QueryStorageChallengesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StorageChallenges not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Fileupload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFileUploadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Fileupload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stratos.sds.v1.Query/Fileupload",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Fileupload(ctx, req.(*QueryFileUploadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SimPrepay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimPrepayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimPrepay(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stratos.sds.v1.Query/SimPrepay",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimPrepay(ctx, req.(*QuerySimPrepayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SimRefund_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimRefundRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimRefund(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stratos.sds.v1.Query/SimRefund",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimRefund(ctx, req.(*QuerySimRefundRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_NozPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNozPriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NozPrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stratos.sds.v1.Query/NozPrice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NozPrice(ctx, req.(*QueryNozPriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_NozSupply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNozSupplyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NozSupply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stratos.sds.v1.Query/NozSupply",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NozSupply(ctx, req.(*QueryNozSupplyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stratos.sds.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PrepaySubscriptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPrepaySubscriptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PrepaySubscriptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stratos.sds.v1.Query/PrepaySubscriptions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PrepaySubscriptions(ctx, req.(*QueryPrepaySubscriptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_NozPriceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNozPriceHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NozPriceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stratos.sds.v1.Query/NozPriceHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NozPriceHistory(ctx, req.(*QueryNozPriceHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_NozPriceTWAP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNozPriceTWAPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NozPriceTWAP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stratos.sds.v1.Query/NozPriceTWAP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NozPriceTWAP(ctx, req.(*QueryNozPriceTWAPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_NozBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNozBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NozBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stratos.sds.v1.Query/NozBalance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NozBalance(ctx, req.(*QueryNozBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_NozAllowance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNozAllowanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NozAllowance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stratos.sds.v1.Query/NozAllowance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NozAllowance(ctx, req.(*QueryNozAllowanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FileAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFileAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FileAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stratos.sds.v1.Query/FileAccess",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FileAccess(ctx, req.(*QueryFileAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StorageRent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStorageRentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StorageRent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stratos.sds.v1.Query/StorageRent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StorageRent(ctx, req.(*QueryStorageRentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StorageChallenges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStorageChallengesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StorageChallenges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stratos.sds.v1.Query/StorageChallenges",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StorageChallenges(ctx, req.(*QueryStorageChallengesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "stratos.sds.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Fileupload",
+			Handler:    _Query_Fileupload_Handler,
+		},
+		{
+			MethodName: "SimPrepay",
+			Handler:    _Query_SimPrepay_Handler,
+		},
+		{
+			MethodName: "SimRefund",
+			Handler:    _Query_SimRefund_Handler,
+		},
+		{
+			MethodName: "NozPrice",
+			Handler:    _Query_NozPrice_Handler,
+		},
+		{
+			MethodName: "NozSupply",
+			Handler:    _Query_NozSupply_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "PrepaySubscriptions",
+			Handler:    _Query_PrepaySubscriptions_Handler,
+		},
+		{
+			MethodName: "NozPriceHistory",
+			Handler:    _Query_NozPriceHistory_Handler,
+		},
+		{
+			MethodName: "NozPriceTWAP",
+			Handler:    _Query_NozPriceTWAP_Handler,
+		},
+		{
+			MethodName: "NozBalance",
+			Handler:    _Query_NozBalance_Handler,
+		},
+		{
+			MethodName: "NozAllowance",
+			Handler:    _Query_NozAllowance_Handler,
+		},
+		{
+			MethodName: "FileAccess",
+			Handler:    _Query_FileAccess_Handler,
+		},
+		{
+			MethodName: "StorageRent",
+			Handler:    _Query_StorageRent_Handler,
+		},
+		{
+			MethodName: "StorageChallenges",
+			Handler:    _Query_StorageChallenges_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "stratos/sds/v1/query.proto",
+}
+
+func (m *QueryFileUploadRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFileUploadRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFileUploadRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FileHash) > 0 {
+		i -= len(m.FileHash)
+		copy(dAtA[i:], m.FileHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FileHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFileUploadResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFileUploadResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFileUploadResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FileInfo != nil {
+		{
+			size, err := m.FileInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimPrepayRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimPrepayRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimPrepayRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimPrepayResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimPrepayResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimPrepayResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Noz.Size()
+		i -= size
+		if _, err := m.Noz.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNozPriceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNozPriceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNozPriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNozPriceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNozPriceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNozPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNozSupplyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNozSupplyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNozSupplyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNozSupplyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNozSupplyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNozSupplyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Total.Size()
+		i -= size
+		if _, err := m.Total.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Remaining.Size()
+		i -= size
+		if _, err := m.Remaining.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Params != nil {
+		{
+			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPrepaySubscriptionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPrepaySubscriptionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPrepaySubscriptionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPrepaySubscriptionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPrepaySubscriptionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPrepaySubscriptionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Subscriptions) > 0 {
+		for iNdEx := len(m.Subscriptions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Subscriptions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNozPriceHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNozPriceHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNozPriceHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.EndHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNozPriceHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNozPriceHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNozPriceHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Snapshots) > 0 {
+		for iNdEx := len(m.Snapshots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Snapshots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNozPriceTWAPRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNozPriceTWAPRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNozPriceTWAPRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Window != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Window))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNozPriceTWAPResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNozPriceTWAPResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNozPriceTWAPResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNozBalanceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNozBalanceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNozBalanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNozBalanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNozBalanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNozBalanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Balance.Size()
+		i -= size
+		if _, err := m.Balance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNozAllowanceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNozAllowanceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNozAllowanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Spender) > 0 {
+		i -= len(m.Spender)
+		copy(dAtA[i:], m.Spender)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Spender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNozAllowanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNozAllowanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNozAllowanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Allowance.Size()
+		i -= size
+		if _, err := m.Allowance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFileAccessRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFileAccessRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFileAccessRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FileHash) > 0 {
+		i -= len(m.FileHash)
+		copy(dAtA[i:], m.FileHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FileHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFileAccessResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFileAccessResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFileAccessResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Grant != nil {
+		{
+			size, err := m.Grant.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.HasAccess {
+		i--
+		if m.HasAccess {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStorageChallengesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStorageChallengesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStorageChallengesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NetworkAddress) > 0 {
+		i -= len(m.NetworkAddress)
+		copy(dAtA[i:], m.NetworkAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.NetworkAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStorageChallengesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStorageChallengesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStorageChallengesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.FailureCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FailureCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Challenges) > 0 {
+		for iNdEx := len(m.Challenges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Challenges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimRefundRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimRefundRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimRefundRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimRefundResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimRefundResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimRefundResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Refunded.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStorageRentRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStorageRentRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStorageRentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FileHash) > 0 {
+		i -= len(m.FileHash)
+		copy(dAtA[i:], m.FileHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FileHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStorageRentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStorageRentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStorageRentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Rent != nil {
+		{
+			size, err := m.Rent.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryFileUploadRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FileHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFileUploadResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FileInfo != nil {
+		l = m.FileInfo.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySimPrepayRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySimPrepayResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Noz.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryNozPriceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryNozPriceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Price.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryNozSupplyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryNozSupplyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Remaining.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Total.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPrepaySubscriptionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPrepaySubscriptionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Subscriptions) > 0 {
+		for _, e := range m.Subscriptions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNozPriceHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartHeight != 0 {
+		n += 1 + sovQuery(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovQuery(uint64(m.EndHeight))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNozPriceHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Snapshots) > 0 {
+		for _, e := range m.Snapshots {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNozPriceTWAPRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Window != 0 {
+		n += 1 + sovQuery(uint64(m.Window))
+	}
+	return n
+}
+
+func (m *QueryNozPriceTWAPResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Price.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryNozBalanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNozBalanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Balance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryNozAllowanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Spender)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNozAllowanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Allowance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryFileAccessRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FileHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFileAccessResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HasAccess {
+		n += 2
+	}
+	if m.Grant != nil {
+		l = m.Grant.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStorageChallengesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NetworkAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStorageChallengesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Challenges) > 0 {
+		for _, e := range m.Challenges {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.FailureCount != 0 {
+		n += 1 + sovQuery(uint64(m.FailureCount))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySimRefundRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySimRefundResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Refunded.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Fee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryStorageRentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FileHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStorageRentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Rent != nil {
+		l = m.Rent.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryFileUploadRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFileUploadRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFileUploadRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FileHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FileHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFileUploadResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFileUploadResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFileUploadResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FileInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FileInfo == nil {
+				m.FileInfo = &FileInfo{}
+			}
+			if err := m.FileInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySimPrepayRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySimPrepayRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySimPrepayRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySimPrepayResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySimPrepayResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySimPrepayResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Noz", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Noz.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNozPriceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNozPriceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNozPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNozPriceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNozPriceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNozPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNozSupplyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNozSupplyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNozSupplyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNozSupplyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNozSupplyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNozSupplyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remaining", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Remaining.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Total.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Params == nil {
+				m.Params = &Params{}
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPrepaySubscriptionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPrepaySubscriptionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPrepaySubscriptionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPrepaySubscriptionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPrepaySubscriptionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPrepaySubscriptionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subscriptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subscriptions = append(m.Subscriptions, PrepaySubscription{})
+			if err := m.Subscriptions[len(m.Subscriptions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNozPriceHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNozPriceHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNozPriceHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNozPriceHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNozPriceHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNozPriceHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Snapshots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Snapshots = append(m.Snapshots, NozPriceSnapshot{})
+			if err := m.Snapshots[len(m.Snapshots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNozPriceTWAPRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNozPriceTWAPRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNozPriceTWAPRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			m.Window = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Window |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNozPriceTWAPResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNozPriceTWAPResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNozPriceTWAPResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNozBalanceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNozBalanceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNozBalanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNozBalanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNozBalanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNozBalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryNozSupplyResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Remaining.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.Total.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return nil
 }
-
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryNozAllowanceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNozAllowanceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNozAllowanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Spender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Params != nil {
-		l = m.Params.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryFileUploadRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryNozAllowanceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1189,15 +5152,15 @@ func (m *QueryFileUploadRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFileUploadRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryNozAllowanceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFileUploadRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryNozAllowanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FileHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1225,7 +5188,9 @@ func (m *QueryFileUploadRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FileHash = string(dAtA[iNdEx:postIndex])
+			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1248,7 +5213,7 @@ func (m *QueryFileUploadRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFileUploadResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFileAccessRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1271,17 +5236,17 @@ func (m *QueryFileUploadResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFileUploadResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFileAccessRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFileUploadResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFileAccessRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FileInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FileHash", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1291,27 +5256,55 @@ func (m *QueryFileUploadResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.FileInfo == nil {
-				m.FileInfo = &FileInfo{}
+			m.FileHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
 			}
-			if err := m.FileInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1334,7 +5327,7 @@ func (m *QueryFileUploadResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySimPrepayRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFileAccessResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1357,17 +5350,37 @@ func (m *QuerySimPrepayRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySimPrepayRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFileAccessResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySimPrepayRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFileAccessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasAccess", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HasAccess = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Grant", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1377,23 +5390,27 @@ func (m *QuerySimPrepayRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = string(dAtA[iNdEx:postIndex])
+			if m.Grant == nil {
+				m.Grant = &FileAccessGrant{}
+			}
+			if err := m.Grant.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1416,7 +5433,7 @@ func (m *QuerySimPrepayRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySimPrepayResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryStorageChallengesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1439,15 +5456,15 @@ func (m *QuerySimPrepayResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySimPrepayResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStorageChallengesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySimPrepayResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStorageChallengesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Noz", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NetworkAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1475,7 +5492,41 @@ func (m *QuerySimPrepayResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Noz.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.NetworkAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1500,7 +5551,7 @@ func (m *QuerySimPrepayResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryNozPriceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryStorageChallengesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1523,67 +5574,70 @@ func (m *QueryNozPriceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryNozPriceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStorageChallengesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryNozPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStorageChallengesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Challenges", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryNozPriceResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			m.Challenges = append(m.Challenges, StorageChallenge{})
+			if err := m.Challenges[len(m.Challenges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailureCount", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.FailureCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FailureCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryNozPriceResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryNozPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1593,23 +5647,25 @@ func (m *QueryNozPriceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1634,7 +5690,7 @@ func (m *QueryNozPriceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryNozSupplyRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySimRefundRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1657,12 +5713,44 @@ func (m *QueryNozSupplyRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryNozSupplyRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySimRefundRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryNozSupplyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySimRefundRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1684,7 +5772,7 @@ func (m *QueryNozSupplyRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryNozSupplyResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySimRefundResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1707,17 +5795,17 @@ func (m *QueryNozSupplyResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryNozSupplyResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySimRefundResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryNozSupplyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySimRefundResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Remaining", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Refunded", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1727,31 +5815,30 @@ func (m *QueryNozSupplyResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Remaining.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Refunded.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1761,23 +5848,22 @@ func (m *QueryNozSupplyResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Total.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1802,7 +5888,7 @@ func (m *QueryNozSupplyResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryStorageRentRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1825,12 +5911,44 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStorageRentRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStorageRentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FileHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FileHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1852,7 +5970,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryStorageRentResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1875,15 +5993,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStorageRentResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStorageRentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rent", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1910,10 +6028,10 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Params == nil {
-				m.Params = &Params{}
+			if m.Rent == nil {
+				m.Rent = &StorageRent{}
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Rent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8,6 +8,7 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -30,6 +31,22 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // Params defines the parameters for the sds module.
 type Params struct {
 	BondDenom string `protobuf:"bytes,1,opt,name=bond_denom,json=bondDenom,proto3" json:"bond_denom" yaml:"bond_denom"`
+	// noz_price_snapshot_interval is the number of blocks between two noz price snapshots, zero disables the recording
+	NozPriceSnapshotInterval int64 `protobuf:"varint,2,opt,name=noz_price_snapshot_interval,json=nozPriceSnapshotInterval,proto3" json:"noz_price_snapshot_interval" yaml:"noz_price_snapshot_interval"`
+	// noz_price_history_size is the max number of noz price snapshots kept in store
+	NozPriceHistorySize uint64 `protobuf:"varint,3,opt,name=noz_price_history_size,json=nozPriceHistorySize,proto3" json:"noz_price_history_size" yaml:"noz_price_history_size"`
+	// storage_challenge_interval is the number of blocks between two storage challenge rounds, zero disables the challenges
+	StorageChallengeInterval int64 `protobuf:"varint,4,opt,name=storage_challenge_interval,json=storageChallengeInterval,proto3" json:"storage_challenge_interval" yaml:"storage_challenge_interval"`
+	// storage_challenges_per_round is the max number of challenges issued in a challenge round
+	StorageChallengesPerRound uint32 `protobuf:"varint,5,opt,name=storage_challenges_per_round,json=storageChallengesPerRound,proto3" json:"storage_challenges_per_round" yaml:"storage_challenges_per_round"`
+	// storage_challenge_response_window is the number of blocks a resource node has to answer a challenge
+	StorageChallengeResponseWindow int64 `protobuf:"varint,6,opt,name=storage_challenge_response_window,json=storageChallengeResponseWindow,proto3" json:"storage_challenge_response_window" yaml:"storage_challenge_response_window"`
+	// storage_challenge_slash_noz is the traffic volume used to compute the slashing of a failed challenge
+	StorageChallengeSlashNoz github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=storage_challenge_slash_noz,json=storageChallengeSlashNoz,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"storage_challenge_slash_noz" yaml:"storage_challenge_slash_noz"`
+	// storage_challenge_max_failures is the number of consecutive failed challenges after which the resource node is suspended
+	StorageChallengeMaxFailures uint32 `protobuf:"varint,8,opt,name=storage_challenge_max_failures,json=storageChallengeMaxFailures,proto3" json:"storage_challenge_max_failures" yaml:"storage_challenge_max_failures"`
+	// noz_refund_fee_rate is the share of the refunded STOS sent to the community pool on noz refund
+	NozRefundFeeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=noz_refund_fee_rate,json=nozRefundFeeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"noz_refund_fee_rate" yaml:"noz_refund_fee_rate"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -72,10 +89,57 @@ func (m *Params) GetBondDenom() string {
 	return ""
 }
 
+func (m *Params) GetNozPriceSnapshotInterval() int64 {
+	if m != nil {
+		return m.NozPriceSnapshotInterval
+	}
+	return 0
+}
+
+func (m *Params) GetNozPriceHistorySize() uint64 {
+	if m != nil {
+		return m.NozPriceHistorySize
+	}
+	return 0
+}
+
+func (m *Params) GetStorageChallengeInterval() int64 {
+	if m != nil {
+		return m.StorageChallengeInterval
+	}
+	return 0
+}
+
+func (m *Params) GetStorageChallengesPerRound() uint32 {
+	if m != nil {
+		return m.StorageChallengesPerRound
+	}
+	return 0
+}
+
+func (m *Params) GetStorageChallengeResponseWindow() int64 {
+	if m != nil {
+		return m.StorageChallengeResponseWindow
+	}
+	return 0
+}
+
+func (m *Params) GetStorageChallengeMaxFailures() uint32 {
+	if m != nil {
+		return m.StorageChallengeMaxFailures
+	}
+	return 0
+}
+
 type FileInfo struct {
 	Height    github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=height,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"height" yaml:"height"`
 	Reporters []byte                                 `protobuf:"bytes,2,opt,name=reporters,proto3" json:"reporters" yaml:"reporters"`
 	Uploader  string                                 `protobuf:"bytes,3,opt,name=uploader,proto3" json:"uploader" yaml:"uploader"`
+	// segment_root is the merkle root of the file segments, files without segment root are not challenged
+	SegmentRoot  []byte `protobuf:"bytes,4,opt,name=segment_root,json=segmentRoot,proto3" json:"segment_root" yaml:"segment_root"`
+	SegmentCount uint64 `protobuf:"varint,5,opt,name=segment_count,json=segmentCount,proto3" json:"segment_count" yaml:"segment_count"`
+	// storage_nodes are the network addresses of the resource nodes storing the file
+	StorageNodes []string `protobuf:"bytes,6,rep,name=storage_nodes,json=storageNodes,proto3" json:"storage_nodes" yaml:"storage_nodes"`
 }
 
 func (m *FileInfo) Reset()         { *m = FileInfo{} }
@@ -125,224 +189,3816 @@ func (m *FileInfo) GetUploader() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*Params)(nil), "stratos.sds.v1.Params")
-	proto.RegisterType((*FileInfo)(nil), "stratos.sds.v1.FileInfo")
+func (m *FileInfo) GetSegmentRoot() []byte {
+	if m != nil {
+		return m.SegmentRoot
+	}
+	return nil
 }
 
-func init() { proto.RegisterFile("stratos/sds/v1/sds.proto", fileDescriptor_a89f3959b8649eb2) }
-
-var fileDescriptor_a89f3959b8649eb2 = []byte{
-	// 406 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x91, 0x3f, 0xeb, 0xd3, 0x40,
-	0x18, 0xc7, 0x73, 0x3f, 0xa1, 0xb4, 0x87, 0xff, 0x1a, 0x3a, 0xc4, 0x82, 0xb9, 0x1a, 0x41, 0x8a,
-	0xd0, 0x84, 0xe2, 0x20, 0x74, 0x11, 0x83, 0x08, 0x1d, 0x04, 0x49, 0x07, 0xc1, 0xa5, 0xa4, 0xcd,
-	0x99, 0x84, 0x36, 0x77, 0xe1, 0xee, 0x5a, 0xec, 0x5b, 0x70, 0xd2, 0xcd, 0xd1, 0xd1, 0xd1, 0xc1,
-	0x17, 0xd1, 0xb1, 0x38, 0x89, 0xc3, 0x21, 0xe9, 0x20, 0x64, 0xcc, 0x2b, 0x90, 0xe4, 0xae, 0x7f,
-	0x96, 0xdc, 0xf3, 0x7c, 0xbe, 0xf7, 0xe4, 0xf9, 0x3e, 0xcf, 0x41, 0x8b, 0x0b, 0x16, 0x0a, 0xca,
-	0x3d, 0x1e, 0x71, 0x6f, 0x3b, 0xae, 0x0f, 0x37, 0x67, 0x54, 0x50, 0xf3, 0xae, 0x56, 0xdc, 0x1a,
-	0x6d, 0xc7, 0xfd, 0x5e, 0x4c, 0x63, 0xda, 0x48, 0x5e, 0x1d, 0xa9, 0x5b, 0xfd, 0x07, 0x4b, 0xca,
-	0x33, 0xca, 0xe7, 0x4a, 0x50, 0x89, 0x96, 0xba, 0x61, 0x96, 0x12, 0xea, 0x35, 0x5f, 0x85, 0x9c,
-	0x15, 0x6c, 0xbd, 0x0d, 0x59, 0x98, 0x71, 0xd3, 0x87, 0x70, 0x41, 0x49, 0x34, 0x8f, 0x30, 0xa1,
-	0x99, 0x05, 0x06, 0x60, 0xd8, 0xf1, 0x1f, 0x97, 0x12, 0x5d, 0xd1, 0x4a, 0xa2, 0xee, 0x2e, 0xcc,
-	0xd6, 0x13, 0xe7, 0xc2, 0x9c, 0xa0, 0x53, 0x27, 0xaf, 0xea, 0x78, 0xf2, 0xf0, 0xeb, 0x37, 0x04,
-	0x3e, 0xfd, 0xfb, 0xf1, 0xb4, 0x77, 0x1a, 0xe2, 0x63, 0x33, 0x86, 0x6a, 0xe1, 0x7c, 0xb9, 0x81,
-	0xed, 0xd7, 0xe9, 0x1a, 0x4f, 0xc9, 0x07, 0x6a, 0x52, 0xd8, 0x4a, 0x70, 0x1a, 0x27, 0x42, 0xf7,
-	0x7a, 0xb7, 0x97, 0xc8, 0xf8, 0x23, 0xd1, 0x93, 0x38, 0x15, 0xc9, 0x66, 0xe1, 0x2e, 0x69, 0xa6,
-	0xdd, 0xeb, 0x63, 0xc4, 0xa3, 0x95, 0x27, 0x76, 0x39, 0xe6, 0xee, 0x94, 0x88, 0x52, 0x22, 0x5d,
-	0x5f, 0x49, 0x74, 0x47, 0xb9, 0x52, 0xb9, 0xf3, 0xeb, 0xe7, 0x08, 0xea, 0xb9, 0xa7, 0x44, 0x04,
-	0xfa, 0x9a, 0xf9, 0x02, 0x76, 0x18, 0xce, 0x29, 0x13, 0x98, 0x71, 0xeb, 0x66, 0x00, 0x86, 0xb7,
-	0xfd, 0x47, 0xa5, 0x44, 0x17, 0x58, 0x49, 0x74, 0x5f, 0xfd, 0xe8, 0x8c, 0x9c, 0xe0, 0x22, 0x9b,
-	0x33, 0xd8, 0xde, 0xe4, 0x6b, 0x1a, 0x46, 0x98, 0x59, 0xb7, 0x1a, 0xcf, 0xcf, 0x4b, 0x89, 0xce,
-	0xac, 0x92, 0xe8, 0x9e, 0x2a, 0x3f, 0x91, 0xda, 0x49, 0x4f, 0x3b, 0x79, 0x19, 0x45, 0x0c, 0x73,
-	0x3e, 0x13, 0x2c, 0x25, 0x71, 0x70, 0x2e, 0xf2, 0xdf, 0x7c, 0x2f, 0x6c, 0xb0, 0x2f, 0x6c, 0x70,
-	0x28, 0x6c, 0xf0, 0xb7, 0xb0, 0xc1, 0xe7, 0xa3, 0x6d, 0x1c, 0x8e, 0xb6, 0xf1, 0xfb, 0x68, 0x1b,
-	0xef, 0xbd, 0xab, 0x65, 0xe8, 0x95, 0x12, 0x2c, 0x4e, 0xe1, 0x68, 0x99, 0x84, 0x29, 0xd1, 0x3b,
-	0x6e, 0x36, 0xb3, 0x68, 0x35, 0xcf, 0xfa, 0xec, 0x7f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x07, 0x87,
-	0x09, 0x64, 0x46, 0x02, 0x00, 0x00,
+func (m *FileInfo) GetSegmentCount() uint64 {
+	if m != nil {
+		return m.SegmentCount
+	}
+	return 0
 }
 
-func (this *Params) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *FileInfo) GetStorageNodes() []string {
+	if m != nil {
+		return m.StorageNodes
 	}
+	return nil
+}
 
-	that1, ok := that.(*Params)
-	if !ok {
-		that2, ok := that.(Params)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// PrepaySubscription defines a recurring prepay executed by the sds EndBlocker
+type PrepaySubscription struct {
+	Id          uint64                                   `protobuf:"varint,1,opt,name=id,proto3" json:"id" yaml:"id"`
+	Sender      string                                   `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender" yaml:"sender"`
+	Beneficiary string                                   `protobuf:"bytes,3,opt,name=beneficiary,proto3" json:"beneficiary" yaml:"beneficiary"`
+	Amount      github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount" yaml:"amount"`
+	// interval is the number of blocks between two executions
+	Interval int64 `protobuf:"varint,5,opt,name=interval,proto3" json:"interval" yaml:"interval"`
+	// max_count is the max number of successful executions, zero means unlimited
+	MaxCount uint64 `protobuf:"varint,6,opt,name=max_count,json=maxCount,proto3" json:"max_count" yaml:"max_count"`
+	// end_height is the last height at which the subscription can be executed, zero means no end height
+	EndHeight     int64  `protobuf:"varint,7,opt,name=end_height,json=endHeight,proto3" json:"end_height" yaml:"end_height"`
+	NextHeight    int64  `protobuf:"varint,8,opt,name=next_height,json=nextHeight,proto3" json:"next_height" yaml:"next_height"`
+	ExecutedCount uint64 `protobuf:"varint,9,opt,name=executed_count,json=executedCount,proto3" json:"executed_count" yaml:"executed_count"`
+	// failed_count is the number of consecutive failed executions
+	FailedCount uint32 `protobuf:"varint,10,opt,name=failed_count,json=failedCount,proto3" json:"failed_count" yaml:"failed_count"`
+}
+
+func (m *PrepaySubscription) Reset()         { *m = PrepaySubscription{} }
+func (m *PrepaySubscription) String() string { return proto.CompactTextString(m) }
+func (*PrepaySubscription) ProtoMessage()    {}
+func (*PrepaySubscription) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a89f3959b8649eb2, []int{2}
+}
+func (m *PrepaySubscription) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PrepaySubscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PrepaySubscription.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.BondDenom != that1.BondDenom {
-		return false
-	}
-	return true
 }
-func (this *FileInfo) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *PrepaySubscription) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PrepaySubscription.Merge(m, src)
+}
+func (m *PrepaySubscription) XXX_Size() int {
+	return m.Size()
+}
+func (m *PrepaySubscription) XXX_DiscardUnknown() {
+	xxx_messageInfo_PrepaySubscription.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PrepaySubscription proto.InternalMessageInfo
+
+func (m *PrepaySubscription) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
+	return 0
+}
 
-	that1, ok := that.(*FileInfo)
-	if !ok {
-		that2, ok := that.(FileInfo)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *PrepaySubscription) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return ""
+}
+
+func (m *PrepaySubscription) GetBeneficiary() string {
+	if m != nil {
+		return m.Beneficiary
 	}
-	if !this.Height.Equal(that1.Height) {
-		return false
+	return ""
+}
+
+func (m *PrepaySubscription) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
 	}
-	if !bytes.Equal(this.Reporters, that1.Reporters) {
-		return false
+	return nil
+}
+
+func (m *PrepaySubscription) GetInterval() int64 {
+	if m != nil {
+		return m.Interval
 	}
-	if this.Uploader != that1.Uploader {
-		return false
+	return 0
+}
+
+func (m *PrepaySubscription) GetMaxCount() uint64 {
+	if m != nil {
+		return m.MaxCount
 	}
-	return true
+	return 0
 }
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+
+func (m *PrepaySubscription) GetEndHeight() int64 {
+	if m != nil {
+		return m.EndHeight
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *PrepaySubscription) GetNextHeight() int64 {
+	if m != nil {
+		return m.NextHeight
+	}
+	return 0
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.BondDenom) > 0 {
-		i -= len(m.BondDenom)
-		copy(dAtA[i:], m.BondDenom)
-		i = encodeVarintSds(dAtA, i, uint64(len(m.BondDenom)))
-		i--
-		dAtA[i] = 0xa
+func (m *PrepaySubscription) GetExecutedCount() uint64 {
+	if m != nil {
+		return m.ExecutedCount
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *FileInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PrepaySubscription) GetFailedCount() uint32 {
+	if m != nil {
+		return m.FailedCount
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *FileInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// NozPriceSnapshot defines the noz price and its pricing parameters recorded at a block height
+type NozPriceSnapshot struct {
+	Height int64                                  `protobuf:"varint,1,opt,name=height,proto3" json:"height" yaml:"height"`
+	St     github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=st,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"st" yaml:"st"`
+	Pt     github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=pt,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"pt" yaml:"pt"`
+	Lt     github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=lt,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"lt" yaml:"lt"`
+	Price  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price" yaml:"price"`
 }
 
-func (m *FileInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Uploader) > 0 {
-		i -= len(m.Uploader)
-		copy(dAtA[i:], m.Uploader)
-		i = encodeVarintSds(dAtA, i, uint64(len(m.Uploader)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Reporters) > 0 {
-		i -= len(m.Reporters)
-		copy(dAtA[i:], m.Reporters)
-		i = encodeVarintSds(dAtA, i, uint64(len(m.Reporters)))
-		i--
-		dAtA[i] = 0x12
-	}
-	{
-		size := m.Height.Size()
-		i -= size
-		if _, err := m.Height.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *NozPriceSnapshot) Reset()         { *m = NozPriceSnapshot{} }
+func (m *NozPriceSnapshot) String() string { return proto.CompactTextString(m) }
+func (*NozPriceSnapshot) ProtoMessage()    {}
+func (*NozPriceSnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a89f3959b8649eb2, []int{3}
+}
+func (m *NozPriceSnapshot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NozPriceSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NozPriceSnapshot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintSds(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *NozPriceSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NozPriceSnapshot.Merge(m, src)
+}
+func (m *NozPriceSnapshot) XXX_Size() int {
+	return m.Size()
+}
+func (m *NozPriceSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_NozPriceSnapshot.DiscardUnknown(m)
 }
 
-func encodeVarintSds(dAtA []byte, offset int, v uint64) int {
-	offset -= sovSds(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_NozPriceSnapshot proto.InternalMessageInfo
+
+func (m *NozPriceSnapshot) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return 0
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
+
+// NozBalance defines the amount of noz held by a wallet in the noz ledger
+type NozBalance struct {
+	Owner  string                                 `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner" yaml:"owner"`
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount" yaml:"amount"`
+}
+
+func (m *NozBalance) Reset()         { *m = NozBalance{} }
+func (m *NozBalance) String() string { return proto.CompactTextString(m) }
+func (*NozBalance) ProtoMessage()    {}
+func (*NozBalance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a89f3959b8649eb2, []int{4}
+}
+func (m *NozBalance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NozBalance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NozBalance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.BondDenom)
-	if l > 0 {
-		n += 1 + l + sovSds(uint64(l))
+}
+func (m *NozBalance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NozBalance.Merge(m, src)
+}
+func (m *NozBalance) XXX_Size() int {
+	return m.Size()
+}
+func (m *NozBalance) XXX_DiscardUnknown() {
+	xxx_messageInfo_NozBalance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NozBalance proto.InternalMessageInfo
+
+func (m *NozBalance) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	return n
+	return ""
 }
 
-func (m *FileInfo) Size() (n int) {
-	if m == nil {
-		return 0
+// NozAllowance defines the amount of noz a spender is allowed to transfer on behalf of the owner
+type NozAllowance struct {
+	Owner   string                                 `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner" yaml:"owner"`
+	Spender string                                 `protobuf:"bytes,2,opt,name=spender,proto3" json:"spender" yaml:"spender"`
+	Amount  github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount" yaml:"amount"`
+}
+
+func (m *NozAllowance) Reset()         { *m = NozAllowance{} }
+func (m *NozAllowance) String() string { return proto.CompactTextString(m) }
+func (*NozAllowance) ProtoMessage()    {}
+func (*NozAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a89f3959b8649eb2, []int{5}
+}
+func (m *NozAllowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NozAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NozAllowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
+}
+func (m *NozAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NozAllowance.Merge(m, src)
+}
+func (m *NozAllowance) XXX_Size() int {
+	return m.Size()
+}
+func (m *NozAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_NozAllowance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NozAllowance proto.InternalMessageInfo
+
+func (m *NozAllowance) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *NozAllowance) GetSpender() string {
+	if m != nil {
+		return m.Spender
+	}
+	return ""
+}
+
+// FileAccessGrant defines the read access to a file granted by its uploader
+type FileAccessGrant struct {
+	FileHash string `protobuf:"bytes,1,opt,name=file_hash,json=fileHash,proto3" json:"file_hash" yaml:"file_hash"`
+	Granter  string `protobuf:"bytes,2,opt,name=granter,proto3" json:"granter" yaml:"granter"`
+	Grantee  string `protobuf:"bytes,3,opt,name=grantee,proto3" json:"grantee" yaml:"grantee"`
+	// expiry_height is the height from which the grant is no longer valid, zero means no expiry
+	ExpiryHeight int64 `protobuf:"varint,4,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height" yaml:"expiry_height"`
+	// fee is the amount of noz paid by the grantee to the uploader
+	Fee github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=fee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"fee" yaml:"fee"`
+}
+
+func (m *FileAccessGrant) Reset()         { *m = FileAccessGrant{} }
+func (m *FileAccessGrant) String() string { return proto.CompactTextString(m) }
+func (*FileAccessGrant) ProtoMessage()    {}
+func (*FileAccessGrant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a89f3959b8649eb2, []int{6}
+}
+func (m *FileAccessGrant) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FileAccessGrant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FileAccessGrant.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FileAccessGrant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FileAccessGrant.Merge(m, src)
+}
+func (m *FileAccessGrant) XXX_Size() int {
+	return m.Size()
+}
+func (m *FileAccessGrant) XXX_DiscardUnknown() {
+	xxx_messageInfo_FileAccessGrant.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FileAccessGrant proto.InternalMessageInfo
+
+func (m *FileAccessGrant) GetFileHash() string {
+	if m != nil {
+		return m.FileHash
+	}
+	return ""
+}
+
+func (m *FileAccessGrant) GetGranter() string {
+	if m != nil {
+		return m.Granter
+	}
+	return ""
+}
+
+func (m *FileAccessGrant) GetGrantee() string {
+	if m != nil {
+		return m.Grantee
+	}
+	return ""
+}
+
+func (m *FileAccessGrant) GetExpiryHeight() int64 {
+	if m != nil {
+		return m.ExpiryHeight
+	}
+	return 0
+}
+
+// StorageChallenge defines a pending challenge asking a resource node to prove it stores a file segment
+type StorageChallenge struct {
+	Id             uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id" yaml:"id"`
+	NetworkAddress string `protobuf:"bytes,2,opt,name=network_address,json=networkAddress,proto3" json:"network_address" yaml:"network_address"`
+	FileHash       string `protobuf:"bytes,3,opt,name=file_hash,json=fileHash,proto3" json:"file_hash" yaml:"file_hash"`
+	SegmentIndex   uint64 `protobuf:"varint,4,opt,name=segment_index,json=segmentIndex,proto3" json:"segment_index" yaml:"segment_index"`
+	Height         int64  `protobuf:"varint,5,opt,name=height,proto3" json:"height" yaml:"height"`
+	// deadline_height is the last height at which the challenge can be answered
+	DeadlineHeight int64 `protobuf:"varint,6,opt,name=deadline_height,json=deadlineHeight,proto3" json:"deadline_height" yaml:"deadline_height"`
+}
+
+func (m *StorageChallenge) Reset()         { *m = StorageChallenge{} }
+func (m *StorageChallenge) String() string { return proto.CompactTextString(m) }
+func (*StorageChallenge) ProtoMessage()    {}
+func (*StorageChallenge) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a89f3959b8649eb2, []int{7}
+}
+func (m *StorageChallenge) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StorageChallenge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StorageChallenge.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StorageChallenge) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StorageChallenge.Merge(m, src)
+}
+func (m *StorageChallenge) XXX_Size() int {
+	return m.Size()
+}
+func (m *StorageChallenge) XXX_DiscardUnknown() {
+	xxx_messageInfo_StorageChallenge.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StorageChallenge proto.InternalMessageInfo
+
+func (m *StorageChallenge) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *StorageChallenge) GetNetworkAddress() string {
+	if m != nil {
+		return m.NetworkAddress
+	}
+	return ""
+}
+
+func (m *StorageChallenge) GetFileHash() string {
+	if m != nil {
+		return m.FileHash
+	}
+	return ""
+}
+
+func (m *StorageChallenge) GetSegmentIndex() uint64 {
+	if m != nil {
+		return m.SegmentIndex
+	}
+	return 0
+}
+
+func (m *StorageChallenge) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *StorageChallenge) GetDeadlineHeight() int64 {
+	if m != nil {
+		return m.DeadlineHeight
+	}
+	return 0
+}
+
+// StorageChallengeFailure defines the number of consecutive failed challenges of a resource node
+type StorageChallengeFailure struct {
+	NetworkAddress string `protobuf:"bytes,1,opt,name=network_address,json=networkAddress,proto3" json:"network_address" yaml:"network_address"`
+	Count          uint64 `protobuf:"varint,2,opt,name=count,proto3" json:"count" yaml:"count"`
+}
+
+func (m *StorageChallengeFailure) Reset()         { *m = StorageChallengeFailure{} }
+func (m *StorageChallengeFailure) String() string { return proto.CompactTextString(m) }
+func (*StorageChallengeFailure) ProtoMessage()    {}
+func (*StorageChallengeFailure) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a89f3959b8649eb2, []int{8}
+}
+func (m *StorageChallengeFailure) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StorageChallengeFailure) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StorageChallengeFailure.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StorageChallengeFailure) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StorageChallengeFailure.Merge(m, src)
+}
+func (m *StorageChallengeFailure) XXX_Size() int {
+	return m.Size()
+}
+func (m *StorageChallengeFailure) XXX_DiscardUnknown() {
+	xxx_messageInfo_StorageChallengeFailure.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StorageChallengeFailure proto.InternalMessageInfo
+
+func (m *StorageChallengeFailure) GetNetworkAddress() string {
+	if m != nil {
+		return m.NetworkAddress
+	}
+	return ""
+}
+
+func (m *StorageChallengeFailure) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+// RefundNonce defines the next noz refund nonce of a sender
+type RefundNonce struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender" yaml:"sender"`
+	Nonce  uint64 `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce" yaml:"nonce"`
+}
+
+func (m *RefundNonce) Reset()         { *m = RefundNonce{} }
+func (m *RefundNonce) String() string { return proto.CompactTextString(m) }
+func (*RefundNonce) ProtoMessage()    {}
+func (*RefundNonce) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a89f3959b8649eb2, []int{9}
+}
+func (m *RefundNonce) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RefundNonce) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RefundNonce.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RefundNonce) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RefundNonce.Merge(m, src)
+}
+func (m *RefundNonce) XXX_Size() int {
+	return m.Size()
+}
+func (m *RefundNonce) XXX_DiscardUnknown() {
+	xxx_messageInfo_RefundNonce.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RefundNonce proto.InternalMessageInfo
+
+func (m *RefundNonce) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *RefundNonce) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+// StorageRent defines the noz escrow locked by the uploader of a file and streamed to the resource nodes storing it
+type StorageRent struct {
+	FileHash string `protobuf:"bytes,1,opt,name=file_hash,json=fileHash,proto3" json:"file_hash" yaml:"file_hash"`
+	Uploader string `protobuf:"bytes,2,opt,name=uploader,proto3" json:"uploader" yaml:"uploader"`
+	// amount is the amount of noz locked when the rent is created
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount" yaml:"amount"`
+	// remaining is the amount of noz not yet paid out
+	Remaining github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=remaining,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"remaining" yaml:"remaining"`
+	// replicas is the number of resource nodes paid for storing the file
+	Replicas        uint32 `protobuf:"varint,5,opt,name=replicas,proto3" json:"replicas" yaml:"replicas"`
+	StartEpoch      int64  `protobuf:"varint,6,opt,name=start_epoch,json=startEpoch,proto3" json:"start_epoch" yaml:"start_epoch"`
+	EndEpoch        int64  `protobuf:"varint,7,opt,name=end_epoch,json=endEpoch,proto3" json:"end_epoch" yaml:"end_epoch"`
+	LastPayoutEpoch int64  `protobuf:"varint,8,opt,name=last_payout_epoch,json=lastPayoutEpoch,proto3" json:"last_payout_epoch" yaml:"last_payout_epoch"`
+	NextPayoutEpoch int64  `protobuf:"varint,9,opt,name=next_payout_epoch,json=nextPayoutEpoch,proto3" json:"next_payout_epoch" yaml:"next_payout_epoch"`
+}
+
+func (m *StorageRent) Reset()         { *m = StorageRent{} }
+func (m *StorageRent) String() string { return proto.CompactTextString(m) }
+func (*StorageRent) ProtoMessage()    {}
+func (*StorageRent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a89f3959b8649eb2, []int{10}
+}
+func (m *StorageRent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StorageRent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StorageRent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StorageRent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StorageRent.Merge(m, src)
+}
+func (m *StorageRent) XXX_Size() int {
+	return m.Size()
+}
+func (m *StorageRent) XXX_DiscardUnknown() {
+	xxx_messageInfo_StorageRent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StorageRent proto.InternalMessageInfo
+
+func (m *StorageRent) GetFileHash() string {
+	if m != nil {
+		return m.FileHash
+	}
+	return ""
+}
+
+func (m *StorageRent) GetUploader() string {
+	if m != nil {
+		return m.Uploader
+	}
+	return ""
+}
+
+func (m *StorageRent) GetReplicas() uint32 {
+	if m != nil {
+		return m.Replicas
+	}
+	return 0
+}
+
+func (m *StorageRent) GetStartEpoch() int64 {
+	if m != nil {
+		return m.StartEpoch
+	}
+	return 0
+}
+
+func (m *StorageRent) GetEndEpoch() int64 {
+	if m != nil {
+		return m.EndEpoch
+	}
+	return 0
+}
+
+func (m *StorageRent) GetLastPayoutEpoch() int64 {
+	if m != nil {
+		return m.LastPayoutEpoch
+	}
+	return 0
+}
+
+func (m *StorageRent) GetNextPayoutEpoch() int64 {
+	if m != nil {
+		return m.NextPayoutEpoch
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "stratos.sds.v1.Params")
+	proto.RegisterType((*FileInfo)(nil), "stratos.sds.v1.FileInfo")
+	proto.RegisterType((*PrepaySubscription)(nil), "stratos.sds.v1.PrepaySubscription")
+	proto.RegisterType((*NozPriceSnapshot)(nil), "stratos.sds.v1.NozPriceSnapshot")
+	proto.RegisterType((*NozBalance)(nil), "stratos.sds.v1.NozBalance")
+	proto.RegisterType((*NozAllowance)(nil), "stratos.sds.v1.NozAllowance")
+	proto.RegisterType((*FileAccessGrant)(nil), "stratos.sds.v1.FileAccessGrant")
+	proto.RegisterType((*StorageChallenge)(nil), "stratos.sds.v1.StorageChallenge")
+	proto.RegisterType((*StorageChallengeFailure)(nil), "stratos.sds.v1.StorageChallengeFailure")
+	proto.RegisterType((*RefundNonce)(nil), "stratos.sds.v1.RefundNonce")
+	proto.RegisterType((*StorageRent)(nil), "stratos.sds.v1.StorageRent")
+}
+
+func init() { proto.RegisterFile("stratos/sds/v1/sds.proto", fileDescriptor_a89f3959b8649eb2) }
+
+var fileDescriptor_a89f3959b8649eb2 = []byte{
+	// 1884 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xcd, 0x6f, 0x1b, 0xc7,
+	0x15, 0xf7, 0x92, 0x12, 0x2d, 0x8e, 0x64, 0xc9, 0x5e, 0xbb, 0x29, 0x6d, 0x35, 0x1c, 0x79, 0x8c,
+	0xb4, 0x6a, 0x0a, 0x8b, 0x50, 0x8d, 0xa2, 0x40, 0x82, 0xb6, 0x30, 0xed, 0xd8, 0x56, 0x82, 0x28,
+	0xca, 0x08, 0x4d, 0x80, 0x02, 0xc1, 0x62, 0xb5, 0x3b, 0x22, 0x17, 0x5e, 0xce, 0x6c, 0x77, 0x96,
+	0x96, 0xc4, 0x9e, 0x82, 0xf6, 0x50, 0x14, 0x3d, 0x04, 0x28, 0x50, 0xb4, 0x40, 0x0f, 0x41, 0x4f,
+	0x46, 0xd1, 0x43, 0x0e, 0xfd, 0x07, 0x7a, 0x29, 0x72, 0x0c, 0x7a, 0x69, 0xd1, 0xc3, 0xb4, 0xb0,
+	0x0f, 0x01, 0x78, 0xdc, 0x7b, 0x81, 0x60, 0x3e, 0xf6, 0x83, 0xcb, 0x90, 0xb6, 0x0c, 0xc1, 0x17,
+	0x89, 0xef, 0xf7, 0xde, 0xfc, 0x66, 0xe6, 0xcd, 0x9b, 0xf7, 0xde, 0x2c, 0x68, 0xf1, 0x24, 0x76,
+	0x13, 0xc6, 0x3b, 0xdc, 0xe7, 0x9d, 0x47, 0xdb, 0xf2, 0xdf, 0x56, 0x14, 0xb3, 0x84, 0xd9, 0xab,
+	0x46, 0xb3, 0x25, 0xa1, 0x47, 0xdb, 0xd7, 0xae, 0xf4, 0x58, 0x8f, 0x29, 0x55, 0x47, 0xfe, 0xd2,
+	0x56, 0xd7, 0xae, 0x7a, 0x8c, 0x0f, 0x18, 0x77, 0xb4, 0x42, 0x0b, 0x46, 0x75, 0xc9, 0x1d, 0x04,
+	0x94, 0x75, 0xd4, 0x5f, 0x03, 0xb5, 0xb5, 0x41, 0xe7, 0xc0, 0xe5, 0xa4, 0xf3, 0x68, 0xfb, 0x80,
+	0x24, 0xee, 0x76, 0xc7, 0x63, 0x01, 0xd5, 0x7a, 0xf4, 0x5b, 0x00, 0x1a, 0x7b, 0x6e, 0xec, 0x0e,
+	0xb8, 0xdd, 0x05, 0xe0, 0x80, 0x51, 0xdf, 0xf1, 0x09, 0x65, 0x83, 0x96, 0xb5, 0x61, 0x6d, 0x36,
+	0xbb, 0x37, 0xc6, 0x02, 0x96, 0xd0, 0x54, 0xc0, 0x4b, 0x27, 0xee, 0x20, 0x7c, 0x03, 0x15, 0x18,
+	0xc2, 0x4d, 0x29, 0xdc, 0x95, 0xbf, 0xed, 0x5f, 0x59, 0x60, 0x9d, 0xb2, 0x91, 0x13, 0xc5, 0x81,
+	0x47, 0x1c, 0x4e, 0xdd, 0x88, 0xf7, 0x59, 0xe2, 0x04, 0x34, 0x21, 0xf1, 0x23, 0x37, 0x6c, 0xd5,
+	0x36, 0xac, 0xcd, 0x7a, 0xf7, 0xad, 0xb1, 0x80, 0xf3, 0xcc, 0x52, 0x01, 0x91, 0x9e, 0x66, 0x8e,
+	0x11, 0xc2, 0x2d, 0xca, 0x46, 0x7b, 0x52, 0xb9, 0x6f, 0x74, 0x3b, 0x46, 0x65, 0x47, 0xe0, 0x95,
+	0x62, 0x64, 0x3f, 0xe0, 0x09, 0x8b, 0x4f, 0x1c, 0x1e, 0x8c, 0x48, 0xab, 0xbe, 0x61, 0x6d, 0x2e,
+	0x74, 0xdf, 0x1c, 0x0b, 0x38, 0xc3, 0x22, 0x15, 0xf0, 0xd5, 0xea, 0xdc, 0x65, 0x3d, 0xc2, 0x97,
+	0xb3, 0x69, 0x1f, 0x68, 0x78, 0x3f, 0x18, 0x11, 0xfb, 0x63, 0x0b, 0x5c, 0x93, 0x92, 0xdb, 0x23,
+	0x8e, 0xd7, 0x77, 0xc3, 0x90, 0xd0, 0x1e, 0x29, 0xf6, 0xbd, 0xa0, 0xf6, 0x7d, 0x67, 0x2c, 0xe0,
+	0x1c, 0xab, 0x54, 0xc0, 0xeb, 0x7a, 0xea, 0xd9, 0x36, 0x08, 0xb7, 0x8c, 0xf2, 0x4e, 0xa6, 0xcb,
+	0x77, 0xfd, 0x6b, 0x0b, 0x7c, 0x6b, 0x6a, 0x24, 0x77, 0x22, 0x12, 0x3b, 0x31, 0x1b, 0x52, 0xbf,
+	0xb5, 0xb8, 0x61, 0x6d, 0x5e, 0xe8, 0xde, 0x1f, 0x0b, 0x38, 0xd7, 0x2e, 0x15, 0xf0, 0xc6, 0x8c,
+	0x75, 0x94, 0xac, 0x10, 0xbe, 0x5a, 0x5d, 0x09, 0xdf, 0x23, 0x31, 0x96, 0x3a, 0xfb, 0x4f, 0x16,
+	0xb8, 0x3e, 0xbd, 0x89, 0x98, 0xf0, 0x88, 0x51, 0x4e, 0x9c, 0xa3, 0x80, 0xfa, 0xec, 0xa8, 0xd5,
+	0x50, 0x5e, 0x79, 0x7f, 0x2c, 0xe0, 0xb3, 0x8d, 0x53, 0x01, 0x37, 0x67, 0x39, 0xa7, 0x62, 0x8a,
+	0x70, 0xbb, 0xba, 0x32, 0x6c, 0x2c, 0x3e, 0x54, 0x06, 0xf6, 0xdf, 0x2d, 0xb0, 0x3e, 0x4d, 0xc3,
+	0x43, 0x97, 0xf7, 0x1d, 0xca, 0x46, 0xad, 0xf3, 0x2a, 0xf8, 0x3f, 0xb6, 0x3e, 0x17, 0xf0, 0xdc,
+	0x7f, 0x04, 0xfc, 0x76, 0x2f, 0x48, 0xfa, 0xc3, 0x83, 0x2d, 0x8f, 0x0d, 0xcc, 0x85, 0x33, 0xff,
+	0x6e, 0x72, 0xff, 0x61, 0x27, 0x39, 0x89, 0x08, 0xdf, 0xda, 0xa1, 0x89, 0x8c, 0xea, 0x39, 0xac,
+	0x45, 0x54, 0xcf, 0x31, 0x42, 0xff, 0xfc, 0xdb, 0x4d, 0x60, 0x2e, 0xf5, 0x0e, 0x4d, 0xa6, 0x4f,
+	0x7b, 0x5f, 0x5a, 0xee, 0xb2, 0x91, 0xfd, 0x89, 0x05, 0xda, 0xd3, 0x44, 0x03, 0xf7, 0xd8, 0x39,
+	0x74, 0x83, 0x70, 0x18, 0x13, 0xde, 0x5a, 0x52, 0xe7, 0xfd, 0xce, 0x58, 0xc0, 0x67, 0x58, 0xa6,
+	0x02, 0xbe, 0x36, 0x6b, 0x69, 0x65, 0x3b, 0x84, 0xd7, 0xab, 0xeb, 0x79, 0xd7, 0x3d, 0xbe, 0x67,
+	0xb4, 0xf6, 0xa7, 0x16, 0x90, 0x97, 0xc3, 0x89, 0xc9, 0xe1, 0x90, 0xfa, 0xce, 0x21, 0x21, 0x4e,
+	0xec, 0x26, 0xa4, 0xd5, 0x54, 0xee, 0xfc, 0xf9, 0x29, 0xbc, 0x79, 0x97, 0x78, 0x63, 0x01, 0xbf,
+	0x8e, 0x2c, 0x15, 0xf0, 0x5a, 0x71, 0x3f, 0x2b, 0xca, 0xb2, 0xf7, 0xee, 0x12, 0x0f, 0x5f, 0xa4,
+	0x6c, 0x84, 0x95, 0xc9, 0x3d, 0x42, 0xb0, 0x9b, 0x90, 0x37, 0x5e, 0xfd, 0xc3, 0xa7, 0xd0, 0xfa,
+	0xcd, 0x97, 0x9f, 0xbd, 0x7e, 0x25, 0x4b, 0xc3, 0xc7, 0x2a, 0x11, 0xeb, 0x1c, 0x88, 0xfe, 0x5f,
+	0x07, 0x4b, 0xf7, 0x82, 0x90, 0xec, 0xd0, 0x43, 0x66, 0x33, 0xd0, 0xe8, 0x93, 0xa0, 0xd7, 0x4f,
+	0x4c, 0x32, 0xfc, 0xf0, 0xd4, 0xe1, 0x60, 0xc6, 0xa7, 0x02, 0x5e, 0xd0, 0x6b, 0xd6, 0x72, 0xf5,
+	0x90, 0x8d, 0x99, 0xfd, 0x13, 0xd0, 0x8c, 0x49, 0xc4, 0xe2, 0x84, 0xc4, 0x5c, 0xa5, 0xca, 0x95,
+	0xee, 0xf5, 0xb1, 0x80, 0x05, 0x98, 0x0a, 0x78, 0x51, 0x13, 0xe5, 0x10, 0xc2, 0x85, 0xda, 0xde,
+	0x07, 0x4b, 0xc3, 0x28, 0x64, 0xae, 0x4f, 0x62, 0x95, 0xe9, 0x9a, 0xdd, 0x1f, 0x8e, 0x05, 0xcc,
+	0xb1, 0x54, 0xc0, 0x35, 0x3d, 0x3c, 0x43, 0xe4, 0x4a, 0xae, 0x98, 0x95, 0xdc, 0xf6, 0xfd, 0x98,
+	0x70, 0xbe, 0x9f, 0xc4, 0x01, 0xed, 0xe1, 0x7c, 0x90, 0xfd, 0x36, 0x58, 0xe1, 0xa4, 0x37, 0x20,
+	0x34, 0x71, 0x62, 0xc6, 0x12, 0x95, 0xcb, 0x56, 0xba, 0xdf, 0x19, 0x0b, 0x38, 0x81, 0xa7, 0x02,
+	0x5e, 0x36, 0x31, 0x54, 0x42, 0x11, 0x5e, 0x36, 0x22, 0x66, 0x2c, 0xb1, 0x77, 0xc1, 0x85, 0x4c,
+	0xeb, 0xb1, 0x21, 0x4d, 0x54, 0x4a, 0x5a, 0xe8, 0x7e, 0x77, 0x2c, 0xe0, 0xa4, 0x22, 0x15, 0xf0,
+	0xca, 0x24, 0x9b, 0x82, 0x11, 0xce, 0xe6, 0xbc, 0x23, 0x45, 0xc5, 0x67, 0x22, 0x96, 0x32, 0x9f,
+	0xf0, 0x56, 0x63, 0xa3, 0xbe, 0xd9, 0x34, 0x7c, 0x65, 0x45, 0x89, 0xaf, 0x0c, 0x4b, 0x3e, 0x2d,
+	0xef, 0x2a, 0xf1, 0xf7, 0x0d, 0x60, 0xef, 0xc5, 0x24, 0x72, 0x4f, 0xf6, 0x87, 0x07, 0xdc, 0x8b,
+	0x83, 0x28, 0x09, 0x18, 0xb5, 0x6f, 0x80, 0x5a, 0xe0, 0xab, 0x28, 0x58, 0xe8, 0x5e, 0x1e, 0x0b,
+	0x58, 0x0b, 0x64, 0x92, 0x6c, 0x6a, 0xc2, 0xc0, 0x47, 0xb8, 0x16, 0xf8, 0xf6, 0x3b, 0xa0, 0xc1,
+	0x09, 0x95, 0xae, 0xaf, 0x29, 0xd7, 0xdf, 0x92, 0x01, 0xa0, 0x91, 0x22, 0x00, 0xb4, 0x3c, 0xdb,
+	0xed, 0x66, 0x80, 0xed, 0x80, 0xe5, 0x03, 0x42, 0xc9, 0x61, 0xe0, 0x05, 0x6e, 0x7c, 0x62, 0x0e,
+	0xf3, 0x47, 0x63, 0x01, 0xcb, 0x70, 0x2a, 0xa0, 0x6d, 0xca, 0x71, 0x01, 0xce, 0xe6, 0x2e, 0x0f,
+	0xb5, 0x7f, 0x69, 0x81, 0x86, 0x3b, 0x50, 0x67, 0xb0, 0xb0, 0x51, 0xdf, 0x5c, 0xfe, 0xfe, 0xd5,
+	0x2d, 0x33, 0x48, 0xb6, 0x0a, 0x5b, 0xa6, 0x55, 0xd8, 0xba, 0xc3, 0x02, 0xda, 0xdd, 0x93, 0x81,
+	0x2f, 0x77, 0xa3, 0x07, 0x14, 0xbb, 0xd1, 0x32, 0xfa, 0xcb, 0x7f, 0xe1, 0xe6, 0x73, 0xdc, 0x09,
+	0xc9, 0xc5, 0x1f, 0x7f, 0xf9, 0xd9, 0xeb, 0x16, 0x36, 0x4c, 0xf6, 0x9b, 0x60, 0x29, 0xaf, 0x91,
+	0x8b, 0xaa, 0x1a, 0x40, 0x19, 0xb0, 0xa5, 0x8a, 0x68, 0x02, 0xb6, 0xa8, 0x7f, 0xb9, 0xd2, 0xfe,
+	0x31, 0x68, 0xca, 0xe4, 0xa4, 0x03, 0xa9, 0xa1, 0x0e, 0x47, 0x5d, 0x97, 0x1c, 0x2c, 0xae, 0x4b,
+	0x0e, 0x21, 0xbc, 0x34, 0x70, 0x8f, 0x75, 0xf0, 0x74, 0x01, 0x20, 0xd4, 0x77, 0xcc, 0x1d, 0x3f,
+	0xaf, 0xa6, 0x57, 0x0d, 0x4f, 0x81, 0x16, 0x0d, 0x4f, 0x81, 0x21, 0xdc, 0x24, 0xd4, 0x7f, 0xa0,
+	0xaf, 0xec, 0x3d, 0xb0, 0x4c, 0xc9, 0x71, 0x92, 0x91, 0x2c, 0x29, 0x92, 0xd7, 0xe4, 0x39, 0x95,
+	0xe0, 0xe2, 0x9c, 0x4a, 0x20, 0xc2, 0x40, 0x4a, 0x86, 0x07, 0x83, 0x55, 0x72, 0x4c, 0xbc, 0x61,
+	0x42, 0x7c, 0xb3, 0xa1, 0xa6, 0xda, 0xd0, 0xf7, 0xc6, 0x02, 0x56, 0x34, 0xa9, 0x80, 0xdf, 0x30,
+	0x6b, 0x9a, 0xc0, 0x11, 0xbe, 0x90, 0x01, 0x7a, 0x7f, 0x6f, 0x83, 0x15, 0x99, 0xb8, 0x73, 0x46,
+	0xa0, 0xca, 0x81, 0xba, 0xb8, 0x65, 0xbc, 0xb8, 0xb8, 0x65, 0x14, 0xe1, 0x65, 0x2d, 0x2a, 0x2e,
+	0xf4, 0xaf, 0x3a, 0xb8, 0xb8, 0x5b, 0x69, 0xb7, 0xec, 0x5b, 0x13, 0x09, 0xb2, 0xde, 0x5d, 0x9f,
+	0x93, 0xf2, 0xf2, 0x24, 0xe7, 0x80, 0x1a, 0x4f, 0xcc, 0x15, 0x79, 0xef, 0xd4, 0x19, 0xb5, 0xc6,
+	0x93, 0xe2, 0xe6, 0xf1, 0xa9, 0x4c, 0x5a, 0xe3, 0x6a, 0x82, 0x28, 0x69, 0xd5, 0x5f, 0x74, 0x82,
+	0xa8, 0x34, 0x41, 0x34, 0x3d, 0x41, 0xa4, 0x26, 0x08, 0x75, 0x1a, 0x7c, 0xa1, 0x09, 0xc2, 0xd2,
+	0x04, 0xe1, 0xf4, 0x04, 0x61, 0x62, 0x3f, 0x04, 0x8b, 0xaa, 0xf1, 0x54, 0x57, 0xa2, 0xd9, 0xfd,
+	0xe9, 0xa9, 0x0b, 0xa7, 0x1e, 0x9e, 0x0a, 0xb8, 0x62, 0xf6, 0x21, 0xc5, 0x6a, 0x71, 0xd4, 0x46,
+	0xe8, 0x1f, 0x16, 0x00, 0xbb, 0x6c, 0xd4, 0x75, 0x43, 0x97, 0x7a, 0xc4, 0xbe, 0x0f, 0x16, 0xd9,
+	0x11, 0x25, 0xb1, 0xa9, 0x79, 0xdb, 0x92, 0x4d, 0x01, 0x05, 0x9b, 0x12, 0x67, 0xa7, 0x19, 0x6d,
+	0x2e, 0xab, 0xa7, 0xc9, 0x2f, 0xb5, 0x17, 0xad, 0x9e, 0x33, 0xd2, 0x4d, 0xb5, 0x7a, 0x1a, 0xf8,
+	0x8f, 0x35, 0xb0, 0xb2, 0xcb, 0x46, 0xb7, 0xc3, 0x90, 0x1d, 0x9d, 0xed, 0x56, 0xde, 0x03, 0xe7,
+	0x79, 0x54, 0x4e, 0xed, 0x3f, 0x18, 0x0b, 0x98, 0x41, 0xa9, 0x80, 0xab, 0x26, 0x1c, 0xa3, 0x67,
+	0x24, 0xf7, 0x6c, 0x48, 0xc9, 0x37, 0xf5, 0x97, 0xe3, 0x9b, 0xc7, 0x75, 0xb0, 0x26, 0xfb, 0x9a,
+	0xdb, 0x9e, 0x47, 0x38, 0xbf, 0x1f, 0xbb, 0x34, 0x91, 0xe9, 0xf3, 0x30, 0x08, 0x89, 0xd3, 0x77,
+	0x79, 0xdf, 0xb8, 0x48, 0xa5, 0xcf, 0x1c, 0x2c, 0xd2, 0x67, 0x0e, 0x21, 0xbc, 0x24, 0x7f, 0x3f,
+	0x70, 0x79, 0x5f, 0x7a, 0xa5, 0x27, 0x89, 0x26, 0xbd, 0x62, 0xa0, 0xc2, 0x2b, 0x06, 0x98, 0xe3,
+	0x15, 0x63, 0x51, 0x10, 0x92, 0x56, 0xbd, 0x4a, 0x48, 0xaa, 0x84, 0xe4, 0x99, 0x84, 0x44, 0x76,
+	0x07, 0xe4, 0x38, 0x0a, 0xe2, 0x93, 0x2c, 0x3d, 0xeb, 0x67, 0x98, 0xea, 0x0e, 0x26, 0x14, 0x45,
+	0x77, 0x30, 0x01, 0x23, 0xbc, 0xa2, 0x65, 0x93, 0xa4, 0x3d, 0x50, 0x3f, 0x24, 0xd9, 0xad, 0x7c,
+	0xff, 0xd4, 0x67, 0x26, 0x07, 0xa7, 0x02, 0x02, 0xe3, 0x53, 0x42, 0xaa, 0xa7, 0x25, 0x0d, 0xd0,
+	0x5f, 0xeb, 0xe0, 0xe2, 0x7e, 0xa5, 0xc9, 0x7e, 0xbe, 0x06, 0xe4, 0x03, 0xb0, 0x46, 0x49, 0x72,
+	0xc4, 0xe2, 0x87, 0x8e, 0xab, 0x1d, 0x62, 0x0e, 0xe6, 0xe6, 0x58, 0xc0, 0xaa, 0x2a, 0x15, 0xf0,
+	0x95, 0xac, 0x26, 0x4d, 0x28, 0x10, 0x5e, 0x35, 0x88, 0xf1, 0xea, 0x64, 0xa0, 0xd4, 0x4f, 0x1f,
+	0x28, 0xa5, 0xa6, 0x2f, 0xa0, 0x3e, 0x39, 0x6e, 0x2d, 0x4c, 0x37, 0x7d, 0x4a, 0x31, 0xdd, 0xf4,
+	0x29, 0xb8, 0x68, 0xfa, 0x76, 0xa4, 0x58, 0x2a, 0x3b, 0x8b, 0xcf, 0x5f, 0x76, 0x3e, 0x00, 0x6b,
+	0x3e, 0x71, 0xfd, 0x30, 0xa0, 0x24, 0x8b, 0x06, 0xfd, 0xfc, 0x54, 0xce, 0xa9, 0xa8, 0x0a, 0xe7,
+	0x54, 0x14, 0x08, 0xaf, 0x66, 0x88, 0x8e, 0x09, 0xf4, 0x67, 0x0b, 0x7c, 0xb3, 0x7a, 0x5c, 0xe6,
+	0x41, 0xf4, 0x75, 0x07, 0x62, 0x9d, 0xc5, 0x81, 0x74, 0xc0, 0xa2, 0x97, 0x67, 0xd6, 0x85, 0xee,
+	0x55, 0x99, 0xd8, 0xb2, 0x52, 0x6e, 0x12, 0x9b, 0xa9, 0xe1, 0x1a, 0x46, 0x1c, 0x2c, 0xeb, 0x67,
+	0xd0, 0x2e, 0x93, 0x89, 0xf1, 0x56, 0xde, 0xa9, 0xea, 0xe5, 0xac, 0xcf, 0xe9, 0x54, 0xf3, 0x8e,
+	0xb4, 0x03, 0x16, 0xa9, 0x1c, 0x5d, 0x9e, 0x54, 0x01, 0xc5, 0xa4, 0x4a, 0x44, 0x58, 0xc3, 0xe8,
+	0x77, 0x0d, 0xb0, 0x6c, 0x3c, 0x83, 0xc9, 0x19, 0xe4, 0x9b, 0xf2, 0xe3, 0xa6, 0x76, 0x56, 0x8f,
+	0x9b, 0x97, 0x9d, 0x89, 0xed, 0x5f, 0xc8, 0x37, 0xde, 0xc0, 0x0d, 0x68, 0x40, 0x7b, 0xa6, 0x87,
+	0xf8, 0xe8, 0xd4, 0x73, 0x16, 0x14, 0xe5, 0x17, 0xa1, 0x81, 0xaa, 0x33, 0x17, 0xc6, 0xb2, 0xdd,
+	0x8e, 0x49, 0x14, 0x06, 0x9e, 0xcb, 0xcd, 0xc7, 0x20, 0xd5, 0x6e, 0x67, 0x58, 0xe1, 0xc2, 0x0c,
+	0x41, 0x38, 0x57, 0xca, 0x56, 0x97, 0x27, 0x6e, 0x9c, 0x38, 0x24, 0x62, 0x5e, 0xbf, 0xd5, 0x28,
+	0x5a, 0xdd, 0x12, 0x5c, 0xb4, 0xba, 0x25, 0x10, 0x61, 0xa0, 0xa4, 0xb7, 0xa4, 0x20, 0xe3, 0x40,
+	0x36, 0xd3, 0x9a, 0x45, 0x77, 0xdd, 0x2a, 0x0e, 0x72, 0xb0, 0xd8, 0x53, 0x0e, 0x21, 0xbc, 0x44,
+	0xa8, 0xaf, 0xc7, 0x7f, 0x04, 0x2e, 0x85, 0x2e, 0x4f, 0x9c, 0xc8, 0x3d, 0x61, 0xc3, 0x6c, 0x35,
+	0xba, 0xf1, 0x96, 0x25, 0x7e, 0x5a, 0x99, 0x0a, 0xd8, 0xd2, 0x7c, 0x53, 0x2a, 0x84, 0xd7, 0x24,
+	0xb6, 0xa7, 0xa0, 0x9c, 0x5e, 0x75, 0xe9, 0x13, 0xf4, 0xcd, 0x82, 0x7e, 0x4a, 0x59, 0xd0, 0x4f,
+	0xa9, 0x10, 0x5e, 0x93, 0x58, 0x89, 0xbe, 0xfb, 0xee, 0xe3, 0x27, 0x6d, 0xeb, 0xf3, 0x27, 0x6d,
+	0xeb, 0x8b, 0x27, 0x6d, 0xeb, 0x7f, 0x4f, 0xda, 0xd6, 0x27, 0x4f, 0xdb, 0xe7, 0xbe, 0x78, 0xda,
+	0x3e, 0xf7, 0xef, 0xa7, 0xed, 0x73, 0x3f, 0xeb, 0x94, 0x42, 0xc0, 0x7c, 0xa0, 0xa0, 0x24, 0xc9,
+	0x7e, 0xde, 0xf4, 0xfa, 0x6e, 0x40, 0xcd, 0x17, 0x0b, 0x15, 0x0f, 0x07, 0x0d, 0xf5, 0x19, 0xf7,
+	0xd6, 0x57, 0x03, 0x00, 0xbd, 0x1d, 0xaa, 0x1a, 0x56, 0x16, 0x00, 0x00,
+}
+
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.BondDenom != that1.BondDenom {
+		return false
+	}
+	if this.NozPriceSnapshotInterval != that1.NozPriceSnapshotInterval {
+		return false
+	}
+	if this.NozPriceHistorySize != that1.NozPriceHistorySize {
+		return false
+	}
+	if this.StorageChallengeInterval != that1.StorageChallengeInterval {
+		return false
+	}
+	if this.StorageChallengesPerRound != that1.StorageChallengesPerRound {
+		return false
+	}
+	if this.StorageChallengeResponseWindow != that1.StorageChallengeResponseWindow {
+		return false
+	}
+	if !this.StorageChallengeSlashNoz.Equal(that1.StorageChallengeSlashNoz) {
+		return false
+	}
+	if this.StorageChallengeMaxFailures != that1.StorageChallengeMaxFailures {
+		return false
+	}
+	if !this.NozRefundFeeRate.Equal(that1.NozRefundFeeRate) {
+		return false
+	}
+	return true
+}
+func (this *FileInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*FileInfo)
+	if !ok {
+		that2, ok := that.(FileInfo)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Height.Equal(that1.Height) {
+		return false
+	}
+	if !bytes.Equal(this.Reporters, that1.Reporters) {
+		return false
+	}
+	if this.Uploader != that1.Uploader {
+		return false
+	}
+	if !bytes.Equal(this.SegmentRoot, that1.SegmentRoot) {
+		return false
+	}
+	if this.SegmentCount != that1.SegmentCount {
+		return false
+	}
+	if len(this.StorageNodes) != len(that1.StorageNodes) {
+		return false
+	}
+	for i := range this.StorageNodes {
+		if this.StorageNodes[i] != that1.StorageNodes[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *PrepaySubscription) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PrepaySubscription)
+	if !ok {
+		that2, ok := that.(PrepaySubscription)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.Sender != that1.Sender {
+		return false
+	}
+	if this.Beneficiary != that1.Beneficiary {
+		return false
+	}
+	if len(this.Amount) != len(that1.Amount) {
+		return false
+	}
+	for i := range this.Amount {
+		if !this.Amount[i].Equal(&that1.Amount[i]) {
+			return false
+		}
+	}
+	if this.Interval != that1.Interval {
+		return false
+	}
+	if this.MaxCount != that1.MaxCount {
+		return false
+	}
+	if this.EndHeight != that1.EndHeight {
+		return false
+	}
+	if this.NextHeight != that1.NextHeight {
+		return false
+	}
+	if this.ExecutedCount != that1.ExecutedCount {
+		return false
+	}
+	if this.FailedCount != that1.FailedCount {
+		return false
+	}
+	return true
+}
+func (this *NozPriceSnapshot) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*NozPriceSnapshot)
+	if !ok {
+		that2, ok := that.(NozPriceSnapshot)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	if !this.St.Equal(that1.St) {
+		return false
+	}
+	if !this.Pt.Equal(that1.Pt) {
+		return false
+	}
+	if !this.Lt.Equal(that1.Lt) {
+		return false
+	}
+	if !this.Price.Equal(that1.Price) {
+		return false
+	}
+	return true
+}
+func (this *NozBalance) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*NozBalance)
+	if !ok {
+		that2, ok := that.(NozBalance)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Owner != that1.Owner {
+		return false
+	}
+	if !this.Amount.Equal(that1.Amount) {
+		return false
+	}
+	return true
+}
+func (this *NozAllowance) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*NozAllowance)
+	if !ok {
+		that2, ok := that.(NozAllowance)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Owner != that1.Owner {
+		return false
+	}
+	if this.Spender != that1.Spender {
+		return false
+	}
+	if !this.Amount.Equal(that1.Amount) {
+		return false
+	}
+	return true
+}
+func (this *FileAccessGrant) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*FileAccessGrant)
+	if !ok {
+		that2, ok := that.(FileAccessGrant)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.FileHash != that1.FileHash {
+		return false
+	}
+	if this.Granter != that1.Granter {
+		return false
+	}
+	if this.Grantee != that1.Grantee {
+		return false
+	}
+	if this.ExpiryHeight != that1.ExpiryHeight {
+		return false
+	}
+	if !this.Fee.Equal(that1.Fee) {
+		return false
+	}
+	return true
+}
+func (this *StorageChallenge) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*StorageChallenge)
+	if !ok {
+		that2, ok := that.(StorageChallenge)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.NetworkAddress != that1.NetworkAddress {
+		return false
+	}
+	if this.FileHash != that1.FileHash {
+		return false
+	}
+	if this.SegmentIndex != that1.SegmentIndex {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	if this.DeadlineHeight != that1.DeadlineHeight {
+		return false
+	}
+	return true
+}
+func (this *StorageChallengeFailure) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*StorageChallengeFailure)
+	if !ok {
+		that2, ok := that.(StorageChallengeFailure)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.NetworkAddress != that1.NetworkAddress {
+		return false
+	}
+	if this.Count != that1.Count {
+		return false
+	}
+	return true
+}
+func (this *RefundNonce) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RefundNonce)
+	if !ok {
+		that2, ok := that.(RefundNonce)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Sender != that1.Sender {
+		return false
+	}
+	if this.Nonce != that1.Nonce {
+		return false
+	}
+	return true
+}
+func (this *StorageRent) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*StorageRent)
+	if !ok {
+		that2, ok := that.(StorageRent)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.FileHash != that1.FileHash {
+		return false
+	}
+	if this.Uploader != that1.Uploader {
+		return false
+	}
+	if !this.Amount.Equal(that1.Amount) {
+		return false
+	}
+	if !this.Remaining.Equal(that1.Remaining) {
+		return false
+	}
+	if this.Replicas != that1.Replicas {
+		return false
+	}
+	if this.StartEpoch != that1.StartEpoch {
+		return false
+	}
+	if this.EndEpoch != that1.EndEpoch {
+		return false
+	}
+	if this.LastPayoutEpoch != that1.LastPayoutEpoch {
+		return false
+	}
+	if this.NextPayoutEpoch != that1.NextPayoutEpoch {
+		return false
+	}
+	return true
+}
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.NozRefundFeeRate.Size()
+		i -= size
+		if _, err := m.NozRefundFeeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSds(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if m.StorageChallengeMaxFailures != 0 {
+		i = encodeVarintSds(dAtA, i, uint64(m.StorageChallengeMaxFailures))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size := m.StorageChallengeSlashNoz.Size()
+		i -= size
+		if _, err := m.StorageChallengeSlashNoz.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSds(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.StorageChallengeResponseWindow != 0 {
+		i = encodeVarintSds(dAtA, i, uint64(m.StorageChallengeResponseWindow))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.StorageChallengesPerRound != 0 {
+		i = encodeVarintSds(dAtA, i, uint64(m.StorageChallengesPerRound))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.StorageChallengeInterval != 0 {
+		i = encodeVarintSds(dAtA, i, uint64(m.StorageChallengeInterval))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.NozPriceHistorySize != 0 {
+		i = encodeVarintSds(dAtA, i, uint64(m.NozPriceHistorySize))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.NozPriceSnapshotInterval != 0 {
+		i = encodeVarintSds(dAtA, i, uint64(m.NozPriceSnapshotInterval))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.BondDenom) > 0 {
+		i -= len(m.BondDenom)
+		copy(dAtA[i:], m.BondDenom)
+		i = encodeVarintSds(dAtA, i, uint64(len(m.BondDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FileInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FileInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FileInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StorageNodes) > 0 {
+		for iNdEx := len(m.StorageNodes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.StorageNodes[iNdEx])
+			copy(dAtA[i:], m.StorageNodes[iNdEx])
+			i = encodeVarintSds(dAtA, i, uint64(len(m.StorageNodes[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.SegmentCount != 0 {
+		i = encodeVarintSds(dAtA, i, uint64(m.SegmentCount))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.SegmentRoot) > 0 {
+		i -= len(m.SegmentRoot)
+		copy(dAtA[i:], m.SegmentRoot)
+		i = encodeVarintSds(dAtA, i, uint64(len(m.SegmentRoot)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Uploader) > 0 {
+		i -= len(m.Uploader)
+		copy(dAtA[i:], m.Uploader)
+		i = encodeVarintSds(dAtA, i, uint64(len(m.Uploader)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Reporters) > 0 {
+		i -= len(m.Reporters)
+		copy(dAtA[i:], m.Reporters)
+		i = encodeVarintSds(dAtA, i, uint64(len(m.Reporters)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.Height.Size()
+		i -= size
+		if _, err := m.Height.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSds(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PrepaySubscription) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PrepaySubscription) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PrepaySubscription) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FailedCount != 0 {
+		i = encodeVarintSds(dAtA, i, uint64(m.FailedCount))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.ExecutedCount != 0 {
+		i = encodeVarintSds(dAtA, i, uint64(m.ExecutedCount))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.NextHeight != 0 {
+		i = encodeVarintSds(dAtA, i, uint64(m.NextHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.EndHeight != 0 {
+		i = encodeVarintSds(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MaxCount != 0 {
+		i = encodeVarintSds(dAtA, i, uint64(m.MaxCount))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Interval != 0 {
+		i = encodeVarintSds(dAtA, i, uint64(m.Interval))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSds(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Beneficiary) > 0 {
+		i -= len(m.Beneficiary)
+		copy(dAtA[i:], m.Beneficiary)
+		i = encodeVarintSds(dAtA, i, uint64(len(m.Beneficiary)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintSds(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintSds(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NozPriceSnapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NozPriceSnapshot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NozPriceSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSds(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Lt.Size()
+		i -= size
+		if _, err := m.Lt.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSds(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Pt.Size()
+		i -= size
+		if _, err := m.Pt.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSds(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.St.Size()
+		i -= size
+		if _, err := m.St.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSds(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintSds(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NozBalance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NozBalance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NozBalance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSds(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintSds(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NozAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NozAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NozAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSds(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Spender) > 0 {
+		i -= len(m.Spender)
+		copy(dAtA[i:], m.Spender)
+		i = encodeVarintSds(dAtA, i, uint64(len(m.Spender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintSds(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FileAccessGrant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FileAccessGrant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FileAccessGrant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Fee.Size()
+		i -= size
+		if _, err := m.Fee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSds(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintSds(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintSds(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintSds(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FileHash) > 0 {
+		i -= len(m.FileHash)
+		copy(dAtA[i:], m.FileHash)
+		i = encodeVarintSds(dAtA, i, uint64(len(m.FileHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StorageChallenge) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StorageChallenge) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StorageChallenge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DeadlineHeight != 0 {
+		i = encodeVarintSds(dAtA, i, uint64(m.DeadlineHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Height != 0 {
+		i = encodeVarintSds(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.SegmentIndex != 0 {
+		i = encodeVarintSds(dAtA, i, uint64(m.SegmentIndex))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.FileHash) > 0 {
+		i -= len(m.FileHash)
+		copy(dAtA[i:], m.FileHash)
+		i = encodeVarintSds(dAtA, i, uint64(len(m.FileHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.NetworkAddress) > 0 {
+		i -= len(m.NetworkAddress)
+		copy(dAtA[i:], m.NetworkAddress)
+		i = encodeVarintSds(dAtA, i, uint64(len(m.NetworkAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintSds(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StorageChallengeFailure) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StorageChallengeFailure) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StorageChallengeFailure) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		i = encodeVarintSds(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.NetworkAddress) > 0 {
+		i -= len(m.NetworkAddress)
+		copy(dAtA[i:], m.NetworkAddress)
+		i = encodeVarintSds(dAtA, i, uint64(len(m.NetworkAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RefundNonce) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RefundNonce) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RefundNonce) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintSds(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintSds(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StorageRent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StorageRent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StorageRent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NextPayoutEpoch != 0 {
+		i = encodeVarintSds(dAtA, i, uint64(m.NextPayoutEpoch))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.LastPayoutEpoch != 0 {
+		i = encodeVarintSds(dAtA, i, uint64(m.LastPayoutEpoch))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.EndEpoch != 0 {
+		i = encodeVarintSds(dAtA, i, uint64(m.EndEpoch))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.StartEpoch != 0 {
+		i = encodeVarintSds(dAtA, i, uint64(m.StartEpoch))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Replicas != 0 {
+		i = encodeVarintSds(dAtA, i, uint64(m.Replicas))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.Remaining.Size()
+		i -= size
+		if _, err := m.Remaining.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSds(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSds(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Uploader) > 0 {
+		i -= len(m.Uploader)
+		copy(dAtA[i:], m.Uploader)
+		i = encodeVarintSds(dAtA, i, uint64(len(m.Uploader)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FileHash) > 0 {
+		i -= len(m.FileHash)
+		copy(dAtA[i:], m.FileHash)
+		i = encodeVarintSds(dAtA, i, uint64(len(m.FileHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSds(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSds(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BondDenom)
+	if l > 0 {
+		n += 1 + l + sovSds(uint64(l))
+	}
+	if m.NozPriceSnapshotInterval != 0 {
+		n += 1 + sovSds(uint64(m.NozPriceSnapshotInterval))
+	}
+	if m.NozPriceHistorySize != 0 {
+		n += 1 + sovSds(uint64(m.NozPriceHistorySize))
+	}
+	if m.StorageChallengeInterval != 0 {
+		n += 1 + sovSds(uint64(m.StorageChallengeInterval))
+	}
+	if m.StorageChallengesPerRound != 0 {
+		n += 1 + sovSds(uint64(m.StorageChallengesPerRound))
+	}
+	if m.StorageChallengeResponseWindow != 0 {
+		n += 1 + sovSds(uint64(m.StorageChallengeResponseWindow))
+	}
+	l = m.StorageChallengeSlashNoz.Size()
+	n += 1 + l + sovSds(uint64(l))
+	if m.StorageChallengeMaxFailures != 0 {
+		n += 1 + sovSds(uint64(m.StorageChallengeMaxFailures))
+	}
+	l = m.NozRefundFeeRate.Size()
+	n += 1 + l + sovSds(uint64(l))
+	return n
+}
+
+func (m *FileInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
 	l = m.Height.Size()
 	n += 1 + l + sovSds(uint64(l))
 	l = len(m.Reporters)
 	if l > 0 {
 		n += 1 + l + sovSds(uint64(l))
 	}
-	l = len(m.Uploader)
-	if l > 0 {
-		n += 1 + l + sovSds(uint64(l))
+	l = len(m.Uploader)
+	if l > 0 {
+		n += 1 + l + sovSds(uint64(l))
+	}
+	l = len(m.SegmentRoot)
+	if l > 0 {
+		n += 1 + l + sovSds(uint64(l))
+	}
+	if m.SegmentCount != 0 {
+		n += 1 + sovSds(uint64(m.SegmentCount))
+	}
+	if len(m.StorageNodes) > 0 {
+		for _, s := range m.StorageNodes {
+			l = len(s)
+			n += 1 + l + sovSds(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PrepaySubscription) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovSds(uint64(m.Id))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovSds(uint64(l))
+	}
+	l = len(m.Beneficiary)
+	if l > 0 {
+		n += 1 + l + sovSds(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovSds(uint64(l))
+		}
+	}
+	if m.Interval != 0 {
+		n += 1 + sovSds(uint64(m.Interval))
+	}
+	if m.MaxCount != 0 {
+		n += 1 + sovSds(uint64(m.MaxCount))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovSds(uint64(m.EndHeight))
+	}
+	if m.NextHeight != 0 {
+		n += 1 + sovSds(uint64(m.NextHeight))
+	}
+	if m.ExecutedCount != 0 {
+		n += 1 + sovSds(uint64(m.ExecutedCount))
+	}
+	if m.FailedCount != 0 {
+		n += 1 + sovSds(uint64(m.FailedCount))
+	}
+	return n
+}
+
+func (m *NozPriceSnapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovSds(uint64(m.Height))
+	}
+	l = m.St.Size()
+	n += 1 + l + sovSds(uint64(l))
+	l = m.Pt.Size()
+	n += 1 + l + sovSds(uint64(l))
+	l = m.Lt.Size()
+	n += 1 + l + sovSds(uint64(l))
+	l = m.Price.Size()
+	n += 1 + l + sovSds(uint64(l))
+	return n
+}
+
+func (m *NozBalance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovSds(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovSds(uint64(l))
+	return n
+}
+
+func (m *NozAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovSds(uint64(l))
+	}
+	l = len(m.Spender)
+	if l > 0 {
+		n += 1 + l + sovSds(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovSds(uint64(l))
+	return n
+}
+
+func (m *FileAccessGrant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FileHash)
+	if l > 0 {
+		n += 1 + l + sovSds(uint64(l))
+	}
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovSds(uint64(l))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovSds(uint64(l))
+	}
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovSds(uint64(m.ExpiryHeight))
+	}
+	l = m.Fee.Size()
+	n += 1 + l + sovSds(uint64(l))
+	return n
+}
+
+func (m *StorageChallenge) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovSds(uint64(m.Id))
+	}
+	l = len(m.NetworkAddress)
+	if l > 0 {
+		n += 1 + l + sovSds(uint64(l))
+	}
+	l = len(m.FileHash)
+	if l > 0 {
+		n += 1 + l + sovSds(uint64(l))
+	}
+	if m.SegmentIndex != 0 {
+		n += 1 + sovSds(uint64(m.SegmentIndex))
+	}
+	if m.Height != 0 {
+		n += 1 + sovSds(uint64(m.Height))
+	}
+	if m.DeadlineHeight != 0 {
+		n += 1 + sovSds(uint64(m.DeadlineHeight))
+	}
+	return n
+}
+
+func (m *StorageChallengeFailure) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NetworkAddress)
+	if l > 0 {
+		n += 1 + l + sovSds(uint64(l))
+	}
+	if m.Count != 0 {
+		n += 1 + sovSds(uint64(m.Count))
+	}
+	return n
+}
+
+func (m *RefundNonce) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovSds(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovSds(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *StorageRent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FileHash)
+	if l > 0 {
+		n += 1 + l + sovSds(uint64(l))
+	}
+	l = len(m.Uploader)
+	if l > 0 {
+		n += 1 + l + sovSds(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovSds(uint64(l))
+	l = m.Remaining.Size()
+	n += 1 + l + sovSds(uint64(l))
+	if m.Replicas != 0 {
+		n += 1 + sovSds(uint64(m.Replicas))
+	}
+	if m.StartEpoch != 0 {
+		n += 1 + sovSds(uint64(m.StartEpoch))
+	}
+	if m.EndEpoch != 0 {
+		n += 1 + sovSds(uint64(m.EndEpoch))
+	}
+	if m.LastPayoutEpoch != 0 {
+		n += 1 + sovSds(uint64(m.LastPayoutEpoch))
+	}
+	if m.NextPayoutEpoch != 0 {
+		n += 1 + sovSds(uint64(m.NextPayoutEpoch))
+	}
+	return n
+}
+
+func sovSds(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSds(x uint64) (n int) {
+	return sovSds(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSds
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSds
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSds
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BondDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NozPriceSnapshotInterval", wireType)
+			}
+			m.NozPriceSnapshotInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NozPriceSnapshotInterval |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NozPriceHistorySize", wireType)
+			}
+			m.NozPriceHistorySize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NozPriceHistorySize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageChallengeInterval", wireType)
+			}
+			m.StorageChallengeInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StorageChallengeInterval |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageChallengesPerRound", wireType)
+			}
+			m.StorageChallengesPerRound = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StorageChallengesPerRound |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageChallengeResponseWindow", wireType)
+			}
+			m.StorageChallengeResponseWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StorageChallengeResponseWindow |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageChallengeSlashNoz", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSds
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSds
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StorageChallengeSlashNoz.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageChallengeMaxFailures", wireType)
+			}
+			m.StorageChallengeMaxFailures = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StorageChallengeMaxFailures |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NozRefundFeeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSds
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSds
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NozRefundFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSds(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSds
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FileInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSds
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FileInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FileInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSds
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSds
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Height.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reporters", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSds
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSds
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reporters = append(m.Reporters[:0], dAtA[iNdEx:postIndex]...)
+			if m.Reporters == nil {
+				m.Reporters = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uploader", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSds
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSds
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Uploader = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SegmentRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSds
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSds
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SegmentRoot = append(m.SegmentRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.SegmentRoot == nil {
+				m.SegmentRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SegmentCount", wireType)
+			}
+			m.SegmentCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SegmentCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageNodes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSds
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSds
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StorageNodes = append(m.StorageNodes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSds(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSds
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PrepaySubscription) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSds
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PrepaySubscription: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PrepaySubscription: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSds
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSds
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Beneficiary", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSds
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSds
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Beneficiary = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSds
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSds
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			m.Interval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Interval |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCount", wireType)
+			}
+			m.MaxCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextHeight", wireType)
+			}
+			m.NextHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutedCount", wireType)
+			}
+			m.ExecutedCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecutedCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedCount", wireType)
+			}
+			m.FailedCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FailedCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSds(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSds
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NozPriceSnapshot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSds
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NozPriceSnapshot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NozPriceSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field St", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSds
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSds
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.St.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSds
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSds
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSds
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSds
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Lt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSds
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSds
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSds(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSds
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NozBalance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSds
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NozBalance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NozBalance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSds
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSds
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSds
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSds
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSds(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSds
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NozAllowance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSds
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NozAllowance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NozAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSds
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSds
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSds
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSds
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Spender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSds
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSds
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSds(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSds
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FileAccessGrant) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSds
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FileAccessGrant: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FileAccessGrant: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FileHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSds
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSds
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FileHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSds
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSds
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSds
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSds
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+			}
+			m.ExpiryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSds
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSds
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSds(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSds
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StorageChallenge) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSds
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StorageChallenge: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StorageChallenge: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetworkAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSds
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSds
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NetworkAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FileHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSds
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSds
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FileHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SegmentIndex", wireType)
+			}
+			m.SegmentIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SegmentIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeadlineHeight", wireType)
+			}
+			m.DeadlineHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeadlineHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSds(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSds
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func sovSds(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozSds(x uint64) (n int) {
-	return sovSds(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *StorageChallengeFailure) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -365,15 +4021,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: StorageChallengeFailure: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StorageChallengeFailure: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BondDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NetworkAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -401,8 +4057,27 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BondDenom = string(dAtA[iNdEx:postIndex])
+			m.NetworkAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSds(dAtA[iNdEx:])
@@ -424,7 +4099,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FileInfo) Unmarshal(dAtA []byte) error {
+func (m *RefundNonce) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -447,15 +4122,15 @@ func (m *FileInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FileInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: RefundNonce: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FileInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RefundNonce: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -483,15 +4158,114 @@ func (m *FileInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Height.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSds(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSds
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StorageRent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSds
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StorageRent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StorageRent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FileHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSds
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSds
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FileHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reporters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Uploader", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSds
@@ -501,29 +4275,27 @@ func (m *FileInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSds
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSds
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reporters = append(m.Reporters[:0], dAtA[iNdEx:postIndex]...)
-			if m.Reporters == nil {
-				m.Reporters = []byte{}
-			}
+			m.Uploader = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Uploader", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -551,8 +4323,139 @@ func (m *FileInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Uploader = string(dAtA[iNdEx:postIndex])
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remaining", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSds
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSds
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Remaining.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
+			}
+			m.Replicas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Replicas |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartEpoch", wireType)
+			}
+			m.StartEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartEpoch |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndEpoch", wireType)
+			}
+			m.EndEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndEpoch |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastPayoutEpoch", wireType)
+			}
+			m.LastPayoutEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastPayoutEpoch |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPayoutEpoch", wireType)
+			}
+			m.NextPayoutEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextPayoutEpoch |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSds(dAtA[iNdEx:])
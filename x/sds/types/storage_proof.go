@@ -0,0 +1,65 @@
+package types
+
+import (
+	"bytes"
+	"crypto/sha256"
+)
+
+const (
+	// SegmentRootLength is the length of the merkle root and of the proof hashes of file segments
+	SegmentRootLength = sha256.Size
+	// MaxSegmentSize is the max size of a file segment, so that a challenged segment fits in the tx answering it
+	MaxSegmentSize = 64 * 1024
+)
+
+var (
+	segmentLeafPrefix = []byte{0x00}
+	segmentNodePrefix = []byte{0x01}
+)
+
+// GetSegmentProofDepth returns the number of hashes in the proof of a segment.
+// The segment tree duplicates the last node of a level holding an odd number of nodes,
+// so every segment of a file has a proof of the same depth.
+func GetSegmentProofDepth(segmentCount uint64) int {
+	depth := 0
+	for width := uint64(1); width < segmentCount; width <<= 1 {
+		depth++
+	}
+	return depth
+}
+
+// HashSegmentLeaf returns the leaf hash of a segment in the segment tree
+func HashSegmentLeaf(segment []byte) []byte {
+	hash := sha256.Sum256(append(append([]byte{}, segmentLeafPrefix...), segment...))
+	return hash[:]
+}
+
+// HashSegmentNode returns the hash of an inner node of the segment tree
+func HashSegmentNode(left, right []byte) []byte {
+	data := make([]byte, 0, len(segmentNodePrefix)+len(left)+len(right))
+	data = append(data, segmentNodePrefix...)
+	data = append(data, left...)
+	data = append(data, right...)
+	hash := sha256.Sum256(data)
+	return hash[:]
+}
+
+// VerifySegmentProof verifies the merkle inclusion proof of the segment at segmentIndex against the segment root.
+// The proof lists the sibling hashes from the leaf up to the root.
+func VerifySegmentProof(segmentRoot []byte, segmentCount, segmentIndex uint64, segment []byte, proof [][]byte) bool {
+	if segmentIndex >= segmentCount || len(proof) != GetSegmentProofDepth(segmentCount) {
+		return false
+	}
+
+	hash := HashSegmentLeaf(segment)
+	index := segmentIndex
+	for _, sibling := range proof {
+		if index%2 == 0 {
+			hash = HashSegmentNode(hash, sibling)
+		} else {
+			hash = HashSegmentNode(sibling, hash)
+		}
+		index /= 2
+	}
+	return bytes.Equal(hash, segmentRoot)
+}
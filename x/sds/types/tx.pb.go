@@ -4,6 +4,7 @@
 package types
 
 import (
+	bytes "bytes"
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
@@ -38,6 +39,17 @@ type MsgFileUpload struct {
 	From     string `protobuf:"bytes,2,opt,name=from,proto3" json:"from" yaml:"from"`
 	Reporter string `protobuf:"bytes,3,opt,name=reporter,proto3" json:"reporter" yaml:"reporter"`
 	Uploader string `protobuf:"bytes,4,opt,name=uploader,proto3" json:"uploader" yaml:"uploader"`
+	// segment_root is the merkle root of the file segments, used to verify storage proofs
+	SegmentRoot  []byte   `protobuf:"bytes,5,opt,name=segment_root,json=segmentRoot,proto3" json:"segment_root" yaml:"segment_root"`
+	SegmentCount uint64   `protobuf:"varint,6,opt,name=segment_count,json=segmentCount,proto3" json:"segment_count" yaml:"segment_count"`
+	StorageNodes []string `protobuf:"bytes,7,rep,name=storage_nodes,json=storageNodes,proto3" json:"storage_nodes" yaml:"storage_nodes"`
+	// rent_amount is the optional amount of noz locked as storage rent when the file is first uploaded. It is taken from
+	// the noz ledger balance of uploader through the noz allowance approved by uploader to from
+	RentAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,8,opt,name=rent_amount,json=rentAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"rent_amount" yaml:"rent_amount"`
+	// rent_duration is the number of pot epochs over which the storage rent is paid out
+	RentDuration int64 `protobuf:"varint,9,opt,name=rent_duration,json=rentDuration,proto3" json:"rent_duration" yaml:"rent_duration"`
+	// rent_replicas is the number of resource nodes paid for storing the file
+	RentReplicas uint32 `protobuf:"varint,10,opt,name=rent_replicas,json=rentReplicas,proto3" json:"rent_replicas" yaml:"rent_replicas"`
 }
 
 func (m *MsgFileUpload) Reset()         { *m = MsgFileUpload{} }
@@ -101,6 +113,41 @@ func (m *MsgFileUpload) GetUploader() string {
 	return ""
 }
 
+func (m *MsgFileUpload) GetSegmentRoot() []byte {
+	if m != nil {
+		return m.SegmentRoot
+	}
+	return nil
+}
+
+func (m *MsgFileUpload) GetSegmentCount() uint64 {
+	if m != nil {
+		return m.SegmentCount
+	}
+	return 0
+}
+
+func (m *MsgFileUpload) GetStorageNodes() []string {
+	if m != nil {
+		return m.StorageNodes
+	}
+	return nil
+}
+
+func (m *MsgFileUpload) GetRentDuration() int64 {
+	if m != nil {
+		return m.RentDuration
+	}
+	return 0
+}
+
+func (m *MsgFileUpload) GetRentReplicas() uint32 {
+	if m != nil {
+		return m.RentReplicas
+	}
+	return 0
+}
+
 type MsgFileUploadResponse struct {
 }
 
@@ -141,6 +188,10 @@ type MsgPrepay struct {
 	Sender      string                                   `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender" yaml:"sender"`
 	Beneficiary string                                   `protobuf:"bytes,2,opt,name=beneficiary,proto3" json:"beneficiary" yaml:"beneficiary"`
 	Amount      github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount" yaml:"amount"`
+	// min_noz_out is the minimum amount of noz the prepay must purchase, zero means no limit
+	MinNozOut github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=min_noz_out,json=minNozOut,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_noz_out" yaml:"min_noz_out"`
+	// deadline_height is the last block height at which the prepay can be executed, zero means no deadline
+	DeadlineHeight int64 `protobuf:"varint,5,opt,name=deadline_height,json=deadlineHeight,proto3" json:"deadline_height" yaml:"deadline_height"`
 }
 
 func (m *MsgPrepay) Reset()         { *m = MsgPrepay{} }
@@ -197,6 +248,13 @@ func (m *MsgPrepay) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
 	return nil
 }
 
+func (m *MsgPrepay) GetDeadlineHeight() int64 {
+	if m != nil {
+		return m.DeadlineHeight
+	}
+	return 0
+}
+
 type MsgPrepayResponse struct {
 }
 
@@ -233,27 +291,30 @@ func (m *MsgPrepayResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgPrepayResponse proto.InternalMessageInfo
 
-// MsgUpdateParams defines a Msg for updating the x/sds module parameters.
-type MsgUpdateParams struct {
-	// authority is the address of the governance account.
-	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	// params defines the x/sds parameters to update.
-	// NOTE: All parameters must be supplied.
-	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+type MsgCreatePrepaySubscription struct {
+	Sender      string                                   `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender" yaml:"sender"`
+	Beneficiary string                                   `protobuf:"bytes,2,opt,name=beneficiary,proto3" json:"beneficiary" yaml:"beneficiary"`
+	Amount      github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount" yaml:"amount"`
+	// interval is the number of blocks between two executions
+	Interval int64 `protobuf:"varint,4,opt,name=interval,proto3" json:"interval" yaml:"interval"`
+	// max_count is the max number of successful executions, zero means unlimited
+	MaxCount uint64 `protobuf:"varint,5,opt,name=max_count,json=maxCount,proto3" json:"max_count" yaml:"max_count"`
+	// end_height is the last height at which the subscription can be executed, zero means no end height
+	EndHeight int64 `protobuf:"varint,6,opt,name=end_height,json=endHeight,proto3" json:"end_height" yaml:"end_height"`
 }
 
-func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
-func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateParams) ProtoMessage()    {}
-func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+func (m *MsgCreatePrepaySubscription) Reset()         { *m = MsgCreatePrepaySubscription{} }
+func (m *MsgCreatePrepaySubscription) String() string { return proto.CompactTextString(m) }
+func (*MsgCreatePrepaySubscription) ProtoMessage()    {}
+func (*MsgCreatePrepaySubscription) Descriptor() ([]byte, []int) {
 	return fileDescriptor_a5a216e2f9435b27, []int{4}
 }
-func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+func (m *MsgCreatePrepaySubscription) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCreatePrepaySubscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCreatePrepaySubscription.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -263,49 +324,76 @@ func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+func (m *MsgCreatePrepaySubscription) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreatePrepaySubscription.Merge(m, src)
 }
-func (m *MsgUpdateParams) XXX_Size() int {
+func (m *MsgCreatePrepaySubscription) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateParams) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+func (m *MsgCreatePrepaySubscription) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreatePrepaySubscription.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+var xxx_messageInfo_MsgCreatePrepaySubscription proto.InternalMessageInfo
 
-func (m *MsgUpdateParams) GetAuthority() string {
+func (m *MsgCreatePrepaySubscription) GetSender() string {
 	if m != nil {
-		return m.Authority
+		return m.Sender
 	}
 	return ""
 }
 
-func (m *MsgUpdateParams) GetParams() Params {
+func (m *MsgCreatePrepaySubscription) GetBeneficiary() string {
 	if m != nil {
-		return m.Params
+		return m.Beneficiary
 	}
-	return Params{}
+	return ""
 }
 
-// MsgUpdateParamsResponse defines the response structure for executing a
-// MsgUpdateParams message.
-type MsgUpdateParamsResponse struct {
+func (m *MsgCreatePrepaySubscription) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
 }
 
-func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
-func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateParamsResponse) ProtoMessage()    {}
-func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+func (m *MsgCreatePrepaySubscription) GetInterval() int64 {
+	if m != nil {
+		return m.Interval
+	}
+	return 0
+}
+
+func (m *MsgCreatePrepaySubscription) GetMaxCount() uint64 {
+	if m != nil {
+		return m.MaxCount
+	}
+	return 0
+}
+
+func (m *MsgCreatePrepaySubscription) GetEndHeight() int64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+type MsgCreatePrepaySubscriptionResponse struct {
+	SubscriptionId uint64 `protobuf:"varint,1,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+}
+
+func (m *MsgCreatePrepaySubscriptionResponse) Reset()         { *m = MsgCreatePrepaySubscriptionResponse{} }
+func (m *MsgCreatePrepaySubscriptionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreatePrepaySubscriptionResponse) ProtoMessage()    {}
+func (*MsgCreatePrepaySubscriptionResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_a5a216e2f9435b27, []int{5}
 }
-func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgCreatePrepaySubscriptionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCreatePrepaySubscriptionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCreatePrepaySubscriptionResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -315,717 +403,6710 @@ func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+func (m *MsgCreatePrepaySubscriptionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreatePrepaySubscriptionResponse.Merge(m, src)
 }
-func (m *MsgUpdateParamsResponse) XXX_Size() int {
+func (m *MsgCreatePrepaySubscriptionResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+func (m *MsgCreatePrepaySubscriptionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreatePrepaySubscriptionResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgCreatePrepaySubscriptionResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgFileUpload)(nil), "stratos.sds.v1.MsgFileUpload")
-	proto.RegisterType((*MsgFileUploadResponse)(nil), "stratos.sds.v1.MsgFileUploadResponse")
-	proto.RegisterType((*MsgPrepay)(nil), "stratos.sds.v1.MsgPrepay")
-	proto.RegisterType((*MsgPrepayResponse)(nil), "stratos.sds.v1.MsgPrepayResponse")
-	proto.RegisterType((*MsgUpdateParams)(nil), "stratos.sds.v1.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "stratos.sds.v1.MsgUpdateParamsResponse")
+func (m *MsgCreatePrepaySubscriptionResponse) GetSubscriptionId() uint64 {
+	if m != nil {
+		return m.SubscriptionId
+	}
+	return 0
 }
 
-func init() { proto.RegisterFile("stratos/sds/v1/tx.proto", fileDescriptor_a5a216e2f9435b27) }
-
-var fileDescriptor_a5a216e2f9435b27 = []byte{
-	// 726 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x54, 0x4d, 0x6b, 0x13, 0x5d,
-	0x14, 0xce, 0x24, 0x7d, 0x43, 0x73, 0xf3, 0xd6, 0xda, 0x69, 0x6b, 0x3e, 0xa0, 0x33, 0xed, 0x88,
-	0x58, 0x0a, 0x9d, 0x31, 0x2d, 0x28, 0x06, 0x14, 0x4c, 0x41, 0x0a, 0x12, 0x2c, 0x29, 0x05, 0xd1,
-	0x45, 0xb9, 0xc9, 0xdc, 0x4e, 0x06, 0x33, 0x73, 0x87, 0xb9, 0x37, 0xa5, 0xd9, 0x89, 0xee, 0x5c,
-	0xb9, 0xf0, 0x47, 0x14, 0x57, 0x5d, 0x88, 0xbf, 0xa1, 0xcb, 0xe2, 0xca, 0xd5, 0x28, 0xc9, 0xa2,
-	0x90, 0x65, 0x7e, 0x81, 0xdc, 0x8f, 0xc9, 0x17, 0x4d, 0xdd, 0xb4, 0xf7, 0x3e, 0xcf, 0x39, 0xcf,
-	0x9c, 0xf3, 0x9c, 0x73, 0x03, 0x72, 0x84, 0x86, 0x90, 0x62, 0x62, 0x11, 0x9b, 0x58, 0xa7, 0x25,
-	0x8b, 0x9e, 0x99, 0x41, 0x88, 0x29, 0x56, 0xef, 0x48, 0xc2, 0x24, 0x36, 0x31, 0x4f, 0x4b, 0xc5,
-	0x15, 0x07, 0x3b, 0x98, 0x53, 0x16, 0x3b, 0x89, 0xa8, 0x62, 0xa1, 0x81, 0x89, 0x87, 0xc9, 0xb1,
-	0x20, 0xc4, 0x45, 0x52, 0x4b, 0xd0, 0x73, 0x7d, 0x6c, 0xf1, 0xbf, 0x12, 0xca, 0x89, 0x00, 0xcb,
-	0x23, 0x0e, 0xfb, 0x96, 0x47, 0x1c, 0x49, 0x68, 0x92, 0xa8, 0x43, 0x82, 0xac, 0xd3, 0x52, 0x1d,
-	0x51, 0x58, 0xb2, 0x1a, 0xd8, 0xf5, 0x25, 0x9f, 0x9f, 0xaa, 0x92, 0xd5, 0xc4, 0x19, 0xa3, 0x97,
-	0x04, 0x0b, 0x55, 0xe2, 0xbc, 0x74, 0x5b, 0xe8, 0x28, 0x68, 0x61, 0x68, 0xab, 0xcf, 0x41, 0xe6,
-	0xc4, 0x6d, 0xa1, 0xe3, 0x26, 0x24, 0xcd, 0xbc, 0xb2, 0xae, 0x6c, 0x66, 0x2a, 0x1b, 0xfd, 0x48,
-	0x1f, 0x81, 0x83, 0x48, 0xbf, 0xdb, 0x81, 0x5e, 0xab, 0x6c, 0x0c, 0x21, 0xa3, 0x36, 0xcf, 0xce,
-	0xfb, 0x90, 0x34, 0xd5, 0x3d, 0x30, 0x77, 0x12, 0x62, 0x2f, 0x9f, 0xe4, 0xa9, 0x56, 0x3f, 0xd2,
-	0xf9, 0x7d, 0x10, 0xe9, 0x59, 0x99, 0x15, 0x62, 0xcf, 0xf8, 0xf9, 0x7d, 0x7b, 0x45, 0xb6, 0xfb,
-	0xc2, 0xb6, 0x43, 0x44, 0xc8, 0x21, 0x0d, 0x5d, 0xdf, 0xa9, 0xf1, 0x60, 0xf5, 0x10, 0xcc, 0x87,
-	0x28, 0xc0, 0x21, 0x45, 0x61, 0x3e, 0xc5, 0x85, 0x9e, 0xf4, 0x23, 0x7d, 0x88, 0x0d, 0x22, 0x7d,
-	0x51, 0x88, 0xc5, 0xc8, 0x6c, 0xc1, 0x61, 0x12, 0x13, 0x6d, 0xf3, 0x1e, 0x51, 0x98, 0x9f, 0x1b,
-	0x89, 0xc6, 0xd8, 0x48, 0x34, 0x46, 0x6e, 0x11, 0x8d, 0x43, 0xca, 0xf7, 0x3f, 0x5e, 0x5f, 0x6c,
-	0xf1, 0xa2, 0x3f, 0x5f, 0x5f, 0x6c, 0xad, 0xc6, 0x46, 0x4f, 0x78, 0x6a, 0xe4, 0xc0, 0xea, 0x04,
-	0x50, 0x43, 0x24, 0xc0, 0x3e, 0x41, 0x46, 0x37, 0x09, 0x32, 0x55, 0xe2, 0x1c, 0x84, 0x28, 0x80,
-	0x1d, 0xf5, 0x15, 0x48, 0x13, 0xe4, 0xb3, 0xf2, 0x84, 0xef, 0xbb, 0xfd, 0x48, 0x97, 0xc8, 0x20,
-	0xd2, 0x17, 0x44, 0x71, 0xe2, 0x3e, 0xbb, 0x34, 0x99, 0xa0, 0x1e, 0x83, 0x6c, 0x1d, 0xf9, 0xe8,
-	0xc4, 0x6d, 0xb8, 0x30, 0xec, 0xc8, 0x71, 0x3c, 0xeb, 0x47, 0xfa, 0x38, 0x3c, 0x88, 0x74, 0x55,
-	0xc8, 0x8e, 0x81, 0xb3, 0xb5, 0xc7, 0x53, 0xd5, 0x4f, 0x0a, 0x48, 0x43, 0x0f, 0xb7, 0x7d, 0x9a,
-	0x4f, 0xad, 0xa7, 0x36, 0xb3, 0x3b, 0x05, 0x53, 0x26, 0xb1, 0x35, 0x34, 0xe5, 0x1a, 0x9a, 0x7b,
-	0xd8, 0xf5, 0x2b, 0x07, 0x97, 0x91, 0x9e, 0x60, 0xdd, 0x88, 0x84, 0x51, 0x37, 0xe2, 0x6e, 0x7c,
-	0xfb, 0xad, 0x6f, 0x3a, 0x2e, 0x6d, 0xb6, 0xeb, 0x66, 0x03, 0x7b, 0xf2, 0x21, 0xc8, 0x7f, 0xdb,
-	0xc4, 0x7e, 0x6f, 0xd1, 0x4e, 0x80, 0x08, 0xd7, 0x22, 0xe7, 0xd7, 0x17, 0x5b, 0x4a, 0x4d, 0x2a,
-	0x95, 0x37, 0x98, 0xff, 0xb2, 0x67, 0x36, 0x81, 0xa5, 0xb1, 0x09, 0x08, 0x5b, 0x8d, 0x65, 0xb0,
-	0x34, 0xbc, 0x0c, 0x9d, 0xff, 0xa1, 0x80, 0xc5, 0x2a, 0x71, 0x8e, 0x02, 0x1b, 0x52, 0x74, 0x00,
-	0x43, 0xe8, 0x11, 0xf5, 0x31, 0xc8, 0xc0, 0x36, 0x6d, 0xe2, 0xd0, 0xa5, 0x1d, 0x39, 0x82, 0xfc,
-	0x4c, 0x2f, 0x46, 0xa1, 0xea, 0x53, 0x90, 0x0e, 0xb8, 0x02, 0x77, 0x39, 0xbb, 0x73, 0xcf, 0x9c,
-	0x7c, 0xfc, 0xa6, 0xd0, 0xaf, 0x64, 0x98, 0x0b, 0xb2, 0x7c, 0x91, 0x50, 0x7e, 0xc4, 0xca, 0x1f,
-	0x49, 0xb1, 0x0e, 0xd6, 0xe2, 0x0e, 0xce, 0xf8, 0x73, 0x9d, 0x2a, 0xd2, 0x28, 0x80, 0xdc, 0x14,
-	0x14, 0xf7, 0xb4, 0xf3, 0x35, 0x09, 0x52, 0x55, 0xe2, 0xa8, 0xef, 0xc0, 0xf2, 0x3e, 0xf4, 0xed,
-	0x16, 0x9a, 0x7c, 0xd9, 0x6b, 0xd3, 0x65, 0x4d, 0xd0, 0xc5, 0x07, 0xb7, 0xd2, 0xf1, 0x47, 0xd4,
-	0xd7, 0x60, 0x71, 0x28, 0x2e, 0xf7, 0xb6, 0x70, 0x43, 0xa6, 0xa0, 0x8a, 0x1b, 0x33, 0xa9, 0xa1,
-	0xe0, 0x1b, 0xf0, 0xff, 0xc4, 0x14, 0xf4, 0x1b, 0x52, 0xc6, 0x03, 0x8a, 0x0f, 0xff, 0x11, 0x10,
-	0x2b, 0x17, 0xff, 0xfb, 0xc0, 0xbc, 0xae, 0x54, 0xcf, 0xbb, 0x9a, 0x72, 0xd9, 0xd5, 0x94, 0xab,
-	0xae, 0xa6, 0xfc, 0xe9, 0x6a, 0xca, 0x97, 0x9e, 0x96, 0xb8, 0xea, 0x69, 0x89, 0x5f, 0x3d, 0x2d,
-	0xf1, 0xd6, 0x1a, 0xdb, 0x3b, 0xa9, 0xeb, 0x23, 0x1a, 0x1f, 0xb7, 0x1b, 0x4d, 0xe8, 0xfa, 0x72,
-	0x14, 0x7c, 0x09, 0xeb, 0x69, 0xfe, 0xcb, 0xb9, 0xfb, 0x37, 0x00, 0x00, 0xff, 0xff, 0xe1, 0x17,
-	0x46, 0x4e, 0xfb, 0x05, 0x00, 0x00,
+type MsgCancelPrepaySubscription struct {
+	Sender         string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender" yaml:"sender"`
+	SubscriptionId uint64 `protobuf:"varint,2,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id" yaml:"subscription_id"`
 }
 
-func (this *MsgFileUpload) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
-
-	that1, ok := that.(*MsgFileUpload)
-	if !ok {
-		that2, ok := that.(MsgFileUpload)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+func (m *MsgCancelPrepaySubscription) Reset()         { *m = MsgCancelPrepaySubscription{} }
+func (m *MsgCancelPrepaySubscription) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelPrepaySubscription) ProtoMessage()    {}
+func (*MsgCancelPrepaySubscription) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5a216e2f9435b27, []int{6}
+}
+func (m *MsgCancelPrepaySubscription) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelPrepaySubscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelPrepaySubscription.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.FileHash != that1.FileHash {
-		return false
-	}
-	if this.From != that1.From {
-		return false
-	}
-	if this.Reporter != that1.Reporter {
-		return false
-	}
-	if this.Uploader != that1.Uploader {
-		return false
+}
+func (m *MsgCancelPrepaySubscription) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelPrepaySubscription.Merge(m, src)
+}
+func (m *MsgCancelPrepaySubscription) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelPrepaySubscription) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelPrepaySubscription.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelPrepaySubscription proto.InternalMessageInfo
+
+func (m *MsgCancelPrepaySubscription) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return true
+	return ""
 }
-func (this *MsgFileUploadResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *MsgCancelPrepaySubscription) GetSubscriptionId() uint64 {
+	if m != nil {
+		return m.SubscriptionId
 	}
+	return 0
+}
 
-	that1, ok := that.(*MsgFileUploadResponse)
-	if !ok {
-		that2, ok := that.(MsgFileUploadResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type MsgCancelPrepaySubscriptionResponse struct {
+}
+
+func (m *MsgCancelPrepaySubscriptionResponse) Reset()         { *m = MsgCancelPrepaySubscriptionResponse{} }
+func (m *MsgCancelPrepaySubscriptionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelPrepaySubscriptionResponse) ProtoMessage()    {}
+func (*MsgCancelPrepaySubscriptionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5a216e2f9435b27, []int{7}
+}
+func (m *MsgCancelPrepaySubscriptionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelPrepaySubscriptionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelPrepaySubscriptionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	return true
 }
-func (this *MsgPrepay) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *MsgCancelPrepaySubscriptionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelPrepaySubscriptionResponse.Merge(m, src)
+}
+func (m *MsgCancelPrepaySubscriptionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelPrepaySubscriptionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelPrepaySubscriptionResponse.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*MsgPrepay)
-	if !ok {
-		that2, ok := that.(MsgPrepay)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+var xxx_messageInfo_MsgCancelPrepaySubscriptionResponse proto.InternalMessageInfo
+
+type MsgTransferNoz struct {
+	Sender    string                                 `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender" yaml:"sender"`
+	Recipient string                                 `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient" yaml:"recipient"`
+	Amount    github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount" yaml:"amount"`
+}
+
+func (m *MsgTransferNoz) Reset()         { *m = MsgTransferNoz{} }
+func (m *MsgTransferNoz) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferNoz) ProtoMessage()    {}
+func (*MsgTransferNoz) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5a216e2f9435b27, []int{8}
+}
+func (m *MsgTransferNoz) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferNoz) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferNoz.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Sender != that1.Sender {
-		return false
-	}
-	if this.Beneficiary != that1.Beneficiary {
-		return false
+}
+func (m *MsgTransferNoz) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferNoz.Merge(m, src)
+}
+func (m *MsgTransferNoz) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferNoz) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferNoz.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTransferNoz proto.InternalMessageInfo
+
+func (m *MsgTransferNoz) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	if len(this.Amount) != len(that1.Amount) {
-		return false
+	return ""
+}
+
+func (m *MsgTransferNoz) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
 	}
-	for i := range this.Amount {
-		if !this.Amount[i].Equal(&that1.Amount[i]) {
-			return false
+	return ""
+}
+
+type MsgTransferNozResponse struct {
+}
+
+func (m *MsgTransferNozResponse) Reset()         { *m = MsgTransferNozResponse{} }
+func (m *MsgTransferNozResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferNozResponse) ProtoMessage()    {}
+func (*MsgTransferNozResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5a216e2f9435b27, []int{9}
+}
+func (m *MsgTransferNozResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferNozResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferNozResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return true
 }
-func (this *MsgPrepayResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *MsgTransferNozResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferNozResponse.Merge(m, src)
+}
+func (m *MsgTransferNozResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferNozResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferNozResponse.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*MsgPrepayResponse)
-	if !ok {
-		that2, ok := that.(MsgPrepayResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	return true
+var xxx_messageInfo_MsgTransferNozResponse proto.InternalMessageInfo
+
+type MsgApproveNoz struct {
+	Owner   string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner" yaml:"owner"`
+	Spender string `protobuf:"bytes,2,opt,name=spender,proto3" json:"spender" yaml:"spender"`
+	// amount replaces the current allowance of the spender
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount" yaml:"amount"`
 }
-func (this *MsgUpdateParams) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*MsgUpdateParams)
-	if !ok {
-		that2, ok := that.(MsgUpdateParams)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+func (m *MsgApproveNoz) Reset()         { *m = MsgApproveNoz{} }
+func (m *MsgApproveNoz) String() string { return proto.CompactTextString(m) }
+func (*MsgApproveNoz) ProtoMessage()    {}
+func (*MsgApproveNoz) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5a216e2f9435b27, []int{10}
+}
+func (m *MsgApproveNoz) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgApproveNoz) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgApproveNoz.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Authority != that1.Authority {
-		return false
-	}
-	if !this.Params.Equal(&that1.Params) {
-		return false
+}
+func (m *MsgApproveNoz) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgApproveNoz.Merge(m, src)
+}
+func (m *MsgApproveNoz) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgApproveNoz) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgApproveNoz.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgApproveNoz proto.InternalMessageInfo
+
+func (m *MsgApproveNoz) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	return true
+	return ""
 }
-func (this *MsgUpdateParamsResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *MsgApproveNoz) GetSpender() string {
+	if m != nil {
+		return m.Spender
 	}
+	return ""
+}
 
-	that1, ok := that.(*MsgUpdateParamsResponse)
-	if !ok {
-		that2, ok := that.(MsgUpdateParamsResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type MsgApproveNozResponse struct {
+}
+
+func (m *MsgApproveNozResponse) Reset()         { *m = MsgApproveNozResponse{} }
+func (m *MsgApproveNozResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgApproveNozResponse) ProtoMessage()    {}
+func (*MsgApproveNozResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5a216e2f9435b27, []int{11}
+}
+func (m *MsgApproveNozResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgApproveNozResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgApproveNozResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	return true
+}
+func (m *MsgApproveNozResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgApproveNozResponse.Merge(m, src)
+}
+func (m *MsgApproveNozResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgApproveNozResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgApproveNozResponse.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_MsgApproveNozResponse proto.InternalMessageInfo
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// HandleMsgFileUpload defines a method for file uploading
-	HandleMsgFileUpload(ctx context.Context, in *MsgFileUpload, opts ...grpc.CallOption) (*MsgFileUploadResponse, error)
-	// HandleMsgPrepay defines a method for prepay
-	HandleMsgPrepay(ctx context.Context, in *MsgPrepay, opts ...grpc.CallOption) (*MsgPrepayResponse, error)
-	// UpdateParams defined a governance operation for updating the x/sds module parameters.
-	// The authority is hard-coded to the Cosmos SDK x/gov module account
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+type MsgTransferNozFrom struct {
+	Spender   string                                 `protobuf:"bytes,1,opt,name=spender,proto3" json:"spender" yaml:"spender"`
+	Owner     string                                 `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner" yaml:"owner"`
+	Recipient string                                 `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient" yaml:"recipient"`
+	Amount    github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount" yaml:"amount"`
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgTransferNozFrom) Reset()         { *m = MsgTransferNozFrom{} }
+func (m *MsgTransferNozFrom) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferNozFrom) ProtoMessage()    {}
+func (*MsgTransferNozFrom) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5a216e2f9435b27, []int{12}
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgTransferNozFrom) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *msgClient) HandleMsgFileUpload(ctx context.Context, in *MsgFileUpload, opts ...grpc.CallOption) (*MsgFileUploadResponse, error) {
-	out := new(MsgFileUploadResponse)
-	err := c.cc.Invoke(ctx, "/stratos.sds.v1.Msg/HandleMsgFileUpload", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgTransferNozFrom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferNozFrom.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgTransferNozFrom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferNozFrom.Merge(m, src)
+}
+func (m *MsgTransferNozFrom) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferNozFrom) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferNozFrom.DiscardUnknown(m)
 }
 
-func (c *msgClient) HandleMsgPrepay(ctx context.Context, in *MsgPrepay, opts ...grpc.CallOption) (*MsgPrepayResponse, error) {
-	out := new(MsgPrepayResponse)
-	err := c.cc.Invoke(ctx, "/stratos.sds.v1.Msg/HandleMsgPrepay", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgTransferNozFrom proto.InternalMessageInfo
+
+func (m *MsgTransferNozFrom) GetSpender() string {
+	if m != nil {
+		return m.Spender
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/stratos.sds.v1.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgTransferNozFrom) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	return out, nil
+	return ""
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// HandleMsgFileUpload defines a method for file uploading
-	HandleMsgFileUpload(context.Context, *MsgFileUpload) (*MsgFileUploadResponse, error)
-	// HandleMsgPrepay defines a method for prepay
-	HandleMsgPrepay(context.Context, *MsgPrepay) (*MsgPrepayResponse, error)
-	// UpdateParams defined a governance operation for updating the x/sds module parameters.
-	// The authority is hard-coded to the Cosmos SDK x/gov module account
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+func (m *MsgTransferNozFrom) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+type MsgTransferNozFromResponse struct {
 }
 
-func (*UnimplementedMsgServer) HandleMsgFileUpload(ctx context.Context, req *MsgFileUpload) (*MsgFileUploadResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method HandleMsgFileUpload not implemented")
+func (m *MsgTransferNozFromResponse) Reset()         { *m = MsgTransferNozFromResponse{} }
+func (m *MsgTransferNozFromResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferNozFromResponse) ProtoMessage()    {}
+func (*MsgTransferNozFromResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5a216e2f9435b27, []int{13}
 }
-func (*UnimplementedMsgServer) HandleMsgPrepay(ctx context.Context, req *MsgPrepay) (*MsgPrepayResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method HandleMsgPrepay not implemented")
+func (m *MsgTransferNozFromResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+func (m *MsgTransferNozFromResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferNozFromResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTransferNozFromResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferNozFromResponse.Merge(m, src)
+}
+func (m *MsgTransferNozFromResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferNozFromResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferNozFromResponse.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+var xxx_messageInfo_MsgTransferNozFromResponse proto.InternalMessageInfo
+
+// MsgGrantFileAccess grants the read access to a file to the grantee. A positive fee is paid by the grantee in two
+// steps: the grantee first approves at least the fee to the uploader with MsgApproveNoz, then the uploader sends
+// MsgGrantFileAccess, which transfers the fee from the grantee through that noz allowance.
+type MsgGrantFileAccess struct {
+	Granter  string `protobuf:"bytes,1,opt,name=granter,proto3" json:"granter" yaml:"granter"`
+	FileHash string `protobuf:"bytes,2,opt,name=file_hash,json=fileHash,proto3" json:"file_hash" yaml:"file_hash"`
+	Grantee  string `protobuf:"bytes,3,opt,name=grantee,proto3" json:"grantee" yaml:"grantee"`
+	// expiry_height is the height from which the grant is no longer valid, zero means no expiry
+	ExpiryHeight int64 `protobuf:"varint,4,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height" yaml:"expiry_height"`
+	// fee is the amount of noz the grantee pays to the uploader through the noz allowance approved to the uploader,
+	// zero means free
+	Fee github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=fee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"fee" yaml:"fee"`
 }
 
-func _Msg_HandleMsgFileUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgFileUpload)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).HandleMsgFileUpload(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/stratos.sds.v1.Msg/HandleMsgFileUpload",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).HandleMsgFileUpload(ctx, req.(*MsgFileUpload))
+func (m *MsgGrantFileAccess) Reset()         { *m = MsgGrantFileAccess{} }
+func (m *MsgGrantFileAccess) String() string { return proto.CompactTextString(m) }
+func (*MsgGrantFileAccess) ProtoMessage()    {}
+func (*MsgGrantFileAccess) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5a216e2f9435b27, []int{14}
+}
+func (m *MsgGrantFileAccess) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGrantFileAccess) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGrantFileAccess.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgGrantFileAccess) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGrantFileAccess.Merge(m, src)
+}
+func (m *MsgGrantFileAccess) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGrantFileAccess) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGrantFileAccess.DiscardUnknown(m)
 }
 
-func _Msg_HandleMsgPrepay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgPrepay)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_MsgGrantFileAccess proto.InternalMessageInfo
+
+func (m *MsgGrantFileAccess) GetGranter() string {
+	if m != nil {
+		return m.Granter
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).HandleMsgPrepay(ctx, in)
+	return ""
+}
+
+func (m *MsgGrantFileAccess) GetFileHash() string {
+	if m != nil {
+		return m.FileHash
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/stratos.sds.v1.Msg/HandleMsgPrepay",
+	return ""
+}
+
+func (m *MsgGrantFileAccess) GetGrantee() string {
+	if m != nil {
+		return m.Grantee
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).HandleMsgPrepay(ctx, req.(*MsgPrepay))
+	return ""
+}
+
+func (m *MsgGrantFileAccess) GetExpiryHeight() int64 {
+	if m != nil {
+		return m.ExpiryHeight
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/stratos.sds.v1.Msg/UpdateParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
-	}
-	return interceptor(ctx, in, info, handler)
+type MsgGrantFileAccessResponse struct {
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "stratos.sds.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "HandleMsgFileUpload",
-			Handler:    _Msg_HandleMsgFileUpload_Handler,
-		},
-		{
-			MethodName: "HandleMsgPrepay",
-			Handler:    _Msg_HandleMsgPrepay_Handler,
-		},
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "stratos/sds/v1/tx.proto",
+func (m *MsgGrantFileAccessResponse) Reset()         { *m = MsgGrantFileAccessResponse{} }
+func (m *MsgGrantFileAccessResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgGrantFileAccessResponse) ProtoMessage()    {}
+func (*MsgGrantFileAccessResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5a216e2f9435b27, []int{15}
 }
-
-func (m *MsgFileUpload) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgGrantFileAccessResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGrantFileAccessResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGrantFileAccessResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgGrantFileAccessResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGrantFileAccessResponse.Merge(m, src)
+}
+func (m *MsgGrantFileAccessResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGrantFileAccessResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGrantFileAccessResponse.DiscardUnknown(m)
 }
 
-func (m *MsgFileUpload) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgGrantFileAccessResponse proto.InternalMessageInfo
+
+type MsgRevokeFileAccess struct {
+	Granter  string `protobuf:"bytes,1,opt,name=granter,proto3" json:"granter" yaml:"granter"`
+	FileHash string `protobuf:"bytes,2,opt,name=file_hash,json=fileHash,proto3" json:"file_hash" yaml:"file_hash"`
+	Grantee  string `protobuf:"bytes,3,opt,name=grantee,proto3" json:"grantee" yaml:"grantee"`
 }
 
-func (m *MsgFileUpload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Uploader) > 0 {
-		i -= len(m.Uploader)
-		copy(dAtA[i:], m.Uploader)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Uploader)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Reporter) > 0 {
-		i -= len(m.Reporter)
-		copy(dAtA[i:], m.Reporter)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Reporter)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.From) > 0 {
-		i -= len(m.From)
-		copy(dAtA[i:], m.From)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
-		i--
-		dAtA[i] = 0x12
+func (m *MsgRevokeFileAccess) Reset()         { *m = MsgRevokeFileAccess{} }
+func (m *MsgRevokeFileAccess) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeFileAccess) ProtoMessage()    {}
+func (*MsgRevokeFileAccess) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5a216e2f9435b27, []int{16}
+}
+func (m *MsgRevokeFileAccess) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeFileAccess) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeFileAccess.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.FileHash) > 0 {
-		i -= len(m.FileHash)
-		copy(dAtA[i:], m.FileHash)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.FileHash)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgRevokeFileAccess) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeFileAccess.Merge(m, src)
+}
+func (m *MsgRevokeFileAccess) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeFileAccess) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeFileAccess.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokeFileAccess proto.InternalMessageInfo
+
+func (m *MsgRevokeFileAccess) GetGranter() string {
+	if m != nil {
+		return m.Granter
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgFileUploadResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRevokeFileAccess) GetFileHash() string {
+	if m != nil {
+		return m.FileHash
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgFileUploadResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRevokeFileAccess) GetGrantee() string {
+	if m != nil {
+		return m.Grantee
+	}
+	return ""
 }
 
-func (m *MsgFileUploadResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type MsgRevokeFileAccessResponse struct {
 }
 
-func (m *MsgPrepay) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRevokeFileAccessResponse) Reset()         { *m = MsgRevokeFileAccessResponse{} }
+func (m *MsgRevokeFileAccessResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeFileAccessResponse) ProtoMessage()    {}
+func (*MsgRevokeFileAccessResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5a216e2f9435b27, []int{17}
+}
+func (m *MsgRevokeFileAccessResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeFileAccessResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeFileAccessResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgRevokeFileAccessResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeFileAccessResponse.Merge(m, src)
+}
+func (m *MsgRevokeFileAccessResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeFileAccessResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeFileAccessResponse.DiscardUnknown(m)
 }
 
-func (m *MsgPrepay) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgRevokeFileAccessResponse proto.InternalMessageInfo
+
+type MsgSubmitStorageProof struct {
+	From           string `protobuf:"bytes,1,opt,name=from,proto3" json:"from" yaml:"from"`
+	NetworkAddress string `protobuf:"bytes,2,opt,name=network_address,json=networkAddress,proto3" json:"network_address" yaml:"network_address"`
+	ChallengeId    uint64 `protobuf:"varint,3,opt,name=challenge_id,json=challengeId,proto3" json:"challenge_id" yaml:"challenge_id"`
+	// segment is the content of the challenged segment
+	Segment []byte `protobuf:"bytes,4,opt,name=segment,proto3" json:"segment" yaml:"segment"`
+	// proof is the list of sibling hashes from the segment leaf up to the segment root
+	Proof [][]byte `protobuf:"bytes,5,rep,name=proof,proto3" json:"proof" yaml:"proof"`
 }
 
-func (m *MsgPrepay) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Amount) > 0 {
-		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+func (m *MsgSubmitStorageProof) Reset()         { *m = MsgSubmitStorageProof{} }
+func (m *MsgSubmitStorageProof) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitStorageProof) ProtoMessage()    {}
+func (*MsgSubmitStorageProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5a216e2f9435b27, []int{18}
+}
+func (m *MsgSubmitStorageProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitStorageProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitStorageProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Beneficiary) > 0 {
-		i -= len(m.Beneficiary)
-		copy(dAtA[i:], m.Beneficiary)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Beneficiary)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgSubmitStorageProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitStorageProof.Merge(m, src)
+}
+func (m *MsgSubmitStorageProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitStorageProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitStorageProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitStorageProof proto.InternalMessageInfo
+
+func (m *MsgSubmitStorageProof) GetFrom() string {
+	if m != nil {
+		return m.From
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgPrepayResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSubmitStorageProof) GetNetworkAddress() string {
+	if m != nil {
+		return m.NetworkAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgPrepayResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSubmitStorageProof) GetChallengeId() uint64 {
+	if m != nil {
+		return m.ChallengeId
+	}
+	return 0
 }
 
-func (m *MsgPrepayResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgSubmitStorageProof) GetSegment() []byte {
+	if m != nil {
+		return m.Segment
+	}
+	return nil
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSubmitStorageProof) GetProof() [][]byte {
+	if m != nil {
+		return m.Proof
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgSubmitStorageProofResponse struct {
+	Valid bool `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgSubmitStorageProofResponse) Reset()         { *m = MsgSubmitStorageProofResponse{} }
+func (m *MsgSubmitStorageProofResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitStorageProofResponse) ProtoMessage()    {}
+func (*MsgSubmitStorageProofResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5a216e2f9435b27, []int{19}
+}
+func (m *MsgSubmitStorageProofResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitStorageProofResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitStorageProofResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgSubmitStorageProofResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitStorageProofResponse.Merge(m, src)
+}
+func (m *MsgSubmitStorageProofResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitStorageProofResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitStorageProofResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSubmitStorageProofResponse proto.InternalMessageInfo
+
+func (m *MsgSubmitStorageProofResponse) GetValid() bool {
+	if m != nil {
+		return m.Valid
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgRefundNoz struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender" yaml:"sender"`
+	// amount is the amount of noz burned from the noz ledger balance of sender
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount" yaml:"amount"`
+	// nonce is the refund nonce of sender, it prevents the attestation from being replayed
+	Nonce uint64 `protobuf:"varint,3,opt,name=nonce,proto3" json:"nonce" yaml:"nonce"`
+	// BLS_signature is the threshold signature of the meta nodes attesting that amount has not been consumed by traffic
+	BLSSignature BLSSignatureInfo `protobuf:"bytes,4,opt,name=BLS_signature,json=BLSSignature,proto3" json:"bls_signature" yaml:"bls_signature"`
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgRefundNoz) Reset()         { *m = MsgRefundNoz{} }
+func (m *MsgRefundNoz) String() string { return proto.CompactTextString(m) }
+func (*MsgRefundNoz) ProtoMessage()    {}
+func (*MsgRefundNoz) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5a216e2f9435b27, []int{20}
 }
-
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgRefundNoz) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRefundNoz) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRefundNoz.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	dAtA[offset] = uint8(v)
-	return base
 }
-func (m *MsgFileUpload) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRefundNoz) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRefundNoz.Merge(m, src)
+}
+func (m *MsgRefundNoz) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRefundNoz) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRefundNoz.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRefundNoz proto.InternalMessageInfo
+
+func (m *MsgRefundNoz) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	var l int
-	_ = l
-	l = len(m.FileHash)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+func (m *MsgRefundNoz) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
 	}
-	l = len(m.From)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return 0
+}
+
+func (m *MsgRefundNoz) GetBLSSignature() BLSSignatureInfo {
+	if m != nil {
+		return m.BLSSignature
 	}
-	l = len(m.Reporter)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return BLSSignatureInfo{}
+}
+
+type MsgRefundNozResponse struct {
+	Refunded types.Coin `protobuf:"bytes,1,opt,name=refunded,proto3" json:"refunded"`
+	Fee      types.Coin `protobuf:"bytes,2,opt,name=fee,proto3" json:"fee"`
+}
+
+func (m *MsgRefundNozResponse) Reset()         { *m = MsgRefundNozResponse{} }
+func (m *MsgRefundNozResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRefundNozResponse) ProtoMessage()    {}
+func (*MsgRefundNozResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5a216e2f9435b27, []int{21}
+}
+func (m *MsgRefundNozResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRefundNozResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRefundNozResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.Uploader)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgRefundNozResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRefundNozResponse.Merge(m, src)
+}
+func (m *MsgRefundNozResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRefundNozResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRefundNozResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRefundNozResponse proto.InternalMessageInfo
+
+func (m *MsgRefundNozResponse) GetRefunded() types.Coin {
+	if m != nil {
+		return m.Refunded
 	}
-	return n
+	return types.Coin{}
 }
 
-func (m *MsgFileUploadResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRefundNozResponse) GetFee() types.Coin {
+	if m != nil {
+		return m.Fee
 	}
-	var l int
-	_ = l
-	return n
+	return types.Coin{}
 }
 
-func (m *MsgPrepay) Size() (n int) {
-	if m == nil {
-		return 0
+type MsgDeleteFile struct {
+	Uploader string `protobuf:"bytes,1,opt,name=uploader,proto3" json:"uploader" yaml:"uploader"`
+	FileHash string `protobuf:"bytes,2,opt,name=file_hash,json=fileHash,proto3" json:"file_hash" yaml:"file_hash"`
+}
+
+func (m *MsgDeleteFile) Reset()         { *m = MsgDeleteFile{} }
+func (m *MsgDeleteFile) String() string { return proto.CompactTextString(m) }
+func (*MsgDeleteFile) ProtoMessage()    {}
+func (*MsgDeleteFile) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5a216e2f9435b27, []int{22}
+}
+func (m *MsgDeleteFile) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeleteFile) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeleteFile.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgDeleteFile) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteFile.Merge(m, src)
+}
+func (m *MsgDeleteFile) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeleteFile) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteFile.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDeleteFile proto.InternalMessageInfo
+
+func (m *MsgDeleteFile) GetUploader() string {
+	if m != nil {
+		return m.Uploader
 	}
-	l = len(m.Beneficiary)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+func (m *MsgDeleteFile) GetFileHash() string {
+	if m != nil {
+		return m.FileHash
 	}
-	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+type MsgDeleteFileResponse struct {
+	// refunded_noz is the unspent storage rent credited back to the uploader
+	RefundedNoz github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=refunded_noz,json=refundedNoz,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"refunded_noz"`
+}
+
+func (m *MsgDeleteFileResponse) Reset()         { *m = MsgDeleteFileResponse{} }
+func (m *MsgDeleteFileResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDeleteFileResponse) ProtoMessage()    {}
+func (*MsgDeleteFileResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5a216e2f9435b27, []int{23}
+}
+func (m *MsgDeleteFileResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeleteFileResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeleteFileResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *MsgDeleteFileResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteFileResponse.Merge(m, src)
+}
+func (m *MsgDeleteFileResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeleteFileResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteFileResponse.DiscardUnknown(m)
 }
 
-func (m *MsgPrepayResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+var xxx_messageInfo_MsgDeleteFileResponse proto.InternalMessageInfo
+
+// MsgUpdateParams defines a Msg for updating the x/sds module parameters.
+type MsgUpdateParams struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// params defines the x/sds parameters to update.
+	// NOTE: All parameters must be supplied.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
 }
 
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5a216e2f9435b27, []int{24}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	var l int
-	_ = l
-	return n
+	return ""
 }
 
-func sovTx(x uint64) (n int) {
+func (m *MsgUpdateParams) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+// MsgUpdateParamsResponse defines the response structure for executing a
+// MsgUpdateParams message.
+type MsgUpdateParamsResponse struct {
+}
+
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5a216e2f9435b27, []int{25}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
+type BLSSignatureInfo struct {
+	PubKeys   [][]byte `protobuf:"bytes,1,rep,name=pub_keys,json=pubKeys,proto3" json:"pub_keys" yaml:"pub_keys"`
+	Signature []byte   `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature" yaml:"signature"`
+	TxData    []byte   `protobuf:"bytes,3,opt,name=txData,proto3" json:"tx_data" yaml:"tx_data"`
+}
+
+func (m *BLSSignatureInfo) Reset()         { *m = BLSSignatureInfo{} }
+func (m *BLSSignatureInfo) String() string { return proto.CompactTextString(m) }
+func (*BLSSignatureInfo) ProtoMessage()    {}
+func (*BLSSignatureInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5a216e2f9435b27, []int{26}
+}
+func (m *BLSSignatureInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BLSSignatureInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BLSSignatureInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BLSSignatureInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BLSSignatureInfo.Merge(m, src)
+}
+func (m *BLSSignatureInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *BLSSignatureInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_BLSSignatureInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BLSSignatureInfo proto.InternalMessageInfo
+
+func (m *BLSSignatureInfo) GetPubKeys() [][]byte {
+	if m != nil {
+		return m.PubKeys
+	}
+	return nil
+}
+
+func (m *BLSSignatureInfo) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+func (m *BLSSignatureInfo) GetTxData() []byte {
+	if m != nil {
+		return m.TxData
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*MsgFileUpload)(nil), "stratos.sds.v1.MsgFileUpload")
+	proto.RegisterType((*MsgFileUploadResponse)(nil), "stratos.sds.v1.MsgFileUploadResponse")
+	proto.RegisterType((*MsgPrepay)(nil), "stratos.sds.v1.MsgPrepay")
+	proto.RegisterType((*MsgPrepayResponse)(nil), "stratos.sds.v1.MsgPrepayResponse")
+	proto.RegisterType((*MsgCreatePrepaySubscription)(nil), "stratos.sds.v1.MsgCreatePrepaySubscription")
+	proto.RegisterType((*MsgCreatePrepaySubscriptionResponse)(nil), "stratos.sds.v1.MsgCreatePrepaySubscriptionResponse")
+	proto.RegisterType((*MsgCancelPrepaySubscription)(nil), "stratos.sds.v1.MsgCancelPrepaySubscription")
+	proto.RegisterType((*MsgCancelPrepaySubscriptionResponse)(nil), "stratos.sds.v1.MsgCancelPrepaySubscriptionResponse")
+	proto.RegisterType((*MsgTransferNoz)(nil), "stratos.sds.v1.MsgTransferNoz")
+	proto.RegisterType((*MsgTransferNozResponse)(nil), "stratos.sds.v1.MsgTransferNozResponse")
+	proto.RegisterType((*MsgApproveNoz)(nil), "stratos.sds.v1.MsgApproveNoz")
+	proto.RegisterType((*MsgApproveNozResponse)(nil), "stratos.sds.v1.MsgApproveNozResponse")
+	proto.RegisterType((*MsgTransferNozFrom)(nil), "stratos.sds.v1.MsgTransferNozFrom")
+	proto.RegisterType((*MsgTransferNozFromResponse)(nil), "stratos.sds.v1.MsgTransferNozFromResponse")
+	proto.RegisterType((*MsgGrantFileAccess)(nil), "stratos.sds.v1.MsgGrantFileAccess")
+	proto.RegisterType((*MsgGrantFileAccessResponse)(nil), "stratos.sds.v1.MsgGrantFileAccessResponse")
+	proto.RegisterType((*MsgRevokeFileAccess)(nil), "stratos.sds.v1.MsgRevokeFileAccess")
+	proto.RegisterType((*MsgRevokeFileAccessResponse)(nil), "stratos.sds.v1.MsgRevokeFileAccessResponse")
+	proto.RegisterType((*MsgSubmitStorageProof)(nil), "stratos.sds.v1.MsgSubmitStorageProof")
+	proto.RegisterType((*MsgSubmitStorageProofResponse)(nil), "stratos.sds.v1.MsgSubmitStorageProofResponse")
+	proto.RegisterType((*MsgRefundNoz)(nil), "stratos.sds.v1.MsgRefundNoz")
+	proto.RegisterType((*MsgRefundNozResponse)(nil), "stratos.sds.v1.MsgRefundNozResponse")
+	proto.RegisterType((*MsgDeleteFile)(nil), "stratos.sds.v1.MsgDeleteFile")
+	proto.RegisterType((*MsgDeleteFileResponse)(nil), "stratos.sds.v1.MsgDeleteFileResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "stratos.sds.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "stratos.sds.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*BLSSignatureInfo)(nil), "stratos.sds.v1.BLSSignatureInfo")
+}
+
+func init() { proto.RegisterFile("stratos/sds/v1/tx.proto", fileDescriptor_a5a216e2f9435b27) }
+
+var fileDescriptor_a5a216e2f9435b27 = []byte{
+	// 2166 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0x4d, 0x6c, 0x1c, 0x49,
+	0x15, 0x4e, 0xcf, 0x8c, 0x1d, 0x4f, 0x79, 0x6c, 0x6f, 0x3a, 0x4e, 0x32, 0x9e, 0x6c, 0xdc, 0x4e,
+	0x79, 0x93, 0x18, 0x07, 0xcf, 0xe0, 0x58, 0x61, 0xb5, 0x5e, 0x7e, 0x94, 0x49, 0xb4, 0x1b, 0xb3,
+	0x6b, 0x27, 0xb4, 0xd9, 0x2c, 0x02, 0x89, 0x51, 0xcf, 0x74, 0xb9, 0xdd, 0x64, 0xa6, 0xab, 0xd5,
+	0xd5, 0xe3, 0xf5, 0xe4, 0x04, 0x8b, 0xe0, 0xc0, 0x89, 0x1b, 0x37, 0x6e, 0x48, 0x2b, 0x4e, 0x39,
+	0x20, 0x8e, 0x88, 0x13, 0xec, 0x05, 0xb1, 0x42, 0x1c, 0x56, 0x1c, 0x1a, 0x94, 0x1c, 0x22, 0xcd,
+	0x71, 0x84, 0xc4, 0x15, 0xd5, 0x4f, 0x57, 0x57, 0xb7, 0x67, 0xc6, 0x31, 0xc9, 0xe6, 0x02, 0x97,
+	0x64, 0xea, 0x7b, 0xaf, 0xbe, 0xaa, 0x7e, 0xef, 0xab, 0x57, 0x3f, 0x06, 0x17, 0x48, 0x18, 0x58,
+	0x21, 0x26, 0x35, 0x62, 0x93, 0xda, 0xc1, 0x7a, 0x2d, 0x3c, 0xac, 0xfa, 0x01, 0x0e, 0xb1, 0x3e,
+	0x2b, 0x0c, 0x55, 0x62, 0x93, 0xea, 0xc1, 0x7a, 0x65, 0xde, 0xc1, 0x0e, 0x66, 0xa6, 0x1a, 0xfd,
+	0xc5, 0xbd, 0x2a, 0x0b, 0x2d, 0x4c, 0x3a, 0x98, 0x34, 0xb8, 0x81, 0x37, 0x84, 0xe9, 0x8c, 0xd5,
+	0x71, 0x3d, 0x5c, 0x63, 0xff, 0x0a, 0xe8, 0x02, 0x77, 0xa8, 0x75, 0x88, 0x43, 0xc7, 0xea, 0x10,
+	0x47, 0x18, 0x16, 0x85, 0xa1, 0x69, 0x11, 0x54, 0x3b, 0x58, 0x6f, 0xa2, 0xd0, 0x5a, 0xaf, 0xb5,
+	0xb0, 0xeb, 0x09, 0x7b, 0x39, 0x33, 0x4b, 0x3a, 0x27, 0x66, 0x81, 0xff, 0x9a, 0x04, 0x33, 0xdb,
+	0xc4, 0x79, 0xc7, 0x6d, 0xa3, 0x0f, 0xfc, 0x36, 0xb6, 0x6c, 0xfd, 0x1b, 0xa0, 0xb8, 0xe7, 0xb6,
+	0x51, 0x63, 0xdf, 0x22, 0xfb, 0x65, 0x6d, 0x49, 0x5b, 0x29, 0xd6, 0x2f, 0xf7, 0x23, 0x23, 0x01,
+	0x07, 0x91, 0xf1, 0x5a, 0xcf, 0xea, 0xb4, 0x37, 0xa1, 0x84, 0xa0, 0x39, 0x45, 0x7f, 0xdf, 0xb5,
+	0xc8, 0xbe, 0x7e, 0x1b, 0x14, 0xf6, 0x02, 0xdc, 0x29, 0xe7, 0x58, 0xd7, 0x5a, 0x3f, 0x32, 0x58,
+	0x7b, 0x10, 0x19, 0xd3, 0xa2, 0x57, 0x80, 0x3b, 0xf0, 0xaf, 0xbf, 0x5d, 0x9b, 0x17, 0x9f, 0x7b,
+	0xcb, 0xb6, 0x03, 0x44, 0xc8, 0x6e, 0x18, 0xb8, 0x9e, 0x63, 0x32, 0x67, 0x7d, 0x17, 0x4c, 0x05,
+	0xc8, 0xc7, 0x41, 0x88, 0x82, 0x72, 0x9e, 0x11, 0xbd, 0xd9, 0x8f, 0x0c, 0x89, 0x0d, 0x22, 0x63,
+	0x8e, 0x93, 0xc5, 0xc8, 0x68, 0x42, 0xd9, 0x89, 0x92, 0x76, 0xd9, 0x37, 0xa2, 0xa0, 0x5c, 0x48,
+	0x48, 0x63, 0x2c, 0x21, 0x8d, 0x91, 0x31, 0xa4, 0xb1, 0x8b, 0xfe, 0x2d, 0x50, 0x22, 0xc8, 0xe9,
+	0x20, 0x2f, 0x6c, 0x04, 0x18, 0x87, 0xe5, 0x89, 0x25, 0x6d, 0xa5, 0x54, 0xbf, 0xd6, 0x8f, 0x8c,
+	0x14, 0x3e, 0x88, 0x8c, 0xb3, 0x9c, 0x5c, 0x45, 0xa1, 0x39, 0x2d, 0x9a, 0x26, 0xc6, 0xa1, 0xbe,
+	0x03, 0x66, 0x62, 0x6b, 0x0b, 0x77, 0xbd, 0xb0, 0x3c, 0xb9, 0xa4, 0xad, 0x14, 0xea, 0x5f, 0xea,
+	0x47, 0x46, 0xda, 0x30, 0x88, 0x8c, 0xf9, 0x34, 0x1b, 0x83, 0xa1, 0x19, 0x8f, 0x79, 0x9b, 0x36,
+	0x19, 0x5f, 0x88, 0x03, 0xcb, 0x41, 0x0d, 0x0f, 0xdb, 0x88, 0x94, 0x4f, 0x2f, 0xe5, 0x57, 0x8a,
+	0x82, 0x4f, 0x35, 0x28, 0x7c, 0x2a, 0x4c, 0xf9, 0x78, 0x7b, 0x87, 0x36, 0xf5, 0x8f, 0x35, 0x30,
+	0x1d, 0xd0, 0xd1, 0xac, 0x0e, 0x9b, 0xde, 0x14, 0x0b, 0xa2, 0xf5, 0x69, 0x64, 0x9c, 0xfa, 0x7b,
+	0x64, 0x5c, 0x75, 0xdc, 0x70, 0xbf, 0xdb, 0xac, 0xb6, 0x70, 0x47, 0x28, 0x59, 0xfc, 0xb7, 0x46,
+	0xec, 0x87, 0xb5, 0xb0, 0xe7, 0x23, 0x52, 0xdd, 0xf2, 0xc2, 0x7e, 0x64, 0xa8, 0x24, 0x83, 0xc8,
+	0xd0, 0xe3, 0x54, 0x4a, 0x90, 0x06, 0x1e, 0x88, 0xc0, 0x6f, 0x79, 0xa1, 0x09, 0xa8, 0xed, 0x56,
+	0x27, 0xfe, 0x28, 0xe6, 0x69, 0x77, 0x03, 0x2b, 0x74, 0xb1, 0x57, 0x2e, 0x2e, 0x69, 0x2b, 0x79,
+	0xfe, 0x51, 0x29, 0x43, 0xf2, 0x51, 0x29, 0x18, 0x9a, 0x25, 0xda, 0xbe, 0x23, 0x9a, 0x92, 0x2f,
+	0x40, 0x7e, 0xdb, 0x6d, 0x59, 0xa4, 0x0c, 0x96, 0xb4, 0x95, 0x19, 0x85, 0x2f, 0x36, 0x64, 0xf8,
+	0x62, 0x58, 0xf0, 0x99, 0xa2, 0xb9, 0xb9, 0xfc, 0xf1, 0xb3, 0xc7, 0xab, 0x4c, 0xc5, 0x3f, 0x7f,
+	0xf6, 0x78, 0xf5, 0x5c, 0xbc, 0xf2, 0x52, 0x8b, 0x0c, 0x5e, 0x00, 0xe7, 0x52, 0x80, 0x89, 0x88,
+	0x8f, 0x3d, 0x82, 0xe0, 0xef, 0x0b, 0xa0, 0xb8, 0x4d, 0x9c, 0xfb, 0x01, 0xf2, 0xad, 0x9e, 0xfe,
+	0x1e, 0x98, 0x24, 0xc8, 0xa3, 0x7a, 0xe5, 0x0b, 0x71, 0xa3, 0x1f, 0x19, 0x02, 0x19, 0x44, 0xc6,
+	0x4c, 0x2c, 0x01, 0x6f, 0xac, 0x56, 0x45, 0x07, 0xbd, 0x01, 0xa6, 0x9b, 0xc8, 0x43, 0x7b, 0x6e,
+	0xcb, 0xb5, 0x82, 0x9e, 0x58, 0x9f, 0x5f, 0xa7, 0xe9, 0x50, 0xe0, 0x24, 0x1d, 0x0a, 0x38, 0x9a,
+	0x5b, 0xed, 0xaa, 0xff, 0x44, 0x03, 0x93, 0x42, 0x19, 0xf9, 0xa5, 0xfc, 0xca, 0xf4, 0x8d, 0x85,
+	0xaa, 0xe8, 0x44, 0xeb, 0x52, 0x55, 0xd4, 0xa5, 0xea, 0x6d, 0xec, 0x7a, 0xf5, 0xfb, 0x54, 0x34,
+	0xf4, 0x6b, 0xa4, 0x0a, 0xc4, 0xd7, 0x08, 0x01, 0xfc, 0xe6, 0x1f, 0xc6, 0xca, 0x73, 0xe8, 0x89,
+	0x72, 0x91, 0x4f, 0x9e, 0x3d, 0x5e, 0xd5, 0x4c, 0xc1, 0xa4, 0xff, 0x58, 0x03, 0xd3, 0x1d, 0xd7,
+	0x6b, 0x78, 0xf8, 0x51, 0x03, 0x77, 0xc3, 0x72, 0xe1, 0xbf, 0x15, 0xa9, 0x42, 0x92, 0x44, 0x45,
+	0x01, 0xb3, 0x22, 0x2d, 0x76, 0x5c, 0x6f, 0x07, 0x3f, 0xba, 0xd7, 0x0d, 0xf5, 0x07, 0x60, 0xce,
+	0x46, 0x96, 0xdd, 0x76, 0x3d, 0xd4, 0xd8, 0x47, 0xae, 0xb3, 0xcf, 0xeb, 0x42, 0xbe, 0xbe, 0xd6,
+	0x8f, 0x8c, 0xac, 0x69, 0x10, 0x19, 0xe7, 0x39, 0x79, 0xc6, 0x00, 0xcd, 0xd9, 0x18, 0xb9, 0xcb,
+	0x80, 0xcd, 0xcb, 0x54, 0x5b, 0x22, 0x9f, 0x54, 0x5d, 0x67, 0x14, 0x75, 0x71, 0xc9, 0xc0, 0xb3,
+	0xe0, 0x8c, 0x6c, 0x48, 0x55, 0xfd, 0xa9, 0x00, 0x2e, 0x6e, 0x13, 0xe7, 0x76, 0x80, 0xac, 0x10,
+	0x71, 0xdb, 0x6e, 0xb7, 0x49, 0x5a, 0x81, 0xeb, 0xb3, 0x35, 0xf0, 0x7f, 0x9d, 0x9d, 0x5c, 0x67,
+	0x6f, 0x83, 0x29, 0xd7, 0x0b, 0x51, 0x70, 0x60, 0xb5, 0x99, 0xc6, 0xf2, 0x75, 0x83, 0xee, 0x26,
+	0x31, 0x96, 0xec, 0x26, 0x31, 0x02, 0x4d, 0x69, 0xa4, 0x9b, 0x6c, 0xc7, 0x3a, 0x14, 0x55, 0x7e,
+	0x82, 0x55, 0x79, 0xb6, 0xc9, 0x4a, 0x30, 0xd9, 0x64, 0x25, 0x04, 0xcd, 0xa9, 0x8e, 0x75, 0xc8,
+	0x2b, 0x7b, 0x1d, 0x00, 0xe4, 0xd9, 0xb1, 0xb6, 0x26, 0xd9, 0xf0, 0xcb, 0xfd, 0xc8, 0x50, 0xd0,
+	0x41, 0x64, 0x9c, 0xe1, 0x0c, 0x09, 0x06, 0xcd, 0x22, 0xf2, 0x6c, 0x21, 0xa6, 0x8d, 0x8c, 0x98,
+	0x96, 0x15, 0x31, 0x8d, 0x52, 0x0a, 0xdc, 0x01, 0xcb, 0x63, 0xcc, 0xb1, 0xe0, 0xf4, 0x6b, 0x60,
+	0x8e, 0x28, 0x78, 0xc3, 0xb5, 0x99, 0xb2, 0x0a, 0xe6, 0xac, 0x0a, 0x6f, 0xd9, 0xf0, 0xdf, 0x1a,
+	0x57, 0xa6, 0xe5, 0xb5, 0x50, 0xfb, 0x8b, 0x56, 0xe6, 0x83, 0xa3, 0xb3, 0xca, 0xb1, 0xd8, 0xb3,
+	0x65, 0x99, 0x31, 0x25, 0xcb, 0x32, 0x63, 0x80, 0xd9, 0x8f, 0x18, 0x1f, 0xc9, 0x11, 0x5f, 0x06,
+	0xaf, 0x80, 0xe5, 0x31, 0x66, 0xb9, 0x74, 0xff, 0x92, 0x03, 0xb3, 0xdb, 0xc4, 0xf9, 0x4e, 0x60,
+	0x79, 0x64, 0x0f, 0x05, 0x3b, 0xf8, 0xd1, 0xcb, 0x8d, 0xc9, 0x87, 0xa0, 0x18, 0xa0, 0x96, 0xeb,
+	0xbb, 0xc8, 0x0b, 0xc5, 0x5a, 0x7d, 0x8b, 0x2a, 0x51, 0x82, 0x89, 0x12, 0x25, 0x34, 0x9a, 0x35,
+	0xe9, 0xa6, 0x63, 0x65, 0x91, 0x52, 0xd6, 0x0f, 0x4f, 0x5c, 0x81, 0x47, 0xad, 0xd9, 0x4c, 0xdd,
+	0x15, 0x6e, 0x9b, 0x57, 0x33, 0x59, 0x38, 0xaf, 0x64, 0x41, 0x09, 0x1f, 0x2c, 0x83, 0xf3, 0x69,
+	0x44, 0xc6, 0xfa, 0x0f, 0x39, 0x76, 0x18, 0xbe, 0xe5, 0xfb, 0x01, 0x3e, 0x40, 0x34, 0xd4, 0xef,
+	0x82, 0x09, 0xfc, 0x91, 0x27, 0x23, 0xbd, 0xde, 0x8f, 0x0c, 0x0e, 0x0c, 0x22, 0xa3, 0xc4, 0x27,
+	0xc5, 0x9a, 0xa3, 0x23, 0xc2, 0xdd, 0xf5, 0x7b, 0xe0, 0x34, 0xf1, 0x79, 0xd2, 0x78, 0x90, 0x6f,
+	0xf6, 0x23, 0x23, 0x86, 0x06, 0x91, 0x31, 0x2b, 0xb2, 0xe6, 0x1f, 0x93, 0xb6, 0xb8, 0xcb, 0xab,
+	0x0f, 0xef, 0x1b, 0x34, 0xbc, 0xfc, 0x6b, 0xb2, 0x07, 0x9b, 0x24, 0x60, 0xe2, 0x60, 0x93, 0x00,
+	0x32, 0xb6, 0xbf, 0xce, 0x03, 0x3d, 0x1d, 0xf6, 0x77, 0xe8, 0x41, 0x5f, 0x89, 0x8b, 0xf6, 0x52,
+	0xe2, 0x22, 0x33, 0x96, 0x7b, 0xc1, 0x8c, 0xa5, 0x16, 0x46, 0xfe, 0x0b, 0x59, 0x18, 0x85, 0x57,
+	0x93, 0xb9, 0xeb, 0x34, 0x73, 0x71, 0x80, 0x68, 0xee, 0x2a, 0xc3, 0x57, 0x06, 0x4d, 0x08, 0x7c,
+	0x1d, 0x54, 0x8e, 0xa2, 0x32, 0x8b, 0x9f, 0xf3, 0x2c, 0xbe, 0x1b, 0x58, 0x5e, 0x48, 0x4f, 0xaf,
+	0xb7, 0x5a, 0x2d, 0x44, 0x08, 0xcd, 0xa2, 0x43, 0xa1, 0x74, 0x16, 0x05, 0x94, 0x64, 0x51, 0x00,
+	0x63, 0xb2, 0x28, 0x3c, 0xd2, 0x97, 0xd0, 0xdc, 0xc9, 0x2f, 0xa1, 0x72, 0x42, 0xa8, 0x9c, 0xcf,
+	0x4e, 0x08, 0x65, 0x27, 0x84, 0x8e, 0x9d, 0x10, 0xa2, 0xb7, 0x04, 0x74, 0xe8, 0xbb, 0x41, 0x2f,
+	0xde, 0x73, 0x0b, 0xc9, 0xad, 0x23, 0x65, 0x48, 0x6e, 0x09, 0x29, 0x18, 0x9a, 0x25, 0xde, 0xe6,
+	0x9b, 0xaf, 0xde, 0x02, 0xf9, 0x3d, 0x84, 0xd8, 0xd6, 0x5f, 0xac, 0x7f, 0xfb, 0xc4, 0x0a, 0xa0,
+	0x9d, 0x07, 0x91, 0x01, 0x44, 0x08, 0x10, 0xca, 0xe6, 0x9e, 0x3a, 0x88, 0xc4, 0x8b, 0x98, 0x66,
+	0x13, 0x9f, 0xc9, 0xa1, 0x48, 0x7c, 0x06, 0x4d, 0x96, 0x6f, 0x0e, 0x9c, 0xdd, 0x26, 0x8e, 0x89,
+	0x0e, 0xf0, 0x43, 0xf4, 0xbf, 0x94, 0xf9, 0xcd, 0x2f, 0x67, 0x83, 0x78, 0x51, 0x09, 0x62, 0x36,
+	0x1e, 0xf0, 0x12, 0xb8, 0x38, 0x04, 0x96, 0x61, 0xfc, 0x65, 0x9e, 0xd5, 0xc7, 0xdd, 0x6e, 0xb3,
+	0xe3, 0x86, 0xbb, 0xfc, 0x6e, 0x7d, 0x3f, 0xc0, 0x78, 0x4f, 0x3e, 0x9b, 0x68, 0x2f, 0xf2, 0x6c,
+	0xf2, 0x00, 0xcc, 0x79, 0x28, 0xfc, 0x08, 0x07, 0x0f, 0x1b, 0x16, 0x37, 0x8b, 0x10, 0xb2, 0x03,
+	0x4e, 0xc6, 0x94, 0x1c, 0x70, 0x32, 0x06, 0x68, 0xce, 0x0a, 0x44, 0x8c, 0x41, 0x1f, 0x39, 0x5a,
+	0xfb, 0x56, 0xbb, 0x8d, 0x3c, 0x07, 0xd1, 0x53, 0x53, 0x9e, 0x9d, 0x9a, 0xd8, 0x23, 0x87, 0x8a,
+	0x27, 0x8f, 0x1c, 0x2a, 0x0a, 0xcd, 0x69, 0xd9, 0xdc, 0xb2, 0xf5, 0x37, 0xc1, 0x69, 0xf1, 0x48,
+	0xc1, 0xd6, 0x50, 0xa9, 0x7e, 0x89, 0x55, 0x7c, 0x0e, 0x29, 0x15, 0x9f, 0x03, 0xd0, 0x8c, 0x4d,
+	0x7a, 0x0d, 0x4c, 0xf8, 0x34, 0x54, 0xe5, 0x89, 0xa5, 0xfc, 0x4a, 0xa9, 0xbe, 0x40, 0x2b, 0x3b,
+	0x03, 0x92, 0xca, 0xce, 0x9a, 0xd0, 0xe4, 0x30, 0x97, 0xbf, 0xbc, 0x89, 0x5f, 0x52, 0xd2, 0x76,
+	0x34, 0xfe, 0xf0, 0x26, 0xb8, 0x34, 0xd4, 0x20, 0x8f, 0xb4, 0xf3, 0x60, 0xe2, 0xc0, 0x6a, 0x8b,
+	0x83, 0xec, 0x94, 0xc9, 0x1b, 0xf0, 0x57, 0x79, 0x50, 0x62, 0x09, 0xdf, 0xeb, 0x7a, 0xf6, 0x4b,
+	0x3f, 0x9c, 0x25, 0x5b, 0x45, 0xee, 0x95, 0x6c, 0x15, 0x34, 0xc6, 0x1e, 0xf6, 0x5a, 0x48, 0x64,
+	0x98, 0xc5, 0x98, 0x01, 0x49, 0x8c, 0x59, 0x13, 0x9a, 0x1c, 0xd6, 0x7b, 0x60, 0xa6, 0xfe, 0xfe,
+	0x6e, 0x83, 0xb8, 0x8e, 0x67, 0x85, 0xdd, 0x00, 0xb1, 0x9c, 0x4e, 0xdf, 0x58, 0xaa, 0xa6, 0x9f,
+	0x3f, 0xab, 0xf5, 0xf7, 0x77, 0x77, 0x63, 0x9f, 0x2d, 0x6f, 0x0f, 0xd7, 0x6f, 0x88, 0x8b, 0xd9,
+	0x4c, 0xb3, 0x4d, 0x92, 0xee, 0x49, 0xf5, 0x4c, 0xc1, 0x90, 0x5f, 0xbd, 0x4a, 0x2a, 0x0b, 0x3f,
+	0x90, 0x28, 0xe7, 0xbd, 0xf9, 0xd4, 0xba, 0x14, 0xf9, 0x80, 0x3f, 0xd3, 0xc0, 0xbc, 0x0a, 0xc8,
+	0x7c, 0xbe, 0x4d, 0x9f, 0x18, 0x29, 0x88, 0x78, 0x4a, 0xc7, 0x5e, 0x23, 0x0b, 0x74, 0xb6, 0xa6,
+	0xec, 0xa0, 0xaf, 0xf3, 0xf2, 0x9d, 0x7b, 0xbe, 0x7e, 0xd4, 0x17, 0xfe, 0x59, 0x63, 0x87, 0xcb,
+	0x3b, 0xa8, 0x8d, 0x42, 0x56, 0x1a, 0x52, 0xef, 0x91, 0xda, 0xcb, 0x7a, 0x8f, 0x7c, 0xc1, 0xfa,
+	0xb9, 0x79, 0x8d, 0x46, 0x55, 0xd2, 0x65, 0x4f, 0x7a, 0xc9, 0xec, 0xe1, 0x21, 0x38, 0x97, 0x02,
+	0x64, 0x60, 0x1b, 0xa0, 0x14, 0xc7, 0x89, 0x3e, 0x95, 0x88, 0x4f, 0xfb, 0xda, 0xc9, 0xa4, 0x9b,
+	0xd1, 0xe7, 0x74, 0xcc, 0x48, 0x53, 0xfa, 0x3b, 0x0d, 0xcc, 0x6d, 0x13, 0xe7, 0x03, 0xdf, 0xa6,
+	0x97, 0x50, 0x2b, 0xb0, 0x3a, 0x44, 0xff, 0x2a, 0x28, 0x5a, 0xdd, 0x70, 0x1f, 0x07, 0x6e, 0xd8,
+	0x13, 0x23, 0x96, 0x47, 0x1f, 0xc6, 0xa4, 0xab, 0xfe, 0x16, 0x98, 0xf4, 0x19, 0x83, 0xc8, 0xe5,
+	0xf9, 0xac, 0x70, 0x39, 0x7f, 0xbd, 0x48, 0xa7, 0x2f, 0x1e, 0x00, 0x78, 0x87, 0xcd, 0xaf, 0xd0,
+	0x48, 0x25, 0x54, 0xa9, 0x1a, 0x73, 0xc8, 0x5e, 0xda, 0x33, 0x93, 0x84, 0x0b, 0xe0, 0x42, 0x06,
+	0x92, 0x1b, 0xc3, 0x1f, 0x35, 0xf0, 0x5a, 0x76, 0x8d, 0xe8, 0x9b, 0x60, 0xca, 0xef, 0x36, 0x1b,
+	0x0f, 0x51, 0x8f, 0x94, 0x35, 0x56, 0xf4, 0xd8, 0x13, 0x43, 0x8c, 0x25, 0x02, 0x89, 0x11, 0x68,
+	0x9e, 0xf6, 0xbb, 0xcd, 0xf7, 0x50, 0x8f, 0xe8, 0xdf, 0x04, 0xc5, 0x64, 0x51, 0xe6, 0x58, 0xa1,
+	0x65, 0x3a, 0x50, 0x97, 0x9a, 0xd0, 0x41, 0xb2, 0xcc, 0xcc, 0xc4, 0xac, 0xdf, 0x04, 0x93, 0xe1,
+	0xe1, 0x1d, 0x2b, 0xb4, 0xca, 0xf9, 0xa4, 0x4c, 0x87, 0x87, 0x0d, 0xdb, 0x0a, 0xad, 0xa4, 0x4c,
+	0x0b, 0x00, 0x9a, 0xc2, 0xf9, 0xc6, 0xdf, 0x00, 0xc8, 0x6f, 0x13, 0x47, 0xff, 0x3e, 0x38, 0x7b,
+	0xd7, 0xf2, 0xec, 0x36, 0x4a, 0xff, 0x75, 0xe1, 0x52, 0x36, 0xbe, 0x29, 0x73, 0xe5, 0xca, 0x58,
+	0xb3, 0x94, 0xd8, 0x3d, 0x30, 0x27, 0xc9, 0xc5, 0x53, 0xe9, 0xc2, 0x90, 0x9e, 0xdc, 0x54, 0xb9,
+	0x3c, 0xd2, 0x24, 0x09, 0x7f, 0xaa, 0x81, 0xcb, 0x92, 0x71, 0xe4, 0x33, 0xd9, 0xf5, 0x21, 0x44,
+	0xa3, 0x9c, 0x2b, 0x1b, 0x27, 0x70, 0x1e, 0x31, 0x8f, 0x51, 0x8f, 0x22, 0x43, 0xe7, 0x31, 0xc2,
+	0xb9, 0xb2, 0x71, 0x02, 0x67, 0x39, 0x8f, 0x1f, 0x80, 0x79, 0x39, 0x0d, 0xf5, 0xe9, 0x61, 0x71,
+	0x08, 0x99, 0x62, 0xaf, 0x5c, 0x1d, 0x6f, 0x97, 0xfc, 0xaa, 0x3a, 0x94, 0xeb, 0xf6, 0x30, 0x75,
+	0x24, 0xe6, 0xca, 0x95, 0xb1, 0x66, 0x49, 0xfe, 0x43, 0x50, 0x1e, 0x36, 0x79, 0x76, 0xdf, 0x84,
+	0xe3, 0x27, 0x48, 0x7d, 0x2a, 0xab, 0xc7, 0xfb, 0x0c, 0x1d, 0x2b, 0x7b, 0x2b, 0x1a, 0x36, 0x56,
+	0xc6, 0xa7, 0xb2, 0x7a, 0xbc, 0x8f, 0x1c, 0xab, 0x03, 0x16, 0xe4, 0x58, 0x47, 0x0e, 0xe2, 0xcb,
+	0x43, 0x88, 0xb2, 0x4e, 0x95, 0xeb, 0xcf, 0xe1, 0x24, 0x87, 0x23, 0xe0, 0xa2, 0x1c, 0x6e, 0xc8,
+	0x81, 0x75, 0x58, 0x32, 0x8e, 0xba, 0x55, 0xd6, 0x9e, 0xcb, 0x4d, 0x0e, 0xfa, 0x5d, 0xa0, 0x2b,
+	0xdf, 0x18, 0x1f, 0xaa, 0x5e, 0x1f, 0x3a, 0x6f, 0x61, 0xad, 0xbc, 0x31, 0xce, 0x3a, 0x54, 0x72,
+	0xca, 0x26, 0x3c, 0x4c, 0x72, 0x89, 0xb9, 0x72, 0x65, 0xac, 0x59, 0x99, 0x76, 0x29, 0xb5, 0x1d,
+	0x19, 0x43, 0xba, 0xa9, 0x0e, 0x95, 0x6b, 0xc7, 0x38, 0xc4, 0xcc, 0x95, 0x89, 0x1f, 0xd1, 0x4d,
+	0xa7, 0xbe, 0xfd, 0xc9, 0x93, 0x45, 0xed, 0xd3, 0x27, 0x8b, 0xda, 0x67, 0x4f, 0x16, 0xb5, 0x7f,
+	0x3e, 0x59, 0xd4, 0x7e, 0xf1, 0x74, 0xf1, 0xd4, 0x67, 0x4f, 0x17, 0x4f, 0x7d, 0xfe, 0x74, 0xf1,
+	0xd4, 0xf7, 0x6a, 0xca, 0xa6, 0x2a, 0x78, 0x3d, 0x14, 0xc6, 0x3f, 0xd7, 0x5a, 0xfb, 0x96, 0xeb,
+	0x89, 0x3d, 0x89, 0xed, 0xb0, 0xcd, 0x49, 0xf6, 0xd7, 0xdf, 0x8d, 0xff, 0x0c, 0x00, 0xb7, 0xe8,
+	0x61, 0xbc, 0xbf, 0x1e, 0x00, 0x00,
+}
+
+func (this *MsgFileUpload) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgFileUpload)
+	if !ok {
+		that2, ok := that.(MsgFileUpload)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.FileHash != that1.FileHash {
+		return false
+	}
+	if this.From != that1.From {
+		return false
+	}
+	if this.Reporter != that1.Reporter {
+		return false
+	}
+	if this.Uploader != that1.Uploader {
+		return false
+	}
+	if !bytes.Equal(this.SegmentRoot, that1.SegmentRoot) {
+		return false
+	}
+	if this.SegmentCount != that1.SegmentCount {
+		return false
+	}
+	if len(this.StorageNodes) != len(that1.StorageNodes) {
+		return false
+	}
+	for i := range this.StorageNodes {
+		if this.StorageNodes[i] != that1.StorageNodes[i] {
+			return false
+		}
+	}
+	if !this.RentAmount.Equal(that1.RentAmount) {
+		return false
+	}
+	if this.RentDuration != that1.RentDuration {
+		return false
+	}
+	if this.RentReplicas != that1.RentReplicas {
+		return false
+	}
+	return true
+}
+func (this *MsgFileUploadResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgFileUploadResponse)
+	if !ok {
+		that2, ok := that.(MsgFileUploadResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+func (this *MsgPrepay) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgPrepay)
+	if !ok {
+		that2, ok := that.(MsgPrepay)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Sender != that1.Sender {
+		return false
+	}
+	if this.Beneficiary != that1.Beneficiary {
+		return false
+	}
+	if len(this.Amount) != len(that1.Amount) {
+		return false
+	}
+	for i := range this.Amount {
+		if !this.Amount[i].Equal(&that1.Amount[i]) {
+			return false
+		}
+	}
+	if !this.MinNozOut.Equal(that1.MinNozOut) {
+		return false
+	}
+	if this.DeadlineHeight != that1.DeadlineHeight {
+		return false
+	}
+	return true
+}
+func (this *MsgPrepayResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgPrepayResponse)
+	if !ok {
+		that2, ok := that.(MsgPrepayResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+func (this *MsgCreatePrepaySubscription) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgCreatePrepaySubscription)
+	if !ok {
+		that2, ok := that.(MsgCreatePrepaySubscription)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Sender != that1.Sender {
+		return false
+	}
+	if this.Beneficiary != that1.Beneficiary {
+		return false
+	}
+	if len(this.Amount) != len(that1.Amount) {
+		return false
+	}
+	for i := range this.Amount {
+		if !this.Amount[i].Equal(&that1.Amount[i]) {
+			return false
+		}
+	}
+	if this.Interval != that1.Interval {
+		return false
+	}
+	if this.MaxCount != that1.MaxCount {
+		return false
+	}
+	if this.EndHeight != that1.EndHeight {
+		return false
+	}
+	return true
+}
+func (this *MsgCreatePrepaySubscriptionResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgCreatePrepaySubscriptionResponse)
+	if !ok {
+		that2, ok := that.(MsgCreatePrepaySubscriptionResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.SubscriptionId != that1.SubscriptionId {
+		return false
+	}
+	return true
+}
+func (this *MsgCancelPrepaySubscription) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgCancelPrepaySubscription)
+	if !ok {
+		that2, ok := that.(MsgCancelPrepaySubscription)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Sender != that1.Sender {
+		return false
+	}
+	if this.SubscriptionId != that1.SubscriptionId {
+		return false
+	}
+	return true
+}
+func (this *MsgCancelPrepaySubscriptionResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgCancelPrepaySubscriptionResponse)
+	if !ok {
+		that2, ok := that.(MsgCancelPrepaySubscriptionResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+func (this *MsgTransferNoz) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgTransferNoz)
+	if !ok {
+		that2, ok := that.(MsgTransferNoz)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Sender != that1.Sender {
+		return false
+	}
+	if this.Recipient != that1.Recipient {
+		return false
+	}
+	if !this.Amount.Equal(that1.Amount) {
+		return false
+	}
+	return true
+}
+func (this *MsgTransferNozResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgTransferNozResponse)
+	if !ok {
+		that2, ok := that.(MsgTransferNozResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+func (this *MsgApproveNoz) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgApproveNoz)
+	if !ok {
+		that2, ok := that.(MsgApproveNoz)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Owner != that1.Owner {
+		return false
+	}
+	if this.Spender != that1.Spender {
+		return false
+	}
+	if !this.Amount.Equal(that1.Amount) {
+		return false
+	}
+	return true
+}
+func (this *MsgApproveNozResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgApproveNozResponse)
+	if !ok {
+		that2, ok := that.(MsgApproveNozResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+func (this *MsgTransferNozFrom) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgTransferNozFrom)
+	if !ok {
+		that2, ok := that.(MsgTransferNozFrom)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Spender != that1.Spender {
+		return false
+	}
+	if this.Owner != that1.Owner {
+		return false
+	}
+	if this.Recipient != that1.Recipient {
+		return false
+	}
+	if !this.Amount.Equal(that1.Amount) {
+		return false
+	}
+	return true
+}
+func (this *MsgTransferNozFromResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgTransferNozFromResponse)
+	if !ok {
+		that2, ok := that.(MsgTransferNozFromResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+func (this *MsgGrantFileAccess) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgGrantFileAccess)
+	if !ok {
+		that2, ok := that.(MsgGrantFileAccess)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Granter != that1.Granter {
+		return false
+	}
+	if this.FileHash != that1.FileHash {
+		return false
+	}
+	if this.Grantee != that1.Grantee {
+		return false
+	}
+	if this.ExpiryHeight != that1.ExpiryHeight {
+		return false
+	}
+	if !this.Fee.Equal(that1.Fee) {
+		return false
+	}
+	return true
+}
+func (this *MsgGrantFileAccessResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgGrantFileAccessResponse)
+	if !ok {
+		that2, ok := that.(MsgGrantFileAccessResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+func (this *MsgRevokeFileAccess) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgRevokeFileAccess)
+	if !ok {
+		that2, ok := that.(MsgRevokeFileAccess)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Granter != that1.Granter {
+		return false
+	}
+	if this.FileHash != that1.FileHash {
+		return false
+	}
+	if this.Grantee != that1.Grantee {
+		return false
+	}
+	return true
+}
+func (this *MsgRevokeFileAccessResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgRevokeFileAccessResponse)
+	if !ok {
+		that2, ok := that.(MsgRevokeFileAccessResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+func (this *MsgSubmitStorageProof) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgSubmitStorageProof)
+	if !ok {
+		that2, ok := that.(MsgSubmitStorageProof)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.From != that1.From {
+		return false
+	}
+	if this.NetworkAddress != that1.NetworkAddress {
+		return false
+	}
+	if this.ChallengeId != that1.ChallengeId {
+		return false
+	}
+	if !bytes.Equal(this.Segment, that1.Segment) {
+		return false
+	}
+	if len(this.Proof) != len(that1.Proof) {
+		return false
+	}
+	for i := range this.Proof {
+		if !bytes.Equal(this.Proof[i], that1.Proof[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *MsgSubmitStorageProofResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgSubmitStorageProofResponse)
+	if !ok {
+		that2, ok := that.(MsgSubmitStorageProofResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Valid != that1.Valid {
+		return false
+	}
+	return true
+}
+func (this *MsgRefundNoz) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgRefundNoz)
+	if !ok {
+		that2, ok := that.(MsgRefundNoz)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Sender != that1.Sender {
+		return false
+	}
+	if !this.Amount.Equal(that1.Amount) {
+		return false
+	}
+	if this.Nonce != that1.Nonce {
+		return false
+	}
+	if !this.BLSSignature.Equal(&that1.BLSSignature) {
+		return false
+	}
+	return true
+}
+func (this *MsgRefundNozResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgRefundNozResponse)
+	if !ok {
+		that2, ok := that.(MsgRefundNozResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Refunded.Equal(&that1.Refunded) {
+		return false
+	}
+	if !this.Fee.Equal(&that1.Fee) {
+		return false
+	}
+	return true
+}
+func (this *MsgDeleteFile) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgDeleteFile)
+	if !ok {
+		that2, ok := that.(MsgDeleteFile)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Uploader != that1.Uploader {
+		return false
+	}
+	if this.FileHash != that1.FileHash {
+		return false
+	}
+	return true
+}
+func (this *MsgDeleteFileResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgDeleteFileResponse)
+	if !ok {
+		that2, ok := that.(MsgDeleteFileResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.RefundedNoz.Equal(that1.RefundedNoz) {
+		return false
+	}
+	return true
+}
+func (this *MsgUpdateParams) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgUpdateParams)
+	if !ok {
+		that2, ok := that.(MsgUpdateParams)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Authority != that1.Authority {
+		return false
+	}
+	if !this.Params.Equal(&that1.Params) {
+		return false
+	}
+	return true
+}
+func (this *MsgUpdateParamsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgUpdateParamsResponse)
+	if !ok {
+		that2, ok := that.(MsgUpdateParamsResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+func (this *BLSSignatureInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*BLSSignatureInfo)
+	if !ok {
+		that2, ok := that.(BLSSignatureInfo)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.PubKeys) != len(that1.PubKeys) {
+		return false
+	}
+	for i := range this.PubKeys {
+		if !bytes.Equal(this.PubKeys[i], that1.PubKeys[i]) {
+			return false
+		}
+	}
+	if !bytes.Equal(this.Signature, that1.Signature) {
+		return false
+	}
+	if !bytes.Equal(this.TxData, that1.TxData) {
+		return false
+	}
+	return true
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// HandleMsgFileUpload defines a method for file uploading
+	HandleMsgFileUpload(ctx context.Context, in *MsgFileUpload, opts ...grpc.CallOption) (*MsgFileUploadResponse, error)
+	// HandleMsgPrepay defines a method for prepay
+	HandleMsgPrepay(ctx context.Context, in *MsgPrepay, opts ...grpc.CallOption) (*MsgPrepayResponse, error)
+	// HandleMsgCreatePrepaySubscription defines a method for creating a recurring prepay
+	HandleMsgCreatePrepaySubscription(ctx context.Context, in *MsgCreatePrepaySubscription, opts ...grpc.CallOption) (*MsgCreatePrepaySubscriptionResponse, error)
+	// HandleMsgCancelPrepaySubscription defines a method for cancelling a recurring prepay
+	HandleMsgCancelPrepaySubscription(ctx context.Context, in *MsgCancelPrepaySubscription, opts ...grpc.CallOption) (*MsgCancelPrepaySubscriptionResponse, error)
+	// HandleMsgTransferNoz defines a method for transferring noz to another wallet
+	HandleMsgTransferNoz(ctx context.Context, in *MsgTransferNoz, opts ...grpc.CallOption) (*MsgTransferNozResponse, error)
+	// HandleMsgApproveNoz defines a method for allowing a spender to transfer noz on behalf of the owner
+	HandleMsgApproveNoz(ctx context.Context, in *MsgApproveNoz, opts ...grpc.CallOption) (*MsgApproveNozResponse, error)
+	// HandleMsgTransferNozFrom defines a method for transferring noz on behalf of the owner
+	HandleMsgTransferNozFrom(ctx context.Context, in *MsgTransferNozFrom, opts ...grpc.CallOption) (*MsgTransferNozFromResponse, error)
+	// HandleMsgGrantFileAccess defines a method for granting read access to an uploaded file
+	HandleMsgGrantFileAccess(ctx context.Context, in *MsgGrantFileAccess, opts ...grpc.CallOption) (*MsgGrantFileAccessResponse, error)
+	// HandleMsgRevokeFileAccess defines a method for revoking read access to an uploaded file
+	HandleMsgRevokeFileAccess(ctx context.Context, in *MsgRevokeFileAccess, opts ...grpc.CallOption) (*MsgRevokeFileAccessResponse, error)
+	// HandleMsgSubmitStorageProof defines a method for a resource node to answer a storage challenge
+	HandleMsgSubmitStorageProof(ctx context.Context, in *MsgSubmitStorageProof, opts ...grpc.CallOption) (*MsgSubmitStorageProofResponse, error)
+	// HandleMsgRefundNoz defines a method for burning unused noz in exchange of the prepaid STOS
+	HandleMsgRefundNoz(ctx context.Context, in *MsgRefundNoz, opts ...grpc.CallOption) (*MsgRefundNozResponse, error)
+	// HandleMsgDeleteFile defines a method for the uploader to delete a file and get back its unspent storage rent
+	HandleMsgDeleteFile(ctx context.Context, in *MsgDeleteFile, opts ...grpc.CallOption) (*MsgDeleteFileResponse, error)
+	// UpdateParams defined a governance operation for updating the x/sds module parameters.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) HandleMsgFileUpload(ctx context.Context, in *MsgFileUpload, opts ...grpc.CallOption) (*MsgFileUploadResponse, error) {
+	out := new(MsgFileUploadResponse)
+	err := c.cc.Invoke(ctx, "/stratos.sds.v1.Msg/HandleMsgFileUpload", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) HandleMsgPrepay(ctx context.Context, in *MsgPrepay, opts ...grpc.CallOption) (*MsgPrepayResponse, error) {
+	out := new(MsgPrepayResponse)
+	err := c.cc.Invoke(ctx, "/stratos.sds.v1.Msg/HandleMsgPrepay", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) HandleMsgCreatePrepaySubscription(ctx context.Context, in *MsgCreatePrepaySubscription, opts ...grpc.CallOption) (*MsgCreatePrepaySubscriptionResponse, error) {
+	out := new(MsgCreatePrepaySubscriptionResponse)
+	err := c.cc.Invoke(ctx, "/stratos.sds.v1.Msg/HandleMsgCreatePrepaySubscription", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) HandleMsgCancelPrepaySubscription(ctx context.Context, in *MsgCancelPrepaySubscription, opts ...grpc.CallOption) (*MsgCancelPrepaySubscriptionResponse, error) {
+	out := new(MsgCancelPrepaySubscriptionResponse)
+	err := c.cc.Invoke(ctx, "/stratos.sds.v1.Msg/HandleMsgCancelPrepaySubscription", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) HandleMsgTransferNoz(ctx context.Context, in *MsgTransferNoz, opts ...grpc.CallOption) (*MsgTransferNozResponse, error) {
+	out := new(MsgTransferNozResponse)
+	err := c.cc.Invoke(ctx, "/stratos.sds.v1.Msg/HandleMsgTransferNoz", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) HandleMsgApproveNoz(ctx context.Context, in *MsgApproveNoz, opts ...grpc.CallOption) (*MsgApproveNozResponse, error) {
+	out := new(MsgApproveNozResponse)
+	err := c.cc.Invoke(ctx, "/stratos.sds.v1.Msg/HandleMsgApproveNoz", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) HandleMsgTransferNozFrom(ctx context.Context, in *MsgTransferNozFrom, opts ...grpc.CallOption) (*MsgTransferNozFromResponse, error) {
+	out := new(MsgTransferNozFromResponse)
+	err := c.cc.Invoke(ctx, "/stratos.sds.v1.Msg/HandleMsgTransferNozFrom", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) HandleMsgGrantFileAccess(ctx context.Context, in *MsgGrantFileAccess, opts ...grpc.CallOption) (*MsgGrantFileAccessResponse, error) {
+	out := new(MsgGrantFileAccessResponse)
+	err := c.cc.Invoke(ctx, "/stratos.sds.v1.Msg/HandleMsgGrantFileAccess", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) HandleMsgRevokeFileAccess(ctx context.Context, in *MsgRevokeFileAccess, opts ...grpc.CallOption) (*MsgRevokeFileAccessResponse, error) {
+	out := new(MsgRevokeFileAccessResponse)
+	err := c.cc.Invoke(ctx, "/stratos.sds.v1.Msg/HandleMsgRevokeFileAccess", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) HandleMsgSubmitStorageProof(ctx context.Context, in *MsgSubmitStorageProof, opts ...grpc.CallOption) (*MsgSubmitStorageProofResponse, error) {
+	out := new(MsgSubmitStorageProofResponse)
+	err := c.cc.Invoke(ctx, "/stratos.sds.v1.Msg/HandleMsgSubmitStorageProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) HandleMsgRefundNoz(ctx context.Context, in *MsgRefundNoz, opts ...grpc.CallOption) (*MsgRefundNozResponse, error) {
+	out := new(MsgRefundNozResponse)
+	err := c.cc.Invoke(ctx, "/stratos.sds.v1.Msg/HandleMsgRefundNoz", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) HandleMsgDeleteFile(ctx context.Context, in *MsgDeleteFile, opts ...grpc.CallOption) (*MsgDeleteFileResponse, error) {
+	out := new(MsgDeleteFileResponse)
+	err := c.cc.Invoke(ctx, "/stratos.sds.v1.Msg/HandleMsgDeleteFile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/stratos.sds.v1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// HandleMsgFileUpload defines a method for file uploading
+	HandleMsgFileUpload(context.Context, *MsgFileUpload) (*MsgFileUploadResponse, error)
+	// HandleMsgPrepay defines a method for prepay
+	HandleMsgPrepay(context.Context, *MsgPrepay) (*MsgPrepayResponse, error)
+	// HandleMsgCreatePrepaySubscription defines a method for creating a recurring prepay
+	HandleMsgCreatePrepaySubscription(context.Context, *MsgCreatePrepaySubscription) (*MsgCreatePrepaySubscriptionResponse, error)
+	// HandleMsgCancelPrepaySubscription defines a method for cancelling a recurring prepay
+	HandleMsgCancelPrepaySubscription(context.Context, *MsgCancelPrepaySubscription) (*MsgCancelPrepaySubscriptionResponse, error)
+	// HandleMsgTransferNoz defines a method for transferring noz to another wallet
+	HandleMsgTransferNoz(context.Context, *MsgTransferNoz) (*MsgTransferNozResponse, error)
+	// HandleMsgApproveNoz defines a method for allowing a spender to transfer noz on behalf of the owner
+	HandleMsgApproveNoz(context.Context, *MsgApproveNoz) (*MsgApproveNozResponse, error)
+	// HandleMsgTransferNozFrom defines a method for transferring noz on behalf of the owner
+	HandleMsgTransferNozFrom(context.Context, *MsgTransferNozFrom) (*MsgTransferNozFromResponse, error)
+	// HandleMsgGrantFileAccess defines a method for granting read access to an uploaded file
+	HandleMsgGrantFileAccess(context.Context, *MsgGrantFileAccess) (*MsgGrantFileAccessResponse, error)
+	// HandleMsgRevokeFileAccess defines a method for revoking read access to an uploaded file
+	HandleMsgRevokeFileAccess(context.Context, *MsgRevokeFileAccess) (*MsgRevokeFileAccessResponse, error)
+	// HandleMsgSubmitStorageProof defines a method for a resource node to answer a storage challenge
+	HandleMsgSubmitStorageProof(context.Context, *MsgSubmitStorageProof) (*MsgSubmitStorageProofResponse, error)
+	// HandleMsgRefundNoz defines a method for burning unused noz in exchange of the prepaid STOS
+	HandleMsgRefundNoz(context.Context, *MsgRefundNoz) (*MsgRefundNozResponse, error)
+	// HandleMsgDeleteFile defines a method for the uploader to delete a file and get back its unspent storage rent
+	HandleMsgDeleteFile(context.Context, *MsgDeleteFile) (*MsgDeleteFileResponse, error)
+	// UpdateParams defined a governance operation for updating the x/sds module parameters.
+	// The authority is hard-coded to the Cosmos SDK x/gov module account
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) HandleMsgFileUpload(ctx context.Context, req *MsgFileUpload) (*MsgFileUploadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HandleMsgFileUpload not implemented")
+}
+func (*UnimplementedMsgServer) HandleMsgPrepay(ctx context.Context, req *MsgPrepay) (*MsgPrepayResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HandleMsgPrepay not implemented")
+}
+func (*UnimplementedMsgServer) HandleMsgCreatePrepaySubscription(ctx context.Context, req *MsgCreatePrepaySubscription) (*MsgCreatePrepaySubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HandleMsgCreatePrepaySubscription not implemented")
+}
+func (*UnimplementedMsgServer) HandleMsgCancelPrepaySubscription(ctx context.Context, req *MsgCancelPrepaySubscription) (*MsgCancelPrepaySubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HandleMsgCancelPrepaySubscription not implemented")
+}
+func (*UnimplementedMsgServer) HandleMsgTransferNoz(ctx context.Context, req *MsgTransferNoz) (*MsgTransferNozResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HandleMsgTransferNoz not implemented")
+}
+func (*UnimplementedMsgServer) HandleMsgApproveNoz(ctx context.Context, req *MsgApproveNoz) (*MsgApproveNozResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HandleMsgApproveNoz not implemented")
+}
+func (*UnimplementedMsgServer) HandleMsgTransferNozFrom(ctx context.Context, req *MsgTransferNozFrom) (*MsgTransferNozFromResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HandleMsgTransferNozFrom not implemented")
+}
+func (*UnimplementedMsgServer) HandleMsgGrantFileAccess(ctx context.Context, req *MsgGrantFileAccess) (*MsgGrantFileAccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HandleMsgGrantFileAccess not implemented")
+}
+func (*UnimplementedMsgServer) HandleMsgRevokeFileAccess(ctx context.Context, req *MsgRevokeFileAccess) (*MsgRevokeFileAccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HandleMsgRevokeFileAccess not implemented")
+}
+func (*UnimplementedMsgServer) HandleMsgSubmitStorageProof(ctx context.Context, req *MsgSubmitStorageProof) (*MsgSubmitStorageProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HandleMsgSubmitStorageProof not implemented")
+}
+func (*UnimplementedMsgServer) HandleMsgRefundNoz(ctx context.Context, req *MsgRefundNoz) (*MsgRefundNozResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HandleMsgRefundNoz not implemented")
+}
+func (*UnimplementedMsgServer) HandleMsgDeleteFile(ctx context.Context, req *MsgDeleteFile) (*MsgDeleteFileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HandleMsgDeleteFile not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_HandleMsgFileUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFileUpload)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).HandleMsgFileUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stratos.sds.v1.Msg/HandleMsgFileUpload",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).HandleMsgFileUpload(ctx, req.(*MsgFileUpload))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_HandleMsgPrepay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPrepay)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).HandleMsgPrepay(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stratos.sds.v1.Msg/HandleMsgPrepay",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).HandleMsgPrepay(ctx, req.(*MsgPrepay))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_HandleMsgCreatePrepaySubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreatePrepaySubscription)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).HandleMsgCreatePrepaySubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stratos.sds.v1.Msg/HandleMsgCreatePrepaySubscription",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).HandleMsgCreatePrepaySubscription(ctx, req.(*MsgCreatePrepaySubscription))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_HandleMsgCancelPrepaySubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelPrepaySubscription)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).HandleMsgCancelPrepaySubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stratos.sds.v1.Msg/HandleMsgCancelPrepaySubscription",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).HandleMsgCancelPrepaySubscription(ctx, req.(*MsgCancelPrepaySubscription))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_HandleMsgTransferNoz_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferNoz)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).HandleMsgTransferNoz(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stratos.sds.v1.Msg/HandleMsgTransferNoz",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).HandleMsgTransferNoz(ctx, req.(*MsgTransferNoz))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_HandleMsgApproveNoz_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgApproveNoz)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).HandleMsgApproveNoz(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stratos.sds.v1.Msg/HandleMsgApproveNoz",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).HandleMsgApproveNoz(ctx, req.(*MsgApproveNoz))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_HandleMsgTransferNozFrom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferNozFrom)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).HandleMsgTransferNozFrom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stratos.sds.v1.Msg/HandleMsgTransferNozFrom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).HandleMsgTransferNozFrom(ctx, req.(*MsgTransferNozFrom))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_HandleMsgGrantFileAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGrantFileAccess)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).HandleMsgGrantFileAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stratos.sds.v1.Msg/HandleMsgGrantFileAccess",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).HandleMsgGrantFileAccess(ctx, req.(*MsgGrantFileAccess))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_HandleMsgRevokeFileAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeFileAccess)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).HandleMsgRevokeFileAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stratos.sds.v1.Msg/HandleMsgRevokeFileAccess",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).HandleMsgRevokeFileAccess(ctx, req.(*MsgRevokeFileAccess))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_HandleMsgSubmitStorageProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitStorageProof)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).HandleMsgSubmitStorageProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stratos.sds.v1.Msg/HandleMsgSubmitStorageProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).HandleMsgSubmitStorageProof(ctx, req.(*MsgSubmitStorageProof))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_HandleMsgRefundNoz_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRefundNoz)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).HandleMsgRefundNoz(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stratos.sds.v1.Msg/HandleMsgRefundNoz",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).HandleMsgRefundNoz(ctx, req.(*MsgRefundNoz))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_HandleMsgDeleteFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteFile)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).HandleMsgDeleteFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stratos.sds.v1.Msg/HandleMsgDeleteFile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).HandleMsgDeleteFile(ctx, req.(*MsgDeleteFile))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stratos.sds.v1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "stratos.sds.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "HandleMsgFileUpload",
+			Handler:    _Msg_HandleMsgFileUpload_Handler,
+		},
+		{
+			MethodName: "HandleMsgPrepay",
+			Handler:    _Msg_HandleMsgPrepay_Handler,
+		},
+		{
+			MethodName: "HandleMsgCreatePrepaySubscription",
+			Handler:    _Msg_HandleMsgCreatePrepaySubscription_Handler,
+		},
+		{
+			MethodName: "HandleMsgCancelPrepaySubscription",
+			Handler:    _Msg_HandleMsgCancelPrepaySubscription_Handler,
+		},
+		{
+			MethodName: "HandleMsgTransferNoz",
+			Handler:    _Msg_HandleMsgTransferNoz_Handler,
+		},
+		{
+			MethodName: "HandleMsgApproveNoz",
+			Handler:    _Msg_HandleMsgApproveNoz_Handler,
+		},
+		{
+			MethodName: "HandleMsgTransferNozFrom",
+			Handler:    _Msg_HandleMsgTransferNozFrom_Handler,
+		},
+		{
+			MethodName: "HandleMsgGrantFileAccess",
+			Handler:    _Msg_HandleMsgGrantFileAccess_Handler,
+		},
+		{
+			MethodName: "HandleMsgRevokeFileAccess",
+			Handler:    _Msg_HandleMsgRevokeFileAccess_Handler,
+		},
+		{
+			MethodName: "HandleMsgSubmitStorageProof",
+			Handler:    _Msg_HandleMsgSubmitStorageProof_Handler,
+		},
+		{
+			MethodName: "HandleMsgRefundNoz",
+			Handler:    _Msg_HandleMsgRefundNoz_Handler,
+		},
+		{
+			MethodName: "HandleMsgDeleteFile",
+			Handler:    _Msg_HandleMsgDeleteFile_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "stratos/sds/v1/tx.proto",
+}
+
+func (m *MsgFileUpload) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFileUpload) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFileUpload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RentReplicas != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RentReplicas))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.RentDuration != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RentDuration))
+		i--
+		dAtA[i] = 0x48
+	}
+	{
+		size := m.RentAmount.Size()
+		i -= size
+		if _, err := m.RentAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if len(m.StorageNodes) > 0 {
+		for iNdEx := len(m.StorageNodes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.StorageNodes[iNdEx])
+			copy(dAtA[i:], m.StorageNodes[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.StorageNodes[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.SegmentCount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SegmentCount))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.SegmentRoot) > 0 {
+		i -= len(m.SegmentRoot)
+		copy(dAtA[i:], m.SegmentRoot)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SegmentRoot)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Uploader) > 0 {
+		i -= len(m.Uploader)
+		copy(dAtA[i:], m.Uploader)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Uploader)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Reporter) > 0 {
+		i -= len(m.Reporter)
+		copy(dAtA[i:], m.Reporter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reporter)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FileHash) > 0 {
+		i -= len(m.FileHash)
+		copy(dAtA[i:], m.FileHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FileHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFileUploadResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFileUploadResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFileUploadResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPrepay) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPrepay) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPrepay) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DeadlineHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DeadlineHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.MinNozOut.Size()
+		i -= size
+		if _, err := m.MinNozOut.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Beneficiary) > 0 {
+		i -= len(m.Beneficiary)
+		copy(dAtA[i:], m.Beneficiary)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Beneficiary)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPrepayResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPrepayResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPrepayResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreatePrepaySubscription) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreatePrepaySubscription) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreatePrepaySubscription) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MaxCount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxCount))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Interval != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Interval))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Beneficiary) > 0 {
+		i -= len(m.Beneficiary)
+		copy(dAtA[i:], m.Beneficiary)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Beneficiary)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreatePrepaySubscriptionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreatePrepaySubscriptionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreatePrepaySubscriptionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SubscriptionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SubscriptionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelPrepaySubscription) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelPrepaySubscription) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelPrepaySubscription) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SubscriptionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SubscriptionId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelPrepaySubscriptionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelPrepaySubscriptionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelPrepaySubscriptionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferNoz) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferNoz) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferNoz) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferNozResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferNozResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferNozResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgApproveNoz) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgApproveNoz) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgApproveNoz) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Spender) > 0 {
+		i -= len(m.Spender)
+		copy(dAtA[i:], m.Spender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Spender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgApproveNozResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgApproveNozResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgApproveNozResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferNozFrom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferNozFrom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferNozFrom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Spender) > 0 {
+		i -= len(m.Spender)
+		copy(dAtA[i:], m.Spender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Spender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferNozFromResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferNozFromResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferNozFromResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGrantFileAccess) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGrantFileAccess) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGrantFileAccess) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Fee.Size()
+		i -= size
+		if _, err := m.Fee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.FileHash) > 0 {
+		i -= len(m.FileHash)
+		copy(dAtA[i:], m.FileHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FileHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGrantFileAccessResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGrantFileAccessResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGrantFileAccessResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeFileAccess) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeFileAccess) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeFileAccess) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.FileHash) > 0 {
+		i -= len(m.FileHash)
+		copy(dAtA[i:], m.FileHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FileHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeFileAccessResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeFileAccessResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeFileAccessResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitStorageProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitStorageProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitStorageProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Proof) > 0 {
+		for iNdEx := len(m.Proof) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Proof[iNdEx])
+			copy(dAtA[i:], m.Proof[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Proof[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Segment) > 0 {
+		i -= len(m.Segment)
+		copy(dAtA[i:], m.Segment)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Segment)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ChallengeId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ChallengeId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.NetworkAddress) > 0 {
+		i -= len(m.NetworkAddress)
+		copy(dAtA[i:], m.NetworkAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NetworkAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitStorageProofResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitStorageProofResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitStorageProofResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Valid {
+		i--
+		if m.Valid {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRefundNoz) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRefundNoz) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRefundNoz) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.BLSSignature.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Nonce != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRefundNozResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRefundNozResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRefundNozResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Refunded.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteFile) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteFile) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteFile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FileHash) > 0 {
+		i -= len(m.FileHash)
+		copy(dAtA[i:], m.FileHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FileHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Uploader) > 0 {
+		i -= len(m.Uploader)
+		copy(dAtA[i:], m.Uploader)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Uploader)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteFileResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteFileResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteFileResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.RefundedNoz.Size()
+		i -= size
+		if _, err := m.RefundedNoz.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *BLSSignatureInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BLSSignatureInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BLSSignatureInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxData) > 0 {
+		i -= len(m.TxData)
+		copy(dAtA[i:], m.TxData)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TxData)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PubKeys) > 0 {
+		for iNdEx := len(m.PubKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PubKeys[iNdEx])
+			copy(dAtA[i:], m.PubKeys[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.PubKeys[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgFileUpload) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FileHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Reporter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Uploader)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SegmentRoot)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SegmentCount != 0 {
+		n += 1 + sovTx(uint64(m.SegmentCount))
+	}
+	if len(m.StorageNodes) > 0 {
+		for _, s := range m.StorageNodes {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = m.RentAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.RentDuration != 0 {
+		n += 1 + sovTx(uint64(m.RentDuration))
+	}
+	if m.RentReplicas != 0 {
+		n += 1 + sovTx(uint64(m.RentReplicas))
+	}
+	return n
+}
+
+func (m *MsgFileUploadResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgPrepay) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Beneficiary)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = m.MinNozOut.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.DeadlineHeight != 0 {
+		n += 1 + sovTx(uint64(m.DeadlineHeight))
+	}
+	return n
+}
+
+func (m *MsgPrepayResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreatePrepaySubscription) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Beneficiary)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Interval != 0 {
+		n += 1 + sovTx(uint64(m.Interval))
+	}
+	if m.MaxCount != 0 {
+		n += 1 + sovTx(uint64(m.MaxCount))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovTx(uint64(m.EndHeight))
+	}
+	return n
+}
+
+func (m *MsgCreatePrepaySubscriptionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubscriptionId != 0 {
+		n += 1 + sovTx(uint64(m.SubscriptionId))
+	}
+	return n
+}
+
+func (m *MsgCancelPrepaySubscription) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SubscriptionId != 0 {
+		n += 1 + sovTx(uint64(m.SubscriptionId))
+	}
+	return n
+}
+
+func (m *MsgCancelPrepaySubscriptionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgTransferNoz) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgTransferNozResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgApproveNoz) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Spender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgApproveNozResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgTransferNozFrom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Spender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgTransferNozFromResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgGrantFileAccess) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.FileHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovTx(uint64(m.ExpiryHeight))
+	}
+	l = m.Fee.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgGrantFileAccessResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRevokeFileAccess) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.FileHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRevokeFileAccessResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSubmitStorageProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NetworkAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ChallengeId != 0 {
+		n += 1 + sovTx(uint64(m.ChallengeId))
+	}
+	l = len(m.Segment)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Proof) > 0 {
+		for _, b := range m.Proof {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSubmitStorageProofResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Valid {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgRefundNoz) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Nonce != 0 {
+		n += 1 + sovTx(uint64(m.Nonce))
+	}
+	l = m.BLSSignature.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgRefundNozResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Refunded.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Fee.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgDeleteFile) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Uploader)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.FileHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeleteFileResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RefundedNoz.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *BLSSignatureInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PubKeys) > 0 {
+		for _, b := range m.PubKeys {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TxData)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgFileUpload) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFileUpload: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFileUpload: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FileHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FileHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reporter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reporter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uploader", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Uploader = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SegmentRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SegmentRoot = append(m.SegmentRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.SegmentRoot == nil {
+				m.SegmentRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SegmentCount", wireType)
+			}
+			m.SegmentCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SegmentCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageNodes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StorageNodes = append(m.StorageNodes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RentAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RentAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RentDuration", wireType)
+			}
+			m.RentDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RentDuration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RentReplicas", wireType)
+			}
+			m.RentReplicas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RentReplicas |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFileUploadResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFileUploadResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFileUploadResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPrepay) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPrepay: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPrepay: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Beneficiary", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Beneficiary = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinNozOut", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinNozOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeadlineHeight", wireType)
+			}
+			m.DeadlineHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeadlineHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPrepayResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPrepayResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPrepayResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreatePrepaySubscription) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreatePrepaySubscription: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreatePrepaySubscription: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Beneficiary", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Beneficiary = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			m.Interval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Interval |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCount", wireType)
+			}
+			m.MaxCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreatePrepaySubscriptionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreatePrepaySubscriptionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreatePrepaySubscriptionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubscriptionId", wireType)
+			}
+			m.SubscriptionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubscriptionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelPrepaySubscription) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelPrepaySubscription: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelPrepaySubscription: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubscriptionId", wireType)
+			}
+			m.SubscriptionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubscriptionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelPrepaySubscriptionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelPrepaySubscriptionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelPrepaySubscriptionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTransferNoz) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferNoz: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferNoz: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTransferNozResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferNozResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferNozResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgApproveNoz) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgApproveNoz: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgApproveNoz: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Spender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgApproveNozResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgApproveNozResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgApproveNozResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTransferNozFrom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferNozFrom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferNozFrom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Spender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTransferNozFromResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferNozFromResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferNozFromResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgGrantFileAccess) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgGrantFileAccess: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgGrantFileAccess: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FileHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FileHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+			}
+			m.ExpiryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgGrantFileAccessResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgGrantFileAccessResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgGrantFileAccessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevokeFileAccess) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokeFileAccess: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokeFileAccess: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FileHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FileHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevokeFileAccessResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokeFileAccessResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokeFileAccessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitStorageProof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitStorageProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitStorageProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetworkAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NetworkAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChallengeId", wireType)
+			}
+			m.ChallengeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChallengeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Segment", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Segment = append(m.Segment[:0], dAtA[iNdEx:postIndex]...)
+			if m.Segment == nil {
+				m.Segment = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proof = append(m.Proof, make([]byte, postIndex-iNdEx))
+			copy(m.Proof[len(m.Proof)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitStorageProofResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitStorageProofResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitStorageProofResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Valid", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Valid = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRefundNoz) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRefundNoz: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRefundNoz: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BLSSignature", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BLSSignature.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgFileUpload) Unmarshal(dAtA []byte) error {
+func (m *MsgRefundNozResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1048,17 +7129,17 @@ func (m *MsgFileUpload) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFileUpload: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRefundNozResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFileUpload: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRefundNozResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FileHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Refunded", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1068,29 +7149,30 @@ func (m *MsgFileUpload) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FileHash = string(dAtA[iNdEx:postIndex])
+			if err := m.Refunded.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1100,27 +7182,78 @@ func (m *MsgFileUpload) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.From = string(dAtA[iNdEx:postIndex])
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteFile) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteFile: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteFile: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reporter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Uploader", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1148,11 +7281,11 @@ func (m *MsgFileUpload) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reporter = string(dAtA[iNdEx:postIndex])
+			m.Uploader = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Uploader", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FileHash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1180,7 +7313,7 @@ func (m *MsgFileUpload) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Uploader = string(dAtA[iNdEx:postIndex])
+			m.FileHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1203,7 +7336,7 @@ func (m *MsgFileUpload) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgFileUploadResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDeleteFileResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1226,12 +7359,46 @@ func (m *MsgFileUploadResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFileUploadResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeleteFileResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFileUploadResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeleteFileResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundedNoz", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RefundedNoz.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1253,7 +7420,7 @@ func (m *MsgFileUploadResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgPrepay) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1276,15 +7443,15 @@ func (m *MsgPrepay) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgPrepay: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgPrepay: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1312,43 +7479,11 @@ func (m *MsgPrepay) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Beneficiary", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Beneficiary = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1375,8 +7510,7 @@ func (m *MsgPrepay) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1401,7 +7535,7 @@ func (m *MsgPrepay) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgPrepayResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1424,10 +7558,10 @@ func (m *MsgPrepayResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgPrepayResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgPrepayResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1451,7 +7585,7 @@ func (m *MsgPrepayResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *BLSSignatureInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1474,17 +7608,17 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: BLSSignatureInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BLSSignatureInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKeys", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1494,29 +7628,29 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.PubKeys = append(m.PubKeys, make([]byte, postIndex-iNdEx))
+			copy(m.PubKeys[len(m.PubKeys)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1526,75 +7660,60 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxData", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.TxData = append(m.TxData[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxData == nil {
+				m.TxData = []byte{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
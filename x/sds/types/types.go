@@ -4,11 +4,30 @@ import (
 	sdkmath "cosmossdk.io/math"
 )
 
+const (
+	// MinPrepaySubscriptionInterval is the min number of blocks between two executions of a prepay subscription
+	MinPrepaySubscriptionInterval = int64(100)
+	// MaxPrepaySubscriptionsPerSender is the max number of prepay subscriptions owned by a sender
+	MaxPrepaySubscriptionsPerSender = 10
+	// MaxPrepaySubscriptionFailures is the number of consecutive failed executions after which a prepay subscription is removed
+	MaxPrepaySubscriptionFailures = uint32(3)
+)
+
 // NewFileInfo constructor
-func NewFileInfo(height sdkmath.Int, reporters []byte, uploader string) FileInfo {
+func NewFileInfo(height sdkmath.Int, reporters []byte, uploader string, segmentRoot []byte, segmentCount uint64,
+	storageNodes []string) FileInfo {
+
 	return FileInfo{
-		Height:    height,
-		Reporters: reporters,
-		Uploader:  uploader,
+		Height:       height,
+		Reporters:    reporters,
+		Uploader:     uploader,
+		SegmentRoot:  segmentRoot,
+		SegmentCount: segmentCount,
+		StorageNodes: storageNodes,
 	}
 }
+
+// IsChallengeable returns true when the storage of the file can be challenged
+func (f FileInfo) IsChallengeable() bool {
+	return len(f.GetSegmentRoot()) > 0 && f.GetSegmentCount() > 0 && len(f.GetStorageNodes()) > 0
+}
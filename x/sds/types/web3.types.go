@@ -26,3 +26,43 @@ func (m *MsgPrepay) GetWeb3Msg() (*stratos.Web3Msg, error) {
 		Value: value,
 	}, nil
 }
+
+func (m *MsgCreatePrepaySubscription) GetWeb3Msg() (*stratos.Web3Msg, error) {
+	return nil, nil // SKIP
+}
+
+func (m *MsgCancelPrepaySubscription) GetWeb3Msg() (*stratos.Web3Msg, error) {
+	return nil, nil // SKIP
+}
+
+func (m *MsgTransferNoz) GetWeb3Msg() (*stratos.Web3Msg, error) {
+	return nil, nil // SKIP
+}
+
+func (m *MsgApproveNoz) GetWeb3Msg() (*stratos.Web3Msg, error) {
+	return nil, nil // SKIP
+}
+
+func (m *MsgTransferNozFrom) GetWeb3Msg() (*stratos.Web3Msg, error) {
+	return nil, nil // SKIP
+}
+
+func (m *MsgGrantFileAccess) GetWeb3Msg() (*stratos.Web3Msg, error) {
+	return nil, nil // SKIP
+}
+
+func (m *MsgRevokeFileAccess) GetWeb3Msg() (*stratos.Web3Msg, error) {
+	return nil, nil // SKIP
+}
+
+func (m *MsgSubmitStorageProof) GetWeb3Msg() (*stratos.Web3Msg, error) {
+	return nil, nil // SKIP
+}
+
+func (m *MsgRefundNoz) GetWeb3Msg() (*stratos.Web3Msg, error) {
+	return nil, nil // SKIP
+}
+
+func (m *MsgDeleteFile) GetWeb3Msg() (*stratos.Web3Msg, error) {
+	return nil, nil // SKIP
+}